@@ -0,0 +1,33 @@
+// Command protoc-gen-descriptorset writes the compiled FileDescriptorSet of
+// the API, including imports and source comments, to descriptor.binpb under
+// the output directory. It is embedded by gen/descriptorset.go.
+//
+// This is what "buf build -o gen/descriptor.binpb" produces, but as a plugin
+// it stays in sync with the rest of "buf generate".
+package main
+
+import (
+	"flag"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+func main() {
+	var flags flag.FlagSet
+	protogen.Options{ParamFunc: flags.Set}.Run(func(gen *protogen.Plugin) error {
+		gen.SupportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL)
+		// ProtoFile lists every file with its imports before it, which is
+		// the order protodesc.NewFiles expects.
+		set := &descriptorpb.FileDescriptorSet{File: gen.Request.GetProtoFile()}
+		data, err := proto.MarshalOptions{Deterministic: true}.Marshal(set)
+		if err != nil {
+			return err
+		}
+		g := gen.NewGeneratedFile("descriptor.binpb", "")
+		_, err = g.Write(data)
+		return err
+	})
+}
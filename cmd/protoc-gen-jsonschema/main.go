@@ -0,0 +1,263 @@
+// Command protoc-gen-jsonschema generates a JSON Schema (draft 2020-12) for
+// every message, written as jsonschema/<Message>.schema.json under the output
+// directory and embedded by gen/jsonschema.go.
+//
+// Schemas describe the protojson encoding the gateway speaks: lowerCamelCase
+// field names, 64-bit integers as numbers or strings, enums by value name and
+// well-known types in their JSON forms. Referenced messages and enums are
+// included under $defs, so every file is self-contained.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+const draft = "https://json-schema.org/draft/2020-12/schema"
+
+func main() {
+	var flags flag.FlagSet
+	protogen.Options{ParamFunc: flags.Set}.Run(func(gen *protogen.Plugin) error {
+		gen.SupportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL)
+		for _, f := range gen.Files {
+			if !f.Generate {
+				continue
+			}
+			for _, m := range allMessages(f.Messages) {
+				if err := generateSchema(gen, m); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+func allMessages(ms []*protogen.Message) []*protogen.Message {
+	var out []*protogen.Message
+	for _, m := range ms {
+		if m.Desc.IsMapEntry() {
+			continue
+		}
+		out = append(out, m)
+		out = append(out, allMessages(m.Messages)...)
+	}
+	return out
+}
+
+// object is a JSON object that keeps its keys in insertion order.
+type object struct {
+	keys   []string
+	values map[string]any
+}
+
+func newObject() *object { return &object{values: make(map[string]any)} }
+
+func (o *object) set(key string, v any) *object {
+	if _, ok := o.values[key]; !ok {
+		o.keys = append(o.keys, key)
+	}
+	o.values[key] = v
+	return o
+}
+
+func (o *object) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, k := range o.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		kb, _ := json.Marshal(k)
+		buf.Write(kb)
+		buf.WriteByte(':')
+		vb, err := json.Marshal(o.values[k])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(vb)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// schemaBuilder collects the $defs of one output file.
+type schemaBuilder struct {
+	root *protogen.Message
+	defs *object
+}
+
+func generateSchema(gen *protogen.Plugin, m *protogen.Message) error {
+	name := defName(m.Desc)
+	b := &schemaBuilder{root: m, defs: newObject()}
+	s := newObject().
+		set("$schema", draft).
+		set("$id", name+".schema.json")
+	b.messageInto(s, m)
+	if len(b.defs.keys) > 0 {
+		s.set("$defs", b.defs)
+	}
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	g := gen.NewGeneratedFile("jsonschema/"+name+".schema.json", "")
+	_, err = g.Write(append(data, '\n'))
+	return err
+}
+
+// defName is the message or enum name relative to its package, e.g.
+// "InsertOrderRequest".
+func defName(d protoreflect.Descriptor) string {
+	return strings.TrimPrefix(string(d.FullName()), string(d.ParentFile().Package())+".")
+}
+
+func (b *schemaBuilder) messageInto(s *object, m *protogen.Message) {
+	s.set("title", defName(m.Desc))
+	if desc := comment(m.Comments.Leading, m.Comments.Trailing); desc != "" {
+		s.set("description", desc)
+	}
+	props := newObject()
+	for _, f := range m.Fields {
+		fs := b.field(f)
+		if desc := comment(f.Comments.Leading, f.Comments.Trailing); desc != "" {
+			fs.set("description", desc)
+		}
+		props.set(f.Desc.JSONName(), fs)
+	}
+	s.set("type", "object").
+		set("properties", props).
+		set("additionalProperties", false)
+}
+
+func (b *schemaBuilder) field(f *protogen.Field) *object {
+	switch {
+	case f.Desc.IsMap():
+		return newObject().
+			set("type", "object").
+			set("additionalProperties", b.value(f.Message.Fields[1]))
+	case f.Desc.IsList():
+		return newObject().
+			set("type", "array").
+			set("items", b.value(f))
+	}
+	return b.value(f)
+}
+
+// value is the schema of a single (non-repeated) value of f.
+func (b *schemaBuilder) value(f *protogen.Field) *object {
+	switch f.Desc.Kind() {
+	case protoreflect.BoolKind:
+		return newObject().set("type", "boolean")
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return newObject().set("type", "integer").set("minimum", -1<<31).set("maximum", 1<<31-1)
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return newObject().set("type", "integer").set("minimum", 0).set("maximum", 1<<32-1)
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return int64Schema("int64")
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return int64Schema("uint64")
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return newObject().set("type", "number")
+	case protoreflect.StringKind:
+		return newObject().set("type", "string")
+	case protoreflect.BytesKind:
+		return newObject().set("type", "string").set("contentEncoding", "base64")
+	case protoreflect.EnumKind:
+		return b.ref(f.Enum.Desc, func() *object { return enumSchema(f.Enum) })
+	default:
+		if s := wellKnown(f.Message.Desc.FullName()); s != nil {
+			return s
+		}
+		return b.ref(f.Message.Desc, func() *object {
+			s := newObject()
+			b.messageInto(s, f.Message)
+			return s
+		})
+	}
+}
+
+// ref points at d's schema, adding it to $defs on first use.
+func (b *schemaBuilder) ref(d protoreflect.Descriptor, build func() *object) *object {
+	if d.FullName() == b.root.Desc.FullName() {
+		return newObject().set("$ref", "#")
+	}
+	name := defName(d)
+	if _, ok := b.defs.values[name]; !ok {
+		b.defs.set(name, nil) // reserve first, the message may refer to itself
+		b.defs.set(name, build())
+	}
+	return newObject().set("$ref", "#/$defs/"+name)
+}
+
+func int64Schema(format string) *object {
+	// protojson writes 64-bit integers as strings and accepts both forms.
+	return newObject().set("type", []string{"integer", "string"}).set("format", format)
+}
+
+func enumSchema(e *protogen.Enum) *object {
+	names := make([]string, len(e.Values))
+	for i, v := range e.Values {
+		names[i] = string(v.Desc.Name())
+	}
+	s := newObject().set("title", defName(e.Desc))
+	if desc := comment(e.Comments.Leading, e.Comments.Trailing); desc != "" {
+		s.set("description", desc)
+	}
+	return s.set("type", "string").set("enum", names)
+}
+
+func wellKnown(name protoreflect.FullName) *object {
+	switch name {
+	case "google.protobuf.Timestamp":
+		return newObject().set("type", "string").set("format", "date-time")
+	case "google.protobuf.Duration":
+		return newObject().set("type", "string").set("pattern", `^-?[0-9]+(\.[0-9]{1,9})?s$`)
+	case "google.protobuf.FieldMask":
+		return newObject().set("type", "string").set("description", "Comma-separated lowerCamelCase field paths.")
+	case "google.protobuf.Struct", "google.protobuf.Empty":
+		return newObject().set("type", "object")
+	case "google.protobuf.ListValue":
+		return newObject().set("type", "array")
+	case "google.protobuf.Value":
+		return newObject()
+	case "google.protobuf.Any":
+		return newObject().
+			set("type", "object").
+			set("properties", newObject().set("@type", newObject().set("type", "string"))).
+			set("required", []string{"@type"})
+	case "google.protobuf.BoolValue":
+		return newObject().set("type", []string{"boolean", "null"})
+	case "google.protobuf.StringValue":
+		return newObject().set("type", []string{"string", "null"})
+	case "google.protobuf.BytesValue":
+		return newObject().set("type", []string{"string", "null"}).set("contentEncoding", "base64")
+	case "google.protobuf.Int32Value", "google.protobuf.UInt32Value":
+		return newObject().set("type", []string{"integer", "null"})
+	case "google.protobuf.Int64Value":
+		return newObject().set("type", []string{"integer", "string", "null"}).set("format", "int64")
+	case "google.protobuf.UInt64Value":
+		return newObject().set("type", []string{"integer", "string", "null"}).set("format", "uint64")
+	case "google.protobuf.FloatValue", "google.protobuf.DoubleValue":
+		return newObject().set("type", []string{"number", "null"})
+	}
+	return nil
+}
+
+func comment(cs ...protogen.Comments) string {
+	var parts []string
+	for _, c := range cs {
+		for _, line := range strings.Split(string(c), "\n") {
+			if t := strings.TrimSpace(line); t != "" {
+				parts = append(parts, t)
+			}
+		}
+	}
+	return strings.Join(parts, "\n")
+}
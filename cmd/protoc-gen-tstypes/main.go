@@ -0,0 +1,233 @@
+// Command protoc-gen-tstypes generates TypeScript types for the gateway's JSON
+// payloads: one ts/<file>.ts per proto file with an interface per message and
+// a string union per enum, plus ts/index.ts re-exporting everything. The
+// output is embedded by gen/typescript.go.
+//
+// Types follow protojson: lowerCamelCase field names, 64-bit integers as
+// strings, enums by value name and well-known types in their JSON forms. All
+// fields are optional because requests may omit them; gateway responses
+// include every field (unset messages and optional scalars as null).
+package main
+
+import (
+	"flag"
+	"path"
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+func main() {
+	var flags flag.FlagSet
+	protogen.Options{ParamFunc: flags.Set}.Run(func(gen *protogen.Plugin) error {
+		gen.SupportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL)
+		var modules []string
+		for _, f := range gen.Files {
+			if f.Generate {
+				generateFile(gen, f)
+				modules = append(modules, moduleName(f.Desc))
+			}
+		}
+		if len(modules) > 0 {
+			slices.Sort(modules)
+			g := gen.NewGeneratedFile("ts/index.ts", "")
+			g.P("// Code generated by protoc-gen-tstypes. DO NOT EDIT.")
+			g.P()
+			for _, m := range modules {
+				g.P(`export * from "./`, m, `";`)
+			}
+		}
+		return nil
+	})
+}
+
+// moduleName is the TypeScript module of a proto file, e.g. "order" for
+// order.proto.
+func moduleName(f protoreflect.FileDescriptor) string {
+	return strings.TrimSuffix(path.Base(f.Path()), ".proto")
+}
+
+// typeName is the TypeScript name of a message or enum; nested types are
+// joined with underscores ("Outer_Inner").
+func typeName(d protoreflect.Descriptor) string {
+	name := strings.TrimPrefix(string(d.FullName()), string(d.ParentFile().Package())+".")
+	return strings.ReplaceAll(name, ".", "_")
+}
+
+type fileGen struct {
+	file    *protogen.File
+	imports map[string][]string // module -> imported type names
+	body    strings.Builder
+}
+
+func generateFile(gen *protogen.Plugin, f *protogen.File) {
+	fg := &fileGen{file: f, imports: make(map[string][]string)}
+	for _, e := range f.Enums {
+		fg.enum(e)
+	}
+	for _, m := range f.Messages {
+		fg.message(m)
+	}
+
+	g := gen.NewGeneratedFile("ts/"+moduleName(f.Desc)+".ts", "")
+	g.P("// Code generated by protoc-gen-tstypes. DO NOT EDIT.")
+	g.P("// source: ", f.Desc.Path())
+	g.P()
+	modules := make([]string, 0, len(fg.imports))
+	for m := range fg.imports {
+		modules = append(modules, m)
+	}
+	slices.Sort(modules)
+	for _, m := range modules {
+		names := fg.imports[m]
+		slices.Sort(names)
+		g.P("import type { ", strings.Join(names, ", "), ` } from "./`, m, `";`)
+	}
+	if len(modules) > 0 {
+		g.P()
+	}
+	g.P(strings.TrimSuffix(fg.body.String(), "\n"))
+}
+
+func (fg *fileGen) p(parts ...string) {
+	for _, s := range parts {
+		fg.body.WriteString(s)
+	}
+	fg.body.WriteByte('\n')
+}
+
+func (fg *fileGen) doc(indent string, cs ...protogen.Comments) {
+	var lines []string
+	for _, c := range cs {
+		for _, line := range strings.Split(string(c), "\n") {
+			if t := strings.TrimSpace(line); t != "" {
+				lines = append(lines, strings.ReplaceAll(t, "*/", "*\\/"))
+			}
+		}
+	}
+	switch len(lines) {
+	case 0:
+	case 1:
+		fg.p(indent, "/** ", lines[0], " */")
+	default:
+		fg.p(indent, "/**")
+		for _, l := range lines {
+			fg.p(indent, " * ", l)
+		}
+		fg.p(indent, " */")
+	}
+}
+
+func (fg *fileGen) enum(e *protogen.Enum) {
+	fg.doc("", e.Comments.Leading, e.Comments.Trailing)
+	values := make([]string, len(e.Values))
+	for i, v := range e.Values {
+		values[i] = `"` + string(v.Desc.Name()) + `"`
+	}
+	fg.p("export type ", typeName(e.Desc), " = ", strings.Join(values, " | "), ";")
+	fg.p()
+}
+
+func (fg *fileGen) message(m *protogen.Message) {
+	if m.Desc.IsMapEntry() {
+		return
+	}
+	fg.doc("", m.Comments.Leading, m.Comments.Trailing)
+	if len(m.Fields) == 0 {
+		fg.p("export type ", typeName(m.Desc), " = Record<string, never>;")
+	} else {
+		fg.p("export interface ", typeName(m.Desc), " {")
+		for _, f := range m.Fields {
+			fg.doc("  ", f.Comments.Leading, f.Comments.Trailing)
+			fg.p("  ", f.Desc.JSONName(), "?: ", fg.fieldType(f), ";")
+		}
+		fg.p("}")
+	}
+	fg.p()
+	for _, e := range m.Enums {
+		fg.enum(e)
+	}
+	for _, nested := range m.Messages {
+		fg.message(nested)
+	}
+}
+
+func (fg *fileGen) fieldType(f *protogen.Field) string {
+	switch {
+	case f.Desc.IsMap():
+		return "{ [key: string]: " + fg.valueType(f.Message.Fields[1]) + " }"
+	case f.Desc.IsList():
+		t := fg.valueType(f)
+		if strings.Contains(t, " ") {
+			t = "(" + t + ")"
+		}
+		return t + "[]"
+	case f.Message != nil && wellKnown(f.Message.Desc.FullName()) == "", f.Desc.HasOptionalKeyword():
+		return fg.valueType(f) + " | null"
+	}
+	return fg.valueType(f)
+}
+
+func (fg *fileGen) valueType(f *protogen.Field) string {
+	switch f.Desc.Kind() {
+	case protoreflect.BoolKind:
+		return "boolean"
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.FloatKind, protoreflect.DoubleKind:
+		return "number"
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return "string" // protojson encodes 64-bit integers as strings
+	case protoreflect.StringKind, protoreflect.BytesKind:
+		return "string"
+	case protoreflect.EnumKind:
+		return fg.ref(f.Enum.Desc)
+	default:
+		if t := wellKnown(f.Message.Desc.FullName()); t != "" {
+			return t
+		}
+		return fg.ref(f.Message.Desc)
+	}
+}
+
+// ref names d, importing it when it lives in another proto file.
+func (fg *fileGen) ref(d protoreflect.Descriptor) string {
+	name := typeName(d)
+	if d.ParentFile().Path() != fg.file.Desc.Path() {
+		m := moduleName(d.ParentFile())
+		if !slices.Contains(fg.imports[m], name) {
+			fg.imports[m] = append(fg.imports[m], name)
+		}
+	}
+	return name
+}
+
+func wellKnown(name protoreflect.FullName) string {
+	switch name {
+	case "google.protobuf.Timestamp", "google.protobuf.Duration", "google.protobuf.FieldMask":
+		return "string"
+	case "google.protobuf.Struct":
+		return "{ [key: string]: unknown }"
+	case "google.protobuf.Value":
+		return "unknown"
+	case "google.protobuf.ListValue":
+		return "unknown[]"
+	case "google.protobuf.Empty":
+		return "Record<string, never>"
+	case "google.protobuf.Any":
+		return `{ "@type": string; [key: string]: unknown }`
+	case "google.protobuf.BoolValue":
+		return "boolean | null"
+	case "google.protobuf.StringValue", "google.protobuf.BytesValue",
+		"google.protobuf.Int64Value", "google.protobuf.UInt64Value":
+		return "string | null"
+	case "google.protobuf.Int32Value", "google.protobuf.UInt32Value",
+		"google.protobuf.FloatValue", "google.protobuf.DoubleValue":
+		return "number | null"
+	}
+	return ""
+}
@@ -10,6 +10,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -22,6 +23,157 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type PushPlatform int32
+
+const (
+	PushPlatform_PUSH_PLATFORM_UNSPECIFIED PushPlatform = 0
+	PushPlatform_PUSH_PLATFORM_FCM         PushPlatform = 1
+	PushPlatform_PUSH_PLATFORM_APNS        PushPlatform = 2
+)
+
+// Enum value maps for PushPlatform.
+var (
+	PushPlatform_name = map[int32]string{
+		0: "PUSH_PLATFORM_UNSPECIFIED",
+		1: "PUSH_PLATFORM_FCM",
+		2: "PUSH_PLATFORM_APNS",
+	}
+	PushPlatform_value = map[string]int32{
+		"PUSH_PLATFORM_UNSPECIFIED": 0,
+		"PUSH_PLATFORM_FCM":         1,
+		"PUSH_PLATFORM_APNS":        2,
+	}
+)
+
+func (x PushPlatform) Enum() *PushPlatform {
+	p := new(PushPlatform)
+	*p = x
+	return p
+}
+
+func (x PushPlatform) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PushPlatform) Descriptor() protoreflect.EnumDescriptor {
+	return file_account_proto_enumTypes[0].Descriptor()
+}
+
+func (PushPlatform) Type() protoreflect.EnumType {
+	return &file_account_proto_enumTypes[0]
+}
+
+func (x PushPlatform) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PushPlatform.Descriptor instead.
+func (PushPlatform) EnumDescriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{0}
+}
+
+// 병합 시 같은 항목이 양쪽에 있을 때의 처리 방식
+type MergeConflictResolution int32
+
+const (
+	MergeConflictResolution_MERGE_CONFLICT_RESOLUTION_UNSPECIFIED MergeConflictResolution = 0
+	MergeConflictResolution_MERGE_CONFLICT_RESOLUTION_KEPT_TARGET MergeConflictResolution = 1 // target 값 유지, source 값 폐기
+	MergeConflictResolution_MERGE_CONFLICT_RESOLUTION_KEPT_SOURCE MergeConflictResolution = 2 // source 값으로 덮어씀
+	MergeConflictResolution_MERGE_CONFLICT_RESOLUTION_COMBINED    MergeConflictResolution = 3 // 수량/포인트 합산
+)
+
+// Enum value maps for MergeConflictResolution.
+var (
+	MergeConflictResolution_name = map[int32]string{
+		0: "MERGE_CONFLICT_RESOLUTION_UNSPECIFIED",
+		1: "MERGE_CONFLICT_RESOLUTION_KEPT_TARGET",
+		2: "MERGE_CONFLICT_RESOLUTION_KEPT_SOURCE",
+		3: "MERGE_CONFLICT_RESOLUTION_COMBINED",
+	}
+	MergeConflictResolution_value = map[string]int32{
+		"MERGE_CONFLICT_RESOLUTION_UNSPECIFIED": 0,
+		"MERGE_CONFLICT_RESOLUTION_KEPT_TARGET": 1,
+		"MERGE_CONFLICT_RESOLUTION_KEPT_SOURCE": 2,
+		"MERGE_CONFLICT_RESOLUTION_COMBINED":    3,
+	}
+)
+
+func (x MergeConflictResolution) Enum() *MergeConflictResolution {
+	p := new(MergeConflictResolution)
+	*p = x
+	return p
+}
+
+func (x MergeConflictResolution) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MergeConflictResolution) Descriptor() protoreflect.EnumDescriptor {
+	return file_account_proto_enumTypes[1].Descriptor()
+}
+
+func (MergeConflictResolution) Type() protoreflect.EnumType {
+	return &file_account_proto_enumTypes[1]
+}
+
+func (x MergeConflictResolution) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MergeConflictResolution.Descriptor instead.
+func (MergeConflictResolution) EnumDescriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{1}
+}
+
+type Theme int32
+
+const (
+	Theme_THEME_UNSPECIFIED Theme = 0 // 시스템 설정 따름
+	Theme_THEME_LIGHT       Theme = 1
+	Theme_THEME_DARK        Theme = 2
+)
+
+// Enum value maps for Theme.
+var (
+	Theme_name = map[int32]string{
+		0: "THEME_UNSPECIFIED",
+		1: "THEME_LIGHT",
+		2: "THEME_DARK",
+	}
+	Theme_value = map[string]int32{
+		"THEME_UNSPECIFIED": 0,
+		"THEME_LIGHT":       1,
+		"THEME_DARK":        2,
+	}
+)
+
+func (x Theme) Enum() *Theme {
+	p := new(Theme)
+	*p = x
+	return p
+}
+
+func (x Theme) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Theme) Descriptor() protoreflect.EnumDescriptor {
+	return file_account_proto_enumTypes[2].Descriptor()
+}
+
+func (Theme) Type() protoreflect.EnumType {
+	return &file_account_proto_enumTypes[2]
+}
+
+func (x Theme) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Theme.Descriptor instead.
+func (Theme) EnumDescriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{2}
+}
+
 type GetKakaoLoginURLRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -151,6 +303,7 @@ type GetKakaoCallBackResponse struct {
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
 	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
 	UserInfoJson  string                 `protobuf:"bytes,3,opt,name=user_info_json,json=userInfoJson,proto3" json:"user_info_json,omitempty"`
+	Scopes        []string               `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"` // access_token에 부여된 권한 (ex: "orders:read")
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -206,10 +359,19 @@ func (x *GetKakaoCallBackResponse) GetUserInfoJson() string {
 	return ""
 }
 
+func (x *GetKakaoCallBackResponse) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
 type LoginRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
 	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Device        *DeviceFingerprint     `protobuf:"bytes,3,opt,name=device,proto3" json:"device,omitempty"`
+	CaptchaToken  string                 `protobuf:"bytes,4,opt,name=captcha_token,json=captchaToken,proto3" json:"captcha_token,omitempty"` // 로그인 실패가 반복되면 필수
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -258,12 +420,31 @@ func (x *LoginRequest) GetPassword() string {
 	return ""
 }
 
+func (x *LoginRequest) GetDevice() *DeviceFingerprint {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
+func (x *LoginRequest) GetCaptchaToken() string {
+	if x != nil {
+		return x.CaptchaToken
+	}
+	return ""
+}
+
 type LoginResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
-	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken  string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	// 사용자가 동의해야 하는 최신 약관 버전, terms_acceptance_required가 true면
+	// 클라이언트는 재동의 화면을 띄운 뒤 AcceptTerms를 호출해야 함
+	RequiredTermsVersion    string   `protobuf:"bytes,3,opt,name=required_terms_version,json=requiredTermsVersion,proto3" json:"required_terms_version,omitempty"`
+	TermsAcceptanceRequired bool     `protobuf:"varint,4,opt,name=terms_acceptance_required,json=termsAcceptanceRequired,proto3" json:"terms_acceptance_required,omitempty"`
+	Scopes                  []string `protobuf:"bytes,5,rep,name=scopes,proto3" json:"scopes,omitempty"` // access_token에 부여된 권한 (ex: "orders:read")
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
 func (x *LoginResponse) Reset() {
@@ -310,10 +491,32 @@ func (x *LoginResponse) GetRefreshToken() string {
 	return ""
 }
 
+func (x *LoginResponse) GetRequiredTermsVersion() string {
+	if x != nil {
+		return x.RequiredTermsVersion
+	}
+	return ""
+}
+
+func (x *LoginResponse) GetTermsAcceptanceRequired() bool {
+	if x != nil {
+		return x.TermsAcceptanceRequired
+	}
+	return false
+}
+
+func (x *LoginResponse) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
 type RegisterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"` // 필요하면 추가 필드 (예: 이름, 전화번호 등)
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	CaptchaToken  string                 `protobuf:"bytes,3,opt,name=captcha_token,json=captchaToken,proto3" json:"captcha_token,omitempty"` // 봇 가입 방지, 필수
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -362,6 +565,13 @@ func (x *RegisterRequest) GetPassword() string {
 	return ""
 }
 
+func (x *RegisterRequest) GetCaptchaToken() string {
+	if x != nil {
+		return x.CaptchaToken
+	}
+	return ""
+}
+
 type RegisterResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"` // ex) "Registration successful"
@@ -406,93 +616,3222 @@ func (x *RegisterResponse) GetMessage() string {
 	return ""
 }
 
-var File_account_proto protoreflect.FileDescriptor
+type RefreshTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	Device        *DeviceFingerprint     `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_account_proto_rawDesc = "" +
-	"\n" +
-	"\raccount.proto\x12\x17go.escape.ship.proto.v1\x1a\x1cgoogle/api/annotations.proto\"\x19\n" +
-	"\x17GetKakaoLoginURLRequest\"7\n" +
-	"\x18GetKakaoLoginURLResponse\x12\x1b\n" +
-	"\tlogin_url\x18\x01 \x01(\tR\bloginUrl\"-\n" +
-	"\x17GetKakaoCallBackRequest\x12\x12\n" +
-	"\x04code\x18\x01 \x01(\tR\x04code\"\x88\x01\n" +
-	"\x18GetKakaoCallBackResponse\x12!\n" +
-	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
-	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12$\n" +
-	"\x0euser_info_json\x18\x03 \x01(\tR\fuserInfoJson\"@\n" +
-	"\fLoginRequest\x12\x14\n" +
-	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"W\n" +
-	"\rLoginResponse\x12!\n" +
-	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
-	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\"C\n" +
-	"\x0fRegisterRequest\x12\x14\n" +
-	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\",\n" +
-	"\x10RegisterResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage2\xa4\x04\n" +
-	"\x0eAccountService\x12\x93\x01\n" +
-	"\x10GetKakaoLoginURL\x120.go.escape.ship.proto.v1.GetKakaoLoginURLRequest\x1a1.go.escape.ship.proto.v1.GetKakaoLoginURLResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/oauth/kakao/login\x12\x99\x01\n" +
-	"\x10GetKakaoCallBack\x120.go.escape.ship.proto.v1.GetKakaoCallBackRequest\x1a1.go.escape.ship.proto.v1.GetKakaoCallBackResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/oauth/kakao/callback\x12i\n" +
-	"\x05Login\x12%.go.escape.ship.proto.v1.LoginRequest\x1a&.go.escape.ship.proto.v1.LoginResponse\"\x11\x82\xd3\xe4\x93\x02\v:\x01*\"\x06/login\x12u\n" +
-	"\bRegister\x12(.go.escape.ship.proto.v1.RegisterRequest\x1a).go.escape.ship.proto.v1.RegisterResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/registerB#Z!github.com/escape-ship/protos/genb\x06proto3"
+func (x *RefreshTokenRequest) Reset() {
+	*x = RefreshTokenRequest{}
+	mi := &file_account_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-var (
-	file_account_proto_rawDescOnce sync.Once
-	file_account_proto_rawDescData []byte
-)
+func (x *RefreshTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func file_account_proto_rawDescGZIP() []byte {
-	file_account_proto_rawDescOnce.Do(func() {
-		file_account_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_account_proto_rawDesc), len(file_account_proto_rawDesc)))
-	})
-	return file_account_proto_rawDescData
+func (*RefreshTokenRequest) ProtoMessage() {}
+
+func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var file_account_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
-var file_account_proto_goTypes = []any{
-	(*GetKakaoLoginURLRequest)(nil),  // 0: go.escape.ship.proto.v1.GetKakaoLoginURLRequest
-	(*GetKakaoLoginURLResponse)(nil), // 1: go.escape.ship.proto.v1.GetKakaoLoginURLResponse
-	(*GetKakaoCallBackRequest)(nil),  // 2: go.escape.ship.proto.v1.GetKakaoCallBackRequest
-	(*GetKakaoCallBackResponse)(nil), // 3: go.escape.ship.proto.v1.GetKakaoCallBackResponse
-	(*LoginRequest)(nil),             // 4: go.escape.ship.proto.v1.LoginRequest
-	(*LoginResponse)(nil),            // 5: go.escape.ship.proto.v1.LoginResponse
-	(*RegisterRequest)(nil),          // 6: go.escape.ship.proto.v1.RegisterRequest
-	(*RegisterResponse)(nil),         // 7: go.escape.ship.proto.v1.RegisterResponse
+// Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
+func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{8}
 }
-var file_account_proto_depIdxs = []int32{
-	0, // 0: go.escape.ship.proto.v1.AccountService.GetKakaoLoginURL:input_type -> go.escape.ship.proto.v1.GetKakaoLoginURLRequest
-	2, // 1: go.escape.ship.proto.v1.AccountService.GetKakaoCallBack:input_type -> go.escape.ship.proto.v1.GetKakaoCallBackRequest
-	4, // 2: go.escape.ship.proto.v1.AccountService.Login:input_type -> go.escape.ship.proto.v1.LoginRequest
-	6, // 3: go.escape.ship.proto.v1.AccountService.Register:input_type -> go.escape.ship.proto.v1.RegisterRequest
-	1, // 4: go.escape.ship.proto.v1.AccountService.GetKakaoLoginURL:output_type -> go.escape.ship.proto.v1.GetKakaoLoginURLResponse
-	3, // 5: go.escape.ship.proto.v1.AccountService.GetKakaoCallBack:output_type -> go.escape.ship.proto.v1.GetKakaoCallBackResponse
-	5, // 6: go.escape.ship.proto.v1.AccountService.Login:output_type -> go.escape.ship.proto.v1.LoginResponse
-	7, // 7: go.escape.ship.proto.v1.AccountService.Register:output_type -> go.escape.ship.proto.v1.RegisterResponse
-	4, // [4:8] is the sub-list for method output_type
-	0, // [0:4] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+
+func (x *RefreshTokenRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
 }
 
-func init() { file_account_proto_init() }
-func file_account_proto_init() {
-	if File_account_proto != nil {
-		return
+func (x *RefreshTokenRequest) GetDevice() *DeviceFingerprint {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
+type RefreshTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"` // 새로 발급된 토큰, 다음 갱신에 사용
+	ExpiresIn     int32                  `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`         // access_token 만료까지 남은 시간 (초)
+	Scopes        []string               `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`                                 // access_token에 부여된 권한 (ex: "orders:read")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshTokenResponse) Reset() {
+	*x = RefreshTokenResponse{}
+	mi := &file_account_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenResponse) ProtoMessage() {}
+
+func (x *RefreshTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenResponse.ProtoReflect.Descriptor instead.
+func (*RefreshTokenResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RefreshTokenResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *RefreshTokenResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *RefreshTokenResponse) GetExpiresIn() int32 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+func (x *RefreshTokenResponse) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+type RevokeTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                                 // access_token 또는 refresh_token
+	AllSessions   bool                   `protobuf:"varint,2,opt,name=all_sessions,json=allSessions,proto3" json:"all_sessions,omitempty"` // true면 사용자의 모든 기기 세션 폐기
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeTokenRequest) Reset() {
+	*x = RevokeTokenRequest{}
+	mi := &file_account_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeTokenRequest) ProtoMessage() {}
+
+func (x *RevokeTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeTokenRequest.ProtoReflect.Descriptor instead.
+func (*RevokeTokenRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RevokeTokenRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RevokeTokenRequest) GetAllSessions() bool {
+	if x != nil {
+		return x.AllSessions
+	}
+	return false
+}
+
+type RevokeTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeTokenResponse) Reset() {
+	*x = RevokeTokenResponse{}
+	mi := &file_account_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeTokenResponse) ProtoMessage() {}
+
+func (x *RevokeTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeTokenResponse.ProtoReflect.Descriptor instead.
+func (*RevokeTokenResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{11}
+}
+
+type AnonymizeUserDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"` // ex) "account_deleted", "privacy_request"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnonymizeUserDataRequest) Reset() {
+	*x = AnonymizeUserDataRequest{}
+	mi := &file_account_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnonymizeUserDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnonymizeUserDataRequest) ProtoMessage() {}
+
+func (x *AnonymizeUserDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnonymizeUserDataRequest.ProtoReflect.Descriptor instead.
+func (*AnonymizeUserDataRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AnonymizeUserDataRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AnonymizeUserDataRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AnonymizeUserDataResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	AnonymizedOrders   int64                  `protobuf:"varint,1,opt,name=anonymized_orders,json=anonymizedOrders,proto3" json:"anonymized_orders,omitempty"`
+	AnonymizedPayments int64                  `protobuf:"varint,2,opt,name=anonymized_payments,json=anonymizedPayments,proto3" json:"anonymized_payments,omitempty"`
+	CompletedAt        string                 `protobuf:"bytes,3,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *AnonymizeUserDataResponse) Reset() {
+	*x = AnonymizeUserDataResponse{}
+	mi := &file_account_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnonymizeUserDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnonymizeUserDataResponse) ProtoMessage() {}
+
+func (x *AnonymizeUserDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnonymizeUserDataResponse.ProtoReflect.Descriptor instead.
+func (*AnonymizeUserDataResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AnonymizeUserDataResponse) GetAnonymizedOrders() int64 {
+	if x != nil {
+		return x.AnonymizedOrders
+	}
+	return 0
+}
+
+func (x *AnonymizeUserDataResponse) GetAnonymizedPayments() int64 {
+	if x != nil {
+		return x.AnonymizedPayments
+	}
+	return 0
+}
+
+func (x *AnonymizeUserDataResponse) GetCompletedAt() string {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return ""
+}
+
+type AcceptTermsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TermsVersion  string                 `protobuf:"bytes,1,opt,name=terms_version,json=termsVersion,proto3" json:"terms_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptTermsRequest) Reset() {
+	*x = AcceptTermsRequest{}
+	mi := &file_account_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptTermsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptTermsRequest) ProtoMessage() {}
+
+func (x *AcceptTermsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptTermsRequest.ProtoReflect.Descriptor instead.
+func (*AcceptTermsRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AcceptTermsRequest) GetTermsVersion() string {
+	if x != nil {
+		return x.TermsVersion
+	}
+	return ""
+}
+
+type AcceptTermsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TermsVersion  string                 `protobuf:"bytes,1,opt,name=terms_version,json=termsVersion,proto3" json:"terms_version,omitempty"`
+	AcceptedAt    string                 `protobuf:"bytes,2,opt,name=accepted_at,json=acceptedAt,proto3" json:"accepted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptTermsResponse) Reset() {
+	*x = AcceptTermsResponse{}
+	mi := &file_account_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptTermsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptTermsResponse) ProtoMessage() {}
+
+func (x *AcceptTermsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptTermsResponse.ProtoReflect.Descriptor instead.
+func (*AcceptTermsResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AcceptTermsResponse) GetTermsVersion() string {
+	if x != nil {
+		return x.TermsVersion
+	}
+	return ""
+}
+
+func (x *AcceptTermsResponse) GetAcceptedAt() string {
+	if x != nil {
+		return x.AcceptedAt
+	}
+	return ""
+}
+
+type RegisterPushTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId      string                 `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Platform      PushPlatform           `protobuf:"varint,3,opt,name=platform,proto3,enum=go.escape.ship.proto.v1.PushPlatform" json:"platform,omitempty"`
+	AppVersion    string                 `protobuf:"bytes,4,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterPushTokenRequest) Reset() {
+	*x = RegisterPushTokenRequest{}
+	mi := &file_account_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterPushTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterPushTokenRequest) ProtoMessage() {}
+
+func (x *RegisterPushTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterPushTokenRequest.ProtoReflect.Descriptor instead.
+func (*RegisterPushTokenRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RegisterPushTokenRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *RegisterPushTokenRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RegisterPushTokenRequest) GetPlatform() PushPlatform {
+	if x != nil {
+		return x.Platform
+	}
+	return PushPlatform_PUSH_PLATFORM_UNSPECIFIED
+}
+
+func (x *RegisterPushTokenRequest) GetAppVersion() string {
+	if x != nil {
+		return x.AppVersion
+	}
+	return ""
+}
+
+type RegisterPushTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RegisteredAt  string                 `protobuf:"bytes,1,opt,name=registered_at,json=registeredAt,proto3" json:"registered_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterPushTokenResponse) Reset() {
+	*x = RegisterPushTokenResponse{}
+	mi := &file_account_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterPushTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterPushTokenResponse) ProtoMessage() {}
+
+func (x *RegisterPushTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterPushTokenResponse.ProtoReflect.Descriptor instead.
+func (*RegisterPushTokenResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RegisterPushTokenResponse) GetRegisteredAt() string {
+	if x != nil {
+		return x.RegisteredAt
+	}
+	return ""
+}
+
+type UnregisterPushTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId      string                 `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnregisterPushTokenRequest) Reset() {
+	*x = UnregisterPushTokenRequest{}
+	mi := &file_account_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnregisterPushTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnregisterPushTokenRequest) ProtoMessage() {}
+
+func (x *UnregisterPushTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnregisterPushTokenRequest.ProtoReflect.Descriptor instead.
+func (*UnregisterPushTokenRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UnregisterPushTokenRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *UnregisterPushTokenRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type UnregisterPushTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnregisterPushTokenResponse) Reset() {
+	*x = UnregisterPushTokenResponse{}
+	mi := &file_account_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnregisterPushTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnregisterPushTokenResponse) ProtoMessage() {}
+
+func (x *UnregisterPushTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnregisterPushTokenResponse.ProtoReflect.Descriptor instead.
+func (*UnregisterPushTokenResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{19}
+}
+
+type VerifyCaptchaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CaptchaToken  string                 `protobuf:"bytes,1,opt,name=captcha_token,json=captchaToken,proto3" json:"captcha_token,omitempty"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"` // ex) "login", "register"
+	RemoteIp      string                 `protobuf:"bytes,3,opt,name=remote_ip,json=remoteIp,proto3" json:"remote_ip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyCaptchaRequest) Reset() {
+	*x = VerifyCaptchaRequest{}
+	mi := &file_account_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyCaptchaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyCaptchaRequest) ProtoMessage() {}
+
+func (x *VerifyCaptchaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyCaptchaRequest.ProtoReflect.Descriptor instead.
+func (*VerifyCaptchaRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *VerifyCaptchaRequest) GetCaptchaToken() string {
+	if x != nil {
+		return x.CaptchaToken
+	}
+	return ""
+}
+
+func (x *VerifyCaptchaRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *VerifyCaptchaRequest) GetRemoteIp() string {
+	if x != nil {
+		return x.RemoteIp
+	}
+	return ""
+}
+
+type VerifyCaptchaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Score         float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"` // 점수 기반 CAPTCHA일 때 0.0(봇) ~ 1.0(사람)
+	ErrorCodes    []string               `protobuf:"bytes,3,rep,name=error_codes,json=errorCodes,proto3" json:"error_codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyCaptchaResponse) Reset() {
+	*x = VerifyCaptchaResponse{}
+	mi := &file_account_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyCaptchaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyCaptchaResponse) ProtoMessage() {}
+
+func (x *VerifyCaptchaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyCaptchaResponse.ProtoReflect.Descriptor instead.
+func (*VerifyCaptchaResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *VerifyCaptchaResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *VerifyCaptchaResponse) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *VerifyCaptchaResponse) GetErrorCodes() []string {
+	if x != nil {
+		return x.ErrorCodes
+	}
+	return nil
+}
+
+// Login 실패 시 gRPC status details로 전달되는 잠금 정보
+// 잠금 전: Unauthenticated + remaining_attempts, 잠금 후: ResourceExhausted + retry_after_seconds
+type AccountLockout struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Locked            bool                   `protobuf:"varint,1,opt,name=locked,proto3" json:"locked,omitempty"`
+	RemainingAttempts int32                  `protobuf:"varint,2,opt,name=remaining_attempts,json=remainingAttempts,proto3" json:"remaining_attempts,omitempty"`
+	RetryAfterSeconds int64                  `protobuf:"varint,3,opt,name=retry_after_seconds,json=retryAfterSeconds,proto3" json:"retry_after_seconds,omitempty"`
+	LockedUntil       string                 `protobuf:"bytes,4,opt,name=locked_until,json=lockedUntil,proto3" json:"locked_until,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AccountLockout) Reset() {
+	*x = AccountLockout{}
+	mi := &file_account_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountLockout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountLockout) ProtoMessage() {}
+
+func (x *AccountLockout) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountLockout.ProtoReflect.Descriptor instead.
+func (*AccountLockout) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AccountLockout) GetLocked() bool {
+	if x != nil {
+		return x.Locked
+	}
+	return false
+}
+
+func (x *AccountLockout) GetRemainingAttempts() int32 {
+	if x != nil {
+		return x.RemainingAttempts
+	}
+	return 0
+}
+
+func (x *AccountLockout) GetRetryAfterSeconds() int64 {
+	if x != nil {
+		return x.RetryAfterSeconds
+	}
+	return 0
+}
+
+func (x *AccountLockout) GetLockedUntil() string {
+	if x != nil {
+		return x.LockedUntil
+	}
+	return ""
+}
+
+type UnlockAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockAccountRequest) Reset() {
+	*x = UnlockAccountRequest{}
+	mi := &file_account_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockAccountRequest) ProtoMessage() {}
+
+func (x *UnlockAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockAccountRequest.ProtoReflect.Descriptor instead.
+func (*UnlockAccountRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UnlockAccountRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UnlockAccountRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UnlockAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UnlockedAt    string                 `protobuf:"bytes,1,opt,name=unlocked_at,json=unlockedAt,proto3" json:"unlocked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockAccountResponse) Reset() {
+	*x = UnlockAccountResponse{}
+	mi := &file_account_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockAccountResponse) ProtoMessage() {}
+
+func (x *UnlockAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockAccountResponse.ProtoReflect.Descriptor instead.
+func (*UnlockAccountResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UnlockAccountResponse) GetUnlockedAt() string {
+	if x != nil {
+		return x.UnlockedAt
+	}
+	return ""
+}
+
+type IssueGuestTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GuestId       string                 `protobuf:"bytes,1,opt,name=guest_id,json=guestId,proto3" json:"guest_id,omitempty"` // 기존 게스트 ID 갱신 시 설정, 비어 있으면 신규 발급
+	Device        *DeviceFingerprint     `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueGuestTokenRequest) Reset() {
+	*x = IssueGuestTokenRequest{}
+	mi := &file_account_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueGuestTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueGuestTokenRequest) ProtoMessage() {}
+
+func (x *IssueGuestTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueGuestTokenRequest.ProtoReflect.Descriptor instead.
+func (*IssueGuestTokenRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *IssueGuestTokenRequest) GetGuestId() string {
+	if x != nil {
+		return x.GuestId
+	}
+	return ""
+}
+
+func (x *IssueGuestTokenRequest) GetDevice() *DeviceFingerprint {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
+type IssueGuestTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GuestId       string                 `protobuf:"bytes,1,opt,name=guest_id,json=guestId,proto3" json:"guest_id,omitempty"` // 안정적인 익명 식별자 (ex: "guest_...")
+	GuestToken    string                 `protobuf:"bytes,2,opt,name=guest_token,json=guestToken,proto3" json:"guest_token,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueGuestTokenResponse) Reset() {
+	*x = IssueGuestTokenResponse{}
+	mi := &file_account_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueGuestTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueGuestTokenResponse) ProtoMessage() {}
+
+func (x *IssueGuestTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueGuestTokenResponse.ProtoReflect.Descriptor instead.
+func (*IssueGuestTokenResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *IssueGuestTokenResponse) GetGuestId() string {
+	if x != nil {
+		return x.GuestId
+	}
+	return ""
+}
+
+func (x *IssueGuestTokenResponse) GetGuestToken() string {
+	if x != nil {
+		return x.GuestToken
+	}
+	return ""
+}
+
+func (x *IssueGuestTokenResponse) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type MergeConflict struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	ResourceType  string                  `protobuf:"bytes,1,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"` // ex) "cart_item", "wishlist_item", "points"
+	ResourceId    string                  `protobuf:"bytes,2,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	Resolution    MergeConflictResolution `protobuf:"varint,3,opt,name=resolution,proto3,enum=go.escape.ship.proto.v1.MergeConflictResolution" json:"resolution,omitempty"`
+	Detail        string                  `protobuf:"bytes,4,opt,name=detail,proto3" json:"detail,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeConflict) Reset() {
+	*x = MergeConflict{}
+	mi := &file_account_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeConflict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeConflict) ProtoMessage() {}
+
+func (x *MergeConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeConflict.ProtoReflect.Descriptor instead.
+func (*MergeConflict) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *MergeConflict) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *MergeConflict) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *MergeConflict) GetResolution() MergeConflictResolution {
+	if x != nil {
+		return x.Resolution
+	}
+	return MergeConflictResolution_MERGE_CONFLICT_RESOLUTION_UNSPECIFIED
+}
+
+func (x *MergeConflict) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+type MergeAccountsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceUserId  string                 `protobuf:"bytes,1,opt,name=source_user_id,json=sourceUserId,proto3" json:"source_user_id,omitempty"` // 병합 후 비활성화됨
+	TargetUserId  string                 `protobuf:"bytes,2,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+	SourceToken   string                 `protobuf:"bytes,3,opt,name=source_token,json=sourceToken,proto3" json:"source_token,omitempty"` // source 계정 소유 증명 (게스트 토큰 또는 source 액세스 토큰)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeAccountsRequest) Reset() {
+	*x = MergeAccountsRequest{}
+	mi := &file_account_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeAccountsRequest) ProtoMessage() {}
+
+func (x *MergeAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeAccountsRequest.ProtoReflect.Descriptor instead.
+func (*MergeAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *MergeAccountsRequest) GetSourceUserId() string {
+	if x != nil {
+		return x.SourceUserId
+	}
+	return ""
+}
+
+func (x *MergeAccountsRequest) GetTargetUserId() string {
+	if x != nil {
+		return x.TargetUserId
+	}
+	return ""
+}
+
+func (x *MergeAccountsRequest) GetSourceToken() string {
+	if x != nil {
+		return x.SourceToken
+	}
+	return ""
+}
+
+type MergeAccountsResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	OrdersMoved        int32                  `protobuf:"varint,1,opt,name=orders_moved,json=ordersMoved,proto3" json:"orders_moved,omitempty"`
+	CartItemsMoved     int32                  `protobuf:"varint,2,opt,name=cart_items_moved,json=cartItemsMoved,proto3" json:"cart_items_moved,omitempty"`
+	WishlistItemsMoved int32                  `protobuf:"varint,3,opt,name=wishlist_items_moved,json=wishlistItemsMoved,proto3" json:"wishlist_items_moved,omitempty"`
+	PointsMoved        int64                  `protobuf:"varint,4,opt,name=points_moved,json=pointsMoved,proto3" json:"points_moved,omitempty"`
+	Conflicts          []*MergeConflict       `protobuf:"bytes,5,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *MergeAccountsResponse) Reset() {
+	*x = MergeAccountsResponse{}
+	mi := &file_account_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeAccountsResponse) ProtoMessage() {}
+
+func (x *MergeAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeAccountsResponse.ProtoReflect.Descriptor instead.
+func (*MergeAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *MergeAccountsResponse) GetOrdersMoved() int32 {
+	if x != nil {
+		return x.OrdersMoved
+	}
+	return 0
+}
+
+func (x *MergeAccountsResponse) GetCartItemsMoved() int32 {
+	if x != nil {
+		return x.CartItemsMoved
+	}
+	return 0
+}
+
+func (x *MergeAccountsResponse) GetWishlistItemsMoved() int32 {
+	if x != nil {
+		return x.WishlistItemsMoved
+	}
+	return 0
+}
+
+func (x *MergeAccountsResponse) GetPointsMoved() int64 {
+	if x != nil {
+		return x.PointsMoved
+	}
+	return 0
+}
+
+func (x *MergeAccountsResponse) GetConflicts() []*MergeConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+type RequestEmailChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NewEmail      string                 `protobuf:"bytes,1,opt,name=new_email,json=newEmail,proto3" json:"new_email,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"` // 본인 확인용 현재 비밀번호
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestEmailChangeRequest) Reset() {
+	*x = RequestEmailChangeRequest{}
+	mi := &file_account_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestEmailChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestEmailChangeRequest) ProtoMessage() {}
+
+func (x *RequestEmailChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestEmailChangeRequest.ProtoReflect.Descriptor instead.
+func (*RequestEmailChangeRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RequestEmailChangeRequest) GetNewEmail() string {
+	if x != nil {
+		return x.NewEmail
+	}
+	return ""
+}
+
+func (x *RequestEmailChangeRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type RequestEmailChangeResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ChangeRequestId string                 `protobuf:"bytes,1,opt,name=change_request_id,json=changeRequestId,proto3" json:"change_request_id,omitempty"`
+	ExpiresAt       string                 `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // 인증 코드 만료 시각
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RequestEmailChangeResponse) Reset() {
+	*x = RequestEmailChangeResponse{}
+	mi := &file_account_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestEmailChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestEmailChangeResponse) ProtoMessage() {}
+
+func (x *RequestEmailChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestEmailChangeResponse.ProtoReflect.Descriptor instead.
+func (*RequestEmailChangeResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RequestEmailChangeResponse) GetChangeRequestId() string {
+	if x != nil {
+		return x.ChangeRequestId
+	}
+	return ""
+}
+
+func (x *RequestEmailChangeResponse) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type ConfirmEmailChangeRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ChangeRequestId  string                 `protobuf:"bytes,1,opt,name=change_request_id,json=changeRequestId,proto3" json:"change_request_id,omitempty"`
+	VerificationCode string                 `protobuf:"bytes,2,opt,name=verification_code,json=verificationCode,proto3" json:"verification_code,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ConfirmEmailChangeRequest) Reset() {
+	*x = ConfirmEmailChangeRequest{}
+	mi := &file_account_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmEmailChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmEmailChangeRequest) ProtoMessage() {}
+
+func (x *ConfirmEmailChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmEmailChangeRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmEmailChangeRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ConfirmEmailChangeRequest) GetChangeRequestId() string {
+	if x != nil {
+		return x.ChangeRequestId
+	}
+	return ""
+}
+
+func (x *ConfirmEmailChangeRequest) GetVerificationCode() string {
+	if x != nil {
+		return x.VerificationCode
+	}
+	return ""
+}
+
+type ConfirmEmailChangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	ChangedAt     string                 `protobuf:"bytes,2,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmEmailChangeResponse) Reset() {
+	*x = ConfirmEmailChangeResponse{}
+	mi := &file_account_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmEmailChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmEmailChangeResponse) ProtoMessage() {}
+
+func (x *ConfirmEmailChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmEmailChangeResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmEmailChangeResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ConfirmEmailChangeResponse) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ConfirmEmailChangeResponse) GetChangedAt() string {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return ""
+}
+
+// 사용자 프로필
+type UserProfile struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email          string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	AvatarUrl      string                 `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"` // CDN URL
+	Nickname       string                 `protobuf:"bytes,4,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Phone          string                 `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`                                         // 숫자만 (ex: "01012345678")
+	DefaultAddress *Address               `protobuf:"bytes,6,opt,name=default_address,json=defaultAddress,proto3" json:"default_address,omitempty"` // 주문 시 기본 배송지
+	HasPassword    bool                   `protobuf:"varint,7,opt,name=has_password,json=hasPassword,proto3" json:"has_password,omitempty"`         // 카카오 전용 계정은 false
+	CreatedAt      string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UserProfile) Reset() {
+	*x = UserProfile{}
+	mi := &file_account_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserProfile) ProtoMessage() {}
+
+func (x *UserProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserProfile.ProtoReflect.Descriptor instead.
+func (*UserProfile) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *UserProfile) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserProfile) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UserProfile) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *UserProfile) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *UserProfile) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *UserProfile) GetDefaultAddress() *Address {
+	if x != nil {
+		return x.DefaultAddress
+	}
+	return nil
+}
+
+func (x *UserProfile) GetHasPassword() bool {
+	if x != nil {
+		return x.HasPassword
+	}
+	return false
+}
+
+func (x *UserProfile) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type AvatarMetadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentType   string                 `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // image/jpeg, image/png, image/webp
+	SizeBytes     int64                  `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`      // 최대 5MB
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AvatarMetadata) Reset() {
+	*x = AvatarMetadata{}
+	mi := &file_account_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AvatarMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvatarMetadata) ProtoMessage() {}
+
+func (x *AvatarMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvatarMetadata.ProtoReflect.Descriptor instead.
+func (*AvatarMetadata) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *AvatarMetadata) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *AvatarMetadata) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+type UploadAvatarRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*UploadAvatarRequest_Metadata
+	//	*UploadAvatarRequest_Chunk
+	Data          isUploadAvatarRequest_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAvatarRequest) Reset() {
+	*x = UploadAvatarRequest{}
+	mi := &file_account_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAvatarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAvatarRequest) ProtoMessage() {}
+
+func (x *UploadAvatarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAvatarRequest.ProtoReflect.Descriptor instead.
+func (*UploadAvatarRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *UploadAvatarRequest) GetData() isUploadAvatarRequest_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UploadAvatarRequest) GetMetadata() *AvatarMetadata {
+	if x != nil {
+		if x, ok := x.Data.(*UploadAvatarRequest_Metadata); ok {
+			return x.Metadata
+		}
+	}
+	return nil
+}
+
+func (x *UploadAvatarRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Data.(*UploadAvatarRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isUploadAvatarRequest_Data interface {
+	isUploadAvatarRequest_Data()
+}
+
+type UploadAvatarRequest_Metadata struct {
+	Metadata *AvatarMetadata `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
+}
+
+type UploadAvatarRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadAvatarRequest_Metadata) isUploadAvatarRequest_Data() {}
+
+func (*UploadAvatarRequest_Chunk) isUploadAvatarRequest_Data() {}
+
+type UploadAvatarResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *UserProfile           `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAvatarResponse) Reset() {
+	*x = UploadAvatarResponse{}
+	mi := &file_account_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAvatarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAvatarResponse) ProtoMessage() {}
+
+func (x *UploadAvatarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAvatarResponse.ProtoReflect.Descriptor instead.
+func (*UploadAvatarResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *UploadAvatarResponse) GetProfile() *UserProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type UserPreferences struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Locale        string                 `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"`     // BCP 47 (ex: "ko-KR")
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"` // ISO 4217 (ex: "KRW")
+	Theme         Theme                  `protobuf:"varint,3,opt,name=theme,proto3,enum=go.escape.ship.proto.v1.Theme" json:"theme,omitempty"`
+	Extra         map[string]string      `protobuf:"bytes,4,rep,name=extra,proto3" json:"extra,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 클라이언트 정의 확장 키 (ex: "web.sidebar_collapsed")
+	UpdatedAt     string                 `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserPreferences) Reset() {
+	*x = UserPreferences{}
+	mi := &file_account_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserPreferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserPreferences) ProtoMessage() {}
+
+func (x *UserPreferences) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserPreferences.ProtoReflect.Descriptor instead.
+func (*UserPreferences) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UserPreferences) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *UserPreferences) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *UserPreferences) GetTheme() Theme {
+	if x != nil {
+		return x.Theme
+	}
+	return Theme_THEME_UNSPECIFIED
+}
+
+func (x *UserPreferences) GetExtra() map[string]string {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+func (x *UserPreferences) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type GetPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPreferencesRequest) Reset() {
+	*x = GetPreferencesRequest{}
+	mi := &file_account_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPreferencesRequest) ProtoMessage() {}
+
+func (x *GetPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{39}
+}
+
+type GetPreferencesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Preferences   *UserPreferences       `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPreferencesResponse) Reset() {
+	*x = GetPreferencesResponse{}
+	mi := &file_account_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPreferencesResponse) ProtoMessage() {}
+
+func (x *GetPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*GetPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetPreferencesResponse) GetPreferences() *UserPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+type SetPreferencesRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Preferences *UserPreferences       `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	// 변경할 필드 (ex: "locale,extra"), 비어 있으면 전체 교체
+	// extra는 맵 전체가 아닌 전달된 키만 갱신하며 값이 빈 문자열이면 키 삭제
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPreferencesRequest) Reset() {
+	*x = SetPreferencesRequest{}
+	mi := &file_account_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPreferencesRequest) ProtoMessage() {}
+
+func (x *SetPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*SetPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SetPreferencesRequest) GetPreferences() *UserPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+func (x *SetPreferencesRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type SetPreferencesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Preferences   *UserPreferences       `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPreferencesResponse) Reset() {
+	*x = SetPreferencesResponse{}
+	mi := &file_account_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPreferencesResponse) ProtoMessage() {}
+
+func (x *SetPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*SetPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SetPreferencesResponse) GetPreferences() *UserPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+type GetMeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMeRequest) Reset() {
+	*x = GetMeRequest{}
+	mi := &file_account_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMeRequest) ProtoMessage() {}
+
+func (x *GetMeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMeRequest.ProtoReflect.Descriptor instead.
+func (*GetMeRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{43}
+}
+
+type GetMeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *UserProfile           `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMeResponse) Reset() {
+	*x = GetMeResponse{}
+	mi := &file_account_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMeResponse) ProtoMessage() {}
+
+func (x *GetMeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMeResponse.ProtoReflect.Descriptor instead.
+func (*GetMeResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetMeResponse) GetProfile() *UserProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type UpdateProfileRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Profile *UserProfile           `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	// 변경할 필드 (nickname, phone, default_address), 비어 있으면 세 필드 모두 교체
+	// default_address를 지정하고 값을 비우면 기본 배송지 삭제
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileRequest) Reset() {
+	*x = UpdateProfileRequest{}
+	mi := &file_account_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileRequest) ProtoMessage() {}
+
+func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *UpdateProfileRequest) GetProfile() *UserProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *UpdateProfileRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type UpdateProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *UserProfile           `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileResponse) Reset() {
+	*x = UpdateProfileResponse{}
+	mi := &file_account_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileResponse) ProtoMessage() {}
+
+func (x *UpdateProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProfileResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *UpdateProfileResponse) GetProfile() *UserProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type ChangePasswordRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	CurrentPassword     string                 `protobuf:"bytes,1,opt,name=current_password,json=currentPassword,proto3" json:"current_password,omitempty"` // has_password가 false인 계정은 비워서 최초 설정
+	NewPassword         string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	RevokeOtherSessions bool                   `protobuf:"varint,3,opt,name=revoke_other_sessions,json=revokeOtherSessions,proto3" json:"revoke_other_sessions,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ChangePasswordRequest) Reset() {
+	*x = ChangePasswordRequest{}
+	mi := &file_account_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordRequest) ProtoMessage() {}
+
+func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ChangePasswordRequest) GetCurrentPassword() string {
+	if x != nil {
+		return x.CurrentPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetRevokeOtherSessions() bool {
+	if x != nil {
+		return x.RevokeOtherSessions
+	}
+	return false
+}
+
+type ChangePasswordResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ChangedAt       string                 `protobuf:"bytes,1,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	RevokedSessions int32                  `protobuf:"varint,2,opt,name=revoked_sessions,json=revokedSessions,proto3" json:"revoked_sessions,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ChangePasswordResponse) Reset() {
+	*x = ChangePasswordResponse{}
+	mi := &file_account_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordResponse) ProtoMessage() {}
+
+func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordResponse.ProtoReflect.Descriptor instead.
+func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ChangePasswordResponse) GetChangedAt() string {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return ""
+}
+
+func (x *ChangePasswordResponse) GetRevokedSessions() int32 {
+	if x != nil {
+		return x.RevokedSessions
+	}
+	return 0
+}
+
+type DeleteAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Password      string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"` // 카카오 전용 계정은 비움 (재인증된 access_token 필요)
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`     // 탈퇴 사유 (선택, 통계용)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountRequest) Reset() {
+	*x = DeleteAccountRequest{}
+	mi := &file_account_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountRequest) ProtoMessage() {}
+
+func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DeleteAccountRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *DeleteAccountRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type DeleteAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeletedAt     string                 `protobuf:"bytes,1,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	PurgeAfter    string                 `protobuf:"bytes,2,opt,name=purge_after,json=purgeAfter,proto3" json:"purge_after,omitempty"` // 이 시각 이후 개인정보 파기 (그 전까지는 복구 문의 가능)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountResponse) Reset() {
+	*x = DeleteAccountResponse{}
+	mi := &file_account_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountResponse) ProtoMessage() {}
+
+func (x *DeleteAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAccountResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DeleteAccountResponse) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
+func (x *DeleteAccountResponse) GetPurgeAfter() string {
+	if x != nil {
+		return x.PurgeAfter
+	}
+	return ""
+}
+
+// 파트너 API 키 (secret 원문은 저장하지 않고 발급 시 한 번만 반환)
+type APIKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	PartnerId     string                 `protobuf:"bytes,2,opt,name=partner_id,json=partnerId,proto3" json:"partner_id,omitempty"` // 게이트웨이가 x-partner-id 메타데이터로 전달하는 서비스 식별자
+	PartnerName   string                 `protobuf:"bytes,3,opt,name=partner_name,json=partnerName,proto3" json:"partner_name,omitempty"`
+	Scopes        []string               `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"` // 파트너에 허용된 권한 (ex: "orders:read")
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // 비어 있으면 만료 없음
+	RevokedAt     string                 `protobuf:"bytes,7,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *APIKey) Reset() {
+	*x = APIKey{}
+	mi := &file_account_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *APIKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIKey) ProtoMessage() {}
+
+func (x *APIKey) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIKey.ProtoReflect.Descriptor instead.
+func (*APIKey) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *APIKey) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *APIKey) GetPartnerId() string {
+	if x != nil {
+		return x.PartnerId
+	}
+	return ""
+}
+
+func (x *APIKey) GetPartnerName() string {
+	if x != nil {
+		return x.PartnerName
+	}
+	return ""
+}
+
+func (x *APIKey) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *APIKey) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *APIKey) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *APIKey) GetRevokedAt() string {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return ""
+}
+
+type CreateAPIKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PartnerId     string                 `protobuf:"bytes,1,opt,name=partner_id,json=partnerId,proto3" json:"partner_id,omitempty"`
+	PartnerName   string                 `protobuf:"bytes,2,opt,name=partner_name,json=partnerName,proto3" json:"partner_name,omitempty"`
+	Scopes        []string               `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAPIKeyRequest) Reset() {
+	*x = CreateAPIKeyRequest{}
+	mi := &file_account_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyRequest) ProtoMessage() {}
+
+func (x *CreateAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CreateAPIKeyRequest) GetPartnerId() string {
+	if x != nil {
+		return x.PartnerId
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyRequest) GetPartnerName() string {
+	if x != nil {
+		return x.PartnerName
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *CreateAPIKeyRequest) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type CreateAPIKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey        *APIKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	Secret        string                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"` // x-api-key 헤더 값, 재조회 불가
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAPIKeyResponse) Reset() {
+	*x = CreateAPIKeyResponse{}
+	mi := &file_account_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyResponse) ProtoMessage() {}
+
+func (x *CreateAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CreateAPIKeyResponse) GetApiKey() *APIKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+func (x *CreateAPIKeyResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type RevokeAPIKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAPIKeyRequest) Reset() {
+	*x = RevokeAPIKeyRequest{}
+	mi := &file_account_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPIKeyRequest) ProtoMessage() {}
+
+func (x *RevokeAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *RevokeAPIKeyRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *RevokeAPIKeyRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RevokeAPIKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey        *APIKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAPIKeyResponse) Reset() {
+	*x = RevokeAPIKeyResponse{}
+	mi := &file_account_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPIKeyResponse) ProtoMessage() {}
+
+func (x *RevokeAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*RevokeAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *RevokeAPIKeyResponse) GetApiKey() *APIKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+type ValidateAPIKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secret        string                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateAPIKeyRequest) Reset() {
+	*x = ValidateAPIKeyRequest{}
+	mi := &file_account_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateAPIKeyRequest) ProtoMessage() {}
+
+func (x *ValidateAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*ValidateAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ValidateAPIKeyRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type ValidateAPIKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"` // 만료/폐기/미존재 키는 false
+	ApiKey        *APIKey                `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateAPIKeyResponse) Reset() {
+	*x = ValidateAPIKeyResponse{}
+	mi := &file_account_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateAPIKeyResponse) ProtoMessage() {}
+
+func (x *ValidateAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*ValidateAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ValidateAPIKeyResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateAPIKeyResponse) GetApiKey() *APIKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+var File_account_proto protoreflect.FileDescriptor
+
+const file_account_proto_rawDesc = "" +
+	"\n" +
+	"\raccount.proto\x12\x17go.escape.ship.proto.v1\x1a\fcommon.proto\x1a\x1cgoogle/api/annotations.proto\x1a google/protobuf/field_mask.proto\x1a\x0eshipping.proto\"\x19\n" +
+	"\x17GetKakaoLoginURLRequest\"7\n" +
+	"\x18GetKakaoLoginURLResponse\x12\x1b\n" +
+	"\tlogin_url\x18\x01 \x01(\tR\bloginUrl\"2\n" +
+	"\x17GetKakaoCallBackRequest\x12\x17\n" +
+	"\x04code\x18\x01 \x01(\tB\x03\x80\x01\x01R\x04code\"\xaa\x01\n" +
+	"\x18GetKakaoCallBackResponse\x12&\n" +
+	"\faccess_token\x18\x01 \x01(\tB\x03\x80\x01\x01R\vaccessToken\x12(\n" +
+	"\rrefresh_token\x18\x02 \x01(\tB\x03\x80\x01\x01R\frefreshToken\x12$\n" +
+	"\x0euser_info_json\x18\x03 \x01(\tR\fuserInfoJson\x12\x16\n" +
+	"\x06scopes\x18\x04 \x03(\tR\x06scopes\"\xb3\x01\n" +
+	"\fLoginRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1f\n" +
+	"\bpassword\x18\x02 \x01(\tB\x03\x80\x01\x01R\bpassword\x12B\n" +
+	"\x06device\x18\x03 \x01(\v2*.go.escape.ship.proto.v1.DeviceFingerprintR\x06device\x12(\n" +
+	"\rcaptcha_token\x18\x04 \x01(\tB\x03\x80\x01\x01R\fcaptchaToken\"\xeb\x01\n" +
+	"\rLoginResponse\x12&\n" +
+	"\faccess_token\x18\x01 \x01(\tB\x03\x80\x01\x01R\vaccessToken\x12(\n" +
+	"\rrefresh_token\x18\x02 \x01(\tB\x03\x80\x01\x01R\frefreshToken\x124\n" +
+	"\x16required_terms_version\x18\x03 \x01(\tR\x14requiredTermsVersion\x12:\n" +
+	"\x19terms_acceptance_required\x18\x04 \x01(\bR\x17termsAcceptanceRequired\x12\x16\n" +
+	"\x06scopes\x18\x05 \x03(\tR\x06scopes\"r\n" +
+	"\x0fRegisterRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1f\n" +
+	"\bpassword\x18\x02 \x01(\tB\x03\x80\x01\x01R\bpassword\x12(\n" +
+	"\rcaptcha_token\x18\x03 \x01(\tB\x03\x80\x01\x01R\fcaptchaToken\",\n" +
+	"\x10RegisterResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\x83\x01\n" +
+	"\x13RefreshTokenRequest\x12(\n" +
+	"\rrefresh_token\x18\x01 \x01(\tB\x03\x80\x01\x01R\frefreshToken\x12B\n" +
+	"\x06device\x18\x02 \x01(\v2*.go.escape.ship.proto.v1.DeviceFingerprintR\x06device\"\x9f\x01\n" +
+	"\x14RefreshTokenResponse\x12&\n" +
+	"\faccess_token\x18\x01 \x01(\tB\x03\x80\x01\x01R\vaccessToken\x12(\n" +
+	"\rrefresh_token\x18\x02 \x01(\tB\x03\x80\x01\x01R\frefreshToken\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x03 \x01(\x05R\texpiresIn\x12\x16\n" +
+	"\x06scopes\x18\x04 \x03(\tR\x06scopes\"R\n" +
+	"\x12RevokeTokenRequest\x12\x19\n" +
+	"\x05token\x18\x01 \x01(\tB\x03\x80\x01\x01R\x05token\x12!\n" +
+	"\fall_sessions\x18\x02 \x01(\bR\vallSessions\"\x15\n" +
+	"\x13RevokeTokenResponse\"K\n" +
+	"\x18AnonymizeUserDataRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x9c\x01\n" +
+	"\x19AnonymizeUserDataResponse\x12+\n" +
+	"\x11anonymized_orders\x18\x01 \x01(\x03R\x10anonymizedOrders\x12/\n" +
+	"\x13anonymized_payments\x18\x02 \x01(\x03R\x12anonymizedPayments\x12!\n" +
+	"\fcompleted_at\x18\x03 \x01(\tR\vcompletedAt\"9\n" +
+	"\x12AcceptTermsRequest\x12#\n" +
+	"\rterms_version\x18\x01 \x01(\tR\ftermsVersion\"[\n" +
+	"\x13AcceptTermsResponse\x12#\n" +
+	"\rterms_version\x18\x01 \x01(\tR\ftermsVersion\x12\x1f\n" +
+	"\vaccepted_at\x18\x02 \x01(\tR\n" +
+	"acceptedAt\"\xb6\x01\n" +
+	"\x18RegisterPushTokenRequest\x12\x1b\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\bdeviceId\x12\x19\n" +
+	"\x05token\x18\x02 \x01(\tB\x03\x80\x01\x01R\x05token\x12A\n" +
+	"\bplatform\x18\x03 \x01(\x0e2%.go.escape.ship.proto.v1.PushPlatformR\bplatform\x12\x1f\n" +
+	"\vapp_version\x18\x04 \x01(\tR\n" +
+	"appVersion\"@\n" +
+	"\x19RegisterPushTokenResponse\x12#\n" +
+	"\rregistered_at\x18\x01 \x01(\tR\fregisteredAt\"T\n" +
+	"\x1aUnregisterPushTokenRequest\x12\x1b\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\bdeviceId\x12\x19\n" +
+	"\x05token\x18\x02 \x01(\tB\x03\x80\x01\x01R\x05token\"\x1d\n" +
+	"\x1bUnregisterPushTokenResponse\"u\n" +
+	"\x14VerifyCaptchaRequest\x12(\n" +
+	"\rcaptcha_token\x18\x01 \x01(\tB\x03\x80\x01\x01R\fcaptchaToken\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12\x1b\n" +
+	"\tremote_ip\x18\x03 \x01(\tR\bremoteIp\"h\n" +
+	"\x15VerifyCaptchaResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12\x1f\n" +
+	"\verror_codes\x18\x03 \x03(\tR\n" +
+	"errorCodes\"\xaa\x01\n" +
+	"\x0eAccountLockout\x12\x16\n" +
+	"\x06locked\x18\x01 \x01(\bR\x06locked\x12-\n" +
+	"\x12remaining_attempts\x18\x02 \x01(\x05R\x11remainingAttempts\x12.\n" +
+	"\x13retry_after_seconds\x18\x03 \x01(\x03R\x11retryAfterSeconds\x12!\n" +
+	"\flocked_until\x18\x04 \x01(\tR\vlockedUntil\"G\n" +
+	"\x14UnlockAccountRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"8\n" +
+	"\x15UnlockAccountResponse\x12\x1f\n" +
+	"\vunlocked_at\x18\x01 \x01(\tR\n" +
+	"unlockedAt\"w\n" +
+	"\x16IssueGuestTokenRequest\x12\x19\n" +
+	"\bguest_id\x18\x01 \x01(\tR\aguestId\x12B\n" +
+	"\x06device\x18\x02 \x01(\v2*.go.escape.ship.proto.v1.DeviceFingerprintR\x06device\"y\n" +
+	"\x17IssueGuestTokenResponse\x12\x19\n" +
+	"\bguest_id\x18\x01 \x01(\tR\aguestId\x12$\n" +
+	"\vguest_token\x18\x02 \x01(\tB\x03\x80\x01\x01R\n" +
+	"guestToken\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\tR\texpiresAt\"\xbf\x01\n" +
+	"\rMergeConflict\x12#\n" +
+	"\rresource_type\x18\x01 \x01(\tR\fresourceType\x12\x1f\n" +
+	"\vresource_id\x18\x02 \x01(\tR\n" +
+	"resourceId\x12P\n" +
+	"\n" +
+	"resolution\x18\x03 \x01(\x0e20.go.escape.ship.proto.v1.MergeConflictResolutionR\n" +
+	"resolution\x12\x16\n" +
+	"\x06detail\x18\x04 \x01(\tR\x06detail\"\x8a\x01\n" +
+	"\x14MergeAccountsRequest\x12$\n" +
+	"\x0esource_user_id\x18\x01 \x01(\tR\fsourceUserId\x12$\n" +
+	"\x0etarget_user_id\x18\x02 \x01(\tR\ftargetUserId\x12&\n" +
+	"\fsource_token\x18\x03 \x01(\tB\x03\x80\x01\x01R\vsourceToken\"\xff\x01\n" +
+	"\x15MergeAccountsResponse\x12!\n" +
+	"\forders_moved\x18\x01 \x01(\x05R\vordersMoved\x12(\n" +
+	"\x10cart_items_moved\x18\x02 \x01(\x05R\x0ecartItemsMoved\x120\n" +
+	"\x14wishlist_items_moved\x18\x03 \x01(\x05R\x12wishlistItemsMoved\x12!\n" +
+	"\fpoints_moved\x18\x04 \x01(\x03R\vpointsMoved\x12D\n" +
+	"\tconflicts\x18\x05 \x03(\v2&.go.escape.ship.proto.v1.MergeConflictR\tconflicts\"Y\n" +
+	"\x19RequestEmailChangeRequest\x12\x1b\n" +
+	"\tnew_email\x18\x01 \x01(\tR\bnewEmail\x12\x1f\n" +
+	"\bpassword\x18\x02 \x01(\tB\x03\x80\x01\x01R\bpassword\"g\n" +
+	"\x1aRequestEmailChangeResponse\x12*\n" +
+	"\x11change_request_id\x18\x01 \x01(\tR\x0fchangeRequestId\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\tR\texpiresAt\"y\n" +
+	"\x19ConfirmEmailChangeRequest\x12*\n" +
+	"\x11change_request_id\x18\x01 \x01(\tR\x0fchangeRequestId\x120\n" +
+	"\x11verification_code\x18\x02 \x01(\tB\x03\x80\x01\x01R\x10verificationCode\"Q\n" +
+	"\x1aConfirmEmailChangeResponse\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1d\n" +
+	"\n" +
+	"changed_at\x18\x02 \x01(\tR\tchangedAt\"\x9a\x02\n" +
+	"\vUserProfile\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x03 \x01(\tR\tavatarUrl\x12\x1a\n" +
+	"\bnickname\x18\x04 \x01(\tR\bnickname\x12\x14\n" +
+	"\x05phone\x18\x05 \x01(\tR\x05phone\x12I\n" +
+	"\x0fdefault_address\x18\x06 \x01(\v2 .go.escape.ship.proto.v1.AddressR\x0edefaultAddress\x12!\n" +
+	"\fhas_password\x18\a \x01(\bR\vhasPassword\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\"R\n" +
+	"\x0eAvatarMetadata\x12!\n" +
+	"\fcontent_type\x18\x01 \x01(\tR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x02 \x01(\x03R\tsizeBytes\"|\n" +
+	"\x13UploadAvatarRequest\x12E\n" +
+	"\bmetadata\x18\x01 \x01(\v2'.go.escape.ship.proto.v1.AvatarMetadataH\x00R\bmetadata\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\x06\n" +
+	"\x04data\"V\n" +
+	"\x14UploadAvatarResponse\x12>\n" +
+	"\aprofile\x18\x01 \x01(\v2$.go.escape.ship.proto.v1.UserProfileR\aprofile\"\x9f\x02\n" +
+	"\x0fUserPreferences\x12\x16\n" +
+	"\x06locale\x18\x01 \x01(\tR\x06locale\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x124\n" +
+	"\x05theme\x18\x03 \x01(\x0e2\x1e.go.escape.ship.proto.v1.ThemeR\x05theme\x12I\n" +
+	"\x05extra\x18\x04 \x03(\v23.go.escape.ship.proto.v1.UserPreferences.ExtraEntryR\x05extra\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\tR\tupdatedAt\x1a8\n" +
+	"\n" +
+	"ExtraEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x17\n" +
+	"\x15GetPreferencesRequest\"d\n" +
+	"\x16GetPreferencesResponse\x12J\n" +
+	"\vpreferences\x18\x01 \x01(\v2(.go.escape.ship.proto.v1.UserPreferencesR\vpreferences\"\xa0\x01\n" +
+	"\x15SetPreferencesRequest\x12J\n" +
+	"\vpreferences\x18\x01 \x01(\v2(.go.escape.ship.proto.v1.UserPreferencesR\vpreferences\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"d\n" +
+	"\x16SetPreferencesResponse\x12J\n" +
+	"\vpreferences\x18\x01 \x01(\v2(.go.escape.ship.proto.v1.UserPreferencesR\vpreferences\"\x0e\n" +
+	"\fGetMeRequest\"O\n" +
+	"\rGetMeResponse\x12>\n" +
+	"\aprofile\x18\x01 \x01(\v2$.go.escape.ship.proto.v1.UserProfileR\aprofile\"\x93\x01\n" +
+	"\x14UpdateProfileRequest\x12>\n" +
+	"\aprofile\x18\x01 \x01(\v2$.go.escape.ship.proto.v1.UserProfileR\aprofile\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"W\n" +
+	"\x15UpdateProfileResponse\x12>\n" +
+	"\aprofile\x18\x01 \x01(\v2$.go.escape.ship.proto.v1.UserProfileR\aprofile\"\xa3\x01\n" +
+	"\x15ChangePasswordRequest\x12.\n" +
+	"\x10current_password\x18\x01 \x01(\tB\x03\x80\x01\x01R\x0fcurrentPassword\x12&\n" +
+	"\fnew_password\x18\x02 \x01(\tB\x03\x80\x01\x01R\vnewPassword\x122\n" +
+	"\x15revoke_other_sessions\x18\x03 \x01(\bR\x13revokeOtherSessions\"b\n" +
+	"\x16ChangePasswordResponse\x12\x1d\n" +
+	"\n" +
+	"changed_at\x18\x01 \x01(\tR\tchangedAt\x12)\n" +
+	"\x10revoked_sessions\x18\x02 \x01(\x05R\x0frevokedSessions\"O\n" +
+	"\x14DeleteAccountRequest\x12\x1f\n" +
+	"\bpassword\x18\x01 \x01(\tB\x03\x80\x01\x01R\bpassword\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"W\n" +
+	"\x15DeleteAccountResponse\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\x01 \x01(\tR\tdeletedAt\x12\x1f\n" +
+	"\vpurge_after\x18\x02 \x01(\tR\n" +
+	"purgeAfter\"\xd6\x01\n" +
+	"\x06APIKey\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\x12\x1d\n" +
+	"\n" +
+	"partner_id\x18\x02 \x01(\tR\tpartnerId\x12!\n" +
+	"\fpartner_name\x18\x03 \x01(\tR\vpartnerName\x12\x16\n" +
+	"\x06scopes\x18\x04 \x03(\tR\x06scopes\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\tR\texpiresAt\x12\x1d\n" +
+	"\n" +
+	"revoked_at\x18\a \x01(\tR\trevokedAt\"\x8e\x01\n" +
+	"\x13CreateAPIKeyRequest\x12\x1d\n" +
+	"\n" +
+	"partner_id\x18\x01 \x01(\tR\tpartnerId\x12!\n" +
+	"\fpartner_name\x18\x02 \x01(\tR\vpartnerName\x12\x16\n" +
+	"\x06scopes\x18\x03 \x03(\tR\x06scopes\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\tR\texpiresAt\"m\n" +
+	"\x14CreateAPIKeyResponse\x128\n" +
+	"\aapi_key\x18\x01 \x01(\v2\x1f.go.escape.ship.proto.v1.APIKeyR\x06apiKey\x12\x1b\n" +
+	"\x06secret\x18\x02 \x01(\tB\x03\x80\x01\x01R\x06secret\"D\n" +
+	"\x13RevokeAPIKeyRequest\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"P\n" +
+	"\x14RevokeAPIKeyResponse\x128\n" +
+	"\aapi_key\x18\x01 \x01(\v2\x1f.go.escape.ship.proto.v1.APIKeyR\x06apiKey\"4\n" +
+	"\x15ValidateAPIKeyRequest\x12\x1b\n" +
+	"\x06secret\x18\x01 \x01(\tB\x03\x80\x01\x01R\x06secret\"h\n" +
+	"\x16ValidateAPIKeyResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x128\n" +
+	"\aapi_key\x18\x02 \x01(\v2\x1f.go.escape.ship.proto.v1.APIKeyR\x06apiKey*\\\n" +
+	"\fPushPlatform\x12\x1d\n" +
+	"\x19PUSH_PLATFORM_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11PUSH_PLATFORM_FCM\x10\x01\x12\x16\n" +
+	"\x12PUSH_PLATFORM_APNS\x10\x02*\xc2\x01\n" +
+	"\x17MergeConflictResolution\x12)\n" +
+	"%MERGE_CONFLICT_RESOLUTION_UNSPECIFIED\x10\x00\x12)\n" +
+	"%MERGE_CONFLICT_RESOLUTION_KEPT_TARGET\x10\x01\x12)\n" +
+	"%MERGE_CONFLICT_RESOLUTION_KEPT_SOURCE\x10\x02\x12&\n" +
+	"\"MERGE_CONFLICT_RESOLUTION_COMBINED\x10\x03*?\n" +
+	"\x05Theme\x12\x15\n" +
+	"\x11THEME_UNSPECIFIED\x10\x00\x12\x0f\n" +
+	"\vTHEME_LIGHT\x10\x01\x12\x0e\n" +
+	"\n" +
+	"THEME_DARK\x10\x022\xed\x1c\n" +
+	"\x0eAccountService\x12\x93\x01\n" +
+	"\x10GetKakaoLoginURL\x120.go.escape.ship.proto.v1.GetKakaoLoginURLRequest\x1a1.go.escape.ship.proto.v1.GetKakaoLoginURLResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/oauth/kakao/login\x12\x99\x01\n" +
+	"\x10GetKakaoCallBack\x120.go.escape.ship.proto.v1.GetKakaoCallBackRequest\x1a1.go.escape.ship.proto.v1.GetKakaoCallBackResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/oauth/kakao/callback\x12i\n" +
+	"\x05Login\x12%.go.escape.ship.proto.v1.LoginRequest\x1a&.go.escape.ship.proto.v1.LoginResponse\"\x11\x82\xd3\xe4\x93\x02\v:\x01*\"\x06/login\x12u\n" +
+	"\bRegister\x12(.go.escape.ship.proto.v1.RegisterRequest\x1a).go.escape.ship.proto.v1.RegisterResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/register\x12\x85\x01\n" +
+	"\fRefreshToken\x12,.go.escape.ship.proto.v1.RefreshTokenRequest\x1a-.go.escape.ship.proto.v1.RefreshTokenResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/auth/refresh\x12\x81\x01\n" +
+	"\vRevokeToken\x12+.go.escape.ship.proto.v1.RevokeTokenRequest\x1a,.go.escape.ship.proto.v1.RevokeTokenResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/auth/revoke\x12\xa1\x01\n" +
+	"\x11AnonymizeUserData\x121.go.escape.ship.proto.v1.AnonymizeUserDataRequest\x1a2.go.escape.ship.proto.v1.AnonymizeUserDataResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/users/{user_id}/anonymize\x12\x82\x01\n" +
+	"\vAcceptTerms\x12+.go.escape.ship.proto.v1.AcceptTermsRequest\x1a,.go.escape.ship.proto.v1.AcceptTermsResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/terms/accept\x12\x93\x01\n" +
+	"\x11RegisterPushToken\x121.go.escape.ship.proto.v1.RegisterPushTokenRequest\x1a2.go.escape.ship.proto.v1.RegisterPushTokenResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/push-tokens\x12\xa4\x01\n" +
+	"\x13UnregisterPushToken\x123.go.escape.ship.proto.v1.UnregisterPushTokenRequest\x1a4.go.escape.ship.proto.v1.UnregisterPushTokenResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/push-tokens/unregister\x12\x8a\x01\n" +
+	"\rVerifyCaptcha\x12-.go.escape.ship.proto.v1.VerifyCaptchaRequest\x1a..go.escape.ship.proto.v1.VerifyCaptchaResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/captcha/verify\x12\x92\x01\n" +
+	"\rUnlockAccount\x12-.go.escape.ship.proto.v1.UnlockAccountRequest\x1a..go.escape.ship.proto.v1.UnlockAccountResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/users/{user_id}/unlock\x12\x8d\x01\n" +
+	"\x0fIssueGuestToken\x12/.go.escape.ship.proto.v1.IssueGuestTokenRequest\x1a0.go.escape.ship.proto.v1.IssueGuestTokenResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/guest/token\x12\x87\x01\n" +
+	"\rMergeAccounts\x12-.go.escape.ship.proto.v1.MergeAccountsRequest\x1a..go.escape.ship.proto.v1.MergeAccountsResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/users/merge\x12\xa0\x01\n" +
+	"\x12RequestEmailChange\x122.go.escape.ship.proto.v1.RequestEmailChangeRequest\x1a3.go.escape.ship.proto.v1.RequestEmailChangeResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/users/me/email/change\x12\xa1\x01\n" +
+	"\x12ConfirmEmailChange\x122.go.escape.ship.proto.v1.ConfirmEmailChangeRequest\x1a3.go.escape.ship.proto.v1.ConfirmEmailChangeResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/users/me/email/confirm\x12\x8a\x01\n" +
+	"\fUploadAvatar\x12,.go.escape.ship.proto.v1.UploadAvatarRequest\x1a-.go.escape.ship.proto.v1.UploadAvatarResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/users/me/avatar(\x01\x12\x90\x01\n" +
+	"\x0eGetPreferences\x12..go.escape.ship.proto.v1.GetPreferencesRequest\x1a/.go.escape.ship.proto.v1.GetPreferencesResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/users/me/preferences\x12\x93\x01\n" +
+	"\x0eSetPreferences\x12..go.escape.ship.proto.v1.SetPreferencesRequest\x1a/.go.escape.ship.proto.v1.SetPreferencesResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\x1a\x15/users/me/preferences\x12i\n" +
+	"\x05GetMe\x12%.go.escape.ship.proto.v1.GetMeRequest\x1a&.go.escape.ship.proto.v1.GetMeResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/users/me\x12\x84\x01\n" +
+	"\rUpdateProfile\x12-.go.escape.ship.proto.v1.UpdateProfileRequest\x1a..go.escape.ship.proto.v1.UpdateProfileResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*2\t/users/me\x12\x90\x01\n" +
+	"\x0eChangePassword\x12..go.escape.ship.proto.v1.ChangePasswordRequest\x1a/.go.escape.ship.proto.v1.ChangePasswordResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/users/me/password\x12\x8b\x01\n" +
+	"\rDeleteAccount\x12-.go.escape.ship.proto.v1.DeleteAccountRequest\x1a..go.escape.ship.proto.v1.DeleteAccountResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/users/me/delete\x12\x81\x01\n" +
+	"\fCreateAPIKey\x12,.go.escape.ship.proto.v1.CreateAPIKeyRequest\x1a-.go.escape.ship.proto.v1.CreateAPIKeyResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/api-keys\x12\x91\x01\n" +
+	"\fRevokeAPIKey\x12,.go.escape.ship.proto.v1.RevokeAPIKeyRequest\x1a-.go.escape.ship.proto.v1.RevokeAPIKeyResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api-keys/{key_id}/revoke\x12q\n" +
+	"\x0eValidateAPIKey\x12..go.escape.ship.proto.v1.ValidateAPIKeyRequest\x1a/.go.escape.ship.proto.v1.ValidateAPIKeyResponseB#Z!github.com/escape-ship/protos/genb\x06proto3"
+
+var (
+	file_account_proto_rawDescOnce sync.Once
+	file_account_proto_rawDescData []byte
+)
+
+func file_account_proto_rawDescGZIP() []byte {
+	file_account_proto_rawDescOnce.Do(func() {
+		file_account_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_account_proto_rawDesc), len(file_account_proto_rawDesc)))
+	})
+	return file_account_proto_rawDescData
+}
+
+var file_account_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_account_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
+var file_account_proto_goTypes = []any{
+	(PushPlatform)(0),                   // 0: go.escape.ship.proto.v1.PushPlatform
+	(MergeConflictResolution)(0),        // 1: go.escape.ship.proto.v1.MergeConflictResolution
+	(Theme)(0),                          // 2: go.escape.ship.proto.v1.Theme
+	(*GetKakaoLoginURLRequest)(nil),     // 3: go.escape.ship.proto.v1.GetKakaoLoginURLRequest
+	(*GetKakaoLoginURLResponse)(nil),    // 4: go.escape.ship.proto.v1.GetKakaoLoginURLResponse
+	(*GetKakaoCallBackRequest)(nil),     // 5: go.escape.ship.proto.v1.GetKakaoCallBackRequest
+	(*GetKakaoCallBackResponse)(nil),    // 6: go.escape.ship.proto.v1.GetKakaoCallBackResponse
+	(*LoginRequest)(nil),                // 7: go.escape.ship.proto.v1.LoginRequest
+	(*LoginResponse)(nil),               // 8: go.escape.ship.proto.v1.LoginResponse
+	(*RegisterRequest)(nil),             // 9: go.escape.ship.proto.v1.RegisterRequest
+	(*RegisterResponse)(nil),            // 10: go.escape.ship.proto.v1.RegisterResponse
+	(*RefreshTokenRequest)(nil),         // 11: go.escape.ship.proto.v1.RefreshTokenRequest
+	(*RefreshTokenResponse)(nil),        // 12: go.escape.ship.proto.v1.RefreshTokenResponse
+	(*RevokeTokenRequest)(nil),          // 13: go.escape.ship.proto.v1.RevokeTokenRequest
+	(*RevokeTokenResponse)(nil),         // 14: go.escape.ship.proto.v1.RevokeTokenResponse
+	(*AnonymizeUserDataRequest)(nil),    // 15: go.escape.ship.proto.v1.AnonymizeUserDataRequest
+	(*AnonymizeUserDataResponse)(nil),   // 16: go.escape.ship.proto.v1.AnonymizeUserDataResponse
+	(*AcceptTermsRequest)(nil),          // 17: go.escape.ship.proto.v1.AcceptTermsRequest
+	(*AcceptTermsResponse)(nil),         // 18: go.escape.ship.proto.v1.AcceptTermsResponse
+	(*RegisterPushTokenRequest)(nil),    // 19: go.escape.ship.proto.v1.RegisterPushTokenRequest
+	(*RegisterPushTokenResponse)(nil),   // 20: go.escape.ship.proto.v1.RegisterPushTokenResponse
+	(*UnregisterPushTokenRequest)(nil),  // 21: go.escape.ship.proto.v1.UnregisterPushTokenRequest
+	(*UnregisterPushTokenResponse)(nil), // 22: go.escape.ship.proto.v1.UnregisterPushTokenResponse
+	(*VerifyCaptchaRequest)(nil),        // 23: go.escape.ship.proto.v1.VerifyCaptchaRequest
+	(*VerifyCaptchaResponse)(nil),       // 24: go.escape.ship.proto.v1.VerifyCaptchaResponse
+	(*AccountLockout)(nil),              // 25: go.escape.ship.proto.v1.AccountLockout
+	(*UnlockAccountRequest)(nil),        // 26: go.escape.ship.proto.v1.UnlockAccountRequest
+	(*UnlockAccountResponse)(nil),       // 27: go.escape.ship.proto.v1.UnlockAccountResponse
+	(*IssueGuestTokenRequest)(nil),      // 28: go.escape.ship.proto.v1.IssueGuestTokenRequest
+	(*IssueGuestTokenResponse)(nil),     // 29: go.escape.ship.proto.v1.IssueGuestTokenResponse
+	(*MergeConflict)(nil),               // 30: go.escape.ship.proto.v1.MergeConflict
+	(*MergeAccountsRequest)(nil),        // 31: go.escape.ship.proto.v1.MergeAccountsRequest
+	(*MergeAccountsResponse)(nil),       // 32: go.escape.ship.proto.v1.MergeAccountsResponse
+	(*RequestEmailChangeRequest)(nil),   // 33: go.escape.ship.proto.v1.RequestEmailChangeRequest
+	(*RequestEmailChangeResponse)(nil),  // 34: go.escape.ship.proto.v1.RequestEmailChangeResponse
+	(*ConfirmEmailChangeRequest)(nil),   // 35: go.escape.ship.proto.v1.ConfirmEmailChangeRequest
+	(*ConfirmEmailChangeResponse)(nil),  // 36: go.escape.ship.proto.v1.ConfirmEmailChangeResponse
+	(*UserProfile)(nil),                 // 37: go.escape.ship.proto.v1.UserProfile
+	(*AvatarMetadata)(nil),              // 38: go.escape.ship.proto.v1.AvatarMetadata
+	(*UploadAvatarRequest)(nil),         // 39: go.escape.ship.proto.v1.UploadAvatarRequest
+	(*UploadAvatarResponse)(nil),        // 40: go.escape.ship.proto.v1.UploadAvatarResponse
+	(*UserPreferences)(nil),             // 41: go.escape.ship.proto.v1.UserPreferences
+	(*GetPreferencesRequest)(nil),       // 42: go.escape.ship.proto.v1.GetPreferencesRequest
+	(*GetPreferencesResponse)(nil),      // 43: go.escape.ship.proto.v1.GetPreferencesResponse
+	(*SetPreferencesRequest)(nil),       // 44: go.escape.ship.proto.v1.SetPreferencesRequest
+	(*SetPreferencesResponse)(nil),      // 45: go.escape.ship.proto.v1.SetPreferencesResponse
+	(*GetMeRequest)(nil),                // 46: go.escape.ship.proto.v1.GetMeRequest
+	(*GetMeResponse)(nil),               // 47: go.escape.ship.proto.v1.GetMeResponse
+	(*UpdateProfileRequest)(nil),        // 48: go.escape.ship.proto.v1.UpdateProfileRequest
+	(*UpdateProfileResponse)(nil),       // 49: go.escape.ship.proto.v1.UpdateProfileResponse
+	(*ChangePasswordRequest)(nil),       // 50: go.escape.ship.proto.v1.ChangePasswordRequest
+	(*ChangePasswordResponse)(nil),      // 51: go.escape.ship.proto.v1.ChangePasswordResponse
+	(*DeleteAccountRequest)(nil),        // 52: go.escape.ship.proto.v1.DeleteAccountRequest
+	(*DeleteAccountResponse)(nil),       // 53: go.escape.ship.proto.v1.DeleteAccountResponse
+	(*APIKey)(nil),                      // 54: go.escape.ship.proto.v1.APIKey
+	(*CreateAPIKeyRequest)(nil),         // 55: go.escape.ship.proto.v1.CreateAPIKeyRequest
+	(*CreateAPIKeyResponse)(nil),        // 56: go.escape.ship.proto.v1.CreateAPIKeyResponse
+	(*RevokeAPIKeyRequest)(nil),         // 57: go.escape.ship.proto.v1.RevokeAPIKeyRequest
+	(*RevokeAPIKeyResponse)(nil),        // 58: go.escape.ship.proto.v1.RevokeAPIKeyResponse
+	(*ValidateAPIKeyRequest)(nil),       // 59: go.escape.ship.proto.v1.ValidateAPIKeyRequest
+	(*ValidateAPIKeyResponse)(nil),      // 60: go.escape.ship.proto.v1.ValidateAPIKeyResponse
+	nil,                                 // 61: go.escape.ship.proto.v1.UserPreferences.ExtraEntry
+	(*DeviceFingerprint)(nil),           // 62: go.escape.ship.proto.v1.DeviceFingerprint
+	(*Address)(nil),                     // 63: go.escape.ship.proto.v1.Address
+	(*fieldmaskpb.FieldMask)(nil),       // 64: google.protobuf.FieldMask
+}
+var file_account_proto_depIdxs = []int32{
+	62, // 0: go.escape.ship.proto.v1.LoginRequest.device:type_name -> go.escape.ship.proto.v1.DeviceFingerprint
+	62, // 1: go.escape.ship.proto.v1.RefreshTokenRequest.device:type_name -> go.escape.ship.proto.v1.DeviceFingerprint
+	0,  // 2: go.escape.ship.proto.v1.RegisterPushTokenRequest.platform:type_name -> go.escape.ship.proto.v1.PushPlatform
+	62, // 3: go.escape.ship.proto.v1.IssueGuestTokenRequest.device:type_name -> go.escape.ship.proto.v1.DeviceFingerprint
+	1,  // 4: go.escape.ship.proto.v1.MergeConflict.resolution:type_name -> go.escape.ship.proto.v1.MergeConflictResolution
+	30, // 5: go.escape.ship.proto.v1.MergeAccountsResponse.conflicts:type_name -> go.escape.ship.proto.v1.MergeConflict
+	63, // 6: go.escape.ship.proto.v1.UserProfile.default_address:type_name -> go.escape.ship.proto.v1.Address
+	38, // 7: go.escape.ship.proto.v1.UploadAvatarRequest.metadata:type_name -> go.escape.ship.proto.v1.AvatarMetadata
+	37, // 8: go.escape.ship.proto.v1.UploadAvatarResponse.profile:type_name -> go.escape.ship.proto.v1.UserProfile
+	2,  // 9: go.escape.ship.proto.v1.UserPreferences.theme:type_name -> go.escape.ship.proto.v1.Theme
+	61, // 10: go.escape.ship.proto.v1.UserPreferences.extra:type_name -> go.escape.ship.proto.v1.UserPreferences.ExtraEntry
+	41, // 11: go.escape.ship.proto.v1.GetPreferencesResponse.preferences:type_name -> go.escape.ship.proto.v1.UserPreferences
+	41, // 12: go.escape.ship.proto.v1.SetPreferencesRequest.preferences:type_name -> go.escape.ship.proto.v1.UserPreferences
+	64, // 13: go.escape.ship.proto.v1.SetPreferencesRequest.update_mask:type_name -> google.protobuf.FieldMask
+	41, // 14: go.escape.ship.proto.v1.SetPreferencesResponse.preferences:type_name -> go.escape.ship.proto.v1.UserPreferences
+	37, // 15: go.escape.ship.proto.v1.GetMeResponse.profile:type_name -> go.escape.ship.proto.v1.UserProfile
+	37, // 16: go.escape.ship.proto.v1.UpdateProfileRequest.profile:type_name -> go.escape.ship.proto.v1.UserProfile
+	64, // 17: go.escape.ship.proto.v1.UpdateProfileRequest.update_mask:type_name -> google.protobuf.FieldMask
+	37, // 18: go.escape.ship.proto.v1.UpdateProfileResponse.profile:type_name -> go.escape.ship.proto.v1.UserProfile
+	54, // 19: go.escape.ship.proto.v1.CreateAPIKeyResponse.api_key:type_name -> go.escape.ship.proto.v1.APIKey
+	54, // 20: go.escape.ship.proto.v1.RevokeAPIKeyResponse.api_key:type_name -> go.escape.ship.proto.v1.APIKey
+	54, // 21: go.escape.ship.proto.v1.ValidateAPIKeyResponse.api_key:type_name -> go.escape.ship.proto.v1.APIKey
+	3,  // 22: go.escape.ship.proto.v1.AccountService.GetKakaoLoginURL:input_type -> go.escape.ship.proto.v1.GetKakaoLoginURLRequest
+	5,  // 23: go.escape.ship.proto.v1.AccountService.GetKakaoCallBack:input_type -> go.escape.ship.proto.v1.GetKakaoCallBackRequest
+	7,  // 24: go.escape.ship.proto.v1.AccountService.Login:input_type -> go.escape.ship.proto.v1.LoginRequest
+	9,  // 25: go.escape.ship.proto.v1.AccountService.Register:input_type -> go.escape.ship.proto.v1.RegisterRequest
+	11, // 26: go.escape.ship.proto.v1.AccountService.RefreshToken:input_type -> go.escape.ship.proto.v1.RefreshTokenRequest
+	13, // 27: go.escape.ship.proto.v1.AccountService.RevokeToken:input_type -> go.escape.ship.proto.v1.RevokeTokenRequest
+	15, // 28: go.escape.ship.proto.v1.AccountService.AnonymizeUserData:input_type -> go.escape.ship.proto.v1.AnonymizeUserDataRequest
+	17, // 29: go.escape.ship.proto.v1.AccountService.AcceptTerms:input_type -> go.escape.ship.proto.v1.AcceptTermsRequest
+	19, // 30: go.escape.ship.proto.v1.AccountService.RegisterPushToken:input_type -> go.escape.ship.proto.v1.RegisterPushTokenRequest
+	21, // 31: go.escape.ship.proto.v1.AccountService.UnregisterPushToken:input_type -> go.escape.ship.proto.v1.UnregisterPushTokenRequest
+	23, // 32: go.escape.ship.proto.v1.AccountService.VerifyCaptcha:input_type -> go.escape.ship.proto.v1.VerifyCaptchaRequest
+	26, // 33: go.escape.ship.proto.v1.AccountService.UnlockAccount:input_type -> go.escape.ship.proto.v1.UnlockAccountRequest
+	28, // 34: go.escape.ship.proto.v1.AccountService.IssueGuestToken:input_type -> go.escape.ship.proto.v1.IssueGuestTokenRequest
+	31, // 35: go.escape.ship.proto.v1.AccountService.MergeAccounts:input_type -> go.escape.ship.proto.v1.MergeAccountsRequest
+	33, // 36: go.escape.ship.proto.v1.AccountService.RequestEmailChange:input_type -> go.escape.ship.proto.v1.RequestEmailChangeRequest
+	35, // 37: go.escape.ship.proto.v1.AccountService.ConfirmEmailChange:input_type -> go.escape.ship.proto.v1.ConfirmEmailChangeRequest
+	39, // 38: go.escape.ship.proto.v1.AccountService.UploadAvatar:input_type -> go.escape.ship.proto.v1.UploadAvatarRequest
+	42, // 39: go.escape.ship.proto.v1.AccountService.GetPreferences:input_type -> go.escape.ship.proto.v1.GetPreferencesRequest
+	44, // 40: go.escape.ship.proto.v1.AccountService.SetPreferences:input_type -> go.escape.ship.proto.v1.SetPreferencesRequest
+	46, // 41: go.escape.ship.proto.v1.AccountService.GetMe:input_type -> go.escape.ship.proto.v1.GetMeRequest
+	48, // 42: go.escape.ship.proto.v1.AccountService.UpdateProfile:input_type -> go.escape.ship.proto.v1.UpdateProfileRequest
+	50, // 43: go.escape.ship.proto.v1.AccountService.ChangePassword:input_type -> go.escape.ship.proto.v1.ChangePasswordRequest
+	52, // 44: go.escape.ship.proto.v1.AccountService.DeleteAccount:input_type -> go.escape.ship.proto.v1.DeleteAccountRequest
+	55, // 45: go.escape.ship.proto.v1.AccountService.CreateAPIKey:input_type -> go.escape.ship.proto.v1.CreateAPIKeyRequest
+	57, // 46: go.escape.ship.proto.v1.AccountService.RevokeAPIKey:input_type -> go.escape.ship.proto.v1.RevokeAPIKeyRequest
+	59, // 47: go.escape.ship.proto.v1.AccountService.ValidateAPIKey:input_type -> go.escape.ship.proto.v1.ValidateAPIKeyRequest
+	4,  // 48: go.escape.ship.proto.v1.AccountService.GetKakaoLoginURL:output_type -> go.escape.ship.proto.v1.GetKakaoLoginURLResponse
+	6,  // 49: go.escape.ship.proto.v1.AccountService.GetKakaoCallBack:output_type -> go.escape.ship.proto.v1.GetKakaoCallBackResponse
+	8,  // 50: go.escape.ship.proto.v1.AccountService.Login:output_type -> go.escape.ship.proto.v1.LoginResponse
+	10, // 51: go.escape.ship.proto.v1.AccountService.Register:output_type -> go.escape.ship.proto.v1.RegisterResponse
+	12, // 52: go.escape.ship.proto.v1.AccountService.RefreshToken:output_type -> go.escape.ship.proto.v1.RefreshTokenResponse
+	14, // 53: go.escape.ship.proto.v1.AccountService.RevokeToken:output_type -> go.escape.ship.proto.v1.RevokeTokenResponse
+	16, // 54: go.escape.ship.proto.v1.AccountService.AnonymizeUserData:output_type -> go.escape.ship.proto.v1.AnonymizeUserDataResponse
+	18, // 55: go.escape.ship.proto.v1.AccountService.AcceptTerms:output_type -> go.escape.ship.proto.v1.AcceptTermsResponse
+	20, // 56: go.escape.ship.proto.v1.AccountService.RegisterPushToken:output_type -> go.escape.ship.proto.v1.RegisterPushTokenResponse
+	22, // 57: go.escape.ship.proto.v1.AccountService.UnregisterPushToken:output_type -> go.escape.ship.proto.v1.UnregisterPushTokenResponse
+	24, // 58: go.escape.ship.proto.v1.AccountService.VerifyCaptcha:output_type -> go.escape.ship.proto.v1.VerifyCaptchaResponse
+	27, // 59: go.escape.ship.proto.v1.AccountService.UnlockAccount:output_type -> go.escape.ship.proto.v1.UnlockAccountResponse
+	29, // 60: go.escape.ship.proto.v1.AccountService.IssueGuestToken:output_type -> go.escape.ship.proto.v1.IssueGuestTokenResponse
+	32, // 61: go.escape.ship.proto.v1.AccountService.MergeAccounts:output_type -> go.escape.ship.proto.v1.MergeAccountsResponse
+	34, // 62: go.escape.ship.proto.v1.AccountService.RequestEmailChange:output_type -> go.escape.ship.proto.v1.RequestEmailChangeResponse
+	36, // 63: go.escape.ship.proto.v1.AccountService.ConfirmEmailChange:output_type -> go.escape.ship.proto.v1.ConfirmEmailChangeResponse
+	40, // 64: go.escape.ship.proto.v1.AccountService.UploadAvatar:output_type -> go.escape.ship.proto.v1.UploadAvatarResponse
+	43, // 65: go.escape.ship.proto.v1.AccountService.GetPreferences:output_type -> go.escape.ship.proto.v1.GetPreferencesResponse
+	45, // 66: go.escape.ship.proto.v1.AccountService.SetPreferences:output_type -> go.escape.ship.proto.v1.SetPreferencesResponse
+	47, // 67: go.escape.ship.proto.v1.AccountService.GetMe:output_type -> go.escape.ship.proto.v1.GetMeResponse
+	49, // 68: go.escape.ship.proto.v1.AccountService.UpdateProfile:output_type -> go.escape.ship.proto.v1.UpdateProfileResponse
+	51, // 69: go.escape.ship.proto.v1.AccountService.ChangePassword:output_type -> go.escape.ship.proto.v1.ChangePasswordResponse
+	53, // 70: go.escape.ship.proto.v1.AccountService.DeleteAccount:output_type -> go.escape.ship.proto.v1.DeleteAccountResponse
+	56, // 71: go.escape.ship.proto.v1.AccountService.CreateAPIKey:output_type -> go.escape.ship.proto.v1.CreateAPIKeyResponse
+	58, // 72: go.escape.ship.proto.v1.AccountService.RevokeAPIKey:output_type -> go.escape.ship.proto.v1.RevokeAPIKeyResponse
+	60, // 73: go.escape.ship.proto.v1.AccountService.ValidateAPIKey:output_type -> go.escape.ship.proto.v1.ValidateAPIKeyResponse
+	48, // [48:74] is the sub-list for method output_type
+	22, // [22:48] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
+}
+
+func init() { file_account_proto_init() }
+func file_account_proto_init() {
+	if File_account_proto != nil {
+		return
+	}
+	file_common_proto_init()
+	file_shipping_proto_init()
+	file_account_proto_msgTypes[36].OneofWrappers = []any{
+		(*UploadAvatarRequest_Metadata)(nil),
+		(*UploadAvatarRequest_Chunk)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_account_proto_rawDesc), len(file_account_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   8,
+			NumEnums:      3,
+			NumMessages:   59,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_account_proto_goTypes,
 		DependencyIndexes: file_account_proto_depIdxs,
+		EnumInfos:         file_account_proto_enumTypes,
 		MessageInfos:      file_account_proto_msgTypes,
 	}.Build()
 	File_account_proto = out.File
@@ -0,0 +1,8285 @@
+// Code generated by protoc-gen-twirp v8.1.3, DO NOT EDIT.
+// source: account.proto
+
+package gen
+
+import context "context"
+import fmt "fmt"
+import http "net/http"
+import io "io"
+import json "encoding/json"
+import strconv "strconv"
+import strings "strings"
+
+import protojson "google.golang.org/protobuf/encoding/protojson"
+import proto "google.golang.org/protobuf/proto"
+import twirp "github.com/twitchtv/twirp"
+import ctxsetters "github.com/twitchtv/twirp/ctxsetters"
+
+import bytes "bytes"
+import errors "errors"
+import path "path"
+import url "net/url"
+
+// Version compatibility assertion.
+// If the constant is not defined in the package, that likely means
+// the package needs to be updated to work with this generated code.
+// See https://twitchtv.github.io/twirp/docs/version_matrix.html
+const _ = twirp.TwirpPackageMinVersion_8_1_0
+
+// ========================
+// AccountService Interface
+// ========================
+
+type AccountService interface {
+	GetKakaoLoginURL(context.Context, *GetKakaoLoginURLRequest) (*GetKakaoLoginURLResponse, error)
+
+	GetKakaoCallBack(context.Context, *GetKakaoCallBackRequest) (*GetKakaoCallBackResponse, error)
+
+	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+
+	Register(context.Context, *RegisterRequest) (*RegisterResponse, error)
+
+	// refresh_token으로 새 access/refresh 토큰 발급 (rotation: 사용한 refresh_token은 즉시 무효)
+	// 이미 사용된 refresh_token이 다시 오면 탈취로 보고 같은 계열의 토큰을 모두 폐기
+	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
+
+	// 로그아웃: access 또는 refresh 토큰 폐기 (이미 무효한 토큰도 성공 처리)
+	RevokeToken(context.Context, *RevokeTokenRequest) (*RevokeTokenResponse, error)
+
+	// 개인정보 파기 요청: 주문/결제의 PII를 삭제하되 금액 등 집계 데이터는 보존
+	AnonymizeUserData(context.Context, *AnonymizeUserDataRequest) (*AnonymizeUserDataResponse, error)
+
+	// 약관 동의 (Authorization 헤더의 사용자 기준)
+	AcceptTerms(context.Context, *AcceptTermsRequest) (*AcceptTermsResponse, error)
+
+	// 주문 상태 푸시 알림을 위한 디바이스 토큰 등록/해제 (FCM/APNs)
+	RegisterPushToken(context.Context, *RegisterPushTokenRequest) (*RegisterPushTokenResponse, error)
+
+	UnregisterPushToken(context.Context, *UnregisterPushTokenRequest) (*UnregisterPushTokenResponse, error)
+
+	// CAPTCHA 토큰 검증 (Login/Register 처리 중 내부 호출 또는 단독 사용)
+	VerifyCaptcha(context.Context, *VerifyCaptchaRequest) (*VerifyCaptchaResponse, error)
+
+	// 관리자용: 로그인 실패로 잠긴 계정 해제
+	UnlockAccount(context.Context, *UnlockAccountRequest) (*UnlockAccountResponse, error)
+
+	// 비회원 장바구니/이벤트 추적용 익명 토큰 발급, 가입 후 계정으로 병합 가능
+	IssueGuestToken(context.Context, *IssueGuestTokenRequest) (*IssueGuestTokenResponse, error)
+
+	// 계정 병합 (게스트→회원, 카카오→이메일): 장바구니, 주문, 포인트, 위시리스트를 target으로 이전
+	MergeAccounts(context.Context, *MergeAccountsRequest) (*MergeAccountsResponse, error)
+
+	// 이메일 변경: 새 주소로 인증 코드 발송 + 기존 주소로 변경 요청 알림
+	RequestEmailChange(context.Context, *RequestEmailChangeRequest) (*RequestEmailChangeResponse, error)
+
+	// 새 주소로 받은 인증 코드 확인 후 이메일 변경 완료
+	ConfirmEmailChange(context.Context, *ConfirmEmailChangeRequest) (*ConfirmEmailChangeResponse, error)
+
+	// 프로필 이미지 업로드: 첫 메시지는 metadata, 이후 chunk 전송
+	UploadAvatar(context.Context, *UploadAvatarRequest) (*UploadAvatarResponse, error)
+
+	// UI 설정 (언어, 통화, 테마 등) 기기 간 동기화
+	GetPreferences(context.Context, *GetPreferencesRequest) (*GetPreferencesResponse, error)
+
+	SetPreferences(context.Context, *SetPreferencesRequest) (*SetPreferencesResponse, error)
+
+	// 로그인한 사용자 (Authorization 헤더 기준) 프로필 조회/변경
+	GetMe(context.Context, *GetMeRequest) (*GetMeResponse, error)
+
+	UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error)
+
+	// 비밀번호 변경: 성공 시 현재 세션을 제외한 refresh 토큰 폐기 (선택)
+	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error)
+
+	// 회원 탈퇴: 모든 토큰 폐기 후 유예 기간이 지나면 AnonymizeUserData로 개인정보 파기
+	DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error)
+
+	// 관리자용: 파트너 HTTP 연동용 API 키 발급/폐기
+	CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error)
+
+	RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error)
+
+	// 게이트웨이 전용: x-api-key 검증 (HTTP 매핑 없음)
+	ValidateAPIKey(context.Context, *ValidateAPIKeyRequest) (*ValidateAPIKeyResponse, error)
+}
+
+// ==============================
+// AccountService Protobuf Client
+// ==============================
+
+type accountServiceProtobufClient struct {
+	client      HTTPClient
+	urls        [26]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewAccountServiceProtobufClient creates a Protobuf client that implements the AccountService interface.
+// It communicates using Protobuf and can be configured with a custom HTTPClient.
+func NewAccountServiceProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) AccountService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "go.escape.ship.proto.v1", "AccountService")
+	urls := [26]string{
+		serviceURL + "GetKakaoLoginURL",
+		serviceURL + "GetKakaoCallBack",
+		serviceURL + "Login",
+		serviceURL + "Register",
+		serviceURL + "RefreshToken",
+		serviceURL + "RevokeToken",
+		serviceURL + "AnonymizeUserData",
+		serviceURL + "AcceptTerms",
+		serviceURL + "RegisterPushToken",
+		serviceURL + "UnregisterPushToken",
+		serviceURL + "VerifyCaptcha",
+		serviceURL + "UnlockAccount",
+		serviceURL + "IssueGuestToken",
+		serviceURL + "MergeAccounts",
+		serviceURL + "RequestEmailChange",
+		serviceURL + "ConfirmEmailChange",
+		serviceURL + "UploadAvatar",
+		serviceURL + "GetPreferences",
+		serviceURL + "SetPreferences",
+		serviceURL + "GetMe",
+		serviceURL + "UpdateProfile",
+		serviceURL + "ChangePassword",
+		serviceURL + "DeleteAccount",
+		serviceURL + "CreateAPIKey",
+		serviceURL + "RevokeAPIKey",
+		serviceURL + "ValidateAPIKey",
+	}
+
+	return &accountServiceProtobufClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *accountServiceProtobufClient) GetKakaoLoginURL(ctx context.Context, in *GetKakaoLoginURLRequest) (*GetKakaoLoginURLResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetKakaoLoginURL")
+	caller := c.callGetKakaoLoginURL
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetKakaoLoginURLRequest) (*GetKakaoLoginURLResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetKakaoLoginURLRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetKakaoLoginURLRequest) when calling interceptor")
+					}
+					return c.callGetKakaoLoginURL(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetKakaoLoginURLResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetKakaoLoginURLResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceProtobufClient) callGetKakaoLoginURL(ctx context.Context, in *GetKakaoLoginURLRequest) (*GetKakaoLoginURLResponse, error) {
+	out := new(GetKakaoLoginURLResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceProtobufClient) GetKakaoCallBack(ctx context.Context, in *GetKakaoCallBackRequest) (*GetKakaoCallBackResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetKakaoCallBack")
+	caller := c.callGetKakaoCallBack
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetKakaoCallBackRequest) (*GetKakaoCallBackResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetKakaoCallBackRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetKakaoCallBackRequest) when calling interceptor")
+					}
+					return c.callGetKakaoCallBack(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetKakaoCallBackResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetKakaoCallBackResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceProtobufClient) callGetKakaoCallBack(ctx context.Context, in *GetKakaoCallBackRequest) (*GetKakaoCallBackResponse, error) {
+	out := new(GetKakaoCallBackResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceProtobufClient) Login(ctx context.Context, in *LoginRequest) (*LoginResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "Login")
+	caller := c.callLogin
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *LoginRequest) (*LoginResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*LoginRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*LoginRequest) when calling interceptor")
+					}
+					return c.callLogin(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*LoginResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*LoginResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceProtobufClient) callLogin(ctx context.Context, in *LoginRequest) (*LoginResponse, error) {
+	out := new(LoginResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceProtobufClient) Register(ctx context.Context, in *RegisterRequest) (*RegisterResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "Register")
+	caller := c.callRegister
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RegisterRequest) (*RegisterResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RegisterRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RegisterRequest) when calling interceptor")
+					}
+					return c.callRegister(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RegisterResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RegisterResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceProtobufClient) callRegister(ctx context.Context, in *RegisterRequest) (*RegisterResponse, error) {
+	out := new(RegisterResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceProtobufClient) RefreshToken(ctx context.Context, in *RefreshTokenRequest) (*RefreshTokenResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "RefreshToken")
+	caller := c.callRefreshToken
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RefreshTokenRequest) (*RefreshTokenResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RefreshTokenRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RefreshTokenRequest) when calling interceptor")
+					}
+					return c.callRefreshToken(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RefreshTokenResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RefreshTokenResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceProtobufClient) callRefreshToken(ctx context.Context, in *RefreshTokenRequest) (*RefreshTokenResponse, error) {
+	out := new(RefreshTokenResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceProtobufClient) RevokeToken(ctx context.Context, in *RevokeTokenRequest) (*RevokeTokenResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "RevokeToken")
+	caller := c.callRevokeToken
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RevokeTokenRequest) (*RevokeTokenResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RevokeTokenRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RevokeTokenRequest) when calling interceptor")
+					}
+					return c.callRevokeToken(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RevokeTokenResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RevokeTokenResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceProtobufClient) callRevokeToken(ctx context.Context, in *RevokeTokenRequest) (*RevokeTokenResponse, error) {
+	out := new(RevokeTokenResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceProtobufClient) AnonymizeUserData(ctx context.Context, in *AnonymizeUserDataRequest) (*AnonymizeUserDataResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "AnonymizeUserData")
+	caller := c.callAnonymizeUserData
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AnonymizeUserDataRequest) (*AnonymizeUserDataResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AnonymizeUserDataRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AnonymizeUserDataRequest) when calling interceptor")
+					}
+					return c.callAnonymizeUserData(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AnonymizeUserDataResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AnonymizeUserDataResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceProtobufClient) callAnonymizeUserData(ctx context.Context, in *AnonymizeUserDataRequest) (*AnonymizeUserDataResponse, error) {
+	out := new(AnonymizeUserDataResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceProtobufClient) AcceptTerms(ctx context.Context, in *AcceptTermsRequest) (*AcceptTermsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "AcceptTerms")
+	caller := c.callAcceptTerms
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AcceptTermsRequest) (*AcceptTermsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AcceptTermsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AcceptTermsRequest) when calling interceptor")
+					}
+					return c.callAcceptTerms(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AcceptTermsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AcceptTermsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceProtobufClient) callAcceptTerms(ctx context.Context, in *AcceptTermsRequest) (*AcceptTermsResponse, error) {
+	out := new(AcceptTermsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceProtobufClient) RegisterPushToken(ctx context.Context, in *RegisterPushTokenRequest) (*RegisterPushTokenResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "RegisterPushToken")
+	caller := c.callRegisterPushToken
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RegisterPushTokenRequest) (*RegisterPushTokenResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RegisterPushTokenRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RegisterPushTokenRequest) when calling interceptor")
+					}
+					return c.callRegisterPushToken(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RegisterPushTokenResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RegisterPushTokenResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceProtobufClient) callRegisterPushToken(ctx context.Context, in *RegisterPushTokenRequest) (*RegisterPushTokenResponse, error) {
+	out := new(RegisterPushTokenResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceProtobufClient) UnregisterPushToken(ctx context.Context, in *UnregisterPushTokenRequest) (*UnregisterPushTokenResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "UnregisterPushToken")
+	caller := c.callUnregisterPushToken
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UnregisterPushTokenRequest) (*UnregisterPushTokenResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UnregisterPushTokenRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UnregisterPushTokenRequest) when calling interceptor")
+					}
+					return c.callUnregisterPushToken(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UnregisterPushTokenResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UnregisterPushTokenResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceProtobufClient) callUnregisterPushToken(ctx context.Context, in *UnregisterPushTokenRequest) (*UnregisterPushTokenResponse, error) {
+	out := new(UnregisterPushTokenResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceProtobufClient) VerifyCaptcha(ctx context.Context, in *VerifyCaptchaRequest) (*VerifyCaptchaResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "VerifyCaptcha")
+	caller := c.callVerifyCaptcha
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *VerifyCaptchaRequest) (*VerifyCaptchaResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*VerifyCaptchaRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*VerifyCaptchaRequest) when calling interceptor")
+					}
+					return c.callVerifyCaptcha(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*VerifyCaptchaResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*VerifyCaptchaResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceProtobufClient) callVerifyCaptcha(ctx context.Context, in *VerifyCaptchaRequest) (*VerifyCaptchaResponse, error) {
+	out := new(VerifyCaptchaResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceProtobufClient) UnlockAccount(ctx context.Context, in *UnlockAccountRequest) (*UnlockAccountResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "UnlockAccount")
+	caller := c.callUnlockAccount
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UnlockAccountRequest) (*UnlockAccountResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UnlockAccountRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UnlockAccountRequest) when calling interceptor")
+					}
+					return c.callUnlockAccount(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UnlockAccountResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UnlockAccountResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceProtobufClient) callUnlockAccount(ctx context.Context, in *UnlockAccountRequest) (*UnlockAccountResponse, error) {
+	out := new(UnlockAccountResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceProtobufClient) IssueGuestToken(ctx context.Context, in *IssueGuestTokenRequest) (*IssueGuestTokenResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "IssueGuestToken")
+	caller := c.callIssueGuestToken
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *IssueGuestTokenRequest) (*IssueGuestTokenResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*IssueGuestTokenRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*IssueGuestTokenRequest) when calling interceptor")
+					}
+					return c.callIssueGuestToken(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*IssueGuestTokenResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*IssueGuestTokenResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceProtobufClient) callIssueGuestToken(ctx context.Context, in *IssueGuestTokenRequest) (*IssueGuestTokenResponse, error) {
+	out := new(IssueGuestTokenResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceProtobufClient) MergeAccounts(ctx context.Context, in *MergeAccountsRequest) (*MergeAccountsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "MergeAccounts")
+	caller := c.callMergeAccounts
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *MergeAccountsRequest) (*MergeAccountsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MergeAccountsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MergeAccountsRequest) when calling interceptor")
+					}
+					return c.callMergeAccounts(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*MergeAccountsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*MergeAccountsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceProtobufClient) callMergeAccounts(ctx context.Context, in *MergeAccountsRequest) (*MergeAccountsResponse, error) {
+	out := new(MergeAccountsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceProtobufClient) RequestEmailChange(ctx context.Context, in *RequestEmailChangeRequest) (*RequestEmailChangeResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "RequestEmailChange")
+	caller := c.callRequestEmailChange
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RequestEmailChangeRequest) (*RequestEmailChangeResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RequestEmailChangeRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RequestEmailChangeRequest) when calling interceptor")
+					}
+					return c.callRequestEmailChange(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RequestEmailChangeResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RequestEmailChangeResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceProtobufClient) callRequestEmailChange(ctx context.Context, in *RequestEmailChangeRequest) (*RequestEmailChangeResponse, error) {
+	out := new(RequestEmailChangeResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[14], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceProtobufClient) ConfirmEmailChange(ctx context.Context, in *ConfirmEmailChangeRequest) (*ConfirmEmailChangeResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "ConfirmEmailChange")
+	caller := c.callConfirmEmailChange
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ConfirmEmailChangeRequest) (*ConfirmEmailChangeResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ConfirmEmailChangeRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ConfirmEmailChangeRequest) when calling interceptor")
+					}
+					return c.callConfirmEmailChange(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ConfirmEmailChangeResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ConfirmEmailChangeResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceProtobufClient) callConfirmEmailChange(ctx context.Context, in *ConfirmEmailChangeRequest) (*ConfirmEmailChangeResponse, error) {
+	out := new(ConfirmEmailChangeResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[15], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceProtobufClient) UploadAvatar(ctx context.Context, in *UploadAvatarRequest) (*UploadAvatarResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "UploadAvatar")
+	caller := c.callUploadAvatar
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UploadAvatarRequest) (*UploadAvatarResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UploadAvatarRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UploadAvatarRequest) when calling interceptor")
+					}
+					return c.callUploadAvatar(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UploadAvatarResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UploadAvatarResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceProtobufClient) callUploadAvatar(ctx context.Context, in *UploadAvatarRequest) (*UploadAvatarResponse, error) {
+	out := new(UploadAvatarResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[16], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceProtobufClient) GetPreferences(ctx context.Context, in *GetPreferencesRequest) (*GetPreferencesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetPreferences")
+	caller := c.callGetPreferences
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetPreferencesRequest) (*GetPreferencesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetPreferencesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetPreferencesRequest) when calling interceptor")
+					}
+					return c.callGetPreferences(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetPreferencesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetPreferencesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceProtobufClient) callGetPreferences(ctx context.Context, in *GetPreferencesRequest) (*GetPreferencesResponse, error) {
+	out := new(GetPreferencesResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[17], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceProtobufClient) SetPreferences(ctx context.Context, in *SetPreferencesRequest) (*SetPreferencesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "SetPreferences")
+	caller := c.callSetPreferences
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SetPreferencesRequest) (*SetPreferencesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetPreferencesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetPreferencesRequest) when calling interceptor")
+					}
+					return c.callSetPreferences(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SetPreferencesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SetPreferencesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceProtobufClient) callSetPreferences(ctx context.Context, in *SetPreferencesRequest) (*SetPreferencesResponse, error) {
+	out := new(SetPreferencesResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[18], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceProtobufClient) GetMe(ctx context.Context, in *GetMeRequest) (*GetMeResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetMe")
+	caller := c.callGetMe
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetMeRequest) (*GetMeResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetMeRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetMeRequest) when calling interceptor")
+					}
+					return c.callGetMe(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetMeResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetMeResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceProtobufClient) callGetMe(ctx context.Context, in *GetMeRequest) (*GetMeResponse, error) {
+	out := new(GetMeResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[19], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceProtobufClient) UpdateProfile(ctx context.Context, in *UpdateProfileRequest) (*UpdateProfileResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateProfile")
+	caller := c.callUpdateProfile
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateProfileRequest) (*UpdateProfileResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateProfileRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateProfileRequest) when calling interceptor")
+					}
+					return c.callUpdateProfile(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateProfileResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateProfileResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceProtobufClient) callUpdateProfile(ctx context.Context, in *UpdateProfileRequest) (*UpdateProfileResponse, error) {
+	out := new(UpdateProfileResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[20], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceProtobufClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest) (*ChangePasswordResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "ChangePassword")
+	caller := c.callChangePassword
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ChangePasswordRequest) (*ChangePasswordResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ChangePasswordRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ChangePasswordRequest) when calling interceptor")
+					}
+					return c.callChangePassword(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ChangePasswordResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ChangePasswordResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceProtobufClient) callChangePassword(ctx context.Context, in *ChangePasswordRequest) (*ChangePasswordResponse, error) {
+	out := new(ChangePasswordResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[21], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceProtobufClient) DeleteAccount(ctx context.Context, in *DeleteAccountRequest) (*DeleteAccountResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteAccount")
+	caller := c.callDeleteAccount
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeleteAccountRequest) (*DeleteAccountResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteAccountRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteAccountRequest) when calling interceptor")
+					}
+					return c.callDeleteAccount(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteAccountResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteAccountResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceProtobufClient) callDeleteAccount(ctx context.Context, in *DeleteAccountRequest) (*DeleteAccountResponse, error) {
+	out := new(DeleteAccountResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[22], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceProtobufClient) CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateAPIKey")
+	caller := c.callCreateAPIKey
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateAPIKeyRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateAPIKeyRequest) when calling interceptor")
+					}
+					return c.callCreateAPIKey(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateAPIKeyResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateAPIKeyResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceProtobufClient) callCreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+	out := new(CreateAPIKeyResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[23], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceProtobufClient) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "RevokeAPIKey")
+	caller := c.callRevokeAPIKey
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RevokeAPIKeyRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RevokeAPIKeyRequest) when calling interceptor")
+					}
+					return c.callRevokeAPIKey(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RevokeAPIKeyResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RevokeAPIKeyResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceProtobufClient) callRevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error) {
+	out := new(RevokeAPIKeyResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[24], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceProtobufClient) ValidateAPIKey(ctx context.Context, in *ValidateAPIKeyRequest) (*ValidateAPIKeyResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "ValidateAPIKey")
+	caller := c.callValidateAPIKey
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ValidateAPIKeyRequest) (*ValidateAPIKeyResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ValidateAPIKeyRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ValidateAPIKeyRequest) when calling interceptor")
+					}
+					return c.callValidateAPIKey(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ValidateAPIKeyResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ValidateAPIKeyResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceProtobufClient) callValidateAPIKey(ctx context.Context, in *ValidateAPIKeyRequest) (*ValidateAPIKeyResponse, error) {
+	out := new(ValidateAPIKeyResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[25], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ==========================
+// AccountService JSON Client
+// ==========================
+
+type accountServiceJSONClient struct {
+	client      HTTPClient
+	urls        [26]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewAccountServiceJSONClient creates a JSON client that implements the AccountService interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewAccountServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) AccountService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "go.escape.ship.proto.v1", "AccountService")
+	urls := [26]string{
+		serviceURL + "GetKakaoLoginURL",
+		serviceURL + "GetKakaoCallBack",
+		serviceURL + "Login",
+		serviceURL + "Register",
+		serviceURL + "RefreshToken",
+		serviceURL + "RevokeToken",
+		serviceURL + "AnonymizeUserData",
+		serviceURL + "AcceptTerms",
+		serviceURL + "RegisterPushToken",
+		serviceURL + "UnregisterPushToken",
+		serviceURL + "VerifyCaptcha",
+		serviceURL + "UnlockAccount",
+		serviceURL + "IssueGuestToken",
+		serviceURL + "MergeAccounts",
+		serviceURL + "RequestEmailChange",
+		serviceURL + "ConfirmEmailChange",
+		serviceURL + "UploadAvatar",
+		serviceURL + "GetPreferences",
+		serviceURL + "SetPreferences",
+		serviceURL + "GetMe",
+		serviceURL + "UpdateProfile",
+		serviceURL + "ChangePassword",
+		serviceURL + "DeleteAccount",
+		serviceURL + "CreateAPIKey",
+		serviceURL + "RevokeAPIKey",
+		serviceURL + "ValidateAPIKey",
+	}
+
+	return &accountServiceJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *accountServiceJSONClient) GetKakaoLoginURL(ctx context.Context, in *GetKakaoLoginURLRequest) (*GetKakaoLoginURLResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetKakaoLoginURL")
+	caller := c.callGetKakaoLoginURL
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetKakaoLoginURLRequest) (*GetKakaoLoginURLResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetKakaoLoginURLRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetKakaoLoginURLRequest) when calling interceptor")
+					}
+					return c.callGetKakaoLoginURL(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetKakaoLoginURLResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetKakaoLoginURLResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceJSONClient) callGetKakaoLoginURL(ctx context.Context, in *GetKakaoLoginURLRequest) (*GetKakaoLoginURLResponse, error) {
+	out := new(GetKakaoLoginURLResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceJSONClient) GetKakaoCallBack(ctx context.Context, in *GetKakaoCallBackRequest) (*GetKakaoCallBackResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetKakaoCallBack")
+	caller := c.callGetKakaoCallBack
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetKakaoCallBackRequest) (*GetKakaoCallBackResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetKakaoCallBackRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetKakaoCallBackRequest) when calling interceptor")
+					}
+					return c.callGetKakaoCallBack(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetKakaoCallBackResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetKakaoCallBackResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceJSONClient) callGetKakaoCallBack(ctx context.Context, in *GetKakaoCallBackRequest) (*GetKakaoCallBackResponse, error) {
+	out := new(GetKakaoCallBackResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceJSONClient) Login(ctx context.Context, in *LoginRequest) (*LoginResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "Login")
+	caller := c.callLogin
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *LoginRequest) (*LoginResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*LoginRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*LoginRequest) when calling interceptor")
+					}
+					return c.callLogin(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*LoginResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*LoginResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceJSONClient) callLogin(ctx context.Context, in *LoginRequest) (*LoginResponse, error) {
+	out := new(LoginResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceJSONClient) Register(ctx context.Context, in *RegisterRequest) (*RegisterResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "Register")
+	caller := c.callRegister
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RegisterRequest) (*RegisterResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RegisterRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RegisterRequest) when calling interceptor")
+					}
+					return c.callRegister(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RegisterResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RegisterResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceJSONClient) callRegister(ctx context.Context, in *RegisterRequest) (*RegisterResponse, error) {
+	out := new(RegisterResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceJSONClient) RefreshToken(ctx context.Context, in *RefreshTokenRequest) (*RefreshTokenResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "RefreshToken")
+	caller := c.callRefreshToken
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RefreshTokenRequest) (*RefreshTokenResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RefreshTokenRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RefreshTokenRequest) when calling interceptor")
+					}
+					return c.callRefreshToken(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RefreshTokenResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RefreshTokenResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceJSONClient) callRefreshToken(ctx context.Context, in *RefreshTokenRequest) (*RefreshTokenResponse, error) {
+	out := new(RefreshTokenResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceJSONClient) RevokeToken(ctx context.Context, in *RevokeTokenRequest) (*RevokeTokenResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "RevokeToken")
+	caller := c.callRevokeToken
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RevokeTokenRequest) (*RevokeTokenResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RevokeTokenRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RevokeTokenRequest) when calling interceptor")
+					}
+					return c.callRevokeToken(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RevokeTokenResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RevokeTokenResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceJSONClient) callRevokeToken(ctx context.Context, in *RevokeTokenRequest) (*RevokeTokenResponse, error) {
+	out := new(RevokeTokenResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceJSONClient) AnonymizeUserData(ctx context.Context, in *AnonymizeUserDataRequest) (*AnonymizeUserDataResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "AnonymizeUserData")
+	caller := c.callAnonymizeUserData
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AnonymizeUserDataRequest) (*AnonymizeUserDataResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AnonymizeUserDataRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AnonymizeUserDataRequest) when calling interceptor")
+					}
+					return c.callAnonymizeUserData(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AnonymizeUserDataResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AnonymizeUserDataResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceJSONClient) callAnonymizeUserData(ctx context.Context, in *AnonymizeUserDataRequest) (*AnonymizeUserDataResponse, error) {
+	out := new(AnonymizeUserDataResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceJSONClient) AcceptTerms(ctx context.Context, in *AcceptTermsRequest) (*AcceptTermsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "AcceptTerms")
+	caller := c.callAcceptTerms
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AcceptTermsRequest) (*AcceptTermsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AcceptTermsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AcceptTermsRequest) when calling interceptor")
+					}
+					return c.callAcceptTerms(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AcceptTermsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AcceptTermsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceJSONClient) callAcceptTerms(ctx context.Context, in *AcceptTermsRequest) (*AcceptTermsResponse, error) {
+	out := new(AcceptTermsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceJSONClient) RegisterPushToken(ctx context.Context, in *RegisterPushTokenRequest) (*RegisterPushTokenResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "RegisterPushToken")
+	caller := c.callRegisterPushToken
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RegisterPushTokenRequest) (*RegisterPushTokenResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RegisterPushTokenRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RegisterPushTokenRequest) when calling interceptor")
+					}
+					return c.callRegisterPushToken(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RegisterPushTokenResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RegisterPushTokenResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceJSONClient) callRegisterPushToken(ctx context.Context, in *RegisterPushTokenRequest) (*RegisterPushTokenResponse, error) {
+	out := new(RegisterPushTokenResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceJSONClient) UnregisterPushToken(ctx context.Context, in *UnregisterPushTokenRequest) (*UnregisterPushTokenResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "UnregisterPushToken")
+	caller := c.callUnregisterPushToken
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UnregisterPushTokenRequest) (*UnregisterPushTokenResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UnregisterPushTokenRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UnregisterPushTokenRequest) when calling interceptor")
+					}
+					return c.callUnregisterPushToken(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UnregisterPushTokenResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UnregisterPushTokenResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceJSONClient) callUnregisterPushToken(ctx context.Context, in *UnregisterPushTokenRequest) (*UnregisterPushTokenResponse, error) {
+	out := new(UnregisterPushTokenResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceJSONClient) VerifyCaptcha(ctx context.Context, in *VerifyCaptchaRequest) (*VerifyCaptchaResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "VerifyCaptcha")
+	caller := c.callVerifyCaptcha
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *VerifyCaptchaRequest) (*VerifyCaptchaResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*VerifyCaptchaRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*VerifyCaptchaRequest) when calling interceptor")
+					}
+					return c.callVerifyCaptcha(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*VerifyCaptchaResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*VerifyCaptchaResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceJSONClient) callVerifyCaptcha(ctx context.Context, in *VerifyCaptchaRequest) (*VerifyCaptchaResponse, error) {
+	out := new(VerifyCaptchaResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceJSONClient) UnlockAccount(ctx context.Context, in *UnlockAccountRequest) (*UnlockAccountResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "UnlockAccount")
+	caller := c.callUnlockAccount
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UnlockAccountRequest) (*UnlockAccountResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UnlockAccountRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UnlockAccountRequest) when calling interceptor")
+					}
+					return c.callUnlockAccount(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UnlockAccountResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UnlockAccountResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceJSONClient) callUnlockAccount(ctx context.Context, in *UnlockAccountRequest) (*UnlockAccountResponse, error) {
+	out := new(UnlockAccountResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceJSONClient) IssueGuestToken(ctx context.Context, in *IssueGuestTokenRequest) (*IssueGuestTokenResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "IssueGuestToken")
+	caller := c.callIssueGuestToken
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *IssueGuestTokenRequest) (*IssueGuestTokenResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*IssueGuestTokenRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*IssueGuestTokenRequest) when calling interceptor")
+					}
+					return c.callIssueGuestToken(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*IssueGuestTokenResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*IssueGuestTokenResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceJSONClient) callIssueGuestToken(ctx context.Context, in *IssueGuestTokenRequest) (*IssueGuestTokenResponse, error) {
+	out := new(IssueGuestTokenResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceJSONClient) MergeAccounts(ctx context.Context, in *MergeAccountsRequest) (*MergeAccountsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "MergeAccounts")
+	caller := c.callMergeAccounts
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *MergeAccountsRequest) (*MergeAccountsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MergeAccountsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MergeAccountsRequest) when calling interceptor")
+					}
+					return c.callMergeAccounts(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*MergeAccountsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*MergeAccountsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceJSONClient) callMergeAccounts(ctx context.Context, in *MergeAccountsRequest) (*MergeAccountsResponse, error) {
+	out := new(MergeAccountsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceJSONClient) RequestEmailChange(ctx context.Context, in *RequestEmailChangeRequest) (*RequestEmailChangeResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "RequestEmailChange")
+	caller := c.callRequestEmailChange
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RequestEmailChangeRequest) (*RequestEmailChangeResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RequestEmailChangeRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RequestEmailChangeRequest) when calling interceptor")
+					}
+					return c.callRequestEmailChange(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RequestEmailChangeResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RequestEmailChangeResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceJSONClient) callRequestEmailChange(ctx context.Context, in *RequestEmailChangeRequest) (*RequestEmailChangeResponse, error) {
+	out := new(RequestEmailChangeResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[14], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceJSONClient) ConfirmEmailChange(ctx context.Context, in *ConfirmEmailChangeRequest) (*ConfirmEmailChangeResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "ConfirmEmailChange")
+	caller := c.callConfirmEmailChange
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ConfirmEmailChangeRequest) (*ConfirmEmailChangeResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ConfirmEmailChangeRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ConfirmEmailChangeRequest) when calling interceptor")
+					}
+					return c.callConfirmEmailChange(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ConfirmEmailChangeResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ConfirmEmailChangeResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceJSONClient) callConfirmEmailChange(ctx context.Context, in *ConfirmEmailChangeRequest) (*ConfirmEmailChangeResponse, error) {
+	out := new(ConfirmEmailChangeResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[15], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceJSONClient) UploadAvatar(ctx context.Context, in *UploadAvatarRequest) (*UploadAvatarResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "UploadAvatar")
+	caller := c.callUploadAvatar
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UploadAvatarRequest) (*UploadAvatarResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UploadAvatarRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UploadAvatarRequest) when calling interceptor")
+					}
+					return c.callUploadAvatar(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UploadAvatarResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UploadAvatarResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceJSONClient) callUploadAvatar(ctx context.Context, in *UploadAvatarRequest) (*UploadAvatarResponse, error) {
+	out := new(UploadAvatarResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[16], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceJSONClient) GetPreferences(ctx context.Context, in *GetPreferencesRequest) (*GetPreferencesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetPreferences")
+	caller := c.callGetPreferences
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetPreferencesRequest) (*GetPreferencesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetPreferencesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetPreferencesRequest) when calling interceptor")
+					}
+					return c.callGetPreferences(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetPreferencesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetPreferencesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceJSONClient) callGetPreferences(ctx context.Context, in *GetPreferencesRequest) (*GetPreferencesResponse, error) {
+	out := new(GetPreferencesResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[17], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceJSONClient) SetPreferences(ctx context.Context, in *SetPreferencesRequest) (*SetPreferencesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "SetPreferences")
+	caller := c.callSetPreferences
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SetPreferencesRequest) (*SetPreferencesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetPreferencesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetPreferencesRequest) when calling interceptor")
+					}
+					return c.callSetPreferences(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SetPreferencesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SetPreferencesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceJSONClient) callSetPreferences(ctx context.Context, in *SetPreferencesRequest) (*SetPreferencesResponse, error) {
+	out := new(SetPreferencesResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[18], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceJSONClient) GetMe(ctx context.Context, in *GetMeRequest) (*GetMeResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetMe")
+	caller := c.callGetMe
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetMeRequest) (*GetMeResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetMeRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetMeRequest) when calling interceptor")
+					}
+					return c.callGetMe(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetMeResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetMeResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceJSONClient) callGetMe(ctx context.Context, in *GetMeRequest) (*GetMeResponse, error) {
+	out := new(GetMeResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[19], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceJSONClient) UpdateProfile(ctx context.Context, in *UpdateProfileRequest) (*UpdateProfileResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateProfile")
+	caller := c.callUpdateProfile
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateProfileRequest) (*UpdateProfileResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateProfileRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateProfileRequest) when calling interceptor")
+					}
+					return c.callUpdateProfile(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateProfileResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateProfileResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceJSONClient) callUpdateProfile(ctx context.Context, in *UpdateProfileRequest) (*UpdateProfileResponse, error) {
+	out := new(UpdateProfileResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[20], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceJSONClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest) (*ChangePasswordResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "ChangePassword")
+	caller := c.callChangePassword
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ChangePasswordRequest) (*ChangePasswordResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ChangePasswordRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ChangePasswordRequest) when calling interceptor")
+					}
+					return c.callChangePassword(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ChangePasswordResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ChangePasswordResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceJSONClient) callChangePassword(ctx context.Context, in *ChangePasswordRequest) (*ChangePasswordResponse, error) {
+	out := new(ChangePasswordResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[21], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceJSONClient) DeleteAccount(ctx context.Context, in *DeleteAccountRequest) (*DeleteAccountResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteAccount")
+	caller := c.callDeleteAccount
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeleteAccountRequest) (*DeleteAccountResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteAccountRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteAccountRequest) when calling interceptor")
+					}
+					return c.callDeleteAccount(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteAccountResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteAccountResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceJSONClient) callDeleteAccount(ctx context.Context, in *DeleteAccountRequest) (*DeleteAccountResponse, error) {
+	out := new(DeleteAccountResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[22], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceJSONClient) CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateAPIKey")
+	caller := c.callCreateAPIKey
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateAPIKeyRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateAPIKeyRequest) when calling interceptor")
+					}
+					return c.callCreateAPIKey(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateAPIKeyResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateAPIKeyResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceJSONClient) callCreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+	out := new(CreateAPIKeyResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[23], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceJSONClient) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "RevokeAPIKey")
+	caller := c.callRevokeAPIKey
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RevokeAPIKeyRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RevokeAPIKeyRequest) when calling interceptor")
+					}
+					return c.callRevokeAPIKey(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RevokeAPIKeyResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RevokeAPIKeyResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceJSONClient) callRevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error) {
+	out := new(RevokeAPIKeyResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[24], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *accountServiceJSONClient) ValidateAPIKey(ctx context.Context, in *ValidateAPIKeyRequest) (*ValidateAPIKeyResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithMethodName(ctx, "ValidateAPIKey")
+	caller := c.callValidateAPIKey
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ValidateAPIKeyRequest) (*ValidateAPIKeyResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ValidateAPIKeyRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ValidateAPIKeyRequest) when calling interceptor")
+					}
+					return c.callValidateAPIKey(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ValidateAPIKeyResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ValidateAPIKeyResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *accountServiceJSONClient) callValidateAPIKey(ctx context.Context, in *ValidateAPIKeyRequest) (*ValidateAPIKeyResponse, error) {
+	out := new(ValidateAPIKeyResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[25], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// =============================
+// AccountService Server Handler
+// =============================
+
+type accountServiceServer struct {
+	AccountService
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewAccountServiceServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewAccountServiceServer(svc AccountService, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	return &accountServiceServer{
+		AccountService:   svc,
+		hooks:            serverOpts.Hooks,
+		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:       pathPrefix,
+		jsonSkipDefaults: jsonSkipDefaults,
+		jsonCamelCase:    jsonCamelCase,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *accountServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *accountServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
+
+// AccountServicePathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const AccountServicePathPrefix = "/twirp/go.escape.ship.proto.v1.AccountService/"
+
+func (s *accountServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AccountService")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "go.escape.ship.proto.v1.AccountService" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "GetKakaoLoginURL":
+		s.serveGetKakaoLoginURL(ctx, resp, req)
+		return
+	case "GetKakaoCallBack":
+		s.serveGetKakaoCallBack(ctx, resp, req)
+		return
+	case "Login":
+		s.serveLogin(ctx, resp, req)
+		return
+	case "Register":
+		s.serveRegister(ctx, resp, req)
+		return
+	case "RefreshToken":
+		s.serveRefreshToken(ctx, resp, req)
+		return
+	case "RevokeToken":
+		s.serveRevokeToken(ctx, resp, req)
+		return
+	case "AnonymizeUserData":
+		s.serveAnonymizeUserData(ctx, resp, req)
+		return
+	case "AcceptTerms":
+		s.serveAcceptTerms(ctx, resp, req)
+		return
+	case "RegisterPushToken":
+		s.serveRegisterPushToken(ctx, resp, req)
+		return
+	case "UnregisterPushToken":
+		s.serveUnregisterPushToken(ctx, resp, req)
+		return
+	case "VerifyCaptcha":
+		s.serveVerifyCaptcha(ctx, resp, req)
+		return
+	case "UnlockAccount":
+		s.serveUnlockAccount(ctx, resp, req)
+		return
+	case "IssueGuestToken":
+		s.serveIssueGuestToken(ctx, resp, req)
+		return
+	case "MergeAccounts":
+		s.serveMergeAccounts(ctx, resp, req)
+		return
+	case "RequestEmailChange":
+		s.serveRequestEmailChange(ctx, resp, req)
+		return
+	case "ConfirmEmailChange":
+		s.serveConfirmEmailChange(ctx, resp, req)
+		return
+	case "UploadAvatar":
+		s.serveUploadAvatar(ctx, resp, req)
+		return
+	case "GetPreferences":
+		s.serveGetPreferences(ctx, resp, req)
+		return
+	case "SetPreferences":
+		s.serveSetPreferences(ctx, resp, req)
+		return
+	case "GetMe":
+		s.serveGetMe(ctx, resp, req)
+		return
+	case "UpdateProfile":
+		s.serveUpdateProfile(ctx, resp, req)
+		return
+	case "ChangePassword":
+		s.serveChangePassword(ctx, resp, req)
+		return
+	case "DeleteAccount":
+		s.serveDeleteAccount(ctx, resp, req)
+		return
+	case "CreateAPIKey":
+		s.serveCreateAPIKey(ctx, resp, req)
+		return
+	case "RevokeAPIKey":
+		s.serveRevokeAPIKey(ctx, resp, req)
+		return
+	case "ValidateAPIKey":
+		s.serveValidateAPIKey(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *accountServiceServer) serveGetKakaoLoginURL(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetKakaoLoginURLJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetKakaoLoginURLProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *accountServiceServer) serveGetKakaoLoginURLJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetKakaoLoginURL")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetKakaoLoginURLRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.AccountService.GetKakaoLoginURL
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetKakaoLoginURLRequest) (*GetKakaoLoginURLResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetKakaoLoginURLRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetKakaoLoginURLRequest) when calling interceptor")
+					}
+					return s.AccountService.GetKakaoLoginURL(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetKakaoLoginURLResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetKakaoLoginURLResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetKakaoLoginURLResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetKakaoLoginURLResponse and nil error while calling GetKakaoLoginURL. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveGetKakaoLoginURLProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetKakaoLoginURL")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetKakaoLoginURLRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AccountService.GetKakaoLoginURL
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetKakaoLoginURLRequest) (*GetKakaoLoginURLResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetKakaoLoginURLRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetKakaoLoginURLRequest) when calling interceptor")
+					}
+					return s.AccountService.GetKakaoLoginURL(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetKakaoLoginURLResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetKakaoLoginURLResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetKakaoLoginURLResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetKakaoLoginURLResponse and nil error while calling GetKakaoLoginURL. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveGetKakaoCallBack(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetKakaoCallBackJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetKakaoCallBackProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *accountServiceServer) serveGetKakaoCallBackJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetKakaoCallBack")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetKakaoCallBackRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.AccountService.GetKakaoCallBack
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetKakaoCallBackRequest) (*GetKakaoCallBackResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetKakaoCallBackRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetKakaoCallBackRequest) when calling interceptor")
+					}
+					return s.AccountService.GetKakaoCallBack(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetKakaoCallBackResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetKakaoCallBackResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetKakaoCallBackResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetKakaoCallBackResponse and nil error while calling GetKakaoCallBack. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveGetKakaoCallBackProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetKakaoCallBack")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetKakaoCallBackRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AccountService.GetKakaoCallBack
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetKakaoCallBackRequest) (*GetKakaoCallBackResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetKakaoCallBackRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetKakaoCallBackRequest) when calling interceptor")
+					}
+					return s.AccountService.GetKakaoCallBack(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetKakaoCallBackResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetKakaoCallBackResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetKakaoCallBackResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetKakaoCallBackResponse and nil error while calling GetKakaoCallBack. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveLogin(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveLoginJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveLoginProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *accountServiceServer) serveLoginJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "Login")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(LoginRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.AccountService.Login
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *LoginRequest) (*LoginResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*LoginRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*LoginRequest) when calling interceptor")
+					}
+					return s.AccountService.Login(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*LoginResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*LoginResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *LoginResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *LoginResponse and nil error while calling Login. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveLoginProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "Login")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(LoginRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AccountService.Login
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *LoginRequest) (*LoginResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*LoginRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*LoginRequest) when calling interceptor")
+					}
+					return s.AccountService.Login(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*LoginResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*LoginResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *LoginResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *LoginResponse and nil error while calling Login. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveRegister(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveRegisterJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveRegisterProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *accountServiceServer) serveRegisterJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "Register")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(RegisterRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.AccountService.Register
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RegisterRequest) (*RegisterResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RegisterRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RegisterRequest) when calling interceptor")
+					}
+					return s.AccountService.Register(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RegisterResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RegisterResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RegisterResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RegisterResponse and nil error while calling Register. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveRegisterProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "Register")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(RegisterRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AccountService.Register
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RegisterRequest) (*RegisterResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RegisterRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RegisterRequest) when calling interceptor")
+					}
+					return s.AccountService.Register(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RegisterResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RegisterResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RegisterResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RegisterResponse and nil error while calling Register. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveRefreshToken(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveRefreshTokenJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveRefreshTokenProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *accountServiceServer) serveRefreshTokenJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RefreshToken")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(RefreshTokenRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.AccountService.RefreshToken
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RefreshTokenRequest) (*RefreshTokenResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RefreshTokenRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RefreshTokenRequest) when calling interceptor")
+					}
+					return s.AccountService.RefreshToken(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RefreshTokenResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RefreshTokenResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RefreshTokenResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RefreshTokenResponse and nil error while calling RefreshToken. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveRefreshTokenProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RefreshToken")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(RefreshTokenRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AccountService.RefreshToken
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RefreshTokenRequest) (*RefreshTokenResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RefreshTokenRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RefreshTokenRequest) when calling interceptor")
+					}
+					return s.AccountService.RefreshToken(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RefreshTokenResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RefreshTokenResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RefreshTokenResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RefreshTokenResponse and nil error while calling RefreshToken. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveRevokeToken(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveRevokeTokenJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveRevokeTokenProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *accountServiceServer) serveRevokeTokenJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RevokeToken")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(RevokeTokenRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.AccountService.RevokeToken
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RevokeTokenRequest) (*RevokeTokenResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RevokeTokenRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RevokeTokenRequest) when calling interceptor")
+					}
+					return s.AccountService.RevokeToken(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RevokeTokenResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RevokeTokenResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RevokeTokenResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RevokeTokenResponse and nil error while calling RevokeToken. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveRevokeTokenProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RevokeToken")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(RevokeTokenRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AccountService.RevokeToken
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RevokeTokenRequest) (*RevokeTokenResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RevokeTokenRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RevokeTokenRequest) when calling interceptor")
+					}
+					return s.AccountService.RevokeToken(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RevokeTokenResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RevokeTokenResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RevokeTokenResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RevokeTokenResponse and nil error while calling RevokeToken. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveAnonymizeUserData(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveAnonymizeUserDataJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveAnonymizeUserDataProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *accountServiceServer) serveAnonymizeUserDataJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "AnonymizeUserData")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(AnonymizeUserDataRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.AccountService.AnonymizeUserData
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *AnonymizeUserDataRequest) (*AnonymizeUserDataResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AnonymizeUserDataRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AnonymizeUserDataRequest) when calling interceptor")
+					}
+					return s.AccountService.AnonymizeUserData(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AnonymizeUserDataResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AnonymizeUserDataResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *AnonymizeUserDataResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AnonymizeUserDataResponse and nil error while calling AnonymizeUserData. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveAnonymizeUserDataProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "AnonymizeUserData")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(AnonymizeUserDataRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AccountService.AnonymizeUserData
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *AnonymizeUserDataRequest) (*AnonymizeUserDataResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AnonymizeUserDataRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AnonymizeUserDataRequest) when calling interceptor")
+					}
+					return s.AccountService.AnonymizeUserData(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AnonymizeUserDataResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AnonymizeUserDataResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *AnonymizeUserDataResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AnonymizeUserDataResponse and nil error while calling AnonymizeUserData. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveAcceptTerms(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveAcceptTermsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveAcceptTermsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *accountServiceServer) serveAcceptTermsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "AcceptTerms")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(AcceptTermsRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.AccountService.AcceptTerms
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *AcceptTermsRequest) (*AcceptTermsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AcceptTermsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AcceptTermsRequest) when calling interceptor")
+					}
+					return s.AccountService.AcceptTerms(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AcceptTermsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AcceptTermsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *AcceptTermsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AcceptTermsResponse and nil error while calling AcceptTerms. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveAcceptTermsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "AcceptTerms")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(AcceptTermsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AccountService.AcceptTerms
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *AcceptTermsRequest) (*AcceptTermsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AcceptTermsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AcceptTermsRequest) when calling interceptor")
+					}
+					return s.AccountService.AcceptTerms(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AcceptTermsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AcceptTermsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *AcceptTermsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AcceptTermsResponse and nil error while calling AcceptTerms. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveRegisterPushToken(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveRegisterPushTokenJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveRegisterPushTokenProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *accountServiceServer) serveRegisterPushTokenJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RegisterPushToken")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(RegisterPushTokenRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.AccountService.RegisterPushToken
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RegisterPushTokenRequest) (*RegisterPushTokenResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RegisterPushTokenRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RegisterPushTokenRequest) when calling interceptor")
+					}
+					return s.AccountService.RegisterPushToken(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RegisterPushTokenResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RegisterPushTokenResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RegisterPushTokenResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RegisterPushTokenResponse and nil error while calling RegisterPushToken. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveRegisterPushTokenProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RegisterPushToken")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(RegisterPushTokenRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AccountService.RegisterPushToken
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RegisterPushTokenRequest) (*RegisterPushTokenResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RegisterPushTokenRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RegisterPushTokenRequest) when calling interceptor")
+					}
+					return s.AccountService.RegisterPushToken(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RegisterPushTokenResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RegisterPushTokenResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RegisterPushTokenResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RegisterPushTokenResponse and nil error while calling RegisterPushToken. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveUnregisterPushToken(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveUnregisterPushTokenJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveUnregisterPushTokenProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *accountServiceServer) serveUnregisterPushTokenJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UnregisterPushToken")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(UnregisterPushTokenRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.AccountService.UnregisterPushToken
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UnregisterPushTokenRequest) (*UnregisterPushTokenResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UnregisterPushTokenRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UnregisterPushTokenRequest) when calling interceptor")
+					}
+					return s.AccountService.UnregisterPushToken(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UnregisterPushTokenResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UnregisterPushTokenResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UnregisterPushTokenResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UnregisterPushTokenResponse and nil error while calling UnregisterPushToken. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveUnregisterPushTokenProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UnregisterPushToken")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(UnregisterPushTokenRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AccountService.UnregisterPushToken
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UnregisterPushTokenRequest) (*UnregisterPushTokenResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UnregisterPushTokenRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UnregisterPushTokenRequest) when calling interceptor")
+					}
+					return s.AccountService.UnregisterPushToken(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UnregisterPushTokenResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UnregisterPushTokenResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UnregisterPushTokenResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UnregisterPushTokenResponse and nil error while calling UnregisterPushToken. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveVerifyCaptcha(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveVerifyCaptchaJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveVerifyCaptchaProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *accountServiceServer) serveVerifyCaptchaJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "VerifyCaptcha")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(VerifyCaptchaRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.AccountService.VerifyCaptcha
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *VerifyCaptchaRequest) (*VerifyCaptchaResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*VerifyCaptchaRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*VerifyCaptchaRequest) when calling interceptor")
+					}
+					return s.AccountService.VerifyCaptcha(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*VerifyCaptchaResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*VerifyCaptchaResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *VerifyCaptchaResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *VerifyCaptchaResponse and nil error while calling VerifyCaptcha. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveVerifyCaptchaProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "VerifyCaptcha")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(VerifyCaptchaRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AccountService.VerifyCaptcha
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *VerifyCaptchaRequest) (*VerifyCaptchaResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*VerifyCaptchaRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*VerifyCaptchaRequest) when calling interceptor")
+					}
+					return s.AccountService.VerifyCaptcha(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*VerifyCaptchaResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*VerifyCaptchaResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *VerifyCaptchaResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *VerifyCaptchaResponse and nil error while calling VerifyCaptcha. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveUnlockAccount(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveUnlockAccountJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveUnlockAccountProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *accountServiceServer) serveUnlockAccountJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UnlockAccount")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(UnlockAccountRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.AccountService.UnlockAccount
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UnlockAccountRequest) (*UnlockAccountResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UnlockAccountRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UnlockAccountRequest) when calling interceptor")
+					}
+					return s.AccountService.UnlockAccount(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UnlockAccountResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UnlockAccountResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UnlockAccountResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UnlockAccountResponse and nil error while calling UnlockAccount. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveUnlockAccountProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UnlockAccount")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(UnlockAccountRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AccountService.UnlockAccount
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UnlockAccountRequest) (*UnlockAccountResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UnlockAccountRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UnlockAccountRequest) when calling interceptor")
+					}
+					return s.AccountService.UnlockAccount(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UnlockAccountResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UnlockAccountResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UnlockAccountResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UnlockAccountResponse and nil error while calling UnlockAccount. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveIssueGuestToken(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveIssueGuestTokenJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveIssueGuestTokenProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *accountServiceServer) serveIssueGuestTokenJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "IssueGuestToken")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(IssueGuestTokenRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.AccountService.IssueGuestToken
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *IssueGuestTokenRequest) (*IssueGuestTokenResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*IssueGuestTokenRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*IssueGuestTokenRequest) when calling interceptor")
+					}
+					return s.AccountService.IssueGuestToken(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*IssueGuestTokenResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*IssueGuestTokenResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *IssueGuestTokenResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *IssueGuestTokenResponse and nil error while calling IssueGuestToken. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveIssueGuestTokenProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "IssueGuestToken")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(IssueGuestTokenRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AccountService.IssueGuestToken
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *IssueGuestTokenRequest) (*IssueGuestTokenResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*IssueGuestTokenRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*IssueGuestTokenRequest) when calling interceptor")
+					}
+					return s.AccountService.IssueGuestToken(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*IssueGuestTokenResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*IssueGuestTokenResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *IssueGuestTokenResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *IssueGuestTokenResponse and nil error while calling IssueGuestToken. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveMergeAccounts(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveMergeAccountsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveMergeAccountsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *accountServiceServer) serveMergeAccountsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "MergeAccounts")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(MergeAccountsRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.AccountService.MergeAccounts
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *MergeAccountsRequest) (*MergeAccountsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MergeAccountsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MergeAccountsRequest) when calling interceptor")
+					}
+					return s.AccountService.MergeAccounts(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*MergeAccountsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*MergeAccountsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *MergeAccountsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *MergeAccountsResponse and nil error while calling MergeAccounts. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveMergeAccountsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "MergeAccounts")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(MergeAccountsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AccountService.MergeAccounts
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *MergeAccountsRequest) (*MergeAccountsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MergeAccountsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MergeAccountsRequest) when calling interceptor")
+					}
+					return s.AccountService.MergeAccounts(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*MergeAccountsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*MergeAccountsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *MergeAccountsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *MergeAccountsResponse and nil error while calling MergeAccounts. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveRequestEmailChange(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveRequestEmailChangeJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveRequestEmailChangeProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *accountServiceServer) serveRequestEmailChangeJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RequestEmailChange")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(RequestEmailChangeRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.AccountService.RequestEmailChange
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RequestEmailChangeRequest) (*RequestEmailChangeResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RequestEmailChangeRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RequestEmailChangeRequest) when calling interceptor")
+					}
+					return s.AccountService.RequestEmailChange(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RequestEmailChangeResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RequestEmailChangeResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RequestEmailChangeResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RequestEmailChangeResponse and nil error while calling RequestEmailChange. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveRequestEmailChangeProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RequestEmailChange")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(RequestEmailChangeRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AccountService.RequestEmailChange
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RequestEmailChangeRequest) (*RequestEmailChangeResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RequestEmailChangeRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RequestEmailChangeRequest) when calling interceptor")
+					}
+					return s.AccountService.RequestEmailChange(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RequestEmailChangeResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RequestEmailChangeResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RequestEmailChangeResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RequestEmailChangeResponse and nil error while calling RequestEmailChange. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveConfirmEmailChange(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveConfirmEmailChangeJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveConfirmEmailChangeProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *accountServiceServer) serveConfirmEmailChangeJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ConfirmEmailChange")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ConfirmEmailChangeRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.AccountService.ConfirmEmailChange
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ConfirmEmailChangeRequest) (*ConfirmEmailChangeResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ConfirmEmailChangeRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ConfirmEmailChangeRequest) when calling interceptor")
+					}
+					return s.AccountService.ConfirmEmailChange(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ConfirmEmailChangeResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ConfirmEmailChangeResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ConfirmEmailChangeResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ConfirmEmailChangeResponse and nil error while calling ConfirmEmailChange. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveConfirmEmailChangeProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ConfirmEmailChange")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ConfirmEmailChangeRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AccountService.ConfirmEmailChange
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ConfirmEmailChangeRequest) (*ConfirmEmailChangeResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ConfirmEmailChangeRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ConfirmEmailChangeRequest) when calling interceptor")
+					}
+					return s.AccountService.ConfirmEmailChange(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ConfirmEmailChangeResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ConfirmEmailChangeResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ConfirmEmailChangeResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ConfirmEmailChangeResponse and nil error while calling ConfirmEmailChange. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveUploadAvatar(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveUploadAvatarJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveUploadAvatarProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *accountServiceServer) serveUploadAvatarJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UploadAvatar")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(UploadAvatarRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.AccountService.UploadAvatar
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UploadAvatarRequest) (*UploadAvatarResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UploadAvatarRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UploadAvatarRequest) when calling interceptor")
+					}
+					return s.AccountService.UploadAvatar(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UploadAvatarResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UploadAvatarResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UploadAvatarResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UploadAvatarResponse and nil error while calling UploadAvatar. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveUploadAvatarProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UploadAvatar")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(UploadAvatarRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AccountService.UploadAvatar
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UploadAvatarRequest) (*UploadAvatarResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UploadAvatarRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UploadAvatarRequest) when calling interceptor")
+					}
+					return s.AccountService.UploadAvatar(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UploadAvatarResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UploadAvatarResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UploadAvatarResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UploadAvatarResponse and nil error while calling UploadAvatar. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveGetPreferences(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetPreferencesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetPreferencesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *accountServiceServer) serveGetPreferencesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetPreferences")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetPreferencesRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.AccountService.GetPreferences
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetPreferencesRequest) (*GetPreferencesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetPreferencesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetPreferencesRequest) when calling interceptor")
+					}
+					return s.AccountService.GetPreferences(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetPreferencesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetPreferencesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetPreferencesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetPreferencesResponse and nil error while calling GetPreferences. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveGetPreferencesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetPreferences")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetPreferencesRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AccountService.GetPreferences
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetPreferencesRequest) (*GetPreferencesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetPreferencesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetPreferencesRequest) when calling interceptor")
+					}
+					return s.AccountService.GetPreferences(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetPreferencesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetPreferencesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetPreferencesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetPreferencesResponse and nil error while calling GetPreferences. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveSetPreferences(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSetPreferencesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSetPreferencesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *accountServiceServer) serveSetPreferencesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetPreferences")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(SetPreferencesRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.AccountService.SetPreferences
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SetPreferencesRequest) (*SetPreferencesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetPreferencesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetPreferencesRequest) when calling interceptor")
+					}
+					return s.AccountService.SetPreferences(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SetPreferencesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SetPreferencesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SetPreferencesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SetPreferencesResponse and nil error while calling SetPreferences. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveSetPreferencesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetPreferences")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(SetPreferencesRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AccountService.SetPreferences
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SetPreferencesRequest) (*SetPreferencesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetPreferencesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetPreferencesRequest) when calling interceptor")
+					}
+					return s.AccountService.SetPreferences(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SetPreferencesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SetPreferencesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SetPreferencesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SetPreferencesResponse and nil error while calling SetPreferences. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveGetMe(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetMeJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetMeProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *accountServiceServer) serveGetMeJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetMe")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetMeRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.AccountService.GetMe
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetMeRequest) (*GetMeResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetMeRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetMeRequest) when calling interceptor")
+					}
+					return s.AccountService.GetMe(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetMeResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetMeResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetMeResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetMeResponse and nil error while calling GetMe. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveGetMeProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetMe")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetMeRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AccountService.GetMe
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetMeRequest) (*GetMeResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetMeRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetMeRequest) when calling interceptor")
+					}
+					return s.AccountService.GetMe(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetMeResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetMeResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetMeResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetMeResponse and nil error while calling GetMe. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveUpdateProfile(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveUpdateProfileJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveUpdateProfileProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *accountServiceServer) serveUpdateProfileJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateProfile")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(UpdateProfileRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.AccountService.UpdateProfile
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateProfileRequest) (*UpdateProfileResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateProfileRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateProfileRequest) when calling interceptor")
+					}
+					return s.AccountService.UpdateProfile(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateProfileResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateProfileResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UpdateProfileResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpdateProfileResponse and nil error while calling UpdateProfile. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveUpdateProfileProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateProfile")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(UpdateProfileRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AccountService.UpdateProfile
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateProfileRequest) (*UpdateProfileResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateProfileRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateProfileRequest) when calling interceptor")
+					}
+					return s.AccountService.UpdateProfile(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateProfileResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateProfileResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UpdateProfileResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpdateProfileResponse and nil error while calling UpdateProfile. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveChangePassword(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveChangePasswordJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveChangePasswordProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *accountServiceServer) serveChangePasswordJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ChangePassword")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ChangePasswordRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.AccountService.ChangePassword
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ChangePasswordRequest) (*ChangePasswordResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ChangePasswordRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ChangePasswordRequest) when calling interceptor")
+					}
+					return s.AccountService.ChangePassword(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ChangePasswordResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ChangePasswordResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ChangePasswordResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ChangePasswordResponse and nil error while calling ChangePassword. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveChangePasswordProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ChangePassword")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ChangePasswordRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AccountService.ChangePassword
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ChangePasswordRequest) (*ChangePasswordResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ChangePasswordRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ChangePasswordRequest) when calling interceptor")
+					}
+					return s.AccountService.ChangePassword(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ChangePasswordResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ChangePasswordResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ChangePasswordResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ChangePasswordResponse and nil error while calling ChangePassword. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveDeleteAccount(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveDeleteAccountJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveDeleteAccountProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *accountServiceServer) serveDeleteAccountJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteAccount")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(DeleteAccountRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.AccountService.DeleteAccount
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DeleteAccountRequest) (*DeleteAccountResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteAccountRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteAccountRequest) when calling interceptor")
+					}
+					return s.AccountService.DeleteAccount(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteAccountResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteAccountResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *DeleteAccountResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DeleteAccountResponse and nil error while calling DeleteAccount. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveDeleteAccountProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteAccount")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(DeleteAccountRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AccountService.DeleteAccount
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DeleteAccountRequest) (*DeleteAccountResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteAccountRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteAccountRequest) when calling interceptor")
+					}
+					return s.AccountService.DeleteAccount(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteAccountResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteAccountResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *DeleteAccountResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DeleteAccountResponse and nil error while calling DeleteAccount. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveCreateAPIKey(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveCreateAPIKeyJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveCreateAPIKeyProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *accountServiceServer) serveCreateAPIKeyJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateAPIKey")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(CreateAPIKeyRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.AccountService.CreateAPIKey
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateAPIKeyRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateAPIKeyRequest) when calling interceptor")
+					}
+					return s.AccountService.CreateAPIKey(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateAPIKeyResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateAPIKeyResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreateAPIKeyResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateAPIKeyResponse and nil error while calling CreateAPIKey. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveCreateAPIKeyProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateAPIKey")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(CreateAPIKeyRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AccountService.CreateAPIKey
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateAPIKeyRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateAPIKeyRequest) when calling interceptor")
+					}
+					return s.AccountService.CreateAPIKey(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateAPIKeyResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateAPIKeyResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreateAPIKeyResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateAPIKeyResponse and nil error while calling CreateAPIKey. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveRevokeAPIKey(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveRevokeAPIKeyJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveRevokeAPIKeyProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *accountServiceServer) serveRevokeAPIKeyJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RevokeAPIKey")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(RevokeAPIKeyRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.AccountService.RevokeAPIKey
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RevokeAPIKeyRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RevokeAPIKeyRequest) when calling interceptor")
+					}
+					return s.AccountService.RevokeAPIKey(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RevokeAPIKeyResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RevokeAPIKeyResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RevokeAPIKeyResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RevokeAPIKeyResponse and nil error while calling RevokeAPIKey. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveRevokeAPIKeyProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RevokeAPIKey")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(RevokeAPIKeyRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AccountService.RevokeAPIKey
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RevokeAPIKeyRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RevokeAPIKeyRequest) when calling interceptor")
+					}
+					return s.AccountService.RevokeAPIKey(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RevokeAPIKeyResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RevokeAPIKeyResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RevokeAPIKeyResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RevokeAPIKeyResponse and nil error while calling RevokeAPIKey. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveValidateAPIKey(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveValidateAPIKeyJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveValidateAPIKeyProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *accountServiceServer) serveValidateAPIKeyJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ValidateAPIKey")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ValidateAPIKeyRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.AccountService.ValidateAPIKey
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ValidateAPIKeyRequest) (*ValidateAPIKeyResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ValidateAPIKeyRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ValidateAPIKeyRequest) when calling interceptor")
+					}
+					return s.AccountService.ValidateAPIKey(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ValidateAPIKeyResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ValidateAPIKeyResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ValidateAPIKeyResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ValidateAPIKeyResponse and nil error while calling ValidateAPIKey. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) serveValidateAPIKeyProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ValidateAPIKey")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ValidateAPIKeyRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AccountService.ValidateAPIKey
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ValidateAPIKeyRequest) (*ValidateAPIKeyResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ValidateAPIKeyRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ValidateAPIKeyRequest) when calling interceptor")
+					}
+					return s.AccountService.ValidateAPIKey(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ValidateAPIKeyResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ValidateAPIKeyResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ValidateAPIKeyResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ValidateAPIKeyResponse and nil error while calling ValidateAPIKey. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *accountServiceServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor0, 0
+}
+
+func (s *accountServiceServer) ProtocGenTwirpVersion() string {
+	return "v8.1.3"
+}
+
+// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
+// that is everything in a Twirp route except for the <Method>. This can be used for routing,
+// for example to identify the requests that are targeted to this service in a mux.
+func (s *accountServiceServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "go.escape.ship.proto.v1", "AccountService")
+}
+
+// =====
+// Utils
+// =====
+
+// HTTPClient is the interface used by generated clients to send HTTP requests.
+// It is fulfilled by *(net/http).Client, which is sufficient for most users.
+// Users can provide their own implementation for special retry policies.
+//
+// HTTPClient implementations should not follow redirects. Redirects are
+// automatically disabled if *(net/http).Client is passed to client
+// constructors. See the withoutRedirects function in this file for more
+// details.
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// TwirpServer is the interface generated server structs will support: they're
+// HTTP handlers with additional methods for accessing metadata about the
+// service. Those accessors are a low-level API for building reflection tools.
+// Most people can think of TwirpServers as just http.Handlers.
+type TwirpServer interface {
+	http.Handler
+
+	// ServiceDescriptor returns gzipped bytes describing the .proto file that
+	// this service was generated from. Once unzipped, the bytes can be
+	// unmarshalled as a
+	// google.golang.org/protobuf/types/descriptorpb.FileDescriptorProto.
+	//
+	// The returned integer is the index of this particular service within that
+	// FileDescriptorProto's 'Service' slice of ServiceDescriptorProtos. This is a
+	// low-level field, expected to be used for reflection.
+	ServiceDescriptor() ([]byte, int)
+
+	// ProtocGenTwirpVersion is the semantic version string of the version of
+	// twirp used to generate this file.
+	ProtocGenTwirpVersion() string
+
+	// PathPrefix returns the HTTP URL path prefix for all methods handled by this
+	// service. This can be used with an HTTP mux to route Twirp requests.
+	// The path prefix is in the form: "/<prefix>/<package>.<Service>/"
+	// that is, everything in a Twirp route except for the <Method> at the end.
+	PathPrefix() string
+}
+
+func newServerOpts(opts []interface{}) *twirp.ServerOptions {
+	serverOpts := &twirp.ServerOptions{}
+	for _, opt := range opts {
+		switch o := opt.(type) {
+		case twirp.ServerOption:
+			o(serverOpts)
+		case *twirp.ServerHooks: // backwards compatibility, allow to specify hooks as an argument
+			twirp.WithServerHooks(o)(serverOpts)
+		case nil: // backwards compatibility, allow nil value for the argument
+			continue
+		default:
+			panic(fmt.Sprintf("Invalid option type %T, please use a twirp.ServerOption", o))
+		}
+	}
+	return serverOpts
+}
+
+// WriteError writes an HTTP response with a valid Twirp error format (code, msg, meta).
+// Useful outside of the Twirp server (e.g. http middleware), but does not trigger hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func WriteError(resp http.ResponseWriter, err error) {
+	writeError(context.Background(), resp, err, nil)
+}
+
+// writeError writes Twirp errors in the response and triggers hooks.
+func writeError(ctx context.Context, resp http.ResponseWriter, err error, hooks *twirp.ServerHooks) {
+	// Convert to a twirp.Error. Non-twirp errors are converted to internal errors.
+	var twerr twirp.Error
+	if !errors.As(err, &twerr) {
+		twerr = twirp.InternalErrorWith(err)
+	}
+
+	statusCode := twirp.ServerHTTPStatusFromErrorCode(twerr.Code())
+	ctx = ctxsetters.WithStatusCode(ctx, statusCode)
+	ctx = callError(ctx, hooks, twerr)
+
+	respBody := marshalErrorToJSON(twerr)
+
+	resp.Header().Set("Content-Type", "application/json") // Error responses are always JSON
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBody)))
+	resp.WriteHeader(statusCode) // set HTTP status code and send response
+
+	_, writeErr := resp.Write(respBody)
+	if writeErr != nil {
+		// We have three options here. We could log the error, call the Error
+		// hook, or just silently ignore the error.
+		//
+		// Logging is unacceptable because we don't have a user-controlled
+		// logger; writing out to stderr without permission is too rude.
+		//
+		// Calling the Error hook would confuse users: it would mean the Error
+		// hook got called twice for one request, which is likely to lead to
+		// duplicated log messages and metrics, no matter how well we document
+		// the behavior.
+		//
+		// Silently ignoring the error is our least-bad option. It's highly
+		// likely that the connection is broken and the original 'err' says
+		// so anyway.
+		_ = writeErr
+	}
+
+	callResponseSent(ctx, hooks)
+}
+
+// sanitizeBaseURL parses the the baseURL, and adds the "http" scheme if needed.
+// If the URL is unparsable, the baseURL is returned unchanged.
+func sanitizeBaseURL(baseURL string) string {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return baseURL // invalid URL will fail later when making requests
+	}
+	if u.Scheme == "" {
+		u.Scheme = "http"
+	}
+	return u.String()
+}
+
+// baseServicePath composes the path prefix for the service (without <Method>).
+// e.g.: baseServicePath("/twirp", "my.pkg", "MyService")
+//
+//	returns => "/twirp/my.pkg.MyService/"
+//
+// e.g.: baseServicePath("", "", "MyService")
+//
+//	returns => "/MyService/"
+func baseServicePath(prefix, pkg, service string) string {
+	fullServiceName := service
+	if pkg != "" {
+		fullServiceName = pkg + "." + service
+	}
+	return path.Join("/", prefix, fullServiceName) + "/"
+}
+
+// parseTwirpPath extracts path components form a valid Twirp route.
+// Expected format: "[<prefix>]/<package>.<Service>/<Method>"
+// e.g.: prefix, pkgService, method := parseTwirpPath("/twirp/pkg.Svc/MakeHat")
+func parseTwirpPath(path string) (string, string, string) {
+	parts := strings.Split(path, "/")
+	if len(parts) < 2 {
+		return "", "", ""
+	}
+	method := parts[len(parts)-1]
+	pkgService := parts[len(parts)-2]
+	prefix := strings.Join(parts[0:len(parts)-2], "/")
+	return prefix, pkgService, method
+}
+
+// getCustomHTTPReqHeaders retrieves a copy of any headers that are set in
+// a context through the twirp.WithHTTPRequestHeaders function.
+// If there are no headers set, or if they have the wrong type, nil is returned.
+func getCustomHTTPReqHeaders(ctx context.Context) http.Header {
+	header, ok := twirp.HTTPRequestHeaders(ctx)
+	if !ok || header == nil {
+		return nil
+	}
+	copied := make(http.Header)
+	for k, vv := range header {
+		if vv == nil {
+			copied[k] = nil
+			continue
+		}
+		copied[k] = make([]string, len(vv))
+		copy(copied[k], vv)
+	}
+	return copied
+}
+
+// newRequest makes an http.Request from a client, adding common headers.
+func newRequest(ctx context.Context, url string, reqBody io.Reader, contentType string) (*http.Request, error) {
+	req, err := http.NewRequest("POST", url, reqBody)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if customHeader := getCustomHTTPReqHeaders(ctx); customHeader != nil {
+		req.Header = customHeader
+	}
+	req.Header.Set("Accept", contentType)
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Twirp-Version", "v8.1.3")
+	return req, nil
+}
+
+// JSON serialization for errors
+type twerrJSON struct {
+	Code string            `json:"code"`
+	Msg  string            `json:"msg"`
+	Meta map[string]string `json:"meta,omitempty"`
+}
+
+// marshalErrorToJSON returns JSON from a twirp.Error, that can be used as HTTP error response body.
+// If serialization fails, it will use a descriptive Internal error instead.
+func marshalErrorToJSON(twerr twirp.Error) []byte {
+	// make sure that msg is not too large
+	msg := twerr.Msg()
+	if len(msg) > 1e6 {
+		msg = msg[:1e6]
+	}
+
+	tj := twerrJSON{
+		Code: string(twerr.Code()),
+		Msg:  msg,
+		Meta: twerr.MetaMap(),
+	}
+
+	buf, err := json.Marshal(&tj)
+	if err != nil {
+		buf = []byte("{\"type\": \"" + twirp.Internal + "\", \"msg\": \"There was an error but it could not be serialized into JSON\"}") // fallback
+	}
+
+	return buf
+}
+
+// errorFromResponse builds a twirp.Error from a non-200 HTTP response.
+// If the response has a valid serialized Twirp error, then it's returned.
+// If not, the response status code is used to generate a similar twirp
+// error. See twirpErrorFromIntermediary for more info on intermediary errors.
+func errorFromResponse(resp *http.Response) twirp.Error {
+	statusCode := resp.StatusCode
+	statusText := http.StatusText(statusCode)
+
+	if isHTTPRedirect(statusCode) {
+		// Unexpected redirect: it must be an error from an intermediary.
+		// Twirp clients don't follow redirects automatically, Twirp only handles
+		// POST requests, redirects should only happen on GET and HEAD requests.
+		location := resp.Header.Get("Location")
+		msg := fmt.Sprintf("unexpected HTTP status code %d %q received, Location=%q", statusCode, statusText, location)
+		return twirpErrorFromIntermediary(statusCode, msg, location)
+	}
+
+	respBodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return wrapInternal(err, "failed to read server error response body")
+	}
+
+	var tj twerrJSON
+	dec := json.NewDecoder(bytes.NewReader(respBodyBytes))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&tj); err != nil || tj.Code == "" {
+		// Invalid JSON response; it must be an error from an intermediary.
+		msg := fmt.Sprintf("Error from intermediary with HTTP status code %d %q", statusCode, statusText)
+		return twirpErrorFromIntermediary(statusCode, msg, string(respBodyBytes))
+	}
+
+	errorCode := twirp.ErrorCode(tj.Code)
+	if !twirp.IsValidErrorCode(errorCode) {
+		msg := "invalid type returned from server error response: " + tj.Code
+		return twirp.InternalError(msg).WithMeta("body", string(respBodyBytes))
+	}
+
+	twerr := twirp.NewError(errorCode, tj.Msg)
+	for k, v := range tj.Meta {
+		twerr = twerr.WithMeta(k, v)
+	}
+	return twerr
+}
+
+// twirpErrorFromIntermediary maps HTTP errors from non-twirp sources to twirp errors.
+// The mapping is similar to gRPC: https://github.com/grpc/grpc/blob/master/doc/http-grpc-status-mapping.md.
+// Returned twirp Errors have some additional metadata for inspection.
+func twirpErrorFromIntermediary(status int, msg string, bodyOrLocation string) twirp.Error {
+	var code twirp.ErrorCode
+	if isHTTPRedirect(status) { // 3xx
+		code = twirp.Internal
+	} else {
+		switch status {
+		case 400: // Bad Request
+			code = twirp.Internal
+		case 401: // Unauthorized
+			code = twirp.Unauthenticated
+		case 403: // Forbidden
+			code = twirp.PermissionDenied
+		case 404: // Not Found
+			code = twirp.BadRoute
+		case 429: // Too Many Requests
+			code = twirp.ResourceExhausted
+		case 502, 503, 504: // Bad Gateway, Service Unavailable, Gateway Timeout
+			code = twirp.Unavailable
+		default: // All other codes
+			code = twirp.Unknown
+		}
+	}
+
+	twerr := twirp.NewError(code, msg)
+	twerr = twerr.WithMeta("http_error_from_intermediary", "true") // to easily know if this error was from intermediary
+	twerr = twerr.WithMeta("status_code", strconv.Itoa(status))
+	if isHTTPRedirect(status) {
+		twerr = twerr.WithMeta("location", bodyOrLocation)
+	} else {
+		twerr = twerr.WithMeta("body", bodyOrLocation)
+	}
+	return twerr
+}
+
+func isHTTPRedirect(status int) bool {
+	return status >= 300 && status <= 399
+}
+
+// wrapInternal wraps an error with a prefix as an Internal error.
+// The original error cause is accessible by github.com/pkg/errors.Cause.
+func wrapInternal(err error, prefix string) twirp.Error {
+	return twirp.InternalErrorWith(&wrappedError{prefix: prefix, cause: err})
+}
+
+type wrappedError struct {
+	prefix string
+	cause  error
+}
+
+func (e *wrappedError) Error() string { return e.prefix + ": " + e.cause.Error() }
+func (e *wrappedError) Unwrap() error { return e.cause } // for go1.13 + errors.Is/As
+func (e *wrappedError) Cause() error  { return e.cause } // for github.com/pkg/errors
+
+// ensurePanicResponses makes sure that rpc methods causing a panic still result in a Twirp Internal
+// error response (status 500), and error hooks are properly called with the panic wrapped as an error.
+// The panic is re-raised so it can be handled normally with middleware.
+func ensurePanicResponses(ctx context.Context, resp http.ResponseWriter, hooks *twirp.ServerHooks) {
+	if r := recover(); r != nil {
+		// Wrap the panic as an error so it can be passed to error hooks.
+		// The original error is accessible from error hooks, but not visible in the response.
+		err := errFromPanic(r)
+		twerr := &internalWithCause{msg: "Internal service panic", cause: err}
+		// Actually write the error
+		writeError(ctx, resp, twerr, hooks)
+		// If possible, flush the error to the wire.
+		f, ok := resp.(http.Flusher)
+		if ok {
+			f.Flush()
+		}
+
+		panic(r)
+	}
+}
+
+// errFromPanic returns the typed error if the recovered panic is an error, otherwise formats as error.
+func errFromPanic(p interface{}) error {
+	if err, ok := p.(error); ok {
+		return err
+	}
+	return fmt.Errorf("panic: %v", p)
+}
+
+// internalWithCause is a Twirp Internal error wrapping an original error cause,
+// but the original error message is not exposed on Msg(). The original error
+// can be checked with go1.13+ errors.Is/As, and also by (github.com/pkg/errors).Unwrap
+type internalWithCause struct {
+	msg   string
+	cause error
+}
+
+func (e *internalWithCause) Unwrap() error                               { return e.cause } // for go1.13 + errors.Is/As
+func (e *internalWithCause) Cause() error                                { return e.cause } // for github.com/pkg/errors
+func (e *internalWithCause) Error() string                               { return e.msg + ": " + e.cause.Error() }
+func (e *internalWithCause) Code() twirp.ErrorCode                       { return twirp.Internal }
+func (e *internalWithCause) Msg() string                                 { return e.msg }
+func (e *internalWithCause) Meta(key string) string                      { return "" }
+func (e *internalWithCause) MetaMap() map[string]string                  { return nil }
+func (e *internalWithCause) WithMeta(key string, val string) twirp.Error { return e }
+
+// malformedRequestError is used when the twirp server cannot unmarshal a request
+func malformedRequestError(msg string) twirp.Error {
+	return twirp.NewError(twirp.Malformed, msg)
+}
+
+// badRouteError is used when the twirp server cannot route a request
+func badRouteError(msg string, method, url string) twirp.Error {
+	err := twirp.NewError(twirp.BadRoute, msg)
+	err = err.WithMeta("twirp_invalid_route", method+" "+url)
+	return err
+}
+
+// withoutRedirects makes sure that the POST request can not be redirected.
+// The standard library will, by default, redirect requests (including POSTs) if it gets a 302 or
+// 303 response, and also 301s in go1.8. It redirects by making a second request, changing the
+// method to GET and removing the body. This produces very confusing error messages, so instead we
+// set a redirect policy that always errors. This stops Go from executing the redirect.
+//
+// We have to be a little careful in case the user-provided http.Client has its own CheckRedirect
+// policy - if so, we'll run through that policy first.
+//
+// Because this requires modifying the http.Client, we make a new copy of the client and return it.
+func withoutRedirects(in *http.Client) *http.Client {
+	copy := *in
+	copy.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if in.CheckRedirect != nil {
+			// Run the input's redirect if it exists, in case it has side effects, but ignore any error it
+			// returns, since we want to use ErrUseLastResponse.
+			err := in.CheckRedirect(req, via)
+			_ = err // Silly, but this makes sure generated code passes errcheck -blank, which some people use.
+		}
+		return http.ErrUseLastResponse
+	}
+	return &copy
+}
+
+// doProtobufRequest makes a Protobuf request to the remote Twirp service.
+func doProtobufRequest(ctx context.Context, client HTTPClient, hooks *twirp.ClientHooks, url string, in, out proto.Message) (_ context.Context, err error) {
+	reqBodyBytes, err := proto.Marshal(in)
+	if err != nil {
+		return ctx, wrapInternal(err, "failed to marshal proto request")
+	}
+	reqBody := bytes.NewBuffer(reqBodyBytes)
+	if err = ctx.Err(); err != nil {
+		return ctx, wrapInternal(err, "aborted because context was done")
+	}
+
+	req, err := newRequest(ctx, url, reqBody, "application/protobuf")
+	if err != nil {
+		return ctx, wrapInternal(err, "could not build request")
+	}
+	ctx, err = callClientRequestPrepared(ctx, hooks, req)
+	if err != nil {
+		return ctx, err
+	}
+
+	req = req.WithContext(ctx)
+	resp, err := client.Do(req)
+	if err != nil {
+		return ctx, wrapInternal(err, "failed to do request")
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if err = ctx.Err(); err != nil {
+		return ctx, wrapInternal(err, "aborted because context was done")
+	}
+
+	if resp.StatusCode != 200 {
+		return ctx, errorFromResponse(resp)
+	}
+
+	respBodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return ctx, wrapInternal(err, "failed to read response body")
+	}
+	if err = ctx.Err(); err != nil {
+		return ctx, wrapInternal(err, "aborted because context was done")
+	}
+
+	if err = proto.Unmarshal(respBodyBytes, out); err != nil {
+		return ctx, wrapInternal(err, "failed to unmarshal proto response")
+	}
+	return ctx, nil
+}
+
+// doJSONRequest makes a JSON request to the remote Twirp service.
+func doJSONRequest(ctx context.Context, client HTTPClient, hooks *twirp.ClientHooks, url string, in, out proto.Message) (_ context.Context, err error) {
+	marshaler := &protojson.MarshalOptions{UseProtoNames: true}
+	reqBytes, err := marshaler.Marshal(in)
+	if err != nil {
+		return ctx, wrapInternal(err, "failed to marshal json request")
+	}
+	if err = ctx.Err(); err != nil {
+		return ctx, wrapInternal(err, "aborted because context was done")
+	}
+
+	req, err := newRequest(ctx, url, bytes.NewReader(reqBytes), "application/json")
+	if err != nil {
+		return ctx, wrapInternal(err, "could not build request")
+	}
+	ctx, err = callClientRequestPrepared(ctx, hooks, req)
+	if err != nil {
+		return ctx, err
+	}
+
+	req = req.WithContext(ctx)
+	resp, err := client.Do(req)
+	if err != nil {
+		return ctx, wrapInternal(err, "failed to do request")
+	}
+
+	defer func() {
+		cerr := resp.Body.Close()
+		if err == nil && cerr != nil {
+			err = wrapInternal(cerr, "failed to close response body")
+		}
+	}()
+
+	if err = ctx.Err(); err != nil {
+		return ctx, wrapInternal(err, "aborted because context was done")
+	}
+
+	if resp.StatusCode != 200 {
+		return ctx, errorFromResponse(resp)
+	}
+
+	d := json.NewDecoder(resp.Body)
+	rawRespBody := json.RawMessage{}
+	if err := d.Decode(&rawRespBody); err != nil {
+		return ctx, wrapInternal(err, "failed to unmarshal json response")
+	}
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawRespBody, out); err != nil {
+		return ctx, wrapInternal(err, "failed to unmarshal json response")
+	}
+	if err = ctx.Err(); err != nil {
+		return ctx, wrapInternal(err, "aborted because context was done")
+	}
+	return ctx, nil
+}
+
+// Call twirp.ServerHooks.RequestReceived if the hook is available
+func callRequestReceived(ctx context.Context, h *twirp.ServerHooks) (context.Context, error) {
+	if h == nil || h.RequestReceived == nil {
+		return ctx, nil
+	}
+	return h.RequestReceived(ctx)
+}
+
+// Call twirp.ServerHooks.RequestRouted if the hook is available
+func callRequestRouted(ctx context.Context, h *twirp.ServerHooks) (context.Context, error) {
+	if h == nil || h.RequestRouted == nil {
+		return ctx, nil
+	}
+	return h.RequestRouted(ctx)
+}
+
+// Call twirp.ServerHooks.ResponsePrepared if the hook is available
+func callResponsePrepared(ctx context.Context, h *twirp.ServerHooks) context.Context {
+	if h == nil || h.ResponsePrepared == nil {
+		return ctx
+	}
+	return h.ResponsePrepared(ctx)
+}
+
+// Call twirp.ServerHooks.ResponseSent if the hook is available
+func callResponseSent(ctx context.Context, h *twirp.ServerHooks) {
+	if h == nil || h.ResponseSent == nil {
+		return
+	}
+	h.ResponseSent(ctx)
+}
+
+// Call twirp.ServerHooks.Error if the hook is available
+func callError(ctx context.Context, h *twirp.ServerHooks, err twirp.Error) context.Context {
+	if h == nil || h.Error == nil {
+		return ctx
+	}
+	return h.Error(ctx, err)
+}
+
+func callClientResponseReceived(ctx context.Context, h *twirp.ClientHooks) {
+	if h == nil || h.ResponseReceived == nil {
+		return
+	}
+	h.ResponseReceived(ctx)
+}
+
+func callClientRequestPrepared(ctx context.Context, h *twirp.ClientHooks, req *http.Request) (context.Context, error) {
+	if h == nil || h.RequestPrepared == nil {
+		return ctx, nil
+	}
+	return h.RequestPrepared(ctx, req)
+}
+
+func callClientError(ctx context.Context, h *twirp.ClientHooks, err twirp.Error) {
+	if h == nil || h.Error == nil {
+		return
+	}
+	h.Error(ctx, err)
+}
+
+var twirpFileDescriptor0 = []byte{
+	// 3190 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0x5f, 0x6f, 0x1b, 0xc7,
+	0x11, 0xcf, 0x51, 0xa6, 0x2c, 0x0d, 0x29, 0x8a, 0x5a, 0x89, 0xfa, 0x73, 0x8e, 0x63, 0xfb, 0xe2,
+	0xb8, 0x8e, 0x63, 0x93, 0x8e, 0x6c, 0xa0, 0x6e, 0x0a, 0xb4, 0xa5, 0x25, 0x5a, 0x66, 0x6c, 0x59,
+	0xea, 0x49, 0x72, 0xd0, 0x3f, 0xc0, 0x61, 0x7d, 0x5c, 0x91, 0x57, 0x92, 0x77, 0x97, 0xbb, 0xa5,
+	0x1c, 0x26, 0x0d, 0xd0, 0xa6, 0x2d, 0x0a, 0xa4, 0x68, 0xd1, 0xd6, 0x4f, 0x2d, 0x0a, 0x24, 0x45,
+	0xfb, 0xd4, 0xd7, 0x02, 0x7d, 0x2d, 0xd0, 0xaf, 0x50, 0xa0, 0x9f, 0xa0, 0x6f, 0x45, 0x3f, 0x43,
+	0x8b, 0xfd, 0x73, 0xc7, 0xbb, 0xe3, 0x1d, 0x4d, 0xc5, 0xce, 0x1b, 0x77, 0x66, 0x76, 0xe7, 0x37,
+	0xb3, 0xb3, 0xb3, 0x73, 0xb3, 0x84, 0x05, 0x6c, 0x9a, 0xce, 0xc0, 0xa6, 0x55, 0xd7, 0x73, 0xa8,
+	0x83, 0xd6, 0xda, 0x4e, 0x95, 0xf8, 0x26, 0x76, 0x49, 0xd5, 0xef, 0x58, 0xae, 0xa0, 0x56, 0x4f,
+	0xde, 0x56, 0x8b, 0xa6, 0xd3, 0xef, 0x3b, 0xb6, 0x20, 0xa8, 0xaf, 0xb6, 0x1d, 0xa7, 0xdd, 0x23,
+	0x35, 0xec, 0x5a, 0x35, 0x6c, 0xdb, 0x0e, 0xc5, 0xd4, 0x72, 0x6c, 0x5f, 0x72, 0x2f, 0x4a, 0x2e,
+	0x1f, 0x3d, 0x19, 0x1c, 0xd7, 0x8e, 0x2d, 0xd2, 0x6b, 0x19, 0x7d, 0xec, 0x77, 0xa5, 0x44, 0x89,
+	0x2d, 0xee, 0x5a, 0x76, 0x5b, 0x8c, 0xb5, 0x0d, 0x58, 0xdb, 0x21, 0xf4, 0x01, 0xee, 0x62, 0xe7,
+	0xa1, 0xd3, 0xb6, 0xec, 0x23, 0xfd, 0xa1, 0x4e, 0xde, 0x1f, 0x10, 0x9f, 0x6a, 0x5f, 0x85, 0xf5,
+	0x71, 0x96, 0xef, 0x3a, 0xb6, 0x4f, 0xd0, 0x39, 0x98, 0xef, 0x31, 0x9a, 0x31, 0xf0, 0x7a, 0xeb,
+	0xca, 0x45, 0xe5, 0xea, 0xbc, 0x3e, 0xc7, 0x09, 0x47, 0x5e, 0x4f, 0xdb, 0x1c, 0xad, 0xb9, 0x85,
+	0x7b, 0xbd, 0xbb, 0xd8, 0xec, 0xca, 0x35, 0xd1, 0x1a, 0x9c, 0x31, 0x9d, 0x16, 0x11, 0x53, 0xee,
+	0xce, 0xfc, 0x48, 0x51, 0x74, 0x4e, 0xd0, 0xfe, 0xa2, 0xc0, 0xfa, 0xf8, 0x24, 0xa9, 0xed, 0x0a,
+	0x14, 0xb1, 0x69, 0x12, 0xdf, 0x37, 0xa8, 0xd3, 0x25, 0x76, 0x74, 0x76, 0x41, 0x30, 0x0e, 0x19,
+	0x1d, 0x5d, 0x85, 0x05, 0x8f, 0x1c, 0x7b, 0xc4, 0xef, 0x48, 0xc1, 0xdc, 0x48, 0xb0, 0x28, 0x39,
+	0x42, 0xf2, 0x32, 0x94, 0x06, 0x3e, 0xf1, 0x0c, 0xcb, 0x3e, 0x76, 0x8c, 0x1f, 0xf8, 0x8e, 0xbd,
+	0x3e, 0xc3, 0x8d, 0x28, 0x32, 0x6a, 0xd3, 0x3e, 0x76, 0xde, 0xf5, 0x1d, 0x1b, 0xad, 0xc2, 0xac,
+	0x6f, 0x3a, 0x2e, 0xf1, 0xd7, 0xcf, 0x5c, 0x9c, 0xb9, 0x3a, 0xaf, 0xcb, 0x91, 0xf6, 0x57, 0x05,
+	0x8a, 0xdc, 0x25, 0x81, 0x59, 0x2b, 0x90, 0x27, 0x7d, 0x6c, 0x05, 0xae, 0x10, 0x03, 0x74, 0x01,
+	0xe6, 0x5c, 0xec, 0xfb, 0x4f, 0x1d, 0xaf, 0x15, 0x45, 0x12, 0x12, 0xd1, 0x5d, 0x98, 0x6d, 0x91,
+	0x13, 0xcb, 0x24, 0x5c, 0x7b, 0x61, 0xf3, 0x5a, 0x35, 0x23, 0x08, 0xaa, 0xdb, 0x5c, 0xec, 0x9e,
+	0x65, 0xb7, 0x89, 0xe7, 0x7a, 0x96, 0x4d, 0x75, 0x39, 0x93, 0xd9, 0x6c, 0x62, 0x97, 0x9a, 0x1d,
+	0x2c, 0x6d, 0x3e, 0x13, 0xb1, 0x59, 0x72, 0xb8, 0xcd, 0xda, 0x7f, 0x14, 0x58, 0x90, 0xa8, 0xbf,
+	0x34, 0xbf, 0xde, 0x86, 0x55, 0x8f, 0xbc, 0x3f, 0xb0, 0x3c, 0xd2, 0x32, 0x28, 0xf1, 0xfa, 0xbe,
+	0x71, 0x42, 0x3c, 0xdf, 0x0a, 0xfd, 0xbb, 0x12, 0x70, 0x0f, 0x19, 0xf3, 0xb1, 0xe0, 0xa1, 0x77,
+	0x60, 0x43, 0x08, 0x33, 0xa5, 0x2e, 0xc5, 0xb6, 0x49, 0x8c, 0x40, 0x90, 0xdb, 0x33, 0xa7, 0xaf,
+	0x71, 0x81, 0x7a, 0xc8, 0xd7, 0x25, 0x3b, 0xb2, 0x47, 0xf9, 0xd8, 0x1e, 0x79, 0xb0, 0xa8, 0x93,
+	0xb6, 0xe5, 0x53, 0xe2, 0xbd, 0xe0, 0x2e, 0x8d, 0x79, 0x78, 0x26, 0xcb, 0xc3, 0xd7, 0xa1, 0x3c,
+	0xd2, 0x29, 0x7d, 0xbc, 0x0e, 0x67, 0xfb, 0xc4, 0xf7, 0x71, 0x5b, 0x06, 0xbd, 0x1e, 0x0c, 0xb5,
+	0x9f, 0x28, 0xb0, 0xac, 0x47, 0x9c, 0x17, 0xc0, 0x1c, 0xf3, 0xb6, 0x92, 0xe5, 0xed, 0x51, 0xfc,
+	0xe4, 0xbe, 0x68, 0xfc, 0x68, 0x9f, 0x29, 0xb0, 0x12, 0x47, 0xf1, 0xa5, 0x05, 0xc7, 0x79, 0x00,
+	0xf2, 0x81, 0x6b, 0x79, 0xc4, 0x37, 0x2c, 0xe1, 0xc5, 0xbc, 0x3e, 0x2f, 0x29, 0xcd, 0xec, 0xd3,
+	0xa6, 0x03, 0xd2, 0xc9, 0x89, 0xd3, 0x25, 0x31, 0x2f, 0x6d, 0x40, 0x7e, 0x0c, 0x97, 0xa0, 0xa0,
+	0x4b, 0x50, 0xc4, 0xbd, 0x9e, 0xe1, 0x13, 0x9f, 0x45, 0x97, 0xcf, 0x01, 0xcd, 0xe9, 0x05, 0xdc,
+	0xeb, 0x1d, 0x48, 0x92, 0x56, 0x81, 0xe5, 0xd8, 0x9a, 0xc2, 0x66, 0xed, 0x01, 0xac, 0xd7, 0x6d,
+	0xc7, 0x1e, 0xf6, 0xad, 0x0f, 0xc9, 0x91, 0x4f, 0xbc, 0x6d, 0x4c, 0xf1, 0x28, 0x75, 0x9d, 0x15,
+	0x29, 0xa3, 0x25, 0x37, 0x72, 0x96, 0xe7, 0x0a, 0x1e, 0x81, 0x1e, 0xc1, 0x2c, 0x87, 0xe4, 0x04,
+	0x5d, 0x8c, 0xb4, 0x3f, 0x28, 0xb0, 0x91, 0xb2, 0x9a, 0x74, 0xef, 0x5b, 0xb0, 0x84, 0x03, 0x66,
+	0xcb, 0x70, 0xbc, 0x16, 0xf1, 0x7c, 0xbe, 0xf0, 0x8c, 0x5e, 0x1e, 0x31, 0xf6, 0x38, 0x1d, 0xd5,
+	0x60, 0x39, 0x22, 0xec, 0xe2, 0x61, 0x9f, 0xd8, 0x54, 0x18, 0x36, 0xa3, 0xa3, 0x11, 0x6b, 0x5f,
+	0x72, 0x98, 0x0b, 0x4c, 0xa7, 0xef, 0xf6, 0x08, 0x25, 0x2d, 0x03, 0x53, 0x79, 0xfa, 0x0a, 0x21,
+	0xad, 0x4e, 0xb5, 0xaf, 0x01, 0x12, 0xc7, 0x89, 0x1f, 0xc5, 0xc0, 0xca, 0xd7, 0x61, 0x21, 0x7e,
+	0x6e, 0x85, 0xad, 0x45, 0x1a, 0x39, 0xaf, 0xda, 0xf7, 0x60, 0x39, 0x36, 0x55, 0x9a, 0x34, 0xcd,
+	0x5c, 0x74, 0x01, 0x0a, 0xe2, 0x94, 0x0b, 0x60, 0xc2, 0x65, 0x10, 0x90, 0xea, 0x54, 0xfb, 0x9b,
+	0x02, 0xeb, 0xc1, 0x29, 0xda, 0x1f, 0x24, 0xce, 0xc6, 0x39, 0x98, 0x17, 0x71, 0x3b, 0xda, 0x86,
+	0x39, 0x41, 0x68, 0xb6, 0x46, 0x21, 0x91, 0x1b, 0x0b, 0x89, 0x3a, 0xcc, 0xb9, 0x3d, 0x4c, 0x8f,
+	0x1d, 0xaf, 0xcf, 0x7d, 0x51, 0xda, 0x7c, 0x23, 0xf3, 0xac, 0x30, 0xa5, 0xfb, 0x52, 0x58, 0x0f,
+	0xa7, 0x71, 0xe0, 0xae, 0x1b, 0xda, 0x76, 0x46, 0x02, 0x77, 0xdd, 0xc0, 0x2b, 0xdf, 0x82, 0x8d,
+	0x14, 0xdc, 0x23, 0xdf, 0x78, 0x92, 0x29, 0x0c, 0x97, 0xbe, 0x19, 0x11, 0xeb, 0x54, 0x3b, 0x04,
+	0xf5, 0xc8, 0xf6, 0x5e, 0xb2, 0xed, 0xda, 0x79, 0x38, 0x97, 0xba, 0xaa, 0x8c, 0xf9, 0x01, 0xac,
+	0x3c, 0x26, 0x9e, 0x75, 0x3c, 0xdc, 0x12, 0xa9, 0x2c, 0x92, 0x86, 0xe2, 0x69, 0x4f, 0xc9, 0x48,
+	0x7b, 0xec, 0x00, 0x60, 0x93, 0x95, 0x21, 0xc1, 0x01, 0x10, 0x23, 0x06, 0xd8, 0x23, 0x7d, 0x87,
+	0x12, 0xc3, 0x72, 0x65, 0x04, 0xce, 0x09, 0x42, 0xd3, 0xd5, 0x3a, 0x50, 0x49, 0xa8, 0x1d, 0x25,
+	0x4c, 0x7f, 0xc0, 0xf3, 0x0b, 0xd7, 0x38, 0xa7, 0x07, 0x43, 0x96, 0xbf, 0x7d, 0xd3, 0xf1, 0x44,
+	0xb6, 0x53, 0x74, 0x31, 0x60, 0xfb, 0x42, 0x3c, 0xcf, 0xf1, 0x0c, 0x56, 0x47, 0xf8, 0xeb, 0x33,
+	0x3c, 0x77, 0x00, 0x27, 0x6d, 0x31, 0x0a, 0x2b, 0x2d, 0x4a, 0x75, 0x51, 0x6b, 0x3d, 0x74, 0xcc,
+	0xae, 0x33, 0xa0, 0x0c, 0x71, 0xcf, 0x31, 0xbb, 0xa4, 0x25, 0x55, 0xc8, 0x11, 0xba, 0x01, 0xc8,
+	0x63, 0xb7, 0x82, 0x6d, 0xd9, 0x6d, 0x03, 0x53, 0x4a, 0xfa, 0xae, 0x3c, 0x66, 0x79, 0x7d, 0x29,
+	0xe4, 0xd4, 0x25, 0x03, 0x55, 0x61, 0xd9, 0x23, 0xd4, 0x1b, 0x1a, 0xf8, 0x98, 0x12, 0xcf, 0xf0,
+	0x89, 0xe9, 0xd8, 0x2d, 0x9f, 0x9b, 0x3a, 0xc3, 0xe4, 0xa9, 0x37, 0xac, 0x33, 0xce, 0x81, 0x60,
+	0xb0, 0x53, 0x29, 0x14, 0x19, 0x03, 0x9b, 0x5a, 0x3d, 0x19, 0x43, 0x05, 0x41, 0x3b, 0x62, 0x24,
+	0x6d, 0x07, 0x56, 0x8e, 0x6c, 0x46, 0x90, 0x88, 0xbf, 0x70, 0xf6, 0xb9, 0x03, 0x95, 0xc4, 0x42,
+	0xd2, 0xbf, 0x17, 0xa0, 0x30, 0xb0, 0x25, 0x8c, 0x30, 0x0e, 0x21, 0x20, 0xd5, 0xa9, 0xf6, 0x14,
+	0x56, 0x9b, 0xbe, 0x3f, 0x20, 0x3b, 0x4c, 0x71, 0x22, 0xe7, 0xce, 0xb5, 0xd9, 0x8f, 0x11, 0x8a,
+	0xb3, 0x7c, 0xdc, 0x6c, 0xbd, 0x94, 0xab, 0x68, 0x08, 0x6b, 0x63, 0x8a, 0x25, 0xe8, 0x09, 0x9a,
+	0x2f, 0x43, 0x41, 0xb0, 0xc6, 0xe2, 0x1f, 0xda, 0xe1, 0x42, 0xd1, 0xbb, 0x27, 0x4c, 0x87, 0xc1,
+	0xdd, 0x53, 0xa7, 0xda, 0xdf, 0x15, 0x58, 0xd8, 0x25, 0x5e, 0x9b, 0x6c, 0x39, 0xf6, 0x71, 0xcf,
+	0x32, 0xa9, 0x38, 0xb0, 0xbe, 0x33, 0xf0, 0x4c, 0x62, 0xd0, 0xa1, 0x4b, 0x46, 0x07, 0x56, 0x10,
+	0x0f, 0x87, 0x2e, 0xf7, 0x65, 0x28, 0x64, 0xb5, 0x82, 0x64, 0x16, 0x90, 0x9a, 0x2d, 0xb4, 0x0f,
+	0x7c, 0xd4, 0x1b, 0xd0, 0xa0, 0x06, 0x2a, 0x6d, 0xde, 0xcc, 0x74, 0x4d, 0x0c, 0x81, 0x1e, 0xce,
+	0xd3, 0x23, 0x6b, 0xb0, 0xfd, 0x6e, 0x11, 0x8a, 0xc3, 0xe8, 0x91, 0x23, 0xed, 0x53, 0x05, 0x56,
+	0xf8, 0x7c, 0xb9, 0xdf, 0x61, 0x46, 0xbf, 0x0c, 0x25, 0x89, 0x30, 0x1e, 0x40, 0x45, 0x41, 0x3d,
+	0x12, 0x61, 0x74, 0x19, 0x4a, 0x14, 0x7b, 0x6d, 0x42, 0x43, 0x29, 0x61, 0x4c, 0x51, 0x50, 0xa5,
+	0xd4, 0x15, 0x28, 0x06, 0x2e, 0x49, 0x56, 0x42, 0x05, 0xe9, 0x16, 0x9e, 0x72, 0xfe, 0xa7, 0x40,
+	0x25, 0x01, 0x46, 0x6e, 0xe4, 0x25, 0x28, 0x8a, 0xbb, 0xce, 0xe8, 0x3b, 0x27, 0xf2, 0xfc, 0xe5,
+	0xf5, 0x82, 0xa0, 0xed, 0x32, 0x12, 0xba, 0x0a, 0x65, 0x13, 0x7b, 0xd4, 0xb0, 0x28, 0xe9, 0x07,
+	0x62, 0xe2, 0x08, 0x96, 0x18, 0xbd, 0xc9, 0xc8, 0x42, 0xf2, 0x26, 0xac, 0x3c, 0xb5, 0xfc, 0x4e,
+	0xcf, 0xf2, 0xe3, 0xd2, 0xa2, 0xb4, 0x40, 0x01, 0x2f, 0x32, 0xe3, 0x12, 0x14, 0x5d, 0xc7, 0xb2,
+	0x69, 0x20, 0x79, 0x86, 0x1f, 0xd5, 0x82, 0xa0, 0x09, 0x91, 0x6d, 0x98, 0x37, 0xe5, 0x16, 0x88,
+	0x9a, 0xb2, 0xb0, 0x79, 0x65, 0xca, 0x1d, 0x1b, 0x4d, 0xd4, 0xbe, 0x03, 0x1b, 0x72, 0x03, 0x1a,
+	0xac, 0xca, 0xdc, 0xea, 0x60, 0xbb, 0x4d, 0x22, 0x99, 0xdc, 0x26, 0x4f, 0x8d, 0x68, 0x31, 0x3a,
+	0x67, 0x93, 0xa7, 0x8d, 0xa9, 0xea, 0x51, 0xad, 0x0d, 0x6a, 0xda, 0xd2, 0xd2, 0xc1, 0xd7, 0x60,
+	0xc9, 0xe4, 0x14, 0x5e, 0x41, 0xc7, 0x8e, 0xcc, 0xa2, 0x19, 0x45, 0xd1, 0x6c, 0x25, 0x0e, 0x45,
+	0x2e, 0x79, 0x28, 0x86, 0xb0, 0xc1, 0x4c, 0xb3, 0xbc, 0x7e, 0x8a, 0x0d, 0xa7, 0xd1, 0x73, 0x13,
+	0x96, 0x4e, 0x58, 0xae, 0xb7, 0x4c, 0xfe, 0xb5, 0xca, 0x33, 0x75, 0xd4, 0xb6, 0x72, 0x94, 0xcb,
+	0x92, 0xb6, 0xf6, 0x6d, 0x50, 0xd3, 0x54, 0x4b, 0x1b, 0xd3, 0x0b, 0xf9, 0xf3, 0x00, 0x42, 0x71,
+	0xa4, 0xb0, 0x98, 0x97, 0x94, 0x3a, 0xd5, 0x7e, 0x9f, 0x83, 0x02, 0x0b, 0xe3, 0x7d, 0xcf, 0x39,
+	0xb6, 0x7a, 0x24, 0x3b, 0xa3, 0x86, 0xab, 0xe7, 0x12, 0xab, 0xe3, 0x13, 0x4c, 0xb1, 0xc7, 0x3f,
+	0x79, 0x65, 0x02, 0x11, 0x94, 0x23, 0xaf, 0x87, 0x54, 0x98, 0xb3, 0x2d, 0xb3, 0x6b, 0xe3, 0x3e,
+	0x91, 0x07, 0x33, 0x1c, 0xb3, 0x05, 0xdd, 0x8e, 0x63, 0x93, 0xf5, 0xbc, 0x58, 0x90, 0x0f, 0x50,
+	0x13, 0x16, 0x5b, 0xe4, 0x18, 0x0f, 0x7a, 0xd4, 0xc0, 0xad, 0x96, 0xc7, 0xee, 0xbb, 0x59, 0x9e,
+	0x3a, 0x2f, 0x66, 0x46, 0x5b, 0x5d, 0xc8, 0xe9, 0x25, 0x39, 0x51, 0x8e, 0x59, 0x54, 0x77, 0xb0,
+	0x6f, 0x84, 0x61, 0x73, 0x56, 0x14, 0xbc, 0x1d, 0xec, 0xef, 0x4b, 0x12, 0x77, 0x8e, 0x47, 0xb0,
+	0xac, 0xba, 0xe6, 0xa4, 0x73, 0x04, 0xa5, 0x4e, 0x35, 0x1d, 0x4a, 0x75, 0x6e, 0xcb, 0x2e, 0xa1,
+	0xb8, 0x85, 0x29, 0x16, 0x15, 0xa4, 0x4d, 0x89, 0x4d, 0xa3, 0xe9, 0xaf, 0x20, 0x69, 0x3c, 0xfb,
+	0x9d, 0x07, 0xf0, 0xad, 0x0f, 0x89, 0xf1, 0x64, 0x48, 0x49, 0x50, 0x8c, 0xce, 0x33, 0xca, 0x5d,
+	0x46, 0xd0, 0x7e, 0x08, 0xcb, 0x47, 0x6e, 0xcf, 0xc1, 0x2d, 0xb1, 0x72, 0x10, 0x38, 0x0d, 0x98,
+	0xeb, 0x4b, 0x25, 0x7c, 0xd1, 0xc2, 0xe6, 0x57, 0xb2, 0x0d, 0x8e, 0x61, 0xba, 0xff, 0x8a, 0x1e,
+	0x4e, 0x45, 0xab, 0x90, 0x37, 0x3b, 0x03, 0xbb, 0xcb, 0xf5, 0x16, 0xef, 0xbf, 0xa2, 0x8b, 0xe1,
+	0xdd, 0x59, 0x38, 0xc3, 0xf8, 0xda, 0x63, 0x58, 0x89, 0x6b, 0x97, 0xb1, 0xf3, 0x0d, 0x38, 0xeb,
+	0x8a, 0x08, 0x90, 0xda, 0x2f, 0x67, 0x6a, 0x8f, 0x44, 0x8b, 0x1e, 0x4c, 0xd2, 0x3e, 0xcb, 0xc1,
+	0xa2, 0x60, 0x90, 0x63, 0xe2, 0x11, 0xdb, 0x24, 0xbe, 0x2c, 0x27, 0x70, 0x2f, 0xf0, 0x92, 0x1c,
+	0xb1, 0xa0, 0x30, 0x07, 0x1e, 0x13, 0x1a, 0xca, 0x60, 0x0a, 0xc7, 0xe8, 0x36, 0xe4, 0x69, 0x87,
+	0xf4, 0x89, 0xbc, 0x14, 0x5e, 0xcb, 0x44, 0x71, 0xc8, 0xa4, 0x74, 0x21, 0x8c, 0x9a, 0x90, 0x27,
+	0x1f, 0x50, 0x0f, 0xf3, 0x4f, 0xa4, 0xc2, 0xe6, 0xad, 0xe7, 0x60, 0x0f, 0x21, 0x56, 0x1b, 0x6c,
+	0x56, 0xc3, 0xa6, 0xde, 0x50, 0x17, 0x2b, 0xb0, 0xdd, 0x1b, 0xb8, 0xad, 0x20, 0x22, 0x44, 0x68,
+	0xce, 0x4b, 0x4a, 0x9d, 0xaa, 0x77, 0x00, 0x46, 0x73, 0x50, 0x19, 0x66, 0xba, 0x64, 0x28, 0xcd,
+	0x63, 0x3f, 0x59, 0x50, 0x9f, 0xe0, 0xde, 0x80, 0x04, 0xa7, 0x84, 0x0f, 0xde, 0xc9, 0xdd, 0x51,
+	0xb4, 0x35, 0xa8, 0xec, 0x10, 0x1a, 0x51, 0x1e, 0x34, 0x94, 0x5a, 0xb0, 0x9a, 0x64, 0xc8, 0x4d,
+	0x79, 0x17, 0x0a, 0xee, 0x88, 0x2c, 0x37, 0xe6, 0xea, 0xb4, 0xc6, 0xe9, 0xd1, 0xc9, 0xda, 0xe7,
+	0x0a, 0x54, 0x0e, 0xd2, 0xf4, 0xbf, 0x4c, 0x2d, 0xe8, 0xeb, 0x50, 0x10, 0xbe, 0xe2, 0xcd, 0x35,
+	0x59, 0xf4, 0xa8, 0x55, 0xd1, 0x7f, 0xab, 0x06, 0xfd, 0xb7, 0xea, 0x3d, 0xd6, 0x7f, 0xdb, 0xc5,
+	0x7e, 0x57, 0x97, 0xce, 0x66, 0xbf, 0x99, 0x23, 0x0e, 0xbe, 0x7c, 0x47, 0x94, 0xa0, 0xb8, 0x43,
+	0xe8, 0x6e, 0x90, 0x87, 0xb5, 0x3d, 0x58, 0x90, 0xe3, 0x97, 0x74, 0x14, 0x9e, 0x29, 0xec, 0x8c,
+	0x31, 0xab, 0x02, 0x96, 0x74, 0xf4, 0x0b, 0x2e, 0xfc, 0x62, 0xce, 0x7d, 0x0f, 0x2a, 0x09, 0x50,
+	0x2f, 0xc9, 0xdc, 0x3f, 0x29, 0x50, 0x11, 0x17, 0x51, 0x90, 0x55, 0x03, 0x7b, 0xab, 0x50, 0x16,
+	0xe7, 0x9a, 0x8e, 0x72, 0x70, 0xe4, 0x6b, 0x69, 0x51, 0x32, 0xc3, 0x64, 0x7c, 0x05, 0x8a, 0xec,
+	0xfe, 0x4f, 0xbb, 0xe6, 0x0b, 0x36, 0x79, 0x1a, 0xca, 0x6d, 0x42, 0xc5, 0xe3, 0x5d, 0x0a, 0xc3,
+	0xa1, 0x1d, 0xfe, 0x81, 0x21, 0x3b, 0x1a, 0x33, 0x3c, 0xc1, 0x2f, 0x0b, 0xe6, 0x1e, 0xe3, 0x85,
+	0x9d, 0x8d, 0x27, 0xb0, 0x9a, 0x04, 0x29, 0xed, 0x8f, 0xdf, 0x8f, 0x4a, 0xe2, 0x7e, 0x44, 0x6f,
+	0x42, 0x59, 0xac, 0xd7, 0x8a, 0x77, 0x4e, 0xf2, 0xfa, 0xa2, 0xa4, 0x87, 0x3a, 0xf6, 0x60, 0x65,
+	0x9b, 0xf4, 0x08, 0x25, 0x89, 0x8f, 0x94, 0x68, 0xe9, 0xa2, 0xa4, 0xb5, 0xd2, 0xb2, 0x3e, 0x56,
+	0xde, 0x83, 0x4a, 0x62, 0xc1, 0x11, 0xe6, 0x16, 0x09, 0xbb, 0x18, 0x12, 0xb3, 0xa4, 0xd4, 0x99,
+	0xc2, 0x82, 0x3b, 0xf0, 0xda, 0x44, 0x7c, 0x80, 0xc9, 0x45, 0x81, 0x93, 0xf8, 0x87, 0x97, 0xf6,
+	0x2f, 0x05, 0x66, 0xeb, 0xfb, 0xcd, 0x07, 0x64, 0x88, 0x2a, 0x30, 0xdb, 0x25, 0xc3, 0xd1, 0x75,
+	0x9f, 0xef, 0x92, 0xa1, 0xa8, 0x81, 0x5c, 0xec, 0x51, 0x3b, 0x5a, 0xf4, 0xce, 0x4b, 0x4a, 0x53,
+	0x14, 0x8c, 0x92, 0xcd, 0xef, 0x76, 0xd9, 0x48, 0x91, 0xb4, 0x47, 0xec, 0x7a, 0xcf, 0xe8, 0x5b,
+	0x25, 0xae, 0xdc, 0x7c, 0xe2, 0xca, 0x4d, 0x14, 0x5f, 0xb3, 0x89, 0xe2, 0x8b, 0xb1, 0x83, 0xed,
+	0xc0, 0x94, 0xdf, 0xe8, 0xf3, 0xfa, 0xbc, 0xa4, 0xd4, 0xa9, 0xf6, 0x2b, 0x05, 0x96, 0xb7, 0xf8,
+	0x5a, 0xc2, 0xbc, 0x60, 0x0b, 0xe2, 0xe6, 0x28, 0xcf, 0x33, 0x27, 0x37, 0xc9, 0x9c, 0x99, 0xa4,
+	0x39, 0x11, 0xbc, 0x67, 0x92, 0xc5, 0x62, 0x1f, 0x56, 0xe2, 0x78, 0xe4, 0x0e, 0xde, 0x81, 0xb3,
+	0xd8, 0xb5, 0x8c, 0xe0, 0xf6, 0x28, 0x6c, 0x5e, 0xc8, 0xbe, 0xed, 0xc5, 0xcc, 0x59, 0xec, 0x5a,
+	0x6c, 0xc3, 0xce, 0xc1, 0xac, 0x4f, 0x4c, 0x8f, 0xd0, 0xe8, 0xf9, 0x90, 0x24, 0x6d, 0x3b, 0x68,
+	0xe0, 0xc5, 0xcd, 0xcf, 0xd8, 0xe4, 0xac, 0xb8, 0xdb, 0x87, 0x95, 0xf8, 0x2a, 0x2f, 0x0a, 0x5a,
+	0xbb, 0x0d, 0x95, 0xc7, 0xb8, 0x67, 0xb5, 0xc6, 0x36, 0x66, 0x64, 0x8d, 0x32, 0x6e, 0x4d, 0x07,
+	0x56, 0x93, 0xb3, 0x46, 0xa5, 0xee, 0x09, 0xe3, 0xc8, 0x46, 0x85, 0x18, 0x44, 0xf1, 0xe5, 0x4e,
+	0x85, 0xef, 0xda, 0xf7, 0xa1, 0x18, 0xed, 0x6f, 0xa1, 0xf3, 0xb0, 0xb1, 0x7f, 0x74, 0x70, 0xdf,
+	0xd8, 0x7f, 0x58, 0x3f, 0xbc, 0xb7, 0xa7, 0xef, 0x1a, 0x47, 0x8f, 0x0e, 0xf6, 0x1b, 0x5b, 0xcd,
+	0x7b, 0xcd, 0xc6, 0x76, 0xf9, 0x15, 0x54, 0x81, 0xa5, 0x38, 0xfb, 0xde, 0xd6, 0x6e, 0x59, 0x41,
+	0xab, 0x80, 0xe2, 0xe4, 0xfa, 0xfe, 0xa3, 0x83, 0x72, 0xee, 0xda, 0x3f, 0x14, 0x58, 0xcb, 0xf8,
+	0x88, 0x45, 0x6f, 0xc2, 0x1b, 0xbb, 0x0d, 0x7d, 0xa7, 0x61, 0x6c, 0xed, 0x3d, 0xba, 0xf7, 0xb0,
+	0xb9, 0x75, 0x68, 0xe8, 0x8d, 0x83, 0xbd, 0x87, 0x47, 0x87, 0xcd, 0xbd, 0x47, 0x09, 0xad, 0x13,
+	0x45, 0x1f, 0x34, 0xf6, 0x0f, 0x8d, 0xc3, 0xba, 0xbe, 0xd3, 0x38, 0x2c, 0x2b, 0x53, 0x88, 0x1e,
+	0xec, 0x1d, 0xe9, 0x5b, 0x8d, 0x72, 0x0e, 0x5d, 0x01, 0x2d, 0x5b, 0x74, 0x6b, 0x6f, 0xf7, 0x6e,
+	0xf3, 0x51, 0x63, 0xbb, 0x3c, 0x73, 0xed, 0x9b, 0x90, 0xe7, 0x35, 0x17, 0x33, 0xfe, 0xf0, 0x7e,
+	0x63, 0xb7, 0x91, 0x40, 0xb7, 0x08, 0x05, 0x41, 0x7e, 0xd8, 0xdc, 0xb9, 0xcf, 0x30, 0x94, 0x00,
+	0x04, 0x61, 0xbb, 0xae, 0x3f, 0x28, 0xe7, 0x36, 0xff, 0xfb, 0x6a, 0xd8, 0x70, 0x3a, 0x20, 0x1e,
+	0x7f, 0xa5, 0x79, 0xa6, 0x40, 0x39, 0xf9, 0x98, 0x86, 0xb2, 0x1b, 0x01, 0x19, 0x4f, 0x72, 0xea,
+	0xdb, 0xa7, 0x98, 0x21, 0xdb, 0x7b, 0xea, 0x27, 0xff, 0xfc, 0xf7, 0xb3, 0xdc, 0x0a, 0x42, 0x35,
+	0x07, 0x0f, 0x68, 0xa7, 0xd6, 0x65, 0x52, 0x35, 0xfe, 0x56, 0x87, 0x7e, 0x17, 0x41, 0x15, 0x3c,
+	0xba, 0x4d, 0x81, 0x2a, 0xf1, 0xa8, 0xa7, 0xbe, 0x7d, 0x8a, 0x19, 0x12, 0xd5, 0x45, 0x8e, 0x4a,
+	0x7d, 0x47, 0xb9, 0xa6, 0x55, 0x62, 0xc0, 0x4c, 0xdc, 0xeb, 0x3d, 0x61, 0x30, 0x2c, 0xc8, 0x73,
+	0x5b, 0x50, 0x76, 0xa3, 0x36, 0xfa, 0x04, 0xa7, 0x5e, 0x79, 0x9e, 0x98, 0xd4, 0xbc, 0xc4, 0x35,
+	0x17, 0x98, 0xe6, 0x59, 0xe9, 0x86, 0x01, 0xcc, 0x05, 0x8d, 0x5b, 0x94, 0x5d, 0x6b, 0x25, 0x5e,
+	0x93, 0xd4, 0x37, 0xa7, 0x90, 0x94, 0x3a, 0x57, 0xb8, 0xce, 0x12, 0xd3, 0x39, 0x5f, 0x0b, 0x3a,
+	0xb1, 0xe8, 0x67, 0x0a, 0x14, 0xa3, 0x2f, 0x2f, 0xe8, 0xfa, 0x84, 0x15, 0xc7, 0x9e, 0x89, 0xd4,
+	0x1b, 0x53, 0x4a, 0x4b, 0x0c, 0xeb, 0x1c, 0x03, 0x62, 0x18, 0x16, 0x6a, 0xdc, 0xe1, 0xf2, 0x6d,
+	0x06, 0xfd, 0x58, 0x81, 0x42, 0xe4, 0x31, 0x04, 0xbd, 0x35, 0x61, 0xe1, 0xe4, 0x33, 0x8c, 0x7a,
+	0x7d, 0x3a, 0x61, 0x09, 0x62, 0x8d, 0x83, 0x58, 0x62, 0x20, 0x8a, 0x01, 0x08, 0x26, 0x85, 0xfe,
+	0xa8, 0xc0, 0xd2, 0xd8, 0x5b, 0x09, 0xca, 0x0e, 0xac, 0xac, 0x57, 0x1a, 0x75, 0xf3, 0x34, 0x53,
+	0x24, 0xaa, 0x37, 0x38, 0xaa, 0x0b, 0x0c, 0x95, 0x5a, 0x1b, 0xf8, 0xc4, 0xf3, 0x6b, 0x1f, 0xc9,
+	0xd6, 0xc0, 0xc7, 0xb5, 0xf0, 0x71, 0x05, 0x7d, 0xa2, 0x40, 0x21, 0xf2, 0xec, 0x31, 0xc1, 0x4f,
+	0xe3, 0xef, 0x2a, 0xea, 0xf5, 0xe9, 0x84, 0xd3, 0x36, 0x8b, 0x3f, 0x9f, 0xd4, 0xc4, 0x0b, 0x09,
+	0x4b, 0x24, 0x4b, 0x63, 0xaf, 0x0c, 0x13, 0x1c, 0x95, 0xf5, 0x92, 0xa2, 0x6e, 0x9e, 0x66, 0x4a,
+	0xda, 0xf6, 0xb9, 0x03, 0xbf, 0x73, 0x83, 0xb7, 0x01, 0x7d, 0xf4, 0x67, 0x05, 0x96, 0x53, 0xde,
+	0x18, 0xd0, 0x84, 0xef, 0xd3, 0xcc, 0x77, 0x0e, 0xf5, 0xf6, 0xe9, 0x26, 0x49, 0x6c, 0x1a, 0xc7,
+	0xf6, 0x2a, 0xc3, 0xb6, 0x16, 0xc5, 0x56, 0x1b, 0x84, 0x93, 0xd0, 0xa7, 0x0a, 0x2c, 0xc4, 0x1e,
+	0x1d, 0x50, 0xf6, 0x21, 0x4a, 0x7b, 0x13, 0x51, 0xab, 0xd3, 0x8a, 0xc7, 0x93, 0x2f, 0x03, 0xb5,
+	0x58, 0x93, 0x6f, 0x26, 0x35, 0xde, 0xec, 0x1a, 0xa2, 0xdf, 0x2a, 0xb0, 0x10, 0xeb, 0xd0, 0x4f,
+	0x00, 0x93, 0xf6, 0x24, 0xa0, 0x56, 0xa7, 0x15, 0x4f, 0xf3, 0x50, 0x32, 0xcc, 0x45, 0xff, 0x1f,
+	0xfd, 0x52, 0x81, 0xc5, 0x44, 0x0f, 0x1e, 0xd5, 0x32, 0xf5, 0xa4, 0x3f, 0x13, 0xa8, 0x37, 0xa7,
+	0x9f, 0x90, 0x16, 0x58, 0xbc, 0x6b, 0x5f, 0x13, 0xef, 0x76, 0x3f, 0x0f, 0xfa, 0xf2, 0xd2, 0x18,
+	0x7f, 0x82, 0x93, 0xd2, 0xba, 0xdf, 0x6a, 0x75, 0x5a, 0xf1, 0x34, 0x24, 0xc2, 0x49, 0x7d, 0x26,
+	0x88, 0x3e, 0x57, 0x00, 0xc9, 0x45, 0x23, 0x2d, 0x49, 0x34, 0xe9, 0x18, 0x65, 0xb4, 0x7f, 0xd5,
+	0x5b, 0xa7, 0x9a, 0x23, 0x81, 0x5d, 0xe2, 0xc0, 0xce, 0x31, 0x60, 0xab, 0x21, 0xb0, 0x1a, 0xef,
+	0x4d, 0xd6, 0xc4, 0x47, 0x1c, 0x4b, 0xa2, 0x68, 0xbc, 0x6b, 0x3a, 0x01, 0x62, 0x66, 0x77, 0x57,
+	0xbd, 0x75, 0xaa, 0x39, 0xd9, 0x01, 0x36, 0x82, 0x28, 0xa6, 0xb1, 0x23, 0x58, 0x8c, 0xf6, 0xe5,
+	0x26, 0x5c, 0x7a, 0x29, 0xcd, 0x43, 0xf5, 0xc6, 0x94, 0xd2, 0x12, 0xd1, 0x39, 0x8e, 0xa8, 0xc2,
+	0x10, 0x95, 0x47, 0x88, 0x44, 0xd7, 0xf6, 0xaa, 0x82, 0x7e, 0xad, 0x40, 0x29, 0xde, 0x91, 0x42,
+	0xd5, 0x49, 0xb5, 0xcc, 0x78, 0x4f, 0x49, 0xad, 0x4d, 0x2d, 0x2f, 0x21, 0x9d, 0xe7, 0x90, 0xd6,
+	0x50, 0x65, 0x84, 0x27, 0xda, 0x57, 0x7a, 0xa6, 0x40, 0xe9, 0x60, 0x5a, 0x48, 0x07, 0xa7, 0x84,
+	0x94, 0xde, 0x74, 0x8a, 0x14, 0x63, 0x6a, 0x06, 0x2a, 0x0b, 0xf2, 0xbc, 0x75, 0x34, 0xa1, 0x18,
+	0x8b, 0xb6, 0x9a, 0xd4, 0x2b, 0xcf, 0x13, 0x8b, 0x17, 0x63, 0x68, 0x3e, 0x54, 0x8b, 0x7e, 0xca,
+	0xd2, 0x62, 0xb4, 0x7f, 0x33, 0x29, 0x2d, 0xa6, 0x34, 0x9f, 0xd4, 0xea, 0xb4, 0xe2, 0x63, 0xc5,
+	0xd9, 0x66, 0x04, 0x06, 0x0b, 0x8d, 0x78, 0x1f, 0x65, 0xc2, 0x3e, 0xa4, 0x76, 0x85, 0xd4, 0xda,
+	0xd4, 0xf2, 0xf1, 0xd0, 0x60, 0xd1, 0x8a, 0x22, 0xfb, 0x10, 0xe8, 0xff, 0x85, 0x02, 0x0b, 0xb1,
+	0x2e, 0xc9, 0x04, 0xcf, 0xa4, 0xb5, 0x67, 0xd4, 0xea, 0xb4, 0xe2, 0x13, 0x4f, 0x8f, 0xe8, 0xbe,
+	0xb0, 0xaa, 0xb1, 0x18, 0xfd, 0xe0, 0x9f, 0x70, 0x90, 0x53, 0xfa, 0x14, 0xea, 0x8d, 0x29, 0xa5,
+	0xd3, 0x2a, 0x68, 0xec, 0x5a, 0x37, 0xba, 0x64, 0xe8, 0xa3, 0xdf, 0xf0, 0x0a, 0x7a, 0xf4, 0xfd,
+	0x8e, 0x9e, 0x57, 0x8d, 0x4e, 0x8b, 0x21, 0xad, 0x29, 0xa0, 0x5d, 0xe6, 0x18, 0x5e, 0x63, 0x18,
+	0x36, 0x42, 0x0c, 0xb5, 0x8f, 0x44, 0xbf, 0xe1, 0xe3, 0xa0, 0x92, 0x7d, 0x1f, 0x4a, 0xf1, 0x4f,
+	0xf9, 0x09, 0x71, 0x93, 0xda, 0x29, 0x50, 0x6b, 0x53, 0xcb, 0x0b, 0x60, 0x77, 0x5f, 0xff, 0xee,
+	0xa5, 0xb6, 0x45, 0x3b, 0x83, 0x27, 0x55, 0xd3, 0xe9, 0xd7, 0xc4, 0xcc, 0x1b, 0x6c, 0xa6, 0xf8,
+	0x1f, 0xa8, 0x5f, 0x6b, 0x13, 0xfb, 0xc9, 0x2c, 0xff, 0x7d, 0xeb, 0xff, 0x03, 0x00, 0xf2, 0xe7,
+	0xe0, 0x1d, 0x77, 0x2a, 0x00, 0x00,
+}
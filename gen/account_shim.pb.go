@@ -0,0 +1,293 @@
+// Code generated by protoc-gen-go-shim. DO NOT EDIT.
+// source: account.proto
+
+package gen
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	iter "iter"
+)
+
+// AccountServiceAPI is AccountServiceClient without per-call options, so it can be
+// mocked with plain method signatures. Streams are exposed as iterators.
+type AccountServiceAPI interface {
+	GetKakaoLoginURL(ctx context.Context, in *GetKakaoLoginURLRequest) (*GetKakaoLoginURLResponse, error)
+	GetKakaoCallBack(ctx context.Context, in *GetKakaoCallBackRequest) (*GetKakaoCallBackResponse, error)
+	Login(ctx context.Context, in *LoginRequest) (*LoginResponse, error)
+	Register(ctx context.Context, in *RegisterRequest) (*RegisterResponse, error)
+	// refresh_token으로 새 access/refresh 토큰 발급 (rotation: 사용한 refresh_token은 즉시 무효)
+	// 이미 사용된 refresh_token이 다시 오면 탈취로 보고 같은 계열의 토큰을 모두 폐기
+	RefreshToken(ctx context.Context, in *RefreshTokenRequest) (*RefreshTokenResponse, error)
+	// 로그아웃: access 또는 refresh 토큰 폐기 (이미 무효한 토큰도 성공 처리)
+	RevokeToken(ctx context.Context, in *RevokeTokenRequest) (*RevokeTokenResponse, error)
+	// 개인정보 파기 요청: 주문/결제의 PII를 삭제하되 금액 등 집계 데이터는 보존
+	AnonymizeUserData(ctx context.Context, in *AnonymizeUserDataRequest) (*AnonymizeUserDataResponse, error)
+	// 약관 동의 (Authorization 헤더의 사용자 기준)
+	AcceptTerms(ctx context.Context, in *AcceptTermsRequest) (*AcceptTermsResponse, error)
+	// 주문 상태 푸시 알림을 위한 디바이스 토큰 등록/해제 (FCM/APNs)
+	RegisterPushToken(ctx context.Context, in *RegisterPushTokenRequest) (*RegisterPushTokenResponse, error)
+	UnregisterPushToken(ctx context.Context, in *UnregisterPushTokenRequest) (*UnregisterPushTokenResponse, error)
+	// CAPTCHA 토큰 검증 (Login/Register 처리 중 내부 호출 또는 단독 사용)
+	VerifyCaptcha(ctx context.Context, in *VerifyCaptchaRequest) (*VerifyCaptchaResponse, error)
+	// 관리자용: 로그인 실패로 잠긴 계정 해제
+	UnlockAccount(ctx context.Context, in *UnlockAccountRequest) (*UnlockAccountResponse, error)
+	// 비회원 장바구니/이벤트 추적용 익명 토큰 발급, 가입 후 계정으로 병합 가능
+	IssueGuestToken(ctx context.Context, in *IssueGuestTokenRequest) (*IssueGuestTokenResponse, error)
+	// 계정 병합 (게스트→회원, 카카오→이메일): 장바구니, 주문, 포인트, 위시리스트를 target으로 이전
+	MergeAccounts(ctx context.Context, in *MergeAccountsRequest) (*MergeAccountsResponse, error)
+	// 이메일 변경: 새 주소로 인증 코드 발송 + 기존 주소로 변경 요청 알림
+	RequestEmailChange(ctx context.Context, in *RequestEmailChangeRequest) (*RequestEmailChangeResponse, error)
+	// 새 주소로 받은 인증 코드 확인 후 이메일 변경 완료
+	ConfirmEmailChange(ctx context.Context, in *ConfirmEmailChangeRequest) (*ConfirmEmailChangeResponse, error)
+	// 프로필 이미지 업로드: 첫 메시지는 metadata, 이후 chunk 전송
+	UploadAvatar(ctx context.Context, in iter.Seq[*UploadAvatarRequest]) (*UploadAvatarResponse, error)
+	// UI 설정 (언어, 통화, 테마 등) 기기 간 동기화
+	GetPreferences(ctx context.Context, in *GetPreferencesRequest) (*GetPreferencesResponse, error)
+	SetPreferences(ctx context.Context, in *SetPreferencesRequest) (*SetPreferencesResponse, error)
+	// 로그인한 사용자 (Authorization 헤더 기준) 프로필 조회/변경
+	GetMe(ctx context.Context, in *GetMeRequest) (*GetMeResponse, error)
+	UpdateProfile(ctx context.Context, in *UpdateProfileRequest) (*UpdateProfileResponse, error)
+	// 비밀번호 변경: 성공 시 현재 세션을 제외한 refresh 토큰 폐기 (선택)
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest) (*ChangePasswordResponse, error)
+	// 회원 탈퇴: 모든 토큰 폐기 후 유예 기간이 지나면 AnonymizeUserData로 개인정보 파기
+	DeleteAccount(ctx context.Context, in *DeleteAccountRequest) (*DeleteAccountResponse, error)
+	// 관리자용: 파트너 HTTP 연동용 API 키 발급/폐기
+	CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error)
+	RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error)
+	// 게이트웨이 전용: x-api-key 검증 (HTTP 매핑 없음)
+	ValidateAPIKey(ctx context.Context, in *ValidateAPIKeyRequest) (*ValidateAPIKeyResponse, error)
+}
+
+// NewAccountServiceAPI adapts c to AccountServiceAPI, passing opts to every call.
+func NewAccountServiceAPI(c AccountServiceClient, opts ...grpc.CallOption) AccountServiceAPI {
+	return &accountServiceAPI{c: c, opts: opts}
+}
+
+type accountServiceAPI struct {
+	c    AccountServiceClient
+	opts []grpc.CallOption
+}
+
+func (a *accountServiceAPI) GetKakaoLoginURL(ctx context.Context, in *GetKakaoLoginURLRequest) (*GetKakaoLoginURLResponse, error) {
+	return a.c.GetKakaoLoginURL(ctx, in, a.opts...)
+}
+
+func (a *accountServiceAPI) GetKakaoCallBack(ctx context.Context, in *GetKakaoCallBackRequest) (*GetKakaoCallBackResponse, error) {
+	return a.c.GetKakaoCallBack(ctx, in, a.opts...)
+}
+
+func (a *accountServiceAPI) Login(ctx context.Context, in *LoginRequest) (*LoginResponse, error) {
+	return a.c.Login(ctx, in, a.opts...)
+}
+
+func (a *accountServiceAPI) Register(ctx context.Context, in *RegisterRequest) (*RegisterResponse, error) {
+	return a.c.Register(ctx, in, a.opts...)
+}
+
+func (a *accountServiceAPI) RefreshToken(ctx context.Context, in *RefreshTokenRequest) (*RefreshTokenResponse, error) {
+	return a.c.RefreshToken(ctx, in, a.opts...)
+}
+
+func (a *accountServiceAPI) RevokeToken(ctx context.Context, in *RevokeTokenRequest) (*RevokeTokenResponse, error) {
+	return a.c.RevokeToken(ctx, in, a.opts...)
+}
+
+func (a *accountServiceAPI) AnonymizeUserData(ctx context.Context, in *AnonymizeUserDataRequest) (*AnonymizeUserDataResponse, error) {
+	return a.c.AnonymizeUserData(ctx, in, a.opts...)
+}
+
+func (a *accountServiceAPI) AcceptTerms(ctx context.Context, in *AcceptTermsRequest) (*AcceptTermsResponse, error) {
+	return a.c.AcceptTerms(ctx, in, a.opts...)
+}
+
+func (a *accountServiceAPI) RegisterPushToken(ctx context.Context, in *RegisterPushTokenRequest) (*RegisterPushTokenResponse, error) {
+	return a.c.RegisterPushToken(ctx, in, a.opts...)
+}
+
+func (a *accountServiceAPI) UnregisterPushToken(ctx context.Context, in *UnregisterPushTokenRequest) (*UnregisterPushTokenResponse, error) {
+	return a.c.UnregisterPushToken(ctx, in, a.opts...)
+}
+
+func (a *accountServiceAPI) VerifyCaptcha(ctx context.Context, in *VerifyCaptchaRequest) (*VerifyCaptchaResponse, error) {
+	return a.c.VerifyCaptcha(ctx, in, a.opts...)
+}
+
+func (a *accountServiceAPI) UnlockAccount(ctx context.Context, in *UnlockAccountRequest) (*UnlockAccountResponse, error) {
+	return a.c.UnlockAccount(ctx, in, a.opts...)
+}
+
+func (a *accountServiceAPI) IssueGuestToken(ctx context.Context, in *IssueGuestTokenRequest) (*IssueGuestTokenResponse, error) {
+	return a.c.IssueGuestToken(ctx, in, a.opts...)
+}
+
+func (a *accountServiceAPI) MergeAccounts(ctx context.Context, in *MergeAccountsRequest) (*MergeAccountsResponse, error) {
+	return a.c.MergeAccounts(ctx, in, a.opts...)
+}
+
+func (a *accountServiceAPI) RequestEmailChange(ctx context.Context, in *RequestEmailChangeRequest) (*RequestEmailChangeResponse, error) {
+	return a.c.RequestEmailChange(ctx, in, a.opts...)
+}
+
+func (a *accountServiceAPI) ConfirmEmailChange(ctx context.Context, in *ConfirmEmailChangeRequest) (*ConfirmEmailChangeResponse, error) {
+	return a.c.ConfirmEmailChange(ctx, in, a.opts...)
+}
+
+func (a *accountServiceAPI) UploadAvatar(ctx context.Context, in iter.Seq[*UploadAvatarRequest]) (*UploadAvatarResponse, error) {
+	stream, err := a.c.UploadAvatar(ctx, a.opts...)
+	if err != nil {
+		return nil, err
+	}
+	return sendAll(stream, in)
+}
+
+func (a *accountServiceAPI) GetPreferences(ctx context.Context, in *GetPreferencesRequest) (*GetPreferencesResponse, error) {
+	return a.c.GetPreferences(ctx, in, a.opts...)
+}
+
+func (a *accountServiceAPI) SetPreferences(ctx context.Context, in *SetPreferencesRequest) (*SetPreferencesResponse, error) {
+	return a.c.SetPreferences(ctx, in, a.opts...)
+}
+
+func (a *accountServiceAPI) GetMe(ctx context.Context, in *GetMeRequest) (*GetMeResponse, error) {
+	return a.c.GetMe(ctx, in, a.opts...)
+}
+
+func (a *accountServiceAPI) UpdateProfile(ctx context.Context, in *UpdateProfileRequest) (*UpdateProfileResponse, error) {
+	return a.c.UpdateProfile(ctx, in, a.opts...)
+}
+
+func (a *accountServiceAPI) ChangePassword(ctx context.Context, in *ChangePasswordRequest) (*ChangePasswordResponse, error) {
+	return a.c.ChangePassword(ctx, in, a.opts...)
+}
+
+func (a *accountServiceAPI) DeleteAccount(ctx context.Context, in *DeleteAccountRequest) (*DeleteAccountResponse, error) {
+	return a.c.DeleteAccount(ctx, in, a.opts...)
+}
+
+func (a *accountServiceAPI) CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+	return a.c.CreateAPIKey(ctx, in, a.opts...)
+}
+
+func (a *accountServiceAPI) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error) {
+	return a.c.RevokeAPIKey(ctx, in, a.opts...)
+}
+
+func (a *accountServiceAPI) ValidateAPIKey(ctx context.Context, in *ValidateAPIKeyRequest) (*ValidateAPIKeyResponse, error) {
+	return a.c.ValidateAPIKey(ctx, in, a.opts...)
+}
+
+// AccountServiceClientFromAPI adapts a to AccountServiceClient, e.g. to hand a
+// mock AccountServiceAPI to code that takes the generated client. Call options
+// are ignored, and streams report empty headers and trailers.
+func AccountServiceClientFromAPI(a AccountServiceAPI) AccountServiceClient {
+	return accountServiceAPIClient{api: a}
+}
+
+type accountServiceAPIClient struct {
+	api AccountServiceAPI
+}
+
+func (c accountServiceAPIClient) GetKakaoLoginURL(ctx context.Context, in *GetKakaoLoginURLRequest, _ ...grpc.CallOption) (*GetKakaoLoginURLResponse, error) {
+	return c.api.GetKakaoLoginURL(ctx, in)
+}
+
+func (c accountServiceAPIClient) GetKakaoCallBack(ctx context.Context, in *GetKakaoCallBackRequest, _ ...grpc.CallOption) (*GetKakaoCallBackResponse, error) {
+	return c.api.GetKakaoCallBack(ctx, in)
+}
+
+func (c accountServiceAPIClient) Login(ctx context.Context, in *LoginRequest, _ ...grpc.CallOption) (*LoginResponse, error) {
+	return c.api.Login(ctx, in)
+}
+
+func (c accountServiceAPIClient) Register(ctx context.Context, in *RegisterRequest, _ ...grpc.CallOption) (*RegisterResponse, error) {
+	return c.api.Register(ctx, in)
+}
+
+func (c accountServiceAPIClient) RefreshToken(ctx context.Context, in *RefreshTokenRequest, _ ...grpc.CallOption) (*RefreshTokenResponse, error) {
+	return c.api.RefreshToken(ctx, in)
+}
+
+func (c accountServiceAPIClient) RevokeToken(ctx context.Context, in *RevokeTokenRequest, _ ...grpc.CallOption) (*RevokeTokenResponse, error) {
+	return c.api.RevokeToken(ctx, in)
+}
+
+func (c accountServiceAPIClient) AnonymizeUserData(ctx context.Context, in *AnonymizeUserDataRequest, _ ...grpc.CallOption) (*AnonymizeUserDataResponse, error) {
+	return c.api.AnonymizeUserData(ctx, in)
+}
+
+func (c accountServiceAPIClient) AcceptTerms(ctx context.Context, in *AcceptTermsRequest, _ ...grpc.CallOption) (*AcceptTermsResponse, error) {
+	return c.api.AcceptTerms(ctx, in)
+}
+
+func (c accountServiceAPIClient) RegisterPushToken(ctx context.Context, in *RegisterPushTokenRequest, _ ...grpc.CallOption) (*RegisterPushTokenResponse, error) {
+	return c.api.RegisterPushToken(ctx, in)
+}
+
+func (c accountServiceAPIClient) UnregisterPushToken(ctx context.Context, in *UnregisterPushTokenRequest, _ ...grpc.CallOption) (*UnregisterPushTokenResponse, error) {
+	return c.api.UnregisterPushToken(ctx, in)
+}
+
+func (c accountServiceAPIClient) VerifyCaptcha(ctx context.Context, in *VerifyCaptchaRequest, _ ...grpc.CallOption) (*VerifyCaptchaResponse, error) {
+	return c.api.VerifyCaptcha(ctx, in)
+}
+
+func (c accountServiceAPIClient) UnlockAccount(ctx context.Context, in *UnlockAccountRequest, _ ...grpc.CallOption) (*UnlockAccountResponse, error) {
+	return c.api.UnlockAccount(ctx, in)
+}
+
+func (c accountServiceAPIClient) IssueGuestToken(ctx context.Context, in *IssueGuestTokenRequest, _ ...grpc.CallOption) (*IssueGuestTokenResponse, error) {
+	return c.api.IssueGuestToken(ctx, in)
+}
+
+func (c accountServiceAPIClient) MergeAccounts(ctx context.Context, in *MergeAccountsRequest, _ ...grpc.CallOption) (*MergeAccountsResponse, error) {
+	return c.api.MergeAccounts(ctx, in)
+}
+
+func (c accountServiceAPIClient) RequestEmailChange(ctx context.Context, in *RequestEmailChangeRequest, _ ...grpc.CallOption) (*RequestEmailChangeResponse, error) {
+	return c.api.RequestEmailChange(ctx, in)
+}
+
+func (c accountServiceAPIClient) ConfirmEmailChange(ctx context.Context, in *ConfirmEmailChangeRequest, _ ...grpc.CallOption) (*ConfirmEmailChangeResponse, error) {
+	return c.api.ConfirmEmailChange(ctx, in)
+}
+
+func (c accountServiceAPIClient) UploadAvatar(ctx context.Context, _ ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAvatarRequest, UploadAvatarResponse], error) {
+	return newSeqClientStreamingClient(ctx, c.api.UploadAvatar), nil
+}
+
+func (c accountServiceAPIClient) GetPreferences(ctx context.Context, in *GetPreferencesRequest, _ ...grpc.CallOption) (*GetPreferencesResponse, error) {
+	return c.api.GetPreferences(ctx, in)
+}
+
+func (c accountServiceAPIClient) SetPreferences(ctx context.Context, in *SetPreferencesRequest, _ ...grpc.CallOption) (*SetPreferencesResponse, error) {
+	return c.api.SetPreferences(ctx, in)
+}
+
+func (c accountServiceAPIClient) GetMe(ctx context.Context, in *GetMeRequest, _ ...grpc.CallOption) (*GetMeResponse, error) {
+	return c.api.GetMe(ctx, in)
+}
+
+func (c accountServiceAPIClient) UpdateProfile(ctx context.Context, in *UpdateProfileRequest, _ ...grpc.CallOption) (*UpdateProfileResponse, error) {
+	return c.api.UpdateProfile(ctx, in)
+}
+
+func (c accountServiceAPIClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, _ ...grpc.CallOption) (*ChangePasswordResponse, error) {
+	return c.api.ChangePassword(ctx, in)
+}
+
+func (c accountServiceAPIClient) DeleteAccount(ctx context.Context, in *DeleteAccountRequest, _ ...grpc.CallOption) (*DeleteAccountResponse, error) {
+	return c.api.DeleteAccount(ctx, in)
+}
+
+func (c accountServiceAPIClient) CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, _ ...grpc.CallOption) (*CreateAPIKeyResponse, error) {
+	return c.api.CreateAPIKey(ctx, in)
+}
+
+func (c accountServiceAPIClient) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, _ ...grpc.CallOption) (*RevokeAPIKeyResponse, error) {
+	return c.api.RevokeAPIKey(ctx, in)
+}
+
+func (c accountServiceAPIClient) ValidateAPIKey(ctx context.Context, in *ValidateAPIKeyRequest, _ ...grpc.CallOption) (*ValidateAPIKeyResponse, error) {
+	return c.api.ValidateAPIKey(ctx, in)
+}
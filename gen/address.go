@@ -0,0 +1,168 @@
+package gen
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+)
+
+var (
+	krPostalCode   = regexp.MustCompile(`^[0-9]{5}$`)
+	postalCode     = regexp.MustCompile(`^[0-9A-Za-z][0-9A-Za-z -]{1,9}$`)
+	countryCode    = regexp.MustCompile(`^[A-Z]{2}$`)
+	legacyPostalRe = regexp.MustCompile(`^\s*[\[(]?([0-9]{5})[\])]?\s+`)
+)
+
+const maxRecipientLen = 50
+
+// CountryCode returns the address's country, defaulting to "KR".
+func (a *Address) CountryCode() string {
+	if c := a.GetCountry(); c != "" {
+		return c
+	}
+	return "KR"
+}
+
+// Validate checks that the address can be handed to a carrier: recipient,
+// phone, postal code and line1 are set, the phone number has 9 to 11 digits
+// (7 to 15 outside Korea), Korean postal codes have 5 digits, and addresses
+// outside Korea name a city. It returns an InvalidArgument error with
+// ERROR_REASON_INVALID_ADDRESS and a google.rpc.BadRequest listing every
+// violation; field names are relative to the address.
+func (a *Address) Validate() error {
+	var violations []*errdetails.BadRequest_FieldViolation
+	violate := func(field, desc string) {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{Field: field, Description: desc})
+	}
+	kr := a.CountryCode() == "KR"
+	switch n := utf8.RuneCountInString(strings.TrimSpace(a.GetRecipient())); {
+	case n == 0:
+		violate("recipient", "required")
+	case n > maxRecipientLen:
+		violate("recipient", "too long")
+	}
+	digits := strings.TrimPrefix(strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, a.GetPhone()), "+")
+	switch {
+	case digits == "":
+		violate("phone", "required")
+	case strings.Trim(digits, "0123456789") != "":
+		violate("phone", "must contain only digits, spaces and hyphens")
+	case kr && (len(digits) < 9 || len(digits) > 11 || digits[0] != '0'):
+		violate("phone", "must be a Korean phone number, e.g. 010-1234-5678")
+	case !kr && (len(digits) < 7 || len(digits) > 15):
+		violate("phone", "must have 7 to 15 digits")
+	}
+	switch {
+	case a.GetPostalCode() == "":
+		violate("postal_code", "required")
+	case kr && !krPostalCode.MatchString(a.GetPostalCode()):
+		violate("postal_code", "must be a 5-digit Korean postal code")
+	case !kr && !postalCode.MatchString(a.GetPostalCode()):
+		violate("postal_code", "invalid postal code")
+	}
+	if strings.TrimSpace(a.GetLine1()) == "" {
+		violate("line1", "required")
+	}
+	if !kr && strings.TrimSpace(a.GetCity()) == "" {
+		violate("city", "required outside KR")
+	}
+	if a.GetCountry() != "" && !countryCode.MatchString(a.GetCountry()) {
+		violate("country", "must be an ISO 3166-1 alpha-2 code")
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+	return reasonError(codes.InvalidArgument, ErrorReason_ERROR_REASON_INVALID_ADDRESS,
+		"invalid address: "+violations[0].GetField()+" "+violations[0].GetDescription(),
+		map[string]string{"field": violations[0].GetField()},
+		&errdetails.BadRequest{FieldViolations: violations})
+}
+
+// Legacy formats the address as the single line older services wrote to the
+// deprecated shipping_address field, e.g. "[06236] 서울특별시 강남구 테헤란로
+// 123 4층". The city is omitted when line1 already starts with it. It is empty
+// for a nil address.
+func (a *Address) Legacy() string {
+	if a == nil {
+		return ""
+	}
+	var parts []string
+	if a.GetPostalCode() != "" {
+		parts = append(parts, "["+a.GetPostalCode()+"]")
+	}
+	if a.GetCity() != "" && !strings.HasPrefix(a.GetLine1(), a.GetCity()) {
+		parts = append(parts, a.GetCity())
+	}
+	for _, s := range []string{a.GetLine1(), a.GetLine2()} {
+		if s = strings.TrimSpace(s); s != "" {
+			parts = append(parts, s)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// ParseLegacyAddress converts a free-text shipping_address into an Address on
+// a best-effort basis: a leading 5-digit postal code (optionally in brackets
+// or parentheses) becomes postal_code and the rest line1. Recipient and phone
+// are unknown, so the result does not pass Validate. It returns nil for an
+// empty string.
+func ParseLegacyAddress(s string) *Address {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+	a := &Address{Line1: s}
+	if m := legacyPostalRe.FindStringSubmatch(s); m != nil {
+		a.PostalCode, a.Line1 = m[1], strings.TrimSpace(s[len(m[0]):])
+	}
+	return a
+}
+
+// EffectiveDeliveryAddress returns the order's delivery_address, falling back
+// to the deprecated shipping_address of orders written by services that
+// predate Address (see ParseLegacyAddress). It is nil if neither is set.
+func (o *Order) EffectiveDeliveryAddress() *Address {
+	return effectiveAddress(o.GetDeliveryAddress(), o.GetShippingAddress())
+}
+
+// SyncDeliveryAddress fills in whichever of delivery_address and
+// shipping_address is missing from the other, so the order reads the same to
+// old and new services while they are rolled out.
+func (o *Order) SyncDeliveryAddress() {
+	o.DeliveryAddress, o.ShippingAddress = syncAddress(o.DeliveryAddress, o.ShippingAddress)
+}
+
+// EffectiveDeliveryAddress returns the requested delivery_address, falling
+// back to the deprecated shipping_address sent by older clients.
+func (r *InsertOrderRequest) EffectiveDeliveryAddress() *Address {
+	return effectiveAddress(r.GetDeliveryAddress(), r.GetShippingAddress())
+}
+
+// SyncDeliveryAddress fills in whichever of delivery_address and
+// shipping_address is missing from the other.
+func (r *InsertOrderRequest) SyncDeliveryAddress() {
+	r.DeliveryAddress, r.ShippingAddress = syncAddress(r.DeliveryAddress, r.ShippingAddress)
+}
+
+func effectiveAddress(a *Address, legacy string) *Address {
+	if a != nil {
+		return a
+	}
+	return ParseLegacyAddress(legacy)
+}
+
+func syncAddress(a *Address, legacy string) (*Address, string) {
+	a = effectiveAddress(a, legacy)
+	if legacy == "" {
+		legacy = a.Legacy()
+	}
+	return a, legacy
+}
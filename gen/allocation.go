@@ -0,0 +1,116 @@
+package gen
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// AllocateKRW splits amount won (e.g. an order-level discount) across lines in
+// proportion to weights (e.g. line subtotals), in multiples of unit won. Units
+// lost to rounding go to the lines with the largest fractional shares, ties
+// to the earlier line, so every service allocates identically. Any amount
+// below one unit cannot be allocated and is returned as remainder, which
+// PriceOrderResponse reports as rounding_remainder. If all weights are zero
+// nothing is allocated.
+func AllocateKRW(amount int64, weights []int64, unit int64) (shares []int64, remainder int64) {
+	shares = make([]int64, len(weights))
+	if unit < 1 {
+		unit = 1
+	}
+	var total big.Int
+	for _, w := range weights {
+		total.Add(&total, big.NewInt(max(w, 0)))
+	}
+	if total.Sign() == 0 || amount <= 0 {
+		return shares, amount
+	}
+	units := amount / unit
+	type frac struct {
+		i   int
+		rem big.Int
+	}
+	fracs := make([]frac, len(weights))
+	allocated := int64(0)
+	for i, w := range weights {
+		var q big.Int
+		q.Mul(big.NewInt(units), big.NewInt(max(w, 0)))
+		q.QuoRem(&q, &total, &fracs[i].rem)
+		fracs[i].i = i
+		shares[i] = q.Int64()
+		allocated += shares[i]
+	}
+	slices.SortStableFunc(fracs, func(a, b frac) int { return b.rem.Cmp(&a.rem) })
+	for k := int64(0); k < units-allocated; k++ {
+		shares[fracs[k].i]++
+	}
+	for i := range shares {
+		shares[i] *= unit
+	}
+	return shares, amount % unit
+}
+
+// CheckTotals verifies the arithmetic invariants of a pricing result: each
+// line's discount is the sum of its allocations and total is subtotal minus
+// discount; the order subtotal and discount_total are the sums over lines; and
+// total is subtotal - discount_total + shipping_fee. Servers should check
+// their results with it, and clients may check responses before charging.
+func (r *PriceOrderResponse) CheckTotals() error {
+	currency := r.GetTotal().GetCurrencyCode()
+	zero := &Money{CurrencyCode: currency}
+	subtotal, discount := zero, zero
+	var errs []error
+	for _, l := range r.GetLines() {
+		lineDiscount := zero
+		for _, a := range l.GetAllocations() {
+			lineDiscount = mustAdd(&errs, lineDiscount, a.GetAmount())
+		}
+		checkMoney(&errs, "line "+l.GetLineId()+" discount", l.GetDiscount(), lineDiscount)
+		checkMoney(&errs, "line "+l.GetLineId()+" total", l.GetTotal(), mustSub(&errs, l.GetSubtotal(), l.GetDiscount()))
+		subtotal = mustAdd(&errs, subtotal, l.GetSubtotal())
+		discount = mustAdd(&errs, discount, l.GetDiscount())
+	}
+	checkMoney(&errs, "subtotal", r.GetSubtotal(), subtotal)
+	checkMoney(&errs, "discount_total", r.GetDiscountTotal(), discount)
+	total := mustSub(&errs, r.GetSubtotal(), r.GetDiscountTotal())
+	total = mustAdd(&errs, total, MoneyOr(r.GetShippingFee(), 0).withCurrency(currency))
+	checkMoney(&errs, "total", r.GetTotal(), total)
+	return errors.Join(errs...)
+}
+
+// withCurrency gives a zero amount without a currency code the given one, so
+// unset optional amounts add up with any currency.
+func (m *Money) withCurrency(currency string) *Money {
+	if m.IsZero() && m.GetCurrencyCode() != currency {
+		return &Money{CurrencyCode: currency}
+	}
+	return m
+}
+
+func mustAdd(errs *[]error, a, b *Money) *Money {
+	sum, err := a.Add(b.withCurrency(a.GetCurrencyCode()))
+	if err != nil {
+		*errs = append(*errs, err)
+		return a
+	}
+	return sum
+}
+
+func mustSub(errs *[]error, a, b *Money) *Money {
+	diff, err := a.Sub(b.withCurrency(a.GetCurrencyCode()))
+	if err != nil {
+		*errs = append(*errs, err)
+		return a
+	}
+	return diff
+}
+
+func checkMoney(errs *[]error, what string, got, want *Money) {
+	got = got.withCurrency(want.GetCurrencyCode())
+	if !proto.Equal(got, want) {
+		*errs = append(*errs, fmt.Errorf("%s is %s, want %s", what, got.Format(), want.Format()))
+	}
+}
@@ -0,0 +1,86 @@
+package gen
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestAllocateKRW(t *testing.T) {
+	tests := []struct {
+		name          string
+		amount        int64
+		weights       []int64
+		unit          int64
+		wantShares    []int64
+		wantRemainder int64
+	}{
+		{"even", 3000, []int64{10000, 10000, 10000}, 10, []int64{1000, 1000, 1000}, 0},
+		{"proportional", 3000, []int64{20000, 10000}, 10, []int64{2000, 1000}, 0},
+		{"largest fraction first", 1000, []int64{1, 1, 1}, 10, []int64{340, 330, 330}, 0},
+		{"ties to earlier line", 20, []int64{1, 1, 1}, 10, []int64{10, 10, 0}, 0},
+		{"remainder below unit", 1005, []int64{1, 1}, 10, []int64{500, 500}, 5},
+		{"won unit", 1001, []int64{1, 1}, 1, []int64{501, 500}, 0},
+		{"unit below one", 1001, []int64{1, 1}, 0, []int64{501, 500}, 0},
+		{"zero weight line", 1000, []int64{0, 5000}, 10, []int64{0, 1000}, 0},
+		{"negative weight as zero", 1000, []int64{-5000, 5000}, 10, []int64{0, 1000}, 0},
+		{"all zero weights", 1000, []int64{0, 0}, 10, []int64{0, 0}, 1000},
+		{"nothing to allocate", 0, []int64{1, 1}, 10, []int64{0, 0}, 0},
+		{"large weights", 3_000_000_000, []int64{1 << 62, 1 << 62, 1 << 62}, 10, []int64{1_000_000_000, 1_000_000_000, 1_000_000_000}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shares, remainder := AllocateKRW(tt.amount, tt.weights, tt.unit)
+			if !slices.Equal(shares, tt.wantShares) || remainder != tt.wantRemainder {
+				t.Errorf("AllocateKRW() = %v, %d, want %v, %d", shares, remainder, tt.wantShares, tt.wantRemainder)
+			}
+		})
+	}
+}
+
+// pricedOrder is a consistent pricing result: two lines, a 3,000 won
+// promotion allocated 2:1 and 3,000 won shipping.
+func pricedOrder() *PriceOrderResponse {
+	return &PriceOrderResponse{
+		Lines: []*PricedLine{
+			{LineId: "a", Quantity: 2, UnitPrice: KRW(10000), Subtotal: KRW(20000),
+				Allocations: []*DiscountAllocation{{PromotionId: "p", Amount: KRW(2000)}}, Discount: KRW(2000), Total: KRW(18000)},
+			{LineId: "b", Quantity: 1, UnitPrice: KRW(10000), Subtotal: KRW(10000),
+				Allocations: []*DiscountAllocation{{PromotionId: "p", Amount: KRW(1000)}}, Discount: KRW(1000), Total: KRW(9000)},
+		},
+		Subtotal:      KRW(30000),
+		DiscountTotal: KRW(3000),
+		ShippingFee:   KRW(3000),
+		Total:         KRW(30000),
+	}
+}
+
+func TestCheckTotals(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(*PriceOrderResponse)
+		wantErr string
+	}{
+		{"consistent", func(*PriceOrderResponse) {}, ""},
+		{"free shipping unset", func(r *PriceOrderResponse) { r.ShippingFee, r.Total = nil, KRW(27000) }, ""},
+		{"line discount", func(r *PriceOrderResponse) { r.Lines[0].Discount = KRW(2500) }, "line a discount"},
+		{"line total", func(r *PriceOrderResponse) { r.Lines[1].Total = KRW(10000) }, "line b total"},
+		{"subtotal", func(r *PriceOrderResponse) { r.Subtotal = KRW(31000) }, "subtotal is KRW 31,000, want KRW 30,000"},
+		{"discount total", func(r *PriceOrderResponse) { r.DiscountTotal = KRW(0) }, "discount_total"},
+		{"total", func(r *PriceOrderResponse) { r.Total = KRW(27000) }, "total is KRW 27,000, want KRW 30,000"},
+		{"mixed currency", func(r *PriceOrderResponse) { r.Lines[0].Subtotal = &Money{CurrencyCode: "USD", Units: 20} }, "currency mismatch"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := pricedOrder()
+			tt.mutate(r)
+			err := r.CheckTotals()
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Errorf("CheckTotals() = %v", err)
+			case tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)):
+				t.Errorf("CheckTotals() = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
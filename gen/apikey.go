@@ -0,0 +1,172 @@
+package gen
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// APIKeyHeader is the HTTP header partners authenticate with.
+const APIKeyHeader = "X-Api-Key"
+
+// Metadata keys carrying the partner identity established by APIKeyAuth.
+const (
+	PartnerIDMetadata     = "x-partner-id"
+	PartnerScopesMetadata = "x-partner-scopes"
+)
+
+// APIKeyAuth is gateway middleware authenticating partners by X-Api-Key. Keys
+// are checked with AccountService.ValidateAPIKey (the gateway's credentials
+// need ScopeAPIKeysValidate) and the partner identity is forwarded to gRPC
+// services as x-partner-id / x-partner-scopes metadata. Requests without the
+// header pass through untouched for other authentication.
+//
+//	auth := &APIKeyAuth{Client: accountClient}
+//	mux := runtime.NewServeMux(runtime.WithMetadata(auth.Metadata))
+//	auth.Mux = mux
+//	http.ListenAndServe(":8080", auth.Handler(mux))
+type APIKeyAuth struct {
+	Client AccountServiceClient
+	// Mux renders rejections with its error handler; nil uses the defaults.
+	Mux *runtime.ServeMux
+	// CacheTTL is how long validation results are reused; zero means one
+	// minute, negative disables caching. Revocations take up to this long
+	// to apply.
+	CacheTTL time.Duration
+
+	mu    sync.Mutex
+	cache map[[sha256.Size]byte]apiKeyCacheEntry
+}
+
+type apiKeyCacheEntry struct {
+	key     *APIKey // nil for invalid keys
+	expires time.Time
+}
+
+type apiKeyCtxKey struct{}
+
+// Handler wraps next with API key authentication.
+func (a *APIKeyAuth) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Never let callers assert a partner identity themselves.
+		for h := range r.Header {
+			if lh := strings.ToLower(h); strings.HasPrefix(lh, strings.ToLower(runtime.MetadataHeaderPrefix)+"x-partner-") {
+				r.Header.Del(h)
+			}
+		}
+		secret := r.Header.Get(APIKeyHeader)
+		if secret == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		r.Header.Del(APIKeyHeader)
+
+		key, err := a.validate(r.Context(), secret)
+		if err != nil {
+			mux := a.Mux
+			if mux == nil {
+				mux = runtime.NewServeMux()
+			}
+			_, outbound := runtime.MarshalerForRequest(mux, r)
+			runtime.HTTPError(r.Context(), mux, outbound, w, r, err)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), apiKeyCtxKey{}, key)))
+	})
+}
+
+// Metadata is a runtime.WithMetadata annotator forwarding the authenticated
+// partner identity.
+func (a *APIKeyAuth) Metadata(_ context.Context, r *http.Request) metadata.MD {
+	key, ok := r.Context().Value(apiKeyCtxKey{}).(*APIKey)
+	if !ok {
+		return nil
+	}
+	return metadata.Pairs(
+		PartnerIDMetadata, key.GetPartnerId(),
+		PartnerScopesMetadata, strings.Join(key.GetScopes(), ","),
+	)
+}
+
+func errInvalidAPIKey() error {
+	return NewError(codes.Unauthenticated, ErrorReason_ERROR_REASON_UNAUTHENTICATED, "invalid API key", nil)
+}
+
+func (a *APIKeyAuth) validate(ctx context.Context, secret string) (*APIKey, error) {
+	ttl := a.CacheTTL
+	if ttl == 0 {
+		ttl = time.Minute
+	}
+	sum := sha256.Sum256([]byte(secret))
+	if ttl > 0 {
+		a.mu.Lock()
+		e, ok := a.cache[sum]
+		a.mu.Unlock()
+		if ok && time.Now().Before(e.expires) {
+			if e.key == nil {
+				return nil, errInvalidAPIKey()
+			}
+			return e.key, nil
+		}
+	}
+
+	resp, err := a.Client.ValidateAPIKey(ctx, &ValidateAPIKeyRequest{Secret: secret})
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "API key validation unavailable")
+	}
+	var key *APIKey
+	if resp.GetValid() {
+		key = resp.GetApiKey()
+	}
+	if ttl > 0 {
+		a.mu.Lock()
+		if a.cache == nil {
+			a.cache = make(map[[sha256.Size]byte]apiKeyCacheEntry)
+		}
+		now := time.Now()
+		for k, e := range a.cache {
+			if now.After(e.expires) {
+				delete(a.cache, k)
+			}
+		}
+		a.cache[sum] = apiKeyCacheEntry{key: key, expires: now.Add(ttl)}
+		a.mu.Unlock()
+	}
+	if key == nil {
+		return nil, errInvalidAPIKey()
+	}
+	return key, nil
+}
+
+// PartnerFromContext returns the partner identity forwarded by APIKeyAuth.
+// Servers must only trust it on traffic that comes through the gateway.
+func PartnerFromContext(ctx context.Context) (partnerID string, scopes []string, ok bool) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	ids := md.Get(PartnerIDMetadata)
+	if len(ids) == 0 || ids[0] == "" {
+		return "", nil, false
+	}
+	if s := md.Get(PartnerScopesMetadata); len(s) > 0 && s[0] != "" {
+		scopes = strings.Split(s[0], ",")
+	}
+	return ids[0], scopes, true
+}
+
+// PartnerScopes is a ScopeExtractor returning the scopes of the partner
+// calling through the gateway.
+func PartnerScopes(ctx context.Context) ([]string, error) {
+	_, scopes, ok := PartnerFromContext(ctx)
+	if !ok {
+		return nil, errors.New("no partner identity")
+	}
+	return scopes, nil
+}
@@ -0,0 +1,27 @@
+package gen
+
+import (
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MaxAvatarBytes is the largest avatar UploadAvatar accepts.
+const MaxAvatarBytes = 5 << 20
+
+// AvatarContentTypes lists the image types UploadAvatar accepts.
+var AvatarContentTypes = []string{"image/jpeg", "image/png", "image/webp"}
+
+// Validate checks the declared type and size of an avatar upload. Servers
+// should call it on the first UploadAvatar message and again with the received
+// byte count once the stream ends.
+func (m *AvatarMetadata) Validate() error {
+	if !slices.Contains(AvatarContentTypes, m.GetContentType()) {
+		return status.Errorf(codes.InvalidArgument, "unsupported avatar content type %q", m.GetContentType())
+	}
+	if m.GetSizeBytes() <= 0 || m.GetSizeBytes() > MaxAvatarBytes {
+		return status.Errorf(codes.InvalidArgument, "avatar size must be between 1 and %d bytes", MaxAvatarBytes)
+	}
+	return nil
+}
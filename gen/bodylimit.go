@@ -0,0 +1,121 @@
+package gen
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// BodyLimits is gateway middleware bounding request bodies before they are
+// decoded and proxied, so pathological payloads never reach the gRPC
+// services. Oversized bodies are rejected with 413 Request Entity Too Large,
+// JSON nested deeper than MaxDepth with 400 Bad Request.
+//
+//	limits := &BodyLimits{Mux: mux, RouteMaxBytes: map[string]int64{"/v1/order/import": 32 << 20}}
+//	http.ListenAndServe(":8080", limits.Handler(mux))
+type BodyLimits struct {
+	// MaxBytes caps request bodies; zero means 1 MiB.
+	MaxBytes int64
+	// MaxDepth caps JSON object/array nesting; zero means 32.
+	MaxDepth int
+	// RouteMaxBytes overrides MaxBytes for paths starting with a key, e.g.
+	// "/users/me/avatar". The longest matching prefix wins.
+	RouteMaxBytes map[string]int64
+	// Mux renders rejections with its error handler; nil uses the defaults.
+	Mux *runtime.ServeMux
+}
+
+func (l *BodyLimits) limitFor(path string) int64 {
+	limit, matched := l.MaxBytes, ""
+	if limit == 0 {
+		limit = 1 << 20
+	}
+	for prefix, n := range l.RouteMaxBytes {
+		if strings.HasPrefix(path, prefix) && len(prefix) > len(matched) {
+			limit, matched = n, prefix
+		}
+	}
+	return limit
+}
+
+// Handler wraps next with the limits.
+func (l *BodyLimits) Handler(next http.Handler) http.Handler {
+	maxDepth := l.MaxDepth
+	if maxDepth == 0 {
+		maxDepth = 32
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Body == nil || r.Body == http.NoBody {
+			next.ServeHTTP(w, r)
+			return
+		}
+		limit := l.limitFor(r.URL.Path)
+		tooLarge := &runtime.HTTPStatusError{
+			HTTPStatus: http.StatusRequestEntityTooLarge,
+			Err:        status.Errorf(codes.InvalidArgument, "request body exceeds %d bytes", limit),
+		}
+		if r.ContentLength > limit {
+			l.reject(w, r, tooLarge)
+			return
+		}
+		body, err := io.ReadAll(io.LimitReader(r.Body, limit+1))
+		r.Body.Close()
+		if err != nil {
+			l.reject(w, r, status.Error(codes.InvalidArgument, "reading request body failed"))
+			return
+		}
+		if int64(len(body)) > limit {
+			l.reject(w, r, tooLarge)
+			return
+		}
+		if depth := jsonDepth(body); depth > maxDepth {
+			l.reject(w, r, status.Errorf(codes.InvalidArgument, "request JSON nested deeper than %d levels", maxDepth))
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (l *BodyLimits) reject(w http.ResponseWriter, r *http.Request, err error) {
+	mux := l.Mux
+	if mux == nil {
+		mux = runtime.NewServeMux()
+	}
+	_, outbound := runtime.MarshalerForRequest(mux, r)
+	runtime.HTTPError(r.Context(), mux, outbound, w, r, err)
+}
+
+// jsonDepth returns the deepest object/array nesting in data, ignoring
+// brackets inside strings. It does not validate the JSON; the gateway's
+// decoder does that afterwards.
+func jsonDepth(data []byte) int {
+	depth, deepest := 0, 0
+	inString, escaped := false, false
+	for _, c := range data {
+		switch {
+		case inString:
+			switch {
+			case escaped:
+				escaped = false
+			case c == '\\':
+				escaped = true
+			case c == '"':
+				inString = false
+			}
+		case c == '"':
+			inString = true
+		case c == '{' || c == '[':
+			depth++
+			deepest = max(deepest, depth)
+		case c == '}' || c == ']':
+			depth--
+		}
+	}
+	return deepest
+}
@@ -0,0 +1,143 @@
+package gen
+
+import (
+	"time"
+)
+
+// KST is Korea Standard Time. Korea observes no daylight saving time, so a
+// fixed zone avoids depending on the tz database being installed.
+var KST = time.FixedZone("KST", 9*60*60)
+
+// HolidayCalendar reports non-working days besides weekends. Lunar holidays
+// (설날, 추석), substitute holidays and company closures change every year,
+// so services supply their own, e.g. from a config file or an admin API.
+type HolidayCalendar interface {
+	// IsHoliday reports whether date (midnight KST) is a holiday.
+	IsHoliday(date time.Time) bool
+}
+
+// HolidayDates is a HolidayCalendar of YYYY-MM-DD dates in KST.
+type HolidayDates map[string]bool
+
+// NewHolidayDates returns a calendar of the given YYYY-MM-DD dates.
+func NewHolidayDates(dates ...string) HolidayDates {
+	h := make(HolidayDates, len(dates))
+	for _, d := range dates {
+		h[d] = true
+	}
+	return h
+}
+
+// IsHoliday implements HolidayCalendar.
+func (h HolidayDates) IsHoliday(date time.Time) bool { return h[FormatKSTDate(date)] }
+
+// FixedKoreanHolidays is a HolidayCalendar of the Korean public holidays with
+// fixed solar dates: 신정, 삼일절, 어린이날, 현충일, 광복절, 개천절, 한글날
+// and 성탄절. Combine it with the lunar and substitute holidays of the year
+// using Holidays.
+var FixedKoreanHolidays HolidayCalendar = fixedKoreanHolidays{}
+
+type fixedKoreanHolidays struct{}
+
+func (fixedKoreanHolidays) IsHoliday(date time.Time) bool {
+	_, m, d := date.In(KST).Date()
+	switch {
+	case m == time.January && d == 1,
+		m == time.March && d == 1,
+		m == time.May && d == 5,
+		m == time.June && d == 6,
+		m == time.August && d == 15,
+		m == time.October && (d == 3 || d == 9),
+		m == time.December && d == 25:
+		return true
+	}
+	return false
+}
+
+// Holidays returns a calendar observing every holiday of cals.
+func Holidays(cals ...HolidayCalendar) HolidayCalendar { return holidayUnion(cals) }
+
+type holidayUnion []HolidayCalendar
+
+func (u holidayUnion) IsHoliday(date time.Time) bool {
+	for _, c := range u {
+		if c != nil && c.IsHoliday(date) {
+			return true
+		}
+	}
+	return false
+}
+
+// FormatKSTDate formats t as a YYYY-MM-DD date in KST, the format of the date
+// fields in DeliveryEstimate.
+func FormatKSTDate(t time.Time) string { return t.In(KST).Format(time.DateOnly) }
+
+// BusinessCalendar computes KST business days for shipping and delivery
+// estimates. The zero value counts every weekday as a business day and ships
+// orders placed on a business day the same day.
+type BusinessCalendar struct {
+	// Holidays are the non-working weekdays; nil means none.
+	Holidays HolidayCalendar
+	// Cutoff is the same-day shipping cutoff as an offset from midnight KST,
+	// e.g. 15*time.Hour for 3 PM. Orders after it ship the next business day.
+	// Zero means no cutoff.
+	Cutoff time.Duration
+}
+
+// kstMidnight returns midnight KST of the day containing t.
+func kstMidnight(t time.Time) time.Time {
+	y, m, d := t.In(KST).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, KST)
+}
+
+// IsBusinessDay reports whether the KST day containing t is neither a weekend
+// nor a holiday.
+func (c BusinessCalendar) IsBusinessDay(t time.Time) bool {
+	day := kstMidnight(t)
+	if wd := day.Weekday(); wd == time.Saturday || wd == time.Sunday {
+		return false
+	}
+	return c.Holidays == nil || !c.Holidays.IsHoliday(day)
+}
+
+// AddBusinessDays returns midnight KST of the n-th business day after the day
+// containing t. n <= 0 returns that day itself (moved forward to a business
+// day if needed).
+func (c BusinessCalendar) AddBusinessDays(t time.Time, n int) time.Time {
+	day := kstMidnight(t)
+	for !c.IsBusinessDay(day) {
+		day = day.AddDate(0, 0, 1)
+	}
+	for ; n > 0; n-- {
+		day = day.AddDate(0, 0, 1)
+		for !c.IsBusinessDay(day) {
+			day = day.AddDate(0, 0, 1)
+		}
+	}
+	return day
+}
+
+// ShipDate returns midnight KST of the day an order placed at orderedAt
+// ships: the same day if it is a business day and before Cutoff, otherwise
+// the next business day.
+func (c BusinessCalendar) ShipDate(orderedAt time.Time) time.Time {
+	day := kstMidnight(orderedAt)
+	if !c.IsBusinessDay(day) || (c.Cutoff > 0 && !orderedAt.Before(day.Add(c.Cutoff))) {
+		return c.AddBusinessDays(day, 1)
+	}
+	return day
+}
+
+// EstimateDelivery returns the delivery estimate for an order placed at
+// orderedAt whose carrier delivers minDays to maxDays business days after
+// shipping.
+func (c BusinessCalendar) EstimateDelivery(orderedAt time.Time, minDays, maxDays int) *DeliveryEstimate {
+	ship := c.ShipDate(orderedAt)
+	return &DeliveryEstimate{
+		ShipDate:        FormatKSTDate(ship),
+		EarliestDate:    FormatKSTDate(c.AddBusinessDays(ship, minDays)),
+		LatestDate:      FormatKSTDate(c.AddBusinessDays(ship, maxDays)),
+		MinBusinessDays: int32(minDays),
+		MaxBusinessDays: int32(maxDays),
+	}
+}
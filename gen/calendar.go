@@ -0,0 +1,87 @@
+package gen
+
+import (
+	"time"
+)
+
+// Calendar returns the listed holidays as a HolidayCalendar, e.g. for
+// BusinessCalendar.Holidays:
+//
+//	res, err := calendar.ListHolidays(ctx, &pb.ListHolidaysRequest{IncludeCompany: true})
+//	cal := pb.BusinessCalendar{Holidays: res.Calendar(), Cutoff: 15 * time.Hour}
+func (r *ListHolidaysResponse) Calendar() HolidayDates {
+	h := make(HolidayDates, len(r.GetHolidays()))
+	for _, d := range r.GetHolidays() {
+		h[d.GetDate()] = true
+	}
+	return h
+}
+
+// IsOpen reports whether customer support is open at t. Support is closed on
+// Sundays, on holidays, during lunch, and on Saturdays unless
+// open_on_saturday is set.
+func (h *SupportHours) IsOpen(t time.Time, holidays HolidayCalendar) bool {
+	open, close, ok := h.hoursOn(t, holidays)
+	if !ok || t.Before(open) || !t.Before(close) {
+		return false
+	}
+	if ls, le, ok := h.lunchOn(t); ok && !t.Before(ls) && t.Before(le) {
+		return false
+	}
+	return true
+}
+
+// NextOpen returns t if support is open at t, otherwise the next time it
+// opens within two weeks. It returns the zero time if the hours are unset or
+// support stays closed that long.
+func (h *SupportHours) NextOpen(t time.Time, holidays HolidayCalendar) time.Time {
+	if h.IsOpen(t, holidays) {
+		return t
+	}
+	if _, close, ok := h.hoursOn(t, holidays); ok {
+		if ls, le, ok := h.lunchOn(t); ok && !t.Before(ls) && t.Before(le) && le.Before(close) {
+			return le
+		}
+	}
+	for day := kstMidnight(t); day.Before(t.AddDate(0, 0, 14)); day = day.AddDate(0, 0, 1) {
+		if open, _, ok := h.hoursOn(day, holidays); ok && open.After(t) {
+			return open
+		}
+	}
+	return time.Time{}
+}
+
+// hoursOn returns the opening and closing times on the KST day containing t,
+// or ok=false if support is closed all day.
+func (h *SupportHours) hoursOn(t time.Time, holidays HolidayCalendar) (open, close time.Time, ok bool) {
+	day := kstMidnight(t)
+	if day.Weekday() == time.Sunday || (holidays != nil && holidays.IsHoliday(day)) {
+		return time.Time{}, time.Time{}, false
+	}
+	closeAt := h.GetWeekdayClose()
+	if day.Weekday() == time.Saturday {
+		if !h.GetOpenOnSaturday() {
+			return time.Time{}, time.Time{}, false
+		}
+		closeAt = h.GetSaturdayClose()
+	}
+	open, ok1 := clockOn(day, h.GetWeekdayOpen())
+	close, ok2 := clockOn(day, closeAt)
+	return open, close, ok1 && ok2 && open.Before(close)
+}
+
+func (h *SupportHours) lunchOn(t time.Time) (start, end time.Time, ok bool) {
+	day := kstMidnight(t)
+	start, ok1 := clockOn(day, h.GetLunchStart())
+	end, ok2 := clockOn(day, h.GetLunchEnd())
+	return start, end, ok1 && ok2
+}
+
+// clockOn returns the HH:MM time hhmm on day (midnight KST).
+func clockOn(day time.Time, hhmm string) (time.Time, bool) {
+	c, err := time.Parse("15:04", hhmm)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return day.Add(time.Duration(c.Hour())*time.Hour + time.Duration(c.Minute())*time.Minute), true
+}
@@ -0,0 +1,525 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: calendar.proto
+
+package gen
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type HolidayKind int32
+
+const (
+	HolidayKind_HOLIDAY_KIND_UNSPECIFIED HolidayKind = 0
+	HolidayKind_HOLIDAY_KIND_PUBLIC      HolidayKind = 1 // 법정 공휴일 (설날, 추석 포함)
+	HolidayKind_HOLIDAY_KIND_SUBSTITUTE  HolidayKind = 2 // 대체공휴일
+	HolidayKind_HOLIDAY_KIND_TEMPORARY   HolidayKind = 3 // 임시공휴일 (선거일 등)
+	HolidayKind_HOLIDAY_KIND_COMPANY     HolidayKind = 4 // 회사 휴무일 (물류센터 휴무 등)
+)
+
+// Enum value maps for HolidayKind.
+var (
+	HolidayKind_name = map[int32]string{
+		0: "HOLIDAY_KIND_UNSPECIFIED",
+		1: "HOLIDAY_KIND_PUBLIC",
+		2: "HOLIDAY_KIND_SUBSTITUTE",
+		3: "HOLIDAY_KIND_TEMPORARY",
+		4: "HOLIDAY_KIND_COMPANY",
+	}
+	HolidayKind_value = map[string]int32{
+		"HOLIDAY_KIND_UNSPECIFIED": 0,
+		"HOLIDAY_KIND_PUBLIC":      1,
+		"HOLIDAY_KIND_SUBSTITUTE":  2,
+		"HOLIDAY_KIND_TEMPORARY":   3,
+		"HOLIDAY_KIND_COMPANY":     4,
+	}
+)
+
+func (x HolidayKind) Enum() *HolidayKind {
+	p := new(HolidayKind)
+	*p = x
+	return p
+}
+
+func (x HolidayKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HolidayKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_calendar_proto_enumTypes[0].Descriptor()
+}
+
+func (HolidayKind) Type() protoreflect.EnumType {
+	return &file_calendar_proto_enumTypes[0]
+}
+
+func (x HolidayKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HolidayKind.Descriptor instead.
+func (HolidayKind) EnumDescriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{0}
+}
+
+type Holiday struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD (KST)
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // ex) "추석"
+	Kind          HolidayKind            `protobuf:"varint,3,opt,name=kind,proto3,enum=go.escape.ship.proto.v1.HolidayKind" json:"kind,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Holiday) Reset() {
+	*x = Holiday{}
+	mi := &file_calendar_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Holiday) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Holiday) ProtoMessage() {}
+
+func (x *Holiday) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Holiday.ProtoReflect.Descriptor instead.
+func (*Holiday) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Holiday) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *Holiday) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Holiday) GetKind() HolidayKind {
+	if x != nil {
+		return x.Kind
+	}
+	return HolidayKind_HOLIDAY_KIND_UNSPECIFIED
+}
+
+type ListHolidaysRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	FromDate       string                 `protobuf:"bytes,1,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`                    // YYYY-MM-DD, 비어 있으면 오늘
+	ToDate         string                 `protobuf:"bytes,2,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`                          // YYYY-MM-DD, 비어 있으면 from_date로부터 1년
+	IncludeCompany bool                   `protobuf:"varint,3,opt,name=include_company,json=includeCompany,proto3" json:"include_company,omitempty"` // 회사 휴무일 포함 여부
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListHolidaysRequest) Reset() {
+	*x = ListHolidaysRequest{}
+	mi := &file_calendar_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHolidaysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHolidaysRequest) ProtoMessage() {}
+
+func (x *ListHolidaysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHolidaysRequest.ProtoReflect.Descriptor instead.
+func (*ListHolidaysRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListHolidaysRequest) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *ListHolidaysRequest) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+func (x *ListHolidaysRequest) GetIncludeCompany() bool {
+	if x != nil {
+		return x.IncludeCompany
+	}
+	return false
+}
+
+type ListHolidaysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Holidays      []*Holiday             `protobuf:"bytes,1,rep,name=holidays,proto3" json:"holidays,omitempty"` // 날짜 순
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHolidaysResponse) Reset() {
+	*x = ListHolidaysResponse{}
+	mi := &file_calendar_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHolidaysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHolidaysResponse) ProtoMessage() {}
+
+func (x *ListHolidaysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHolidaysResponse.ProtoReflect.Descriptor instead.
+func (*ListHolidaysResponse) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListHolidaysResponse) GetHolidays() []*Holiday {
+	if x != nil {
+		return x.Holidays
+	}
+	return nil
+}
+
+// 고객센터 운영 시간 (KST, 공휴일 휴무)
+type SupportHours struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	WeekdayOpen    string                 `protobuf:"bytes,1,opt,name=weekday_open,json=weekdayOpen,proto3" json:"weekday_open,omitempty"`    // HH:MM (ex: "09:00")
+	WeekdayClose   string                 `protobuf:"bytes,2,opt,name=weekday_close,json=weekdayClose,proto3" json:"weekday_close,omitempty"` // HH:MM (ex: "18:00")
+	LunchStart     string                 `protobuf:"bytes,3,opt,name=lunch_start,json=lunchStart,proto3" json:"lunch_start,omitempty"`       // 점심시간 시작, 없으면 빈 문자열
+	LunchEnd       string                 `protobuf:"bytes,4,opt,name=lunch_end,json=lunchEnd,proto3" json:"lunch_end,omitempty"`
+	OpenOnSaturday bool                   `protobuf:"varint,5,opt,name=open_on_saturday,json=openOnSaturday,proto3" json:"open_on_saturday,omitempty"`
+	SaturdayClose  string                 `protobuf:"bytes,6,opt,name=saturday_close,json=saturdayClose,proto3" json:"saturday_close,omitempty"` // 토요일 운영 시 종료 시각
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SupportHours) Reset() {
+	*x = SupportHours{}
+	mi := &file_calendar_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SupportHours) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SupportHours) ProtoMessage() {}
+
+func (x *SupportHours) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SupportHours.ProtoReflect.Descriptor instead.
+func (*SupportHours) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SupportHours) GetWeekdayOpen() string {
+	if x != nil {
+		return x.WeekdayOpen
+	}
+	return ""
+}
+
+func (x *SupportHours) GetWeekdayClose() string {
+	if x != nil {
+		return x.WeekdayClose
+	}
+	return ""
+}
+
+func (x *SupportHours) GetLunchStart() string {
+	if x != nil {
+		return x.LunchStart
+	}
+	return ""
+}
+
+func (x *SupportHours) GetLunchEnd() string {
+	if x != nil {
+		return x.LunchEnd
+	}
+	return ""
+}
+
+func (x *SupportHours) GetOpenOnSaturday() bool {
+	if x != nil {
+		return x.OpenOnSaturday
+	}
+	return false
+}
+
+func (x *SupportHours) GetSaturdayClose() string {
+	if x != nil {
+		return x.SaturdayClose
+	}
+	return ""
+}
+
+type GetSupportHoursRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSupportHoursRequest) Reset() {
+	*x = GetSupportHoursRequest{}
+	mi := &file_calendar_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSupportHoursRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSupportHoursRequest) ProtoMessage() {}
+
+func (x *GetSupportHoursRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSupportHoursRequest.ProtoReflect.Descriptor instead.
+func (*GetSupportHoursRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{4}
+}
+
+type GetSupportHoursResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hours         *SupportHours          `protobuf:"bytes,1,opt,name=hours,proto3" json:"hours,omitempty"`
+	OpenNow       bool                   `protobuf:"varint,2,opt,name=open_now,json=openNow,proto3" json:"open_now,omitempty"`
+	NextOpenAt    string                 `protobuf:"bytes,3,opt,name=next_open_at,json=nextOpenAt,proto3" json:"next_open_at,omitempty"` // 현재 운영 중이 아니면 다음 운영 시작 시각 (RFC3339)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSupportHoursResponse) Reset() {
+	*x = GetSupportHoursResponse{}
+	mi := &file_calendar_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSupportHoursResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSupportHoursResponse) ProtoMessage() {}
+
+func (x *GetSupportHoursResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSupportHoursResponse.ProtoReflect.Descriptor instead.
+func (*GetSupportHoursResponse) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetSupportHoursResponse) GetHours() *SupportHours {
+	if x != nil {
+		return x.Hours
+	}
+	return nil
+}
+
+func (x *GetSupportHoursResponse) GetOpenNow() bool {
+	if x != nil {
+		return x.OpenNow
+	}
+	return false
+}
+
+func (x *GetSupportHoursResponse) GetNextOpenAt() string {
+	if x != nil {
+		return x.NextOpenAt
+	}
+	return ""
+}
+
+var File_calendar_proto protoreflect.FileDescriptor
+
+const file_calendar_proto_rawDesc = "" +
+	"\n" +
+	"\x0ecalendar.proto\x12\x17go.escape.ship.proto.v1\x1a\x1cgoogle/api/annotations.proto\"k\n" +
+	"\aHoliday\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x128\n" +
+	"\x04kind\x18\x03 \x01(\x0e2$.go.escape.ship.proto.v1.HolidayKindR\x04kind\"t\n" +
+	"\x13ListHolidaysRequest\x12\x1b\n" +
+	"\tfrom_date\x18\x01 \x01(\tR\bfromDate\x12\x17\n" +
+	"\ato_date\x18\x02 \x01(\tR\x06toDate\x12'\n" +
+	"\x0finclude_company\x18\x03 \x01(\bR\x0eincludeCompany\"T\n" +
+	"\x14ListHolidaysResponse\x12<\n" +
+	"\bholidays\x18\x01 \x03(\v2 .go.escape.ship.proto.v1.HolidayR\bholidays\"\xe5\x01\n" +
+	"\fSupportHours\x12!\n" +
+	"\fweekday_open\x18\x01 \x01(\tR\vweekdayOpen\x12#\n" +
+	"\rweekday_close\x18\x02 \x01(\tR\fweekdayClose\x12\x1f\n" +
+	"\vlunch_start\x18\x03 \x01(\tR\n" +
+	"lunchStart\x12\x1b\n" +
+	"\tlunch_end\x18\x04 \x01(\tR\blunchEnd\x12(\n" +
+	"\x10open_on_saturday\x18\x05 \x01(\bR\x0eopenOnSaturday\x12%\n" +
+	"\x0esaturday_close\x18\x06 \x01(\tR\rsaturdayClose\"\x18\n" +
+	"\x16GetSupportHoursRequest\"\x93\x01\n" +
+	"\x17GetSupportHoursResponse\x12;\n" +
+	"\x05hours\x18\x01 \x01(\v2%.go.escape.ship.proto.v1.SupportHoursR\x05hours\x12\x19\n" +
+	"\bopen_now\x18\x02 \x01(\bR\aopenNow\x12 \n" +
+	"\fnext_open_at\x18\x03 \x01(\tR\n" +
+	"nextOpenAt*\x97\x01\n" +
+	"\vHolidayKind\x12\x1c\n" +
+	"\x18HOLIDAY_KIND_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13HOLIDAY_KIND_PUBLIC\x10\x01\x12\x1b\n" +
+	"\x17HOLIDAY_KIND_SUBSTITUTE\x10\x02\x12\x1a\n" +
+	"\x16HOLIDAY_KIND_TEMPORARY\x10\x03\x12\x18\n" +
+	"\x14HOLIDAY_KIND_COMPANY\x10\x042\xb9\x02\n" +
+	"\x0fCalendarService\x12\x8a\x01\n" +
+	"\fListHolidays\x12,.go.escape.ship.proto.v1.ListHolidaysRequest\x1a-.go.escape.ship.proto.v1.ListHolidaysResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/calendar/holidays\x12\x98\x01\n" +
+	"\x0fGetSupportHours\x12/.go.escape.ship.proto.v1.GetSupportHoursRequest\x1a0.go.escape.ship.proto.v1.GetSupportHoursResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/calendar/support-hoursB#Z!github.com/escape-ship/protos/genb\x06proto3"
+
+var (
+	file_calendar_proto_rawDescOnce sync.Once
+	file_calendar_proto_rawDescData []byte
+)
+
+func file_calendar_proto_rawDescGZIP() []byte {
+	file_calendar_proto_rawDescOnce.Do(func() {
+		file_calendar_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_calendar_proto_rawDesc), len(file_calendar_proto_rawDesc)))
+	})
+	return file_calendar_proto_rawDescData
+}
+
+var file_calendar_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_calendar_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_calendar_proto_goTypes = []any{
+	(HolidayKind)(0),                // 0: go.escape.ship.proto.v1.HolidayKind
+	(*Holiday)(nil),                 // 1: go.escape.ship.proto.v1.Holiday
+	(*ListHolidaysRequest)(nil),     // 2: go.escape.ship.proto.v1.ListHolidaysRequest
+	(*ListHolidaysResponse)(nil),    // 3: go.escape.ship.proto.v1.ListHolidaysResponse
+	(*SupportHours)(nil),            // 4: go.escape.ship.proto.v1.SupportHours
+	(*GetSupportHoursRequest)(nil),  // 5: go.escape.ship.proto.v1.GetSupportHoursRequest
+	(*GetSupportHoursResponse)(nil), // 6: go.escape.ship.proto.v1.GetSupportHoursResponse
+}
+var file_calendar_proto_depIdxs = []int32{
+	0, // 0: go.escape.ship.proto.v1.Holiday.kind:type_name -> go.escape.ship.proto.v1.HolidayKind
+	1, // 1: go.escape.ship.proto.v1.ListHolidaysResponse.holidays:type_name -> go.escape.ship.proto.v1.Holiday
+	4, // 2: go.escape.ship.proto.v1.GetSupportHoursResponse.hours:type_name -> go.escape.ship.proto.v1.SupportHours
+	2, // 3: go.escape.ship.proto.v1.CalendarService.ListHolidays:input_type -> go.escape.ship.proto.v1.ListHolidaysRequest
+	5, // 4: go.escape.ship.proto.v1.CalendarService.GetSupportHours:input_type -> go.escape.ship.proto.v1.GetSupportHoursRequest
+	3, // 5: go.escape.ship.proto.v1.CalendarService.ListHolidays:output_type -> go.escape.ship.proto.v1.ListHolidaysResponse
+	6, // 6: go.escape.ship.proto.v1.CalendarService.GetSupportHours:output_type -> go.escape.ship.proto.v1.GetSupportHoursResponse
+	5, // [5:7] is the sub-list for method output_type
+	3, // [3:5] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_calendar_proto_init() }
+func file_calendar_proto_init() {
+	if File_calendar_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_calendar_proto_rawDesc), len(file_calendar_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_calendar_proto_goTypes,
+		DependencyIndexes: file_calendar_proto_depIdxs,
+		EnumInfos:         file_calendar_proto_enumTypes,
+		MessageInfos:      file_calendar_proto_msgTypes,
+	}.Build()
+	File_calendar_proto = out.File
+	file_calendar_proto_goTypes = nil
+	file_calendar_proto_depIdxs = nil
+}
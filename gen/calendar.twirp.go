@@ -0,0 +1,845 @@
+// Code generated by protoc-gen-twirp v8.1.3, DO NOT EDIT.
+// source: calendar.proto
+
+package gen
+
+import context "context"
+import fmt "fmt"
+import http "net/http"
+import io "io"
+import json "encoding/json"
+import strconv "strconv"
+import strings "strings"
+
+import protojson "google.golang.org/protobuf/encoding/protojson"
+import proto "google.golang.org/protobuf/proto"
+import twirp "github.com/twitchtv/twirp"
+import ctxsetters "github.com/twitchtv/twirp/ctxsetters"
+
+// Version compatibility assertion.
+// If the constant is not defined in the package, that likely means
+// the package needs to be updated to work with this generated code.
+// See https://twitchtv.github.io/twirp/docs/version_matrix.html
+const _ = twirp.TwirpPackageMinVersion_8_1_0
+
+// =========================
+// CalendarService Interface
+// =========================
+
+// 공휴일 및 고객센터 운영 시간 조회 (배송 예정일, CS 응답 SLA 계산용)
+type CalendarService interface {
+	// 기간 내 공휴일 목록 (대체/임시 공휴일, 회사 휴무일 포함)
+	ListHolidays(context.Context, *ListHolidaysRequest) (*ListHolidaysResponse, error)
+
+	// 고객센터 운영 시간 및 현재 운영 여부
+	GetSupportHours(context.Context, *GetSupportHoursRequest) (*GetSupportHoursResponse, error)
+}
+
+// ===============================
+// CalendarService Protobuf Client
+// ===============================
+
+type calendarServiceProtobufClient struct {
+	client      HTTPClient
+	urls        [2]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewCalendarServiceProtobufClient creates a Protobuf client that implements the CalendarService interface.
+// It communicates using Protobuf and can be configured with a custom HTTPClient.
+func NewCalendarServiceProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) CalendarService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "go.escape.ship.proto.v1", "CalendarService")
+	urls := [2]string{
+		serviceURL + "ListHolidays",
+		serviceURL + "GetSupportHours",
+	}
+
+	return &calendarServiceProtobufClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *calendarServiceProtobufClient) ListHolidays(ctx context.Context, in *ListHolidaysRequest) (*ListHolidaysResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "CalendarService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListHolidays")
+	caller := c.callListHolidays
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListHolidaysRequest) (*ListHolidaysResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListHolidaysRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListHolidaysRequest) when calling interceptor")
+					}
+					return c.callListHolidays(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListHolidaysResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListHolidaysResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *calendarServiceProtobufClient) callListHolidays(ctx context.Context, in *ListHolidaysRequest) (*ListHolidaysResponse, error) {
+	out := new(ListHolidaysResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *calendarServiceProtobufClient) GetSupportHours(ctx context.Context, in *GetSupportHoursRequest) (*GetSupportHoursResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "CalendarService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetSupportHours")
+	caller := c.callGetSupportHours
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetSupportHoursRequest) (*GetSupportHoursResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetSupportHoursRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetSupportHoursRequest) when calling interceptor")
+					}
+					return c.callGetSupportHours(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetSupportHoursResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetSupportHoursResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *calendarServiceProtobufClient) callGetSupportHours(ctx context.Context, in *GetSupportHoursRequest) (*GetSupportHoursResponse, error) {
+	out := new(GetSupportHoursResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ===========================
+// CalendarService JSON Client
+// ===========================
+
+type calendarServiceJSONClient struct {
+	client      HTTPClient
+	urls        [2]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewCalendarServiceJSONClient creates a JSON client that implements the CalendarService interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewCalendarServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) CalendarService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "go.escape.ship.proto.v1", "CalendarService")
+	urls := [2]string{
+		serviceURL + "ListHolidays",
+		serviceURL + "GetSupportHours",
+	}
+
+	return &calendarServiceJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *calendarServiceJSONClient) ListHolidays(ctx context.Context, in *ListHolidaysRequest) (*ListHolidaysResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "CalendarService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListHolidays")
+	caller := c.callListHolidays
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListHolidaysRequest) (*ListHolidaysResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListHolidaysRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListHolidaysRequest) when calling interceptor")
+					}
+					return c.callListHolidays(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListHolidaysResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListHolidaysResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *calendarServiceJSONClient) callListHolidays(ctx context.Context, in *ListHolidaysRequest) (*ListHolidaysResponse, error) {
+	out := new(ListHolidaysResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *calendarServiceJSONClient) GetSupportHours(ctx context.Context, in *GetSupportHoursRequest) (*GetSupportHoursResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "CalendarService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetSupportHours")
+	caller := c.callGetSupportHours
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetSupportHoursRequest) (*GetSupportHoursResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetSupportHoursRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetSupportHoursRequest) when calling interceptor")
+					}
+					return c.callGetSupportHours(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetSupportHoursResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetSupportHoursResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *calendarServiceJSONClient) callGetSupportHours(ctx context.Context, in *GetSupportHoursRequest) (*GetSupportHoursResponse, error) {
+	out := new(GetSupportHoursResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ==============================
+// CalendarService Server Handler
+// ==============================
+
+type calendarServiceServer struct {
+	CalendarService
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewCalendarServiceServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewCalendarServiceServer(svc CalendarService, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	return &calendarServiceServer{
+		CalendarService:  svc,
+		hooks:            serverOpts.Hooks,
+		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:       pathPrefix,
+		jsonSkipDefaults: jsonSkipDefaults,
+		jsonCamelCase:    jsonCamelCase,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *calendarServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *calendarServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
+
+// CalendarServicePathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const CalendarServicePathPrefix = "/twirp/go.escape.ship.proto.v1.CalendarService/"
+
+func (s *calendarServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "CalendarService")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "go.escape.ship.proto.v1.CalendarService" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "ListHolidays":
+		s.serveListHolidays(ctx, resp, req)
+		return
+	case "GetSupportHours":
+		s.serveGetSupportHours(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *calendarServiceServer) serveListHolidays(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListHolidaysJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListHolidaysProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *calendarServiceServer) serveListHolidaysJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListHolidays")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ListHolidaysRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.CalendarService.ListHolidays
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListHolidaysRequest) (*ListHolidaysResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListHolidaysRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListHolidaysRequest) when calling interceptor")
+					}
+					return s.CalendarService.ListHolidays(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListHolidaysResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListHolidaysResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListHolidaysResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListHolidaysResponse and nil error while calling ListHolidays. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *calendarServiceServer) serveListHolidaysProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListHolidays")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ListHolidaysRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.CalendarService.ListHolidays
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListHolidaysRequest) (*ListHolidaysResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListHolidaysRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListHolidaysRequest) when calling interceptor")
+					}
+					return s.CalendarService.ListHolidays(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListHolidaysResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListHolidaysResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListHolidaysResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListHolidaysResponse and nil error while calling ListHolidays. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *calendarServiceServer) serveGetSupportHours(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetSupportHoursJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetSupportHoursProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *calendarServiceServer) serveGetSupportHoursJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetSupportHours")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetSupportHoursRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.CalendarService.GetSupportHours
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetSupportHoursRequest) (*GetSupportHoursResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetSupportHoursRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetSupportHoursRequest) when calling interceptor")
+					}
+					return s.CalendarService.GetSupportHours(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetSupportHoursResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetSupportHoursResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetSupportHoursResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetSupportHoursResponse and nil error while calling GetSupportHours. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *calendarServiceServer) serveGetSupportHoursProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetSupportHours")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetSupportHoursRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.CalendarService.GetSupportHours
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetSupportHoursRequest) (*GetSupportHoursResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetSupportHoursRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetSupportHoursRequest) when calling interceptor")
+					}
+					return s.CalendarService.GetSupportHours(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetSupportHoursResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetSupportHoursResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetSupportHoursResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetSupportHoursResponse and nil error while calling GetSupportHours. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *calendarServiceServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor1, 0
+}
+
+func (s *calendarServiceServer) ProtocGenTwirpVersion() string {
+	return "v8.1.3"
+}
+
+// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
+// that is everything in a Twirp route except for the <Method>. This can be used for routing,
+// for example to identify the requests that are targeted to this service in a mux.
+func (s *calendarServiceServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "go.escape.ship.proto.v1", "CalendarService")
+}
+
+var twirpFileDescriptor1 = []byte{
+	// 664 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x4f, 0x53, 0xda, 0x4c,
+	0x1c, 0xc7, 0x9f, 0x20, 0x2a, 0xfe, 0x40, 0x64, 0x56, 0x1f, 0xc9, 0x83, 0x3e, 0x53, 0x8c, 0x75,
+	0xca, 0x74, 0x2a, 0xa9, 0xf4, 0xd2, 0x99, 0xf6, 0x82, 0x40, 0x2b, 0xa3, 0x02, 0x13, 0xe0, 0x60,
+	0x2f, 0x99, 0x35, 0xd9, 0x42, 0x46, 0xd8, 0x4d, 0xb3, 0x8b, 0x96, 0x6b, 0x8f, 0xbd, 0x7a, 0x68,
+	0xdf, 0x4a, 0x5f, 0x47, 0xdf, 0x42, 0xfb, 0x3e, 0x3a, 0xbb, 0x09, 0x0e, 0x69, 0x65, 0xea, 0x6d,
+	0xf3, 0xf9, 0xfd, 0xff, 0xee, 0x6f, 0x03, 0x59, 0x07, 0x8f, 0x08, 0x75, 0x71, 0x50, 0xf6, 0x03,
+	0x26, 0x18, 0xca, 0x0f, 0x58, 0x99, 0x70, 0x07, 0xfb, 0xa4, 0xcc, 0x87, 0x9e, 0x1f, 0xd2, 0xf2,
+	0xf5, 0x51, 0x61, 0x77, 0xc0, 0xd8, 0x60, 0x44, 0x4c, 0xec, 0x7b, 0x26, 0xa6, 0x94, 0x09, 0x2c,
+	0x3c, 0x46, 0x79, 0xe8, 0x60, 0x5c, 0xc1, 0xea, 0x09, 0x1b, 0x79, 0x2e, 0x9e, 0x22, 0x04, 0x49,
+	0x17, 0x0b, 0xa2, 0x6b, 0x45, 0xad, 0xb4, 0x66, 0xa9, 0xb3, 0x64, 0x14, 0x8f, 0x89, 0x9e, 0x08,
+	0x99, 0x3c, 0xa3, 0x97, 0x90, 0xbc, 0xf2, 0xa8, 0xab, 0x2f, 0x15, 0xb5, 0x52, 0xb6, 0xf2, 0xb8,
+	0xbc, 0xa0, 0x70, 0x39, 0xca, 0x7b, 0xea, 0x51, 0xd7, 0x52, 0x11, 0x86, 0x80, 0xcd, 0x33, 0x8f,
+	0x8b, 0xc8, 0xc0, 0x2d, 0xf2, 0x61, 0x42, 0xb8, 0x40, 0x3b, 0xb0, 0xf6, 0x3e, 0x60, 0x63, 0x7b,
+	0xae, 0x7a, 0x4a, 0x82, 0xba, 0xec, 0x20, 0x0f, 0xab, 0x82, 0x85, 0xa6, 0xb0, 0x89, 0x15, 0xc1,
+	0x94, 0xe1, 0x09, 0x6c, 0x78, 0xd4, 0x19, 0x4d, 0x5c, 0x62, 0x3b, 0x6c, 0xec, 0x63, 0x3a, 0x55,
+	0x1d, 0xa5, 0xac, 0x6c, 0x84, 0x6b, 0x21, 0x35, 0x7a, 0xb0, 0x15, 0xaf, 0xca, 0x7d, 0x46, 0x39,
+	0x41, 0xaf, 0x21, 0x35, 0x8c, 0x98, 0xae, 0x15, 0x97, 0x4a, 0xe9, 0x4a, 0xf1, 0x6f, 0xb3, 0x58,
+	0x77, 0x11, 0xc6, 0x4f, 0x0d, 0x32, 0xdd, 0x89, 0xef, 0xb3, 0x40, 0x9c, 0xb0, 0x49, 0xc0, 0xd1,
+	0x1e, 0x64, 0x6e, 0x08, 0xb9, 0x72, 0xf1, 0xd4, 0x66, 0x3e, 0xa1, 0xd1, 0x20, 0xe9, 0x88, 0xb5,
+	0x7d, 0x42, 0xd1, 0x3e, 0xac, 0xcf, 0x5c, 0x9c, 0x11, 0xe3, 0xb3, 0x89, 0x66, 0x71, 0x35, 0xc9,
+	0xd0, 0x23, 0x48, 0x8f, 0x26, 0xd4, 0x19, 0xda, 0x5c, 0xe0, 0x40, 0xa8, 0x99, 0xd6, 0x2c, 0x50,
+	0xa8, 0x2b, 0x89, 0x94, 0x2b, 0x74, 0x20, 0xd4, 0xd5, 0x93, 0xa1, 0x5c, 0x0a, 0x34, 0xa8, 0x8b,
+	0x4a, 0x90, 0x93, 0xd5, 0x6d, 0x46, 0x6d, 0x8e, 0xc5, 0x24, 0x70, 0xf1, 0x54, 0x5f, 0x0e, 0x65,
+	0x91, 0xbc, 0x4d, 0xbb, 0x11, 0x45, 0x07, 0x90, 0x9d, 0x79, 0x44, 0xdd, 0xac, 0xa8, 0x5c, 0xeb,
+	0x33, 0xaa, 0xda, 0x31, 0x74, 0xd8, 0x7e, 0x4b, 0xc4, 0xfc, 0xa4, 0xd1, 0xb5, 0x19, 0xb7, 0x1a,
+	0xe4, 0xff, 0x30, 0x45, 0xda, 0xbe, 0x82, 0xe5, 0xa1, 0x04, 0x4a, 0x85, 0x74, 0xe5, 0x60, 0xa1,
+	0xb0, 0xb1, 0xe8, 0x30, 0x06, 0xfd, 0x07, 0x29, 0x35, 0x03, 0x65, 0x37, 0x4a, 0xa1, 0x94, 0xb5,
+	0x2a, 0xbf, 0x5b, 0xec, 0x06, 0x15, 0x21, 0x43, 0xc9, 0x47, 0xa1, 0x14, 0xb6, 0xf1, 0x9d, 0x3a,
+	0x92, 0x49, 0x85, 0xab, 0xe2, 0xe9, 0x17, 0x0d, 0xd2, 0x73, 0x9b, 0x87, 0x76, 0x41, 0x3f, 0x69,
+	0x9f, 0x35, 0xeb, 0xd5, 0x0b, 0xfb, 0xb4, 0xd9, 0xaa, 0xdb, 0xfd, 0x56, 0xb7, 0xd3, 0xa8, 0x35,
+	0xdf, 0x34, 0x1b, 0xf5, 0xdc, 0x3f, 0x28, 0x0f, 0x9b, 0x31, 0x6b, 0xa7, 0x7f, 0x7c, 0xd6, 0xac,
+	0xe5, 0x34, 0xb4, 0x03, 0xf9, 0x98, 0xa1, 0xdb, 0x3f, 0xee, 0xf6, 0x9a, 0xbd, 0x7e, 0xaf, 0x91,
+	0x4b, 0xa0, 0x02, 0x6c, 0xc7, 0x8c, 0xbd, 0xc6, 0x79, 0xa7, 0x6d, 0x55, 0xad, 0x8b, 0xdc, 0x12,
+	0xd2, 0x61, 0x2b, 0x66, 0xab, 0xb5, 0xcf, 0x3b, 0xd5, 0xd6, 0x45, 0x2e, 0x59, 0xf9, 0x96, 0x80,
+	0x8d, 0x5a, 0xf4, 0x68, 0xbb, 0x24, 0xb8, 0xf6, 0x1c, 0x82, 0x3e, 0x6b, 0x90, 0x99, 0x5f, 0x4e,
+	0xf4, 0x6c, 0xa1, 0x52, 0xf7, 0xbc, 0x9c, 0xc2, 0xe1, 0x03, 0xbd, 0xc3, 0x5b, 0x31, 0xfe, 0xff,
+	0xf4, 0xfd, 0xc7, 0x6d, 0x22, 0x8f, 0xfe, 0x35, 0xaf, 0x8f, 0xcc, 0xd9, 0xff, 0xc3, 0x9c, 0xad,
+	0x34, 0xfa, 0xaa, 0xc1, 0xc6, 0x6f, 0x17, 0x8a, 0xcc, 0x85, 0x15, 0xee, 0xdf, 0x8a, 0xc2, 0xf3,
+	0x87, 0x07, 0x44, 0x5d, 0x19, 0xaa, 0xab, 0x5d, 0x54, 0x88, 0x75, 0xc5, 0x43, 0xd7, 0x43, 0xb5,
+	0x12, 0xc7, 0xfb, 0xef, 0xf6, 0x06, 0x9e, 0x18, 0x4e, 0x2e, 0xcb, 0x0e, 0x1b, 0x9b, 0x61, 0xfa,
+	0x43, 0x99, 0xde, 0x54, 0xe9, 0xb9, 0x39, 0x20, 0xf4, 0x72, 0x45, 0x9d, 0x5f, 0xfc, 0x1a, 0x00,
+	0xeb, 0x78, 0x27, 0x13, 0x1b, 0x05, 0x00, 0x00,
+}
@@ -0,0 +1,55 @@
+// Code generated by protoc-gen-go-shim. DO NOT EDIT.
+// source: calendar.proto
+
+package gen
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+)
+
+// CalendarServiceAPI is CalendarServiceClient without per-call options, so it can be
+// mocked with plain method signatures. Streams are exposed as iterators.
+type CalendarServiceAPI interface {
+	// 기간 내 공휴일 목록 (대체/임시 공휴일, 회사 휴무일 포함)
+	ListHolidays(ctx context.Context, in *ListHolidaysRequest) (*ListHolidaysResponse, error)
+	// 고객센터 운영 시간 및 현재 운영 여부
+	GetSupportHours(ctx context.Context, in *GetSupportHoursRequest) (*GetSupportHoursResponse, error)
+}
+
+// NewCalendarServiceAPI adapts c to CalendarServiceAPI, passing opts to every call.
+func NewCalendarServiceAPI(c CalendarServiceClient, opts ...grpc.CallOption) CalendarServiceAPI {
+	return &calendarServiceAPI{c: c, opts: opts}
+}
+
+type calendarServiceAPI struct {
+	c    CalendarServiceClient
+	opts []grpc.CallOption
+}
+
+func (a *calendarServiceAPI) ListHolidays(ctx context.Context, in *ListHolidaysRequest) (*ListHolidaysResponse, error) {
+	return a.c.ListHolidays(ctx, in, a.opts...)
+}
+
+func (a *calendarServiceAPI) GetSupportHours(ctx context.Context, in *GetSupportHoursRequest) (*GetSupportHoursResponse, error) {
+	return a.c.GetSupportHours(ctx, in, a.opts...)
+}
+
+// CalendarServiceClientFromAPI adapts a to CalendarServiceClient, e.g. to hand a
+// mock CalendarServiceAPI to code that takes the generated client. Call options
+// are ignored, and streams report empty headers and trailers.
+func CalendarServiceClientFromAPI(a CalendarServiceAPI) CalendarServiceClient {
+	return calendarServiceAPIClient{api: a}
+}
+
+type calendarServiceAPIClient struct {
+	api CalendarServiceAPI
+}
+
+func (c calendarServiceAPIClient) ListHolidays(ctx context.Context, in *ListHolidaysRequest, _ ...grpc.CallOption) (*ListHolidaysResponse, error) {
+	return c.api.ListHolidays(ctx, in)
+}
+
+func (c calendarServiceAPIClient) GetSupportHours(ctx context.Context, in *GetSupportHoursRequest, _ ...grpc.CallOption) (*GetSupportHoursResponse, error) {
+	return c.api.GetSupportHours(ctx, in)
+}
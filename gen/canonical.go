@@ -0,0 +1,102 @@
+package gen
+
+import (
+	"crypto/sha256"
+	"math"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// CanonicalMarshal encodes m in a canonical binary form for signing and
+// hashing: equal messages always produce the same bytes, whichever service or
+// client built them. Compared with proto.Marshal it
+//
+//   - writes fields in field-number order and map entries sorted by key,
+//   - drops unknown fields, so a message relayed through a service with an
+//     older schema still hashes the same,
+//   - normalizes -0.0 to 0, which proto3 then omits like other zero values.
+//
+// Fields with explicit presence (optional, oneof, messages) are encoded when
+// set, even to their zero value. m itself is not modified.
+func CanonicalMarshal(m proto.Message) ([]byte, error) {
+	c := proto.Clone(m)
+	if c == nil {
+		return nil, nil
+	}
+	canonicalize(c.ProtoReflect())
+	return proto.MarshalOptions{Deterministic: true}.Marshal(c)
+}
+
+// CanonicalHash returns the SHA-256 digest of m's canonical encoding, e.g. to
+// derive idempotency keys from request payloads.
+func CanonicalHash(m proto.Message) ([sha256.Size]byte, error) {
+	b, err := CanonicalMarshal(m)
+	if err != nil {
+		return [sha256.Size]byte{}, err
+	}
+	return sha256.Sum256(b), nil
+}
+
+func canonicalize(m protoreflect.Message) {
+	if !m.IsValid() {
+		return
+	}
+	if m.GetUnknown() != nil {
+		m.SetUnknown(nil)
+	}
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				if fd.Message() != nil {
+					canonicalize(list.Get(i).Message())
+				} else if f, ok := negativeZero(fd, list.Get(i)); ok {
+					list.Set(i, f)
+				}
+			}
+		case fd.IsMap():
+			var zeros []protoreflect.MapKey
+			v.Map().Range(func(k protoreflect.MapKey, mv protoreflect.Value) bool {
+				if fd.MapValue().Message() != nil {
+					canonicalize(mv.Message())
+				} else if _, ok := negativeZero(fd.MapValue(), mv); ok {
+					zeros = append(zeros, k)
+				}
+				return true
+			})
+			for _, k := range zeros {
+				f, _ := negativeZero(fd.MapValue(), v.Map().Get(k))
+				v.Map().Set(k, f)
+			}
+		case fd.Message() != nil:
+			canonicalize(v.Message())
+		default:
+			if f, ok := negativeZero(fd, v); ok {
+				if fd.HasPresence() {
+					m.Set(fd, f)
+				} else {
+					m.Clear(fd)
+				}
+			}
+		}
+		return true
+	})
+}
+
+// negativeZero reports whether v is a floating-point -0.0, returning +0.0 of
+// the same kind.
+func negativeZero(fd protoreflect.FieldDescriptor, v protoreflect.Value) (protoreflect.Value, bool) {
+	switch fd.Kind() {
+	case protoreflect.FloatKind:
+		if f := float32(v.Float()); f == 0 && math.Signbit(float64(f)) {
+			return protoreflect.ValueOfFloat32(0), true
+		}
+	case protoreflect.DoubleKind:
+		if f := v.Float(); f == 0 && math.Signbit(f) {
+			return protoreflect.ValueOfFloat64(0), true
+		}
+	}
+	return protoreflect.Value{}, false
+}
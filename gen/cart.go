@@ -0,0 +1,18 @@
+package gen
+
+// InsertOrderItems converts the cart's items into InsertOrder line items,
+// carrying over the prices the cart was displayed with.
+func (c *Cart) InsertOrderItems() []*InsertOrderItem {
+	items := make([]*InsertOrderItem, len(c.GetItems()))
+	for i, it := range c.GetItems() {
+		items[i] = &InsertOrderItem{
+			ProductId:      it.GetProductId(),
+			ProductName:    it.GetProductName(),
+			ProductOptions: it.GetProductOptions(),
+			ProductPrice:   it.GetUnitPrice(),
+			Quantity:       it.GetQuantity(),
+			BundleId:       it.GetBundleId(),
+		}
+	}
+	return items
+}
@@ -0,0 +1,798 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: cart.proto
+
+package gen
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CartItem struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId      string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductName    string                 `protobuf:"bytes,3,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	ProductOptions string                 `protobuf:"bytes,4,opt,name=product_options,json=productOptions,proto3" json:"product_options,omitempty"` // JSON 문자열 (InsertOrderItem.product_options와 동일 형식)
+	UnitPrice      int64                  `protobuf:"varint,5,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`               // 현재 수량 기준 단가 (수량별 할인 반영)
+	Quantity       int32                  `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	LineTotal      int64                  `protobuf:"varint,7,opt,name=line_total,json=lineTotal,proto3" json:"line_total,omitempty"` // unit_price * quantity
+	BundleId       string                 `protobuf:"bytes,8,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"`     // 번들 상품일 때 설정
+	AddedAt        string                 `protobuf:"bytes,9,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CartItem) Reset() {
+	*x = CartItem{}
+	mi := &file_cart_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartItem) ProtoMessage() {}
+
+func (x *CartItem) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartItem.ProtoReflect.Descriptor instead.
+func (*CartItem) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CartItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CartItem) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CartItem) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *CartItem) GetProductOptions() string {
+	if x != nil {
+		return x.ProductOptions
+	}
+	return ""
+}
+
+func (x *CartItem) GetUnitPrice() int64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *CartItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CartItem) GetLineTotal() int64 {
+	if x != nil {
+		return x.LineTotal
+	}
+	return 0
+}
+
+func (x *CartItem) GetBundleId() string {
+	if x != nil {
+		return x.BundleId
+	}
+	return ""
+}
+
+func (x *CartItem) GetAddedAt() string {
+	if x != nil {
+		return x.AddedAt
+	}
+	return ""
+}
+
+type Cart struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Items         []*CartItem            `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	TotalQuantity int32                  `protobuf:"varint,3,opt,name=total_quantity,json=totalQuantity,proto3" json:"total_quantity,omitempty"`
+	TotalPrice    int64                  `protobuf:"varint,4,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"` // 항목 line_total 합계 (배송비 제외)
+	UpdatedAt     string                 `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Cart) Reset() {
+	*x = Cart{}
+	mi := &file_cart_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Cart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cart) ProtoMessage() {}
+
+func (x *Cart) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cart.ProtoReflect.Descriptor instead.
+func (*Cart) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Cart) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Cart) GetItems() []*CartItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Cart) GetTotalQuantity() int32 {
+	if x != nil {
+		return x.TotalQuantity
+	}
+	return 0
+}
+
+func (x *Cart) GetTotalPrice() int64 {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return 0
+}
+
+func (x *Cart) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type GetCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCartRequest) Reset() {
+	*x = GetCartRequest{}
+	mi := &file_cart_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCartRequest) ProtoMessage() {}
+
+func (x *GetCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCartRequest.ProtoReflect.Descriptor instead.
+func (*GetCartRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{2}
+}
+
+type GetCartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cart          *Cart                  `protobuf:"bytes,1,opt,name=cart,proto3" json:"cart,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCartResponse) Reset() {
+	*x = GetCartResponse{}
+	mi := &file_cart_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCartResponse) ProtoMessage() {}
+
+func (x *GetCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCartResponse.ProtoReflect.Descriptor instead.
+func (*GetCartResponse) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetCartResponse) GetCart() *Cart {
+	if x != nil {
+		return x.Cart
+	}
+	return nil
+}
+
+type AddItemRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ProductId      string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductOptions string                 `protobuf:"bytes,2,opt,name=product_options,json=productOptions,proto3" json:"product_options,omitempty"`
+	Quantity       int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	BundleId       string                 `protobuf:"bytes,4,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"` // 번들 상품을 담을 때 설정 (product_id 대신)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AddItemRequest) Reset() {
+	*x = AddItemRequest{}
+	mi := &file_cart_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddItemRequest) ProtoMessage() {}
+
+func (x *AddItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddItemRequest.ProtoReflect.Descriptor instead.
+func (*AddItemRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AddItemRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *AddItemRequest) GetProductOptions() string {
+	if x != nil {
+		return x.ProductOptions
+	}
+	return ""
+}
+
+func (x *AddItemRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *AddItemRequest) GetBundleId() string {
+	if x != nil {
+		return x.BundleId
+	}
+	return ""
+}
+
+type AddItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cart          *Cart                  `protobuf:"bytes,1,opt,name=cart,proto3" json:"cart,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddItemResponse) Reset() {
+	*x = AddItemResponse{}
+	mi := &file_cart_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddItemResponse) ProtoMessage() {}
+
+func (x *AddItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddItemResponse.ProtoReflect.Descriptor instead.
+func (*AddItemResponse) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AddItemResponse) GetCart() *Cart {
+	if x != nil {
+		return x.Cart
+	}
+	return nil
+}
+
+type RemoveItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveItemRequest) Reset() {
+	*x = RemoveItemRequest{}
+	mi := &file_cart_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveItemRequest) ProtoMessage() {}
+
+func (x *RemoveItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveItemRequest.ProtoReflect.Descriptor instead.
+func (*RemoveItemRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RemoveItemRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+type RemoveItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cart          *Cart                  `protobuf:"bytes,1,opt,name=cart,proto3" json:"cart,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveItemResponse) Reset() {
+	*x = RemoveItemResponse{}
+	mi := &file_cart_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveItemResponse) ProtoMessage() {}
+
+func (x *RemoveItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveItemResponse.ProtoReflect.Descriptor instead.
+func (*RemoveItemResponse) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RemoveItemResponse) GetCart() *Cart {
+	if x != nil {
+		return x.Cart
+	}
+	return nil
+}
+
+type UpdateQuantityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateQuantityRequest) Reset() {
+	*x = UpdateQuantityRequest{}
+	mi := &file_cart_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateQuantityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateQuantityRequest) ProtoMessage() {}
+
+func (x *UpdateQuantityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateQuantityRequest.ProtoReflect.Descriptor instead.
+func (*UpdateQuantityRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateQuantityRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *UpdateQuantityRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type UpdateQuantityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cart          *Cart                  `protobuf:"bytes,1,opt,name=cart,proto3" json:"cart,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateQuantityResponse) Reset() {
+	*x = UpdateQuantityResponse{}
+	mi := &file_cart_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateQuantityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateQuantityResponse) ProtoMessage() {}
+
+func (x *UpdateQuantityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateQuantityResponse.ProtoReflect.Descriptor instead.
+func (*UpdateQuantityResponse) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateQuantityResponse) GetCart() *Cart {
+	if x != nil {
+		return x.Cart
+	}
+	return nil
+}
+
+type ClearCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearCartRequest) Reset() {
+	*x = ClearCartRequest{}
+	mi := &file_cart_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearCartRequest) ProtoMessage() {}
+
+func (x *ClearCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearCartRequest.ProtoReflect.Descriptor instead.
+func (*ClearCartRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{10}
+}
+
+type ClearCartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cart          *Cart                  `protobuf:"bytes,1,opt,name=cart,proto3" json:"cart,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearCartResponse) Reset() {
+	*x = ClearCartResponse{}
+	mi := &file_cart_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearCartResponse) ProtoMessage() {}
+
+func (x *ClearCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearCartResponse.ProtoReflect.Descriptor instead.
+func (*ClearCartResponse) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ClearCartResponse) GetCart() *Cart {
+	if x != nil {
+		return x.Cart
+	}
+	return nil
+}
+
+var File_cart_proto protoreflect.FileDescriptor
+
+const file_cart_proto_rawDesc = "" +
+	"\n" +
+	"\n" +
+	"cart.proto\x12\x17go.escape.ship.proto.v1\x1a\x1cgoogle/api/annotations.proto\"\x97\x02\n" +
+	"\bCartItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12!\n" +
+	"\fproduct_name\x18\x03 \x01(\tR\vproductName\x12'\n" +
+	"\x0fproduct_options\x18\x04 \x01(\tR\x0eproductOptions\x12\x1d\n" +
+	"\n" +
+	"unit_price\x18\x05 \x01(\x03R\tunitPrice\x12\x1a\n" +
+	"\bquantity\x18\x06 \x01(\x05R\bquantity\x12\x1d\n" +
+	"\n" +
+	"line_total\x18\a \x01(\x03R\tlineTotal\x12\x1b\n" +
+	"\tbundle_id\x18\b \x01(\tR\bbundleId\x12\x19\n" +
+	"\badded_at\x18\t \x01(\tR\aaddedAt\"\xbf\x01\n" +
+	"\x04Cart\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x127\n" +
+	"\x05items\x18\x02 \x03(\v2!.go.escape.ship.proto.v1.CartItemR\x05items\x12%\n" +
+	"\x0etotal_quantity\x18\x03 \x01(\x05R\rtotalQuantity\x12\x1f\n" +
+	"\vtotal_price\x18\x04 \x01(\x03R\n" +
+	"totalPrice\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\tR\tupdatedAt\"\x10\n" +
+	"\x0eGetCartRequest\"D\n" +
+	"\x0fGetCartResponse\x121\n" +
+	"\x04cart\x18\x01 \x01(\v2\x1d.go.escape.ship.proto.v1.CartR\x04cart\"\x91\x01\n" +
+	"\x0eAddItemRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12'\n" +
+	"\x0fproduct_options\x18\x02 \x01(\tR\x0eproductOptions\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x1b\n" +
+	"\tbundle_id\x18\x04 \x01(\tR\bbundleId\"D\n" +
+	"\x0fAddItemResponse\x121\n" +
+	"\x04cart\x18\x01 \x01(\v2\x1d.go.escape.ship.proto.v1.CartR\x04cart\",\n" +
+	"\x11RemoveItemRequest\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\"G\n" +
+	"\x12RemoveItemResponse\x121\n" +
+	"\x04cart\x18\x01 \x01(\v2\x1d.go.escape.ship.proto.v1.CartR\x04cart\"L\n" +
+	"\x15UpdateQuantityRequest\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\"K\n" +
+	"\x16UpdateQuantityResponse\x121\n" +
+	"\x04cart\x18\x01 \x01(\v2\x1d.go.escape.ship.proto.v1.CartR\x04cart\"\x12\n" +
+	"\x10ClearCartRequest\"F\n" +
+	"\x11ClearCartResponse\x121\n" +
+	"\x04cart\x18\x01 \x01(\v2\x1d.go.escape.ship.proto.v1.CartR\x04cart2\x8f\x05\n" +
+	"\vCartService\x12n\n" +
+	"\aGetCart\x12'.go.escape.ship.proto.v1.GetCartRequest\x1a(.go.escape.ship.proto.v1.GetCartResponse\"\x10\x82\xd3\xe4\x93\x02\n" +
+	"\x12\b/v1/cart\x12w\n" +
+	"\aAddItem\x12'.go.escape.ship.proto.v1.AddItemRequest\x1a(.go.escape.ship.proto.v1.AddItemResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/cart/items\x12\x87\x01\n" +
+	"\n" +
+	"RemoveItem\x12*.go.escape.ship.proto.v1.RemoveItemRequest\x1a+.go.escape.ship.proto.v1.RemoveItemResponse\" \x82\xd3\xe4\x93\x02\x1a*\x18/v1/cart/items/{item_id}\x12\x96\x01\n" +
+	"\x0eUpdateQuantity\x12..go.escape.ship.proto.v1.UpdateQuantityRequest\x1a/.go.escape.ship.proto.v1.UpdateQuantityResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\x1a\x18/v1/cart/items/{item_id}\x12t\n" +
+	"\tClearCart\x12).go.escape.ship.proto.v1.ClearCartRequest\x1a*.go.escape.ship.proto.v1.ClearCartResponse\"\x10\x82\xd3\xe4\x93\x02\n" +
+	"*\b/v1/cartB#Z!github.com/escape-ship/protos/genb\x06proto3"
+
+var (
+	file_cart_proto_rawDescOnce sync.Once
+	file_cart_proto_rawDescData []byte
+)
+
+func file_cart_proto_rawDescGZIP() []byte {
+	file_cart_proto_rawDescOnce.Do(func() {
+		file_cart_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cart_proto_rawDesc), len(file_cart_proto_rawDesc)))
+	})
+	return file_cart_proto_rawDescData
+}
+
+var file_cart_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_cart_proto_goTypes = []any{
+	(*CartItem)(nil),               // 0: go.escape.ship.proto.v1.CartItem
+	(*Cart)(nil),                   // 1: go.escape.ship.proto.v1.Cart
+	(*GetCartRequest)(nil),         // 2: go.escape.ship.proto.v1.GetCartRequest
+	(*GetCartResponse)(nil),        // 3: go.escape.ship.proto.v1.GetCartResponse
+	(*AddItemRequest)(nil),         // 4: go.escape.ship.proto.v1.AddItemRequest
+	(*AddItemResponse)(nil),        // 5: go.escape.ship.proto.v1.AddItemResponse
+	(*RemoveItemRequest)(nil),      // 6: go.escape.ship.proto.v1.RemoveItemRequest
+	(*RemoveItemResponse)(nil),     // 7: go.escape.ship.proto.v1.RemoveItemResponse
+	(*UpdateQuantityRequest)(nil),  // 8: go.escape.ship.proto.v1.UpdateQuantityRequest
+	(*UpdateQuantityResponse)(nil), // 9: go.escape.ship.proto.v1.UpdateQuantityResponse
+	(*ClearCartRequest)(nil),       // 10: go.escape.ship.proto.v1.ClearCartRequest
+	(*ClearCartResponse)(nil),      // 11: go.escape.ship.proto.v1.ClearCartResponse
+}
+var file_cart_proto_depIdxs = []int32{
+	0,  // 0: go.escape.ship.proto.v1.Cart.items:type_name -> go.escape.ship.proto.v1.CartItem
+	1,  // 1: go.escape.ship.proto.v1.GetCartResponse.cart:type_name -> go.escape.ship.proto.v1.Cart
+	1,  // 2: go.escape.ship.proto.v1.AddItemResponse.cart:type_name -> go.escape.ship.proto.v1.Cart
+	1,  // 3: go.escape.ship.proto.v1.RemoveItemResponse.cart:type_name -> go.escape.ship.proto.v1.Cart
+	1,  // 4: go.escape.ship.proto.v1.UpdateQuantityResponse.cart:type_name -> go.escape.ship.proto.v1.Cart
+	1,  // 5: go.escape.ship.proto.v1.ClearCartResponse.cart:type_name -> go.escape.ship.proto.v1.Cart
+	2,  // 6: go.escape.ship.proto.v1.CartService.GetCart:input_type -> go.escape.ship.proto.v1.GetCartRequest
+	4,  // 7: go.escape.ship.proto.v1.CartService.AddItem:input_type -> go.escape.ship.proto.v1.AddItemRequest
+	6,  // 8: go.escape.ship.proto.v1.CartService.RemoveItem:input_type -> go.escape.ship.proto.v1.RemoveItemRequest
+	8,  // 9: go.escape.ship.proto.v1.CartService.UpdateQuantity:input_type -> go.escape.ship.proto.v1.UpdateQuantityRequest
+	10, // 10: go.escape.ship.proto.v1.CartService.ClearCart:input_type -> go.escape.ship.proto.v1.ClearCartRequest
+	3,  // 11: go.escape.ship.proto.v1.CartService.GetCart:output_type -> go.escape.ship.proto.v1.GetCartResponse
+	5,  // 12: go.escape.ship.proto.v1.CartService.AddItem:output_type -> go.escape.ship.proto.v1.AddItemResponse
+	7,  // 13: go.escape.ship.proto.v1.CartService.RemoveItem:output_type -> go.escape.ship.proto.v1.RemoveItemResponse
+	9,  // 14: go.escape.ship.proto.v1.CartService.UpdateQuantity:output_type -> go.escape.ship.proto.v1.UpdateQuantityResponse
+	11, // 15: go.escape.ship.proto.v1.CartService.ClearCart:output_type -> go.escape.ship.proto.v1.ClearCartResponse
+	11, // [11:16] is the sub-list for method output_type
+	6,  // [6:11] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_cart_proto_init() }
+func file_cart_proto_init() {
+	if File_cart_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cart_proto_rawDesc), len(file_cart_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_cart_proto_goTypes,
+		DependencyIndexes: file_cart_proto_depIdxs,
+		MessageInfos:      file_cart_proto_msgTypes,
+	}.Build()
+	File_cart_proto = out.File
+	file_cart_proto_goTypes = nil
+	file_cart_proto_depIdxs = nil
+}
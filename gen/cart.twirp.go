@@ -0,0 +1,1687 @@
+// Code generated by protoc-gen-twirp v8.1.3, DO NOT EDIT.
+// source: cart.proto
+
+package gen
+
+import context "context"
+import fmt "fmt"
+import http "net/http"
+import io "io"
+import json "encoding/json"
+import strconv "strconv"
+import strings "strings"
+
+import protojson "google.golang.org/protobuf/encoding/protojson"
+import proto "google.golang.org/protobuf/proto"
+import twirp "github.com/twitchtv/twirp"
+import ctxsetters "github.com/twitchtv/twirp/ctxsetters"
+
+// Version compatibility assertion.
+// If the constant is not defined in the package, that likely means
+// the package needs to be updated to work with this generated code.
+// See https://twitchtv.github.io/twirp/docs/version_matrix.html
+const _ = twirp.TwirpPackageMinVersion_8_1_0
+
+// =====================
+// CartService Interface
+// =====================
+
+// 장바구니 서비스: Authorization 헤더의 사용자(게스트 토큰 포함) 기준으로 동작
+// 게스트 장바구니는 MergeAccounts로 회원 계정에 병합됨
+type CartService interface {
+	GetCart(context.Context, *GetCartRequest) (*GetCartResponse, error)
+
+	// 같은 상품/옵션/번들 항목이 이미 있으면 수량을 합산
+	// 재고 부족은 OUT_OF_STOCK, 고객당 구매 제한 초과는 PURCHASE_LIMIT_EXCEEDED 에러
+	AddItem(context.Context, *AddItemRequest) (*AddItemResponse, error)
+
+	RemoveItem(context.Context, *RemoveItemRequest) (*RemoveItemResponse, error)
+
+	// quantity가 0이면 항목 삭제
+	UpdateQuantity(context.Context, *UpdateQuantityRequest) (*UpdateQuantityResponse, error)
+
+	// 주문 완료 후 또는 사용자 요청 시 전체 비우기
+	ClearCart(context.Context, *ClearCartRequest) (*ClearCartResponse, error)
+}
+
+// ===========================
+// CartService Protobuf Client
+// ===========================
+
+type cartServiceProtobufClient struct {
+	client      HTTPClient
+	urls        [5]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewCartServiceProtobufClient creates a Protobuf client that implements the CartService interface.
+// It communicates using Protobuf and can be configured with a custom HTTPClient.
+func NewCartServiceProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) CartService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "go.escape.ship.proto.v1", "CartService")
+	urls := [5]string{
+		serviceURL + "GetCart",
+		serviceURL + "AddItem",
+		serviceURL + "RemoveItem",
+		serviceURL + "UpdateQuantity",
+		serviceURL + "ClearCart",
+	}
+
+	return &cartServiceProtobufClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *cartServiceProtobufClient) GetCart(ctx context.Context, in *GetCartRequest) (*GetCartResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "CartService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetCart")
+	caller := c.callGetCart
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetCartRequest) (*GetCartResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetCartRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetCartRequest) when calling interceptor")
+					}
+					return c.callGetCart(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetCartResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetCartResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *cartServiceProtobufClient) callGetCart(ctx context.Context, in *GetCartRequest) (*GetCartResponse, error) {
+	out := new(GetCartResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *cartServiceProtobufClient) AddItem(ctx context.Context, in *AddItemRequest) (*AddItemResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "CartService")
+	ctx = ctxsetters.WithMethodName(ctx, "AddItem")
+	caller := c.callAddItem
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AddItemRequest) (*AddItemResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AddItemRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AddItemRequest) when calling interceptor")
+					}
+					return c.callAddItem(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AddItemResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AddItemResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *cartServiceProtobufClient) callAddItem(ctx context.Context, in *AddItemRequest) (*AddItemResponse, error) {
+	out := new(AddItemResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *cartServiceProtobufClient) RemoveItem(ctx context.Context, in *RemoveItemRequest) (*RemoveItemResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "CartService")
+	ctx = ctxsetters.WithMethodName(ctx, "RemoveItem")
+	caller := c.callRemoveItem
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RemoveItemRequest) (*RemoveItemResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RemoveItemRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RemoveItemRequest) when calling interceptor")
+					}
+					return c.callRemoveItem(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RemoveItemResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RemoveItemResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *cartServiceProtobufClient) callRemoveItem(ctx context.Context, in *RemoveItemRequest) (*RemoveItemResponse, error) {
+	out := new(RemoveItemResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *cartServiceProtobufClient) UpdateQuantity(ctx context.Context, in *UpdateQuantityRequest) (*UpdateQuantityResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "CartService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateQuantity")
+	caller := c.callUpdateQuantity
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateQuantityRequest) (*UpdateQuantityResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateQuantityRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateQuantityRequest) when calling interceptor")
+					}
+					return c.callUpdateQuantity(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateQuantityResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateQuantityResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *cartServiceProtobufClient) callUpdateQuantity(ctx context.Context, in *UpdateQuantityRequest) (*UpdateQuantityResponse, error) {
+	out := new(UpdateQuantityResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *cartServiceProtobufClient) ClearCart(ctx context.Context, in *ClearCartRequest) (*ClearCartResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "CartService")
+	ctx = ctxsetters.WithMethodName(ctx, "ClearCart")
+	caller := c.callClearCart
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ClearCartRequest) (*ClearCartResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ClearCartRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ClearCartRequest) when calling interceptor")
+					}
+					return c.callClearCart(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ClearCartResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ClearCartResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *cartServiceProtobufClient) callClearCart(ctx context.Context, in *ClearCartRequest) (*ClearCartResponse, error) {
+	out := new(ClearCartResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// =======================
+// CartService JSON Client
+// =======================
+
+type cartServiceJSONClient struct {
+	client      HTTPClient
+	urls        [5]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewCartServiceJSONClient creates a JSON client that implements the CartService interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewCartServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) CartService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "go.escape.ship.proto.v1", "CartService")
+	urls := [5]string{
+		serviceURL + "GetCart",
+		serviceURL + "AddItem",
+		serviceURL + "RemoveItem",
+		serviceURL + "UpdateQuantity",
+		serviceURL + "ClearCart",
+	}
+
+	return &cartServiceJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *cartServiceJSONClient) GetCart(ctx context.Context, in *GetCartRequest) (*GetCartResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "CartService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetCart")
+	caller := c.callGetCart
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetCartRequest) (*GetCartResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetCartRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetCartRequest) when calling interceptor")
+					}
+					return c.callGetCart(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetCartResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetCartResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *cartServiceJSONClient) callGetCart(ctx context.Context, in *GetCartRequest) (*GetCartResponse, error) {
+	out := new(GetCartResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *cartServiceJSONClient) AddItem(ctx context.Context, in *AddItemRequest) (*AddItemResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "CartService")
+	ctx = ctxsetters.WithMethodName(ctx, "AddItem")
+	caller := c.callAddItem
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AddItemRequest) (*AddItemResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AddItemRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AddItemRequest) when calling interceptor")
+					}
+					return c.callAddItem(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AddItemResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AddItemResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *cartServiceJSONClient) callAddItem(ctx context.Context, in *AddItemRequest) (*AddItemResponse, error) {
+	out := new(AddItemResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *cartServiceJSONClient) RemoveItem(ctx context.Context, in *RemoveItemRequest) (*RemoveItemResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "CartService")
+	ctx = ctxsetters.WithMethodName(ctx, "RemoveItem")
+	caller := c.callRemoveItem
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RemoveItemRequest) (*RemoveItemResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RemoveItemRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RemoveItemRequest) when calling interceptor")
+					}
+					return c.callRemoveItem(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RemoveItemResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RemoveItemResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *cartServiceJSONClient) callRemoveItem(ctx context.Context, in *RemoveItemRequest) (*RemoveItemResponse, error) {
+	out := new(RemoveItemResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *cartServiceJSONClient) UpdateQuantity(ctx context.Context, in *UpdateQuantityRequest) (*UpdateQuantityResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "CartService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateQuantity")
+	caller := c.callUpdateQuantity
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateQuantityRequest) (*UpdateQuantityResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateQuantityRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateQuantityRequest) when calling interceptor")
+					}
+					return c.callUpdateQuantity(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateQuantityResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateQuantityResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *cartServiceJSONClient) callUpdateQuantity(ctx context.Context, in *UpdateQuantityRequest) (*UpdateQuantityResponse, error) {
+	out := new(UpdateQuantityResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *cartServiceJSONClient) ClearCart(ctx context.Context, in *ClearCartRequest) (*ClearCartResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "CartService")
+	ctx = ctxsetters.WithMethodName(ctx, "ClearCart")
+	caller := c.callClearCart
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ClearCartRequest) (*ClearCartResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ClearCartRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ClearCartRequest) when calling interceptor")
+					}
+					return c.callClearCart(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ClearCartResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ClearCartResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *cartServiceJSONClient) callClearCart(ctx context.Context, in *ClearCartRequest) (*ClearCartResponse, error) {
+	out := new(ClearCartResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ==========================
+// CartService Server Handler
+// ==========================
+
+type cartServiceServer struct {
+	CartService
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewCartServiceServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewCartServiceServer(svc CartService, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	return &cartServiceServer{
+		CartService:      svc,
+		hooks:            serverOpts.Hooks,
+		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:       pathPrefix,
+		jsonSkipDefaults: jsonSkipDefaults,
+		jsonCamelCase:    jsonCamelCase,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *cartServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *cartServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
+
+// CartServicePathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const CartServicePathPrefix = "/twirp/go.escape.ship.proto.v1.CartService/"
+
+func (s *cartServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "CartService")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "go.escape.ship.proto.v1.CartService" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "GetCart":
+		s.serveGetCart(ctx, resp, req)
+		return
+	case "AddItem":
+		s.serveAddItem(ctx, resp, req)
+		return
+	case "RemoveItem":
+		s.serveRemoveItem(ctx, resp, req)
+		return
+	case "UpdateQuantity":
+		s.serveUpdateQuantity(ctx, resp, req)
+		return
+	case "ClearCart":
+		s.serveClearCart(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *cartServiceServer) serveGetCart(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetCartJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetCartProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *cartServiceServer) serveGetCartJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetCart")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetCartRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.CartService.GetCart
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetCartRequest) (*GetCartResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetCartRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetCartRequest) when calling interceptor")
+					}
+					return s.CartService.GetCart(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetCartResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetCartResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetCartResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetCartResponse and nil error while calling GetCart. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *cartServiceServer) serveGetCartProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetCart")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetCartRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.CartService.GetCart
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetCartRequest) (*GetCartResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetCartRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetCartRequest) when calling interceptor")
+					}
+					return s.CartService.GetCart(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetCartResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetCartResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetCartResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetCartResponse and nil error while calling GetCart. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *cartServiceServer) serveAddItem(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveAddItemJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveAddItemProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *cartServiceServer) serveAddItemJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "AddItem")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(AddItemRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.CartService.AddItem
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *AddItemRequest) (*AddItemResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AddItemRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AddItemRequest) when calling interceptor")
+					}
+					return s.CartService.AddItem(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AddItemResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AddItemResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *AddItemResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AddItemResponse and nil error while calling AddItem. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *cartServiceServer) serveAddItemProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "AddItem")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(AddItemRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.CartService.AddItem
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *AddItemRequest) (*AddItemResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AddItemRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AddItemRequest) when calling interceptor")
+					}
+					return s.CartService.AddItem(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AddItemResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AddItemResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *AddItemResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AddItemResponse and nil error while calling AddItem. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *cartServiceServer) serveRemoveItem(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveRemoveItemJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveRemoveItemProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *cartServiceServer) serveRemoveItemJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RemoveItem")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(RemoveItemRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.CartService.RemoveItem
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RemoveItemRequest) (*RemoveItemResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RemoveItemRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RemoveItemRequest) when calling interceptor")
+					}
+					return s.CartService.RemoveItem(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RemoveItemResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RemoveItemResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RemoveItemResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RemoveItemResponse and nil error while calling RemoveItem. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *cartServiceServer) serveRemoveItemProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RemoveItem")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(RemoveItemRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.CartService.RemoveItem
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RemoveItemRequest) (*RemoveItemResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RemoveItemRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RemoveItemRequest) when calling interceptor")
+					}
+					return s.CartService.RemoveItem(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RemoveItemResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RemoveItemResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RemoveItemResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RemoveItemResponse and nil error while calling RemoveItem. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *cartServiceServer) serveUpdateQuantity(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveUpdateQuantityJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveUpdateQuantityProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *cartServiceServer) serveUpdateQuantityJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateQuantity")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(UpdateQuantityRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.CartService.UpdateQuantity
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateQuantityRequest) (*UpdateQuantityResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateQuantityRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateQuantityRequest) when calling interceptor")
+					}
+					return s.CartService.UpdateQuantity(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateQuantityResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateQuantityResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UpdateQuantityResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpdateQuantityResponse and nil error while calling UpdateQuantity. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *cartServiceServer) serveUpdateQuantityProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateQuantity")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(UpdateQuantityRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.CartService.UpdateQuantity
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateQuantityRequest) (*UpdateQuantityResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateQuantityRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateQuantityRequest) when calling interceptor")
+					}
+					return s.CartService.UpdateQuantity(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateQuantityResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateQuantityResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UpdateQuantityResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpdateQuantityResponse and nil error while calling UpdateQuantity. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *cartServiceServer) serveClearCart(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveClearCartJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveClearCartProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *cartServiceServer) serveClearCartJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ClearCart")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ClearCartRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.CartService.ClearCart
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ClearCartRequest) (*ClearCartResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ClearCartRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ClearCartRequest) when calling interceptor")
+					}
+					return s.CartService.ClearCart(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ClearCartResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ClearCartResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ClearCartResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ClearCartResponse and nil error while calling ClearCart. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *cartServiceServer) serveClearCartProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ClearCart")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ClearCartRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.CartService.ClearCart
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ClearCartRequest) (*ClearCartResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ClearCartRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ClearCartRequest) when calling interceptor")
+					}
+					return s.CartService.ClearCart(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ClearCartResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ClearCartResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ClearCartResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ClearCartResponse and nil error while calling ClearCart. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *cartServiceServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor2, 0
+}
+
+func (s *cartServiceServer) ProtocGenTwirpVersion() string {
+	return "v8.1.3"
+}
+
+// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
+// that is everything in a Twirp route except for the <Method>. This can be used for routing,
+// for example to identify the requests that are targeted to this service in a mux.
+func (s *cartServiceServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "go.escape.ship.proto.v1", "CartService")
+}
+
+var twirpFileDescriptor2 = []byte{
+	// 691 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0x95, 0x9d, 0xa4, 0x49, 0x6e, 0xbe, 0xcf, 0x6d, 0x07, 0x41, 0x5d, 0x43, 0x45, 0xea, 0x0a,
+	0x35, 0x04, 0xb0, 0xd5, 0xb2, 0x40, 0xea, 0xae, 0x14, 0x51, 0x45, 0x20, 0x7e, 0x0c, 0x6c, 0xd8,
+	0x44, 0xd3, 0xcc, 0x28, 0x1d, 0x29, 0xf1, 0xb8, 0xf6, 0x38, 0x08, 0x21, 0x36, 0x6c, 0x58, 0x22,
+	0x84, 0x04, 0x8f, 0xc3, 0x43, 0xf0, 0x0a, 0x3c, 0x08, 0x9a, 0x19, 0xc7, 0xc4, 0xa1, 0x6e, 0xa3,
+	0xec, 0x72, 0x8f, 0xcf, 0xdc, 0x73, 0xcf, 0xbd, 0x27, 0x00, 0x03, 0x1c, 0x0b, 0x2f, 0x8a, 0xb9,
+	0xe0, 0x68, 0x63, 0xc8, 0x3d, 0x9a, 0x0c, 0x70, 0x44, 0xbd, 0xe4, 0x94, 0x45, 0x1a, 0xf5, 0x26,
+	0x7b, 0xce, 0x8d, 0x21, 0xe7, 0xc3, 0x11, 0xf5, 0x71, 0xc4, 0x7c, 0x1c, 0x86, 0x5c, 0x60, 0xc1,
+	0x78, 0x98, 0x68, 0x82, 0xfb, 0xc3, 0x84, 0xc6, 0x11, 0x8e, 0x45, 0x4f, 0xd0, 0x31, 0xb2, 0xc0,
+	0x64, 0xc4, 0x36, 0xda, 0x46, 0xa7, 0x19, 0x98, 0x8c, 0xa0, 0x2d, 0x80, 0x28, 0xe6, 0x24, 0x1d,
+	0x88, 0x3e, 0x23, 0xb6, 0xa9, 0xf0, 0x66, 0x86, 0xf4, 0x08, 0xda, 0x86, 0xff, 0xa6, 0x9f, 0x43,
+	0x3c, 0xa6, 0x76, 0x45, 0x11, 0x5a, 0x19, 0xf6, 0x0c, 0x8f, 0x29, 0xda, 0x85, 0xd5, 0x29, 0x85,
+	0x47, 0x4a, 0xd7, 0xae, 0x2a, 0x96, 0x95, 0xc1, 0xcf, 0x35, 0x2a, 0xa5, 0xd2, 0x90, 0x89, 0x7e,
+	0x14, 0xb3, 0x01, 0xb5, 0x6b, 0x6d, 0xa3, 0x53, 0x09, 0x9a, 0x12, 0x79, 0x21, 0x01, 0xe4, 0x40,
+	0xe3, 0x2c, 0xc5, 0xa1, 0x60, 0xe2, 0xbd, 0xbd, 0xd2, 0x36, 0x3a, 0xb5, 0x20, 0xaf, 0xe5, 0xd3,
+	0x11, 0x0b, 0x69, 0x5f, 0x70, 0x81, 0x47, 0x76, 0x5d, 0x3f, 0x95, 0xc8, 0x6b, 0x09, 0xa0, 0xeb,
+	0xd0, 0x3c, 0x49, 0x43, 0x32, 0xa2, 0xd2, 0x43, 0x43, 0x89, 0x37, 0x34, 0xd0, 0x23, 0x68, 0x13,
+	0x1a, 0x98, 0x10, 0x4a, 0xfa, 0x58, 0xd8, 0x4d, 0xf5, 0xad, 0xae, 0xea, 0x43, 0xe1, 0xfe, 0x34,
+	0xa0, 0x2a, 0x37, 0x83, 0x36, 0xa0, 0x9e, 0x26, 0x34, 0xee, 0xe7, 0xab, 0x59, 0x91, 0x65, 0x8f,
+	0xa0, 0x07, 0x50, 0x63, 0x82, 0x8e, 0x13, 0xdb, 0x6c, 0x57, 0x3a, 0xad, 0xfd, 0x6d, 0xaf, 0xe4,
+	0x04, 0xde, 0x74, 0xc1, 0x81, 0xe6, 0xa3, 0x5b, 0x60, 0xa9, 0x61, 0xfb, 0xb9, 0xa7, 0x8a, 0xf2,
+	0xf4, 0xbf, 0x42, 0x5f, 0x4e, 0x8d, 0xdd, 0x84, 0x96, 0xa6, 0xe9, 0xa5, 0x54, 0x95, 0x33, 0x50,
+	0x90, 0xde, 0x8a, 0x5c, 0x5a, 0x44, 0xb0, 0xd0, 0xf3, 0xd7, 0xf4, 0x7d, 0x32, 0xe4, 0x50, 0xb8,
+	0x6b, 0x60, 0x1d, 0x53, 0x21, 0xc5, 0x03, 0x7a, 0x96, 0xd2, 0x44, 0xb8, 0x8f, 0x60, 0x35, 0x47,
+	0x92, 0x88, 0x87, 0x09, 0x45, 0x7b, 0x50, 0x95, 0x29, 0x52, 0xd6, 0x5a, 0xfb, 0x5b, 0x17, 0x7a,
+	0x08, 0x14, 0xd5, 0xfd, 0x6a, 0x80, 0x75, 0x48, 0x88, 0x72, 0xa4, 0x1b, 0xcf, 0x25, 0xc5, 0x98,
+	0x4f, 0xca, 0x39, 0x31, 0x30, 0xcf, 0x8d, 0xc1, 0xec, 0x9d, 0x2b, 0x73, 0x77, 0x2e, 0x1c, 0xb2,
+	0x5a, 0x3c, 0xa4, 0x74, 0x96, 0x8f, 0xb4, 0xbc, 0xb3, 0xbb, 0xb0, 0x1e, 0xd0, 0x31, 0x9f, 0xd0,
+	0x59, 0x6f, 0x1b, 0x50, 0x97, 0x67, 0x9b, 0xb9, 0xbf, 0x2c, 0x7b, 0xc4, 0x3d, 0x06, 0x34, 0xcb,
+	0x5e, 0x5e, 0xf6, 0x29, 0x5c, 0x7d, 0xa3, 0xae, 0x36, 0x3d, 0xfd, 0x65, 0xd2, 0x85, 0x3d, 0x99,
+	0xc5, 0x3d, 0xb9, 0x4f, 0xe0, 0xda, 0x7c, 0xb7, 0xe5, 0x47, 0x43, 0xb0, 0x76, 0x34, 0xa2, 0x38,
+	0x9e, 0x4d, 0xd1, 0x63, 0x58, 0x9f, 0xc1, 0x96, 0xee, 0xbd, 0xff, 0xa5, 0x06, 0x2d, 0x59, 0xbe,
+	0xa2, 0xf1, 0x44, 0xc6, 0x39, 0x84, 0x7a, 0x96, 0x4e, 0xb4, 0x5b, 0xfa, 0xbe, 0x98, 0x68, 0xa7,
+	0x73, 0x39, 0x51, 0x0f, 0xe8, 0xae, 0x7d, 0xfa, 0xf5, 0xfb, 0x9b, 0x09, 0xa8, 0xe1, 0x4f, 0xf6,
+	0x7c, 0xa9, 0x8f, 0xde, 0x41, 0x3d, 0xcb, 0xcc, 0x05, 0x7a, 0xc5, 0xa0, 0x3b, 0x9d, 0xcb, 0x89,
+	0x99, 0xde, 0xa6, 0xd2, 0xbb, 0x72, 0x60, 0x74, 0x5d, 0x6b, 0x2a, 0xe9, 0xeb, 0xff, 0xff, 0x67,
+	0x03, 0xe0, 0x6f, 0x72, 0x50, 0xb7, 0xb4, 0xe7, 0x3f, 0x61, 0x74, 0xee, 0x2c, 0xc4, 0xcd, 0x46,
+	0x68, 0xab, 0x11, 0x9c, 0xae, 0x5d, 0xd4, 0xf7, 0x3f, 0x64, 0xa1, 0xfa, 0x88, 0xbe, 0x1b, 0x60,
+	0x15, 0xc3, 0x82, 0xbc, 0x52, 0x85, 0x73, 0x33, 0xea, 0xf8, 0x0b, 0xf3, 0xb3, 0xa9, 0x76, 0xd4,
+	0x54, 0x5b, 0x07, 0x46, 0xd7, 0x29, 0x1f, 0x4c, 0x40, 0x33, 0xcf, 0x18, 0xba, 0x5d, 0x9e, 0xa6,
+	0xb9, 0x6c, 0x3a, 0xdd, 0x45, 0xa8, 0xc5, 0x44, 0x74, 0xf3, 0x44, 0x3c, 0xdc, 0x79, 0xbb, 0x3d,
+	0x64, 0xe2, 0x34, 0x3d, 0xf1, 0x06, 0x7c, 0xec, 0xeb, 0x36, 0xf7, 0x64, 0x1b, 0x5f, 0xb5, 0x49,
+	0xfc, 0x21, 0x0d, 0x4f, 0x56, 0xd4, 0xef, 0xfb, 0x7f, 0x06, 0x00, 0x97, 0xc1, 0xd7, 0xf2, 0x7e,
+	0x07, 0x00, 0x00,
+}
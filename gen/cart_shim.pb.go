@@ -0,0 +1,84 @@
+// Code generated by protoc-gen-go-shim. DO NOT EDIT.
+// source: cart.proto
+
+package gen
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+)
+
+// CartServiceAPI is CartServiceClient without per-call options, so it can be
+// mocked with plain method signatures. Streams are exposed as iterators.
+type CartServiceAPI interface {
+	GetCart(ctx context.Context, in *GetCartRequest) (*GetCartResponse, error)
+	// 같은 상품/옵션/번들 항목이 이미 있으면 수량을 합산
+	// 재고 부족은 OUT_OF_STOCK, 고객당 구매 제한 초과는 PURCHASE_LIMIT_EXCEEDED 에러
+	AddItem(ctx context.Context, in *AddItemRequest) (*AddItemResponse, error)
+	RemoveItem(ctx context.Context, in *RemoveItemRequest) (*RemoveItemResponse, error)
+	// quantity가 0이면 항목 삭제
+	UpdateQuantity(ctx context.Context, in *UpdateQuantityRequest) (*UpdateQuantityResponse, error)
+	// 주문 완료 후 또는 사용자 요청 시 전체 비우기
+	ClearCart(ctx context.Context, in *ClearCartRequest) (*ClearCartResponse, error)
+}
+
+// NewCartServiceAPI adapts c to CartServiceAPI, passing opts to every call.
+func NewCartServiceAPI(c CartServiceClient, opts ...grpc.CallOption) CartServiceAPI {
+	return &cartServiceAPI{c: c, opts: opts}
+}
+
+type cartServiceAPI struct {
+	c    CartServiceClient
+	opts []grpc.CallOption
+}
+
+func (a *cartServiceAPI) GetCart(ctx context.Context, in *GetCartRequest) (*GetCartResponse, error) {
+	return a.c.GetCart(ctx, in, a.opts...)
+}
+
+func (a *cartServiceAPI) AddItem(ctx context.Context, in *AddItemRequest) (*AddItemResponse, error) {
+	return a.c.AddItem(ctx, in, a.opts...)
+}
+
+func (a *cartServiceAPI) RemoveItem(ctx context.Context, in *RemoveItemRequest) (*RemoveItemResponse, error) {
+	return a.c.RemoveItem(ctx, in, a.opts...)
+}
+
+func (a *cartServiceAPI) UpdateQuantity(ctx context.Context, in *UpdateQuantityRequest) (*UpdateQuantityResponse, error) {
+	return a.c.UpdateQuantity(ctx, in, a.opts...)
+}
+
+func (a *cartServiceAPI) ClearCart(ctx context.Context, in *ClearCartRequest) (*ClearCartResponse, error) {
+	return a.c.ClearCart(ctx, in, a.opts...)
+}
+
+// CartServiceClientFromAPI adapts a to CartServiceClient, e.g. to hand a
+// mock CartServiceAPI to code that takes the generated client. Call options
+// are ignored, and streams report empty headers and trailers.
+func CartServiceClientFromAPI(a CartServiceAPI) CartServiceClient {
+	return cartServiceAPIClient{api: a}
+}
+
+type cartServiceAPIClient struct {
+	api CartServiceAPI
+}
+
+func (c cartServiceAPIClient) GetCart(ctx context.Context, in *GetCartRequest, _ ...grpc.CallOption) (*GetCartResponse, error) {
+	return c.api.GetCart(ctx, in)
+}
+
+func (c cartServiceAPIClient) AddItem(ctx context.Context, in *AddItemRequest, _ ...grpc.CallOption) (*AddItemResponse, error) {
+	return c.api.AddItem(ctx, in)
+}
+
+func (c cartServiceAPIClient) RemoveItem(ctx context.Context, in *RemoveItemRequest, _ ...grpc.CallOption) (*RemoveItemResponse, error) {
+	return c.api.RemoveItem(ctx, in)
+}
+
+func (c cartServiceAPIClient) UpdateQuantity(ctx context.Context, in *UpdateQuantityRequest, _ ...grpc.CallOption) (*UpdateQuantityResponse, error) {
+	return c.api.UpdateQuantity(ctx, in)
+}
+
+func (c cartServiceAPIClient) ClearCart(ctx context.Context, in *ClearCartRequest, _ ...grpc.CallOption) (*ClearCartResponse, error) {
+	return c.api.ClearCart(ctx, in)
+}
@@ -0,0 +1,1050 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: chat.proto
+
+package gen
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ChatSenderRole int32
+
+const (
+	ChatSenderRole_CHAT_SENDER_ROLE_UNSPECIFIED ChatSenderRole = 0
+	ChatSenderRole_CHAT_SENDER_ROLE_CUSTOMER    ChatSenderRole = 1
+	ChatSenderRole_CHAT_SENDER_ROLE_AGENT       ChatSenderRole = 2
+	ChatSenderRole_CHAT_SENDER_ROLE_SYSTEM      ChatSenderRole = 3
+)
+
+// Enum value maps for ChatSenderRole.
+var (
+	ChatSenderRole_name = map[int32]string{
+		0: "CHAT_SENDER_ROLE_UNSPECIFIED",
+		1: "CHAT_SENDER_ROLE_CUSTOMER",
+		2: "CHAT_SENDER_ROLE_AGENT",
+		3: "CHAT_SENDER_ROLE_SYSTEM",
+	}
+	ChatSenderRole_value = map[string]int32{
+		"CHAT_SENDER_ROLE_UNSPECIFIED": 0,
+		"CHAT_SENDER_ROLE_CUSTOMER":    1,
+		"CHAT_SENDER_ROLE_AGENT":       2,
+		"CHAT_SENDER_ROLE_SYSTEM":      3,
+	}
+)
+
+func (x ChatSenderRole) Enum() *ChatSenderRole {
+	p := new(ChatSenderRole)
+	*p = x
+	return p
+}
+
+func (x ChatSenderRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChatSenderRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_chat_proto_enumTypes[0].Descriptor()
+}
+
+func (ChatSenderRole) Type() protoreflect.EnumType {
+	return &file_chat_proto_enumTypes[0]
+}
+
+func (x ChatSenderRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChatSenderRole.Descriptor instead.
+func (ChatSenderRole) EnumDescriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{0}
+}
+
+type PresenceStatus int32
+
+const (
+	PresenceStatus_PRESENCE_STATUS_UNSPECIFIED PresenceStatus = 0
+	PresenceStatus_PRESENCE_STATUS_ONLINE      PresenceStatus = 1
+	PresenceStatus_PRESENCE_STATUS_AWAY        PresenceStatus = 2
+	PresenceStatus_PRESENCE_STATUS_OFFLINE     PresenceStatus = 3
+)
+
+// Enum value maps for PresenceStatus.
+var (
+	PresenceStatus_name = map[int32]string{
+		0: "PRESENCE_STATUS_UNSPECIFIED",
+		1: "PRESENCE_STATUS_ONLINE",
+		2: "PRESENCE_STATUS_AWAY",
+		3: "PRESENCE_STATUS_OFFLINE",
+	}
+	PresenceStatus_value = map[string]int32{
+		"PRESENCE_STATUS_UNSPECIFIED": 0,
+		"PRESENCE_STATUS_ONLINE":      1,
+		"PRESENCE_STATUS_AWAY":        2,
+		"PRESENCE_STATUS_OFFLINE":     3,
+	}
+)
+
+func (x PresenceStatus) Enum() *PresenceStatus {
+	p := new(PresenceStatus)
+	*p = x
+	return p
+}
+
+func (x PresenceStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PresenceStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_chat_proto_enumTypes[1].Descriptor()
+}
+
+func (PresenceStatus) Type() protoreflect.EnumType {
+	return &file_chat_proto_enumTypes[1]
+}
+
+func (x PresenceStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PresenceStatus.Descriptor instead.
+func (PresenceStatus) EnumDescriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{1}
+}
+
+type Conversation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`    // 주문 관련 문의일 때
+	TicketId      string                 `protobuf:"bytes,3,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"` // CS 티켓 관련 문의일 때
+	CustomerId    string                 `protobuf:"bytes,4,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	AgentId       string                 `protobuf:"bytes,5,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ClosedAt      string                 `protobuf:"bytes,7,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Conversation) Reset() {
+	*x = Conversation{}
+	mi := &file_chat_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Conversation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Conversation) ProtoMessage() {}
+
+func (x *Conversation) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Conversation.ProtoReflect.Descriptor instead.
+func (*Conversation) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Conversation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Conversation) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *Conversation) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+func (x *Conversation) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *Conversation) GetAgentId() string {
+	if x != nil {
+		return x.AgentId
+	}
+	return ""
+}
+
+func (x *Conversation) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Conversation) GetClosedAt() string {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return ""
+}
+
+type ChatMessage struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ConversationId  string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	SenderId        string                 `protobuf:"bytes,3,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	SenderRole      ChatSenderRole         `protobuf:"varint,4,opt,name=sender_role,json=senderRole,proto3,enum=go.escape.ship.proto.v1.ChatSenderRole" json:"sender_role,omitempty"`
+	Text            string                 `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
+	ClientMessageId string                 `protobuf:"bytes,6,opt,name=client_message_id,json=clientMessageId,proto3" json:"client_message_id,omitempty"` // 클라이언트 재전송 중복 제거용
+	SentAt          string                 `protobuf:"bytes,7,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ChatMessage) Reset() {
+	*x = ChatMessage{}
+	mi := &file_chat_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatMessage) ProtoMessage() {}
+
+func (x *ChatMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatMessage.ProtoReflect.Descriptor instead.
+func (*ChatMessage) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ChatMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetSenderId() string {
+	if x != nil {
+		return x.SenderId
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetSenderRole() ChatSenderRole {
+	if x != nil {
+		return x.SenderRole
+	}
+	return ChatSenderRole_CHAT_SENDER_ROLE_UNSPECIFIED
+}
+
+func (x *ChatMessage) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetClientMessageId() string {
+	if x != nil {
+		return x.ClientMessageId
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetSentAt() string {
+	if x != nil {
+		return x.SentAt
+	}
+	return ""
+}
+
+type OpenConversationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Scope:
+	//
+	//	*OpenConversationRequest_OrderId
+	//	*OpenConversationRequest_TicketId
+	Scope         isOpenConversationRequest_Scope `protobuf_oneof:"scope"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpenConversationRequest) Reset() {
+	*x = OpenConversationRequest{}
+	mi := &file_chat_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenConversationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenConversationRequest) ProtoMessage() {}
+
+func (x *OpenConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenConversationRequest.ProtoReflect.Descriptor instead.
+func (*OpenConversationRequest) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *OpenConversationRequest) GetScope() isOpenConversationRequest_Scope {
+	if x != nil {
+		return x.Scope
+	}
+	return nil
+}
+
+func (x *OpenConversationRequest) GetOrderId() string {
+	if x != nil {
+		if x, ok := x.Scope.(*OpenConversationRequest_OrderId); ok {
+			return x.OrderId
+		}
+	}
+	return ""
+}
+
+func (x *OpenConversationRequest) GetTicketId() string {
+	if x != nil {
+		if x, ok := x.Scope.(*OpenConversationRequest_TicketId); ok {
+			return x.TicketId
+		}
+	}
+	return ""
+}
+
+type isOpenConversationRequest_Scope interface {
+	isOpenConversationRequest_Scope()
+}
+
+type OpenConversationRequest_OrderId struct {
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3,oneof"`
+}
+
+type OpenConversationRequest_TicketId struct {
+	TicketId string `protobuf:"bytes,2,opt,name=ticket_id,json=ticketId,proto3,oneof"`
+}
+
+func (*OpenConversationRequest_OrderId) isOpenConversationRequest_Scope() {}
+
+func (*OpenConversationRequest_TicketId) isOpenConversationRequest_Scope() {}
+
+type OpenConversationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Conversation  *Conversation          `protobuf:"bytes,1,opt,name=conversation,proto3" json:"conversation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpenConversationResponse) Reset() {
+	*x = OpenConversationResponse{}
+	mi := &file_chat_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenConversationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenConversationResponse) ProtoMessage() {}
+
+func (x *OpenConversationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenConversationResponse.ProtoReflect.Descriptor instead.
+func (*OpenConversationResponse) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *OpenConversationResponse) GetConversation() *Conversation {
+	if x != nil {
+		return x.Conversation
+	}
+	return nil
+}
+
+type ListChatMessagesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	PageSize       int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken      string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListChatMessagesRequest) Reset() {
+	*x = ListChatMessagesRequest{}
+	mi := &file_chat_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChatMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChatMessagesRequest) ProtoMessage() {}
+
+func (x *ListChatMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChatMessagesRequest.ProtoReflect.Descriptor instead.
+func (*ListChatMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListChatMessagesRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *ListChatMessagesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListChatMessagesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListChatMessagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*ChatMessage         `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChatMessagesResponse) Reset() {
+	*x = ListChatMessagesResponse{}
+	mi := &file_chat_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChatMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChatMessagesResponse) ProtoMessage() {}
+
+func (x *ListChatMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChatMessagesResponse.ProtoReflect.Descriptor instead.
+func (*ListChatMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListChatMessagesResponse) GetMessages() []*ChatMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *ListChatMessagesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// 참여자 접속 상태 (저장되지 않음)
+type PresenceEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          ChatSenderRole         `protobuf:"varint,2,opt,name=role,proto3,enum=go.escape.ship.proto.v1.ChatSenderRole" json:"role,omitempty"`
+	Status        PresenceStatus         `protobuf:"varint,3,opt,name=status,proto3,enum=go.escape.ship.proto.v1.PresenceStatus" json:"status,omitempty"`
+	At            string                 `protobuf:"bytes,4,opt,name=at,proto3" json:"at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PresenceEvent) Reset() {
+	*x = PresenceEvent{}
+	mi := &file_chat_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PresenceEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresenceEvent) ProtoMessage() {}
+
+func (x *PresenceEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresenceEvent.ProtoReflect.Descriptor instead.
+func (*PresenceEvent) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PresenceEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PresenceEvent) GetRole() ChatSenderRole {
+	if x != nil {
+		return x.Role
+	}
+	return ChatSenderRole_CHAT_SENDER_ROLE_UNSPECIFIED
+}
+
+func (x *PresenceEvent) GetStatus() PresenceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PresenceStatus_PRESENCE_STATUS_UNSPECIFIED
+}
+
+func (x *PresenceEvent) GetAt() string {
+	if x != nil {
+		return x.At
+	}
+	return ""
+}
+
+// 입력 중 표시 (저장되지 않음, 클라이언트는 수 초간 갱신이 없으면 typing=false로 간주)
+type TypingEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          ChatSenderRole         `protobuf:"varint,2,opt,name=role,proto3,enum=go.escape.ship.proto.v1.ChatSenderRole" json:"role,omitempty"`
+	Typing        bool                   `protobuf:"varint,3,opt,name=typing,proto3" json:"typing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TypingEvent) Reset() {
+	*x = TypingEvent{}
+	mi := &file_chat_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TypingEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypingEvent) ProtoMessage() {}
+
+func (x *TypingEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypingEvent.ProtoReflect.Descriptor instead.
+func (*TypingEvent) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TypingEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TypingEvent) GetRole() ChatSenderRole {
+	if x != nil {
+		return x.Role
+	}
+	return ChatSenderRole_CHAT_SENDER_ROLE_UNSPECIFIED
+}
+
+func (x *TypingEvent) GetTyping() bool {
+	if x != nil {
+		return x.Typing
+	}
+	return false
+}
+
+type ChatRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	// Types that are valid to be assigned to Event:
+	//
+	//	*ChatRequest_Message
+	//	*ChatRequest_Presence
+	//	*ChatRequest_Typing
+	Event         isChatRequest_Event `protobuf_oneof:"event"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatRequest) Reset() {
+	*x = ChatRequest{}
+	mi := &file_chat_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatRequest) ProtoMessage() {}
+
+func (x *ChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatRequest.ProtoReflect.Descriptor instead.
+func (*ChatRequest) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ChatRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *ChatRequest) GetEvent() isChatRequest_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *ChatRequest) GetMessage() *ChatMessage {
+	if x != nil {
+		if x, ok := x.Event.(*ChatRequest_Message); ok {
+			return x.Message
+		}
+	}
+	return nil
+}
+
+func (x *ChatRequest) GetPresence() *PresenceEvent {
+	if x != nil {
+		if x, ok := x.Event.(*ChatRequest_Presence); ok {
+			return x.Presence
+		}
+	}
+	return nil
+}
+
+func (x *ChatRequest) GetTyping() *TypingEvent {
+	if x != nil {
+		if x, ok := x.Event.(*ChatRequest_Typing); ok {
+			return x.Typing
+		}
+	}
+	return nil
+}
+
+type isChatRequest_Event interface {
+	isChatRequest_Event()
+}
+
+type ChatRequest_Message struct {
+	Message *ChatMessage `protobuf:"bytes,2,opt,name=message,proto3,oneof"` // id, sender, sent_at은 서버가 채움
+}
+
+type ChatRequest_Presence struct {
+	Presence *PresenceEvent `protobuf:"bytes,3,opt,name=presence,proto3,oneof"` // user_id, role, at은 서버가 채움
+}
+
+type ChatRequest_Typing struct {
+	Typing *TypingEvent `protobuf:"bytes,4,opt,name=typing,proto3,oneof"`
+}
+
+func (*ChatRequest_Message) isChatRequest_Event() {}
+
+func (*ChatRequest_Presence) isChatRequest_Event() {}
+
+func (*ChatRequest_Typing) isChatRequest_Event() {}
+
+type ChatResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Event:
+	//
+	//	*ChatResponse_Message
+	//	*ChatResponse_Presence
+	//	*ChatResponse_Typing
+	Event         isChatResponse_Event `protobuf_oneof:"event"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatResponse) Reset() {
+	*x = ChatResponse{}
+	mi := &file_chat_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatResponse) ProtoMessage() {}
+
+func (x *ChatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatResponse.ProtoReflect.Descriptor instead.
+func (*ChatResponse) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ChatResponse) GetEvent() isChatResponse_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *ChatResponse) GetMessage() *ChatMessage {
+	if x != nil {
+		if x, ok := x.Event.(*ChatResponse_Message); ok {
+			return x.Message
+		}
+	}
+	return nil
+}
+
+func (x *ChatResponse) GetPresence() *PresenceEvent {
+	if x != nil {
+		if x, ok := x.Event.(*ChatResponse_Presence); ok {
+			return x.Presence
+		}
+	}
+	return nil
+}
+
+func (x *ChatResponse) GetTyping() *TypingEvent {
+	if x != nil {
+		if x, ok := x.Event.(*ChatResponse_Typing); ok {
+			return x.Typing
+		}
+	}
+	return nil
+}
+
+type isChatResponse_Event interface {
+	isChatResponse_Event()
+}
+
+type ChatResponse_Message struct {
+	Message *ChatMessage `protobuf:"bytes,1,opt,name=message,proto3,oneof"`
+}
+
+type ChatResponse_Presence struct {
+	Presence *PresenceEvent `protobuf:"bytes,2,opt,name=presence,proto3,oneof"`
+}
+
+type ChatResponse_Typing struct {
+	Typing *TypingEvent `protobuf:"bytes,3,opt,name=typing,proto3,oneof"`
+}
+
+func (*ChatResponse_Message) isChatResponse_Event() {}
+
+func (*ChatResponse_Presence) isChatResponse_Event() {}
+
+func (*ChatResponse_Typing) isChatResponse_Event() {}
+
+var File_chat_proto protoreflect.FileDescriptor
+
+const file_chat_proto_rawDesc = "" +
+	"\n" +
+	"\n" +
+	"chat.proto\x12\x17go.escape.ship.proto.v1\x1a\x1cgoogle/api/annotations.proto\"\xce\x01\n" +
+	"\fConversation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x1b\n" +
+	"\tticket_id\x18\x03 \x01(\tR\bticketId\x12\x1f\n" +
+	"\vcustomer_id\x18\x04 \x01(\tR\n" +
+	"customerId\x12\x19\n" +
+	"\bagent_id\x18\x05 \x01(\tR\aagentId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1b\n" +
+	"\tclosed_at\x18\a \x01(\tR\bclosedAt\"\x86\x02\n" +
+	"\vChatMessage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\x12\x1b\n" +
+	"\tsender_id\x18\x03 \x01(\tR\bsenderId\x12H\n" +
+	"\vsender_role\x18\x04 \x01(\x0e2'.go.escape.ship.proto.v1.ChatSenderRoleR\n" +
+	"senderRole\x12\x12\n" +
+	"\x04text\x18\x05 \x01(\tR\x04text\x12*\n" +
+	"\x11client_message_id\x18\x06 \x01(\tR\x0fclientMessageId\x12\x17\n" +
+	"\asent_at\x18\a \x01(\tR\x06sentAt\"^\n" +
+	"\x17OpenConversationRequest\x12\x1b\n" +
+	"\border_id\x18\x01 \x01(\tH\x00R\aorderId\x12\x1d\n" +
+	"\tticket_id\x18\x02 \x01(\tH\x00R\bticketIdB\a\n" +
+	"\x05scope\"e\n" +
+	"\x18OpenConversationResponse\x12I\n" +
+	"\fconversation\x18\x01 \x01(\v2%.go.escape.ship.proto.v1.ConversationR\fconversation\"~\n" +
+	"\x17ListChatMessagesRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x84\x01\n" +
+	"\x18ListChatMessagesResponse\x12@\n" +
+	"\bmessages\x18\x01 \x03(\v2$.go.escape.ship.proto.v1.ChatMessageR\bmessages\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xb6\x01\n" +
+	"\rPresenceEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12;\n" +
+	"\x04role\x18\x02 \x01(\x0e2'.go.escape.ship.proto.v1.ChatSenderRoleR\x04role\x12?\n" +
+	"\x06status\x18\x03 \x01(\x0e2'.go.escape.ship.proto.v1.PresenceStatusR\x06status\x12\x0e\n" +
+	"\x02at\x18\x04 \x01(\tR\x02at\"{\n" +
+	"\vTypingEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12;\n" +
+	"\x04role\x18\x02 \x01(\x0e2'.go.escape.ship.proto.v1.ChatSenderRoleR\x04role\x12\x16\n" +
+	"\x06typing\x18\x03 \x01(\bR\x06typing\"\x87\x02\n" +
+	"\vChatRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12@\n" +
+	"\amessage\x18\x02 \x01(\v2$.go.escape.ship.proto.v1.ChatMessageH\x00R\amessage\x12D\n" +
+	"\bpresence\x18\x03 \x01(\v2&.go.escape.ship.proto.v1.PresenceEventH\x00R\bpresence\x12>\n" +
+	"\x06typing\x18\x04 \x01(\v2$.go.escape.ship.proto.v1.TypingEventH\x00R\x06typingB\a\n" +
+	"\x05event\"\xdf\x01\n" +
+	"\fChatResponse\x12@\n" +
+	"\amessage\x18\x01 \x01(\v2$.go.escape.ship.proto.v1.ChatMessageH\x00R\amessage\x12D\n" +
+	"\bpresence\x18\x02 \x01(\v2&.go.escape.ship.proto.v1.PresenceEventH\x00R\bpresence\x12>\n" +
+	"\x06typing\x18\x03 \x01(\v2$.go.escape.ship.proto.v1.TypingEventH\x00R\x06typingB\a\n" +
+	"\x05event*\x8a\x01\n" +
+	"\x0eChatSenderRole\x12 \n" +
+	"\x1cCHAT_SENDER_ROLE_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19CHAT_SENDER_ROLE_CUSTOMER\x10\x01\x12\x1a\n" +
+	"\x16CHAT_SENDER_ROLE_AGENT\x10\x02\x12\x1b\n" +
+	"\x17CHAT_SENDER_ROLE_SYSTEM\x10\x03*\x84\x01\n" +
+	"\x0ePresenceStatus\x12\x1f\n" +
+	"\x1bPRESENCE_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16PRESENCE_STATUS_ONLINE\x10\x01\x12\x18\n" +
+	"\x14PRESENCE_STATUS_AWAY\x10\x02\x12\x1b\n" +
+	"\x17PRESENCE_STATUS_OFFLINE\x10\x032\xb8\x03\n" +
+	"\vChatService\x12\x9a\x01\n" +
+	"\x10OpenConversation\x120.go.escape.ship.proto.v1.OpenConversationRequest\x1a1.go.escape.ship.proto.v1.OpenConversationResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/chat/conversations\x12\xb2\x01\n" +
+	"\x10ListChatMessages\x120.go.escape.ship.proto.v1.ListChatMessagesRequest\x1a1.go.escape.ship.proto.v1.ListChatMessagesResponse\"9\x82\xd3\xe4\x93\x023\x121/v1/chat/conversations/{conversation_id}/messages\x12W\n" +
+	"\x04Chat\x12$.go.escape.ship.proto.v1.ChatRequest\x1a%.go.escape.ship.proto.v1.ChatResponse(\x010\x01B#Z!github.com/escape-ship/protos/genb\x06proto3"
+
+var (
+	file_chat_proto_rawDescOnce sync.Once
+	file_chat_proto_rawDescData []byte
+)
+
+func file_chat_proto_rawDescGZIP() []byte {
+	file_chat_proto_rawDescOnce.Do(func() {
+		file_chat_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_chat_proto_rawDesc), len(file_chat_proto_rawDesc)))
+	})
+	return file_chat_proto_rawDescData
+}
+
+var file_chat_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_chat_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_chat_proto_goTypes = []any{
+	(ChatSenderRole)(0),              // 0: go.escape.ship.proto.v1.ChatSenderRole
+	(PresenceStatus)(0),              // 1: go.escape.ship.proto.v1.PresenceStatus
+	(*Conversation)(nil),             // 2: go.escape.ship.proto.v1.Conversation
+	(*ChatMessage)(nil),              // 3: go.escape.ship.proto.v1.ChatMessage
+	(*OpenConversationRequest)(nil),  // 4: go.escape.ship.proto.v1.OpenConversationRequest
+	(*OpenConversationResponse)(nil), // 5: go.escape.ship.proto.v1.OpenConversationResponse
+	(*ListChatMessagesRequest)(nil),  // 6: go.escape.ship.proto.v1.ListChatMessagesRequest
+	(*ListChatMessagesResponse)(nil), // 7: go.escape.ship.proto.v1.ListChatMessagesResponse
+	(*PresenceEvent)(nil),            // 8: go.escape.ship.proto.v1.PresenceEvent
+	(*TypingEvent)(nil),              // 9: go.escape.ship.proto.v1.TypingEvent
+	(*ChatRequest)(nil),              // 10: go.escape.ship.proto.v1.ChatRequest
+	(*ChatResponse)(nil),             // 11: go.escape.ship.proto.v1.ChatResponse
+}
+var file_chat_proto_depIdxs = []int32{
+	0,  // 0: go.escape.ship.proto.v1.ChatMessage.sender_role:type_name -> go.escape.ship.proto.v1.ChatSenderRole
+	2,  // 1: go.escape.ship.proto.v1.OpenConversationResponse.conversation:type_name -> go.escape.ship.proto.v1.Conversation
+	3,  // 2: go.escape.ship.proto.v1.ListChatMessagesResponse.messages:type_name -> go.escape.ship.proto.v1.ChatMessage
+	0,  // 3: go.escape.ship.proto.v1.PresenceEvent.role:type_name -> go.escape.ship.proto.v1.ChatSenderRole
+	1,  // 4: go.escape.ship.proto.v1.PresenceEvent.status:type_name -> go.escape.ship.proto.v1.PresenceStatus
+	0,  // 5: go.escape.ship.proto.v1.TypingEvent.role:type_name -> go.escape.ship.proto.v1.ChatSenderRole
+	3,  // 6: go.escape.ship.proto.v1.ChatRequest.message:type_name -> go.escape.ship.proto.v1.ChatMessage
+	8,  // 7: go.escape.ship.proto.v1.ChatRequest.presence:type_name -> go.escape.ship.proto.v1.PresenceEvent
+	9,  // 8: go.escape.ship.proto.v1.ChatRequest.typing:type_name -> go.escape.ship.proto.v1.TypingEvent
+	3,  // 9: go.escape.ship.proto.v1.ChatResponse.message:type_name -> go.escape.ship.proto.v1.ChatMessage
+	8,  // 10: go.escape.ship.proto.v1.ChatResponse.presence:type_name -> go.escape.ship.proto.v1.PresenceEvent
+	9,  // 11: go.escape.ship.proto.v1.ChatResponse.typing:type_name -> go.escape.ship.proto.v1.TypingEvent
+	4,  // 12: go.escape.ship.proto.v1.ChatService.OpenConversation:input_type -> go.escape.ship.proto.v1.OpenConversationRequest
+	6,  // 13: go.escape.ship.proto.v1.ChatService.ListChatMessages:input_type -> go.escape.ship.proto.v1.ListChatMessagesRequest
+	10, // 14: go.escape.ship.proto.v1.ChatService.Chat:input_type -> go.escape.ship.proto.v1.ChatRequest
+	5,  // 15: go.escape.ship.proto.v1.ChatService.OpenConversation:output_type -> go.escape.ship.proto.v1.OpenConversationResponse
+	7,  // 16: go.escape.ship.proto.v1.ChatService.ListChatMessages:output_type -> go.escape.ship.proto.v1.ListChatMessagesResponse
+	11, // 17: go.escape.ship.proto.v1.ChatService.Chat:output_type -> go.escape.ship.proto.v1.ChatResponse
+	15, // [15:18] is the sub-list for method output_type
+	12, // [12:15] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_chat_proto_init() }
+func file_chat_proto_init() {
+	if File_chat_proto != nil {
+		return
+	}
+	file_chat_proto_msgTypes[2].OneofWrappers = []any{
+		(*OpenConversationRequest_OrderId)(nil),
+		(*OpenConversationRequest_TicketId)(nil),
+	}
+	file_chat_proto_msgTypes[8].OneofWrappers = []any{
+		(*ChatRequest_Message)(nil),
+		(*ChatRequest_Presence)(nil),
+		(*ChatRequest_Typing)(nil),
+	}
+	file_chat_proto_msgTypes[9].OneofWrappers = []any{
+		(*ChatResponse_Message)(nil),
+		(*ChatResponse_Presence)(nil),
+		(*ChatResponse_Typing)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_chat_proto_rawDesc), len(file_chat_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_chat_proto_goTypes,
+		DependencyIndexes: file_chat_proto_depIdxs,
+		EnumInfos:         file_chat_proto_enumTypes,
+		MessageInfos:      file_chat_proto_msgTypes,
+	}.Build()
+	File_chat_proto = out.File
+	file_chat_proto_goTypes = nil
+	file_chat_proto_depIdxs = nil
+}
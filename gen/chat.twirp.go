@@ -0,0 +1,1143 @@
+// Code generated by protoc-gen-twirp v8.1.3, DO NOT EDIT.
+// source: chat.proto
+
+package gen
+
+import context "context"
+import fmt "fmt"
+import http "net/http"
+import io "io"
+import json "encoding/json"
+import strconv "strconv"
+import strings "strings"
+
+import protojson "google.golang.org/protobuf/encoding/protojson"
+import proto "google.golang.org/protobuf/proto"
+import twirp "github.com/twitchtv/twirp"
+import ctxsetters "github.com/twitchtv/twirp/ctxsetters"
+
+// Version compatibility assertion.
+// If the constant is not defined in the package, that likely means
+// the package needs to be updated to work with this generated code.
+// See https://twitchtv.github.io/twirp/docs/version_matrix.html
+const _ = twirp.TwirpPackageMinVersion_8_1_0
+
+// =====================
+// ChatService Interface
+// =====================
+
+// 고객 ↔ 상담원 채팅 (주문 또는 문의 티켓 단위)
+type ChatService interface {
+	// 주문/티켓에 대한 대화방 생성 또는 기존 대화방 반환
+	OpenConversation(context.Context, *OpenConversationRequest) (*OpenConversationResponse, error)
+
+	// 저장된 메시지 조회 (최신순, 페이지네이션)
+	ListChatMessages(context.Context, *ListChatMessagesRequest) (*ListChatMessagesResponse, error)
+
+	// 실시간 양방향 채팅 스트림 (gRPC 전용, HTTP 매핑 없음)
+	// 첫 요청에 conversation_id를 포함해야 하며 서버는 수신한 메시지를 저장 후 브로드캐스트
+	Chat(context.Context, *ChatRequest) (*ChatResponse, error)
+}
+
+// ===========================
+// ChatService Protobuf Client
+// ===========================
+
+type chatServiceProtobufClient struct {
+	client      HTTPClient
+	urls        [3]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewChatServiceProtobufClient creates a Protobuf client that implements the ChatService interface.
+// It communicates using Protobuf and can be configured with a custom HTTPClient.
+func NewChatServiceProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) ChatService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "go.escape.ship.proto.v1", "ChatService")
+	urls := [3]string{
+		serviceURL + "OpenConversation",
+		serviceURL + "ListChatMessages",
+		serviceURL + "Chat",
+	}
+
+	return &chatServiceProtobufClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *chatServiceProtobufClient) OpenConversation(ctx context.Context, in *OpenConversationRequest) (*OpenConversationResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "ChatService")
+	ctx = ctxsetters.WithMethodName(ctx, "OpenConversation")
+	caller := c.callOpenConversation
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *OpenConversationRequest) (*OpenConversationResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*OpenConversationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*OpenConversationRequest) when calling interceptor")
+					}
+					return c.callOpenConversation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*OpenConversationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*OpenConversationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *chatServiceProtobufClient) callOpenConversation(ctx context.Context, in *OpenConversationRequest) (*OpenConversationResponse, error) {
+	out := new(OpenConversationResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *chatServiceProtobufClient) ListChatMessages(ctx context.Context, in *ListChatMessagesRequest) (*ListChatMessagesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "ChatService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListChatMessages")
+	caller := c.callListChatMessages
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListChatMessagesRequest) (*ListChatMessagesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListChatMessagesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListChatMessagesRequest) when calling interceptor")
+					}
+					return c.callListChatMessages(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListChatMessagesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListChatMessagesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *chatServiceProtobufClient) callListChatMessages(ctx context.Context, in *ListChatMessagesRequest) (*ListChatMessagesResponse, error) {
+	out := new(ListChatMessagesResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *chatServiceProtobufClient) Chat(ctx context.Context, in *ChatRequest) (*ChatResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "ChatService")
+	ctx = ctxsetters.WithMethodName(ctx, "Chat")
+	caller := c.callChat
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ChatRequest) (*ChatResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ChatRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ChatRequest) when calling interceptor")
+					}
+					return c.callChat(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ChatResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ChatResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *chatServiceProtobufClient) callChat(ctx context.Context, in *ChatRequest) (*ChatResponse, error) {
+	out := new(ChatResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// =======================
+// ChatService JSON Client
+// =======================
+
+type chatServiceJSONClient struct {
+	client      HTTPClient
+	urls        [3]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewChatServiceJSONClient creates a JSON client that implements the ChatService interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewChatServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) ChatService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "go.escape.ship.proto.v1", "ChatService")
+	urls := [3]string{
+		serviceURL + "OpenConversation",
+		serviceURL + "ListChatMessages",
+		serviceURL + "Chat",
+	}
+
+	return &chatServiceJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *chatServiceJSONClient) OpenConversation(ctx context.Context, in *OpenConversationRequest) (*OpenConversationResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "ChatService")
+	ctx = ctxsetters.WithMethodName(ctx, "OpenConversation")
+	caller := c.callOpenConversation
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *OpenConversationRequest) (*OpenConversationResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*OpenConversationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*OpenConversationRequest) when calling interceptor")
+					}
+					return c.callOpenConversation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*OpenConversationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*OpenConversationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *chatServiceJSONClient) callOpenConversation(ctx context.Context, in *OpenConversationRequest) (*OpenConversationResponse, error) {
+	out := new(OpenConversationResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *chatServiceJSONClient) ListChatMessages(ctx context.Context, in *ListChatMessagesRequest) (*ListChatMessagesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "ChatService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListChatMessages")
+	caller := c.callListChatMessages
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListChatMessagesRequest) (*ListChatMessagesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListChatMessagesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListChatMessagesRequest) when calling interceptor")
+					}
+					return c.callListChatMessages(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListChatMessagesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListChatMessagesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *chatServiceJSONClient) callListChatMessages(ctx context.Context, in *ListChatMessagesRequest) (*ListChatMessagesResponse, error) {
+	out := new(ListChatMessagesResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *chatServiceJSONClient) Chat(ctx context.Context, in *ChatRequest) (*ChatResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "ChatService")
+	ctx = ctxsetters.WithMethodName(ctx, "Chat")
+	caller := c.callChat
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ChatRequest) (*ChatResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ChatRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ChatRequest) when calling interceptor")
+					}
+					return c.callChat(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ChatResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ChatResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *chatServiceJSONClient) callChat(ctx context.Context, in *ChatRequest) (*ChatResponse, error) {
+	out := new(ChatResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ==========================
+// ChatService Server Handler
+// ==========================
+
+type chatServiceServer struct {
+	ChatService
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewChatServiceServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewChatServiceServer(svc ChatService, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	return &chatServiceServer{
+		ChatService:      svc,
+		hooks:            serverOpts.Hooks,
+		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:       pathPrefix,
+		jsonSkipDefaults: jsonSkipDefaults,
+		jsonCamelCase:    jsonCamelCase,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *chatServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *chatServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
+
+// ChatServicePathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const ChatServicePathPrefix = "/twirp/go.escape.ship.proto.v1.ChatService/"
+
+func (s *chatServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "ChatService")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "go.escape.ship.proto.v1.ChatService" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "OpenConversation":
+		s.serveOpenConversation(ctx, resp, req)
+		return
+	case "ListChatMessages":
+		s.serveListChatMessages(ctx, resp, req)
+		return
+	case "Chat":
+		s.serveChat(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *chatServiceServer) serveOpenConversation(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveOpenConversationJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveOpenConversationProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *chatServiceServer) serveOpenConversationJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "OpenConversation")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(OpenConversationRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ChatService.OpenConversation
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *OpenConversationRequest) (*OpenConversationResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*OpenConversationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*OpenConversationRequest) when calling interceptor")
+					}
+					return s.ChatService.OpenConversation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*OpenConversationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*OpenConversationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *OpenConversationResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *OpenConversationResponse and nil error while calling OpenConversation. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *chatServiceServer) serveOpenConversationProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "OpenConversation")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(OpenConversationRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ChatService.OpenConversation
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *OpenConversationRequest) (*OpenConversationResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*OpenConversationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*OpenConversationRequest) when calling interceptor")
+					}
+					return s.ChatService.OpenConversation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*OpenConversationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*OpenConversationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *OpenConversationResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *OpenConversationResponse and nil error while calling OpenConversation. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *chatServiceServer) serveListChatMessages(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListChatMessagesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListChatMessagesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *chatServiceServer) serveListChatMessagesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListChatMessages")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ListChatMessagesRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ChatService.ListChatMessages
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListChatMessagesRequest) (*ListChatMessagesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListChatMessagesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListChatMessagesRequest) when calling interceptor")
+					}
+					return s.ChatService.ListChatMessages(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListChatMessagesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListChatMessagesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListChatMessagesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListChatMessagesResponse and nil error while calling ListChatMessages. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *chatServiceServer) serveListChatMessagesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListChatMessages")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ListChatMessagesRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ChatService.ListChatMessages
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListChatMessagesRequest) (*ListChatMessagesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListChatMessagesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListChatMessagesRequest) when calling interceptor")
+					}
+					return s.ChatService.ListChatMessages(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListChatMessagesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListChatMessagesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListChatMessagesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListChatMessagesResponse and nil error while calling ListChatMessages. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *chatServiceServer) serveChat(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveChatJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveChatProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *chatServiceServer) serveChatJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "Chat")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ChatRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ChatService.Chat
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ChatRequest) (*ChatResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ChatRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ChatRequest) when calling interceptor")
+					}
+					return s.ChatService.Chat(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ChatResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ChatResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ChatResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ChatResponse and nil error while calling Chat. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *chatServiceServer) serveChatProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "Chat")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ChatRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ChatService.Chat
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ChatRequest) (*ChatResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ChatRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ChatRequest) when calling interceptor")
+					}
+					return s.ChatService.Chat(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ChatResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ChatResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ChatResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ChatResponse and nil error while calling Chat. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *chatServiceServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor3, 0
+}
+
+func (s *chatServiceServer) ProtocGenTwirpVersion() string {
+	return "v8.1.3"
+}
+
+// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
+// that is everything in a Twirp route except for the <Method>. This can be used for routing,
+// for example to identify the requests that are targeted to this service in a mux.
+func (s *chatServiceServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "go.escape.ship.proto.v1", "ChatService")
+}
+
+var twirpFileDescriptor3 = []byte{
+	// 943 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xac, 0x13, 0xdb, 0x79, 0x6e, 0x1d, 0x33, 0x42, 0xb1, 0xeb, 0x34, 0x6a, 0xba, 0xd0,
+	0x36, 0x8a, 0x84, 0xb7, 0x76, 0x4f, 0x80, 0x04, 0x75, 0x9d, 0x0d, 0x59, 0x29, 0x71, 0xa2, 0x5d,
+	0x47, 0x55, 0x39, 0x60, 0x6d, 0x77, 0x47, 0xce, 0xaa, 0xce, 0xce, 0xe2, 0x19, 0x5b, 0xa5, 0x15,
+	0x1c, 0x50, 0x05, 0x12, 0x57, 0x6e, 0x7c, 0x0c, 0x0e, 0x88, 0x4f, 0xc1, 0x85, 0x0f, 0xc0, 0x85,
+	0x0f, 0x82, 0xe6, 0x8f, 0x37, 0x1b, 0xbb, 0x4e, 0x88, 0x0a, 0xb7, 0x9d, 0xf7, 0xe6, 0x37, 0xf3,
+	0xfb, 0xfd, 0xde, 0x7b, 0x63, 0x03, 0x04, 0xa7, 0x3e, 0x6f, 0x24, 0x23, 0xca, 0x29, 0xae, 0x0e,
+	0x68, 0x83, 0xb0, 0xc0, 0x4f, 0x48, 0x83, 0x9d, 0x46, 0x89, 0x8a, 0x36, 0x26, 0xcd, 0xfa, 0xed,
+	0x01, 0xa5, 0x83, 0x21, 0xb1, 0xfc, 0x24, 0xb2, 0xfc, 0x38, 0xa6, 0xdc, 0xe7, 0x11, 0x8d, 0x99,
+	0xda, 0x60, 0xfe, 0x81, 0xe0, 0x46, 0x87, 0xc6, 0x13, 0x32, 0x62, 0x32, 0x8e, 0xcb, 0x60, 0x44,
+	0x61, 0x0d, 0x6d, 0xa1, 0xed, 0x55, 0xd7, 0x88, 0x42, 0x7c, 0x0b, 0x8a, 0x74, 0x14, 0x92, 0x51,
+	0x3f, 0x0a, 0x6b, 0x86, 0x8c, 0x16, 0xe4, 0xda, 0x09, 0xf1, 0x06, 0xac, 0xf2, 0x28, 0x78, 0x41,
+	0xb8, 0xc8, 0xe5, 0x64, 0xae, 0xa8, 0x02, 0x4e, 0x88, 0xef, 0x40, 0x29, 0x18, 0x33, 0x4e, 0xcf,
+	0x14, 0x74, 0x59, 0xa6, 0x61, 0x1a, 0x72, 0xe4, 0xc1, 0xfe, 0x80, 0xc4, 0x12, 0xbc, 0xa2, 0x0e,
+	0x96, 0x6b, 0x27, 0xc4, 0x9b, 0x00, 0xc1, 0x88, 0xf8, 0x9c, 0x84, 0x7d, 0x9f, 0xd7, 0xf2, 0x32,
+	0xb9, 0xaa, 0x23, 0x6d, 0x2e, 0xee, 0x0d, 0x86, 0x94, 0xa9, 0x6c, 0x41, 0xdd, 0xab, 0x02, 0x6d,
+	0x6e, 0xfe, 0x60, 0x40, 0xa9, 0x73, 0xea, 0xf3, 0x43, 0xc2, 0x98, 0x3f, 0x20, 0x73, 0x7a, 0x1e,
+	0xc0, 0x5a, 0x90, 0xd1, 0x7b, 0x2e, 0xab, 0x9c, 0x0d, 0x2b, 0x75, 0x8c, 0xc4, 0x5a, 0xb9, 0x56,
+	0xa7, 0x02, 0x4e, 0x88, 0xf7, 0xa1, 0xa4, 0x93, 0x23, 0x3a, 0x24, 0x52, 0x5d, 0xb9, 0xf5, 0xa0,
+	0xb1, 0xa0, 0x06, 0x0d, 0x41, 0xc8, 0x93, 0xfb, 0x5d, 0x3a, 0x24, 0x2e, 0xb0, 0xf4, 0x1b, 0x63,
+	0x58, 0xe6, 0xe4, 0x25, 0xd7, 0x16, 0xc8, 0x6f, 0xbc, 0x03, 0xef, 0x05, 0xc3, 0x48, 0x78, 0x73,
+	0xa6, 0x54, 0x08, 0x0a, 0xca, 0x86, 0x35, 0x95, 0xd0, 0xea, 0x9c, 0x10, 0x57, 0xa1, 0xc0, 0xc4,
+	0xce, 0xd4, 0x8a, 0xbc, 0x58, 0xb6, 0xb9, 0xf9, 0x15, 0x54, 0x8f, 0x12, 0x12, 0x67, 0x8b, 0xeb,
+	0x92, 0xaf, 0xc7, 0x84, 0x09, 0x03, 0xcf, 0x6b, 0x2a, 0x9d, 0xd9, 0x5f, 0x3a, 0xaf, 0xea, 0x66,
+	0xb6, 0xaa, 0x86, 0xce, 0xa6, 0x75, 0x7d, 0x52, 0x80, 0x15, 0x16, 0xd0, 0x84, 0x98, 0x04, 0x6a,
+	0xf3, 0xe7, 0xb3, 0x84, 0xc6, 0x8c, 0x60, 0x07, 0x6e, 0x64, 0xdd, 0x94, 0x97, 0x94, 0x5a, 0xf7,
+	0x16, 0xfb, 0x93, 0x3d, 0xe4, 0x02, 0xd4, 0xfc, 0x0e, 0xaa, 0x07, 0x11, 0xe3, 0x99, 0x92, 0xb2,
+	0xa9, 0x8c, 0xb7, 0x94, 0x12, 0x2d, 0x2a, 0x65, 0x22, 0x5c, 0x64, 0xd1, 0x2b, 0x22, 0x25, 0xad,
+	0xb8, 0x45, 0x11, 0xf0, 0xa2, 0x57, 0x44, 0x34, 0x9b, 0x4c, 0x72, 0xfa, 0x82, 0xc4, 0xba, 0xd0,
+	0x72, 0x7b, 0x4f, 0x04, 0xcc, 0x37, 0x08, 0x6a, 0xf3, 0x04, 0xb4, 0xce, 0xc7, 0x50, 0xd4, 0x15,
+	0x62, 0x35, 0xb4, 0x95, 0xdb, 0x2e, 0xb5, 0x3e, 0xbc, 0xb4, 0x07, 0xf4, 0x01, 0x6e, 0x8a, 0xc2,
+	0xf7, 0x61, 0x2d, 0x26, 0x2f, 0x79, 0x3f, 0x43, 0x41, 0xb5, 0xe3, 0x4d, 0x11, 0x3e, 0x4e, 0x69,
+	0xfc, 0x86, 0xe0, 0xe6, 0xf1, 0x88, 0x30, 0x12, 0x07, 0xc4, 0x9e, 0x90, 0x98, 0x8b, 0xc2, 0x8f,
+	0x59, 0xa6, 0x86, 0x6e, 0x5e, 0x2c, 0x9d, 0x10, 0x7f, 0x0a, 0xcb, 0xb2, 0x29, 0x8d, 0xeb, 0x35,
+	0xa5, 0x04, 0xe1, 0xcf, 0x21, 0xcf, 0xb8, 0xcf, 0xc7, 0xac, 0x96, 0xbb, 0x02, 0x3e, 0x65, 0xe3,
+	0xc9, 0xed, 0xae, 0x86, 0x89, 0x79, 0xf3, 0xb9, 0x1e, 0x77, 0xc3, 0xe7, 0xe6, 0x6b, 0x28, 0xf5,
+	0xbe, 0x49, 0xa2, 0x78, 0xf0, 0x7f, 0xb2, 0x5e, 0x87, 0x3c, 0x97, 0x97, 0x48, 0xd6, 0x45, 0x57,
+	0xaf, 0xcc, 0x1f, 0xf5, 0x63, 0x70, 0xed, 0x8e, 0x79, 0x0c, 0x05, 0x5d, 0x22, 0x49, 0xe8, 0x5f,
+	0xd6, 0x55, 0x8c, 0x91, 0x86, 0xe1, 0x5d, 0x28, 0x26, 0xda, 0x21, 0x49, 0xaa, 0xd4, 0xba, 0x7f,
+	0xa5, 0x95, 0xd2, 0x22, 0x31, 0x6d, 0x53, 0x24, 0xfe, 0x2c, 0x15, 0xb6, 0x7c, 0x05, 0x8d, 0x8c,
+	0xc9, 0xfb, 0x4b, 0x53, 0x03, 0xc4, 0xb4, 0x12, 0x11, 0x32, 0xff, 0x12, 0xef, 0xbc, 0x74, 0x22,
+	0x6d, 0xdd, 0x54, 0x21, 0x7a, 0x77, 0x85, 0xc6, 0x7f, 0xa0, 0x30, 0xf7, 0x4e, 0x0a, 0x77, 0x7e,
+	0x42, 0x50, 0xbe, 0xd8, 0x1c, 0x78, 0x0b, 0x6e, 0x77, 0xf6, 0xdb, 0xbd, 0xbe, 0x67, 0x77, 0x77,
+	0x6d, 0xb7, 0xef, 0x1e, 0x1d, 0xd8, 0xfd, 0x93, 0xae, 0x77, 0x6c, 0x77, 0x9c, 0x3d, 0xc7, 0xde,
+	0xad, 0x2c, 0xe1, 0x4d, 0xb8, 0x35, 0xb7, 0xa3, 0x73, 0xe2, 0xf5, 0x8e, 0x0e, 0x6d, 0xb7, 0x82,
+	0x70, 0x1d, 0xd6, 0xe7, 0xd2, 0xed, 0x2f, 0xec, 0x6e, 0xaf, 0x62, 0xe0, 0x0d, 0xa8, 0xce, 0xe5,
+	0xbc, 0x67, 0x5e, 0xcf, 0x3e, 0xac, 0xe4, 0x76, 0xde, 0x20, 0x28, 0x5f, 0x1c, 0x10, 0x7c, 0x07,
+	0x36, 0x8e, 0x5d, 0xdb, 0xb3, 0xbb, 0x1d, 0xbb, 0xef, 0xf5, 0xda, 0xbd, 0x13, 0x6f, 0x86, 0x4b,
+	0x1d, 0xd6, 0x67, 0x37, 0x1c, 0x75, 0x0f, 0x9c, 0xae, 0x5d, 0x41, 0xb8, 0x06, 0xef, 0xcf, 0xe6,
+	0xda, 0x4f, 0xdb, 0xcf, 0x14, 0x8d, 0x39, 0xd4, 0xde, 0x9e, 0x84, 0xe5, 0x5a, 0xbf, 0xe7, 0x54,
+	0xff, 0x7b, 0x64, 0x34, 0x89, 0x02, 0x82, 0x7f, 0x41, 0x50, 0x99, 0x7d, 0xb4, 0xf1, 0xc3, 0x85,
+	0x8e, 0x2f, 0xf8, 0xfd, 0xa8, 0x37, 0xaf, 0x81, 0x50, 0xed, 0x66, 0xde, 0xfd, 0xfe, 0xcf, 0xbf,
+	0x7f, 0x36, 0x36, 0x3e, 0x41, 0x3b, 0xe6, 0xba, 0x35, 0x69, 0x5a, 0xe2, 0x9f, 0x8b, 0x95, 0x1d,
+	0x3a, 0x86, 0x7f, 0x45, 0x50, 0x99, 0x7d, 0x69, 0x2f, 0x21, 0xb7, 0xe0, 0x57, 0xa1, 0xde, 0xbc,
+	0x06, 0x42, 0x93, 0xfb, 0x58, 0x92, 0x7b, 0x84, 0x9b, 0x6f, 0x67, 0x66, 0xbd, 0x9e, 0x79, 0x34,
+	0xbe, 0xb5, 0xd2, 0xf7, 0xfb, 0x29, 0x2c, 0x8b, 0x23, 0xf1, 0xe5, 0xd3, 0x33, 0xe5, 0x76, 0xef,
+	0x8a, 0x5d, 0x8a, 0xcf, 0x36, 0x7a, 0x88, 0x9e, 0x7c, 0xf0, 0xe5, 0xdd, 0x41, 0xc4, 0x4f, 0xc7,
+	0xcf, 0x1b, 0x01, 0x3d, 0xb3, 0x14, 0xe6, 0x23, 0x81, 0xb1, 0x24, 0x86, 0x59, 0x03, 0x12, 0x3f,
+	0xcf, 0xcb, 0xef, 0x47, 0xff, 0x0c, 0x00, 0x1f, 0x7d, 0x11, 0x82, 0x09, 0x0a, 0x00, 0x00,
+}
@@ -0,0 +1,69 @@
+// Code generated by protoc-gen-go-shim. DO NOT EDIT.
+// source: chat.proto
+
+package gen
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	iter "iter"
+)
+
+// ChatServiceAPI is ChatServiceClient without per-call options, so it can be
+// mocked with plain method signatures. Streams are exposed as iterators.
+type ChatServiceAPI interface {
+	// 주문/티켓에 대한 대화방 생성 또는 기존 대화방 반환
+	OpenConversation(ctx context.Context, in *OpenConversationRequest) (*OpenConversationResponse, error)
+	// 저장된 메시지 조회 (최신순, 페이지네이션)
+	ListChatMessages(ctx context.Context, in *ListChatMessagesRequest) (*ListChatMessagesResponse, error)
+	// 실시간 양방향 채팅 스트림 (gRPC 전용, HTTP 매핑 없음)
+	// 첫 요청에 conversation_id를 포함해야 하며 서버는 수신한 메시지를 저장 후 브로드캐스트
+	Chat(ctx context.Context, in iter.Seq[*ChatRequest]) iter.Seq2[*ChatResponse, error]
+}
+
+// NewChatServiceAPI adapts c to ChatServiceAPI, passing opts to every call.
+func NewChatServiceAPI(c ChatServiceClient, opts ...grpc.CallOption) ChatServiceAPI {
+	return &chatServiceAPI{c: c, opts: opts}
+}
+
+type chatServiceAPI struct {
+	c    ChatServiceClient
+	opts []grpc.CallOption
+}
+
+func (a *chatServiceAPI) OpenConversation(ctx context.Context, in *OpenConversationRequest) (*OpenConversationResponse, error) {
+	return a.c.OpenConversation(ctx, in, a.opts...)
+}
+
+func (a *chatServiceAPI) ListChatMessages(ctx context.Context, in *ListChatMessagesRequest) (*ListChatMessagesResponse, error) {
+	return a.c.ListChatMessages(ctx, in, a.opts...)
+}
+
+func (a *chatServiceAPI) Chat(ctx context.Context, in iter.Seq[*ChatRequest]) iter.Seq2[*ChatResponse, error] {
+	return bidiStreamSeq(ctx, func(ctx context.Context) (grpc.BidiStreamingClient[ChatRequest, ChatResponse], error) {
+		return a.c.Chat(ctx, a.opts...)
+	}, in)
+}
+
+// ChatServiceClientFromAPI adapts a to ChatServiceClient, e.g. to hand a
+// mock ChatServiceAPI to code that takes the generated client. Call options
+// are ignored, and streams report empty headers and trailers.
+func ChatServiceClientFromAPI(a ChatServiceAPI) ChatServiceClient {
+	return chatServiceAPIClient{api: a}
+}
+
+type chatServiceAPIClient struct {
+	api ChatServiceAPI
+}
+
+func (c chatServiceAPIClient) OpenConversation(ctx context.Context, in *OpenConversationRequest, _ ...grpc.CallOption) (*OpenConversationResponse, error) {
+	return c.api.OpenConversation(ctx, in)
+}
+
+func (c chatServiceAPIClient) ListChatMessages(ctx context.Context, in *ListChatMessagesRequest, _ ...grpc.CallOption) (*ListChatMessagesResponse, error) {
+	return c.api.ListChatMessages(ctx, in)
+}
+
+func (c chatServiceAPIClient) Chat(ctx context.Context, _ ...grpc.CallOption) (grpc.BidiStreamingClient[ChatRequest, ChatResponse], error) {
+	return newSeqBidiStream(ctx, c.api.Chat), nil
+}
@@ -0,0 +1,210 @@
+package gen
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultReadyTimeout bounds the startup probe when ClientConfig.ReadyTimeout
+// is zero.
+const DefaultReadyTimeout = 10 * time.Second
+
+// ClientConfig describes how to reach an Escape Ship service.
+type ClientConfig struct {
+	// Address is the gRPC target, e.g. "dns:///ordersrv:50051".
+	Address string
+	// TLS enables transport security. Nil dials in plaintext, which is the
+	// norm inside the cluster.
+	TLS *tls.Config
+
+	// WaitForReady makes NewConnection block until the connection is READY
+	// and every service in ExpectServices reports SERVING through the
+	// standard gRPC health API (grpc.health.v1.Health), so a mis-wired
+	// address fails at startup rather than on the first request.
+	WaitForReady bool
+	// ExpectServices lists fully-qualified service names the target must
+	// serve, e.g. OrderService_ServiceDesc.ServiceName. Only checked when
+	// WaitForReady is set.
+	ExpectServices []string
+	// ReadyTimeout bounds the WaitForReady probe; zero means
+	// DefaultReadyTimeout.
+	ReadyTimeout time.Duration
+
+	// UnaryInterceptors and StreamInterceptors are chained in order on every
+	// call, e.g. UnaryTimeoutInterceptor, UnaryRetryInterceptor and
+	// UnaryAuthTokenInterceptor. The first interceptor is the outermost, so
+	// put the timeout before retry to bound all attempts together.
+	UnaryInterceptors  []grpc.UnaryClientInterceptor
+	StreamInterceptors []grpc.StreamClientInterceptor
+}
+
+// NewConnection returns a client connection for cfg. Like grpc.NewClient it
+// does not block unless cfg.WaitForReady is set; otherwise the connection is
+// established on first use.
+func NewConnection(cfg ClientConfig) (*grpc.ClientConn, error) {
+	if cfg.Address == "" {
+		return nil, errors.New("client config: address is required")
+	}
+	creds := insecure.NewCredentials()
+	if cfg.TLS != nil {
+		creds = credentials.NewTLS(cfg.TLS)
+	}
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(creds)}
+	if len(cfg.UnaryInterceptors) > 0 {
+		opts = append(opts, grpc.WithChainUnaryInterceptor(cfg.UnaryInterceptors...))
+	}
+	if len(cfg.StreamInterceptors) > 0 {
+		opts = append(opts, grpc.WithChainStreamInterceptor(cfg.StreamInterceptors...))
+	}
+	conn, err := grpc.NewClient(cfg.Address, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.WaitForReady {
+		if err := probe(conn, cfg); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+	return conn, nil
+}
+
+// probe waits for conn to become READY and checks the health of each expected
+// service.
+func probe(conn *grpc.ClientConn, cfg ClientConfig) error {
+	timeout := cfg.ReadyTimeout
+	if timeout == 0 {
+		timeout = DefaultReadyTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	conn.Connect()
+	for state := conn.GetState(); state != connectivity.Ready; state = conn.GetState() {
+		if !conn.WaitForStateChange(ctx, state) {
+			return fmt.Errorf("%s: not ready after %v (last state %v)", cfg.Address, timeout, state)
+		}
+	}
+
+	health := healthpb.NewHealthClient(conn)
+	for _, svc := range cfg.ExpectServices {
+		resp, err := health.Check(ctx, &healthpb.HealthCheckRequest{Service: svc})
+		switch status.Code(err) {
+		case codes.OK:
+		case codes.Unimplemented:
+			return fmt.Errorf("%s: target does not implement the gRPC health API", cfg.Address)
+		case codes.NotFound:
+			return fmt.Errorf("%s: target does not serve %s", cfg.Address, svc)
+		default:
+			return fmt.Errorf("%s: health check for %s: %w", cfg.Address, svc, err)
+		}
+		if resp.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+			return fmt.Errorf("%s: %s is %v", cfg.Address, svc, resp.GetStatus())
+		}
+	}
+	return nil
+}
+
+// ClientSet bundles the clients of the core services sharing one connection,
+// typically to the gateway or a service mesh sidecar.
+type ClientSet struct {
+	Account AccountServiceClient
+	Order   OrderServiceClient
+	Payment PaymentServiceClient
+	Product ProductServiceClient
+
+	conn *grpc.ClientConn
+}
+
+// NewClientSet connects to cfg.Address and returns clients for the core
+// services. Call Close when done.
+func NewClientSet(cfg ClientConfig) (*ClientSet, error) {
+	conn, err := NewConnection(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return NewClientSetFromConn(conn), nil
+}
+
+// NewClientSetFromConn returns clients sharing an existing connection. Close
+// closes conn.
+func NewClientSetFromConn(conn *grpc.ClientConn) *ClientSet {
+	return &ClientSet{
+		Account: NewAccountServiceClient(conn),
+		Order:   NewOrderServiceClient(conn),
+		Payment: NewPaymentServiceClient(conn),
+		Product: NewProductServiceClient(conn),
+		conn:    conn,
+	}
+}
+
+// Conn returns the underlying connection.
+func (c *ClientSet) Conn() *grpc.ClientConn { return c.conn }
+
+// Close closes the underlying connection and stops all state watchers. It is
+// a no-op for a ClientSet built without a connection, e.g. from mocks.
+func (c *ClientSet) Close() error {
+	if c.conn == nil {
+		return nil
+	}
+	return c.conn.Close()
+}
+
+// State returns the current connectivity state. A ClientSet built without a
+// connection, e.g. from mocks, is always READY.
+func (c *ClientSet) State() connectivity.State {
+	if c.conn == nil {
+		return connectivity.Ready
+	}
+	return c.conn.GetState()
+}
+
+// Ready reports whether the connection is READY, for use in readiness probes.
+func (c *ClientSet) Ready() bool { return c.State() == connectivity.Ready }
+
+// StateChangeFunc is called with the previous and new connectivity state.
+type StateChangeFunc func(from, to connectivity.State)
+
+// OnStateChange calls fn on every connectivity transition, e.g. to flip a
+// readiness probe on READY and raise an alert on TRANSIENT_FAILURE. It also
+// keeps the connection warm: whenever it goes IDLE the watcher reconnects, so
+// a degraded downstream is noticed without waiting for the next RPC.
+//
+// fn runs on a dedicated goroutine, one call at a time. Watching stops when
+// the returned function is called or the ClientSet is closed. A ClientSet
+// built without a connection never changes state, so fn is never called.
+func (c *ClientSet) OnStateChange(fn StateChangeFunc) (stop func()) {
+	if c.conn == nil {
+		return func() {}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	from := c.conn.GetState()
+	if from == connectivity.Idle {
+		c.conn.Connect()
+	}
+	go func() {
+		for c.conn.WaitForStateChange(ctx, from) {
+			to := c.conn.GetState()
+			fn(from, to)
+			switch to {
+			case connectivity.Idle:
+				c.conn.Connect()
+			case connectivity.Shutdown:
+				return
+			}
+			from = to
+		}
+	}()
+	return cancel
+}
@@ -0,0 +1,183 @@
+package gen
+
+import (
+	"context"
+	"math/rand/v2"
+	"slices"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// RetryConfig controls UnaryRetryInterceptor. Zero fields take the values of
+// DefaultRetryConfig.
+type RetryConfig struct {
+	// MaxAttempts is the total number of attempts including the first.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry. Each further retry
+	// waits Multiplier times longer, up to MaxBackoff, with ±20% jitter.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Multiplier     float64
+	// Codes are the status codes worth retrying. Only add codes other than
+	// Unavailable for idempotent methods, e.g. IdempotentMutations behind
+	// UnaryIdempotencyKeyInterceptor.
+	Codes []codes.Code
+	// Methods restricts retries to these full method names, e.g.
+	// ProductService_GetProducts_FullMethodName. Empty means all methods.
+	Methods []string
+}
+
+// DefaultRetryConfig retries Unavailable up to three attempts in total.
+// Unavailable means the request was not processed, so retrying is safe even
+// for mutations.
+var DefaultRetryConfig = RetryConfig{
+	MaxAttempts:    3,
+	InitialBackoff: 100 * time.Millisecond,
+	MaxBackoff:     2 * time.Second,
+	Multiplier:     2,
+	Codes:          []codes.Code{codes.Unavailable},
+}
+
+func (c RetryConfig) withDefaults() RetryConfig {
+	d := DefaultRetryConfig
+	if c.MaxAttempts == 0 {
+		c.MaxAttempts = d.MaxAttempts
+	}
+	if c.InitialBackoff == 0 {
+		c.InitialBackoff = d.InitialBackoff
+	}
+	if c.MaxBackoff == 0 {
+		c.MaxBackoff = d.MaxBackoff
+	}
+	if c.Multiplier == 0 {
+		c.Multiplier = d.Multiplier
+	}
+	if len(c.Codes) == 0 {
+		c.Codes = d.Codes
+	}
+	return c
+}
+
+// UnaryRetryInterceptor retries failed unary calls per cfg. A google.rpc.RetryInfo
+// detail on the error (as sent with AccountLockout) overrides the backoff; if
+// it asks for a longer wait than MaxBackoff, or the wait would pass the
+// context deadline, the error is returned instead.
+func UnaryRetryInterceptor(cfg RetryConfig) grpc.UnaryClientInterceptor {
+	cfg = cfg.withDefaults()
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if len(cfg.Methods) > 0 && !slices.Contains(cfg.Methods, method) {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		backoff := cfg.InitialBackoff
+		for attempt := 1; ; attempt++ {
+			err := invoker(ctx, method, req, reply, cc, opts...)
+			if err == nil || attempt >= cfg.MaxAttempts {
+				return err
+			}
+			st := status.Convert(err)
+			if !slices.Contains(cfg.Codes, st.Code()) {
+				return err
+			}
+			wait := jitter(backoff)
+			if d, ok := retryDelay(st); ok {
+				if d > cfg.MaxBackoff {
+					return err
+				}
+				wait = d
+			}
+			if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+				return err
+			}
+			t := time.NewTimer(wait)
+			select {
+			case <-ctx.Done():
+				t.Stop()
+				return err
+			case <-t.C:
+			}
+			backoff = min(time.Duration(float64(backoff)*cfg.Multiplier), cfg.MaxBackoff)
+		}
+	}
+}
+
+func jitter(d time.Duration) time.Duration {
+	return time.Duration(float64(d) * (0.8 + 0.4*rand.Float64()))
+}
+
+// retryDelay returns the delay of a RetryInfo detail on st.
+func retryDelay(st *status.Status) (time.Duration, bool) {
+	for _, d := range st.Details() {
+		if ri, ok := d.(*errdetails.RetryInfo); ok && ri.GetRetryDelay() != nil {
+			return ri.GetRetryDelay().AsDuration(), true
+		}
+	}
+	return 0, false
+}
+
+// UnaryTimeoutInterceptor gives calls without a deadline a timeout of d.
+// Calls that already have a deadline keep it. There is no streaming
+// counterpart: streams such as WatchOrder are meant to stay open.
+func UnaryTimeoutInterceptor(d time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if _, ok := ctx.Deadline(); !ok {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, d)
+			defer cancel()
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// AuthorizationHeader is the metadata key carrying end-user bearer tokens.
+const AuthorizationHeader = "authorization"
+
+// TokenSource returns the bearer token for an outgoing call, e.g. the access
+// token of the signed-in user. An empty token sends no header.
+type TokenSource func(ctx context.Context) (string, error)
+
+// StaticToken returns a TokenSource that always returns token.
+func StaticToken(token string) TokenSource {
+	return func(context.Context) (string, error) { return token, nil }
+}
+
+func attachToken(ctx context.Context, src TokenSource) (context.Context, error) {
+	token, err := src(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "auth token: %v", err)
+	}
+	if token == "" {
+		return ctx, nil
+	}
+	return metadata.AppendToOutgoingContext(ctx, AuthorizationHeader, "Bearer "+token), nil
+}
+
+// UnaryAuthTokenInterceptor attaches "authorization: Bearer <token>" from src
+// to every unary call. If src fails the call is not sent and Unauthenticated
+// is returned. Unlike grpc.WithPerRPCCredentials it works over plaintext
+// connections inside the cluster.
+func UnaryAuthTokenInterceptor(src TokenSource) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx, err := attachToken(ctx, src)
+		if err != nil {
+			return err
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// StreamAuthTokenInterceptor is the streaming counterpart of
+// UnaryAuthTokenInterceptor.
+func StreamAuthTokenInterceptor(src TokenSource) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		ctx, err := attachToken(ctx, src)
+		if err != nil {
+			return nil, err
+		}
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+}
@@ -0,0 +1,73 @@
+package gen
+
+import (
+	"context"
+	"slices"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Metadata keys identifying the calling client build.
+const (
+	APIVersionHeader    = "x-api-version"
+	ClientNameHeader    = "x-client-name"
+	ClientVersionHeader = "x-client-version"
+	ClientRegionHeader  = "x-client-region"
+)
+
+// StaticMetadata is metadata attached unchanged to outgoing calls so servers
+// can segment traffic by client build. Empty fields are not sent.
+type StaticMetadata struct {
+	APIVersion    string
+	ClientName    string
+	ClientVersion string
+	Region        string
+	// Extra holds additional key/value pairs; keys must be lowercase.
+	Extra map[string]string
+	// Methods restricts injection to these full method names, e.g.
+	// OrderService_InsertOrder_FullMethodName. Empty means all methods.
+	Methods []string
+}
+
+func (m StaticMetadata) pairs() []string {
+	var kv []string
+	add := func(k, v string) {
+		if v != "" {
+			kv = append(kv, k, v)
+		}
+	}
+	add(APIVersionHeader, m.APIVersion)
+	add(ClientNameHeader, m.ClientName)
+	add(ClientVersionHeader, m.ClientVersion)
+	add(ClientRegionHeader, m.Region)
+	for k, v := range m.Extra {
+		add(k, v)
+	}
+	return kv
+}
+
+func (m StaticMetadata) attach(ctx context.Context, method string, kv []string) context.Context {
+	if len(kv) == 0 || (len(m.Methods) > 0 && !slices.Contains(m.Methods, method)) {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, kv...)
+}
+
+// UnaryStaticMetadataInterceptor attaches md to every allowed outgoing unary
+// call. Install it with grpc.WithChainUnaryInterceptor.
+func UnaryStaticMetadataInterceptor(md StaticMetadata) grpc.UnaryClientInterceptor {
+	kv := md.pairs()
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(md.attach(ctx, method, kv), method, req, reply, cc, opts...)
+	}
+}
+
+// StreamStaticMetadataInterceptor is the streaming counterpart of
+// UnaryStaticMetadataInterceptor.
+func StreamStaticMetadataInterceptor(md StaticMetadata) grpc.StreamClientInterceptor {
+	kv := md.pairs()
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(md.attach(ctx, method, kv), desc, cc, method, opts...)
+	}
+}
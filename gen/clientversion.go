@@ -0,0 +1,143 @@
+package gen
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Version handshake: clients identify their build with the ClientNameHeader and
+// ClientVersionHeader metadata (see StaticMetadata). Servers enforce a minimum
+// with ClientVersionPolicy and reject older builds with FailedPrecondition and
+// an UpgradeRequired detail, which apps turn into an "update the app" screen
+// via UpgradeRequiredFromError.
+
+// ClientVersionFromContext returns the client name and version from incoming
+// call metadata, or empty strings when absent.
+func ClientVersionFromContext(ctx context.Context) (name, version string) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if v := md.Get(ClientNameHeader); len(v) > 0 {
+		name = v[0]
+	}
+	if v := md.Get(ClientVersionHeader); len(v) > 0 {
+		version = v[0]
+	}
+	return name, version
+}
+
+// CompareVersions compares dotted numeric versions such as "2.10.1" and
+// returns -1, 0, or +1. A leading "v" and any pre-release or build suffix
+// ("-beta", "+42") are ignored, and missing components count as zero.
+func CompareVersions(a, b string) int {
+	pa, pb := versionParts(a), versionParts(b)
+	for i := 0; i < max(len(pa), len(pb)); i++ {
+		var x, y int
+		if i < len(pa) {
+			x = pa[i]
+		}
+		if i < len(pb) {
+			y = pb[i]
+		}
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+	}
+	return 0
+}
+
+func versionParts(v string) []int {
+	v = strings.TrimPrefix(v, "v")
+	if i := strings.IndexAny(v, "-+"); i >= 0 {
+		v = v[:i]
+	}
+	var parts []int
+	for _, p := range strings.Split(v, ".") {
+		n, _ := strconv.Atoi(p)
+		parts = append(parts, n)
+	}
+	return parts
+}
+
+// UpgradeRequiredError returns the FailedPrecondition error rejecting an
+// outdated client.
+func UpgradeRequiredError(detail *UpgradeRequired) error {
+	return reasonError(codes.FailedPrecondition, ErrorReason_ERROR_REASON_UPGRADE_REQUIRED,
+		"client upgrade required: minimum version is "+detail.GetMinimumVersion(),
+		map[string]string{"minimum_version": detail.GetMinimumVersion()},
+		detail)
+}
+
+// UpgradeRequiredFromError extracts the UpgradeRequired detail from an error
+// returned by any RPC.
+func UpgradeRequiredFromError(err error) (*UpgradeRequired, bool) {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.FailedPrecondition {
+		return nil, false
+	}
+	for _, d := range st.Details() {
+		if u, ok := d.(*UpgradeRequired); ok {
+			return u, true
+		}
+	}
+	return nil, false
+}
+
+// ClientVersionPolicy sets the oldest client builds a server accepts.
+type ClientVersionPolicy struct {
+	// Minimum maps client name (the x-client-name value) to its minimum
+	// version. Clients not listed are accepted.
+	Minimum map[string]string
+	// UpgradeURL maps client name to where users can get a newer build.
+	UpgradeURL map[string]string
+	// RequireVersion rejects listed clients that send no version at all.
+	// Otherwise they are accepted, so older builds predating the handshake
+	// keep working until they are deprecated explicitly.
+	RequireVersion bool
+}
+
+// Check returns an UpgradeRequiredError if the caller in ctx is older than
+// the policy allows.
+func (p ClientVersionPolicy) Check(ctx context.Context) error {
+	name, version := ClientVersionFromContext(ctx)
+	minVersion, ok := p.Minimum[name]
+	if !ok || (version == "" && !p.RequireVersion) {
+		return nil
+	}
+	if version != "" && CompareVersions(version, minVersion) >= 0 {
+		return nil
+	}
+	return UpgradeRequiredError(&UpgradeRequired{
+		ClientName:     name,
+		ClientVersion:  version,
+		MinimumVersion: minVersion,
+		UpgradeUrl:     p.UpgradeURL[name],
+	})
+}
+
+// UnaryServerInterceptor rejects calls from clients below the minimum version.
+func (p ClientVersionPolicy) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := p.Check(ctx); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor is the streaming counterpart of UnaryServerInterceptor.
+func (p ClientVersionPolicy) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := p.Check(ss.Context()); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
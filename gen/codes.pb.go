@@ -0,0 +1,508 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: codes.proto
+
+package gen
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 카드사 (발급사 기준)
+// kakao_code: 카카오페이 승인 응답 card_info.kakaopay_issuer_corp_code
+// toss_code: 토스페이먼츠 card.issuerCode
+type CardCompany int32
+
+const (
+	CardCompany_CARD_COMPANY_UNSPECIFIED CardCompany = 0
+	CardCompany_CARD_COMPANY_BC          CardCompany = 1
+	CardCompany_CARD_COMPANY_KB          CardCompany = 2
+	CardCompany_CARD_COMPANY_SAMSUNG     CardCompany = 3
+	CardCompany_CARD_COMPANY_SHINHAN     CardCompany = 4
+	CardCompany_CARD_COMPANY_HYUNDAI     CardCompany = 5
+	CardCompany_CARD_COMPANY_LOTTE       CardCompany = 6
+	CardCompany_CARD_COMPANY_CITI        CardCompany = 7
+	CardCompany_CARD_COMPANY_NH          CardCompany = 8
+	CardCompany_CARD_COMPANY_SUHYUP      CardCompany = 9
+	CardCompany_CARD_COMPANY_SHINHYUP    CardCompany = 10
+	CardCompany_CARD_COMPANY_WOORI       CardCompany = 11
+	CardCompany_CARD_COMPANY_HANA        CardCompany = 12
+	CardCompany_CARD_COMPANY_KAKAOBANK   CardCompany = 13
+	CardCompany_CARD_COMPANY_KBANK       CardCompany = 14
+	CardCompany_CARD_COMPANY_TOSSBANK    CardCompany = 15
+)
+
+// Enum value maps for CardCompany.
+var (
+	CardCompany_name = map[int32]string{
+		0:  "CARD_COMPANY_UNSPECIFIED",
+		1:  "CARD_COMPANY_BC",
+		2:  "CARD_COMPANY_KB",
+		3:  "CARD_COMPANY_SAMSUNG",
+		4:  "CARD_COMPANY_SHINHAN",
+		5:  "CARD_COMPANY_HYUNDAI",
+		6:  "CARD_COMPANY_LOTTE",
+		7:  "CARD_COMPANY_CITI",
+		8:  "CARD_COMPANY_NH",
+		9:  "CARD_COMPANY_SUHYUP",
+		10: "CARD_COMPANY_SHINHYUP",
+		11: "CARD_COMPANY_WOORI",
+		12: "CARD_COMPANY_HANA",
+		13: "CARD_COMPANY_KAKAOBANK",
+		14: "CARD_COMPANY_KBANK",
+		15: "CARD_COMPANY_TOSSBANK",
+	}
+	CardCompany_value = map[string]int32{
+		"CARD_COMPANY_UNSPECIFIED": 0,
+		"CARD_COMPANY_BC":          1,
+		"CARD_COMPANY_KB":          2,
+		"CARD_COMPANY_SAMSUNG":     3,
+		"CARD_COMPANY_SHINHAN":     4,
+		"CARD_COMPANY_HYUNDAI":     5,
+		"CARD_COMPANY_LOTTE":       6,
+		"CARD_COMPANY_CITI":        7,
+		"CARD_COMPANY_NH":          8,
+		"CARD_COMPANY_SUHYUP":      9,
+		"CARD_COMPANY_SHINHYUP":    10,
+		"CARD_COMPANY_WOORI":       11,
+		"CARD_COMPANY_HANA":        12,
+		"CARD_COMPANY_KAKAOBANK":   13,
+		"CARD_COMPANY_KBANK":       14,
+		"CARD_COMPANY_TOSSBANK":    15,
+	}
+)
+
+func (x CardCompany) Enum() *CardCompany {
+	p := new(CardCompany)
+	*p = x
+	return p
+}
+
+func (x CardCompany) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CardCompany) Descriptor() protoreflect.EnumDescriptor {
+	return file_codes_proto_enumTypes[0].Descriptor()
+}
+
+func (CardCompany) Type() protoreflect.EnumType {
+	return &file_codes_proto_enumTypes[0]
+}
+
+func (x CardCompany) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CardCompany.Descriptor instead.
+func (CardCompany) EnumDescriptor() ([]byte, []int) {
+	return file_codes_proto_rawDescGZIP(), []int{0}
+}
+
+// 은행 (가상계좌/계좌이체/환불 계좌)
+// toss_code: 토스페이먼츠 bankCode (2자리)
+type Bank int32
+
+const (
+	Bank_BANK_UNSPECIFIED Bank = 0
+	Bank_BANK_KDB         Bank = 1
+	Bank_BANK_IBK         Bank = 2
+	Bank_BANK_KB          Bank = 3
+	Bank_BANK_SUHYUP      Bank = 4
+	Bank_BANK_NH          Bank = 5
+	Bank_BANK_WOORI       Bank = 6
+	Bank_BANK_SC          Bank = 7
+	Bank_BANK_CITI        Bank = 8
+	Bank_BANK_DAEGU       Bank = 9
+	Bank_BANK_BUSAN       Bank = 10
+	Bank_BANK_GWANGJU     Bank = 11
+	Bank_BANK_JEJU        Bank = 12
+	Bank_BANK_JEONBUK     Bank = 13
+	Bank_BANK_KYONGNAM    Bank = 14
+	Bank_BANK_SAEMAUL     Bank = 15
+	Bank_BANK_SHINHYUP    Bank = 16
+	Bank_BANK_POST        Bank = 17
+	Bank_BANK_HANA        Bank = 18
+	Bank_BANK_SHINHAN     Bank = 19
+	Bank_BANK_KBANK       Bank = 20
+	Bank_BANK_KAKAOBANK   Bank = 21
+	Bank_BANK_TOSSBANK    Bank = 22
+)
+
+// Enum value maps for Bank.
+var (
+	Bank_name = map[int32]string{
+		0:  "BANK_UNSPECIFIED",
+		1:  "BANK_KDB",
+		2:  "BANK_IBK",
+		3:  "BANK_KB",
+		4:  "BANK_SUHYUP",
+		5:  "BANK_NH",
+		6:  "BANK_WOORI",
+		7:  "BANK_SC",
+		8:  "BANK_CITI",
+		9:  "BANK_DAEGU",
+		10: "BANK_BUSAN",
+		11: "BANK_GWANGJU",
+		12: "BANK_JEJU",
+		13: "BANK_JEONBUK",
+		14: "BANK_KYONGNAM",
+		15: "BANK_SAEMAUL",
+		16: "BANK_SHINHYUP",
+		17: "BANK_POST",
+		18: "BANK_HANA",
+		19: "BANK_SHINHAN",
+		20: "BANK_KBANK",
+		21: "BANK_KAKAOBANK",
+		22: "BANK_TOSSBANK",
+	}
+	Bank_value = map[string]int32{
+		"BANK_UNSPECIFIED": 0,
+		"BANK_KDB":         1,
+		"BANK_IBK":         2,
+		"BANK_KB":          3,
+		"BANK_SUHYUP":      4,
+		"BANK_NH":          5,
+		"BANK_WOORI":       6,
+		"BANK_SC":          7,
+		"BANK_CITI":        8,
+		"BANK_DAEGU":       9,
+		"BANK_BUSAN":       10,
+		"BANK_GWANGJU":     11,
+		"BANK_JEJU":        12,
+		"BANK_JEONBUK":     13,
+		"BANK_KYONGNAM":    14,
+		"BANK_SAEMAUL":     15,
+		"BANK_SHINHYUP":    16,
+		"BANK_POST":        17,
+		"BANK_HANA":        18,
+		"BANK_SHINHAN":     19,
+		"BANK_KBANK":       20,
+		"BANK_KAKAOBANK":   21,
+		"BANK_TOSSBANK":    22,
+	}
+)
+
+func (x Bank) Enum() *Bank {
+	p := new(Bank)
+	*p = x
+	return p
+}
+
+func (x Bank) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Bank) Descriptor() protoreflect.EnumDescriptor {
+	return file_codes_proto_enumTypes[1].Descriptor()
+}
+
+func (Bank) Type() protoreflect.EnumType {
+	return &file_codes_proto_enumTypes[1]
+}
+
+func (x Bank) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Bank.Descriptor instead.
+func (Bank) EnumDescriptor() ([]byte, []int) {
+	return file_codes_proto_rawDescGZIP(), []int{1}
+}
+
+// 택배사
+// 배송 조회 링크는 UI마다 만들지 않고 tracking_url 옵션에서 생성 (Go: TrackingURL)
+type Carrier int32
+
+const (
+	Carrier_CARRIER_UNSPECIFIED  Carrier = 0
+	Carrier_CARRIER_EPOST        Carrier = 1
+	Carrier_CARRIER_CJ_LOGISTICS Carrier = 2
+	Carrier_CARRIER_HANJIN       Carrier = 3
+	Carrier_CARRIER_LOGEN        Carrier = 4
+	Carrier_CARRIER_LOTTE        Carrier = 5
+	Carrier_CARRIER_DAESIN       Carrier = 6
+	Carrier_CARRIER_KDEXP        Carrier = 7
+	Carrier_CARRIER_CU_POST      Carrier = 8
+)
+
+// Enum value maps for Carrier.
+var (
+	Carrier_name = map[int32]string{
+		0: "CARRIER_UNSPECIFIED",
+		1: "CARRIER_EPOST",
+		2: "CARRIER_CJ_LOGISTICS",
+		3: "CARRIER_HANJIN",
+		4: "CARRIER_LOGEN",
+		5: "CARRIER_LOTTE",
+		6: "CARRIER_DAESIN",
+		7: "CARRIER_KDEXP",
+		8: "CARRIER_CU_POST",
+	}
+	Carrier_value = map[string]int32{
+		"CARRIER_UNSPECIFIED":  0,
+		"CARRIER_EPOST":        1,
+		"CARRIER_CJ_LOGISTICS": 2,
+		"CARRIER_HANJIN":       3,
+		"CARRIER_LOGEN":        4,
+		"CARRIER_LOTTE":        5,
+		"CARRIER_DAESIN":       6,
+		"CARRIER_KDEXP":        7,
+		"CARRIER_CU_POST":      8,
+	}
+)
+
+func (x Carrier) Enum() *Carrier {
+	p := new(Carrier)
+	*p = x
+	return p
+}
+
+func (x Carrier) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Carrier) Descriptor() protoreflect.EnumDescriptor {
+	return file_codes_proto_enumTypes[2].Descriptor()
+}
+
+func (Carrier) Type() protoreflect.EnumType {
+	return &file_codes_proto_enumTypes[2]
+}
+
+func (x Carrier) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Carrier.Descriptor instead.
+func (Carrier) EnumDescriptor() ([]byte, []int) {
+	return file_codes_proto_rawDescGZIP(), []int{2}
+}
+
+var file_codes_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.EnumValueOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         50001,
+		Name:          "go.escape.ship.proto.v1.kakao_code",
+		Tag:           "bytes,50001,opt,name=kakao_code",
+		Filename:      "codes.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.EnumValueOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         50002,
+		Name:          "go.escape.ship.proto.v1.toss_code",
+		Tag:           "bytes,50002,opt,name=toss_code",
+		Filename:      "codes.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.EnumValueOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         50003,
+		Name:          "go.escape.ship.proto.v1.kftc_code",
+		Tag:           "bytes,50003,opt,name=kftc_code",
+		Filename:      "codes.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.EnumValueOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         50004,
+		Name:          "go.escape.ship.proto.v1.tracker_code",
+		Tag:           "bytes,50004,opt,name=tracker_code",
+		Filename:      "codes.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.EnumValueOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         50005,
+		Name:          "go.escape.ship.proto.v1.display_name_ko",
+		Tag:           "bytes,50005,opt,name=display_name_ko",
+		Filename:      "codes.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.EnumValueOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         50006,
+		Name:          "go.escape.ship.proto.v1.display_name_en",
+		Tag:           "bytes,50006,opt,name=display_name_en",
+		Filename:      "codes.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.EnumValueOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         50007,
+		Name:          "go.escape.ship.proto.v1.tracking_url",
+		Tag:           "bytes,50007,opt,name=tracking_url",
+		Filename:      "codes.proto",
+	},
+}
+
+// Extension fields to descriptorpb.EnumValueOptions.
+var (
+	// optional string kakao_code = 50001;
+	E_KakaoCode = &file_codes_proto_extTypes[0] // 카카오페이 코드
+	// optional string toss_code = 50002;
+	E_TossCode = &file_codes_proto_extTypes[1] // 토스페이먼츠 코드
+	// optional string kftc_code = 50003;
+	E_KftcCode = &file_codes_proto_extTypes[2] // 금융결제원 표준 은행 코드 (3자리)
+	// optional string tracker_code = 50004;
+	E_TrackerCode = &file_codes_proto_extTypes[3] // 스마트택배(스윗트래커) 택배사 코드
+	// optional string display_name_ko = 50005;
+	E_DisplayNameKo = &file_codes_proto_extTypes[4] // 표시 이름 (한국어)
+	// optional string display_name_en = 50006;
+	E_DisplayNameEn = &file_codes_proto_extTypes[5] // 표시 이름 (영어)
+	// optional string tracking_url = 50007;
+	E_TrackingUrl = &file_codes_proto_extTypes[6] // 배송 조회 URL 템플릿 ({number}: 송장 번호)
+)
+
+var File_codes_proto protoreflect.FileDescriptor
+
+const file_codes_proto_rawDesc = "" +
+	"\n" +
+	"\vcodes.proto\x12\x17go.escape.ship.proto.v1\x1a google/protobuf/descriptor.proto*\xae\b\n" +
+	"\vCardCompany\x12\x1c\n" +
+	"\x18CARD_COMPANY_UNSPECIFIED\x10\x00\x128\n" +
+	"\x0fCARD_COMPANY_BC\x10\x01\x1a#\x8a\xb5\x18\x0201\x92\xb5\x18\x0231\xaa\xb5\x18\bBC카드\xb2\xb5\x18\aBC Card\x12F\n" +
+	"\x0fCARD_COMPANY_KB\x10\x02\x1a1\x8a\xb5\x18\x0202\x92\xb5\x18\x0211\xaa\xb5\x18\x0eKB국민카드\xb2\xb5\x18\x0fKB Kookmin Card\x12F\n" +
+	"\x14CARD_COMPANY_SAMSUNG\x10\x03\x1a,\x8a\xb5\x18\x0203\x92\xb5\x18\x0251\xaa\xb5\x18\f삼성카드\xb2\xb5\x18\fSamsung Card\x12F\n" +
+	"\x14CARD_COMPANY_SHINHAN\x10\x04\x1a,\x8a\xb5\x18\x0204\x92\xb5\x18\x0241\xaa\xb5\x18\f신한카드\xb2\xb5\x18\fShinhan Card\x12F\n" +
+	"\x14CARD_COMPANY_HYUNDAI\x10\x05\x1a,\x8a\xb5\x18\x0205\x92\xb5\x18\x0261\xaa\xb5\x18\f현대카드\xb2\xb5\x18\fHyundai Card\x12B\n" +
+	"\x12CARD_COMPANY_LOTTE\x10\x06\x1a*\x8a\xb5\x18\x0206\x92\xb5\x18\x0271\xaa\xb5\x18\f롯데카드\xb2\xb5\x18\n" +
+	"Lotte Card\x12@\n" +
+	"\x11CARD_COMPANY_CITI\x10\a\x1a)\x8a\xb5\x18\x0207\x92\xb5\x18\x0236\xaa\xb5\x18\f씨티카드\xb2\xb5\x18\tCiti Card\x12G\n" +
+	"\x0fCARD_COMPANY_NH\x10\b\x1a2\x8a\xb5\x18\x0208\x92\xb5\x18\x0291\xaa\xb5\x18\x0eNH농협카드\xb2\xb5\x18\x10NH NongHyup Card\x12D\n" +
+	"\x13CARD_COMPANY_SUHYUP\x10\t\x1a+\x8a\xb5\x18\x0209\x92\xb5\x18\x0234\xaa\xb5\x18\f수협카드\xb2\xb5\x18\vSuhyup Card\x12H\n" +
+	"\x15CARD_COMPANY_SHINHYUP\x10\n" +
+	"\x1a-\x8a\xb5\x18\x0210\x92\xb5\x18\x0262\xaa\xb5\x18\f신협카드\xb2\xb5\x18\rShinhyup Card\x12B\n" +
+	"\x12CARD_COMPANY_WOORI\x10\v\x1a*\x8a\xb5\x18\x0211\x92\xb5\x18\x02W1\xaa\xb5\x18\f우리카드\xb2\xb5\x18\n" +
+	"Woori Card\x12@\n" +
+	"\x11CARD_COMPANY_HANA\x10\f\x1a)\x8a\xb5\x18\x0212\x92\xb5\x18\x0221\xaa\xb5\x18\f하나카드\xb2\xb5\x18\tHana Card\x12H\n" +
+	"\x16CARD_COMPANY_KAKAOBANK\x10\r\x1a,\x8a\xb5\x18\x0215\x92\xb5\x18\x0215\xaa\xb5\x18\x0f카카오뱅크\xb2\xb5\x18\tKakaoBank\x128\n" +
+	"\x12CARD_COMPANY_KBANK\x10\x0e\x1a \x92\xb5\x18\x023A\xaa\xb5\x18\f케이뱅크\xb2\xb5\x18\x06K Bank\x12>\n" +
+	"\x15CARD_COMPANY_TOSSBANK\x10\x0f\x1a#\x92\xb5\x18\x0224\xaa\xb5\x18\f토스뱅크\xb2\xb5\x18\tToss Bank*\xf7\n" +
+	"\n" +
+	"\x04Bank\x12\x14\n" +
+	"\x10BANK_UNSPECIFIED\x10\x00\x12:\n" +
+	"\bBANK_KDB\x10\x01\x1a,\x92\xb5\x18\x0202\x9a\xb5\x18\x03002\xaa\xb5\x18\x0fKDB산업은행\xb2\xb5\x18\bKDB Bank\x125\n" +
+	"\bBANK_IBK\x10\x02\x1a'\x92\xb5\x18\x0203\x9a\xb5\x18\x03003\xaa\xb5\x18\x0fIBK기업은행\xb2\xb5\x18\x03IBK\x12?\n" +
+	"\aBANK_KB\x10\x03\x1a2\x92\xb5\x18\x0206\x9a\xb5\x18\x03004\xaa\xb5\x18\x0eKB국민은행\xb2\xb5\x18\x0fKB Kookmin Bank\x12=\n" +
+	"\vBANK_SUHYUP\x10\x04\x1a,\x92\xb5\x18\x0207\x9a\xb5\x18\x03007\xaa\xb5\x18\f수협은행\xb2\xb5\x18\vSuhyup Bank\x12@\n" +
+	"\aBANK_NH\x10\x05\x1a3\x92\xb5\x18\x0211\x9a\xb5\x18\x03011\xaa\xb5\x18\x0eNH농협은행\xb2\xb5\x18\x10NH NongHyup Bank\x12;\n" +
+	"\n" +
+	"BANK_WOORI\x10\x06\x1a+\x92\xb5\x18\x0220\x9a\xb5\x18\x03020\xaa\xb5\x18\f우리은행\xb2\xb5\x18\n" +
+	"Woori Bank\x12=\n" +
+	"\aBANK_SC\x10\a\x1a0\x92\xb5\x18\x0223\x9a\xb5\x18\x03023\xaa\xb5\x18\x0eSC제일은행\xb2\xb5\x18\rSC First Bank\x12D\n" +
+	"\tBANK_CITI\x10\b\x1a5\x92\xb5\x18\x0227\x9a\xb5\x18\x03027\xaa\xb5\x18\x12한국씨티은행\xb2\xb5\x18\x0eCitibank Korea\x124\n" +
+	"\n" +
+	"BANK_DAEGU\x10\t\x1a$\x92\xb5\x18\x0231\x9a\xb5\x18\x03031\xaa\xb5\x18\biM뱅크\xb2\xb5\x18\aiM Bank\x12;\n" +
+	"\n" +
+	"BANK_BUSAN\x10\n" +
+	"\x1a+\x92\xb5\x18\x0232\x9a\xb5\x18\x03032\xaa\xb5\x18\f부산은행\xb2\xb5\x18\n" +
+	"Busan Bank\x12?\n" +
+	"\fBANK_GWANGJU\x10\v\x1a-\x92\xb5\x18\x0234\x9a\xb5\x18\x03034\xaa\xb5\x18\f광주은행\xb2\xb5\x18\fKwangju Bank\x129\n" +
+	"\tBANK_JEJU\x10\f\x1a*\x92\xb5\x18\x0235\x9a\xb5\x18\x03035\xaa\xb5\x18\f제주은행\xb2\xb5\x18\tJeju Bank\x12?\n" +
+	"\fBANK_JEONBUK\x10\r\x1a-\x92\xb5\x18\x0237\x9a\xb5\x18\x03037\xaa\xb5\x18\f전북은행\xb2\xb5\x18\fJeonbuk Bank\x12A\n" +
+	"\rBANK_KYONGNAM\x10\x0e\x1a.\x92\xb5\x18\x0239\x9a\xb5\x18\x03039\xaa\xb5\x18\f경남은행\xb2\xb5\x18\rKyongnam Bank\x12G\n" +
+	"\fBANK_SAEMAUL\x10\x0f\x1a5\x92\xb5\x18\x0245\x9a\xb5\x18\x03045\xaa\xb5\x18\x0f새마을금고\xb2\xb5\x18\x11MG Saemaul Geumgo\x126\n" +
+	"\rBANK_SHINHYUP\x10\x10\x1a#\x92\xb5\x18\x0248\x9a\xb5\x18\x03048\xaa\xb5\x18\x06신협\xb2\xb5\x18\bShinhyup\x127\n" +
+	"\tBANK_POST\x10\x11\x1a(\x92\xb5\x18\x0271\x9a\xb5\x18\x03071\xaa\xb5\x18\t우체국\xb2\xb5\x18\n" +
+	"Korea Post\x129\n" +
+	"\tBANK_HANA\x10\x12\x1a*\x92\xb5\x18\x0281\x9a\xb5\x18\x03081\xaa\xb5\x18\f하나은행\xb2\xb5\x18\tHana Bank\x12?\n" +
+	"\fBANK_SHINHAN\x10\x13\x1a-\x92\xb5\x18\x0288\x9a\xb5\x18\x03088\xaa\xb5\x18\f신한은행\xb2\xb5\x18\fShinhan Bank\x127\n" +
+	"\n" +
+	"BANK_KBANK\x10\x14\x1a'\x92\xb5\x18\x0289\x9a\xb5\x18\x03089\xaa\xb5\x18\f케이뱅크\xb2\xb5\x18\x06K Bank\x12A\n" +
+	"\x0eBANK_KAKAOBANK\x10\x15\x1a-\x92\xb5\x18\x0290\x9a\xb5\x18\x03090\xaa\xb5\x18\x0f카카오뱅크\xb2\xb5\x18\tKakaoBank\x12=\n" +
+	"\rBANK_TOSSBANK\x10\x16\x1a*\x92\xb5\x18\x0292\x9a\xb5\x18\x03092\xaa\xb5\x18\f토스뱅크\xb2\xb5\x18\tToss Bank*\xfc\b\n" +
+	"\aCarrier\x12\x17\n" +
+	"\x13CARRIER_UNSPECIFIED\x10\x00\x12\x8b\x01\n" +
+	"\rCARRIER_EPOST\x10\x01\x1ax\xa2\xb5\x18\x0201\xaa\xb5\x18\x0f우체국택배\xb2\xb5\x18\n" +
+	"Korea Post\xba\xb5\x18Mhttps://service.epost.go.kr/trace.RetrieveDomRigiTraceList.comm?sid1={number}\x12\x85\x01\n" +
+	"\x14CARRIER_CJ_LOGISTICS\x10\x02\x1ak\xa2\xb5\x18\x0204\xaa\xb5\x18\x0eCJ대한통운\xb2\xb5\x18\fCJ Logistics\xba\xb5\x18?https://trace.cjlogistics.com/next/tracking.html?wblNo={number}\x12\xa0\x01\n" +
+	"\x0eCARRIER_HANJIN\x10\x03\x1a\x8b\x01\xa2\xb5\x18\x0205\xaa\xb5\x18\f한진택배\xb2\xb5\x18\x06Hanjin\xba\xb5\x18ghttps://www.hanjin.com/kor/CMS/DeliveryMgr/WaybillResult.do?mCode=MN038&schLang=KR&wblnumText2={number}\x12h\n" +
+	"\rCARRIER_LOGEN\x10\x04\x1aU\xa2\xb5\x18\x0206\xaa\xb5\x18\f로젠택배\xb2\xb5\x18\x05Logen\xba\xb5\x182https://www.ilogen.com/web/personal/trace/{number}\x12\x95\x01\n" +
+	"\rCARRIER_LOTTE\x10\x05\x1a\x81\x01\xa2\xb5\x18\x0208\xaa\xb5\x18\f롯데택배\xb2\xb5\x18\x16Lotte Global Logistics\xba\xb5\x18Mhttps://www.lotteglogis.com/home/reservation/tracking/linkView?InvNo={number}\x12\x81\x01\n" +
+	"\x0eCARRIER_DAESIN\x10\x06\x1am\xa2\xb5\x18\x0222\xaa\xb5\x18\f대신택배\xb2\xb5\x18\x06Daesin\xba\xb5\x18Ihttps://www.ds3211.co.kr/freight/internalFreightSearch.ht?billno={number}\x12\x85\x01\n" +
+	"\rCARRIER_KDEXP\x10\a\x1ar\xa2\xb5\x18\x0223\xaa\xb5\x18\f경동택배\xb2\xb5\x18\x11Kyungdong Express\xba\xb5\x18Chttps://kdexp.com/service/delivery/etc/delivery.do?barcode={number}\x12\x90\x01\n" +
+	"\x0fCARRIER_CU_POST\x10\b\x1a{\xa2\xb5\x18\x0246\xaa\xb5\x18\x12CU 편의점택배\xb2\xb5\x18\aCU Post\xba\xb5\x18Phttps://www.cupost.co.kr/postbox/delivery/localResult.cupost?invoice_no={number}:B\n" +
+	"\n" +
+	"kakao_code\x12!.google.protobuf.EnumValueOptions\x18ц\x03 \x01(\tR\tkakaoCode:@\n" +
+	"\ttoss_code\x12!.google.protobuf.EnumValueOptions\x18҆\x03 \x01(\tR\btossCode:@\n" +
+	"\tkftc_code\x12!.google.protobuf.EnumValueOptions\x18ӆ\x03 \x01(\tR\bkftcCode:F\n" +
+	"\ftracker_code\x12!.google.protobuf.EnumValueOptions\x18Ԇ\x03 \x01(\tR\vtrackerCode:K\n" +
+	"\x0fdisplay_name_ko\x12!.google.protobuf.EnumValueOptions\x18Ն\x03 \x01(\tR\rdisplayNameKo:K\n" +
+	"\x0fdisplay_name_en\x12!.google.protobuf.EnumValueOptions\x18ֆ\x03 \x01(\tR\rdisplayNameEn:F\n" +
+	"\ftracking_url\x12!.google.protobuf.EnumValueOptions\x18׆\x03 \x01(\tR\vtrackingUrlB#Z!github.com/escape-ship/protos/genb\x06proto3"
+
+var (
+	file_codes_proto_rawDescOnce sync.Once
+	file_codes_proto_rawDescData []byte
+)
+
+func file_codes_proto_rawDescGZIP() []byte {
+	file_codes_proto_rawDescOnce.Do(func() {
+		file_codes_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_codes_proto_rawDesc), len(file_codes_proto_rawDesc)))
+	})
+	return file_codes_proto_rawDescData
+}
+
+var file_codes_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_codes_proto_goTypes = []any{
+	(CardCompany)(0),                      // 0: go.escape.ship.proto.v1.CardCompany
+	(Bank)(0),                             // 1: go.escape.ship.proto.v1.Bank
+	(Carrier)(0),                          // 2: go.escape.ship.proto.v1.Carrier
+	(*descriptorpb.EnumValueOptions)(nil), // 3: google.protobuf.EnumValueOptions
+}
+var file_codes_proto_depIdxs = []int32{
+	3, // 0: go.escape.ship.proto.v1.kakao_code:extendee -> google.protobuf.EnumValueOptions
+	3, // 1: go.escape.ship.proto.v1.toss_code:extendee -> google.protobuf.EnumValueOptions
+	3, // 2: go.escape.ship.proto.v1.kftc_code:extendee -> google.protobuf.EnumValueOptions
+	3, // 3: go.escape.ship.proto.v1.tracker_code:extendee -> google.protobuf.EnumValueOptions
+	3, // 4: go.escape.ship.proto.v1.display_name_ko:extendee -> google.protobuf.EnumValueOptions
+	3, // 5: go.escape.ship.proto.v1.display_name_en:extendee -> google.protobuf.EnumValueOptions
+	3, // 6: go.escape.ship.proto.v1.tracking_url:extendee -> google.protobuf.EnumValueOptions
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	0, // [0:7] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_codes_proto_init() }
+func file_codes_proto_init() {
+	if File_codes_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_codes_proto_rawDesc), len(file_codes_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   0,
+			NumExtensions: 7,
+			NumServices:   0,
+		},
+		GoTypes:           file_codes_proto_goTypes,
+		DependencyIndexes: file_codes_proto_depIdxs,
+		EnumInfos:         file_codes_proto_enumTypes,
+		ExtensionInfos:    file_codes_proto_extTypes,
+	}.Build()
+	File_codes_proto = out.File
+	file_codes_proto_goTypes = nil
+	file_codes_proto_depIdxs = nil
+}
@@ -0,0 +1,611 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: common.proto
+
+package gen
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 에러 사유 코드. 서버는 gRPC status details의 google.rpc.ErrorInfo로 전달
+// (reason = 접두사 ERROR_REASON_을 뺀 이름, ex: "ACCOUNT_LOCKED", domain = "escape-ship")
+// 게이트웨이는 이 코드로 Accept-Language에 맞는 메시지를 찾아 응답
+type ErrorReason int32
+
+const (
+	ErrorReason_ERROR_REASON_UNSPECIFIED              ErrorReason = 0
+	ErrorReason_ERROR_REASON_INVALID_CREDENTIALS      ErrorReason = 1 // 이메일 또는 비밀번호 불일치
+	ErrorReason_ERROR_REASON_ACCOUNT_LOCKED           ErrorReason = 2 // 로그인 실패 누적으로 계정 잠금
+	ErrorReason_ERROR_REASON_UNAUTHENTICATED          ErrorReason = 3 // 인증 토큰 없음/만료
+	ErrorReason_ERROR_REASON_MISSING_SCOPE            ErrorReason = 4 // 권한(scope) 부족
+	ErrorReason_ERROR_REASON_UPGRADE_REQUIRED         ErrorReason = 5 // 최소 지원 버전 미만 클라이언트
+	ErrorReason_ERROR_REASON_INVALID_PAGE_TOKEN       ErrorReason = 6 // 위조/다른 조건의 page_token
+	ErrorReason_ERROR_REASON_PAGE_TOKEN_EXPIRED       ErrorReason = 7
+	ErrorReason_ERROR_REASON_CAPTCHA_REQUIRED         ErrorReason = 8
+	ErrorReason_ERROR_REASON_EMAIL_ALREADY_REGISTERED ErrorReason = 9
+	ErrorReason_ERROR_REASON_OUT_OF_STOCK             ErrorReason = 10
+	ErrorReason_ERROR_REASON_PURCHASE_LIMIT_EXCEEDED  ErrorReason = 11 // 1인당 구매 한도 초과
+	ErrorReason_ERROR_REASON_PAYMENT_DECLINED         ErrorReason = 12
+	ErrorReason_ERROR_REASON_BLOCKED                  ErrorReason = 13 // 부정 거래 차단 목록 대상
+	ErrorReason_ERROR_REASON_INVALID_SIGNATURE        ErrorReason = 14 // 서명된 페이로드 위변조 또는 만료
+	ErrorReason_ERROR_REASON_ORDER_NOT_CANCELLABLE    ErrorReason = 15 // 배송 시작/취소/환불된 주문 취소 요청
+	ErrorReason_ERROR_REASON_REFUND_EXCEEDS_PAYMENT   ErrorReason = 16 // 환불 요청 금액이 남은 결제 금액 초과
+	ErrorReason_ERROR_REASON_INVALID_ADDRESS          ErrorReason = 17 // 배송지 필수 항목 누락/형식 오류 (BadRequest에 필드별 위반 포함)
+)
+
+// Enum value maps for ErrorReason.
+var (
+	ErrorReason_name = map[int32]string{
+		0:  "ERROR_REASON_UNSPECIFIED",
+		1:  "ERROR_REASON_INVALID_CREDENTIALS",
+		2:  "ERROR_REASON_ACCOUNT_LOCKED",
+		3:  "ERROR_REASON_UNAUTHENTICATED",
+		4:  "ERROR_REASON_MISSING_SCOPE",
+		5:  "ERROR_REASON_UPGRADE_REQUIRED",
+		6:  "ERROR_REASON_INVALID_PAGE_TOKEN",
+		7:  "ERROR_REASON_PAGE_TOKEN_EXPIRED",
+		8:  "ERROR_REASON_CAPTCHA_REQUIRED",
+		9:  "ERROR_REASON_EMAIL_ALREADY_REGISTERED",
+		10: "ERROR_REASON_OUT_OF_STOCK",
+		11: "ERROR_REASON_PURCHASE_LIMIT_EXCEEDED",
+		12: "ERROR_REASON_PAYMENT_DECLINED",
+		13: "ERROR_REASON_BLOCKED",
+		14: "ERROR_REASON_INVALID_SIGNATURE",
+		15: "ERROR_REASON_ORDER_NOT_CANCELLABLE",
+		16: "ERROR_REASON_REFUND_EXCEEDS_PAYMENT",
+		17: "ERROR_REASON_INVALID_ADDRESS",
+	}
+	ErrorReason_value = map[string]int32{
+		"ERROR_REASON_UNSPECIFIED":              0,
+		"ERROR_REASON_INVALID_CREDENTIALS":      1,
+		"ERROR_REASON_ACCOUNT_LOCKED":           2,
+		"ERROR_REASON_UNAUTHENTICATED":          3,
+		"ERROR_REASON_MISSING_SCOPE":            4,
+		"ERROR_REASON_UPGRADE_REQUIRED":         5,
+		"ERROR_REASON_INVALID_PAGE_TOKEN":       6,
+		"ERROR_REASON_PAGE_TOKEN_EXPIRED":       7,
+		"ERROR_REASON_CAPTCHA_REQUIRED":         8,
+		"ERROR_REASON_EMAIL_ALREADY_REGISTERED": 9,
+		"ERROR_REASON_OUT_OF_STOCK":             10,
+		"ERROR_REASON_PURCHASE_LIMIT_EXCEEDED":  11,
+		"ERROR_REASON_PAYMENT_DECLINED":         12,
+		"ERROR_REASON_BLOCKED":                  13,
+		"ERROR_REASON_INVALID_SIGNATURE":        14,
+		"ERROR_REASON_ORDER_NOT_CANCELLABLE":    15,
+		"ERROR_REASON_REFUND_EXCEEDS_PAYMENT":   16,
+		"ERROR_REASON_INVALID_ADDRESS":          17,
+	}
+)
+
+func (x ErrorReason) Enum() *ErrorReason {
+	p := new(ErrorReason)
+	*p = x
+	return p
+}
+
+func (x ErrorReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_common_proto_enumTypes[0].Descriptor()
+}
+
+func (ErrorReason) Type() protoreflect.EnumType {
+	return &file_common_proto_enumTypes[0]
+}
+
+func (x ErrorReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorReason.Descriptor instead.
+func (ErrorReason) EnumDescriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{0}
+}
+
+// 해외 결제 시 표시 통화 환율 스냅샷 (정산은 항상 base_currency(KRW) 기준)
+type FxSnapshot struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	BaseCurrency    string                 `protobuf:"bytes,1,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`          // 정산 통화, 현재 항상 "KRW"
+	BaseAmount      int64                  `protobuf:"varint,2,opt,name=base_amount,json=baseAmount,proto3" json:"base_amount,omitempty"`               // 정산 금액 (base_currency 최소 단위)
+	DisplayCurrency string                 `protobuf:"bytes,3,opt,name=display_currency,json=displayCurrency,proto3" json:"display_currency,omitempty"` // 고객에게 표시한 통화 ISO 4217 (ex: "USD")
+	DisplayAmount   int64                  `protobuf:"varint,4,opt,name=display_amount,json=displayAmount,proto3" json:"display_amount,omitempty"`      // 표시 금액 (display_currency 최소 단위, ex: cents)
+	FxRate          string                 `protobuf:"bytes,5,opt,name=fx_rate,json=fxRate,proto3" json:"fx_rate,omitempty"`                            // 1 base_currency 당 display_currency 환율, 10진수 문자열 (ex: "0.000731")
+	CapturedAt      string                 `protobuf:"bytes,6,opt,name=captured_at,json=capturedAt,proto3" json:"captured_at,omitempty"`                // 환율 적용 시각 (RFC3339)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FxSnapshot) Reset() {
+	*x = FxSnapshot{}
+	mi := &file_common_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FxSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FxSnapshot) ProtoMessage() {}
+
+func (x *FxSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FxSnapshot.ProtoReflect.Descriptor instead.
+func (*FxSnapshot) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FxSnapshot) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
+func (x *FxSnapshot) GetBaseAmount() int64 {
+	if x != nil {
+		return x.BaseAmount
+	}
+	return 0
+}
+
+func (x *FxSnapshot) GetDisplayCurrency() string {
+	if x != nil {
+		return x.DisplayCurrency
+	}
+	return ""
+}
+
+func (x *FxSnapshot) GetDisplayAmount() int64 {
+	if x != nil {
+		return x.DisplayAmount
+	}
+	return 0
+}
+
+func (x *FxSnapshot) GetFxRate() string {
+	if x != nil {
+		return x.FxRate
+	}
+	return ""
+}
+
+func (x *FxSnapshot) GetCapturedAt() string {
+	if x != nil {
+		return x.CapturedAt
+	}
+	return ""
+}
+
+// 로그인/결제 요청의 디바이스 및 세션 식별 정보 (위험도 평가용)
+// 게이트웨이 경유 요청은 X-Device-Id 등 헤더에서 서버가 자동으로 채움
+type DeviceFingerprint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId      string                 `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"` // 앱 설치 단위 식별자 또는 웹 쿠키 ID
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Fingerprint   string                 `protobuf:"bytes,3,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"` // 클라이언트 SDK가 계산한 브라우저/디바이스 지문 해시
+	UserAgent     string                 `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	IpAddress     string                 `protobuf:"bytes,5,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeviceFingerprint) Reset() {
+	*x = DeviceFingerprint{}
+	mi := &file_common_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceFingerprint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceFingerprint) ProtoMessage() {}
+
+func (x *DeviceFingerprint) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceFingerprint.ProtoReflect.Descriptor instead.
+func (*DeviceFingerprint) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DeviceFingerprint) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *DeviceFingerprint) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *DeviceFingerprint) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *DeviceFingerprint) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *DeviceFingerprint) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+// 예상 배송일 (배송비/배송 옵션 응답에 포함, 날짜는 KST 기준 YYYY-MM-DD)
+// 주말·공휴일을 제외한 영업일로 계산하며, 출고 마감 시각 이후 주문은 다음 영업일 출고
+type DeliveryEstimate struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ShipDate        string                 `protobuf:"bytes,1,opt,name=ship_date,json=shipDate,proto3" json:"ship_date,omitempty"`                         // 출고 예정일
+	EarliestDate    string                 `protobuf:"bytes,2,opt,name=earliest_date,json=earliestDate,proto3" json:"earliest_date,omitempty"`             // 도착 예정일 (최소)
+	LatestDate      string                 `protobuf:"bytes,3,opt,name=latest_date,json=latestDate,proto3" json:"latest_date,omitempty"`                   // 도착 예정일 (최대)
+	MinBusinessDays int32                  `protobuf:"varint,4,opt,name=min_business_days,json=minBusinessDays,proto3" json:"min_business_days,omitempty"` // 출고일로부터 영업일 기준 최소 소요일
+	MaxBusinessDays int32                  `protobuf:"varint,5,opt,name=max_business_days,json=maxBusinessDays,proto3" json:"max_business_days,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeliveryEstimate) Reset() {
+	*x = DeliveryEstimate{}
+	mi := &file_common_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeliveryEstimate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliveryEstimate) ProtoMessage() {}
+
+func (x *DeliveryEstimate) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliveryEstimate.ProtoReflect.Descriptor instead.
+func (*DeliveryEstimate) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DeliveryEstimate) GetShipDate() string {
+	if x != nil {
+		return x.ShipDate
+	}
+	return ""
+}
+
+func (x *DeliveryEstimate) GetEarliestDate() string {
+	if x != nil {
+		return x.EarliestDate
+	}
+	return ""
+}
+
+func (x *DeliveryEstimate) GetLatestDate() string {
+	if x != nil {
+		return x.LatestDate
+	}
+	return ""
+}
+
+func (x *DeliveryEstimate) GetMinBusinessDays() int32 {
+	if x != nil {
+		return x.MinBusinessDays
+	}
+	return 0
+}
+
+func (x *DeliveryEstimate) GetMaxBusinessDays() int32 {
+	if x != nil {
+		return x.MaxBusinessDays
+	}
+	return 0
+}
+
+// 최소 지원 버전 미만 클라이언트 거부 시 gRPC status details로 전달 (FailedPrecondition)
+// 클라이언트는 요청 메타데이터 x-client-name / x-client-version으로 빌드를 알림
+type UpgradeRequired struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ClientName     string                 `protobuf:"bytes,1,opt,name=client_name,json=clientName,proto3" json:"client_name,omitempty"`
+	ClientVersion  string                 `protobuf:"bytes,2,opt,name=client_version,json=clientVersion,proto3" json:"client_version,omitempty"`    // 요청한 클라이언트 버전 (미전송 시 빈 문자열)
+	MinimumVersion string                 `protobuf:"bytes,3,opt,name=minimum_version,json=minimumVersion,proto3" json:"minimum_version,omitempty"` // 지원되는 최소 버전 (ex: "2.3.0")
+	UpgradeUrl     string                 `protobuf:"bytes,4,opt,name=upgrade_url,json=upgradeUrl,proto3" json:"upgrade_url,omitempty"`             // 앱스토어/다운로드 링크 (선택)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpgradeRequired) Reset() {
+	*x = UpgradeRequired{}
+	mi := &file_common_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpgradeRequired) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradeRequired) ProtoMessage() {}
+
+func (x *UpgradeRequired) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpgradeRequired.ProtoReflect.Descriptor instead.
+func (*UpgradeRequired) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpgradeRequired) GetClientName() string {
+	if x != nil {
+		return x.ClientName
+	}
+	return ""
+}
+
+func (x *UpgradeRequired) GetClientVersion() string {
+	if x != nil {
+		return x.ClientVersion
+	}
+	return ""
+}
+
+func (x *UpgradeRequired) GetMinimumVersion() string {
+	if x != nil {
+		return x.MinimumVersion
+	}
+	return ""
+}
+
+func (x *UpgradeRequired) GetUpgradeUrl() string {
+	if x != nil {
+		return x.UpgradeUrl
+	}
+	return ""
+}
+
+// 통화와 금액 (google.type.Money와 같은 구조)
+// units는 통화의 정수 단위, nanos는 10^-9 단위 소수부이며 부호는 units와 같아야 함
+// ex: USD 1.75 = {currency_code: "USD", units: 1, nanos: 750000000}, KRW 25,000원 = {currency_code: "KRW", units: 25000}
+type Money struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CurrencyCode  string                 `protobuf:"bytes,1,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"` // ISO 4217 (ex: "KRW")
+	Units         int64                  `protobuf:"varint,2,opt,name=units,proto3" json:"units,omitempty"`
+	Nanos         int32                  `protobuf:"varint,3,opt,name=nanos,proto3" json:"nanos,omitempty"` // -999,999,999 ~ +999,999,999
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_common_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Money) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *Money) GetUnits() int64 {
+	if x != nil {
+		return x.Units
+	}
+	return 0
+}
+
+func (x *Money) GetNanos() int32 {
+	if x != nil {
+		return x.Nanos
+	}
+	return 0
+}
+
+var File_common_proto protoreflect.FileDescriptor
+
+const file_common_proto_rawDesc = "" +
+	"\n" +
+	"\fcommon.proto\x12\x17go.escape.ship.proto.v1\"\xde\x01\n" +
+	"\n" +
+	"FxSnapshot\x12#\n" +
+	"\rbase_currency\x18\x01 \x01(\tR\fbaseCurrency\x12\x1f\n" +
+	"\vbase_amount\x18\x02 \x01(\x03R\n" +
+	"baseAmount\x12)\n" +
+	"\x10display_currency\x18\x03 \x01(\tR\x0fdisplayCurrency\x12%\n" +
+	"\x0edisplay_amount\x18\x04 \x01(\x03R\rdisplayAmount\x12\x17\n" +
+	"\afx_rate\x18\x05 \x01(\tR\x06fxRate\x12\x1f\n" +
+	"\vcaptured_at\x18\x06 \x01(\tR\n" +
+	"capturedAt\"\xaf\x01\n" +
+	"\x11DeviceFingerprint\x12\x1b\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\bdeviceId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12 \n" +
+	"\vfingerprint\x18\x03 \x01(\tR\vfingerprint\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x04 \x01(\tR\tuserAgent\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x05 \x01(\tR\tipAddress\"\xcd\x01\n" +
+	"\x10DeliveryEstimate\x12\x1b\n" +
+	"\tship_date\x18\x01 \x01(\tR\bshipDate\x12#\n" +
+	"\rearliest_date\x18\x02 \x01(\tR\fearliestDate\x12\x1f\n" +
+	"\vlatest_date\x18\x03 \x01(\tR\n" +
+	"latestDate\x12*\n" +
+	"\x11min_business_days\x18\x04 \x01(\x05R\x0fminBusinessDays\x12*\n" +
+	"\x11max_business_days\x18\x05 \x01(\x05R\x0fmaxBusinessDays\"\xa3\x01\n" +
+	"\x0fUpgradeRequired\x12\x1f\n" +
+	"\vclient_name\x18\x01 \x01(\tR\n" +
+	"clientName\x12%\n" +
+	"\x0eclient_version\x18\x02 \x01(\tR\rclientVersion\x12'\n" +
+	"\x0fminimum_version\x18\x03 \x01(\tR\x0eminimumVersion\x12\x1f\n" +
+	"\vupgrade_url\x18\x04 \x01(\tR\n" +
+	"upgradeUrl\"X\n" +
+	"\x05Money\x12#\n" +
+	"\rcurrency_code\x18\x01 \x01(\tR\fcurrencyCode\x12\x14\n" +
+	"\x05units\x18\x02 \x01(\x03R\x05units\x12\x14\n" +
+	"\x05nanos\x18\x03 \x01(\x05R\x05nanos*\x8c\x05\n" +
+	"\vErrorReason\x12\x1c\n" +
+	"\x18ERROR_REASON_UNSPECIFIED\x10\x00\x12$\n" +
+	" ERROR_REASON_INVALID_CREDENTIALS\x10\x01\x12\x1f\n" +
+	"\x1bERROR_REASON_ACCOUNT_LOCKED\x10\x02\x12 \n" +
+	"\x1cERROR_REASON_UNAUTHENTICATED\x10\x03\x12\x1e\n" +
+	"\x1aERROR_REASON_MISSING_SCOPE\x10\x04\x12!\n" +
+	"\x1dERROR_REASON_UPGRADE_REQUIRED\x10\x05\x12#\n" +
+	"\x1fERROR_REASON_INVALID_PAGE_TOKEN\x10\x06\x12#\n" +
+	"\x1fERROR_REASON_PAGE_TOKEN_EXPIRED\x10\a\x12!\n" +
+	"\x1dERROR_REASON_CAPTCHA_REQUIRED\x10\b\x12)\n" +
+	"%ERROR_REASON_EMAIL_ALREADY_REGISTERED\x10\t\x12\x1d\n" +
+	"\x19ERROR_REASON_OUT_OF_STOCK\x10\n" +
+	"\x12(\n" +
+	"$ERROR_REASON_PURCHASE_LIMIT_EXCEEDED\x10\v\x12!\n" +
+	"\x1dERROR_REASON_PAYMENT_DECLINED\x10\f\x12\x18\n" +
+	"\x14ERROR_REASON_BLOCKED\x10\r\x12\"\n" +
+	"\x1eERROR_REASON_INVALID_SIGNATURE\x10\x0e\x12&\n" +
+	"\"ERROR_REASON_ORDER_NOT_CANCELLABLE\x10\x0f\x12'\n" +
+	"#ERROR_REASON_REFUND_EXCEEDS_PAYMENT\x10\x10\x12 \n" +
+	"\x1cERROR_REASON_INVALID_ADDRESS\x10\x11B#Z!github.com/escape-ship/protos/genb\x06proto3"
+
+var (
+	file_common_proto_rawDescOnce sync.Once
+	file_common_proto_rawDescData []byte
+)
+
+func file_common_proto_rawDescGZIP() []byte {
+	file_common_proto_rawDescOnce.Do(func() {
+		file_common_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_common_proto_rawDesc), len(file_common_proto_rawDesc)))
+	})
+	return file_common_proto_rawDescData
+}
+
+var file_common_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_common_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_common_proto_goTypes = []any{
+	(ErrorReason)(0),          // 0: go.escape.ship.proto.v1.ErrorReason
+	(*FxSnapshot)(nil),        // 1: go.escape.ship.proto.v1.FxSnapshot
+	(*DeviceFingerprint)(nil), // 2: go.escape.ship.proto.v1.DeviceFingerprint
+	(*DeliveryEstimate)(nil),  // 3: go.escape.ship.proto.v1.DeliveryEstimate
+	(*UpgradeRequired)(nil),   // 4: go.escape.ship.proto.v1.UpgradeRequired
+	(*Money)(nil),             // 5: go.escape.ship.proto.v1.Money
+}
+var file_common_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_common_proto_init() }
+func file_common_proto_init() {
+	if File_common_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_common_proto_rawDesc), len(file_common_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_common_proto_goTypes,
+		DependencyIndexes: file_common_proto_depIdxs,
+		EnumInfos:         file_common_proto_enumTypes,
+		MessageInfos:      file_common_proto_msgTypes,
+	}.Build()
+	File_common_proto = out.File
+	file_common_proto_goTypes = nil
+	file_common_proto_depIdxs = nil
+}
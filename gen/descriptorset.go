@@ -0,0 +1,96 @@
+package gen
+
+import (
+	_ "embed"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// descriptorSet is the FileDescriptorSet written by protoc-gen-descriptorset.
+//
+//go:embed descriptor.binpb
+var descriptorSet []byte
+
+// DescriptorSetBytes returns the serialized FileDescriptorSet of the API,
+// including imported files and source comments, e.g. to serve over gRPC
+// reflection or feed to "buf breaking --against".
+func DescriptorSetBytes() []byte {
+	return append([]byte(nil), descriptorSet...)
+}
+
+// DescriptorSet returns a copy of the embedded FileDescriptorSet.
+func DescriptorSet() *descriptorpb.FileDescriptorSet {
+	set := new(descriptorpb.FileDescriptorSet)
+	if err := proto.Unmarshal(descriptorSet, set); err != nil {
+		panic("gen: corrupt embedded descriptor set: " + err.Error())
+	}
+	return set
+}
+
+var embeddedFiles = sync.OnceValue(func() *protoregistry.Files {
+	files, err := protodesc.NewFiles(DescriptorSet())
+	if err != nil {
+		panic("gen: invalid embedded descriptor set: " + err.Error())
+	}
+	return files
+})
+
+// Files returns a registry built from the embedded descriptor set. Unlike
+// protoregistry.GlobalFiles it is independent of which packages the binary
+// links and keeps source comments, so tools such as dynamic gateways and
+// schema diffs see the whole API. Use dynamicpb to create messages from its
+// descriptors.
+func Files() *protoregistry.Files {
+	return embeddedFiles()
+}
+
+// FindDescriptor looks up a message, enum, service or other descriptor by
+// full name, e.g. "go.escape.ship.proto.v1.Order".
+func FindDescriptor(name protoreflect.FullName) (protoreflect.Descriptor, error) {
+	return Files().FindDescriptorByName(name)
+}
+
+// FindMethod looks up the method of a gRPC full method name such as
+// OrderService_GetAllOrders_FullMethodName ("/pkg.Service/Method").
+func FindMethod(fullMethod string) (protoreflect.MethodDescriptor, error) {
+	service, method, ok := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if !ok {
+		return nil, fmt.Errorf("gen: malformed method name %q", fullMethod)
+	}
+	d, err := FindDescriptor(protoreflect.FullName(service))
+	if err != nil {
+		return nil, err
+	}
+	sd, ok := d.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("gen: %s is not a service", service)
+	}
+	md := sd.Methods().ByName(protoreflect.Name(method))
+	if md == nil {
+		return nil, fmt.Errorf("gen: %w: %s", protoregistry.NotFound, fullMethod)
+	}
+	return md, nil
+}
+
+// Services returns the services of the API sorted by full name.
+func Services() []protoreflect.ServiceDescriptor {
+	var out []protoreflect.ServiceDescriptor
+	Files().RangeFilesByPackage(File_order_proto.Package(), func(fd protoreflect.FileDescriptor) bool {
+		for i := 0; i < fd.Services().Len(); i++ {
+			out = append(out, fd.Services().Get(i))
+		}
+		return true
+	})
+	slices.SortFunc(out, func(a, b protoreflect.ServiceDescriptor) int {
+		return strings.Compare(string(a.FullName()), string(b.FullName()))
+	})
+	return out
+}
@@ -0,0 +1,184 @@
+package gen
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// FieldChange is a difference between two versions of a message.
+type FieldChange struct {
+	// Path locates the changed value with proto field names, list indexes
+	// and map keys, e.g. "status", "items[1].quantity" or
+	// `options["size"]`.
+	Path string
+	// Old and New are the values on each side: Go scalars, enum value names,
+	// []byte or proto.Message. A nil side means the value is absent (an unset
+	// message, or a list element or map entry that does not exist).
+	Old, New any
+}
+
+func (c FieldChange) String() string {
+	return fmt.Sprintf("%s: %v -> %v", c.Path, c.Old, c.New)
+}
+
+// Diff returns the fields that differ between a and b, in declaration order
+// with list elements and map keys in order. A nil message compares as empty.
+// Unknown fields are ignored. Use it for audit logs and to explain
+// optimistic-concurrency conflicts without hand-written comparisons:
+//
+//	for _, c := range pb.Diff(stored, updated) {
+//	    log.Printf("order %s: %s", stored.GetId(), c)
+//	}
+func Diff[M proto.Message](a, b M) []FieldChange {
+	var d differ
+	d.message("", a.ProtoReflect(), b.ProtoReflect())
+	return d.changes
+}
+
+// DiffOrders is Diff for orders.
+func DiffOrders(a, b *Order) []FieldChange {
+	return Diff(a, b)
+}
+
+// DiffProducts is Diff for products.
+func DiffProducts(a, b *Product) []FieldChange {
+	return Diff(a, b)
+}
+
+type differ struct {
+	changes []FieldChange
+}
+
+func (d *differ) add(path string, old, new any) {
+	d.changes = append(d.changes, FieldChange{Path: path, Old: old, New: new})
+}
+
+func (d *differ) message(prefix string, a, b protoreflect.Message) {
+	fields := a.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		path := prefix + string(fd.Name())
+		switch {
+		case fd.IsList():
+			d.list(path, fd, a.Get(fd).List(), b.Get(fd).List())
+		case fd.IsMap():
+			d.mapField(path, fd, a.Get(fd).Map(), b.Get(fd).Map())
+		case fd.HasPresence() && a.Has(fd) != b.Has(fd):
+			d.add(path, presentValue(fd, a), presentValue(fd, b))
+		default:
+			d.value(path, fd, a.Get(fd), b.Get(fd))
+		}
+	}
+}
+
+func presentValue(fd protoreflect.FieldDescriptor, m protoreflect.Message) any {
+	if !m.Has(fd) {
+		return nil
+	}
+	return goValue(fd, m.Get(fd))
+}
+
+func (d *differ) list(path string, fd protoreflect.FieldDescriptor, a, b protoreflect.List) {
+	for i := 0; i < max(a.Len(), b.Len()); i++ {
+		elem := path + "[" + strconv.Itoa(i) + "]"
+		switch {
+		case i >= a.Len():
+			d.add(elem, nil, goValue(fd, b.Get(i)))
+		case i >= b.Len():
+			d.add(elem, goValue(fd, a.Get(i)), nil)
+		default:
+			d.value(elem, fd, a.Get(i), b.Get(i))
+		}
+	}
+}
+
+func (d *differ) mapField(path string, fd protoreflect.FieldDescriptor, a, b protoreflect.Map) {
+	var keys []protoreflect.MapKey
+	a.Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+		keys = append(keys, k)
+		return true
+	})
+	b.Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+		if !a.Has(k) {
+			keys = append(keys, k)
+		}
+		return true
+	})
+	slices.SortFunc(keys, compareMapKeys)
+	vd := fd.MapValue()
+	for _, k := range keys {
+		entry := path + "[" + mapKeyString(k) + "]"
+		switch {
+		case !a.Has(k):
+			d.add(entry, nil, goValue(vd, b.Get(k)))
+		case !b.Has(k):
+			d.add(entry, goValue(vd, a.Get(k)), nil)
+		default:
+			d.value(entry, vd, a.Get(k), b.Get(k))
+		}
+	}
+}
+
+// value compares a single (non-list, non-map) value of fd.
+func (d *differ) value(path string, fd protoreflect.FieldDescriptor, a, b protoreflect.Value) {
+	if fd.Message() != nil {
+		am, bm := a.Message(), b.Message()
+		switch {
+		case !am.IsValid() && !bm.IsValid():
+		case !am.IsValid():
+			d.add(path, nil, bm.Interface())
+		case !bm.IsValid():
+			d.add(path, am.Interface(), nil)
+		default:
+			d.message(path+".", am, bm)
+		}
+		return
+	}
+	if !a.Equal(b) {
+		d.add(path, goValue(fd, a), goValue(fd, b))
+	}
+}
+
+// goValue converts v to the form FieldChange reports.
+func goValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) any {
+	switch {
+	case fd.Enum() != nil:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return string(ev.Name())
+		}
+		return int32(v.Enum())
+	case fd.Message() != nil:
+		return v.Message().Interface()
+	}
+	return v.Interface()
+}
+
+func mapKeyString(k protoreflect.MapKey) string {
+	if s, ok := k.Interface().(string); ok {
+		return strconv.Quote(s)
+	}
+	return k.String()
+}
+
+func compareMapKeys(a, b protoreflect.MapKey) int {
+	switch av := a.Interface().(type) {
+	case string:
+		return cmp.Compare(av, b.String())
+	case bool:
+		if av == b.Bool() {
+			return 0
+		} else if av {
+			return 1
+		}
+		return -1
+	case int32, int64:
+		return cmp.Compare(a.Int(), b.Int())
+	default:
+		return cmp.Compare(a.Uint(), b.Uint())
+	}
+}
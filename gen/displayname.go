@@ -0,0 +1,53 @@
+package gen
+
+import (
+	"golang.org/x/text/language"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// displayLanguages are the languages of the display_name_* options in
+// codes.proto. The first is the fallback.
+var displayLanguages = []language.Tag{language.Korean, language.English}
+
+var displayLanguageMatcher = language.NewMatcher(displayLanguages)
+
+// displayName returns the display name of v in the language best matching
+// acceptLanguage (an Accept-Language header value), falling back to Korean and
+// then to the value name. Unknown and UNSPECIFIED values have no name.
+func displayName(v protoreflect.EnumValueDescriptor, acceptLanguage string) string {
+	if v == nil || v.Number() == 0 {
+		return ""
+	}
+	opts := v.Options()
+	ko := proto.GetExtension(opts, E_DisplayNameKo).(string)
+	_, i := language.MatchStrings(displayLanguageMatcher, acceptLanguage)
+	if displayLanguages[i] == language.English {
+		if en := proto.GetExtension(opts, E_DisplayNameEn).(string); en != "" {
+			return en
+		}
+	}
+	if ko != "" {
+		return ko
+	}
+	return string(v.Name())
+}
+
+// DisplayName returns the card company name shown on payment methods and
+// settlement reports, e.g. "KB국민카드", in the language best matching
+// acceptLanguage (Korean by default).
+func (c CardCompany) DisplayName(acceptLanguage string) string {
+	return displayName(c.Descriptor().Values().ByNumber(protoreflect.EnumNumber(c)), acceptLanguage)
+}
+
+// DisplayName returns the bank name shown for virtual accounts, refund
+// accounts and settlement reports, e.g. "신한은행".
+func (b Bank) DisplayName(acceptLanguage string) string {
+	return displayName(b.Descriptor().Values().ByNumber(protoreflect.EnumNumber(b)), acceptLanguage)
+}
+
+// DisplayName returns the carrier name shown with tracking numbers, e.g.
+// "CJ대한통운".
+func (c Carrier) DisplayName(acceptLanguage string) string {
+	return displayName(c.Descriptor().Values().ByNumber(protoreflect.EnumNumber(c)), acceptLanguage)
+}
@@ -3,17 +3,28 @@
 // # Overview
 //
 // This package contains Protocol Buffer generated code for a microservices-based e-commerce platform
-// called "Escape Ship". It provides client and server stubs for four core services that handle
+// called "Escape Ship". It provides client and server stubs for the core services that handle
 // authentication, product management, order processing, and payment integration.
 //
 // # Services
 //
-// The platform consists of four main gRPC services:
+// The platform consists of the following gRPC services:
 //
 //   - AccountService: User authentication and Kakao OAuth integration
 //   - ProductService: Product catalog management with categories and options
 //   - OrderService: Order creation and retrieval with detailed item tracking
-//   - PaymentService: Kakao Pay payment processing integration
+//   - PaymentService: Payment processing through Kakao Pay and Toss Payments
+//   - InventoryService: Stock lookup, reservations, adjustments, audits and low-inventory alerts
+//   - NotificationService: Customer notification preferences and delivery
+//   - ChatService: Customer support chat scoped to orders or tickets
+//   - SubscriptionService: Recurring orders charged via billing keys
+//   - FlashSaleService: Limited-quantity drops with queueing
+//   - RiskService: Fraud blocklist management
+//   - CalendarService: Holidays and customer support hours
+//   - CartService: Shopping carts for members and guests
+//   - WishlistService: Saved products that can be moved to the cart
+//   - ReviewService: Product reviews and rating summaries
+//   - ShippingService: Shipments and carrier tracking
 //
 // # Architecture
 //
@@ -55,12 +66,16 @@
 //	    Code: "oauth_code_from_kakao",
 //	})
 //
+// Servers verify the returned access tokens with UnaryAuthInterceptor and a
+// TokenVerifier such as NewJWTVerifier; handlers read the caller with
+// UserIDFromContext.
+//
 // # Product Management
 //
 // Products are organized with categories and support configurable options:
 //
-//	// Get all products
-//	products, err := productClient.GetProducts(ctx, &GetProductsRequest{})
+//	// Get the first page of products
+//	products, err := productClient.GetProducts(ctx, &GetProductsRequest{PageSize: 50})
 //
 //	// Get specific product with options
 //	product, err := productClient.GetProductByID(ctx, &GetProductByIDRequest{
@@ -77,10 +92,15 @@
 //	order := &InsertOrderRequest{
 //	    UserId:          "user-123",
 //	    OrderNumber:     "ORD-2024-001",
-//	    Status:          "pending",
+//	    Status:          OrderStatus_ORDER_STATUS_PENDING,
 //	    TotalPrice:      50000,
 //	    PaymentMethod:   "kakao_pay",
-//	    ShippingAddress: "123 Main St, Seoul",
+//	    DeliveryAddress: &Address{
+//	        Recipient:  "홍길동",
+//	        Phone:      "010-1234-5678",
+//	        PostalCode: "06236",
+//	        Line1:      "서울특별시 강남구 테헤란로 123",
+//	    },
 //	    Items: []*InsertOrderItem{
 //	        {
 //	            ProductId:      "prod-1",
@@ -103,8 +123,8 @@
 //	    PartnerUserId:  "user-456",
 //	    ItemName:       "Order Items",
 //	    Quantity:       2,
-//	    TotalAmount:    50000,
-//	    TaxFreeAmount:  0,
+//	    Total:          KRW(50000),
+//	    TaxFree:        KRW(0),
 //	})
 //
 //	// 2. User completes payment on Kakao
@@ -116,6 +136,27 @@
 //	    PgToken:        "payment_token_from_kakao",
 //	})
 //
+// PreparePayment, ApprovePayment and CancelPayment run the same flow against
+// any provider, with provider-specific input in the provider_details oneof.
+// EffectiveProvider resolves the provider of a PreparePayment request, and
+// CheckProvider and Payment.CheckCancel validate the later calls:
+//
+//	prep, err := paymentClient.PreparePayment(ctx, &PreparePaymentRequest{
+//	    OrderId:         "order-123",
+//	    OrderName:       "Order Items",
+//	    Amount:          KRW(50000),
+//	    ProviderDetails: &PreparePaymentRequest_TossPayments{TossPayments: &TossPaymentsPrepareDetails{}},
+//	})
+//
+//	// Open the Toss Payments checkout with prep.GetTossPayments(), then
+//	payment, err := paymentClient.ApprovePayment(ctx, &ApprovePaymentRequest{
+//	    PaymentId: prep.GetPayment().GetId(),
+//	    ProviderDetails: &ApprovePaymentRequest_TossPayments{TossPayments: &TossPaymentsApproveDetails{
+//	        PaymentKey: paymentKeyFromSuccessURL,
+//	        Amount:     KRW(50000),
+//	    }},
+//	})
+//
 // # HTTP/JSON Gateway
 //
 // All services support both gRPC and HTTP/JSON through grpc-gateway annotations.
@@ -126,21 +167,161 @@
 //	  POST /oauth/kakao/callback  - Handle OAuth callback
 //	  POST /login                 - Traditional login
 //	  POST /register              - User registration
+//	  POST /auth/refresh          - Rotate refresh token, issue new access token
+//	  POST /auth/revoke           - Revoke token (logout)
+//	  POST /users/{user_id}/anonymize - Scrub PII, keep aggregates
+//	  POST /terms/accept          - Accept current terms of service
+//	  POST /push-tokens           - Register FCM/APNs push token
+//	  POST /push-tokens/unregister - Unregister push token
+//	  POST /captcha/verify        - Verify CAPTCHA token
+//	  POST /users/{user_id}/unlock - Unlock a locked-out account (admin)
+//	  POST /guest/token           - Issue anonymous guest token
+//	  POST /users/merge           - Merge guest or Kakao account into target
+//	  POST /users/me/email/change - Request email change (verifies new address)
+//	  POST /users/me/email/confirm - Confirm email change
+//	  POST /users/me/avatar       - Upload profile avatar (client streaming)
+//	  GET  /users/me/preferences  - Get UI preferences
+//	  PUT  /users/me/preferences  - Update UI preferences
+//	  GET  /users/me              - Get the current user's profile
+//	  PATCH /users/me             - Update nickname, phone, default address
+//	  POST /users/me/password     - Change password
+//	  POST /users/me/delete       - Delete account (PII purged after grace period)
+//	  POST /api-keys              - Issue partner API key (admin)
+//	  POST /api-keys/{key_id}/revoke - Revoke partner API key (admin)
 //
 //	Product Service:
-//	  GET  /products              - List all products
+//	  GET  /products              - List products (paginated)
 //	  GET  /products/{id}         - Get specific product
 //	  POST /products              - Create new product
+//	  PATCH /products/{id}        - Update only the fields set in the patch
 //	  POST /product/{id}/options  - Get product options
+//	  POST /products/bundles      - Create product bundle
+//	  GET  /products/bundles/{bundle_id} - Resolve bundle components
+//
+//	Review Service:
+//	  POST   /products/{product_id}/reviews             - Create review
+//	  GET    /products/{product_id}/reviews             - List reviews (paginated)
+//	  PATCH  /products/{product_id}/reviews/{review_id} - Update own review
+//	  DELETE /products/{product_id}/reviews/{review_id} - Delete review
+//	  GET    /products/{product_id}/reviews/summary     - Get rating summary
 //
 //	Order Service:
 //	  POST /v1/order/insert       - Create new order
-//	  GET  /v1/order              - Get all orders
+//	  GET  /v1/order              - List orders (paginated)
+//	  GET  /v1/orders/{id}        - Get specific order
+//	  GET  /v1/users/{user_id}/orders - List a user's orders by status (paginated)
+//	  GET  /v1/order/{order_id}/watch - Stream order status changes (SSE)
+//	  POST /v1/order/{order_id}/cancel - Cancel an unshipped order
+//	  POST /v1/order/{order_id}/refunds - Refund an order in full or in part
+//	  POST /v1/order/returns/{return_id}/label - Book return pickup and label
+//	  POST /v1/order/import       - Bulk import orders (client streaming)
+//	  POST /v1/order/batch-get    - Get orders by IDs (partial results)
+//	  POST /v1/order/archive      - Move old orders to cold storage
+//	  GET  /v1/order/archived/{id} - Get an archived order
+//	  POST /v1/quotes             - Create B2B quote
+//	  POST /v1/quotes/{quote_id}/accept  - Accept quote
+//	  POST /v1/quotes/{quote_id}/convert - Convert quote to order
+//	  POST /v1/order/price        - Price an order with promotions applied
+//	  POST /v1/order/eligibility  - Check per-customer purchase limits
 //
 //	Payment Service:
 //	  POST /payment/kakao/ready   - Prepare Kakao payment
 //	  POST /payment/kakao/approve - Approve Kakao payment
 //	  POST /payment/kakao/cancel  - Cancel Kakao payment
+//	  POST /v1/payments/prepare   - Prepare payment with any provider
+//	  POST /v1/payments/{payment_id}/approve - Approve payment
+//	  POST /v1/payments/{payment_id}/cancel  - Cancel payment in full or in part
+//
+//	Shipping Service:
+//	  POST /v1/shipments          - Register shipment (tracking number)
+//	  GET  /v1/shipments/{shipment_id}/tracking - Get tracking info
+//	  POST /v1/shipments/{shipment_id}/status   - Record carrier tracking event
+//	  GET  /v1/shipments/{shipment_id}/watch    - Stream tracking events (SSE)
+//
+//	Cart Service:
+//	  GET    /v1/cart             - Get cart
+//	  POST   /v1/cart/items       - Add item
+//	  DELETE /v1/cart/items/{item_id} - Remove item
+//	  PUT    /v1/cart/items/{item_id} - Update item quantity
+//	  DELETE /v1/cart             - Clear cart
+//
+//	Wishlist Service:
+//	  GET    /v1/wishlist         - List saved products (paginated)
+//	  POST   /v1/wishlist/items   - Save product
+//	  DELETE /v1/wishlist/items/{item_id} - Remove saved product
+//	  POST   /v1/wishlist/items/{item_id}/move-to-cart - Move to cart
+//
+//	Inventory Service:
+//	  GET  /v1/inventory/products/{product_id}/stock - Get stock per option combination
+//	  POST /v1/inventory/reservations - Reserve stock for an order
+//	  POST /v1/inventory/reservations/{reservation_id}/release - Release reservation
+//	  POST /v1/inventory/adjustments - Adjust on-hand stock (admin)
+//	  GET  /v1/inventory/low-stock/watch - Stream low-stock alerts
+//	  POST /v1/inventory/audit    - Report stock discrepancies with corrections (admin)
+//
+//	Notification Service:
+//	  GET  /v1/notifications/preferences - Get notification preferences
+//	  PUT  /v1/notifications/preferences - Update notification preferences
+//	  GET  /v1/notifications      - List in-app notifications
+//	  POST /v1/notifications/{notification_id}/read - Mark notification read
+//	  POST /v1/notifications/email - Send email (service-to-service)
+//	  POST /v1/notifications/sms  - Send SMS (service-to-service)
+//	  POST /v1/notifications/push - Send push notification (service-to-service)
+//	  GET  /v1/notifications/events - Stream delivery events
+//
+//	Chat Service:
+//	  POST /v1/chat/conversations - Open a support conversation
+//	  GET  /v1/chat/conversations/{conversation_id}/messages - List messages
+//
+//	Subscription Service:
+//	  POST /v1/subscriptions      - Create subscription
+//	  POST /v1/subscriptions/{subscription_id}/pause  - Pause subscription
+//	  POST /v1/subscriptions/{subscription_id}/skip   - Skip next delivery
+//	  POST /v1/subscriptions/{subscription_id}/cancel - Cancel subscription
+//
+//	Flash Sale Service:
+//	  POST /v1/flash-sales        - Create flash sale
+//	  GET  /v1/flash-sales/{flash_sale_id} - Get flash sale
+//	  GET  /v1/flash-sales/{flash_sale_id}/queue/{user_id} - Get queue position
+//	  POST /v1/flash-sales/{flash_sale_id}/queue - Join queue, issue queue token
+//	  POST /v1/flash-sales/queue/validate - Validate queue token
+//
+//	Risk Service:
+//	  POST   /v1/risk/blocklist            - Add blocklist entry
+//	  DELETE /v1/risk/blocklist/{entry_id} - Remove blocklist entry
+//	  POST   /v1/risk/blocklist/check      - Check identifiers against blocklist
+//
+//	Calendar Service:
+//	  GET  /v1/calendar/holidays      - List holidays in a date range
+//	  GET  /v1/calendar/support-hours - Get customer support hours
+//
+// # Pagination
+//
+// List RPCs use keyset pagination with opaque page tokens. Results are ordered
+// by a stable sort key with the resource ID as tie-breaker, so concurrent writes
+// never cause a page walk to skip or duplicate rows. Servers mint and verify
+// tokens with PageTokenCodec:
+//
+//	codec := NewPageTokenCodec(signingKey, 24*time.Hour)
+//	cur, err := codec.Decode(req.PageToken, filterFingerprint)
+//	// ... query rows after (cur.SortKey, cur.LastID) ...
+//	next, err := codec.Encode(PageCursor{SortKey: last.CreatedAt, LastID: last.Id, Query: filterFingerprint})
+//
+// Pages are also bounded by encoded size so image-heavy products cannot blow up
+// a response. PageSizeForBudget picks a row limit from the size of previous
+// results, and TruncateToBudget trims a fetched page to DefaultPageBytes:
+//
+//	limit := PageSizeForBudget(prevPage, DefaultPageBytes, 100)
+//	page := TruncateToBudget(rows, DefaultPageBytes)
+//	last := page[len(page)-1] // issue the next token from here
+//
+// Clients walk all pages with a Pager, which follows next_page_token until it
+// is empty:
+//
+//	p := NewOrdersPager(orderClient, &GetAllOrdersRequest{PageSize: 100})
+//	for order, err := range p.All(ctx) {
+//	    // ...
+//	}
 //
 // # Error Handling
 //
@@ -151,6 +332,104 @@
 //   - Unauthenticated: Authentication required or failed
 //   - Internal: Server-side processing errors
 //
+// Errors meant for end users also carry an ErrorReason in a google.rpc.ErrorInfo
+// detail (see NewError). The gateway's LocalizedErrorHandler replaces their
+// messages with Korean or English text from a catalog keyed by reason,
+// chosen by the request's Accept-Language:
+//
+//	mux := runtime.NewServeMux(runtime.WithErrorHandler(LocalizedErrorHandler(nil)))
+//
+// # Connecting
+//
+// NewClientSet dials one address and returns clients for the core services.
+// OnStateChange reports connectivity transitions, e.g. for readiness probes:
+//
+//	clients, err := NewClientSet(ClientConfig{Address: "dns:///gatewaysrv:50051"})
+//	stop := clients.OnStateChange(func(from, to connectivity.State) {
+//	    ready.Store(to == connectivity.Ready)
+//	})
+//
+// Set ClientConfig.WaitForReady (with ExpectServices) to fail fast at startup
+// when the target is unreachable or does not serve the expected services
+// according to the gRPC health API.
+//
+// ClientConfig.UnaryInterceptors and StreamInterceptors are chained on the
+// connection. UnaryTimeoutInterceptor, UnaryRetryInterceptor (see RetryConfig)
+// and UnaryAuthTokenInterceptor cover the common needs:
+//
+//	clients, err := NewClientSet(ClientConfig{
+//	    Address: "dns:///gatewaysrv:50051",
+//	    UnaryInterceptors: []grpc.UnaryClientInterceptor{
+//	        UnaryTimeoutInterceptor(5 * time.Second),
+//	        UnaryRetryInterceptor(DefaultRetryConfig),
+//	        UnaryAuthTokenInterceptor(StaticToken(token)),
+//	    },
+//	})
+//
+// InsertOrder, KakaoReady and the other IdempotentMutations take an
+// idempotency_key. Servers process each key once and return the first
+// response for repeats, so a retried call cannot create a second order or
+// payment. UnaryIdempotencyKeyInterceptor fills in a key per call; chain it
+// before UnaryRetryInterceptor so every attempt sends the same one, and pin a
+// key with WithIdempotencyKey when the user may resubmit:
+//
+//	ctx = WithIdempotencyKey(ctx, checkoutID)
+//	result, err := orderClient.InsertOrder(ctx, order)
+//
+// Servers chaining UnaryServerTimingInterceptor return a per-dependency
+// latency breakdown in the server-timing trailer, which explains slow calls
+// such as a checkout waiting on Kakao Pay:
+//
+//	var trailer metadata.MD
+//	result, err := orderClient.InsertOrder(ctx, order, grpc.Trailer(&trailer))
+//	timings := ServerTimingFromTrailer(trailer)
+//
+// # Mocking Clients
+//
+// Every service has an XxxServiceAPI interface that mirrors XxxServiceClient
+// without grpc.CallOption variadics, so mocks need only plain signatures.
+// Streaming RPCs take and return iterators:
+//
+//	orders := NewOrderServiceAPI(NewOrderServiceClient(conn))
+//	for alert, err := range inventory.WatchLowStock(ctx, req) {
+//	    // ...
+//	}
+//
+// XxxServiceClientFromAPI goes the other way, turning a mock API into a client.
+//
+// The mocks sub-package (github.com/escape-ship/protos/gen/mocks) has ready-made
+// MockXxxServiceClient fakes with programmable responses and call recording,
+// e.g. to build a ClientSet in consumer tests. For tests that should exercise
+// the real gRPC stack, the testserver sub-package serves fake Account, Order,
+// Payment and Product implementations in-process over bufconn and returns a
+// connected ClientSet.
+//
+// The scaffold sub-package has starting-point server implementations, e.g.
+// NewAccountServer, whose stores and external clients are interfaces in a
+// Deps struct, so handlers can be unit tested with in-memory fakes. The kakao
+// sub-package provides the Kakao Login client they expect, with retries and
+// a per-user token cache, and the kakaopay sub-package maps the KakaoReady,
+// KakaoApprove and KakaoCancel messages to Kakao Pay REST calls.
+//
+// # External Codes
+//
+// The CardCompany, Bank and Carrier enums in codes.proto carry their Kakao Pay,
+// Toss Payments, KFTC and tracker codes as enum value options. Convert with the
+// generated lookups rather than per-service switch statements:
+//
+//	card, ok := CardCompanyFromToss(issuerCode)
+//	code := Bank_BANK_KB.KFTCCode() // "004"
+//
+// DisplayName returns their Korean or English names for payment methods,
+// virtual accounts and settlement reports, chosen by Accept-Language.
+// TrackingURL links a tracking number to the carrier's tracking page.
+//
+// # Fixtures
+//
+// The fixtures sub-package (github.com/escape-ship/protos/gen/fixtures) holds the
+// canonical sample users, products, orders, and payments used in the examples
+// above. Consumer tests can reuse them instead of hand-building messages.
+//
 // # Development
 //
 // This package is generated from Protocol Buffer definitions using buf and the standard
@@ -162,6 +441,18 @@
 //   - Service client interfaces for calling remote services
 //   - Service server interfaces for implementing services
 //   - HTTP/JSON gateway reverse proxy code
+//   - Call-option-free client interfaces for mocking (protoc-gen-go-shim)
+//   - Programmable mock clients in the mocks sub-package (protoc-gen-go-mock)
+//   - Connect protocol handlers and clients in the genconnect sub-package
+//     (protoc-gen-connect-go)
+//   - Twirp servers and clients for plain HTTP/1.1 consumers (protoc-gen-twirp)
+//   - JSON Schemas of all messages, embedded and served by JSONSchema
+//     (protoc-gen-jsonschema)
+//   - TypeScript types for gateway JSON payloads, embedded as TypeScriptFS
+//     (protoc-gen-tstypes)
+//   - The compiled FileDescriptorSet with source comments, embedded and
+//     queryable through Files, FindDescriptor and FindMethod
+//     (protoc-gen-descriptorset)
 //
 // # Dependencies
 //
@@ -169,6 +460,8 @@
 //   - google.golang.org/grpc - gRPC runtime
 //   - google.golang.org/protobuf - Protocol Buffer runtime
 //   - github.com/grpc-ecosystem/grpc-gateway/v2 - HTTP/gRPC gateway
+//   - connectrpc.com/connect - Connect protocol runtime (genconnect)
+//   - github.com/twitchtv/twirp - Twirp runtime
 //   - google.golang.org/genproto - Google API annotations
 //
 // For complete API documentation and examples, see the individual service client
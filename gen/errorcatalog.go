@@ -0,0 +1,186 @@
+package gen
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"golang.org/x/text/language"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// ErrorDomain is the google.rpc.ErrorInfo domain of Escape Ship errors.
+const ErrorDomain = "escape-ship"
+
+// Reason returns the ErrorInfo reason string for r, e.g. "ACCOUNT_LOCKED".
+func (r ErrorReason) Reason() string {
+	return strings.TrimPrefix(r.String(), "ERROR_REASON_")
+}
+
+// ErrorReasonOf returns the reason carried by err's ErrorInfo detail, or
+// ERROR_REASON_UNSPECIFIED if err has none from ErrorDomain.
+func ErrorReasonOf(err error) ErrorReason {
+	st, ok := status.FromError(err)
+	if !ok {
+		return ErrorReason_ERROR_REASON_UNSPECIFIED
+	}
+	info := errorInfo(st)
+	if info == nil {
+		return ErrorReason_ERROR_REASON_UNSPECIFIED
+	}
+	return ErrorReason(ErrorReason_value["ERROR_REASON_"+info.GetReason()])
+}
+
+// NewError returns a status error carrying reason as a google.rpc.ErrorInfo.
+// msg is the developer-facing (English) message; meta fills the {key}
+// placeholders of the localized catalog message.
+func NewError(code codes.Code, reason ErrorReason, msg string, meta map[string]string) error {
+	return reasonError(code, reason, msg, meta)
+}
+
+func reasonError(code codes.Code, reason ErrorReason, msg string, meta map[string]string, details ...protoadapt.MessageV1) error {
+	info := &errdetails.ErrorInfo{Reason: reason.Reason(), Domain: ErrorDomain, Metadata: meta}
+	st, err := status.New(code, msg).WithDetails(append([]protoadapt.MessageV1{info}, details...)...)
+	if err != nil {
+		return status.Error(code, msg)
+	}
+	return st.Err()
+}
+
+func errorInfo(st *status.Status) *errdetails.ErrorInfo {
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok && info.GetDomain() == ErrorDomain {
+			return info
+		}
+	}
+	return nil
+}
+
+// errorCatalog holds user-facing messages per language and reason. The first
+// language is the fallback. Placeholders go in a parenthetical, which is
+// dropped when meta lacks the value, so the message still reads well.
+var errorCatalog = []struct {
+	tag      language.Tag
+	messages map[ErrorReason]string
+}{
+	{language.Korean, map[ErrorReason]string{
+		ErrorReason_ERROR_REASON_INVALID_CREDENTIALS:      "이메일 또는 비밀번호가 올바르지 않습니다. (남은 시도 {remaining_attempts}회)",
+		ErrorReason_ERROR_REASON_ACCOUNT_LOCKED:           "로그인 시도가 너무 많아 계정이 잠겼습니다. 잠시 후 다시 시도해 주세요.",
+		ErrorReason_ERROR_REASON_UNAUTHENTICATED:          "로그인이 필요합니다.",
+		ErrorReason_ERROR_REASON_MISSING_SCOPE:            "이 작업을 수행할 권한이 없습니다.",
+		ErrorReason_ERROR_REASON_UPGRADE_REQUIRED:         "앱을 최신 버전({minimum_version} 이상)으로 업데이트해 주세요.",
+		ErrorReason_ERROR_REASON_INVALID_PAGE_TOKEN:       "목록을 처음부터 다시 불러와 주세요.",
+		ErrorReason_ERROR_REASON_PAGE_TOKEN_EXPIRED:       "목록 정보가 만료되었습니다. 처음부터 다시 불러와 주세요.",
+		ErrorReason_ERROR_REASON_CAPTCHA_REQUIRED:         "보안 문자 확인이 필요합니다.",
+		ErrorReason_ERROR_REASON_EMAIL_ALREADY_REGISTERED: "이미 가입된 이메일입니다.",
+		ErrorReason_ERROR_REASON_OUT_OF_STOCK:             "재고가 부족합니다.",
+		ErrorReason_ERROR_REASON_PURCHASE_LIMIT_EXCEEDED:  "1인당 구매 가능 수량을 초과했습니다.",
+		ErrorReason_ERROR_REASON_PAYMENT_DECLINED:         "결제가 거절되었습니다. 다른 결제 수단을 이용해 주세요.",
+		ErrorReason_ERROR_REASON_BLOCKED:                  "요청을 처리할 수 없습니다. 고객센터로 문의해 주세요.",
+		ErrorReason_ERROR_REASON_INVALID_SIGNATURE:        "요청 정보가 만료되었거나 올바르지 않습니다. 처음부터 다시 시도해 주세요.",
+		ErrorReason_ERROR_REASON_ORDER_NOT_CANCELLABLE:    "이미 배송이 시작되었거나 취소된 주문입니다.",
+		ErrorReason_ERROR_REASON_REFUND_EXCEEDS_PAYMENT:   "환불 가능 금액({refundable})을 초과했습니다.",
+		ErrorReason_ERROR_REASON_INVALID_ADDRESS:          "배송지 정보를 확인해 주세요.",
+	}},
+	{language.English, map[ErrorReason]string{
+		ErrorReason_ERROR_REASON_INVALID_CREDENTIALS:      "Incorrect email or password. ({remaining_attempts} attempts left)",
+		ErrorReason_ERROR_REASON_ACCOUNT_LOCKED:           "Your account is locked after too many sign-in attempts. Please try again later.",
+		ErrorReason_ERROR_REASON_UNAUTHENTICATED:          "Please sign in.",
+		ErrorReason_ERROR_REASON_MISSING_SCOPE:            "You don't have permission to do this.",
+		ErrorReason_ERROR_REASON_UPGRADE_REQUIRED:         "Please update the app to the latest version ({minimum_version} or later).",
+		ErrorReason_ERROR_REASON_INVALID_PAGE_TOKEN:       "Please reload the list from the beginning.",
+		ErrorReason_ERROR_REASON_PAGE_TOKEN_EXPIRED:       "The list has expired. Please reload it from the beginning.",
+		ErrorReason_ERROR_REASON_CAPTCHA_REQUIRED:         "Please complete the security check.",
+		ErrorReason_ERROR_REASON_EMAIL_ALREADY_REGISTERED: "This email is already registered.",
+		ErrorReason_ERROR_REASON_OUT_OF_STOCK:             "This item is out of stock.",
+		ErrorReason_ERROR_REASON_PURCHASE_LIMIT_EXCEEDED:  "You've reached the purchase limit for this item.",
+		ErrorReason_ERROR_REASON_PAYMENT_DECLINED:         "Your payment was declined. Please try another payment method.",
+		ErrorReason_ERROR_REASON_BLOCKED:                  "We can't process this request. Please contact customer support.",
+		ErrorReason_ERROR_REASON_INVALID_SIGNATURE:        "This request has expired or is invalid. Please start over.",
+		ErrorReason_ERROR_REASON_ORDER_NOT_CANCELLABLE:    "This order has already shipped or been cancelled.",
+		ErrorReason_ERROR_REASON_REFUND_EXCEEDS_PAYMENT:   "The refund exceeds the refundable amount ({refundable}).",
+		ErrorReason_ERROR_REASON_INVALID_ADDRESS:          "Please check the shipping address.",
+	}},
+}
+
+var errorLanguageMatcher = func() language.Matcher {
+	tags := make([]language.Tag, len(errorCatalog))
+	for i, c := range errorCatalog {
+		tags[i] = c.tag
+	}
+	return language.NewMatcher(tags)
+}()
+
+// LocalizedErrorMessage returns the catalog message for reason in the
+// language best matching acceptLanguage (an Accept-Language header value),
+// falling back to Korean. {key} placeholders are filled from meta; a
+// parenthetical with a placeholder meta has no value for is left out.
+func LocalizedErrorMessage(reason ErrorReason, acceptLanguage string, meta map[string]string) (string, bool) {
+	_, i := language.MatchStrings(errorLanguageMatcher, acceptLanguage)
+	msg, ok := errorCatalog[i].messages[reason]
+	if !ok {
+		return "", false
+	}
+	resolved := func(s string) bool {
+		for _, m := range placeholderPattern.FindAllStringSubmatch(s, -1) {
+			if _, ok := meta[m[1]]; !ok {
+				return false
+			}
+		}
+		return true
+	}
+	msg = placeholderGroupPattern.ReplaceAllStringFunc(msg, func(group string) string {
+		if resolved(group) {
+			return group
+		}
+		return ""
+	})
+	msg = placeholderPattern.ReplaceAllStringFunc(msg, func(p string) string {
+		return meta[p[1:len(p)-1]]
+	})
+	return msg, true
+}
+
+var (
+	placeholderPattern      = regexp.MustCompile(`\{([a-z_]+)\}`)
+	placeholderGroupPattern = regexp.MustCompile(` ?\([^()]*\{[a-z_]+\}[^()]*\)`)
+)
+
+// LocalizeError returns err with its message replaced by the localized catalog
+// message for its ErrorInfo reason. Code and details are kept. Errors without
+// a catalogued reason are returned unchanged.
+func LocalizeError(err error, acceptLanguage string) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	info := errorInfo(st)
+	if info == nil {
+		return err
+	}
+	msg, ok := LocalizedErrorMessage(ErrorReasonOf(err), acceptLanguage, info.GetMetadata())
+	if !ok {
+		return err
+	}
+	p := st.Proto()
+	p.Message = msg
+	return status.FromProto(p).Err()
+}
+
+// LocalizedErrorHandler wraps a gateway error handler (nil means
+// runtime.DefaultHTTPErrorHandler) so error messages are localized according
+// to the request's Accept-Language. Install it with
+// runtime.WithErrorHandler(LocalizedErrorHandler(nil)).
+func LocalizedErrorHandler(next runtime.ErrorHandlerFunc) runtime.ErrorHandlerFunc {
+	if next == nil {
+		next = runtime.DefaultHTTPErrorHandler
+	}
+	return func(ctx context.Context, mux *runtime.ServeMux, m runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+		next(ctx, mux, m, w, r, LocalizeError(err, r.Header.Get("Accept-Language")))
+	}
+}
@@ -0,0 +1,65 @@
+package gen
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestLocalizedErrorMessage(t *testing.T) {
+	tests := []struct {
+		name   string
+		reason ErrorReason
+		lang   string
+		meta   map[string]string
+		want   string
+	}{
+		{"korean with meta", ErrorReason_ERROR_REASON_INVALID_CREDENTIALS, "ko-KR", map[string]string{"remaining_attempts": "3"}, "이메일 또는 비밀번호가 올바르지 않습니다. (남은 시도 3회)"},
+		{"korean without meta", ErrorReason_ERROR_REASON_INVALID_CREDENTIALS, "ko", nil, "이메일 또는 비밀번호가 올바르지 않습니다."},
+		{"english with meta", ErrorReason_ERROR_REASON_INVALID_CREDENTIALS, "en-US,en;q=0.9", map[string]string{"remaining_attempts": "2"}, "Incorrect email or password. (2 attempts left)"},
+		{"english without meta", ErrorReason_ERROR_REASON_INVALID_CREDENTIALS, "en", map[string]string{"other": "x"}, "Incorrect email or password."},
+		{"placeholder mid sentence", ErrorReason_ERROR_REASON_UPGRADE_REQUIRED, "ko", nil, "앱을 최신 버전으로 업데이트해 주세요."},
+		{"english upgrade", ErrorReason_ERROR_REASON_UPGRADE_REQUIRED, "en", map[string]string{"minimum_version": "2.3.0"}, "Please update the app to the latest version (2.3.0 or later)."},
+		{"meta value is not rescanned", ErrorReason_ERROR_REASON_REFUND_EXCEEDS_PAYMENT, "en", map[string]string{"refundable": "{remaining_attempts}"}, "The refund exceeds the refundable amount ({remaining_attempts})."},
+		{"unknown language falls back to korean", ErrorReason_ERROR_REASON_OUT_OF_STOCK, "fr", nil, "재고가 부족합니다."},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := LocalizedErrorMessage(tt.reason, tt.lang, tt.meta)
+			if !ok || got != tt.want {
+				t.Errorf("LocalizedErrorMessage() = %q, %v, want %q", got, ok, tt.want)
+			}
+		})
+	}
+	if _, ok := LocalizedErrorMessage(ErrorReason_ERROR_REASON_UNSPECIFIED, "ko", nil); ok {
+		t.Error("LocalizedErrorMessage() found a message for UNSPECIFIED")
+	}
+}
+
+func TestCatalogMessagesHaveNoBarePlaceholders(t *testing.T) {
+	for _, c := range errorCatalog {
+		for reason := range c.messages {
+			got, _ := LocalizedErrorMessage(reason, c.tag.String(), nil)
+			if strings.ContainsAny(got, "{}") {
+				t.Errorf("%s %v without meta = %q", c.tag, reason, got)
+			}
+		}
+	}
+}
+
+func TestLocalizeError(t *testing.T) {
+	err := NewError(codes.Unauthenticated, ErrorReason_ERROR_REASON_INVALID_CREDENTIALS, "bad credentials", map[string]string{"remaining_attempts": "1"})
+	got := LocalizeError(err, "en")
+	if st := status.Convert(got); st.Code() != codes.Unauthenticated || st.Message() != "Incorrect email or password. (1 attempts left)" {
+		t.Errorf("LocalizeError() = %v", got)
+	}
+	if ErrorReasonOf(got) != ErrorReason_ERROR_REASON_INVALID_CREDENTIALS {
+		t.Error("LocalizeError() dropped the ErrorInfo detail")
+	}
+	plain := status.Error(codes.Internal, "boom")
+	if LocalizeError(plain, "en") != plain {
+		t.Error("LocalizeError() changed an error without a reason")
+	}
+}
@@ -0,0 +1,104 @@
+package gen
+
+import (
+	"context"
+	"net/textproto"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// HTTP headers clients send to identify their device and session.
+const (
+	DeviceIDHeader          = "X-Device-Id"
+	SessionIDHeader         = "X-Session-Id"
+	DeviceFingerprintHeader = "X-Device-Fingerprint"
+)
+
+// DeviceHeaderMatcher forwards the device headers, the client build
+// headers (X-Client-Name, X-Client-Version) and Idempotency-Key to gRPC
+// metadata and otherwise behaves like runtime.DefaultHeaderMatcher. Install it on the
+// gateway mux with runtime.WithIncomingHeaderMatcher(DeviceHeaderMatcher).
+func DeviceHeaderMatcher(key string) (string, bool) {
+	switch textproto.CanonicalMIMEHeaderKey(key) {
+	case DeviceIDHeader, SessionIDHeader, DeviceFingerprintHeader,
+		textproto.CanonicalMIMEHeaderKey(ClientNameHeader), textproto.CanonicalMIMEHeaderKey(ClientVersionHeader),
+		IdempotencyKeyHeader:
+		return strings.ToLower(key), true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// DeviceFingerprintFromMetadata builds a DeviceFingerprint from incoming call
+// metadata, as forwarded by a gateway using DeviceHeaderMatcher. It returns nil
+// when no device information is present. The IP address is the last
+// X-Forwarded-For hop, which the gateway appends from the connection's peer
+// address; earlier hops come from the client and may be spoofed.
+func DeviceFingerprintFromMetadata(ctx context.Context) *DeviceFingerprint {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+	first := func(keys ...string) string {
+		for _, k := range keys {
+			if v := md.Get(k); len(v) > 0 && v[0] != "" {
+				return v[0]
+			}
+		}
+		return ""
+	}
+	fp := &DeviceFingerprint{
+		DeviceId:    first(strings.ToLower(DeviceIDHeader)),
+		SessionId:   first(strings.ToLower(SessionIDHeader)),
+		Fingerprint: first(strings.ToLower(DeviceFingerprintHeader)),
+		UserAgent:   first(runtime.MetadataPrefix+"user-agent", "user-agent"),
+	}
+	if xff := md.Get("x-forwarded-for"); len(xff) > 0 {
+		hops := strings.Split(xff[len(xff)-1], ",")
+		fp.IpAddress = strings.TrimSpace(hops[len(hops)-1])
+	}
+	if proto.Equal(fp, &DeviceFingerprint{}) {
+		return nil
+	}
+	return fp
+}
+
+type deviceFingerprintKey struct{}
+
+// DeviceFingerprintFromContext returns the fingerprint stored by
+// UnaryDeviceFingerprintInterceptor, or nil.
+func DeviceFingerprintFromContext(ctx context.Context) *DeviceFingerprint {
+	fp, _ := ctx.Value(deviceFingerprintKey{}).(*DeviceFingerprint)
+	return fp
+}
+
+// UnaryDeviceFingerprintInterceptor extracts the caller's device fingerprint
+// from metadata into the context. Requests with an unset "device" field, such
+// as LoginRequest and InsertOrderRequest, also get it filled in so risk scoring
+// sees the same data whether the client sent it in the body or as headers.
+func UnaryDeviceFingerprintInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		fp := DeviceFingerprintFromMetadata(ctx)
+		if fp == nil {
+			return handler(ctx, req)
+		}
+		if m, ok := req.(proto.Message); ok {
+			fillDeviceField(m.ProtoReflect(), fp)
+		}
+		return handler(context.WithValue(ctx, deviceFingerprintKey{}, fp), req)
+	}
+}
+
+func fillDeviceField(m protoreflect.Message, fp *DeviceFingerprint) {
+	fd := m.Descriptor().Fields().ByName("device")
+	if fd == nil || fd.Message() == nil || fd.Message().FullName() != fp.ProtoReflect().Descriptor().FullName() {
+		return
+	}
+	if !m.Has(fd) {
+		m.Set(fd, protoreflect.ValueOfMessage(proto.Clone(fp).ProtoReflect()))
+	}
+}
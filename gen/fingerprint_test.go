@@ -0,0 +1,61 @@
+package gen
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestDeviceFingerprintFromMetadata(t *testing.T) {
+	tests := []struct {
+		name string
+		md   metadata.MD
+		want *DeviceFingerprint
+	}{
+		{name: "no device info", md: metadata.Pairs("content-type", "application/grpc"), want: nil},
+		{
+			name: "gateway headers",
+			md: metadata.Pairs(
+				"x-device-id", "d-1", "x-session-id", "s-1", "x-device-fingerprint", "fp",
+				"grpcgateway-user-agent", "app/1.0", "x-forwarded-for", "203.0.113.7",
+			),
+			want: &DeviceFingerprint{DeviceId: "d-1", SessionId: "s-1", Fingerprint: "fp", UserAgent: "app/1.0", IpAddress: "203.0.113.7"},
+		},
+		{
+			name: "spoofed X-Forwarded-For",
+			md:   metadata.Pairs("x-forwarded-for", "10.0.0.1, 192.0.2.1 , 203.0.113.7"),
+			want: &DeviceFingerprint{IpAddress: "203.0.113.7"},
+		},
+		{
+			name: "several header values",
+			md:   metadata.Pairs("x-forwarded-for", "10.0.0.1", "x-forwarded-for", "203.0.113.7"),
+			want: &DeviceFingerprint{IpAddress: "203.0.113.7"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DeviceFingerprintFromMetadata(metadata.NewIncomingContext(context.Background(), tt.md))
+			if !proto.Equal(got, tt.want) {
+				t.Errorf("DeviceFingerprintFromMetadata() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeviceFingerprintFromGatewayRequest(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/v1/order", nil)
+	req.RemoteAddr = "203.0.113.7:52000"
+	req.Header.Set("X-Forwarded-For", "10.0.0.1")
+	ctx, err := runtime.AnnotateIncomingContext(context.Background(), runtime.NewServeMux(), req, OrderService_InsertOrder_FullMethodName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := DeviceFingerprintFromMetadata(ctx).GetIpAddress(); got != "203.0.113.7" {
+		t.Errorf("IpAddress = %q, want the peer address 203.0.113.7", got)
+	}
+}
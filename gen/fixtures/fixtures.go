@@ -0,0 +1,193 @@
+// Package fixtures provides canonical sample messages for the Escape Ship API.
+//
+// The same values appear in the package documentation examples, so docs and
+// consumer tests stay consistent. Every function returns a fresh message that
+// callers may modify freely.
+//
+//	req := fixtures.InsertOrderRequest()
+//	req.Memo = "문 앞에 놓아주세요"
+//	resp, err := orderClient.InsertOrder(ctx, req)
+package fixtures
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/escape-ship/protos/gen"
+)
+
+// Canonical identifiers shared by all fixtures.
+const (
+	UserID         = "user-123"
+	UserEmail      = "user@example.com"
+	UserPassword   = "password123"
+	ProductID      = "product-123"
+	OtherProductID = "product-456"
+	OrderID        = "order-123"
+	OrderNumber    = "ORD-2024-001"
+	PaymentTID     = "T1234567890123456789"
+	CreatedAt      = "2024-01-15T10:30:00+09:00"
+)
+
+// createdAt returns CreatedAt as a fresh Timestamp.
+func createdAt() *timestamppb.Timestamp {
+	ts, _ := pb.ParseLegacyTime(CreatedAt)
+	return ts
+}
+
+// UserProfile returns the canonical user's profile.
+func UserProfile() *pb.UserProfile {
+	return &pb.UserProfile{
+		UserId:    UserID,
+		Email:     UserEmail,
+		AvatarUrl: "https://cdn.escape-ship.example/avatars/user-123.png",
+	}
+}
+
+// LoginRequest returns an email/password login for the canonical user.
+func LoginRequest() *pb.LoginRequest {
+	return &pb.LoginRequest{Email: UserEmail, Password: UserPassword}
+}
+
+// RegisterRequest returns a registration for the canonical user.
+func RegisterRequest() *pb.RegisterRequest {
+	return &pb.RegisterRequest{Email: UserEmail, Password: UserPassword}
+}
+
+// Product returns the canonical product.
+func Product() *pb.Product {
+	return &pb.Product{
+		Id:          ProductID,
+		Name:        "Sample Product",
+		Category:    "apparel",
+		Price:       25000,
+		ListPrice:   pb.KRW(25000),
+		ImageUrl:    "https://cdn.escape-ship.example/products/product-123.jpg",
+		Description: "기본 샘플 상품",
+		CreatedAt:   CreatedAt,
+		UpdatedAt:   CreatedAt,
+		OptionsJson: `{"size":["S","M","L"],"color":["Blue","Black"]}`,
+	}
+}
+
+// OtherProduct returns a second product for list and bundle fixtures.
+func OtherProduct() *pb.Product {
+	return &pb.Product{
+		Id:          OtherProductID,
+		Name:        "Another Product",
+		Category:    "accessories",
+		Price:       12000,
+		ListPrice:   pb.KRW(12000),
+		ImageUrl:    "https://cdn.escape-ship.example/products/product-456.jpg",
+		Description: "두 번째 샘플 상품",
+		CreatedAt:   CreatedAt,
+		UpdatedAt:   CreatedAt,
+		OptionsJson: `{}`,
+	}
+}
+
+// Products returns the canonical product list.
+func Products() []*pb.Product {
+	return []*pb.Product{Product(), OtherProduct()}
+}
+
+// InsertOrderRequest returns an order of two units of Product by the
+// canonical user, paid with Kakao Pay.
+func InsertOrderRequest() *pb.InsertOrderRequest {
+	return &pb.InsertOrderRequest{
+		UserId:          UserID,
+		OrderNumber:     OrderNumber,
+		OrderStatus:     pb.OrderStatus_ORDER_STATUS_PENDING,
+		TotalPrice:      50000,
+		Quantity:        2,
+		PaymentMethod:   "kakao_pay",
+		ShippingFee:     proto.Int32(0),
+		ShippingAddress: "123 Main St, Seoul",
+		Items: []*pb.InsertOrderItem{{
+			ProductId:      ProductID,
+			ProductName:    "Sample Product",
+			ProductOptions: "Size: M, Color: Blue",
+			ProductPrice:   25000,
+			Quantity:       2,
+		}},
+	}
+}
+
+// Order returns the stored form of InsertOrderRequest.
+func Order() *pb.Order {
+	return &pb.Order{
+		Id:              OrderID,
+		UserId:          UserID,
+		OrderNumber:     OrderNumber,
+		OrderStatus:     pb.OrderStatus_ORDER_STATUS_PAID,
+		TotalPrice:      50000,
+		Quantity:        2,
+		PaymentMethod:   "kakao_pay",
+		ShippingFee:     proto.Int32(0),
+		ShippingAddress: "123 Main St, Seoul",
+		OrderedTime:     createdAt(),
+		PaidTime:        createdAt(),
+		OrderedAt:       CreatedAt,
+		PaidAt:          CreatedAt,
+		Items: []*pb.OrderItem{{
+			Id:           "order-item-1",
+			OrderId:      OrderID,
+			ProductId:    ProductID,
+			ProductName:  "Sample Product",
+			ProductPrice: 25000,
+			UnitPrice:    pb.KRW(25000),
+			Quantity:     2,
+		}},
+	}
+}
+
+// KakaoReadyRequest returns the payment preparation for Order.
+func KakaoReadyRequest() *pb.KakaoReadyRequest {
+	return &pb.KakaoReadyRequest{
+		PartnerOrderId: OrderID,
+		PartnerUserId:  UserID,
+		ItemName:       "Sample Product",
+		Quantity:       2,
+		TotalAmount:    50000,
+		TaxFreeAmount:  0,
+		Total:          pb.KRW(50000),
+		TaxFree:        pb.KRW(0),
+	}
+}
+
+// KakaoReadyResponse returns Kakao Pay's answer to KakaoReadyRequest.
+func KakaoReadyResponse() *pb.KakaoReadyResponse {
+	return &pb.KakaoReadyResponse{
+		Tid:                   PaymentTID,
+		NextRedirectAppUrl:    "https://online-pay.kakao.com/mockup/v1/app",
+		NextRedirectMobileUrl: "https://online-pay.kakao.com/mockup/v1/mobile",
+		NextRedirectPcUrl:     "https://online-pay.kakao.com/mockup/v1/pc",
+		AndroidAppScheme:      "kakaotalk://kakaopay/pg?url=https://online-pay.kakao.com/pg/v1",
+		IosAppScheme:          "kakaotalk://kakaopay/pg?url=https://online-pay.kakao.com/pg/v1",
+	}
+}
+
+// KakaoApproveRequest returns the approval of the payment started by KakaoReadyRequest.
+func KakaoApproveRequest() *pb.KakaoApproveRequest {
+	return &pb.KakaoApproveRequest{
+		Tid:            PaymentTID,
+		PartnerOrderId: OrderID,
+		PartnerUserId:  UserID,
+		PgToken:        "payment_token_from_kakao",
+	}
+}
+
+// KakaoCancelRequest returns a full cancellation of Order's payment.
+func KakaoCancelRequest() *pb.KakaoCancelRequest {
+	return &pb.KakaoCancelRequest{
+		PartnerOrderId:        OrderID,
+		CancelAmount:          "50000",
+		CancelTaxFreeAmount:   0,
+		CancelVatAmount:       4545,
+		CancelAvailableAmount: 50000,
+		Cancel:                pb.KRW(50000),
+		CancelTaxFree:         pb.KRW(0),
+		CancelVat:             pb.KRW(4545),
+		CancelAvailable:       pb.KRW(50000),
+	}
+}
@@ -0,0 +1,938 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: flashsale.proto
+
+package gen
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type FlashSaleStatus int32
+
+const (
+	FlashSaleStatus_FLASH_SALE_STATUS_UNSPECIFIED FlashSaleStatus = 0
+	FlashSaleStatus_FLASH_SALE_STATUS_SCHEDULED   FlashSaleStatus = 1
+	FlashSaleStatus_FLASH_SALE_STATUS_ACTIVE      FlashSaleStatus = 2
+	FlashSaleStatus_FLASH_SALE_STATUS_SOLD_OUT    FlashSaleStatus = 3
+	FlashSaleStatus_FLASH_SALE_STATUS_ENDED       FlashSaleStatus = 4
+)
+
+// Enum value maps for FlashSaleStatus.
+var (
+	FlashSaleStatus_name = map[int32]string{
+		0: "FLASH_SALE_STATUS_UNSPECIFIED",
+		1: "FLASH_SALE_STATUS_SCHEDULED",
+		2: "FLASH_SALE_STATUS_ACTIVE",
+		3: "FLASH_SALE_STATUS_SOLD_OUT",
+		4: "FLASH_SALE_STATUS_ENDED",
+	}
+	FlashSaleStatus_value = map[string]int32{
+		"FLASH_SALE_STATUS_UNSPECIFIED": 0,
+		"FLASH_SALE_STATUS_SCHEDULED":   1,
+		"FLASH_SALE_STATUS_ACTIVE":      2,
+		"FLASH_SALE_STATUS_SOLD_OUT":    3,
+		"FLASH_SALE_STATUS_ENDED":       4,
+	}
+)
+
+func (x FlashSaleStatus) Enum() *FlashSaleStatus {
+	p := new(FlashSaleStatus)
+	*p = x
+	return p
+}
+
+func (x FlashSaleStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FlashSaleStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_flashsale_proto_enumTypes[0].Descriptor()
+}
+
+func (FlashSaleStatus) Type() protoreflect.EnumType {
+	return &file_flashsale_proto_enumTypes[0]
+}
+
+func (x FlashSaleStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FlashSaleStatus.Descriptor instead.
+func (FlashSaleStatus) EnumDescriptor() ([]byte, []int) {
+	return file_flashsale_proto_rawDescGZIP(), []int{0}
+}
+
+type FlashSale struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId         string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	SalePrice         int64                  `protobuf:"varint,3,opt,name=sale_price,json=salePrice,proto3" json:"sale_price,omitempty"`
+	QuantityCap       int32                  `protobuf:"varint,4,opt,name=quantity_cap,json=quantityCap,proto3" json:"quantity_cap,omitempty"` // 세일 전체 판매 수량 상한
+	RemainingQuantity int32                  `protobuf:"varint,5,opt,name=remaining_quantity,json=remainingQuantity,proto3" json:"remaining_quantity,omitempty"`
+	StartAt           string                 `protobuf:"bytes,6,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"` // RFC3339
+	EndAt             string                 `protobuf:"bytes,7,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`       // RFC3339
+	Status            FlashSaleStatus        `protobuf:"varint,8,opt,name=status,proto3,enum=go.escape.ship.proto.v1.FlashSaleStatus" json:"status,omitempty"`
+	MaxPerCustomer    int32                  `protobuf:"varint,9,opt,name=max_per_customer,json=maxPerCustomer,proto3" json:"max_per_customer,omitempty"` // 고객당 최대 구매 수량, 0이면 제한 없음
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *FlashSale) Reset() {
+	*x = FlashSale{}
+	mi := &file_flashsale_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlashSale) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlashSale) ProtoMessage() {}
+
+func (x *FlashSale) ProtoReflect() protoreflect.Message {
+	mi := &file_flashsale_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlashSale.ProtoReflect.Descriptor instead.
+func (*FlashSale) Descriptor() ([]byte, []int) {
+	return file_flashsale_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FlashSale) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FlashSale) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *FlashSale) GetSalePrice() int64 {
+	if x != nil {
+		return x.SalePrice
+	}
+	return 0
+}
+
+func (x *FlashSale) GetQuantityCap() int32 {
+	if x != nil {
+		return x.QuantityCap
+	}
+	return 0
+}
+
+func (x *FlashSale) GetRemainingQuantity() int32 {
+	if x != nil {
+		return x.RemainingQuantity
+	}
+	return 0
+}
+
+func (x *FlashSale) GetStartAt() string {
+	if x != nil {
+		return x.StartAt
+	}
+	return ""
+}
+
+func (x *FlashSale) GetEndAt() string {
+	if x != nil {
+		return x.EndAt
+	}
+	return ""
+}
+
+func (x *FlashSale) GetStatus() FlashSaleStatus {
+	if x != nil {
+		return x.Status
+	}
+	return FlashSaleStatus_FLASH_SALE_STATUS_UNSPECIFIED
+}
+
+func (x *FlashSale) GetMaxPerCustomer() int32 {
+	if x != nil {
+		return x.MaxPerCustomer
+	}
+	return 0
+}
+
+type CreateFlashSaleRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ProductId      string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	SalePrice      int64                  `protobuf:"varint,2,opt,name=sale_price,json=salePrice,proto3" json:"sale_price,omitempty"`
+	QuantityCap    int32                  `protobuf:"varint,3,opt,name=quantity_cap,json=quantityCap,proto3" json:"quantity_cap,omitempty"`
+	StartAt        string                 `protobuf:"bytes,4,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	EndAt          string                 `protobuf:"bytes,5,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	MaxPerCustomer int32                  `protobuf:"varint,6,opt,name=max_per_customer,json=maxPerCustomer,proto3" json:"max_per_customer,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateFlashSaleRequest) Reset() {
+	*x = CreateFlashSaleRequest{}
+	mi := &file_flashsale_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFlashSaleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFlashSaleRequest) ProtoMessage() {}
+
+func (x *CreateFlashSaleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flashsale_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFlashSaleRequest.ProtoReflect.Descriptor instead.
+func (*CreateFlashSaleRequest) Descriptor() ([]byte, []int) {
+	return file_flashsale_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateFlashSaleRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CreateFlashSaleRequest) GetSalePrice() int64 {
+	if x != nil {
+		return x.SalePrice
+	}
+	return 0
+}
+
+func (x *CreateFlashSaleRequest) GetQuantityCap() int32 {
+	if x != nil {
+		return x.QuantityCap
+	}
+	return 0
+}
+
+func (x *CreateFlashSaleRequest) GetStartAt() string {
+	if x != nil {
+		return x.StartAt
+	}
+	return ""
+}
+
+func (x *CreateFlashSaleRequest) GetEndAt() string {
+	if x != nil {
+		return x.EndAt
+	}
+	return ""
+}
+
+func (x *CreateFlashSaleRequest) GetMaxPerCustomer() int32 {
+	if x != nil {
+		return x.MaxPerCustomer
+	}
+	return 0
+}
+
+type CreateFlashSaleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FlashSale     *FlashSale             `protobuf:"bytes,1,opt,name=flash_sale,json=flashSale,proto3" json:"flash_sale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFlashSaleResponse) Reset() {
+	*x = CreateFlashSaleResponse{}
+	mi := &file_flashsale_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFlashSaleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFlashSaleResponse) ProtoMessage() {}
+
+func (x *CreateFlashSaleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flashsale_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFlashSaleResponse.ProtoReflect.Descriptor instead.
+func (*CreateFlashSaleResponse) Descriptor() ([]byte, []int) {
+	return file_flashsale_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateFlashSaleResponse) GetFlashSale() *FlashSale {
+	if x != nil {
+		return x.FlashSale
+	}
+	return nil
+}
+
+type GetFlashSaleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FlashSaleId   string                 `protobuf:"bytes,1,opt,name=flash_sale_id,json=flashSaleId,proto3" json:"flash_sale_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFlashSaleRequest) Reset() {
+	*x = GetFlashSaleRequest{}
+	mi := &file_flashsale_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFlashSaleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFlashSaleRequest) ProtoMessage() {}
+
+func (x *GetFlashSaleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flashsale_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFlashSaleRequest.ProtoReflect.Descriptor instead.
+func (*GetFlashSaleRequest) Descriptor() ([]byte, []int) {
+	return file_flashsale_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetFlashSaleRequest) GetFlashSaleId() string {
+	if x != nil {
+		return x.FlashSaleId
+	}
+	return ""
+}
+
+type GetFlashSaleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FlashSale     *FlashSale             `protobuf:"bytes,1,opt,name=flash_sale,json=flashSale,proto3" json:"flash_sale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFlashSaleResponse) Reset() {
+	*x = GetFlashSaleResponse{}
+	mi := &file_flashsale_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFlashSaleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFlashSaleResponse) ProtoMessage() {}
+
+func (x *GetFlashSaleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flashsale_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFlashSaleResponse.ProtoReflect.Descriptor instead.
+func (*GetFlashSaleResponse) Descriptor() ([]byte, []int) {
+	return file_flashsale_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetFlashSaleResponse) GetFlashSale() *FlashSale {
+	if x != nil {
+		return x.FlashSale
+	}
+	return nil
+}
+
+type GetQueuePositionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FlashSaleId   string                 `protobuf:"bytes,1,opt,name=flash_sale_id,json=flashSaleId,proto3" json:"flash_sale_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQueuePositionRequest) Reset() {
+	*x = GetQueuePositionRequest{}
+	mi := &file_flashsale_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQueuePositionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQueuePositionRequest) ProtoMessage() {}
+
+func (x *GetQueuePositionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flashsale_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQueuePositionRequest.ProtoReflect.Descriptor instead.
+func (*GetQueuePositionRequest) Descriptor() ([]byte, []int) {
+	return file_flashsale_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetQueuePositionRequest) GetFlashSaleId() string {
+	if x != nil {
+		return x.FlashSaleId
+	}
+	return ""
+}
+
+func (x *GetQueuePositionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetQueuePositionResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Position          int64                  `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"` // 1부터 시작, 0이면 대기열에 없음
+	QueueLength       int64                  `protobuf:"varint,2,opt,name=queue_length,json=queueLength,proto3" json:"queue_length,omitempty"`
+	RemainingQuantity int32                  `protobuf:"varint,3,opt,name=remaining_quantity,json=remainingQuantity,proto3" json:"remaining_quantity,omitempty"`
+	SoldOut           bool                   `protobuf:"varint,4,opt,name=sold_out,json=soldOut,proto3" json:"sold_out,omitempty"` // true면 대기 중단 안내
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetQueuePositionResponse) Reset() {
+	*x = GetQueuePositionResponse{}
+	mi := &file_flashsale_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQueuePositionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQueuePositionResponse) ProtoMessage() {}
+
+func (x *GetQueuePositionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flashsale_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQueuePositionResponse.ProtoReflect.Descriptor instead.
+func (*GetQueuePositionResponse) Descriptor() ([]byte, []int) {
+	return file_flashsale_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetQueuePositionResponse) GetPosition() int64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *GetQueuePositionResponse) GetQueueLength() int64 {
+	if x != nil {
+		return x.QueueLength
+	}
+	return 0
+}
+
+func (x *GetQueuePositionResponse) GetRemainingQuantity() int32 {
+	if x != nil {
+		return x.RemainingQuantity
+	}
+	return 0
+}
+
+func (x *GetQueuePositionResponse) GetSoldOut() bool {
+	if x != nil {
+		return x.SoldOut
+	}
+	return false
+}
+
+type IssueQueueTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FlashSaleId   string                 `protobuf:"bytes,1,opt,name=flash_sale_id,json=flashSaleId,proto3" json:"flash_sale_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueQueueTokenRequest) Reset() {
+	*x = IssueQueueTokenRequest{}
+	mi := &file_flashsale_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueQueueTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueQueueTokenRequest) ProtoMessage() {}
+
+func (x *IssueQueueTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flashsale_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueQueueTokenRequest.ProtoReflect.Descriptor instead.
+func (*IssueQueueTokenRequest) Descriptor() ([]byte, []int) {
+	return file_flashsale_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *IssueQueueTokenRequest) GetFlashSaleId() string {
+	if x != nil {
+		return x.FlashSaleId
+	}
+	return ""
+}
+
+func (x *IssueQueueTokenRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type IssueQueueTokenResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	QueueToken           string                 `protobuf:"bytes,1,opt,name=queue_token,json=queueToken,proto3" json:"queue_token,omitempty"` // 불투명 토큰, x-queue-token 헤더로 전달
+	Position             int64                  `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	EstimatedWaitSeconds int64                  `protobuf:"varint,3,opt,name=estimated_wait_seconds,json=estimatedWaitSeconds,proto3" json:"estimated_wait_seconds,omitempty"`
+	Admitted             bool                   `protobuf:"varint,4,opt,name=admitted,proto3" json:"admitted,omitempty"` // true면 즉시 결제 진입 가능
+	ExpiresAt            string                 `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *IssueQueueTokenResponse) Reset() {
+	*x = IssueQueueTokenResponse{}
+	mi := &file_flashsale_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueQueueTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueQueueTokenResponse) ProtoMessage() {}
+
+func (x *IssueQueueTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flashsale_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueQueueTokenResponse.ProtoReflect.Descriptor instead.
+func (*IssueQueueTokenResponse) Descriptor() ([]byte, []int) {
+	return file_flashsale_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *IssueQueueTokenResponse) GetQueueToken() string {
+	if x != nil {
+		return x.QueueToken
+	}
+	return ""
+}
+
+func (x *IssueQueueTokenResponse) GetPosition() int64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *IssueQueueTokenResponse) GetEstimatedWaitSeconds() int64 {
+	if x != nil {
+		return x.EstimatedWaitSeconds
+	}
+	return 0
+}
+
+func (x *IssueQueueTokenResponse) GetAdmitted() bool {
+	if x != nil {
+		return x.Admitted
+	}
+	return false
+}
+
+func (x *IssueQueueTokenResponse) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type ValidateQueueTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QueueToken    string                 `protobuf:"bytes,1,opt,name=queue_token,json=queueToken,proto3" json:"queue_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateQueueTokenRequest) Reset() {
+	*x = ValidateQueueTokenRequest{}
+	mi := &file_flashsale_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateQueueTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateQueueTokenRequest) ProtoMessage() {}
+
+func (x *ValidateQueueTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flashsale_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateQueueTokenRequest.ProtoReflect.Descriptor instead.
+func (*ValidateQueueTokenRequest) Descriptor() ([]byte, []int) {
+	return file_flashsale_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ValidateQueueTokenRequest) GetQueueToken() string {
+	if x != nil {
+		return x.QueueToken
+	}
+	return ""
+}
+
+type ValidateQueueTokenResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Valid                bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Admitted             bool                   `protobuf:"varint,2,opt,name=admitted,proto3" json:"admitted,omitempty"` // valid && admitted 일 때만 결제 진입 허용
+	FlashSaleId          string                 `protobuf:"bytes,3,opt,name=flash_sale_id,json=flashSaleId,proto3" json:"flash_sale_id,omitempty"`
+	UserId               string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Position             int64                  `protobuf:"varint,5,opt,name=position,proto3" json:"position,omitempty"`
+	EstimatedWaitSeconds int64                  `protobuf:"varint,6,opt,name=estimated_wait_seconds,json=estimatedWaitSeconds,proto3" json:"estimated_wait_seconds,omitempty"`
+	ExpiresAt            string                 `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ValidateQueueTokenResponse) Reset() {
+	*x = ValidateQueueTokenResponse{}
+	mi := &file_flashsale_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateQueueTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateQueueTokenResponse) ProtoMessage() {}
+
+func (x *ValidateQueueTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flashsale_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateQueueTokenResponse.ProtoReflect.Descriptor instead.
+func (*ValidateQueueTokenResponse) Descriptor() ([]byte, []int) {
+	return file_flashsale_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ValidateQueueTokenResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateQueueTokenResponse) GetAdmitted() bool {
+	if x != nil {
+		return x.Admitted
+	}
+	return false
+}
+
+func (x *ValidateQueueTokenResponse) GetFlashSaleId() string {
+	if x != nil {
+		return x.FlashSaleId
+	}
+	return ""
+}
+
+func (x *ValidateQueueTokenResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ValidateQueueTokenResponse) GetPosition() int64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *ValidateQueueTokenResponse) GetEstimatedWaitSeconds() int64 {
+	if x != nil {
+		return x.EstimatedWaitSeconds
+	}
+	return 0
+}
+
+func (x *ValidateQueueTokenResponse) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+var File_flashsale_proto protoreflect.FileDescriptor
+
+const file_flashsale_proto_rawDesc = "" +
+	"\n" +
+	"\x0fflashsale.proto\x12\x17go.escape.ship.proto.v1\x1a\x1cgoogle/api/annotations.proto\"\xc9\x02\n" +
+	"\tFlashSale\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x1d\n" +
+	"\n" +
+	"sale_price\x18\x03 \x01(\x03R\tsalePrice\x12!\n" +
+	"\fquantity_cap\x18\x04 \x01(\x05R\vquantityCap\x12-\n" +
+	"\x12remaining_quantity\x18\x05 \x01(\x05R\x11remainingQuantity\x12\x19\n" +
+	"\bstart_at\x18\x06 \x01(\tR\astartAt\x12\x15\n" +
+	"\x06end_at\x18\a \x01(\tR\x05endAt\x12@\n" +
+	"\x06status\x18\b \x01(\x0e2(.go.escape.ship.proto.v1.FlashSaleStatusR\x06status\x12(\n" +
+	"\x10max_per_customer\x18\t \x01(\x05R\x0emaxPerCustomer\"\xd5\x01\n" +
+	"\x16CreateFlashSaleRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1d\n" +
+	"\n" +
+	"sale_price\x18\x02 \x01(\x03R\tsalePrice\x12!\n" +
+	"\fquantity_cap\x18\x03 \x01(\x05R\vquantityCap\x12\x19\n" +
+	"\bstart_at\x18\x04 \x01(\tR\astartAt\x12\x15\n" +
+	"\x06end_at\x18\x05 \x01(\tR\x05endAt\x12(\n" +
+	"\x10max_per_customer\x18\x06 \x01(\x05R\x0emaxPerCustomer\"\\\n" +
+	"\x17CreateFlashSaleResponse\x12A\n" +
+	"\n" +
+	"flash_sale\x18\x01 \x01(\v2\".go.escape.ship.proto.v1.FlashSaleR\tflashSale\"9\n" +
+	"\x13GetFlashSaleRequest\x12\"\n" +
+	"\rflash_sale_id\x18\x01 \x01(\tR\vflashSaleId\"Y\n" +
+	"\x14GetFlashSaleResponse\x12A\n" +
+	"\n" +
+	"flash_sale\x18\x01 \x01(\v2\".go.escape.ship.proto.v1.FlashSaleR\tflashSale\"V\n" +
+	"\x17GetQueuePositionRequest\x12\"\n" +
+	"\rflash_sale_id\x18\x01 \x01(\tR\vflashSaleId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\xa3\x01\n" +
+	"\x18GetQueuePositionResponse\x12\x1a\n" +
+	"\bposition\x18\x01 \x01(\x03R\bposition\x12!\n" +
+	"\fqueue_length\x18\x02 \x01(\x03R\vqueueLength\x12-\n" +
+	"\x12remaining_quantity\x18\x03 \x01(\x05R\x11remainingQuantity\x12\x19\n" +
+	"\bsold_out\x18\x04 \x01(\bR\asoldOut\"U\n" +
+	"\x16IssueQueueTokenRequest\x12\"\n" +
+	"\rflash_sale_id\x18\x01 \x01(\tR\vflashSaleId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\xc7\x01\n" +
+	"\x17IssueQueueTokenResponse\x12\x1f\n" +
+	"\vqueue_token\x18\x01 \x01(\tR\n" +
+	"queueToken\x12\x1a\n" +
+	"\bposition\x18\x02 \x01(\x03R\bposition\x124\n" +
+	"\x16estimated_wait_seconds\x18\x03 \x01(\x03R\x14estimatedWaitSeconds\x12\x1a\n" +
+	"\badmitted\x18\x04 \x01(\bR\badmitted\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\tR\texpiresAt\"<\n" +
+	"\x19ValidateQueueTokenRequest\x12\x1f\n" +
+	"\vqueue_token\x18\x01 \x01(\tR\n" +
+	"queueToken\"\xfc\x01\n" +
+	"\x1aValidateQueueTokenResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x1a\n" +
+	"\badmitted\x18\x02 \x01(\bR\badmitted\x12\"\n" +
+	"\rflash_sale_id\x18\x03 \x01(\tR\vflashSaleId\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bposition\x18\x05 \x01(\x03R\bposition\x124\n" +
+	"\x16estimated_wait_seconds\x18\x06 \x01(\x03R\x14estimatedWaitSeconds\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\tR\texpiresAt*\xb0\x01\n" +
+	"\x0fFlashSaleStatus\x12!\n" +
+	"\x1dFLASH_SALE_STATUS_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bFLASH_SALE_STATUS_SCHEDULED\x10\x01\x12\x1c\n" +
+	"\x18FLASH_SALE_STATUS_ACTIVE\x10\x02\x12\x1e\n" +
+	"\x1aFLASH_SALE_STATUS_SOLD_OUT\x10\x03\x12\x1b\n" +
+	"\x17FLASH_SALE_STATUS_ENDED\x10\x042\xc3\x06\n" +
+	"\x10FlashSaleService\x12\x90\x01\n" +
+	"\x0fCreateFlashSale\x12/.go.escape.ship.proto.v1.CreateFlashSaleRequest\x1a0.go.escape.ship.proto.v1.CreateFlashSaleResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/flash-sales\x12\x94\x01\n" +
+	"\fGetFlashSale\x12,.go.escape.ship.proto.v1.GetFlashSaleRequest\x1a-.go.escape.ship.proto.v1.GetFlashSaleResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/flash-sales/{flash_sale_id}\x12\xb0\x01\n" +
+	"\x10GetQueuePosition\x120.go.escape.ship.proto.v1.GetQueuePositionRequest\x1a1.go.escape.ship.proto.v1.GetQueuePositionResponse\"7\x82\xd3\xe4\x93\x021\x12//v1/flash-sales/{flash_sale_id}/queue/{user_id}\x12\xa6\x01\n" +
+	"\x0fIssueQueueToken\x12/.go.escape.ship.proto.v1.IssueQueueTokenRequest\x1a0.go.escape.ship.proto.v1.IssueQueueTokenResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/v1/flash-sales/{flash_sale_id}/queue\x12\xa8\x01\n" +
+	"\x12ValidateQueueToken\x122.go.escape.ship.proto.v1.ValidateQueueTokenRequest\x1a3.go.escape.ship.proto.v1.ValidateQueueTokenResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/flash-sales/queue/validateB#Z!github.com/escape-ship/protos/genb\x06proto3"
+
+var (
+	file_flashsale_proto_rawDescOnce sync.Once
+	file_flashsale_proto_rawDescData []byte
+)
+
+func file_flashsale_proto_rawDescGZIP() []byte {
+	file_flashsale_proto_rawDescOnce.Do(func() {
+		file_flashsale_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_flashsale_proto_rawDesc), len(file_flashsale_proto_rawDesc)))
+	})
+	return file_flashsale_proto_rawDescData
+}
+
+var file_flashsale_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_flashsale_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_flashsale_proto_goTypes = []any{
+	(FlashSaleStatus)(0),               // 0: go.escape.ship.proto.v1.FlashSaleStatus
+	(*FlashSale)(nil),                  // 1: go.escape.ship.proto.v1.FlashSale
+	(*CreateFlashSaleRequest)(nil),     // 2: go.escape.ship.proto.v1.CreateFlashSaleRequest
+	(*CreateFlashSaleResponse)(nil),    // 3: go.escape.ship.proto.v1.CreateFlashSaleResponse
+	(*GetFlashSaleRequest)(nil),        // 4: go.escape.ship.proto.v1.GetFlashSaleRequest
+	(*GetFlashSaleResponse)(nil),       // 5: go.escape.ship.proto.v1.GetFlashSaleResponse
+	(*GetQueuePositionRequest)(nil),    // 6: go.escape.ship.proto.v1.GetQueuePositionRequest
+	(*GetQueuePositionResponse)(nil),   // 7: go.escape.ship.proto.v1.GetQueuePositionResponse
+	(*IssueQueueTokenRequest)(nil),     // 8: go.escape.ship.proto.v1.IssueQueueTokenRequest
+	(*IssueQueueTokenResponse)(nil),    // 9: go.escape.ship.proto.v1.IssueQueueTokenResponse
+	(*ValidateQueueTokenRequest)(nil),  // 10: go.escape.ship.proto.v1.ValidateQueueTokenRequest
+	(*ValidateQueueTokenResponse)(nil), // 11: go.escape.ship.proto.v1.ValidateQueueTokenResponse
+}
+var file_flashsale_proto_depIdxs = []int32{
+	0,  // 0: go.escape.ship.proto.v1.FlashSale.status:type_name -> go.escape.ship.proto.v1.FlashSaleStatus
+	1,  // 1: go.escape.ship.proto.v1.CreateFlashSaleResponse.flash_sale:type_name -> go.escape.ship.proto.v1.FlashSale
+	1,  // 2: go.escape.ship.proto.v1.GetFlashSaleResponse.flash_sale:type_name -> go.escape.ship.proto.v1.FlashSale
+	2,  // 3: go.escape.ship.proto.v1.FlashSaleService.CreateFlashSale:input_type -> go.escape.ship.proto.v1.CreateFlashSaleRequest
+	4,  // 4: go.escape.ship.proto.v1.FlashSaleService.GetFlashSale:input_type -> go.escape.ship.proto.v1.GetFlashSaleRequest
+	6,  // 5: go.escape.ship.proto.v1.FlashSaleService.GetQueuePosition:input_type -> go.escape.ship.proto.v1.GetQueuePositionRequest
+	8,  // 6: go.escape.ship.proto.v1.FlashSaleService.IssueQueueToken:input_type -> go.escape.ship.proto.v1.IssueQueueTokenRequest
+	10, // 7: go.escape.ship.proto.v1.FlashSaleService.ValidateQueueToken:input_type -> go.escape.ship.proto.v1.ValidateQueueTokenRequest
+	3,  // 8: go.escape.ship.proto.v1.FlashSaleService.CreateFlashSale:output_type -> go.escape.ship.proto.v1.CreateFlashSaleResponse
+	5,  // 9: go.escape.ship.proto.v1.FlashSaleService.GetFlashSale:output_type -> go.escape.ship.proto.v1.GetFlashSaleResponse
+	7,  // 10: go.escape.ship.proto.v1.FlashSaleService.GetQueuePosition:output_type -> go.escape.ship.proto.v1.GetQueuePositionResponse
+	9,  // 11: go.escape.ship.proto.v1.FlashSaleService.IssueQueueToken:output_type -> go.escape.ship.proto.v1.IssueQueueTokenResponse
+	11, // 12: go.escape.ship.proto.v1.FlashSaleService.ValidateQueueToken:output_type -> go.escape.ship.proto.v1.ValidateQueueTokenResponse
+	8,  // [8:13] is the sub-list for method output_type
+	3,  // [3:8] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_flashsale_proto_init() }
+func file_flashsale_proto_init() {
+	if File_flashsale_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_flashsale_proto_rawDesc), len(file_flashsale_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_flashsale_proto_goTypes,
+		DependencyIndexes: file_flashsale_proto_depIdxs,
+		EnumInfos:         file_flashsale_proto_enumTypes,
+		MessageInfos:      file_flashsale_proto_msgTypes,
+	}.Build()
+	File_flashsale_proto = out.File
+	file_flashsale_proto_goTypes = nil
+	file_flashsale_proto_depIdxs = nil
+}
@@ -0,0 +1,1702 @@
+// Code generated by protoc-gen-twirp v8.1.3, DO NOT EDIT.
+// source: flashsale.proto
+
+package gen
+
+import context "context"
+import fmt "fmt"
+import http "net/http"
+import io "io"
+import json "encoding/json"
+import strconv "strconv"
+import strings "strings"
+
+import protojson "google.golang.org/protobuf/encoding/protojson"
+import proto "google.golang.org/protobuf/proto"
+import twirp "github.com/twitchtv/twirp"
+import ctxsetters "github.com/twitchtv/twirp/ctxsetters"
+
+// Version compatibility assertion.
+// If the constant is not defined in the package, that likely means
+// the package needs to be updated to work with this generated code.
+// See https://twitchtv.github.io/twirp/docs/version_matrix.html
+const _ = twirp.TwirpPackageMinVersion_8_1_0
+
+// ==========================
+// FlashSaleService Interface
+// ==========================
+
+// 한정 수량 타임세일: 판매 수량 상한과 대기열로 일반 주문 경로의 초과 판매를 방지
+type FlashSaleService interface {
+	CreateFlashSale(context.Context, *CreateFlashSaleRequest) (*CreateFlashSaleResponse, error)
+
+	GetFlashSale(context.Context, *GetFlashSaleRequest) (*GetFlashSaleResponse, error)
+
+	// 대기열 내 현재 순번 조회 (클라이언트 폴링용)
+	GetQueuePosition(context.Context, *GetQueuePositionRequest) (*GetQueuePositionResponse, error)
+
+	// 대기열 진입 및 대기열 토큰 발급 (재호출 시 기존 순번 유지)
+	IssueQueueToken(context.Context, *IssueQueueTokenRequest) (*IssueQueueTokenResponse, error)
+
+	// 게이트웨이가 결제/주문 진입 전 x-queue-token 헤더 값을 검증
+	ValidateQueueToken(context.Context, *ValidateQueueTokenRequest) (*ValidateQueueTokenResponse, error)
+}
+
+// ================================
+// FlashSaleService Protobuf Client
+// ================================
+
+type flashSaleServiceProtobufClient struct {
+	client      HTTPClient
+	urls        [5]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewFlashSaleServiceProtobufClient creates a Protobuf client that implements the FlashSaleService interface.
+// It communicates using Protobuf and can be configured with a custom HTTPClient.
+func NewFlashSaleServiceProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) FlashSaleService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "go.escape.ship.proto.v1", "FlashSaleService")
+	urls := [5]string{
+		serviceURL + "CreateFlashSale",
+		serviceURL + "GetFlashSale",
+		serviceURL + "GetQueuePosition",
+		serviceURL + "IssueQueueToken",
+		serviceURL + "ValidateQueueToken",
+	}
+
+	return &flashSaleServiceProtobufClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *flashSaleServiceProtobufClient) CreateFlashSale(ctx context.Context, in *CreateFlashSaleRequest) (*CreateFlashSaleResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "FlashSaleService")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateFlashSale")
+	caller := c.callCreateFlashSale
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateFlashSaleRequest) (*CreateFlashSaleResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateFlashSaleRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateFlashSaleRequest) when calling interceptor")
+					}
+					return c.callCreateFlashSale(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateFlashSaleResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateFlashSaleResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *flashSaleServiceProtobufClient) callCreateFlashSale(ctx context.Context, in *CreateFlashSaleRequest) (*CreateFlashSaleResponse, error) {
+	out := new(CreateFlashSaleResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *flashSaleServiceProtobufClient) GetFlashSale(ctx context.Context, in *GetFlashSaleRequest) (*GetFlashSaleResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "FlashSaleService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetFlashSale")
+	caller := c.callGetFlashSale
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetFlashSaleRequest) (*GetFlashSaleResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetFlashSaleRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetFlashSaleRequest) when calling interceptor")
+					}
+					return c.callGetFlashSale(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetFlashSaleResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetFlashSaleResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *flashSaleServiceProtobufClient) callGetFlashSale(ctx context.Context, in *GetFlashSaleRequest) (*GetFlashSaleResponse, error) {
+	out := new(GetFlashSaleResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *flashSaleServiceProtobufClient) GetQueuePosition(ctx context.Context, in *GetQueuePositionRequest) (*GetQueuePositionResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "FlashSaleService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetQueuePosition")
+	caller := c.callGetQueuePosition
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetQueuePositionRequest) (*GetQueuePositionResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetQueuePositionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetQueuePositionRequest) when calling interceptor")
+					}
+					return c.callGetQueuePosition(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetQueuePositionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetQueuePositionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *flashSaleServiceProtobufClient) callGetQueuePosition(ctx context.Context, in *GetQueuePositionRequest) (*GetQueuePositionResponse, error) {
+	out := new(GetQueuePositionResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *flashSaleServiceProtobufClient) IssueQueueToken(ctx context.Context, in *IssueQueueTokenRequest) (*IssueQueueTokenResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "FlashSaleService")
+	ctx = ctxsetters.WithMethodName(ctx, "IssueQueueToken")
+	caller := c.callIssueQueueToken
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *IssueQueueTokenRequest) (*IssueQueueTokenResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*IssueQueueTokenRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*IssueQueueTokenRequest) when calling interceptor")
+					}
+					return c.callIssueQueueToken(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*IssueQueueTokenResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*IssueQueueTokenResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *flashSaleServiceProtobufClient) callIssueQueueToken(ctx context.Context, in *IssueQueueTokenRequest) (*IssueQueueTokenResponse, error) {
+	out := new(IssueQueueTokenResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *flashSaleServiceProtobufClient) ValidateQueueToken(ctx context.Context, in *ValidateQueueTokenRequest) (*ValidateQueueTokenResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "FlashSaleService")
+	ctx = ctxsetters.WithMethodName(ctx, "ValidateQueueToken")
+	caller := c.callValidateQueueToken
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ValidateQueueTokenRequest) (*ValidateQueueTokenResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ValidateQueueTokenRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ValidateQueueTokenRequest) when calling interceptor")
+					}
+					return c.callValidateQueueToken(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ValidateQueueTokenResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ValidateQueueTokenResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *flashSaleServiceProtobufClient) callValidateQueueToken(ctx context.Context, in *ValidateQueueTokenRequest) (*ValidateQueueTokenResponse, error) {
+	out := new(ValidateQueueTokenResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ============================
+// FlashSaleService JSON Client
+// ============================
+
+type flashSaleServiceJSONClient struct {
+	client      HTTPClient
+	urls        [5]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewFlashSaleServiceJSONClient creates a JSON client that implements the FlashSaleService interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewFlashSaleServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) FlashSaleService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "go.escape.ship.proto.v1", "FlashSaleService")
+	urls := [5]string{
+		serviceURL + "CreateFlashSale",
+		serviceURL + "GetFlashSale",
+		serviceURL + "GetQueuePosition",
+		serviceURL + "IssueQueueToken",
+		serviceURL + "ValidateQueueToken",
+	}
+
+	return &flashSaleServiceJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *flashSaleServiceJSONClient) CreateFlashSale(ctx context.Context, in *CreateFlashSaleRequest) (*CreateFlashSaleResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "FlashSaleService")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateFlashSale")
+	caller := c.callCreateFlashSale
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateFlashSaleRequest) (*CreateFlashSaleResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateFlashSaleRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateFlashSaleRequest) when calling interceptor")
+					}
+					return c.callCreateFlashSale(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateFlashSaleResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateFlashSaleResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *flashSaleServiceJSONClient) callCreateFlashSale(ctx context.Context, in *CreateFlashSaleRequest) (*CreateFlashSaleResponse, error) {
+	out := new(CreateFlashSaleResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *flashSaleServiceJSONClient) GetFlashSale(ctx context.Context, in *GetFlashSaleRequest) (*GetFlashSaleResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "FlashSaleService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetFlashSale")
+	caller := c.callGetFlashSale
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetFlashSaleRequest) (*GetFlashSaleResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetFlashSaleRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetFlashSaleRequest) when calling interceptor")
+					}
+					return c.callGetFlashSale(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetFlashSaleResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetFlashSaleResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *flashSaleServiceJSONClient) callGetFlashSale(ctx context.Context, in *GetFlashSaleRequest) (*GetFlashSaleResponse, error) {
+	out := new(GetFlashSaleResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *flashSaleServiceJSONClient) GetQueuePosition(ctx context.Context, in *GetQueuePositionRequest) (*GetQueuePositionResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "FlashSaleService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetQueuePosition")
+	caller := c.callGetQueuePosition
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetQueuePositionRequest) (*GetQueuePositionResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetQueuePositionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetQueuePositionRequest) when calling interceptor")
+					}
+					return c.callGetQueuePosition(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetQueuePositionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetQueuePositionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *flashSaleServiceJSONClient) callGetQueuePosition(ctx context.Context, in *GetQueuePositionRequest) (*GetQueuePositionResponse, error) {
+	out := new(GetQueuePositionResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *flashSaleServiceJSONClient) IssueQueueToken(ctx context.Context, in *IssueQueueTokenRequest) (*IssueQueueTokenResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "FlashSaleService")
+	ctx = ctxsetters.WithMethodName(ctx, "IssueQueueToken")
+	caller := c.callIssueQueueToken
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *IssueQueueTokenRequest) (*IssueQueueTokenResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*IssueQueueTokenRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*IssueQueueTokenRequest) when calling interceptor")
+					}
+					return c.callIssueQueueToken(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*IssueQueueTokenResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*IssueQueueTokenResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *flashSaleServiceJSONClient) callIssueQueueToken(ctx context.Context, in *IssueQueueTokenRequest) (*IssueQueueTokenResponse, error) {
+	out := new(IssueQueueTokenResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *flashSaleServiceJSONClient) ValidateQueueToken(ctx context.Context, in *ValidateQueueTokenRequest) (*ValidateQueueTokenResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "FlashSaleService")
+	ctx = ctxsetters.WithMethodName(ctx, "ValidateQueueToken")
+	caller := c.callValidateQueueToken
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ValidateQueueTokenRequest) (*ValidateQueueTokenResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ValidateQueueTokenRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ValidateQueueTokenRequest) when calling interceptor")
+					}
+					return c.callValidateQueueToken(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ValidateQueueTokenResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ValidateQueueTokenResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *flashSaleServiceJSONClient) callValidateQueueToken(ctx context.Context, in *ValidateQueueTokenRequest) (*ValidateQueueTokenResponse, error) {
+	out := new(ValidateQueueTokenResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ===============================
+// FlashSaleService Server Handler
+// ===============================
+
+type flashSaleServiceServer struct {
+	FlashSaleService
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewFlashSaleServiceServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewFlashSaleServiceServer(svc FlashSaleService, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	return &flashSaleServiceServer{
+		FlashSaleService: svc,
+		hooks:            serverOpts.Hooks,
+		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:       pathPrefix,
+		jsonSkipDefaults: jsonSkipDefaults,
+		jsonCamelCase:    jsonCamelCase,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *flashSaleServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *flashSaleServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
+
+// FlashSaleServicePathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const FlashSaleServicePathPrefix = "/twirp/go.escape.ship.proto.v1.FlashSaleService/"
+
+func (s *flashSaleServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "FlashSaleService")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "go.escape.ship.proto.v1.FlashSaleService" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "CreateFlashSale":
+		s.serveCreateFlashSale(ctx, resp, req)
+		return
+	case "GetFlashSale":
+		s.serveGetFlashSale(ctx, resp, req)
+		return
+	case "GetQueuePosition":
+		s.serveGetQueuePosition(ctx, resp, req)
+		return
+	case "IssueQueueToken":
+		s.serveIssueQueueToken(ctx, resp, req)
+		return
+	case "ValidateQueueToken":
+		s.serveValidateQueueToken(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *flashSaleServiceServer) serveCreateFlashSale(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveCreateFlashSaleJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveCreateFlashSaleProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *flashSaleServiceServer) serveCreateFlashSaleJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateFlashSale")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(CreateFlashSaleRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.FlashSaleService.CreateFlashSale
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateFlashSaleRequest) (*CreateFlashSaleResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateFlashSaleRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateFlashSaleRequest) when calling interceptor")
+					}
+					return s.FlashSaleService.CreateFlashSale(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateFlashSaleResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateFlashSaleResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreateFlashSaleResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateFlashSaleResponse and nil error while calling CreateFlashSale. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *flashSaleServiceServer) serveCreateFlashSaleProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateFlashSale")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(CreateFlashSaleRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.FlashSaleService.CreateFlashSale
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateFlashSaleRequest) (*CreateFlashSaleResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateFlashSaleRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateFlashSaleRequest) when calling interceptor")
+					}
+					return s.FlashSaleService.CreateFlashSale(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateFlashSaleResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateFlashSaleResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreateFlashSaleResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateFlashSaleResponse and nil error while calling CreateFlashSale. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *flashSaleServiceServer) serveGetFlashSale(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetFlashSaleJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetFlashSaleProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *flashSaleServiceServer) serveGetFlashSaleJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetFlashSale")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetFlashSaleRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.FlashSaleService.GetFlashSale
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetFlashSaleRequest) (*GetFlashSaleResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetFlashSaleRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetFlashSaleRequest) when calling interceptor")
+					}
+					return s.FlashSaleService.GetFlashSale(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetFlashSaleResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetFlashSaleResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetFlashSaleResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetFlashSaleResponse and nil error while calling GetFlashSale. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *flashSaleServiceServer) serveGetFlashSaleProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetFlashSale")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetFlashSaleRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.FlashSaleService.GetFlashSale
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetFlashSaleRequest) (*GetFlashSaleResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetFlashSaleRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetFlashSaleRequest) when calling interceptor")
+					}
+					return s.FlashSaleService.GetFlashSale(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetFlashSaleResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetFlashSaleResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetFlashSaleResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetFlashSaleResponse and nil error while calling GetFlashSale. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *flashSaleServiceServer) serveGetQueuePosition(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetQueuePositionJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetQueuePositionProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *flashSaleServiceServer) serveGetQueuePositionJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetQueuePosition")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetQueuePositionRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.FlashSaleService.GetQueuePosition
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetQueuePositionRequest) (*GetQueuePositionResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetQueuePositionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetQueuePositionRequest) when calling interceptor")
+					}
+					return s.FlashSaleService.GetQueuePosition(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetQueuePositionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetQueuePositionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetQueuePositionResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetQueuePositionResponse and nil error while calling GetQueuePosition. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *flashSaleServiceServer) serveGetQueuePositionProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetQueuePosition")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetQueuePositionRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.FlashSaleService.GetQueuePosition
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetQueuePositionRequest) (*GetQueuePositionResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetQueuePositionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetQueuePositionRequest) when calling interceptor")
+					}
+					return s.FlashSaleService.GetQueuePosition(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetQueuePositionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetQueuePositionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetQueuePositionResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetQueuePositionResponse and nil error while calling GetQueuePosition. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *flashSaleServiceServer) serveIssueQueueToken(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveIssueQueueTokenJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveIssueQueueTokenProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *flashSaleServiceServer) serveIssueQueueTokenJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "IssueQueueToken")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(IssueQueueTokenRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.FlashSaleService.IssueQueueToken
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *IssueQueueTokenRequest) (*IssueQueueTokenResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*IssueQueueTokenRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*IssueQueueTokenRequest) when calling interceptor")
+					}
+					return s.FlashSaleService.IssueQueueToken(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*IssueQueueTokenResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*IssueQueueTokenResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *IssueQueueTokenResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *IssueQueueTokenResponse and nil error while calling IssueQueueToken. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *flashSaleServiceServer) serveIssueQueueTokenProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "IssueQueueToken")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(IssueQueueTokenRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.FlashSaleService.IssueQueueToken
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *IssueQueueTokenRequest) (*IssueQueueTokenResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*IssueQueueTokenRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*IssueQueueTokenRequest) when calling interceptor")
+					}
+					return s.FlashSaleService.IssueQueueToken(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*IssueQueueTokenResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*IssueQueueTokenResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *IssueQueueTokenResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *IssueQueueTokenResponse and nil error while calling IssueQueueToken. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *flashSaleServiceServer) serveValidateQueueToken(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveValidateQueueTokenJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveValidateQueueTokenProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *flashSaleServiceServer) serveValidateQueueTokenJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ValidateQueueToken")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ValidateQueueTokenRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.FlashSaleService.ValidateQueueToken
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ValidateQueueTokenRequest) (*ValidateQueueTokenResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ValidateQueueTokenRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ValidateQueueTokenRequest) when calling interceptor")
+					}
+					return s.FlashSaleService.ValidateQueueToken(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ValidateQueueTokenResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ValidateQueueTokenResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ValidateQueueTokenResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ValidateQueueTokenResponse and nil error while calling ValidateQueueToken. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *flashSaleServiceServer) serveValidateQueueTokenProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ValidateQueueToken")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ValidateQueueTokenRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.FlashSaleService.ValidateQueueToken
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ValidateQueueTokenRequest) (*ValidateQueueTokenResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ValidateQueueTokenRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ValidateQueueTokenRequest) when calling interceptor")
+					}
+					return s.FlashSaleService.ValidateQueueToken(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ValidateQueueTokenResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ValidateQueueTokenResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ValidateQueueTokenResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ValidateQueueTokenResponse and nil error while calling ValidateQueueToken. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *flashSaleServiceServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor4, 0
+}
+
+func (s *flashSaleServiceServer) ProtocGenTwirpVersion() string {
+	return "v8.1.3"
+}
+
+// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
+// that is everything in a Twirp route except for the <Method>. This can be used for routing,
+// for example to identify the requests that are targeted to this service in a mux.
+func (s *flashSaleServiceServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "go.escape.ship.proto.v1", "FlashSaleService")
+}
+
+var twirpFileDescriptor4 = []byte{
+	// 973 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0xc6, 0x49, 0xf3, 0x77, 0xba, 0x6c, 0xc2, 0x50, 0x1a, 0xaf, 0xf7, 0xa7, 0xad, 0x57, 0x88,
+	0x50, 0xd1, 0xb8, 0xed, 0x22, 0x21, 0x10, 0x17, 0x84, 0x24, 0xdd, 0x8d, 0x14, 0x6d, 0xbb, 0xf9,
+	0x29, 0x02, 0x21, 0x59, 0xb3, 0xf1, 0x6c, 0x6a, 0x91, 0xd8, 0xae, 0x67, 0x1c, 0x8a, 0x56, 0x7b,
+	0xc3, 0x13, 0x20, 0xc1, 0x1b, 0x20, 0x21, 0x2e, 0xf7, 0x1d, 0xb8, 0x40, 0x5c, 0x23, 0xde, 0x80,
+	0xc7, 0xe0, 0x02, 0xcd, 0x78, 0x9a, 0x34, 0xb6, 0x93, 0xa6, 0xd2, 0xde, 0x79, 0xce, 0xdf, 0x7c,
+	0xe7, 0x3b, 0x73, 0x3e, 0x43, 0xf1, 0xc5, 0x08, 0xd3, 0x33, 0x8a, 0x47, 0xa4, 0xea, 0xf9, 0x2e,
+	0x73, 0x51, 0x79, 0xe8, 0x56, 0x09, 0x1d, 0x60, 0x8f, 0x54, 0xe9, 0x99, 0xed, 0x85, 0xd6, 0xea,
+	0xe4, 0x40, 0xbb, 0x37, 0x74, 0xdd, 0xe1, 0x88, 0x18, 0xd8, 0xb3, 0x0d, 0xec, 0x38, 0x2e, 0xc3,
+	0xcc, 0x76, 0x1d, 0x1a, 0x06, 0xe8, 0x7f, 0xa5, 0xa0, 0x70, 0xc4, 0x4b, 0x75, 0xf1, 0x88, 0xa0,
+	0xdb, 0x90, 0xb2, 0x2d, 0x55, 0xd9, 0x56, 0x2a, 0x85, 0x4e, 0xca, 0xb6, 0xd0, 0x7d, 0x00, 0xcf,
+	0x77, 0xad, 0x60, 0xc0, 0x4c, 0xdb, 0x52, 0x53, 0xc2, 0x5e, 0x90, 0x96, 0x96, 0x70, 0x73, 0x04,
+	0xa6, 0xe7, 0xdb, 0x03, 0xa2, 0xa6, 0xb7, 0x95, 0x4a, 0xba, 0x53, 0xe0, 0x96, 0x13, 0x6e, 0x40,
+	0x3b, 0x70, 0xeb, 0x3c, 0xc0, 0x0e, 0xb3, 0xd9, 0x0f, 0xe6, 0x00, 0x7b, 0xea, 0xda, 0xb6, 0x52,
+	0xc9, 0x74, 0xd6, 0x2f, 0x6d, 0x75, 0xec, 0xa1, 0x3d, 0x40, 0x3e, 0x19, 0x63, 0xdb, 0xb1, 0x9d,
+	0xa1, 0x79, 0xe9, 0x50, 0x33, 0x22, 0xf0, 0x9d, 0xa9, 0xe7, 0x99, 0x74, 0xa0, 0x3b, 0x90, 0xa7,
+	0x0c, 0xfb, 0xcc, 0xc4, 0x4c, 0xcd, 0x0a, 0x34, 0x39, 0x71, 0xae, 0x31, 0xf4, 0x1e, 0x64, 0x89,
+	0x63, 0x71, 0x47, 0x4e, 0x38, 0x32, 0xc4, 0xb1, 0x6a, 0x0c, 0x7d, 0x01, 0x59, 0xca, 0x30, 0x0b,
+	0xa8, 0x9a, 0xdf, 0x56, 0x2a, 0xb7, 0x0f, 0x2b, 0xd5, 0x05, 0x3c, 0x55, 0xa7, 0x2c, 0x74, 0x45,
+	0x7c, 0x47, 0xe6, 0xa1, 0x0a, 0x94, 0xc6, 0xf8, 0xc2, 0xf4, 0x88, 0x6f, 0x0e, 0x02, 0xca, 0xdc,
+	0x31, 0xf1, 0xd5, 0x82, 0x00, 0x78, 0x7b, 0x8c, 0x2f, 0x4e, 0x88, 0x5f, 0x97, 0x56, 0xfd, 0x1f,
+	0x05, 0x36, 0xeb, 0x3e, 0xc1, 0x8c, 0x4c, 0x6b, 0x75, 0xc8, 0x79, 0x40, 0x28, 0x8b, 0x10, 0xa9,
+	0x2c, 0x27, 0x32, 0x75, 0x1d, 0x91, 0xe9, 0x38, 0x91, 0x57, 0x99, 0x59, 0x5b, 0xc4, 0x4c, 0xe6,
+	0x2a, 0x33, 0x49, 0x7d, 0x65, 0x13, 0xfb, 0xfa, 0x16, 0xca, 0xb1, 0xb6, 0xa8, 0xe7, 0x3a, 0x94,
+	0xa0, 0x1a, 0x80, 0x78, 0x88, 0x26, 0x07, 0x2b, 0xfa, 0x5a, 0x3f, 0xd4, 0xaf, 0xa7, 0xb8, 0x53,
+	0x78, 0x71, 0xf9, 0xa9, 0x7f, 0x0a, 0xef, 0x3e, 0x26, 0x2c, 0xc6, 0x98, 0x0e, 0x6f, 0xcf, 0x2a,
+	0xcf, 0x48, 0x5b, 0x9f, 0x26, 0xb6, 0x2c, 0xfd, 0x6b, 0xd8, 0x98, 0x4f, 0x7d, 0x73, 0xa8, 0x4e,
+	0xa1, 0xfc, 0x98, 0xb0, 0x67, 0x01, 0x09, 0xc8, 0x89, 0x4b, 0x6d, 0xbe, 0x32, 0x37, 0x40, 0x86,
+	0xca, 0x90, 0x0b, 0x28, 0xf1, 0x67, 0x5b, 0x93, 0xe5, 0xc7, 0x96, 0xa5, 0xff, 0xaa, 0x80, 0x1a,
+	0x2f, 0x2c, 0x71, 0x6b, 0x90, 0xf7, 0xa4, 0x4d, 0x14, 0x4d, 0x77, 0xa6, 0xe7, 0xf0, 0x0d, 0x90,
+	0x80, 0x98, 0x23, 0xe2, 0x0c, 0xd9, 0x99, 0x7c, 0x24, 0xeb, 0xc2, 0xd6, 0x16, 0xa6, 0x05, 0xcb,
+	0x94, 0x5e, 0xb6, 0x4c, 0xee, 0xc8, 0x32, 0xdd, 0x20, 0x7c, 0x32, 0xf9, 0x4e, 0x8e, 0x9f, 0x8f,
+	0x03, 0xa6, 0xf7, 0x61, 0xb3, 0x45, 0x69, 0x40, 0x04, 0xcc, 0x9e, 0xfb, 0x1d, 0x79, 0x33, 0xcd,
+	0xff, 0xa9, 0x40, 0x39, 0x56, 0x57, 0xf6, 0xbe, 0x05, 0x61, 0x2f, 0x26, 0xe3, 0x66, 0x59, 0x16,
+	0xce, 0xa7, 0x81, 0x73, 0xe4, 0xa4, 0x22, 0xe4, 0x7c, 0x0c, 0x9b, 0x84, 0x32, 0x7b, 0x8c, 0x19,
+	0xb1, 0xcc, 0xef, 0xb1, 0xcd, 0x4c, 0x4a, 0x06, 0xae, 0x63, 0x51, 0x29, 0x4a, 0x1b, 0x53, 0xef,
+	0x57, 0xd8, 0x66, 0xdd, 0xd0, 0xc7, 0x2b, 0x62, 0x6b, 0x6c, 0x33, 0x46, 0x2c, 0x49, 0xc0, 0xf4,
+	0xcc, 0x37, 0x92, 0x5c, 0x78, 0xb6, 0x4f, 0xe8, 0x6c, 0x71, 0x0a, 0xd2, 0x52, 0x63, 0xfa, 0xe7,
+	0x70, 0xe7, 0x14, 0x8f, 0x6c, 0x0b, 0xb3, 0x04, 0x8e, 0xae, 0x6b, 0x45, 0xff, 0x4f, 0x01, 0x2d,
+	0x29, 0x5d, 0x52, 0xb1, 0x01, 0x99, 0x09, 0xf7, 0x8a, 0xcc, 0x7c, 0x27, 0x3c, 0xcc, 0xa1, 0x4d,
+	0x45, 0xd0, 0xc6, 0xa6, 0x92, 0x5e, 0x3a, 0x95, 0xb5, 0xab, 0x53, 0x99, 0x23, 0x36, 0xb3, 0x32,
+	0xb1, 0xd9, 0x25, 0xc4, 0xce, 0x93, 0x97, 0x8b, 0x90, 0xb7, 0xfb, 0x5a, 0x81, 0x62, 0x44, 0x6d,
+	0xd1, 0x0e, 0xdc, 0x3f, 0x6a, 0xd7, 0xba, 0x4f, 0xcc, 0x6e, 0xad, 0xdd, 0x34, 0xbb, 0xbd, 0x5a,
+	0xaf, 0xdf, 0x35, 0xfb, 0x4f, 0xbb, 0x27, 0xcd, 0x7a, 0xeb, 0xa8, 0xd5, 0x6c, 0x94, 0xde, 0x42,
+	0x5b, 0x70, 0x37, 0x1e, 0xd2, 0xad, 0x3f, 0x69, 0x36, 0xfa, 0xed, 0x66, 0xa3, 0xa4, 0xa0, 0x7b,
+	0xa0, 0xc6, 0x03, 0x6a, 0xf5, 0x5e, 0xeb, 0xb4, 0x59, 0x4a, 0xa1, 0x07, 0xa0, 0x25, 0xa4, 0x1f,
+	0xb7, 0x1b, 0xe6, 0x71, 0xbf, 0x57, 0x4a, 0xa3, 0xbb, 0x50, 0x8e, 0xfb, 0x9b, 0x4f, 0x1b, 0xcd,
+	0x46, 0x69, 0xed, 0xf0, 0x8f, 0x2c, 0x94, 0x66, 0x90, 0x89, 0x3f, 0xe1, 0xb2, 0xfc, 0x93, 0x02,
+	0xc5, 0x88, 0x30, 0x22, 0x63, 0xa1, 0xcc, 0x24, 0xff, 0x19, 0xb4, 0xfd, 0xd5, 0x13, 0xc2, 0xe7,
+	0xa1, 0x6b, 0x3f, 0xfe, 0xfd, 0xef, 0xcf, 0xa9, 0x8d, 0xcf, 0x94, 0x5d, 0xbd, 0x68, 0x4c, 0x0e,
+	0x0c, 0x31, 0xe4, 0x3d, 0x3e, 0x79, 0x8a, 0x7e, 0x51, 0xe0, 0xd6, 0x55, 0x49, 0x44, 0x1f, 0x2d,
+	0x2c, 0x9f, 0x20, 0xba, 0xda, 0xde, 0x8a, 0xd1, 0x12, 0xc9, 0x07, 0x02, 0xc9, 0x0e, 0xda, 0x8a,
+	0xc0, 0x30, 0x5e, 0xce, 0xbd, 0xc6, 0x57, 0xe8, 0xb5, 0x02, 0xa5, 0xa8, 0xea, 0xa1, 0xfd, 0x65,
+	0x97, 0x25, 0x29, 0xaf, 0x76, 0x70, 0x83, 0x0c, 0x09, 0xf1, 0x13, 0x01, 0xf1, 0x00, 0x19, 0xd7,
+	0x40, 0x34, 0xc4, 0x7a, 0x1a, 0x2f, 0xe5, 0x8a, 0xbc, 0x42, 0xbf, 0x29, 0x50, 0x8c, 0x68, 0xd5,
+	0x92, 0xe1, 0x26, 0xab, 0xa5, 0xb6, 0xbf, 0x7a, 0x82, 0xc4, 0xbb, 0x2f, 0xf0, 0xee, 0xf2, 0xe1,
+	0xbe, 0xbf, 0x12, 0x64, 0xf4, 0xbb, 0x02, 0x28, 0x2e, 0x26, 0xe8, 0x70, 0xe1, 0xd5, 0x0b, 0x85,
+	0x4b, 0x7b, 0x74, 0xa3, 0x1c, 0x89, 0xf8, 0x43, 0x81, 0xf8, 0x21, 0x47, 0xfc, 0x20, 0x8a, 0x38,
+	0x24, 0x75, 0x22, 0x93, 0xbf, 0x7c, 0xf8, 0xcd, 0xce, 0xd0, 0x66, 0x67, 0xc1, 0xf3, 0xea, 0xc0,
+	0x1d, 0x1b, 0xe1, 0x45, 0x7b, 0xfc, 0x22, 0x43, 0x5c, 0x44, 0x8d, 0x21, 0x71, 0x9e, 0x67, 0xc5,
+	0xf7, 0xa3, 0xff, 0x07, 0x00, 0x72, 0xab, 0xf3, 0x56, 0xe2, 0x0a, 0x00, 0x00,
+}
@@ -0,0 +1,283 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: flashsale.proto
+
+package gen
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	FlashSaleService_CreateFlashSale_FullMethodName    = "/go.escape.ship.proto.v1.FlashSaleService/CreateFlashSale"
+	FlashSaleService_GetFlashSale_FullMethodName       = "/go.escape.ship.proto.v1.FlashSaleService/GetFlashSale"
+	FlashSaleService_GetQueuePosition_FullMethodName   = "/go.escape.ship.proto.v1.FlashSaleService/GetQueuePosition"
+	FlashSaleService_IssueQueueToken_FullMethodName    = "/go.escape.ship.proto.v1.FlashSaleService/IssueQueueToken"
+	FlashSaleService_ValidateQueueToken_FullMethodName = "/go.escape.ship.proto.v1.FlashSaleService/ValidateQueueToken"
+)
+
+// FlashSaleServiceClient is the client API for FlashSaleService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 한정 수량 타임세일: 판매 수량 상한과 대기열로 일반 주문 경로의 초과 판매를 방지
+type FlashSaleServiceClient interface {
+	CreateFlashSale(ctx context.Context, in *CreateFlashSaleRequest, opts ...grpc.CallOption) (*CreateFlashSaleResponse, error)
+	GetFlashSale(ctx context.Context, in *GetFlashSaleRequest, opts ...grpc.CallOption) (*GetFlashSaleResponse, error)
+	// 대기열 내 현재 순번 조회 (클라이언트 폴링용)
+	GetQueuePosition(ctx context.Context, in *GetQueuePositionRequest, opts ...grpc.CallOption) (*GetQueuePositionResponse, error)
+	// 대기열 진입 및 대기열 토큰 발급 (재호출 시 기존 순번 유지)
+	IssueQueueToken(ctx context.Context, in *IssueQueueTokenRequest, opts ...grpc.CallOption) (*IssueQueueTokenResponse, error)
+	// 게이트웨이가 결제/주문 진입 전 x-queue-token 헤더 값을 검증
+	ValidateQueueToken(ctx context.Context, in *ValidateQueueTokenRequest, opts ...grpc.CallOption) (*ValidateQueueTokenResponse, error)
+}
+
+type flashSaleServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFlashSaleServiceClient(cc grpc.ClientConnInterface) FlashSaleServiceClient {
+	return &flashSaleServiceClient{cc}
+}
+
+func (c *flashSaleServiceClient) CreateFlashSale(ctx context.Context, in *CreateFlashSaleRequest, opts ...grpc.CallOption) (*CreateFlashSaleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateFlashSaleResponse)
+	err := c.cc.Invoke(ctx, FlashSaleService_CreateFlashSale_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *flashSaleServiceClient) GetFlashSale(ctx context.Context, in *GetFlashSaleRequest, opts ...grpc.CallOption) (*GetFlashSaleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFlashSaleResponse)
+	err := c.cc.Invoke(ctx, FlashSaleService_GetFlashSale_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *flashSaleServiceClient) GetQueuePosition(ctx context.Context, in *GetQueuePositionRequest, opts ...grpc.CallOption) (*GetQueuePositionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetQueuePositionResponse)
+	err := c.cc.Invoke(ctx, FlashSaleService_GetQueuePosition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *flashSaleServiceClient) IssueQueueToken(ctx context.Context, in *IssueQueueTokenRequest, opts ...grpc.CallOption) (*IssueQueueTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IssueQueueTokenResponse)
+	err := c.cc.Invoke(ctx, FlashSaleService_IssueQueueToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *flashSaleServiceClient) ValidateQueueToken(ctx context.Context, in *ValidateQueueTokenRequest, opts ...grpc.CallOption) (*ValidateQueueTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateQueueTokenResponse)
+	err := c.cc.Invoke(ctx, FlashSaleService_ValidateQueueToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FlashSaleServiceServer is the server API for FlashSaleService service.
+// All implementations must embed UnimplementedFlashSaleServiceServer
+// for forward compatibility.
+//
+// 한정 수량 타임세일: 판매 수량 상한과 대기열로 일반 주문 경로의 초과 판매를 방지
+type FlashSaleServiceServer interface {
+	CreateFlashSale(context.Context, *CreateFlashSaleRequest) (*CreateFlashSaleResponse, error)
+	GetFlashSale(context.Context, *GetFlashSaleRequest) (*GetFlashSaleResponse, error)
+	// 대기열 내 현재 순번 조회 (클라이언트 폴링용)
+	GetQueuePosition(context.Context, *GetQueuePositionRequest) (*GetQueuePositionResponse, error)
+	// 대기열 진입 및 대기열 토큰 발급 (재호출 시 기존 순번 유지)
+	IssueQueueToken(context.Context, *IssueQueueTokenRequest) (*IssueQueueTokenResponse, error)
+	// 게이트웨이가 결제/주문 진입 전 x-queue-token 헤더 값을 검증
+	ValidateQueueToken(context.Context, *ValidateQueueTokenRequest) (*ValidateQueueTokenResponse, error)
+	mustEmbedUnimplementedFlashSaleServiceServer()
+}
+
+// UnimplementedFlashSaleServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFlashSaleServiceServer struct{}
+
+func (UnimplementedFlashSaleServiceServer) CreateFlashSale(context.Context, *CreateFlashSaleRequest) (*CreateFlashSaleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFlashSale not implemented")
+}
+func (UnimplementedFlashSaleServiceServer) GetFlashSale(context.Context, *GetFlashSaleRequest) (*GetFlashSaleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFlashSale not implemented")
+}
+func (UnimplementedFlashSaleServiceServer) GetQueuePosition(context.Context, *GetQueuePositionRequest) (*GetQueuePositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQueuePosition not implemented")
+}
+func (UnimplementedFlashSaleServiceServer) IssueQueueToken(context.Context, *IssueQueueTokenRequest) (*IssueQueueTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueQueueToken not implemented")
+}
+func (UnimplementedFlashSaleServiceServer) ValidateQueueToken(context.Context, *ValidateQueueTokenRequest) (*ValidateQueueTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateQueueToken not implemented")
+}
+func (UnimplementedFlashSaleServiceServer) mustEmbedUnimplementedFlashSaleServiceServer() {}
+func (UnimplementedFlashSaleServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeFlashSaleServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FlashSaleServiceServer will
+// result in compilation errors.
+type UnsafeFlashSaleServiceServer interface {
+	mustEmbedUnimplementedFlashSaleServiceServer()
+}
+
+func RegisterFlashSaleServiceServer(s grpc.ServiceRegistrar, srv FlashSaleServiceServer) {
+	// If the following call pancis, it indicates UnimplementedFlashSaleServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&FlashSaleService_ServiceDesc, srv)
+}
+
+func _FlashSaleService_CreateFlashSale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFlashSaleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlashSaleServiceServer).CreateFlashSale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FlashSaleService_CreateFlashSale_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlashSaleServiceServer).CreateFlashSale(ctx, req.(*CreateFlashSaleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FlashSaleService_GetFlashSale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFlashSaleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlashSaleServiceServer).GetFlashSale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FlashSaleService_GetFlashSale_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlashSaleServiceServer).GetFlashSale(ctx, req.(*GetFlashSaleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FlashSaleService_GetQueuePosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQueuePositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlashSaleServiceServer).GetQueuePosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FlashSaleService_GetQueuePosition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlashSaleServiceServer).GetQueuePosition(ctx, req.(*GetQueuePositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FlashSaleService_IssueQueueToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueQueueTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlashSaleServiceServer).IssueQueueToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FlashSaleService_IssueQueueToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlashSaleServiceServer).IssueQueueToken(ctx, req.(*IssueQueueTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FlashSaleService_ValidateQueueToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateQueueTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlashSaleServiceServer).ValidateQueueToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FlashSaleService_ValidateQueueToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlashSaleServiceServer).ValidateQueueToken(ctx, req.(*ValidateQueueTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FlashSaleService_ServiceDesc is the grpc.ServiceDesc for FlashSaleService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FlashSaleService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "go.escape.ship.proto.v1.FlashSaleService",
+	HandlerType: (*FlashSaleServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateFlashSale",
+			Handler:    _FlashSaleService_CreateFlashSale_Handler,
+		},
+		{
+			MethodName: "GetFlashSale",
+			Handler:    _FlashSaleService_GetFlashSale_Handler,
+		},
+		{
+			MethodName: "GetQueuePosition",
+			Handler:    _FlashSaleService_GetQueuePosition_Handler,
+		},
+		{
+			MethodName: "IssueQueueToken",
+			Handler:    _FlashSaleService_IssueQueueToken_Handler,
+		},
+		{
+			MethodName: "ValidateQueueToken",
+			Handler:    _FlashSaleService_ValidateQueueToken_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "flashsale.proto",
+}
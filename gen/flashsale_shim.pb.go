@@ -0,0 +1,83 @@
+// Code generated by protoc-gen-go-shim. DO NOT EDIT.
+// source: flashsale.proto
+
+package gen
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+)
+
+// FlashSaleServiceAPI is FlashSaleServiceClient without per-call options, so it can be
+// mocked with plain method signatures. Streams are exposed as iterators.
+type FlashSaleServiceAPI interface {
+	CreateFlashSale(ctx context.Context, in *CreateFlashSaleRequest) (*CreateFlashSaleResponse, error)
+	GetFlashSale(ctx context.Context, in *GetFlashSaleRequest) (*GetFlashSaleResponse, error)
+	// 대기열 내 현재 순번 조회 (클라이언트 폴링용)
+	GetQueuePosition(ctx context.Context, in *GetQueuePositionRequest) (*GetQueuePositionResponse, error)
+	// 대기열 진입 및 대기열 토큰 발급 (재호출 시 기존 순번 유지)
+	IssueQueueToken(ctx context.Context, in *IssueQueueTokenRequest) (*IssueQueueTokenResponse, error)
+	// 게이트웨이가 결제/주문 진입 전 x-queue-token 헤더 값을 검증
+	ValidateQueueToken(ctx context.Context, in *ValidateQueueTokenRequest) (*ValidateQueueTokenResponse, error)
+}
+
+// NewFlashSaleServiceAPI adapts c to FlashSaleServiceAPI, passing opts to every call.
+func NewFlashSaleServiceAPI(c FlashSaleServiceClient, opts ...grpc.CallOption) FlashSaleServiceAPI {
+	return &flashSaleServiceAPI{c: c, opts: opts}
+}
+
+type flashSaleServiceAPI struct {
+	c    FlashSaleServiceClient
+	opts []grpc.CallOption
+}
+
+func (a *flashSaleServiceAPI) CreateFlashSale(ctx context.Context, in *CreateFlashSaleRequest) (*CreateFlashSaleResponse, error) {
+	return a.c.CreateFlashSale(ctx, in, a.opts...)
+}
+
+func (a *flashSaleServiceAPI) GetFlashSale(ctx context.Context, in *GetFlashSaleRequest) (*GetFlashSaleResponse, error) {
+	return a.c.GetFlashSale(ctx, in, a.opts...)
+}
+
+func (a *flashSaleServiceAPI) GetQueuePosition(ctx context.Context, in *GetQueuePositionRequest) (*GetQueuePositionResponse, error) {
+	return a.c.GetQueuePosition(ctx, in, a.opts...)
+}
+
+func (a *flashSaleServiceAPI) IssueQueueToken(ctx context.Context, in *IssueQueueTokenRequest) (*IssueQueueTokenResponse, error) {
+	return a.c.IssueQueueToken(ctx, in, a.opts...)
+}
+
+func (a *flashSaleServiceAPI) ValidateQueueToken(ctx context.Context, in *ValidateQueueTokenRequest) (*ValidateQueueTokenResponse, error) {
+	return a.c.ValidateQueueToken(ctx, in, a.opts...)
+}
+
+// FlashSaleServiceClientFromAPI adapts a to FlashSaleServiceClient, e.g. to hand a
+// mock FlashSaleServiceAPI to code that takes the generated client. Call options
+// are ignored, and streams report empty headers and trailers.
+func FlashSaleServiceClientFromAPI(a FlashSaleServiceAPI) FlashSaleServiceClient {
+	return flashSaleServiceAPIClient{api: a}
+}
+
+type flashSaleServiceAPIClient struct {
+	api FlashSaleServiceAPI
+}
+
+func (c flashSaleServiceAPIClient) CreateFlashSale(ctx context.Context, in *CreateFlashSaleRequest, _ ...grpc.CallOption) (*CreateFlashSaleResponse, error) {
+	return c.api.CreateFlashSale(ctx, in)
+}
+
+func (c flashSaleServiceAPIClient) GetFlashSale(ctx context.Context, in *GetFlashSaleRequest, _ ...grpc.CallOption) (*GetFlashSaleResponse, error) {
+	return c.api.GetFlashSale(ctx, in)
+}
+
+func (c flashSaleServiceAPIClient) GetQueuePosition(ctx context.Context, in *GetQueuePositionRequest, _ ...grpc.CallOption) (*GetQueuePositionResponse, error) {
+	return c.api.GetQueuePosition(ctx, in)
+}
+
+func (c flashSaleServiceAPIClient) IssueQueueToken(ctx context.Context, in *IssueQueueTokenRequest, _ ...grpc.CallOption) (*IssueQueueTokenResponse, error) {
+	return c.api.IssueQueueToken(ctx, in)
+}
+
+func (c flashSaleServiceAPIClient) ValidateQueueToken(ctx context.Context, in *ValidateQueueTokenRequest, _ ...grpc.CallOption) (*ValidateQueueTokenResponse, error) {
+	return c.api.ValidateQueueToken(ctx, in)
+}
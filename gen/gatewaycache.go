@@ -0,0 +1,235 @@
+package gen
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/gob"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CachedResponse is a gateway response held by a CacheStore.
+type CachedResponse struct {
+	Status int
+	Header http.Header
+	Body   []byte
+	ETag   string
+}
+
+// MarshalBinary encodes r for external stores such as Redis.
+func (r *CachedResponse) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(r)
+	return buf.Bytes(), err
+}
+
+// UnmarshalBinary decodes a response encoded by MarshalBinary.
+func (r *CachedResponse) UnmarshalBinary(data []byte) error {
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(r)
+}
+
+// CacheStore stores cached gateway responses. NewMemoryCacheStore covers a
+// single gateway instance; share a Redis-backed implementation between
+// replicas using CachedResponse's binary encoding.
+type CacheStore interface {
+	Get(ctx context.Context, key string) (*CachedResponse, bool)
+	Set(ctx context.Context, key string, resp *CachedResponse, ttl time.Duration)
+}
+
+// NewMemoryCacheStore returns an in-process store holding up to maxEntries
+// responses. When full, expired entries are dropped first, then arbitrary ones.
+func NewMemoryCacheStore(maxEntries int) CacheStore {
+	return &memoryCacheStore{max: maxEntries, entries: make(map[string]memoryCacheEntry)}
+}
+
+type memoryCacheStore struct {
+	mu      sync.Mutex
+	max     int
+	entries map[string]memoryCacheEntry
+}
+
+type memoryCacheEntry struct {
+	resp    *CachedResponse
+	expires time.Time
+}
+
+func (s *memoryCacheStore) Get(_ context.Context, key string) (*CachedResponse, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.entries[key]
+	if !ok || time.Now().After(e.expires) {
+		return nil, false
+	}
+	return e.resp, true
+}
+
+func (s *memoryCacheStore) Set(_ context.Context, key string, resp *CachedResponse, ttl time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.entries[key]; !ok && len(s.entries) >= s.max {
+		now := time.Now()
+		for k, e := range s.entries {
+			if now.After(e.expires) {
+				delete(s.entries, k)
+			}
+		}
+		for k := range s.entries {
+			if len(s.entries) < s.max {
+				break
+			}
+			delete(s.entries, k)
+		}
+	}
+	s.entries[key] = memoryCacheEntry{resp: resp, expires: time.Now().Add(ttl)}
+}
+
+// ResponseCache is opt-in gateway middleware caching successful GET responses
+// of catalog routes. Cached responses carry Cache-Control and an ETag;
+// conditional requests get 304 Not Modified.
+//
+// With Version set, the ETag is derived from the resource version, so a
+// conditional request whose version is unchanged is answered without calling
+// the backend, and a cached response is dropped as soon as the version moves.
+// Without it, the ETag is a hash of the body, which only saves the transfer.
+//
+// Responses are keyed by URL and Accept-Language only, so PathPrefixes must not
+// include routes whose responses depend on the caller.
+//
+//	cache := &ResponseCache{Store: NewMemoryCacheStore(10_000), TTL: 30 * time.Second}
+//	http.ListenAndServe(":8080", cache.Handler(mux))
+type ResponseCache struct {
+	Store CacheStore
+	// TTL bounds staleness both in Store and for clients (max-age); zero
+	// means 30 seconds.
+	TTL time.Duration
+	// PathPrefixes selects cached routes, matched on whole path segments;
+	// empty means "/products".
+	PathPrefixes []string
+	// Version optionally returns the current version of the resources behind
+	// r, e.g. a catalog revision the product service bumps on every write.
+	// It must be much cheaper than the request itself. ok is false when the
+	// version is unknown, which falls back to body ETags.
+	Version func(r *http.Request) (version string, ok bool)
+}
+
+func (c *ResponseCache) cacheable(r *http.Request) bool {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		return false
+	}
+	prefixes := c.PathPrefixes
+	if len(prefixes) == 0 {
+		prefixes = []string{"/products"}
+	}
+	for _, p := range prefixes {
+		if pathHasPrefix(r.URL.Path, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// pathHasPrefix reports whether prefix is path or one of its leading
+// segments, so "/products" matches "/products/1" but not "/productsX".
+func pathHasPrefix(path, prefix string) bool {
+	prefix = strings.TrimSuffix(prefix, "/")
+	return path == prefix || strings.HasPrefix(path, prefix+"/")
+}
+
+// etag returns a strong ETag hashing parts.
+func etag(parts ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// Handler wraps next with the cache.
+func (c *ResponseCache) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !c.cacheable(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		ttl := c.TTL
+		if ttl == 0 {
+			ttl = 30 * time.Second
+		}
+		key := r.URL.RequestURI() + "\x00" + r.Header.Get("Accept-Language")
+		var versionETag string
+		if c.Version != nil {
+			if v, ok := c.Version(r); ok {
+				versionETag = etag(key, v)
+			}
+		}
+
+		if versionETag != "" && etagMatches(r.Header.Get("If-None-Match"), versionETag) {
+			writeCached(w, r, &CachedResponse{Status: http.StatusOK, ETag: versionETag}, ttl, "REVALIDATED")
+			return
+		}
+		if resp, ok := c.Store.Get(r.Context(), key); ok && (versionETag == "" || resp.ETag == versionETag) {
+			writeCached(w, r, resp, ttl, "HIT")
+			return
+		}
+
+		rec := &bufferedWriter{header: make(http.Header), status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+		resp := &CachedResponse{Status: rec.status, Header: rec.header, Body: rec.body.Bytes()}
+		if rec.status == http.StatusOK && r.Method == http.MethodGet && rec.header.Get("Set-Cookie") == "" {
+			resp.Header = rec.header.Clone()
+			resp.Header.Del(RequestIDHeader) // per request, not per resource
+			resp.ETag = versionETag
+			if resp.ETag == "" {
+				resp.ETag = etag(string(resp.Body))
+			}
+			c.Store.Set(r.Context(), key, resp, ttl)
+		}
+		writeCached(w, r, resp, ttl, "MISS")
+	})
+}
+
+func writeCached(w http.ResponseWriter, r *http.Request, resp *CachedResponse, ttl time.Duration, state string) {
+	h := w.Header()
+	for k, v := range resp.Header {
+		h[k] = v
+	}
+	h.Set("X-Cache", state)
+	if resp.ETag != "" {
+		h.Set("ETag", resp.ETag)
+		h.Set("Cache-Control", "public, max-age="+strconv.Itoa(int(ttl/time.Second)))
+		if etagMatches(r.Header.Get("If-None-Match"), resp.ETag) {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+	}
+	w.WriteHeader(resp.Status)
+	if r.Method != http.MethodHead {
+		w.Write(resp.Body)
+	}
+}
+
+func etagMatches(ifNoneMatch, etag string) bool {
+	if ifNoneMatch == "" {
+		return false
+	}
+	for _, t := range strings.Split(ifNoneMatch, ",") {
+		t = strings.TrimPrefix(strings.TrimSpace(t), "W/")
+		if t == etag || t == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// bufferedWriter collects a response so it can be stored before sending.
+type bufferedWriter struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (w *bufferedWriter) Header() http.Header         { return w.header }
+func (w *bufferedWriter) WriteHeader(code int)        { w.status = code }
+func (w *bufferedWriter) Write(b []byte) (int, error) { return w.body.Write(b) }
@@ -0,0 +1,118 @@
+package gen
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPathHasPrefix(t *testing.T) {
+	tests := []struct {
+		path, prefix string
+		want         bool
+	}{
+		{"/products", "/products", true},
+		{"/products/1", "/products", true},
+		{"/products/1", "/products/", true},
+		{"/productsX", "/products", false},
+		{"/products-admin/1", "/products", false},
+		{"/v1/products", "/products", false},
+	}
+	for _, tt := range tests {
+		if got := pathHasPrefix(tt.path, tt.prefix); got != tt.want {
+			t.Errorf("pathHasPrefix(%q, %q) = %v, want %v", tt.path, tt.prefix, got, tt.want)
+		}
+	}
+}
+
+func TestResponseCache(t *testing.T) {
+	type step struct {
+		path        string
+		ifNoneMatch bool // send the ETag of the previous response
+		version     string
+		wantStatus  int
+		wantCache   string
+		wantCalls   int // backend calls so far
+	}
+	tests := []struct {
+		name       string
+		versioned  bool
+		setsCookie bool
+		steps      []step
+	}{
+		{
+			name: "body etag",
+			steps: []step{
+				{path: "/products/1", wantStatus: 200, wantCache: "MISS", wantCalls: 1},
+				{path: "/products/1", wantStatus: 200, wantCache: "HIT", wantCalls: 1},
+				{path: "/products/1", ifNoneMatch: true, wantStatus: 304, wantCache: "HIT", wantCalls: 1},
+			},
+		},
+		{
+			name: "prefix matches whole segments",
+			steps: []step{
+				{path: "/productsX", wantStatus: 200, wantCalls: 1},
+				{path: "/productsX", wantStatus: 200, wantCalls: 2},
+			},
+		},
+		{
+			name:       "set-cookie is not cached",
+			setsCookie: true,
+			steps: []step{
+				{path: "/products/1", wantStatus: 200, wantCache: "MISS", wantCalls: 1},
+				{path: "/products/1", wantStatus: 200, wantCache: "MISS", wantCalls: 2},
+			},
+		},
+		{
+			name:      "version revalidates without the backend",
+			versioned: true,
+			steps: []step{
+				{path: "/products/1", version: "7", wantStatus: 200, wantCache: "MISS", wantCalls: 1},
+				{path: "/products/1", version: "7", ifNoneMatch: true, wantStatus: 304, wantCache: "REVALIDATED", wantCalls: 1},
+				{path: "/products/1", version: "7", wantStatus: 200, wantCache: "HIT", wantCalls: 1},
+			},
+		},
+		{
+			name:      "version change invalidates",
+			versioned: true,
+			steps: []step{
+				{path: "/products/1", version: "7", wantStatus: 200, wantCache: "MISS", wantCalls: 1},
+				{path: "/products/1", version: "8", ifNoneMatch: true, wantStatus: 200, wantCache: "MISS", wantCalls: 2},
+				{path: "/products/1", version: "8", wantStatus: 200, wantCache: "HIT", wantCalls: 2},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			backend := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				if tt.setsCookie {
+					w.Header().Set("Set-Cookie", "session=1")
+				}
+				w.Write([]byte(`{"id":"1"}`))
+			})
+			version := ""
+			cache := &ResponseCache{Store: NewMemoryCacheStore(10)}
+			if tt.versioned {
+				cache.Version = func(*http.Request) (string, bool) { return version, true }
+			}
+			h := cache.Handler(backend)
+			etag := ""
+			for i, s := range tt.steps {
+				version = s.version
+				req := httptest.NewRequest(http.MethodGet, s.path, nil)
+				if s.ifNoneMatch {
+					req.Header.Set("If-None-Match", etag)
+				}
+				rec := httptest.NewRecorder()
+				h.ServeHTTP(rec, req)
+				if rec.Code != s.wantStatus || rec.Header().Get("X-Cache") != s.wantCache || calls != s.wantCalls {
+					t.Fatalf("step %d: status %d, X-Cache %q, %d backend calls; want %d, %q, %d",
+						i, rec.Code, rec.Header().Get("X-Cache"), calls, s.wantStatus, s.wantCache, s.wantCalls)
+				}
+				etag = rec.Header().Get("ETag")
+			}
+		})
+	}
+}
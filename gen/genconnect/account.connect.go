@@ -0,0 +1,869 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: account.proto
+
+package genconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	gen "github.com/escape-ship/protos/gen"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AccountServiceName is the fully-qualified name of the AccountService service.
+	AccountServiceName = "go.escape.ship.proto.v1.AccountService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AccountServiceGetKakaoLoginURLProcedure is the fully-qualified name of the AccountService's
+	// GetKakaoLoginURL RPC.
+	AccountServiceGetKakaoLoginURLProcedure = "/go.escape.ship.proto.v1.AccountService/GetKakaoLoginURL"
+	// AccountServiceGetKakaoCallBackProcedure is the fully-qualified name of the AccountService's
+	// GetKakaoCallBack RPC.
+	AccountServiceGetKakaoCallBackProcedure = "/go.escape.ship.proto.v1.AccountService/GetKakaoCallBack"
+	// AccountServiceLoginProcedure is the fully-qualified name of the AccountService's Login RPC.
+	AccountServiceLoginProcedure = "/go.escape.ship.proto.v1.AccountService/Login"
+	// AccountServiceRegisterProcedure is the fully-qualified name of the AccountService's Register RPC.
+	AccountServiceRegisterProcedure = "/go.escape.ship.proto.v1.AccountService/Register"
+	// AccountServiceRefreshTokenProcedure is the fully-qualified name of the AccountService's
+	// RefreshToken RPC.
+	AccountServiceRefreshTokenProcedure = "/go.escape.ship.proto.v1.AccountService/RefreshToken"
+	// AccountServiceRevokeTokenProcedure is the fully-qualified name of the AccountService's
+	// RevokeToken RPC.
+	AccountServiceRevokeTokenProcedure = "/go.escape.ship.proto.v1.AccountService/RevokeToken"
+	// AccountServiceAnonymizeUserDataProcedure is the fully-qualified name of the AccountService's
+	// AnonymizeUserData RPC.
+	AccountServiceAnonymizeUserDataProcedure = "/go.escape.ship.proto.v1.AccountService/AnonymizeUserData"
+	// AccountServiceAcceptTermsProcedure is the fully-qualified name of the AccountService's
+	// AcceptTerms RPC.
+	AccountServiceAcceptTermsProcedure = "/go.escape.ship.proto.v1.AccountService/AcceptTerms"
+	// AccountServiceRegisterPushTokenProcedure is the fully-qualified name of the AccountService's
+	// RegisterPushToken RPC.
+	AccountServiceRegisterPushTokenProcedure = "/go.escape.ship.proto.v1.AccountService/RegisterPushToken"
+	// AccountServiceUnregisterPushTokenProcedure is the fully-qualified name of the AccountService's
+	// UnregisterPushToken RPC.
+	AccountServiceUnregisterPushTokenProcedure = "/go.escape.ship.proto.v1.AccountService/UnregisterPushToken"
+	// AccountServiceVerifyCaptchaProcedure is the fully-qualified name of the AccountService's
+	// VerifyCaptcha RPC.
+	AccountServiceVerifyCaptchaProcedure = "/go.escape.ship.proto.v1.AccountService/VerifyCaptcha"
+	// AccountServiceUnlockAccountProcedure is the fully-qualified name of the AccountService's
+	// UnlockAccount RPC.
+	AccountServiceUnlockAccountProcedure = "/go.escape.ship.proto.v1.AccountService/UnlockAccount"
+	// AccountServiceIssueGuestTokenProcedure is the fully-qualified name of the AccountService's
+	// IssueGuestToken RPC.
+	AccountServiceIssueGuestTokenProcedure = "/go.escape.ship.proto.v1.AccountService/IssueGuestToken"
+	// AccountServiceMergeAccountsProcedure is the fully-qualified name of the AccountService's
+	// MergeAccounts RPC.
+	AccountServiceMergeAccountsProcedure = "/go.escape.ship.proto.v1.AccountService/MergeAccounts"
+	// AccountServiceRequestEmailChangeProcedure is the fully-qualified name of the AccountService's
+	// RequestEmailChange RPC.
+	AccountServiceRequestEmailChangeProcedure = "/go.escape.ship.proto.v1.AccountService/RequestEmailChange"
+	// AccountServiceConfirmEmailChangeProcedure is the fully-qualified name of the AccountService's
+	// ConfirmEmailChange RPC.
+	AccountServiceConfirmEmailChangeProcedure = "/go.escape.ship.proto.v1.AccountService/ConfirmEmailChange"
+	// AccountServiceUploadAvatarProcedure is the fully-qualified name of the AccountService's
+	// UploadAvatar RPC.
+	AccountServiceUploadAvatarProcedure = "/go.escape.ship.proto.v1.AccountService/UploadAvatar"
+	// AccountServiceGetPreferencesProcedure is the fully-qualified name of the AccountService's
+	// GetPreferences RPC.
+	AccountServiceGetPreferencesProcedure = "/go.escape.ship.proto.v1.AccountService/GetPreferences"
+	// AccountServiceSetPreferencesProcedure is the fully-qualified name of the AccountService's
+	// SetPreferences RPC.
+	AccountServiceSetPreferencesProcedure = "/go.escape.ship.proto.v1.AccountService/SetPreferences"
+	// AccountServiceGetMeProcedure is the fully-qualified name of the AccountService's GetMe RPC.
+	AccountServiceGetMeProcedure = "/go.escape.ship.proto.v1.AccountService/GetMe"
+	// AccountServiceUpdateProfileProcedure is the fully-qualified name of the AccountService's
+	// UpdateProfile RPC.
+	AccountServiceUpdateProfileProcedure = "/go.escape.ship.proto.v1.AccountService/UpdateProfile"
+	// AccountServiceChangePasswordProcedure is the fully-qualified name of the AccountService's
+	// ChangePassword RPC.
+	AccountServiceChangePasswordProcedure = "/go.escape.ship.proto.v1.AccountService/ChangePassword"
+	// AccountServiceDeleteAccountProcedure is the fully-qualified name of the AccountService's
+	// DeleteAccount RPC.
+	AccountServiceDeleteAccountProcedure = "/go.escape.ship.proto.v1.AccountService/DeleteAccount"
+	// AccountServiceCreateAPIKeyProcedure is the fully-qualified name of the AccountService's
+	// CreateAPIKey RPC.
+	AccountServiceCreateAPIKeyProcedure = "/go.escape.ship.proto.v1.AccountService/CreateAPIKey"
+	// AccountServiceRevokeAPIKeyProcedure is the fully-qualified name of the AccountService's
+	// RevokeAPIKey RPC.
+	AccountServiceRevokeAPIKeyProcedure = "/go.escape.ship.proto.v1.AccountService/RevokeAPIKey"
+	// AccountServiceValidateAPIKeyProcedure is the fully-qualified name of the AccountService's
+	// ValidateAPIKey RPC.
+	AccountServiceValidateAPIKeyProcedure = "/go.escape.ship.proto.v1.AccountService/ValidateAPIKey"
+)
+
+// AccountServiceClient is a client for the go.escape.ship.proto.v1.AccountService service.
+type AccountServiceClient interface {
+	GetKakaoLoginURL(context.Context, *connect.Request[gen.GetKakaoLoginURLRequest]) (*connect.Response[gen.GetKakaoLoginURLResponse], error)
+	GetKakaoCallBack(context.Context, *connect.Request[gen.GetKakaoCallBackRequest]) (*connect.Response[gen.GetKakaoCallBackResponse], error)
+	Login(context.Context, *connect.Request[gen.LoginRequest]) (*connect.Response[gen.LoginResponse], error)
+	Register(context.Context, *connect.Request[gen.RegisterRequest]) (*connect.Response[gen.RegisterResponse], error)
+	// refresh_token으로 새 access/refresh 토큰 발급 (rotation: 사용한 refresh_token은 즉시 무효)
+	// 이미 사용된 refresh_token이 다시 오면 탈취로 보고 같은 계열의 토큰을 모두 폐기
+	RefreshToken(context.Context, *connect.Request[gen.RefreshTokenRequest]) (*connect.Response[gen.RefreshTokenResponse], error)
+	// 로그아웃: access 또는 refresh 토큰 폐기 (이미 무효한 토큰도 성공 처리)
+	RevokeToken(context.Context, *connect.Request[gen.RevokeTokenRequest]) (*connect.Response[gen.RevokeTokenResponse], error)
+	// 개인정보 파기 요청: 주문/결제의 PII를 삭제하되 금액 등 집계 데이터는 보존
+	AnonymizeUserData(context.Context, *connect.Request[gen.AnonymizeUserDataRequest]) (*connect.Response[gen.AnonymizeUserDataResponse], error)
+	// 약관 동의 (Authorization 헤더의 사용자 기준)
+	AcceptTerms(context.Context, *connect.Request[gen.AcceptTermsRequest]) (*connect.Response[gen.AcceptTermsResponse], error)
+	// 주문 상태 푸시 알림을 위한 디바이스 토큰 등록/해제 (FCM/APNs)
+	RegisterPushToken(context.Context, *connect.Request[gen.RegisterPushTokenRequest]) (*connect.Response[gen.RegisterPushTokenResponse], error)
+	UnregisterPushToken(context.Context, *connect.Request[gen.UnregisterPushTokenRequest]) (*connect.Response[gen.UnregisterPushTokenResponse], error)
+	// CAPTCHA 토큰 검증 (Login/Register 처리 중 내부 호출 또는 단독 사용)
+	VerifyCaptcha(context.Context, *connect.Request[gen.VerifyCaptchaRequest]) (*connect.Response[gen.VerifyCaptchaResponse], error)
+	// 관리자용: 로그인 실패로 잠긴 계정 해제
+	UnlockAccount(context.Context, *connect.Request[gen.UnlockAccountRequest]) (*connect.Response[gen.UnlockAccountResponse], error)
+	// 비회원 장바구니/이벤트 추적용 익명 토큰 발급, 가입 후 계정으로 병합 가능
+	IssueGuestToken(context.Context, *connect.Request[gen.IssueGuestTokenRequest]) (*connect.Response[gen.IssueGuestTokenResponse], error)
+	// 계정 병합 (게스트→회원, 카카오→이메일): 장바구니, 주문, 포인트, 위시리스트를 target으로 이전
+	MergeAccounts(context.Context, *connect.Request[gen.MergeAccountsRequest]) (*connect.Response[gen.MergeAccountsResponse], error)
+	// 이메일 변경: 새 주소로 인증 코드 발송 + 기존 주소로 변경 요청 알림
+	RequestEmailChange(context.Context, *connect.Request[gen.RequestEmailChangeRequest]) (*connect.Response[gen.RequestEmailChangeResponse], error)
+	// 새 주소로 받은 인증 코드 확인 후 이메일 변경 완료
+	ConfirmEmailChange(context.Context, *connect.Request[gen.ConfirmEmailChangeRequest]) (*connect.Response[gen.ConfirmEmailChangeResponse], error)
+	// 프로필 이미지 업로드: 첫 메시지는 metadata, 이후 chunk 전송
+	UploadAvatar(context.Context) *connect.ClientStreamForClient[gen.UploadAvatarRequest, gen.UploadAvatarResponse]
+	// UI 설정 (언어, 통화, 테마 등) 기기 간 동기화
+	GetPreferences(context.Context, *connect.Request[gen.GetPreferencesRequest]) (*connect.Response[gen.GetPreferencesResponse], error)
+	SetPreferences(context.Context, *connect.Request[gen.SetPreferencesRequest]) (*connect.Response[gen.SetPreferencesResponse], error)
+	// 로그인한 사용자 (Authorization 헤더 기준) 프로필 조회/변경
+	GetMe(context.Context, *connect.Request[gen.GetMeRequest]) (*connect.Response[gen.GetMeResponse], error)
+	UpdateProfile(context.Context, *connect.Request[gen.UpdateProfileRequest]) (*connect.Response[gen.UpdateProfileResponse], error)
+	// 비밀번호 변경: 성공 시 현재 세션을 제외한 refresh 토큰 폐기 (선택)
+	ChangePassword(context.Context, *connect.Request[gen.ChangePasswordRequest]) (*connect.Response[gen.ChangePasswordResponse], error)
+	// 회원 탈퇴: 모든 토큰 폐기 후 유예 기간이 지나면 AnonymizeUserData로 개인정보 파기
+	DeleteAccount(context.Context, *connect.Request[gen.DeleteAccountRequest]) (*connect.Response[gen.DeleteAccountResponse], error)
+	// 관리자용: 파트너 HTTP 연동용 API 키 발급/폐기
+	CreateAPIKey(context.Context, *connect.Request[gen.CreateAPIKeyRequest]) (*connect.Response[gen.CreateAPIKeyResponse], error)
+	RevokeAPIKey(context.Context, *connect.Request[gen.RevokeAPIKeyRequest]) (*connect.Response[gen.RevokeAPIKeyResponse], error)
+	// 게이트웨이 전용: x-api-key 검증 (HTTP 매핑 없음)
+	ValidateAPIKey(context.Context, *connect.Request[gen.ValidateAPIKeyRequest]) (*connect.Response[gen.ValidateAPIKeyResponse], error)
+}
+
+// NewAccountServiceClient constructs a client for the go.escape.ship.proto.v1.AccountService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAccountServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AccountServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	accountServiceMethods := gen.File_account_proto.Services().ByName("AccountService").Methods()
+	return &accountServiceClient{
+		getKakaoLoginURL: connect.NewClient[gen.GetKakaoLoginURLRequest, gen.GetKakaoLoginURLResponse](
+			httpClient,
+			baseURL+AccountServiceGetKakaoLoginURLProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("GetKakaoLoginURL")),
+			connect.WithClientOptions(opts...),
+		),
+		getKakaoCallBack: connect.NewClient[gen.GetKakaoCallBackRequest, gen.GetKakaoCallBackResponse](
+			httpClient,
+			baseURL+AccountServiceGetKakaoCallBackProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("GetKakaoCallBack")),
+			connect.WithClientOptions(opts...),
+		),
+		login: connect.NewClient[gen.LoginRequest, gen.LoginResponse](
+			httpClient,
+			baseURL+AccountServiceLoginProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("Login")),
+			connect.WithClientOptions(opts...),
+		),
+		register: connect.NewClient[gen.RegisterRequest, gen.RegisterResponse](
+			httpClient,
+			baseURL+AccountServiceRegisterProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("Register")),
+			connect.WithClientOptions(opts...),
+		),
+		refreshToken: connect.NewClient[gen.RefreshTokenRequest, gen.RefreshTokenResponse](
+			httpClient,
+			baseURL+AccountServiceRefreshTokenProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("RefreshToken")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeToken: connect.NewClient[gen.RevokeTokenRequest, gen.RevokeTokenResponse](
+			httpClient,
+			baseURL+AccountServiceRevokeTokenProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("RevokeToken")),
+			connect.WithClientOptions(opts...),
+		),
+		anonymizeUserData: connect.NewClient[gen.AnonymizeUserDataRequest, gen.AnonymizeUserDataResponse](
+			httpClient,
+			baseURL+AccountServiceAnonymizeUserDataProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("AnonymizeUserData")),
+			connect.WithClientOptions(opts...),
+		),
+		acceptTerms: connect.NewClient[gen.AcceptTermsRequest, gen.AcceptTermsResponse](
+			httpClient,
+			baseURL+AccountServiceAcceptTermsProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("AcceptTerms")),
+			connect.WithClientOptions(opts...),
+		),
+		registerPushToken: connect.NewClient[gen.RegisterPushTokenRequest, gen.RegisterPushTokenResponse](
+			httpClient,
+			baseURL+AccountServiceRegisterPushTokenProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("RegisterPushToken")),
+			connect.WithClientOptions(opts...),
+		),
+		unregisterPushToken: connect.NewClient[gen.UnregisterPushTokenRequest, gen.UnregisterPushTokenResponse](
+			httpClient,
+			baseURL+AccountServiceUnregisterPushTokenProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("UnregisterPushToken")),
+			connect.WithClientOptions(opts...),
+		),
+		verifyCaptcha: connect.NewClient[gen.VerifyCaptchaRequest, gen.VerifyCaptchaResponse](
+			httpClient,
+			baseURL+AccountServiceVerifyCaptchaProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("VerifyCaptcha")),
+			connect.WithClientOptions(opts...),
+		),
+		unlockAccount: connect.NewClient[gen.UnlockAccountRequest, gen.UnlockAccountResponse](
+			httpClient,
+			baseURL+AccountServiceUnlockAccountProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("UnlockAccount")),
+			connect.WithClientOptions(opts...),
+		),
+		issueGuestToken: connect.NewClient[gen.IssueGuestTokenRequest, gen.IssueGuestTokenResponse](
+			httpClient,
+			baseURL+AccountServiceIssueGuestTokenProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("IssueGuestToken")),
+			connect.WithClientOptions(opts...),
+		),
+		mergeAccounts: connect.NewClient[gen.MergeAccountsRequest, gen.MergeAccountsResponse](
+			httpClient,
+			baseURL+AccountServiceMergeAccountsProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("MergeAccounts")),
+			connect.WithClientOptions(opts...),
+		),
+		requestEmailChange: connect.NewClient[gen.RequestEmailChangeRequest, gen.RequestEmailChangeResponse](
+			httpClient,
+			baseURL+AccountServiceRequestEmailChangeProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("RequestEmailChange")),
+			connect.WithClientOptions(opts...),
+		),
+		confirmEmailChange: connect.NewClient[gen.ConfirmEmailChangeRequest, gen.ConfirmEmailChangeResponse](
+			httpClient,
+			baseURL+AccountServiceConfirmEmailChangeProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("ConfirmEmailChange")),
+			connect.WithClientOptions(opts...),
+		),
+		uploadAvatar: connect.NewClient[gen.UploadAvatarRequest, gen.UploadAvatarResponse](
+			httpClient,
+			baseURL+AccountServiceUploadAvatarProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("UploadAvatar")),
+			connect.WithClientOptions(opts...),
+		),
+		getPreferences: connect.NewClient[gen.GetPreferencesRequest, gen.GetPreferencesResponse](
+			httpClient,
+			baseURL+AccountServiceGetPreferencesProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("GetPreferences")),
+			connect.WithClientOptions(opts...),
+		),
+		setPreferences: connect.NewClient[gen.SetPreferencesRequest, gen.SetPreferencesResponse](
+			httpClient,
+			baseURL+AccountServiceSetPreferencesProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("SetPreferences")),
+			connect.WithClientOptions(opts...),
+		),
+		getMe: connect.NewClient[gen.GetMeRequest, gen.GetMeResponse](
+			httpClient,
+			baseURL+AccountServiceGetMeProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("GetMe")),
+			connect.WithClientOptions(opts...),
+		),
+		updateProfile: connect.NewClient[gen.UpdateProfileRequest, gen.UpdateProfileResponse](
+			httpClient,
+			baseURL+AccountServiceUpdateProfileProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("UpdateProfile")),
+			connect.WithClientOptions(opts...),
+		),
+		changePassword: connect.NewClient[gen.ChangePasswordRequest, gen.ChangePasswordResponse](
+			httpClient,
+			baseURL+AccountServiceChangePasswordProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("ChangePassword")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAccount: connect.NewClient[gen.DeleteAccountRequest, gen.DeleteAccountResponse](
+			httpClient,
+			baseURL+AccountServiceDeleteAccountProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("DeleteAccount")),
+			connect.WithClientOptions(opts...),
+		),
+		createAPIKey: connect.NewClient[gen.CreateAPIKeyRequest, gen.CreateAPIKeyResponse](
+			httpClient,
+			baseURL+AccountServiceCreateAPIKeyProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("CreateAPIKey")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeAPIKey: connect.NewClient[gen.RevokeAPIKeyRequest, gen.RevokeAPIKeyResponse](
+			httpClient,
+			baseURL+AccountServiceRevokeAPIKeyProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("RevokeAPIKey")),
+			connect.WithClientOptions(opts...),
+		),
+		validateAPIKey: connect.NewClient[gen.ValidateAPIKeyRequest, gen.ValidateAPIKeyResponse](
+			httpClient,
+			baseURL+AccountServiceValidateAPIKeyProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("ValidateAPIKey")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// accountServiceClient implements AccountServiceClient.
+type accountServiceClient struct {
+	getKakaoLoginURL    *connect.Client[gen.GetKakaoLoginURLRequest, gen.GetKakaoLoginURLResponse]
+	getKakaoCallBack    *connect.Client[gen.GetKakaoCallBackRequest, gen.GetKakaoCallBackResponse]
+	login               *connect.Client[gen.LoginRequest, gen.LoginResponse]
+	register            *connect.Client[gen.RegisterRequest, gen.RegisterResponse]
+	refreshToken        *connect.Client[gen.RefreshTokenRequest, gen.RefreshTokenResponse]
+	revokeToken         *connect.Client[gen.RevokeTokenRequest, gen.RevokeTokenResponse]
+	anonymizeUserData   *connect.Client[gen.AnonymizeUserDataRequest, gen.AnonymizeUserDataResponse]
+	acceptTerms         *connect.Client[gen.AcceptTermsRequest, gen.AcceptTermsResponse]
+	registerPushToken   *connect.Client[gen.RegisterPushTokenRequest, gen.RegisterPushTokenResponse]
+	unregisterPushToken *connect.Client[gen.UnregisterPushTokenRequest, gen.UnregisterPushTokenResponse]
+	verifyCaptcha       *connect.Client[gen.VerifyCaptchaRequest, gen.VerifyCaptchaResponse]
+	unlockAccount       *connect.Client[gen.UnlockAccountRequest, gen.UnlockAccountResponse]
+	issueGuestToken     *connect.Client[gen.IssueGuestTokenRequest, gen.IssueGuestTokenResponse]
+	mergeAccounts       *connect.Client[gen.MergeAccountsRequest, gen.MergeAccountsResponse]
+	requestEmailChange  *connect.Client[gen.RequestEmailChangeRequest, gen.RequestEmailChangeResponse]
+	confirmEmailChange  *connect.Client[gen.ConfirmEmailChangeRequest, gen.ConfirmEmailChangeResponse]
+	uploadAvatar        *connect.Client[gen.UploadAvatarRequest, gen.UploadAvatarResponse]
+	getPreferences      *connect.Client[gen.GetPreferencesRequest, gen.GetPreferencesResponse]
+	setPreferences      *connect.Client[gen.SetPreferencesRequest, gen.SetPreferencesResponse]
+	getMe               *connect.Client[gen.GetMeRequest, gen.GetMeResponse]
+	updateProfile       *connect.Client[gen.UpdateProfileRequest, gen.UpdateProfileResponse]
+	changePassword      *connect.Client[gen.ChangePasswordRequest, gen.ChangePasswordResponse]
+	deleteAccount       *connect.Client[gen.DeleteAccountRequest, gen.DeleteAccountResponse]
+	createAPIKey        *connect.Client[gen.CreateAPIKeyRequest, gen.CreateAPIKeyResponse]
+	revokeAPIKey        *connect.Client[gen.RevokeAPIKeyRequest, gen.RevokeAPIKeyResponse]
+	validateAPIKey      *connect.Client[gen.ValidateAPIKeyRequest, gen.ValidateAPIKeyResponse]
+}
+
+// GetKakaoLoginURL calls go.escape.ship.proto.v1.AccountService.GetKakaoLoginURL.
+func (c *accountServiceClient) GetKakaoLoginURL(ctx context.Context, req *connect.Request[gen.GetKakaoLoginURLRequest]) (*connect.Response[gen.GetKakaoLoginURLResponse], error) {
+	return c.getKakaoLoginURL.CallUnary(ctx, req)
+}
+
+// GetKakaoCallBack calls go.escape.ship.proto.v1.AccountService.GetKakaoCallBack.
+func (c *accountServiceClient) GetKakaoCallBack(ctx context.Context, req *connect.Request[gen.GetKakaoCallBackRequest]) (*connect.Response[gen.GetKakaoCallBackResponse], error) {
+	return c.getKakaoCallBack.CallUnary(ctx, req)
+}
+
+// Login calls go.escape.ship.proto.v1.AccountService.Login.
+func (c *accountServiceClient) Login(ctx context.Context, req *connect.Request[gen.LoginRequest]) (*connect.Response[gen.LoginResponse], error) {
+	return c.login.CallUnary(ctx, req)
+}
+
+// Register calls go.escape.ship.proto.v1.AccountService.Register.
+func (c *accountServiceClient) Register(ctx context.Context, req *connect.Request[gen.RegisterRequest]) (*connect.Response[gen.RegisterResponse], error) {
+	return c.register.CallUnary(ctx, req)
+}
+
+// RefreshToken calls go.escape.ship.proto.v1.AccountService.RefreshToken.
+func (c *accountServiceClient) RefreshToken(ctx context.Context, req *connect.Request[gen.RefreshTokenRequest]) (*connect.Response[gen.RefreshTokenResponse], error) {
+	return c.refreshToken.CallUnary(ctx, req)
+}
+
+// RevokeToken calls go.escape.ship.proto.v1.AccountService.RevokeToken.
+func (c *accountServiceClient) RevokeToken(ctx context.Context, req *connect.Request[gen.RevokeTokenRequest]) (*connect.Response[gen.RevokeTokenResponse], error) {
+	return c.revokeToken.CallUnary(ctx, req)
+}
+
+// AnonymizeUserData calls go.escape.ship.proto.v1.AccountService.AnonymizeUserData.
+func (c *accountServiceClient) AnonymizeUserData(ctx context.Context, req *connect.Request[gen.AnonymizeUserDataRequest]) (*connect.Response[gen.AnonymizeUserDataResponse], error) {
+	return c.anonymizeUserData.CallUnary(ctx, req)
+}
+
+// AcceptTerms calls go.escape.ship.proto.v1.AccountService.AcceptTerms.
+func (c *accountServiceClient) AcceptTerms(ctx context.Context, req *connect.Request[gen.AcceptTermsRequest]) (*connect.Response[gen.AcceptTermsResponse], error) {
+	return c.acceptTerms.CallUnary(ctx, req)
+}
+
+// RegisterPushToken calls go.escape.ship.proto.v1.AccountService.RegisterPushToken.
+func (c *accountServiceClient) RegisterPushToken(ctx context.Context, req *connect.Request[gen.RegisterPushTokenRequest]) (*connect.Response[gen.RegisterPushTokenResponse], error) {
+	return c.registerPushToken.CallUnary(ctx, req)
+}
+
+// UnregisterPushToken calls go.escape.ship.proto.v1.AccountService.UnregisterPushToken.
+func (c *accountServiceClient) UnregisterPushToken(ctx context.Context, req *connect.Request[gen.UnregisterPushTokenRequest]) (*connect.Response[gen.UnregisterPushTokenResponse], error) {
+	return c.unregisterPushToken.CallUnary(ctx, req)
+}
+
+// VerifyCaptcha calls go.escape.ship.proto.v1.AccountService.VerifyCaptcha.
+func (c *accountServiceClient) VerifyCaptcha(ctx context.Context, req *connect.Request[gen.VerifyCaptchaRequest]) (*connect.Response[gen.VerifyCaptchaResponse], error) {
+	return c.verifyCaptcha.CallUnary(ctx, req)
+}
+
+// UnlockAccount calls go.escape.ship.proto.v1.AccountService.UnlockAccount.
+func (c *accountServiceClient) UnlockAccount(ctx context.Context, req *connect.Request[gen.UnlockAccountRequest]) (*connect.Response[gen.UnlockAccountResponse], error) {
+	return c.unlockAccount.CallUnary(ctx, req)
+}
+
+// IssueGuestToken calls go.escape.ship.proto.v1.AccountService.IssueGuestToken.
+func (c *accountServiceClient) IssueGuestToken(ctx context.Context, req *connect.Request[gen.IssueGuestTokenRequest]) (*connect.Response[gen.IssueGuestTokenResponse], error) {
+	return c.issueGuestToken.CallUnary(ctx, req)
+}
+
+// MergeAccounts calls go.escape.ship.proto.v1.AccountService.MergeAccounts.
+func (c *accountServiceClient) MergeAccounts(ctx context.Context, req *connect.Request[gen.MergeAccountsRequest]) (*connect.Response[gen.MergeAccountsResponse], error) {
+	return c.mergeAccounts.CallUnary(ctx, req)
+}
+
+// RequestEmailChange calls go.escape.ship.proto.v1.AccountService.RequestEmailChange.
+func (c *accountServiceClient) RequestEmailChange(ctx context.Context, req *connect.Request[gen.RequestEmailChangeRequest]) (*connect.Response[gen.RequestEmailChangeResponse], error) {
+	return c.requestEmailChange.CallUnary(ctx, req)
+}
+
+// ConfirmEmailChange calls go.escape.ship.proto.v1.AccountService.ConfirmEmailChange.
+func (c *accountServiceClient) ConfirmEmailChange(ctx context.Context, req *connect.Request[gen.ConfirmEmailChangeRequest]) (*connect.Response[gen.ConfirmEmailChangeResponse], error) {
+	return c.confirmEmailChange.CallUnary(ctx, req)
+}
+
+// UploadAvatar calls go.escape.ship.proto.v1.AccountService.UploadAvatar.
+func (c *accountServiceClient) UploadAvatar(ctx context.Context) *connect.ClientStreamForClient[gen.UploadAvatarRequest, gen.UploadAvatarResponse] {
+	return c.uploadAvatar.CallClientStream(ctx)
+}
+
+// GetPreferences calls go.escape.ship.proto.v1.AccountService.GetPreferences.
+func (c *accountServiceClient) GetPreferences(ctx context.Context, req *connect.Request[gen.GetPreferencesRequest]) (*connect.Response[gen.GetPreferencesResponse], error) {
+	return c.getPreferences.CallUnary(ctx, req)
+}
+
+// SetPreferences calls go.escape.ship.proto.v1.AccountService.SetPreferences.
+func (c *accountServiceClient) SetPreferences(ctx context.Context, req *connect.Request[gen.SetPreferencesRequest]) (*connect.Response[gen.SetPreferencesResponse], error) {
+	return c.setPreferences.CallUnary(ctx, req)
+}
+
+// GetMe calls go.escape.ship.proto.v1.AccountService.GetMe.
+func (c *accountServiceClient) GetMe(ctx context.Context, req *connect.Request[gen.GetMeRequest]) (*connect.Response[gen.GetMeResponse], error) {
+	return c.getMe.CallUnary(ctx, req)
+}
+
+// UpdateProfile calls go.escape.ship.proto.v1.AccountService.UpdateProfile.
+func (c *accountServiceClient) UpdateProfile(ctx context.Context, req *connect.Request[gen.UpdateProfileRequest]) (*connect.Response[gen.UpdateProfileResponse], error) {
+	return c.updateProfile.CallUnary(ctx, req)
+}
+
+// ChangePassword calls go.escape.ship.proto.v1.AccountService.ChangePassword.
+func (c *accountServiceClient) ChangePassword(ctx context.Context, req *connect.Request[gen.ChangePasswordRequest]) (*connect.Response[gen.ChangePasswordResponse], error) {
+	return c.changePassword.CallUnary(ctx, req)
+}
+
+// DeleteAccount calls go.escape.ship.proto.v1.AccountService.DeleteAccount.
+func (c *accountServiceClient) DeleteAccount(ctx context.Context, req *connect.Request[gen.DeleteAccountRequest]) (*connect.Response[gen.DeleteAccountResponse], error) {
+	return c.deleteAccount.CallUnary(ctx, req)
+}
+
+// CreateAPIKey calls go.escape.ship.proto.v1.AccountService.CreateAPIKey.
+func (c *accountServiceClient) CreateAPIKey(ctx context.Context, req *connect.Request[gen.CreateAPIKeyRequest]) (*connect.Response[gen.CreateAPIKeyResponse], error) {
+	return c.createAPIKey.CallUnary(ctx, req)
+}
+
+// RevokeAPIKey calls go.escape.ship.proto.v1.AccountService.RevokeAPIKey.
+func (c *accountServiceClient) RevokeAPIKey(ctx context.Context, req *connect.Request[gen.RevokeAPIKeyRequest]) (*connect.Response[gen.RevokeAPIKeyResponse], error) {
+	return c.revokeAPIKey.CallUnary(ctx, req)
+}
+
+// ValidateAPIKey calls go.escape.ship.proto.v1.AccountService.ValidateAPIKey.
+func (c *accountServiceClient) ValidateAPIKey(ctx context.Context, req *connect.Request[gen.ValidateAPIKeyRequest]) (*connect.Response[gen.ValidateAPIKeyResponse], error) {
+	return c.validateAPIKey.CallUnary(ctx, req)
+}
+
+// AccountServiceHandler is an implementation of the go.escape.ship.proto.v1.AccountService service.
+type AccountServiceHandler interface {
+	GetKakaoLoginURL(context.Context, *connect.Request[gen.GetKakaoLoginURLRequest]) (*connect.Response[gen.GetKakaoLoginURLResponse], error)
+	GetKakaoCallBack(context.Context, *connect.Request[gen.GetKakaoCallBackRequest]) (*connect.Response[gen.GetKakaoCallBackResponse], error)
+	Login(context.Context, *connect.Request[gen.LoginRequest]) (*connect.Response[gen.LoginResponse], error)
+	Register(context.Context, *connect.Request[gen.RegisterRequest]) (*connect.Response[gen.RegisterResponse], error)
+	// refresh_token으로 새 access/refresh 토큰 발급 (rotation: 사용한 refresh_token은 즉시 무효)
+	// 이미 사용된 refresh_token이 다시 오면 탈취로 보고 같은 계열의 토큰을 모두 폐기
+	RefreshToken(context.Context, *connect.Request[gen.RefreshTokenRequest]) (*connect.Response[gen.RefreshTokenResponse], error)
+	// 로그아웃: access 또는 refresh 토큰 폐기 (이미 무효한 토큰도 성공 처리)
+	RevokeToken(context.Context, *connect.Request[gen.RevokeTokenRequest]) (*connect.Response[gen.RevokeTokenResponse], error)
+	// 개인정보 파기 요청: 주문/결제의 PII를 삭제하되 금액 등 집계 데이터는 보존
+	AnonymizeUserData(context.Context, *connect.Request[gen.AnonymizeUserDataRequest]) (*connect.Response[gen.AnonymizeUserDataResponse], error)
+	// 약관 동의 (Authorization 헤더의 사용자 기준)
+	AcceptTerms(context.Context, *connect.Request[gen.AcceptTermsRequest]) (*connect.Response[gen.AcceptTermsResponse], error)
+	// 주문 상태 푸시 알림을 위한 디바이스 토큰 등록/해제 (FCM/APNs)
+	RegisterPushToken(context.Context, *connect.Request[gen.RegisterPushTokenRequest]) (*connect.Response[gen.RegisterPushTokenResponse], error)
+	UnregisterPushToken(context.Context, *connect.Request[gen.UnregisterPushTokenRequest]) (*connect.Response[gen.UnregisterPushTokenResponse], error)
+	// CAPTCHA 토큰 검증 (Login/Register 처리 중 내부 호출 또는 단독 사용)
+	VerifyCaptcha(context.Context, *connect.Request[gen.VerifyCaptchaRequest]) (*connect.Response[gen.VerifyCaptchaResponse], error)
+	// 관리자용: 로그인 실패로 잠긴 계정 해제
+	UnlockAccount(context.Context, *connect.Request[gen.UnlockAccountRequest]) (*connect.Response[gen.UnlockAccountResponse], error)
+	// 비회원 장바구니/이벤트 추적용 익명 토큰 발급, 가입 후 계정으로 병합 가능
+	IssueGuestToken(context.Context, *connect.Request[gen.IssueGuestTokenRequest]) (*connect.Response[gen.IssueGuestTokenResponse], error)
+	// 계정 병합 (게스트→회원, 카카오→이메일): 장바구니, 주문, 포인트, 위시리스트를 target으로 이전
+	MergeAccounts(context.Context, *connect.Request[gen.MergeAccountsRequest]) (*connect.Response[gen.MergeAccountsResponse], error)
+	// 이메일 변경: 새 주소로 인증 코드 발송 + 기존 주소로 변경 요청 알림
+	RequestEmailChange(context.Context, *connect.Request[gen.RequestEmailChangeRequest]) (*connect.Response[gen.RequestEmailChangeResponse], error)
+	// 새 주소로 받은 인증 코드 확인 후 이메일 변경 완료
+	ConfirmEmailChange(context.Context, *connect.Request[gen.ConfirmEmailChangeRequest]) (*connect.Response[gen.ConfirmEmailChangeResponse], error)
+	// 프로필 이미지 업로드: 첫 메시지는 metadata, 이후 chunk 전송
+	UploadAvatar(context.Context, *connect.ClientStream[gen.UploadAvatarRequest]) (*connect.Response[gen.UploadAvatarResponse], error)
+	// UI 설정 (언어, 통화, 테마 등) 기기 간 동기화
+	GetPreferences(context.Context, *connect.Request[gen.GetPreferencesRequest]) (*connect.Response[gen.GetPreferencesResponse], error)
+	SetPreferences(context.Context, *connect.Request[gen.SetPreferencesRequest]) (*connect.Response[gen.SetPreferencesResponse], error)
+	// 로그인한 사용자 (Authorization 헤더 기준) 프로필 조회/변경
+	GetMe(context.Context, *connect.Request[gen.GetMeRequest]) (*connect.Response[gen.GetMeResponse], error)
+	UpdateProfile(context.Context, *connect.Request[gen.UpdateProfileRequest]) (*connect.Response[gen.UpdateProfileResponse], error)
+	// 비밀번호 변경: 성공 시 현재 세션을 제외한 refresh 토큰 폐기 (선택)
+	ChangePassword(context.Context, *connect.Request[gen.ChangePasswordRequest]) (*connect.Response[gen.ChangePasswordResponse], error)
+	// 회원 탈퇴: 모든 토큰 폐기 후 유예 기간이 지나면 AnonymizeUserData로 개인정보 파기
+	DeleteAccount(context.Context, *connect.Request[gen.DeleteAccountRequest]) (*connect.Response[gen.DeleteAccountResponse], error)
+	// 관리자용: 파트너 HTTP 연동용 API 키 발급/폐기
+	CreateAPIKey(context.Context, *connect.Request[gen.CreateAPIKeyRequest]) (*connect.Response[gen.CreateAPIKeyResponse], error)
+	RevokeAPIKey(context.Context, *connect.Request[gen.RevokeAPIKeyRequest]) (*connect.Response[gen.RevokeAPIKeyResponse], error)
+	// 게이트웨이 전용: x-api-key 검증 (HTTP 매핑 없음)
+	ValidateAPIKey(context.Context, *connect.Request[gen.ValidateAPIKeyRequest]) (*connect.Response[gen.ValidateAPIKeyResponse], error)
+}
+
+// NewAccountServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAccountServiceHandler(svc AccountServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	accountServiceMethods := gen.File_account_proto.Services().ByName("AccountService").Methods()
+	accountServiceGetKakaoLoginURLHandler := connect.NewUnaryHandler(
+		AccountServiceGetKakaoLoginURLProcedure,
+		svc.GetKakaoLoginURL,
+		connect.WithSchema(accountServiceMethods.ByName("GetKakaoLoginURL")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceGetKakaoCallBackHandler := connect.NewUnaryHandler(
+		AccountServiceGetKakaoCallBackProcedure,
+		svc.GetKakaoCallBack,
+		connect.WithSchema(accountServiceMethods.ByName("GetKakaoCallBack")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceLoginHandler := connect.NewUnaryHandler(
+		AccountServiceLoginProcedure,
+		svc.Login,
+		connect.WithSchema(accountServiceMethods.ByName("Login")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceRegisterHandler := connect.NewUnaryHandler(
+		AccountServiceRegisterProcedure,
+		svc.Register,
+		connect.WithSchema(accountServiceMethods.ByName("Register")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceRefreshTokenHandler := connect.NewUnaryHandler(
+		AccountServiceRefreshTokenProcedure,
+		svc.RefreshToken,
+		connect.WithSchema(accountServiceMethods.ByName("RefreshToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceRevokeTokenHandler := connect.NewUnaryHandler(
+		AccountServiceRevokeTokenProcedure,
+		svc.RevokeToken,
+		connect.WithSchema(accountServiceMethods.ByName("RevokeToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceAnonymizeUserDataHandler := connect.NewUnaryHandler(
+		AccountServiceAnonymizeUserDataProcedure,
+		svc.AnonymizeUserData,
+		connect.WithSchema(accountServiceMethods.ByName("AnonymizeUserData")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceAcceptTermsHandler := connect.NewUnaryHandler(
+		AccountServiceAcceptTermsProcedure,
+		svc.AcceptTerms,
+		connect.WithSchema(accountServiceMethods.ByName("AcceptTerms")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceRegisterPushTokenHandler := connect.NewUnaryHandler(
+		AccountServiceRegisterPushTokenProcedure,
+		svc.RegisterPushToken,
+		connect.WithSchema(accountServiceMethods.ByName("RegisterPushToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceUnregisterPushTokenHandler := connect.NewUnaryHandler(
+		AccountServiceUnregisterPushTokenProcedure,
+		svc.UnregisterPushToken,
+		connect.WithSchema(accountServiceMethods.ByName("UnregisterPushToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceVerifyCaptchaHandler := connect.NewUnaryHandler(
+		AccountServiceVerifyCaptchaProcedure,
+		svc.VerifyCaptcha,
+		connect.WithSchema(accountServiceMethods.ByName("VerifyCaptcha")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceUnlockAccountHandler := connect.NewUnaryHandler(
+		AccountServiceUnlockAccountProcedure,
+		svc.UnlockAccount,
+		connect.WithSchema(accountServiceMethods.ByName("UnlockAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceIssueGuestTokenHandler := connect.NewUnaryHandler(
+		AccountServiceIssueGuestTokenProcedure,
+		svc.IssueGuestToken,
+		connect.WithSchema(accountServiceMethods.ByName("IssueGuestToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceMergeAccountsHandler := connect.NewUnaryHandler(
+		AccountServiceMergeAccountsProcedure,
+		svc.MergeAccounts,
+		connect.WithSchema(accountServiceMethods.ByName("MergeAccounts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceRequestEmailChangeHandler := connect.NewUnaryHandler(
+		AccountServiceRequestEmailChangeProcedure,
+		svc.RequestEmailChange,
+		connect.WithSchema(accountServiceMethods.ByName("RequestEmailChange")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceConfirmEmailChangeHandler := connect.NewUnaryHandler(
+		AccountServiceConfirmEmailChangeProcedure,
+		svc.ConfirmEmailChange,
+		connect.WithSchema(accountServiceMethods.ByName("ConfirmEmailChange")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceUploadAvatarHandler := connect.NewClientStreamHandler(
+		AccountServiceUploadAvatarProcedure,
+		svc.UploadAvatar,
+		connect.WithSchema(accountServiceMethods.ByName("UploadAvatar")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceGetPreferencesHandler := connect.NewUnaryHandler(
+		AccountServiceGetPreferencesProcedure,
+		svc.GetPreferences,
+		connect.WithSchema(accountServiceMethods.ByName("GetPreferences")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceSetPreferencesHandler := connect.NewUnaryHandler(
+		AccountServiceSetPreferencesProcedure,
+		svc.SetPreferences,
+		connect.WithSchema(accountServiceMethods.ByName("SetPreferences")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceGetMeHandler := connect.NewUnaryHandler(
+		AccountServiceGetMeProcedure,
+		svc.GetMe,
+		connect.WithSchema(accountServiceMethods.ByName("GetMe")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceUpdateProfileHandler := connect.NewUnaryHandler(
+		AccountServiceUpdateProfileProcedure,
+		svc.UpdateProfile,
+		connect.WithSchema(accountServiceMethods.ByName("UpdateProfile")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceChangePasswordHandler := connect.NewUnaryHandler(
+		AccountServiceChangePasswordProcedure,
+		svc.ChangePassword,
+		connect.WithSchema(accountServiceMethods.ByName("ChangePassword")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceDeleteAccountHandler := connect.NewUnaryHandler(
+		AccountServiceDeleteAccountProcedure,
+		svc.DeleteAccount,
+		connect.WithSchema(accountServiceMethods.ByName("DeleteAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceCreateAPIKeyHandler := connect.NewUnaryHandler(
+		AccountServiceCreateAPIKeyProcedure,
+		svc.CreateAPIKey,
+		connect.WithSchema(accountServiceMethods.ByName("CreateAPIKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceRevokeAPIKeyHandler := connect.NewUnaryHandler(
+		AccountServiceRevokeAPIKeyProcedure,
+		svc.RevokeAPIKey,
+		connect.WithSchema(accountServiceMethods.ByName("RevokeAPIKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceValidateAPIKeyHandler := connect.NewUnaryHandler(
+		AccountServiceValidateAPIKeyProcedure,
+		svc.ValidateAPIKey,
+		connect.WithSchema(accountServiceMethods.ByName("ValidateAPIKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/go.escape.ship.proto.v1.AccountService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AccountServiceGetKakaoLoginURLProcedure:
+			accountServiceGetKakaoLoginURLHandler.ServeHTTP(w, r)
+		case AccountServiceGetKakaoCallBackProcedure:
+			accountServiceGetKakaoCallBackHandler.ServeHTTP(w, r)
+		case AccountServiceLoginProcedure:
+			accountServiceLoginHandler.ServeHTTP(w, r)
+		case AccountServiceRegisterProcedure:
+			accountServiceRegisterHandler.ServeHTTP(w, r)
+		case AccountServiceRefreshTokenProcedure:
+			accountServiceRefreshTokenHandler.ServeHTTP(w, r)
+		case AccountServiceRevokeTokenProcedure:
+			accountServiceRevokeTokenHandler.ServeHTTP(w, r)
+		case AccountServiceAnonymizeUserDataProcedure:
+			accountServiceAnonymizeUserDataHandler.ServeHTTP(w, r)
+		case AccountServiceAcceptTermsProcedure:
+			accountServiceAcceptTermsHandler.ServeHTTP(w, r)
+		case AccountServiceRegisterPushTokenProcedure:
+			accountServiceRegisterPushTokenHandler.ServeHTTP(w, r)
+		case AccountServiceUnregisterPushTokenProcedure:
+			accountServiceUnregisterPushTokenHandler.ServeHTTP(w, r)
+		case AccountServiceVerifyCaptchaProcedure:
+			accountServiceVerifyCaptchaHandler.ServeHTTP(w, r)
+		case AccountServiceUnlockAccountProcedure:
+			accountServiceUnlockAccountHandler.ServeHTTP(w, r)
+		case AccountServiceIssueGuestTokenProcedure:
+			accountServiceIssueGuestTokenHandler.ServeHTTP(w, r)
+		case AccountServiceMergeAccountsProcedure:
+			accountServiceMergeAccountsHandler.ServeHTTP(w, r)
+		case AccountServiceRequestEmailChangeProcedure:
+			accountServiceRequestEmailChangeHandler.ServeHTTP(w, r)
+		case AccountServiceConfirmEmailChangeProcedure:
+			accountServiceConfirmEmailChangeHandler.ServeHTTP(w, r)
+		case AccountServiceUploadAvatarProcedure:
+			accountServiceUploadAvatarHandler.ServeHTTP(w, r)
+		case AccountServiceGetPreferencesProcedure:
+			accountServiceGetPreferencesHandler.ServeHTTP(w, r)
+		case AccountServiceSetPreferencesProcedure:
+			accountServiceSetPreferencesHandler.ServeHTTP(w, r)
+		case AccountServiceGetMeProcedure:
+			accountServiceGetMeHandler.ServeHTTP(w, r)
+		case AccountServiceUpdateProfileProcedure:
+			accountServiceUpdateProfileHandler.ServeHTTP(w, r)
+		case AccountServiceChangePasswordProcedure:
+			accountServiceChangePasswordHandler.ServeHTTP(w, r)
+		case AccountServiceDeleteAccountProcedure:
+			accountServiceDeleteAccountHandler.ServeHTTP(w, r)
+		case AccountServiceCreateAPIKeyProcedure:
+			accountServiceCreateAPIKeyHandler.ServeHTTP(w, r)
+		case AccountServiceRevokeAPIKeyProcedure:
+			accountServiceRevokeAPIKeyHandler.ServeHTTP(w, r)
+		case AccountServiceValidateAPIKeyProcedure:
+			accountServiceValidateAPIKeyHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAccountServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAccountServiceHandler struct{}
+
+func (UnimplementedAccountServiceHandler) GetKakaoLoginURL(context.Context, *connect.Request[gen.GetKakaoLoginURLRequest]) (*connect.Response[gen.GetKakaoLoginURLResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.AccountService.GetKakaoLoginURL is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) GetKakaoCallBack(context.Context, *connect.Request[gen.GetKakaoCallBackRequest]) (*connect.Response[gen.GetKakaoCallBackResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.AccountService.GetKakaoCallBack is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) Login(context.Context, *connect.Request[gen.LoginRequest]) (*connect.Response[gen.LoginResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.AccountService.Login is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) Register(context.Context, *connect.Request[gen.RegisterRequest]) (*connect.Response[gen.RegisterResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.AccountService.Register is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) RefreshToken(context.Context, *connect.Request[gen.RefreshTokenRequest]) (*connect.Response[gen.RefreshTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.AccountService.RefreshToken is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) RevokeToken(context.Context, *connect.Request[gen.RevokeTokenRequest]) (*connect.Response[gen.RevokeTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.AccountService.RevokeToken is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) AnonymizeUserData(context.Context, *connect.Request[gen.AnonymizeUserDataRequest]) (*connect.Response[gen.AnonymizeUserDataResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.AccountService.AnonymizeUserData is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) AcceptTerms(context.Context, *connect.Request[gen.AcceptTermsRequest]) (*connect.Response[gen.AcceptTermsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.AccountService.AcceptTerms is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) RegisterPushToken(context.Context, *connect.Request[gen.RegisterPushTokenRequest]) (*connect.Response[gen.RegisterPushTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.AccountService.RegisterPushToken is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) UnregisterPushToken(context.Context, *connect.Request[gen.UnregisterPushTokenRequest]) (*connect.Response[gen.UnregisterPushTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.AccountService.UnregisterPushToken is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) VerifyCaptcha(context.Context, *connect.Request[gen.VerifyCaptchaRequest]) (*connect.Response[gen.VerifyCaptchaResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.AccountService.VerifyCaptcha is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) UnlockAccount(context.Context, *connect.Request[gen.UnlockAccountRequest]) (*connect.Response[gen.UnlockAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.AccountService.UnlockAccount is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) IssueGuestToken(context.Context, *connect.Request[gen.IssueGuestTokenRequest]) (*connect.Response[gen.IssueGuestTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.AccountService.IssueGuestToken is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) MergeAccounts(context.Context, *connect.Request[gen.MergeAccountsRequest]) (*connect.Response[gen.MergeAccountsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.AccountService.MergeAccounts is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) RequestEmailChange(context.Context, *connect.Request[gen.RequestEmailChangeRequest]) (*connect.Response[gen.RequestEmailChangeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.AccountService.RequestEmailChange is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) ConfirmEmailChange(context.Context, *connect.Request[gen.ConfirmEmailChangeRequest]) (*connect.Response[gen.ConfirmEmailChangeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.AccountService.ConfirmEmailChange is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) UploadAvatar(context.Context, *connect.ClientStream[gen.UploadAvatarRequest]) (*connect.Response[gen.UploadAvatarResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.AccountService.UploadAvatar is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) GetPreferences(context.Context, *connect.Request[gen.GetPreferencesRequest]) (*connect.Response[gen.GetPreferencesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.AccountService.GetPreferences is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) SetPreferences(context.Context, *connect.Request[gen.SetPreferencesRequest]) (*connect.Response[gen.SetPreferencesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.AccountService.SetPreferences is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) GetMe(context.Context, *connect.Request[gen.GetMeRequest]) (*connect.Response[gen.GetMeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.AccountService.GetMe is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) UpdateProfile(context.Context, *connect.Request[gen.UpdateProfileRequest]) (*connect.Response[gen.UpdateProfileResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.AccountService.UpdateProfile is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) ChangePassword(context.Context, *connect.Request[gen.ChangePasswordRequest]) (*connect.Response[gen.ChangePasswordResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.AccountService.ChangePassword is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) DeleteAccount(context.Context, *connect.Request[gen.DeleteAccountRequest]) (*connect.Response[gen.DeleteAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.AccountService.DeleteAccount is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) CreateAPIKey(context.Context, *connect.Request[gen.CreateAPIKeyRequest]) (*connect.Response[gen.CreateAPIKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.AccountService.CreateAPIKey is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) RevokeAPIKey(context.Context, *connect.Request[gen.RevokeAPIKeyRequest]) (*connect.Response[gen.RevokeAPIKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.AccountService.RevokeAPIKey is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) ValidateAPIKey(context.Context, *connect.Request[gen.ValidateAPIKeyRequest]) (*connect.Response[gen.ValidateAPIKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.AccountService.ValidateAPIKey is not implemented"))
+}
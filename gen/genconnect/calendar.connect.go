@@ -0,0 +1,143 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: calendar.proto
+
+package genconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	gen "github.com/escape-ship/protos/gen"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// CalendarServiceName is the fully-qualified name of the CalendarService service.
+	CalendarServiceName = "go.escape.ship.proto.v1.CalendarService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// CalendarServiceListHolidaysProcedure is the fully-qualified name of the CalendarService's
+	// ListHolidays RPC.
+	CalendarServiceListHolidaysProcedure = "/go.escape.ship.proto.v1.CalendarService/ListHolidays"
+	// CalendarServiceGetSupportHoursProcedure is the fully-qualified name of the CalendarService's
+	// GetSupportHours RPC.
+	CalendarServiceGetSupportHoursProcedure = "/go.escape.ship.proto.v1.CalendarService/GetSupportHours"
+)
+
+// CalendarServiceClient is a client for the go.escape.ship.proto.v1.CalendarService service.
+type CalendarServiceClient interface {
+	// 기간 내 공휴일 목록 (대체/임시 공휴일, 회사 휴무일 포함)
+	ListHolidays(context.Context, *connect.Request[gen.ListHolidaysRequest]) (*connect.Response[gen.ListHolidaysResponse], error)
+	// 고객센터 운영 시간 및 현재 운영 여부
+	GetSupportHours(context.Context, *connect.Request[gen.GetSupportHoursRequest]) (*connect.Response[gen.GetSupportHoursResponse], error)
+}
+
+// NewCalendarServiceClient constructs a client for the go.escape.ship.proto.v1.CalendarService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewCalendarServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) CalendarServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	calendarServiceMethods := gen.File_calendar_proto.Services().ByName("CalendarService").Methods()
+	return &calendarServiceClient{
+		listHolidays: connect.NewClient[gen.ListHolidaysRequest, gen.ListHolidaysResponse](
+			httpClient,
+			baseURL+CalendarServiceListHolidaysProcedure,
+			connect.WithSchema(calendarServiceMethods.ByName("ListHolidays")),
+			connect.WithClientOptions(opts...),
+		),
+		getSupportHours: connect.NewClient[gen.GetSupportHoursRequest, gen.GetSupportHoursResponse](
+			httpClient,
+			baseURL+CalendarServiceGetSupportHoursProcedure,
+			connect.WithSchema(calendarServiceMethods.ByName("GetSupportHours")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// calendarServiceClient implements CalendarServiceClient.
+type calendarServiceClient struct {
+	listHolidays    *connect.Client[gen.ListHolidaysRequest, gen.ListHolidaysResponse]
+	getSupportHours *connect.Client[gen.GetSupportHoursRequest, gen.GetSupportHoursResponse]
+}
+
+// ListHolidays calls go.escape.ship.proto.v1.CalendarService.ListHolidays.
+func (c *calendarServiceClient) ListHolidays(ctx context.Context, req *connect.Request[gen.ListHolidaysRequest]) (*connect.Response[gen.ListHolidaysResponse], error) {
+	return c.listHolidays.CallUnary(ctx, req)
+}
+
+// GetSupportHours calls go.escape.ship.proto.v1.CalendarService.GetSupportHours.
+func (c *calendarServiceClient) GetSupportHours(ctx context.Context, req *connect.Request[gen.GetSupportHoursRequest]) (*connect.Response[gen.GetSupportHoursResponse], error) {
+	return c.getSupportHours.CallUnary(ctx, req)
+}
+
+// CalendarServiceHandler is an implementation of the go.escape.ship.proto.v1.CalendarService
+// service.
+type CalendarServiceHandler interface {
+	// 기간 내 공휴일 목록 (대체/임시 공휴일, 회사 휴무일 포함)
+	ListHolidays(context.Context, *connect.Request[gen.ListHolidaysRequest]) (*connect.Response[gen.ListHolidaysResponse], error)
+	// 고객센터 운영 시간 및 현재 운영 여부
+	GetSupportHours(context.Context, *connect.Request[gen.GetSupportHoursRequest]) (*connect.Response[gen.GetSupportHoursResponse], error)
+}
+
+// NewCalendarServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewCalendarServiceHandler(svc CalendarServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	calendarServiceMethods := gen.File_calendar_proto.Services().ByName("CalendarService").Methods()
+	calendarServiceListHolidaysHandler := connect.NewUnaryHandler(
+		CalendarServiceListHolidaysProcedure,
+		svc.ListHolidays,
+		connect.WithSchema(calendarServiceMethods.ByName("ListHolidays")),
+		connect.WithHandlerOptions(opts...),
+	)
+	calendarServiceGetSupportHoursHandler := connect.NewUnaryHandler(
+		CalendarServiceGetSupportHoursProcedure,
+		svc.GetSupportHours,
+		connect.WithSchema(calendarServiceMethods.ByName("GetSupportHours")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/go.escape.ship.proto.v1.CalendarService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case CalendarServiceListHolidaysProcedure:
+			calendarServiceListHolidaysHandler.ServeHTTP(w, r)
+		case CalendarServiceGetSupportHoursProcedure:
+			calendarServiceGetSupportHoursHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedCalendarServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedCalendarServiceHandler struct{}
+
+func (UnimplementedCalendarServiceHandler) ListHolidays(context.Context, *connect.Request[gen.ListHolidaysRequest]) (*connect.Response[gen.ListHolidaysResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.CalendarService.ListHolidays is not implemented"))
+}
+
+func (UnimplementedCalendarServiceHandler) GetSupportHours(context.Context, *connect.Request[gen.GetSupportHoursRequest]) (*connect.Response[gen.GetSupportHoursResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.CalendarService.GetSupportHours is not implemented"))
+}
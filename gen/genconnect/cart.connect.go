@@ -0,0 +1,229 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: cart.proto
+
+package genconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	gen "github.com/escape-ship/protos/gen"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// CartServiceName is the fully-qualified name of the CartService service.
+	CartServiceName = "go.escape.ship.proto.v1.CartService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// CartServiceGetCartProcedure is the fully-qualified name of the CartService's GetCart RPC.
+	CartServiceGetCartProcedure = "/go.escape.ship.proto.v1.CartService/GetCart"
+	// CartServiceAddItemProcedure is the fully-qualified name of the CartService's AddItem RPC.
+	CartServiceAddItemProcedure = "/go.escape.ship.proto.v1.CartService/AddItem"
+	// CartServiceRemoveItemProcedure is the fully-qualified name of the CartService's RemoveItem RPC.
+	CartServiceRemoveItemProcedure = "/go.escape.ship.proto.v1.CartService/RemoveItem"
+	// CartServiceUpdateQuantityProcedure is the fully-qualified name of the CartService's
+	// UpdateQuantity RPC.
+	CartServiceUpdateQuantityProcedure = "/go.escape.ship.proto.v1.CartService/UpdateQuantity"
+	// CartServiceClearCartProcedure is the fully-qualified name of the CartService's ClearCart RPC.
+	CartServiceClearCartProcedure = "/go.escape.ship.proto.v1.CartService/ClearCart"
+)
+
+// CartServiceClient is a client for the go.escape.ship.proto.v1.CartService service.
+type CartServiceClient interface {
+	GetCart(context.Context, *connect.Request[gen.GetCartRequest]) (*connect.Response[gen.GetCartResponse], error)
+	// 같은 상품/옵션/번들 항목이 이미 있으면 수량을 합산
+	// 재고 부족은 OUT_OF_STOCK, 고객당 구매 제한 초과는 PURCHASE_LIMIT_EXCEEDED 에러
+	AddItem(context.Context, *connect.Request[gen.AddItemRequest]) (*connect.Response[gen.AddItemResponse], error)
+	RemoveItem(context.Context, *connect.Request[gen.RemoveItemRequest]) (*connect.Response[gen.RemoveItemResponse], error)
+	// quantity가 0이면 항목 삭제
+	UpdateQuantity(context.Context, *connect.Request[gen.UpdateQuantityRequest]) (*connect.Response[gen.UpdateQuantityResponse], error)
+	// 주문 완료 후 또는 사용자 요청 시 전체 비우기
+	ClearCart(context.Context, *connect.Request[gen.ClearCartRequest]) (*connect.Response[gen.ClearCartResponse], error)
+}
+
+// NewCartServiceClient constructs a client for the go.escape.ship.proto.v1.CartService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewCartServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) CartServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	cartServiceMethods := gen.File_cart_proto.Services().ByName("CartService").Methods()
+	return &cartServiceClient{
+		getCart: connect.NewClient[gen.GetCartRequest, gen.GetCartResponse](
+			httpClient,
+			baseURL+CartServiceGetCartProcedure,
+			connect.WithSchema(cartServiceMethods.ByName("GetCart")),
+			connect.WithClientOptions(opts...),
+		),
+		addItem: connect.NewClient[gen.AddItemRequest, gen.AddItemResponse](
+			httpClient,
+			baseURL+CartServiceAddItemProcedure,
+			connect.WithSchema(cartServiceMethods.ByName("AddItem")),
+			connect.WithClientOptions(opts...),
+		),
+		removeItem: connect.NewClient[gen.RemoveItemRequest, gen.RemoveItemResponse](
+			httpClient,
+			baseURL+CartServiceRemoveItemProcedure,
+			connect.WithSchema(cartServiceMethods.ByName("RemoveItem")),
+			connect.WithClientOptions(opts...),
+		),
+		updateQuantity: connect.NewClient[gen.UpdateQuantityRequest, gen.UpdateQuantityResponse](
+			httpClient,
+			baseURL+CartServiceUpdateQuantityProcedure,
+			connect.WithSchema(cartServiceMethods.ByName("UpdateQuantity")),
+			connect.WithClientOptions(opts...),
+		),
+		clearCart: connect.NewClient[gen.ClearCartRequest, gen.ClearCartResponse](
+			httpClient,
+			baseURL+CartServiceClearCartProcedure,
+			connect.WithSchema(cartServiceMethods.ByName("ClearCart")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// cartServiceClient implements CartServiceClient.
+type cartServiceClient struct {
+	getCart        *connect.Client[gen.GetCartRequest, gen.GetCartResponse]
+	addItem        *connect.Client[gen.AddItemRequest, gen.AddItemResponse]
+	removeItem     *connect.Client[gen.RemoveItemRequest, gen.RemoveItemResponse]
+	updateQuantity *connect.Client[gen.UpdateQuantityRequest, gen.UpdateQuantityResponse]
+	clearCart      *connect.Client[gen.ClearCartRequest, gen.ClearCartResponse]
+}
+
+// GetCart calls go.escape.ship.proto.v1.CartService.GetCart.
+func (c *cartServiceClient) GetCart(ctx context.Context, req *connect.Request[gen.GetCartRequest]) (*connect.Response[gen.GetCartResponse], error) {
+	return c.getCart.CallUnary(ctx, req)
+}
+
+// AddItem calls go.escape.ship.proto.v1.CartService.AddItem.
+func (c *cartServiceClient) AddItem(ctx context.Context, req *connect.Request[gen.AddItemRequest]) (*connect.Response[gen.AddItemResponse], error) {
+	return c.addItem.CallUnary(ctx, req)
+}
+
+// RemoveItem calls go.escape.ship.proto.v1.CartService.RemoveItem.
+func (c *cartServiceClient) RemoveItem(ctx context.Context, req *connect.Request[gen.RemoveItemRequest]) (*connect.Response[gen.RemoveItemResponse], error) {
+	return c.removeItem.CallUnary(ctx, req)
+}
+
+// UpdateQuantity calls go.escape.ship.proto.v1.CartService.UpdateQuantity.
+func (c *cartServiceClient) UpdateQuantity(ctx context.Context, req *connect.Request[gen.UpdateQuantityRequest]) (*connect.Response[gen.UpdateQuantityResponse], error) {
+	return c.updateQuantity.CallUnary(ctx, req)
+}
+
+// ClearCart calls go.escape.ship.proto.v1.CartService.ClearCart.
+func (c *cartServiceClient) ClearCart(ctx context.Context, req *connect.Request[gen.ClearCartRequest]) (*connect.Response[gen.ClearCartResponse], error) {
+	return c.clearCart.CallUnary(ctx, req)
+}
+
+// CartServiceHandler is an implementation of the go.escape.ship.proto.v1.CartService service.
+type CartServiceHandler interface {
+	GetCart(context.Context, *connect.Request[gen.GetCartRequest]) (*connect.Response[gen.GetCartResponse], error)
+	// 같은 상품/옵션/번들 항목이 이미 있으면 수량을 합산
+	// 재고 부족은 OUT_OF_STOCK, 고객당 구매 제한 초과는 PURCHASE_LIMIT_EXCEEDED 에러
+	AddItem(context.Context, *connect.Request[gen.AddItemRequest]) (*connect.Response[gen.AddItemResponse], error)
+	RemoveItem(context.Context, *connect.Request[gen.RemoveItemRequest]) (*connect.Response[gen.RemoveItemResponse], error)
+	// quantity가 0이면 항목 삭제
+	UpdateQuantity(context.Context, *connect.Request[gen.UpdateQuantityRequest]) (*connect.Response[gen.UpdateQuantityResponse], error)
+	// 주문 완료 후 또는 사용자 요청 시 전체 비우기
+	ClearCart(context.Context, *connect.Request[gen.ClearCartRequest]) (*connect.Response[gen.ClearCartResponse], error)
+}
+
+// NewCartServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewCartServiceHandler(svc CartServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	cartServiceMethods := gen.File_cart_proto.Services().ByName("CartService").Methods()
+	cartServiceGetCartHandler := connect.NewUnaryHandler(
+		CartServiceGetCartProcedure,
+		svc.GetCart,
+		connect.WithSchema(cartServiceMethods.ByName("GetCart")),
+		connect.WithHandlerOptions(opts...),
+	)
+	cartServiceAddItemHandler := connect.NewUnaryHandler(
+		CartServiceAddItemProcedure,
+		svc.AddItem,
+		connect.WithSchema(cartServiceMethods.ByName("AddItem")),
+		connect.WithHandlerOptions(opts...),
+	)
+	cartServiceRemoveItemHandler := connect.NewUnaryHandler(
+		CartServiceRemoveItemProcedure,
+		svc.RemoveItem,
+		connect.WithSchema(cartServiceMethods.ByName("RemoveItem")),
+		connect.WithHandlerOptions(opts...),
+	)
+	cartServiceUpdateQuantityHandler := connect.NewUnaryHandler(
+		CartServiceUpdateQuantityProcedure,
+		svc.UpdateQuantity,
+		connect.WithSchema(cartServiceMethods.ByName("UpdateQuantity")),
+		connect.WithHandlerOptions(opts...),
+	)
+	cartServiceClearCartHandler := connect.NewUnaryHandler(
+		CartServiceClearCartProcedure,
+		svc.ClearCart,
+		connect.WithSchema(cartServiceMethods.ByName("ClearCart")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/go.escape.ship.proto.v1.CartService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case CartServiceGetCartProcedure:
+			cartServiceGetCartHandler.ServeHTTP(w, r)
+		case CartServiceAddItemProcedure:
+			cartServiceAddItemHandler.ServeHTTP(w, r)
+		case CartServiceRemoveItemProcedure:
+			cartServiceRemoveItemHandler.ServeHTTP(w, r)
+		case CartServiceUpdateQuantityProcedure:
+			cartServiceUpdateQuantityHandler.ServeHTTP(w, r)
+		case CartServiceClearCartProcedure:
+			cartServiceClearCartHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedCartServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedCartServiceHandler struct{}
+
+func (UnimplementedCartServiceHandler) GetCart(context.Context, *connect.Request[gen.GetCartRequest]) (*connect.Response[gen.GetCartResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.CartService.GetCart is not implemented"))
+}
+
+func (UnimplementedCartServiceHandler) AddItem(context.Context, *connect.Request[gen.AddItemRequest]) (*connect.Response[gen.AddItemResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.CartService.AddItem is not implemented"))
+}
+
+func (UnimplementedCartServiceHandler) RemoveItem(context.Context, *connect.Request[gen.RemoveItemRequest]) (*connect.Response[gen.RemoveItemResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.CartService.RemoveItem is not implemented"))
+}
+
+func (UnimplementedCartServiceHandler) UpdateQuantity(context.Context, *connect.Request[gen.UpdateQuantityRequest]) (*connect.Response[gen.UpdateQuantityResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.CartService.UpdateQuantity is not implemented"))
+}
+
+func (UnimplementedCartServiceHandler) ClearCart(context.Context, *connect.Request[gen.ClearCartRequest]) (*connect.Response[gen.ClearCartResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.CartService.ClearCart is not implemented"))
+}
@@ -0,0 +1,174 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: chat.proto
+
+package genconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	gen "github.com/escape-ship/protos/gen"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ChatServiceName is the fully-qualified name of the ChatService service.
+	ChatServiceName = "go.escape.ship.proto.v1.ChatService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ChatServiceOpenConversationProcedure is the fully-qualified name of the ChatService's
+	// OpenConversation RPC.
+	ChatServiceOpenConversationProcedure = "/go.escape.ship.proto.v1.ChatService/OpenConversation"
+	// ChatServiceListChatMessagesProcedure is the fully-qualified name of the ChatService's
+	// ListChatMessages RPC.
+	ChatServiceListChatMessagesProcedure = "/go.escape.ship.proto.v1.ChatService/ListChatMessages"
+	// ChatServiceChatProcedure is the fully-qualified name of the ChatService's Chat RPC.
+	ChatServiceChatProcedure = "/go.escape.ship.proto.v1.ChatService/Chat"
+)
+
+// ChatServiceClient is a client for the go.escape.ship.proto.v1.ChatService service.
+type ChatServiceClient interface {
+	// 주문/티켓에 대한 대화방 생성 또는 기존 대화방 반환
+	OpenConversation(context.Context, *connect.Request[gen.OpenConversationRequest]) (*connect.Response[gen.OpenConversationResponse], error)
+	// 저장된 메시지 조회 (최신순, 페이지네이션)
+	ListChatMessages(context.Context, *connect.Request[gen.ListChatMessagesRequest]) (*connect.Response[gen.ListChatMessagesResponse], error)
+	// 실시간 양방향 채팅 스트림 (gRPC 전용, HTTP 매핑 없음)
+	// 첫 요청에 conversation_id를 포함해야 하며 서버는 수신한 메시지를 저장 후 브로드캐스트
+	Chat(context.Context) *connect.BidiStreamForClient[gen.ChatRequest, gen.ChatResponse]
+}
+
+// NewChatServiceClient constructs a client for the go.escape.ship.proto.v1.ChatService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewChatServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ChatServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	chatServiceMethods := gen.File_chat_proto.Services().ByName("ChatService").Methods()
+	return &chatServiceClient{
+		openConversation: connect.NewClient[gen.OpenConversationRequest, gen.OpenConversationResponse](
+			httpClient,
+			baseURL+ChatServiceOpenConversationProcedure,
+			connect.WithSchema(chatServiceMethods.ByName("OpenConversation")),
+			connect.WithClientOptions(opts...),
+		),
+		listChatMessages: connect.NewClient[gen.ListChatMessagesRequest, gen.ListChatMessagesResponse](
+			httpClient,
+			baseURL+ChatServiceListChatMessagesProcedure,
+			connect.WithSchema(chatServiceMethods.ByName("ListChatMessages")),
+			connect.WithClientOptions(opts...),
+		),
+		chat: connect.NewClient[gen.ChatRequest, gen.ChatResponse](
+			httpClient,
+			baseURL+ChatServiceChatProcedure,
+			connect.WithSchema(chatServiceMethods.ByName("Chat")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// chatServiceClient implements ChatServiceClient.
+type chatServiceClient struct {
+	openConversation *connect.Client[gen.OpenConversationRequest, gen.OpenConversationResponse]
+	listChatMessages *connect.Client[gen.ListChatMessagesRequest, gen.ListChatMessagesResponse]
+	chat             *connect.Client[gen.ChatRequest, gen.ChatResponse]
+}
+
+// OpenConversation calls go.escape.ship.proto.v1.ChatService.OpenConversation.
+func (c *chatServiceClient) OpenConversation(ctx context.Context, req *connect.Request[gen.OpenConversationRequest]) (*connect.Response[gen.OpenConversationResponse], error) {
+	return c.openConversation.CallUnary(ctx, req)
+}
+
+// ListChatMessages calls go.escape.ship.proto.v1.ChatService.ListChatMessages.
+func (c *chatServiceClient) ListChatMessages(ctx context.Context, req *connect.Request[gen.ListChatMessagesRequest]) (*connect.Response[gen.ListChatMessagesResponse], error) {
+	return c.listChatMessages.CallUnary(ctx, req)
+}
+
+// Chat calls go.escape.ship.proto.v1.ChatService.Chat.
+func (c *chatServiceClient) Chat(ctx context.Context) *connect.BidiStreamForClient[gen.ChatRequest, gen.ChatResponse] {
+	return c.chat.CallBidiStream(ctx)
+}
+
+// ChatServiceHandler is an implementation of the go.escape.ship.proto.v1.ChatService service.
+type ChatServiceHandler interface {
+	// 주문/티켓에 대한 대화방 생성 또는 기존 대화방 반환
+	OpenConversation(context.Context, *connect.Request[gen.OpenConversationRequest]) (*connect.Response[gen.OpenConversationResponse], error)
+	// 저장된 메시지 조회 (최신순, 페이지네이션)
+	ListChatMessages(context.Context, *connect.Request[gen.ListChatMessagesRequest]) (*connect.Response[gen.ListChatMessagesResponse], error)
+	// 실시간 양방향 채팅 스트림 (gRPC 전용, HTTP 매핑 없음)
+	// 첫 요청에 conversation_id를 포함해야 하며 서버는 수신한 메시지를 저장 후 브로드캐스트
+	Chat(context.Context, *connect.BidiStream[gen.ChatRequest, gen.ChatResponse]) error
+}
+
+// NewChatServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewChatServiceHandler(svc ChatServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	chatServiceMethods := gen.File_chat_proto.Services().ByName("ChatService").Methods()
+	chatServiceOpenConversationHandler := connect.NewUnaryHandler(
+		ChatServiceOpenConversationProcedure,
+		svc.OpenConversation,
+		connect.WithSchema(chatServiceMethods.ByName("OpenConversation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	chatServiceListChatMessagesHandler := connect.NewUnaryHandler(
+		ChatServiceListChatMessagesProcedure,
+		svc.ListChatMessages,
+		connect.WithSchema(chatServiceMethods.ByName("ListChatMessages")),
+		connect.WithHandlerOptions(opts...),
+	)
+	chatServiceChatHandler := connect.NewBidiStreamHandler(
+		ChatServiceChatProcedure,
+		svc.Chat,
+		connect.WithSchema(chatServiceMethods.ByName("Chat")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/go.escape.ship.proto.v1.ChatService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ChatServiceOpenConversationProcedure:
+			chatServiceOpenConversationHandler.ServeHTTP(w, r)
+		case ChatServiceListChatMessagesProcedure:
+			chatServiceListChatMessagesHandler.ServeHTTP(w, r)
+		case ChatServiceChatProcedure:
+			chatServiceChatHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedChatServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedChatServiceHandler struct{}
+
+func (UnimplementedChatServiceHandler) OpenConversation(context.Context, *connect.Request[gen.OpenConversationRequest]) (*connect.Response[gen.OpenConversationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.ChatService.OpenConversation is not implemented"))
+}
+
+func (UnimplementedChatServiceHandler) ListChatMessages(context.Context, *connect.Request[gen.ListChatMessagesRequest]) (*connect.Response[gen.ListChatMessagesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.ChatService.ListChatMessages is not implemented"))
+}
+
+func (UnimplementedChatServiceHandler) Chat(context.Context, *connect.BidiStream[gen.ChatRequest, gen.ChatResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.ChatService.Chat is not implemented"))
+}
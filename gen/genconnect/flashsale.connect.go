@@ -0,0 +1,232 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: flashsale.proto
+
+package genconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	gen "github.com/escape-ship/protos/gen"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// FlashSaleServiceName is the fully-qualified name of the FlashSaleService service.
+	FlashSaleServiceName = "go.escape.ship.proto.v1.FlashSaleService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// FlashSaleServiceCreateFlashSaleProcedure is the fully-qualified name of the FlashSaleService's
+	// CreateFlashSale RPC.
+	FlashSaleServiceCreateFlashSaleProcedure = "/go.escape.ship.proto.v1.FlashSaleService/CreateFlashSale"
+	// FlashSaleServiceGetFlashSaleProcedure is the fully-qualified name of the FlashSaleService's
+	// GetFlashSale RPC.
+	FlashSaleServiceGetFlashSaleProcedure = "/go.escape.ship.proto.v1.FlashSaleService/GetFlashSale"
+	// FlashSaleServiceGetQueuePositionProcedure is the fully-qualified name of the FlashSaleService's
+	// GetQueuePosition RPC.
+	FlashSaleServiceGetQueuePositionProcedure = "/go.escape.ship.proto.v1.FlashSaleService/GetQueuePosition"
+	// FlashSaleServiceIssueQueueTokenProcedure is the fully-qualified name of the FlashSaleService's
+	// IssueQueueToken RPC.
+	FlashSaleServiceIssueQueueTokenProcedure = "/go.escape.ship.proto.v1.FlashSaleService/IssueQueueToken"
+	// FlashSaleServiceValidateQueueTokenProcedure is the fully-qualified name of the FlashSaleService's
+	// ValidateQueueToken RPC.
+	FlashSaleServiceValidateQueueTokenProcedure = "/go.escape.ship.proto.v1.FlashSaleService/ValidateQueueToken"
+)
+
+// FlashSaleServiceClient is a client for the go.escape.ship.proto.v1.FlashSaleService service.
+type FlashSaleServiceClient interface {
+	CreateFlashSale(context.Context, *connect.Request[gen.CreateFlashSaleRequest]) (*connect.Response[gen.CreateFlashSaleResponse], error)
+	GetFlashSale(context.Context, *connect.Request[gen.GetFlashSaleRequest]) (*connect.Response[gen.GetFlashSaleResponse], error)
+	// 대기열 내 현재 순번 조회 (클라이언트 폴링용)
+	GetQueuePosition(context.Context, *connect.Request[gen.GetQueuePositionRequest]) (*connect.Response[gen.GetQueuePositionResponse], error)
+	// 대기열 진입 및 대기열 토큰 발급 (재호출 시 기존 순번 유지)
+	IssueQueueToken(context.Context, *connect.Request[gen.IssueQueueTokenRequest]) (*connect.Response[gen.IssueQueueTokenResponse], error)
+	// 게이트웨이가 결제/주문 진입 전 x-queue-token 헤더 값을 검증
+	ValidateQueueToken(context.Context, *connect.Request[gen.ValidateQueueTokenRequest]) (*connect.Response[gen.ValidateQueueTokenResponse], error)
+}
+
+// NewFlashSaleServiceClient constructs a client for the go.escape.ship.proto.v1.FlashSaleService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewFlashSaleServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) FlashSaleServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	flashSaleServiceMethods := gen.File_flashsale_proto.Services().ByName("FlashSaleService").Methods()
+	return &flashSaleServiceClient{
+		createFlashSale: connect.NewClient[gen.CreateFlashSaleRequest, gen.CreateFlashSaleResponse](
+			httpClient,
+			baseURL+FlashSaleServiceCreateFlashSaleProcedure,
+			connect.WithSchema(flashSaleServiceMethods.ByName("CreateFlashSale")),
+			connect.WithClientOptions(opts...),
+		),
+		getFlashSale: connect.NewClient[gen.GetFlashSaleRequest, gen.GetFlashSaleResponse](
+			httpClient,
+			baseURL+FlashSaleServiceGetFlashSaleProcedure,
+			connect.WithSchema(flashSaleServiceMethods.ByName("GetFlashSale")),
+			connect.WithClientOptions(opts...),
+		),
+		getQueuePosition: connect.NewClient[gen.GetQueuePositionRequest, gen.GetQueuePositionResponse](
+			httpClient,
+			baseURL+FlashSaleServiceGetQueuePositionProcedure,
+			connect.WithSchema(flashSaleServiceMethods.ByName("GetQueuePosition")),
+			connect.WithClientOptions(opts...),
+		),
+		issueQueueToken: connect.NewClient[gen.IssueQueueTokenRequest, gen.IssueQueueTokenResponse](
+			httpClient,
+			baseURL+FlashSaleServiceIssueQueueTokenProcedure,
+			connect.WithSchema(flashSaleServiceMethods.ByName("IssueQueueToken")),
+			connect.WithClientOptions(opts...),
+		),
+		validateQueueToken: connect.NewClient[gen.ValidateQueueTokenRequest, gen.ValidateQueueTokenResponse](
+			httpClient,
+			baseURL+FlashSaleServiceValidateQueueTokenProcedure,
+			connect.WithSchema(flashSaleServiceMethods.ByName("ValidateQueueToken")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// flashSaleServiceClient implements FlashSaleServiceClient.
+type flashSaleServiceClient struct {
+	createFlashSale    *connect.Client[gen.CreateFlashSaleRequest, gen.CreateFlashSaleResponse]
+	getFlashSale       *connect.Client[gen.GetFlashSaleRequest, gen.GetFlashSaleResponse]
+	getQueuePosition   *connect.Client[gen.GetQueuePositionRequest, gen.GetQueuePositionResponse]
+	issueQueueToken    *connect.Client[gen.IssueQueueTokenRequest, gen.IssueQueueTokenResponse]
+	validateQueueToken *connect.Client[gen.ValidateQueueTokenRequest, gen.ValidateQueueTokenResponse]
+}
+
+// CreateFlashSale calls go.escape.ship.proto.v1.FlashSaleService.CreateFlashSale.
+func (c *flashSaleServiceClient) CreateFlashSale(ctx context.Context, req *connect.Request[gen.CreateFlashSaleRequest]) (*connect.Response[gen.CreateFlashSaleResponse], error) {
+	return c.createFlashSale.CallUnary(ctx, req)
+}
+
+// GetFlashSale calls go.escape.ship.proto.v1.FlashSaleService.GetFlashSale.
+func (c *flashSaleServiceClient) GetFlashSale(ctx context.Context, req *connect.Request[gen.GetFlashSaleRequest]) (*connect.Response[gen.GetFlashSaleResponse], error) {
+	return c.getFlashSale.CallUnary(ctx, req)
+}
+
+// GetQueuePosition calls go.escape.ship.proto.v1.FlashSaleService.GetQueuePosition.
+func (c *flashSaleServiceClient) GetQueuePosition(ctx context.Context, req *connect.Request[gen.GetQueuePositionRequest]) (*connect.Response[gen.GetQueuePositionResponse], error) {
+	return c.getQueuePosition.CallUnary(ctx, req)
+}
+
+// IssueQueueToken calls go.escape.ship.proto.v1.FlashSaleService.IssueQueueToken.
+func (c *flashSaleServiceClient) IssueQueueToken(ctx context.Context, req *connect.Request[gen.IssueQueueTokenRequest]) (*connect.Response[gen.IssueQueueTokenResponse], error) {
+	return c.issueQueueToken.CallUnary(ctx, req)
+}
+
+// ValidateQueueToken calls go.escape.ship.proto.v1.FlashSaleService.ValidateQueueToken.
+func (c *flashSaleServiceClient) ValidateQueueToken(ctx context.Context, req *connect.Request[gen.ValidateQueueTokenRequest]) (*connect.Response[gen.ValidateQueueTokenResponse], error) {
+	return c.validateQueueToken.CallUnary(ctx, req)
+}
+
+// FlashSaleServiceHandler is an implementation of the go.escape.ship.proto.v1.FlashSaleService
+// service.
+type FlashSaleServiceHandler interface {
+	CreateFlashSale(context.Context, *connect.Request[gen.CreateFlashSaleRequest]) (*connect.Response[gen.CreateFlashSaleResponse], error)
+	GetFlashSale(context.Context, *connect.Request[gen.GetFlashSaleRequest]) (*connect.Response[gen.GetFlashSaleResponse], error)
+	// 대기열 내 현재 순번 조회 (클라이언트 폴링용)
+	GetQueuePosition(context.Context, *connect.Request[gen.GetQueuePositionRequest]) (*connect.Response[gen.GetQueuePositionResponse], error)
+	// 대기열 진입 및 대기열 토큰 발급 (재호출 시 기존 순번 유지)
+	IssueQueueToken(context.Context, *connect.Request[gen.IssueQueueTokenRequest]) (*connect.Response[gen.IssueQueueTokenResponse], error)
+	// 게이트웨이가 결제/주문 진입 전 x-queue-token 헤더 값을 검증
+	ValidateQueueToken(context.Context, *connect.Request[gen.ValidateQueueTokenRequest]) (*connect.Response[gen.ValidateQueueTokenResponse], error)
+}
+
+// NewFlashSaleServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewFlashSaleServiceHandler(svc FlashSaleServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	flashSaleServiceMethods := gen.File_flashsale_proto.Services().ByName("FlashSaleService").Methods()
+	flashSaleServiceCreateFlashSaleHandler := connect.NewUnaryHandler(
+		FlashSaleServiceCreateFlashSaleProcedure,
+		svc.CreateFlashSale,
+		connect.WithSchema(flashSaleServiceMethods.ByName("CreateFlashSale")),
+		connect.WithHandlerOptions(opts...),
+	)
+	flashSaleServiceGetFlashSaleHandler := connect.NewUnaryHandler(
+		FlashSaleServiceGetFlashSaleProcedure,
+		svc.GetFlashSale,
+		connect.WithSchema(flashSaleServiceMethods.ByName("GetFlashSale")),
+		connect.WithHandlerOptions(opts...),
+	)
+	flashSaleServiceGetQueuePositionHandler := connect.NewUnaryHandler(
+		FlashSaleServiceGetQueuePositionProcedure,
+		svc.GetQueuePosition,
+		connect.WithSchema(flashSaleServiceMethods.ByName("GetQueuePosition")),
+		connect.WithHandlerOptions(opts...),
+	)
+	flashSaleServiceIssueQueueTokenHandler := connect.NewUnaryHandler(
+		FlashSaleServiceIssueQueueTokenProcedure,
+		svc.IssueQueueToken,
+		connect.WithSchema(flashSaleServiceMethods.ByName("IssueQueueToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	flashSaleServiceValidateQueueTokenHandler := connect.NewUnaryHandler(
+		FlashSaleServiceValidateQueueTokenProcedure,
+		svc.ValidateQueueToken,
+		connect.WithSchema(flashSaleServiceMethods.ByName("ValidateQueueToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/go.escape.ship.proto.v1.FlashSaleService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case FlashSaleServiceCreateFlashSaleProcedure:
+			flashSaleServiceCreateFlashSaleHandler.ServeHTTP(w, r)
+		case FlashSaleServiceGetFlashSaleProcedure:
+			flashSaleServiceGetFlashSaleHandler.ServeHTTP(w, r)
+		case FlashSaleServiceGetQueuePositionProcedure:
+			flashSaleServiceGetQueuePositionHandler.ServeHTTP(w, r)
+		case FlashSaleServiceIssueQueueTokenProcedure:
+			flashSaleServiceIssueQueueTokenHandler.ServeHTTP(w, r)
+		case FlashSaleServiceValidateQueueTokenProcedure:
+			flashSaleServiceValidateQueueTokenHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedFlashSaleServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedFlashSaleServiceHandler struct{}
+
+func (UnimplementedFlashSaleServiceHandler) CreateFlashSale(context.Context, *connect.Request[gen.CreateFlashSaleRequest]) (*connect.Response[gen.CreateFlashSaleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.FlashSaleService.CreateFlashSale is not implemented"))
+}
+
+func (UnimplementedFlashSaleServiceHandler) GetFlashSale(context.Context, *connect.Request[gen.GetFlashSaleRequest]) (*connect.Response[gen.GetFlashSaleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.FlashSaleService.GetFlashSale is not implemented"))
+}
+
+func (UnimplementedFlashSaleServiceHandler) GetQueuePosition(context.Context, *connect.Request[gen.GetQueuePositionRequest]) (*connect.Response[gen.GetQueuePositionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.FlashSaleService.GetQueuePosition is not implemented"))
+}
+
+func (UnimplementedFlashSaleServiceHandler) IssueQueueToken(context.Context, *connect.Request[gen.IssueQueueTokenRequest]) (*connect.Response[gen.IssueQueueTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.FlashSaleService.IssueQueueToken is not implemented"))
+}
+
+func (UnimplementedFlashSaleServiceHandler) ValidateQueueToken(context.Context, *connect.Request[gen.ValidateQueueTokenRequest]) (*connect.Response[gen.ValidateQueueTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.FlashSaleService.ValidateQueueToken is not implemented"))
+}
@@ -0,0 +1,271 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: inventory.proto
+
+package genconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	gen "github.com/escape-ship/protos/gen"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// InventoryServiceName is the fully-qualified name of the InventoryService service.
+	InventoryServiceName = "go.escape.ship.proto.v1.InventoryService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// InventoryServiceWatchLowStockProcedure is the fully-qualified name of the InventoryService's
+	// WatchLowStock RPC.
+	InventoryServiceWatchLowStockProcedure = "/go.escape.ship.proto.v1.InventoryService/WatchLowStock"
+	// InventoryServiceGetStockProcedure is the fully-qualified name of the InventoryService's GetStock
+	// RPC.
+	InventoryServiceGetStockProcedure = "/go.escape.ship.proto.v1.InventoryService/GetStock"
+	// InventoryServiceReserveStockProcedure is the fully-qualified name of the InventoryService's
+	// ReserveStock RPC.
+	InventoryServiceReserveStockProcedure = "/go.escape.ship.proto.v1.InventoryService/ReserveStock"
+	// InventoryServiceReleaseReservationProcedure is the fully-qualified name of the InventoryService's
+	// ReleaseReservation RPC.
+	InventoryServiceReleaseReservationProcedure = "/go.escape.ship.proto.v1.InventoryService/ReleaseReservation"
+	// InventoryServiceAdjustStockProcedure is the fully-qualified name of the InventoryService's
+	// AdjustStock RPC.
+	InventoryServiceAdjustStockProcedure = "/go.escape.ship.proto.v1.InventoryService/AdjustStock"
+	// InventoryServiceAuditInventoryProcedure is the fully-qualified name of the InventoryService's
+	// AuditInventory RPC.
+	InventoryServiceAuditInventoryProcedure = "/go.escape.ship.proto.v1.InventoryService/AuditInventory"
+)
+
+// InventoryServiceClient is a client for the go.escape.ship.proto.v1.InventoryService service.
+type InventoryServiceClient interface {
+	// 재고가 threshold 이하로 떨어진 상품을 실시간으로 전달 (운영 알림용)
+	WatchLowStock(context.Context, *connect.Request[gen.WatchLowStockRequest]) (*connect.ServerStreamForClient[gen.WatchLowStockResponse], error)
+	// 상품의 옵션 조합별 재고 조회 (주문 전 재고 확인용)
+	GetStock(context.Context, *connect.Request[gen.GetStockRequest]) (*connect.Response[gen.GetStockResponse], error)
+	// 주문/결제 진행 중 재고 선점 (전체 항목 성공 또는 전체 실패)
+	// 재고 부족 시 FAILED_PRECONDITION + ERROR_REASON_OUT_OF_STOCK
+	ReserveStock(context.Context, *connect.Request[gen.ReserveStockRequest]) (*connect.Response[gen.ReserveStockResponse], error)
+	// 선점 해제 (결제 실패/취소), 이미 해제·만료된 선점은 성공으로 처리
+	ReleaseReservation(context.Context, *connect.Request[gen.ReleaseReservationRequest]) (*connect.Response[gen.ReleaseReservationResponse], error)
+	// 입고/실사 등으로 보유 재고 수량 조정 (운영자용)
+	AdjustStock(context.Context, *connect.Request[gen.AdjustStockRequest]) (*connect.Response[gen.AdjustStockResponse], error)
+	// 선점·주문·재고 수량 간 불일치(초과 판매, 미해제 선점 등)와 보정 방법 조회 (야간 정합성 점검용)
+	// 조회만 하며, 보정은 제안된 AdjustStock/ReleaseReservation 요청을 검토 후 실행
+	AuditInventory(context.Context, *connect.Request[gen.AuditInventoryRequest]) (*connect.Response[gen.AuditInventoryResponse], error)
+}
+
+// NewInventoryServiceClient constructs a client for the go.escape.ship.proto.v1.InventoryService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewInventoryServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) InventoryServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	inventoryServiceMethods := gen.File_inventory_proto.Services().ByName("InventoryService").Methods()
+	return &inventoryServiceClient{
+		watchLowStock: connect.NewClient[gen.WatchLowStockRequest, gen.WatchLowStockResponse](
+			httpClient,
+			baseURL+InventoryServiceWatchLowStockProcedure,
+			connect.WithSchema(inventoryServiceMethods.ByName("WatchLowStock")),
+			connect.WithClientOptions(opts...),
+		),
+		getStock: connect.NewClient[gen.GetStockRequest, gen.GetStockResponse](
+			httpClient,
+			baseURL+InventoryServiceGetStockProcedure,
+			connect.WithSchema(inventoryServiceMethods.ByName("GetStock")),
+			connect.WithClientOptions(opts...),
+		),
+		reserveStock: connect.NewClient[gen.ReserveStockRequest, gen.ReserveStockResponse](
+			httpClient,
+			baseURL+InventoryServiceReserveStockProcedure,
+			connect.WithSchema(inventoryServiceMethods.ByName("ReserveStock")),
+			connect.WithClientOptions(opts...),
+		),
+		releaseReservation: connect.NewClient[gen.ReleaseReservationRequest, gen.ReleaseReservationResponse](
+			httpClient,
+			baseURL+InventoryServiceReleaseReservationProcedure,
+			connect.WithSchema(inventoryServiceMethods.ByName("ReleaseReservation")),
+			connect.WithClientOptions(opts...),
+		),
+		adjustStock: connect.NewClient[gen.AdjustStockRequest, gen.AdjustStockResponse](
+			httpClient,
+			baseURL+InventoryServiceAdjustStockProcedure,
+			connect.WithSchema(inventoryServiceMethods.ByName("AdjustStock")),
+			connect.WithClientOptions(opts...),
+		),
+		auditInventory: connect.NewClient[gen.AuditInventoryRequest, gen.AuditInventoryResponse](
+			httpClient,
+			baseURL+InventoryServiceAuditInventoryProcedure,
+			connect.WithSchema(inventoryServiceMethods.ByName("AuditInventory")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// inventoryServiceClient implements InventoryServiceClient.
+type inventoryServiceClient struct {
+	watchLowStock      *connect.Client[gen.WatchLowStockRequest, gen.WatchLowStockResponse]
+	getStock           *connect.Client[gen.GetStockRequest, gen.GetStockResponse]
+	reserveStock       *connect.Client[gen.ReserveStockRequest, gen.ReserveStockResponse]
+	releaseReservation *connect.Client[gen.ReleaseReservationRequest, gen.ReleaseReservationResponse]
+	adjustStock        *connect.Client[gen.AdjustStockRequest, gen.AdjustStockResponse]
+	auditInventory     *connect.Client[gen.AuditInventoryRequest, gen.AuditInventoryResponse]
+}
+
+// WatchLowStock calls go.escape.ship.proto.v1.InventoryService.WatchLowStock.
+func (c *inventoryServiceClient) WatchLowStock(ctx context.Context, req *connect.Request[gen.WatchLowStockRequest]) (*connect.ServerStreamForClient[gen.WatchLowStockResponse], error) {
+	return c.watchLowStock.CallServerStream(ctx, req)
+}
+
+// GetStock calls go.escape.ship.proto.v1.InventoryService.GetStock.
+func (c *inventoryServiceClient) GetStock(ctx context.Context, req *connect.Request[gen.GetStockRequest]) (*connect.Response[gen.GetStockResponse], error) {
+	return c.getStock.CallUnary(ctx, req)
+}
+
+// ReserveStock calls go.escape.ship.proto.v1.InventoryService.ReserveStock.
+func (c *inventoryServiceClient) ReserveStock(ctx context.Context, req *connect.Request[gen.ReserveStockRequest]) (*connect.Response[gen.ReserveStockResponse], error) {
+	return c.reserveStock.CallUnary(ctx, req)
+}
+
+// ReleaseReservation calls go.escape.ship.proto.v1.InventoryService.ReleaseReservation.
+func (c *inventoryServiceClient) ReleaseReservation(ctx context.Context, req *connect.Request[gen.ReleaseReservationRequest]) (*connect.Response[gen.ReleaseReservationResponse], error) {
+	return c.releaseReservation.CallUnary(ctx, req)
+}
+
+// AdjustStock calls go.escape.ship.proto.v1.InventoryService.AdjustStock.
+func (c *inventoryServiceClient) AdjustStock(ctx context.Context, req *connect.Request[gen.AdjustStockRequest]) (*connect.Response[gen.AdjustStockResponse], error) {
+	return c.adjustStock.CallUnary(ctx, req)
+}
+
+// AuditInventory calls go.escape.ship.proto.v1.InventoryService.AuditInventory.
+func (c *inventoryServiceClient) AuditInventory(ctx context.Context, req *connect.Request[gen.AuditInventoryRequest]) (*connect.Response[gen.AuditInventoryResponse], error) {
+	return c.auditInventory.CallUnary(ctx, req)
+}
+
+// InventoryServiceHandler is an implementation of the go.escape.ship.proto.v1.InventoryService
+// service.
+type InventoryServiceHandler interface {
+	// 재고가 threshold 이하로 떨어진 상품을 실시간으로 전달 (운영 알림용)
+	WatchLowStock(context.Context, *connect.Request[gen.WatchLowStockRequest], *connect.ServerStream[gen.WatchLowStockResponse]) error
+	// 상품의 옵션 조합별 재고 조회 (주문 전 재고 확인용)
+	GetStock(context.Context, *connect.Request[gen.GetStockRequest]) (*connect.Response[gen.GetStockResponse], error)
+	// 주문/결제 진행 중 재고 선점 (전체 항목 성공 또는 전체 실패)
+	// 재고 부족 시 FAILED_PRECONDITION + ERROR_REASON_OUT_OF_STOCK
+	ReserveStock(context.Context, *connect.Request[gen.ReserveStockRequest]) (*connect.Response[gen.ReserveStockResponse], error)
+	// 선점 해제 (결제 실패/취소), 이미 해제·만료된 선점은 성공으로 처리
+	ReleaseReservation(context.Context, *connect.Request[gen.ReleaseReservationRequest]) (*connect.Response[gen.ReleaseReservationResponse], error)
+	// 입고/실사 등으로 보유 재고 수량 조정 (운영자용)
+	AdjustStock(context.Context, *connect.Request[gen.AdjustStockRequest]) (*connect.Response[gen.AdjustStockResponse], error)
+	// 선점·주문·재고 수량 간 불일치(초과 판매, 미해제 선점 등)와 보정 방법 조회 (야간 정합성 점검용)
+	// 조회만 하며, 보정은 제안된 AdjustStock/ReleaseReservation 요청을 검토 후 실행
+	AuditInventory(context.Context, *connect.Request[gen.AuditInventoryRequest]) (*connect.Response[gen.AuditInventoryResponse], error)
+}
+
+// NewInventoryServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewInventoryServiceHandler(svc InventoryServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	inventoryServiceMethods := gen.File_inventory_proto.Services().ByName("InventoryService").Methods()
+	inventoryServiceWatchLowStockHandler := connect.NewServerStreamHandler(
+		InventoryServiceWatchLowStockProcedure,
+		svc.WatchLowStock,
+		connect.WithSchema(inventoryServiceMethods.ByName("WatchLowStock")),
+		connect.WithHandlerOptions(opts...),
+	)
+	inventoryServiceGetStockHandler := connect.NewUnaryHandler(
+		InventoryServiceGetStockProcedure,
+		svc.GetStock,
+		connect.WithSchema(inventoryServiceMethods.ByName("GetStock")),
+		connect.WithHandlerOptions(opts...),
+	)
+	inventoryServiceReserveStockHandler := connect.NewUnaryHandler(
+		InventoryServiceReserveStockProcedure,
+		svc.ReserveStock,
+		connect.WithSchema(inventoryServiceMethods.ByName("ReserveStock")),
+		connect.WithHandlerOptions(opts...),
+	)
+	inventoryServiceReleaseReservationHandler := connect.NewUnaryHandler(
+		InventoryServiceReleaseReservationProcedure,
+		svc.ReleaseReservation,
+		connect.WithSchema(inventoryServiceMethods.ByName("ReleaseReservation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	inventoryServiceAdjustStockHandler := connect.NewUnaryHandler(
+		InventoryServiceAdjustStockProcedure,
+		svc.AdjustStock,
+		connect.WithSchema(inventoryServiceMethods.ByName("AdjustStock")),
+		connect.WithHandlerOptions(opts...),
+	)
+	inventoryServiceAuditInventoryHandler := connect.NewUnaryHandler(
+		InventoryServiceAuditInventoryProcedure,
+		svc.AuditInventory,
+		connect.WithSchema(inventoryServiceMethods.ByName("AuditInventory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/go.escape.ship.proto.v1.InventoryService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case InventoryServiceWatchLowStockProcedure:
+			inventoryServiceWatchLowStockHandler.ServeHTTP(w, r)
+		case InventoryServiceGetStockProcedure:
+			inventoryServiceGetStockHandler.ServeHTTP(w, r)
+		case InventoryServiceReserveStockProcedure:
+			inventoryServiceReserveStockHandler.ServeHTTP(w, r)
+		case InventoryServiceReleaseReservationProcedure:
+			inventoryServiceReleaseReservationHandler.ServeHTTP(w, r)
+		case InventoryServiceAdjustStockProcedure:
+			inventoryServiceAdjustStockHandler.ServeHTTP(w, r)
+		case InventoryServiceAuditInventoryProcedure:
+			inventoryServiceAuditInventoryHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedInventoryServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedInventoryServiceHandler struct{}
+
+func (UnimplementedInventoryServiceHandler) WatchLowStock(context.Context, *connect.Request[gen.WatchLowStockRequest], *connect.ServerStream[gen.WatchLowStockResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.InventoryService.WatchLowStock is not implemented"))
+}
+
+func (UnimplementedInventoryServiceHandler) GetStock(context.Context, *connect.Request[gen.GetStockRequest]) (*connect.Response[gen.GetStockResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.InventoryService.GetStock is not implemented"))
+}
+
+func (UnimplementedInventoryServiceHandler) ReserveStock(context.Context, *connect.Request[gen.ReserveStockRequest]) (*connect.Response[gen.ReserveStockResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.InventoryService.ReserveStock is not implemented"))
+}
+
+func (UnimplementedInventoryServiceHandler) ReleaseReservation(context.Context, *connect.Request[gen.ReleaseReservationRequest]) (*connect.Response[gen.ReleaseReservationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.InventoryService.ReleaseReservation is not implemented"))
+}
+
+func (UnimplementedInventoryServiceHandler) AdjustStock(context.Context, *connect.Request[gen.AdjustStockRequest]) (*connect.Response[gen.AdjustStockResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.InventoryService.AdjustStock is not implemented"))
+}
+
+func (UnimplementedInventoryServiceHandler) AuditInventory(context.Context, *connect.Request[gen.AuditInventoryRequest]) (*connect.Response[gen.AuditInventoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.InventoryService.AuditInventory is not implemented"))
+}
@@ -0,0 +1,331 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: notification.proto
+
+package genconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	gen "github.com/escape-ship/protos/gen"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// NotificationServiceName is the fully-qualified name of the NotificationService service.
+	NotificationServiceName = "go.escape.ship.proto.v1.NotificationService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// NotificationServiceGetNotificationPreferencesProcedure is the fully-qualified name of the
+	// NotificationService's GetNotificationPreferences RPC.
+	NotificationServiceGetNotificationPreferencesProcedure = "/go.escape.ship.proto.v1.NotificationService/GetNotificationPreferences"
+	// NotificationServiceUpdateNotificationPreferencesProcedure is the fully-qualified name of the
+	// NotificationService's UpdateNotificationPreferences RPC.
+	NotificationServiceUpdateNotificationPreferencesProcedure = "/go.escape.ship.proto.v1.NotificationService/UpdateNotificationPreferences"
+	// NotificationServiceListNotificationsProcedure is the fully-qualified name of the
+	// NotificationService's ListNotifications RPC.
+	NotificationServiceListNotificationsProcedure = "/go.escape.ship.proto.v1.NotificationService/ListNotifications"
+	// NotificationServiceMarkNotificationReadProcedure is the fully-qualified name of the
+	// NotificationService's MarkNotificationRead RPC.
+	NotificationServiceMarkNotificationReadProcedure = "/go.escape.ship.proto.v1.NotificationService/MarkNotificationRead"
+	// NotificationServiceSendEmailProcedure is the fully-qualified name of the NotificationService's
+	// SendEmail RPC.
+	NotificationServiceSendEmailProcedure = "/go.escape.ship.proto.v1.NotificationService/SendEmail"
+	// NotificationServiceSendSMSProcedure is the fully-qualified name of the NotificationService's
+	// SendSMS RPC.
+	NotificationServiceSendSMSProcedure = "/go.escape.ship.proto.v1.NotificationService/SendSMS"
+	// NotificationServiceSendPushProcedure is the fully-qualified name of the NotificationService's
+	// SendPush RPC.
+	NotificationServiceSendPushProcedure = "/go.escape.ship.proto.v1.NotificationService/SendPush"
+	// NotificationServiceSubscribeEventsProcedure is the fully-qualified name of the
+	// NotificationService's SubscribeEvents RPC.
+	NotificationServiceSubscribeEventsProcedure = "/go.escape.ship.proto.v1.NotificationService/SubscribeEvents"
+)
+
+// NotificationServiceClient is a client for the go.escape.ship.proto.v1.NotificationService
+// service.
+type NotificationServiceClient interface {
+	// 채널/카테고리별 알림 수신 설정 (Authorization 헤더의 사용자 기준)
+	GetNotificationPreferences(context.Context, *connect.Request[gen.GetNotificationPreferencesRequest]) (*connect.Response[gen.GetNotificationPreferencesResponse], error)
+	UpdateNotificationPreferences(context.Context, *connect.Request[gen.UpdateNotificationPreferencesRequest]) (*connect.Response[gen.UpdateNotificationPreferencesResponse], error)
+	// 알림함 목록 (최신순, 페이지네이션)
+	ListNotifications(context.Context, *connect.Request[gen.ListNotificationsRequest]) (*connect.Response[gen.ListNotificationsResponse], error)
+	MarkNotificationRead(context.Context, *connect.Request[gen.MarkNotificationReadRequest]) (*connect.Response[gen.MarkNotificationReadResponse], error)
+	// 서비스 간 발송 요청 (주문 확인, 결제 결과 등)
+	// 사용자 수신 설정을 확인하며, 수신 거부 시 발송하지 않고 SUPPRESSED 반환
+	// 같은 dedup_key로 재요청하면 다시 발송하지 않고 처음 결과를 반환
+	SendEmail(context.Context, *connect.Request[gen.SendEmailRequest]) (*connect.Response[gen.SendNotificationResponse], error)
+	SendSMS(context.Context, *connect.Request[gen.SendSMSRequest]) (*connect.Response[gen.SendNotificationResponse], error)
+	// 사용자의 등록된 모든 기기(AccountService.RegisterPushToken)로 발송
+	SendPush(context.Context, *connect.Request[gen.SendPushRequest]) (*connect.Response[gen.SendNotificationResponse], error)
+	// 발송 결과(발송/도달/실패) 이벤트 구독 (발송 요청 서비스의 재시도·대체 채널 판단용)
+	SubscribeEvents(context.Context, *connect.Request[gen.SubscribeEventsRequest]) (*connect.ServerStreamForClient[gen.NotificationEvent], error)
+}
+
+// NewNotificationServiceClient constructs a client for the
+// go.escape.ship.proto.v1.NotificationService service. By default, it uses the Connect protocol
+// with the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed requests. To
+// use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or connect.WithGRPCWeb()
+// options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewNotificationServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) NotificationServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	notificationServiceMethods := gen.File_notification_proto.Services().ByName("NotificationService").Methods()
+	return &notificationServiceClient{
+		getNotificationPreferences: connect.NewClient[gen.GetNotificationPreferencesRequest, gen.GetNotificationPreferencesResponse](
+			httpClient,
+			baseURL+NotificationServiceGetNotificationPreferencesProcedure,
+			connect.WithSchema(notificationServiceMethods.ByName("GetNotificationPreferences")),
+			connect.WithClientOptions(opts...),
+		),
+		updateNotificationPreferences: connect.NewClient[gen.UpdateNotificationPreferencesRequest, gen.UpdateNotificationPreferencesResponse](
+			httpClient,
+			baseURL+NotificationServiceUpdateNotificationPreferencesProcedure,
+			connect.WithSchema(notificationServiceMethods.ByName("UpdateNotificationPreferences")),
+			connect.WithClientOptions(opts...),
+		),
+		listNotifications: connect.NewClient[gen.ListNotificationsRequest, gen.ListNotificationsResponse](
+			httpClient,
+			baseURL+NotificationServiceListNotificationsProcedure,
+			connect.WithSchema(notificationServiceMethods.ByName("ListNotifications")),
+			connect.WithClientOptions(opts...),
+		),
+		markNotificationRead: connect.NewClient[gen.MarkNotificationReadRequest, gen.MarkNotificationReadResponse](
+			httpClient,
+			baseURL+NotificationServiceMarkNotificationReadProcedure,
+			connect.WithSchema(notificationServiceMethods.ByName("MarkNotificationRead")),
+			connect.WithClientOptions(opts...),
+		),
+		sendEmail: connect.NewClient[gen.SendEmailRequest, gen.SendNotificationResponse](
+			httpClient,
+			baseURL+NotificationServiceSendEmailProcedure,
+			connect.WithSchema(notificationServiceMethods.ByName("SendEmail")),
+			connect.WithClientOptions(opts...),
+		),
+		sendSMS: connect.NewClient[gen.SendSMSRequest, gen.SendNotificationResponse](
+			httpClient,
+			baseURL+NotificationServiceSendSMSProcedure,
+			connect.WithSchema(notificationServiceMethods.ByName("SendSMS")),
+			connect.WithClientOptions(opts...),
+		),
+		sendPush: connect.NewClient[gen.SendPushRequest, gen.SendNotificationResponse](
+			httpClient,
+			baseURL+NotificationServiceSendPushProcedure,
+			connect.WithSchema(notificationServiceMethods.ByName("SendPush")),
+			connect.WithClientOptions(opts...),
+		),
+		subscribeEvents: connect.NewClient[gen.SubscribeEventsRequest, gen.NotificationEvent](
+			httpClient,
+			baseURL+NotificationServiceSubscribeEventsProcedure,
+			connect.WithSchema(notificationServiceMethods.ByName("SubscribeEvents")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// notificationServiceClient implements NotificationServiceClient.
+type notificationServiceClient struct {
+	getNotificationPreferences    *connect.Client[gen.GetNotificationPreferencesRequest, gen.GetNotificationPreferencesResponse]
+	updateNotificationPreferences *connect.Client[gen.UpdateNotificationPreferencesRequest, gen.UpdateNotificationPreferencesResponse]
+	listNotifications             *connect.Client[gen.ListNotificationsRequest, gen.ListNotificationsResponse]
+	markNotificationRead          *connect.Client[gen.MarkNotificationReadRequest, gen.MarkNotificationReadResponse]
+	sendEmail                     *connect.Client[gen.SendEmailRequest, gen.SendNotificationResponse]
+	sendSMS                       *connect.Client[gen.SendSMSRequest, gen.SendNotificationResponse]
+	sendPush                      *connect.Client[gen.SendPushRequest, gen.SendNotificationResponse]
+	subscribeEvents               *connect.Client[gen.SubscribeEventsRequest, gen.NotificationEvent]
+}
+
+// GetNotificationPreferences calls
+// go.escape.ship.proto.v1.NotificationService.GetNotificationPreferences.
+func (c *notificationServiceClient) GetNotificationPreferences(ctx context.Context, req *connect.Request[gen.GetNotificationPreferencesRequest]) (*connect.Response[gen.GetNotificationPreferencesResponse], error) {
+	return c.getNotificationPreferences.CallUnary(ctx, req)
+}
+
+// UpdateNotificationPreferences calls
+// go.escape.ship.proto.v1.NotificationService.UpdateNotificationPreferences.
+func (c *notificationServiceClient) UpdateNotificationPreferences(ctx context.Context, req *connect.Request[gen.UpdateNotificationPreferencesRequest]) (*connect.Response[gen.UpdateNotificationPreferencesResponse], error) {
+	return c.updateNotificationPreferences.CallUnary(ctx, req)
+}
+
+// ListNotifications calls go.escape.ship.proto.v1.NotificationService.ListNotifications.
+func (c *notificationServiceClient) ListNotifications(ctx context.Context, req *connect.Request[gen.ListNotificationsRequest]) (*connect.Response[gen.ListNotificationsResponse], error) {
+	return c.listNotifications.CallUnary(ctx, req)
+}
+
+// MarkNotificationRead calls go.escape.ship.proto.v1.NotificationService.MarkNotificationRead.
+func (c *notificationServiceClient) MarkNotificationRead(ctx context.Context, req *connect.Request[gen.MarkNotificationReadRequest]) (*connect.Response[gen.MarkNotificationReadResponse], error) {
+	return c.markNotificationRead.CallUnary(ctx, req)
+}
+
+// SendEmail calls go.escape.ship.proto.v1.NotificationService.SendEmail.
+func (c *notificationServiceClient) SendEmail(ctx context.Context, req *connect.Request[gen.SendEmailRequest]) (*connect.Response[gen.SendNotificationResponse], error) {
+	return c.sendEmail.CallUnary(ctx, req)
+}
+
+// SendSMS calls go.escape.ship.proto.v1.NotificationService.SendSMS.
+func (c *notificationServiceClient) SendSMS(ctx context.Context, req *connect.Request[gen.SendSMSRequest]) (*connect.Response[gen.SendNotificationResponse], error) {
+	return c.sendSMS.CallUnary(ctx, req)
+}
+
+// SendPush calls go.escape.ship.proto.v1.NotificationService.SendPush.
+func (c *notificationServiceClient) SendPush(ctx context.Context, req *connect.Request[gen.SendPushRequest]) (*connect.Response[gen.SendNotificationResponse], error) {
+	return c.sendPush.CallUnary(ctx, req)
+}
+
+// SubscribeEvents calls go.escape.ship.proto.v1.NotificationService.SubscribeEvents.
+func (c *notificationServiceClient) SubscribeEvents(ctx context.Context, req *connect.Request[gen.SubscribeEventsRequest]) (*connect.ServerStreamForClient[gen.NotificationEvent], error) {
+	return c.subscribeEvents.CallServerStream(ctx, req)
+}
+
+// NotificationServiceHandler is an implementation of the
+// go.escape.ship.proto.v1.NotificationService service.
+type NotificationServiceHandler interface {
+	// 채널/카테고리별 알림 수신 설정 (Authorization 헤더의 사용자 기준)
+	GetNotificationPreferences(context.Context, *connect.Request[gen.GetNotificationPreferencesRequest]) (*connect.Response[gen.GetNotificationPreferencesResponse], error)
+	UpdateNotificationPreferences(context.Context, *connect.Request[gen.UpdateNotificationPreferencesRequest]) (*connect.Response[gen.UpdateNotificationPreferencesResponse], error)
+	// 알림함 목록 (최신순, 페이지네이션)
+	ListNotifications(context.Context, *connect.Request[gen.ListNotificationsRequest]) (*connect.Response[gen.ListNotificationsResponse], error)
+	MarkNotificationRead(context.Context, *connect.Request[gen.MarkNotificationReadRequest]) (*connect.Response[gen.MarkNotificationReadResponse], error)
+	// 서비스 간 발송 요청 (주문 확인, 결제 결과 등)
+	// 사용자 수신 설정을 확인하며, 수신 거부 시 발송하지 않고 SUPPRESSED 반환
+	// 같은 dedup_key로 재요청하면 다시 발송하지 않고 처음 결과를 반환
+	SendEmail(context.Context, *connect.Request[gen.SendEmailRequest]) (*connect.Response[gen.SendNotificationResponse], error)
+	SendSMS(context.Context, *connect.Request[gen.SendSMSRequest]) (*connect.Response[gen.SendNotificationResponse], error)
+	// 사용자의 등록된 모든 기기(AccountService.RegisterPushToken)로 발송
+	SendPush(context.Context, *connect.Request[gen.SendPushRequest]) (*connect.Response[gen.SendNotificationResponse], error)
+	// 발송 결과(발송/도달/실패) 이벤트 구독 (발송 요청 서비스의 재시도·대체 채널 판단용)
+	SubscribeEvents(context.Context, *connect.Request[gen.SubscribeEventsRequest], *connect.ServerStream[gen.NotificationEvent]) error
+}
+
+// NewNotificationServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewNotificationServiceHandler(svc NotificationServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	notificationServiceMethods := gen.File_notification_proto.Services().ByName("NotificationService").Methods()
+	notificationServiceGetNotificationPreferencesHandler := connect.NewUnaryHandler(
+		NotificationServiceGetNotificationPreferencesProcedure,
+		svc.GetNotificationPreferences,
+		connect.WithSchema(notificationServiceMethods.ByName("GetNotificationPreferences")),
+		connect.WithHandlerOptions(opts...),
+	)
+	notificationServiceUpdateNotificationPreferencesHandler := connect.NewUnaryHandler(
+		NotificationServiceUpdateNotificationPreferencesProcedure,
+		svc.UpdateNotificationPreferences,
+		connect.WithSchema(notificationServiceMethods.ByName("UpdateNotificationPreferences")),
+		connect.WithHandlerOptions(opts...),
+	)
+	notificationServiceListNotificationsHandler := connect.NewUnaryHandler(
+		NotificationServiceListNotificationsProcedure,
+		svc.ListNotifications,
+		connect.WithSchema(notificationServiceMethods.ByName("ListNotifications")),
+		connect.WithHandlerOptions(opts...),
+	)
+	notificationServiceMarkNotificationReadHandler := connect.NewUnaryHandler(
+		NotificationServiceMarkNotificationReadProcedure,
+		svc.MarkNotificationRead,
+		connect.WithSchema(notificationServiceMethods.ByName("MarkNotificationRead")),
+		connect.WithHandlerOptions(opts...),
+	)
+	notificationServiceSendEmailHandler := connect.NewUnaryHandler(
+		NotificationServiceSendEmailProcedure,
+		svc.SendEmail,
+		connect.WithSchema(notificationServiceMethods.ByName("SendEmail")),
+		connect.WithHandlerOptions(opts...),
+	)
+	notificationServiceSendSMSHandler := connect.NewUnaryHandler(
+		NotificationServiceSendSMSProcedure,
+		svc.SendSMS,
+		connect.WithSchema(notificationServiceMethods.ByName("SendSMS")),
+		connect.WithHandlerOptions(opts...),
+	)
+	notificationServiceSendPushHandler := connect.NewUnaryHandler(
+		NotificationServiceSendPushProcedure,
+		svc.SendPush,
+		connect.WithSchema(notificationServiceMethods.ByName("SendPush")),
+		connect.WithHandlerOptions(opts...),
+	)
+	notificationServiceSubscribeEventsHandler := connect.NewServerStreamHandler(
+		NotificationServiceSubscribeEventsProcedure,
+		svc.SubscribeEvents,
+		connect.WithSchema(notificationServiceMethods.ByName("SubscribeEvents")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/go.escape.ship.proto.v1.NotificationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case NotificationServiceGetNotificationPreferencesProcedure:
+			notificationServiceGetNotificationPreferencesHandler.ServeHTTP(w, r)
+		case NotificationServiceUpdateNotificationPreferencesProcedure:
+			notificationServiceUpdateNotificationPreferencesHandler.ServeHTTP(w, r)
+		case NotificationServiceListNotificationsProcedure:
+			notificationServiceListNotificationsHandler.ServeHTTP(w, r)
+		case NotificationServiceMarkNotificationReadProcedure:
+			notificationServiceMarkNotificationReadHandler.ServeHTTP(w, r)
+		case NotificationServiceSendEmailProcedure:
+			notificationServiceSendEmailHandler.ServeHTTP(w, r)
+		case NotificationServiceSendSMSProcedure:
+			notificationServiceSendSMSHandler.ServeHTTP(w, r)
+		case NotificationServiceSendPushProcedure:
+			notificationServiceSendPushHandler.ServeHTTP(w, r)
+		case NotificationServiceSubscribeEventsProcedure:
+			notificationServiceSubscribeEventsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedNotificationServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedNotificationServiceHandler struct{}
+
+func (UnimplementedNotificationServiceHandler) GetNotificationPreferences(context.Context, *connect.Request[gen.GetNotificationPreferencesRequest]) (*connect.Response[gen.GetNotificationPreferencesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.NotificationService.GetNotificationPreferences is not implemented"))
+}
+
+func (UnimplementedNotificationServiceHandler) UpdateNotificationPreferences(context.Context, *connect.Request[gen.UpdateNotificationPreferencesRequest]) (*connect.Response[gen.UpdateNotificationPreferencesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.NotificationService.UpdateNotificationPreferences is not implemented"))
+}
+
+func (UnimplementedNotificationServiceHandler) ListNotifications(context.Context, *connect.Request[gen.ListNotificationsRequest]) (*connect.Response[gen.ListNotificationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.NotificationService.ListNotifications is not implemented"))
+}
+
+func (UnimplementedNotificationServiceHandler) MarkNotificationRead(context.Context, *connect.Request[gen.MarkNotificationReadRequest]) (*connect.Response[gen.MarkNotificationReadResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.NotificationService.MarkNotificationRead is not implemented"))
+}
+
+func (UnimplementedNotificationServiceHandler) SendEmail(context.Context, *connect.Request[gen.SendEmailRequest]) (*connect.Response[gen.SendNotificationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.NotificationService.SendEmail is not implemented"))
+}
+
+func (UnimplementedNotificationServiceHandler) SendSMS(context.Context, *connect.Request[gen.SendSMSRequest]) (*connect.Response[gen.SendNotificationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.NotificationService.SendSMS is not implemented"))
+}
+
+func (UnimplementedNotificationServiceHandler) SendPush(context.Context, *connect.Request[gen.SendPushRequest]) (*connect.Response[gen.SendNotificationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.NotificationService.SendPush is not implemented"))
+}
+
+func (UnimplementedNotificationServiceHandler) SubscribeEvents(context.Context, *connect.Request[gen.SubscribeEventsRequest], *connect.ServerStream[gen.NotificationEvent]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.NotificationService.SubscribeEvents is not implemented"))
+}
@@ -0,0 +1,605 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: order.proto
+
+package genconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	gen "github.com/escape-ship/protos/gen"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// OrderServiceName is the fully-qualified name of the OrderService service.
+	OrderServiceName = "go.escape.ship.proto.v1.OrderService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// OrderServiceInsertOrderProcedure is the fully-qualified name of the OrderService's InsertOrder
+	// RPC.
+	OrderServiceInsertOrderProcedure = "/go.escape.ship.proto.v1.OrderService/InsertOrder"
+	// OrderServiceGetAllOrdersProcedure is the fully-qualified name of the OrderService's GetAllOrders
+	// RPC.
+	OrderServiceGetAllOrdersProcedure = "/go.escape.ship.proto.v1.OrderService/GetAllOrders"
+	// OrderServiceGetOrderByIDProcedure is the fully-qualified name of the OrderService's GetOrderByID
+	// RPC.
+	OrderServiceGetOrderByIDProcedure = "/go.escape.ship.proto.v1.OrderService/GetOrderByID"
+	// OrderServiceGetOrdersByUserProcedure is the fully-qualified name of the OrderService's
+	// GetOrdersByUser RPC.
+	OrderServiceGetOrdersByUserProcedure = "/go.escape.ship.proto.v1.OrderService/GetOrdersByUser"
+	// OrderServiceWatchOrderProcedure is the fully-qualified name of the OrderService's WatchOrder RPC.
+	OrderServiceWatchOrderProcedure = "/go.escape.ship.proto.v1.OrderService/WatchOrder"
+	// OrderServiceCancelOrderProcedure is the fully-qualified name of the OrderService's CancelOrder
+	// RPC.
+	OrderServiceCancelOrderProcedure = "/go.escape.ship.proto.v1.OrderService/CancelOrder"
+	// OrderServiceRefundOrderProcedure is the fully-qualified name of the OrderService's RefundOrder
+	// RPC.
+	OrderServiceRefundOrderProcedure = "/go.escape.ship.proto.v1.OrderService/RefundOrder"
+	// OrderServiceCreateReturnLabelProcedure is the fully-qualified name of the OrderService's
+	// CreateReturnLabel RPC.
+	OrderServiceCreateReturnLabelProcedure = "/go.escape.ship.proto.v1.OrderService/CreateReturnLabel"
+	// OrderServiceImportOrdersProcedure is the fully-qualified name of the OrderService's ImportOrders
+	// RPC.
+	OrderServiceImportOrdersProcedure = "/go.escape.ship.proto.v1.OrderService/ImportOrders"
+	// OrderServiceGetOrdersByIDsProcedure is the fully-qualified name of the OrderService's
+	// GetOrdersByIDs RPC.
+	OrderServiceGetOrdersByIDsProcedure = "/go.escape.ship.proto.v1.OrderService/GetOrdersByIDs"
+	// OrderServiceArchiveOrdersProcedure is the fully-qualified name of the OrderService's
+	// ArchiveOrders RPC.
+	OrderServiceArchiveOrdersProcedure = "/go.escape.ship.proto.v1.OrderService/ArchiveOrders"
+	// OrderServiceGetArchivedOrderProcedure is the fully-qualified name of the OrderService's
+	// GetArchivedOrder RPC.
+	OrderServiceGetArchivedOrderProcedure = "/go.escape.ship.proto.v1.OrderService/GetArchivedOrder"
+	// OrderServiceCreateQuoteProcedure is the fully-qualified name of the OrderService's CreateQuote
+	// RPC.
+	OrderServiceCreateQuoteProcedure = "/go.escape.ship.proto.v1.OrderService/CreateQuote"
+	// OrderServiceAcceptQuoteProcedure is the fully-qualified name of the OrderService's AcceptQuote
+	// RPC.
+	OrderServiceAcceptQuoteProcedure = "/go.escape.ship.proto.v1.OrderService/AcceptQuote"
+	// OrderServiceConvertQuoteToOrderProcedure is the fully-qualified name of the OrderService's
+	// ConvertQuoteToOrder RPC.
+	OrderServiceConvertQuoteToOrderProcedure = "/go.escape.ship.proto.v1.OrderService/ConvertQuoteToOrder"
+	// OrderServicePriceOrderProcedure is the fully-qualified name of the OrderService's PriceOrder RPC.
+	OrderServicePriceOrderProcedure = "/go.escape.ship.proto.v1.OrderService/PriceOrder"
+	// OrderServiceCheckPurchaseEligibilityProcedure is the fully-qualified name of the OrderService's
+	// CheckPurchaseEligibility RPC.
+	OrderServiceCheckPurchaseEligibilityProcedure = "/go.escape.ship.proto.v1.OrderService/CheckPurchaseEligibility"
+)
+
+// OrderServiceClient is a client for the go.escape.ship.proto.v1.OrderService service.
+type OrderServiceClient interface {
+	InsertOrder(context.Context, *connect.Request[gen.InsertOrderRequest]) (*connect.Response[gen.InsertOrderResponse], error)
+	GetAllOrders(context.Context, *connect.Request[gen.GetAllOrdersRequest]) (*connect.Response[gen.GetAllOrdersResponse], error)
+	// 주문 단건 조회, 본인 주문이 아니면 orders:admin 필요 (없으면 NOT_FOUND)
+	// 아카이브된 주문은 NOT_FOUND, GetArchivedOrder로 조회
+	GetOrderByID(context.Context, *connect.Request[gen.GetOrderByIDRequest]) (*connect.Response[gen.GetOrderByIDResponse], error)
+	// 사용자별 주문 목록 (최신 주문 순), 다른 사용자의 주문은 orders:admin 필요 (없으면 PERMISSION_DENIED)
+	GetOrdersByUser(context.Context, *connect.Request[gen.GetOrdersByUserRequest]) (*connect.Response[gen.GetOrdersByUserResponse], error)
+	// 주문 상태 변경을 실시간으로 전달 (GetAllOrders 폴링 대체)
+	// 구독 직후 현재 상태를 한 번 보내고, 이후 변경될 때마다 전달
+	// 게이트웨이에서는 Accept: text/event-stream 요청 시 SSE로 응답
+	WatchOrder(context.Context, *connect.Request[gen.WatchOrderRequest]) (*connect.ServerStreamForClient[gen.OrderStatusEvent], error)
+	// 배송 전(PENDING/PAID) 주문 취소, 결제 완료 주문은 전액 환불까지 처리
+	CancelOrder(context.Context, *connect.Request[gen.CancelOrderRequest]) (*connect.Response[gen.CancelOrderResponse], error)
+	// 전체/부분 환불 (PaymentService.KakaoCancel로 결제 취소 후 Order.refunds에 기록)
+	RefundOrder(context.Context, *connect.Request[gen.RefundOrderRequest]) (*connect.Response[gen.RefundOrderResponse], error)
+	// 반품 건에 대해 택배사 수거 예약 후 출력용 라벨 URL 발급
+	CreateReturnLabel(context.Context, *connect.Request[gen.CreateReturnLabelRequest]) (*connect.Response[gen.CreateReturnLabelResponse], error)
+	// 전화/오프라인 주문 및 마켓플레이스 주문 일괄 등록 (행 단위 검증 결과 반환)
+	ImportOrders(context.Context) *connect.ClientStreamForClient[gen.ImportOrdersRequest, gen.ImportOrdersResponse]
+	// 여러 주문 ID를 한 번에 조회, orders:admin 필요 (일부만 존재해도 성공, 없는 ID는 not_found_ids로 반환)
+	GetOrdersByIDs(context.Context, *connect.Request[gen.GetOrdersByIDsRequest]) (*connect.Response[gen.GetOrdersByIDsResponse], error)
+	// before_date 이전 주문을 콜드 스토리지로 이동
+	ArchiveOrders(context.Context, *connect.Request[gen.ArchiveOrdersRequest]) (*connect.Response[gen.ArchiveOrdersResponse], error)
+	// 아카이브된 주문 조회
+	GetArchivedOrder(context.Context, *connect.Request[gen.GetArchivedOrderRequest]) (*connect.Response[gen.GetArchivedOrderResponse], error)
+	// B2B 견적: 생성 → 고객 수락 → 주문 전환 (외상 결제 조건 지원)
+	CreateQuote(context.Context, *connect.Request[gen.CreateQuoteRequest]) (*connect.Response[gen.CreateQuoteResponse], error)
+	AcceptQuote(context.Context, *connect.Request[gen.AcceptQuoteRequest]) (*connect.Response[gen.AcceptQuoteResponse], error)
+	ConvertQuoteToOrder(context.Context, *connect.Request[gen.ConvertQuoteToOrderRequest]) (*connect.Response[gen.ConvertQuoteToOrderResponse], error)
+	// 프로모션 규칙을 적용한 주문 금액 계산 (장바구니/결제/환불 시 공통 사용)
+	// 같은 입력과 priced_at이면 항상 같은 결과를 반환
+	PriceOrder(context.Context, *connect.Request[gen.PriceOrderRequest]) (*connect.Response[gen.PriceOrderResponse], error)
+	// 고객당 구매 수량 제한 확인 (장바구니/결제/주문 등록 시 공통 사용)
+	CheckPurchaseEligibility(context.Context, *connect.Request[gen.CheckPurchaseEligibilityRequest]) (*connect.Response[gen.CheckPurchaseEligibilityResponse], error)
+}
+
+// NewOrderServiceClient constructs a client for the go.escape.ship.proto.v1.OrderService service.
+// By default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped
+// responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewOrderServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) OrderServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	orderServiceMethods := gen.File_order_proto.Services().ByName("OrderService").Methods()
+	return &orderServiceClient{
+		insertOrder: connect.NewClient[gen.InsertOrderRequest, gen.InsertOrderResponse](
+			httpClient,
+			baseURL+OrderServiceInsertOrderProcedure,
+			connect.WithSchema(orderServiceMethods.ByName("InsertOrder")),
+			connect.WithClientOptions(opts...),
+		),
+		getAllOrders: connect.NewClient[gen.GetAllOrdersRequest, gen.GetAllOrdersResponse](
+			httpClient,
+			baseURL+OrderServiceGetAllOrdersProcedure,
+			connect.WithSchema(orderServiceMethods.ByName("GetAllOrders")),
+			connect.WithClientOptions(opts...),
+		),
+		getOrderByID: connect.NewClient[gen.GetOrderByIDRequest, gen.GetOrderByIDResponse](
+			httpClient,
+			baseURL+OrderServiceGetOrderByIDProcedure,
+			connect.WithSchema(orderServiceMethods.ByName("GetOrderByID")),
+			connect.WithClientOptions(opts...),
+		),
+		getOrdersByUser: connect.NewClient[gen.GetOrdersByUserRequest, gen.GetOrdersByUserResponse](
+			httpClient,
+			baseURL+OrderServiceGetOrdersByUserProcedure,
+			connect.WithSchema(orderServiceMethods.ByName("GetOrdersByUser")),
+			connect.WithClientOptions(opts...),
+		),
+		watchOrder: connect.NewClient[gen.WatchOrderRequest, gen.OrderStatusEvent](
+			httpClient,
+			baseURL+OrderServiceWatchOrderProcedure,
+			connect.WithSchema(orderServiceMethods.ByName("WatchOrder")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelOrder: connect.NewClient[gen.CancelOrderRequest, gen.CancelOrderResponse](
+			httpClient,
+			baseURL+OrderServiceCancelOrderProcedure,
+			connect.WithSchema(orderServiceMethods.ByName("CancelOrder")),
+			connect.WithClientOptions(opts...),
+		),
+		refundOrder: connect.NewClient[gen.RefundOrderRequest, gen.RefundOrderResponse](
+			httpClient,
+			baseURL+OrderServiceRefundOrderProcedure,
+			connect.WithSchema(orderServiceMethods.ByName("RefundOrder")),
+			connect.WithClientOptions(opts...),
+		),
+		createReturnLabel: connect.NewClient[gen.CreateReturnLabelRequest, gen.CreateReturnLabelResponse](
+			httpClient,
+			baseURL+OrderServiceCreateReturnLabelProcedure,
+			connect.WithSchema(orderServiceMethods.ByName("CreateReturnLabel")),
+			connect.WithClientOptions(opts...),
+		),
+		importOrders: connect.NewClient[gen.ImportOrdersRequest, gen.ImportOrdersResponse](
+			httpClient,
+			baseURL+OrderServiceImportOrdersProcedure,
+			connect.WithSchema(orderServiceMethods.ByName("ImportOrders")),
+			connect.WithClientOptions(opts...),
+		),
+		getOrdersByIDs: connect.NewClient[gen.GetOrdersByIDsRequest, gen.GetOrdersByIDsResponse](
+			httpClient,
+			baseURL+OrderServiceGetOrdersByIDsProcedure,
+			connect.WithSchema(orderServiceMethods.ByName("GetOrdersByIDs")),
+			connect.WithClientOptions(opts...),
+		),
+		archiveOrders: connect.NewClient[gen.ArchiveOrdersRequest, gen.ArchiveOrdersResponse](
+			httpClient,
+			baseURL+OrderServiceArchiveOrdersProcedure,
+			connect.WithSchema(orderServiceMethods.ByName("ArchiveOrders")),
+			connect.WithClientOptions(opts...),
+		),
+		getArchivedOrder: connect.NewClient[gen.GetArchivedOrderRequest, gen.GetArchivedOrderResponse](
+			httpClient,
+			baseURL+OrderServiceGetArchivedOrderProcedure,
+			connect.WithSchema(orderServiceMethods.ByName("GetArchivedOrder")),
+			connect.WithClientOptions(opts...),
+		),
+		createQuote: connect.NewClient[gen.CreateQuoteRequest, gen.CreateQuoteResponse](
+			httpClient,
+			baseURL+OrderServiceCreateQuoteProcedure,
+			connect.WithSchema(orderServiceMethods.ByName("CreateQuote")),
+			connect.WithClientOptions(opts...),
+		),
+		acceptQuote: connect.NewClient[gen.AcceptQuoteRequest, gen.AcceptQuoteResponse](
+			httpClient,
+			baseURL+OrderServiceAcceptQuoteProcedure,
+			connect.WithSchema(orderServiceMethods.ByName("AcceptQuote")),
+			connect.WithClientOptions(opts...),
+		),
+		convertQuoteToOrder: connect.NewClient[gen.ConvertQuoteToOrderRequest, gen.ConvertQuoteToOrderResponse](
+			httpClient,
+			baseURL+OrderServiceConvertQuoteToOrderProcedure,
+			connect.WithSchema(orderServiceMethods.ByName("ConvertQuoteToOrder")),
+			connect.WithClientOptions(opts...),
+		),
+		priceOrder: connect.NewClient[gen.PriceOrderRequest, gen.PriceOrderResponse](
+			httpClient,
+			baseURL+OrderServicePriceOrderProcedure,
+			connect.WithSchema(orderServiceMethods.ByName("PriceOrder")),
+			connect.WithClientOptions(opts...),
+		),
+		checkPurchaseEligibility: connect.NewClient[gen.CheckPurchaseEligibilityRequest, gen.CheckPurchaseEligibilityResponse](
+			httpClient,
+			baseURL+OrderServiceCheckPurchaseEligibilityProcedure,
+			connect.WithSchema(orderServiceMethods.ByName("CheckPurchaseEligibility")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// orderServiceClient implements OrderServiceClient.
+type orderServiceClient struct {
+	insertOrder              *connect.Client[gen.InsertOrderRequest, gen.InsertOrderResponse]
+	getAllOrders             *connect.Client[gen.GetAllOrdersRequest, gen.GetAllOrdersResponse]
+	getOrderByID             *connect.Client[gen.GetOrderByIDRequest, gen.GetOrderByIDResponse]
+	getOrdersByUser          *connect.Client[gen.GetOrdersByUserRequest, gen.GetOrdersByUserResponse]
+	watchOrder               *connect.Client[gen.WatchOrderRequest, gen.OrderStatusEvent]
+	cancelOrder              *connect.Client[gen.CancelOrderRequest, gen.CancelOrderResponse]
+	refundOrder              *connect.Client[gen.RefundOrderRequest, gen.RefundOrderResponse]
+	createReturnLabel        *connect.Client[gen.CreateReturnLabelRequest, gen.CreateReturnLabelResponse]
+	importOrders             *connect.Client[gen.ImportOrdersRequest, gen.ImportOrdersResponse]
+	getOrdersByIDs           *connect.Client[gen.GetOrdersByIDsRequest, gen.GetOrdersByIDsResponse]
+	archiveOrders            *connect.Client[gen.ArchiveOrdersRequest, gen.ArchiveOrdersResponse]
+	getArchivedOrder         *connect.Client[gen.GetArchivedOrderRequest, gen.GetArchivedOrderResponse]
+	createQuote              *connect.Client[gen.CreateQuoteRequest, gen.CreateQuoteResponse]
+	acceptQuote              *connect.Client[gen.AcceptQuoteRequest, gen.AcceptQuoteResponse]
+	convertQuoteToOrder      *connect.Client[gen.ConvertQuoteToOrderRequest, gen.ConvertQuoteToOrderResponse]
+	priceOrder               *connect.Client[gen.PriceOrderRequest, gen.PriceOrderResponse]
+	checkPurchaseEligibility *connect.Client[gen.CheckPurchaseEligibilityRequest, gen.CheckPurchaseEligibilityResponse]
+}
+
+// InsertOrder calls go.escape.ship.proto.v1.OrderService.InsertOrder.
+func (c *orderServiceClient) InsertOrder(ctx context.Context, req *connect.Request[gen.InsertOrderRequest]) (*connect.Response[gen.InsertOrderResponse], error) {
+	return c.insertOrder.CallUnary(ctx, req)
+}
+
+// GetAllOrders calls go.escape.ship.proto.v1.OrderService.GetAllOrders.
+func (c *orderServiceClient) GetAllOrders(ctx context.Context, req *connect.Request[gen.GetAllOrdersRequest]) (*connect.Response[gen.GetAllOrdersResponse], error) {
+	return c.getAllOrders.CallUnary(ctx, req)
+}
+
+// GetOrderByID calls go.escape.ship.proto.v1.OrderService.GetOrderByID.
+func (c *orderServiceClient) GetOrderByID(ctx context.Context, req *connect.Request[gen.GetOrderByIDRequest]) (*connect.Response[gen.GetOrderByIDResponse], error) {
+	return c.getOrderByID.CallUnary(ctx, req)
+}
+
+// GetOrdersByUser calls go.escape.ship.proto.v1.OrderService.GetOrdersByUser.
+func (c *orderServiceClient) GetOrdersByUser(ctx context.Context, req *connect.Request[gen.GetOrdersByUserRequest]) (*connect.Response[gen.GetOrdersByUserResponse], error) {
+	return c.getOrdersByUser.CallUnary(ctx, req)
+}
+
+// WatchOrder calls go.escape.ship.proto.v1.OrderService.WatchOrder.
+func (c *orderServiceClient) WatchOrder(ctx context.Context, req *connect.Request[gen.WatchOrderRequest]) (*connect.ServerStreamForClient[gen.OrderStatusEvent], error) {
+	return c.watchOrder.CallServerStream(ctx, req)
+}
+
+// CancelOrder calls go.escape.ship.proto.v1.OrderService.CancelOrder.
+func (c *orderServiceClient) CancelOrder(ctx context.Context, req *connect.Request[gen.CancelOrderRequest]) (*connect.Response[gen.CancelOrderResponse], error) {
+	return c.cancelOrder.CallUnary(ctx, req)
+}
+
+// RefundOrder calls go.escape.ship.proto.v1.OrderService.RefundOrder.
+func (c *orderServiceClient) RefundOrder(ctx context.Context, req *connect.Request[gen.RefundOrderRequest]) (*connect.Response[gen.RefundOrderResponse], error) {
+	return c.refundOrder.CallUnary(ctx, req)
+}
+
+// CreateReturnLabel calls go.escape.ship.proto.v1.OrderService.CreateReturnLabel.
+func (c *orderServiceClient) CreateReturnLabel(ctx context.Context, req *connect.Request[gen.CreateReturnLabelRequest]) (*connect.Response[gen.CreateReturnLabelResponse], error) {
+	return c.createReturnLabel.CallUnary(ctx, req)
+}
+
+// ImportOrders calls go.escape.ship.proto.v1.OrderService.ImportOrders.
+func (c *orderServiceClient) ImportOrders(ctx context.Context) *connect.ClientStreamForClient[gen.ImportOrdersRequest, gen.ImportOrdersResponse] {
+	return c.importOrders.CallClientStream(ctx)
+}
+
+// GetOrdersByIDs calls go.escape.ship.proto.v1.OrderService.GetOrdersByIDs.
+func (c *orderServiceClient) GetOrdersByIDs(ctx context.Context, req *connect.Request[gen.GetOrdersByIDsRequest]) (*connect.Response[gen.GetOrdersByIDsResponse], error) {
+	return c.getOrdersByIDs.CallUnary(ctx, req)
+}
+
+// ArchiveOrders calls go.escape.ship.proto.v1.OrderService.ArchiveOrders.
+func (c *orderServiceClient) ArchiveOrders(ctx context.Context, req *connect.Request[gen.ArchiveOrdersRequest]) (*connect.Response[gen.ArchiveOrdersResponse], error) {
+	return c.archiveOrders.CallUnary(ctx, req)
+}
+
+// GetArchivedOrder calls go.escape.ship.proto.v1.OrderService.GetArchivedOrder.
+func (c *orderServiceClient) GetArchivedOrder(ctx context.Context, req *connect.Request[gen.GetArchivedOrderRequest]) (*connect.Response[gen.GetArchivedOrderResponse], error) {
+	return c.getArchivedOrder.CallUnary(ctx, req)
+}
+
+// CreateQuote calls go.escape.ship.proto.v1.OrderService.CreateQuote.
+func (c *orderServiceClient) CreateQuote(ctx context.Context, req *connect.Request[gen.CreateQuoteRequest]) (*connect.Response[gen.CreateQuoteResponse], error) {
+	return c.createQuote.CallUnary(ctx, req)
+}
+
+// AcceptQuote calls go.escape.ship.proto.v1.OrderService.AcceptQuote.
+func (c *orderServiceClient) AcceptQuote(ctx context.Context, req *connect.Request[gen.AcceptQuoteRequest]) (*connect.Response[gen.AcceptQuoteResponse], error) {
+	return c.acceptQuote.CallUnary(ctx, req)
+}
+
+// ConvertQuoteToOrder calls go.escape.ship.proto.v1.OrderService.ConvertQuoteToOrder.
+func (c *orderServiceClient) ConvertQuoteToOrder(ctx context.Context, req *connect.Request[gen.ConvertQuoteToOrderRequest]) (*connect.Response[gen.ConvertQuoteToOrderResponse], error) {
+	return c.convertQuoteToOrder.CallUnary(ctx, req)
+}
+
+// PriceOrder calls go.escape.ship.proto.v1.OrderService.PriceOrder.
+func (c *orderServiceClient) PriceOrder(ctx context.Context, req *connect.Request[gen.PriceOrderRequest]) (*connect.Response[gen.PriceOrderResponse], error) {
+	return c.priceOrder.CallUnary(ctx, req)
+}
+
+// CheckPurchaseEligibility calls go.escape.ship.proto.v1.OrderService.CheckPurchaseEligibility.
+func (c *orderServiceClient) CheckPurchaseEligibility(ctx context.Context, req *connect.Request[gen.CheckPurchaseEligibilityRequest]) (*connect.Response[gen.CheckPurchaseEligibilityResponse], error) {
+	return c.checkPurchaseEligibility.CallUnary(ctx, req)
+}
+
+// OrderServiceHandler is an implementation of the go.escape.ship.proto.v1.OrderService service.
+type OrderServiceHandler interface {
+	InsertOrder(context.Context, *connect.Request[gen.InsertOrderRequest]) (*connect.Response[gen.InsertOrderResponse], error)
+	GetAllOrders(context.Context, *connect.Request[gen.GetAllOrdersRequest]) (*connect.Response[gen.GetAllOrdersResponse], error)
+	// 주문 단건 조회, 본인 주문이 아니면 orders:admin 필요 (없으면 NOT_FOUND)
+	// 아카이브된 주문은 NOT_FOUND, GetArchivedOrder로 조회
+	GetOrderByID(context.Context, *connect.Request[gen.GetOrderByIDRequest]) (*connect.Response[gen.GetOrderByIDResponse], error)
+	// 사용자별 주문 목록 (최신 주문 순), 다른 사용자의 주문은 orders:admin 필요 (없으면 PERMISSION_DENIED)
+	GetOrdersByUser(context.Context, *connect.Request[gen.GetOrdersByUserRequest]) (*connect.Response[gen.GetOrdersByUserResponse], error)
+	// 주문 상태 변경을 실시간으로 전달 (GetAllOrders 폴링 대체)
+	// 구독 직후 현재 상태를 한 번 보내고, 이후 변경될 때마다 전달
+	// 게이트웨이에서는 Accept: text/event-stream 요청 시 SSE로 응답
+	WatchOrder(context.Context, *connect.Request[gen.WatchOrderRequest], *connect.ServerStream[gen.OrderStatusEvent]) error
+	// 배송 전(PENDING/PAID) 주문 취소, 결제 완료 주문은 전액 환불까지 처리
+	CancelOrder(context.Context, *connect.Request[gen.CancelOrderRequest]) (*connect.Response[gen.CancelOrderResponse], error)
+	// 전체/부분 환불 (PaymentService.KakaoCancel로 결제 취소 후 Order.refunds에 기록)
+	RefundOrder(context.Context, *connect.Request[gen.RefundOrderRequest]) (*connect.Response[gen.RefundOrderResponse], error)
+	// 반품 건에 대해 택배사 수거 예약 후 출력용 라벨 URL 발급
+	CreateReturnLabel(context.Context, *connect.Request[gen.CreateReturnLabelRequest]) (*connect.Response[gen.CreateReturnLabelResponse], error)
+	// 전화/오프라인 주문 및 마켓플레이스 주문 일괄 등록 (행 단위 검증 결과 반환)
+	ImportOrders(context.Context, *connect.ClientStream[gen.ImportOrdersRequest]) (*connect.Response[gen.ImportOrdersResponse], error)
+	// 여러 주문 ID를 한 번에 조회, orders:admin 필요 (일부만 존재해도 성공, 없는 ID는 not_found_ids로 반환)
+	GetOrdersByIDs(context.Context, *connect.Request[gen.GetOrdersByIDsRequest]) (*connect.Response[gen.GetOrdersByIDsResponse], error)
+	// before_date 이전 주문을 콜드 스토리지로 이동
+	ArchiveOrders(context.Context, *connect.Request[gen.ArchiveOrdersRequest]) (*connect.Response[gen.ArchiveOrdersResponse], error)
+	// 아카이브된 주문 조회
+	GetArchivedOrder(context.Context, *connect.Request[gen.GetArchivedOrderRequest]) (*connect.Response[gen.GetArchivedOrderResponse], error)
+	// B2B 견적: 생성 → 고객 수락 → 주문 전환 (외상 결제 조건 지원)
+	CreateQuote(context.Context, *connect.Request[gen.CreateQuoteRequest]) (*connect.Response[gen.CreateQuoteResponse], error)
+	AcceptQuote(context.Context, *connect.Request[gen.AcceptQuoteRequest]) (*connect.Response[gen.AcceptQuoteResponse], error)
+	ConvertQuoteToOrder(context.Context, *connect.Request[gen.ConvertQuoteToOrderRequest]) (*connect.Response[gen.ConvertQuoteToOrderResponse], error)
+	// 프로모션 규칙을 적용한 주문 금액 계산 (장바구니/결제/환불 시 공통 사용)
+	// 같은 입력과 priced_at이면 항상 같은 결과를 반환
+	PriceOrder(context.Context, *connect.Request[gen.PriceOrderRequest]) (*connect.Response[gen.PriceOrderResponse], error)
+	// 고객당 구매 수량 제한 확인 (장바구니/결제/주문 등록 시 공통 사용)
+	CheckPurchaseEligibility(context.Context, *connect.Request[gen.CheckPurchaseEligibilityRequest]) (*connect.Response[gen.CheckPurchaseEligibilityResponse], error)
+}
+
+// NewOrderServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewOrderServiceHandler(svc OrderServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	orderServiceMethods := gen.File_order_proto.Services().ByName("OrderService").Methods()
+	orderServiceInsertOrderHandler := connect.NewUnaryHandler(
+		OrderServiceInsertOrderProcedure,
+		svc.InsertOrder,
+		connect.WithSchema(orderServiceMethods.ByName("InsertOrder")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orderServiceGetAllOrdersHandler := connect.NewUnaryHandler(
+		OrderServiceGetAllOrdersProcedure,
+		svc.GetAllOrders,
+		connect.WithSchema(orderServiceMethods.ByName("GetAllOrders")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orderServiceGetOrderByIDHandler := connect.NewUnaryHandler(
+		OrderServiceGetOrderByIDProcedure,
+		svc.GetOrderByID,
+		connect.WithSchema(orderServiceMethods.ByName("GetOrderByID")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orderServiceGetOrdersByUserHandler := connect.NewUnaryHandler(
+		OrderServiceGetOrdersByUserProcedure,
+		svc.GetOrdersByUser,
+		connect.WithSchema(orderServiceMethods.ByName("GetOrdersByUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orderServiceWatchOrderHandler := connect.NewServerStreamHandler(
+		OrderServiceWatchOrderProcedure,
+		svc.WatchOrder,
+		connect.WithSchema(orderServiceMethods.ByName("WatchOrder")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orderServiceCancelOrderHandler := connect.NewUnaryHandler(
+		OrderServiceCancelOrderProcedure,
+		svc.CancelOrder,
+		connect.WithSchema(orderServiceMethods.ByName("CancelOrder")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orderServiceRefundOrderHandler := connect.NewUnaryHandler(
+		OrderServiceRefundOrderProcedure,
+		svc.RefundOrder,
+		connect.WithSchema(orderServiceMethods.ByName("RefundOrder")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orderServiceCreateReturnLabelHandler := connect.NewUnaryHandler(
+		OrderServiceCreateReturnLabelProcedure,
+		svc.CreateReturnLabel,
+		connect.WithSchema(orderServiceMethods.ByName("CreateReturnLabel")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orderServiceImportOrdersHandler := connect.NewClientStreamHandler(
+		OrderServiceImportOrdersProcedure,
+		svc.ImportOrders,
+		connect.WithSchema(orderServiceMethods.ByName("ImportOrders")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orderServiceGetOrdersByIDsHandler := connect.NewUnaryHandler(
+		OrderServiceGetOrdersByIDsProcedure,
+		svc.GetOrdersByIDs,
+		connect.WithSchema(orderServiceMethods.ByName("GetOrdersByIDs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orderServiceArchiveOrdersHandler := connect.NewUnaryHandler(
+		OrderServiceArchiveOrdersProcedure,
+		svc.ArchiveOrders,
+		connect.WithSchema(orderServiceMethods.ByName("ArchiveOrders")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orderServiceGetArchivedOrderHandler := connect.NewUnaryHandler(
+		OrderServiceGetArchivedOrderProcedure,
+		svc.GetArchivedOrder,
+		connect.WithSchema(orderServiceMethods.ByName("GetArchivedOrder")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orderServiceCreateQuoteHandler := connect.NewUnaryHandler(
+		OrderServiceCreateQuoteProcedure,
+		svc.CreateQuote,
+		connect.WithSchema(orderServiceMethods.ByName("CreateQuote")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orderServiceAcceptQuoteHandler := connect.NewUnaryHandler(
+		OrderServiceAcceptQuoteProcedure,
+		svc.AcceptQuote,
+		connect.WithSchema(orderServiceMethods.ByName("AcceptQuote")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orderServiceConvertQuoteToOrderHandler := connect.NewUnaryHandler(
+		OrderServiceConvertQuoteToOrderProcedure,
+		svc.ConvertQuoteToOrder,
+		connect.WithSchema(orderServiceMethods.ByName("ConvertQuoteToOrder")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orderServicePriceOrderHandler := connect.NewUnaryHandler(
+		OrderServicePriceOrderProcedure,
+		svc.PriceOrder,
+		connect.WithSchema(orderServiceMethods.ByName("PriceOrder")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orderServiceCheckPurchaseEligibilityHandler := connect.NewUnaryHandler(
+		OrderServiceCheckPurchaseEligibilityProcedure,
+		svc.CheckPurchaseEligibility,
+		connect.WithSchema(orderServiceMethods.ByName("CheckPurchaseEligibility")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/go.escape.ship.proto.v1.OrderService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case OrderServiceInsertOrderProcedure:
+			orderServiceInsertOrderHandler.ServeHTTP(w, r)
+		case OrderServiceGetAllOrdersProcedure:
+			orderServiceGetAllOrdersHandler.ServeHTTP(w, r)
+		case OrderServiceGetOrderByIDProcedure:
+			orderServiceGetOrderByIDHandler.ServeHTTP(w, r)
+		case OrderServiceGetOrdersByUserProcedure:
+			orderServiceGetOrdersByUserHandler.ServeHTTP(w, r)
+		case OrderServiceWatchOrderProcedure:
+			orderServiceWatchOrderHandler.ServeHTTP(w, r)
+		case OrderServiceCancelOrderProcedure:
+			orderServiceCancelOrderHandler.ServeHTTP(w, r)
+		case OrderServiceRefundOrderProcedure:
+			orderServiceRefundOrderHandler.ServeHTTP(w, r)
+		case OrderServiceCreateReturnLabelProcedure:
+			orderServiceCreateReturnLabelHandler.ServeHTTP(w, r)
+		case OrderServiceImportOrdersProcedure:
+			orderServiceImportOrdersHandler.ServeHTTP(w, r)
+		case OrderServiceGetOrdersByIDsProcedure:
+			orderServiceGetOrdersByIDsHandler.ServeHTTP(w, r)
+		case OrderServiceArchiveOrdersProcedure:
+			orderServiceArchiveOrdersHandler.ServeHTTP(w, r)
+		case OrderServiceGetArchivedOrderProcedure:
+			orderServiceGetArchivedOrderHandler.ServeHTTP(w, r)
+		case OrderServiceCreateQuoteProcedure:
+			orderServiceCreateQuoteHandler.ServeHTTP(w, r)
+		case OrderServiceAcceptQuoteProcedure:
+			orderServiceAcceptQuoteHandler.ServeHTTP(w, r)
+		case OrderServiceConvertQuoteToOrderProcedure:
+			orderServiceConvertQuoteToOrderHandler.ServeHTTP(w, r)
+		case OrderServicePriceOrderProcedure:
+			orderServicePriceOrderHandler.ServeHTTP(w, r)
+		case OrderServiceCheckPurchaseEligibilityProcedure:
+			orderServiceCheckPurchaseEligibilityHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedOrderServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedOrderServiceHandler struct{}
+
+func (UnimplementedOrderServiceHandler) InsertOrder(context.Context, *connect.Request[gen.InsertOrderRequest]) (*connect.Response[gen.InsertOrderResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.OrderService.InsertOrder is not implemented"))
+}
+
+func (UnimplementedOrderServiceHandler) GetAllOrders(context.Context, *connect.Request[gen.GetAllOrdersRequest]) (*connect.Response[gen.GetAllOrdersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.OrderService.GetAllOrders is not implemented"))
+}
+
+func (UnimplementedOrderServiceHandler) GetOrderByID(context.Context, *connect.Request[gen.GetOrderByIDRequest]) (*connect.Response[gen.GetOrderByIDResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.OrderService.GetOrderByID is not implemented"))
+}
+
+func (UnimplementedOrderServiceHandler) GetOrdersByUser(context.Context, *connect.Request[gen.GetOrdersByUserRequest]) (*connect.Response[gen.GetOrdersByUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.OrderService.GetOrdersByUser is not implemented"))
+}
+
+func (UnimplementedOrderServiceHandler) WatchOrder(context.Context, *connect.Request[gen.WatchOrderRequest], *connect.ServerStream[gen.OrderStatusEvent]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.OrderService.WatchOrder is not implemented"))
+}
+
+func (UnimplementedOrderServiceHandler) CancelOrder(context.Context, *connect.Request[gen.CancelOrderRequest]) (*connect.Response[gen.CancelOrderResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.OrderService.CancelOrder is not implemented"))
+}
+
+func (UnimplementedOrderServiceHandler) RefundOrder(context.Context, *connect.Request[gen.RefundOrderRequest]) (*connect.Response[gen.RefundOrderResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.OrderService.RefundOrder is not implemented"))
+}
+
+func (UnimplementedOrderServiceHandler) CreateReturnLabel(context.Context, *connect.Request[gen.CreateReturnLabelRequest]) (*connect.Response[gen.CreateReturnLabelResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.OrderService.CreateReturnLabel is not implemented"))
+}
+
+func (UnimplementedOrderServiceHandler) ImportOrders(context.Context, *connect.ClientStream[gen.ImportOrdersRequest]) (*connect.Response[gen.ImportOrdersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.OrderService.ImportOrders is not implemented"))
+}
+
+func (UnimplementedOrderServiceHandler) GetOrdersByIDs(context.Context, *connect.Request[gen.GetOrdersByIDsRequest]) (*connect.Response[gen.GetOrdersByIDsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.OrderService.GetOrdersByIDs is not implemented"))
+}
+
+func (UnimplementedOrderServiceHandler) ArchiveOrders(context.Context, *connect.Request[gen.ArchiveOrdersRequest]) (*connect.Response[gen.ArchiveOrdersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.OrderService.ArchiveOrders is not implemented"))
+}
+
+func (UnimplementedOrderServiceHandler) GetArchivedOrder(context.Context, *connect.Request[gen.GetArchivedOrderRequest]) (*connect.Response[gen.GetArchivedOrderResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.OrderService.GetArchivedOrder is not implemented"))
+}
+
+func (UnimplementedOrderServiceHandler) CreateQuote(context.Context, *connect.Request[gen.CreateQuoteRequest]) (*connect.Response[gen.CreateQuoteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.OrderService.CreateQuote is not implemented"))
+}
+
+func (UnimplementedOrderServiceHandler) AcceptQuote(context.Context, *connect.Request[gen.AcceptQuoteRequest]) (*connect.Response[gen.AcceptQuoteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.OrderService.AcceptQuote is not implemented"))
+}
+
+func (UnimplementedOrderServiceHandler) ConvertQuoteToOrder(context.Context, *connect.Request[gen.ConvertQuoteToOrderRequest]) (*connect.Response[gen.ConvertQuoteToOrderResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.OrderService.ConvertQuoteToOrder is not implemented"))
+}
+
+func (UnimplementedOrderServiceHandler) PriceOrder(context.Context, *connect.Request[gen.PriceOrderRequest]) (*connect.Response[gen.PriceOrderResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.OrderService.PriceOrder is not implemented"))
+}
+
+func (UnimplementedOrderServiceHandler) CheckPurchaseEligibility(context.Context, *connect.Request[gen.CheckPurchaseEligibilityRequest]) (*connect.Response[gen.CheckPurchaseEligibilityResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.OrderService.CheckPurchaseEligibility is not implemented"))
+}
@@ -0,0 +1,262 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: payment.proto
+
+package genconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	gen "github.com/escape-ship/protos/gen"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// PaymentServiceName is the fully-qualified name of the PaymentService service.
+	PaymentServiceName = "go.escape.ship.proto.v1.PaymentService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// PaymentServiceKakaoReadyProcedure is the fully-qualified name of the PaymentService's KakaoReady
+	// RPC.
+	PaymentServiceKakaoReadyProcedure = "/go.escape.ship.proto.v1.PaymentService/KakaoReady"
+	// PaymentServiceKakaoApproveProcedure is the fully-qualified name of the PaymentService's
+	// KakaoApprove RPC.
+	PaymentServiceKakaoApproveProcedure = "/go.escape.ship.proto.v1.PaymentService/KakaoApprove"
+	// PaymentServiceKakaoCancelProcedure is the fully-qualified name of the PaymentService's
+	// KakaoCancel RPC.
+	PaymentServiceKakaoCancelProcedure = "/go.escape.ship.proto.v1.PaymentService/KakaoCancel"
+	// PaymentServicePreparePaymentProcedure is the fully-qualified name of the PaymentService's
+	// PreparePayment RPC.
+	PaymentServicePreparePaymentProcedure = "/go.escape.ship.proto.v1.PaymentService/PreparePayment"
+	// PaymentServiceApprovePaymentProcedure is the fully-qualified name of the PaymentService's
+	// ApprovePayment RPC.
+	PaymentServiceApprovePaymentProcedure = "/go.escape.ship.proto.v1.PaymentService/ApprovePayment"
+	// PaymentServiceCancelPaymentProcedure is the fully-qualified name of the PaymentService's
+	// CancelPayment RPC.
+	PaymentServiceCancelPaymentProcedure = "/go.escape.ship.proto.v1.PaymentService/CancelPayment"
+)
+
+// PaymentServiceClient is a client for the go.escape.ship.proto.v1.PaymentService service.
+type PaymentServiceClient interface {
+	KakaoReady(context.Context, *connect.Request[gen.KakaoReadyRequest]) (*connect.Response[gen.KakaoReadyResponse], error)
+	KakaoApprove(context.Context, *connect.Request[gen.KakaoApproveRequest]) (*connect.Response[gen.KakaoApproveResponse], error)
+	KakaoCancel(context.Context, *connect.Request[gen.KakaoCancelRequest]) (*connect.Response[gen.KakaoCancelResponse], error)
+	// 결제 준비: provider_details의 PG사로 결제를 생성하고 결제창 호출 정보를 반환
+	PreparePayment(context.Context, *connect.Request[gen.PreparePaymentRequest]) (*connect.Response[gen.PreparePaymentResponse], error)
+	// 결제 승인: 결제창 인증 결과(카카오페이 pg_token, 토스페이먼츠 paymentKey)로 결제 확정
+	// 토스페이먼츠 금액이 준비 때와 다르면 INVALID_ARGUMENT, 거절은 PAYMENT_DECLINED 에러
+	ApprovePayment(context.Context, *connect.Request[gen.ApprovePaymentRequest]) (*connect.Response[gen.ApprovePaymentResponse], error)
+	// 결제 전체/부분 취소
+	CancelPayment(context.Context, *connect.Request[gen.CancelPaymentRequest]) (*connect.Response[gen.CancelPaymentResponse], error)
+}
+
+// NewPaymentServiceClient constructs a client for the go.escape.ship.proto.v1.PaymentService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewPaymentServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) PaymentServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	paymentServiceMethods := gen.File_payment_proto.Services().ByName("PaymentService").Methods()
+	return &paymentServiceClient{
+		kakaoReady: connect.NewClient[gen.KakaoReadyRequest, gen.KakaoReadyResponse](
+			httpClient,
+			baseURL+PaymentServiceKakaoReadyProcedure,
+			connect.WithSchema(paymentServiceMethods.ByName("KakaoReady")),
+			connect.WithClientOptions(opts...),
+		),
+		kakaoApprove: connect.NewClient[gen.KakaoApproveRequest, gen.KakaoApproveResponse](
+			httpClient,
+			baseURL+PaymentServiceKakaoApproveProcedure,
+			connect.WithSchema(paymentServiceMethods.ByName("KakaoApprove")),
+			connect.WithClientOptions(opts...),
+		),
+		kakaoCancel: connect.NewClient[gen.KakaoCancelRequest, gen.KakaoCancelResponse](
+			httpClient,
+			baseURL+PaymentServiceKakaoCancelProcedure,
+			connect.WithSchema(paymentServiceMethods.ByName("KakaoCancel")),
+			connect.WithClientOptions(opts...),
+		),
+		preparePayment: connect.NewClient[gen.PreparePaymentRequest, gen.PreparePaymentResponse](
+			httpClient,
+			baseURL+PaymentServicePreparePaymentProcedure,
+			connect.WithSchema(paymentServiceMethods.ByName("PreparePayment")),
+			connect.WithClientOptions(opts...),
+		),
+		approvePayment: connect.NewClient[gen.ApprovePaymentRequest, gen.ApprovePaymentResponse](
+			httpClient,
+			baseURL+PaymentServiceApprovePaymentProcedure,
+			connect.WithSchema(paymentServiceMethods.ByName("ApprovePayment")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelPayment: connect.NewClient[gen.CancelPaymentRequest, gen.CancelPaymentResponse](
+			httpClient,
+			baseURL+PaymentServiceCancelPaymentProcedure,
+			connect.WithSchema(paymentServiceMethods.ByName("CancelPayment")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// paymentServiceClient implements PaymentServiceClient.
+type paymentServiceClient struct {
+	kakaoReady     *connect.Client[gen.KakaoReadyRequest, gen.KakaoReadyResponse]
+	kakaoApprove   *connect.Client[gen.KakaoApproveRequest, gen.KakaoApproveResponse]
+	kakaoCancel    *connect.Client[gen.KakaoCancelRequest, gen.KakaoCancelResponse]
+	preparePayment *connect.Client[gen.PreparePaymentRequest, gen.PreparePaymentResponse]
+	approvePayment *connect.Client[gen.ApprovePaymentRequest, gen.ApprovePaymentResponse]
+	cancelPayment  *connect.Client[gen.CancelPaymentRequest, gen.CancelPaymentResponse]
+}
+
+// KakaoReady calls go.escape.ship.proto.v1.PaymentService.KakaoReady.
+func (c *paymentServiceClient) KakaoReady(ctx context.Context, req *connect.Request[gen.KakaoReadyRequest]) (*connect.Response[gen.KakaoReadyResponse], error) {
+	return c.kakaoReady.CallUnary(ctx, req)
+}
+
+// KakaoApprove calls go.escape.ship.proto.v1.PaymentService.KakaoApprove.
+func (c *paymentServiceClient) KakaoApprove(ctx context.Context, req *connect.Request[gen.KakaoApproveRequest]) (*connect.Response[gen.KakaoApproveResponse], error) {
+	return c.kakaoApprove.CallUnary(ctx, req)
+}
+
+// KakaoCancel calls go.escape.ship.proto.v1.PaymentService.KakaoCancel.
+func (c *paymentServiceClient) KakaoCancel(ctx context.Context, req *connect.Request[gen.KakaoCancelRequest]) (*connect.Response[gen.KakaoCancelResponse], error) {
+	return c.kakaoCancel.CallUnary(ctx, req)
+}
+
+// PreparePayment calls go.escape.ship.proto.v1.PaymentService.PreparePayment.
+func (c *paymentServiceClient) PreparePayment(ctx context.Context, req *connect.Request[gen.PreparePaymentRequest]) (*connect.Response[gen.PreparePaymentResponse], error) {
+	return c.preparePayment.CallUnary(ctx, req)
+}
+
+// ApprovePayment calls go.escape.ship.proto.v1.PaymentService.ApprovePayment.
+func (c *paymentServiceClient) ApprovePayment(ctx context.Context, req *connect.Request[gen.ApprovePaymentRequest]) (*connect.Response[gen.ApprovePaymentResponse], error) {
+	return c.approvePayment.CallUnary(ctx, req)
+}
+
+// CancelPayment calls go.escape.ship.proto.v1.PaymentService.CancelPayment.
+func (c *paymentServiceClient) CancelPayment(ctx context.Context, req *connect.Request[gen.CancelPaymentRequest]) (*connect.Response[gen.CancelPaymentResponse], error) {
+	return c.cancelPayment.CallUnary(ctx, req)
+}
+
+// PaymentServiceHandler is an implementation of the go.escape.ship.proto.v1.PaymentService service.
+type PaymentServiceHandler interface {
+	KakaoReady(context.Context, *connect.Request[gen.KakaoReadyRequest]) (*connect.Response[gen.KakaoReadyResponse], error)
+	KakaoApprove(context.Context, *connect.Request[gen.KakaoApproveRequest]) (*connect.Response[gen.KakaoApproveResponse], error)
+	KakaoCancel(context.Context, *connect.Request[gen.KakaoCancelRequest]) (*connect.Response[gen.KakaoCancelResponse], error)
+	// 결제 준비: provider_details의 PG사로 결제를 생성하고 결제창 호출 정보를 반환
+	PreparePayment(context.Context, *connect.Request[gen.PreparePaymentRequest]) (*connect.Response[gen.PreparePaymentResponse], error)
+	// 결제 승인: 결제창 인증 결과(카카오페이 pg_token, 토스페이먼츠 paymentKey)로 결제 확정
+	// 토스페이먼츠 금액이 준비 때와 다르면 INVALID_ARGUMENT, 거절은 PAYMENT_DECLINED 에러
+	ApprovePayment(context.Context, *connect.Request[gen.ApprovePaymentRequest]) (*connect.Response[gen.ApprovePaymentResponse], error)
+	// 결제 전체/부분 취소
+	CancelPayment(context.Context, *connect.Request[gen.CancelPaymentRequest]) (*connect.Response[gen.CancelPaymentResponse], error)
+}
+
+// NewPaymentServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewPaymentServiceHandler(svc PaymentServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	paymentServiceMethods := gen.File_payment_proto.Services().ByName("PaymentService").Methods()
+	paymentServiceKakaoReadyHandler := connect.NewUnaryHandler(
+		PaymentServiceKakaoReadyProcedure,
+		svc.KakaoReady,
+		connect.WithSchema(paymentServiceMethods.ByName("KakaoReady")),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentServiceKakaoApproveHandler := connect.NewUnaryHandler(
+		PaymentServiceKakaoApproveProcedure,
+		svc.KakaoApprove,
+		connect.WithSchema(paymentServiceMethods.ByName("KakaoApprove")),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentServiceKakaoCancelHandler := connect.NewUnaryHandler(
+		PaymentServiceKakaoCancelProcedure,
+		svc.KakaoCancel,
+		connect.WithSchema(paymentServiceMethods.ByName("KakaoCancel")),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentServicePreparePaymentHandler := connect.NewUnaryHandler(
+		PaymentServicePreparePaymentProcedure,
+		svc.PreparePayment,
+		connect.WithSchema(paymentServiceMethods.ByName("PreparePayment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentServiceApprovePaymentHandler := connect.NewUnaryHandler(
+		PaymentServiceApprovePaymentProcedure,
+		svc.ApprovePayment,
+		connect.WithSchema(paymentServiceMethods.ByName("ApprovePayment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentServiceCancelPaymentHandler := connect.NewUnaryHandler(
+		PaymentServiceCancelPaymentProcedure,
+		svc.CancelPayment,
+		connect.WithSchema(paymentServiceMethods.ByName("CancelPayment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/go.escape.ship.proto.v1.PaymentService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case PaymentServiceKakaoReadyProcedure:
+			paymentServiceKakaoReadyHandler.ServeHTTP(w, r)
+		case PaymentServiceKakaoApproveProcedure:
+			paymentServiceKakaoApproveHandler.ServeHTTP(w, r)
+		case PaymentServiceKakaoCancelProcedure:
+			paymentServiceKakaoCancelHandler.ServeHTTP(w, r)
+		case PaymentServicePreparePaymentProcedure:
+			paymentServicePreparePaymentHandler.ServeHTTP(w, r)
+		case PaymentServiceApprovePaymentProcedure:
+			paymentServiceApprovePaymentHandler.ServeHTTP(w, r)
+		case PaymentServiceCancelPaymentProcedure:
+			paymentServiceCancelPaymentHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedPaymentServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedPaymentServiceHandler struct{}
+
+func (UnimplementedPaymentServiceHandler) KakaoReady(context.Context, *connect.Request[gen.KakaoReadyRequest]) (*connect.Response[gen.KakaoReadyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.PaymentService.KakaoReady is not implemented"))
+}
+
+func (UnimplementedPaymentServiceHandler) KakaoApprove(context.Context, *connect.Request[gen.KakaoApproveRequest]) (*connect.Response[gen.KakaoApproveResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.PaymentService.KakaoApprove is not implemented"))
+}
+
+func (UnimplementedPaymentServiceHandler) KakaoCancel(context.Context, *connect.Request[gen.KakaoCancelRequest]) (*connect.Response[gen.KakaoCancelResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.PaymentService.KakaoCancel is not implemented"))
+}
+
+func (UnimplementedPaymentServiceHandler) PreparePayment(context.Context, *connect.Request[gen.PreparePaymentRequest]) (*connect.Response[gen.PreparePaymentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.PaymentService.PreparePayment is not implemented"))
+}
+
+func (UnimplementedPaymentServiceHandler) ApprovePayment(context.Context, *connect.Request[gen.ApprovePaymentRequest]) (*connect.Response[gen.ApprovePaymentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.PaymentService.ApprovePayment is not implemented"))
+}
+
+func (UnimplementedPaymentServiceHandler) CancelPayment(context.Context, *connect.Request[gen.CancelPaymentRequest]) (*connect.Response[gen.CancelPaymentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.PaymentService.CancelPayment is not implemented"))
+}
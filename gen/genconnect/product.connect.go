@@ -0,0 +1,258 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: product.proto
+
+package genconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	gen "github.com/escape-ship/protos/gen"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ProductServiceName is the fully-qualified name of the ProductService service.
+	ProductServiceName = "go.escape.ship.proto.v1.ProductService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ProductServiceGetProductsProcedure is the fully-qualified name of the ProductService's
+	// GetProducts RPC.
+	ProductServiceGetProductsProcedure = "/go.escape.ship.proto.v1.ProductService/GetProducts"
+	// ProductServiceGetProductByIDProcedure is the fully-qualified name of the ProductService's
+	// GetProductByID RPC.
+	ProductServiceGetProductByIDProcedure = "/go.escape.ship.proto.v1.ProductService/GetProductByID"
+	// ProductServicePostProductsProcedure is the fully-qualified name of the ProductService's
+	// PostProducts RPC.
+	ProductServicePostProductsProcedure = "/go.escape.ship.proto.v1.ProductService/PostProducts"
+	// ProductServiceUpdateProductProcedure is the fully-qualified name of the ProductService's
+	// UpdateProduct RPC.
+	ProductServiceUpdateProductProcedure = "/go.escape.ship.proto.v1.ProductService/UpdateProduct"
+	// ProductServiceCreateBundleProcedure is the fully-qualified name of the ProductService's
+	// CreateBundle RPC.
+	ProductServiceCreateBundleProcedure = "/go.escape.ship.proto.v1.ProductService/CreateBundle"
+	// ProductServiceResolveBundleProcedure is the fully-qualified name of the ProductService's
+	// ResolveBundle RPC.
+	ProductServiceResolveBundleProcedure = "/go.escape.ship.proto.v1.ProductService/ResolveBundle"
+)
+
+// ProductServiceClient is a client for the go.escape.ship.proto.v1.ProductService service.
+type ProductServiceClient interface {
+	GetProducts(context.Context, *connect.Request[gen.GetProductsRequest]) (*connect.Response[gen.GetProductsResponse], error)
+	GetProductByID(context.Context, *connect.Request[gen.GetProductByIDRequest]) (*connect.Response[gen.GetProductByIDResponse], error)
+	PostProducts(context.Context, *connect.Request[gen.PostProductsRequest]) (*connect.Response[gen.PostProductsResponse], error)
+	// 상품 부분 수정: patch에 설정된 필드만 변경 (Go: ApplyProductPatch)
+	UpdateProduct(context.Context, *connect.Request[gen.UpdateProductRequest]) (*connect.Response[gen.UpdateProductResponse], error)
+	CreateBundle(context.Context, *connect.Request[gen.CreateBundleRequest]) (*connect.Response[gen.CreateBundleResponse], error)
+	// 번들을 구성 상품 단위로 전개 (주문 출고용)
+	ResolveBundle(context.Context, *connect.Request[gen.ResolveBundleRequest]) (*connect.Response[gen.ResolveBundleResponse], error)
+}
+
+// NewProductServiceClient constructs a client for the go.escape.ship.proto.v1.ProductService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewProductServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ProductServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	productServiceMethods := gen.File_product_proto.Services().ByName("ProductService").Methods()
+	return &productServiceClient{
+		getProducts: connect.NewClient[gen.GetProductsRequest, gen.GetProductsResponse](
+			httpClient,
+			baseURL+ProductServiceGetProductsProcedure,
+			connect.WithSchema(productServiceMethods.ByName("GetProducts")),
+			connect.WithClientOptions(opts...),
+		),
+		getProductByID: connect.NewClient[gen.GetProductByIDRequest, gen.GetProductByIDResponse](
+			httpClient,
+			baseURL+ProductServiceGetProductByIDProcedure,
+			connect.WithSchema(productServiceMethods.ByName("GetProductByID")),
+			connect.WithClientOptions(opts...),
+		),
+		postProducts: connect.NewClient[gen.PostProductsRequest, gen.PostProductsResponse](
+			httpClient,
+			baseURL+ProductServicePostProductsProcedure,
+			connect.WithSchema(productServiceMethods.ByName("PostProducts")),
+			connect.WithClientOptions(opts...),
+		),
+		updateProduct: connect.NewClient[gen.UpdateProductRequest, gen.UpdateProductResponse](
+			httpClient,
+			baseURL+ProductServiceUpdateProductProcedure,
+			connect.WithSchema(productServiceMethods.ByName("UpdateProduct")),
+			connect.WithClientOptions(opts...),
+		),
+		createBundle: connect.NewClient[gen.CreateBundleRequest, gen.CreateBundleResponse](
+			httpClient,
+			baseURL+ProductServiceCreateBundleProcedure,
+			connect.WithSchema(productServiceMethods.ByName("CreateBundle")),
+			connect.WithClientOptions(opts...),
+		),
+		resolveBundle: connect.NewClient[gen.ResolveBundleRequest, gen.ResolveBundleResponse](
+			httpClient,
+			baseURL+ProductServiceResolveBundleProcedure,
+			connect.WithSchema(productServiceMethods.ByName("ResolveBundle")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// productServiceClient implements ProductServiceClient.
+type productServiceClient struct {
+	getProducts    *connect.Client[gen.GetProductsRequest, gen.GetProductsResponse]
+	getProductByID *connect.Client[gen.GetProductByIDRequest, gen.GetProductByIDResponse]
+	postProducts   *connect.Client[gen.PostProductsRequest, gen.PostProductsResponse]
+	updateProduct  *connect.Client[gen.UpdateProductRequest, gen.UpdateProductResponse]
+	createBundle   *connect.Client[gen.CreateBundleRequest, gen.CreateBundleResponse]
+	resolveBundle  *connect.Client[gen.ResolveBundleRequest, gen.ResolveBundleResponse]
+}
+
+// GetProducts calls go.escape.ship.proto.v1.ProductService.GetProducts.
+func (c *productServiceClient) GetProducts(ctx context.Context, req *connect.Request[gen.GetProductsRequest]) (*connect.Response[gen.GetProductsResponse], error) {
+	return c.getProducts.CallUnary(ctx, req)
+}
+
+// GetProductByID calls go.escape.ship.proto.v1.ProductService.GetProductByID.
+func (c *productServiceClient) GetProductByID(ctx context.Context, req *connect.Request[gen.GetProductByIDRequest]) (*connect.Response[gen.GetProductByIDResponse], error) {
+	return c.getProductByID.CallUnary(ctx, req)
+}
+
+// PostProducts calls go.escape.ship.proto.v1.ProductService.PostProducts.
+func (c *productServiceClient) PostProducts(ctx context.Context, req *connect.Request[gen.PostProductsRequest]) (*connect.Response[gen.PostProductsResponse], error) {
+	return c.postProducts.CallUnary(ctx, req)
+}
+
+// UpdateProduct calls go.escape.ship.proto.v1.ProductService.UpdateProduct.
+func (c *productServiceClient) UpdateProduct(ctx context.Context, req *connect.Request[gen.UpdateProductRequest]) (*connect.Response[gen.UpdateProductResponse], error) {
+	return c.updateProduct.CallUnary(ctx, req)
+}
+
+// CreateBundle calls go.escape.ship.proto.v1.ProductService.CreateBundle.
+func (c *productServiceClient) CreateBundle(ctx context.Context, req *connect.Request[gen.CreateBundleRequest]) (*connect.Response[gen.CreateBundleResponse], error) {
+	return c.createBundle.CallUnary(ctx, req)
+}
+
+// ResolveBundle calls go.escape.ship.proto.v1.ProductService.ResolveBundle.
+func (c *productServiceClient) ResolveBundle(ctx context.Context, req *connect.Request[gen.ResolveBundleRequest]) (*connect.Response[gen.ResolveBundleResponse], error) {
+	return c.resolveBundle.CallUnary(ctx, req)
+}
+
+// ProductServiceHandler is an implementation of the go.escape.ship.proto.v1.ProductService service.
+type ProductServiceHandler interface {
+	GetProducts(context.Context, *connect.Request[gen.GetProductsRequest]) (*connect.Response[gen.GetProductsResponse], error)
+	GetProductByID(context.Context, *connect.Request[gen.GetProductByIDRequest]) (*connect.Response[gen.GetProductByIDResponse], error)
+	PostProducts(context.Context, *connect.Request[gen.PostProductsRequest]) (*connect.Response[gen.PostProductsResponse], error)
+	// 상품 부분 수정: patch에 설정된 필드만 변경 (Go: ApplyProductPatch)
+	UpdateProduct(context.Context, *connect.Request[gen.UpdateProductRequest]) (*connect.Response[gen.UpdateProductResponse], error)
+	CreateBundle(context.Context, *connect.Request[gen.CreateBundleRequest]) (*connect.Response[gen.CreateBundleResponse], error)
+	// 번들을 구성 상품 단위로 전개 (주문 출고용)
+	ResolveBundle(context.Context, *connect.Request[gen.ResolveBundleRequest]) (*connect.Response[gen.ResolveBundleResponse], error)
+}
+
+// NewProductServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewProductServiceHandler(svc ProductServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	productServiceMethods := gen.File_product_proto.Services().ByName("ProductService").Methods()
+	productServiceGetProductsHandler := connect.NewUnaryHandler(
+		ProductServiceGetProductsProcedure,
+		svc.GetProducts,
+		connect.WithSchema(productServiceMethods.ByName("GetProducts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceGetProductByIDHandler := connect.NewUnaryHandler(
+		ProductServiceGetProductByIDProcedure,
+		svc.GetProductByID,
+		connect.WithSchema(productServiceMethods.ByName("GetProductByID")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServicePostProductsHandler := connect.NewUnaryHandler(
+		ProductServicePostProductsProcedure,
+		svc.PostProducts,
+		connect.WithSchema(productServiceMethods.ByName("PostProducts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceUpdateProductHandler := connect.NewUnaryHandler(
+		ProductServiceUpdateProductProcedure,
+		svc.UpdateProduct,
+		connect.WithSchema(productServiceMethods.ByName("UpdateProduct")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceCreateBundleHandler := connect.NewUnaryHandler(
+		ProductServiceCreateBundleProcedure,
+		svc.CreateBundle,
+		connect.WithSchema(productServiceMethods.ByName("CreateBundle")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceResolveBundleHandler := connect.NewUnaryHandler(
+		ProductServiceResolveBundleProcedure,
+		svc.ResolveBundle,
+		connect.WithSchema(productServiceMethods.ByName("ResolveBundle")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/go.escape.ship.proto.v1.ProductService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ProductServiceGetProductsProcedure:
+			productServiceGetProductsHandler.ServeHTTP(w, r)
+		case ProductServiceGetProductByIDProcedure:
+			productServiceGetProductByIDHandler.ServeHTTP(w, r)
+		case ProductServicePostProductsProcedure:
+			productServicePostProductsHandler.ServeHTTP(w, r)
+		case ProductServiceUpdateProductProcedure:
+			productServiceUpdateProductHandler.ServeHTTP(w, r)
+		case ProductServiceCreateBundleProcedure:
+			productServiceCreateBundleHandler.ServeHTTP(w, r)
+		case ProductServiceResolveBundleProcedure:
+			productServiceResolveBundleHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedProductServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedProductServiceHandler struct{}
+
+func (UnimplementedProductServiceHandler) GetProducts(context.Context, *connect.Request[gen.GetProductsRequest]) (*connect.Response[gen.GetProductsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.ProductService.GetProducts is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) GetProductByID(context.Context, *connect.Request[gen.GetProductByIDRequest]) (*connect.Response[gen.GetProductByIDResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.ProductService.GetProductByID is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) PostProducts(context.Context, *connect.Request[gen.PostProductsRequest]) (*connect.Response[gen.PostProductsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.ProductService.PostProducts is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) UpdateProduct(context.Context, *connect.Request[gen.UpdateProductRequest]) (*connect.Response[gen.UpdateProductResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.ProductService.UpdateProduct is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) CreateBundle(context.Context, *connect.Request[gen.CreateBundleRequest]) (*connect.Response[gen.CreateBundleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.ProductService.CreateBundle is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) ResolveBundle(context.Context, *connect.Request[gen.ResolveBundleRequest]) (*connect.Response[gen.ResolveBundleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.ProductService.ResolveBundle is not implemented"))
+}
@@ -0,0 +1,231 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: review.proto
+
+package genconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	gen "github.com/escape-ship/protos/gen"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ReviewServiceName is the fully-qualified name of the ReviewService service.
+	ReviewServiceName = "go.escape.ship.proto.v1.ReviewService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ReviewServiceCreateReviewProcedure is the fully-qualified name of the ReviewService's
+	// CreateReview RPC.
+	ReviewServiceCreateReviewProcedure = "/go.escape.ship.proto.v1.ReviewService/CreateReview"
+	// ReviewServiceListReviewsByProductProcedure is the fully-qualified name of the ReviewService's
+	// ListReviewsByProduct RPC.
+	ReviewServiceListReviewsByProductProcedure = "/go.escape.ship.proto.v1.ReviewService/ListReviewsByProduct"
+	// ReviewServiceUpdateReviewProcedure is the fully-qualified name of the ReviewService's
+	// UpdateReview RPC.
+	ReviewServiceUpdateReviewProcedure = "/go.escape.ship.proto.v1.ReviewService/UpdateReview"
+	// ReviewServiceDeleteReviewProcedure is the fully-qualified name of the ReviewService's
+	// DeleteReview RPC.
+	ReviewServiceDeleteReviewProcedure = "/go.escape.ship.proto.v1.ReviewService/DeleteReview"
+	// ReviewServiceGetProductRatingSummaryProcedure is the fully-qualified name of the ReviewService's
+	// GetProductRatingSummary RPC.
+	ReviewServiceGetProductRatingSummaryProcedure = "/go.escape.ship.proto.v1.ReviewService/GetProductRatingSummary"
+)
+
+// ReviewServiceClient is a client for the go.escape.ship.proto.v1.ReviewService service.
+type ReviewServiceClient interface {
+	// 구매 확정(배송 완료) 주문의 상품에 대해 주문 항목당 1회 작성 가능, 작성자는 인증된 사용자
+	CreateReview(context.Context, *connect.Request[gen.CreateReviewRequest]) (*connect.Response[gen.CreateReviewResponse], error)
+	ListReviewsByProduct(context.Context, *connect.Request[gen.ListReviewsByProductRequest]) (*connect.Response[gen.ListReviewsByProductResponse], error)
+	// 작성자 본인만 수정 가능
+	UpdateReview(context.Context, *connect.Request[gen.UpdateReviewRequest]) (*connect.Response[gen.UpdateReviewResponse], error)
+	// 작성자 본인 또는 관리자만 삭제 가능
+	DeleteReview(context.Context, *connect.Request[gen.DeleteReviewRequest]) (*connect.Response[gen.DeleteReviewResponse], error)
+	GetProductRatingSummary(context.Context, *connect.Request[gen.GetProductRatingSummaryRequest]) (*connect.Response[gen.GetProductRatingSummaryResponse], error)
+}
+
+// NewReviewServiceClient constructs a client for the go.escape.ship.proto.v1.ReviewService service.
+// By default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped
+// responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewReviewServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ReviewServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	reviewServiceMethods := gen.File_review_proto.Services().ByName("ReviewService").Methods()
+	return &reviewServiceClient{
+		createReview: connect.NewClient[gen.CreateReviewRequest, gen.CreateReviewResponse](
+			httpClient,
+			baseURL+ReviewServiceCreateReviewProcedure,
+			connect.WithSchema(reviewServiceMethods.ByName("CreateReview")),
+			connect.WithClientOptions(opts...),
+		),
+		listReviewsByProduct: connect.NewClient[gen.ListReviewsByProductRequest, gen.ListReviewsByProductResponse](
+			httpClient,
+			baseURL+ReviewServiceListReviewsByProductProcedure,
+			connect.WithSchema(reviewServiceMethods.ByName("ListReviewsByProduct")),
+			connect.WithClientOptions(opts...),
+		),
+		updateReview: connect.NewClient[gen.UpdateReviewRequest, gen.UpdateReviewResponse](
+			httpClient,
+			baseURL+ReviewServiceUpdateReviewProcedure,
+			connect.WithSchema(reviewServiceMethods.ByName("UpdateReview")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteReview: connect.NewClient[gen.DeleteReviewRequest, gen.DeleteReviewResponse](
+			httpClient,
+			baseURL+ReviewServiceDeleteReviewProcedure,
+			connect.WithSchema(reviewServiceMethods.ByName("DeleteReview")),
+			connect.WithClientOptions(opts...),
+		),
+		getProductRatingSummary: connect.NewClient[gen.GetProductRatingSummaryRequest, gen.GetProductRatingSummaryResponse](
+			httpClient,
+			baseURL+ReviewServiceGetProductRatingSummaryProcedure,
+			connect.WithSchema(reviewServiceMethods.ByName("GetProductRatingSummary")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// reviewServiceClient implements ReviewServiceClient.
+type reviewServiceClient struct {
+	createReview            *connect.Client[gen.CreateReviewRequest, gen.CreateReviewResponse]
+	listReviewsByProduct    *connect.Client[gen.ListReviewsByProductRequest, gen.ListReviewsByProductResponse]
+	updateReview            *connect.Client[gen.UpdateReviewRequest, gen.UpdateReviewResponse]
+	deleteReview            *connect.Client[gen.DeleteReviewRequest, gen.DeleteReviewResponse]
+	getProductRatingSummary *connect.Client[gen.GetProductRatingSummaryRequest, gen.GetProductRatingSummaryResponse]
+}
+
+// CreateReview calls go.escape.ship.proto.v1.ReviewService.CreateReview.
+func (c *reviewServiceClient) CreateReview(ctx context.Context, req *connect.Request[gen.CreateReviewRequest]) (*connect.Response[gen.CreateReviewResponse], error) {
+	return c.createReview.CallUnary(ctx, req)
+}
+
+// ListReviewsByProduct calls go.escape.ship.proto.v1.ReviewService.ListReviewsByProduct.
+func (c *reviewServiceClient) ListReviewsByProduct(ctx context.Context, req *connect.Request[gen.ListReviewsByProductRequest]) (*connect.Response[gen.ListReviewsByProductResponse], error) {
+	return c.listReviewsByProduct.CallUnary(ctx, req)
+}
+
+// UpdateReview calls go.escape.ship.proto.v1.ReviewService.UpdateReview.
+func (c *reviewServiceClient) UpdateReview(ctx context.Context, req *connect.Request[gen.UpdateReviewRequest]) (*connect.Response[gen.UpdateReviewResponse], error) {
+	return c.updateReview.CallUnary(ctx, req)
+}
+
+// DeleteReview calls go.escape.ship.proto.v1.ReviewService.DeleteReview.
+func (c *reviewServiceClient) DeleteReview(ctx context.Context, req *connect.Request[gen.DeleteReviewRequest]) (*connect.Response[gen.DeleteReviewResponse], error) {
+	return c.deleteReview.CallUnary(ctx, req)
+}
+
+// GetProductRatingSummary calls go.escape.ship.proto.v1.ReviewService.GetProductRatingSummary.
+func (c *reviewServiceClient) GetProductRatingSummary(ctx context.Context, req *connect.Request[gen.GetProductRatingSummaryRequest]) (*connect.Response[gen.GetProductRatingSummaryResponse], error) {
+	return c.getProductRatingSummary.CallUnary(ctx, req)
+}
+
+// ReviewServiceHandler is an implementation of the go.escape.ship.proto.v1.ReviewService service.
+type ReviewServiceHandler interface {
+	// 구매 확정(배송 완료) 주문의 상품에 대해 주문 항목당 1회 작성 가능, 작성자는 인증된 사용자
+	CreateReview(context.Context, *connect.Request[gen.CreateReviewRequest]) (*connect.Response[gen.CreateReviewResponse], error)
+	ListReviewsByProduct(context.Context, *connect.Request[gen.ListReviewsByProductRequest]) (*connect.Response[gen.ListReviewsByProductResponse], error)
+	// 작성자 본인만 수정 가능
+	UpdateReview(context.Context, *connect.Request[gen.UpdateReviewRequest]) (*connect.Response[gen.UpdateReviewResponse], error)
+	// 작성자 본인 또는 관리자만 삭제 가능
+	DeleteReview(context.Context, *connect.Request[gen.DeleteReviewRequest]) (*connect.Response[gen.DeleteReviewResponse], error)
+	GetProductRatingSummary(context.Context, *connect.Request[gen.GetProductRatingSummaryRequest]) (*connect.Response[gen.GetProductRatingSummaryResponse], error)
+}
+
+// NewReviewServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewReviewServiceHandler(svc ReviewServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	reviewServiceMethods := gen.File_review_proto.Services().ByName("ReviewService").Methods()
+	reviewServiceCreateReviewHandler := connect.NewUnaryHandler(
+		ReviewServiceCreateReviewProcedure,
+		svc.CreateReview,
+		connect.WithSchema(reviewServiceMethods.ByName("CreateReview")),
+		connect.WithHandlerOptions(opts...),
+	)
+	reviewServiceListReviewsByProductHandler := connect.NewUnaryHandler(
+		ReviewServiceListReviewsByProductProcedure,
+		svc.ListReviewsByProduct,
+		connect.WithSchema(reviewServiceMethods.ByName("ListReviewsByProduct")),
+		connect.WithHandlerOptions(opts...),
+	)
+	reviewServiceUpdateReviewHandler := connect.NewUnaryHandler(
+		ReviewServiceUpdateReviewProcedure,
+		svc.UpdateReview,
+		connect.WithSchema(reviewServiceMethods.ByName("UpdateReview")),
+		connect.WithHandlerOptions(opts...),
+	)
+	reviewServiceDeleteReviewHandler := connect.NewUnaryHandler(
+		ReviewServiceDeleteReviewProcedure,
+		svc.DeleteReview,
+		connect.WithSchema(reviewServiceMethods.ByName("DeleteReview")),
+		connect.WithHandlerOptions(opts...),
+	)
+	reviewServiceGetProductRatingSummaryHandler := connect.NewUnaryHandler(
+		ReviewServiceGetProductRatingSummaryProcedure,
+		svc.GetProductRatingSummary,
+		connect.WithSchema(reviewServiceMethods.ByName("GetProductRatingSummary")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/go.escape.ship.proto.v1.ReviewService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ReviewServiceCreateReviewProcedure:
+			reviewServiceCreateReviewHandler.ServeHTTP(w, r)
+		case ReviewServiceListReviewsByProductProcedure:
+			reviewServiceListReviewsByProductHandler.ServeHTTP(w, r)
+		case ReviewServiceUpdateReviewProcedure:
+			reviewServiceUpdateReviewHandler.ServeHTTP(w, r)
+		case ReviewServiceDeleteReviewProcedure:
+			reviewServiceDeleteReviewHandler.ServeHTTP(w, r)
+		case ReviewServiceGetProductRatingSummaryProcedure:
+			reviewServiceGetProductRatingSummaryHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedReviewServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedReviewServiceHandler struct{}
+
+func (UnimplementedReviewServiceHandler) CreateReview(context.Context, *connect.Request[gen.CreateReviewRequest]) (*connect.Response[gen.CreateReviewResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.ReviewService.CreateReview is not implemented"))
+}
+
+func (UnimplementedReviewServiceHandler) ListReviewsByProduct(context.Context, *connect.Request[gen.ListReviewsByProductRequest]) (*connect.Response[gen.ListReviewsByProductResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.ReviewService.ListReviewsByProduct is not implemented"))
+}
+
+func (UnimplementedReviewServiceHandler) UpdateReview(context.Context, *connect.Request[gen.UpdateReviewRequest]) (*connect.Response[gen.UpdateReviewResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.ReviewService.UpdateReview is not implemented"))
+}
+
+func (UnimplementedReviewServiceHandler) DeleteReview(context.Context, *connect.Request[gen.DeleteReviewRequest]) (*connect.Response[gen.DeleteReviewResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.ReviewService.DeleteReview is not implemented"))
+}
+
+func (UnimplementedReviewServiceHandler) GetProductRatingSummary(context.Context, *connect.Request[gen.GetProductRatingSummaryRequest]) (*connect.Response[gen.GetProductRatingSummaryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.ReviewService.GetProductRatingSummary is not implemented"))
+}
@@ -0,0 +1,169 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: risk.proto
+
+package genconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	gen "github.com/escape-ship/protos/gen"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// RiskServiceName is the fully-qualified name of the RiskService service.
+	RiskServiceName = "go.escape.ship.proto.v1.RiskService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// RiskServiceAddToBlocklistProcedure is the fully-qualified name of the RiskService's
+	// AddToBlocklist RPC.
+	RiskServiceAddToBlocklistProcedure = "/go.escape.ship.proto.v1.RiskService/AddToBlocklist"
+	// RiskServiceRemoveFromBlocklistProcedure is the fully-qualified name of the RiskService's
+	// RemoveFromBlocklist RPC.
+	RiskServiceRemoveFromBlocklistProcedure = "/go.escape.ship.proto.v1.RiskService/RemoveFromBlocklist"
+	// RiskServiceCheckBlocklistProcedure is the fully-qualified name of the RiskService's
+	// CheckBlocklist RPC.
+	RiskServiceCheckBlocklistProcedure = "/go.escape.ship.proto.v1.RiskService/CheckBlocklist"
+)
+
+// RiskServiceClient is a client for the go.escape.ship.proto.v1.RiskService service.
+type RiskServiceClient interface {
+	AddToBlocklist(context.Context, *connect.Request[gen.AddToBlocklistRequest]) (*connect.Response[gen.AddToBlocklistResponse], error)
+	RemoveFromBlocklist(context.Context, *connect.Request[gen.RemoveFromBlocklistRequest]) (*connect.Response[gen.RemoveFromBlocklistResponse], error)
+	// 주어진 식별자 중 하나라도 차단 목록에 있으면 blocked=true
+	CheckBlocklist(context.Context, *connect.Request[gen.CheckBlocklistRequest]) (*connect.Response[gen.CheckBlocklistResponse], error)
+}
+
+// NewRiskServiceClient constructs a client for the go.escape.ship.proto.v1.RiskService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewRiskServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) RiskServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	riskServiceMethods := gen.File_risk_proto.Services().ByName("RiskService").Methods()
+	return &riskServiceClient{
+		addToBlocklist: connect.NewClient[gen.AddToBlocklistRequest, gen.AddToBlocklistResponse](
+			httpClient,
+			baseURL+RiskServiceAddToBlocklistProcedure,
+			connect.WithSchema(riskServiceMethods.ByName("AddToBlocklist")),
+			connect.WithClientOptions(opts...),
+		),
+		removeFromBlocklist: connect.NewClient[gen.RemoveFromBlocklistRequest, gen.RemoveFromBlocklistResponse](
+			httpClient,
+			baseURL+RiskServiceRemoveFromBlocklistProcedure,
+			connect.WithSchema(riskServiceMethods.ByName("RemoveFromBlocklist")),
+			connect.WithClientOptions(opts...),
+		),
+		checkBlocklist: connect.NewClient[gen.CheckBlocklistRequest, gen.CheckBlocklistResponse](
+			httpClient,
+			baseURL+RiskServiceCheckBlocklistProcedure,
+			connect.WithSchema(riskServiceMethods.ByName("CheckBlocklist")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// riskServiceClient implements RiskServiceClient.
+type riskServiceClient struct {
+	addToBlocklist      *connect.Client[gen.AddToBlocklistRequest, gen.AddToBlocklistResponse]
+	removeFromBlocklist *connect.Client[gen.RemoveFromBlocklistRequest, gen.RemoveFromBlocklistResponse]
+	checkBlocklist      *connect.Client[gen.CheckBlocklistRequest, gen.CheckBlocklistResponse]
+}
+
+// AddToBlocklist calls go.escape.ship.proto.v1.RiskService.AddToBlocklist.
+func (c *riskServiceClient) AddToBlocklist(ctx context.Context, req *connect.Request[gen.AddToBlocklistRequest]) (*connect.Response[gen.AddToBlocklistResponse], error) {
+	return c.addToBlocklist.CallUnary(ctx, req)
+}
+
+// RemoveFromBlocklist calls go.escape.ship.proto.v1.RiskService.RemoveFromBlocklist.
+func (c *riskServiceClient) RemoveFromBlocklist(ctx context.Context, req *connect.Request[gen.RemoveFromBlocklistRequest]) (*connect.Response[gen.RemoveFromBlocklistResponse], error) {
+	return c.removeFromBlocklist.CallUnary(ctx, req)
+}
+
+// CheckBlocklist calls go.escape.ship.proto.v1.RiskService.CheckBlocklist.
+func (c *riskServiceClient) CheckBlocklist(ctx context.Context, req *connect.Request[gen.CheckBlocklistRequest]) (*connect.Response[gen.CheckBlocklistResponse], error) {
+	return c.checkBlocklist.CallUnary(ctx, req)
+}
+
+// RiskServiceHandler is an implementation of the go.escape.ship.proto.v1.RiskService service.
+type RiskServiceHandler interface {
+	AddToBlocklist(context.Context, *connect.Request[gen.AddToBlocklistRequest]) (*connect.Response[gen.AddToBlocklistResponse], error)
+	RemoveFromBlocklist(context.Context, *connect.Request[gen.RemoveFromBlocklistRequest]) (*connect.Response[gen.RemoveFromBlocklistResponse], error)
+	// 주어진 식별자 중 하나라도 차단 목록에 있으면 blocked=true
+	CheckBlocklist(context.Context, *connect.Request[gen.CheckBlocklistRequest]) (*connect.Response[gen.CheckBlocklistResponse], error)
+}
+
+// NewRiskServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewRiskServiceHandler(svc RiskServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	riskServiceMethods := gen.File_risk_proto.Services().ByName("RiskService").Methods()
+	riskServiceAddToBlocklistHandler := connect.NewUnaryHandler(
+		RiskServiceAddToBlocklistProcedure,
+		svc.AddToBlocklist,
+		connect.WithSchema(riskServiceMethods.ByName("AddToBlocklist")),
+		connect.WithHandlerOptions(opts...),
+	)
+	riskServiceRemoveFromBlocklistHandler := connect.NewUnaryHandler(
+		RiskServiceRemoveFromBlocklistProcedure,
+		svc.RemoveFromBlocklist,
+		connect.WithSchema(riskServiceMethods.ByName("RemoveFromBlocklist")),
+		connect.WithHandlerOptions(opts...),
+	)
+	riskServiceCheckBlocklistHandler := connect.NewUnaryHandler(
+		RiskServiceCheckBlocklistProcedure,
+		svc.CheckBlocklist,
+		connect.WithSchema(riskServiceMethods.ByName("CheckBlocklist")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/go.escape.ship.proto.v1.RiskService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case RiskServiceAddToBlocklistProcedure:
+			riskServiceAddToBlocklistHandler.ServeHTTP(w, r)
+		case RiskServiceRemoveFromBlocklistProcedure:
+			riskServiceRemoveFromBlocklistHandler.ServeHTTP(w, r)
+		case RiskServiceCheckBlocklistProcedure:
+			riskServiceCheckBlocklistHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedRiskServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedRiskServiceHandler struct{}
+
+func (UnimplementedRiskServiceHandler) AddToBlocklist(context.Context, *connect.Request[gen.AddToBlocklistRequest]) (*connect.Response[gen.AddToBlocklistResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.RiskService.AddToBlocklist is not implemented"))
+}
+
+func (UnimplementedRiskServiceHandler) RemoveFromBlocklist(context.Context, *connect.Request[gen.RemoveFromBlocklistRequest]) (*connect.Response[gen.RemoveFromBlocklistResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.RiskService.RemoveFromBlocklist is not implemented"))
+}
+
+func (UnimplementedRiskServiceHandler) CheckBlocklist(context.Context, *connect.Request[gen.CheckBlocklistRequest]) (*connect.Response[gen.CheckBlocklistResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.RiskService.CheckBlocklist is not implemented"))
+}
@@ -0,0 +1,209 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: shipping.proto
+
+package genconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	gen "github.com/escape-ship/protos/gen"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ShippingServiceName is the fully-qualified name of the ShippingService service.
+	ShippingServiceName = "go.escape.ship.proto.v1.ShippingService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ShippingServiceCreateShipmentProcedure is the fully-qualified name of the ShippingService's
+	// CreateShipment RPC.
+	ShippingServiceCreateShipmentProcedure = "/go.escape.ship.proto.v1.ShippingService/CreateShipment"
+	// ShippingServiceGetTrackingInfoProcedure is the fully-qualified name of the ShippingService's
+	// GetTrackingInfo RPC.
+	ShippingServiceGetTrackingInfoProcedure = "/go.escape.ship.proto.v1.ShippingService/GetTrackingInfo"
+	// ShippingServiceUpdateShipmentStatusProcedure is the fully-qualified name of the ShippingService's
+	// UpdateShipmentStatus RPC.
+	ShippingServiceUpdateShipmentStatusProcedure = "/go.escape.ship.proto.v1.ShippingService/UpdateShipmentStatus"
+	// ShippingServiceWatchShipmentProcedure is the fully-qualified name of the ShippingService's
+	// WatchShipment RPC.
+	ShippingServiceWatchShipmentProcedure = "/go.escape.ship.proto.v1.ShippingService/WatchShipment"
+)
+
+// ShippingServiceClient is a client for the go.escape.ship.proto.v1.ShippingService service.
+type ShippingServiceClient interface {
+	// 송장 등록 (주문 항목 일부만 출고하는 분할 배송 지원)
+	CreateShipment(context.Context, *connect.Request[gen.CreateShipmentRequest]) (*connect.Response[gen.CreateShipmentResponse], error)
+	// 배송 상태와 추적 이력, 택배사 조회 링크 반환
+	GetTrackingInfo(context.Context, *connect.Request[gen.GetTrackingInfoRequest]) (*connect.Response[gen.GetTrackingInfoResponse], error)
+	// 택배사 웹훅/스마트택배 폴링 결과 반영 (SHIPPED/DELIVERED 시 주문 상태도 변경)
+	UpdateShipmentStatus(context.Context, *connect.Request[gen.UpdateShipmentStatusRequest]) (*connect.Response[gen.UpdateShipmentStatusResponse], error)
+	// 추적 이벤트를 실시간으로 전달 (GetTrackingInfo 폴링 대체)
+	// 구독 직후 지금까지의 이벤트를 보내고, 이후 새 이벤트마다 전달하며 배송 완료/반송 시 종료
+	// 게이트웨이에서는 Accept: text/event-stream 요청 시 SSE로 응답
+	WatchShipment(context.Context, *connect.Request[gen.WatchShipmentRequest]) (*connect.ServerStreamForClient[gen.TrackingEvent], error)
+}
+
+// NewShippingServiceClient constructs a client for the go.escape.ship.proto.v1.ShippingService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewShippingServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ShippingServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	shippingServiceMethods := gen.File_shipping_proto.Services().ByName("ShippingService").Methods()
+	return &shippingServiceClient{
+		createShipment: connect.NewClient[gen.CreateShipmentRequest, gen.CreateShipmentResponse](
+			httpClient,
+			baseURL+ShippingServiceCreateShipmentProcedure,
+			connect.WithSchema(shippingServiceMethods.ByName("CreateShipment")),
+			connect.WithClientOptions(opts...),
+		),
+		getTrackingInfo: connect.NewClient[gen.GetTrackingInfoRequest, gen.GetTrackingInfoResponse](
+			httpClient,
+			baseURL+ShippingServiceGetTrackingInfoProcedure,
+			connect.WithSchema(shippingServiceMethods.ByName("GetTrackingInfo")),
+			connect.WithClientOptions(opts...),
+		),
+		updateShipmentStatus: connect.NewClient[gen.UpdateShipmentStatusRequest, gen.UpdateShipmentStatusResponse](
+			httpClient,
+			baseURL+ShippingServiceUpdateShipmentStatusProcedure,
+			connect.WithSchema(shippingServiceMethods.ByName("UpdateShipmentStatus")),
+			connect.WithClientOptions(opts...),
+		),
+		watchShipment: connect.NewClient[gen.WatchShipmentRequest, gen.TrackingEvent](
+			httpClient,
+			baseURL+ShippingServiceWatchShipmentProcedure,
+			connect.WithSchema(shippingServiceMethods.ByName("WatchShipment")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// shippingServiceClient implements ShippingServiceClient.
+type shippingServiceClient struct {
+	createShipment       *connect.Client[gen.CreateShipmentRequest, gen.CreateShipmentResponse]
+	getTrackingInfo      *connect.Client[gen.GetTrackingInfoRequest, gen.GetTrackingInfoResponse]
+	updateShipmentStatus *connect.Client[gen.UpdateShipmentStatusRequest, gen.UpdateShipmentStatusResponse]
+	watchShipment        *connect.Client[gen.WatchShipmentRequest, gen.TrackingEvent]
+}
+
+// CreateShipment calls go.escape.ship.proto.v1.ShippingService.CreateShipment.
+func (c *shippingServiceClient) CreateShipment(ctx context.Context, req *connect.Request[gen.CreateShipmentRequest]) (*connect.Response[gen.CreateShipmentResponse], error) {
+	return c.createShipment.CallUnary(ctx, req)
+}
+
+// GetTrackingInfo calls go.escape.ship.proto.v1.ShippingService.GetTrackingInfo.
+func (c *shippingServiceClient) GetTrackingInfo(ctx context.Context, req *connect.Request[gen.GetTrackingInfoRequest]) (*connect.Response[gen.GetTrackingInfoResponse], error) {
+	return c.getTrackingInfo.CallUnary(ctx, req)
+}
+
+// UpdateShipmentStatus calls go.escape.ship.proto.v1.ShippingService.UpdateShipmentStatus.
+func (c *shippingServiceClient) UpdateShipmentStatus(ctx context.Context, req *connect.Request[gen.UpdateShipmentStatusRequest]) (*connect.Response[gen.UpdateShipmentStatusResponse], error) {
+	return c.updateShipmentStatus.CallUnary(ctx, req)
+}
+
+// WatchShipment calls go.escape.ship.proto.v1.ShippingService.WatchShipment.
+func (c *shippingServiceClient) WatchShipment(ctx context.Context, req *connect.Request[gen.WatchShipmentRequest]) (*connect.ServerStreamForClient[gen.TrackingEvent], error) {
+	return c.watchShipment.CallServerStream(ctx, req)
+}
+
+// ShippingServiceHandler is an implementation of the go.escape.ship.proto.v1.ShippingService
+// service.
+type ShippingServiceHandler interface {
+	// 송장 등록 (주문 항목 일부만 출고하는 분할 배송 지원)
+	CreateShipment(context.Context, *connect.Request[gen.CreateShipmentRequest]) (*connect.Response[gen.CreateShipmentResponse], error)
+	// 배송 상태와 추적 이력, 택배사 조회 링크 반환
+	GetTrackingInfo(context.Context, *connect.Request[gen.GetTrackingInfoRequest]) (*connect.Response[gen.GetTrackingInfoResponse], error)
+	// 택배사 웹훅/스마트택배 폴링 결과 반영 (SHIPPED/DELIVERED 시 주문 상태도 변경)
+	UpdateShipmentStatus(context.Context, *connect.Request[gen.UpdateShipmentStatusRequest]) (*connect.Response[gen.UpdateShipmentStatusResponse], error)
+	// 추적 이벤트를 실시간으로 전달 (GetTrackingInfo 폴링 대체)
+	// 구독 직후 지금까지의 이벤트를 보내고, 이후 새 이벤트마다 전달하며 배송 완료/반송 시 종료
+	// 게이트웨이에서는 Accept: text/event-stream 요청 시 SSE로 응답
+	WatchShipment(context.Context, *connect.Request[gen.WatchShipmentRequest], *connect.ServerStream[gen.TrackingEvent]) error
+}
+
+// NewShippingServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewShippingServiceHandler(svc ShippingServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	shippingServiceMethods := gen.File_shipping_proto.Services().ByName("ShippingService").Methods()
+	shippingServiceCreateShipmentHandler := connect.NewUnaryHandler(
+		ShippingServiceCreateShipmentProcedure,
+		svc.CreateShipment,
+		connect.WithSchema(shippingServiceMethods.ByName("CreateShipment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	shippingServiceGetTrackingInfoHandler := connect.NewUnaryHandler(
+		ShippingServiceGetTrackingInfoProcedure,
+		svc.GetTrackingInfo,
+		connect.WithSchema(shippingServiceMethods.ByName("GetTrackingInfo")),
+		connect.WithHandlerOptions(opts...),
+	)
+	shippingServiceUpdateShipmentStatusHandler := connect.NewUnaryHandler(
+		ShippingServiceUpdateShipmentStatusProcedure,
+		svc.UpdateShipmentStatus,
+		connect.WithSchema(shippingServiceMethods.ByName("UpdateShipmentStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
+	shippingServiceWatchShipmentHandler := connect.NewServerStreamHandler(
+		ShippingServiceWatchShipmentProcedure,
+		svc.WatchShipment,
+		connect.WithSchema(shippingServiceMethods.ByName("WatchShipment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/go.escape.ship.proto.v1.ShippingService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ShippingServiceCreateShipmentProcedure:
+			shippingServiceCreateShipmentHandler.ServeHTTP(w, r)
+		case ShippingServiceGetTrackingInfoProcedure:
+			shippingServiceGetTrackingInfoHandler.ServeHTTP(w, r)
+		case ShippingServiceUpdateShipmentStatusProcedure:
+			shippingServiceUpdateShipmentStatusHandler.ServeHTTP(w, r)
+		case ShippingServiceWatchShipmentProcedure:
+			shippingServiceWatchShipmentHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedShippingServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedShippingServiceHandler struct{}
+
+func (UnimplementedShippingServiceHandler) CreateShipment(context.Context, *connect.Request[gen.CreateShipmentRequest]) (*connect.Response[gen.CreateShipmentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.ShippingService.CreateShipment is not implemented"))
+}
+
+func (UnimplementedShippingServiceHandler) GetTrackingInfo(context.Context, *connect.Request[gen.GetTrackingInfoRequest]) (*connect.Response[gen.GetTrackingInfoResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.ShippingService.GetTrackingInfo is not implemented"))
+}
+
+func (UnimplementedShippingServiceHandler) UpdateShipmentStatus(context.Context, *connect.Request[gen.UpdateShipmentStatusRequest]) (*connect.Response[gen.UpdateShipmentStatusResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.ShippingService.UpdateShipmentStatus is not implemented"))
+}
+
+func (UnimplementedShippingServiceHandler) WatchShipment(context.Context, *connect.Request[gen.WatchShipmentRequest], *connect.ServerStream[gen.TrackingEvent]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.ShippingService.WatchShipment is not implemented"))
+}
@@ -0,0 +1,199 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: subscription.proto
+
+package genconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	gen "github.com/escape-ship/protos/gen"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// SubscriptionServiceName is the fully-qualified name of the SubscriptionService service.
+	SubscriptionServiceName = "go.escape.ship.proto.v1.SubscriptionService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// SubscriptionServiceCreateSubscriptionProcedure is the fully-qualified name of the
+	// SubscriptionService's CreateSubscription RPC.
+	SubscriptionServiceCreateSubscriptionProcedure = "/go.escape.ship.proto.v1.SubscriptionService/CreateSubscription"
+	// SubscriptionServicePauseSubscriptionProcedure is the fully-qualified name of the
+	// SubscriptionService's PauseSubscription RPC.
+	SubscriptionServicePauseSubscriptionProcedure = "/go.escape.ship.proto.v1.SubscriptionService/PauseSubscription"
+	// SubscriptionServiceSkipNextDeliveryProcedure is the fully-qualified name of the
+	// SubscriptionService's SkipNextDelivery RPC.
+	SubscriptionServiceSkipNextDeliveryProcedure = "/go.escape.ship.proto.v1.SubscriptionService/SkipNextDelivery"
+	// SubscriptionServiceCancelSubscriptionProcedure is the fully-qualified name of the
+	// SubscriptionService's CancelSubscription RPC.
+	SubscriptionServiceCancelSubscriptionProcedure = "/go.escape.ship.proto.v1.SubscriptionService/CancelSubscription"
+)
+
+// SubscriptionServiceClient is a client for the go.escape.ship.proto.v1.SubscriptionService
+// service.
+type SubscriptionServiceClient interface {
+	CreateSubscription(context.Context, *connect.Request[gen.CreateSubscriptionRequest]) (*connect.Response[gen.CreateSubscriptionResponse], error)
+	PauseSubscription(context.Context, *connect.Request[gen.PauseSubscriptionRequest]) (*connect.Response[gen.PauseSubscriptionResponse], error)
+	SkipNextDelivery(context.Context, *connect.Request[gen.SkipNextDeliveryRequest]) (*connect.Response[gen.SkipNextDeliveryResponse], error)
+	CancelSubscription(context.Context, *connect.Request[gen.CancelSubscriptionRequest]) (*connect.Response[gen.CancelSubscriptionResponse], error)
+}
+
+// NewSubscriptionServiceClient constructs a client for the
+// go.escape.ship.proto.v1.SubscriptionService service. By default, it uses the Connect protocol
+// with the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed requests. To
+// use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or connect.WithGRPCWeb()
+// options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewSubscriptionServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) SubscriptionServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	subscriptionServiceMethods := gen.File_subscription_proto.Services().ByName("SubscriptionService").Methods()
+	return &subscriptionServiceClient{
+		createSubscription: connect.NewClient[gen.CreateSubscriptionRequest, gen.CreateSubscriptionResponse](
+			httpClient,
+			baseURL+SubscriptionServiceCreateSubscriptionProcedure,
+			connect.WithSchema(subscriptionServiceMethods.ByName("CreateSubscription")),
+			connect.WithClientOptions(opts...),
+		),
+		pauseSubscription: connect.NewClient[gen.PauseSubscriptionRequest, gen.PauseSubscriptionResponse](
+			httpClient,
+			baseURL+SubscriptionServicePauseSubscriptionProcedure,
+			connect.WithSchema(subscriptionServiceMethods.ByName("PauseSubscription")),
+			connect.WithClientOptions(opts...),
+		),
+		skipNextDelivery: connect.NewClient[gen.SkipNextDeliveryRequest, gen.SkipNextDeliveryResponse](
+			httpClient,
+			baseURL+SubscriptionServiceSkipNextDeliveryProcedure,
+			connect.WithSchema(subscriptionServiceMethods.ByName("SkipNextDelivery")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelSubscription: connect.NewClient[gen.CancelSubscriptionRequest, gen.CancelSubscriptionResponse](
+			httpClient,
+			baseURL+SubscriptionServiceCancelSubscriptionProcedure,
+			connect.WithSchema(subscriptionServiceMethods.ByName("CancelSubscription")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// subscriptionServiceClient implements SubscriptionServiceClient.
+type subscriptionServiceClient struct {
+	createSubscription *connect.Client[gen.CreateSubscriptionRequest, gen.CreateSubscriptionResponse]
+	pauseSubscription  *connect.Client[gen.PauseSubscriptionRequest, gen.PauseSubscriptionResponse]
+	skipNextDelivery   *connect.Client[gen.SkipNextDeliveryRequest, gen.SkipNextDeliveryResponse]
+	cancelSubscription *connect.Client[gen.CancelSubscriptionRequest, gen.CancelSubscriptionResponse]
+}
+
+// CreateSubscription calls go.escape.ship.proto.v1.SubscriptionService.CreateSubscription.
+func (c *subscriptionServiceClient) CreateSubscription(ctx context.Context, req *connect.Request[gen.CreateSubscriptionRequest]) (*connect.Response[gen.CreateSubscriptionResponse], error) {
+	return c.createSubscription.CallUnary(ctx, req)
+}
+
+// PauseSubscription calls go.escape.ship.proto.v1.SubscriptionService.PauseSubscription.
+func (c *subscriptionServiceClient) PauseSubscription(ctx context.Context, req *connect.Request[gen.PauseSubscriptionRequest]) (*connect.Response[gen.PauseSubscriptionResponse], error) {
+	return c.pauseSubscription.CallUnary(ctx, req)
+}
+
+// SkipNextDelivery calls go.escape.ship.proto.v1.SubscriptionService.SkipNextDelivery.
+func (c *subscriptionServiceClient) SkipNextDelivery(ctx context.Context, req *connect.Request[gen.SkipNextDeliveryRequest]) (*connect.Response[gen.SkipNextDeliveryResponse], error) {
+	return c.skipNextDelivery.CallUnary(ctx, req)
+}
+
+// CancelSubscription calls go.escape.ship.proto.v1.SubscriptionService.CancelSubscription.
+func (c *subscriptionServiceClient) CancelSubscription(ctx context.Context, req *connect.Request[gen.CancelSubscriptionRequest]) (*connect.Response[gen.CancelSubscriptionResponse], error) {
+	return c.cancelSubscription.CallUnary(ctx, req)
+}
+
+// SubscriptionServiceHandler is an implementation of the
+// go.escape.ship.proto.v1.SubscriptionService service.
+type SubscriptionServiceHandler interface {
+	CreateSubscription(context.Context, *connect.Request[gen.CreateSubscriptionRequest]) (*connect.Response[gen.CreateSubscriptionResponse], error)
+	PauseSubscription(context.Context, *connect.Request[gen.PauseSubscriptionRequest]) (*connect.Response[gen.PauseSubscriptionResponse], error)
+	SkipNextDelivery(context.Context, *connect.Request[gen.SkipNextDeliveryRequest]) (*connect.Response[gen.SkipNextDeliveryResponse], error)
+	CancelSubscription(context.Context, *connect.Request[gen.CancelSubscriptionRequest]) (*connect.Response[gen.CancelSubscriptionResponse], error)
+}
+
+// NewSubscriptionServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewSubscriptionServiceHandler(svc SubscriptionServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	subscriptionServiceMethods := gen.File_subscription_proto.Services().ByName("SubscriptionService").Methods()
+	subscriptionServiceCreateSubscriptionHandler := connect.NewUnaryHandler(
+		SubscriptionServiceCreateSubscriptionProcedure,
+		svc.CreateSubscription,
+		connect.WithSchema(subscriptionServiceMethods.ByName("CreateSubscription")),
+		connect.WithHandlerOptions(opts...),
+	)
+	subscriptionServicePauseSubscriptionHandler := connect.NewUnaryHandler(
+		SubscriptionServicePauseSubscriptionProcedure,
+		svc.PauseSubscription,
+		connect.WithSchema(subscriptionServiceMethods.ByName("PauseSubscription")),
+		connect.WithHandlerOptions(opts...),
+	)
+	subscriptionServiceSkipNextDeliveryHandler := connect.NewUnaryHandler(
+		SubscriptionServiceSkipNextDeliveryProcedure,
+		svc.SkipNextDelivery,
+		connect.WithSchema(subscriptionServiceMethods.ByName("SkipNextDelivery")),
+		connect.WithHandlerOptions(opts...),
+	)
+	subscriptionServiceCancelSubscriptionHandler := connect.NewUnaryHandler(
+		SubscriptionServiceCancelSubscriptionProcedure,
+		svc.CancelSubscription,
+		connect.WithSchema(subscriptionServiceMethods.ByName("CancelSubscription")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/go.escape.ship.proto.v1.SubscriptionService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case SubscriptionServiceCreateSubscriptionProcedure:
+			subscriptionServiceCreateSubscriptionHandler.ServeHTTP(w, r)
+		case SubscriptionServicePauseSubscriptionProcedure:
+			subscriptionServicePauseSubscriptionHandler.ServeHTTP(w, r)
+		case SubscriptionServiceSkipNextDeliveryProcedure:
+			subscriptionServiceSkipNextDeliveryHandler.ServeHTTP(w, r)
+		case SubscriptionServiceCancelSubscriptionProcedure:
+			subscriptionServiceCancelSubscriptionHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedSubscriptionServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedSubscriptionServiceHandler struct{}
+
+func (UnimplementedSubscriptionServiceHandler) CreateSubscription(context.Context, *connect.Request[gen.CreateSubscriptionRequest]) (*connect.Response[gen.CreateSubscriptionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.SubscriptionService.CreateSubscription is not implemented"))
+}
+
+func (UnimplementedSubscriptionServiceHandler) PauseSubscription(context.Context, *connect.Request[gen.PauseSubscriptionRequest]) (*connect.Response[gen.PauseSubscriptionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.SubscriptionService.PauseSubscription is not implemented"))
+}
+
+func (UnimplementedSubscriptionServiceHandler) SkipNextDelivery(context.Context, *connect.Request[gen.SkipNextDeliveryRequest]) (*connect.Response[gen.SkipNextDeliveryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.SubscriptionService.SkipNextDelivery is not implemented"))
+}
+
+func (UnimplementedSubscriptionServiceHandler) CancelSubscription(context.Context, *connect.Request[gen.CancelSubscriptionRequest]) (*connect.Response[gen.CancelSubscriptionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.SubscriptionService.CancelSubscription is not implemented"))
+}
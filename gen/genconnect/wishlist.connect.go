@@ -0,0 +1,205 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: wishlist.proto
+
+package genconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	gen "github.com/escape-ship/protos/gen"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// WishlistServiceName is the fully-qualified name of the WishlistService service.
+	WishlistServiceName = "go.escape.ship.proto.v1.WishlistService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// WishlistServiceAddToWishlistProcedure is the fully-qualified name of the WishlistService's
+	// AddToWishlist RPC.
+	WishlistServiceAddToWishlistProcedure = "/go.escape.ship.proto.v1.WishlistService/AddToWishlist"
+	// WishlistServiceRemoveFromWishlistProcedure is the fully-qualified name of the WishlistService's
+	// RemoveFromWishlist RPC.
+	WishlistServiceRemoveFromWishlistProcedure = "/go.escape.ship.proto.v1.WishlistService/RemoveFromWishlist"
+	// WishlistServiceListWishlistProcedure is the fully-qualified name of the WishlistService's
+	// ListWishlist RPC.
+	WishlistServiceListWishlistProcedure = "/go.escape.ship.proto.v1.WishlistService/ListWishlist"
+	// WishlistServiceMoveToCartProcedure is the fully-qualified name of the WishlistService's
+	// MoveToCart RPC.
+	WishlistServiceMoveToCartProcedure = "/go.escape.ship.proto.v1.WishlistService/MoveToCart"
+)
+
+// WishlistServiceClient is a client for the go.escape.ship.proto.v1.WishlistService service.
+type WishlistServiceClient interface {
+	// 같은 상품/옵션이 이미 있으면 기존 항목을 그대로 반환
+	AddToWishlist(context.Context, *connect.Request[gen.AddToWishlistRequest]) (*connect.Response[gen.AddToWishlistResponse], error)
+	RemoveFromWishlist(context.Context, *connect.Request[gen.RemoveFromWishlistRequest]) (*connect.Response[gen.RemoveFromWishlistResponse], error)
+	// 최근 담은 순
+	ListWishlist(context.Context, *connect.Request[gen.ListWishlistRequest]) (*connect.Response[gen.ListWishlistResponse], error)
+	// 장바구니에 담고 위시리스트에서 제거 (keep_in_wishlist면 유지)
+	// 장바구니 담기와 동일하게 재고 부족은 OUT_OF_STOCK, 구매 제한 초과는 PURCHASE_LIMIT_EXCEEDED 에러
+	MoveToCart(context.Context, *connect.Request[gen.MoveToCartRequest]) (*connect.Response[gen.MoveToCartResponse], error)
+}
+
+// NewWishlistServiceClient constructs a client for the go.escape.ship.proto.v1.WishlistService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewWishlistServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) WishlistServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	wishlistServiceMethods := gen.File_wishlist_proto.Services().ByName("WishlistService").Methods()
+	return &wishlistServiceClient{
+		addToWishlist: connect.NewClient[gen.AddToWishlistRequest, gen.AddToWishlistResponse](
+			httpClient,
+			baseURL+WishlistServiceAddToWishlistProcedure,
+			connect.WithSchema(wishlistServiceMethods.ByName("AddToWishlist")),
+			connect.WithClientOptions(opts...),
+		),
+		removeFromWishlist: connect.NewClient[gen.RemoveFromWishlistRequest, gen.RemoveFromWishlistResponse](
+			httpClient,
+			baseURL+WishlistServiceRemoveFromWishlistProcedure,
+			connect.WithSchema(wishlistServiceMethods.ByName("RemoveFromWishlist")),
+			connect.WithClientOptions(opts...),
+		),
+		listWishlist: connect.NewClient[gen.ListWishlistRequest, gen.ListWishlistResponse](
+			httpClient,
+			baseURL+WishlistServiceListWishlistProcedure,
+			connect.WithSchema(wishlistServiceMethods.ByName("ListWishlist")),
+			connect.WithClientOptions(opts...),
+		),
+		moveToCart: connect.NewClient[gen.MoveToCartRequest, gen.MoveToCartResponse](
+			httpClient,
+			baseURL+WishlistServiceMoveToCartProcedure,
+			connect.WithSchema(wishlistServiceMethods.ByName("MoveToCart")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// wishlistServiceClient implements WishlistServiceClient.
+type wishlistServiceClient struct {
+	addToWishlist      *connect.Client[gen.AddToWishlistRequest, gen.AddToWishlistResponse]
+	removeFromWishlist *connect.Client[gen.RemoveFromWishlistRequest, gen.RemoveFromWishlistResponse]
+	listWishlist       *connect.Client[gen.ListWishlistRequest, gen.ListWishlistResponse]
+	moveToCart         *connect.Client[gen.MoveToCartRequest, gen.MoveToCartResponse]
+}
+
+// AddToWishlist calls go.escape.ship.proto.v1.WishlistService.AddToWishlist.
+func (c *wishlistServiceClient) AddToWishlist(ctx context.Context, req *connect.Request[gen.AddToWishlistRequest]) (*connect.Response[gen.AddToWishlistResponse], error) {
+	return c.addToWishlist.CallUnary(ctx, req)
+}
+
+// RemoveFromWishlist calls go.escape.ship.proto.v1.WishlistService.RemoveFromWishlist.
+func (c *wishlistServiceClient) RemoveFromWishlist(ctx context.Context, req *connect.Request[gen.RemoveFromWishlistRequest]) (*connect.Response[gen.RemoveFromWishlistResponse], error) {
+	return c.removeFromWishlist.CallUnary(ctx, req)
+}
+
+// ListWishlist calls go.escape.ship.proto.v1.WishlistService.ListWishlist.
+func (c *wishlistServiceClient) ListWishlist(ctx context.Context, req *connect.Request[gen.ListWishlistRequest]) (*connect.Response[gen.ListWishlistResponse], error) {
+	return c.listWishlist.CallUnary(ctx, req)
+}
+
+// MoveToCart calls go.escape.ship.proto.v1.WishlistService.MoveToCart.
+func (c *wishlistServiceClient) MoveToCart(ctx context.Context, req *connect.Request[gen.MoveToCartRequest]) (*connect.Response[gen.MoveToCartResponse], error) {
+	return c.moveToCart.CallUnary(ctx, req)
+}
+
+// WishlistServiceHandler is an implementation of the go.escape.ship.proto.v1.WishlistService
+// service.
+type WishlistServiceHandler interface {
+	// 같은 상품/옵션이 이미 있으면 기존 항목을 그대로 반환
+	AddToWishlist(context.Context, *connect.Request[gen.AddToWishlistRequest]) (*connect.Response[gen.AddToWishlistResponse], error)
+	RemoveFromWishlist(context.Context, *connect.Request[gen.RemoveFromWishlistRequest]) (*connect.Response[gen.RemoveFromWishlistResponse], error)
+	// 최근 담은 순
+	ListWishlist(context.Context, *connect.Request[gen.ListWishlistRequest]) (*connect.Response[gen.ListWishlistResponse], error)
+	// 장바구니에 담고 위시리스트에서 제거 (keep_in_wishlist면 유지)
+	// 장바구니 담기와 동일하게 재고 부족은 OUT_OF_STOCK, 구매 제한 초과는 PURCHASE_LIMIT_EXCEEDED 에러
+	MoveToCart(context.Context, *connect.Request[gen.MoveToCartRequest]) (*connect.Response[gen.MoveToCartResponse], error)
+}
+
+// NewWishlistServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewWishlistServiceHandler(svc WishlistServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	wishlistServiceMethods := gen.File_wishlist_proto.Services().ByName("WishlistService").Methods()
+	wishlistServiceAddToWishlistHandler := connect.NewUnaryHandler(
+		WishlistServiceAddToWishlistProcedure,
+		svc.AddToWishlist,
+		connect.WithSchema(wishlistServiceMethods.ByName("AddToWishlist")),
+		connect.WithHandlerOptions(opts...),
+	)
+	wishlistServiceRemoveFromWishlistHandler := connect.NewUnaryHandler(
+		WishlistServiceRemoveFromWishlistProcedure,
+		svc.RemoveFromWishlist,
+		connect.WithSchema(wishlistServiceMethods.ByName("RemoveFromWishlist")),
+		connect.WithHandlerOptions(opts...),
+	)
+	wishlistServiceListWishlistHandler := connect.NewUnaryHandler(
+		WishlistServiceListWishlistProcedure,
+		svc.ListWishlist,
+		connect.WithSchema(wishlistServiceMethods.ByName("ListWishlist")),
+		connect.WithHandlerOptions(opts...),
+	)
+	wishlistServiceMoveToCartHandler := connect.NewUnaryHandler(
+		WishlistServiceMoveToCartProcedure,
+		svc.MoveToCart,
+		connect.WithSchema(wishlistServiceMethods.ByName("MoveToCart")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/go.escape.ship.proto.v1.WishlistService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case WishlistServiceAddToWishlistProcedure:
+			wishlistServiceAddToWishlistHandler.ServeHTTP(w, r)
+		case WishlistServiceRemoveFromWishlistProcedure:
+			wishlistServiceRemoveFromWishlistHandler.ServeHTTP(w, r)
+		case WishlistServiceListWishlistProcedure:
+			wishlistServiceListWishlistHandler.ServeHTTP(w, r)
+		case WishlistServiceMoveToCartProcedure:
+			wishlistServiceMoveToCartHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedWishlistServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedWishlistServiceHandler struct{}
+
+func (UnimplementedWishlistServiceHandler) AddToWishlist(context.Context, *connect.Request[gen.AddToWishlistRequest]) (*connect.Response[gen.AddToWishlistResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.WishlistService.AddToWishlist is not implemented"))
+}
+
+func (UnimplementedWishlistServiceHandler) RemoveFromWishlist(context.Context, *connect.Request[gen.RemoveFromWishlistRequest]) (*connect.Response[gen.RemoveFromWishlistResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.WishlistService.RemoveFromWishlist is not implemented"))
+}
+
+func (UnimplementedWishlistServiceHandler) ListWishlist(context.Context, *connect.Request[gen.ListWishlistRequest]) (*connect.Response[gen.ListWishlistResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.WishlistService.ListWishlist is not implemented"))
+}
+
+func (UnimplementedWishlistServiceHandler) MoveToCart(context.Context, *connect.Request[gen.MoveToCartRequest]) (*connect.Response[gen.MoveToCartResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("go.escape.ship.proto.v1.WishlistService.MoveToCart is not implemented"))
+}
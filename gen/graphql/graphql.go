@@ -0,0 +1,147 @@
+// Package graphql exposes products, orders and account data as a GraphQL
+// schema resolved through the generated gRPC clients, for frontends that
+// prefer a single query endpoint over the REST gateway:
+//
+//	cs, err := pb.NewClientSet(pb.ClientConfig{Address: "localhost:9090"})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	http.Handle("/graphql", graphql.Handler(graphql.MustNewSchema(cs)))
+//
+// Queries run with the caller's Authorization header and request ID forwarded
+// as gRPC metadata, so the services authorize them exactly like gateway
+// traffic. gRPC errors are returned as GraphQL errors whose extensions carry
+// the status code and, when set, the ErrorReason (without the
+// ERROR_REASON_ prefix).
+package graphql
+
+import (
+	"context"
+	"net/http"
+
+	gql "github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+	"google.golang.org/grpc/metadata"
+
+	pb "github.com/escape-ship/protos/gen"
+)
+
+// Schema is the GraphQL schema served by NewSchema. Money amounts are Long
+// (64-bit integers, KRW unless stated otherwise); timestamps are strings as in
+// the protos.
+const Schema = `
+schema {
+    query: Query
+}
+
+scalar Long
+
+type Query {
+    # Every product, fetched page by page; prefer productPage for large catalogs.
+    products: [Product!]!
+    # One page of products; pass nextPageToken back as pageToken for the next.
+    productPage(pageSize: Int, pageToken: String): ProductPage!
+    product(id: ID!): Product
+    # Orders in request order; IDs that do not exist are skipped. Needs orders:admin.
+    orders(ids: [ID!]!): [Order!]!
+    # Preferences of the authenticated user.
+    preferences: Preferences
+}
+
+type Product {
+    id: ID!
+    name: String!
+    category: String!
+    price: Long!
+    imageUrl: String!
+    description: String!
+    optionsJson: String!
+    priceTiers: [PriceTier!]!
+    maxPerCustomer: Int!
+    createdAt: String!
+    updatedAt: String!
+}
+
+type ProductPage {
+    products: [Product!]!
+    # Empty on the last page.
+    nextPageToken: String!
+    totalCount: Int!
+}
+
+type PriceTier {
+    minQuantity: Int!
+    unitPrice: Long!
+}
+
+type Order {
+    id: ID!
+    userId: ID!
+    orderNumber: String!
+    status: String!
+    totalPrice: Long!
+    quantity: Int!
+    paymentMethod: String!
+    shippingFee: Int!
+    shippingAddress: String!
+    orderedAt: String!
+    paidAt: String!
+    memo: String!
+    items: [OrderItem!]!
+}
+
+type OrderItem {
+    id: ID!
+    productId: ID!
+    productName: String!
+    productPrice: Long!
+    quantity: Int!
+    # The current catalog entry, or null if the product no longer exists.
+    product: Product
+}
+
+type Preferences {
+    locale: String!
+    currency: String!
+    theme: String!
+    updatedAt: String!
+}
+`
+
+// NewSchema parses Schema with resolvers backed by cs. Only the Account, Order
+// and Product clients are used.
+func NewSchema(cs *pb.ClientSet, opts ...gql.SchemaOpt) (*gql.Schema, error) {
+	return gql.ParseSchema(Schema, &resolver{clients: cs}, opts...)
+}
+
+// MustNewSchema is like NewSchema but panics on error.
+func MustNewSchema(cs *pb.ClientSet, opts ...gql.SchemaOpt) *gql.Schema {
+	s, err := NewSchema(cs, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// Handler serves schema over HTTP (POST, JSON body with query, operationName
+// and variables), forwarding the caller's credentials to the services.
+func Handler(schema *gql.Schema) http.Handler {
+	h := &relay.Handler{Schema: schema}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h.ServeHTTP(w, r.WithContext(forwardMetadata(r.Context(), r)))
+	})
+}
+
+func forwardMetadata(ctx context.Context, r *http.Request) context.Context {
+	var kv []string
+	if auth := r.Header.Get("Authorization"); auth != "" {
+		kv = append(kv, "authorization", auth)
+	}
+	if id := r.Header.Get(pb.RequestIDHeader); id != "" {
+		kv = append(kv, "x-request-id", id)
+	}
+	if len(kv) == 0 {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, kv...)
+}
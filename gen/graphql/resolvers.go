@@ -0,0 +1,276 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	gql "github.com/graph-gophers/graphql-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/escape-ship/protos/gen"
+)
+
+// Long is the GraphQL scalar for 64-bit integers such as prices; GraphQL's
+// Int is only 32 bits.
+type Long int64
+
+// ImplementsGraphQLType maps Long to the schema's Long scalar.
+func (Long) ImplementsGraphQLType(name string) bool { return name == "Long" }
+
+// UnmarshalGraphQL decodes a Long argument.
+func (l *Long) UnmarshalGraphQL(input any) error {
+	switch v := input.(type) {
+	case int32:
+		*l = Long(v)
+	case float64:
+		*l = Long(v)
+	default:
+		return fmt.Errorf("wrong type for Long: %T", input)
+	}
+	return nil
+}
+
+// rpcError carries the gRPC status of a failed call into the GraphQL error's
+// extensions.
+type rpcError struct {
+	err error
+}
+
+func (e rpcError) Error() string { return status.Convert(e.err).Message() }
+
+func (e rpcError) Extensions() map[string]any {
+	ext := map[string]any{"code": status.Code(e.err).String()}
+	if reason := pb.ErrorReasonOf(e.err); reason != pb.ErrorReason_ERROR_REASON_UNSPECIFIED {
+		ext["reason"] = reason.Reason()
+	}
+	return ext
+}
+
+func wrap(err error) error {
+	if err == nil {
+		return nil
+	}
+	return rpcError{err: err}
+}
+
+type resolver struct {
+	clients *pb.ClientSet
+}
+
+// productsPageSize is the page size Products walks the catalog with, the
+// largest GetProducts allows.
+const productsPageSize = 100
+
+func (r *resolver) Products(ctx context.Context) ([]*productResolver, error) {
+	var out []*productResolver
+	pager := pb.NewProductsPager(r.clients.Product, &pb.GetProductsRequest{PageSize: productsPageSize})
+	for p, err := range pager.All(ctx) {
+		if err != nil {
+			return nil, wrap(err)
+		}
+		out = append(out, &productResolver{p})
+	}
+	return out, nil
+}
+
+func (r *resolver) ProductPage(ctx context.Context, args struct {
+	PageSize  *int32
+	PageToken *string
+}) (*productPageResolver, error) {
+	req := &pb.GetProductsRequest{}
+	if args.PageSize != nil {
+		req.PageSize = *args.PageSize
+	}
+	if args.PageToken != nil {
+		req.PageToken = *args.PageToken
+	}
+	resp, err := r.clients.Product.GetProducts(ctx, req)
+	if err != nil {
+		return nil, wrap(err)
+	}
+	return &productPageResolver{resp}, nil
+}
+
+func (r *resolver) Product(ctx context.Context, args struct{ ID gql.ID }) (*productResolver, error) {
+	p, err := r.product(ctx, string(args.ID))
+	if p == nil {
+		return nil, err
+	}
+	return &productResolver{p}, err
+}
+
+// product returns nil without error for unknown IDs.
+func (r *resolver) product(ctx context.Context, id string) (*pb.Product, error) {
+	resp, err := r.clients.Product.GetProductByID(ctx, &pb.GetProductByIDRequest{Id: id})
+	switch {
+	case status.Code(err) == codes.NotFound:
+		return nil, nil
+	case err != nil:
+		return nil, wrap(err)
+	}
+	return resp.GetProduct(), nil
+}
+
+func (r *resolver) Orders(ctx context.Context, args struct{ IDs []gql.ID }) ([]*orderResolver, error) {
+	ids := make([]string, len(args.IDs))
+	for i, id := range args.IDs {
+		ids[i] = string(id)
+	}
+	resp, err := r.clients.Order.GetOrdersByIDs(ctx, &pb.GetOrdersByIDsRequest{Ids: ids})
+	if err != nil {
+		return nil, wrap(err)
+	}
+	// Items of all returned orders share one product lookup per ID.
+	products := &productCache{r: r, entries: make(map[string]*productCacheEntry)}
+	out := make([]*orderResolver, len(resp.GetOrders()))
+	for i, o := range resp.GetOrders() {
+		out[i] = &orderResolver{o: o, products: products}
+	}
+	return out, nil
+}
+
+func (r *resolver) Preferences(ctx context.Context) (*preferencesResolver, error) {
+	resp, err := r.clients.Account.GetPreferences(ctx, &pb.GetPreferencesRequest{})
+	if err != nil {
+		return nil, wrap(err)
+	}
+	if resp.GetPreferences() == nil {
+		return nil, nil
+	}
+	return &preferencesResolver{resp.GetPreferences()}, nil
+}
+
+type productCache struct {
+	r       *resolver
+	mu      sync.Mutex
+	entries map[string]*productCacheEntry
+}
+
+type productCacheEntry struct {
+	once sync.Once
+	p    *pb.Product
+	err  error
+}
+
+func (c *productCache) get(ctx context.Context, id string) (*pb.Product, error) {
+	c.mu.Lock()
+	e, ok := c.entries[id]
+	if !ok {
+		e = &productCacheEntry{}
+		c.entries[id] = e
+	}
+	c.mu.Unlock()
+	e.once.Do(func() { e.p, e.err = c.r.product(ctx, id) })
+	return e.p, e.err
+}
+
+type productPageResolver struct{ resp *pb.GetProductsResponse }
+
+func (r *productPageResolver) Products() []*productResolver {
+	out := make([]*productResolver, len(r.resp.GetProducts()))
+	for i, p := range r.resp.GetProducts() {
+		out[i] = &productResolver{p}
+	}
+	return out
+}
+
+func (r *productPageResolver) NextPageToken() string { return r.resp.GetNextPageToken() }
+func (r *productPageResolver) TotalCount() int32     { return r.resp.GetTotalCount() }
+
+type productResolver struct{ p *pb.Product }
+
+func (r *productResolver) ID() gql.ID            { return gql.ID(r.p.GetId()) }
+func (r *productResolver) Name() string          { return r.p.GetName() }
+func (r *productResolver) Category() string      { return r.p.GetCategory() }
+func (r *productResolver) Price() (Long, error)  { return won(r.p.EffectiveListPrice()) }
+func (r *productResolver) ImageURL() string      { return r.p.GetImageUrl() }
+func (r *productResolver) Description() string   { return r.p.GetDescription() }
+func (r *productResolver) OptionsJSON() string   { return r.p.GetOptionsJson() }
+func (r *productResolver) MaxPerCustomer() int32 { return r.p.GetMaxPerCustomer() }
+func (r *productResolver) CreatedAt() string     { return r.p.GetCreatedAt() }
+func (r *productResolver) UpdatedAt() string     { return r.p.GetUpdatedAt() }
+func (r *productResolver) PriceTiers() []*tierResolver {
+	out := make([]*tierResolver, len(r.p.GetPriceTiers()))
+	for i, t := range r.p.GetPriceTiers() {
+		out[i] = &tierResolver{t}
+	}
+	return out
+}
+
+type tierResolver struct{ t *pb.PriceTier }
+
+func (r *tierResolver) MinQuantity() int32       { return r.t.GetMinQuantity() }
+func (r *tierResolver) UnitPrice() (Long, error) { return won(r.t.EffectivePrice()) }
+
+// won returns m as the schema's KRW Long. Amounts in other currencies fail
+// rather than being shown as won.
+func won(m *pb.Money) (Long, error) {
+	units, err := m.KRWUnits()
+	return Long(units), err
+}
+
+type orderResolver struct {
+	o        *pb.Order
+	products *productCache
+}
+
+func (r *orderResolver) ID() gql.ID              { return gql.ID(r.o.GetId()) }
+func (r *orderResolver) UserID() gql.ID          { return gql.ID(r.o.GetUserId()) }
+func (r *orderResolver) OrderNumber() string     { return r.o.GetOrderNumber() }
+func (r *orderResolver) Status() string          { return r.o.EffectiveStatus().Legacy() }
+func (r *orderResolver) TotalPrice() Long        { return Long(r.o.GetTotalPrice()) }
+func (r *orderResolver) Quantity() int32         { return r.o.GetQuantity() }
+func (r *orderResolver) PaymentMethod() string   { return r.o.GetPaymentMethod() }
+func (r *orderResolver) ShippingFee() int32      { return r.o.GetShippingFee() }
+func (r *orderResolver) ShippingAddress() string { return shippingAddress(r.o) }
+func (r *orderResolver) OrderedAt() string       { return pb.LegacyTime(r.o.EffectiveOrderedTime()) }
+func (r *orderResolver) PaidAt() string          { return pb.LegacyTime(r.o.EffectivePaidTime()) }
+func (r *orderResolver) Memo() string            { return r.o.GetMemo() }
+func (r *orderResolver) Items() []*orderItemResolver {
+	out := make([]*orderItemResolver, len(r.o.GetItems()))
+	for i, it := range r.o.GetItems() {
+		out[i] = &orderItemResolver{it: it, products: r.products}
+	}
+	return out
+}
+
+// shippingAddress keeps the schema's single-line address for orders that only
+// carry the structured delivery_address.
+func shippingAddress(o *pb.Order) string {
+	if s := o.GetShippingAddress(); s != "" {
+		return s
+	}
+	return o.GetDeliveryAddress().Legacy()
+}
+
+type orderItemResolver struct {
+	it       *pb.OrderItem
+	products *productCache
+}
+
+func (r *orderItemResolver) ID() gql.ID                  { return gql.ID(r.it.GetId()) }
+func (r *orderItemResolver) ProductID() gql.ID           { return gql.ID(r.it.GetProductId()) }
+func (r *orderItemResolver) ProductName() string         { return r.it.GetProductName() }
+func (r *orderItemResolver) ProductPrice() (Long, error) { return won(r.it.EffectiveUnitPrice()) }
+func (r *orderItemResolver) Quantity() int32             { return r.it.GetQuantity() }
+func (r *orderItemResolver) Product(ctx context.Context) (*productResolver, error) {
+	p, err := r.products.get(ctx, r.it.GetProductId())
+	if p == nil {
+		return nil, err
+	}
+	return &productResolver{p}, err
+}
+
+type preferencesResolver struct{ p *pb.UserPreferences }
+
+func (r *preferencesResolver) Locale() string    { return r.p.GetLocale() }
+func (r *preferencesResolver) Currency() string  { return r.p.GetCurrency() }
+func (r *preferencesResolver) UpdatedAt() string { return r.p.GetUpdatedAt() }
+
+// Theme is the enum value name without its THEME_ prefix, e.g. "DARK".
+func (r *preferencesResolver) Theme() string {
+	return strings.TrimPrefix(r.p.GetTheme().String(), "THEME_")
+}
@@ -0,0 +1,122 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	pb "github.com/escape-ship/protos/gen"
+	"github.com/escape-ship/protos/gen/mocks"
+)
+
+// pagedProducts serves three products two per page.
+func pagedProducts() *mocks.MockProductServiceClient {
+	pages := map[string]*pb.GetProductsResponse{
+		"":   {Products: []*pb.Product{{Id: "1"}, {Id: "2"}}, NextPageToken: "p2", TotalCount: 3},
+		"p2": {Products: []*pb.Product{{Id: "3"}}, TotalCount: 3},
+	}
+	return &mocks.MockProductServiceClient{
+		GetProductsFunc: func(_ context.Context, in *pb.GetProductsRequest) (*pb.GetProductsResponse, error) {
+			return pages[in.GetPageToken()], nil
+		},
+	}
+}
+
+func TestProductsQueries(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  string
+		calls int
+	}{
+		{
+			name:  "products walks every page",
+			query: `{ products { id } }`,
+			want:  `{"products":[{"id":"1"},{"id":"2"},{"id":"3"}]}`,
+			calls: 2,
+		},
+		{
+			name:  "first page",
+			query: `{ productPage(pageSize: 2) { products { id } nextPageToken totalCount } }`,
+			want:  `{"productPage":{"products":[{"id":"1"},{"id":"2"}],"nextPageToken":"p2","totalCount":3}}`,
+			calls: 1,
+		},
+		{
+			name:  "last page",
+			query: `{ productPage(pageToken: "p2") { products { id } nextPageToken } }`,
+			want:  `{"productPage":{"products":[{"id":"3"}],"nextPageToken":""}}`,
+			calls: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			products := pagedProducts()
+			schema := MustNewSchema(&pb.ClientSet{Product: products})
+			resp := schema.Exec(context.Background(), tt.query, "", nil)
+			if len(resp.Errors) > 0 {
+				t.Fatal(resp.Errors)
+			}
+			var got, want any
+			json.Unmarshal(resp.Data, &got)
+			json.Unmarshal([]byte(tt.want), &want)
+			if g, w := mustJSON(got), mustJSON(want); g != w {
+				t.Errorf("data = %s, want %s", g, w)
+			}
+			if n := products.CallCount(pb.ProductService_GetProducts_FullMethodName); n != tt.calls {
+				t.Errorf("GetProducts called %d times, want %d", n, tt.calls)
+			}
+		})
+	}
+}
+
+func mustJSON(v any) string {
+	b, _ := json.Marshal(v)
+	return string(b)
+}
+
+func TestPricesReadMoneyFields(t *testing.T) {
+	products := &mocks.MockProductServiceClient{
+		GetProductByIDFunc: func(_ context.Context, in *pb.GetProductByIDRequest) (*pb.GetProductByIDResponse, error) {
+			switch in.GetId() {
+			case "money":
+				return &pb.GetProductByIDResponse{Product: &pb.Product{
+					Id: "money", ListPrice: pb.KRW(12000),
+					PriceTiers: []*pb.PriceTier{{MinQuantity: 10, Price: pb.KRW(11000)}},
+				}}, nil
+			case "legacy":
+				return &pb.GetProductByIDResponse{Product: &pb.Product{
+					Id: "legacy", Price: 9000,
+					PriceTiers: []*pb.PriceTier{{MinQuantity: 10, UnitPrice: 8000}},
+				}}, nil
+			}
+			return &pb.GetProductByIDResponse{Product: &pb.Product{Id: "usd", ListPrice: &pb.Money{CurrencyCode: "USD", Units: 9}}}, nil
+		},
+	}
+	schema := MustNewSchema(&pb.ClientSet{Product: products})
+	tests := []struct {
+		id      string
+		want    string
+		wantErr bool
+	}{
+		{id: "money", want: `{"product":{"price":12000,"priceTiers":[{"unitPrice":11000}]}}`},
+		{id: "legacy", want: `{"product":{"price":9000,"priceTiers":[{"unitPrice":8000}]}}`},
+		{id: "usd", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			resp := schema.Exec(context.Background(), `query($id: ID!) { product(id: $id) { price priceTiers { unitPrice } } }`, "", map[string]any{"id": tt.id})
+			if tt.wantErr {
+				if len(resp.Errors) == 0 {
+					t.Errorf("non-KRW price resolved as won: %s", resp.Data)
+				}
+				return
+			}
+			if len(resp.Errors) > 0 {
+				t.Fatal(resp.Errors)
+			}
+			if string(resp.Data) != tt.want {
+				t.Errorf("data = %s, want %s", resp.Data, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package gen
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// IdempotencyKeyHeader carries the idempotency key of a mutation as an HTTP
+// header through the gateway and as metadata key "idempotency-key" between
+// services. The idempotency_key request field takes precedence over it.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+var idempotencyKeyKey = strings.ToLower(IdempotencyKeyHeader)
+
+// IdempotentMutations are the methods whose requests carry an idempotency_key.
+// Servers process each key at most once and answer repeats with the first
+// response, so these calls can be retried safely as long as every attempt
+// sends the same key.
+var IdempotentMutations = []string{
+	OrderService_InsertOrder_FullMethodName,
+	OrderService_CancelOrder_FullMethodName,
+	OrderService_RefundOrder_FullMethodName,
+	PaymentService_KakaoReady_FullMethodName,
+	PaymentService_KakaoApprove_FullMethodName,
+	PaymentService_KakaoCancel_FullMethodName,
+	PaymentService_PreparePayment_FullMethodName,
+	PaymentService_ApprovePayment_FullMethodName,
+	PaymentService_CancelPayment_FullMethodName,
+}
+
+// NewIdempotencyKey returns a random 128-bit idempotency key in hex.
+func NewIdempotencyKey() string {
+	return NewRequestID()
+}
+
+type idempotencyKeyCtxKey struct{}
+
+// WithIdempotencyKey pins the idempotency key of the calls made with ctx. Use
+// it when a logical operation may be submitted more than once, e.g. a checkout
+// the user may submit twice, so that every submission shares one key.
+// UnaryIdempotencyKeyInterceptor derives a separate key per method from it, so
+// an operation calling InsertOrder and then KakaoReady does not send both the
+// same key.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyCtxKey{}, key)
+}
+
+type idempotentRequest interface {
+	GetIdempotencyKey() string
+}
+
+// IdempotencyKey returns the idempotency key of req: its idempotency_key field
+// if set, otherwise the key sent as metadata by
+// UnaryIdempotencyKeyInterceptor or forwarded by the gateway. It returns ""
+// when the caller sent none; servers then process the request as is.
+func IdempotencyKey(ctx context.Context, req any) string {
+	if r, ok := req.(idempotentRequest); ok && r.GetIdempotencyKey() != "" {
+		return r.GetIdempotencyKey()
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get(idempotencyKeyKey); len(v) > 0 {
+			return v[0]
+		}
+	}
+	return ""
+}
+
+// UnaryIdempotencyKeyInterceptor attaches an idempotency key to calls of
+// methods, or of IdempotentMutations if none are given. The key is the
+// request's idempotency_key if set, then one derived from the key pinned with
+// WithIdempotencyKey and the method, and otherwise a new one per call.
+//
+// Retries below the interceptor reuse the key, so chain it before
+// UnaryRetryInterceptor; that also makes it safe to retry these methods on
+// codes other than Unavailable.
+func UnaryIdempotencyKeyInterceptor(methods ...string) grpc.UnaryClientInterceptor {
+	if len(methods) == 0 {
+		methods = IdempotentMutations
+	}
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if !slices.Contains(methods, method) {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		var key string
+		if r, ok := req.(idempotentRequest); ok && r.GetIdempotencyKey() != "" {
+			key = r.GetIdempotencyKey()
+		} else if pinned, _ := ctx.Value(idempotencyKeyCtxKey{}).(string); pinned != "" {
+			key = methodIdempotencyKey(pinned, method)
+		} else {
+			key = NewIdempotencyKey()
+		}
+		ctx = metadata.AppendToOutgoingContext(ctx, idempotencyKeyKey, key)
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// methodIdempotencyKey derives the key of a call to method from a pinned key.
+// It is shaped like NewIdempotencyKey so servers need not tell them apart.
+func methodIdempotencyKey(pinned, method string) string {
+	sum := sha256.Sum256([]byte(method + "\x00" + pinned))
+	return hex.EncodeToString(sum[:16])
+}
@@ -0,0 +1,48 @@
+package gen
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestUnaryIdempotencyKeyInterceptor(t *testing.T) {
+	intercept := UnaryIdempotencyKeyInterceptor()
+	call := func(ctx context.Context, method string, req any) string {
+		var key string
+		intercept(ctx, method, req, nil, nil, func(ctx context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+			md, _ := metadata.FromOutgoingContext(ctx)
+			if v := md.Get(idempotencyKeyKey); len(v) == 1 {
+				key = v[0]
+			}
+			return nil
+		})
+		return key
+	}
+	pinned := WithIdempotencyKey(context.Background(), "checkout-1")
+	insert := call(pinned, OrderService_InsertOrder_FullMethodName, &InsertOrderRequest{})
+	ready := call(pinned, PaymentService_KakaoReady_FullMethodName, &KakaoReadyRequest{})
+
+	tests := []struct {
+		name string
+		got  string
+		want func(string) bool
+	}{
+		{"pinned key is stable per method", call(pinned, OrderService_InsertOrder_FullMethodName, &InsertOrderRequest{}),
+			func(k string) bool { return k == insert }},
+		{"pinned key differs across methods", ready, func(k string) bool { return k != insert && len(k) == len(NewIdempotencyKey()) }},
+		{"request field wins", call(pinned, OrderService_InsertOrder_FullMethodName, &InsertOrderRequest{IdempotencyKey: "k-1"}),
+			func(k string) bool { return k == "k-1" }},
+		{"new key without pin", call(context.Background(), OrderService_InsertOrder_FullMethodName, &InsertOrderRequest{}),
+			func(k string) bool { return k != "" && k != insert }},
+		{"other methods untouched", call(pinned, OrderService_GetAllOrders_FullMethodName, &GetAllOrdersRequest{}),
+			func(k string) bool { return k == "" }},
+	}
+	for _, tt := range tests {
+		if !tt.want(tt.got) {
+			t.Errorf("%s: key %q", tt.name, tt.got)
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package gen
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"golang.org/x/image/draw"
+)
+
+// ImageProxy serves product images with optional resizing, so development and
+// small deployments can run without a separate CDN tier. Query parameters:
+//
+//	w, h  bounding box in pixels; the image is scaled down to fit, keeping
+//	      its aspect ratio, and never scaled up
+//	q     JPEG quality 1-100 (default 85)
+//
+// Images are read from Files if set, otherwise fetched from Origin.
+type ImageProxy struct {
+	// Origin is the base URL image paths are resolved against, e.g.
+	// "https://cdn.escape-ship.example/products".
+	Origin string
+	// Files serves images from a local tree instead, e.g. os.DirFS("./images").
+	Files fs.FS
+	// Client fetches from Origin; nil means http.DefaultClient.
+	Client *http.Client
+	// MaxDimension caps w and h; zero means 2048.
+	MaxDimension int
+	// MaxPixels caps the decoded size of a source image, read from its header
+	// before decoding, so a small file cannot expand to gigabytes of pixels;
+	// zero means 40 megapixels.
+	MaxPixels int
+	// MaxAge sets Cache-Control max-age; zero means 24 hours.
+	MaxAge time.Duration
+}
+
+// MountImageProxy registers p on mux for GET requests under prefix, e.g.
+// "/images" serves "/images/product-123.jpg?w=400".
+func MountImageProxy(mux *runtime.ServeMux, prefix string, p *ImageProxy) error {
+	return mux.HandlePath(http.MethodGet, prefix+"/{path=**}", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		p.serve(w, r, params["path"])
+	})
+}
+
+// maxProxiedImageBytes caps how much of an origin response is read.
+const maxProxiedImageBytes = 20 << 20
+
+var errImageNotFound = errors.New("image not found")
+
+func (p *ImageProxy) serve(w http.ResponseWriter, r *http.Request, path string) {
+	maxDim := p.MaxDimension
+	if maxDim == 0 {
+		maxDim = 2048
+	}
+	q := r.URL.Query()
+	width, err1 := imageParam(q, "w", 0, maxDim)
+	height, err2 := imageParam(q, "h", 0, maxDim)
+	quality, err3 := imageParam(q, "q", 85, 100)
+	if err := errors.Join(err1, err2, err3); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	data, err := p.load(r, path)
+	switch {
+	case errors.Is(err, errImageNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	case err != nil:
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	maxAge := p.MaxAge
+	if maxAge == 0 {
+		maxAge = 24 * time.Hour
+	}
+	w.Header().Set("Cache-Control", "public, max-age="+strconv.Itoa(int(maxAge/time.Second)))
+
+	if width == 0 && height == 0 {
+		w.Header().Set("Content-Type", http.DetectContentType(data))
+		w.Write(data)
+		return
+	}
+	maxPixels := p.MaxPixels
+	if maxPixels == 0 {
+		maxPixels = 40_000_000
+	}
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		http.Error(w, "unsupported image format", http.StatusUnsupportedMediaType)
+		return
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width > maxPixels/cfg.Height {
+		http.Error(w, "image too large to resize", http.StatusUnprocessableEntity)
+		return
+	}
+	src, format, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		http.Error(w, "unsupported image format", http.StatusUnsupportedMediaType)
+		return
+	}
+	img := fitImage(src, width, height)
+
+	var buf bytes.Buffer
+	switch format {
+	case "jpeg":
+		w.Header().Set("Content-Type", "image/jpeg")
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: quality})
+	case "gif":
+		w.Header().Set("Content-Type", "image/gif")
+		err = gif.Encode(&buf, img, nil)
+	default:
+		w.Header().Set("Content-Type", "image/png")
+		err = png.Encode(&buf, img)
+	}
+	if err != nil {
+		http.Error(w, "encoding image failed", http.StatusInternalServerError)
+		return
+	}
+	w.Write(buf.Bytes())
+}
+
+func imageParam(q url.Values, name string, def, maxVal int) (int, error) {
+	s := q.Get(name)
+	if s == "" {
+		return def, nil
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 1 || n > maxVal {
+		return 0, fmt.Errorf("%s must be between 1 and %d", name, maxVal)
+	}
+	return n, nil
+}
+
+// load reads the image at path. Paths that are not clean relative paths,
+// such as ones with ".." segments, are not found, so a request cannot reach
+// outside Files or the Origin base path.
+func (p *ImageProxy) load(r *http.Request, path string) ([]byte, error) {
+	if !fs.ValidPath(path) || strings.Contains(path, `\`) {
+		return nil, errImageNotFound
+	}
+	if p.Files != nil {
+		data, err := fs.ReadFile(p.Files, path)
+		if errors.Is(err, fs.ErrNotExist) || errors.Is(err, fs.ErrInvalid) {
+			return nil, errImageNotFound
+		}
+		return data, err
+	}
+	target, err := url.JoinPath(p.Origin, path)
+	if err != nil {
+		return nil, errImageNotFound
+	}
+	req, err := http.NewRequestWithContext(r.Context(), http.MethodGet, target, nil)
+	if err != nil {
+		return nil, err
+	}
+	client := p.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetching image: %w", err)
+	}
+	defer resp.Body.Close()
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, errImageNotFound
+	case resp.StatusCode != http.StatusOK:
+		return nil, fmt.Errorf("fetching image: origin returned %s", resp.Status)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, maxProxiedImageBytes))
+}
+
+// fitImage scales src down to fit within width x height (zero means
+// unbounded), keeping its aspect ratio.
+func fitImage(src image.Image, width, height int) image.Image {
+	b := src.Bounds()
+	sw, sh := b.Dx(), b.Dy()
+	scale := 1.0
+	if width > 0 && sw > width {
+		scale = float64(width) / float64(sw)
+	}
+	if height > 0 && float64(sh)*scale > float64(height) {
+		scale = float64(height) / float64(sh)
+	}
+	if scale == 1 {
+		return src
+	}
+	dw, dh := max(int(float64(sw)*scale), 1), max(int(float64(sh)*scale), 1)
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, b, draw.Over, nil)
+	return dst
+}
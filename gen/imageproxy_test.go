@@ -0,0 +1,95 @@
+package gen
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"testing/fstest"
+)
+
+func testPNG(t *testing.T, w, h int) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, w, h))); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestImageProxyServe(t *testing.T) {
+	files := fstest.MapFS{
+		"a.png":     {Data: testPNG(t, 100, 50)},
+		"big.png":   {Data: testPNG(t, 200, 200)},
+		"notes.txt": {Data: []byte("hello")},
+	}
+	tests := []struct {
+		name       string
+		path       string
+		query      string
+		wantStatus int
+		wantBounds image.Rectangle
+	}{
+		{name: "original", path: "a.png", wantStatus: http.StatusOK},
+		{name: "resized", path: "a.png", query: "w=50", wantStatus: http.StatusOK, wantBounds: image.Rect(0, 0, 50, 25)},
+		{name: "missing", path: "b.png", wantStatus: http.StatusNotFound},
+		{name: "parent segment", path: "../a.png", wantStatus: http.StatusNotFound},
+		{name: "bad width", path: "a.png", query: "w=0", wantStatus: http.StatusBadRequest},
+		{name: "not an image", path: "notes.txt", query: "w=10", wantStatus: http.StatusUnsupportedMediaType},
+		{name: "too many pixels", path: "big.png", query: "w=10", wantStatus: http.StatusUnprocessableEntity},
+	}
+	p := &ImageProxy{Files: files, MaxPixels: 10_000}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			p.serve(rec, httptest.NewRequest(http.MethodGet, "/images/x?"+tt.query, nil), tt.path)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.wantBounds.Empty() {
+				return
+			}
+			img, _, err := image.Decode(rec.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if img.Bounds() != tt.wantBounds {
+				t.Errorf("bounds = %v, want %v", img.Bounds(), tt.wantBounds)
+			}
+		})
+	}
+}
+
+func TestImageProxyOriginBasePath(t *testing.T) {
+	var fetched []string
+	origin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetched = append(fetched, r.URL.Path)
+		w.Write([]byte("img"))
+	}))
+	defer origin.Close()
+	p := &ImageProxy{Origin: origin.URL + "/products", Client: origin.Client()}
+
+	tests := []struct {
+		path       string
+		wantStatus int
+	}{
+		{"1/a.jpg", http.StatusOK},
+		{"../secret.jpg", http.StatusNotFound},
+		{"1/../../secret.jpg", http.StatusNotFound},
+		{"./a.jpg", http.StatusNotFound},
+		{"/etc/passwd", http.StatusNotFound},
+		{`..\secret.jpg`, http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		rec := httptest.NewRecorder()
+		p.serve(rec, httptest.NewRequest(http.MethodGet, "/images/x", nil), tt.path)
+		if rec.Code != tt.wantStatus {
+			t.Errorf("serve(%q) status = %d, want %d", tt.path, rec.Code, tt.wantStatus)
+		}
+	}
+	if len(fetched) != 1 || fetched[0] != "/products/1/a.jpg" {
+		t.Errorf("origin fetched %v, want only /products/1/a.jpg", fetched)
+	}
+}
@@ -0,0 +1,1438 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: inventory.proto
+
+package gen
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 재고 불일치 유형
+type InventoryDiscrepancyKind int32
+
+const (
+	InventoryDiscrepancyKind_INVENTORY_DISCREPANCY_KIND_UNSPECIFIED          InventoryDiscrepancyKind = 0
+	InventoryDiscrepancyKind_INVENTORY_DISCREPANCY_KIND_OVERSOLD             InventoryDiscrepancyKind = 1 // 유효한 선점 수량이 보유 수량 초과
+	InventoryDiscrepancyKind_INVENTORY_DISCREPANCY_KIND_RESERVED_MISMATCH    InventoryDiscrepancyKind = 2 // reserved_quantity와 유효한 선점 합계 불일치
+	InventoryDiscrepancyKind_INVENTORY_DISCREPANCY_KIND_EXPIRED_RESERVATION  InventoryDiscrepancyKind = 3 // 만료되었으나 해제되지 않은 선점
+	InventoryDiscrepancyKind_INVENTORY_DISCREPANCY_KIND_ORPHANED_RESERVATION InventoryDiscrepancyKind = 4 // 주문이 없거나 취소/환불된 선점
+	InventoryDiscrepancyKind_INVENTORY_DISCREPANCY_KIND_NEGATIVE_ON_HAND     InventoryDiscrepancyKind = 5 // 보유 수량이 음수
+)
+
+// Enum value maps for InventoryDiscrepancyKind.
+var (
+	InventoryDiscrepancyKind_name = map[int32]string{
+		0: "INVENTORY_DISCREPANCY_KIND_UNSPECIFIED",
+		1: "INVENTORY_DISCREPANCY_KIND_OVERSOLD",
+		2: "INVENTORY_DISCREPANCY_KIND_RESERVED_MISMATCH",
+		3: "INVENTORY_DISCREPANCY_KIND_EXPIRED_RESERVATION",
+		4: "INVENTORY_DISCREPANCY_KIND_ORPHANED_RESERVATION",
+		5: "INVENTORY_DISCREPANCY_KIND_NEGATIVE_ON_HAND",
+	}
+	InventoryDiscrepancyKind_value = map[string]int32{
+		"INVENTORY_DISCREPANCY_KIND_UNSPECIFIED":          0,
+		"INVENTORY_DISCREPANCY_KIND_OVERSOLD":             1,
+		"INVENTORY_DISCREPANCY_KIND_RESERVED_MISMATCH":    2,
+		"INVENTORY_DISCREPANCY_KIND_EXPIRED_RESERVATION":  3,
+		"INVENTORY_DISCREPANCY_KIND_ORPHANED_RESERVATION": 4,
+		"INVENTORY_DISCREPANCY_KIND_NEGATIVE_ON_HAND":     5,
+	}
+)
+
+func (x InventoryDiscrepancyKind) Enum() *InventoryDiscrepancyKind {
+	p := new(InventoryDiscrepancyKind)
+	*p = x
+	return p
+}
+
+func (x InventoryDiscrepancyKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InventoryDiscrepancyKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_proto_enumTypes[0].Descriptor()
+}
+
+func (InventoryDiscrepancyKind) Type() protoreflect.EnumType {
+	return &file_inventory_proto_enumTypes[0]
+}
+
+func (x InventoryDiscrepancyKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InventoryDiscrepancyKind.Descriptor instead.
+func (InventoryDiscrepancyKind) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{0}
+}
+
+// 재고 보정 방법
+type InventoryCorrectionAction int32
+
+const (
+	InventoryCorrectionAction_INVENTORY_CORRECTION_ACTION_UNSPECIFIED         InventoryCorrectionAction = 0
+	InventoryCorrectionAction_INVENTORY_CORRECTION_ACTION_ADJUST_STOCK        InventoryCorrectionAction = 1 // adjust 요청으로 AdjustStock 호출
+	InventoryCorrectionAction_INVENTORY_CORRECTION_ACTION_RELEASE_RESERVATION InventoryCorrectionAction = 2 // release 요청으로 ReleaseReservation 호출
+	InventoryCorrectionAction_INVENTORY_CORRECTION_ACTION_RECOUNT_RESERVED    InventoryCorrectionAction = 3 // reserved_quantity를 유효한 선점 합계로 재계산
+	InventoryCorrectionAction_INVENTORY_CORRECTION_ACTION_MANUAL_REVIEW       InventoryCorrectionAction = 4 // 입고 또는 주문 취소 여부를 운영자가 판단
+)
+
+// Enum value maps for InventoryCorrectionAction.
+var (
+	InventoryCorrectionAction_name = map[int32]string{
+		0: "INVENTORY_CORRECTION_ACTION_UNSPECIFIED",
+		1: "INVENTORY_CORRECTION_ACTION_ADJUST_STOCK",
+		2: "INVENTORY_CORRECTION_ACTION_RELEASE_RESERVATION",
+		3: "INVENTORY_CORRECTION_ACTION_RECOUNT_RESERVED",
+		4: "INVENTORY_CORRECTION_ACTION_MANUAL_REVIEW",
+	}
+	InventoryCorrectionAction_value = map[string]int32{
+		"INVENTORY_CORRECTION_ACTION_UNSPECIFIED":         0,
+		"INVENTORY_CORRECTION_ACTION_ADJUST_STOCK":        1,
+		"INVENTORY_CORRECTION_ACTION_RELEASE_RESERVATION": 2,
+		"INVENTORY_CORRECTION_ACTION_RECOUNT_RESERVED":    3,
+		"INVENTORY_CORRECTION_ACTION_MANUAL_REVIEW":       4,
+	}
+)
+
+func (x InventoryCorrectionAction) Enum() *InventoryCorrectionAction {
+	p := new(InventoryCorrectionAction)
+	*p = x
+	return p
+}
+
+func (x InventoryCorrectionAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InventoryCorrectionAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_proto_enumTypes[1].Descriptor()
+}
+
+func (InventoryCorrectionAction) Type() protoreflect.EnumType {
+	return &file_inventory_proto_enumTypes[1]
+}
+
+func (x InventoryCorrectionAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InventoryCorrectionAction.Descriptor instead.
+func (InventoryCorrectionAction) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{1}
+}
+
+// 상품 재고 수준
+type StockLevel struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ProductId         string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductName       string                 `protobuf:"bytes,2,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	AvailableQuantity int64                  `protobuf:"varint,3,opt,name=available_quantity,json=availableQuantity,proto3" json:"available_quantity,omitempty"` // on_hand_quantity - reserved_quantity
+	UpdatedAt         string                 `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Options           map[string]string      `protobuf:"bytes,5,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 옵션 조합, 옵션 없는 상품은 비어 있음
+	OnHandQuantity    int64                  `protobuf:"varint,6,opt,name=on_hand_quantity,json=onHandQuantity,proto3" json:"on_hand_quantity,omitempty"`                                    // 창고 보유 수량
+	ReservedQuantity  int64                  `protobuf:"varint,7,opt,name=reserved_quantity,json=reservedQuantity,proto3" json:"reserved_quantity,omitempty"`                                // 주문 진행 중 선점된 수량
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *StockLevel) Reset() {
+	*x = StockLevel{}
+	mi := &file_inventory_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockLevel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockLevel) ProtoMessage() {}
+
+func (x *StockLevel) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockLevel.ProtoReflect.Descriptor instead.
+func (*StockLevel) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StockLevel) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *StockLevel) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *StockLevel) GetAvailableQuantity() int64 {
+	if x != nil {
+		return x.AvailableQuantity
+	}
+	return 0
+}
+
+func (x *StockLevel) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *StockLevel) GetOptions() map[string]string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *StockLevel) GetOnHandQuantity() int64 {
+	if x != nil {
+		return x.OnHandQuantity
+	}
+	return 0
+}
+
+func (x *StockLevel) GetReservedQuantity() int64 {
+	if x != nil {
+		return x.ReservedQuantity
+	}
+	return 0
+}
+
+// 재고 단위 (상품 + 옵션 조합)
+type StockKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Options       map[string]string      `protobuf:"bytes,2,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 옵션명 → 옵션값 (ex: {"size": "M", "color": "Blue"})
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockKey) Reset() {
+	*x = StockKey{}
+	mi := &file_inventory_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockKey) ProtoMessage() {}
+
+func (x *StockKey) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockKey.ProtoReflect.Descriptor instead.
+func (*StockKey) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StockKey) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *StockKey) GetOptions() map[string]string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type GetStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStockRequest) Reset() {
+	*x = GetStockRequest{}
+	mi := &file_inventory_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStockRequest) ProtoMessage() {}
+
+func (x *GetStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStockRequest.ProtoReflect.Descriptor instead.
+func (*GetStockRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetStockRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type GetStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stocks        []*StockLevel          `protobuf:"bytes,1,rep,name=stocks,proto3" json:"stocks,omitempty"` // 옵션 조합별 재고
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStockResponse) Reset() {
+	*x = GetStockResponse{}
+	mi := &file_inventory_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStockResponse) ProtoMessage() {}
+
+func (x *GetStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStockResponse.ProtoReflect.Descriptor instead.
+func (*GetStockResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetStockResponse) GetStocks() []*StockLevel {
+	if x != nil {
+		return x.Stocks
+	}
+	return nil
+}
+
+type StockReservationItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           *StockKey              `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockReservationItem) Reset() {
+	*x = StockReservationItem{}
+	mi := &file_inventory_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockReservationItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockReservationItem) ProtoMessage() {}
+
+func (x *StockReservationItem) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockReservationItem.ProtoReflect.Descriptor instead.
+func (*StockReservationItem) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StockReservationItem) GetKey() *StockKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *StockReservationItem) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// 재고 선점
+type StockReservation struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId       string                  `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Items         []*StockReservationItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	CreatedAt     string                  `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     string                  `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // 이후 자동 해제
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockReservation) Reset() {
+	*x = StockReservation{}
+	mi := &file_inventory_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockReservation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockReservation) ProtoMessage() {}
+
+func (x *StockReservation) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockReservation.ProtoReflect.Descriptor instead.
+func (*StockReservation) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *StockReservation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StockReservation) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *StockReservation) GetItems() []*StockReservationItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *StockReservation) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *StockReservation) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type ReserveStockRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	OrderId       string                  `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Items         []*StockReservationItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	TtlSeconds    int32                   `protobuf:"varint,3,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"` // 0이면 서버 기본값
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveStockRequest) Reset() {
+	*x = ReserveStockRequest{}
+	mi := &file_inventory_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveStockRequest) ProtoMessage() {}
+
+func (x *ReserveStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveStockRequest.ProtoReflect.Descriptor instead.
+func (*ReserveStockRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReserveStockRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ReserveStockRequest) GetItems() []*StockReservationItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ReserveStockRequest) GetTtlSeconds() int32 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+type ReserveStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reservation   *StockReservation      `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveStockResponse) Reset() {
+	*x = ReserveStockResponse{}
+	mi := &file_inventory_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveStockResponse) ProtoMessage() {}
+
+func (x *ReserveStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveStockResponse.ProtoReflect.Descriptor instead.
+func (*ReserveStockResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReserveStockResponse) GetReservation() *StockReservation {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+type ReleaseReservationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"` // ex: "payment_failed", "order_cancelled"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseReservationRequest) Reset() {
+	*x = ReleaseReservationRequest{}
+	mi := &file_inventory_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseReservationRequest) ProtoMessage() {}
+
+func (x *ReleaseReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseReservationRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseReservationRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReleaseReservationRequest) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *ReleaseReservationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ReleaseReservationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseReservationResponse) Reset() {
+	*x = ReleaseReservationResponse{}
+	mi := &file_inventory_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseReservationResponse) ProtoMessage() {}
+
+func (x *ReleaseReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseReservationResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseReservationResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{9}
+}
+
+type AdjustStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           *StockKey              `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Delta         int64                  `protobuf:"varint,2,opt,name=delta,proto3" json:"delta,omitempty"`  // 증감 수량 (입고 +, 파손/분실 -)
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"` // ex: "restock", "stocktake", "damaged"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustStockRequest) Reset() {
+	*x = AdjustStockRequest{}
+	mi := &file_inventory_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustStockRequest) ProtoMessage() {}
+
+func (x *AdjustStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustStockRequest.ProtoReflect.Descriptor instead.
+func (*AdjustStockRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AdjustStockRequest) GetKey() *StockKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *AdjustStockRequest) GetDelta() int64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *AdjustStockRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AdjustStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stock         *StockLevel            `protobuf:"bytes,1,opt,name=stock,proto3" json:"stock,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustStockResponse) Reset() {
+	*x = AdjustStockResponse{}
+	mi := &file_inventory_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustStockResponse) ProtoMessage() {}
+
+func (x *AdjustStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustStockResponse.ProtoReflect.Descriptor instead.
+func (*AdjustStockResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AdjustStockResponse) GetStock() *StockLevel {
+	if x != nil {
+		return x.Stock
+	}
+	return nil
+}
+
+type WatchLowStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Threshold     int64                  `protobuf:"varint,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	ProductIds    []string               `protobuf:"bytes,2,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"` // 비어 있으면 전체 상품 감시
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchLowStockRequest) Reset() {
+	*x = WatchLowStockRequest{}
+	mi := &file_inventory_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchLowStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchLowStockRequest) ProtoMessage() {}
+
+func (x *WatchLowStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchLowStockRequest.ProtoReflect.Descriptor instead.
+func (*WatchLowStockRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *WatchLowStockRequest) GetThreshold() int64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *WatchLowStockRequest) GetProductIds() []string {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+type WatchLowStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stock         *StockLevel            `protobuf:"bytes,1,opt,name=stock,proto3" json:"stock,omitempty"`
+	Threshold     int64                  `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchLowStockResponse) Reset() {
+	*x = WatchLowStockResponse{}
+	mi := &file_inventory_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchLowStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchLowStockResponse) ProtoMessage() {}
+
+func (x *WatchLowStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchLowStockResponse.ProtoReflect.Descriptor instead.
+func (*WatchLowStockResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *WatchLowStockResponse) GetStock() *StockLevel {
+	if x != nil {
+		return x.Stock
+	}
+	return nil
+}
+
+func (x *WatchLowStockResponse) GetThreshold() int64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+// 제안된 보정
+type InventoryCorrection struct {
+	state  protoimpl.MessageState    `protogen:"open.v1"`
+	Action InventoryCorrectionAction `protobuf:"varint,1,opt,name=action,proto3,enum=go.escape.ship.proto.v1.InventoryCorrectionAction" json:"action,omitempty"`
+	// Types that are valid to be assigned to Request:
+	//
+	//	*InventoryCorrection_Adjust
+	//	*InventoryCorrection_Release
+	Request       isInventoryCorrection_Request `protobuf_oneof:"request"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InventoryCorrection) Reset() {
+	*x = InventoryCorrection{}
+	mi := &file_inventory_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryCorrection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryCorrection) ProtoMessage() {}
+
+func (x *InventoryCorrection) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryCorrection.ProtoReflect.Descriptor instead.
+func (*InventoryCorrection) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *InventoryCorrection) GetAction() InventoryCorrectionAction {
+	if x != nil {
+		return x.Action
+	}
+	return InventoryCorrectionAction_INVENTORY_CORRECTION_ACTION_UNSPECIFIED
+}
+
+func (x *InventoryCorrection) GetRequest() isInventoryCorrection_Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *InventoryCorrection) GetAdjust() *AdjustStockRequest {
+	if x != nil {
+		if x, ok := x.Request.(*InventoryCorrection_Adjust); ok {
+			return x.Adjust
+		}
+	}
+	return nil
+}
+
+func (x *InventoryCorrection) GetRelease() *ReleaseReservationRequest {
+	if x != nil {
+		if x, ok := x.Request.(*InventoryCorrection_Release); ok {
+			return x.Release
+		}
+	}
+	return nil
+}
+
+type isInventoryCorrection_Request interface {
+	isInventoryCorrection_Request()
+}
+
+type InventoryCorrection_Adjust struct {
+	Adjust *AdjustStockRequest `protobuf:"bytes,2,opt,name=adjust,proto3,oneof"`
+}
+
+type InventoryCorrection_Release struct {
+	Release *ReleaseReservationRequest `protobuf:"bytes,3,opt,name=release,proto3,oneof"`
+}
+
+func (*InventoryCorrection_Adjust) isInventoryCorrection_Request() {}
+
+func (*InventoryCorrection_Release) isInventoryCorrection_Request() {}
+
+type InventoryDiscrepancy struct {
+	state            protoimpl.MessageState   `protogen:"open.v1"`
+	Kind             InventoryDiscrepancyKind `protobuf:"varint,1,opt,name=kind,proto3,enum=go.escape.ship.proto.v1.InventoryDiscrepancyKind" json:"kind,omitempty"`
+	Key              *StockKey                `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`                                          // 선점 관련 불일치는 비어 있을 수 있음
+	ReservationId    string                   `protobuf:"bytes,3,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"` // EXPIRED/ORPHANED_RESERVATION일 때 설정
+	OrderId          string                   `protobuf:"bytes,4,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	ExpectedQuantity int64                    `protobuf:"varint,5,opt,name=expected_quantity,json=expectedQuantity,proto3" json:"expected_quantity,omitempty"` // 선점·주문 기준으로 계산한 수량
+	ActualQuantity   int64                    `protobuf:"varint,6,opt,name=actual_quantity,json=actualQuantity,proto3" json:"actual_quantity,omitempty"`       // 저장된 수량
+	Correction       *InventoryCorrection     `protobuf:"bytes,7,opt,name=correction,proto3" json:"correction,omitempty"`
+	Detail           string                   `protobuf:"bytes,8,opt,name=detail,proto3" json:"detail,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *InventoryDiscrepancy) Reset() {
+	*x = InventoryDiscrepancy{}
+	mi := &file_inventory_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryDiscrepancy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryDiscrepancy) ProtoMessage() {}
+
+func (x *InventoryDiscrepancy) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryDiscrepancy.ProtoReflect.Descriptor instead.
+func (*InventoryDiscrepancy) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *InventoryDiscrepancy) GetKind() InventoryDiscrepancyKind {
+	if x != nil {
+		return x.Kind
+	}
+	return InventoryDiscrepancyKind_INVENTORY_DISCREPANCY_KIND_UNSPECIFIED
+}
+
+func (x *InventoryDiscrepancy) GetKey() *StockKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *InventoryDiscrepancy) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *InventoryDiscrepancy) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *InventoryDiscrepancy) GetExpectedQuantity() int64 {
+	if x != nil {
+		return x.ExpectedQuantity
+	}
+	return 0
+}
+
+func (x *InventoryDiscrepancy) GetActualQuantity() int64 {
+	if x != nil {
+		return x.ActualQuantity
+	}
+	return 0
+}
+
+func (x *InventoryDiscrepancy) GetCorrection() *InventoryCorrection {
+	if x != nil {
+		return x.Correction
+	}
+	return nil
+}
+
+func (x *InventoryDiscrepancy) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+type AuditInventoryRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ProductIds       []string               `protobuf:"bytes,1,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`                    // 비어 있으면 전체 상품 점검
+	MaxDiscrepancies int32                  `protobuf:"varint,2,opt,name=max_discrepancies,json=maxDiscrepancies,proto3" json:"max_discrepancies,omitempty"` // 0이면 서버 기본값, 초과 시 truncated
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AuditInventoryRequest) Reset() {
+	*x = AuditInventoryRequest{}
+	mi := &file_inventory_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditInventoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditInventoryRequest) ProtoMessage() {}
+
+func (x *AuditInventoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditInventoryRequest.ProtoReflect.Descriptor instead.
+func (*AuditInventoryRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AuditInventoryRequest) GetProductIds() []string {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+func (x *AuditInventoryRequest) GetMaxDiscrepancies() int32 {
+	if x != nil {
+		return x.MaxDiscrepancies
+	}
+	return 0
+}
+
+type AuditInventoryResponse struct {
+	state                   protoimpl.MessageState  `protogen:"open.v1"`
+	Discrepancies           []*InventoryDiscrepancy `protobuf:"bytes,1,rep,name=discrepancies,proto3" json:"discrepancies,omitempty"`
+	CheckedStockCount       int64                   `protobuf:"varint,2,opt,name=checked_stock_count,json=checkedStockCount,proto3" json:"checked_stock_count,omitempty"` // 점검한 재고 단위(상품 + 옵션 조합) 수
+	CheckedReservationCount int64                   `protobuf:"varint,3,opt,name=checked_reservation_count,json=checkedReservationCount,proto3" json:"checked_reservation_count,omitempty"`
+	Truncated               bool                    `protobuf:"varint,4,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	AuditedAt               string                  `protobuf:"bytes,5,opt,name=audited_at,json=auditedAt,proto3" json:"audited_at,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *AuditInventoryResponse) Reset() {
+	*x = AuditInventoryResponse{}
+	mi := &file_inventory_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditInventoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditInventoryResponse) ProtoMessage() {}
+
+func (x *AuditInventoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditInventoryResponse.ProtoReflect.Descriptor instead.
+func (*AuditInventoryResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AuditInventoryResponse) GetDiscrepancies() []*InventoryDiscrepancy {
+	if x != nil {
+		return x.Discrepancies
+	}
+	return nil
+}
+
+func (x *AuditInventoryResponse) GetCheckedStockCount() int64 {
+	if x != nil {
+		return x.CheckedStockCount
+	}
+	return 0
+}
+
+func (x *AuditInventoryResponse) GetCheckedReservationCount() int64 {
+	if x != nil {
+		return x.CheckedReservationCount
+	}
+	return 0
+}
+
+func (x *AuditInventoryResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *AuditInventoryResponse) GetAuditedAt() string {
+	if x != nil {
+		return x.AuditedAt
+	}
+	return ""
+}
+
+var File_inventory_proto protoreflect.FileDescriptor
+
+const file_inventory_proto_rawDesc = "" +
+	"\n" +
+	"\x0finventory.proto\x12\x17go.escape.ship.proto.v1\x1a\x1cgoogle/api/annotations.proto\"\xfb\x02\n" +
+	"\n" +
+	"StockLevel\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12!\n" +
+	"\fproduct_name\x18\x02 \x01(\tR\vproductName\x12-\n" +
+	"\x12available_quantity\x18\x03 \x01(\x03R\x11availableQuantity\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\tR\tupdatedAt\x12J\n" +
+	"\aoptions\x18\x05 \x03(\v20.go.escape.ship.proto.v1.StockLevel.OptionsEntryR\aoptions\x12(\n" +
+	"\x10on_hand_quantity\x18\x06 \x01(\x03R\x0eonHandQuantity\x12+\n" +
+	"\x11reserved_quantity\x18\a \x01(\x03R\x10reservedQuantity\x1a:\n" +
+	"\fOptionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xaf\x01\n" +
+	"\bStockKey\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12H\n" +
+	"\aoptions\x18\x02 \x03(\v2..go.escape.ship.proto.v1.StockKey.OptionsEntryR\aoptions\x1a:\n" +
+	"\fOptionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"0\n" +
+	"\x0fGetStockRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"O\n" +
+	"\x10GetStockResponse\x12;\n" +
+	"\x06stocks\x18\x01 \x03(\v2#.go.escape.ship.proto.v1.StockLevelR\x06stocks\"g\n" +
+	"\x14StockReservationItem\x123\n" +
+	"\x03key\x18\x01 \x01(\v2!.go.escape.ship.proto.v1.StockKeyR\x03key\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\"\xc0\x01\n" +
+	"\x10StockReservation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12C\n" +
+	"\x05items\x18\x03 \x03(\v2-.go.escape.ship.proto.v1.StockReservationItemR\x05items\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\tR\texpiresAt\"\x96\x01\n" +
+	"\x13ReserveStockRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12C\n" +
+	"\x05items\x18\x02 \x03(\v2-.go.escape.ship.proto.v1.StockReservationItemR\x05items\x12\x1f\n" +
+	"\vttl_seconds\x18\x03 \x01(\x05R\n" +
+	"ttlSeconds\"c\n" +
+	"\x14ReserveStockResponse\x12K\n" +
+	"\vreservation\x18\x01 \x01(\v2).go.escape.ship.proto.v1.StockReservationR\vreservation\"Z\n" +
+	"\x19ReleaseReservationRequest\x12%\n" +
+	"\x0ereservation_id\x18\x01 \x01(\tR\rreservationId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x1c\n" +
+	"\x1aReleaseReservationResponse\"w\n" +
+	"\x12AdjustStockRequest\x123\n" +
+	"\x03key\x18\x01 \x01(\v2!.go.escape.ship.proto.v1.StockKeyR\x03key\x12\x14\n" +
+	"\x05delta\x18\x02 \x01(\x03R\x05delta\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"P\n" +
+	"\x13AdjustStockResponse\x129\n" +
+	"\x05stock\x18\x01 \x01(\v2#.go.escape.ship.proto.v1.StockLevelR\x05stock\"U\n" +
+	"\x14WatchLowStockRequest\x12\x1c\n" +
+	"\tthreshold\x18\x01 \x01(\x03R\tthreshold\x12\x1f\n" +
+	"\vproduct_ids\x18\x02 \x03(\tR\n" +
+	"productIds\"p\n" +
+	"\x15WatchLowStockResponse\x129\n" +
+	"\x05stock\x18\x01 \x01(\v2#.go.escape.ship.proto.v1.StockLevelR\x05stock\x12\x1c\n" +
+	"\tthreshold\x18\x02 \x01(\x03R\tthreshold\"\x83\x02\n" +
+	"\x13InventoryCorrection\x12J\n" +
+	"\x06action\x18\x01 \x01(\x0e22.go.escape.ship.proto.v1.InventoryCorrectionActionR\x06action\x12E\n" +
+	"\x06adjust\x18\x02 \x01(\v2+.go.escape.ship.proto.v1.AdjustStockRequestH\x00R\x06adjust\x12N\n" +
+	"\arelease\x18\x03 \x01(\v22.go.escape.ship.proto.v1.ReleaseReservationRequestH\x00R\areleaseB\t\n" +
+	"\arequest\"\x90\x03\n" +
+	"\x14InventoryDiscrepancy\x12E\n" +
+	"\x04kind\x18\x01 \x01(\x0e21.go.escape.ship.proto.v1.InventoryDiscrepancyKindR\x04kind\x123\n" +
+	"\x03key\x18\x02 \x01(\v2!.go.escape.ship.proto.v1.StockKeyR\x03key\x12%\n" +
+	"\x0ereservation_id\x18\x03 \x01(\tR\rreservationId\x12\x19\n" +
+	"\border_id\x18\x04 \x01(\tR\aorderId\x12+\n" +
+	"\x11expected_quantity\x18\x05 \x01(\x03R\x10expectedQuantity\x12'\n" +
+	"\x0factual_quantity\x18\x06 \x01(\x03R\x0eactualQuantity\x12L\n" +
+	"\n" +
+	"correction\x18\a \x01(\v2,.go.escape.ship.proto.v1.InventoryCorrectionR\n" +
+	"correction\x12\x16\n" +
+	"\x06detail\x18\b \x01(\tR\x06detail\"e\n" +
+	"\x15AuditInventoryRequest\x12\x1f\n" +
+	"\vproduct_ids\x18\x01 \x03(\tR\n" +
+	"productIds\x12+\n" +
+	"\x11max_discrepancies\x18\x02 \x01(\x05R\x10maxDiscrepancies\"\x96\x02\n" +
+	"\x16AuditInventoryResponse\x12S\n" +
+	"\rdiscrepancies\x18\x01 \x03(\v2-.go.escape.ship.proto.v1.InventoryDiscrepancyR\rdiscrepancies\x12.\n" +
+	"\x13checked_stock_count\x18\x02 \x01(\x03R\x11checkedStockCount\x12:\n" +
+	"\x19checked_reservation_count\x18\x03 \x01(\x03R\x17checkedReservationCount\x12\x1c\n" +
+	"\ttruncated\x18\x04 \x01(\bR\ttruncated\x12\x1d\n" +
+	"\n" +
+	"audited_at\x18\x05 \x01(\tR\tauditedAt*\xbb\x02\n" +
+	"\x18InventoryDiscrepancyKind\x12*\n" +
+	"&INVENTORY_DISCREPANCY_KIND_UNSPECIFIED\x10\x00\x12'\n" +
+	"#INVENTORY_DISCREPANCY_KIND_OVERSOLD\x10\x01\x120\n" +
+	",INVENTORY_DISCREPANCY_KIND_RESERVED_MISMATCH\x10\x02\x122\n" +
+	".INVENTORY_DISCREPANCY_KIND_EXPIRED_RESERVATION\x10\x03\x123\n" +
+	"/INVENTORY_DISCREPANCY_KIND_ORPHANED_RESERVATION\x10\x04\x12/\n" +
+	"+INVENTORY_DISCREPANCY_KIND_NEGATIVE_ON_HAND\x10\x05*\x8c\x02\n" +
+	"\x19InventoryCorrectionAction\x12+\n" +
+	"'INVENTORY_CORRECTION_ACTION_UNSPECIFIED\x10\x00\x12,\n" +
+	"(INVENTORY_CORRECTION_ACTION_ADJUST_STOCK\x10\x01\x123\n" +
+	"/INVENTORY_CORRECTION_ACTION_RELEASE_RESERVATION\x10\x02\x120\n" +
+	",INVENTORY_CORRECTION_ACTION_RECOUNT_RESERVED\x10\x03\x12-\n" +
+	")INVENTORY_CORRECTION_ACTION_MANUAL_REVIEW\x10\x042\xbb\a\n" +
+	"\x10InventoryService\x12\x97\x01\n" +
+	"\rWatchLowStock\x12-.go.escape.ship.proto.v1.WatchLowStockRequest\x1a..go.escape.ship.proto.v1.WatchLowStockResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/inventory/low-stock/watch0\x01\x12\x92\x01\n" +
+	"\bGetStock\x12(.go.escape.ship.proto.v1.GetStockRequest\x1a).go.escape.ship.proto.v1.GetStockResponse\"1\x82\xd3\xe4\x93\x02+\x12)/v1/inventory/products/{product_id}/stock\x12\x92\x01\n" +
+	"\fReserveStock\x12,.go.escape.ship.proto.v1.ReserveStockRequest\x1a-.go.escape.ship.proto.v1.ReserveStockResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/inventory/reservations\x12\xbd\x01\n" +
+	"\x12ReleaseReservation\x122.go.escape.ship.proto.v1.ReleaseReservationRequest\x1a3.go.escape.ship.proto.v1.ReleaseReservationResponse\">\x82\xd3\xe4\x93\x028:\x01*\"3/v1/inventory/reservations/{reservation_id}/release\x12\x8e\x01\n" +
+	"\vAdjustStock\x12+.go.escape.ship.proto.v1.AdjustStockRequest\x1a,.go.escape.ship.proto.v1.AdjustStockResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/inventory/adjustments\x12\x91\x01\n" +
+	"\x0eAuditInventory\x12..go.escape.ship.proto.v1.AuditInventoryRequest\x1a/.go.escape.ship.proto.v1.AuditInventoryResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/inventory/auditB#Z!github.com/escape-ship/protos/genb\x06proto3"
+
+var (
+	file_inventory_proto_rawDescOnce sync.Once
+	file_inventory_proto_rawDescData []byte
+)
+
+func file_inventory_proto_rawDescGZIP() []byte {
+	file_inventory_proto_rawDescOnce.Do(func() {
+		file_inventory_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_inventory_proto_rawDesc), len(file_inventory_proto_rawDesc)))
+	})
+	return file_inventory_proto_rawDescData
+}
+
+var file_inventory_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_inventory_proto_goTypes = []any{
+	(InventoryDiscrepancyKind)(0),      // 0: go.escape.ship.proto.v1.InventoryDiscrepancyKind
+	(InventoryCorrectionAction)(0),     // 1: go.escape.ship.proto.v1.InventoryCorrectionAction
+	(*StockLevel)(nil),                 // 2: go.escape.ship.proto.v1.StockLevel
+	(*StockKey)(nil),                   // 3: go.escape.ship.proto.v1.StockKey
+	(*GetStockRequest)(nil),            // 4: go.escape.ship.proto.v1.GetStockRequest
+	(*GetStockResponse)(nil),           // 5: go.escape.ship.proto.v1.GetStockResponse
+	(*StockReservationItem)(nil),       // 6: go.escape.ship.proto.v1.StockReservationItem
+	(*StockReservation)(nil),           // 7: go.escape.ship.proto.v1.StockReservation
+	(*ReserveStockRequest)(nil),        // 8: go.escape.ship.proto.v1.ReserveStockRequest
+	(*ReserveStockResponse)(nil),       // 9: go.escape.ship.proto.v1.ReserveStockResponse
+	(*ReleaseReservationRequest)(nil),  // 10: go.escape.ship.proto.v1.ReleaseReservationRequest
+	(*ReleaseReservationResponse)(nil), // 11: go.escape.ship.proto.v1.ReleaseReservationResponse
+	(*AdjustStockRequest)(nil),         // 12: go.escape.ship.proto.v1.AdjustStockRequest
+	(*AdjustStockResponse)(nil),        // 13: go.escape.ship.proto.v1.AdjustStockResponse
+	(*WatchLowStockRequest)(nil),       // 14: go.escape.ship.proto.v1.WatchLowStockRequest
+	(*WatchLowStockResponse)(nil),      // 15: go.escape.ship.proto.v1.WatchLowStockResponse
+	(*InventoryCorrection)(nil),        // 16: go.escape.ship.proto.v1.InventoryCorrection
+	(*InventoryDiscrepancy)(nil),       // 17: go.escape.ship.proto.v1.InventoryDiscrepancy
+	(*AuditInventoryRequest)(nil),      // 18: go.escape.ship.proto.v1.AuditInventoryRequest
+	(*AuditInventoryResponse)(nil),     // 19: go.escape.ship.proto.v1.AuditInventoryResponse
+	nil,                                // 20: go.escape.ship.proto.v1.StockLevel.OptionsEntry
+	nil,                                // 21: go.escape.ship.proto.v1.StockKey.OptionsEntry
+}
+var file_inventory_proto_depIdxs = []int32{
+	20, // 0: go.escape.ship.proto.v1.StockLevel.options:type_name -> go.escape.ship.proto.v1.StockLevel.OptionsEntry
+	21, // 1: go.escape.ship.proto.v1.StockKey.options:type_name -> go.escape.ship.proto.v1.StockKey.OptionsEntry
+	2,  // 2: go.escape.ship.proto.v1.GetStockResponse.stocks:type_name -> go.escape.ship.proto.v1.StockLevel
+	3,  // 3: go.escape.ship.proto.v1.StockReservationItem.key:type_name -> go.escape.ship.proto.v1.StockKey
+	6,  // 4: go.escape.ship.proto.v1.StockReservation.items:type_name -> go.escape.ship.proto.v1.StockReservationItem
+	6,  // 5: go.escape.ship.proto.v1.ReserveStockRequest.items:type_name -> go.escape.ship.proto.v1.StockReservationItem
+	7,  // 6: go.escape.ship.proto.v1.ReserveStockResponse.reservation:type_name -> go.escape.ship.proto.v1.StockReservation
+	3,  // 7: go.escape.ship.proto.v1.AdjustStockRequest.key:type_name -> go.escape.ship.proto.v1.StockKey
+	2,  // 8: go.escape.ship.proto.v1.AdjustStockResponse.stock:type_name -> go.escape.ship.proto.v1.StockLevel
+	2,  // 9: go.escape.ship.proto.v1.WatchLowStockResponse.stock:type_name -> go.escape.ship.proto.v1.StockLevel
+	1,  // 10: go.escape.ship.proto.v1.InventoryCorrection.action:type_name -> go.escape.ship.proto.v1.InventoryCorrectionAction
+	12, // 11: go.escape.ship.proto.v1.InventoryCorrection.adjust:type_name -> go.escape.ship.proto.v1.AdjustStockRequest
+	10, // 12: go.escape.ship.proto.v1.InventoryCorrection.release:type_name -> go.escape.ship.proto.v1.ReleaseReservationRequest
+	0,  // 13: go.escape.ship.proto.v1.InventoryDiscrepancy.kind:type_name -> go.escape.ship.proto.v1.InventoryDiscrepancyKind
+	3,  // 14: go.escape.ship.proto.v1.InventoryDiscrepancy.key:type_name -> go.escape.ship.proto.v1.StockKey
+	16, // 15: go.escape.ship.proto.v1.InventoryDiscrepancy.correction:type_name -> go.escape.ship.proto.v1.InventoryCorrection
+	17, // 16: go.escape.ship.proto.v1.AuditInventoryResponse.discrepancies:type_name -> go.escape.ship.proto.v1.InventoryDiscrepancy
+	14, // 17: go.escape.ship.proto.v1.InventoryService.WatchLowStock:input_type -> go.escape.ship.proto.v1.WatchLowStockRequest
+	4,  // 18: go.escape.ship.proto.v1.InventoryService.GetStock:input_type -> go.escape.ship.proto.v1.GetStockRequest
+	8,  // 19: go.escape.ship.proto.v1.InventoryService.ReserveStock:input_type -> go.escape.ship.proto.v1.ReserveStockRequest
+	10, // 20: go.escape.ship.proto.v1.InventoryService.ReleaseReservation:input_type -> go.escape.ship.proto.v1.ReleaseReservationRequest
+	12, // 21: go.escape.ship.proto.v1.InventoryService.AdjustStock:input_type -> go.escape.ship.proto.v1.AdjustStockRequest
+	18, // 22: go.escape.ship.proto.v1.InventoryService.AuditInventory:input_type -> go.escape.ship.proto.v1.AuditInventoryRequest
+	15, // 23: go.escape.ship.proto.v1.InventoryService.WatchLowStock:output_type -> go.escape.ship.proto.v1.WatchLowStockResponse
+	5,  // 24: go.escape.ship.proto.v1.InventoryService.GetStock:output_type -> go.escape.ship.proto.v1.GetStockResponse
+	9,  // 25: go.escape.ship.proto.v1.InventoryService.ReserveStock:output_type -> go.escape.ship.proto.v1.ReserveStockResponse
+	11, // 26: go.escape.ship.proto.v1.InventoryService.ReleaseReservation:output_type -> go.escape.ship.proto.v1.ReleaseReservationResponse
+	13, // 27: go.escape.ship.proto.v1.InventoryService.AdjustStock:output_type -> go.escape.ship.proto.v1.AdjustStockResponse
+	19, // 28: go.escape.ship.proto.v1.InventoryService.AuditInventory:output_type -> go.escape.ship.proto.v1.AuditInventoryResponse
+	23, // [23:29] is the sub-list for method output_type
+	17, // [17:23] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
+}
+
+func init() { file_inventory_proto_init() }
+func file_inventory_proto_init() {
+	if File_inventory_proto != nil {
+		return
+	}
+	file_inventory_proto_msgTypes[14].OneofWrappers = []any{
+		(*InventoryCorrection_Adjust)(nil),
+		(*InventoryCorrection_Release)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_inventory_proto_rawDesc), len(file_inventory_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   20,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_inventory_proto_goTypes,
+		DependencyIndexes: file_inventory_proto_depIdxs,
+		EnumInfos:         file_inventory_proto_enumTypes,
+		MessageInfos:      file_inventory_proto_msgTypes,
+	}.Build()
+	File_inventory_proto = out.File
+	file_inventory_proto_goTypes = nil
+	file_inventory_proto_depIdxs = nil
+}
@@ -0,0 +1,2018 @@
+// Code generated by protoc-gen-twirp v8.1.3, DO NOT EDIT.
+// source: inventory.proto
+
+package gen
+
+import context "context"
+import fmt "fmt"
+import http "net/http"
+import io "io"
+import json "encoding/json"
+import strconv "strconv"
+import strings "strings"
+
+import protojson "google.golang.org/protobuf/encoding/protojson"
+import proto "google.golang.org/protobuf/proto"
+import twirp "github.com/twitchtv/twirp"
+import ctxsetters "github.com/twitchtv/twirp/ctxsetters"
+
+// Version compatibility assertion.
+// If the constant is not defined in the package, that likely means
+// the package needs to be updated to work with this generated code.
+// See https://twitchtv.github.io/twirp/docs/version_matrix.html
+const _ = twirp.TwirpPackageMinVersion_8_1_0
+
+// ==========================
+// InventoryService Interface
+// ==========================
+
+type InventoryService interface {
+	// 재고가 threshold 이하로 떨어진 상품을 실시간으로 전달 (운영 알림용)
+	WatchLowStock(context.Context, *WatchLowStockRequest) (*WatchLowStockResponse, error)
+
+	// 상품의 옵션 조합별 재고 조회 (주문 전 재고 확인용)
+	GetStock(context.Context, *GetStockRequest) (*GetStockResponse, error)
+
+	// 주문/결제 진행 중 재고 선점 (전체 항목 성공 또는 전체 실패)
+	// 재고 부족 시 FAILED_PRECONDITION + ERROR_REASON_OUT_OF_STOCK
+	ReserveStock(context.Context, *ReserveStockRequest) (*ReserveStockResponse, error)
+
+	// 선점 해제 (결제 실패/취소), 이미 해제·만료된 선점은 성공으로 처리
+	ReleaseReservation(context.Context, *ReleaseReservationRequest) (*ReleaseReservationResponse, error)
+
+	// 입고/실사 등으로 보유 재고 수량 조정 (운영자용)
+	AdjustStock(context.Context, *AdjustStockRequest) (*AdjustStockResponse, error)
+
+	// 선점·주문·재고 수량 간 불일치(초과 판매, 미해제 선점 등)와 보정 방법 조회 (야간 정합성 점검용)
+	// 조회만 하며, 보정은 제안된 AdjustStock/ReleaseReservation 요청을 검토 후 실행
+	AuditInventory(context.Context, *AuditInventoryRequest) (*AuditInventoryResponse, error)
+}
+
+// ================================
+// InventoryService Protobuf Client
+// ================================
+
+type inventoryServiceProtobufClient struct {
+	client      HTTPClient
+	urls        [6]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewInventoryServiceProtobufClient creates a Protobuf client that implements the InventoryService interface.
+// It communicates using Protobuf and can be configured with a custom HTTPClient.
+func NewInventoryServiceProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) InventoryService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "go.escape.ship.proto.v1", "InventoryService")
+	urls := [6]string{
+		serviceURL + "WatchLowStock",
+		serviceURL + "GetStock",
+		serviceURL + "ReserveStock",
+		serviceURL + "ReleaseReservation",
+		serviceURL + "AdjustStock",
+		serviceURL + "AuditInventory",
+	}
+
+	return &inventoryServiceProtobufClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *inventoryServiceProtobufClient) WatchLowStock(ctx context.Context, in *WatchLowStockRequest) (*WatchLowStockResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "InventoryService")
+	ctx = ctxsetters.WithMethodName(ctx, "WatchLowStock")
+	caller := c.callWatchLowStock
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *WatchLowStockRequest) (*WatchLowStockResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*WatchLowStockRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*WatchLowStockRequest) when calling interceptor")
+					}
+					return c.callWatchLowStock(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*WatchLowStockResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*WatchLowStockResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *inventoryServiceProtobufClient) callWatchLowStock(ctx context.Context, in *WatchLowStockRequest) (*WatchLowStockResponse, error) {
+	out := new(WatchLowStockResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *inventoryServiceProtobufClient) GetStock(ctx context.Context, in *GetStockRequest) (*GetStockResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "InventoryService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetStock")
+	caller := c.callGetStock
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetStockRequest) (*GetStockResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetStockRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetStockRequest) when calling interceptor")
+					}
+					return c.callGetStock(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetStockResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetStockResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *inventoryServiceProtobufClient) callGetStock(ctx context.Context, in *GetStockRequest) (*GetStockResponse, error) {
+	out := new(GetStockResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *inventoryServiceProtobufClient) ReserveStock(ctx context.Context, in *ReserveStockRequest) (*ReserveStockResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "InventoryService")
+	ctx = ctxsetters.WithMethodName(ctx, "ReserveStock")
+	caller := c.callReserveStock
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ReserveStockRequest) (*ReserveStockResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ReserveStockRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ReserveStockRequest) when calling interceptor")
+					}
+					return c.callReserveStock(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ReserveStockResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ReserveStockResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *inventoryServiceProtobufClient) callReserveStock(ctx context.Context, in *ReserveStockRequest) (*ReserveStockResponse, error) {
+	out := new(ReserveStockResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *inventoryServiceProtobufClient) ReleaseReservation(ctx context.Context, in *ReleaseReservationRequest) (*ReleaseReservationResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "InventoryService")
+	ctx = ctxsetters.WithMethodName(ctx, "ReleaseReservation")
+	caller := c.callReleaseReservation
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ReleaseReservationRequest) (*ReleaseReservationResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ReleaseReservationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ReleaseReservationRequest) when calling interceptor")
+					}
+					return c.callReleaseReservation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ReleaseReservationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ReleaseReservationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *inventoryServiceProtobufClient) callReleaseReservation(ctx context.Context, in *ReleaseReservationRequest) (*ReleaseReservationResponse, error) {
+	out := new(ReleaseReservationResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *inventoryServiceProtobufClient) AdjustStock(ctx context.Context, in *AdjustStockRequest) (*AdjustStockResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "InventoryService")
+	ctx = ctxsetters.WithMethodName(ctx, "AdjustStock")
+	caller := c.callAdjustStock
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AdjustStockRequest) (*AdjustStockResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AdjustStockRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AdjustStockRequest) when calling interceptor")
+					}
+					return c.callAdjustStock(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AdjustStockResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AdjustStockResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *inventoryServiceProtobufClient) callAdjustStock(ctx context.Context, in *AdjustStockRequest) (*AdjustStockResponse, error) {
+	out := new(AdjustStockResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *inventoryServiceProtobufClient) AuditInventory(ctx context.Context, in *AuditInventoryRequest) (*AuditInventoryResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "InventoryService")
+	ctx = ctxsetters.WithMethodName(ctx, "AuditInventory")
+	caller := c.callAuditInventory
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AuditInventoryRequest) (*AuditInventoryResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AuditInventoryRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AuditInventoryRequest) when calling interceptor")
+					}
+					return c.callAuditInventory(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AuditInventoryResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AuditInventoryResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *inventoryServiceProtobufClient) callAuditInventory(ctx context.Context, in *AuditInventoryRequest) (*AuditInventoryResponse, error) {
+	out := new(AuditInventoryResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ============================
+// InventoryService JSON Client
+// ============================
+
+type inventoryServiceJSONClient struct {
+	client      HTTPClient
+	urls        [6]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewInventoryServiceJSONClient creates a JSON client that implements the InventoryService interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewInventoryServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) InventoryService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "go.escape.ship.proto.v1", "InventoryService")
+	urls := [6]string{
+		serviceURL + "WatchLowStock",
+		serviceURL + "GetStock",
+		serviceURL + "ReserveStock",
+		serviceURL + "ReleaseReservation",
+		serviceURL + "AdjustStock",
+		serviceURL + "AuditInventory",
+	}
+
+	return &inventoryServiceJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *inventoryServiceJSONClient) WatchLowStock(ctx context.Context, in *WatchLowStockRequest) (*WatchLowStockResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "InventoryService")
+	ctx = ctxsetters.WithMethodName(ctx, "WatchLowStock")
+	caller := c.callWatchLowStock
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *WatchLowStockRequest) (*WatchLowStockResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*WatchLowStockRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*WatchLowStockRequest) when calling interceptor")
+					}
+					return c.callWatchLowStock(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*WatchLowStockResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*WatchLowStockResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *inventoryServiceJSONClient) callWatchLowStock(ctx context.Context, in *WatchLowStockRequest) (*WatchLowStockResponse, error) {
+	out := new(WatchLowStockResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *inventoryServiceJSONClient) GetStock(ctx context.Context, in *GetStockRequest) (*GetStockResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "InventoryService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetStock")
+	caller := c.callGetStock
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetStockRequest) (*GetStockResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetStockRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetStockRequest) when calling interceptor")
+					}
+					return c.callGetStock(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetStockResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetStockResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *inventoryServiceJSONClient) callGetStock(ctx context.Context, in *GetStockRequest) (*GetStockResponse, error) {
+	out := new(GetStockResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *inventoryServiceJSONClient) ReserveStock(ctx context.Context, in *ReserveStockRequest) (*ReserveStockResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "InventoryService")
+	ctx = ctxsetters.WithMethodName(ctx, "ReserveStock")
+	caller := c.callReserveStock
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ReserveStockRequest) (*ReserveStockResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ReserveStockRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ReserveStockRequest) when calling interceptor")
+					}
+					return c.callReserveStock(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ReserveStockResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ReserveStockResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *inventoryServiceJSONClient) callReserveStock(ctx context.Context, in *ReserveStockRequest) (*ReserveStockResponse, error) {
+	out := new(ReserveStockResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *inventoryServiceJSONClient) ReleaseReservation(ctx context.Context, in *ReleaseReservationRequest) (*ReleaseReservationResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "InventoryService")
+	ctx = ctxsetters.WithMethodName(ctx, "ReleaseReservation")
+	caller := c.callReleaseReservation
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ReleaseReservationRequest) (*ReleaseReservationResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ReleaseReservationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ReleaseReservationRequest) when calling interceptor")
+					}
+					return c.callReleaseReservation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ReleaseReservationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ReleaseReservationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *inventoryServiceJSONClient) callReleaseReservation(ctx context.Context, in *ReleaseReservationRequest) (*ReleaseReservationResponse, error) {
+	out := new(ReleaseReservationResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *inventoryServiceJSONClient) AdjustStock(ctx context.Context, in *AdjustStockRequest) (*AdjustStockResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "InventoryService")
+	ctx = ctxsetters.WithMethodName(ctx, "AdjustStock")
+	caller := c.callAdjustStock
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AdjustStockRequest) (*AdjustStockResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AdjustStockRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AdjustStockRequest) when calling interceptor")
+					}
+					return c.callAdjustStock(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AdjustStockResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AdjustStockResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *inventoryServiceJSONClient) callAdjustStock(ctx context.Context, in *AdjustStockRequest) (*AdjustStockResponse, error) {
+	out := new(AdjustStockResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *inventoryServiceJSONClient) AuditInventory(ctx context.Context, in *AuditInventoryRequest) (*AuditInventoryResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "InventoryService")
+	ctx = ctxsetters.WithMethodName(ctx, "AuditInventory")
+	caller := c.callAuditInventory
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AuditInventoryRequest) (*AuditInventoryResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AuditInventoryRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AuditInventoryRequest) when calling interceptor")
+					}
+					return c.callAuditInventory(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AuditInventoryResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AuditInventoryResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *inventoryServiceJSONClient) callAuditInventory(ctx context.Context, in *AuditInventoryRequest) (*AuditInventoryResponse, error) {
+	out := new(AuditInventoryResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ===============================
+// InventoryService Server Handler
+// ===============================
+
+type inventoryServiceServer struct {
+	InventoryService
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewInventoryServiceServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewInventoryServiceServer(svc InventoryService, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	return &inventoryServiceServer{
+		InventoryService: svc,
+		hooks:            serverOpts.Hooks,
+		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:       pathPrefix,
+		jsonSkipDefaults: jsonSkipDefaults,
+		jsonCamelCase:    jsonCamelCase,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *inventoryServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *inventoryServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
+
+// InventoryServicePathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const InventoryServicePathPrefix = "/twirp/go.escape.ship.proto.v1.InventoryService/"
+
+func (s *inventoryServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "InventoryService")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "go.escape.ship.proto.v1.InventoryService" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "WatchLowStock":
+		s.serveWatchLowStock(ctx, resp, req)
+		return
+	case "GetStock":
+		s.serveGetStock(ctx, resp, req)
+		return
+	case "ReserveStock":
+		s.serveReserveStock(ctx, resp, req)
+		return
+	case "ReleaseReservation":
+		s.serveReleaseReservation(ctx, resp, req)
+		return
+	case "AdjustStock":
+		s.serveAdjustStock(ctx, resp, req)
+		return
+	case "AuditInventory":
+		s.serveAuditInventory(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *inventoryServiceServer) serveWatchLowStock(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveWatchLowStockJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveWatchLowStockProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *inventoryServiceServer) serveWatchLowStockJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "WatchLowStock")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(WatchLowStockRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.InventoryService.WatchLowStock
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *WatchLowStockRequest) (*WatchLowStockResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*WatchLowStockRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*WatchLowStockRequest) when calling interceptor")
+					}
+					return s.InventoryService.WatchLowStock(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*WatchLowStockResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*WatchLowStockResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *WatchLowStockResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *WatchLowStockResponse and nil error while calling WatchLowStock. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *inventoryServiceServer) serveWatchLowStockProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "WatchLowStock")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(WatchLowStockRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.InventoryService.WatchLowStock
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *WatchLowStockRequest) (*WatchLowStockResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*WatchLowStockRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*WatchLowStockRequest) when calling interceptor")
+					}
+					return s.InventoryService.WatchLowStock(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*WatchLowStockResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*WatchLowStockResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *WatchLowStockResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *WatchLowStockResponse and nil error while calling WatchLowStock. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *inventoryServiceServer) serveGetStock(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetStockJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetStockProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *inventoryServiceServer) serveGetStockJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetStock")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetStockRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.InventoryService.GetStock
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetStockRequest) (*GetStockResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetStockRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetStockRequest) when calling interceptor")
+					}
+					return s.InventoryService.GetStock(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetStockResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetStockResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetStockResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetStockResponse and nil error while calling GetStock. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *inventoryServiceServer) serveGetStockProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetStock")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetStockRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.InventoryService.GetStock
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetStockRequest) (*GetStockResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetStockRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetStockRequest) when calling interceptor")
+					}
+					return s.InventoryService.GetStock(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetStockResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetStockResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetStockResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetStockResponse and nil error while calling GetStock. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *inventoryServiceServer) serveReserveStock(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveReserveStockJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveReserveStockProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *inventoryServiceServer) serveReserveStockJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ReserveStock")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ReserveStockRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.InventoryService.ReserveStock
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ReserveStockRequest) (*ReserveStockResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ReserveStockRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ReserveStockRequest) when calling interceptor")
+					}
+					return s.InventoryService.ReserveStock(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ReserveStockResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ReserveStockResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ReserveStockResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ReserveStockResponse and nil error while calling ReserveStock. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *inventoryServiceServer) serveReserveStockProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ReserveStock")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ReserveStockRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.InventoryService.ReserveStock
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ReserveStockRequest) (*ReserveStockResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ReserveStockRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ReserveStockRequest) when calling interceptor")
+					}
+					return s.InventoryService.ReserveStock(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ReserveStockResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ReserveStockResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ReserveStockResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ReserveStockResponse and nil error while calling ReserveStock. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *inventoryServiceServer) serveReleaseReservation(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveReleaseReservationJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveReleaseReservationProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *inventoryServiceServer) serveReleaseReservationJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ReleaseReservation")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ReleaseReservationRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.InventoryService.ReleaseReservation
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ReleaseReservationRequest) (*ReleaseReservationResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ReleaseReservationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ReleaseReservationRequest) when calling interceptor")
+					}
+					return s.InventoryService.ReleaseReservation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ReleaseReservationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ReleaseReservationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ReleaseReservationResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ReleaseReservationResponse and nil error while calling ReleaseReservation. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *inventoryServiceServer) serveReleaseReservationProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ReleaseReservation")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ReleaseReservationRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.InventoryService.ReleaseReservation
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ReleaseReservationRequest) (*ReleaseReservationResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ReleaseReservationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ReleaseReservationRequest) when calling interceptor")
+					}
+					return s.InventoryService.ReleaseReservation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ReleaseReservationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ReleaseReservationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ReleaseReservationResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ReleaseReservationResponse and nil error while calling ReleaseReservation. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *inventoryServiceServer) serveAdjustStock(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveAdjustStockJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveAdjustStockProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *inventoryServiceServer) serveAdjustStockJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "AdjustStock")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(AdjustStockRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.InventoryService.AdjustStock
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *AdjustStockRequest) (*AdjustStockResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AdjustStockRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AdjustStockRequest) when calling interceptor")
+					}
+					return s.InventoryService.AdjustStock(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AdjustStockResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AdjustStockResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *AdjustStockResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AdjustStockResponse and nil error while calling AdjustStock. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *inventoryServiceServer) serveAdjustStockProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "AdjustStock")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(AdjustStockRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.InventoryService.AdjustStock
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *AdjustStockRequest) (*AdjustStockResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AdjustStockRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AdjustStockRequest) when calling interceptor")
+					}
+					return s.InventoryService.AdjustStock(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AdjustStockResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AdjustStockResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *AdjustStockResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AdjustStockResponse and nil error while calling AdjustStock. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *inventoryServiceServer) serveAuditInventory(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveAuditInventoryJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveAuditInventoryProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *inventoryServiceServer) serveAuditInventoryJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "AuditInventory")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(AuditInventoryRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.InventoryService.AuditInventory
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *AuditInventoryRequest) (*AuditInventoryResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AuditInventoryRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AuditInventoryRequest) when calling interceptor")
+					}
+					return s.InventoryService.AuditInventory(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AuditInventoryResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AuditInventoryResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *AuditInventoryResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AuditInventoryResponse and nil error while calling AuditInventory. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *inventoryServiceServer) serveAuditInventoryProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "AuditInventory")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(AuditInventoryRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.InventoryService.AuditInventory
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *AuditInventoryRequest) (*AuditInventoryResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AuditInventoryRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AuditInventoryRequest) when calling interceptor")
+					}
+					return s.InventoryService.AuditInventory(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AuditInventoryResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AuditInventoryResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *AuditInventoryResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AuditInventoryResponse and nil error while calling AuditInventory. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *inventoryServiceServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor5, 0
+}
+
+func (s *inventoryServiceServer) ProtocGenTwirpVersion() string {
+	return "v8.1.3"
+}
+
+// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
+// that is everything in a Twirp route except for the <Method>. This can be used for routing,
+// for example to identify the requests that are targeted to this service in a mux.
+func (s *inventoryServiceServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "go.escape.ship.proto.v1", "InventoryService")
+}
+
+var twirpFileDescriptor5 = []byte{
+	// 1503 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xdb, 0x6e, 0xdb, 0x46,
+	0x13, 0x0e, 0x29, 0xcb, 0x87, 0x51, 0xe2, 0xc8, 0x6b, 0x27, 0x91, 0x85, 0x1c, 0x99, 0x3f, 0x7f,
+	0x7c, 0x14, 0x6d, 0xf9, 0x26, 0xbf, 0x7f, 0xa0, 0x00, 0x23, 0xb1, 0x31, 0x63, 0x47, 0x72, 0x57,
+	0xb6, 0xd3, 0xe4, 0x86, 0x60, 0xc8, 0x85, 0xcd, 0x5a, 0x22, 0x15, 0x72, 0x25, 0xdb, 0x08, 0x72,
+	0xd3, 0xde, 0x16, 0x45, 0x9b, 0x02, 0xe9, 0x63, 0xf4, 0x01, 0x82, 0x02, 0x7d, 0x87, 0xbe, 0x42,
+	0xdf, 0xa2, 0x37, 0x05, 0x97, 0x2b, 0x8a, 0x94, 0x25, 0x59, 0x4e, 0x6e, 0x12, 0x71, 0x76, 0x0e,
+	0xdf, 0x7c, 0x3b, 0xb3, 0x33, 0x86, 0xeb, 0xb6, 0xd3, 0x26, 0x0e, 0x75, 0xbd, 0xb3, 0x42, 0xd3,
+	0x73, 0xa9, 0x8b, 0x6e, 0x1d, 0xba, 0x05, 0xe2, 0x9b, 0x46, 0x93, 0x14, 0xfc, 0x23, 0xbb, 0x19,
+	0x4a, 0x0b, 0xed, 0xf5, 0xfc, 0xed, 0x43, 0xd7, 0x3d, 0xac, 0x13, 0xd9, 0x68, 0xda, 0xb2, 0xe1,
+	0x38, 0x2e, 0x35, 0xa8, 0xed, 0x3a, 0x7e, 0xa8, 0x20, 0xfd, 0x23, 0x02, 0xd4, 0xa8, 0x6b, 0x1e,
+	0xef, 0x90, 0x36, 0xa9, 0xa3, 0x3b, 0x00, 0x4d, 0xcf, 0xb5, 0x5a, 0x26, 0xd5, 0x6d, 0x2b, 0x27,
+	0xdc, 0x17, 0x16, 0xa6, 0xf0, 0x14, 0x97, 0x68, 0x16, 0x7a, 0x00, 0x57, 0x3b, 0xc7, 0x8e, 0xd1,
+	0x20, 0x39, 0x91, 0x29, 0x64, 0xb8, 0xac, 0x62, 0x34, 0x08, 0x5a, 0x05, 0x64, 0xb4, 0x0d, 0xbb,
+	0x6e, 0xbc, 0xa9, 0x13, 0xfd, 0x6d, 0xcb, 0x70, 0xa8, 0x4d, 0xcf, 0x72, 0xa9, 0xfb, 0xc2, 0x42,
+	0x0a, 0xcf, 0x44, 0x27, 0xdf, 0xf0, 0x83, 0x20, 0x60, 0xab, 0x69, 0x19, 0x94, 0x58, 0xba, 0x41,
+	0x73, 0x63, 0x61, 0x40, 0x2e, 0x51, 0x28, 0x7a, 0x0e, 0x13, 0x6e, 0x93, 0xe1, 0xcd, 0xa5, 0xef,
+	0xa7, 0x16, 0x32, 0xc5, 0xb5, 0xc2, 0x80, 0x3c, 0x0b, 0xdd, 0x2c, 0x0a, 0xd5, 0xd0, 0x44, 0x75,
+	0xa8, 0x77, 0x86, 0x3b, 0x0e, 0xd0, 0x02, 0x64, 0x5d, 0x47, 0x3f, 0x32, 0x1c, 0xab, 0x8b, 0x6b,
+	0x9c, 0xe1, 0x9a, 0x76, 0x9d, 0x2d, 0xc3, 0xb1, 0x22, 0x50, 0xcb, 0x30, 0xe3, 0x11, 0x9f, 0x78,
+	0x6d, 0x12, 0x53, 0x9d, 0x60, 0xaa, 0xd9, 0xce, 0x41, 0x47, 0x39, 0xbf, 0x09, 0x57, 0xe3, 0xf1,
+	0x50, 0x16, 0x52, 0xc7, 0xe4, 0x8c, 0x73, 0x17, 0xfc, 0x44, 0x73, 0x90, 0x6e, 0x1b, 0xf5, 0x56,
+	0x87, 0xae, 0xf0, 0x63, 0x53, 0x7c, 0x22, 0x48, 0xbf, 0x0b, 0x30, 0xc9, 0x70, 0x6f, 0x93, 0xb3,
+	0x8b, 0xb8, 0xdf, 0xea, 0x52, 0x21, 0x32, 0x2a, 0x0a, 0xc3, 0xa9, 0xd8, 0x26, 0x67, 0xfd, 0x89,
+	0xf8, 0x22, 0xc4, 0x6b, 0x70, 0xfd, 0x19, 0xa1, 0x2c, 0x00, 0x26, 0x6f, 0x5b, 0xc4, 0xa7, 0x17,
+	0xe0, 0x96, 0xaa, 0x90, 0xed, 0x5a, 0xf8, 0x4d, 0xd7, 0xf1, 0x09, 0xfa, 0x3f, 0x8c, 0xfb, 0x81,
+	0xc0, 0xcf, 0x09, 0x2c, 0x95, 0x87, 0x23, 0xdc, 0x2a, 0xe6, 0x26, 0xd2, 0x21, 0xcc, 0x75, 0xbc,
+	0x11, 0xaf, 0xcd, 0xaa, 0x59, 0xa3, 0xa4, 0x81, 0x36, 0xba, 0x69, 0x64, 0x8a, 0x0f, 0x2e, 0x24,
+	0x27, 0xcc, 0x34, 0x0f, 0x93, 0xd1, 0x0d, 0x8b, 0xec, 0x86, 0xa3, 0x6f, 0xe9, 0x4f, 0x01, 0xb2,
+	0xbd, 0x91, 0xd0, 0x34, 0x88, 0x51, 0x96, 0xa2, 0x6d, 0xa1, 0x79, 0x98, 0x74, 0x3d, 0x8b, 0x78,
+	0x41, 0xee, 0x21, 0x5b, 0x13, 0xec, 0x5b, 0xb3, 0x50, 0x09, 0xd2, 0x36, 0x25, 0x0d, 0x3f, 0x97,
+	0x62, 0x49, 0xae, 0x0e, 0x87, 0xd4, 0x93, 0x0e, 0x0e, 0x6d, 0x03, 0x76, 0x4d, 0x8f, 0xf4, 0x34,
+	0x08, 0x97, 0x28, 0x8c, 0x7c, 0x72, 0xda, 0xb4, 0x3d, 0xe2, 0x07, 0xc7, 0xe9, 0xf0, 0x98, 0x4b,
+	0x14, 0x2a, 0x7d, 0x14, 0x60, 0x36, 0x74, 0x4c, 0x12, 0x77, 0x16, 0x47, 0x2d, 0x0c, 0x40, 0x2d,
+	0x7e, 0x01, 0xea, 0x7b, 0x90, 0xa1, 0xb4, 0xae, 0xfb, 0xc4, 0x74, 0x1d, 0xcb, 0x67, 0xed, 0x9f,
+	0xc6, 0x40, 0x69, 0xbd, 0x16, 0x4a, 0x24, 0x13, 0xe6, 0x92, 0xb8, 0x78, 0x65, 0x6c, 0x43, 0xc6,
+	0xeb, 0xba, 0xe4, 0x97, 0xb9, 0x38, 0x32, 0x06, 0x1c, 0xb7, 0x96, 0x5e, 0xc3, 0x3c, 0x26, 0x75,
+	0x62, 0xf8, 0x24, 0xae, 0xc2, 0x29, 0x78, 0x04, 0xd3, 0x31, 0xdd, 0x2e, 0x11, 0xd7, 0x62, 0x52,
+	0xcd, 0x42, 0x37, 0x61, 0xdc, 0x23, 0x86, 0xef, 0x3a, 0xfc, 0x76, 0xf9, 0x97, 0x74, 0x1b, 0xf2,
+	0xfd, 0x7c, 0x87, 0x69, 0x48, 0x27, 0x80, 0x14, 0xeb, 0xbb, 0x96, 0x9f, 0xec, 0x94, 0xcf, 0xaa,
+	0xd0, 0x39, 0x48, 0x5b, 0xa4, 0x4e, 0x0d, 0x5e, 0x9e, 0xe1, 0x47, 0x0c, 0x56, 0x2a, 0x01, 0x6b,
+	0x17, 0x66, 0x13, 0x81, 0x39, 0xad, 0xff, 0x83, 0x34, 0xeb, 0x1e, 0x1e, 0x7b, 0xa4, 0x7e, 0x0b,
+	0x2d, 0xa4, 0x7d, 0x98, 0x7b, 0x69, 0x50, 0xf3, 0x68, 0xc7, 0x3d, 0x49, 0x24, 0x73, 0x1b, 0xa6,
+	0xe8, 0x91, 0x47, 0xfc, 0x23, 0xb7, 0x1e, 0x52, 0x97, 0xc2, 0x5d, 0x41, 0x50, 0x00, 0xdd, 0x47,
+	0x21, 0xac, 0xa5, 0x29, 0x0c, 0xd1, 0xab, 0xe0, 0x4b, 0x4d, 0xb8, 0xd1, 0xe3, 0xf6, 0x8b, 0xa1,
+	0x26, 0x21, 0x89, 0x3d, 0x90, 0xa4, 0x1f, 0x44, 0x98, 0xd5, 0x3a, 0x53, 0xb3, 0xe4, 0x7a, 0x1e,
+	0x31, 0x59, 0x47, 0x3f, 0x87, 0x71, 0xc3, 0x8c, 0xaa, 0x6d, 0xba, 0x58, 0x1c, 0x18, 0xb1, 0x8f,
+	0xb5, 0xc2, 0xfe, 0xc5, 0xdc, 0x03, 0x52, 0x61, 0xdc, 0x60, 0xf4, 0xb3, 0xf0, 0x99, 0xe2, 0xf2,
+	0x40, 0x5f, 0xe7, 0xcb, 0x63, 0xeb, 0x0a, 0xe6, 0xc6, 0xa8, 0x02, 0x13, 0x5e, 0x58, 0x5c, 0xec,
+	0x7a, 0x33, 0x43, 0x30, 0x0d, 0x2c, 0xf0, 0xad, 0x2b, 0xb8, 0xe3, 0xe4, 0xe9, 0x54, 0xe0, 0x8f,
+	0x49, 0xa5, 0x9f, 0x53, 0x30, 0x17, 0xe5, 0x51, 0xb6, 0x7d, 0xd3, 0x23, 0x4d, 0xc3, 0x31, 0xcf,
+	0x90, 0x0a, 0x63, 0xc7, 0xb6, 0x63, 0x71, 0x12, 0xd6, 0x2f, 0x26, 0x21, 0x66, 0xbc, 0x6d, 0x3b,
+	0x16, 0x66, 0xe6, 0x9d, 0x1a, 0x17, 0x2f, 0x55, 0xe3, 0xe7, 0x7b, 0x31, 0xd5, 0xaf, 0x17, 0xe3,
+	0xaf, 0xd6, 0x58, 0xf2, 0xd5, 0x5a, 0x86, 0x19, 0x72, 0xda, 0x24, 0x26, 0x8d, 0x8f, 0xec, 0x74,
+	0x38, 0xb2, 0x3b, 0x07, 0xd1, 0x7c, 0x7f, 0x0c, 0xd7, 0x0d, 0x93, 0xb6, 0x8c, 0xfa, 0xb9, 0x45,
+	0x20, 0x14, 0x47, 0x8a, 0x3b, 0x00, 0x66, 0x74, 0xd5, 0x6c, 0x03, 0xc8, 0x14, 0x57, 0x2e, 0x53,
+	0x1e, 0x38, 0x66, 0x1f, 0xf4, 0xac, 0x45, 0xa8, 0x61, 0xd7, 0x73, 0x93, 0x61, 0xcf, 0x86, 0x5f,
+	0x12, 0x81, 0x1b, 0x4a, 0xcb, 0xb2, 0x69, 0x64, 0xdf, 0x69, 0xb1, 0x9e, 0x26, 0x12, 0x7a, 0x9b,
+	0x28, 0xc8, 0xba, 0x61, 0x9c, 0xea, 0x56, 0x74, 0x13, 0x36, 0xf1, 0x19, 0xf5, 0x69, 0x9c, 0x6d,
+	0x18, 0xa7, 0xe5, 0xb8, 0x5c, 0xfa, 0x28, 0xc2, 0xcd, 0xde, 0x38, 0xbc, 0xe7, 0x6a, 0x70, 0x2d,
+	0xe9, 0x43, 0xb8, 0xe0, 0xed, 0xef, 0x57, 0x04, 0x38, 0xe9, 0x03, 0x15, 0x60, 0xd6, 0x3c, 0x22,
+	0xe6, 0x31, 0xb1, 0x74, 0xd6, 0x9e, 0xba, 0xe9, 0xb6, 0x1c, 0xca, 0xfb, 0x72, 0x86, 0x1f, 0xb1,
+	0x3a, 0x28, 0x05, 0x07, 0x68, 0x13, 0xe6, 0x3b, 0xfa, 0xf1, 0x62, 0x08, 0xad, 0xc2, 0x05, 0xf2,
+	0x16, 0x57, 0x88, 0x55, 0x7b, 0x68, 0x1b, 0x74, 0xbe, 0xd7, 0x72, 0xcc, 0x60, 0x2a, 0xb2, 0xd2,
+	0x98, 0xc4, 0x5d, 0x41, 0x30, 0x24, 0x8d, 0x20, 0xf1, 0x70, 0x86, 0xf2, 0x21, 0xc9, 0x25, 0x0a,
+	0x5d, 0xfa, 0x24, 0x42, 0x6e, 0x50, 0x55, 0xa3, 0x25, 0xf8, 0xaf, 0x56, 0x39, 0x50, 0x2b, 0x7b,
+	0x55, 0xfc, 0x4a, 0x2f, 0x6b, 0xb5, 0x12, 0x56, 0x77, 0x95, 0x4a, 0xe9, 0x95, 0xbe, 0xad, 0x55,
+	0xca, 0xfa, 0x7e, 0xa5, 0xb6, 0xab, 0x96, 0xb4, 0xaf, 0x35, 0xb5, 0x9c, 0xbd, 0x82, 0x1e, 0xc3,
+	0xc3, 0x21, 0xba, 0xd5, 0x03, 0x15, 0xd7, 0xaa, 0x3b, 0xe5, 0xac, 0x80, 0xd6, 0x60, 0x65, 0x88,
+	0x22, 0x56, 0x6b, 0x2a, 0x3e, 0x50, 0xcb, 0xfa, 0x0b, 0xad, 0xf6, 0x42, 0xd9, 0x2b, 0x6d, 0x65,
+	0x45, 0x54, 0x84, 0xc2, 0x10, 0x0b, 0xf5, 0xdb, 0x5d, 0x0d, 0xab, 0x1d, 0x4b, 0x65, 0x4f, 0xab,
+	0x56, 0xb2, 0x29, 0xb4, 0x01, 0xf2, 0x30, 0x38, 0x78, 0x77, 0x4b, 0xa9, 0xf4, 0x18, 0x8d, 0x21,
+	0x19, 0x96, 0x87, 0x18, 0x55, 0xd4, 0x67, 0xca, 0x9e, 0x76, 0xa0, 0xea, 0xd5, 0x8a, 0xbe, 0xa5,
+	0x54, 0xca, 0xd9, 0xf4, 0xd2, 0x8f, 0x22, 0xcc, 0x0f, 0x7c, 0x18, 0xd1, 0x32, 0x3c, 0xee, 0xba,
+	0x2b, 0x55, 0x31, 0x56, 0x4b, 0x41, 0x20, 0x5d, 0x09, 0xff, 0x4b, 0xf2, 0xb7, 0x02, 0x0b, 0xc3,
+	0x94, 0x95, 0xf2, 0xf3, 0xfd, 0xda, 0x9e, 0x5e, 0xdb, 0xab, 0x96, 0xb6, 0xb3, 0x42, 0x32, 0xbd,
+	0xf3, 0xda, 0x58, 0xdd, 0x51, 0x95, 0x9a, 0x9a, 0x48, 0x4f, 0x4c, 0x32, 0xdf, 0xcf, 0xa8, 0x54,
+	0xdd, 0xaf, 0xec, 0x45, 0x57, 0x90, 0x4d, 0xa1, 0x55, 0x58, 0x1c, 0x66, 0xf1, 0x42, 0xa9, 0xec,
+	0x2b, 0x3b, 0x3a, 0x56, 0x0f, 0x34, 0xf5, 0x65, 0x76, 0xac, 0xf8, 0x69, 0x02, 0xb2, 0x11, 0x1d,
+	0x35, 0xe2, 0xb5, 0x6d, 0x93, 0xa0, 0xdf, 0x04, 0xb8, 0x96, 0x98, 0x76, 0x68, 0x70, 0x6b, 0xf5,
+	0x1b, 0xb6, 0xf9, 0xc2, 0xa8, 0xea, 0x7c, 0xff, 0x78, 0xf4, 0xfd, 0x5f, 0x7f, 0xff, 0x2a, 0xde,
+	0x43, 0x77, 0xe4, 0xf6, 0xba, 0x1c, 0xfd, 0xa9, 0x28, 0xd7, 0xdd, 0x93, 0x55, 0xd6, 0x8b, 0xf2,
+	0x49, 0x60, 0xb6, 0x26, 0xa0, 0x0f, 0x02, 0x4c, 0x76, 0xd6, 0x73, 0xb4, 0x30, 0x30, 0x4a, 0xcf,
+	0xce, 0x9f, 0x5f, 0x1c, 0x41, 0x93, 0x43, 0x59, 0x67, 0x50, 0x96, 0xd1, 0x62, 0x12, 0x0a, 0x7f,
+	0xc5, 0x7c, 0xf9, 0x5d, 0xf7, 0x89, 0x7b, 0x2f, 0x87, 0x73, 0xfc, 0x83, 0x00, 0x57, 0xe3, 0xdb,
+	0x21, 0x5a, 0x19, 0x32, 0xfe, 0xce, 0x2d, 0xb7, 0xf9, 0xd5, 0x11, 0xb5, 0x93, 0x5c, 0x6d, 0x0a,
+	0x4b, 0x52, 0x3e, 0x89, 0x31, 0xf6, 0x0c, 0xf9, 0xe8, 0x0f, 0x01, 0xd0, 0xf9, 0x61, 0x8b, 0x3e,
+	0x63, 0x32, 0xe7, 0x37, 0x2e, 0x65, 0xc3, 0x61, 0x7e, 0xc5, 0x60, 0x3e, 0x09, 0x60, 0x6e, 0x0c,
+	0x86, 0x29, 0xbf, 0x4b, 0x0e, 0xd2, 0xf7, 0x32, 0xdf, 0x01, 0xd0, 0x4f, 0x02, 0x64, 0x62, 0x4b,
+	0x07, 0xba, 0xcc, 0x6a, 0x92, 0x5f, 0x19, 0x4d, 0x99, 0x43, 0xfd, 0x0f, 0x83, 0x7a, 0x37, 0x80,
+	0x3a, 0x9f, 0x84, 0x1a, 0xee, 0x37, 0x0d, 0xe2, 0x50, 0x1f, 0xfd, 0x22, 0xc0, 0x74, 0x72, 0x1e,
+	0xa1, 0xc1, 0x65, 0xde, 0x77, 0x40, 0xe6, 0xe5, 0x91, 0xf5, 0x39, 0xb2, 0xbb, 0x0c, 0x59, 0x2e,
+	0x40, 0x36, 0xdb, 0x83, 0x2c, 0x30, 0x78, 0xfa, 0xf0, 0xf5, 0x83, 0x43, 0x9b, 0x1e, 0xb5, 0xde,
+	0x14, 0x4c, 0xb7, 0x21, 0x87, 0x9e, 0x57, 0x03, 0xcf, 0x32, 0xf3, 0xec, 0xcb, 0x87, 0xc4, 0x79,
+	0x33, 0xce, 0x7e, 0x6f, 0xfc, 0x3b, 0x00, 0x8e, 0xed, 0xf5, 0x53, 0x84, 0x11, 0x00, 0x00,
+}
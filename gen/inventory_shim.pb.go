@@ -0,0 +1,100 @@
+// Code generated by protoc-gen-go-shim. DO NOT EDIT.
+// source: inventory.proto
+
+package gen
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	iter "iter"
+)
+
+// InventoryServiceAPI is InventoryServiceClient without per-call options, so it can be
+// mocked with plain method signatures. Streams are exposed as iterators.
+type InventoryServiceAPI interface {
+	// 재고가 threshold 이하로 떨어진 상품을 실시간으로 전달 (운영 알림용)
+	WatchLowStock(ctx context.Context, in *WatchLowStockRequest) iter.Seq2[*WatchLowStockResponse, error]
+	// 상품의 옵션 조합별 재고 조회 (주문 전 재고 확인용)
+	GetStock(ctx context.Context, in *GetStockRequest) (*GetStockResponse, error)
+	// 주문/결제 진행 중 재고 선점 (전체 항목 성공 또는 전체 실패)
+	// 재고 부족 시 FAILED_PRECONDITION + ERROR_REASON_OUT_OF_STOCK
+	ReserveStock(ctx context.Context, in *ReserveStockRequest) (*ReserveStockResponse, error)
+	// 선점 해제 (결제 실패/취소), 이미 해제·만료된 선점은 성공으로 처리
+	ReleaseReservation(ctx context.Context, in *ReleaseReservationRequest) (*ReleaseReservationResponse, error)
+	// 입고/실사 등으로 보유 재고 수량 조정 (운영자용)
+	AdjustStock(ctx context.Context, in *AdjustStockRequest) (*AdjustStockResponse, error)
+	// 선점·주문·재고 수량 간 불일치(초과 판매, 미해제 선점 등)와 보정 방법 조회 (야간 정합성 점검용)
+	// 조회만 하며, 보정은 제안된 AdjustStock/ReleaseReservation 요청을 검토 후 실행
+	AuditInventory(ctx context.Context, in *AuditInventoryRequest) (*AuditInventoryResponse, error)
+}
+
+// NewInventoryServiceAPI adapts c to InventoryServiceAPI, passing opts to every call.
+func NewInventoryServiceAPI(c InventoryServiceClient, opts ...grpc.CallOption) InventoryServiceAPI {
+	return &inventoryServiceAPI{c: c, opts: opts}
+}
+
+type inventoryServiceAPI struct {
+	c    InventoryServiceClient
+	opts []grpc.CallOption
+}
+
+func (a *inventoryServiceAPI) WatchLowStock(ctx context.Context, in *WatchLowStockRequest) iter.Seq2[*WatchLowStockResponse, error] {
+	return serverStreamSeq(ctx, func(ctx context.Context) (grpc.ServerStreamingClient[WatchLowStockResponse], error) {
+		return a.c.WatchLowStock(ctx, in, a.opts...)
+	})
+}
+
+func (a *inventoryServiceAPI) GetStock(ctx context.Context, in *GetStockRequest) (*GetStockResponse, error) {
+	return a.c.GetStock(ctx, in, a.opts...)
+}
+
+func (a *inventoryServiceAPI) ReserveStock(ctx context.Context, in *ReserveStockRequest) (*ReserveStockResponse, error) {
+	return a.c.ReserveStock(ctx, in, a.opts...)
+}
+
+func (a *inventoryServiceAPI) ReleaseReservation(ctx context.Context, in *ReleaseReservationRequest) (*ReleaseReservationResponse, error) {
+	return a.c.ReleaseReservation(ctx, in, a.opts...)
+}
+
+func (a *inventoryServiceAPI) AdjustStock(ctx context.Context, in *AdjustStockRequest) (*AdjustStockResponse, error) {
+	return a.c.AdjustStock(ctx, in, a.opts...)
+}
+
+func (a *inventoryServiceAPI) AuditInventory(ctx context.Context, in *AuditInventoryRequest) (*AuditInventoryResponse, error) {
+	return a.c.AuditInventory(ctx, in, a.opts...)
+}
+
+// InventoryServiceClientFromAPI adapts a to InventoryServiceClient, e.g. to hand a
+// mock InventoryServiceAPI to code that takes the generated client. Call options
+// are ignored, and streams report empty headers and trailers.
+func InventoryServiceClientFromAPI(a InventoryServiceAPI) InventoryServiceClient {
+	return inventoryServiceAPIClient{api: a}
+}
+
+type inventoryServiceAPIClient struct {
+	api InventoryServiceAPI
+}
+
+func (c inventoryServiceAPIClient) WatchLowStock(ctx context.Context, in *WatchLowStockRequest, _ ...grpc.CallOption) (grpc.ServerStreamingClient[WatchLowStockResponse], error) {
+	return newSeqServerStream(ctx, c.api.WatchLowStock(ctx, in)), nil
+}
+
+func (c inventoryServiceAPIClient) GetStock(ctx context.Context, in *GetStockRequest, _ ...grpc.CallOption) (*GetStockResponse, error) {
+	return c.api.GetStock(ctx, in)
+}
+
+func (c inventoryServiceAPIClient) ReserveStock(ctx context.Context, in *ReserveStockRequest, _ ...grpc.CallOption) (*ReserveStockResponse, error) {
+	return c.api.ReserveStock(ctx, in)
+}
+
+func (c inventoryServiceAPIClient) ReleaseReservation(ctx context.Context, in *ReleaseReservationRequest, _ ...grpc.CallOption) (*ReleaseReservationResponse, error) {
+	return c.api.ReleaseReservation(ctx, in)
+}
+
+func (c inventoryServiceAPIClient) AdjustStock(ctx context.Context, in *AdjustStockRequest, _ ...grpc.CallOption) (*AdjustStockResponse, error) {
+	return c.api.AdjustStock(ctx, in)
+}
+
+func (c inventoryServiceAPIClient) AuditInventory(ctx context.Context, in *AuditInventoryRequest, _ ...grpc.CallOption) (*AuditInventoryResponse, error) {
+	return c.api.AuditInventory(ctx, in)
+}
@@ -0,0 +1,117 @@
+package gen
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+)
+
+// AuditStock compares stock levels against reservations, as AuditInventory
+// does, and returns the discrepancies with suggested corrections.
+// orderStatus looks up the order of a reservation; ok is false for an order
+// that does not exist. With a nil orderStatus, orphaned reservations are not
+// detected.
+//
+// A reservation is stale if it expired before now or its order is missing,
+// cancelled or refunded; stale reservations are reported with a release
+// request and left out of the reserved totals. Each stock level is then
+// checked for a negative on-hand count, a reserved_quantity that differs from
+// its active reservations, and active reservations exceeding the on-hand
+// count (oversold). Reservations for stock not in stocks are ignored, so
+// callers can audit a subset of products.
+func AuditStock(stocks []*StockLevel, reservations []*StockReservation, orderStatus func(orderID string) (OrderStatus, bool), now time.Time) []*InventoryDiscrepancy {
+	var out []*InventoryDiscrepancy
+	reserved := make(map[string]int64)
+	for _, r := range reservations {
+		if d := staleReservation(r, orderStatus, now); d != nil {
+			out = append(out, d)
+			continue
+		}
+		for _, it := range r.GetItems() {
+			reserved[stockKeyString(it.GetKey().GetProductId(), it.GetKey().GetOptions())] += it.GetQuantity()
+		}
+	}
+	for _, s := range stocks {
+		key := &StockKey{ProductId: s.GetProductId(), Options: s.GetOptions()}
+		active := reserved[stockKeyString(s.GetProductId(), s.GetOptions())]
+		onHand := s.GetOnHandQuantity()
+		if onHand < 0 {
+			out = append(out, &InventoryDiscrepancy{
+				Kind:             InventoryDiscrepancyKind_INVENTORY_DISCREPANCY_KIND_NEGATIVE_ON_HAND,
+				Key:              key,
+				ExpectedQuantity: 0,
+				ActualQuantity:   onHand,
+				Correction: &InventoryCorrection{
+					Action:  InventoryCorrectionAction_INVENTORY_CORRECTION_ACTION_ADJUST_STOCK,
+					Request: &InventoryCorrection_Adjust{Adjust: &AdjustStockRequest{Key: key, Delta: -onHand, Reason: "stocktake"}},
+				},
+				Detail: "on-hand quantity is negative; verify with a stocktake",
+			})
+		}
+		if s.GetReservedQuantity() != active {
+			out = append(out, &InventoryDiscrepancy{
+				Kind:             InventoryDiscrepancyKind_INVENTORY_DISCREPANCY_KIND_RESERVED_MISMATCH,
+				Key:              key,
+				ExpectedQuantity: active,
+				ActualQuantity:   s.GetReservedQuantity(),
+				Correction:       &InventoryCorrection{Action: InventoryCorrectionAction_INVENTORY_CORRECTION_ACTION_RECOUNT_RESERVED},
+				Detail:           "reserved quantity differs from the sum of active reservations",
+			})
+		}
+		if active > max(onHand, 0) {
+			out = append(out, &InventoryDiscrepancy{
+				Kind:             InventoryDiscrepancyKind_INVENTORY_DISCREPANCY_KIND_OVERSOLD,
+				Key:              key,
+				ExpectedQuantity: active,
+				ActualQuantity:   onHand,
+				Correction:       &InventoryCorrection{Action: InventoryCorrectionAction_INVENTORY_CORRECTION_ACTION_MANUAL_REVIEW},
+				Detail:           fmt.Sprintf("%d reserved but %d on hand; restock or cancel orders", active, onHand),
+			})
+		}
+	}
+	return out
+}
+
+// staleReservation returns the discrepancy for an expired or orphaned
+// reservation, or nil if r is active.
+func staleReservation(r *StockReservation, orderStatus func(string) (OrderStatus, bool), now time.Time) *InventoryDiscrepancy {
+	d := &InventoryDiscrepancy{ReservationId: r.GetId(), OrderId: r.GetOrderId()}
+	var reason string
+	if expires, err := time.Parse(time.RFC3339, r.GetExpiresAt()); err == nil && expires.Before(now) {
+		d.Kind, reason = InventoryDiscrepancyKind_INVENTORY_DISCREPANCY_KIND_EXPIRED_RESERVATION, "expired"
+		d.Detail = "reservation expired at " + r.GetExpiresAt() + " but was not released"
+	} else if orderStatus == nil {
+		return nil
+	} else if status, ok := orderStatus(r.GetOrderId()); !ok {
+		d.Kind, reason = InventoryDiscrepancyKind_INVENTORY_DISCREPANCY_KIND_ORPHANED_RESERVATION, "order_missing"
+		d.Detail = "order " + r.GetOrderId() + " does not exist"
+	} else if status == OrderStatus_ORDER_STATUS_CANCELLED || status == OrderStatus_ORDER_STATUS_REFUNDED {
+		d.Kind, reason = InventoryDiscrepancyKind_INVENTORY_DISCREPANCY_KIND_ORPHANED_RESERVATION, "order_cancelled"
+		d.Detail = "order " + r.GetOrderId() + " is " + status.Legacy()
+	} else {
+		return nil
+	}
+	for _, it := range r.GetItems() {
+		d.ActualQuantity += it.GetQuantity() // expected is zero: nothing should remain reserved
+	}
+	if items := r.GetItems(); len(items) == 1 {
+		d.Key = items[0].GetKey()
+	}
+	d.Correction = &InventoryCorrection{
+		Action:  InventoryCorrectionAction_INVENTORY_CORRECTION_ACTION_RELEASE_RESERVATION,
+		Request: &InventoryCorrection_Release{Release: &ReleaseReservationRequest{ReservationId: r.GetId(), Reason: reason}},
+	}
+	return d
+}
+
+// stockKeyString identifies a stock unit by product and option combination.
+func stockKeyString(productID string, options map[string]string) string {
+	var b strings.Builder
+	b.WriteString(productID)
+	for _, name := range slices.Sorted(maps.Keys(options)) {
+		fmt.Fprintf(&b, "\x00%s=%s", name, options[name])
+	}
+	return b.String()
+}
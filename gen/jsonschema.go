@@ -0,0 +1,31 @@
+package gen
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+)
+
+// jsonSchemas holds the schemas generated by protoc-gen-jsonschema.
+//
+//go:embed jsonschema/*.schema.json
+var jsonSchemas embed.FS
+
+// JSONSchema returns the JSON Schema (draft 2020-12) of the named message,
+// e.g. JSONSchema("InsertOrderRequest"). Nested messages are named with dots
+// ("Outer.Inner"). Schemas follow the gateway's JSON encoding, so non-Go
+// consumers and form builders can validate payloads before sending them.
+func JSONSchema(message string) ([]byte, error) {
+	data, err := jsonSchemas.ReadFile("jsonschema/" + message + ".schema.json")
+	if err != nil {
+		return nil, fmt.Errorf("no JSON schema for message %q", message)
+	}
+	return data, nil
+}
+
+// JSONSchemaFS returns all schemas as <Message>.schema.json files, e.g. to
+// publish them with http.FileServerFS.
+func JSONSchemaFS() fs.FS {
+	sub, _ := fs.Sub(jsonSchemas, "jsonschema")
+	return sub
+}
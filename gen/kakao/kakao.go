@@ -0,0 +1,219 @@
+// Package kakao is an HTTP client for Kakao Login, shared by AccountService
+// implementations so they do not each re-implement the authorize URL, the
+// code-for-token exchange and the user-info call. Client satisfies
+// scaffold.KakaoClient:
+//
+//	kc := kakao.NewClient(kakao.Config{
+//	    ClientID:    restAPIKey,
+//	    RedirectURI: "https://escape-ship.example/oauth/kakao/callback",
+//	})
+//	srv := scaffold.NewAccountServer(scaffold.AccountDeps{Users: users, Tokens: issuer, Kakao: kc, Logins: limiter})
+//
+// Requests are retried on 429 and 503 responses, honouring Retry-After, and
+// reads additionally on network errors and other 5xx responses. Authorization
+// codes are single-use, so a code exchange is never resent after Kakao may
+// have processed it.
+//
+// Failed calls return an *Error, which carries a gRPC status (see
+// Error.GRPCStatus) so handlers can return it unchanged.
+package kakao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Default endpoints of Kakao Login.
+const (
+	DefaultAuthURL = "https://kauth.kakao.com"
+	DefaultAPIURL  = "https://kapi.kakao.com"
+)
+
+// Config configures a Client. ClientID and RedirectURI are required.
+type Config struct {
+	// ClientID is the app's REST API key.
+	ClientID string
+	// ClientSecret is sent with token requests if the app has client secret
+	// enabled.
+	ClientSecret string
+	// RedirectURI is the registered callback URL, e.g. the gateway's
+	// /oauth/kakao/callback page.
+	RedirectURI string
+	// Scopes are requested consent items, e.g. "account_email". Empty means
+	// the app's default consent items.
+	Scopes []string
+
+	// AuthURL and APIURL override DefaultAuthURL and DefaultAPIURL, e.g. to
+	// point at a fake in tests.
+	AuthURL string
+	APIURL  string
+	// HTTPClient sends the requests; nil means http.DefaultClient.
+	HTTPClient *http.Client
+	// MaxAttempts is the total number of attempts per call including the
+	// first; zero means 3.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry, doubling with each
+	// further retry; zero means 200ms.
+	InitialBackoff time.Duration
+	// ClockSkew is how long before their expiry cached access tokens are
+	// refreshed, covering request latency and clock drift between servers;
+	// zero means one minute.
+	ClockSkew time.Duration
+	// Now returns the current time. Nil means time.Now.
+	Now func() time.Time
+}
+
+func (c Config) withDefaults() Config {
+	if c.AuthURL == "" {
+		c.AuthURL = DefaultAuthURL
+	}
+	if c.APIURL == "" {
+		c.APIURL = DefaultAPIURL
+	}
+	if c.HTTPClient == nil {
+		c.HTTPClient = http.DefaultClient
+	}
+	if c.MaxAttempts == 0 {
+		c.MaxAttempts = 3
+	}
+	if c.InitialBackoff == 0 {
+		c.InitialBackoff = 200 * time.Millisecond
+	}
+	if c.ClockSkew == 0 {
+		c.ClockSkew = time.Minute
+	}
+	if c.Now == nil {
+		c.Now = time.Now
+	}
+	return c
+}
+
+// Error is a failed Kakao call. Network errors are wrapped as is.
+type Error struct {
+	// StatusCode is the HTTP status of the response.
+	StatusCode int
+	// Code is the OAuth error (e.g. "invalid_grant") or, for API calls, the
+	// Kakao error code (e.g. "-401").
+	Code string
+	// Message is Kakao's description of the error.
+	Message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("kakao: %d %s: %s", e.StatusCode, e.Code, e.Message)
+}
+
+// GRPCStatus maps the error for status.FromError: an invalid or expired
+// authorization code is InvalidArgument, rejected tokens Unauthenticated,
+// throttling ResourceExhausted and Kakao outages Unavailable. Anything else
+// points at a misconfigured app and is Internal.
+func (e *Error) GRPCStatus() *status.Status {
+	c := codes.Internal
+	switch {
+	case e.Code == "invalid_grant":
+		c = codes.InvalidArgument
+	case e.StatusCode == http.StatusUnauthorized:
+		c = codes.Unauthenticated
+	case e.StatusCode == http.StatusForbidden:
+		c = codes.PermissionDenied
+	case e.StatusCode == http.StatusTooManyRequests:
+		c = codes.ResourceExhausted
+	case e.StatusCode >= 500:
+		c = codes.Unavailable
+	}
+	return status.New(c, e.Error())
+}
+
+// maxResponseBytes caps how much of a response body is read.
+const maxResponseBytes = 1 << 20
+
+// do sends the request built by newReq and decodes a 2xx JSON response into
+// out. Requests that are not idempotent are only retried when Kakao reports
+// that it did not process them (429, 503).
+func (c *Client) do(ctx context.Context, idempotent bool, newReq func(context.Context) (*http.Request, error), out any) error {
+	backoff := c.cfg.InitialBackoff
+	for attempt := 1; ; attempt++ {
+		req, err := newReq(ctx)
+		if err != nil {
+			return err
+		}
+		var wait time.Duration
+		retry := false
+		resp, err := c.cfg.HTTPClient.Do(req)
+		if err != nil {
+			if ctx.Err() != nil {
+				return status.FromContextError(ctx.Err()).Err()
+			}
+			err = fmt.Errorf("kakao: %w", err)
+			retry = idempotent
+		} else {
+			body, rerr := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes))
+			resp.Body.Close()
+			switch {
+			case rerr != nil:
+				err = fmt.Errorf("kakao: reading response: %w", rerr)
+				retry = idempotent
+			case resp.StatusCode/100 == 2:
+				if err := json.Unmarshal(body, out); err != nil {
+					return fmt.Errorf("kakao: decoding response: %w", err)
+				}
+				return nil
+			default:
+				err = parseError(resp.StatusCode, body)
+				retry = resp.StatusCode == http.StatusTooManyRequests ||
+					resp.StatusCode == http.StatusServiceUnavailable ||
+					(idempotent && resp.StatusCode >= 500)
+				wait = retryAfter(resp.Header)
+			}
+		}
+		if !retry || attempt >= c.cfg.MaxAttempts {
+			return err
+		}
+		if wait == 0 {
+			wait = backoff
+			backoff *= 2
+		}
+		t := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return err
+		case <-t.C:
+		}
+	}
+}
+
+// parseError decodes an OAuth ({"error", "error_description"}) or API
+// ({"code", "msg"}) error body.
+func parseError(statusCode int, body []byte) error {
+	var e struct {
+		Error       string          `json:"error"`
+		Description string          `json:"error_description"`
+		Code        json.RawMessage `json:"code"`
+		Msg         string          `json:"msg"`
+	}
+	if json.Unmarshal(body, &e) != nil {
+		return &Error{StatusCode: statusCode, Message: http.StatusText(statusCode)}
+	}
+	if e.Error != "" {
+		return &Error{StatusCode: statusCode, Code: e.Error, Message: e.Description}
+	}
+	return &Error{StatusCode: statusCode, Code: string(e.Code), Message: e.Msg}
+}
+
+// retryAfter returns the delay of a Retry-After header in seconds, or zero.
+func retryAfter(h http.Header) time.Duration {
+	n, err := strconv.Atoi(h.Get("Retry-After"))
+	if err != nil || n <= 0 {
+		return 0
+	}
+	return time.Duration(n) * time.Second
+}
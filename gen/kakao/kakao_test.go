@@ -0,0 +1,280 @@
+package kakao
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// testNow is the clock of clients made by newFakeKakao.
+var testNow = time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+type response struct {
+	status int
+	body   string
+}
+
+// fakeKakao serves the token and user-info endpoints from per-path
+// responses, in order and repeating the last one, and records the requests.
+type fakeKakao struct {
+	t         *testing.T
+	mu        sync.Mutex
+	responses map[string][]response
+	calls     map[string]int
+	forms     []url.Values // token request forms
+	bearer    string       // last user-info Authorization header
+}
+
+func newFakeKakao(t *testing.T, responses map[string][]response) (*Client, *fakeKakao) {
+	t.Helper()
+	f := &fakeKakao{t: t, responses: responses, calls: make(map[string]int)}
+	srv := httptest.NewServer(f)
+	t.Cleanup(srv.Close)
+	return NewClient(Config{
+		ClientID:       "rest-key",
+		ClientSecret:   "secret",
+		RedirectURI:    "https://escape-ship.example/oauth/kakao/callback",
+		AuthURL:        srv.URL,
+		APIURL:         srv.URL,
+		InitialBackoff: time.Millisecond,
+		Now:            func() time.Time { return testNow },
+	}), f
+}
+
+func (f *fakeKakao) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls[r.URL.Path]++
+	switch r.URL.Path {
+	case "/oauth/token":
+		if err := r.ParseForm(); err != nil {
+			f.t.Errorf("parsing token form: %v", err)
+		}
+		f.forms = append(f.forms, r.PostForm)
+	case "/v2/user/me":
+		f.bearer = r.Header.Get("Authorization")
+	}
+	rs := f.responses[r.URL.Path]
+	if len(rs) == 0 {
+		http.NotFound(w, r)
+		return
+	}
+	resp := rs[min(f.calls[r.URL.Path], len(rs))-1]
+	w.WriteHeader(resp.status)
+	w.Write([]byte(resp.body))
+}
+
+func (f *fakeKakao) count(path string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.calls[path]
+}
+
+const tokenBody = `{"token_type": "bearer", "access_token": "at", "refresh_token": "rt", "expires_in": 21599,
+	"refresh_token_expires_in": 5183999, "scope": "account_email profile_nickname"}`
+
+func TestExchangeCode(t *testing.T) {
+	tests := []struct {
+		name         string
+		responses    []response
+		wantAttempts int
+		wantCode     codes.Code
+		wantErrCode  string
+	}{
+		{name: "success", responses: []response{{200, tokenBody}}, wantAttempts: 1},
+		{name: "throttled then success", responses: []response{{429, `{}`}, {200, tokenBody}}, wantAttempts: 2},
+		{
+			name:         "used code",
+			responses:    []response{{400, `{"error": "invalid_grant", "error_description": "authorization code not found for code=abc"}`}},
+			wantAttempts: 1,
+			wantCode:     codes.InvalidArgument,
+			wantErrCode:  "invalid_grant",
+		},
+		{
+			name:         "wrong client secret",
+			responses:    []response{{401, `{"error": "invalid_client", "error_description": "Bad client credentials"}`}},
+			wantAttempts: 1,
+			wantCode:     codes.Unauthenticated,
+			wantErrCode:  "invalid_client",
+		},
+		{
+			// Kakao may have used the code, so it is not sent again.
+			name:         "server error not retried",
+			responses:    []response{{500, `{}`}, {200, tokenBody}},
+			wantAttempts: 1,
+			wantCode:     codes.Unavailable,
+		},
+		{name: "unavailable every time", responses: []response{{503, `{}`}}, wantAttempts: 3, wantCode: codes.Unavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, f := newFakeKakao(t, map[string][]response{"/oauth/token": tt.responses})
+			tok, err := c.ExchangeCode(context.Background(), "abc")
+			if got := status.Code(err); got != tt.wantCode {
+				t.Fatalf("ExchangeCode() error = %v, want code %v", err, tt.wantCode)
+			}
+			if n := f.count("/oauth/token"); n != tt.wantAttempts {
+				t.Errorf("%d attempts, want %d", n, tt.wantAttempts)
+			}
+			want := url.Values{
+				"grant_type":    {"authorization_code"},
+				"code":          {"abc"},
+				"redirect_uri":  {"https://escape-ship.example/oauth/kakao/callback"},
+				"client_id":     {"rest-key"},
+				"client_secret": {"secret"},
+			}
+			for k, v := range want {
+				if got := f.forms[0][k]; !slices.Equal(got, v) {
+					t.Errorf("form %s = %v, want %v", k, got, v)
+				}
+			}
+			if err != nil {
+				var kerr *Error
+				if !errors.As(err, &kerr) || kerr.Code != tt.wantErrCode {
+					t.Errorf("ExchangeCode() error = %#v, want *Error with code %q", err, tt.wantErrCode)
+				}
+				return
+			}
+			if tok.AccessToken != "at" || tok.RefreshToken != "rt" ||
+				!slices.Equal(tok.Scopes, []string{"account_email", "profile_nickname"}) ||
+				!tok.Expiry.Equal(testNow.Add(21599*time.Second)) || !tok.RefreshExpiry.Equal(testNow.Add(5183999*time.Second)) {
+				t.Errorf("ExchangeCode() = %+v", tok)
+			}
+		})
+	}
+}
+
+func TestUserInfoRetries(t *testing.T) {
+	c, f := newFakeKakao(t, map[string][]response{"/v2/user/me": {
+		{502, `<html>bad gateway</html>`},
+		{200, `{"id": 42, "kakao_account": {"email": "a@example.com", "profile": {"nickname": "kim"}}}`},
+	}})
+	u, err := c.UserInfo(context.Background(), "at")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *u != (User{ID: 42, Email: "a@example.com", Nickname: "kim"}) {
+		t.Errorf("UserInfo() = %+v", u)
+	}
+	if n := f.count("/v2/user/me"); n != 2 {
+		t.Errorf("%d attempts, want 2", n)
+	}
+	if f.bearer != "Bearer at" {
+		t.Errorf("Authorization = %q", f.bearer)
+	}
+}
+
+func TestErrorGRPCStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		body     string
+		want     Error
+		wantCode codes.Code
+	}{
+		{
+			name:     "invalid code",
+			status:   400,
+			body:     `{"error": "invalid_grant", "error_description": "authorization code not found"}`,
+			want:     Error{StatusCode: 400, Code: "invalid_grant", Message: "authorization code not found"},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "misconfigured app",
+			status:   400,
+			body:     `{"error": "invalid_request", "error_description": "redirect_uri mismatch"}`,
+			want:     Error{StatusCode: 400, Code: "invalid_request", Message: "redirect_uri mismatch"},
+			wantCode: codes.Internal,
+		},
+		{
+			name:     "expired access token",
+			status:   401,
+			body:     `{"code": -401, "msg": "this access token is already expired"}`,
+			want:     Error{StatusCode: 401, Code: "-401", Message: "this access token is already expired"},
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "missing consent",
+			status:   403,
+			body:     `{"code": -402, "msg": "insufficient scopes"}`,
+			want:     Error{StatusCode: 403, Code: "-402", Message: "insufficient scopes"},
+			wantCode: codes.PermissionDenied,
+		},
+		{name: "throttled", status: 429, body: `{}`, want: Error{StatusCode: 429}, wantCode: codes.ResourceExhausted},
+		{
+			name:     "outage with html body",
+			status:   502,
+			body:     `<html>bad gateway</html>`,
+			want:     Error{StatusCode: 502, Message: "Bad Gateway"},
+			wantCode: codes.Unavailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := parseError(tt.status, []byte(tt.body))
+			var kerr *Error
+			if !errors.As(err, &kerr) || *kerr != tt.want {
+				t.Errorf("parseError() = %#v, want %+v", err, tt.want)
+			}
+			if got := status.Code(err); got != tt.wantCode {
+				t.Errorf("status code = %v, want %v", got, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestAccessToken(t *testing.T) {
+	cached := func(expiry, refreshExpiry time.Duration) *Token {
+		return &Token{AccessToken: "old", RefreshToken: "rt-old", Expiry: testNow.Add(expiry), RefreshExpiry: testNow.Add(refreshExpiry)}
+	}
+	tests := []struct {
+		name        string
+		cached      *Token
+		refresh     []response
+		want        string
+		wantErr     error
+		wantRefresh int
+	}{
+		{name: "not cached", wantErr: ErrNoToken},
+		{name: "valid", cached: cached(time.Hour, 24*time.Hour), want: "old"},
+		{name: "about to expire", cached: cached(30*time.Second, 24*time.Hour), refresh: []response{{200, tokenBody}}, want: "at", wantRefresh: 1},
+		{name: "refresh token expired", cached: cached(-time.Hour, 30*time.Second), wantErr: ErrNoToken},
+		{
+			name:        "revoked",
+			cached:      cached(-time.Hour, 24*time.Hour),
+			refresh:     []response{{400, `{"error": "invalid_grant", "error_description": "refresh token not found"}`}},
+			wantErr:     ErrNoToken,
+			wantRefresh: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, f := newFakeKakao(t, map[string][]response{"/oauth/token": tt.refresh})
+			if tt.cached != nil {
+				c.tokens[42] = tt.cached
+			}
+			got, err := c.AccessToken(context.Background(), 42)
+			if got != tt.want || !errors.Is(err, tt.wantErr) {
+				t.Fatalf("AccessToken() = %q, %v; want %q, %v", got, err, tt.want, tt.wantErr)
+			}
+			if n := f.count("/oauth/token"); n != tt.wantRefresh {
+				t.Errorf("%d refreshes, want %d", n, tt.wantRefresh)
+			}
+			if tt.wantRefresh > 0 && f.forms[0].Get("refresh_token") != "rt-old" {
+				t.Errorf("refresh form = %v", f.forms[0])
+			}
+			if _, cached := c.tokens[42]; cached != (err == nil) {
+				t.Errorf("token cached = %v after error %v", cached, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,239 @@
+package kakao
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/escape-ship/protos/gen/scaffold"
+)
+
+// ErrNoToken is returned by AccessToken when no usable token is cached for
+// the user, e.g. because the refresh token expired. The user has to sign in
+// with Kakao again.
+var ErrNoToken = errors.New("kakao: no cached token")
+
+// Token is a Kakao user token.
+type Token struct {
+	AccessToken  string
+	RefreshToken string
+	// IDToken is set when the app has OpenID Connect enabled.
+	IDToken string
+	Scopes  []string
+	// Expiry and RefreshExpiry are measured from when the request was sent,
+	// so they err on the early side.
+	Expiry        time.Time
+	RefreshExpiry time.Time
+}
+
+// User is the Kakao account an access token was issued for.
+type User struct {
+	ID       int64
+	Email    string // empty unless the user agreed to share it
+	Nickname string
+	// ProfileImageURL is empty unless the user agreed to share it.
+	ProfileImageURL string
+}
+
+// Client calls Kakao Login. Create it with NewClient; it is safe for
+// concurrent use.
+type Client struct {
+	cfg Config
+
+	mu     sync.Mutex
+	tokens map[int64]*Token // by Kakao user ID
+}
+
+// NewClient returns a Client for cfg.
+func NewClient(cfg Config) *Client {
+	return &Client{cfg: cfg.withDefaults(), tokens: make(map[int64]*Token)}
+}
+
+// AuthCodeURL returns the Kakao login page to redirect the user to.
+func (c *Client) AuthCodeURL() string {
+	return c.AuthCodeURLWithState("")
+}
+
+// AuthCodeURLWithState is AuthCodeURL with an opaque state value that Kakao
+// passes back to the callback, e.g. a CSRF token.
+func (c *Client) AuthCodeURLWithState(state string) string {
+	q := url.Values{
+		"response_type": {"code"},
+		"client_id":     {c.cfg.ClientID},
+		"redirect_uri":  {c.cfg.RedirectURI},
+	}
+	if len(c.cfg.Scopes) > 0 {
+		q.Set("scope", strings.Join(c.cfg.Scopes, ","))
+	}
+	if state != "" {
+		q.Set("state", state)
+	}
+	return c.cfg.AuthURL + "/oauth/authorize?" + q.Encode()
+}
+
+type tokenResponse struct {
+	AccessToken           string `json:"access_token"`
+	RefreshToken          string `json:"refresh_token"`
+	IDToken               string `json:"id_token"`
+	Scope                 string `json:"scope"`
+	ExpiresIn             int64  `json:"expires_in"`
+	RefreshTokenExpiresIn int64  `json:"refresh_token_expires_in"`
+}
+
+func (c *Client) token(ctx context.Context, form url.Values) (*Token, error) {
+	form.Set("client_id", c.cfg.ClientID)
+	if c.cfg.ClientSecret != "" {
+		form.Set("client_secret", c.cfg.ClientSecret)
+	}
+	sent := c.cfg.Now()
+	var resp tokenResponse
+	// Authorization codes are single-use and refresh tokens may be rotated,
+	// so token requests are not idempotent.
+	err := c.do(ctx, false, func(ctx context.Context) (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.cfg.AuthURL+"/oauth/token", strings.NewReader(form.Encode()))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded;charset=utf-8")
+		return req, nil
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	t := &Token{
+		AccessToken:  resp.AccessToken,
+		RefreshToken: resp.RefreshToken,
+		IDToken:      resp.IDToken,
+		Scopes:       strings.Fields(resp.Scope),
+		Expiry:       sent.Add(time.Duration(resp.ExpiresIn) * time.Second),
+	}
+	if resp.RefreshToken != "" {
+		t.RefreshExpiry = sent.Add(time.Duration(resp.RefreshTokenExpiresIn) * time.Second)
+	}
+	return t, nil
+}
+
+// ExchangeCode trades the authorization code of a login callback for a
+// token. An invalid, expired or already used code yields an *Error with Code
+// "invalid_grant".
+func (c *Client) ExchangeCode(ctx context.Context, code string) (*Token, error) {
+	return c.token(ctx, url.Values{
+		"grant_type":   {"authorization_code"},
+		"redirect_uri": {c.cfg.RedirectURI},
+		"code":         {code},
+	})
+}
+
+// Refresh issues a new access token with t's refresh token. Kakao only
+// returns a new refresh token when the old one is about to expire; otherwise
+// the result keeps t's.
+func (c *Client) Refresh(ctx context.Context, t *Token) (*Token, error) {
+	nt, err := c.token(ctx, url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {t.RefreshToken},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if nt.RefreshToken == "" {
+		nt.RefreshToken, nt.RefreshExpiry = t.RefreshToken, t.RefreshExpiry
+	}
+	if len(nt.Scopes) == 0 {
+		nt.Scopes = t.Scopes
+	}
+	return nt, nil
+}
+
+// UserInfo returns the Kakao account accessToken was issued for.
+func (c *Client) UserInfo(ctx context.Context, accessToken string) (*User, error) {
+	var resp struct {
+		ID      int64 `json:"id"`
+		Account struct {
+			Email   string `json:"email"`
+			Profile struct {
+				Nickname        string `json:"nickname"`
+				ProfileImageURL string `json:"profile_image_url"`
+			} `json:"profile"`
+		} `json:"kakao_account"`
+	}
+	err := c.do(ctx, true, func(ctx context.Context) (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.cfg.APIURL+"/v2/user/me", nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+		return req, nil
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &User{
+		ID:              resp.ID,
+		Email:           resp.Account.Email,
+		Nickname:        resp.Account.Profile.Nickname,
+		ProfileImageURL: resp.Account.Profile.ProfileImageURL,
+	}, nil
+}
+
+// Exchange implements scaffold.KakaoClient: it exchanges code, looks up the
+// user and caches the token for AccessToken.
+func (c *Client) Exchange(ctx context.Context, code string) (*scaffold.KakaoUser, error) {
+	t, err := c.ExchangeCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	u, err := c.UserInfo(ctx, t.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	c.tokens[u.ID] = t
+	c.mu.Unlock()
+	return &scaffold.KakaoUser{ID: u.ID, Email: u.Email, Nickname: u.Nickname}, nil
+}
+
+// AccessToken returns a valid access token of the Kakao user, e.g. to call
+// other Kakao APIs on their behalf. The token cached by Exchange is refreshed
+// once it is within ClockSkew of expiring; ErrNoToken is returned if there is
+// none or its refresh token has expired too.
+func (c *Client) AccessToken(ctx context.Context, kakaoID int64) (string, error) {
+	c.mu.Lock()
+	t, ok := c.tokens[kakaoID]
+	c.mu.Unlock()
+	if !ok {
+		return "", ErrNoToken
+	}
+	now := c.cfg.Now()
+	if now.Add(c.cfg.ClockSkew).Before(t.Expiry) {
+		return t.AccessToken, nil
+	}
+	if t.RefreshToken == "" || !now.Add(c.cfg.ClockSkew).Before(t.RefreshExpiry) {
+		c.Forget(kakaoID)
+		return "", ErrNoToken
+	}
+	nt, err := c.Refresh(ctx, t)
+	var kerr *Error
+	if errors.As(err, &kerr) && kerr.Code == "invalid_grant" {
+		// Revoked, e.g. the user unlinked the app.
+		c.Forget(kakaoID)
+		return "", ErrNoToken
+	}
+	if err != nil {
+		return "", err
+	}
+	c.mu.Lock()
+	c.tokens[kakaoID] = nt
+	c.mu.Unlock()
+	return nt.AccessToken, nil
+}
+
+// Forget drops the cached token of the Kakao user, e.g. on logout.
+func (c *Client) Forget(kakaoID int64) {
+	c.mu.Lock()
+	delete(c.tokens, kakaoID)
+	c.mu.Unlock()
+}
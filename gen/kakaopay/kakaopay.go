@@ -0,0 +1,418 @@
+// Package kakaopay maps PaymentService's KakaoReady, KakaoApprove and
+// KakaoCancel messages to the Kakao Pay online payment API, so deployments
+// share one vetted implementation of the headers, merchant code (cid),
+// amount conversion and error translation:
+//
+//	kp := kakaopay.NewClient(kakaopay.Config{
+//	    SecretKey:   secretKey,
+//	    CID:         kakaopay.TestCID,
+//	    ApprovalURL: "https://escape-ship.example/payment/kakao/approve?order={partner_order_id}",
+//	    CancelURL:   "https://escape-ship.example/payment/kakao/cancel?order={partner_order_id}",
+//	    FailURL:     "https://escape-ship.example/payment/kakao/fail?order={partner_order_id}",
+//	})
+//	resp, err := kp.Ready(ctx, req) // in KakaoReady; store resp.Tid with the order
+//
+// Requests are made to the merchant code in their cid field, which must be
+// Config.CID or listed in Config.CIDs, or to Config.CID when it is empty.
+//
+// Amounts are read from the Money fields, falling back to the deprecated KRW
+// fields, and must be whole won. Payment calls are not idempotent at Kakao
+// Pay, so they are only retried when Kakao Pay reports that it did not process
+// them (429, 503). Failed calls return an *Error, which carries a gRPC status
+// (see Error.GRPCStatus) so handlers can return it unchanged.
+package kakaopay
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/escape-ship/protos/gen"
+)
+
+// DefaultBaseURL is the Kakao Pay online payment API.
+const DefaultBaseURL = "https://open-api.kakaopay.com"
+
+// Kakao Pay's merchant codes for test payments.
+const (
+	TestCID             = "TC0ONETIME" // one-time payments
+	TestSubscriptionCID = "TCSUBSCRIP" // recurring payments
+)
+
+// Config configures a Client. SecretKey, CID and the redirect URLs are
+// required.
+type Config struct {
+	// SecretKey is the secret key (or dev secret key) of the app.
+	SecretKey string
+	// CID is the merchant code payments are made to when the request has no
+	// cid.
+	CID string
+	// CIDs are further merchant codes requests may select with their cid
+	// field, e.g. a subscription code next to the regular one. Other codes
+	// are rejected so clients cannot pay into arbitrary merchants.
+	CIDs []string
+	// ApprovalURL, CancelURL and FailURL are where Kakao Pay sends the user
+	// after approving, cancelling or failing the payment. The placeholder
+	// {partner_order_id} is replaced with the order being paid.
+	ApprovalURL string
+	CancelURL   string
+	FailURL     string
+
+	// BaseURL overrides DefaultBaseURL, e.g. to point at a fake in tests.
+	BaseURL string
+	// HTTPClient sends the requests; nil means http.DefaultClient.
+	HTTPClient *http.Client
+	// MaxAttempts is the total number of attempts per call including the
+	// first; zero means 3.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry, doubling with each
+	// further retry; zero means 200ms.
+	InitialBackoff time.Duration
+}
+
+// Client calls Kakao Pay. Create it with NewClient; it is safe for
+// concurrent use.
+type Client struct {
+	cfg Config
+}
+
+func (c Config) withDefaults() Config {
+	if c.BaseURL == "" {
+		c.BaseURL = DefaultBaseURL
+	}
+	if c.HTTPClient == nil {
+		c.HTTPClient = http.DefaultClient
+	}
+	if c.MaxAttempts == 0 {
+		c.MaxAttempts = 3
+	}
+	if c.InitialBackoff == 0 {
+		c.InitialBackoff = 200 * time.Millisecond
+	}
+	return c
+}
+
+// NewClient returns a Client for cfg.
+func NewClient(cfg Config) *Client {
+	return &Client{cfg: cfg.withDefaults()}
+}
+
+// cid returns the merchant code for a request's cid field.
+func (c *Client) cid(requested string) (string, error) {
+	if requested == "" || requested == c.cfg.CID || slices.Contains(c.cfg.CIDs, requested) {
+		return cmp.Or(requested, c.cfg.CID), nil
+	}
+	return "", status.Errorf(codes.InvalidArgument, "cid %q is not configured", requested)
+}
+
+// Ready prepares a payment and returns the tid and the URLs to send the user
+// to. Keep the tid with the order; Approve and Cancel need it.
+func (c *Client) Ready(ctx context.Context, req *pb.KakaoReadyRequest) (*pb.KakaoReadyResponse, error) {
+	cid, err := c.cid(req.GetCid())
+	if err != nil {
+		return nil, err
+	}
+	total, err := won("total", pb.MoneyOr(req.GetTotal(), req.GetTotalAmount()))
+	if err != nil {
+		return nil, err
+	}
+	taxFree, err := won("tax_free", pb.MoneyOr(req.GetTaxFree(), req.GetTaxFreeAmount()))
+	if err != nil {
+		return nil, err
+	}
+	redirect := func(u string) string {
+		return strings.ReplaceAll(u, "{partner_order_id}", req.GetPartnerOrderId())
+	}
+	var resp struct {
+		Tid                   string `json:"tid"`
+		NextRedirectAppURL    string `json:"next_redirect_app_url"`
+		NextRedirectMobileURL string `json:"next_redirect_mobile_url"`
+		NextRedirectPcURL     string `json:"next_redirect_pc_url"`
+		AndroidAppScheme      string `json:"android_app_scheme"`
+		IosAppScheme          string `json:"ios_app_scheme"`
+	}
+	err = c.post(ctx, "/online/v1/payment/ready", map[string]any{
+		"cid":              cid,
+		"partner_order_id": req.GetPartnerOrderId(),
+		"partner_user_id":  req.GetPartnerUserId(),
+		"item_name":        req.GetItemName(),
+		"quantity":         req.GetQuantity(),
+		"total_amount":     total,
+		"tax_free_amount":  taxFree,
+		"approval_url":     redirect(c.cfg.ApprovalURL),
+		"cancel_url":       redirect(c.cfg.CancelURL),
+		"fail_url":         redirect(c.cfg.FailURL),
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.KakaoReadyResponse{
+		Tid:                   resp.Tid,
+		NextRedirectAppUrl:    resp.NextRedirectAppURL,
+		NextRedirectMobileUrl: resp.NextRedirectMobileURL,
+		NextRedirectPcUrl:     resp.NextRedirectPcURL,
+		AndroidAppScheme:      resp.AndroidAppScheme,
+		IosAppScheme:          resp.IosAppScheme,
+	}, nil
+}
+
+// Approve completes a payment with the pg_token Kakao Pay passed to the
+// approval URL.
+func (c *Client) Approve(ctx context.Context, req *pb.KakaoApproveRequest) (*pb.KakaoApproveResponse, error) {
+	cid, err := c.cid(req.GetCid())
+	if err != nil {
+		return nil, err
+	}
+	var resp struct {
+		PartnerOrderID string `json:"partner_order_id"`
+	}
+	err = c.post(ctx, "/online/v1/payment/approve", map[string]any{
+		"cid":              cid,
+		"tid":              req.GetTid(),
+		"partner_order_id": req.GetPartnerOrderId(),
+		"partner_user_id":  req.GetPartnerUserId(),
+		"pg_token":         req.GetPgToken(),
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.KakaoApproveResponse{PartnerOrderId: resp.PartnerOrderID}, nil
+}
+
+// Cancel cancels all or part of the payment tid, e.g. a request built by
+// pb.NewKakaoCancelRequest. KakaoCancelRequest identifies the payment by
+// order only, so the caller passes the tid stored by Ready.
+func (c *Client) Cancel(ctx context.Context, tid string, req *pb.KakaoCancelRequest) (*pb.KakaoCancelResponse, error) {
+	cid, err := c.cid(req.GetCid())
+	if err != nil {
+		return nil, err
+	}
+	legacy, err := legacyAmount(req.GetCancelAmount())
+	if err != nil {
+		return nil, err
+	}
+	amount, err := won("cancel", pb.MoneyOr(req.GetCancel(), legacy))
+	if err != nil {
+		return nil, err
+	}
+	taxFree, err := won("cancel_tax_free", pb.MoneyOr(req.GetCancelTaxFree(), req.GetCancelTaxFreeAmount()))
+	if err != nil {
+		return nil, err
+	}
+	body := map[string]any{
+		"cid":                    cid,
+		"tid":                    tid,
+		"cancel_amount":          amount,
+		"cancel_tax_free_amount": taxFree,
+	}
+	// VAT and the expected cancellable balance are optional: Kakao Pay
+	// computes the former and skips the check without the latter.
+	if vat := pb.MoneyOr(req.GetCancelVat(), req.GetCancelVatAmount()); !vat.IsZero() {
+		if body["cancel_vat_amount"], err = won("cancel_vat", vat); err != nil {
+			return nil, err
+		}
+	}
+	if avail := pb.MoneyOr(req.GetCancelAvailable(), req.GetCancelAvailableAmount()); !avail.IsZero() {
+		if body["cancel_available_amount"], err = won("cancel_available", avail); err != nil {
+			return nil, err
+		}
+	}
+	var resp struct {
+		PartnerOrderID string `json:"partner_order_id"`
+	}
+	if err := c.post(ctx, "/online/v1/payment/cancel", body, &resp); err != nil {
+		return nil, err
+	}
+	if resp.PartnerOrderID == "" {
+		resp.PartnerOrderID = req.GetPartnerOrderId()
+	}
+	return &pb.KakaoCancelResponse{PartnerOrderId: resp.PartnerOrderID}, nil
+}
+
+// won converts an amount for Kakao Pay, which only takes whole won.
+func won(field string, m *pb.Money) (int64, error) {
+	n, err := m.KRWUnits()
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "%s: %v", field, err)
+	}
+	if n < 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "%s must not be negative", field)
+	}
+	return n, nil
+}
+
+// legacyAmount parses the deprecated decimal string cancel_amount.
+func legacyAmount(s string) (int64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "cancel_amount %q is not a whole won amount", s)
+	}
+	return n, nil
+}
+
+// Error is a Kakao Pay error response.
+type Error struct {
+	// StatusCode is the HTTP status of the response.
+	StatusCode int
+	// Code is Kakao Pay's error code, e.g. "-780" for a declined approval.
+	Code string
+	// Message is Kakao Pay's description of the error.
+	Message string
+	// MethodResultCode and MethodResultMessage are the card company's or
+	// bank's result when the payment method declined.
+	MethodResultCode    string
+	MethodResultMessage string
+}
+
+func (e *Error) Error() string {
+	msg := fmt.Sprintf("kakaopay: %d %s: %s", e.StatusCode, e.Code, e.Message)
+	if e.MethodResultCode != "" {
+		msg += fmt.Sprintf(" (%s %s)", e.MethodResultCode, e.MethodResultMessage)
+	}
+	return msg
+}
+
+// Kakao Pay error codes with a specific translation.
+const (
+	codeApprovalFailure = "-780" // declined by the card company or bank
+	codeAlreadyDone     = "-702" // payment already approved or cancelled
+	codeInvalidTid      = "-721" // tid does not exist or belongs to another cid
+)
+
+// GRPCStatus maps the error for status.FromError. A declined payment is
+// FailedPrecondition with ERROR_REASON_PAYMENT_DECLINED, so the gateway shows
+// the localized message; a repeated approval or cancellation is
+// AlreadyExists and an unknown tid NotFound. Other request errors are
+// InvalidArgument, throttling ResourceExhausted and Kakao Pay outages
+// Unavailable. A rejected secret key points at a misconfigured deployment and
+// is Internal.
+func (e *Error) GRPCStatus() *status.Status {
+	if e.Code == codeApprovalFailure {
+		return status.Convert(pb.NewError(codes.FailedPrecondition, pb.ErrorReason_ERROR_REASON_PAYMENT_DECLINED, e.Error(),
+			map[string]string{"method_result_code": e.MethodResultCode}))
+	}
+	c := codes.Internal
+	switch {
+	case e.Code == codeAlreadyDone:
+		c = codes.AlreadyExists
+	case e.Code == codeInvalidTid, e.StatusCode == http.StatusNotFound:
+		c = codes.NotFound
+	case e.StatusCode == http.StatusTooManyRequests:
+		c = codes.ResourceExhausted
+	case e.StatusCode >= 500:
+		c = codes.Unavailable
+	case e.StatusCode == http.StatusBadRequest:
+		c = codes.InvalidArgument
+	}
+	return status.New(c, e.Error())
+}
+
+// maxResponseBytes caps how much of a response body is read.
+const maxResponseBytes = 1 << 20
+
+// post sends body as JSON to path and decodes a 2xx response into out.
+func (c *Client) post(ctx context.Context, path string, body, out any) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	backoff := c.cfg.InitialBackoff
+	for attempt := 1; ; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.cfg.BaseURL+path, bytes.NewReader(data))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Authorization", "SECRET_KEY "+c.cfg.SecretKey)
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := c.cfg.HTTPClient.Do(req)
+		if err != nil {
+			if ctx.Err() != nil {
+				return status.FromContextError(ctx.Err()).Err()
+			}
+			// Kakao Pay may have processed the request; let the caller
+			// check the payment state rather than paying twice.
+			return status.Errorf(codes.Unavailable, "kakaopay: %v", err)
+		}
+		respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes))
+		resp.Body.Close()
+		if err != nil {
+			return status.Errorf(codes.Unavailable, "kakaopay: reading response: %v", err)
+		}
+		if resp.StatusCode/100 == 2 {
+			if err := json.Unmarshal(respBody, out); err != nil {
+				return fmt.Errorf("kakaopay: decoding response: %w", err)
+			}
+			return nil
+		}
+		perr := parseError(resp.StatusCode, respBody)
+		retry := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable
+		if !retry || attempt >= c.cfg.MaxAttempts {
+			return perr
+		}
+		wait := retryAfter(resp.Header)
+		if wait == 0 {
+			wait = backoff
+			backoff *= 2
+		}
+		t := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return perr
+		case <-t.C:
+		}
+	}
+}
+
+// parseError decodes an error body. Kakao Pay has sent both
+// {"error_code", "error_message"} and the older {"code", "msg"} shapes, with
+// the code as a number or a string.
+func parseError(statusCode int, body []byte) *Error {
+	var e struct {
+		ErrorCode    json.RawMessage `json:"error_code"`
+		ErrorMessage string          `json:"error_message"`
+		Code         json.RawMessage `json:"code"`
+		Msg          string          `json:"msg"`
+		Extras       struct {
+			MethodResultCode    string `json:"method_result_code"`
+			MethodResultMessage string `json:"method_result_message"`
+		} `json:"extras"`
+	}
+	if json.Unmarshal(body, &e) != nil {
+		return &Error{StatusCode: statusCode, Message: http.StatusText(statusCode)}
+	}
+	code, msg := e.ErrorCode, e.ErrorMessage
+	if len(code) == 0 {
+		code, msg = e.Code, e.Msg
+	}
+	return &Error{
+		StatusCode:          statusCode,
+		Code:                strings.Trim(string(code), `"`),
+		Message:             msg,
+		MethodResultCode:    e.Extras.MethodResultCode,
+		MethodResultMessage: e.Extras.MethodResultMessage,
+	}
+}
+
+// retryAfter returns the delay of a Retry-After header in seconds, or zero.
+func retryAfter(h http.Header) time.Duration {
+	n, err := strconv.Atoi(h.Get("Retry-After"))
+	if err != nil || n <= 0 {
+		return 0
+	}
+	return time.Duration(n) * time.Second
+}
@@ -0,0 +1,229 @@
+package kakaopay
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	pb "github.com/escape-ship/protos/gen"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type response struct {
+	status int
+	header http.Header
+	body   string
+}
+
+// fakeKakaoPay serves responses in order, repeating the last one, and
+// records the number of requests and the last request body.
+func fakeKakaoPay(t *testing.T, responses ...response) (*Client, *atomic.Int32, *map[string]any) {
+	t.Helper()
+	var calls atomic.Int32
+	var last map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(calls.Add(1))
+		if got := r.Header.Get("Authorization"); got != "SECRET_KEY sk" {
+			t.Errorf("Authorization = %q", got)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&last); err != nil {
+			t.Errorf("decoding request: %v", err)
+		}
+		resp := responses[min(n, len(responses))-1]
+		for k, v := range resp.header {
+			w.Header()[k] = v
+		}
+		w.WriteHeader(resp.status)
+		w.Write([]byte(resp.body))
+	}))
+	t.Cleanup(srv.Close)
+	c := NewClient(Config{
+		SecretKey:      "sk",
+		CID:            TestCID,
+		CIDs:           []string{TestSubscriptionCID},
+		BaseURL:        srv.URL,
+		InitialBackoff: time.Millisecond,
+	})
+	return c, &calls, &last
+}
+
+func TestRetries(t *testing.T) {
+	ok := response{status: http.StatusOK, body: `{"partner_order_id": "o-1"}`}
+	tests := []struct {
+		name         string
+		responses    []response
+		wantAttempts int32
+		wantCode     codes.Code
+	}{
+		{name: "success", responses: []response{ok}, wantAttempts: 1},
+		{name: "throttled then success", responses: []response{{status: http.StatusTooManyRequests}, ok}, wantAttempts: 2},
+		{
+			name:         "unavailable then success",
+			responses:    []response{{status: http.StatusServiceUnavailable, header: http.Header{"Retry-After": {"0"}}}, ok},
+			wantAttempts: 2,
+		},
+		{name: "throttled every time", responses: []response{{status: http.StatusTooManyRequests}}, wantAttempts: 3, wantCode: codes.ResourceExhausted},
+		{name: "unavailable every time", responses: []response{{status: http.StatusServiceUnavailable}}, wantAttempts: 3, wantCode: codes.Unavailable},
+		{name: "server error not retried", responses: []response{{status: http.StatusInternalServerError}}, wantAttempts: 1, wantCode: codes.Unavailable},
+		{name: "bad gateway not retried", responses: []response{{status: http.StatusBadGateway}}, wantAttempts: 1, wantCode: codes.Unavailable},
+		{
+			name:         "declined not retried",
+			responses:    []response{{status: http.StatusBadRequest, body: `{"error_code": -780, "error_message": "approval failure"}`}},
+			wantAttempts: 1,
+			wantCode:     codes.FailedPrecondition,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, calls, _ := fakeKakaoPay(t, tt.responses...)
+			resp, err := c.Approve(context.Background(), &pb.KakaoApproveRequest{Tid: "T1", PartnerOrderId: "o-1", PgToken: "pg"})
+			if got := status.Code(err); got != tt.wantCode {
+				t.Errorf("Approve() error = %v, want code %v", err, tt.wantCode)
+			}
+			if got := calls.Load(); got != tt.wantAttempts {
+				t.Errorf("%d attempts, want %d", got, tt.wantAttempts)
+			}
+			if err == nil && resp.GetPartnerOrderId() != "o-1" {
+				t.Errorf("Approve() = %v", resp)
+			}
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	tests := []struct {
+		header string
+		want   time.Duration
+	}{
+		{"", 0},
+		{"2", 2 * time.Second},
+		{"0", 0},
+		{"-1", 0},
+		{"Wed, 21 Oct 2026 07:28:00 GMT", 0},
+	}
+	for _, tt := range tests {
+		if got := retryAfter(http.Header{"Retry-After": {tt.header}}); got != tt.want {
+			t.Errorf("retryAfter(%q) = %v, want %v", tt.header, got, tt.want)
+		}
+	}
+}
+
+func TestErrorGRPCStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     int
+		body       string
+		want       *Error
+		wantCode   codes.Code
+		wantReason pb.ErrorReason
+	}{
+		{
+			name:   "declined",
+			status: http.StatusBadRequest,
+			body:   `{"error_code": -780, "error_message": "approval failure", "extras": {"method_result_code": "8000", "method_result_message": "한도 초과"}}`,
+			want: &Error{StatusCode: 400, Code: "-780", Message: "approval failure",
+				MethodResultCode: "8000", MethodResultMessage: "한도 초과"},
+			wantCode:   codes.FailedPrecondition,
+			wantReason: pb.ErrorReason_ERROR_REASON_PAYMENT_DECLINED,
+		},
+		{
+			name:     "already done, older shape",
+			status:   http.StatusBadRequest,
+			body:     `{"code": -702, "msg": "payment already done"}`,
+			want:     &Error{StatusCode: 400, Code: "-702", Message: "payment already done"},
+			wantCode: codes.AlreadyExists,
+		},
+		{
+			name:     "unknown tid, string code",
+			status:   http.StatusBadRequest,
+			body:     `{"error_code": "-721", "error_message": "invalid tid"}`,
+			want:     &Error{StatusCode: 400, Code: "-721", Message: "invalid tid"},
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "other request error",
+			status:   http.StatusBadRequest,
+			body:     `{"error_code": -701, "error_message": "invalid parameter"}`,
+			want:     &Error{StatusCode: 400, Code: "-701", Message: "invalid parameter"},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "rejected secret key",
+			status:   http.StatusUnauthorized,
+			body:     `{"error_code": -401, "error_message": "invalid secret key"}`,
+			want:     &Error{StatusCode: 401, Code: "-401", Message: "invalid secret key"},
+			wantCode: codes.Internal,
+		},
+		{
+			name:     "throttled",
+			status:   http.StatusTooManyRequests,
+			body:     `{}`,
+			want:     &Error{StatusCode: 429},
+			wantCode: codes.ResourceExhausted,
+		},
+		{
+			name:     "outage with html body",
+			status:   http.StatusBadGateway,
+			body:     `<html>bad gateway</html>`,
+			want:     &Error{StatusCode: 502, Message: "Bad Gateway"},
+			wantCode: codes.Unavailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseError(tt.status, []byte(tt.body))
+			if *got != *tt.want {
+				t.Errorf("parseError() = %+v, want %+v", got, tt.want)
+			}
+			var err error = got
+			if code := status.Code(err); code != tt.wantCode {
+				t.Errorf("status code = %v, want %v", code, tt.wantCode)
+			}
+			if reason := pb.ErrorReasonOf(err); reason != tt.wantReason {
+				t.Errorf("reason = %v, want %v", reason, tt.wantReason)
+			}
+			var kerr *Error
+			if !errors.As(err, &kerr) {
+				t.Error("errors.As(*Error) = false")
+			}
+		})
+	}
+}
+
+func TestMerchantCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		cid      string
+		wantCID  string
+		wantCode codes.Code
+	}{
+		{name: "default", cid: "", wantCID: TestCID},
+		{name: "configured", cid: TestCID, wantCID: TestCID},
+		{name: "listed", cid: TestSubscriptionCID, wantCID: TestSubscriptionCID},
+		{name: "not configured", cid: "CXXXXXXXXX", wantCode: codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, calls, last := fakeKakaoPay(t, response{status: http.StatusOK, body: `{"partner_order_id": "o-1"}`})
+			_, err := c.Cancel(context.Background(), "T1", &pb.KakaoCancelRequest{Cid: tt.cid, Cancel: pb.KRW(1000)})
+			if got := status.Code(err); got != tt.wantCode {
+				t.Fatalf("Cancel() error = %v, want code %v", err, tt.wantCode)
+			}
+			if err != nil {
+				if n := calls.Load(); n != 0 {
+					t.Errorf("%d requests sent for a rejected cid", n)
+				}
+				return
+			}
+			if got := (*last)["cid"]; got != tt.wantCID {
+				t.Errorf("cid sent = %v, want %s", got, tt.wantCID)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package gen
+
+import (
+	"strconv"
+	"strings"
+)
+
+// VATRate is the Korean value-added tax rate in percent.
+const VATRate = 10
+
+// KRWRounding is how an amount is rounded to a whole unit of won.
+type KRWRounding int
+
+const (
+	// RoundDown truncates toward zero (절사), the usual rule for discount
+	// amounts and tax invoice VAT.
+	RoundDown KRWRounding = iota
+	// RoundHalfUp rounds halves away from zero (반올림).
+	RoundHalfUp
+	// RoundUp rounds away from zero (올림).
+	RoundUp
+)
+
+// RoundKRW rounds won to a multiple of unit (e.g. 10 or 100) using mode.
+// A unit below 2 returns won unchanged.
+func RoundKRW(won, unit int64, mode KRWRounding) int64 {
+	if unit < 2 {
+		return won
+	}
+	q, r := won/unit, won%unit
+	if r < 0 {
+		r = -r
+	}
+	sign := int64(1)
+	if won < 0 {
+		sign = -1
+	}
+	switch {
+	case r == 0:
+	case mode == RoundUp, mode == RoundHalfUp && 2*r >= unit:
+		q += sign
+	}
+	return q * unit
+}
+
+// DiscountKRW returns the discount on price at a rate given in basis points
+// (1000 = 10%), truncated to 10 won as Korean shops advertise it.
+func DiscountKRW(price, basisPoints int64) int64 {
+	return RoundKRW(mulDiv(price, basisPoints, 10_000, RoundDown), 10, RoundDown)
+}
+
+// SplitVAT splits a VAT-inclusive total into its taxable supply value (공급가액)
+// and VAT (부가세), so that total = supply + vat + taxFree. The VAT is the
+// taxable part divided by 11, rounded half up to the won, matching what Kakao
+// Pay and card acquirers compute when no VAT amount is given.
+func SplitVAT(total, taxFree int64) (supply, vat int64) {
+	taxable := total - taxFree
+	vat = mulDiv(taxable, VATRate, 100+VATRate, RoundHalfUp)
+	return taxable - vat, vat
+}
+
+// VATOnSupply returns the VAT on a supply value, truncated to the won as on a
+// tax invoice (세금계산서).
+func VATOnSupply(supply int64) int64 {
+	return mulDiv(supply, VATRate, 100, RoundDown)
+}
+
+// mulDiv returns a*b/c rounded to the won using mode. a*b must fit in int64,
+// which holds for any realistic amount and rate.
+func mulDiv(a, b, c int64, mode KRWRounding) int64 {
+	return RoundKRW(a*b, c, mode) / c
+}
+
+// FormatKRW formats won the way prices are shown to customers, e.g.
+// "25,000원" or "-3,000원".
+func FormatKRW(won int64) string {
+	var b strings.Builder
+	if won < 0 {
+		b.WriteByte('-')
+	}
+	digits := strconv.FormatUint(absInt64(won), 10)
+	for i, d := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteRune(d)
+	}
+	b.WriteString("원")
+	return b.String()
+}
+
+func absInt64(n int64) uint64 {
+	if n < 0 {
+		return uint64(-(n + 1)) + 1
+	}
+	return uint64(n)
+}
@@ -0,0 +1,104 @@
+package gen
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRoundKRW(t *testing.T) {
+	tests := []struct {
+		won, unit int64
+		mode      KRWRounding
+		want      int64
+	}{
+		{1234, 10, RoundDown, 1230},
+		{1234, 10, RoundHalfUp, 1230},
+		{1235, 10, RoundHalfUp, 1240},
+		{1231, 10, RoundUp, 1240},
+		{1230, 10, RoundUp, 1230},
+		{1250, 100, RoundHalfUp, 1300},
+		{-1235, 10, RoundDown, -1230},
+		{-1235, 10, RoundHalfUp, -1240},
+		{-1231, 10, RoundUp, -1240},
+		{1234, 1, RoundUp, 1234},
+		{1234, 0, RoundUp, 1234},
+	}
+	for _, tt := range tests {
+		if got := RoundKRW(tt.won, tt.unit, tt.mode); got != tt.want {
+			t.Errorf("RoundKRW(%d, %d, %v) = %d, want %d", tt.won, tt.unit, tt.mode, got, tt.want)
+		}
+	}
+}
+
+func TestDiscountKRW(t *testing.T) {
+	tests := []struct {
+		price, bp, want int64
+	}{
+		{25000, 1000, 2500},
+		{12345, 1000, 1230},
+		{9999, 1500, 1490},
+		{99, 1000, 0},
+		{25000, 0, 0},
+		{25000, 10_000, 25000},
+	}
+	for _, tt := range tests {
+		if got := DiscountKRW(tt.price, tt.bp); got != tt.want {
+			t.Errorf("DiscountKRW(%d, %d) = %d, want %d", tt.price, tt.bp, got, tt.want)
+		}
+	}
+}
+
+func TestSplitVAT(t *testing.T) {
+	tests := []struct {
+		total, taxFree      int64
+		wantSupply, wantVAT int64
+	}{
+		{11000, 0, 10000, 1000},
+		{10000, 0, 9091, 909},
+		{10005, 0, 9095, 910},
+		{15000, 4000, 10000, 1000},
+		{5000, 5000, 0, 0},
+		{0, 0, 0, 0},
+	}
+	for _, tt := range tests {
+		supply, vat := SplitVAT(tt.total, tt.taxFree)
+		if supply != tt.wantSupply || vat != tt.wantVAT {
+			t.Errorf("SplitVAT(%d, %d) = %d, %d, want %d, %d", tt.total, tt.taxFree, supply, vat, tt.wantSupply, tt.wantVAT)
+		}
+		if supply+vat+tt.taxFree != tt.total {
+			t.Errorf("SplitVAT(%d, %d) parts do not add up", tt.total, tt.taxFree)
+		}
+	}
+}
+
+func TestVATOnSupply(t *testing.T) {
+	tests := []struct{ supply, want int64 }{
+		{10000, 1000},
+		{9099, 909},
+		{9, 0},
+	}
+	for _, tt := range tests {
+		if got := VATOnSupply(tt.supply); got != tt.want {
+			t.Errorf("VATOnSupply(%d) = %d, want %d", tt.supply, got, tt.want)
+		}
+	}
+}
+
+func TestFormatKRW(t *testing.T) {
+	tests := []struct {
+		won  int64
+		want string
+	}{
+		{0, "0원"},
+		{999, "999원"},
+		{25000, "25,000원"},
+		{1234567, "1,234,567원"},
+		{-3000, "-3,000원"},
+		{math.MinInt64, "-9,223,372,036,854,775,808원"},
+	}
+	for _, tt := range tests {
+		if got := FormatKRW(tt.won); got != tt.want {
+			t.Errorf("FormatKRW(%d) = %q, want %q", tt.won, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package gen
+
+import (
+	"strconv"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// LoginFailedError returns the Unauthenticated error for a wrong password,
+// carrying how many attempts remain before the account is locked.
+func LoginFailedError(remainingAttempts int32) error {
+	return reasonError(codes.Unauthenticated, ErrorReason_ERROR_REASON_INVALID_CREDENTIALS, "invalid email or password",
+		map[string]string{"remaining_attempts": strconv.Itoa(int(remainingAttempts))},
+		&AccountLockout{RemainingAttempts: remainingAttempts})
+}
+
+// AccountLockedError returns the ResourceExhausted error for a locked account.
+// Besides AccountLockout it attaches a standard RetryInfo so generic gRPC
+// clients back off correctly.
+func AccountLockedError(until time.Time) error {
+	retryAfter := time.Until(until).Round(time.Second)
+	if retryAfter < 0 {
+		retryAfter = 0
+	}
+	lockedUntil := until.UTC().Format(time.RFC3339)
+	return reasonError(codes.ResourceExhausted, ErrorReason_ERROR_REASON_ACCOUNT_LOCKED, "account temporarily locked",
+		map[string]string{"locked_until": lockedUntil},
+		&AccountLockout{
+			Locked:            true,
+			RetryAfterSeconds: int64(retryAfter / time.Second),
+			LockedUntil:       lockedUntil,
+		},
+		&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)},
+	)
+}
+
+// AccountLockoutFromError extracts the AccountLockout detail from a Login error.
+func AccountLockoutFromError(err error) (*AccountLockout, bool) {
+	st, ok := status.FromError(err)
+	if !ok {
+		return nil, false
+	}
+	for _, d := range st.Details() {
+		if l, ok := d.(*AccountLockout); ok {
+			return l, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,122 @@
+package gen
+
+import (
+	"log/slog"
+	"strconv"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Redacted replaces the values of secret fields in logs.
+const Redacted = "[REDACTED]"
+
+// LogValue renders m for log/slog as a group of its populated fields, with
+// fields marked [debug_redact = true] in the proto (passwords, tokens, API key
+// secrets) replaced by Redacted. Nested messages, lists and maps become
+// nested groups. Use it to log arbitrary requests, e.g. from an interceptor:
+//
+//	slog.InfoContext(ctx, "request", "method", info.FullMethod, "body", pb.LogValue(req.(proto.Message)))
+//
+// Messages with secret fields also implement slog.LogValuer, so logging them
+// directly is safe too.
+func LogValue(m proto.Message) slog.Value {
+	if m == nil {
+		return slog.GroupValue()
+	}
+	return messageLogValue(m.ProtoReflect())
+}
+
+func messageLogValue(m protoreflect.Message) slog.Value {
+	if !m.IsValid() {
+		return slog.GroupValue()
+	}
+	fields := m.Descriptor().Fields()
+	var attrs []slog.Attr
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if !m.Has(fd) {
+			continue
+		}
+		name := string(fd.Name())
+		if isRedacted(fd) {
+			attrs = append(attrs, slog.String(name, Redacted))
+			continue
+		}
+		v := m.Get(fd)
+		switch {
+		case fd.IsList():
+			list := v.List()
+			elems := make([]slog.Attr, list.Len())
+			for j := range elems {
+				elems[j] = slog.Attr{Key: strconv.Itoa(j), Value: fieldLogValue(fd, list.Get(j))}
+			}
+			attrs = append(attrs, slog.Attr{Key: name, Value: slog.GroupValue(elems...)})
+		case fd.IsMap():
+			var entries []slog.Attr
+			v.Map().Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+				entries = append(entries, slog.Attr{Key: k.String(), Value: fieldLogValue(fd.MapValue(), v)})
+				return true
+			})
+			attrs = append(attrs, slog.Attr{Key: name, Value: slog.GroupValue(entries...)})
+		default:
+			attrs = append(attrs, slog.Attr{Key: name, Value: fieldLogValue(fd, v)})
+		}
+	}
+	return slog.GroupValue(attrs...)
+}
+
+func fieldLogValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) slog.Value {
+	switch {
+	case fd.Message() != nil:
+		return messageLogValue(v.Message())
+	case fd.Enum() != nil:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return slog.StringValue(string(ev.Name()))
+		}
+		return slog.Int64Value(int64(v.Enum()))
+	}
+	return slog.AnyValue(v.Interface())
+}
+
+func isRedacted(fd protoreflect.FieldDescriptor) bool {
+	opts, ok := fd.Options().(*descriptorpb.FieldOptions)
+	return ok && opts.GetDebugRedact()
+}
+
+// Messages with fields marked debug_redact. Keep this list in sync when
+// marking new fields.
+
+func (x *GetKakaoCallBackRequest) LogValue() slog.Value    { return LogValue(x) }
+func (x *GetKakaoCallBackResponse) LogValue() slog.Value   { return LogValue(x) }
+func (x *LoginRequest) LogValue() slog.Value               { return LogValue(x) }
+func (x *LoginResponse) LogValue() slog.Value              { return LogValue(x) }
+func (x *RegisterRequest) LogValue() slog.Value            { return LogValue(x) }
+func (x *RefreshTokenRequest) LogValue() slog.Value        { return LogValue(x) }
+func (x *RefreshTokenResponse) LogValue() slog.Value       { return LogValue(x) }
+func (x *RevokeTokenRequest) LogValue() slog.Value         { return LogValue(x) }
+func (x *RegisterPushTokenRequest) LogValue() slog.Value   { return LogValue(x) }
+func (x *UnregisterPushTokenRequest) LogValue() slog.Value { return LogValue(x) }
+func (x *VerifyCaptchaRequest) LogValue() slog.Value       { return LogValue(x) }
+func (x *IssueGuestTokenResponse) LogValue() slog.Value    { return LogValue(x) }
+func (x *MergeAccountsRequest) LogValue() slog.Value       { return LogValue(x) }
+func (x *RequestEmailChangeRequest) LogValue() slog.Value  { return LogValue(x) }
+func (x *ConfirmEmailChangeRequest) LogValue() slog.Value  { return LogValue(x) }
+func (x *CreateAPIKeyResponse) LogValue() slog.Value       { return LogValue(x) }
+func (x *ValidateAPIKeyRequest) LogValue() slog.Value      { return LogValue(x) }
+func (x *ChangePasswordRequest) LogValue() slog.Value      { return LogValue(x) }
+func (x *DeleteAccountRequest) LogValue() slog.Value       { return LogValue(x) }
+func (x *KakaoApproveRequest) LogValue() slog.Value        { return LogValue(x) }
+func (x *KakaoPayApproveDetails) LogValue() slog.Value     { return LogValue(x) }
+func (x *TossPaymentsApproveDetails) LogValue() slog.Value { return LogValue(x) }
+func (x *ApprovePaymentRequest) LogValue() slog.Value      { return LogValue(x) }
+func (x *RefundReceiveAccount) LogValue() slog.Value       { return LogValue(x) }
+func (x *CancelPaymentRequest) LogValue() slog.Value       { return LogValue(x) }
+func (x *TossPaymentsCancelDetails) LogValue() slog.Value  { return LogValue(x) }
+func (x *Subscription) LogValue() slog.Value               { return LogValue(x) }
+func (x *CreateSubscriptionRequest) LogValue() slog.Value  { return LogValue(x) }
+func (x *CreateSubscriptionResponse) LogValue() slog.Value { return LogValue(x) }
+func (x *PauseSubscriptionResponse) LogValue() slog.Value  { return LogValue(x) }
+func (x *SkipNextDeliveryResponse) LogValue() slog.Value   { return LogValue(x) }
+func (x *CancelSubscriptionResponse) LogValue() slog.Value { return LogValue(x) }
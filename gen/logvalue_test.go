@@ -0,0 +1,62 @@
+package gen
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// holdsSecret reports whether md has a debug_redact field, directly or in a
+// nested message.
+func holdsSecret(md protoreflect.MessageDescriptor, seen map[protoreflect.FullName]bool) bool {
+	if seen[md.FullName()] {
+		return false
+	}
+	seen[md.FullName()] = true
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if isRedacted(fd) || fd.Message() != nil && holdsSecret(fd.Message(), seen) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestMessagesWithSecretsAreLogValuers(t *testing.T) {
+	protoregistry.GlobalTypes.RangeMessages(func(mt protoreflect.MessageType) bool {
+		md := mt.Descriptor()
+		if md.ParentFile().Package() != "go.escape.ship.proto.v1" || !holdsSecret(md, map[protoreflect.FullName]bool{}) {
+			return true
+		}
+		if _, ok := mt.New().Interface().(slog.LogValuer); !ok {
+			t.Errorf("%s holds a debug_redact field but does not implement slog.LogValuer", md.FullName())
+		}
+		return true
+	})
+}
+
+func TestLogValueRedacts(t *testing.T) {
+	tests := []struct {
+		name   string
+		msg    slog.LogValuer
+		secret string
+	}{
+		{"login", &LoginRequest{Email: "a@example.com", Password: "hunter2"}, "hunter2"},
+		{"subscription", &Subscription{Id: "s-1", BillingKey: "SID-secret"}, "SID-secret"},
+		{"nested", &CreateSubscriptionResponse{Subscription: &Subscription{BillingKey: "SID-secret"}}, "SID-secret"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			slog.New(slog.NewTextHandler(&buf, nil)).Info("msg", "body", tt.msg)
+			if out := buf.String(); strings.Contains(out, tt.secret) || !strings.Contains(out, Redacted) {
+				t.Errorf("log output %q leaks the secret or lacks %s", out, Redacted)
+			}
+		})
+	}
+}
@@ -0,0 +1,438 @@
+// Code generated by protoc-gen-go-mock. DO NOT EDIT.
+// source: account.proto
+
+package mocks
+
+import (
+	context "context"
+	gen "github.com/escape-ship/protos/gen"
+	grpc "google.golang.org/grpc"
+	iter "iter"
+)
+
+// MockAccountServiceClient is a programmable gen.AccountServiceClient. Set the
+// XxxFunc fields to script responses; calls to unset methods fail with
+// Unimplemented. Every call is recorded.
+type MockAccountServiceClient struct {
+	Recorder
+
+	GetKakaoLoginURLFunc    func(ctx context.Context, in *gen.GetKakaoLoginURLRequest) (*gen.GetKakaoLoginURLResponse, error)
+	GetKakaoCallBackFunc    func(ctx context.Context, in *gen.GetKakaoCallBackRequest) (*gen.GetKakaoCallBackResponse, error)
+	LoginFunc               func(ctx context.Context, in *gen.LoginRequest) (*gen.LoginResponse, error)
+	RegisterFunc            func(ctx context.Context, in *gen.RegisterRequest) (*gen.RegisterResponse, error)
+	RefreshTokenFunc        func(ctx context.Context, in *gen.RefreshTokenRequest) (*gen.RefreshTokenResponse, error)
+	RevokeTokenFunc         func(ctx context.Context, in *gen.RevokeTokenRequest) (*gen.RevokeTokenResponse, error)
+	AnonymizeUserDataFunc   func(ctx context.Context, in *gen.AnonymizeUserDataRequest) (*gen.AnonymizeUserDataResponse, error)
+	AcceptTermsFunc         func(ctx context.Context, in *gen.AcceptTermsRequest) (*gen.AcceptTermsResponse, error)
+	RegisterPushTokenFunc   func(ctx context.Context, in *gen.RegisterPushTokenRequest) (*gen.RegisterPushTokenResponse, error)
+	UnregisterPushTokenFunc func(ctx context.Context, in *gen.UnregisterPushTokenRequest) (*gen.UnregisterPushTokenResponse, error)
+	VerifyCaptchaFunc       func(ctx context.Context, in *gen.VerifyCaptchaRequest) (*gen.VerifyCaptchaResponse, error)
+	UnlockAccountFunc       func(ctx context.Context, in *gen.UnlockAccountRequest) (*gen.UnlockAccountResponse, error)
+	IssueGuestTokenFunc     func(ctx context.Context, in *gen.IssueGuestTokenRequest) (*gen.IssueGuestTokenResponse, error)
+	MergeAccountsFunc       func(ctx context.Context, in *gen.MergeAccountsRequest) (*gen.MergeAccountsResponse, error)
+	RequestEmailChangeFunc  func(ctx context.Context, in *gen.RequestEmailChangeRequest) (*gen.RequestEmailChangeResponse, error)
+	ConfirmEmailChangeFunc  func(ctx context.Context, in *gen.ConfirmEmailChangeRequest) (*gen.ConfirmEmailChangeResponse, error)
+	UploadAvatarFunc        func(ctx context.Context, in iter.Seq[*gen.UploadAvatarRequest]) (*gen.UploadAvatarResponse, error)
+	GetPreferencesFunc      func(ctx context.Context, in *gen.GetPreferencesRequest) (*gen.GetPreferencesResponse, error)
+	SetPreferencesFunc      func(ctx context.Context, in *gen.SetPreferencesRequest) (*gen.SetPreferencesResponse, error)
+	GetMeFunc               func(ctx context.Context, in *gen.GetMeRequest) (*gen.GetMeResponse, error)
+	UpdateProfileFunc       func(ctx context.Context, in *gen.UpdateProfileRequest) (*gen.UpdateProfileResponse, error)
+	ChangePasswordFunc      func(ctx context.Context, in *gen.ChangePasswordRequest) (*gen.ChangePasswordResponse, error)
+	DeleteAccountFunc       func(ctx context.Context, in *gen.DeleteAccountRequest) (*gen.DeleteAccountResponse, error)
+	CreateAPIKeyFunc        func(ctx context.Context, in *gen.CreateAPIKeyRequest) (*gen.CreateAPIKeyResponse, error)
+	RevokeAPIKeyFunc        func(ctx context.Context, in *gen.RevokeAPIKeyRequest) (*gen.RevokeAPIKeyResponse, error)
+	ValidateAPIKeyFunc      func(ctx context.Context, in *gen.ValidateAPIKeyRequest) (*gen.ValidateAPIKeyResponse, error)
+}
+
+var _ gen.AccountServiceClient = (*MockAccountServiceClient)(nil)
+
+func (m *MockAccountServiceClient) GetKakaoLoginURL(ctx context.Context, in *gen.GetKakaoLoginURLRequest, _ ...grpc.CallOption) (*gen.GetKakaoLoginURLResponse, error) {
+	m.record(gen.AccountService_GetKakaoLoginURL_FullMethodName, in)
+	if m.GetKakaoLoginURLFunc == nil {
+		return nil, unimplemented(gen.AccountService_GetKakaoLoginURL_FullMethodName)
+	}
+	return m.GetKakaoLoginURLFunc(ctx, in)
+}
+
+func (m *MockAccountServiceClient) GetKakaoCallBack(ctx context.Context, in *gen.GetKakaoCallBackRequest, _ ...grpc.CallOption) (*gen.GetKakaoCallBackResponse, error) {
+	m.record(gen.AccountService_GetKakaoCallBack_FullMethodName, in)
+	if m.GetKakaoCallBackFunc == nil {
+		return nil, unimplemented(gen.AccountService_GetKakaoCallBack_FullMethodName)
+	}
+	return m.GetKakaoCallBackFunc(ctx, in)
+}
+
+func (m *MockAccountServiceClient) Login(ctx context.Context, in *gen.LoginRequest, _ ...grpc.CallOption) (*gen.LoginResponse, error) {
+	m.record(gen.AccountService_Login_FullMethodName, in)
+	if m.LoginFunc == nil {
+		return nil, unimplemented(gen.AccountService_Login_FullMethodName)
+	}
+	return m.LoginFunc(ctx, in)
+}
+
+func (m *MockAccountServiceClient) Register(ctx context.Context, in *gen.RegisterRequest, _ ...grpc.CallOption) (*gen.RegisterResponse, error) {
+	m.record(gen.AccountService_Register_FullMethodName, in)
+	if m.RegisterFunc == nil {
+		return nil, unimplemented(gen.AccountService_Register_FullMethodName)
+	}
+	return m.RegisterFunc(ctx, in)
+}
+
+func (m *MockAccountServiceClient) RefreshToken(ctx context.Context, in *gen.RefreshTokenRequest, _ ...grpc.CallOption) (*gen.RefreshTokenResponse, error) {
+	m.record(gen.AccountService_RefreshToken_FullMethodName, in)
+	if m.RefreshTokenFunc == nil {
+		return nil, unimplemented(gen.AccountService_RefreshToken_FullMethodName)
+	}
+	return m.RefreshTokenFunc(ctx, in)
+}
+
+func (m *MockAccountServiceClient) RevokeToken(ctx context.Context, in *gen.RevokeTokenRequest, _ ...grpc.CallOption) (*gen.RevokeTokenResponse, error) {
+	m.record(gen.AccountService_RevokeToken_FullMethodName, in)
+	if m.RevokeTokenFunc == nil {
+		return nil, unimplemented(gen.AccountService_RevokeToken_FullMethodName)
+	}
+	return m.RevokeTokenFunc(ctx, in)
+}
+
+func (m *MockAccountServiceClient) AnonymizeUserData(ctx context.Context, in *gen.AnonymizeUserDataRequest, _ ...grpc.CallOption) (*gen.AnonymizeUserDataResponse, error) {
+	m.record(gen.AccountService_AnonymizeUserData_FullMethodName, in)
+	if m.AnonymizeUserDataFunc == nil {
+		return nil, unimplemented(gen.AccountService_AnonymizeUserData_FullMethodName)
+	}
+	return m.AnonymizeUserDataFunc(ctx, in)
+}
+
+func (m *MockAccountServiceClient) AcceptTerms(ctx context.Context, in *gen.AcceptTermsRequest, _ ...grpc.CallOption) (*gen.AcceptTermsResponse, error) {
+	m.record(gen.AccountService_AcceptTerms_FullMethodName, in)
+	if m.AcceptTermsFunc == nil {
+		return nil, unimplemented(gen.AccountService_AcceptTerms_FullMethodName)
+	}
+	return m.AcceptTermsFunc(ctx, in)
+}
+
+func (m *MockAccountServiceClient) RegisterPushToken(ctx context.Context, in *gen.RegisterPushTokenRequest, _ ...grpc.CallOption) (*gen.RegisterPushTokenResponse, error) {
+	m.record(gen.AccountService_RegisterPushToken_FullMethodName, in)
+	if m.RegisterPushTokenFunc == nil {
+		return nil, unimplemented(gen.AccountService_RegisterPushToken_FullMethodName)
+	}
+	return m.RegisterPushTokenFunc(ctx, in)
+}
+
+func (m *MockAccountServiceClient) UnregisterPushToken(ctx context.Context, in *gen.UnregisterPushTokenRequest, _ ...grpc.CallOption) (*gen.UnregisterPushTokenResponse, error) {
+	m.record(gen.AccountService_UnregisterPushToken_FullMethodName, in)
+	if m.UnregisterPushTokenFunc == nil {
+		return nil, unimplemented(gen.AccountService_UnregisterPushToken_FullMethodName)
+	}
+	return m.UnregisterPushTokenFunc(ctx, in)
+}
+
+func (m *MockAccountServiceClient) VerifyCaptcha(ctx context.Context, in *gen.VerifyCaptchaRequest, _ ...grpc.CallOption) (*gen.VerifyCaptchaResponse, error) {
+	m.record(gen.AccountService_VerifyCaptcha_FullMethodName, in)
+	if m.VerifyCaptchaFunc == nil {
+		return nil, unimplemented(gen.AccountService_VerifyCaptcha_FullMethodName)
+	}
+	return m.VerifyCaptchaFunc(ctx, in)
+}
+
+func (m *MockAccountServiceClient) UnlockAccount(ctx context.Context, in *gen.UnlockAccountRequest, _ ...grpc.CallOption) (*gen.UnlockAccountResponse, error) {
+	m.record(gen.AccountService_UnlockAccount_FullMethodName, in)
+	if m.UnlockAccountFunc == nil {
+		return nil, unimplemented(gen.AccountService_UnlockAccount_FullMethodName)
+	}
+	return m.UnlockAccountFunc(ctx, in)
+}
+
+func (m *MockAccountServiceClient) IssueGuestToken(ctx context.Context, in *gen.IssueGuestTokenRequest, _ ...grpc.CallOption) (*gen.IssueGuestTokenResponse, error) {
+	m.record(gen.AccountService_IssueGuestToken_FullMethodName, in)
+	if m.IssueGuestTokenFunc == nil {
+		return nil, unimplemented(gen.AccountService_IssueGuestToken_FullMethodName)
+	}
+	return m.IssueGuestTokenFunc(ctx, in)
+}
+
+func (m *MockAccountServiceClient) MergeAccounts(ctx context.Context, in *gen.MergeAccountsRequest, _ ...grpc.CallOption) (*gen.MergeAccountsResponse, error) {
+	m.record(gen.AccountService_MergeAccounts_FullMethodName, in)
+	if m.MergeAccountsFunc == nil {
+		return nil, unimplemented(gen.AccountService_MergeAccounts_FullMethodName)
+	}
+	return m.MergeAccountsFunc(ctx, in)
+}
+
+func (m *MockAccountServiceClient) RequestEmailChange(ctx context.Context, in *gen.RequestEmailChangeRequest, _ ...grpc.CallOption) (*gen.RequestEmailChangeResponse, error) {
+	m.record(gen.AccountService_RequestEmailChange_FullMethodName, in)
+	if m.RequestEmailChangeFunc == nil {
+		return nil, unimplemented(gen.AccountService_RequestEmailChange_FullMethodName)
+	}
+	return m.RequestEmailChangeFunc(ctx, in)
+}
+
+func (m *MockAccountServiceClient) ConfirmEmailChange(ctx context.Context, in *gen.ConfirmEmailChangeRequest, _ ...grpc.CallOption) (*gen.ConfirmEmailChangeResponse, error) {
+	m.record(gen.AccountService_ConfirmEmailChange_FullMethodName, in)
+	if m.ConfirmEmailChangeFunc == nil {
+		return nil, unimplemented(gen.AccountService_ConfirmEmailChange_FullMethodName)
+	}
+	return m.ConfirmEmailChangeFunc(ctx, in)
+}
+
+func (m *MockAccountServiceClient) UploadAvatar(ctx context.Context, _ ...grpc.CallOption) (grpc.ClientStreamingClient[gen.UploadAvatarRequest, gen.UploadAvatarResponse], error) {
+	m.record(gen.AccountService_UploadAvatar_FullMethodName, nil)
+	return gen.AccountServiceClientFromAPI(accountServiceMockAPI{m}).UploadAvatar(ctx)
+}
+
+func (m *MockAccountServiceClient) GetPreferences(ctx context.Context, in *gen.GetPreferencesRequest, _ ...grpc.CallOption) (*gen.GetPreferencesResponse, error) {
+	m.record(gen.AccountService_GetPreferences_FullMethodName, in)
+	if m.GetPreferencesFunc == nil {
+		return nil, unimplemented(gen.AccountService_GetPreferences_FullMethodName)
+	}
+	return m.GetPreferencesFunc(ctx, in)
+}
+
+func (m *MockAccountServiceClient) SetPreferences(ctx context.Context, in *gen.SetPreferencesRequest, _ ...grpc.CallOption) (*gen.SetPreferencesResponse, error) {
+	m.record(gen.AccountService_SetPreferences_FullMethodName, in)
+	if m.SetPreferencesFunc == nil {
+		return nil, unimplemented(gen.AccountService_SetPreferences_FullMethodName)
+	}
+	return m.SetPreferencesFunc(ctx, in)
+}
+
+func (m *MockAccountServiceClient) GetMe(ctx context.Context, in *gen.GetMeRequest, _ ...grpc.CallOption) (*gen.GetMeResponse, error) {
+	m.record(gen.AccountService_GetMe_FullMethodName, in)
+	if m.GetMeFunc == nil {
+		return nil, unimplemented(gen.AccountService_GetMe_FullMethodName)
+	}
+	return m.GetMeFunc(ctx, in)
+}
+
+func (m *MockAccountServiceClient) UpdateProfile(ctx context.Context, in *gen.UpdateProfileRequest, _ ...grpc.CallOption) (*gen.UpdateProfileResponse, error) {
+	m.record(gen.AccountService_UpdateProfile_FullMethodName, in)
+	if m.UpdateProfileFunc == nil {
+		return nil, unimplemented(gen.AccountService_UpdateProfile_FullMethodName)
+	}
+	return m.UpdateProfileFunc(ctx, in)
+}
+
+func (m *MockAccountServiceClient) ChangePassword(ctx context.Context, in *gen.ChangePasswordRequest, _ ...grpc.CallOption) (*gen.ChangePasswordResponse, error) {
+	m.record(gen.AccountService_ChangePassword_FullMethodName, in)
+	if m.ChangePasswordFunc == nil {
+		return nil, unimplemented(gen.AccountService_ChangePassword_FullMethodName)
+	}
+	return m.ChangePasswordFunc(ctx, in)
+}
+
+func (m *MockAccountServiceClient) DeleteAccount(ctx context.Context, in *gen.DeleteAccountRequest, _ ...grpc.CallOption) (*gen.DeleteAccountResponse, error) {
+	m.record(gen.AccountService_DeleteAccount_FullMethodName, in)
+	if m.DeleteAccountFunc == nil {
+		return nil, unimplemented(gen.AccountService_DeleteAccount_FullMethodName)
+	}
+	return m.DeleteAccountFunc(ctx, in)
+}
+
+func (m *MockAccountServiceClient) CreateAPIKey(ctx context.Context, in *gen.CreateAPIKeyRequest, _ ...grpc.CallOption) (*gen.CreateAPIKeyResponse, error) {
+	m.record(gen.AccountService_CreateAPIKey_FullMethodName, in)
+	if m.CreateAPIKeyFunc == nil {
+		return nil, unimplemented(gen.AccountService_CreateAPIKey_FullMethodName)
+	}
+	return m.CreateAPIKeyFunc(ctx, in)
+}
+
+func (m *MockAccountServiceClient) RevokeAPIKey(ctx context.Context, in *gen.RevokeAPIKeyRequest, _ ...grpc.CallOption) (*gen.RevokeAPIKeyResponse, error) {
+	m.record(gen.AccountService_RevokeAPIKey_FullMethodName, in)
+	if m.RevokeAPIKeyFunc == nil {
+		return nil, unimplemented(gen.AccountService_RevokeAPIKey_FullMethodName)
+	}
+	return m.RevokeAPIKeyFunc(ctx, in)
+}
+
+func (m *MockAccountServiceClient) ValidateAPIKey(ctx context.Context, in *gen.ValidateAPIKeyRequest, _ ...grpc.CallOption) (*gen.ValidateAPIKeyResponse, error) {
+	m.record(gen.AccountService_ValidateAPIKey_FullMethodName, in)
+	if m.ValidateAPIKeyFunc == nil {
+		return nil, unimplemented(gen.AccountService_ValidateAPIKey_FullMethodName)
+	}
+	return m.ValidateAPIKeyFunc(ctx, in)
+}
+
+// accountServiceMockAPI serves the streaming methods of MockAccountServiceClient through
+// gen.AccountServiceClientFromAPI.
+type accountServiceMockAPI struct{ m *MockAccountServiceClient }
+
+func (a accountServiceMockAPI) GetKakaoLoginURL(ctx context.Context, in *gen.GetKakaoLoginURLRequest) (*gen.GetKakaoLoginURLResponse, error) {
+	if a.m.GetKakaoLoginURLFunc == nil {
+		return nil, unimplemented(gen.AccountService_GetKakaoLoginURL_FullMethodName)
+	}
+	return a.m.GetKakaoLoginURLFunc(ctx, in)
+}
+
+func (a accountServiceMockAPI) GetKakaoCallBack(ctx context.Context, in *gen.GetKakaoCallBackRequest) (*gen.GetKakaoCallBackResponse, error) {
+	if a.m.GetKakaoCallBackFunc == nil {
+		return nil, unimplemented(gen.AccountService_GetKakaoCallBack_FullMethodName)
+	}
+	return a.m.GetKakaoCallBackFunc(ctx, in)
+}
+
+func (a accountServiceMockAPI) Login(ctx context.Context, in *gen.LoginRequest) (*gen.LoginResponse, error) {
+	if a.m.LoginFunc == nil {
+		return nil, unimplemented(gen.AccountService_Login_FullMethodName)
+	}
+	return a.m.LoginFunc(ctx, in)
+}
+
+func (a accountServiceMockAPI) Register(ctx context.Context, in *gen.RegisterRequest) (*gen.RegisterResponse, error) {
+	if a.m.RegisterFunc == nil {
+		return nil, unimplemented(gen.AccountService_Register_FullMethodName)
+	}
+	return a.m.RegisterFunc(ctx, in)
+}
+
+func (a accountServiceMockAPI) RefreshToken(ctx context.Context, in *gen.RefreshTokenRequest) (*gen.RefreshTokenResponse, error) {
+	if a.m.RefreshTokenFunc == nil {
+		return nil, unimplemented(gen.AccountService_RefreshToken_FullMethodName)
+	}
+	return a.m.RefreshTokenFunc(ctx, in)
+}
+
+func (a accountServiceMockAPI) RevokeToken(ctx context.Context, in *gen.RevokeTokenRequest) (*gen.RevokeTokenResponse, error) {
+	if a.m.RevokeTokenFunc == nil {
+		return nil, unimplemented(gen.AccountService_RevokeToken_FullMethodName)
+	}
+	return a.m.RevokeTokenFunc(ctx, in)
+}
+
+func (a accountServiceMockAPI) AnonymizeUserData(ctx context.Context, in *gen.AnonymizeUserDataRequest) (*gen.AnonymizeUserDataResponse, error) {
+	if a.m.AnonymizeUserDataFunc == nil {
+		return nil, unimplemented(gen.AccountService_AnonymizeUserData_FullMethodName)
+	}
+	return a.m.AnonymizeUserDataFunc(ctx, in)
+}
+
+func (a accountServiceMockAPI) AcceptTerms(ctx context.Context, in *gen.AcceptTermsRequest) (*gen.AcceptTermsResponse, error) {
+	if a.m.AcceptTermsFunc == nil {
+		return nil, unimplemented(gen.AccountService_AcceptTerms_FullMethodName)
+	}
+	return a.m.AcceptTermsFunc(ctx, in)
+}
+
+func (a accountServiceMockAPI) RegisterPushToken(ctx context.Context, in *gen.RegisterPushTokenRequest) (*gen.RegisterPushTokenResponse, error) {
+	if a.m.RegisterPushTokenFunc == nil {
+		return nil, unimplemented(gen.AccountService_RegisterPushToken_FullMethodName)
+	}
+	return a.m.RegisterPushTokenFunc(ctx, in)
+}
+
+func (a accountServiceMockAPI) UnregisterPushToken(ctx context.Context, in *gen.UnregisterPushTokenRequest) (*gen.UnregisterPushTokenResponse, error) {
+	if a.m.UnregisterPushTokenFunc == nil {
+		return nil, unimplemented(gen.AccountService_UnregisterPushToken_FullMethodName)
+	}
+	return a.m.UnregisterPushTokenFunc(ctx, in)
+}
+
+func (a accountServiceMockAPI) VerifyCaptcha(ctx context.Context, in *gen.VerifyCaptchaRequest) (*gen.VerifyCaptchaResponse, error) {
+	if a.m.VerifyCaptchaFunc == nil {
+		return nil, unimplemented(gen.AccountService_VerifyCaptcha_FullMethodName)
+	}
+	return a.m.VerifyCaptchaFunc(ctx, in)
+}
+
+func (a accountServiceMockAPI) UnlockAccount(ctx context.Context, in *gen.UnlockAccountRequest) (*gen.UnlockAccountResponse, error) {
+	if a.m.UnlockAccountFunc == nil {
+		return nil, unimplemented(gen.AccountService_UnlockAccount_FullMethodName)
+	}
+	return a.m.UnlockAccountFunc(ctx, in)
+}
+
+func (a accountServiceMockAPI) IssueGuestToken(ctx context.Context, in *gen.IssueGuestTokenRequest) (*gen.IssueGuestTokenResponse, error) {
+	if a.m.IssueGuestTokenFunc == nil {
+		return nil, unimplemented(gen.AccountService_IssueGuestToken_FullMethodName)
+	}
+	return a.m.IssueGuestTokenFunc(ctx, in)
+}
+
+func (a accountServiceMockAPI) MergeAccounts(ctx context.Context, in *gen.MergeAccountsRequest) (*gen.MergeAccountsResponse, error) {
+	if a.m.MergeAccountsFunc == nil {
+		return nil, unimplemented(gen.AccountService_MergeAccounts_FullMethodName)
+	}
+	return a.m.MergeAccountsFunc(ctx, in)
+}
+
+func (a accountServiceMockAPI) RequestEmailChange(ctx context.Context, in *gen.RequestEmailChangeRequest) (*gen.RequestEmailChangeResponse, error) {
+	if a.m.RequestEmailChangeFunc == nil {
+		return nil, unimplemented(gen.AccountService_RequestEmailChange_FullMethodName)
+	}
+	return a.m.RequestEmailChangeFunc(ctx, in)
+}
+
+func (a accountServiceMockAPI) ConfirmEmailChange(ctx context.Context, in *gen.ConfirmEmailChangeRequest) (*gen.ConfirmEmailChangeResponse, error) {
+	if a.m.ConfirmEmailChangeFunc == nil {
+		return nil, unimplemented(gen.AccountService_ConfirmEmailChange_FullMethodName)
+	}
+	return a.m.ConfirmEmailChangeFunc(ctx, in)
+}
+
+func (a accountServiceMockAPI) UploadAvatar(ctx context.Context, in iter.Seq[*gen.UploadAvatarRequest]) (*gen.UploadAvatarResponse, error) {
+	if a.m.UploadAvatarFunc == nil {
+		return nil, unimplemented(gen.AccountService_UploadAvatar_FullMethodName)
+	}
+	return a.m.UploadAvatarFunc(ctx, in)
+}
+
+func (a accountServiceMockAPI) GetPreferences(ctx context.Context, in *gen.GetPreferencesRequest) (*gen.GetPreferencesResponse, error) {
+	if a.m.GetPreferencesFunc == nil {
+		return nil, unimplemented(gen.AccountService_GetPreferences_FullMethodName)
+	}
+	return a.m.GetPreferencesFunc(ctx, in)
+}
+
+func (a accountServiceMockAPI) SetPreferences(ctx context.Context, in *gen.SetPreferencesRequest) (*gen.SetPreferencesResponse, error) {
+	if a.m.SetPreferencesFunc == nil {
+		return nil, unimplemented(gen.AccountService_SetPreferences_FullMethodName)
+	}
+	return a.m.SetPreferencesFunc(ctx, in)
+}
+
+func (a accountServiceMockAPI) GetMe(ctx context.Context, in *gen.GetMeRequest) (*gen.GetMeResponse, error) {
+	if a.m.GetMeFunc == nil {
+		return nil, unimplemented(gen.AccountService_GetMe_FullMethodName)
+	}
+	return a.m.GetMeFunc(ctx, in)
+}
+
+func (a accountServiceMockAPI) UpdateProfile(ctx context.Context, in *gen.UpdateProfileRequest) (*gen.UpdateProfileResponse, error) {
+	if a.m.UpdateProfileFunc == nil {
+		return nil, unimplemented(gen.AccountService_UpdateProfile_FullMethodName)
+	}
+	return a.m.UpdateProfileFunc(ctx, in)
+}
+
+func (a accountServiceMockAPI) ChangePassword(ctx context.Context, in *gen.ChangePasswordRequest) (*gen.ChangePasswordResponse, error) {
+	if a.m.ChangePasswordFunc == nil {
+		return nil, unimplemented(gen.AccountService_ChangePassword_FullMethodName)
+	}
+	return a.m.ChangePasswordFunc(ctx, in)
+}
+
+func (a accountServiceMockAPI) DeleteAccount(ctx context.Context, in *gen.DeleteAccountRequest) (*gen.DeleteAccountResponse, error) {
+	if a.m.DeleteAccountFunc == nil {
+		return nil, unimplemented(gen.AccountService_DeleteAccount_FullMethodName)
+	}
+	return a.m.DeleteAccountFunc(ctx, in)
+}
+
+func (a accountServiceMockAPI) CreateAPIKey(ctx context.Context, in *gen.CreateAPIKeyRequest) (*gen.CreateAPIKeyResponse, error) {
+	if a.m.CreateAPIKeyFunc == nil {
+		return nil, unimplemented(gen.AccountService_CreateAPIKey_FullMethodName)
+	}
+	return a.m.CreateAPIKeyFunc(ctx, in)
+}
+
+func (a accountServiceMockAPI) RevokeAPIKey(ctx context.Context, in *gen.RevokeAPIKeyRequest) (*gen.RevokeAPIKeyResponse, error) {
+	if a.m.RevokeAPIKeyFunc == nil {
+		return nil, unimplemented(gen.AccountService_RevokeAPIKey_FullMethodName)
+	}
+	return a.m.RevokeAPIKeyFunc(ctx, in)
+}
+
+func (a accountServiceMockAPI) ValidateAPIKey(ctx context.Context, in *gen.ValidateAPIKeyRequest) (*gen.ValidateAPIKeyResponse, error) {
+	if a.m.ValidateAPIKeyFunc == nil {
+		return nil, unimplemented(gen.AccountService_ValidateAPIKey_FullMethodName)
+	}
+	return a.m.ValidateAPIKeyFunc(ctx, in)
+}
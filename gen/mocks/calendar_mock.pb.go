@@ -0,0 +1,38 @@
+// Code generated by protoc-gen-go-mock. DO NOT EDIT.
+// source: calendar.proto
+
+package mocks
+
+import (
+	context "context"
+	gen "github.com/escape-ship/protos/gen"
+	grpc "google.golang.org/grpc"
+)
+
+// MockCalendarServiceClient is a programmable gen.CalendarServiceClient. Set the
+// XxxFunc fields to script responses; calls to unset methods fail with
+// Unimplemented. Every call is recorded.
+type MockCalendarServiceClient struct {
+	Recorder
+
+	ListHolidaysFunc    func(ctx context.Context, in *gen.ListHolidaysRequest) (*gen.ListHolidaysResponse, error)
+	GetSupportHoursFunc func(ctx context.Context, in *gen.GetSupportHoursRequest) (*gen.GetSupportHoursResponse, error)
+}
+
+var _ gen.CalendarServiceClient = (*MockCalendarServiceClient)(nil)
+
+func (m *MockCalendarServiceClient) ListHolidays(ctx context.Context, in *gen.ListHolidaysRequest, _ ...grpc.CallOption) (*gen.ListHolidaysResponse, error) {
+	m.record(gen.CalendarService_ListHolidays_FullMethodName, in)
+	if m.ListHolidaysFunc == nil {
+		return nil, unimplemented(gen.CalendarService_ListHolidays_FullMethodName)
+	}
+	return m.ListHolidaysFunc(ctx, in)
+}
+
+func (m *MockCalendarServiceClient) GetSupportHours(ctx context.Context, in *gen.GetSupportHoursRequest, _ ...grpc.CallOption) (*gen.GetSupportHoursResponse, error) {
+	m.record(gen.CalendarService_GetSupportHours_FullMethodName, in)
+	if m.GetSupportHoursFunc == nil {
+		return nil, unimplemented(gen.CalendarService_GetSupportHours_FullMethodName)
+	}
+	return m.GetSupportHoursFunc(ctx, in)
+}
@@ -0,0 +1,65 @@
+// Code generated by protoc-gen-go-mock. DO NOT EDIT.
+// source: cart.proto
+
+package mocks
+
+import (
+	context "context"
+	gen "github.com/escape-ship/protos/gen"
+	grpc "google.golang.org/grpc"
+)
+
+// MockCartServiceClient is a programmable gen.CartServiceClient. Set the
+// XxxFunc fields to script responses; calls to unset methods fail with
+// Unimplemented. Every call is recorded.
+type MockCartServiceClient struct {
+	Recorder
+
+	GetCartFunc        func(ctx context.Context, in *gen.GetCartRequest) (*gen.GetCartResponse, error)
+	AddItemFunc        func(ctx context.Context, in *gen.AddItemRequest) (*gen.AddItemResponse, error)
+	RemoveItemFunc     func(ctx context.Context, in *gen.RemoveItemRequest) (*gen.RemoveItemResponse, error)
+	UpdateQuantityFunc func(ctx context.Context, in *gen.UpdateQuantityRequest) (*gen.UpdateQuantityResponse, error)
+	ClearCartFunc      func(ctx context.Context, in *gen.ClearCartRequest) (*gen.ClearCartResponse, error)
+}
+
+var _ gen.CartServiceClient = (*MockCartServiceClient)(nil)
+
+func (m *MockCartServiceClient) GetCart(ctx context.Context, in *gen.GetCartRequest, _ ...grpc.CallOption) (*gen.GetCartResponse, error) {
+	m.record(gen.CartService_GetCart_FullMethodName, in)
+	if m.GetCartFunc == nil {
+		return nil, unimplemented(gen.CartService_GetCart_FullMethodName)
+	}
+	return m.GetCartFunc(ctx, in)
+}
+
+func (m *MockCartServiceClient) AddItem(ctx context.Context, in *gen.AddItemRequest, _ ...grpc.CallOption) (*gen.AddItemResponse, error) {
+	m.record(gen.CartService_AddItem_FullMethodName, in)
+	if m.AddItemFunc == nil {
+		return nil, unimplemented(gen.CartService_AddItem_FullMethodName)
+	}
+	return m.AddItemFunc(ctx, in)
+}
+
+func (m *MockCartServiceClient) RemoveItem(ctx context.Context, in *gen.RemoveItemRequest, _ ...grpc.CallOption) (*gen.RemoveItemResponse, error) {
+	m.record(gen.CartService_RemoveItem_FullMethodName, in)
+	if m.RemoveItemFunc == nil {
+		return nil, unimplemented(gen.CartService_RemoveItem_FullMethodName)
+	}
+	return m.RemoveItemFunc(ctx, in)
+}
+
+func (m *MockCartServiceClient) UpdateQuantity(ctx context.Context, in *gen.UpdateQuantityRequest, _ ...grpc.CallOption) (*gen.UpdateQuantityResponse, error) {
+	m.record(gen.CartService_UpdateQuantity_FullMethodName, in)
+	if m.UpdateQuantityFunc == nil {
+		return nil, unimplemented(gen.CartService_UpdateQuantity_FullMethodName)
+	}
+	return m.UpdateQuantityFunc(ctx, in)
+}
+
+func (m *MockCartServiceClient) ClearCart(ctx context.Context, in *gen.ClearCartRequest, _ ...grpc.CallOption) (*gen.ClearCartResponse, error) {
+	m.record(gen.CartService_ClearCart_FullMethodName, in)
+	if m.ClearCartFunc == nil {
+		return nil, unimplemented(gen.CartService_ClearCart_FullMethodName)
+	}
+	return m.ClearCartFunc(ctx, in)
+}
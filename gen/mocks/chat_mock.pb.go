@@ -0,0 +1,70 @@
+// Code generated by protoc-gen-go-mock. DO NOT EDIT.
+// source: chat.proto
+
+package mocks
+
+import (
+	context "context"
+	gen "github.com/escape-ship/protos/gen"
+	grpc "google.golang.org/grpc"
+	iter "iter"
+)
+
+// MockChatServiceClient is a programmable gen.ChatServiceClient. Set the
+// XxxFunc fields to script responses; calls to unset methods fail with
+// Unimplemented. Every call is recorded.
+type MockChatServiceClient struct {
+	Recorder
+
+	OpenConversationFunc func(ctx context.Context, in *gen.OpenConversationRequest) (*gen.OpenConversationResponse, error)
+	ListChatMessagesFunc func(ctx context.Context, in *gen.ListChatMessagesRequest) (*gen.ListChatMessagesResponse, error)
+	ChatFunc             func(ctx context.Context, in iter.Seq[*gen.ChatRequest]) iter.Seq2[*gen.ChatResponse, error]
+}
+
+var _ gen.ChatServiceClient = (*MockChatServiceClient)(nil)
+
+func (m *MockChatServiceClient) OpenConversation(ctx context.Context, in *gen.OpenConversationRequest, _ ...grpc.CallOption) (*gen.OpenConversationResponse, error) {
+	m.record(gen.ChatService_OpenConversation_FullMethodName, in)
+	if m.OpenConversationFunc == nil {
+		return nil, unimplemented(gen.ChatService_OpenConversation_FullMethodName)
+	}
+	return m.OpenConversationFunc(ctx, in)
+}
+
+func (m *MockChatServiceClient) ListChatMessages(ctx context.Context, in *gen.ListChatMessagesRequest, _ ...grpc.CallOption) (*gen.ListChatMessagesResponse, error) {
+	m.record(gen.ChatService_ListChatMessages_FullMethodName, in)
+	if m.ListChatMessagesFunc == nil {
+		return nil, unimplemented(gen.ChatService_ListChatMessages_FullMethodName)
+	}
+	return m.ListChatMessagesFunc(ctx, in)
+}
+
+func (m *MockChatServiceClient) Chat(ctx context.Context, _ ...grpc.CallOption) (grpc.BidiStreamingClient[gen.ChatRequest, gen.ChatResponse], error) {
+	m.record(gen.ChatService_Chat_FullMethodName, nil)
+	return gen.ChatServiceClientFromAPI(chatServiceMockAPI{m}).Chat(ctx)
+}
+
+// chatServiceMockAPI serves the streaming methods of MockChatServiceClient through
+// gen.ChatServiceClientFromAPI.
+type chatServiceMockAPI struct{ m *MockChatServiceClient }
+
+func (a chatServiceMockAPI) OpenConversation(ctx context.Context, in *gen.OpenConversationRequest) (*gen.OpenConversationResponse, error) {
+	if a.m.OpenConversationFunc == nil {
+		return nil, unimplemented(gen.ChatService_OpenConversation_FullMethodName)
+	}
+	return a.m.OpenConversationFunc(ctx, in)
+}
+
+func (a chatServiceMockAPI) ListChatMessages(ctx context.Context, in *gen.ListChatMessagesRequest) (*gen.ListChatMessagesResponse, error) {
+	if a.m.ListChatMessagesFunc == nil {
+		return nil, unimplemented(gen.ChatService_ListChatMessages_FullMethodName)
+	}
+	return a.m.ListChatMessagesFunc(ctx, in)
+}
+
+func (a chatServiceMockAPI) Chat(ctx context.Context, in iter.Seq[*gen.ChatRequest]) iter.Seq2[*gen.ChatResponse, error] {
+	if a.m.ChatFunc == nil {
+		return errSeq[gen.ChatResponse](unimplemented(gen.ChatService_Chat_FullMethodName))
+	}
+	return a.m.ChatFunc(ctx, in)
+}
@@ -0,0 +1,65 @@
+// Code generated by protoc-gen-go-mock. DO NOT EDIT.
+// source: flashsale.proto
+
+package mocks
+
+import (
+	context "context"
+	gen "github.com/escape-ship/protos/gen"
+	grpc "google.golang.org/grpc"
+)
+
+// MockFlashSaleServiceClient is a programmable gen.FlashSaleServiceClient. Set the
+// XxxFunc fields to script responses; calls to unset methods fail with
+// Unimplemented. Every call is recorded.
+type MockFlashSaleServiceClient struct {
+	Recorder
+
+	CreateFlashSaleFunc    func(ctx context.Context, in *gen.CreateFlashSaleRequest) (*gen.CreateFlashSaleResponse, error)
+	GetFlashSaleFunc       func(ctx context.Context, in *gen.GetFlashSaleRequest) (*gen.GetFlashSaleResponse, error)
+	GetQueuePositionFunc   func(ctx context.Context, in *gen.GetQueuePositionRequest) (*gen.GetQueuePositionResponse, error)
+	IssueQueueTokenFunc    func(ctx context.Context, in *gen.IssueQueueTokenRequest) (*gen.IssueQueueTokenResponse, error)
+	ValidateQueueTokenFunc func(ctx context.Context, in *gen.ValidateQueueTokenRequest) (*gen.ValidateQueueTokenResponse, error)
+}
+
+var _ gen.FlashSaleServiceClient = (*MockFlashSaleServiceClient)(nil)
+
+func (m *MockFlashSaleServiceClient) CreateFlashSale(ctx context.Context, in *gen.CreateFlashSaleRequest, _ ...grpc.CallOption) (*gen.CreateFlashSaleResponse, error) {
+	m.record(gen.FlashSaleService_CreateFlashSale_FullMethodName, in)
+	if m.CreateFlashSaleFunc == nil {
+		return nil, unimplemented(gen.FlashSaleService_CreateFlashSale_FullMethodName)
+	}
+	return m.CreateFlashSaleFunc(ctx, in)
+}
+
+func (m *MockFlashSaleServiceClient) GetFlashSale(ctx context.Context, in *gen.GetFlashSaleRequest, _ ...grpc.CallOption) (*gen.GetFlashSaleResponse, error) {
+	m.record(gen.FlashSaleService_GetFlashSale_FullMethodName, in)
+	if m.GetFlashSaleFunc == nil {
+		return nil, unimplemented(gen.FlashSaleService_GetFlashSale_FullMethodName)
+	}
+	return m.GetFlashSaleFunc(ctx, in)
+}
+
+func (m *MockFlashSaleServiceClient) GetQueuePosition(ctx context.Context, in *gen.GetQueuePositionRequest, _ ...grpc.CallOption) (*gen.GetQueuePositionResponse, error) {
+	m.record(gen.FlashSaleService_GetQueuePosition_FullMethodName, in)
+	if m.GetQueuePositionFunc == nil {
+		return nil, unimplemented(gen.FlashSaleService_GetQueuePosition_FullMethodName)
+	}
+	return m.GetQueuePositionFunc(ctx, in)
+}
+
+func (m *MockFlashSaleServiceClient) IssueQueueToken(ctx context.Context, in *gen.IssueQueueTokenRequest, _ ...grpc.CallOption) (*gen.IssueQueueTokenResponse, error) {
+	m.record(gen.FlashSaleService_IssueQueueToken_FullMethodName, in)
+	if m.IssueQueueTokenFunc == nil {
+		return nil, unimplemented(gen.FlashSaleService_IssueQueueToken_FullMethodName)
+	}
+	return m.IssueQueueTokenFunc(ctx, in)
+}
+
+func (m *MockFlashSaleServiceClient) ValidateQueueToken(ctx context.Context, in *gen.ValidateQueueTokenRequest, _ ...grpc.CallOption) (*gen.ValidateQueueTokenResponse, error) {
+	m.record(gen.FlashSaleService_ValidateQueueToken_FullMethodName, in)
+	if m.ValidateQueueTokenFunc == nil {
+		return nil, unimplemented(gen.FlashSaleService_ValidateQueueToken_FullMethodName)
+	}
+	return m.ValidateQueueTokenFunc(ctx, in)
+}
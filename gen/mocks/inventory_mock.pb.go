@@ -0,0 +1,118 @@
+// Code generated by protoc-gen-go-mock. DO NOT EDIT.
+// source: inventory.proto
+
+package mocks
+
+import (
+	context "context"
+	gen "github.com/escape-ship/protos/gen"
+	grpc "google.golang.org/grpc"
+	iter "iter"
+)
+
+// MockInventoryServiceClient is a programmable gen.InventoryServiceClient. Set the
+// XxxFunc fields to script responses; calls to unset methods fail with
+// Unimplemented. Every call is recorded.
+type MockInventoryServiceClient struct {
+	Recorder
+
+	WatchLowStockFunc      func(ctx context.Context, in *gen.WatchLowStockRequest) iter.Seq2[*gen.WatchLowStockResponse, error]
+	GetStockFunc           func(ctx context.Context, in *gen.GetStockRequest) (*gen.GetStockResponse, error)
+	ReserveStockFunc       func(ctx context.Context, in *gen.ReserveStockRequest) (*gen.ReserveStockResponse, error)
+	ReleaseReservationFunc func(ctx context.Context, in *gen.ReleaseReservationRequest) (*gen.ReleaseReservationResponse, error)
+	AdjustStockFunc        func(ctx context.Context, in *gen.AdjustStockRequest) (*gen.AdjustStockResponse, error)
+	AuditInventoryFunc     func(ctx context.Context, in *gen.AuditInventoryRequest) (*gen.AuditInventoryResponse, error)
+}
+
+var _ gen.InventoryServiceClient = (*MockInventoryServiceClient)(nil)
+
+func (m *MockInventoryServiceClient) WatchLowStock(ctx context.Context, in *gen.WatchLowStockRequest, _ ...grpc.CallOption) (grpc.ServerStreamingClient[gen.WatchLowStockResponse], error) {
+	m.record(gen.InventoryService_WatchLowStock_FullMethodName, in)
+	return gen.InventoryServiceClientFromAPI(inventoryServiceMockAPI{m}).WatchLowStock(ctx, in)
+}
+
+func (m *MockInventoryServiceClient) GetStock(ctx context.Context, in *gen.GetStockRequest, _ ...grpc.CallOption) (*gen.GetStockResponse, error) {
+	m.record(gen.InventoryService_GetStock_FullMethodName, in)
+	if m.GetStockFunc == nil {
+		return nil, unimplemented(gen.InventoryService_GetStock_FullMethodName)
+	}
+	return m.GetStockFunc(ctx, in)
+}
+
+func (m *MockInventoryServiceClient) ReserveStock(ctx context.Context, in *gen.ReserveStockRequest, _ ...grpc.CallOption) (*gen.ReserveStockResponse, error) {
+	m.record(gen.InventoryService_ReserveStock_FullMethodName, in)
+	if m.ReserveStockFunc == nil {
+		return nil, unimplemented(gen.InventoryService_ReserveStock_FullMethodName)
+	}
+	return m.ReserveStockFunc(ctx, in)
+}
+
+func (m *MockInventoryServiceClient) ReleaseReservation(ctx context.Context, in *gen.ReleaseReservationRequest, _ ...grpc.CallOption) (*gen.ReleaseReservationResponse, error) {
+	m.record(gen.InventoryService_ReleaseReservation_FullMethodName, in)
+	if m.ReleaseReservationFunc == nil {
+		return nil, unimplemented(gen.InventoryService_ReleaseReservation_FullMethodName)
+	}
+	return m.ReleaseReservationFunc(ctx, in)
+}
+
+func (m *MockInventoryServiceClient) AdjustStock(ctx context.Context, in *gen.AdjustStockRequest, _ ...grpc.CallOption) (*gen.AdjustStockResponse, error) {
+	m.record(gen.InventoryService_AdjustStock_FullMethodName, in)
+	if m.AdjustStockFunc == nil {
+		return nil, unimplemented(gen.InventoryService_AdjustStock_FullMethodName)
+	}
+	return m.AdjustStockFunc(ctx, in)
+}
+
+func (m *MockInventoryServiceClient) AuditInventory(ctx context.Context, in *gen.AuditInventoryRequest, _ ...grpc.CallOption) (*gen.AuditInventoryResponse, error) {
+	m.record(gen.InventoryService_AuditInventory_FullMethodName, in)
+	if m.AuditInventoryFunc == nil {
+		return nil, unimplemented(gen.InventoryService_AuditInventory_FullMethodName)
+	}
+	return m.AuditInventoryFunc(ctx, in)
+}
+
+// inventoryServiceMockAPI serves the streaming methods of MockInventoryServiceClient through
+// gen.InventoryServiceClientFromAPI.
+type inventoryServiceMockAPI struct{ m *MockInventoryServiceClient }
+
+func (a inventoryServiceMockAPI) WatchLowStock(ctx context.Context, in *gen.WatchLowStockRequest) iter.Seq2[*gen.WatchLowStockResponse, error] {
+	if a.m.WatchLowStockFunc == nil {
+		return errSeq[gen.WatchLowStockResponse](unimplemented(gen.InventoryService_WatchLowStock_FullMethodName))
+	}
+	return a.m.WatchLowStockFunc(ctx, in)
+}
+
+func (a inventoryServiceMockAPI) GetStock(ctx context.Context, in *gen.GetStockRequest) (*gen.GetStockResponse, error) {
+	if a.m.GetStockFunc == nil {
+		return nil, unimplemented(gen.InventoryService_GetStock_FullMethodName)
+	}
+	return a.m.GetStockFunc(ctx, in)
+}
+
+func (a inventoryServiceMockAPI) ReserveStock(ctx context.Context, in *gen.ReserveStockRequest) (*gen.ReserveStockResponse, error) {
+	if a.m.ReserveStockFunc == nil {
+		return nil, unimplemented(gen.InventoryService_ReserveStock_FullMethodName)
+	}
+	return a.m.ReserveStockFunc(ctx, in)
+}
+
+func (a inventoryServiceMockAPI) ReleaseReservation(ctx context.Context, in *gen.ReleaseReservationRequest) (*gen.ReleaseReservationResponse, error) {
+	if a.m.ReleaseReservationFunc == nil {
+		return nil, unimplemented(gen.InventoryService_ReleaseReservation_FullMethodName)
+	}
+	return a.m.ReleaseReservationFunc(ctx, in)
+}
+
+func (a inventoryServiceMockAPI) AdjustStock(ctx context.Context, in *gen.AdjustStockRequest) (*gen.AdjustStockResponse, error) {
+	if a.m.AdjustStockFunc == nil {
+		return nil, unimplemented(gen.InventoryService_AdjustStock_FullMethodName)
+	}
+	return a.m.AdjustStockFunc(ctx, in)
+}
+
+func (a inventoryServiceMockAPI) AuditInventory(ctx context.Context, in *gen.AuditInventoryRequest) (*gen.AuditInventoryResponse, error) {
+	if a.m.AuditInventoryFunc == nil {
+		return nil, unimplemented(gen.InventoryService_AuditInventory_FullMethodName)
+	}
+	return a.m.AuditInventoryFunc(ctx, in)
+}
@@ -0,0 +1,25 @@
+package mocks
+
+import (
+	"testing"
+
+	pb "github.com/escape-ship/protos/gen"
+	"google.golang.org/grpc/connectivity"
+)
+
+func TestClientSetWithoutConn(t *testing.T) {
+	cs := &pb.ClientSet{Order: &MockOrderServiceClient{}}
+	if got := cs.State(); got != connectivity.Ready {
+		t.Errorf("State() = %v, want READY", got)
+	}
+	if !cs.Ready() {
+		t.Error("Ready() = false, want true")
+	}
+	stop := cs.OnStateChange(func(from, to connectivity.State) {
+		t.Errorf("state changed from %v to %v", from, to)
+	})
+	stop()
+	if err := cs.Close(); err != nil {
+		t.Errorf("Close() = %v", err)
+	}
+}
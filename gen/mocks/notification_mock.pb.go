@@ -0,0 +1,152 @@
+// Code generated by protoc-gen-go-mock. DO NOT EDIT.
+// source: notification.proto
+
+package mocks
+
+import (
+	context "context"
+	gen "github.com/escape-ship/protos/gen"
+	grpc "google.golang.org/grpc"
+	iter "iter"
+)
+
+// MockNotificationServiceClient is a programmable gen.NotificationServiceClient. Set the
+// XxxFunc fields to script responses; calls to unset methods fail with
+// Unimplemented. Every call is recorded.
+type MockNotificationServiceClient struct {
+	Recorder
+
+	GetNotificationPreferencesFunc    func(ctx context.Context, in *gen.GetNotificationPreferencesRequest) (*gen.GetNotificationPreferencesResponse, error)
+	UpdateNotificationPreferencesFunc func(ctx context.Context, in *gen.UpdateNotificationPreferencesRequest) (*gen.UpdateNotificationPreferencesResponse, error)
+	ListNotificationsFunc             func(ctx context.Context, in *gen.ListNotificationsRequest) (*gen.ListNotificationsResponse, error)
+	MarkNotificationReadFunc          func(ctx context.Context, in *gen.MarkNotificationReadRequest) (*gen.MarkNotificationReadResponse, error)
+	SendEmailFunc                     func(ctx context.Context, in *gen.SendEmailRequest) (*gen.SendNotificationResponse, error)
+	SendSMSFunc                       func(ctx context.Context, in *gen.SendSMSRequest) (*gen.SendNotificationResponse, error)
+	SendPushFunc                      func(ctx context.Context, in *gen.SendPushRequest) (*gen.SendNotificationResponse, error)
+	SubscribeEventsFunc               func(ctx context.Context, in *gen.SubscribeEventsRequest) iter.Seq2[*gen.NotificationEvent, error]
+}
+
+var _ gen.NotificationServiceClient = (*MockNotificationServiceClient)(nil)
+
+func (m *MockNotificationServiceClient) GetNotificationPreferences(ctx context.Context, in *gen.GetNotificationPreferencesRequest, _ ...grpc.CallOption) (*gen.GetNotificationPreferencesResponse, error) {
+	m.record(gen.NotificationService_GetNotificationPreferences_FullMethodName, in)
+	if m.GetNotificationPreferencesFunc == nil {
+		return nil, unimplemented(gen.NotificationService_GetNotificationPreferences_FullMethodName)
+	}
+	return m.GetNotificationPreferencesFunc(ctx, in)
+}
+
+func (m *MockNotificationServiceClient) UpdateNotificationPreferences(ctx context.Context, in *gen.UpdateNotificationPreferencesRequest, _ ...grpc.CallOption) (*gen.UpdateNotificationPreferencesResponse, error) {
+	m.record(gen.NotificationService_UpdateNotificationPreferences_FullMethodName, in)
+	if m.UpdateNotificationPreferencesFunc == nil {
+		return nil, unimplemented(gen.NotificationService_UpdateNotificationPreferences_FullMethodName)
+	}
+	return m.UpdateNotificationPreferencesFunc(ctx, in)
+}
+
+func (m *MockNotificationServiceClient) ListNotifications(ctx context.Context, in *gen.ListNotificationsRequest, _ ...grpc.CallOption) (*gen.ListNotificationsResponse, error) {
+	m.record(gen.NotificationService_ListNotifications_FullMethodName, in)
+	if m.ListNotificationsFunc == nil {
+		return nil, unimplemented(gen.NotificationService_ListNotifications_FullMethodName)
+	}
+	return m.ListNotificationsFunc(ctx, in)
+}
+
+func (m *MockNotificationServiceClient) MarkNotificationRead(ctx context.Context, in *gen.MarkNotificationReadRequest, _ ...grpc.CallOption) (*gen.MarkNotificationReadResponse, error) {
+	m.record(gen.NotificationService_MarkNotificationRead_FullMethodName, in)
+	if m.MarkNotificationReadFunc == nil {
+		return nil, unimplemented(gen.NotificationService_MarkNotificationRead_FullMethodName)
+	}
+	return m.MarkNotificationReadFunc(ctx, in)
+}
+
+func (m *MockNotificationServiceClient) SendEmail(ctx context.Context, in *gen.SendEmailRequest, _ ...grpc.CallOption) (*gen.SendNotificationResponse, error) {
+	m.record(gen.NotificationService_SendEmail_FullMethodName, in)
+	if m.SendEmailFunc == nil {
+		return nil, unimplemented(gen.NotificationService_SendEmail_FullMethodName)
+	}
+	return m.SendEmailFunc(ctx, in)
+}
+
+func (m *MockNotificationServiceClient) SendSMS(ctx context.Context, in *gen.SendSMSRequest, _ ...grpc.CallOption) (*gen.SendNotificationResponse, error) {
+	m.record(gen.NotificationService_SendSMS_FullMethodName, in)
+	if m.SendSMSFunc == nil {
+		return nil, unimplemented(gen.NotificationService_SendSMS_FullMethodName)
+	}
+	return m.SendSMSFunc(ctx, in)
+}
+
+func (m *MockNotificationServiceClient) SendPush(ctx context.Context, in *gen.SendPushRequest, _ ...grpc.CallOption) (*gen.SendNotificationResponse, error) {
+	m.record(gen.NotificationService_SendPush_FullMethodName, in)
+	if m.SendPushFunc == nil {
+		return nil, unimplemented(gen.NotificationService_SendPush_FullMethodName)
+	}
+	return m.SendPushFunc(ctx, in)
+}
+
+func (m *MockNotificationServiceClient) SubscribeEvents(ctx context.Context, in *gen.SubscribeEventsRequest, _ ...grpc.CallOption) (grpc.ServerStreamingClient[gen.NotificationEvent], error) {
+	m.record(gen.NotificationService_SubscribeEvents_FullMethodName, in)
+	return gen.NotificationServiceClientFromAPI(notificationServiceMockAPI{m}).SubscribeEvents(ctx, in)
+}
+
+// notificationServiceMockAPI serves the streaming methods of MockNotificationServiceClient through
+// gen.NotificationServiceClientFromAPI.
+type notificationServiceMockAPI struct {
+	m *MockNotificationServiceClient
+}
+
+func (a notificationServiceMockAPI) GetNotificationPreferences(ctx context.Context, in *gen.GetNotificationPreferencesRequest) (*gen.GetNotificationPreferencesResponse, error) {
+	if a.m.GetNotificationPreferencesFunc == nil {
+		return nil, unimplemented(gen.NotificationService_GetNotificationPreferences_FullMethodName)
+	}
+	return a.m.GetNotificationPreferencesFunc(ctx, in)
+}
+
+func (a notificationServiceMockAPI) UpdateNotificationPreferences(ctx context.Context, in *gen.UpdateNotificationPreferencesRequest) (*gen.UpdateNotificationPreferencesResponse, error) {
+	if a.m.UpdateNotificationPreferencesFunc == nil {
+		return nil, unimplemented(gen.NotificationService_UpdateNotificationPreferences_FullMethodName)
+	}
+	return a.m.UpdateNotificationPreferencesFunc(ctx, in)
+}
+
+func (a notificationServiceMockAPI) ListNotifications(ctx context.Context, in *gen.ListNotificationsRequest) (*gen.ListNotificationsResponse, error) {
+	if a.m.ListNotificationsFunc == nil {
+		return nil, unimplemented(gen.NotificationService_ListNotifications_FullMethodName)
+	}
+	return a.m.ListNotificationsFunc(ctx, in)
+}
+
+func (a notificationServiceMockAPI) MarkNotificationRead(ctx context.Context, in *gen.MarkNotificationReadRequest) (*gen.MarkNotificationReadResponse, error) {
+	if a.m.MarkNotificationReadFunc == nil {
+		return nil, unimplemented(gen.NotificationService_MarkNotificationRead_FullMethodName)
+	}
+	return a.m.MarkNotificationReadFunc(ctx, in)
+}
+
+func (a notificationServiceMockAPI) SendEmail(ctx context.Context, in *gen.SendEmailRequest) (*gen.SendNotificationResponse, error) {
+	if a.m.SendEmailFunc == nil {
+		return nil, unimplemented(gen.NotificationService_SendEmail_FullMethodName)
+	}
+	return a.m.SendEmailFunc(ctx, in)
+}
+
+func (a notificationServiceMockAPI) SendSMS(ctx context.Context, in *gen.SendSMSRequest) (*gen.SendNotificationResponse, error) {
+	if a.m.SendSMSFunc == nil {
+		return nil, unimplemented(gen.NotificationService_SendSMS_FullMethodName)
+	}
+	return a.m.SendSMSFunc(ctx, in)
+}
+
+func (a notificationServiceMockAPI) SendPush(ctx context.Context, in *gen.SendPushRequest) (*gen.SendNotificationResponse, error) {
+	if a.m.SendPushFunc == nil {
+		return nil, unimplemented(gen.NotificationService_SendPush_FullMethodName)
+	}
+	return a.m.SendPushFunc(ctx, in)
+}
+
+func (a notificationServiceMockAPI) SubscribeEvents(ctx context.Context, in *gen.SubscribeEventsRequest) iter.Seq2[*gen.NotificationEvent, error] {
+	if a.m.SubscribeEventsFunc == nil {
+		return errSeq[gen.NotificationEvent](unimplemented(gen.NotificationService_SubscribeEvents_FullMethodName))
+	}
+	return a.m.SubscribeEventsFunc(ctx, in)
+}
@@ -0,0 +1,291 @@
+// Code generated by protoc-gen-go-mock. DO NOT EDIT.
+// source: order.proto
+
+package mocks
+
+import (
+	context "context"
+	gen "github.com/escape-ship/protos/gen"
+	grpc "google.golang.org/grpc"
+	iter "iter"
+)
+
+// MockOrderServiceClient is a programmable gen.OrderServiceClient. Set the
+// XxxFunc fields to script responses; calls to unset methods fail with
+// Unimplemented. Every call is recorded.
+type MockOrderServiceClient struct {
+	Recorder
+
+	InsertOrderFunc              func(ctx context.Context, in *gen.InsertOrderRequest) (*gen.InsertOrderResponse, error)
+	GetAllOrdersFunc             func(ctx context.Context, in *gen.GetAllOrdersRequest) (*gen.GetAllOrdersResponse, error)
+	GetOrderByIDFunc             func(ctx context.Context, in *gen.GetOrderByIDRequest) (*gen.GetOrderByIDResponse, error)
+	GetOrdersByUserFunc          func(ctx context.Context, in *gen.GetOrdersByUserRequest) (*gen.GetOrdersByUserResponse, error)
+	WatchOrderFunc               func(ctx context.Context, in *gen.WatchOrderRequest) iter.Seq2[*gen.OrderStatusEvent, error]
+	CancelOrderFunc              func(ctx context.Context, in *gen.CancelOrderRequest) (*gen.CancelOrderResponse, error)
+	RefundOrderFunc              func(ctx context.Context, in *gen.RefundOrderRequest) (*gen.RefundOrderResponse, error)
+	CreateReturnLabelFunc        func(ctx context.Context, in *gen.CreateReturnLabelRequest) (*gen.CreateReturnLabelResponse, error)
+	ImportOrdersFunc             func(ctx context.Context, in iter.Seq[*gen.ImportOrdersRequest]) (*gen.ImportOrdersResponse, error)
+	GetOrdersByIDsFunc           func(ctx context.Context, in *gen.GetOrdersByIDsRequest) (*gen.GetOrdersByIDsResponse, error)
+	ArchiveOrdersFunc            func(ctx context.Context, in *gen.ArchiveOrdersRequest) (*gen.ArchiveOrdersResponse, error)
+	GetArchivedOrderFunc         func(ctx context.Context, in *gen.GetArchivedOrderRequest) (*gen.GetArchivedOrderResponse, error)
+	CreateQuoteFunc              func(ctx context.Context, in *gen.CreateQuoteRequest) (*gen.CreateQuoteResponse, error)
+	AcceptQuoteFunc              func(ctx context.Context, in *gen.AcceptQuoteRequest) (*gen.AcceptQuoteResponse, error)
+	ConvertQuoteToOrderFunc      func(ctx context.Context, in *gen.ConvertQuoteToOrderRequest) (*gen.ConvertQuoteToOrderResponse, error)
+	PriceOrderFunc               func(ctx context.Context, in *gen.PriceOrderRequest) (*gen.PriceOrderResponse, error)
+	CheckPurchaseEligibilityFunc func(ctx context.Context, in *gen.CheckPurchaseEligibilityRequest) (*gen.CheckPurchaseEligibilityResponse, error)
+}
+
+var _ gen.OrderServiceClient = (*MockOrderServiceClient)(nil)
+
+func (m *MockOrderServiceClient) InsertOrder(ctx context.Context, in *gen.InsertOrderRequest, _ ...grpc.CallOption) (*gen.InsertOrderResponse, error) {
+	m.record(gen.OrderService_InsertOrder_FullMethodName, in)
+	if m.InsertOrderFunc == nil {
+		return nil, unimplemented(gen.OrderService_InsertOrder_FullMethodName)
+	}
+	return m.InsertOrderFunc(ctx, in)
+}
+
+func (m *MockOrderServiceClient) GetAllOrders(ctx context.Context, in *gen.GetAllOrdersRequest, _ ...grpc.CallOption) (*gen.GetAllOrdersResponse, error) {
+	m.record(gen.OrderService_GetAllOrders_FullMethodName, in)
+	if m.GetAllOrdersFunc == nil {
+		return nil, unimplemented(gen.OrderService_GetAllOrders_FullMethodName)
+	}
+	return m.GetAllOrdersFunc(ctx, in)
+}
+
+func (m *MockOrderServiceClient) GetOrderByID(ctx context.Context, in *gen.GetOrderByIDRequest, _ ...grpc.CallOption) (*gen.GetOrderByIDResponse, error) {
+	m.record(gen.OrderService_GetOrderByID_FullMethodName, in)
+	if m.GetOrderByIDFunc == nil {
+		return nil, unimplemented(gen.OrderService_GetOrderByID_FullMethodName)
+	}
+	return m.GetOrderByIDFunc(ctx, in)
+}
+
+func (m *MockOrderServiceClient) GetOrdersByUser(ctx context.Context, in *gen.GetOrdersByUserRequest, _ ...grpc.CallOption) (*gen.GetOrdersByUserResponse, error) {
+	m.record(gen.OrderService_GetOrdersByUser_FullMethodName, in)
+	if m.GetOrdersByUserFunc == nil {
+		return nil, unimplemented(gen.OrderService_GetOrdersByUser_FullMethodName)
+	}
+	return m.GetOrdersByUserFunc(ctx, in)
+}
+
+func (m *MockOrderServiceClient) WatchOrder(ctx context.Context, in *gen.WatchOrderRequest, _ ...grpc.CallOption) (grpc.ServerStreamingClient[gen.OrderStatusEvent], error) {
+	m.record(gen.OrderService_WatchOrder_FullMethodName, in)
+	return gen.OrderServiceClientFromAPI(orderServiceMockAPI{m}).WatchOrder(ctx, in)
+}
+
+func (m *MockOrderServiceClient) CancelOrder(ctx context.Context, in *gen.CancelOrderRequest, _ ...grpc.CallOption) (*gen.CancelOrderResponse, error) {
+	m.record(gen.OrderService_CancelOrder_FullMethodName, in)
+	if m.CancelOrderFunc == nil {
+		return nil, unimplemented(gen.OrderService_CancelOrder_FullMethodName)
+	}
+	return m.CancelOrderFunc(ctx, in)
+}
+
+func (m *MockOrderServiceClient) RefundOrder(ctx context.Context, in *gen.RefundOrderRequest, _ ...grpc.CallOption) (*gen.RefundOrderResponse, error) {
+	m.record(gen.OrderService_RefundOrder_FullMethodName, in)
+	if m.RefundOrderFunc == nil {
+		return nil, unimplemented(gen.OrderService_RefundOrder_FullMethodName)
+	}
+	return m.RefundOrderFunc(ctx, in)
+}
+
+func (m *MockOrderServiceClient) CreateReturnLabel(ctx context.Context, in *gen.CreateReturnLabelRequest, _ ...grpc.CallOption) (*gen.CreateReturnLabelResponse, error) {
+	m.record(gen.OrderService_CreateReturnLabel_FullMethodName, in)
+	if m.CreateReturnLabelFunc == nil {
+		return nil, unimplemented(gen.OrderService_CreateReturnLabel_FullMethodName)
+	}
+	return m.CreateReturnLabelFunc(ctx, in)
+}
+
+func (m *MockOrderServiceClient) ImportOrders(ctx context.Context, _ ...grpc.CallOption) (grpc.ClientStreamingClient[gen.ImportOrdersRequest, gen.ImportOrdersResponse], error) {
+	m.record(gen.OrderService_ImportOrders_FullMethodName, nil)
+	return gen.OrderServiceClientFromAPI(orderServiceMockAPI{m}).ImportOrders(ctx)
+}
+
+func (m *MockOrderServiceClient) GetOrdersByIDs(ctx context.Context, in *gen.GetOrdersByIDsRequest, _ ...grpc.CallOption) (*gen.GetOrdersByIDsResponse, error) {
+	m.record(gen.OrderService_GetOrdersByIDs_FullMethodName, in)
+	if m.GetOrdersByIDsFunc == nil {
+		return nil, unimplemented(gen.OrderService_GetOrdersByIDs_FullMethodName)
+	}
+	return m.GetOrdersByIDsFunc(ctx, in)
+}
+
+func (m *MockOrderServiceClient) ArchiveOrders(ctx context.Context, in *gen.ArchiveOrdersRequest, _ ...grpc.CallOption) (*gen.ArchiveOrdersResponse, error) {
+	m.record(gen.OrderService_ArchiveOrders_FullMethodName, in)
+	if m.ArchiveOrdersFunc == nil {
+		return nil, unimplemented(gen.OrderService_ArchiveOrders_FullMethodName)
+	}
+	return m.ArchiveOrdersFunc(ctx, in)
+}
+
+func (m *MockOrderServiceClient) GetArchivedOrder(ctx context.Context, in *gen.GetArchivedOrderRequest, _ ...grpc.CallOption) (*gen.GetArchivedOrderResponse, error) {
+	m.record(gen.OrderService_GetArchivedOrder_FullMethodName, in)
+	if m.GetArchivedOrderFunc == nil {
+		return nil, unimplemented(gen.OrderService_GetArchivedOrder_FullMethodName)
+	}
+	return m.GetArchivedOrderFunc(ctx, in)
+}
+
+func (m *MockOrderServiceClient) CreateQuote(ctx context.Context, in *gen.CreateQuoteRequest, _ ...grpc.CallOption) (*gen.CreateQuoteResponse, error) {
+	m.record(gen.OrderService_CreateQuote_FullMethodName, in)
+	if m.CreateQuoteFunc == nil {
+		return nil, unimplemented(gen.OrderService_CreateQuote_FullMethodName)
+	}
+	return m.CreateQuoteFunc(ctx, in)
+}
+
+func (m *MockOrderServiceClient) AcceptQuote(ctx context.Context, in *gen.AcceptQuoteRequest, _ ...grpc.CallOption) (*gen.AcceptQuoteResponse, error) {
+	m.record(gen.OrderService_AcceptQuote_FullMethodName, in)
+	if m.AcceptQuoteFunc == nil {
+		return nil, unimplemented(gen.OrderService_AcceptQuote_FullMethodName)
+	}
+	return m.AcceptQuoteFunc(ctx, in)
+}
+
+func (m *MockOrderServiceClient) ConvertQuoteToOrder(ctx context.Context, in *gen.ConvertQuoteToOrderRequest, _ ...grpc.CallOption) (*gen.ConvertQuoteToOrderResponse, error) {
+	m.record(gen.OrderService_ConvertQuoteToOrder_FullMethodName, in)
+	if m.ConvertQuoteToOrderFunc == nil {
+		return nil, unimplemented(gen.OrderService_ConvertQuoteToOrder_FullMethodName)
+	}
+	return m.ConvertQuoteToOrderFunc(ctx, in)
+}
+
+func (m *MockOrderServiceClient) PriceOrder(ctx context.Context, in *gen.PriceOrderRequest, _ ...grpc.CallOption) (*gen.PriceOrderResponse, error) {
+	m.record(gen.OrderService_PriceOrder_FullMethodName, in)
+	if m.PriceOrderFunc == nil {
+		return nil, unimplemented(gen.OrderService_PriceOrder_FullMethodName)
+	}
+	return m.PriceOrderFunc(ctx, in)
+}
+
+func (m *MockOrderServiceClient) CheckPurchaseEligibility(ctx context.Context, in *gen.CheckPurchaseEligibilityRequest, _ ...grpc.CallOption) (*gen.CheckPurchaseEligibilityResponse, error) {
+	m.record(gen.OrderService_CheckPurchaseEligibility_FullMethodName, in)
+	if m.CheckPurchaseEligibilityFunc == nil {
+		return nil, unimplemented(gen.OrderService_CheckPurchaseEligibility_FullMethodName)
+	}
+	return m.CheckPurchaseEligibilityFunc(ctx, in)
+}
+
+// orderServiceMockAPI serves the streaming methods of MockOrderServiceClient through
+// gen.OrderServiceClientFromAPI.
+type orderServiceMockAPI struct{ m *MockOrderServiceClient }
+
+func (a orderServiceMockAPI) InsertOrder(ctx context.Context, in *gen.InsertOrderRequest) (*gen.InsertOrderResponse, error) {
+	if a.m.InsertOrderFunc == nil {
+		return nil, unimplemented(gen.OrderService_InsertOrder_FullMethodName)
+	}
+	return a.m.InsertOrderFunc(ctx, in)
+}
+
+func (a orderServiceMockAPI) GetAllOrders(ctx context.Context, in *gen.GetAllOrdersRequest) (*gen.GetAllOrdersResponse, error) {
+	if a.m.GetAllOrdersFunc == nil {
+		return nil, unimplemented(gen.OrderService_GetAllOrders_FullMethodName)
+	}
+	return a.m.GetAllOrdersFunc(ctx, in)
+}
+
+func (a orderServiceMockAPI) GetOrderByID(ctx context.Context, in *gen.GetOrderByIDRequest) (*gen.GetOrderByIDResponse, error) {
+	if a.m.GetOrderByIDFunc == nil {
+		return nil, unimplemented(gen.OrderService_GetOrderByID_FullMethodName)
+	}
+	return a.m.GetOrderByIDFunc(ctx, in)
+}
+
+func (a orderServiceMockAPI) GetOrdersByUser(ctx context.Context, in *gen.GetOrdersByUserRequest) (*gen.GetOrdersByUserResponse, error) {
+	if a.m.GetOrdersByUserFunc == nil {
+		return nil, unimplemented(gen.OrderService_GetOrdersByUser_FullMethodName)
+	}
+	return a.m.GetOrdersByUserFunc(ctx, in)
+}
+
+func (a orderServiceMockAPI) WatchOrder(ctx context.Context, in *gen.WatchOrderRequest) iter.Seq2[*gen.OrderStatusEvent, error] {
+	if a.m.WatchOrderFunc == nil {
+		return errSeq[gen.OrderStatusEvent](unimplemented(gen.OrderService_WatchOrder_FullMethodName))
+	}
+	return a.m.WatchOrderFunc(ctx, in)
+}
+
+func (a orderServiceMockAPI) CancelOrder(ctx context.Context, in *gen.CancelOrderRequest) (*gen.CancelOrderResponse, error) {
+	if a.m.CancelOrderFunc == nil {
+		return nil, unimplemented(gen.OrderService_CancelOrder_FullMethodName)
+	}
+	return a.m.CancelOrderFunc(ctx, in)
+}
+
+func (a orderServiceMockAPI) RefundOrder(ctx context.Context, in *gen.RefundOrderRequest) (*gen.RefundOrderResponse, error) {
+	if a.m.RefundOrderFunc == nil {
+		return nil, unimplemented(gen.OrderService_RefundOrder_FullMethodName)
+	}
+	return a.m.RefundOrderFunc(ctx, in)
+}
+
+func (a orderServiceMockAPI) CreateReturnLabel(ctx context.Context, in *gen.CreateReturnLabelRequest) (*gen.CreateReturnLabelResponse, error) {
+	if a.m.CreateReturnLabelFunc == nil {
+		return nil, unimplemented(gen.OrderService_CreateReturnLabel_FullMethodName)
+	}
+	return a.m.CreateReturnLabelFunc(ctx, in)
+}
+
+func (a orderServiceMockAPI) ImportOrders(ctx context.Context, in iter.Seq[*gen.ImportOrdersRequest]) (*gen.ImportOrdersResponse, error) {
+	if a.m.ImportOrdersFunc == nil {
+		return nil, unimplemented(gen.OrderService_ImportOrders_FullMethodName)
+	}
+	return a.m.ImportOrdersFunc(ctx, in)
+}
+
+func (a orderServiceMockAPI) GetOrdersByIDs(ctx context.Context, in *gen.GetOrdersByIDsRequest) (*gen.GetOrdersByIDsResponse, error) {
+	if a.m.GetOrdersByIDsFunc == nil {
+		return nil, unimplemented(gen.OrderService_GetOrdersByIDs_FullMethodName)
+	}
+	return a.m.GetOrdersByIDsFunc(ctx, in)
+}
+
+func (a orderServiceMockAPI) ArchiveOrders(ctx context.Context, in *gen.ArchiveOrdersRequest) (*gen.ArchiveOrdersResponse, error) {
+	if a.m.ArchiveOrdersFunc == nil {
+		return nil, unimplemented(gen.OrderService_ArchiveOrders_FullMethodName)
+	}
+	return a.m.ArchiveOrdersFunc(ctx, in)
+}
+
+func (a orderServiceMockAPI) GetArchivedOrder(ctx context.Context, in *gen.GetArchivedOrderRequest) (*gen.GetArchivedOrderResponse, error) {
+	if a.m.GetArchivedOrderFunc == nil {
+		return nil, unimplemented(gen.OrderService_GetArchivedOrder_FullMethodName)
+	}
+	return a.m.GetArchivedOrderFunc(ctx, in)
+}
+
+func (a orderServiceMockAPI) CreateQuote(ctx context.Context, in *gen.CreateQuoteRequest) (*gen.CreateQuoteResponse, error) {
+	if a.m.CreateQuoteFunc == nil {
+		return nil, unimplemented(gen.OrderService_CreateQuote_FullMethodName)
+	}
+	return a.m.CreateQuoteFunc(ctx, in)
+}
+
+func (a orderServiceMockAPI) AcceptQuote(ctx context.Context, in *gen.AcceptQuoteRequest) (*gen.AcceptQuoteResponse, error) {
+	if a.m.AcceptQuoteFunc == nil {
+		return nil, unimplemented(gen.OrderService_AcceptQuote_FullMethodName)
+	}
+	return a.m.AcceptQuoteFunc(ctx, in)
+}
+
+func (a orderServiceMockAPI) ConvertQuoteToOrder(ctx context.Context, in *gen.ConvertQuoteToOrderRequest) (*gen.ConvertQuoteToOrderResponse, error) {
+	if a.m.ConvertQuoteToOrderFunc == nil {
+		return nil, unimplemented(gen.OrderService_ConvertQuoteToOrder_FullMethodName)
+	}
+	return a.m.ConvertQuoteToOrderFunc(ctx, in)
+}
+
+func (a orderServiceMockAPI) PriceOrder(ctx context.Context, in *gen.PriceOrderRequest) (*gen.PriceOrderResponse, error) {
+	if a.m.PriceOrderFunc == nil {
+		return nil, unimplemented(gen.OrderService_PriceOrder_FullMethodName)
+	}
+	return a.m.PriceOrderFunc(ctx, in)
+}
+
+func (a orderServiceMockAPI) CheckPurchaseEligibility(ctx context.Context, in *gen.CheckPurchaseEligibilityRequest) (*gen.CheckPurchaseEligibilityResponse, error) {
+	if a.m.CheckPurchaseEligibilityFunc == nil {
+		return nil, unimplemented(gen.OrderService_CheckPurchaseEligibility_FullMethodName)
+	}
+	return a.m.CheckPurchaseEligibilityFunc(ctx, in)
+}
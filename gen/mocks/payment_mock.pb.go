@@ -0,0 +1,74 @@
+// Code generated by protoc-gen-go-mock. DO NOT EDIT.
+// source: payment.proto
+
+package mocks
+
+import (
+	context "context"
+	gen "github.com/escape-ship/protos/gen"
+	grpc "google.golang.org/grpc"
+)
+
+// MockPaymentServiceClient is a programmable gen.PaymentServiceClient. Set the
+// XxxFunc fields to script responses; calls to unset methods fail with
+// Unimplemented. Every call is recorded.
+type MockPaymentServiceClient struct {
+	Recorder
+
+	KakaoReadyFunc     func(ctx context.Context, in *gen.KakaoReadyRequest) (*gen.KakaoReadyResponse, error)
+	KakaoApproveFunc   func(ctx context.Context, in *gen.KakaoApproveRequest) (*gen.KakaoApproveResponse, error)
+	KakaoCancelFunc    func(ctx context.Context, in *gen.KakaoCancelRequest) (*gen.KakaoCancelResponse, error)
+	PreparePaymentFunc func(ctx context.Context, in *gen.PreparePaymentRequest) (*gen.PreparePaymentResponse, error)
+	ApprovePaymentFunc func(ctx context.Context, in *gen.ApprovePaymentRequest) (*gen.ApprovePaymentResponse, error)
+	CancelPaymentFunc  func(ctx context.Context, in *gen.CancelPaymentRequest) (*gen.CancelPaymentResponse, error)
+}
+
+var _ gen.PaymentServiceClient = (*MockPaymentServiceClient)(nil)
+
+func (m *MockPaymentServiceClient) KakaoReady(ctx context.Context, in *gen.KakaoReadyRequest, _ ...grpc.CallOption) (*gen.KakaoReadyResponse, error) {
+	m.record(gen.PaymentService_KakaoReady_FullMethodName, in)
+	if m.KakaoReadyFunc == nil {
+		return nil, unimplemented(gen.PaymentService_KakaoReady_FullMethodName)
+	}
+	return m.KakaoReadyFunc(ctx, in)
+}
+
+func (m *MockPaymentServiceClient) KakaoApprove(ctx context.Context, in *gen.KakaoApproveRequest, _ ...grpc.CallOption) (*gen.KakaoApproveResponse, error) {
+	m.record(gen.PaymentService_KakaoApprove_FullMethodName, in)
+	if m.KakaoApproveFunc == nil {
+		return nil, unimplemented(gen.PaymentService_KakaoApprove_FullMethodName)
+	}
+	return m.KakaoApproveFunc(ctx, in)
+}
+
+func (m *MockPaymentServiceClient) KakaoCancel(ctx context.Context, in *gen.KakaoCancelRequest, _ ...grpc.CallOption) (*gen.KakaoCancelResponse, error) {
+	m.record(gen.PaymentService_KakaoCancel_FullMethodName, in)
+	if m.KakaoCancelFunc == nil {
+		return nil, unimplemented(gen.PaymentService_KakaoCancel_FullMethodName)
+	}
+	return m.KakaoCancelFunc(ctx, in)
+}
+
+func (m *MockPaymentServiceClient) PreparePayment(ctx context.Context, in *gen.PreparePaymentRequest, _ ...grpc.CallOption) (*gen.PreparePaymentResponse, error) {
+	m.record(gen.PaymentService_PreparePayment_FullMethodName, in)
+	if m.PreparePaymentFunc == nil {
+		return nil, unimplemented(gen.PaymentService_PreparePayment_FullMethodName)
+	}
+	return m.PreparePaymentFunc(ctx, in)
+}
+
+func (m *MockPaymentServiceClient) ApprovePayment(ctx context.Context, in *gen.ApprovePaymentRequest, _ ...grpc.CallOption) (*gen.ApprovePaymentResponse, error) {
+	m.record(gen.PaymentService_ApprovePayment_FullMethodName, in)
+	if m.ApprovePaymentFunc == nil {
+		return nil, unimplemented(gen.PaymentService_ApprovePayment_FullMethodName)
+	}
+	return m.ApprovePaymentFunc(ctx, in)
+}
+
+func (m *MockPaymentServiceClient) CancelPayment(ctx context.Context, in *gen.CancelPaymentRequest, _ ...grpc.CallOption) (*gen.CancelPaymentResponse, error) {
+	m.record(gen.PaymentService_CancelPayment_FullMethodName, in)
+	if m.CancelPaymentFunc == nil {
+		return nil, unimplemented(gen.PaymentService_CancelPayment_FullMethodName)
+	}
+	return m.CancelPaymentFunc(ctx, in)
+}
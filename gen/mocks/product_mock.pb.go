@@ -0,0 +1,74 @@
+// Code generated by protoc-gen-go-mock. DO NOT EDIT.
+// source: product.proto
+
+package mocks
+
+import (
+	context "context"
+	gen "github.com/escape-ship/protos/gen"
+	grpc "google.golang.org/grpc"
+)
+
+// MockProductServiceClient is a programmable gen.ProductServiceClient. Set the
+// XxxFunc fields to script responses; calls to unset methods fail with
+// Unimplemented. Every call is recorded.
+type MockProductServiceClient struct {
+	Recorder
+
+	GetProductsFunc    func(ctx context.Context, in *gen.GetProductsRequest) (*gen.GetProductsResponse, error)
+	GetProductByIDFunc func(ctx context.Context, in *gen.GetProductByIDRequest) (*gen.GetProductByIDResponse, error)
+	PostProductsFunc   func(ctx context.Context, in *gen.PostProductsRequest) (*gen.PostProductsResponse, error)
+	UpdateProductFunc  func(ctx context.Context, in *gen.UpdateProductRequest) (*gen.UpdateProductResponse, error)
+	CreateBundleFunc   func(ctx context.Context, in *gen.CreateBundleRequest) (*gen.CreateBundleResponse, error)
+	ResolveBundleFunc  func(ctx context.Context, in *gen.ResolveBundleRequest) (*gen.ResolveBundleResponse, error)
+}
+
+var _ gen.ProductServiceClient = (*MockProductServiceClient)(nil)
+
+func (m *MockProductServiceClient) GetProducts(ctx context.Context, in *gen.GetProductsRequest, _ ...grpc.CallOption) (*gen.GetProductsResponse, error) {
+	m.record(gen.ProductService_GetProducts_FullMethodName, in)
+	if m.GetProductsFunc == nil {
+		return nil, unimplemented(gen.ProductService_GetProducts_FullMethodName)
+	}
+	return m.GetProductsFunc(ctx, in)
+}
+
+func (m *MockProductServiceClient) GetProductByID(ctx context.Context, in *gen.GetProductByIDRequest, _ ...grpc.CallOption) (*gen.GetProductByIDResponse, error) {
+	m.record(gen.ProductService_GetProductByID_FullMethodName, in)
+	if m.GetProductByIDFunc == nil {
+		return nil, unimplemented(gen.ProductService_GetProductByID_FullMethodName)
+	}
+	return m.GetProductByIDFunc(ctx, in)
+}
+
+func (m *MockProductServiceClient) PostProducts(ctx context.Context, in *gen.PostProductsRequest, _ ...grpc.CallOption) (*gen.PostProductsResponse, error) {
+	m.record(gen.ProductService_PostProducts_FullMethodName, in)
+	if m.PostProductsFunc == nil {
+		return nil, unimplemented(gen.ProductService_PostProducts_FullMethodName)
+	}
+	return m.PostProductsFunc(ctx, in)
+}
+
+func (m *MockProductServiceClient) UpdateProduct(ctx context.Context, in *gen.UpdateProductRequest, _ ...grpc.CallOption) (*gen.UpdateProductResponse, error) {
+	m.record(gen.ProductService_UpdateProduct_FullMethodName, in)
+	if m.UpdateProductFunc == nil {
+		return nil, unimplemented(gen.ProductService_UpdateProduct_FullMethodName)
+	}
+	return m.UpdateProductFunc(ctx, in)
+}
+
+func (m *MockProductServiceClient) CreateBundle(ctx context.Context, in *gen.CreateBundleRequest, _ ...grpc.CallOption) (*gen.CreateBundleResponse, error) {
+	m.record(gen.ProductService_CreateBundle_FullMethodName, in)
+	if m.CreateBundleFunc == nil {
+		return nil, unimplemented(gen.ProductService_CreateBundle_FullMethodName)
+	}
+	return m.CreateBundleFunc(ctx, in)
+}
+
+func (m *MockProductServiceClient) ResolveBundle(ctx context.Context, in *gen.ResolveBundleRequest, _ ...grpc.CallOption) (*gen.ResolveBundleResponse, error) {
+	m.record(gen.ProductService_ResolveBundle_FullMethodName, in)
+	if m.ResolveBundleFunc == nil {
+		return nil, unimplemented(gen.ProductService_ResolveBundle_FullMethodName)
+	}
+	return m.ResolveBundleFunc(ctx, in)
+}
@@ -0,0 +1,65 @@
+// Code generated by protoc-gen-go-mock. DO NOT EDIT.
+// source: review.proto
+
+package mocks
+
+import (
+	context "context"
+	gen "github.com/escape-ship/protos/gen"
+	grpc "google.golang.org/grpc"
+)
+
+// MockReviewServiceClient is a programmable gen.ReviewServiceClient. Set the
+// XxxFunc fields to script responses; calls to unset methods fail with
+// Unimplemented. Every call is recorded.
+type MockReviewServiceClient struct {
+	Recorder
+
+	CreateReviewFunc            func(ctx context.Context, in *gen.CreateReviewRequest) (*gen.CreateReviewResponse, error)
+	ListReviewsByProductFunc    func(ctx context.Context, in *gen.ListReviewsByProductRequest) (*gen.ListReviewsByProductResponse, error)
+	UpdateReviewFunc            func(ctx context.Context, in *gen.UpdateReviewRequest) (*gen.UpdateReviewResponse, error)
+	DeleteReviewFunc            func(ctx context.Context, in *gen.DeleteReviewRequest) (*gen.DeleteReviewResponse, error)
+	GetProductRatingSummaryFunc func(ctx context.Context, in *gen.GetProductRatingSummaryRequest) (*gen.GetProductRatingSummaryResponse, error)
+}
+
+var _ gen.ReviewServiceClient = (*MockReviewServiceClient)(nil)
+
+func (m *MockReviewServiceClient) CreateReview(ctx context.Context, in *gen.CreateReviewRequest, _ ...grpc.CallOption) (*gen.CreateReviewResponse, error) {
+	m.record(gen.ReviewService_CreateReview_FullMethodName, in)
+	if m.CreateReviewFunc == nil {
+		return nil, unimplemented(gen.ReviewService_CreateReview_FullMethodName)
+	}
+	return m.CreateReviewFunc(ctx, in)
+}
+
+func (m *MockReviewServiceClient) ListReviewsByProduct(ctx context.Context, in *gen.ListReviewsByProductRequest, _ ...grpc.CallOption) (*gen.ListReviewsByProductResponse, error) {
+	m.record(gen.ReviewService_ListReviewsByProduct_FullMethodName, in)
+	if m.ListReviewsByProductFunc == nil {
+		return nil, unimplemented(gen.ReviewService_ListReviewsByProduct_FullMethodName)
+	}
+	return m.ListReviewsByProductFunc(ctx, in)
+}
+
+func (m *MockReviewServiceClient) UpdateReview(ctx context.Context, in *gen.UpdateReviewRequest, _ ...grpc.CallOption) (*gen.UpdateReviewResponse, error) {
+	m.record(gen.ReviewService_UpdateReview_FullMethodName, in)
+	if m.UpdateReviewFunc == nil {
+		return nil, unimplemented(gen.ReviewService_UpdateReview_FullMethodName)
+	}
+	return m.UpdateReviewFunc(ctx, in)
+}
+
+func (m *MockReviewServiceClient) DeleteReview(ctx context.Context, in *gen.DeleteReviewRequest, _ ...grpc.CallOption) (*gen.DeleteReviewResponse, error) {
+	m.record(gen.ReviewService_DeleteReview_FullMethodName, in)
+	if m.DeleteReviewFunc == nil {
+		return nil, unimplemented(gen.ReviewService_DeleteReview_FullMethodName)
+	}
+	return m.DeleteReviewFunc(ctx, in)
+}
+
+func (m *MockReviewServiceClient) GetProductRatingSummary(ctx context.Context, in *gen.GetProductRatingSummaryRequest, _ ...grpc.CallOption) (*gen.GetProductRatingSummaryResponse, error) {
+	m.record(gen.ReviewService_GetProductRatingSummary_FullMethodName, in)
+	if m.GetProductRatingSummaryFunc == nil {
+		return nil, unimplemented(gen.ReviewService_GetProductRatingSummary_FullMethodName)
+	}
+	return m.GetProductRatingSummaryFunc(ctx, in)
+}
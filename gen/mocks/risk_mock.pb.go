@@ -0,0 +1,47 @@
+// Code generated by protoc-gen-go-mock. DO NOT EDIT.
+// source: risk.proto
+
+package mocks
+
+import (
+	context "context"
+	gen "github.com/escape-ship/protos/gen"
+	grpc "google.golang.org/grpc"
+)
+
+// MockRiskServiceClient is a programmable gen.RiskServiceClient. Set the
+// XxxFunc fields to script responses; calls to unset methods fail with
+// Unimplemented. Every call is recorded.
+type MockRiskServiceClient struct {
+	Recorder
+
+	AddToBlocklistFunc      func(ctx context.Context, in *gen.AddToBlocklistRequest) (*gen.AddToBlocklistResponse, error)
+	RemoveFromBlocklistFunc func(ctx context.Context, in *gen.RemoveFromBlocklistRequest) (*gen.RemoveFromBlocklistResponse, error)
+	CheckBlocklistFunc      func(ctx context.Context, in *gen.CheckBlocklistRequest) (*gen.CheckBlocklistResponse, error)
+}
+
+var _ gen.RiskServiceClient = (*MockRiskServiceClient)(nil)
+
+func (m *MockRiskServiceClient) AddToBlocklist(ctx context.Context, in *gen.AddToBlocklistRequest, _ ...grpc.CallOption) (*gen.AddToBlocklistResponse, error) {
+	m.record(gen.RiskService_AddToBlocklist_FullMethodName, in)
+	if m.AddToBlocklistFunc == nil {
+		return nil, unimplemented(gen.RiskService_AddToBlocklist_FullMethodName)
+	}
+	return m.AddToBlocklistFunc(ctx, in)
+}
+
+func (m *MockRiskServiceClient) RemoveFromBlocklist(ctx context.Context, in *gen.RemoveFromBlocklistRequest, _ ...grpc.CallOption) (*gen.RemoveFromBlocklistResponse, error) {
+	m.record(gen.RiskService_RemoveFromBlocklist_FullMethodName, in)
+	if m.RemoveFromBlocklistFunc == nil {
+		return nil, unimplemented(gen.RiskService_RemoveFromBlocklist_FullMethodName)
+	}
+	return m.RemoveFromBlocklistFunc(ctx, in)
+}
+
+func (m *MockRiskServiceClient) CheckBlocklist(ctx context.Context, in *gen.CheckBlocklistRequest, _ ...grpc.CallOption) (*gen.CheckBlocklistResponse, error) {
+	m.record(gen.RiskService_CheckBlocklist_FullMethodName, in)
+	if m.CheckBlocklistFunc == nil {
+		return nil, unimplemented(gen.RiskService_CheckBlocklist_FullMethodName)
+	}
+	return m.CheckBlocklistFunc(ctx, in)
+}
@@ -0,0 +1,86 @@
+// Code generated by protoc-gen-go-mock. DO NOT EDIT.
+// source: shipping.proto
+
+package mocks
+
+import (
+	context "context"
+	gen "github.com/escape-ship/protos/gen"
+	grpc "google.golang.org/grpc"
+	iter "iter"
+)
+
+// MockShippingServiceClient is a programmable gen.ShippingServiceClient. Set the
+// XxxFunc fields to script responses; calls to unset methods fail with
+// Unimplemented. Every call is recorded.
+type MockShippingServiceClient struct {
+	Recorder
+
+	CreateShipmentFunc       func(ctx context.Context, in *gen.CreateShipmentRequest) (*gen.CreateShipmentResponse, error)
+	GetTrackingInfoFunc      func(ctx context.Context, in *gen.GetTrackingInfoRequest) (*gen.GetTrackingInfoResponse, error)
+	UpdateShipmentStatusFunc func(ctx context.Context, in *gen.UpdateShipmentStatusRequest) (*gen.UpdateShipmentStatusResponse, error)
+	WatchShipmentFunc        func(ctx context.Context, in *gen.WatchShipmentRequest) iter.Seq2[*gen.TrackingEvent, error]
+}
+
+var _ gen.ShippingServiceClient = (*MockShippingServiceClient)(nil)
+
+func (m *MockShippingServiceClient) CreateShipment(ctx context.Context, in *gen.CreateShipmentRequest, _ ...grpc.CallOption) (*gen.CreateShipmentResponse, error) {
+	m.record(gen.ShippingService_CreateShipment_FullMethodName, in)
+	if m.CreateShipmentFunc == nil {
+		return nil, unimplemented(gen.ShippingService_CreateShipment_FullMethodName)
+	}
+	return m.CreateShipmentFunc(ctx, in)
+}
+
+func (m *MockShippingServiceClient) GetTrackingInfo(ctx context.Context, in *gen.GetTrackingInfoRequest, _ ...grpc.CallOption) (*gen.GetTrackingInfoResponse, error) {
+	m.record(gen.ShippingService_GetTrackingInfo_FullMethodName, in)
+	if m.GetTrackingInfoFunc == nil {
+		return nil, unimplemented(gen.ShippingService_GetTrackingInfo_FullMethodName)
+	}
+	return m.GetTrackingInfoFunc(ctx, in)
+}
+
+func (m *MockShippingServiceClient) UpdateShipmentStatus(ctx context.Context, in *gen.UpdateShipmentStatusRequest, _ ...grpc.CallOption) (*gen.UpdateShipmentStatusResponse, error) {
+	m.record(gen.ShippingService_UpdateShipmentStatus_FullMethodName, in)
+	if m.UpdateShipmentStatusFunc == nil {
+		return nil, unimplemented(gen.ShippingService_UpdateShipmentStatus_FullMethodName)
+	}
+	return m.UpdateShipmentStatusFunc(ctx, in)
+}
+
+func (m *MockShippingServiceClient) WatchShipment(ctx context.Context, in *gen.WatchShipmentRequest, _ ...grpc.CallOption) (grpc.ServerStreamingClient[gen.TrackingEvent], error) {
+	m.record(gen.ShippingService_WatchShipment_FullMethodName, in)
+	return gen.ShippingServiceClientFromAPI(shippingServiceMockAPI{m}).WatchShipment(ctx, in)
+}
+
+// shippingServiceMockAPI serves the streaming methods of MockShippingServiceClient through
+// gen.ShippingServiceClientFromAPI.
+type shippingServiceMockAPI struct{ m *MockShippingServiceClient }
+
+func (a shippingServiceMockAPI) CreateShipment(ctx context.Context, in *gen.CreateShipmentRequest) (*gen.CreateShipmentResponse, error) {
+	if a.m.CreateShipmentFunc == nil {
+		return nil, unimplemented(gen.ShippingService_CreateShipment_FullMethodName)
+	}
+	return a.m.CreateShipmentFunc(ctx, in)
+}
+
+func (a shippingServiceMockAPI) GetTrackingInfo(ctx context.Context, in *gen.GetTrackingInfoRequest) (*gen.GetTrackingInfoResponse, error) {
+	if a.m.GetTrackingInfoFunc == nil {
+		return nil, unimplemented(gen.ShippingService_GetTrackingInfo_FullMethodName)
+	}
+	return a.m.GetTrackingInfoFunc(ctx, in)
+}
+
+func (a shippingServiceMockAPI) UpdateShipmentStatus(ctx context.Context, in *gen.UpdateShipmentStatusRequest) (*gen.UpdateShipmentStatusResponse, error) {
+	if a.m.UpdateShipmentStatusFunc == nil {
+		return nil, unimplemented(gen.ShippingService_UpdateShipmentStatus_FullMethodName)
+	}
+	return a.m.UpdateShipmentStatusFunc(ctx, in)
+}
+
+func (a shippingServiceMockAPI) WatchShipment(ctx context.Context, in *gen.WatchShipmentRequest) iter.Seq2[*gen.TrackingEvent, error] {
+	if a.m.WatchShipmentFunc == nil {
+		return errSeq[gen.TrackingEvent](unimplemented(gen.ShippingService_WatchShipment_FullMethodName))
+	}
+	return a.m.WatchShipmentFunc(ctx, in)
+}
@@ -0,0 +1,56 @@
+// Code generated by protoc-gen-go-mock. DO NOT EDIT.
+// source: subscription.proto
+
+package mocks
+
+import (
+	context "context"
+	gen "github.com/escape-ship/protos/gen"
+	grpc "google.golang.org/grpc"
+)
+
+// MockSubscriptionServiceClient is a programmable gen.SubscriptionServiceClient. Set the
+// XxxFunc fields to script responses; calls to unset methods fail with
+// Unimplemented. Every call is recorded.
+type MockSubscriptionServiceClient struct {
+	Recorder
+
+	CreateSubscriptionFunc func(ctx context.Context, in *gen.CreateSubscriptionRequest) (*gen.CreateSubscriptionResponse, error)
+	PauseSubscriptionFunc  func(ctx context.Context, in *gen.PauseSubscriptionRequest) (*gen.PauseSubscriptionResponse, error)
+	SkipNextDeliveryFunc   func(ctx context.Context, in *gen.SkipNextDeliveryRequest) (*gen.SkipNextDeliveryResponse, error)
+	CancelSubscriptionFunc func(ctx context.Context, in *gen.CancelSubscriptionRequest) (*gen.CancelSubscriptionResponse, error)
+}
+
+var _ gen.SubscriptionServiceClient = (*MockSubscriptionServiceClient)(nil)
+
+func (m *MockSubscriptionServiceClient) CreateSubscription(ctx context.Context, in *gen.CreateSubscriptionRequest, _ ...grpc.CallOption) (*gen.CreateSubscriptionResponse, error) {
+	m.record(gen.SubscriptionService_CreateSubscription_FullMethodName, in)
+	if m.CreateSubscriptionFunc == nil {
+		return nil, unimplemented(gen.SubscriptionService_CreateSubscription_FullMethodName)
+	}
+	return m.CreateSubscriptionFunc(ctx, in)
+}
+
+func (m *MockSubscriptionServiceClient) PauseSubscription(ctx context.Context, in *gen.PauseSubscriptionRequest, _ ...grpc.CallOption) (*gen.PauseSubscriptionResponse, error) {
+	m.record(gen.SubscriptionService_PauseSubscription_FullMethodName, in)
+	if m.PauseSubscriptionFunc == nil {
+		return nil, unimplemented(gen.SubscriptionService_PauseSubscription_FullMethodName)
+	}
+	return m.PauseSubscriptionFunc(ctx, in)
+}
+
+func (m *MockSubscriptionServiceClient) SkipNextDelivery(ctx context.Context, in *gen.SkipNextDeliveryRequest, _ ...grpc.CallOption) (*gen.SkipNextDeliveryResponse, error) {
+	m.record(gen.SubscriptionService_SkipNextDelivery_FullMethodName, in)
+	if m.SkipNextDeliveryFunc == nil {
+		return nil, unimplemented(gen.SubscriptionService_SkipNextDelivery_FullMethodName)
+	}
+	return m.SkipNextDeliveryFunc(ctx, in)
+}
+
+func (m *MockSubscriptionServiceClient) CancelSubscription(ctx context.Context, in *gen.CancelSubscriptionRequest, _ ...grpc.CallOption) (*gen.CancelSubscriptionResponse, error) {
+	m.record(gen.SubscriptionService_CancelSubscription_FullMethodName, in)
+	if m.CancelSubscriptionFunc == nil {
+		return nil, unimplemented(gen.SubscriptionService_CancelSubscription_FullMethodName)
+	}
+	return m.CancelSubscriptionFunc(ctx, in)
+}
@@ -0,0 +1,56 @@
+// Code generated by protoc-gen-go-mock. DO NOT EDIT.
+// source: wishlist.proto
+
+package mocks
+
+import (
+	context "context"
+	gen "github.com/escape-ship/protos/gen"
+	grpc "google.golang.org/grpc"
+)
+
+// MockWishlistServiceClient is a programmable gen.WishlistServiceClient. Set the
+// XxxFunc fields to script responses; calls to unset methods fail with
+// Unimplemented. Every call is recorded.
+type MockWishlistServiceClient struct {
+	Recorder
+
+	AddToWishlistFunc      func(ctx context.Context, in *gen.AddToWishlistRequest) (*gen.AddToWishlistResponse, error)
+	RemoveFromWishlistFunc func(ctx context.Context, in *gen.RemoveFromWishlistRequest) (*gen.RemoveFromWishlistResponse, error)
+	ListWishlistFunc       func(ctx context.Context, in *gen.ListWishlistRequest) (*gen.ListWishlistResponse, error)
+	MoveToCartFunc         func(ctx context.Context, in *gen.MoveToCartRequest) (*gen.MoveToCartResponse, error)
+}
+
+var _ gen.WishlistServiceClient = (*MockWishlistServiceClient)(nil)
+
+func (m *MockWishlistServiceClient) AddToWishlist(ctx context.Context, in *gen.AddToWishlistRequest, _ ...grpc.CallOption) (*gen.AddToWishlistResponse, error) {
+	m.record(gen.WishlistService_AddToWishlist_FullMethodName, in)
+	if m.AddToWishlistFunc == nil {
+		return nil, unimplemented(gen.WishlistService_AddToWishlist_FullMethodName)
+	}
+	return m.AddToWishlistFunc(ctx, in)
+}
+
+func (m *MockWishlistServiceClient) RemoveFromWishlist(ctx context.Context, in *gen.RemoveFromWishlistRequest, _ ...grpc.CallOption) (*gen.RemoveFromWishlistResponse, error) {
+	m.record(gen.WishlistService_RemoveFromWishlist_FullMethodName, in)
+	if m.RemoveFromWishlistFunc == nil {
+		return nil, unimplemented(gen.WishlistService_RemoveFromWishlist_FullMethodName)
+	}
+	return m.RemoveFromWishlistFunc(ctx, in)
+}
+
+func (m *MockWishlistServiceClient) ListWishlist(ctx context.Context, in *gen.ListWishlistRequest, _ ...grpc.CallOption) (*gen.ListWishlistResponse, error) {
+	m.record(gen.WishlistService_ListWishlist_FullMethodName, in)
+	if m.ListWishlistFunc == nil {
+		return nil, unimplemented(gen.WishlistService_ListWishlist_FullMethodName)
+	}
+	return m.ListWishlistFunc(ctx, in)
+}
+
+func (m *MockWishlistServiceClient) MoveToCart(ctx context.Context, in *gen.MoveToCartRequest, _ ...grpc.CallOption) (*gen.MoveToCartResponse, error) {
+	m.record(gen.WishlistService_MoveToCart_FullMethodName, in)
+	if m.MoveToCartFunc == nil {
+		return nil, unimplemented(gen.WishlistService_MoveToCart_FullMethodName)
+	}
+	return m.MoveToCartFunc(ctx, in)
+}
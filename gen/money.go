@@ -0,0 +1,176 @@
+package gen
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+const nanosPerUnit = 1_000_000_000
+
+// Errors returned by Money arithmetic.
+var (
+	ErrCurrencyMismatch = errors.New("gen: currency mismatch")
+	ErrMoneyOverflow    = errors.New("gen: money overflow")
+)
+
+// KRW returns an amount in Korean won, the currency of every legacy int64
+// price and amount field.
+func KRW(won int64) *Money {
+	return &Money{CurrencyCode: "KRW", Units: won}
+}
+
+// MoneyOr returns m, or the legacy KRW amount when m is unset. Use it to read
+// fields that replaced an int64 field while old writers are still deployed:
+//
+//	total := pb.MoneyOr(req.GetTotal(), req.GetTotalAmount())
+func MoneyOr(m *Money, legacyKRW int64) *Money {
+	if m != nil {
+		return m
+	}
+	return KRW(legacyKRW)
+}
+
+// EffectiveListPrice returns the list price, falling back to the deprecated
+// KRW price field.
+func (p *Product) EffectiveListPrice() *Money {
+	return MoneyOr(p.GetListPrice(), p.GetPrice())
+}
+
+// EffectivePrice returns the tier's unit price, falling back to the
+// deprecated KRW unit_price field.
+func (t *PriceTier) EffectivePrice() *Money {
+	return MoneyOr(t.GetPrice(), t.GetUnitPrice())
+}
+
+// EffectiveUnitPrice returns the unit price at order time, falling back to
+// the deprecated KRW product_price field.
+func (it *OrderItem) EffectiveUnitPrice() *Money {
+	return MoneyOr(it.GetUnitPrice(), it.GetProductPrice())
+}
+
+// KRWUnits returns the amount in whole won for APIs that still take int64
+// amounts, such as Kakao Pay. It fails for other currencies and fractional
+// amounts.
+func (m *Money) KRWUnits() (int64, error) {
+	if m.GetCurrencyCode() != "KRW" {
+		return 0, fmt.Errorf("%w: %s is not KRW", ErrCurrencyMismatch, m.GetCurrencyCode())
+	}
+	if m.GetNanos() != 0 {
+		return 0, fmt.Errorf("gen: %s has a fractional won amount", m.Format())
+	}
+	return m.GetUnits(), nil
+}
+
+// IsZero reports whether the amount is zero.
+func (m *Money) IsZero() bool {
+	return m.GetUnits() == 0 && m.GetNanos() == 0
+}
+
+// Add returns m + o. Both must be in the same currency.
+func (m *Money) Add(o *Money) (*Money, error) {
+	if m.GetCurrencyCode() != o.GetCurrencyCode() {
+		return nil, fmt.Errorf("%w: %s + %s", ErrCurrencyMismatch, m.GetCurrencyCode(), o.GetCurrencyCode())
+	}
+	units, ok := addInt64(m.GetUnits(), o.GetUnits())
+	if !ok {
+		return nil, ErrMoneyOverflow
+	}
+	return normalizeMoney(m.GetCurrencyCode(), units, int64(m.GetNanos())+int64(o.GetNanos()))
+}
+
+// Sub returns m - o. Both must be in the same currency.
+func (m *Money) Sub(o *Money) (*Money, error) {
+	if o.GetUnits() == math.MinInt64 {
+		return nil, ErrMoneyOverflow
+	}
+	return m.Add(&Money{CurrencyCode: o.GetCurrencyCode(), Units: -o.GetUnits(), Nanos: -o.GetNanos()})
+}
+
+// IsNegative reports whether the amount is below zero.
+func (m *Money) IsNegative() bool {
+	return m.GetUnits() < 0 || m.GetNanos() < 0
+}
+
+// Mul returns m * n, e.g. a unit price times a quantity.
+func (m *Money) Mul(n int64) (*Money, error) {
+	units, ok1 := mulInt64(m.GetUnits(), n)
+	nanos, ok2 := mulInt64(int64(m.GetNanos()), n)
+	if !ok1 || !ok2 {
+		return nil, ErrMoneyOverflow
+	}
+	return normalizeMoney(m.GetCurrencyCode(), units, nanos)
+}
+
+// normalizeMoney carries whole units out of nanos and gives units and nanos
+// the same sign.
+func normalizeMoney(currency string, units, nanos int64) (*Money, error) {
+	units, ok := addInt64(units, nanos/nanosPerUnit)
+	nanos %= nanosPerUnit
+	switch {
+	case units > 0 && nanos < 0:
+		units, nanos = units-1, nanos+nanosPerUnit
+	case units < 0 && nanos > 0:
+		units, nanos = units+1, nanos-nanosPerUnit
+	}
+	if !ok {
+		return nil, ErrMoneyOverflow
+	}
+	return &Money{CurrencyCode: currency, Units: units, Nanos: int32(nanos)}, nil
+}
+
+func addInt64(a, b int64) (int64, bool) {
+	s := a + b
+	return s, (s > a) == (b > 0)
+}
+
+func mulInt64(a, b int64) (int64, bool) {
+	p := a * b
+	return p, b == 0 || p/b == a && !(a == math.MinInt64 && b == -1)
+}
+
+// Format renders the amount with its currency code and thousands separators,
+// using the currency's minor unit digits: "KRW 25,000", "USD 1,234.50".
+// Digits beyond the minor unit are kept rather than rounded.
+func (m *Money) Format() string {
+	units, nanos := m.GetUnits(), int64(m.GetNanos())
+	neg := units < 0 || nanos < 0
+	if neg {
+		units, nanos = -units, -nanos
+	}
+	var b strings.Builder
+	b.WriteString(m.GetCurrencyCode())
+	b.WriteByte(' ')
+	if neg {
+		b.WriteByte('-')
+	}
+	digits := strconv.FormatUint(uint64(units), 10)
+	for i, d := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteRune(d)
+	}
+	frac := strings.TrimRight(fmt.Sprintf("%09d", nanos), "0")
+	if minor := minorDigits(m.GetCurrencyCode()); len(frac) < minor {
+		frac += strings.Repeat("0", minor-len(frac))
+	}
+	if frac != "" {
+		b.WriteByte('.')
+		b.WriteString(frac)
+	}
+	return b.String()
+}
+
+// minorDigits is the number of decimal digits of a currency's minor unit
+// (ISO 4217 exponent).
+func minorDigits(currency string) int {
+	switch currency {
+	case "KRW", "JPY", "VND":
+		return 0
+	default:
+		return 2
+	}
+}
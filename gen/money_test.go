@@ -0,0 +1,103 @@
+package gen
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func usd(units int64, nanos int32) *Money {
+	return &Money{CurrencyCode: "USD", Units: units, Nanos: nanos}
+}
+
+func TestMoneyArithmetic(t *testing.T) {
+	tests := []struct {
+		name    string
+		op      func() (*Money, error)
+		want    *Money
+		wantErr error
+	}{
+		{"add", func() (*Money, error) { return KRW(1000).Add(KRW(500)) }, KRW(1500), nil},
+		{"add carries nanos", func() (*Money, error) { return usd(1, 600_000_000).Add(usd(0, 500_000_000)) }, usd(2, 100_000_000), nil},
+		{"add mismatched currency", func() (*Money, error) { return KRW(1).Add(usd(1, 0)) }, nil, ErrCurrencyMismatch},
+		{"add overflow", func() (*Money, error) { return KRW(math.MaxInt64).Add(KRW(1)) }, nil, ErrMoneyOverflow},
+		{"sub to negative", func() (*Money, error) { return usd(1, 0).Sub(usd(1, 500_000_000)) }, usd(0, -500_000_000), nil},
+		{"sub borrows", func() (*Money, error) { return usd(2, 100_000_000).Sub(usd(0, 200_000_000)) }, usd(1, 900_000_000), nil},
+		{"sub min int", func() (*Money, error) { return KRW(0).Sub(KRW(math.MinInt64)) }, nil, ErrMoneyOverflow},
+		{"mul", func() (*Money, error) { return KRW(9000).Mul(3) }, KRW(27000), nil},
+		{"mul carries nanos", func() (*Money, error) { return usd(1, 250_000_000).Mul(4) }, usd(5, 0), nil},
+		{"mul by zero", func() (*Money, error) { return usd(7, 990_000_000).Mul(0) }, usd(0, 0), nil},
+		{"mul negative", func() (*Money, error) { return usd(1, 500_000_000).Mul(-2) }, usd(-3, 0), nil},
+		{"mul units overflow", func() (*Money, error) { return KRW(math.MaxInt64 / 2).Mul(3) }, nil, ErrMoneyOverflow},
+		{"mul min int by -1", func() (*Money, error) { return KRW(math.MinInt64).Mul(-1) }, nil, ErrMoneyOverflow},
+		{"mul nanos overflow", func() (*Money, error) { return usd(0, 999_999_999).Mul(math.MaxInt64 / 10) }, nil, ErrMoneyOverflow},
+		{"mul nanos carry overflow", func() (*Money, error) { return usd(math.MaxInt64/3, 999_999_999).Mul(3) }, nil, ErrMoneyOverflow},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.op()
+			if !errors.Is(err, tt.wantErr) || tt.wantErr == nil && !proto.Equal(got, tt.want) {
+				t.Errorf("got %v, %v, want %v, %v", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMoneyFormat(t *testing.T) {
+	tests := []struct {
+		m    *Money
+		want string
+	}{
+		{KRW(25000), "KRW 25,000"},
+		{KRW(-1234567), "KRW -1,234,567"},
+		{KRW(0), "KRW 0"},
+		{usd(1234, 500_000_000), "USD 1,234.50"},
+		{usd(0, 1), "USD 0.000000001"},
+		{usd(-1, -250_000_000), "USD -1.25"},
+	}
+	for _, tt := range tests {
+		if got := tt.m.Format(); got != tt.want {
+			t.Errorf("Format(%v) = %q, want %q", tt.m, got, tt.want)
+		}
+	}
+}
+
+func TestKRWUnits(t *testing.T) {
+	tests := []struct {
+		m       *Money
+		want    int64
+		wantErr bool
+	}{
+		{KRW(5000), 5000, false},
+		{usd(5, 0), 0, true},
+		{&Money{CurrencyCode: "KRW", Units: 1, Nanos: 1}, 0, true},
+	}
+	for _, tt := range tests {
+		got, err := tt.m.KRWUnits()
+		if got != tt.want || (err != nil) != tt.wantErr {
+			t.Errorf("KRWUnits(%v) = %d, %v", tt.m, got, err)
+		}
+	}
+}
+
+func TestEffectivePrices(t *testing.T) {
+	tests := []struct {
+		name string
+		got  *Money
+		want *Money
+	}{
+		{"list price", (&Product{Price: 100, ListPrice: KRW(200)}).EffectiveListPrice(), KRW(200)},
+		{"legacy price", (&Product{Price: 100}).EffectiveListPrice(), KRW(100)},
+		{"tier price", (&PriceTier{UnitPrice: 100, Price: usd(2, 0)}).EffectivePrice(), usd(2, 0)},
+		{"legacy tier price", (&PriceTier{UnitPrice: 100}).EffectivePrice(), KRW(100)},
+		{"item unit price", (&OrderItem{ProductPrice: 100, UnitPrice: KRW(90)}).EffectiveUnitPrice(), KRW(90)},
+		{"legacy item price", (&OrderItem{ProductPrice: 100}).EffectiveUnitPrice(), KRW(100)},
+	}
+	for _, tt := range tests {
+		if !proto.Equal(tt.got, tt.want) {
+			t.Errorf("%s = %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package gen
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Template IDs of the order and payment notifications registered with
+// NotificationService. Their parameters are filled by OrderTemplate.
+const (
+	TemplateOrderConfirmed  = "order_confirmed"
+	TemplatePaymentApproved = "payment_approved"
+	TemplatePaymentFailed   = "payment_failed" // also takes "reason"
+)
+
+var templateParam = regexp.MustCompile(`\{([A-Za-z0-9_]+)\}`)
+
+// RenderTemplate fills the {name} placeholders of a notification template
+// with params. A placeholder without a parameter is an InvalidArgument error
+// naming every missing parameter, so a notification is never sent with a
+// literal "{order_number}" in it. Unused params are ignored.
+func RenderTemplate(text string, params map[string]string) (string, error) {
+	var missing []string
+	out := templateParam.ReplaceAllStringFunc(text, func(m string) string {
+		name := m[1 : len(m)-1]
+		v, ok := params[name]
+		if !ok {
+			missing = append(missing, name)
+		}
+		return v
+	})
+	if len(missing) > 0 {
+		return "", status.Errorf(codes.InvalidArgument, "template parameters missing: %s", strings.Join(missing, ", "))
+	}
+	return out, nil
+}
+
+// OrderTemplate returns the template templateID with the order parameters
+// set: order_id, order_number, total_price (e.g. "25,000원") and item_summary
+// (e.g. "티셔츠 외 2건"). Add template-specific parameters, such as "reason"
+// for TemplatePaymentFailed, to Params.
+func OrderTemplate(templateID string, o *Order) *NotificationTemplate {
+	return &NotificationTemplate{
+		TemplateId: templateID,
+		Params: map[string]string{
+			"order_id":     o.GetId(),
+			"order_number": o.GetOrderNumber(),
+			"total_price":  FormatKRW(o.GetTotalPrice()),
+			"item_summary": itemSummary(o.GetItems()),
+		},
+	}
+}
+
+// itemSummary names the first item and counts the rest, the way Korean
+// shops title an order.
+func itemSummary(items []*OrderItem) string {
+	switch len(items) {
+	case 0:
+		return ""
+	case 1:
+		return items[0].GetProductName()
+	}
+	return fmt.Sprintf("%s 외 %d건", items[0].GetProductName(), len(items)-1)
+}
@@ -0,0 +1,1526 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: notification.proto
+
+package gen
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type NotificationChannel int32
+
+const (
+	NotificationChannel_NOTIFICATION_CHANNEL_UNSPECIFIED NotificationChannel = 0
+	NotificationChannel_NOTIFICATION_CHANNEL_EMAIL       NotificationChannel = 1
+	NotificationChannel_NOTIFICATION_CHANNEL_SMS         NotificationChannel = 2
+	NotificationChannel_NOTIFICATION_CHANNEL_PUSH        NotificationChannel = 3
+)
+
+// Enum value maps for NotificationChannel.
+var (
+	NotificationChannel_name = map[int32]string{
+		0: "NOTIFICATION_CHANNEL_UNSPECIFIED",
+		1: "NOTIFICATION_CHANNEL_EMAIL",
+		2: "NOTIFICATION_CHANNEL_SMS",
+		3: "NOTIFICATION_CHANNEL_PUSH",
+	}
+	NotificationChannel_value = map[string]int32{
+		"NOTIFICATION_CHANNEL_UNSPECIFIED": 0,
+		"NOTIFICATION_CHANNEL_EMAIL":       1,
+		"NOTIFICATION_CHANNEL_SMS":         2,
+		"NOTIFICATION_CHANNEL_PUSH":        3,
+	}
+)
+
+func (x NotificationChannel) Enum() *NotificationChannel {
+	p := new(NotificationChannel)
+	*p = x
+	return p
+}
+
+func (x NotificationChannel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotificationChannel) Descriptor() protoreflect.EnumDescriptor {
+	return file_notification_proto_enumTypes[0].Descriptor()
+}
+
+func (NotificationChannel) Type() protoreflect.EnumType {
+	return &file_notification_proto_enumTypes[0]
+}
+
+func (x NotificationChannel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotificationChannel.Descriptor instead.
+func (NotificationChannel) EnumDescriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{0}
+}
+
+type NotificationCategory int32
+
+const (
+	NotificationCategory_NOTIFICATION_CATEGORY_UNSPECIFIED    NotificationCategory = 0
+	NotificationCategory_NOTIFICATION_CATEGORY_ORDER_UPDATES  NotificationCategory = 1 // 주문/배송 상태 변경
+	NotificationCategory_NOTIFICATION_CATEGORY_MARKETING      NotificationCategory = 2 // 광고성 정보 (수신 동의 필요)
+	NotificationCategory_NOTIFICATION_CATEGORY_RESTOCK_ALERTS NotificationCategory = 3 // 재입고 알림
+)
+
+// Enum value maps for NotificationCategory.
+var (
+	NotificationCategory_name = map[int32]string{
+		0: "NOTIFICATION_CATEGORY_UNSPECIFIED",
+		1: "NOTIFICATION_CATEGORY_ORDER_UPDATES",
+		2: "NOTIFICATION_CATEGORY_MARKETING",
+		3: "NOTIFICATION_CATEGORY_RESTOCK_ALERTS",
+	}
+	NotificationCategory_value = map[string]int32{
+		"NOTIFICATION_CATEGORY_UNSPECIFIED":    0,
+		"NOTIFICATION_CATEGORY_ORDER_UPDATES":  1,
+		"NOTIFICATION_CATEGORY_MARKETING":      2,
+		"NOTIFICATION_CATEGORY_RESTOCK_ALERTS": 3,
+	}
+)
+
+func (x NotificationCategory) Enum() *NotificationCategory {
+	p := new(NotificationCategory)
+	*p = x
+	return p
+}
+
+func (x NotificationCategory) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotificationCategory) Descriptor() protoreflect.EnumDescriptor {
+	return file_notification_proto_enumTypes[1].Descriptor()
+}
+
+func (NotificationCategory) Type() protoreflect.EnumType {
+	return &file_notification_proto_enumTypes[1]
+}
+
+func (x NotificationCategory) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotificationCategory.Descriptor instead.
+func (NotificationCategory) EnumDescriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{1}
+}
+
+// 발송 상태
+type NotificationDeliveryStatus int32
+
+const (
+	NotificationDeliveryStatus_NOTIFICATION_DELIVERY_STATUS_UNSPECIFIED NotificationDeliveryStatus = 0
+	NotificationDeliveryStatus_NOTIFICATION_DELIVERY_STATUS_QUEUED      NotificationDeliveryStatus = 1
+	NotificationDeliveryStatus_NOTIFICATION_DELIVERY_STATUS_SENT        NotificationDeliveryStatus = 2 // 발송 대행사(메일/문자/FCM·APNs) 전달 완료
+	NotificationDeliveryStatus_NOTIFICATION_DELIVERY_STATUS_DELIVERED   NotificationDeliveryStatus = 3 // 수신 확인 (지원 채널만)
+	NotificationDeliveryStatus_NOTIFICATION_DELIVERY_STATUS_FAILED      NotificationDeliveryStatus = 4 // failure_reason 참고
+	NotificationDeliveryStatus_NOTIFICATION_DELIVERY_STATUS_SUPPRESSED  NotificationDeliveryStatus = 5 // 수신 거부 또는 연락처 없음으로 미발송
+)
+
+// Enum value maps for NotificationDeliveryStatus.
+var (
+	NotificationDeliveryStatus_name = map[int32]string{
+		0: "NOTIFICATION_DELIVERY_STATUS_UNSPECIFIED",
+		1: "NOTIFICATION_DELIVERY_STATUS_QUEUED",
+		2: "NOTIFICATION_DELIVERY_STATUS_SENT",
+		3: "NOTIFICATION_DELIVERY_STATUS_DELIVERED",
+		4: "NOTIFICATION_DELIVERY_STATUS_FAILED",
+		5: "NOTIFICATION_DELIVERY_STATUS_SUPPRESSED",
+	}
+	NotificationDeliveryStatus_value = map[string]int32{
+		"NOTIFICATION_DELIVERY_STATUS_UNSPECIFIED": 0,
+		"NOTIFICATION_DELIVERY_STATUS_QUEUED":      1,
+		"NOTIFICATION_DELIVERY_STATUS_SENT":        2,
+		"NOTIFICATION_DELIVERY_STATUS_DELIVERED":   3,
+		"NOTIFICATION_DELIVERY_STATUS_FAILED":      4,
+		"NOTIFICATION_DELIVERY_STATUS_SUPPRESSED":  5,
+	}
+)
+
+func (x NotificationDeliveryStatus) Enum() *NotificationDeliveryStatus {
+	p := new(NotificationDeliveryStatus)
+	*p = x
+	return p
+}
+
+func (x NotificationDeliveryStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotificationDeliveryStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_notification_proto_enumTypes[2].Descriptor()
+}
+
+func (NotificationDeliveryStatus) Type() protoreflect.EnumType {
+	return &file_notification_proto_enumTypes[2]
+}
+
+func (x NotificationDeliveryStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotificationDeliveryStatus.Descriptor instead.
+func (NotificationDeliveryStatus) EnumDescriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{2}
+}
+
+type NotificationPreference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       NotificationChannel    `protobuf:"varint,1,opt,name=channel,proto3,enum=go.escape.ship.proto.v1.NotificationChannel" json:"channel,omitempty"`
+	Category      NotificationCategory   `protobuf:"varint,2,opt,name=category,proto3,enum=go.escape.ship.proto.v1.NotificationCategory" json:"category,omitempty"`
+	Enabled       bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationPreference) Reset() {
+	*x = NotificationPreference{}
+	mi := &file_notification_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationPreference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationPreference) ProtoMessage() {}
+
+func (x *NotificationPreference) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationPreference.ProtoReflect.Descriptor instead.
+func (*NotificationPreference) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *NotificationPreference) GetChannel() NotificationChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return NotificationChannel_NOTIFICATION_CHANNEL_UNSPECIFIED
+}
+
+func (x *NotificationPreference) GetCategory() NotificationCategory {
+	if x != nil {
+		return x.Category
+	}
+	return NotificationCategory_NOTIFICATION_CATEGORY_UNSPECIFIED
+}
+
+func (x *NotificationPreference) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type GetNotificationPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationPreferencesRequest) Reset() {
+	*x = GetNotificationPreferencesRequest{}
+	mi := &file_notification_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationPreferencesRequest) ProtoMessage() {}
+
+func (x *GetNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{1}
+}
+
+type GetNotificationPreferencesResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Preferences   []*NotificationPreference `protobuf:"bytes,1,rep,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationPreferencesResponse) Reset() {
+	*x = GetNotificationPreferencesResponse{}
+	mi := &file_notification_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationPreferencesResponse) ProtoMessage() {}
+
+func (x *GetNotificationPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetNotificationPreferencesResponse) GetPreferences() []*NotificationPreference {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+type UpdateNotificationPreferencesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 포함된 (channel, category) 조합만 변경, 나머지는 유지
+	Preferences   []*NotificationPreference `protobuf:"bytes,1,rep,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotificationPreferencesRequest) Reset() {
+	*x = UpdateNotificationPreferencesRequest{}
+	mi := &file_notification_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotificationPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationPreferencesRequest) ProtoMessage() {}
+
+func (x *UpdateNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateNotificationPreferencesRequest) GetPreferences() []*NotificationPreference {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+type UpdateNotificationPreferencesResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Preferences   []*NotificationPreference `protobuf:"bytes,1,rep,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotificationPreferencesResponse) Reset() {
+	*x = UpdateNotificationPreferencesResponse{}
+	mi := &file_notification_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotificationPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationPreferencesResponse) ProtoMessage() {}
+
+func (x *UpdateNotificationPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateNotificationPreferencesResponse) GetPreferences() []*NotificationPreference {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+// 알림함에 표시되는 알림
+type Notification struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Category      NotificationCategory   `protobuf:"varint,2,opt,name=category,proto3,enum=go.escape.ship.proto.v1.NotificationCategory" json:"category,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Body          string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	LinkUrl       string                 `protobuf:"bytes,5,opt,name=link_url,json=linkUrl,proto3" json:"link_url,omitempty"` // 클릭 시 이동할 앱/웹 경로 (ex: "/orders/{id}")
+	Read          bool                   `protobuf:"varint,6,opt,name=read,proto3" json:"read,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ReadAt        string                 `protobuf:"bytes,8,opt,name=read_at,json=readAt,proto3" json:"read_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notification) Reset() {
+	*x = Notification{}
+	mi := &file_notification_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification) ProtoMessage() {}
+
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Notification) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Notification) GetCategory() NotificationCategory {
+	if x != nil {
+		return x.Category
+	}
+	return NotificationCategory_NOTIFICATION_CATEGORY_UNSPECIFIED
+}
+
+func (x *Notification) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Notification) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Notification) GetLinkUrl() string {
+	if x != nil {
+		return x.LinkUrl
+	}
+	return ""
+}
+
+func (x *Notification) GetRead() bool {
+	if x != nil {
+		return x.Read
+	}
+	return false
+}
+
+func (x *Notification) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Notification) GetReadAt() string {
+	if x != nil {
+		return x.ReadAt
+	}
+	return ""
+}
+
+type ListNotificationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	UnreadOnly    bool                   `protobuf:"varint,3,opt,name=unread_only,json=unreadOnly,proto3" json:"unread_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationsRequest) Reset() {
+	*x = ListNotificationsRequest{}
+	mi := &file_notification_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsRequest) ProtoMessage() {}
+
+func (x *ListNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*ListNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListNotificationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListNotificationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListNotificationsRequest) GetUnreadOnly() bool {
+	if x != nil {
+		return x.UnreadOnly
+	}
+	return false
+}
+
+type ListNotificationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notifications []*Notification        `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	UnreadCount   int32                  `protobuf:"varint,3,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationsResponse) Reset() {
+	*x = ListNotificationsResponse{}
+	mi := &file_notification_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsResponse) ProtoMessage() {}
+
+func (x *ListNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*ListNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListNotificationsResponse) GetNotifications() []*Notification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+func (x *ListNotificationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListNotificationsResponse) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+type MarkNotificationReadRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NotificationId string                 `protobuf:"bytes,1,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MarkNotificationReadRequest) Reset() {
+	*x = MarkNotificationReadRequest{}
+	mi := &file_notification_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkNotificationReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkNotificationReadRequest) ProtoMessage() {}
+
+func (x *MarkNotificationReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkNotificationReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkNotificationReadRequest) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MarkNotificationReadRequest) GetNotificationId() string {
+	if x != nil {
+		return x.NotificationId
+	}
+	return ""
+}
+
+type MarkNotificationReadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UnreadCount   int32                  `protobuf:"varint,1,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkNotificationReadResponse) Reset() {
+	*x = MarkNotificationReadResponse{}
+	mi := &file_notification_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkNotificationReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkNotificationReadResponse) ProtoMessage() {}
+
+func (x *MarkNotificationReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkNotificationReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkNotificationReadResponse) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MarkNotificationReadResponse) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+// 템플릿 발송: 서버에 등록된 템플릿의 {name} 자리에 params 값을 채움
+// template_id가 있으면 직접 입력한 제목/본문은 무시
+type NotificationTemplate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`                                                 // ex: "order_confirmed" (Go: TemplateOrderConfirmed)
+	Params        map[string]string      `protobuf:"bytes,2,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // ex: {"order_number": "ORD-2024-001"}
+	Locale        string                 `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`                                                                           // BCP 47 (ex: "ko", "en-US"), 비어 있으면 사용자 설정 언어
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationTemplate) Reset() {
+	*x = NotificationTemplate{}
+	mi := &file_notification_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationTemplate) ProtoMessage() {}
+
+func (x *NotificationTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationTemplate.ProtoReflect.Descriptor instead.
+func (*NotificationTemplate) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *NotificationTemplate) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *NotificationTemplate) GetParams() map[string]string {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *NotificationTemplate) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type SendEmailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`                                                                // 비어 있으면 사용자 계정 이메일
+	Category      NotificationCategory   `protobuf:"varint,3,opt,name=category,proto3,enum=go.escape.ship.proto.v1.NotificationCategory" json:"category,omitempty"` // 수신 설정 확인용
+	Template      *NotificationTemplate  `protobuf:"bytes,4,opt,name=template,proto3" json:"template,omitempty"`
+	Subject       string                 `protobuf:"bytes,5,opt,name=subject,proto3" json:"subject,omitempty"`                   // 템플릿 미사용 시
+	Body          string                 `protobuf:"bytes,6,opt,name=body,proto3" json:"body,omitempty"`                         // 템플릿 미사용 시 (HTML)
+	DedupKey      string                 `protobuf:"bytes,7,opt,name=dedup_key,json=dedupKey,proto3" json:"dedup_key,omitempty"` // ex: "order_confirmed:{order_id}"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendEmailRequest) Reset() {
+	*x = SendEmailRequest{}
+	mi := &file_notification_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendEmailRequest) ProtoMessage() {}
+
+func (x *SendEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendEmailRequest.ProtoReflect.Descriptor instead.
+func (*SendEmailRequest) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SendEmailRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SendEmailRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *SendEmailRequest) GetCategory() NotificationCategory {
+	if x != nil {
+		return x.Category
+	}
+	return NotificationCategory_NOTIFICATION_CATEGORY_UNSPECIFIED
+}
+
+func (x *SendEmailRequest) GetTemplate() *NotificationTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+func (x *SendEmailRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *SendEmailRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *SendEmailRequest) GetDedupKey() string {
+	if x != nil {
+		return x.DedupKey
+	}
+	return ""
+}
+
+type SendSMSRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PhoneNumber   string                 `protobuf:"bytes,2,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"` // 비어 있으면 사용자 계정 전화번호
+	Category      NotificationCategory   `protobuf:"varint,3,opt,name=category,proto3,enum=go.escape.ship.proto.v1.NotificationCategory" json:"category,omitempty"`
+	Template      *NotificationTemplate  `protobuf:"bytes,4,opt,name=template,proto3" json:"template,omitempty"`
+	Text          string                 `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"` // 템플릿 미사용 시 (90바이트 초과 시 LMS)
+	DedupKey      string                 `protobuf:"bytes,6,opt,name=dedup_key,json=dedupKey,proto3" json:"dedup_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendSMSRequest) Reset() {
+	*x = SendSMSRequest{}
+	mi := &file_notification_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendSMSRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendSMSRequest) ProtoMessage() {}
+
+func (x *SendSMSRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendSMSRequest.ProtoReflect.Descriptor instead.
+func (*SendSMSRequest) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SendSMSRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SendSMSRequest) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *SendSMSRequest) GetCategory() NotificationCategory {
+	if x != nil {
+		return x.Category
+	}
+	return NotificationCategory_NOTIFICATION_CATEGORY_UNSPECIFIED
+}
+
+func (x *SendSMSRequest) GetTemplate() *NotificationTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+func (x *SendSMSRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SendSMSRequest) GetDedupKey() string {
+	if x != nil {
+		return x.DedupKey
+	}
+	return ""
+}
+
+type SendPushRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Category      NotificationCategory   `protobuf:"varint,2,opt,name=category,proto3,enum=go.escape.ship.proto.v1.NotificationCategory" json:"category,omitempty"`
+	Template      *NotificationTemplate  `protobuf:"bytes,3,opt,name=template,proto3" json:"template,omitempty"`
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"` // 템플릿 미사용 시
+	Body          string                 `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	LinkUrl       string                 `protobuf:"bytes,6,opt,name=link_url,json=linkUrl,proto3" json:"link_url,omitempty"`                                                      // 클릭 시 이동할 앱 경로 (ex: "/orders/{id}")
+	Data          map[string]string      `protobuf:"bytes,7,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 앱으로 전달할 추가 데이터
+	DedupKey      string                 `protobuf:"bytes,8,opt,name=dedup_key,json=dedupKey,proto3" json:"dedup_key,omitempty"`
+	SaveToInbox   bool                   `protobuf:"varint,9,opt,name=save_to_inbox,json=saveToInbox,proto3" json:"save_to_inbox,omitempty"` // 알림함(ListNotifications)에도 저장
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendPushRequest) Reset() {
+	*x = SendPushRequest{}
+	mi := &file_notification_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendPushRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendPushRequest) ProtoMessage() {}
+
+func (x *SendPushRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendPushRequest.ProtoReflect.Descriptor instead.
+func (*SendPushRequest) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SendPushRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SendPushRequest) GetCategory() NotificationCategory {
+	if x != nil {
+		return x.Category
+	}
+	return NotificationCategory_NOTIFICATION_CATEGORY_UNSPECIFIED
+}
+
+func (x *SendPushRequest) GetTemplate() *NotificationTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+func (x *SendPushRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SendPushRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *SendPushRequest) GetLinkUrl() string {
+	if x != nil {
+		return x.LinkUrl
+	}
+	return ""
+}
+
+func (x *SendPushRequest) GetData() map[string]string {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *SendPushRequest) GetDedupKey() string {
+	if x != nil {
+		return x.DedupKey
+	}
+	return ""
+}
+
+func (x *SendPushRequest) GetSaveToInbox() bool {
+	if x != nil {
+		return x.SaveToInbox
+	}
+	return false
+}
+
+type SendNotificationResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	MessageId     string                     `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Status        NotificationDeliveryStatus `protobuf:"varint,2,opt,name=status,proto3,enum=go.escape.ship.proto.v1.NotificationDeliveryStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendNotificationResponse) Reset() {
+	*x = SendNotificationResponse{}
+	mi := &file_notification_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendNotificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendNotificationResponse) ProtoMessage() {}
+
+func (x *SendNotificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendNotificationResponse.ProtoReflect.Descriptor instead.
+func (*SendNotificationResponse) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SendNotificationResponse) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *SendNotificationResponse) GetStatus() NotificationDeliveryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return NotificationDeliveryStatus_NOTIFICATION_DELIVERY_STATUS_UNSPECIFIED
+}
+
+type SubscribeEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channels      []NotificationChannel  `protobuf:"varint,1,rep,packed,name=channels,proto3,enum=go.escape.ship.proto.v1.NotificationChannel" json:"channels,omitempty"`      // 비어 있으면 전체 채널
+	Categories    []NotificationCategory `protobuf:"varint,2,rep,packed,name=categories,proto3,enum=go.escape.ship.proto.v1.NotificationCategory" json:"categories,omitempty"` // 비어 있으면 전체 카테고리
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                                     // 비어 있으면 전체 사용자
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeEventsRequest) Reset() {
+	*x = SubscribeEventsRequest{}
+	mi := &file_notification_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeEventsRequest) ProtoMessage() {}
+
+func (x *SubscribeEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeEventsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeEventsRequest) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SubscribeEventsRequest) GetChannels() []NotificationChannel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *SubscribeEventsRequest) GetCategories() []NotificationCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *SubscribeEventsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// 발송 상태 변경 이벤트
+type NotificationEvent struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	MessageId     string                     `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	UserId        string                     `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Channel       NotificationChannel        `protobuf:"varint,3,opt,name=channel,proto3,enum=go.escape.ship.proto.v1.NotificationChannel" json:"channel,omitempty"`
+	Category      NotificationCategory       `protobuf:"varint,4,opt,name=category,proto3,enum=go.escape.ship.proto.v1.NotificationCategory" json:"category,omitempty"`
+	Status        NotificationDeliveryStatus `protobuf:"varint,5,opt,name=status,proto3,enum=go.escape.ship.proto.v1.NotificationDeliveryStatus" json:"status,omitempty"`
+	TemplateId    string                     `protobuf:"bytes,6,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	DedupKey      string                     `protobuf:"bytes,7,opt,name=dedup_key,json=dedupKey,proto3" json:"dedup_key,omitempty"`
+	FailureReason string                     `protobuf:"bytes,8,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	OccurredAt    string                     `protobuf:"bytes,9,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationEvent) Reset() {
+	*x = NotificationEvent{}
+	mi := &file_notification_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationEvent) ProtoMessage() {}
+
+func (x *NotificationEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationEvent.ProtoReflect.Descriptor instead.
+func (*NotificationEvent) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *NotificationEvent) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *NotificationEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *NotificationEvent) GetChannel() NotificationChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return NotificationChannel_NOTIFICATION_CHANNEL_UNSPECIFIED
+}
+
+func (x *NotificationEvent) GetCategory() NotificationCategory {
+	if x != nil {
+		return x.Category
+	}
+	return NotificationCategory_NOTIFICATION_CATEGORY_UNSPECIFIED
+}
+
+func (x *NotificationEvent) GetStatus() NotificationDeliveryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return NotificationDeliveryStatus_NOTIFICATION_DELIVERY_STATUS_UNSPECIFIED
+}
+
+func (x *NotificationEvent) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *NotificationEvent) GetDedupKey() string {
+	if x != nil {
+		return x.DedupKey
+	}
+	return ""
+}
+
+func (x *NotificationEvent) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+func (x *NotificationEvent) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+var File_notification_proto protoreflect.FileDescriptor
+
+const file_notification_proto_rawDesc = "" +
+	"\n" +
+	"\x12notification.proto\x12\x17go.escape.ship.proto.v1\x1a\x1cgoogle/api/annotations.proto\"\xc5\x01\n" +
+	"\x16NotificationPreference\x12F\n" +
+	"\achannel\x18\x01 \x01(\x0e2,.go.escape.ship.proto.v1.NotificationChannelR\achannel\x12I\n" +
+	"\bcategory\x18\x02 \x01(\x0e2-.go.escape.ship.proto.v1.NotificationCategoryR\bcategory\x12\x18\n" +
+	"\aenabled\x18\x03 \x01(\bR\aenabled\"#\n" +
+	"!GetNotificationPreferencesRequest\"w\n" +
+	"\"GetNotificationPreferencesResponse\x12Q\n" +
+	"\vpreferences\x18\x01 \x03(\v2/.go.escape.ship.proto.v1.NotificationPreferenceR\vpreferences\"y\n" +
+	"$UpdateNotificationPreferencesRequest\x12Q\n" +
+	"\vpreferences\x18\x01 \x03(\v2/.go.escape.ship.proto.v1.NotificationPreferenceR\vpreferences\"z\n" +
+	"%UpdateNotificationPreferencesResponse\x12Q\n" +
+	"\vpreferences\x18\x01 \x03(\v2/.go.escape.ship.proto.v1.NotificationPreferenceR\vpreferences\"\xfa\x01\n" +
+	"\fNotification\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12I\n" +
+	"\bcategory\x18\x02 \x01(\x0e2-.go.escape.ship.proto.v1.NotificationCategoryR\bcategory\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\x12\x19\n" +
+	"\blink_url\x18\x05 \x01(\tR\alinkUrl\x12\x12\n" +
+	"\x04read\x18\x06 \x01(\bR\x04read\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x17\n" +
+	"\aread_at\x18\b \x01(\tR\x06readAt\"w\n" +
+	"\x18ListNotificationsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x1f\n" +
+	"\vunread_only\x18\x03 \x01(\bR\n" +
+	"unreadOnly\"\xb3\x01\n" +
+	"\x19ListNotificationsResponse\x12K\n" +
+	"\rnotifications\x18\x01 \x03(\v2%.go.escape.ship.proto.v1.NotificationR\rnotifications\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12!\n" +
+	"\funread_count\x18\x03 \x01(\x05R\vunreadCount\"F\n" +
+	"\x1bMarkNotificationReadRequest\x12'\n" +
+	"\x0fnotification_id\x18\x01 \x01(\tR\x0enotificationId\"A\n" +
+	"\x1cMarkNotificationReadResponse\x12!\n" +
+	"\funread_count\x18\x01 \x01(\x05R\vunreadCount\"\xdd\x01\n" +
+	"\x14NotificationTemplate\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12Q\n" +
+	"\x06params\x18\x02 \x03(\v29.go.escape.ship.proto.v1.NotificationTemplate.ParamsEntryR\x06params\x12\x16\n" +
+	"\x06locale\x18\x03 \x01(\tR\x06locale\x1a9\n" +
+	"\vParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x9c\x02\n" +
+	"\x10SendEmailRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12I\n" +
+	"\bcategory\x18\x03 \x01(\x0e2-.go.escape.ship.proto.v1.NotificationCategoryR\bcategory\x12I\n" +
+	"\btemplate\x18\x04 \x01(\v2-.go.escape.ship.proto.v1.NotificationTemplateR\btemplate\x12\x18\n" +
+	"\asubject\x18\x05 \x01(\tR\asubject\x12\x12\n" +
+	"\x04body\x18\x06 \x01(\tR\x04body\x12\x1b\n" +
+	"\tdedup_key\x18\a \x01(\tR\bdedupKey\"\x93\x02\n" +
+	"\x0eSendSMSRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
+	"\fphone_number\x18\x02 \x01(\tR\vphoneNumber\x12I\n" +
+	"\bcategory\x18\x03 \x01(\x0e2-.go.escape.ship.proto.v1.NotificationCategoryR\bcategory\x12I\n" +
+	"\btemplate\x18\x04 \x01(\v2-.go.escape.ship.proto.v1.NotificationTemplateR\btemplate\x12\x12\n" +
+	"\x04text\x18\x05 \x01(\tR\x04text\x12\x1b\n" +
+	"\tdedup_key\x18\x06 \x01(\tR\bdedupKey\"\xc7\x03\n" +
+	"\x0fSendPushRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12I\n" +
+	"\bcategory\x18\x02 \x01(\x0e2-.go.escape.ship.proto.v1.NotificationCategoryR\bcategory\x12I\n" +
+	"\btemplate\x18\x03 \x01(\v2-.go.escape.ship.proto.v1.NotificationTemplateR\btemplate\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x05 \x01(\tR\x04body\x12\x19\n" +
+	"\blink_url\x18\x06 \x01(\tR\alinkUrl\x12F\n" +
+	"\x04data\x18\a \x03(\v22.go.escape.ship.proto.v1.SendPushRequest.DataEntryR\x04data\x12\x1b\n" +
+	"\tdedup_key\x18\b \x01(\tR\bdedupKey\x12\"\n" +
+	"\rsave_to_inbox\x18\t \x01(\bR\vsaveToInbox\x1a7\n" +
+	"\tDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x86\x01\n" +
+	"\x18SendNotificationResponse\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12K\n" +
+	"\x06status\x18\x02 \x01(\x0e23.go.escape.ship.proto.v1.NotificationDeliveryStatusR\x06status\"\xca\x01\n" +
+	"\x16SubscribeEventsRequest\x12H\n" +
+	"\bchannels\x18\x01 \x03(\x0e2,.go.escape.ship.proto.v1.NotificationChannelR\bchannels\x12M\n" +
+	"\n" +
+	"categories\x18\x02 \x03(\x0e2-.go.escape.ship.proto.v1.NotificationCategoryR\n" +
+	"categories\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"\xb1\x03\n" +
+	"\x11NotificationEvent\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12F\n" +
+	"\achannel\x18\x03 \x01(\x0e2,.go.escape.ship.proto.v1.NotificationChannelR\achannel\x12I\n" +
+	"\bcategory\x18\x04 \x01(\x0e2-.go.escape.ship.proto.v1.NotificationCategoryR\bcategory\x12K\n" +
+	"\x06status\x18\x05 \x01(\x0e23.go.escape.ship.proto.v1.NotificationDeliveryStatusR\x06status\x12\x1f\n" +
+	"\vtemplate_id\x18\x06 \x01(\tR\n" +
+	"templateId\x12\x1b\n" +
+	"\tdedup_key\x18\a \x01(\tR\bdedupKey\x12%\n" +
+	"\x0efailure_reason\x18\b \x01(\tR\rfailureReason\x12\x1f\n" +
+	"\voccurred_at\x18\t \x01(\tR\n" +
+	"occurredAt*\x98\x01\n" +
+	"\x13NotificationChannel\x12$\n" +
+	" NOTIFICATION_CHANNEL_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aNOTIFICATION_CHANNEL_EMAIL\x10\x01\x12\x1c\n" +
+	"\x18NOTIFICATION_CHANNEL_SMS\x10\x02\x12\x1d\n" +
+	"\x19NOTIFICATION_CHANNEL_PUSH\x10\x03*\xb5\x01\n" +
+	"\x14NotificationCategory\x12%\n" +
+	"!NOTIFICATION_CATEGORY_UNSPECIFIED\x10\x00\x12'\n" +
+	"#NOTIFICATION_CATEGORY_ORDER_UPDATES\x10\x01\x12#\n" +
+	"\x1fNOTIFICATION_CATEGORY_MARKETING\x10\x02\x12(\n" +
+	"$NOTIFICATION_CATEGORY_RESTOCK_ALERTS\x10\x03*\x9c\x02\n" +
+	"\x1aNotificationDeliveryStatus\x12,\n" +
+	"(NOTIFICATION_DELIVERY_STATUS_UNSPECIFIED\x10\x00\x12'\n" +
+	"#NOTIFICATION_DELIVERY_STATUS_QUEUED\x10\x01\x12%\n" +
+	"!NOTIFICATION_DELIVERY_STATUS_SENT\x10\x02\x12*\n" +
+	"&NOTIFICATION_DELIVERY_STATUS_DELIVERED\x10\x03\x12'\n" +
+	"#NOTIFICATION_DELIVERY_STATUS_FAILED\x10\x04\x12+\n" +
+	"'NOTIFICATION_DELIVERY_STATUS_SUPPRESSED\x10\x052\xae\n" +
+	"\n" +
+	"\x13NotificationService\x12\xbc\x01\n" +
+	"\x1aGetNotificationPreferences\x12:.go.escape.ship.proto.v1.GetNotificationPreferencesRequest\x1a;.go.escape.ship.proto.v1.GetNotificationPreferencesResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/notifications/preferences\x12\xc8\x01\n" +
+	"\x1dUpdateNotificationPreferences\x12=.go.escape.ship.proto.v1.UpdateNotificationPreferencesRequest\x1a>.go.escape.ship.proto.v1.UpdateNotificationPreferencesResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\x1a\x1d/v1/notifications/preferences\x12\x95\x01\n" +
+	"\x11ListNotifications\x121.go.escape.ship.proto.v1.ListNotificationsRequest\x1a2.go.escape.ship.proto.v1.ListNotificationsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/notifications\x12\xb8\x01\n" +
+	"\x14MarkNotificationRead\x124.go.escape.ship.proto.v1.MarkNotificationReadRequest\x1a5.go.escape.ship.proto.v1.MarkNotificationReadResponse\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/v1/notifications/{notification_id}/read\x12\x8d\x01\n" +
+	"\tSendEmail\x12).go.escape.ship.proto.v1.SendEmailRequest\x1a1.go.escape.ship.proto.v1.SendNotificationResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/notifications/email\x12\x87\x01\n" +
+	"\aSendSMS\x12'.go.escape.ship.proto.v1.SendSMSRequest\x1a1.go.escape.ship.proto.v1.SendNotificationResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/notifications/sms\x12\x8a\x01\n" +
+	"\bSendPush\x12(.go.escape.ship.proto.v1.SendPushRequest\x1a1.go.escape.ship.proto.v1.SendNotificationResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/notifications/push\x12\x92\x01\n" +
+	"\x0fSubscribeEvents\x12/.go.escape.ship.proto.v1.SubscribeEventsRequest\x1a*.go.escape.ship.proto.v1.NotificationEvent\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/notifications/events0\x01B#Z!github.com/escape-ship/protos/genb\x06proto3"
+
+var (
+	file_notification_proto_rawDescOnce sync.Once
+	file_notification_proto_rawDescData []byte
+)
+
+func file_notification_proto_rawDescGZIP() []byte {
+	file_notification_proto_rawDescOnce.Do(func() {
+		file_notification_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_notification_proto_rawDesc), len(file_notification_proto_rawDesc)))
+	})
+	return file_notification_proto_rawDescData
+}
+
+var file_notification_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_notification_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_notification_proto_goTypes = []any{
+	(NotificationChannel)(0),                      // 0: go.escape.ship.proto.v1.NotificationChannel
+	(NotificationCategory)(0),                     // 1: go.escape.ship.proto.v1.NotificationCategory
+	(NotificationDeliveryStatus)(0),               // 2: go.escape.ship.proto.v1.NotificationDeliveryStatus
+	(*NotificationPreference)(nil),                // 3: go.escape.ship.proto.v1.NotificationPreference
+	(*GetNotificationPreferencesRequest)(nil),     // 4: go.escape.ship.proto.v1.GetNotificationPreferencesRequest
+	(*GetNotificationPreferencesResponse)(nil),    // 5: go.escape.ship.proto.v1.GetNotificationPreferencesResponse
+	(*UpdateNotificationPreferencesRequest)(nil),  // 6: go.escape.ship.proto.v1.UpdateNotificationPreferencesRequest
+	(*UpdateNotificationPreferencesResponse)(nil), // 7: go.escape.ship.proto.v1.UpdateNotificationPreferencesResponse
+	(*Notification)(nil),                          // 8: go.escape.ship.proto.v1.Notification
+	(*ListNotificationsRequest)(nil),              // 9: go.escape.ship.proto.v1.ListNotificationsRequest
+	(*ListNotificationsResponse)(nil),             // 10: go.escape.ship.proto.v1.ListNotificationsResponse
+	(*MarkNotificationReadRequest)(nil),           // 11: go.escape.ship.proto.v1.MarkNotificationReadRequest
+	(*MarkNotificationReadResponse)(nil),          // 12: go.escape.ship.proto.v1.MarkNotificationReadResponse
+	(*NotificationTemplate)(nil),                  // 13: go.escape.ship.proto.v1.NotificationTemplate
+	(*SendEmailRequest)(nil),                      // 14: go.escape.ship.proto.v1.SendEmailRequest
+	(*SendSMSRequest)(nil),                        // 15: go.escape.ship.proto.v1.SendSMSRequest
+	(*SendPushRequest)(nil),                       // 16: go.escape.ship.proto.v1.SendPushRequest
+	(*SendNotificationResponse)(nil),              // 17: go.escape.ship.proto.v1.SendNotificationResponse
+	(*SubscribeEventsRequest)(nil),                // 18: go.escape.ship.proto.v1.SubscribeEventsRequest
+	(*NotificationEvent)(nil),                     // 19: go.escape.ship.proto.v1.NotificationEvent
+	nil,                                           // 20: go.escape.ship.proto.v1.NotificationTemplate.ParamsEntry
+	nil,                                           // 21: go.escape.ship.proto.v1.SendPushRequest.DataEntry
+}
+var file_notification_proto_depIdxs = []int32{
+	0,  // 0: go.escape.ship.proto.v1.NotificationPreference.channel:type_name -> go.escape.ship.proto.v1.NotificationChannel
+	1,  // 1: go.escape.ship.proto.v1.NotificationPreference.category:type_name -> go.escape.ship.proto.v1.NotificationCategory
+	3,  // 2: go.escape.ship.proto.v1.GetNotificationPreferencesResponse.preferences:type_name -> go.escape.ship.proto.v1.NotificationPreference
+	3,  // 3: go.escape.ship.proto.v1.UpdateNotificationPreferencesRequest.preferences:type_name -> go.escape.ship.proto.v1.NotificationPreference
+	3,  // 4: go.escape.ship.proto.v1.UpdateNotificationPreferencesResponse.preferences:type_name -> go.escape.ship.proto.v1.NotificationPreference
+	1,  // 5: go.escape.ship.proto.v1.Notification.category:type_name -> go.escape.ship.proto.v1.NotificationCategory
+	8,  // 6: go.escape.ship.proto.v1.ListNotificationsResponse.notifications:type_name -> go.escape.ship.proto.v1.Notification
+	20, // 7: go.escape.ship.proto.v1.NotificationTemplate.params:type_name -> go.escape.ship.proto.v1.NotificationTemplate.ParamsEntry
+	1,  // 8: go.escape.ship.proto.v1.SendEmailRequest.category:type_name -> go.escape.ship.proto.v1.NotificationCategory
+	13, // 9: go.escape.ship.proto.v1.SendEmailRequest.template:type_name -> go.escape.ship.proto.v1.NotificationTemplate
+	1,  // 10: go.escape.ship.proto.v1.SendSMSRequest.category:type_name -> go.escape.ship.proto.v1.NotificationCategory
+	13, // 11: go.escape.ship.proto.v1.SendSMSRequest.template:type_name -> go.escape.ship.proto.v1.NotificationTemplate
+	1,  // 12: go.escape.ship.proto.v1.SendPushRequest.category:type_name -> go.escape.ship.proto.v1.NotificationCategory
+	13, // 13: go.escape.ship.proto.v1.SendPushRequest.template:type_name -> go.escape.ship.proto.v1.NotificationTemplate
+	21, // 14: go.escape.ship.proto.v1.SendPushRequest.data:type_name -> go.escape.ship.proto.v1.SendPushRequest.DataEntry
+	2,  // 15: go.escape.ship.proto.v1.SendNotificationResponse.status:type_name -> go.escape.ship.proto.v1.NotificationDeliveryStatus
+	0,  // 16: go.escape.ship.proto.v1.SubscribeEventsRequest.channels:type_name -> go.escape.ship.proto.v1.NotificationChannel
+	1,  // 17: go.escape.ship.proto.v1.SubscribeEventsRequest.categories:type_name -> go.escape.ship.proto.v1.NotificationCategory
+	0,  // 18: go.escape.ship.proto.v1.NotificationEvent.channel:type_name -> go.escape.ship.proto.v1.NotificationChannel
+	1,  // 19: go.escape.ship.proto.v1.NotificationEvent.category:type_name -> go.escape.ship.proto.v1.NotificationCategory
+	2,  // 20: go.escape.ship.proto.v1.NotificationEvent.status:type_name -> go.escape.ship.proto.v1.NotificationDeliveryStatus
+	4,  // 21: go.escape.ship.proto.v1.NotificationService.GetNotificationPreferences:input_type -> go.escape.ship.proto.v1.GetNotificationPreferencesRequest
+	6,  // 22: go.escape.ship.proto.v1.NotificationService.UpdateNotificationPreferences:input_type -> go.escape.ship.proto.v1.UpdateNotificationPreferencesRequest
+	9,  // 23: go.escape.ship.proto.v1.NotificationService.ListNotifications:input_type -> go.escape.ship.proto.v1.ListNotificationsRequest
+	11, // 24: go.escape.ship.proto.v1.NotificationService.MarkNotificationRead:input_type -> go.escape.ship.proto.v1.MarkNotificationReadRequest
+	14, // 25: go.escape.ship.proto.v1.NotificationService.SendEmail:input_type -> go.escape.ship.proto.v1.SendEmailRequest
+	15, // 26: go.escape.ship.proto.v1.NotificationService.SendSMS:input_type -> go.escape.ship.proto.v1.SendSMSRequest
+	16, // 27: go.escape.ship.proto.v1.NotificationService.SendPush:input_type -> go.escape.ship.proto.v1.SendPushRequest
+	18, // 28: go.escape.ship.proto.v1.NotificationService.SubscribeEvents:input_type -> go.escape.ship.proto.v1.SubscribeEventsRequest
+	5,  // 29: go.escape.ship.proto.v1.NotificationService.GetNotificationPreferences:output_type -> go.escape.ship.proto.v1.GetNotificationPreferencesResponse
+	7,  // 30: go.escape.ship.proto.v1.NotificationService.UpdateNotificationPreferences:output_type -> go.escape.ship.proto.v1.UpdateNotificationPreferencesResponse
+	10, // 31: go.escape.ship.proto.v1.NotificationService.ListNotifications:output_type -> go.escape.ship.proto.v1.ListNotificationsResponse
+	12, // 32: go.escape.ship.proto.v1.NotificationService.MarkNotificationRead:output_type -> go.escape.ship.proto.v1.MarkNotificationReadResponse
+	17, // 33: go.escape.ship.proto.v1.NotificationService.SendEmail:output_type -> go.escape.ship.proto.v1.SendNotificationResponse
+	17, // 34: go.escape.ship.proto.v1.NotificationService.SendSMS:output_type -> go.escape.ship.proto.v1.SendNotificationResponse
+	17, // 35: go.escape.ship.proto.v1.NotificationService.SendPush:output_type -> go.escape.ship.proto.v1.SendNotificationResponse
+	19, // 36: go.escape.ship.proto.v1.NotificationService.SubscribeEvents:output_type -> go.escape.ship.proto.v1.NotificationEvent
+	29, // [29:37] is the sub-list for method output_type
+	21, // [21:29] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
+}
+
+func init() { file_notification_proto_init() }
+func file_notification_proto_init() {
+	if File_notification_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_notification_proto_rawDesc), len(file_notification_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   19,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_notification_proto_goTypes,
+		DependencyIndexes: file_notification_proto_depIdxs,
+		EnumInfos:         file_notification_proto_enumTypes,
+		MessageInfos:      file_notification_proto_msgTypes,
+	}.Build()
+	File_notification_proto = out.File
+	file_notification_proto_goTypes = nil
+	file_notification_proto_depIdxs = nil
+}
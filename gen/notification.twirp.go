@@ -0,0 +1,2580 @@
+// Code generated by protoc-gen-twirp v8.1.3, DO NOT EDIT.
+// source: notification.proto
+
+package gen
+
+import context "context"
+import fmt "fmt"
+import http "net/http"
+import io "io"
+import json "encoding/json"
+import strconv "strconv"
+import strings "strings"
+
+import protojson "google.golang.org/protobuf/encoding/protojson"
+import proto "google.golang.org/protobuf/proto"
+import twirp "github.com/twitchtv/twirp"
+import ctxsetters "github.com/twitchtv/twirp/ctxsetters"
+
+// Version compatibility assertion.
+// If the constant is not defined in the package, that likely means
+// the package needs to be updated to work with this generated code.
+// See https://twitchtv.github.io/twirp/docs/version_matrix.html
+const _ = twirp.TwirpPackageMinVersion_8_1_0
+
+// =============================
+// NotificationService Interface
+// =============================
+
+type NotificationService interface {
+	// 채널/카테고리별 알림 수신 설정 (Authorization 헤더의 사용자 기준)
+	GetNotificationPreferences(context.Context, *GetNotificationPreferencesRequest) (*GetNotificationPreferencesResponse, error)
+
+	UpdateNotificationPreferences(context.Context, *UpdateNotificationPreferencesRequest) (*UpdateNotificationPreferencesResponse, error)
+
+	// 알림함 목록 (최신순, 페이지네이션)
+	ListNotifications(context.Context, *ListNotificationsRequest) (*ListNotificationsResponse, error)
+
+	MarkNotificationRead(context.Context, *MarkNotificationReadRequest) (*MarkNotificationReadResponse, error)
+
+	// 서비스 간 발송 요청 (주문 확인, 결제 결과 등)
+	// 사용자 수신 설정을 확인하며, 수신 거부 시 발송하지 않고 SUPPRESSED 반환
+	// 같은 dedup_key로 재요청하면 다시 발송하지 않고 처음 결과를 반환
+	SendEmail(context.Context, *SendEmailRequest) (*SendNotificationResponse, error)
+
+	SendSMS(context.Context, *SendSMSRequest) (*SendNotificationResponse, error)
+
+	// 사용자의 등록된 모든 기기(AccountService.RegisterPushToken)로 발송
+	SendPush(context.Context, *SendPushRequest) (*SendNotificationResponse, error)
+
+	// 발송 결과(발송/도달/실패) 이벤트 구독 (발송 요청 서비스의 재시도·대체 채널 판단용)
+	SubscribeEvents(context.Context, *SubscribeEventsRequest) (*NotificationEvent, error)
+}
+
+// ===================================
+// NotificationService Protobuf Client
+// ===================================
+
+type notificationServiceProtobufClient struct {
+	client      HTTPClient
+	urls        [8]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewNotificationServiceProtobufClient creates a Protobuf client that implements the NotificationService interface.
+// It communicates using Protobuf and can be configured with a custom HTTPClient.
+func NewNotificationServiceProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) NotificationService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "go.escape.ship.proto.v1", "NotificationService")
+	urls := [8]string{
+		serviceURL + "GetNotificationPreferences",
+		serviceURL + "UpdateNotificationPreferences",
+		serviceURL + "ListNotifications",
+		serviceURL + "MarkNotificationRead",
+		serviceURL + "SendEmail",
+		serviceURL + "SendSMS",
+		serviceURL + "SendPush",
+		serviceURL + "SubscribeEvents",
+	}
+
+	return &notificationServiceProtobufClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *notificationServiceProtobufClient) GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesRequest) (*GetNotificationPreferencesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "NotificationService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetNotificationPreferences")
+	caller := c.callGetNotificationPreferences
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetNotificationPreferencesRequest) (*GetNotificationPreferencesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetNotificationPreferencesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetNotificationPreferencesRequest) when calling interceptor")
+					}
+					return c.callGetNotificationPreferences(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetNotificationPreferencesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetNotificationPreferencesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *notificationServiceProtobufClient) callGetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesRequest) (*GetNotificationPreferencesResponse, error) {
+	out := new(GetNotificationPreferencesResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *notificationServiceProtobufClient) UpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesRequest) (*UpdateNotificationPreferencesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "NotificationService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateNotificationPreferences")
+	caller := c.callUpdateNotificationPreferences
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateNotificationPreferencesRequest) (*UpdateNotificationPreferencesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateNotificationPreferencesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateNotificationPreferencesRequest) when calling interceptor")
+					}
+					return c.callUpdateNotificationPreferences(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateNotificationPreferencesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateNotificationPreferencesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *notificationServiceProtobufClient) callUpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesRequest) (*UpdateNotificationPreferencesResponse, error) {
+	out := new(UpdateNotificationPreferencesResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *notificationServiceProtobufClient) ListNotifications(ctx context.Context, in *ListNotificationsRequest) (*ListNotificationsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "NotificationService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListNotifications")
+	caller := c.callListNotifications
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListNotificationsRequest) (*ListNotificationsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListNotificationsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListNotificationsRequest) when calling interceptor")
+					}
+					return c.callListNotifications(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListNotificationsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListNotificationsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *notificationServiceProtobufClient) callListNotifications(ctx context.Context, in *ListNotificationsRequest) (*ListNotificationsResponse, error) {
+	out := new(ListNotificationsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *notificationServiceProtobufClient) MarkNotificationRead(ctx context.Context, in *MarkNotificationReadRequest) (*MarkNotificationReadResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "NotificationService")
+	ctx = ctxsetters.WithMethodName(ctx, "MarkNotificationRead")
+	caller := c.callMarkNotificationRead
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *MarkNotificationReadRequest) (*MarkNotificationReadResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MarkNotificationReadRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MarkNotificationReadRequest) when calling interceptor")
+					}
+					return c.callMarkNotificationRead(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*MarkNotificationReadResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*MarkNotificationReadResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *notificationServiceProtobufClient) callMarkNotificationRead(ctx context.Context, in *MarkNotificationReadRequest) (*MarkNotificationReadResponse, error) {
+	out := new(MarkNotificationReadResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *notificationServiceProtobufClient) SendEmail(ctx context.Context, in *SendEmailRequest) (*SendNotificationResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "NotificationService")
+	ctx = ctxsetters.WithMethodName(ctx, "SendEmail")
+	caller := c.callSendEmail
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SendEmailRequest) (*SendNotificationResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SendEmailRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SendEmailRequest) when calling interceptor")
+					}
+					return c.callSendEmail(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SendNotificationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SendNotificationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *notificationServiceProtobufClient) callSendEmail(ctx context.Context, in *SendEmailRequest) (*SendNotificationResponse, error) {
+	out := new(SendNotificationResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *notificationServiceProtobufClient) SendSMS(ctx context.Context, in *SendSMSRequest) (*SendNotificationResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "NotificationService")
+	ctx = ctxsetters.WithMethodName(ctx, "SendSMS")
+	caller := c.callSendSMS
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SendSMSRequest) (*SendNotificationResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SendSMSRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SendSMSRequest) when calling interceptor")
+					}
+					return c.callSendSMS(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SendNotificationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SendNotificationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *notificationServiceProtobufClient) callSendSMS(ctx context.Context, in *SendSMSRequest) (*SendNotificationResponse, error) {
+	out := new(SendNotificationResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *notificationServiceProtobufClient) SendPush(ctx context.Context, in *SendPushRequest) (*SendNotificationResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "NotificationService")
+	ctx = ctxsetters.WithMethodName(ctx, "SendPush")
+	caller := c.callSendPush
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SendPushRequest) (*SendNotificationResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SendPushRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SendPushRequest) when calling interceptor")
+					}
+					return c.callSendPush(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SendNotificationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SendNotificationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *notificationServiceProtobufClient) callSendPush(ctx context.Context, in *SendPushRequest) (*SendNotificationResponse, error) {
+	out := new(SendNotificationResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *notificationServiceProtobufClient) SubscribeEvents(ctx context.Context, in *SubscribeEventsRequest) (*NotificationEvent, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "NotificationService")
+	ctx = ctxsetters.WithMethodName(ctx, "SubscribeEvents")
+	caller := c.callSubscribeEvents
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SubscribeEventsRequest) (*NotificationEvent, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SubscribeEventsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SubscribeEventsRequest) when calling interceptor")
+					}
+					return c.callSubscribeEvents(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*NotificationEvent)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*NotificationEvent) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *notificationServiceProtobufClient) callSubscribeEvents(ctx context.Context, in *SubscribeEventsRequest) (*NotificationEvent, error) {
+	out := new(NotificationEvent)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ===============================
+// NotificationService JSON Client
+// ===============================
+
+type notificationServiceJSONClient struct {
+	client      HTTPClient
+	urls        [8]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewNotificationServiceJSONClient creates a JSON client that implements the NotificationService interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewNotificationServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) NotificationService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "go.escape.ship.proto.v1", "NotificationService")
+	urls := [8]string{
+		serviceURL + "GetNotificationPreferences",
+		serviceURL + "UpdateNotificationPreferences",
+		serviceURL + "ListNotifications",
+		serviceURL + "MarkNotificationRead",
+		serviceURL + "SendEmail",
+		serviceURL + "SendSMS",
+		serviceURL + "SendPush",
+		serviceURL + "SubscribeEvents",
+	}
+
+	return &notificationServiceJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *notificationServiceJSONClient) GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesRequest) (*GetNotificationPreferencesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "NotificationService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetNotificationPreferences")
+	caller := c.callGetNotificationPreferences
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetNotificationPreferencesRequest) (*GetNotificationPreferencesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetNotificationPreferencesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetNotificationPreferencesRequest) when calling interceptor")
+					}
+					return c.callGetNotificationPreferences(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetNotificationPreferencesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetNotificationPreferencesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *notificationServiceJSONClient) callGetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesRequest) (*GetNotificationPreferencesResponse, error) {
+	out := new(GetNotificationPreferencesResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *notificationServiceJSONClient) UpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesRequest) (*UpdateNotificationPreferencesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "NotificationService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateNotificationPreferences")
+	caller := c.callUpdateNotificationPreferences
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateNotificationPreferencesRequest) (*UpdateNotificationPreferencesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateNotificationPreferencesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateNotificationPreferencesRequest) when calling interceptor")
+					}
+					return c.callUpdateNotificationPreferences(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateNotificationPreferencesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateNotificationPreferencesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *notificationServiceJSONClient) callUpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesRequest) (*UpdateNotificationPreferencesResponse, error) {
+	out := new(UpdateNotificationPreferencesResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *notificationServiceJSONClient) ListNotifications(ctx context.Context, in *ListNotificationsRequest) (*ListNotificationsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "NotificationService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListNotifications")
+	caller := c.callListNotifications
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListNotificationsRequest) (*ListNotificationsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListNotificationsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListNotificationsRequest) when calling interceptor")
+					}
+					return c.callListNotifications(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListNotificationsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListNotificationsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *notificationServiceJSONClient) callListNotifications(ctx context.Context, in *ListNotificationsRequest) (*ListNotificationsResponse, error) {
+	out := new(ListNotificationsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *notificationServiceJSONClient) MarkNotificationRead(ctx context.Context, in *MarkNotificationReadRequest) (*MarkNotificationReadResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "NotificationService")
+	ctx = ctxsetters.WithMethodName(ctx, "MarkNotificationRead")
+	caller := c.callMarkNotificationRead
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *MarkNotificationReadRequest) (*MarkNotificationReadResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MarkNotificationReadRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MarkNotificationReadRequest) when calling interceptor")
+					}
+					return c.callMarkNotificationRead(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*MarkNotificationReadResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*MarkNotificationReadResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *notificationServiceJSONClient) callMarkNotificationRead(ctx context.Context, in *MarkNotificationReadRequest) (*MarkNotificationReadResponse, error) {
+	out := new(MarkNotificationReadResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *notificationServiceJSONClient) SendEmail(ctx context.Context, in *SendEmailRequest) (*SendNotificationResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "NotificationService")
+	ctx = ctxsetters.WithMethodName(ctx, "SendEmail")
+	caller := c.callSendEmail
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SendEmailRequest) (*SendNotificationResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SendEmailRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SendEmailRequest) when calling interceptor")
+					}
+					return c.callSendEmail(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SendNotificationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SendNotificationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *notificationServiceJSONClient) callSendEmail(ctx context.Context, in *SendEmailRequest) (*SendNotificationResponse, error) {
+	out := new(SendNotificationResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *notificationServiceJSONClient) SendSMS(ctx context.Context, in *SendSMSRequest) (*SendNotificationResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "NotificationService")
+	ctx = ctxsetters.WithMethodName(ctx, "SendSMS")
+	caller := c.callSendSMS
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SendSMSRequest) (*SendNotificationResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SendSMSRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SendSMSRequest) when calling interceptor")
+					}
+					return c.callSendSMS(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SendNotificationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SendNotificationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *notificationServiceJSONClient) callSendSMS(ctx context.Context, in *SendSMSRequest) (*SendNotificationResponse, error) {
+	out := new(SendNotificationResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *notificationServiceJSONClient) SendPush(ctx context.Context, in *SendPushRequest) (*SendNotificationResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "NotificationService")
+	ctx = ctxsetters.WithMethodName(ctx, "SendPush")
+	caller := c.callSendPush
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SendPushRequest) (*SendNotificationResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SendPushRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SendPushRequest) when calling interceptor")
+					}
+					return c.callSendPush(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SendNotificationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SendNotificationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *notificationServiceJSONClient) callSendPush(ctx context.Context, in *SendPushRequest) (*SendNotificationResponse, error) {
+	out := new(SendNotificationResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *notificationServiceJSONClient) SubscribeEvents(ctx context.Context, in *SubscribeEventsRequest) (*NotificationEvent, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "NotificationService")
+	ctx = ctxsetters.WithMethodName(ctx, "SubscribeEvents")
+	caller := c.callSubscribeEvents
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SubscribeEventsRequest) (*NotificationEvent, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SubscribeEventsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SubscribeEventsRequest) when calling interceptor")
+					}
+					return c.callSubscribeEvents(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*NotificationEvent)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*NotificationEvent) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *notificationServiceJSONClient) callSubscribeEvents(ctx context.Context, in *SubscribeEventsRequest) (*NotificationEvent, error) {
+	out := new(NotificationEvent)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ==================================
+// NotificationService Server Handler
+// ==================================
+
+type notificationServiceServer struct {
+	NotificationService
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewNotificationServiceServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewNotificationServiceServer(svc NotificationService, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	return &notificationServiceServer{
+		NotificationService: svc,
+		hooks:               serverOpts.Hooks,
+		interceptor:         twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:          pathPrefix,
+		jsonSkipDefaults:    jsonSkipDefaults,
+		jsonCamelCase:       jsonCamelCase,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *notificationServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *notificationServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
+
+// NotificationServicePathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const NotificationServicePathPrefix = "/twirp/go.escape.ship.proto.v1.NotificationService/"
+
+func (s *notificationServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "NotificationService")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "go.escape.ship.proto.v1.NotificationService" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "GetNotificationPreferences":
+		s.serveGetNotificationPreferences(ctx, resp, req)
+		return
+	case "UpdateNotificationPreferences":
+		s.serveUpdateNotificationPreferences(ctx, resp, req)
+		return
+	case "ListNotifications":
+		s.serveListNotifications(ctx, resp, req)
+		return
+	case "MarkNotificationRead":
+		s.serveMarkNotificationRead(ctx, resp, req)
+		return
+	case "SendEmail":
+		s.serveSendEmail(ctx, resp, req)
+		return
+	case "SendSMS":
+		s.serveSendSMS(ctx, resp, req)
+		return
+	case "SendPush":
+		s.serveSendPush(ctx, resp, req)
+		return
+	case "SubscribeEvents":
+		s.serveSubscribeEvents(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *notificationServiceServer) serveGetNotificationPreferences(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetNotificationPreferencesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetNotificationPreferencesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *notificationServiceServer) serveGetNotificationPreferencesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetNotificationPreferences")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetNotificationPreferencesRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.NotificationService.GetNotificationPreferences
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetNotificationPreferencesRequest) (*GetNotificationPreferencesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetNotificationPreferencesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetNotificationPreferencesRequest) when calling interceptor")
+					}
+					return s.NotificationService.GetNotificationPreferences(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetNotificationPreferencesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetNotificationPreferencesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetNotificationPreferencesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetNotificationPreferencesResponse and nil error while calling GetNotificationPreferences. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *notificationServiceServer) serveGetNotificationPreferencesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetNotificationPreferences")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetNotificationPreferencesRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.NotificationService.GetNotificationPreferences
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetNotificationPreferencesRequest) (*GetNotificationPreferencesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetNotificationPreferencesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetNotificationPreferencesRequest) when calling interceptor")
+					}
+					return s.NotificationService.GetNotificationPreferences(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetNotificationPreferencesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetNotificationPreferencesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetNotificationPreferencesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetNotificationPreferencesResponse and nil error while calling GetNotificationPreferences. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *notificationServiceServer) serveUpdateNotificationPreferences(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveUpdateNotificationPreferencesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveUpdateNotificationPreferencesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *notificationServiceServer) serveUpdateNotificationPreferencesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateNotificationPreferences")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(UpdateNotificationPreferencesRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.NotificationService.UpdateNotificationPreferences
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateNotificationPreferencesRequest) (*UpdateNotificationPreferencesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateNotificationPreferencesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateNotificationPreferencesRequest) when calling interceptor")
+					}
+					return s.NotificationService.UpdateNotificationPreferences(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateNotificationPreferencesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateNotificationPreferencesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UpdateNotificationPreferencesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpdateNotificationPreferencesResponse and nil error while calling UpdateNotificationPreferences. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *notificationServiceServer) serveUpdateNotificationPreferencesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateNotificationPreferences")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(UpdateNotificationPreferencesRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.NotificationService.UpdateNotificationPreferences
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateNotificationPreferencesRequest) (*UpdateNotificationPreferencesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateNotificationPreferencesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateNotificationPreferencesRequest) when calling interceptor")
+					}
+					return s.NotificationService.UpdateNotificationPreferences(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateNotificationPreferencesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateNotificationPreferencesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UpdateNotificationPreferencesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpdateNotificationPreferencesResponse and nil error while calling UpdateNotificationPreferences. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *notificationServiceServer) serveListNotifications(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListNotificationsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListNotificationsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *notificationServiceServer) serveListNotificationsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListNotifications")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ListNotificationsRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.NotificationService.ListNotifications
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListNotificationsRequest) (*ListNotificationsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListNotificationsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListNotificationsRequest) when calling interceptor")
+					}
+					return s.NotificationService.ListNotifications(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListNotificationsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListNotificationsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListNotificationsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListNotificationsResponse and nil error while calling ListNotifications. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *notificationServiceServer) serveListNotificationsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListNotifications")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ListNotificationsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.NotificationService.ListNotifications
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListNotificationsRequest) (*ListNotificationsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListNotificationsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListNotificationsRequest) when calling interceptor")
+					}
+					return s.NotificationService.ListNotifications(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListNotificationsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListNotificationsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListNotificationsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListNotificationsResponse and nil error while calling ListNotifications. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *notificationServiceServer) serveMarkNotificationRead(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveMarkNotificationReadJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveMarkNotificationReadProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *notificationServiceServer) serveMarkNotificationReadJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "MarkNotificationRead")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(MarkNotificationReadRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.NotificationService.MarkNotificationRead
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *MarkNotificationReadRequest) (*MarkNotificationReadResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MarkNotificationReadRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MarkNotificationReadRequest) when calling interceptor")
+					}
+					return s.NotificationService.MarkNotificationRead(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*MarkNotificationReadResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*MarkNotificationReadResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *MarkNotificationReadResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *MarkNotificationReadResponse and nil error while calling MarkNotificationRead. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *notificationServiceServer) serveMarkNotificationReadProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "MarkNotificationRead")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(MarkNotificationReadRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.NotificationService.MarkNotificationRead
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *MarkNotificationReadRequest) (*MarkNotificationReadResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MarkNotificationReadRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MarkNotificationReadRequest) when calling interceptor")
+					}
+					return s.NotificationService.MarkNotificationRead(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*MarkNotificationReadResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*MarkNotificationReadResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *MarkNotificationReadResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *MarkNotificationReadResponse and nil error while calling MarkNotificationRead. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *notificationServiceServer) serveSendEmail(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSendEmailJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSendEmailProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *notificationServiceServer) serveSendEmailJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SendEmail")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(SendEmailRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.NotificationService.SendEmail
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SendEmailRequest) (*SendNotificationResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SendEmailRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SendEmailRequest) when calling interceptor")
+					}
+					return s.NotificationService.SendEmail(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SendNotificationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SendNotificationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SendNotificationResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SendNotificationResponse and nil error while calling SendEmail. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *notificationServiceServer) serveSendEmailProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SendEmail")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(SendEmailRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.NotificationService.SendEmail
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SendEmailRequest) (*SendNotificationResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SendEmailRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SendEmailRequest) when calling interceptor")
+					}
+					return s.NotificationService.SendEmail(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SendNotificationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SendNotificationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SendNotificationResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SendNotificationResponse and nil error while calling SendEmail. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *notificationServiceServer) serveSendSMS(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSendSMSJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSendSMSProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *notificationServiceServer) serveSendSMSJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SendSMS")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(SendSMSRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.NotificationService.SendSMS
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SendSMSRequest) (*SendNotificationResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SendSMSRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SendSMSRequest) when calling interceptor")
+					}
+					return s.NotificationService.SendSMS(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SendNotificationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SendNotificationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SendNotificationResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SendNotificationResponse and nil error while calling SendSMS. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *notificationServiceServer) serveSendSMSProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SendSMS")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(SendSMSRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.NotificationService.SendSMS
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SendSMSRequest) (*SendNotificationResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SendSMSRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SendSMSRequest) when calling interceptor")
+					}
+					return s.NotificationService.SendSMS(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SendNotificationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SendNotificationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SendNotificationResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SendNotificationResponse and nil error while calling SendSMS. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *notificationServiceServer) serveSendPush(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSendPushJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSendPushProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *notificationServiceServer) serveSendPushJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SendPush")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(SendPushRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.NotificationService.SendPush
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SendPushRequest) (*SendNotificationResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SendPushRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SendPushRequest) when calling interceptor")
+					}
+					return s.NotificationService.SendPush(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SendNotificationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SendNotificationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SendNotificationResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SendNotificationResponse and nil error while calling SendPush. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *notificationServiceServer) serveSendPushProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SendPush")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(SendPushRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.NotificationService.SendPush
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SendPushRequest) (*SendNotificationResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SendPushRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SendPushRequest) when calling interceptor")
+					}
+					return s.NotificationService.SendPush(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SendNotificationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SendNotificationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SendNotificationResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SendNotificationResponse and nil error while calling SendPush. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *notificationServiceServer) serveSubscribeEvents(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSubscribeEventsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSubscribeEventsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *notificationServiceServer) serveSubscribeEventsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SubscribeEvents")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(SubscribeEventsRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.NotificationService.SubscribeEvents
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SubscribeEventsRequest) (*NotificationEvent, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SubscribeEventsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SubscribeEventsRequest) when calling interceptor")
+					}
+					return s.NotificationService.SubscribeEvents(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*NotificationEvent)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*NotificationEvent) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *NotificationEvent
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *NotificationEvent and nil error while calling SubscribeEvents. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *notificationServiceServer) serveSubscribeEventsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SubscribeEvents")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(SubscribeEventsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.NotificationService.SubscribeEvents
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SubscribeEventsRequest) (*NotificationEvent, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SubscribeEventsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SubscribeEventsRequest) when calling interceptor")
+					}
+					return s.NotificationService.SubscribeEvents(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*NotificationEvent)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*NotificationEvent) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *NotificationEvent
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *NotificationEvent and nil error while calling SubscribeEvents. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *notificationServiceServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor6, 0
+}
+
+func (s *notificationServiceServer) ProtocGenTwirpVersion() string {
+	return "v8.1.3"
+}
+
+// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
+// that is everything in a Twirp route except for the <Method>. This can be used for routing,
+// for example to identify the requests that are targeted to this service in a mux.
+func (s *notificationServiceServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "go.escape.ship.proto.v1", "NotificationService")
+}
+
+var twirpFileDescriptor6 = []byte{
+	// 1574 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6f, 0x1b, 0xd5,
+	0x16, 0x7f, 0x63, 0x27, 0xfe, 0x38, 0x69, 0x12, 0xf7, 0xb6, 0x2f, 0x99, 0x38, 0xc9, 0x6b, 0x32,
+	0x69, 0x1a, 0xbf, 0xbc, 0xc6, 0x7e, 0x49, 0x41, 0xd0, 0x20, 0x90, 0x4c, 0x3c, 0x69, 0x47, 0x49,
+	0x1c, 0x77, 0xc6, 0x46, 0x2a, 0x1b, 0x6b, 0xec, 0xb9, 0x4d, 0x86, 0x4c, 0x66, 0xcc, 0xcc, 0x9d,
+	0x50, 0x17, 0xb1, 0x61, 0x01, 0x12, 0x12, 0x1b, 0x2a, 0x24, 0x16, 0xfd, 0x1b, 0x90, 0x10, 0x42,
+	0x62, 0xc1, 0x16, 0x81, 0xd8, 0xb2, 0x66, 0xc7, 0x5f, 0xc1, 0x0a, 0xdd, 0xeb, 0xb1, 0x33, 0x63,
+	0x8f, 0x3f, 0x92, 0xb4, 0x12, 0xbb, 0xb9, 0xc7, 0xe7, 0xe3, 0x77, 0x7f, 0xf7, 0x9c, 0x7b, 0xcf,
+	0x31, 0x20, 0xd3, 0x22, 0xfa, 0x13, 0xbd, 0xae, 0x12, 0xdd, 0x32, 0xb3, 0x0d, 0xdb, 0x22, 0x16,
+	0x9a, 0x3d, 0xb2, 0xb2, 0xd8, 0xa9, 0xab, 0x0d, 0x9c, 0x75, 0x8e, 0xf5, 0x46, 0x4b, 0x9a, 0x3d,
+	0xdb, 0x4c, 0x2f, 0x1c, 0x59, 0xd6, 0x91, 0x81, 0x73, 0x6a, 0x43, 0xcf, 0xa9, 0xa6, 0x69, 0x11,
+	0x66, 0xe5, 0xb4, 0x14, 0x84, 0x9f, 0x39, 0x98, 0x29, 0xfa, 0xbc, 0x95, 0x6c, 0xfc, 0x04, 0xdb,
+	0xd8, 0xac, 0x63, 0xb4, 0x0b, 0xf1, 0xfa, 0xb1, 0x6a, 0x9a, 0xd8, 0xe0, 0xb9, 0x25, 0x2e, 0x33,
+	0xb5, 0x75, 0x37, 0xdb, 0x27, 0x46, 0xd6, 0xef, 0x61, 0xa7, 0x65, 0x23, 0xb7, 0x8d, 0x91, 0x04,
+	0x89, 0xba, 0x4a, 0xf0, 0x91, 0x65, 0x37, 0xf9, 0x08, 0x73, 0xb4, 0x31, 0x9a, 0x23, 0xcf, 0x48,
+	0xee, 0x98, 0x23, 0x1e, 0xe2, 0xd8, 0x54, 0x6b, 0x06, 0xd6, 0xf8, 0xe8, 0x12, 0x97, 0x49, 0xc8,
+	0xed, 0xa5, 0xb0, 0x02, 0xcb, 0x0f, 0x30, 0x09, 0xdf, 0x89, 0x23, 0xe3, 0x0f, 0x5d, 0xec, 0x10,
+	0xe1, 0x23, 0x10, 0x06, 0x29, 0x39, 0x0d, 0xcb, 0x74, 0x30, 0x7a, 0x04, 0x13, 0x8d, 0x73, 0x31,
+	0xcf, 0x2d, 0x45, 0x33, 0x13, 0x5b, 0xb9, 0x91, 0x20, 0x9f, 0xbb, 0x93, 0xfd, 0x3e, 0x84, 0x26,
+	0xdc, 0xae, 0x34, 0x34, 0x95, 0xe0, 0xc1, 0x00, 0x5f, 0x45, 0xe8, 0x67, 0xb0, 0x3a, 0x24, 0xf4,
+	0xab, 0xdb, 0xf6, 0x5f, 0x1c, 0x5c, 0xf3, 0xeb, 0xa1, 0x29, 0x88, 0xe8, 0x1a, 0xcb, 0xa6, 0xa4,
+	0x1c, 0xd1, 0xb5, 0x97, 0x99, 0x1a, 0x37, 0x61, 0x9c, 0xe8, 0xc4, 0xc0, 0x2c, 0x31, 0x92, 0x72,
+	0x6b, 0x81, 0x10, 0x8c, 0xd5, 0x2c, 0xad, 0xc9, 0x8f, 0x31, 0x21, 0xfb, 0x46, 0x73, 0x90, 0x30,
+	0x74, 0xf3, 0xa4, 0xea, 0xda, 0x06, 0x3f, 0xce, 0xe4, 0x71, 0xba, 0xae, 0xd8, 0x06, 0x55, 0xb7,
+	0xb1, 0xaa, 0xf1, 0x31, 0x96, 0x5c, 0xec, 0x1b, 0x2d, 0x02, 0xd4, 0x6d, 0xac, 0x12, 0xac, 0x55,
+	0x55, 0xc2, 0xc7, 0x99, 0x41, 0xd2, 0x93, 0xe4, 0x09, 0x9a, 0x85, 0x38, 0x55, 0xa3, 0xbf, 0x25,
+	0xd8, 0x6f, 0x31, 0xba, 0xcc, 0xd3, 0x64, 0xe3, 0xf7, 0x75, 0x27, 0x90, 0x6d, 0x9d, 0x73, 0x9e,
+	0x87, 0x64, 0x43, 0x3d, 0xc2, 0x55, 0x47, 0x7f, 0x86, 0x19, 0x1d, 0xe3, 0x72, 0x82, 0x0a, 0x14,
+	0xfd, 0x19, 0xa6, 0x01, 0xd9, 0x8f, 0xc4, 0x3a, 0xc1, 0x26, 0xa3, 0x25, 0x29, 0x33, 0xf5, 0x32,
+	0x15, 0xa0, 0x5b, 0x30, 0xe1, 0x9a, 0x2c, 0xa4, 0x65, 0x1a, 0x4d, 0xaf, 0x0e, 0xa0, 0x25, 0x3a,
+	0x34, 0x8d, 0xa6, 0xf0, 0x3d, 0x07, 0x73, 0x21, 0x91, 0xbd, 0x63, 0xde, 0x83, 0x49, 0xff, 0xed,
+	0xd1, 0x3e, 0xe8, 0xd5, 0x91, 0x78, 0x97, 0x83, 0xb6, 0xe8, 0x0e, 0x4c, 0x9b, 0xf8, 0x29, 0xa9,
+	0xf6, 0xe0, 0x9d, 0xa4, 0xe2, 0x52, 0x07, 0xf3, 0x32, 0x5c, 0xf3, 0x30, 0xd7, 0x2d, 0xd7, 0x24,
+	0x0c, 0xf4, 0xb8, 0xec, 0xed, 0x63, 0x87, 0x8a, 0x84, 0x5d, 0x98, 0x3f, 0x50, 0xed, 0x93, 0x40,
+	0x34, 0xac, 0x6a, 0x6d, 0xc6, 0xd6, 0x60, 0xda, 0x1f, 0xba, 0xda, 0x49, 0xa3, 0x29, 0xbf, 0x58,
+	0xd2, 0x84, 0x3c, 0x2c, 0x84, 0xfb, 0xf1, 0xf6, 0xdf, 0x0d, 0x85, 0xeb, 0x85, 0xf2, 0x07, 0x07,
+	0x37, 0xfd, 0xf6, 0x65, 0x7c, 0xda, 0x30, 0x54, 0x82, 0x29, 0xf5, 0xc4, 0xfb, 0x3e, 0x07, 0x00,
+	0x6d, 0x91, 0xa4, 0xa1, 0x47, 0x10, 0x6b, 0xa8, 0xb6, 0x7a, 0xea, 0xf0, 0x11, 0xc6, 0xea, 0xfd,
+	0x91, 0x58, 0x6d, 0xfb, 0xcf, 0x96, 0x98, 0xad, 0x68, 0x12, 0xbb, 0x29, 0x7b, 0x8e, 0xd0, 0x0c,
+	0xc4, 0x0c, 0xab, 0xae, 0x76, 0x12, 0xdb, 0x5b, 0xa5, 0xef, 0xc3, 0x84, 0x4f, 0x1d, 0xa5, 0x20,
+	0x7a, 0x82, 0x9b, 0x1e, 0x24, 0xfa, 0x49, 0x0b, 0xe2, 0x4c, 0x35, 0x5c, 0xec, 0x9d, 0x48, 0x6b,
+	0xb1, 0x1d, 0x79, 0x93, 0x13, 0x5e, 0x44, 0x20, 0xa5, 0x60, 0x53, 0x13, 0x4f, 0x55, 0xdd, 0x68,
+	0x13, 0x3c, 0x0b, 0x71, 0xd7, 0xc1, 0xf6, 0xf9, 0xbe, 0x62, 0x74, 0x29, 0x69, 0xb4, 0x66, 0x89,
+	0xe5, 0x39, 0x89, 0x10, 0x2b, 0x50, 0xb3, 0xd1, 0xab, 0xd5, 0xac, 0x04, 0x89, 0x36, 0x79, 0xac,
+	0x42, 0x27, 0xb6, 0x36, 0x2e, 0x44, 0x98, 0xdc, 0x31, 0xa7, 0x2f, 0x83, 0xe3, 0xd6, 0x3e, 0xc0,
+	0x75, 0xd2, 0xae, 0x69, 0x6f, 0xd9, 0xb9, 0x02, 0x62, 0xbe, 0x2b, 0x60, 0x1e, 0x92, 0x1a, 0xd6,
+	0xdc, 0x46, 0x95, 0x72, 0xd6, 0x2a, 0xe9, 0x04, 0x13, 0xec, 0xe1, 0xa6, 0xf0, 0x3c, 0x02, 0x53,
+	0x94, 0x1e, 0xe5, 0x40, 0x19, 0x4a, 0xce, 0x32, 0x5c, 0x6b, 0x1c, 0x5b, 0x26, 0xae, 0x9a, 0xee,
+	0x69, 0x0d, 0xdb, 0x1e, 0x4d, 0x13, 0x4c, 0x56, 0x64, 0xa2, 0x7f, 0x28, 0x5f, 0x08, 0xc6, 0x08,
+	0x7e, 0xda, 0x26, 0x8b, 0x7d, 0x07, 0x59, 0x89, 0x75, 0xb1, 0xf2, 0x4b, 0x14, 0xa6, 0x29, 0x2b,
+	0x25, 0xd7, 0x39, 0x1e, 0x4a, 0xcb, 0x4b, 0xbc, 0xd7, 0xfd, 0x7b, 0x8e, 0x5e, 0x6d, 0xcf, 0x9d,
+	0x27, 0x62, 0x2c, 0xec, 0x89, 0x18, 0xef, 0xf3, 0x44, 0xc4, 0x82, 0x4f, 0xc4, 0x2e, 0x8c, 0x69,
+	0x2a, 0x51, 0xf9, 0x38, 0x2b, 0xf0, 0xad, 0xbe, 0x58, 0xba, 0xb8, 0xca, 0x16, 0x54, 0xa2, 0xb6,
+	0x2a, 0x9b, 0xd9, 0x07, 0xc9, 0x4e, 0x04, 0xc9, 0x46, 0x02, 0x4c, 0x3a, 0xea, 0x19, 0xbd, 0x52,
+	0xab, 0xba, 0x59, 0xb3, 0x9e, 0xf2, 0x49, 0x76, 0xcb, 0x4f, 0x50, 0x61, 0xd9, 0x92, 0xa8, 0x28,
+	0xfd, 0x06, 0x24, 0x3b, 0x3e, 0x2f, 0x54, 0xfe, 0x9f, 0x71, 0xc0, 0x53, 0x74, 0xc1, 0x2b, 0xd2,
+	0xbb, 0x1e, 0x17, 0x01, 0x4e, 0xb1, 0xe3, 0xd0, 0xfb, 0xbc, 0x73, 0xaa, 0x49, 0x4f, 0x22, 0x69,
+	0x68, 0x0f, 0x62, 0x0e, 0x51, 0x89, 0xeb, 0x78, 0xc7, 0x7a, 0x6f, 0xa4, 0xb3, 0x28, 0x60, 0x43,
+	0x3f, 0xc3, 0x76, 0x53, 0x61, 0xa6, 0xb2, 0xe7, 0x42, 0xf8, 0x8d, 0x83, 0x19, 0xc5, 0xad, 0x39,
+	0x75, 0x5b, 0xaf, 0x61, 0xf1, 0x0c, 0x9b, 0xa4, 0xf3, 0x40, 0x3e, 0x84, 0x84, 0xd7, 0x3e, 0xb6,
+	0x1e, 0xa8, 0x8b, 0x36, 0x9f, 0x1d, 0x6b, 0x74, 0x00, 0xe0, 0xe5, 0x92, 0x8e, 0x5b, 0xd7, 0xf2,
+	0x85, 0x93, 0xd1, 0xe7, 0xc0, 0x9f, 0xf2, 0x51, 0x7f, 0xca, 0x0b, 0xdf, 0x45, 0xe1, 0xba, 0xdf,
+	0x9a, 0xed, 0x67, 0x18, 0x9d, 0x3e, 0x6f, 0x91, 0x40, 0x01, 0xf9, 0x7a, 0xef, 0xe8, 0xcb, 0xea,
+	0xbd, 0xc7, 0xae, 0x56, 0x88, 0xe7, 0x47, 0x3f, 0x7e, 0xe5, 0xa3, 0xef, 0x7e, 0x49, 0x63, 0x3d,
+	0x2f, 0xe9, 0xa0, 0x1b, 0x1a, 0xad, 0xc2, 0xd4, 0x13, 0x55, 0x37, 0x5c, 0x1b, 0x57, 0x6d, 0xac,
+	0x3a, 0x96, 0xe9, 0x15, 0xd0, 0xa4, 0x27, 0x95, 0x99, 0x90, 0x06, 0xb1, 0xea, 0x75, 0xd7, 0xb6,
+	0x5b, 0xad, 0x5b, 0xb2, 0x15, 0xa4, 0x2d, 0xca, 0x93, 0xf5, 0x6f, 0x38, 0xb8, 0x11, 0x42, 0x1f,
+	0xba, 0x0d, 0x4b, 0xc5, 0xc3, 0xb2, 0xb4, 0x2b, 0xed, 0xe4, 0xcb, 0xd2, 0x61, 0xb1, 0xba, 0xf3,
+	0x30, 0x5f, 0x2c, 0x8a, 0xfb, 0xd5, 0x4a, 0x51, 0x29, 0x89, 0x3b, 0xd2, 0xae, 0x24, 0x16, 0x52,
+	0xff, 0x42, 0xff, 0x81, 0x74, 0xa8, 0x96, 0x78, 0x90, 0x97, 0xf6, 0x53, 0x1c, 0x5a, 0x00, 0x3e,
+	0xf4, 0x77, 0xe5, 0x40, 0x49, 0x45, 0xd0, 0x22, 0xcc, 0x85, 0xfe, 0x5a, 0xaa, 0x28, 0x0f, 0x53,
+	0xd1, 0xf5, 0x1f, 0xba, 0x7a, 0x90, 0xf6, 0x81, 0xa0, 0x55, 0x58, 0x0e, 0xda, 0xe5, 0xcb, 0xe2,
+	0x83, 0x43, 0xf9, 0x71, 0x17, 0xb8, 0x35, 0x58, 0x09, 0x57, 0x3b, 0x94, 0x0b, 0xa2, 0x5c, 0xad,
+	0x94, 0x0a, 0xf9, 0xb2, 0xa8, 0xa4, 0x38, 0xb4, 0x02, 0xb7, 0xc2, 0x15, 0x0f, 0xf2, 0xf2, 0x9e,
+	0x58, 0x96, 0x8a, 0x0f, 0x52, 0x11, 0x94, 0x81, 0xdb, 0xe1, 0x4a, 0xb2, 0xa8, 0x94, 0x0f, 0x77,
+	0xf6, 0xaa, 0xf9, 0x7d, 0x51, 0x2e, 0x2b, 0xa9, 0xe8, 0xfa, 0x8b, 0x08, 0xa4, 0xfb, 0x9f, 0x3f,
+	0xba, 0x0b, 0x99, 0x80, 0xa3, 0x82, 0xb8, 0x2f, 0xbd, 0x27, 0xca, 0x8f, 0xab, 0x4a, 0x39, 0x5f,
+	0xae, 0x28, 0x43, 0x36, 0xd1, 0xad, 0xfd, 0xa8, 0x22, 0x56, 0xc4, 0x42, 0x8a, 0xeb, 0x21, 0xa5,
+	0x5b, 0x51, 0x11, 0x8b, 0xe5, 0x54, 0x04, 0xad, 0xc3, 0x9d, 0x81, 0x6a, 0xde, 0x5a, 0x2c, 0xa4,
+	0xa2, 0x43, 0x63, 0xef, 0xe6, 0xa5, 0x7d, 0xb1, 0x90, 0x1a, 0x43, 0xff, 0x83, 0xb5, 0xc1, 0xb1,
+	0x2b, 0xa5, 0x92, 0x2c, 0x2a, 0x8a, 0x58, 0x48, 0x8d, 0x6f, 0x7d, 0x0b, 0xc1, 0x8c, 0x53, 0xb0,
+	0x7d, 0xa6, 0xd7, 0x31, 0xfa, 0x89, 0x83, 0x74, 0xff, 0xd1, 0x14, 0x6d, 0xf7, 0xad, 0xb5, 0xa1,
+	0x43, 0x6f, 0xfa, 0xad, 0x4b, 0xd9, 0xb6, 0x9e, 0x03, 0x61, 0xf5, 0xd3, 0xdf, 0xff, 0x7c, 0x1e,
+	0xb9, 0x85, 0x16, 0x73, 0x67, 0x9b, 0xb9, 0x40, 0xef, 0x9f, 0xf3, 0x0d, 0x7a, 0xe8, 0x57, 0x0e,
+	0x16, 0x07, 0x4e, 0x99, 0xe8, 0xed, 0xbe, 0x28, 0x46, 0x19, 0x8c, 0xd3, 0xef, 0x5c, 0xd6, 0xdc,
+	0xdb, 0x47, 0x86, 0xed, 0x43, 0xd8, 0xe6, 0xd6, 0xd3, 0x43, 0xb6, 0xf2, 0x35, 0x07, 0xd7, 0x7b,
+	0xa6, 0x27, 0xb4, 0xd9, 0x37, 0x7e, 0xbf, 0x19, 0x2f, 0xbd, 0x75, 0x11, 0x13, 0x0f, 0xe6, 0x1c,
+	0x83, 0x79, 0x03, 0x5d, 0xef, 0xc1, 0x88, 0x7e, 0xe4, 0xe0, 0x66, 0xd8, 0x60, 0x83, 0x5e, 0xeb,
+	0x1b, 0x67, 0xc0, 0x3c, 0x95, 0x7e, 0xfd, 0x82, 0x56, 0x1e, 0xc0, 0x7b, 0x0c, 0xe0, 0xc6, 0x36,
+	0xb7, 0x2e, 0x64, 0x7a, 0x79, 0xfc, 0xb8, 0x6b, 0x44, 0xfb, 0x24, 0xc7, 0x26, 0xe8, 0x2f, 0x39,
+	0x48, 0x76, 0xe6, 0x0d, 0xf4, 0xdf, 0x81, 0x2d, 0x93, 0x7f, 0x26, 0x49, 0x6f, 0x0e, 0x54, 0x0d,
+	0xeb, 0x5f, 0x04, 0x81, 0x01, 0x5c, 0xa0, 0x00, 0x67, 0x7b, 0x01, 0x62, 0x86, 0xe0, 0x73, 0x0e,
+	0xe2, 0x5e, 0x83, 0x8f, 0xd6, 0x06, 0x86, 0x38, 0x1f, 0x01, 0x2e, 0x83, 0x65, 0x89, 0x61, 0x49,
+	0x53, 0x2c, 0xff, 0xee, 0xc5, 0xe2, 0x9c, 0x3a, 0xe8, 0x0b, 0x0e, 0x12, 0xed, 0x46, 0x11, 0x65,
+	0x46, 0xed, 0x25, 0x2f, 0x83, 0x65, 0x99, 0x61, 0x99, 0xa7, 0x58, 0x66, 0x42, 0x0a, 0x80, 0xc6,
+	0xff, 0x8a, 0x83, 0xe9, 0xae, 0x76, 0x0c, 0xf5, 0xff, 0xff, 0x27, 0xbc, 0x71, 0x4b, 0xaf, 0x8f,
+	0xd4, 0x15, 0x30, 0x9b, 0x36, 0x3f, 0x88, 0x0f, 0x39, 0x28, 0xe6, 0xf4, 0xff, 0xdc, 0xbb, 0x2b,
+	0xef, 0x2f, 0x1f, 0xe9, 0xe4, 0xd8, 0xad, 0x65, 0xeb, 0xd6, 0x69, 0xae, 0xe5, 0x78, 0x83, 0x3a,
+	0xce, 0x31, 0xc7, 0x4e, 0xee, 0x08, 0x9b, 0xb5, 0x18, 0xfb, 0xbe, 0xf7, 0xf7, 0x00, 0x98, 0x22,
+	0xae, 0xf9, 0x18, 0x15, 0x00, 0x00,
+}
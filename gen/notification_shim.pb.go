@@ -0,0 +1,117 @@
+// Code generated by protoc-gen-go-shim. DO NOT EDIT.
+// source: notification.proto
+
+package gen
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	iter "iter"
+)
+
+// NotificationServiceAPI is NotificationServiceClient without per-call options, so it can be
+// mocked with plain method signatures. Streams are exposed as iterators.
+type NotificationServiceAPI interface {
+	// 채널/카테고리별 알림 수신 설정 (Authorization 헤더의 사용자 기준)
+	GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesRequest) (*GetNotificationPreferencesResponse, error)
+	UpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesRequest) (*UpdateNotificationPreferencesResponse, error)
+	// 알림함 목록 (최신순, 페이지네이션)
+	ListNotifications(ctx context.Context, in *ListNotificationsRequest) (*ListNotificationsResponse, error)
+	MarkNotificationRead(ctx context.Context, in *MarkNotificationReadRequest) (*MarkNotificationReadResponse, error)
+	// 서비스 간 발송 요청 (주문 확인, 결제 결과 등)
+	// 사용자 수신 설정을 확인하며, 수신 거부 시 발송하지 않고 SUPPRESSED 반환
+	// 같은 dedup_key로 재요청하면 다시 발송하지 않고 처음 결과를 반환
+	SendEmail(ctx context.Context, in *SendEmailRequest) (*SendNotificationResponse, error)
+	SendSMS(ctx context.Context, in *SendSMSRequest) (*SendNotificationResponse, error)
+	// 사용자의 등록된 모든 기기(AccountService.RegisterPushToken)로 발송
+	SendPush(ctx context.Context, in *SendPushRequest) (*SendNotificationResponse, error)
+	// 발송 결과(발송/도달/실패) 이벤트 구독 (발송 요청 서비스의 재시도·대체 채널 판단용)
+	SubscribeEvents(ctx context.Context, in *SubscribeEventsRequest) iter.Seq2[*NotificationEvent, error]
+}
+
+// NewNotificationServiceAPI adapts c to NotificationServiceAPI, passing opts to every call.
+func NewNotificationServiceAPI(c NotificationServiceClient, opts ...grpc.CallOption) NotificationServiceAPI {
+	return &notificationServiceAPI{c: c, opts: opts}
+}
+
+type notificationServiceAPI struct {
+	c    NotificationServiceClient
+	opts []grpc.CallOption
+}
+
+func (a *notificationServiceAPI) GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesRequest) (*GetNotificationPreferencesResponse, error) {
+	return a.c.GetNotificationPreferences(ctx, in, a.opts...)
+}
+
+func (a *notificationServiceAPI) UpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesRequest) (*UpdateNotificationPreferencesResponse, error) {
+	return a.c.UpdateNotificationPreferences(ctx, in, a.opts...)
+}
+
+func (a *notificationServiceAPI) ListNotifications(ctx context.Context, in *ListNotificationsRequest) (*ListNotificationsResponse, error) {
+	return a.c.ListNotifications(ctx, in, a.opts...)
+}
+
+func (a *notificationServiceAPI) MarkNotificationRead(ctx context.Context, in *MarkNotificationReadRequest) (*MarkNotificationReadResponse, error) {
+	return a.c.MarkNotificationRead(ctx, in, a.opts...)
+}
+
+func (a *notificationServiceAPI) SendEmail(ctx context.Context, in *SendEmailRequest) (*SendNotificationResponse, error) {
+	return a.c.SendEmail(ctx, in, a.opts...)
+}
+
+func (a *notificationServiceAPI) SendSMS(ctx context.Context, in *SendSMSRequest) (*SendNotificationResponse, error) {
+	return a.c.SendSMS(ctx, in, a.opts...)
+}
+
+func (a *notificationServiceAPI) SendPush(ctx context.Context, in *SendPushRequest) (*SendNotificationResponse, error) {
+	return a.c.SendPush(ctx, in, a.opts...)
+}
+
+func (a *notificationServiceAPI) SubscribeEvents(ctx context.Context, in *SubscribeEventsRequest) iter.Seq2[*NotificationEvent, error] {
+	return serverStreamSeq(ctx, func(ctx context.Context) (grpc.ServerStreamingClient[NotificationEvent], error) {
+		return a.c.SubscribeEvents(ctx, in, a.opts...)
+	})
+}
+
+// NotificationServiceClientFromAPI adapts a to NotificationServiceClient, e.g. to hand a
+// mock NotificationServiceAPI to code that takes the generated client. Call options
+// are ignored, and streams report empty headers and trailers.
+func NotificationServiceClientFromAPI(a NotificationServiceAPI) NotificationServiceClient {
+	return notificationServiceAPIClient{api: a}
+}
+
+type notificationServiceAPIClient struct {
+	api NotificationServiceAPI
+}
+
+func (c notificationServiceAPIClient) GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesRequest, _ ...grpc.CallOption) (*GetNotificationPreferencesResponse, error) {
+	return c.api.GetNotificationPreferences(ctx, in)
+}
+
+func (c notificationServiceAPIClient) UpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesRequest, _ ...grpc.CallOption) (*UpdateNotificationPreferencesResponse, error) {
+	return c.api.UpdateNotificationPreferences(ctx, in)
+}
+
+func (c notificationServiceAPIClient) ListNotifications(ctx context.Context, in *ListNotificationsRequest, _ ...grpc.CallOption) (*ListNotificationsResponse, error) {
+	return c.api.ListNotifications(ctx, in)
+}
+
+func (c notificationServiceAPIClient) MarkNotificationRead(ctx context.Context, in *MarkNotificationReadRequest, _ ...grpc.CallOption) (*MarkNotificationReadResponse, error) {
+	return c.api.MarkNotificationRead(ctx, in)
+}
+
+func (c notificationServiceAPIClient) SendEmail(ctx context.Context, in *SendEmailRequest, _ ...grpc.CallOption) (*SendNotificationResponse, error) {
+	return c.api.SendEmail(ctx, in)
+}
+
+func (c notificationServiceAPIClient) SendSMS(ctx context.Context, in *SendSMSRequest, _ ...grpc.CallOption) (*SendNotificationResponse, error) {
+	return c.api.SendSMS(ctx, in)
+}
+
+func (c notificationServiceAPIClient) SendPush(ctx context.Context, in *SendPushRequest, _ ...grpc.CallOption) (*SendNotificationResponse, error) {
+	return c.api.SendPush(ctx, in)
+}
+
+func (c notificationServiceAPIClient) SubscribeEvents(ctx context.Context, in *SubscribeEventsRequest, _ ...grpc.CallOption) (grpc.ServerStreamingClient[NotificationEvent], error) {
+	return newSeqServerStream(ctx, c.api.SubscribeEvents(ctx, in)), nil
+}
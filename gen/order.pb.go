@@ -10,6 +10,8 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -22,21 +24,264 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// 주문 상태
+type OrderStatus int32
+
+const (
+	OrderStatus_ORDER_STATUS_UNSPECIFIED OrderStatus = 0
+	OrderStatus_ORDER_STATUS_PENDING     OrderStatus = 1 // 결제 대기
+	OrderStatus_ORDER_STATUS_PAID        OrderStatus = 2
+	OrderStatus_ORDER_STATUS_SHIPPED     OrderStatus = 3
+	OrderStatus_ORDER_STATUS_DELIVERED   OrderStatus = 4
+	OrderStatus_ORDER_STATUS_CANCELLED   OrderStatus = 5
+	OrderStatus_ORDER_STATUS_REFUNDED    OrderStatus = 6
+)
+
+// Enum value maps for OrderStatus.
+var (
+	OrderStatus_name = map[int32]string{
+		0: "ORDER_STATUS_UNSPECIFIED",
+		1: "ORDER_STATUS_PENDING",
+		2: "ORDER_STATUS_PAID",
+		3: "ORDER_STATUS_SHIPPED",
+		4: "ORDER_STATUS_DELIVERED",
+		5: "ORDER_STATUS_CANCELLED",
+		6: "ORDER_STATUS_REFUNDED",
+	}
+	OrderStatus_value = map[string]int32{
+		"ORDER_STATUS_UNSPECIFIED": 0,
+		"ORDER_STATUS_PENDING":     1,
+		"ORDER_STATUS_PAID":        2,
+		"ORDER_STATUS_SHIPPED":     3,
+		"ORDER_STATUS_DELIVERED":   4,
+		"ORDER_STATUS_CANCELLED":   5,
+		"ORDER_STATUS_REFUNDED":    6,
+	}
+)
+
+func (x OrderStatus) Enum() *OrderStatus {
+	p := new(OrderStatus)
+	*p = x
+	return p
+}
+
+func (x OrderStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_order_proto_enumTypes[0].Descriptor()
+}
+
+func (OrderStatus) Type() protoreflect.EnumType {
+	return &file_order_proto_enumTypes[0]
+}
+
+func (x OrderStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderStatus.Descriptor instead.
+func (OrderStatus) EnumDescriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{0}
+}
+
+// 환불 상태
+type RefundStatus int32
+
+const (
+	RefundStatus_REFUND_STATUS_UNSPECIFIED RefundStatus = 0
+	RefundStatus_REFUND_STATUS_PENDING     RefundStatus = 1 // 결제 취소 요청 중
+	RefundStatus_REFUND_STATUS_COMPLETED   RefundStatus = 2
+	RefundStatus_REFUND_STATUS_FAILED      RefundStatus = 3 // 결제 취소 실패, failure_reason 참고
+)
+
+// Enum value maps for RefundStatus.
+var (
+	RefundStatus_name = map[int32]string{
+		0: "REFUND_STATUS_UNSPECIFIED",
+		1: "REFUND_STATUS_PENDING",
+		2: "REFUND_STATUS_COMPLETED",
+		3: "REFUND_STATUS_FAILED",
+	}
+	RefundStatus_value = map[string]int32{
+		"REFUND_STATUS_UNSPECIFIED": 0,
+		"REFUND_STATUS_PENDING":     1,
+		"REFUND_STATUS_COMPLETED":   2,
+		"REFUND_STATUS_FAILED":      3,
+	}
+)
+
+func (x RefundStatus) Enum() *RefundStatus {
+	p := new(RefundStatus)
+	*p = x
+	return p
+}
+
+func (x RefundStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RefundStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_order_proto_enumTypes[1].Descriptor()
+}
+
+func (RefundStatus) Type() protoreflect.EnumType {
+	return &file_order_proto_enumTypes[1]
+}
+
+func (x RefundStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RefundStatus.Descriptor instead.
+func (RefundStatus) EnumDescriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{1}
+}
+
+type QuoteStatus int32
+
+const (
+	QuoteStatus_QUOTE_STATUS_UNSPECIFIED QuoteStatus = 0
+	QuoteStatus_QUOTE_STATUS_PENDING     QuoteStatus = 1 // 고객 수락 대기
+	QuoteStatus_QUOTE_STATUS_ACCEPTED    QuoteStatus = 2
+	QuoteStatus_QUOTE_STATUS_CONVERTED   QuoteStatus = 3 // 주문 전환 완료
+	QuoteStatus_QUOTE_STATUS_EXPIRED     QuoteStatus = 4
+)
+
+// Enum value maps for QuoteStatus.
+var (
+	QuoteStatus_name = map[int32]string{
+		0: "QUOTE_STATUS_UNSPECIFIED",
+		1: "QUOTE_STATUS_PENDING",
+		2: "QUOTE_STATUS_ACCEPTED",
+		3: "QUOTE_STATUS_CONVERTED",
+		4: "QUOTE_STATUS_EXPIRED",
+	}
+	QuoteStatus_value = map[string]int32{
+		"QUOTE_STATUS_UNSPECIFIED": 0,
+		"QUOTE_STATUS_PENDING":     1,
+		"QUOTE_STATUS_ACCEPTED":    2,
+		"QUOTE_STATUS_CONVERTED":   3,
+		"QUOTE_STATUS_EXPIRED":     4,
+	}
+)
+
+func (x QuoteStatus) Enum() *QuoteStatus {
+	p := new(QuoteStatus)
+	*p = x
+	return p
+}
+
+func (x QuoteStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QuoteStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_order_proto_enumTypes[2].Descriptor()
+}
+
+func (QuoteStatus) Type() protoreflect.EnumType {
+	return &file_order_proto_enumTypes[2]
+}
+
+func (x QuoteStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QuoteStatus.Descriptor instead.
+func (QuoteStatus) EnumDescriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{2}
+}
+
+// 가격 계산 시점
+type PricingStage int32
+
+const (
+	PricingStage_PRICING_STAGE_UNSPECIFIED PricingStage = 0
+	PricingStage_PRICING_STAGE_CART        PricingStage = 1 // 장바구니 표시용 (쿠폰 사용 한도 차감 없음)
+	PricingStage_PRICING_STAGE_CHECKOUT    PricingStage = 2 // 결제 직전 확정
+	PricingStage_PRICING_STAGE_REFUND      PricingStage = 3 // 부분 환불 시 남은 항목 재계산 (원 주문의 priced_at 사용)
+)
+
+// Enum value maps for PricingStage.
+var (
+	PricingStage_name = map[int32]string{
+		0: "PRICING_STAGE_UNSPECIFIED",
+		1: "PRICING_STAGE_CART",
+		2: "PRICING_STAGE_CHECKOUT",
+		3: "PRICING_STAGE_REFUND",
+	}
+	PricingStage_value = map[string]int32{
+		"PRICING_STAGE_UNSPECIFIED": 0,
+		"PRICING_STAGE_CART":        1,
+		"PRICING_STAGE_CHECKOUT":    2,
+		"PRICING_STAGE_REFUND":      3,
+	}
+)
+
+func (x PricingStage) Enum() *PricingStage {
+	p := new(PricingStage)
+	*p = x
+	return p
+}
+
+func (x PricingStage) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PricingStage) Descriptor() protoreflect.EnumDescriptor {
+	return file_order_proto_enumTypes[3].Descriptor()
+}
+
+func (PricingStage) Type() protoreflect.EnumType {
+	return &file_order_proto_enumTypes[3]
+}
+
+func (x PricingStage) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PricingStage.Descriptor instead.
+func (PricingStage) EnumDescriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{3}
+}
+
 type Order struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId          string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	OrderNumber     string                 `protobuf:"bytes,3,opt,name=order_number,json=orderNumber,proto3" json:"order_number,omitempty"`
-	Status          string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
-	TotalPrice      int64                  `protobuf:"varint,5,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
-	Quantity        int32                  `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	PaymentMethod   string                 `protobuf:"bytes,7,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
-	ShippingFee     int32                  `protobuf:"varint,8,opt,name=shipping_fee,json=shippingFee,proto3" json:"shipping_fee,omitempty"`
-	ShippingAddress string                 `protobuf:"bytes,9,opt,name=shipping_address,json=shippingAddress,proto3" json:"shipping_address,omitempty"`
-	OrderedAt       string                 `protobuf:"bytes,10,opt,name=ordered_at,json=orderedAt,proto3" json:"ordered_at,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId      string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OrderNumber string                 `protobuf:"bytes,3,opt,name=order_number,json=orderNumber,proto3" json:"order_number,omitempty"`
+	// 이전 버전의 문자열 상태 ("pending", "paid" 등), order_status로 대체됨
+	//
+	// Deprecated: Marked as deprecated in order.proto.
+	Status        string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	TotalPrice    int64  `protobuf:"varint,5,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	Quantity      int32  `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	PaymentMethod string `protobuf:"bytes,7,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	ShippingFee   *int32 `protobuf:"varint,8,opt,name=shipping_fee,json=shippingFee,proto3,oneof" json:"shipping_fee,omitempty"` // 0은 무료배송, 미설정은 배송비 미산정 (이전 버전 주문)
+	// 이전 버전의 문자열 배송지, delivery_address로 대체됨
+	//
+	// Deprecated: Marked as deprecated in order.proto.
+	ShippingAddress string `protobuf:"bytes,9,opt,name=shipping_address,json=shippingAddress,proto3" json:"shipping_address,omitempty"`
+	// 이전 버전의 RFC3339 문자열 시각, ordered_time/paid_time으로 대체됨
+	//
+	// Deprecated: Marked as deprecated in order.proto.
+	OrderedAt string `protobuf:"bytes,10,opt,name=ordered_at,json=orderedAt,proto3" json:"ordered_at,omitempty"`
+	// Deprecated: Marked as deprecated in order.proto.
 	PaidAt          string                 `protobuf:"bytes,11,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`
 	Memo            string                 `protobuf:"bytes,12,opt,name=memo,proto3" json:"memo,omitempty"`
 	Items           []*OrderItem           `protobuf:"bytes,13,rep,name=items,proto3" json:"items,omitempty"`
+	Customs         *CustomsDeclaration    `protobuf:"bytes,14,opt,name=customs,proto3" json:"customs,omitempty"`                               // 해외 배송 주문만 설정
+	Fx              *FxSnapshot            `protobuf:"bytes,15,opt,name=fx,proto3" json:"fx,omitempty"`                                         // 외화 표시 주문만 설정, total_price는 KRW 정산 금액
+	PaymentTerms    *PaymentTerms          `protobuf:"bytes,16,opt,name=payment_terms,json=paymentTerms,proto3" json:"payment_terms,omitempty"` // 외상(net terms) 주문만 설정, payment_method는 "net_terms"
+	OrderStatus     OrderStatus            `protobuf:"varint,17,opt,name=order_status,json=orderStatus,proto3,enum=go.escape.ship.proto.v1.OrderStatus" json:"order_status,omitempty"`
+	Refunds         []*Refund              `protobuf:"bytes,18,rep,name=refunds,proto3" json:"refunds,omitempty"`                                        // 환불 내역 (요청 순)
+	RefundedAmount  *Money                 `protobuf:"bytes,19,opt,name=refunded_amount,json=refundedAmount,proto3" json:"refunded_amount,omitempty"`    // 완료된 환불 누계, 결제 금액과 같아지면 order_status는 REFUNDED
+	DeliveryAddress *Address               `protobuf:"bytes,20,opt,name=delivery_address,json=deliveryAddress,proto3" json:"delivery_address,omitempty"` // 배송지
+	OrderedTime     *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=ordered_time,json=orderedTime,proto3" json:"ordered_time,omitempty"`
+	PaidTime        *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=paid_time,json=paidTime,proto3" json:"paid_time,omitempty"`       // 결제 전이면 미설정
+	PaymentCid      string                 `protobuf:"bytes,23,opt,name=payment_cid,json=paymentCid,proto3" json:"payment_cid,omitempty"` // 결제에 사용한 카카오페이 가맹점 코드 (KakaoReadyRequest.cid), 취소도 같은 코드로 요청
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -92,6 +337,7 @@ func (x *Order) GetOrderNumber() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in order.proto.
 func (x *Order) GetStatus() string {
 	if x != nil {
 		return x.Status
@@ -121,12 +367,13 @@ func (x *Order) GetPaymentMethod() string {
 }
 
 func (x *Order) GetShippingFee() int32 {
-	if x != nil {
-		return x.ShippingFee
+	if x != nil && x.ShippingFee != nil {
+		return *x.ShippingFee
 	}
 	return 0
 }
 
+// Deprecated: Marked as deprecated in order.proto.
 func (x *Order) GetShippingAddress() string {
 	if x != nil {
 		return x.ShippingAddress
@@ -134,6 +381,7 @@ func (x *Order) GetShippingAddress() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in order.proto.
 func (x *Order) GetOrderedAt() string {
 	if x != nil {
 		return x.OrderedAt
@@ -141,6 +389,7 @@ func (x *Order) GetOrderedAt() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in order.proto.
 func (x *Order) GetPaidAt() string {
 	if x != nil {
 		return x.PaidAt
@@ -162,32 +411,100 @@ func (x *Order) GetItems() []*OrderItem {
 	return nil
 }
 
-type OrderItem struct {
+func (x *Order) GetCustoms() *CustomsDeclaration {
+	if x != nil {
+		return x.Customs
+	}
+	return nil
+}
+
+func (x *Order) GetFx() *FxSnapshot {
+	if x != nil {
+		return x.Fx
+	}
+	return nil
+}
+
+func (x *Order) GetPaymentTerms() *PaymentTerms {
+	if x != nil {
+		return x.PaymentTerms
+	}
+	return nil
+}
+
+func (x *Order) GetOrderStatus() OrderStatus {
+	if x != nil {
+		return x.OrderStatus
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+func (x *Order) GetRefunds() []*Refund {
+	if x != nil {
+		return x.Refunds
+	}
+	return nil
+}
+
+func (x *Order) GetRefundedAmount() *Money {
+	if x != nil {
+		return x.RefundedAmount
+	}
+	return nil
+}
+
+func (x *Order) GetDeliveryAddress() *Address {
+	if x != nil {
+		return x.DeliveryAddress
+	}
+	return nil
+}
+
+func (x *Order) GetOrderedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OrderedTime
+	}
+	return nil
+}
+
+func (x *Order) GetPaidTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PaidTime
+	}
+	return nil
+}
+
+func (x *Order) GetPaymentCid() string {
+	if x != nil {
+		return x.PaymentCid
+	}
+	return ""
+}
+
+// 환불 대상 주문 항목 (부분 환불)
+type RefundItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	ProductId     string                 `protobuf:"bytes,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	ProductName   string                 `protobuf:"bytes,4,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
-	ProductPrice  int64                  `protobuf:"varint,5,opt,name=product_price,json=productPrice,proto3" json:"product_price,omitempty"`
-	Quantity      int32                  `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	OrderItemId   string                 `protobuf:"bytes,1,opt,name=order_item_id,json=orderItemId,proto3" json:"order_item_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"` // 할인 배분을 반영한 항목 환불 금액 (AllocateRefund가 계산)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrderItem) Reset() {
-	*x = OrderItem{}
+func (x *RefundItem) Reset() {
+	*x = RefundItem{}
 	mi := &file_order_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderItem) String() string {
+func (x *RefundItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderItem) ProtoMessage() {}
+func (*RefundItem) ProtoMessage() {}
 
-func (x *OrderItem) ProtoReflect() protoreflect.Message {
+func (x *RefundItem) ProtoReflect() protoreflect.Message {
 	mi := &file_order_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -199,84 +516,72 @@ func (x *OrderItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderItem.ProtoReflect.Descriptor instead.
-func (*OrderItem) Descriptor() ([]byte, []int) {
+// Deprecated: Use RefundItem.ProtoReflect.Descriptor instead.
+func (*RefundItem) Descriptor() ([]byte, []int) {
 	return file_order_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *OrderItem) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *OrderItem) GetOrderId() string {
-	if x != nil {
-		return x.OrderId
-	}
-	return ""
-}
-
-func (x *OrderItem) GetProductId() string {
-	if x != nil {
-		return x.ProductId
-	}
-	return ""
-}
-
-func (x *OrderItem) GetProductName() string {
+func (x *RefundItem) GetOrderItemId() string {
 	if x != nil {
-		return x.ProductName
+		return x.OrderItemId
 	}
 	return ""
 }
 
-func (x *OrderItem) GetProductPrice() int64 {
+func (x *RefundItem) GetQuantity() int32 {
 	if x != nil {
-		return x.ProductPrice
+		return x.Quantity
 	}
 	return 0
 }
 
-func (x *OrderItem) GetQuantity() int32 {
+func (x *RefundItem) GetAmount() *Money {
 	if x != nil {
-		return x.Quantity
+		return x.Amount
 	}
-	return 0
+	return nil
 }
 
-type InsertOrderRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	OrderNumber     string                 `protobuf:"bytes,2,opt,name=order_number,json=orderNumber,proto3" json:"order_number,omitempty"`
-	Status          string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	TotalPrice      int64                  `protobuf:"varint,4,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
-	Quantity        int32                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	PaymentMethod   string                 `protobuf:"bytes,6,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
-	ShippingFee     int32                  `protobuf:"varint,7,opt,name=shipping_fee,json=shippingFee,proto3" json:"shipping_fee,omitempty"`
-	ShippingAddress string                 `protobuf:"bytes,8,opt,name=shipping_address,json=shippingAddress,proto3" json:"shipping_address,omitempty"`
-	PaidAt          string                 `protobuf:"bytes,9,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`
-	Memo            string                 `protobuf:"bytes,10,opt,name=memo,proto3" json:"memo,omitempty"`
-	Items           []*InsertOrderItem     `protobuf:"bytes,12,rep,name=items,proto3" json:"items,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type Refund struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId        string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Status         RefundStatus           `protobuf:"varint,3,opt,name=status,proto3,enum=go.escape.ship.proto.v1.RefundStatus" json:"status,omitempty"`
+	Amount         *Money                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`                                      // 환불 금액
+	TaxFreeAmount  *Money                 `protobuf:"bytes,5,opt,name=tax_free_amount,json=taxFreeAmount,proto3" json:"tax_free_amount,omitempty"` // 환불 금액 중 비과세
+	VatAmount      *Money                 `protobuf:"bytes,6,opt,name=vat_amount,json=vatAmount,proto3" json:"vat_amount,omitempty"`               // 환불 금액 중 부가세
+	Items          []*RefundItem          `protobuf:"bytes,7,rep,name=items,proto3" json:"items,omitempty"`                                        // 항목 단위 환불일 때 설정
+	Reason         string                 `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	PartnerOrderId string                 `protobuf:"bytes,9,opt,name=partner_order_id,json=partnerOrderId,proto3" json:"partner_order_id,omitempty"` // KakaoCancel에 전달한 결제 주문 ID
+	FailureReason  string                 `protobuf:"bytes,10,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	// 이전 버전의 RFC3339 문자열 시각, requested_time/completed_time으로 대체됨
+	//
+	// Deprecated: Marked as deprecated in order.proto.
+	RequestedAt string `protobuf:"bytes,11,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	// Deprecated: Marked as deprecated in order.proto.
+	CompletedAt    string                 `protobuf:"bytes,12,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	ShippingAmount *Money                 `protobuf:"bytes,13,opt,name=shipping_amount,json=shippingAmount,proto3" json:"shipping_amount,omitempty"` // amount 중 배송비 환불분
+	RequestedTime  *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=requested_time,json=requestedTime,proto3" json:"requested_time,omitempty"`
+	CompletedTime  *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=completed_time,json=completedTime,proto3" json:"completed_time,omitempty"` // COMPLETED/FAILED 처리 시각
+	Cid            string                 `protobuf:"bytes,16,opt,name=cid,proto3" json:"cid,omitempty"`                                          // KakaoCancel에 전달한 가맹점 코드, 비어 있으면 Order.payment_cid
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *InsertOrderRequest) Reset() {
-	*x = InsertOrderRequest{}
+func (x *Refund) Reset() {
+	*x = Refund{}
 	mi := &file_order_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InsertOrderRequest) String() string {
+func (x *Refund) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InsertOrderRequest) ProtoMessage() {}
+func (*Refund) ProtoMessage() {}
 
-func (x *InsertOrderRequest) ProtoReflect() protoreflect.Message {
+func (x *Refund) ProtoReflect() protoreflect.Message {
 	mi := &file_order_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -288,114 +593,3214 @@ func (x *InsertOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InsertOrderRequest.ProtoReflect.Descriptor instead.
-func (*InsertOrderRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Refund.ProtoReflect.Descriptor instead.
+func (*Refund) Descriptor() ([]byte, []int) {
 	return file_order_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *InsertOrderRequest) GetUserId() string {
+func (x *Refund) GetId() string {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *InsertOrderRequest) GetOrderNumber() string {
+func (x *Refund) GetOrderId() string {
 	if x != nil {
-		return x.OrderNumber
+		return x.OrderId
 	}
 	return ""
 }
 
-func (x *InsertOrderRequest) GetStatus() string {
+func (x *Refund) GetStatus() RefundStatus {
 	if x != nil {
 		return x.Status
 	}
-	return ""
+	return RefundStatus_REFUND_STATUS_UNSPECIFIED
 }
 
-func (x *InsertOrderRequest) GetTotalPrice() int64 {
+func (x *Refund) GetAmount() *Money {
 	if x != nil {
-		return x.TotalPrice
+		return x.Amount
 	}
-	return 0
+	return nil
 }
 
-func (x *InsertOrderRequest) GetQuantity() int32 {
+func (x *Refund) GetTaxFreeAmount() *Money {
 	if x != nil {
-		return x.Quantity
+		return x.TaxFreeAmount
 	}
-	return 0
+	return nil
 }
 
-func (x *InsertOrderRequest) GetPaymentMethod() string {
+func (x *Refund) GetVatAmount() *Money {
 	if x != nil {
-		return x.PaymentMethod
+		return x.VatAmount
 	}
-	return ""
+	return nil
 }
 
-func (x *InsertOrderRequest) GetShippingFee() int32 {
+func (x *Refund) GetItems() []*RefundItem {
 	if x != nil {
-		return x.ShippingFee
+		return x.Items
 	}
-	return 0
+	return nil
 }
 
-func (x *InsertOrderRequest) GetShippingAddress() string {
+func (x *Refund) GetReason() string {
 	if x != nil {
-		return x.ShippingAddress
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *InsertOrderRequest) GetPaidAt() string {
+func (x *Refund) GetPartnerOrderId() string {
 	if x != nil {
-		return x.PaidAt
+		return x.PartnerOrderId
 	}
 	return ""
 }
 
-func (x *InsertOrderRequest) GetMemo() string {
+func (x *Refund) GetFailureReason() string {
 	if x != nil {
-		return x.Memo
+		return x.FailureReason
 	}
 	return ""
 }
 
-func (x *InsertOrderRequest) GetItems() []*InsertOrderItem {
+// Deprecated: Marked as deprecated in order.proto.
+func (x *Refund) GetRequestedAt() string {
 	if x != nil {
-		return x.Items
+		return x.RequestedAt
 	}
-	return nil
+	return ""
 }
 
-type InsertOrderItem struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	ProductId      string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	ProductName    string                 `protobuf:"bytes,2,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
-	ProductOptions string                 `protobuf:"bytes,3,opt,name=product_options,json=productOptions,proto3" json:"product_options,omitempty"`
-	ProductPrice   int64                  `protobuf:"varint,4,opt,name=product_price,json=productPrice,proto3" json:"product_price,omitempty"`
-	Quantity       int32                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// Deprecated: Marked as deprecated in order.proto.
+func (x *Refund) GetCompletedAt() string {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return ""
 }
 
-func (x *InsertOrderItem) Reset() {
-	*x = InsertOrderItem{}
-	mi := &file_order_proto_msgTypes[3]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Refund) GetShippingAmount() *Money {
+	if x != nil {
+		return x.ShippingAmount
+	}
+	return nil
 }
 
-func (x *InsertOrderItem) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Refund) GetRequestedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RequestedTime
+	}
+	return nil
 }
 
-func (*InsertOrderItem) ProtoMessage() {}
-
-func (x *InsertOrderItem) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[3]
+func (x *Refund) GetCompletedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedTime
+	}
+	return nil
+}
+
+func (x *Refund) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+// 외상 결제 조건 (ex: Net 30 = 주문일로부터 30일 이내 결제)
+type PaymentTerms struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NetDays       int32                  `protobuf:"varint,1,opt,name=net_days,json=netDays,proto3" json:"net_days,omitempty"`
+	DueDate       string                 `protobuf:"bytes,2,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"` // 결제 기한 (YYYY-MM-DD)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentTerms) Reset() {
+	*x = PaymentTerms{}
+	mi := &file_order_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentTerms) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentTerms) ProtoMessage() {}
+
+func (x *PaymentTerms) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentTerms.ProtoReflect.Descriptor instead.
+func (*PaymentTerms) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PaymentTerms) GetNetDays() int32 {
+	if x != nil {
+		return x.NetDays
+	}
+	return 0
+}
+
+func (x *PaymentTerms) GetDueDate() string {
+	if x != nil {
+		return x.DueDate
+	}
+	return ""
+}
+
+// 해외 배송 통관 신고 정보
+type CustomsDeclaration struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	PersonalCustomsCode string                 `protobuf:"bytes,1,opt,name=personal_customs_code,json=personalCustomsCode,proto3" json:"personal_customs_code,omitempty"` // 개인통관고유부호 (ex: "P123456789012")
+	DestinationCountry  string                 `protobuf:"bytes,2,opt,name=destination_country,json=destinationCountry,proto3" json:"destination_country,omitempty"`      // ISO 3166-1 alpha-2 (ex: "US")
+	DeclaredCurrency    string                 `protobuf:"bytes,3,opt,name=declared_currency,json=declaredCurrency,proto3" json:"declared_currency,omitempty"`            // ISO 4217 (ex: "USD")
+	DeclaredValue       int64                  `protobuf:"varint,4,opt,name=declared_value,json=declaredValue,proto3" json:"declared_value,omitempty"`                    // 신고 총액 (declared_currency 최소 단위)
+	Items               []*CustomsItem         `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CustomsDeclaration) Reset() {
+	*x = CustomsDeclaration{}
+	mi := &file_order_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomsDeclaration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomsDeclaration) ProtoMessage() {}
+
+func (x *CustomsDeclaration) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomsDeclaration.ProtoReflect.Descriptor instead.
+func (*CustomsDeclaration) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CustomsDeclaration) GetPersonalCustomsCode() string {
+	if x != nil {
+		return x.PersonalCustomsCode
+	}
+	return ""
+}
+
+func (x *CustomsDeclaration) GetDestinationCountry() string {
+	if x != nil {
+		return x.DestinationCountry
+	}
+	return ""
+}
+
+func (x *CustomsDeclaration) GetDeclaredCurrency() string {
+	if x != nil {
+		return x.DeclaredCurrency
+	}
+	return ""
+}
+
+func (x *CustomsDeclaration) GetDeclaredValue() int64 {
+	if x != nil {
+		return x.DeclaredValue
+	}
+	return 0
+}
+
+func (x *CustomsDeclaration) GetItems() []*CustomsItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type CustomsItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	HsCode        string                 `protobuf:"bytes,2,opt,name=hs_code,json=hsCode,proto3" json:"hs_code,omitempty"` // HS 품목 분류 코드 (ex: "6109.10")
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`     // 영문 품명
+	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	DeclaredValue int64                  `protobuf:"varint,5,opt,name=declared_value,json=declaredValue,proto3" json:"declared_value,omitempty"` // 품목별 신고 금액 (declared_currency 최소 단위)
+	OriginCountry string                 `protobuf:"bytes,6,opt,name=origin_country,json=originCountry,proto3" json:"origin_country,omitempty"`  // 원산지 ISO 3166-1 alpha-2
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomsItem) Reset() {
+	*x = CustomsItem{}
+	mi := &file_order_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomsItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomsItem) ProtoMessage() {}
+
+func (x *CustomsItem) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomsItem.ProtoReflect.Descriptor instead.
+func (*CustomsItem) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CustomsItem) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CustomsItem) GetHsCode() string {
+	if x != nil {
+		return x.HsCode
+	}
+	return ""
+}
+
+func (x *CustomsItem) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CustomsItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CustomsItem) GetDeclaredValue() int64 {
+	if x != nil {
+		return x.DeclaredValue
+	}
+	return 0
+}
+
+func (x *CustomsItem) GetOriginCountry() string {
+	if x != nil {
+		return x.OriginCountry
+	}
+	return ""
+}
+
+type OrderItem struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId     string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	ProductId   string                 `protobuf:"bytes,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductName string                 `protobuf:"bytes,4,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	// Deprecated: Marked as deprecated in order.proto.
+	ProductPrice     int64              `protobuf:"varint,5,opt,name=product_price,json=productPrice,proto3" json:"product_price,omitempty"` // KRW 원 단위, unit_price로 대체됨
+	Quantity         int32              `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	BundleId         string             `protobuf:"bytes,7,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"`                         // 번들 주문 항목일 때 설정
+	BundleComponents []*BundleComponent `protobuf:"bytes,8,rep,name=bundle_components,json=bundleComponents,proto3" json:"bundle_components,omitempty"` // 출고용으로 전개된 번들 구성품
+	UnitPrice        *Money             `protobuf:"bytes,9,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`                      // 주문 시점 단가
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *OrderItem) Reset() {
+	*x = OrderItem{}
+	mi := &file_order_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderItem) ProtoMessage() {}
+
+func (x *OrderItem) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderItem.ProtoReflect.Descriptor instead.
+func (*OrderItem) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *OrderItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OrderItem) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *OrderItem) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *OrderItem) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in order.proto.
+func (x *OrderItem) GetProductPrice() int64 {
+	if x != nil {
+		return x.ProductPrice
+	}
+	return 0
+}
+
+func (x *OrderItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *OrderItem) GetBundleId() string {
+	if x != nil {
+		return x.BundleId
+	}
+	return ""
+}
+
+func (x *OrderItem) GetBundleComponents() []*BundleComponent {
+	if x != nil {
+		return x.BundleComponents
+	}
+	return nil
+}
+
+func (x *OrderItem) GetUnitPrice() *Money {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return nil
+}
+
+type InsertOrderRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	UserId      string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OrderNumber string                 `protobuf:"bytes,2,opt,name=order_number,json=orderNumber,proto3" json:"order_number,omitempty"`
+	// 이전 버전의 문자열 상태, order_status로 대체됨
+	//
+	// Deprecated: Marked as deprecated in order.proto.
+	Status        string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	TotalPrice    int64  `protobuf:"varint,4,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	Quantity      int32  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	PaymentMethod string `protobuf:"bytes,6,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	ShippingFee   *int32 `protobuf:"varint,7,opt,name=shipping_fee,json=shippingFee,proto3,oneof" json:"shipping_fee,omitempty"` // 0은 무료배송, 미설정이면 서버가 배송비 정책으로 계산
+	// 이전 버전의 문자열 배송지, delivery_address로 대체됨
+	//
+	// Deprecated: Marked as deprecated in order.proto.
+	ShippingAddress string `protobuf:"bytes,8,opt,name=shipping_address,json=shippingAddress,proto3" json:"shipping_address,omitempty"`
+	// 이전 버전의 RFC3339 문자열 시각, paid_time으로 대체됨
+	//
+	// Deprecated: Marked as deprecated in order.proto.
+	PaidAt          string              `protobuf:"bytes,9,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`
+	Memo            string              `protobuf:"bytes,10,opt,name=memo,proto3" json:"memo,omitempty"`
+	Items           []*InsertOrderItem  `protobuf:"bytes,12,rep,name=items,proto3" json:"items,omitempty"`
+	Customs         *CustomsDeclaration `protobuf:"bytes,13,opt,name=customs,proto3" json:"customs,omitempty"` // 해외 배송 주문만 설정
+	Fx              *FxSnapshot         `protobuf:"bytes,14,opt,name=fx,proto3" json:"fx,omitempty"`           // 외화 표시 주문만 설정, total_price는 KRW 정산 금액
+	Device          *DeviceFingerprint  `protobuf:"bytes,15,opt,name=device,proto3" json:"device,omitempty"`
+	OrderStatus     OrderStatus         `protobuf:"varint,16,opt,name=order_status,json=orderStatus,proto3,enum=go.escape.ship.proto.v1.OrderStatus" json:"order_status,omitempty"`
+	DeliveryAddress *Address            `protobuf:"bytes,17,opt,name=delivery_address,json=deliveryAddress,proto3" json:"delivery_address,omitempty"` // 배송지 (Validate로 검증)
+	// 재시도 시 중복 처리를 막는 키 (논리적 작업마다 클라이언트가 생성, 재시도에는 같은 값 사용)
+	// 같은 키로 다시 요청하면 서버는 처리하지 않고 처음 응답을 반환, 요청 내용이 다르면 ALREADY_EXISTS
+	// 비어 있으면 Idempotency-Key 헤더 값을 사용 (UnaryIdempotencyKeyInterceptor가 설정)
+	IdempotencyKey string                 `protobuf:"bytes,18,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	PaidTime       *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=paid_time,json=paidTime,proto3" json:"paid_time,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InsertOrderRequest) Reset() {
+	*x = InsertOrderRequest{}
+	mi := &file_order_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InsertOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsertOrderRequest) ProtoMessage() {}
+
+func (x *InsertOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsertOrderRequest.ProtoReflect.Descriptor instead.
+func (*InsertOrderRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *InsertOrderRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *InsertOrderRequest) GetOrderNumber() string {
+	if x != nil {
+		return x.OrderNumber
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in order.proto.
+func (x *InsertOrderRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *InsertOrderRequest) GetTotalPrice() int64 {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return 0
+}
+
+func (x *InsertOrderRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *InsertOrderRequest) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *InsertOrderRequest) GetShippingFee() int32 {
+	if x != nil && x.ShippingFee != nil {
+		return *x.ShippingFee
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in order.proto.
+func (x *InsertOrderRequest) GetShippingAddress() string {
+	if x != nil {
+		return x.ShippingAddress
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in order.proto.
+func (x *InsertOrderRequest) GetPaidAt() string {
+	if x != nil {
+		return x.PaidAt
+	}
+	return ""
+}
+
+func (x *InsertOrderRequest) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *InsertOrderRequest) GetItems() []*InsertOrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *InsertOrderRequest) GetCustoms() *CustomsDeclaration {
+	if x != nil {
+		return x.Customs
+	}
+	return nil
+}
+
+func (x *InsertOrderRequest) GetFx() *FxSnapshot {
+	if x != nil {
+		return x.Fx
+	}
+	return nil
+}
+
+func (x *InsertOrderRequest) GetDevice() *DeviceFingerprint {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
+func (x *InsertOrderRequest) GetOrderStatus() OrderStatus {
+	if x != nil {
+		return x.OrderStatus
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+func (x *InsertOrderRequest) GetDeliveryAddress() *Address {
+	if x != nil {
+		return x.DeliveryAddress
+	}
+	return nil
+}
+
+func (x *InsertOrderRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *InsertOrderRequest) GetPaidTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PaidTime
+	}
+	return nil
+}
+
+type InsertOrderItem struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ProductId      string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductName    string                 `protobuf:"bytes,2,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	ProductOptions string                 `protobuf:"bytes,3,opt,name=product_options,json=productOptions,proto3" json:"product_options,omitempty"`
+	ProductPrice   int64                  `protobuf:"varint,4,opt,name=product_price,json=productPrice,proto3" json:"product_price,omitempty"` // 수량 구간 가격 적용 단가, 서버가 Product.UnitPrice(quantity)와 비교해 검증
+	Quantity       int32                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	BundleId       string                 `protobuf:"bytes,6,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"` // 번들 주문 시 설정, 서버가 구성품으로 전개
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InsertOrderItem) Reset() {
+	*x = InsertOrderItem{}
+	mi := &file_order_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InsertOrderItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsertOrderItem) ProtoMessage() {}
+
+func (x *InsertOrderItem) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsertOrderItem.ProtoReflect.Descriptor instead.
+func (*InsertOrderItem) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *InsertOrderItem) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *InsertOrderItem) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *InsertOrderItem) GetProductOptions() string {
+	if x != nil {
+		return x.ProductOptions
+	}
+	return ""
+}
+
+func (x *InsertOrderItem) GetProductPrice() int64 {
+	if x != nil {
+		return x.ProductPrice
+	}
+	return 0
+}
+
+func (x *InsertOrderItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *InsertOrderItem) GetBundleId() string {
+	if x != nil {
+		return x.BundleId
+	}
+	return ""
+}
+
+type InsertOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InsertOrderResponse) Reset() {
+	*x = InsertOrderResponse{}
+	mi := &file_order_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InsertOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsertOrderResponse) ProtoMessage() {}
+
+func (x *InsertOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsertOrderResponse.ProtoReflect.Descriptor instead.
+func (*InsertOrderResponse) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *InsertOrderResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetAllOrdersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 응답에 포함할 Order 필드 (ex: "id,order_status,total_price"), 비어 있으면 전체 필드
+	ReadMask *fieldmaskpb.FieldMask `protobuf:"bytes,1,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	// 페이지 크기 (0이면 서버 기본값, 최대 100)
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// 이전 응답의 next_page_token, 첫 페이지는 비워 둠
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllOrdersRequest) Reset() {
+	*x = GetAllOrdersRequest{}
+	mi := &file_order_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllOrdersRequest) ProtoMessage() {}
+
+func (x *GetAllOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllOrdersRequest.ProtoReflect.Descriptor instead.
+func (*GetAllOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetAllOrdersRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+func (x *GetAllOrdersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetAllOrdersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type WatchOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchOrderRequest) Reset() {
+	*x = WatchOrderRequest{}
+	mi := &file_order_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchOrderRequest) ProtoMessage() {}
+
+func (x *WatchOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchOrderRequest.ProtoReflect.Descriptor instead.
+func (*WatchOrderRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *WatchOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+// 주문 상태 변경 이벤트
+type OrderStatusEvent struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrderId        string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Status         OrderStatus            `protobuf:"varint,2,opt,name=status,proto3,enum=go.escape.ship.proto.v1.OrderStatus" json:"status,omitempty"`
+	PreviousStatus OrderStatus            `protobuf:"varint,3,opt,name=previous_status,json=previousStatus,proto3,enum=go.escape.ship.proto.v1.OrderStatus" json:"previous_status,omitempty"` // 구독 직후 첫 이벤트는 UNSPECIFIED
+	// 이전 버전의 RFC3339 문자열 시각, changed_time으로 대체됨
+	//
+	// Deprecated: Marked as deprecated in order.proto.
+	ChangedAt     string                 `protobuf:"bytes,4,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`     // 취소/환불 사유 등 (선택)
+	Tracking      *TrackingEvent         `protobuf:"bytes,6,opt,name=tracking,proto3" json:"tracking,omitempty"` // SHIPPED/DELIVERED 변경일 때 원인이 된 배송 추적 이벤트
+	ChangedTime   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=changed_time,json=changedTime,proto3" json:"changed_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderStatusEvent) Reset() {
+	*x = OrderStatusEvent{}
+	mi := &file_order_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderStatusEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderStatusEvent) ProtoMessage() {}
+
+func (x *OrderStatusEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderStatusEvent.ProtoReflect.Descriptor instead.
+func (*OrderStatusEvent) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *OrderStatusEvent) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *OrderStatusEvent) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+func (x *OrderStatusEvent) GetPreviousStatus() OrderStatus {
+	if x != nil {
+		return x.PreviousStatus
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+// Deprecated: Marked as deprecated in order.proto.
+func (x *OrderStatusEvent) GetChangedAt() string {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return ""
+}
+
+func (x *OrderStatusEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *OrderStatusEvent) GetTracking() *TrackingEvent {
+	if x != nil {
+		return x.Tracking
+	}
+	return nil
+}
+
+func (x *OrderStatusEvent) GetChangedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedTime
+	}
+	return nil
+}
+
+type CancelOrderRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrderId        string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Reason         string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // InsertOrderRequest.idempotency_key 참고
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CancelOrderRequest) Reset() {
+	*x = CancelOrderRequest{}
+	mi := &file_order_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderRequest) ProtoMessage() {}
+
+func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CancelOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *CancelOrderRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CancelOrderRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type CancelOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Refund        *Refund                `protobuf:"bytes,2,opt,name=refund,proto3" json:"refund,omitempty"` // 결제 완료 주문을 취소한 경우 전액 환불 내역
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrderResponse) Reset() {
+	*x = CancelOrderResponse{}
+	mi := &file_order_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderResponse) ProtoMessage() {}
+
+func (x *CancelOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderResponse.ProtoReflect.Descriptor instead.
+func (*CancelOrderResponse) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CancelOrderResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *CancelOrderResponse) GetRefund() *Refund {
+	if x != nil {
+		return x.Refund
+	}
+	return nil
+}
+
+type RefundOrderRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	OrderId string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// 환불할 항목, 비어 있으면 amount 기준 (둘 다 비어 있으면 남은 금액 전액)
+	Items          []*RefundItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	Amount         *Money        `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"` // 금액 기준 부분 환불 (배송비 등), items가 있으면 무시
+	TaxFreeAmount  *Money        `protobuf:"bytes,4,opt,name=tax_free_amount,json=taxFreeAmount,proto3" json:"tax_free_amount,omitempty"`
+	Reason         string        `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	IdempotencyKey string        `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // InsertOrderRequest.idempotency_key 참고
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RefundOrderRequest) Reset() {
+	*x = RefundOrderRequest{}
+	mi := &file_order_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundOrderRequest) ProtoMessage() {}
+
+func (x *RefundOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundOrderRequest.ProtoReflect.Descriptor instead.
+func (*RefundOrderRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RefundOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *RefundOrderRequest) GetItems() []*RefundItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *RefundOrderRequest) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *RefundOrderRequest) GetTaxFreeAmount() *Money {
+	if x != nil {
+		return x.TaxFreeAmount
+	}
+	return nil
+}
+
+func (x *RefundOrderRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RefundOrderRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type RefundOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Refund        *Refund                `protobuf:"bytes,2,opt,name=refund,proto3" json:"refund,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundOrderResponse) Reset() {
+	*x = RefundOrderResponse{}
+	mi := &file_order_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundOrderResponse) ProtoMessage() {}
+
+func (x *RefundOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundOrderResponse.ProtoReflect.Descriptor instead.
+func (*RefundOrderResponse) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RefundOrderResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *RefundOrderResponse) GetRefund() *Refund {
+	if x != nil {
+		return x.Refund
+	}
+	return nil
+}
+
+type GetAllOrdersResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Orders []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	// 다음 페이지 토큰, 마지막 페이지면 빈 문자열
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// 전체 주문 수
+	TotalCount    int32 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllOrdersResponse) Reset() {
+	*x = GetAllOrdersResponse{}
+	mi := &file_order_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllOrdersResponse) ProtoMessage() {}
+
+func (x *GetAllOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllOrdersResponse.ProtoReflect.Descriptor instead.
+func (*GetAllOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetAllOrdersResponse) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *GetAllOrdersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GetAllOrdersResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// 반품 수거 예약 및 라벨 정보
+type ReturnLabel struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ReturnId        string                 `protobuf:"bytes,1,opt,name=return_id,json=returnId,proto3" json:"return_id,omitempty"`
+	Carrier         string                 `protobuf:"bytes,2,opt,name=carrier,proto3" json:"carrier,omitempty"`
+	TrackingNumber  string                 `protobuf:"bytes,3,opt,name=tracking_number,json=trackingNumber,proto3" json:"tracking_number,omitempty"`
+	LabelUrl        string                 `protobuf:"bytes,4,opt,name=label_url,json=labelUrl,proto3" json:"label_url,omitempty"`                        // 출력용 라벨 (PDF) URL
+	PickupBookingId string                 `protobuf:"bytes,5,opt,name=pickup_booking_id,json=pickupBookingId,proto3" json:"pickup_booking_id,omitempty"` // 택배사 수거 예약 번호
+	PickupDate      string                 `protobuf:"bytes,6,opt,name=pickup_date,json=pickupDate,proto3" json:"pickup_date,omitempty"`
+	// 이전 버전의 RFC3339 문자열 시각, created_time으로 대체됨
+	//
+	// Deprecated: Marked as deprecated in order.proto.
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Events        []*TrackingEvent       `protobuf:"bytes,8,rep,name=events,proto3" json:"events,omitempty"` // 반품 배송 추적 이력 (발생 순)
+	CreatedTime   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReturnLabel) Reset() {
+	*x = ReturnLabel{}
+	mi := &file_order_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnLabel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnLabel) ProtoMessage() {}
+
+func (x *ReturnLabel) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnLabel.ProtoReflect.Descriptor instead.
+func (*ReturnLabel) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ReturnLabel) GetReturnId() string {
+	if x != nil {
+		return x.ReturnId
+	}
+	return ""
+}
+
+func (x *ReturnLabel) GetCarrier() string {
+	if x != nil {
+		return x.Carrier
+	}
+	return ""
+}
+
+func (x *ReturnLabel) GetTrackingNumber() string {
+	if x != nil {
+		return x.TrackingNumber
+	}
+	return ""
+}
+
+func (x *ReturnLabel) GetLabelUrl() string {
+	if x != nil {
+		return x.LabelUrl
+	}
+	return ""
+}
+
+func (x *ReturnLabel) GetPickupBookingId() string {
+	if x != nil {
+		return x.PickupBookingId
+	}
+	return ""
+}
+
+func (x *ReturnLabel) GetPickupDate() string {
+	if x != nil {
+		return x.PickupDate
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in order.proto.
+func (x *ReturnLabel) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ReturnLabel) GetEvents() []*TrackingEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ReturnLabel) GetCreatedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTime
+	}
+	return nil
+}
+
+type CreateReturnLabelRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	ReturnId string                 `protobuf:"bytes,1,opt,name=return_id,json=returnId,proto3" json:"return_id,omitempty"`
+	Carrier  string                 `protobuf:"bytes,2,opt,name=carrier,proto3" json:"carrier,omitempty"` // 비어 있으면 기본 택배사 사용
+	// 이전 버전의 문자열 주소, pickup으로 대체됨
+	//
+	// Deprecated: Marked as deprecated in order.proto.
+	PickupAddress string   `protobuf:"bytes,3,opt,name=pickup_address,json=pickupAddress,proto3" json:"pickup_address,omitempty"`
+	PickupDate    string   `protobuf:"bytes,4,opt,name=pickup_date,json=pickupDate,proto3" json:"pickup_date,omitempty"` // 희망 수거일 (YYYY-MM-DD)
+	Pickup        *Address `protobuf:"bytes,5,opt,name=pickup,proto3" json:"pickup,omitempty"`                           // 수거지
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReturnLabelRequest) Reset() {
+	*x = CreateReturnLabelRequest{}
+	mi := &file_order_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReturnLabelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReturnLabelRequest) ProtoMessage() {}
+
+func (x *CreateReturnLabelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReturnLabelRequest.ProtoReflect.Descriptor instead.
+func (*CreateReturnLabelRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateReturnLabelRequest) GetReturnId() string {
+	if x != nil {
+		return x.ReturnId
+	}
+	return ""
+}
+
+func (x *CreateReturnLabelRequest) GetCarrier() string {
+	if x != nil {
+		return x.Carrier
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in order.proto.
+func (x *CreateReturnLabelRequest) GetPickupAddress() string {
+	if x != nil {
+		return x.PickupAddress
+	}
+	return ""
+}
+
+func (x *CreateReturnLabelRequest) GetPickupDate() string {
+	if x != nil {
+		return x.PickupDate
+	}
+	return ""
+}
+
+func (x *CreateReturnLabelRequest) GetPickup() *Address {
+	if x != nil {
+		return x.Pickup
+	}
+	return nil
+}
+
+type CreateReturnLabelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Label         *ReturnLabel           `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReturnLabelResponse) Reset() {
+	*x = CreateReturnLabelResponse{}
+	mi := &file_order_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReturnLabelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReturnLabelResponse) ProtoMessage() {}
+
+func (x *CreateReturnLabelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReturnLabelResponse.ProtoReflect.Descriptor instead.
+func (*CreateReturnLabelResponse) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateReturnLabelResponse) GetLabel() *ReturnLabel {
+	if x != nil {
+		return x.Label
+	}
+	return nil
+}
+
+type ImportOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RowNumber     int32                  `protobuf:"varint,1,opt,name=row_number,json=rowNumber,proto3" json:"row_number,omitempty"` // 원본 CSV 행 번호 (결과 매칭용)
+	Source        string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`                         // ex) "call_center", "marketplace"
+	Order         *InsertOrderRequest    `protobuf:"bytes,3,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportOrdersRequest) Reset() {
+	*x = ImportOrdersRequest{}
+	mi := &file_order_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOrdersRequest) ProtoMessage() {}
+
+func (x *ImportOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ImportOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ImportOrdersRequest) GetRowNumber() int32 {
+	if x != nil {
+		return x.RowNumber
+	}
+	return 0
+}
+
+func (x *ImportOrdersRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ImportOrdersRequest) GetOrder() *InsertOrderRequest {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+type ImportOrderRowResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RowNumber     int32                  `protobuf:"varint,1,opt,name=row_number,json=rowNumber,proto3" json:"row_number,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	OrderId       string                 `protobuf:"bytes,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"` // 성공 시 생성된 주문 ID
+	Errors        []string               `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`                  // 실패 시 검증 오류 목록
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportOrderRowResult) Reset() {
+	*x = ImportOrderRowResult{}
+	mi := &file_order_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportOrderRowResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOrderRowResult) ProtoMessage() {}
+
+func (x *ImportOrderRowResult) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportOrderRowResult.ProtoReflect.Descriptor instead.
+func (*ImportOrderRowResult) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ImportOrderRowResult) GetRowNumber() int32 {
+	if x != nil {
+		return x.RowNumber
+	}
+	return 0
+}
+
+func (x *ImportOrderRowResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ImportOrderRowResult) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ImportOrderRowResult) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type ImportOrdersResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	TotalRows     int32                   `protobuf:"varint,1,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	ImportedCount int32                   `protobuf:"varint,2,opt,name=imported_count,json=importedCount,proto3" json:"imported_count,omitempty"`
+	FailedCount   int32                   `protobuf:"varint,3,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+	Results       []*ImportOrderRowResult `protobuf:"bytes,4,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportOrdersResponse) Reset() {
+	*x = ImportOrdersResponse{}
+	mi := &file_order_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOrdersResponse) ProtoMessage() {}
+
+func (x *ImportOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ImportOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ImportOrdersResponse) GetTotalRows() int32 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *ImportOrdersResponse) GetImportedCount() int32 {
+	if x != nil {
+		return x.ImportedCount
+	}
+	return 0
+}
+
+func (x *ImportOrdersResponse) GetFailedCount() int32 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+func (x *ImportOrdersResponse) GetResults() []*ImportOrderRowResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type GetOrderByIDRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 응답에 포함할 Order 필드, 비어 있으면 전체 필드
+	ReadMask      *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderByIDRequest) Reset() {
+	*x = GetOrderByIDRequest{}
+	mi := &file_order_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderByIDRequest) ProtoMessage() {}
+
+func (x *GetOrderByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderByIDRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderByIDRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetOrderByIDRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetOrderByIDRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+type GetOrderByIDResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderByIDResponse) Reset() {
+	*x = GetOrderByIDResponse{}
+	mi := &file_order_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderByIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderByIDResponse) ProtoMessage() {}
+
+func (x *GetOrderByIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderByIDResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderByIDResponse) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetOrderByIDResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+type GetOrdersByUserRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 조회할 주문 상태 (ex: PAID, SHIPPED), 비어 있으면 전체
+	Statuses []OrderStatus `protobuf:"varint,2,rep,packed,name=statuses,proto3,enum=go.escape.ship.proto.v1.OrderStatus" json:"statuses,omitempty"`
+	// 페이지 크기 (0이면 서버 기본값, 최대 100)
+	PageSize int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// 이전 응답의 next_page_token, 첫 페이지는 비워 둠
+	PageToken string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// 응답에 포함할 Order 필드, 비어 있으면 전체 필드
+	ReadMask      *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrdersByUserRequest) Reset() {
+	*x = GetOrdersByUserRequest{}
+	mi := &file_order_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrdersByUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrdersByUserRequest) ProtoMessage() {}
+
+func (x *GetOrdersByUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrdersByUserRequest.ProtoReflect.Descriptor instead.
+func (*GetOrdersByUserRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetOrdersByUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetOrdersByUserRequest) GetStatuses() []OrderStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *GetOrdersByUserRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetOrdersByUserRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetOrdersByUserRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+type GetOrdersByUserResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Orders []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	// 다음 페이지 토큰, 마지막 페이지면 빈 문자열
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// 상태 조건에 맞는 전체 주문 수
+	TotalCount    int32 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrdersByUserResponse) Reset() {
+	*x = GetOrdersByUserResponse{}
+	mi := &file_order_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrdersByUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrdersByUserResponse) ProtoMessage() {}
+
+func (x *GetOrdersByUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrdersByUserResponse.ProtoReflect.Descriptor instead.
+func (*GetOrdersByUserResponse) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetOrdersByUserResponse) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *GetOrdersByUserResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GetOrdersByUserResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type GetOrdersByIDsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrdersByIDsRequest) Reset() {
+	*x = GetOrdersByIDsRequest{}
+	mi := &file_order_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrdersByIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrdersByIDsRequest) ProtoMessage() {}
+
+func (x *GetOrdersByIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrdersByIDsRequest.ProtoReflect.Descriptor instead.
+func (*GetOrdersByIDsRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetOrdersByIDsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type GetOrdersByIDsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"` // 요청 순서대로, 찾은 주문만 포함
+	NotFoundIds   []string               `protobuf:"bytes,2,rep,name=not_found_ids,json=notFoundIds,proto3" json:"not_found_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrdersByIDsResponse) Reset() {
+	*x = GetOrdersByIDsResponse{}
+	mi := &file_order_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrdersByIDsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrdersByIDsResponse) ProtoMessage() {}
+
+func (x *GetOrdersByIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrdersByIDsResponse.ProtoReflect.Descriptor instead.
+func (*GetOrdersByIDsResponse) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetOrdersByIDsResponse) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *GetOrdersByIDsResponse) GetNotFoundIds() []string {
+	if x != nil {
+		return x.NotFoundIds
+	}
+	return nil
+}
+
+type ArchiveOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BeforeDate    string                 `protobuf:"bytes,1,opt,name=before_date,json=beforeDate,proto3" json:"before_date,omitempty"` // 이 날짜 이전(ordered_at 기준) 주문을 아카이브 (YYYY-MM-DD)
+	BatchSize     int32                  `protobuf:"varint,2,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`   // 0이면 서버 기본값 사용
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveOrdersRequest) Reset() {
+	*x = ArchiveOrdersRequest{}
+	mi := &file_order_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveOrdersRequest) ProtoMessage() {}
+
+func (x *ArchiveOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ArchiveOrdersRequest) GetBeforeDate() string {
+	if x != nil {
+		return x.BeforeDate
+	}
+	return ""
+}
+
+func (x *ArchiveOrdersRequest) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+type ArchiveOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArchivedCount int64                  `protobuf:"varint,1,opt,name=archived_count,json=archivedCount,proto3" json:"archived_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveOrdersResponse) Reset() {
+	*x = ArchiveOrdersResponse{}
+	mi := &file_order_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveOrdersResponse) ProtoMessage() {}
+
+func (x *ArchiveOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ArchiveOrdersResponse) GetArchivedCount() int64 {
+	if x != nil {
+		return x.ArchivedCount
+	}
+	return 0
+}
+
+type GetArchivedOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetArchivedOrderRequest) Reset() {
+	*x = GetArchivedOrderRequest{}
+	mi := &file_order_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetArchivedOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArchivedOrderRequest) ProtoMessage() {}
+
+func (x *GetArchivedOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArchivedOrderRequest.ProtoReflect.Descriptor instead.
+func (*GetArchivedOrderRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetArchivedOrderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetArchivedOrderResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Order *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	// 이전 버전의 RFC3339 문자열 시각, archived_time으로 대체됨
+	//
+	// Deprecated: Marked as deprecated in order.proto.
+	ArchivedAt    string                 `protobuf:"bytes,2,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`
+	ArchivedTime  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=archived_time,json=archivedTime,proto3" json:"archived_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetArchivedOrderResponse) Reset() {
+	*x = GetArchivedOrderResponse{}
+	mi := &file_order_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetArchivedOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArchivedOrderResponse) ProtoMessage() {}
+
+func (x *GetArchivedOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArchivedOrderResponse.ProtoReflect.Descriptor instead.
+func (*GetArchivedOrderResponse) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetArchivedOrderResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+// Deprecated: Marked as deprecated in order.proto.
+func (x *GetArchivedOrderResponse) GetArchivedAt() string {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return ""
+}
+
+func (x *GetArchivedOrderResponse) GetArchivedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedTime
+	}
+	return nil
+}
+
+type QuoteItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductName   string                 `protobuf:"bytes,2,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPrice     int64                  `protobuf:"varint,4,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"` // 협의 단가
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteItem) Reset() {
+	*x = QuoteItem{}
+	mi := &file_order_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteItem) ProtoMessage() {}
+
+func (x *QuoteItem) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteItem.ProtoReflect.Descriptor instead.
+func (*QuoteItem) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *QuoteItem) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *QuoteItem) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *QuoteItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *QuoteItem) GetUnitPrice() int64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+type Quote struct {
+	state                      protoimpl.MessageState `protogen:"open.v1"`
+	Id                         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId                     string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CompanyName                string                 `protobuf:"bytes,3,opt,name=company_name,json=companyName,proto3" json:"company_name,omitempty"`
+	BusinessRegistrationNumber string                 `protobuf:"bytes,4,opt,name=business_registration_number,json=businessRegistrationNumber,proto3" json:"business_registration_number,omitempty"` // 사업자등록번호
+	Items                      []*QuoteItem           `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	TotalPrice                 int64                  `protobuf:"varint,6,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	Status                     QuoteStatus            `protobuf:"varint,7,opt,name=status,proto3,enum=go.escape.ship.proto.v1.QuoteStatus" json:"status,omitempty"`
+	PaymentTerms               *PaymentTerms          `protobuf:"bytes,8,opt,name=payment_terms,json=paymentTerms,proto3" json:"payment_terms,omitempty"`
+	ValidUntil                 string                 `protobuf:"bytes,9,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
+	OrderId                    string                 `protobuf:"bytes,10,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"` // 주문 전환 후 설정
+	// 이전 버전의 RFC3339 문자열 시각, created_time으로 대체됨
+	//
+	// Deprecated: Marked as deprecated in order.proto.
+	CreatedAt     string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedTime   *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Quote) Reset() {
+	*x = Quote{}
+	mi := &file_order_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Quote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Quote) ProtoMessage() {}
+
+func (x *Quote) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Quote.ProtoReflect.Descriptor instead.
+func (*Quote) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Quote) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Quote) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Quote) GetCompanyName() string {
+	if x != nil {
+		return x.CompanyName
+	}
+	return ""
+}
+
+func (x *Quote) GetBusinessRegistrationNumber() string {
+	if x != nil {
+		return x.BusinessRegistrationNumber
+	}
+	return ""
+}
+
+func (x *Quote) GetItems() []*QuoteItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Quote) GetTotalPrice() int64 {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return 0
+}
+
+func (x *Quote) GetStatus() QuoteStatus {
+	if x != nil {
+		return x.Status
+	}
+	return QuoteStatus_QUOTE_STATUS_UNSPECIFIED
+}
+
+func (x *Quote) GetPaymentTerms() *PaymentTerms {
+	if x != nil {
+		return x.PaymentTerms
+	}
+	return nil
+}
+
+func (x *Quote) GetValidUntil() string {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return ""
+}
+
+func (x *Quote) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in order.proto.
+func (x *Quote) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Quote) GetCreatedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTime
+	}
+	return nil
+}
+
+type CreateQuoteRequest struct {
+	state                      protoimpl.MessageState `protogen:"open.v1"`
+	UserId                     string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CompanyName                string                 `protobuf:"bytes,2,opt,name=company_name,json=companyName,proto3" json:"company_name,omitempty"`
+	BusinessRegistrationNumber string                 `protobuf:"bytes,3,opt,name=business_registration_number,json=businessRegistrationNumber,proto3" json:"business_registration_number,omitempty"`
+	Items                      []*QuoteItem           `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	PaymentTerms               *PaymentTerms          `protobuf:"bytes,5,opt,name=payment_terms,json=paymentTerms,proto3" json:"payment_terms,omitempty"`
+	ValidUntil                 string                 `protobuf:"bytes,6,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *CreateQuoteRequest) Reset() {
+	*x = CreateQuoteRequest{}
+	mi := &file_order_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateQuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateQuoteRequest) ProtoMessage() {}
+
+func (x *CreateQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateQuoteRequest.ProtoReflect.Descriptor instead.
+func (*CreateQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CreateQuoteRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateQuoteRequest) GetCompanyName() string {
+	if x != nil {
+		return x.CompanyName
+	}
+	return ""
+}
+
+func (x *CreateQuoteRequest) GetBusinessRegistrationNumber() string {
+	if x != nil {
+		return x.BusinessRegistrationNumber
+	}
+	return ""
+}
+
+func (x *CreateQuoteRequest) GetItems() []*QuoteItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CreateQuoteRequest) GetPaymentTerms() *PaymentTerms {
+	if x != nil {
+		return x.PaymentTerms
+	}
+	return nil
+}
+
+func (x *CreateQuoteRequest) GetValidUntil() string {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return ""
+}
+
+type CreateQuoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quote         *Quote                 `protobuf:"bytes,1,opt,name=quote,proto3" json:"quote,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateQuoteResponse) Reset() {
+	*x = CreateQuoteResponse{}
+	mi := &file_order_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateQuoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateQuoteResponse) ProtoMessage() {}
+
+func (x *CreateQuoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateQuoteResponse.ProtoReflect.Descriptor instead.
+func (*CreateQuoteResponse) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CreateQuoteResponse) GetQuote() *Quote {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+type AcceptQuoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuoteId       string                 `protobuf:"bytes,1,opt,name=quote_id,json=quoteId,proto3" json:"quote_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptQuoteRequest) Reset() {
+	*x = AcceptQuoteRequest{}
+	mi := &file_order_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptQuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptQuoteRequest) ProtoMessage() {}
+
+func (x *AcceptQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptQuoteRequest.ProtoReflect.Descriptor instead.
+func (*AcceptQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *AcceptQuoteRequest) GetQuoteId() string {
+	if x != nil {
+		return x.QuoteId
+	}
+	return ""
+}
+
+type AcceptQuoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quote         *Quote                 `protobuf:"bytes,1,opt,name=quote,proto3" json:"quote,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptQuoteResponse) Reset() {
+	*x = AcceptQuoteResponse{}
+	mi := &file_order_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptQuoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptQuoteResponse) ProtoMessage() {}
+
+func (x *AcceptQuoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptQuoteResponse.ProtoReflect.Descriptor instead.
+func (*AcceptQuoteResponse) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AcceptQuoteResponse) GetQuote() *Quote {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+type ConvertQuoteToOrderRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	QuoteId         string                 `protobuf:"bytes,1,opt,name=quote_id,json=quoteId,proto3" json:"quote_id,omitempty"`
+	ShippingAddress string                 `protobuf:"bytes,2,opt,name=shipping_address,json=shippingAddress,proto3" json:"shipping_address,omitempty"`
+	Memo            string                 `protobuf:"bytes,3,opt,name=memo,proto3" json:"memo,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ConvertQuoteToOrderRequest) Reset() {
+	*x = ConvertQuoteToOrderRequest{}
+	mi := &file_order_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConvertQuoteToOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertQuoteToOrderRequest) ProtoMessage() {}
+
+func (x *ConvertQuoteToOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertQuoteToOrderRequest.ProtoReflect.Descriptor instead.
+func (*ConvertQuoteToOrderRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ConvertQuoteToOrderRequest) GetQuoteId() string {
+	if x != nil {
+		return x.QuoteId
+	}
+	return ""
+}
+
+func (x *ConvertQuoteToOrderRequest) GetShippingAddress() string {
+	if x != nil {
+		return x.ShippingAddress
+	}
+	return ""
+}
+
+func (x *ConvertQuoteToOrderRequest) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+type ConvertQuoteToOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConvertQuoteToOrderResponse) Reset() {
+	*x = ConvertQuoteToOrderResponse{}
+	mi := &file_order_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConvertQuoteToOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertQuoteToOrderResponse) ProtoMessage() {}
+
+func (x *ConvertQuoteToOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertQuoteToOrderResponse.ProtoReflect.Descriptor instead.
+func (*ConvertQuoteToOrderResponse) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ConvertQuoteToOrderResponse) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type EligibilityItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	FlashSaleId   string                 `protobuf:"bytes,2,opt,name=flash_sale_id,json=flashSaleId,proto3" json:"flash_sale_id,omitempty"` // 타임세일 구매일 때 설정
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EligibilityItem) Reset() {
+	*x = EligibilityItem{}
+	mi := &file_order_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EligibilityItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EligibilityItem) ProtoMessage() {}
+
+func (x *EligibilityItem) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EligibilityItem.ProtoReflect.Descriptor instead.
+func (*EligibilityItem) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *EligibilityItem) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *EligibilityItem) GetFlashSaleId() string {
+	if x != nil {
+		return x.FlashSaleId
+	}
+	return ""
+}
+
+func (x *EligibilityItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// 구매 제한 초과 항목
+type PurchaseLimitViolation struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ProductId         string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	FlashSaleId       string                 `protobuf:"bytes,2,opt,name=flash_sale_id,json=flashSaleId,proto3" json:"flash_sale_id,omitempty"`
+	RequestedQuantity int32                  `protobuf:"varint,3,opt,name=requested_quantity,json=requestedQuantity,proto3" json:"requested_quantity,omitempty"`
+	AlreadyPurchased  int32                  `protobuf:"varint,4,opt,name=already_purchased,json=alreadyPurchased,proto3" json:"already_purchased,omitempty"`
+	MaxPerCustomer    int32                  `protobuf:"varint,5,opt,name=max_per_customer,json=maxPerCustomer,proto3" json:"max_per_customer,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PurchaseLimitViolation) Reset() {
+	*x = PurchaseLimitViolation{}
+	mi := &file_order_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurchaseLimitViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseLimitViolation) ProtoMessage() {}
+
+func (x *PurchaseLimitViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseLimitViolation.ProtoReflect.Descriptor instead.
+func (*PurchaseLimitViolation) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *PurchaseLimitViolation) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *PurchaseLimitViolation) GetFlashSaleId() string {
+	if x != nil {
+		return x.FlashSaleId
+	}
+	return ""
+}
+
+func (x *PurchaseLimitViolation) GetRequestedQuantity() int32 {
+	if x != nil {
+		return x.RequestedQuantity
+	}
+	return 0
+}
+
+func (x *PurchaseLimitViolation) GetAlreadyPurchased() int32 {
+	if x != nil {
+		return x.AlreadyPurchased
+	}
+	return 0
+}
+
+func (x *PurchaseLimitViolation) GetMaxPerCustomer() int32 {
+	if x != nil {
+		return x.MaxPerCustomer
+	}
+	return 0
+}
+
+type CheckPurchaseEligibilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Items         []*EligibilityItem     `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckPurchaseEligibilityRequest) Reset() {
+	*x = CheckPurchaseEligibilityRequest{}
+	mi := &file_order_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckPurchaseEligibilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPurchaseEligibilityRequest) ProtoMessage() {}
+
+func (x *CheckPurchaseEligibilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPurchaseEligibilityRequest.ProtoReflect.Descriptor instead.
+func (*CheckPurchaseEligibilityRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CheckPurchaseEligibilityRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CheckPurchaseEligibilityRequest) GetItems() []*EligibilityItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type CheckPurchaseEligibilityResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Eligible      bool                      `protobuf:"varint,1,opt,name=eligible,proto3" json:"eligible,omitempty"`
+	Violations    []*PurchaseLimitViolation `protobuf:"bytes,2,rep,name=violations,proto3" json:"violations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckPurchaseEligibilityResponse) Reset() {
+	*x = CheckPurchaseEligibilityResponse{}
+	mi := &file_order_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckPurchaseEligibilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPurchaseEligibilityResponse) ProtoMessage() {}
+
+func (x *CheckPurchaseEligibilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPurchaseEligibilityResponse.ProtoReflect.Descriptor instead.
+func (*CheckPurchaseEligibilityResponse) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CheckPurchaseEligibilityResponse) GetEligible() bool {
+	if x != nil {
+		return x.Eligible
+	}
+	return false
+}
+
+func (x *CheckPurchaseEligibilityResponse) GetViolations() []*PurchaseLimitViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+type PriceLineInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LineId        string                 `protobuf:"bytes,1,opt,name=line_id,json=lineId,proto3" json:"line_id,omitempty"` // 장바구니/주문 항목 ID (결과 매칭용)
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPrice     *Money                 `protobuf:"bytes,4,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"` // 서버가 수량 구간 가격(Product.UnitPrice)으로 채움, 다른 값을 보내면 INVALID_ARGUMENT
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceLineInput) Reset() {
+	*x = PriceLineInput{}
+	mi := &file_order_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceLineInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceLineInput) ProtoMessage() {}
+
+func (x *PriceLineInput) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceLineInput.ProtoReflect.Descriptor instead.
+func (*PriceLineInput) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *PriceLineInput) GetLineId() string {
+	if x != nil {
+		return x.LineId
+	}
+	return ""
+}
+
+func (x *PriceLineInput) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *PriceLineInput) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PriceLineInput) GetUnitPrice() *Money {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return nil
+}
+
+type PriceOrderRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	UserId      string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Items       []*PriceLineInput      `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	CouponCodes []string               `protobuf:"bytes,3,rep,name=coupon_codes,json=couponCodes,proto3" json:"coupon_codes,omitempty"`
+	ShippingFee *Money                 `protobuf:"bytes,4,opt,name=shipping_fee,json=shippingFee,proto3" json:"shipping_fee,omitempty"`
+	Stage       PricingStage           `protobuf:"varint,5,opt,name=stage,proto3,enum=go.escape.ship.proto.v1.PricingStage" json:"stage,omitempty"`
+	// 이전 버전의 RFC3339 문자열 시각, priced_time으로 대체됨
+	//
+	// Deprecated: Marked as deprecated in order.proto.
+	PricedAt      string                 `protobuf:"bytes,6,opt,name=priced_at,json=pricedAt,proto3" json:"priced_at,omitempty"`
+	PricedTime    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=priced_time,json=pricedTime,proto3" json:"priced_time,omitempty"` // 프로모션 유효 기간 판단 시각, 비어 있으면 현재 시각
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceOrderRequest) Reset() {
+	*x = PriceOrderRequest{}
+	mi := &file_order_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceOrderRequest) ProtoMessage() {}
+
+func (x *PriceOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceOrderRequest.ProtoReflect.Descriptor instead.
+func (*PriceOrderRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *PriceOrderRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PriceOrderRequest) GetItems() []*PriceLineInput {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *PriceOrderRequest) GetCouponCodes() []string {
+	if x != nil {
+		return x.CouponCodes
+	}
+	return nil
+}
+
+func (x *PriceOrderRequest) GetShippingFee() *Money {
+	if x != nil {
+		return x.ShippingFee
+	}
+	return nil
+}
+
+func (x *PriceOrderRequest) GetStage() PricingStage {
+	if x != nil {
+		return x.Stage
+	}
+	return PricingStage_PRICING_STAGE_UNSPECIFIED
+}
+
+// Deprecated: Marked as deprecated in order.proto.
+func (x *PriceOrderRequest) GetPricedAt() string {
+	if x != nil {
+		return x.PricedAt
+	}
+	return ""
+}
+
+func (x *PriceOrderRequest) GetPricedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PricedTime
+	}
+	return nil
+}
+
+// 적용된 프로모션
+type AppliedPromotion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromotionId   string                 `protobuf:"bytes,1,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CouponCode    string                 `protobuf:"bytes,3,opt,name=coupon_code,json=couponCode,proto3" json:"coupon_code,omitempty"` // 쿠폰으로 적용된 경우
+	Discount      *Money                 `protobuf:"bytes,4,opt,name=discount,proto3" json:"discount,omitempty"`                       // 이 프로모션의 총 할인 금액 (항목 배분 합계)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppliedPromotion) Reset() {
+	*x = AppliedPromotion{}
+	mi := &file_order_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppliedPromotion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppliedPromotion) ProtoMessage() {}
+
+func (x *AppliedPromotion) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -406,68 +3811,124 @@ func (x *InsertOrderItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InsertOrderItem.ProtoReflect.Descriptor instead.
-func (*InsertOrderItem) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use AppliedPromotion.ProtoReflect.Descriptor instead.
+func (*AppliedPromotion) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *InsertOrderItem) GetProductId() string {
+func (x *AppliedPromotion) GetPromotionId() string {
 	if x != nil {
-		return x.ProductId
+		return x.PromotionId
 	}
 	return ""
 }
 
-func (x *InsertOrderItem) GetProductName() string {
+func (x *AppliedPromotion) GetName() string {
 	if x != nil {
-		return x.ProductName
+		return x.Name
 	}
 	return ""
 }
 
-func (x *InsertOrderItem) GetProductOptions() string {
+func (x *AppliedPromotion) GetCouponCode() string {
 	if x != nil {
-		return x.ProductOptions
+		return x.CouponCode
 	}
 	return ""
 }
 
-func (x *InsertOrderItem) GetProductPrice() int64 {
+func (x *AppliedPromotion) GetDiscount() *Money {
 	if x != nil {
-		return x.ProductPrice
+		return x.Discount
 	}
-	return 0
+	return nil
 }
 
-func (x *InsertOrderItem) GetQuantity() int32 {
+// 적용되지 않은 쿠폰/프로모션과 사유
+type RejectedPromotion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromotionId   string                 `protobuf:"bytes,1,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
+	CouponCode    string                 `protobuf:"bytes,2,opt,name=coupon_code,json=couponCode,proto3" json:"coupon_code,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"` // ex) "minimum_amount", "expired", "not_combinable"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectedPromotion) Reset() {
+	*x = RejectedPromotion{}
+	mi := &file_order_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectedPromotion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectedPromotion) ProtoMessage() {}
+
+func (x *RejectedPromotion) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[49]
 	if x != nil {
-		return x.Quantity
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-type InsertOrderResponse struct {
+// Deprecated: Use RejectedPromotion.ProtoReflect.Descriptor instead.
+func (*RejectedPromotion) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *RejectedPromotion) GetPromotionId() string {
+	if x != nil {
+		return x.PromotionId
+	}
+	return ""
+}
+
+func (x *RejectedPromotion) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
+func (x *RejectedPromotion) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 프로모션 할인의 항목별 배분
+type DiscountAllocation struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PromotionId   string                 `protobuf:"bytes,1,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InsertOrderResponse) Reset() {
-	*x = InsertOrderResponse{}
-	mi := &file_order_proto_msgTypes[4]
+func (x *DiscountAllocation) Reset() {
+	*x = DiscountAllocation{}
+	mi := &file_order_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InsertOrderResponse) String() string {
+func (x *DiscountAllocation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InsertOrderResponse) ProtoMessage() {}
+func (*DiscountAllocation) ProtoMessage() {}
 
-func (x *InsertOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[4]
+func (x *DiscountAllocation) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -478,39 +3939,54 @@ func (x *InsertOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InsertOrderResponse.ProtoReflect.Descriptor instead.
-func (*InsertOrderResponse) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use DiscountAllocation.ProtoReflect.Descriptor instead.
+func (*DiscountAllocation) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *InsertOrderResponse) GetId() string {
+func (x *DiscountAllocation) GetPromotionId() string {
 	if x != nil {
-		return x.Id
+		return x.PromotionId
 	}
 	return ""
 }
 
-type GetAllOrdersRequest struct {
+func (x *DiscountAllocation) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+type PricedLine struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	LineId        string                 `protobuf:"bytes,1,opt,name=line_id,json=lineId,proto3" json:"line_id,omitempty"`
+	UnitPrice     *Money                 `protobuf:"bytes,2,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	Subtotal      *Money                 `protobuf:"bytes,3,opt,name=subtotal,proto3" json:"subtotal,omitempty"` // unit_price × quantity
+	Allocations   []*DiscountAllocation  `protobuf:"bytes,4,rep,name=allocations,proto3" json:"allocations,omitempty"`
+	Discount      *Money                 `protobuf:"bytes,5,opt,name=discount,proto3" json:"discount,omitempty"` // allocations 합계
+	Total         *Money                 `protobuf:"bytes,6,opt,name=total,proto3" json:"total,omitempty"`       // subtotal - discount
+	Quantity      int32                  `protobuf:"varint,7,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	TaxFree       bool                   `protobuf:"varint,8,opt,name=tax_free,json=taxFree,proto3" json:"tax_free,omitempty"` // 면세 품목 (도서, 농산물 등), 환불 시 부가세 계산에서 제외
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllOrdersRequest) Reset() {
-	*x = GetAllOrdersRequest{}
-	mi := &file_order_proto_msgTypes[5]
+func (x *PricedLine) Reset() {
+	*x = PricedLine{}
+	mi := &file_order_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllOrdersRequest) String() string {
+func (x *PricedLine) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllOrdersRequest) ProtoMessage() {}
+func (*PricedLine) ProtoMessage() {}
 
-func (x *GetAllOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[5]
+func (x *PricedLine) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -521,33 +3997,103 @@ func (x *GetAllOrdersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllOrdersRequest.ProtoReflect.Descriptor instead.
-func (*GetAllOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use PricedLine.ProtoReflect.Descriptor instead.
+func (*PricedLine) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{51}
 }
 
-type GetAllOrdersResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+func (x *PricedLine) GetLineId() string {
+	if x != nil {
+		return x.LineId
+	}
+	return ""
+}
+
+func (x *PricedLine) GetUnitPrice() *Money {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return nil
+}
+
+func (x *PricedLine) GetSubtotal() *Money {
+	if x != nil {
+		return x.Subtotal
+	}
+	return nil
+}
+
+func (x *PricedLine) GetAllocations() []*DiscountAllocation {
+	if x != nil {
+		return x.Allocations
+	}
+	return nil
+}
+
+func (x *PricedLine) GetDiscount() *Money {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+func (x *PricedLine) GetTotal() *Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *PricedLine) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PricedLine) GetTaxFree() bool {
+	if x != nil {
+		return x.TaxFree
+	}
+	return false
+}
+
+type PriceOrderResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Lines              []*PricedLine          `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"` // 요청 항목 순서대로
+	AppliedPromotions  []*AppliedPromotion    `protobuf:"bytes,2,rep,name=applied_promotions,json=appliedPromotions,proto3" json:"applied_promotions,omitempty"`
+	RejectedPromotions []*RejectedPromotion   `protobuf:"bytes,3,rep,name=rejected_promotions,json=rejectedPromotions,proto3" json:"rejected_promotions,omitempty"`
+	Subtotal           *Money                 `protobuf:"bytes,4,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	DiscountTotal      *Money                 `protobuf:"bytes,5,opt,name=discount_total,json=discountTotal,proto3" json:"discount_total,omitempty"` // lines의 discount 합계
+	ShippingFee        *Money                 `protobuf:"bytes,6,opt,name=shipping_fee,json=shippingFee,proto3" json:"shipping_fee,omitempty"`
+	Total              *Money                 `protobuf:"bytes,7,opt,name=total,proto3" json:"total,omitempty"` // subtotal - discount_total + shipping_fee
+	// 10원 단위 배분 후 항목에 나누지 못한 할인 잔액 (discount_total에 포함되지 않음)
+	RoundingRemainder *Money `protobuf:"bytes,8,opt,name=rounding_remainder,json=roundingRemainder,proto3" json:"rounding_remainder,omitempty"`
+	RulesVersion      string `protobuf:"bytes,9,opt,name=rules_version,json=rulesVersion,proto3" json:"rules_version,omitempty"` // 적용된 프로모션 규칙 버전 (환불 시 재현용)
+	// 이전 버전의 RFC3339 문자열 시각, priced_time으로 대체됨
+	//
+	// Deprecated: Marked as deprecated in order.proto.
+	PricedAt      string                 `protobuf:"bytes,10,opt,name=priced_at,json=pricedAt,proto3" json:"priced_at,omitempty"`
+	PricedTime    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=priced_time,json=pricedTime,proto3" json:"priced_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllOrdersResponse) Reset() {
-	*x = GetAllOrdersResponse{}
-	mi := &file_order_proto_msgTypes[6]
+func (x *PriceOrderResponse) Reset() {
+	*x = PriceOrderResponse{}
+	mi := &file_order_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllOrdersResponse) String() string {
+func (x *PriceOrderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllOrdersResponse) ProtoMessage() {}
+func (*PriceOrderResponse) ProtoMessage() {}
 
-func (x *GetAllOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[6]
+func (x *PriceOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -558,14 +4104,85 @@ func (x *GetAllOrdersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllOrdersResponse.ProtoReflect.Descriptor instead.
-func (*GetAllOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use PriceOrderResponse.ProtoReflect.Descriptor instead.
+func (*PriceOrderResponse) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *GetAllOrdersResponse) GetOrders() []*Order {
+func (x *PriceOrderResponse) GetLines() []*PricedLine {
 	if x != nil {
-		return x.Orders
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *PriceOrderResponse) GetAppliedPromotions() []*AppliedPromotion {
+	if x != nil {
+		return x.AppliedPromotions
+	}
+	return nil
+}
+
+func (x *PriceOrderResponse) GetRejectedPromotions() []*RejectedPromotion {
+	if x != nil {
+		return x.RejectedPromotions
+	}
+	return nil
+}
+
+func (x *PriceOrderResponse) GetSubtotal() *Money {
+	if x != nil {
+		return x.Subtotal
+	}
+	return nil
+}
+
+func (x *PriceOrderResponse) GetDiscountTotal() *Money {
+	if x != nil {
+		return x.DiscountTotal
+	}
+	return nil
+}
+
+func (x *PriceOrderResponse) GetShippingFee() *Money {
+	if x != nil {
+		return x.ShippingFee
+	}
+	return nil
+}
+
+func (x *PriceOrderResponse) GetTotal() *Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *PriceOrderResponse) GetRoundingRemainder() *Money {
+	if x != nil {
+		return x.RoundingRemainder
+	}
+	return nil
+}
+
+func (x *PriceOrderResponse) GetRulesVersion() string {
+	if x != nil {
+		return x.RulesVersion
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in order.proto.
+func (x *PriceOrderResponse) GetPricedAt() string {
+	if x != nil {
+		return x.PricedAt
+	}
+	return ""
+}
+
+func (x *PriceOrderResponse) GetPricedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PricedTime
 	}
 	return nil
 }
@@ -574,61 +4191,397 @@ var File_order_proto protoreflect.FileDescriptor
 
 const file_order_proto_rawDesc = "" +
 	"\n" +
-	"\vorder.proto\x12\x17go.escape.ship.proto.v1\x1a\x1cgoogle/api/annotations.proto\"\xa3\x03\n" +
+	"\vorder.proto\x12\x17go.escape.ship.proto.v1\x1a\fcommon.proto\x1a\x1cgoogle/api/annotations.proto\x1a\rproduct.proto\x1a\x0eshipping.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc4\b\n" +
 	"\x05Order\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12!\n" +
-	"\forder_number\x18\x03 \x01(\tR\vorderNumber\x12\x16\n" +
-	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1f\n" +
+	"\forder_number\x18\x03 \x01(\tR\vorderNumber\x12\x1a\n" +
+	"\x06status\x18\x04 \x01(\tB\x02\x18\x01R\x06status\x12\x1f\n" +
 	"\vtotal_price\x18\x05 \x01(\x03R\n" +
 	"totalPrice\x12\x1a\n" +
 	"\bquantity\x18\x06 \x01(\x05R\bquantity\x12%\n" +
-	"\x0epayment_method\x18\a \x01(\tR\rpaymentMethod\x12!\n" +
-	"\fshipping_fee\x18\b \x01(\x05R\vshippingFee\x12)\n" +
-	"\x10shipping_address\x18\t \x01(\tR\x0fshippingAddress\x12\x1d\n" +
+	"\x0epayment_method\x18\a \x01(\tR\rpaymentMethod\x12&\n" +
+	"\fshipping_fee\x18\b \x01(\x05H\x00R\vshippingFee\x88\x01\x01\x12-\n" +
+	"\x10shipping_address\x18\t \x01(\tB\x02\x18\x01R\x0fshippingAddress\x12!\n" +
 	"\n" +
 	"ordered_at\x18\n" +
-	" \x01(\tR\torderedAt\x12\x17\n" +
-	"\apaid_at\x18\v \x01(\tR\x06paidAt\x12\x12\n" +
+	" \x01(\tB\x02\x18\x01R\torderedAt\x12\x1b\n" +
+	"\apaid_at\x18\v \x01(\tB\x02\x18\x01R\x06paidAt\x12\x12\n" +
 	"\x04memo\x18\f \x01(\tR\x04memo\x128\n" +
-	"\x05items\x18\r \x03(\v2\".go.escape.ship.proto.v1.OrderItemR\x05items\"\xb9\x01\n" +
+	"\x05items\x18\r \x03(\v2\".go.escape.ship.proto.v1.OrderItemR\x05items\x12E\n" +
+	"\acustoms\x18\x0e \x01(\v2+.go.escape.ship.proto.v1.CustomsDeclarationR\acustoms\x123\n" +
+	"\x02fx\x18\x0f \x01(\v2#.go.escape.ship.proto.v1.FxSnapshotR\x02fx\x12J\n" +
+	"\rpayment_terms\x18\x10 \x01(\v2%.go.escape.ship.proto.v1.PaymentTermsR\fpaymentTerms\x12G\n" +
+	"\forder_status\x18\x11 \x01(\x0e2$.go.escape.ship.proto.v1.OrderStatusR\vorderStatus\x129\n" +
+	"\arefunds\x18\x12 \x03(\v2\x1f.go.escape.ship.proto.v1.RefundR\arefunds\x12G\n" +
+	"\x0frefunded_amount\x18\x13 \x01(\v2\x1e.go.escape.ship.proto.v1.MoneyR\x0erefundedAmount\x12K\n" +
+	"\x10delivery_address\x18\x14 \x01(\v2 .go.escape.ship.proto.v1.AddressR\x0fdeliveryAddress\x12=\n" +
+	"\fordered_time\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampR\vorderedTime\x127\n" +
+	"\tpaid_time\x18\x16 \x01(\v2\x1a.google.protobuf.TimestampR\bpaidTime\x12\x1f\n" +
+	"\vpayment_cid\x18\x17 \x01(\tR\n" +
+	"paymentCidB\x0f\n" +
+	"\r_shipping_fee\"\x84\x01\n" +
+	"\n" +
+	"RefundItem\x12\"\n" +
+	"\rorder_item_id\x18\x01 \x01(\tR\vorderItemId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x126\n" +
+	"\x06amount\x18\x03 \x01(\v2\x1e.go.escape.ship.proto.v1.MoneyR\x06amount\"\x84\x06\n" +
+	"\x06Refund\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12=\n" +
+	"\x06status\x18\x03 \x01(\x0e2%.go.escape.ship.proto.v1.RefundStatusR\x06status\x126\n" +
+	"\x06amount\x18\x04 \x01(\v2\x1e.go.escape.ship.proto.v1.MoneyR\x06amount\x12F\n" +
+	"\x0ftax_free_amount\x18\x05 \x01(\v2\x1e.go.escape.ship.proto.v1.MoneyR\rtaxFreeAmount\x12=\n" +
+	"\n" +
+	"vat_amount\x18\x06 \x01(\v2\x1e.go.escape.ship.proto.v1.MoneyR\tvatAmount\x129\n" +
+	"\x05items\x18\a \x03(\v2#.go.escape.ship.proto.v1.RefundItemR\x05items\x12\x16\n" +
+	"\x06reason\x18\b \x01(\tR\x06reason\x12(\n" +
+	"\x10partner_order_id\x18\t \x01(\tR\x0epartnerOrderId\x12%\n" +
+	"\x0efailure_reason\x18\n" +
+	" \x01(\tR\rfailureReason\x12%\n" +
+	"\frequested_at\x18\v \x01(\tB\x02\x18\x01R\vrequestedAt\x12%\n" +
+	"\fcompleted_at\x18\f \x01(\tB\x02\x18\x01R\vcompletedAt\x12G\n" +
+	"\x0fshipping_amount\x18\r \x01(\v2\x1e.go.escape.ship.proto.v1.MoneyR\x0eshippingAmount\x12A\n" +
+	"\x0erequested_time\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\rrequestedTime\x12A\n" +
+	"\x0ecompleted_time\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\rcompletedTime\x12\x10\n" +
+	"\x03cid\x18\x10 \x01(\tR\x03cid\"D\n" +
+	"\fPaymentTerms\x12\x19\n" +
+	"\bnet_days\x18\x01 \x01(\x05R\anetDays\x12\x19\n" +
+	"\bdue_date\x18\x02 \x01(\tR\adueDate\"\x89\x02\n" +
+	"\x12CustomsDeclaration\x122\n" +
+	"\x15personal_customs_code\x18\x01 \x01(\tR\x13personalCustomsCode\x12/\n" +
+	"\x13destination_country\x18\x02 \x01(\tR\x12destinationCountry\x12+\n" +
+	"\x11declared_currency\x18\x03 \x01(\tR\x10declaredCurrency\x12%\n" +
+	"\x0edeclared_value\x18\x04 \x01(\x03R\rdeclaredValue\x12:\n" +
+	"\x05items\x18\x05 \x03(\v2$.go.escape.ship.proto.v1.CustomsItemR\x05items\"\xd1\x01\n" +
+	"\vCustomsItem\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x17\n" +
+	"\ahs_code\x18\x02 \x01(\tR\x06hsCode\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12%\n" +
+	"\x0edeclared_value\x18\x05 \x01(\x03R\rdeclaredValue\x12%\n" +
+	"\x0eorigin_country\x18\x06 \x01(\tR\roriginCountry\"\xf0\x02\n" +
 	"\tOrderItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x03 \x01(\tR\tproductId\x12!\n" +
-	"\fproduct_name\x18\x04 \x01(\tR\vproductName\x12#\n" +
-	"\rproduct_price\x18\x05 \x01(\x03R\fproductPrice\x12\x1a\n" +
-	"\bquantity\x18\x06 \x01(\x05R\bquantity\"\x87\x03\n" +
+	"\fproduct_name\x18\x04 \x01(\tR\vproductName\x12'\n" +
+	"\rproduct_price\x18\x05 \x01(\x03B\x02\x18\x01R\fproductPrice\x12\x1a\n" +
+	"\bquantity\x18\x06 \x01(\x05R\bquantity\x12\x1b\n" +
+	"\tbundle_id\x18\a \x01(\tR\bbundleId\x12U\n" +
+	"\x11bundle_components\x18\b \x03(\v2(.go.escape.ship.proto.v1.BundleComponentR\x10bundleComponents\x12=\n" +
+	"\n" +
+	"unit_price\x18\t \x01(\v2\x1e.go.escape.ship.proto.v1.MoneyR\tunitPrice\"\xe1\x06\n" +
 	"\x12InsertOrderRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
-	"\forder_number\x18\x02 \x01(\tR\vorderNumber\x12\x16\n" +
-	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1f\n" +
+	"\forder_number\x18\x02 \x01(\tR\vorderNumber\x12\x1a\n" +
+	"\x06status\x18\x03 \x01(\tB\x02\x18\x01R\x06status\x12\x1f\n" +
 	"\vtotal_price\x18\x04 \x01(\x03R\n" +
 	"totalPrice\x12\x1a\n" +
 	"\bquantity\x18\x05 \x01(\x05R\bquantity\x12%\n" +
-	"\x0epayment_method\x18\x06 \x01(\tR\rpaymentMethod\x12!\n" +
-	"\fshipping_fee\x18\a \x01(\x05R\vshippingFee\x12)\n" +
-	"\x10shipping_address\x18\b \x01(\tR\x0fshippingAddress\x12\x17\n" +
-	"\apaid_at\x18\t \x01(\tR\x06paidAt\x12\x12\n" +
+	"\x0epayment_method\x18\x06 \x01(\tR\rpaymentMethod\x12&\n" +
+	"\fshipping_fee\x18\a \x01(\x05H\x00R\vshippingFee\x88\x01\x01\x12-\n" +
+	"\x10shipping_address\x18\b \x01(\tB\x02\x18\x01R\x0fshippingAddress\x12\x1b\n" +
+	"\apaid_at\x18\t \x01(\tB\x02\x18\x01R\x06paidAt\x12\x12\n" +
 	"\x04memo\x18\n" +
 	" \x01(\tR\x04memo\x12>\n" +
-	"\x05items\x18\f \x03(\v2(.go.escape.ship.proto.v1.InsertOrderItemR\x05items\"\xbd\x01\n" +
+	"\x05items\x18\f \x03(\v2(.go.escape.ship.proto.v1.InsertOrderItemR\x05items\x12E\n" +
+	"\acustoms\x18\r \x01(\v2+.go.escape.ship.proto.v1.CustomsDeclarationR\acustoms\x123\n" +
+	"\x02fx\x18\x0e \x01(\v2#.go.escape.ship.proto.v1.FxSnapshotR\x02fx\x12B\n" +
+	"\x06device\x18\x0f \x01(\v2*.go.escape.ship.proto.v1.DeviceFingerprintR\x06device\x12G\n" +
+	"\forder_status\x18\x10 \x01(\x0e2$.go.escape.ship.proto.v1.OrderStatusR\vorderStatus\x12K\n" +
+	"\x10delivery_address\x18\x11 \x01(\v2 .go.escape.ship.proto.v1.AddressR\x0fdeliveryAddress\x12'\n" +
+	"\x0fidempotency_key\x18\x12 \x01(\tR\x0eidempotencyKey\x127\n" +
+	"\tpaid_time\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\bpaidTimeB\x0f\n" +
+	"\r_shipping_fee\"\xda\x01\n" +
 	"\x0fInsertOrderItem\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\x12!\n" +
 	"\fproduct_name\x18\x02 \x01(\tR\vproductName\x12'\n" +
 	"\x0fproduct_options\x18\x03 \x01(\tR\x0eproductOptions\x12#\n" +
 	"\rproduct_price\x18\x04 \x01(\x03R\fproductPrice\x12\x1a\n" +
-	"\bquantity\x18\x05 \x01(\x05R\bquantity\"%\n" +
+	"\bquantity\x18\x05 \x01(\x05R\bquantity\x12\x1b\n" +
+	"\tbundle_id\x18\x06 \x01(\tR\bbundleId\"%\n" +
 	"\x13InsertOrderResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x15\n" +
-	"\x13GetAllOrdersRequest\"N\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x8a\x01\n" +
+	"\x13GetAllOrdersRequest\x127\n" +
+	"\tread_mask\x18\x01 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\".\n" +
+	"\x11WatchOrderRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\"\xf8\x02\n" +
+	"\x10OrderStatusEvent\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12<\n" +
+	"\x06status\x18\x02 \x01(\x0e2$.go.escape.ship.proto.v1.OrderStatusR\x06status\x12M\n" +
+	"\x0fprevious_status\x18\x03 \x01(\x0e2$.go.escape.ship.proto.v1.OrderStatusR\x0epreviousStatus\x12!\n" +
+	"\n" +
+	"changed_at\x18\x04 \x01(\tB\x02\x18\x01R\tchangedAt\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12B\n" +
+	"\btracking\x18\x06 \x01(\v2&.go.escape.ship.proto.v1.TrackingEventR\btracking\x12=\n" +
+	"\fchanged_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vchangedTime\"p\n" +
+	"\x12CancelOrderRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12'\n" +
+	"\x0fidempotency_key\x18\x03 \x01(\tR\x0eidempotencyKey\"\x84\x01\n" +
+	"\x13CancelOrderResponse\x124\n" +
+	"\x05order\x18\x01 \x01(\v2\x1e.go.escape.ship.proto.v1.OrderR\x05order\x127\n" +
+	"\x06refund\x18\x02 \x01(\v2\x1f.go.escape.ship.proto.v1.RefundR\x06refund\"\xab\x02\n" +
+	"\x12RefundOrderRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x129\n" +
+	"\x05items\x18\x02 \x03(\v2#.go.escape.ship.proto.v1.RefundItemR\x05items\x126\n" +
+	"\x06amount\x18\x03 \x01(\v2\x1e.go.escape.ship.proto.v1.MoneyR\x06amount\x12F\n" +
+	"\x0ftax_free_amount\x18\x04 \x01(\v2\x1e.go.escape.ship.proto.v1.MoneyR\rtaxFreeAmount\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12'\n" +
+	"\x0fidempotency_key\x18\x06 \x01(\tR\x0eidempotencyKey\"\x84\x01\n" +
+	"\x13RefundOrderResponse\x124\n" +
+	"\x05order\x18\x01 \x01(\v2\x1e.go.escape.ship.proto.v1.OrderR\x05order\x127\n" +
+	"\x06refund\x18\x02 \x01(\v2\x1f.go.escape.ship.proto.v1.RefundR\x06refund\"\x97\x01\n" +
 	"\x14GetAllOrdersResponse\x126\n" +
-	"\x06orders\x18\x01 \x03(\v2\x1e.go.escape.ship.proto.v1.OrderR\x06orders2\x96\x02\n" +
+	"\x06orders\x18\x01 \x03(\v2\x1e.go.escape.ship.proto.v1.OrderR\x06orders\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"\xf9\x02\n" +
+	"\vReturnLabel\x12\x1b\n" +
+	"\treturn_id\x18\x01 \x01(\tR\breturnId\x12\x18\n" +
+	"\acarrier\x18\x02 \x01(\tR\acarrier\x12'\n" +
+	"\x0ftracking_number\x18\x03 \x01(\tR\x0etrackingNumber\x12\x1b\n" +
+	"\tlabel_url\x18\x04 \x01(\tR\blabelUrl\x12*\n" +
+	"\x11pickup_booking_id\x18\x05 \x01(\tR\x0fpickupBookingId\x12\x1f\n" +
+	"\vpickup_date\x18\x06 \x01(\tR\n" +
+	"pickupDate\x12!\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tB\x02\x18\x01R\tcreatedAt\x12>\n" +
+	"\x06events\x18\b \x03(\v2&.go.escape.ship.proto.v1.TrackingEventR\x06events\x12=\n" +
+	"\fcreated_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedTime\"\xd7\x01\n" +
+	"\x18CreateReturnLabelRequest\x12\x1b\n" +
+	"\treturn_id\x18\x01 \x01(\tR\breturnId\x12\x18\n" +
+	"\acarrier\x18\x02 \x01(\tR\acarrier\x12)\n" +
+	"\x0epickup_address\x18\x03 \x01(\tB\x02\x18\x01R\rpickupAddress\x12\x1f\n" +
+	"\vpickup_date\x18\x04 \x01(\tR\n" +
+	"pickupDate\x128\n" +
+	"\x06pickup\x18\x05 \x01(\v2 .go.escape.ship.proto.v1.AddressR\x06pickup\"W\n" +
+	"\x19CreateReturnLabelResponse\x12:\n" +
+	"\x05label\x18\x01 \x01(\v2$.go.escape.ship.proto.v1.ReturnLabelR\x05label\"\x8f\x01\n" +
+	"\x13ImportOrdersRequest\x12\x1d\n" +
+	"\n" +
+	"row_number\x18\x01 \x01(\x05R\trowNumber\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12A\n" +
+	"\x05order\x18\x03 \x01(\v2+.go.escape.ship.proto.v1.InsertOrderRequestR\x05order\"\x82\x01\n" +
+	"\x14ImportOrderRowResult\x12\x1d\n" +
+	"\n" +
+	"row_number\x18\x01 \x01(\x05R\trowNumber\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x19\n" +
+	"\border_id\x18\x03 \x01(\tR\aorderId\x12\x16\n" +
+	"\x06errors\x18\x04 \x03(\tR\x06errors\"\xc8\x01\n" +
+	"\x14ImportOrdersResponse\x12\x1d\n" +
+	"\n" +
+	"total_rows\x18\x01 \x01(\x05R\ttotalRows\x12%\n" +
+	"\x0eimported_count\x18\x02 \x01(\x05R\rimportedCount\x12!\n" +
+	"\ffailed_count\x18\x03 \x01(\x05R\vfailedCount\x12G\n" +
+	"\aresults\x18\x04 \x03(\v2-.go.escape.ship.proto.v1.ImportOrderRowResultR\aresults\"^\n" +
+	"\x13GetOrderByIDRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x127\n" +
+	"\tread_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\"L\n" +
+	"\x14GetOrderByIDResponse\x124\n" +
+	"\x05order\x18\x01 \x01(\v2\x1e.go.escape.ship.proto.v1.OrderR\x05order\"\xe8\x01\n" +
+	"\x16GetOrdersByUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12@\n" +
+	"\bstatuses\x18\x02 \x03(\x0e2$.go.escape.ship.proto.v1.OrderStatusR\bstatuses\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\x127\n" +
+	"\tread_mask\x18\x05 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\"\x9a\x01\n" +
+	"\x17GetOrdersByUserResponse\x126\n" +
+	"\x06orders\x18\x01 \x03(\v2\x1e.go.escape.ship.proto.v1.OrderR\x06orders\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\")\n" +
+	"\x15GetOrdersByIDsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"t\n" +
+	"\x16GetOrdersByIDsResponse\x126\n" +
+	"\x06orders\x18\x01 \x03(\v2\x1e.go.escape.ship.proto.v1.OrderR\x06orders\x12\"\n" +
+	"\rnot_found_ids\x18\x02 \x03(\tR\vnotFoundIds\"V\n" +
+	"\x14ArchiveOrdersRequest\x12\x1f\n" +
+	"\vbefore_date\x18\x01 \x01(\tR\n" +
+	"beforeDate\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x02 \x01(\x05R\tbatchSize\">\n" +
+	"\x15ArchiveOrdersResponse\x12%\n" +
+	"\x0earchived_count\x18\x01 \x01(\x03R\rarchivedCount\")\n" +
+	"\x17GetArchivedOrderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xb6\x01\n" +
+	"\x18GetArchivedOrderResponse\x124\n" +
+	"\x05order\x18\x01 \x01(\v2\x1e.go.escape.ship.proto.v1.OrderR\x05order\x12#\n" +
+	"\varchived_at\x18\x02 \x01(\tB\x02\x18\x01R\n" +
+	"archivedAt\x12?\n" +
+	"\rarchived_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\farchivedTime\"\x88\x01\n" +
+	"\tQuoteItem\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12!\n" +
+	"\fproduct_name\x18\x02 \x01(\tR\vproductName\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x1d\n" +
+	"\n" +
+	"unit_price\x18\x04 \x01(\x03R\tunitPrice\"\x98\x04\n" +
+	"\x05Quote\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12!\n" +
+	"\fcompany_name\x18\x03 \x01(\tR\vcompanyName\x12@\n" +
+	"\x1cbusiness_registration_number\x18\x04 \x01(\tR\x1abusinessRegistrationNumber\x128\n" +
+	"\x05items\x18\x05 \x03(\v2\".go.escape.ship.proto.v1.QuoteItemR\x05items\x12\x1f\n" +
+	"\vtotal_price\x18\x06 \x01(\x03R\n" +
+	"totalPrice\x12<\n" +
+	"\x06status\x18\a \x01(\x0e2$.go.escape.ship.proto.v1.QuoteStatusR\x06status\x12J\n" +
+	"\rpayment_terms\x18\b \x01(\v2%.go.escape.ship.proto.v1.PaymentTermsR\fpaymentTerms\x12\x1f\n" +
+	"\vvalid_until\x18\t \x01(\tR\n" +
+	"validUntil\x12\x19\n" +
+	"\border_id\x18\n" +
+	" \x01(\tR\aorderId\x12!\n" +
+	"\n" +
+	"created_at\x18\v \x01(\tB\x02\x18\x01R\tcreatedAt\x12=\n" +
+	"\fcreated_time\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedTime\"\xb9\x02\n" +
+	"\x12CreateQuoteRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
+	"\fcompany_name\x18\x02 \x01(\tR\vcompanyName\x12@\n" +
+	"\x1cbusiness_registration_number\x18\x03 \x01(\tR\x1abusinessRegistrationNumber\x128\n" +
+	"\x05items\x18\x04 \x03(\v2\".go.escape.ship.proto.v1.QuoteItemR\x05items\x12J\n" +
+	"\rpayment_terms\x18\x05 \x01(\v2%.go.escape.ship.proto.v1.PaymentTermsR\fpaymentTerms\x12\x1f\n" +
+	"\vvalid_until\x18\x06 \x01(\tR\n" +
+	"validUntil\"K\n" +
+	"\x13CreateQuoteResponse\x124\n" +
+	"\x05quote\x18\x01 \x01(\v2\x1e.go.escape.ship.proto.v1.QuoteR\x05quote\"/\n" +
+	"\x12AcceptQuoteRequest\x12\x19\n" +
+	"\bquote_id\x18\x01 \x01(\tR\aquoteId\"K\n" +
+	"\x13AcceptQuoteResponse\x124\n" +
+	"\x05quote\x18\x01 \x01(\v2\x1e.go.escape.ship.proto.v1.QuoteR\x05quote\"v\n" +
+	"\x1aConvertQuoteToOrderRequest\x12\x19\n" +
+	"\bquote_id\x18\x01 \x01(\tR\aquoteId\x12)\n" +
+	"\x10shipping_address\x18\x02 \x01(\tR\x0fshippingAddress\x12\x12\n" +
+	"\x04memo\x18\x03 \x01(\tR\x04memo\"8\n" +
+	"\x1bConvertQuoteToOrderResponse\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\"p\n" +
+	"\x0fEligibilityItem\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\"\n" +
+	"\rflash_sale_id\x18\x02 \x01(\tR\vflashSaleId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\"\xe1\x01\n" +
+	"\x16PurchaseLimitViolation\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\"\n" +
+	"\rflash_sale_id\x18\x02 \x01(\tR\vflashSaleId\x12-\n" +
+	"\x12requested_quantity\x18\x03 \x01(\x05R\x11requestedQuantity\x12+\n" +
+	"\x11already_purchased\x18\x04 \x01(\x05R\x10alreadyPurchased\x12(\n" +
+	"\x10max_per_customer\x18\x05 \x01(\x05R\x0emaxPerCustomer\"z\n" +
+	"\x1fCheckPurchaseEligibilityRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12>\n" +
+	"\x05items\x18\x02 \x03(\v2(.go.escape.ship.proto.v1.EligibilityItemR\x05items\"\x8f\x01\n" +
+	" CheckPurchaseEligibilityResponse\x12\x1a\n" +
+	"\beligible\x18\x01 \x01(\bR\beligible\x12O\n" +
+	"\n" +
+	"violations\x18\x02 \x03(\v2/.go.escape.ship.proto.v1.PurchaseLimitViolationR\n" +
+	"violations\"\xa3\x01\n" +
+	"\x0ePriceLineInput\x12\x17\n" +
+	"\aline_id\x18\x01 \x01(\tR\x06lineId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12=\n" +
+	"\n" +
+	"unit_price\x18\x04 \x01(\v2\x1e.go.escape.ship.proto.v1.MoneyR\tunitPrice\"\xec\x02\n" +
+	"\x11PriceOrderRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12=\n" +
+	"\x05items\x18\x02 \x03(\v2'.go.escape.ship.proto.v1.PriceLineInputR\x05items\x12!\n" +
+	"\fcoupon_codes\x18\x03 \x03(\tR\vcouponCodes\x12A\n" +
+	"\fshipping_fee\x18\x04 \x01(\v2\x1e.go.escape.ship.proto.v1.MoneyR\vshippingFee\x12;\n" +
+	"\x05stage\x18\x05 \x01(\x0e2%.go.escape.ship.proto.v1.PricingStageR\x05stage\x12\x1f\n" +
+	"\tpriced_at\x18\x06 \x01(\tB\x02\x18\x01R\bpricedAt\x12;\n" +
+	"\vpriced_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"pricedTime\"\xa6\x01\n" +
+	"\x10AppliedPromotion\x12!\n" +
+	"\fpromotion_id\x18\x01 \x01(\tR\vpromotionId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1f\n" +
+	"\vcoupon_code\x18\x03 \x01(\tR\n" +
+	"couponCode\x12:\n" +
+	"\bdiscount\x18\x04 \x01(\v2\x1e.go.escape.ship.proto.v1.MoneyR\bdiscount\"o\n" +
+	"\x11RejectedPromotion\x12!\n" +
+	"\fpromotion_id\x18\x01 \x01(\tR\vpromotionId\x12\x1f\n" +
+	"\vcoupon_code\x18\x02 \x01(\tR\n" +
+	"couponCode\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"o\n" +
+	"\x12DiscountAllocation\x12!\n" +
+	"\fpromotion_id\x18\x01 \x01(\tR\vpromotionId\x126\n" +
+	"\x06amount\x18\x02 \x01(\v2\x1e.go.escape.ship.proto.v1.MoneyR\x06amount\"\x98\x03\n" +
+	"\n" +
+	"PricedLine\x12\x17\n" +
+	"\aline_id\x18\x01 \x01(\tR\x06lineId\x12=\n" +
+	"\n" +
+	"unit_price\x18\x02 \x01(\v2\x1e.go.escape.ship.proto.v1.MoneyR\tunitPrice\x12:\n" +
+	"\bsubtotal\x18\x03 \x01(\v2\x1e.go.escape.ship.proto.v1.MoneyR\bsubtotal\x12M\n" +
+	"\vallocations\x18\x04 \x03(\v2+.go.escape.ship.proto.v1.DiscountAllocationR\vallocations\x12:\n" +
+	"\bdiscount\x18\x05 \x01(\v2\x1e.go.escape.ship.proto.v1.MoneyR\bdiscount\x124\n" +
+	"\x05total\x18\x06 \x01(\v2\x1e.go.escape.ship.proto.v1.MoneyR\x05total\x12\x1a\n" +
+	"\bquantity\x18\a \x01(\x05R\bquantity\x12\x19\n" +
+	"\btax_free\x18\b \x01(\bR\ataxFree\"\xd4\x05\n" +
+	"\x12PriceOrderResponse\x129\n" +
+	"\x05lines\x18\x01 \x03(\v2#.go.escape.ship.proto.v1.PricedLineR\x05lines\x12X\n" +
+	"\x12applied_promotions\x18\x02 \x03(\v2).go.escape.ship.proto.v1.AppliedPromotionR\x11appliedPromotions\x12[\n" +
+	"\x13rejected_promotions\x18\x03 \x03(\v2*.go.escape.ship.proto.v1.RejectedPromotionR\x12rejectedPromotions\x12:\n" +
+	"\bsubtotal\x18\x04 \x01(\v2\x1e.go.escape.ship.proto.v1.MoneyR\bsubtotal\x12E\n" +
+	"\x0ediscount_total\x18\x05 \x01(\v2\x1e.go.escape.ship.proto.v1.MoneyR\rdiscountTotal\x12A\n" +
+	"\fshipping_fee\x18\x06 \x01(\v2\x1e.go.escape.ship.proto.v1.MoneyR\vshippingFee\x124\n" +
+	"\x05total\x18\a \x01(\v2\x1e.go.escape.ship.proto.v1.MoneyR\x05total\x12M\n" +
+	"\x12rounding_remainder\x18\b \x01(\v2\x1e.go.escape.ship.proto.v1.MoneyR\x11roundingRemainder\x12#\n" +
+	"\rrules_version\x18\t \x01(\tR\frulesVersion\x12\x1f\n" +
+	"\tpriced_at\x18\n" +
+	" \x01(\tB\x02\x18\x01R\bpricedAt\x12;\n" +
+	"\vpriced_time\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"pricedTime*\xc9\x01\n" +
+	"\vOrderStatus\x12\x1c\n" +
+	"\x18ORDER_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14ORDER_STATUS_PENDING\x10\x01\x12\x15\n" +
+	"\x11ORDER_STATUS_PAID\x10\x02\x12\x18\n" +
+	"\x14ORDER_STATUS_SHIPPED\x10\x03\x12\x1a\n" +
+	"\x16ORDER_STATUS_DELIVERED\x10\x04\x12\x1a\n" +
+	"\x16ORDER_STATUS_CANCELLED\x10\x05\x12\x19\n" +
+	"\x15ORDER_STATUS_REFUNDED\x10\x06*\x7f\n" +
+	"\fRefundStatus\x12\x1d\n" +
+	"\x19REFUND_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15REFUND_STATUS_PENDING\x10\x01\x12\x1b\n" +
+	"\x17REFUND_STATUS_COMPLETED\x10\x02\x12\x18\n" +
+	"\x14REFUND_STATUS_FAILED\x10\x03*\x96\x01\n" +
+	"\vQuoteStatus\x12\x1c\n" +
+	"\x18QUOTE_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14QUOTE_STATUS_PENDING\x10\x01\x12\x19\n" +
+	"\x15QUOTE_STATUS_ACCEPTED\x10\x02\x12\x1a\n" +
+	"\x16QUOTE_STATUS_CONVERTED\x10\x03\x12\x18\n" +
+	"\x14QUOTE_STATUS_EXPIRED\x10\x04*{\n" +
+	"\fPricingStage\x12\x1d\n" +
+	"\x19PRICING_STAGE_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12PRICING_STAGE_CART\x10\x01\x12\x1a\n" +
+	"\x16PRICING_STAGE_CHECKOUT\x10\x02\x12\x18\n" +
+	"\x14PRICING_STAGE_REFUND\x10\x032\xdd\x13\n" +
 	"\fOrderService\x12\x85\x01\n" +
 	"\vInsertOrder\x12+.go.escape.ship.proto.v1.InsertOrderRequest\x1a,.go.escape.ship.proto.v1.InsertOrderResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/order/insert\x12~\n" +
-	"\fGetAllOrders\x12,.go.escape.ship.proto.v1.GetAllOrdersRequest\x1a-.go.escape.ship.proto.v1.GetAllOrdersResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/orderB#Z!github.com/escape-ship/protos/genb\x06proto3"
+	"\fGetAllOrders\x12,.go.escape.ship.proto.v1.GetAllOrdersRequest\x1a-.go.escape.ship.proto.v1.GetAllOrdersResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/order\x12\x84\x01\n" +
+	"\fGetOrderByID\x12,.go.escape.ship.proto.v1.GetOrderByIDRequest\x1a-.go.escape.ship.proto.v1.GetOrderByIDResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/orders/{id}\x12\x98\x01\n" +
+	"\x0fGetOrdersByUser\x12/.go.escape.ship.proto.v1.GetOrdersByUserRequest\x1a0.go.escape.ship.proto.v1.GetOrdersByUserResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/users/{user_id}/orders\x12\x89\x01\n" +
+	"\n" +
+	"WatchOrder\x12*.go.escape.ship.proto.v1.WatchOrderRequest\x1a).go.escape.ship.proto.v1.OrderStatusEvent\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/order/{order_id}/watch0\x01\x12\x90\x01\n" +
+	"\vCancelOrder\x12+.go.escape.ship.proto.v1.CancelOrderRequest\x1a,.go.escape.ship.proto.v1.CancelOrderResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/order/{order_id}/cancel\x12\x91\x01\n" +
+	"\vRefundOrder\x12+.go.escape.ship.proto.v1.RefundOrderRequest\x1a,.go.escape.ship.proto.v1.RefundOrderResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/order/{order_id}/refunds\x12\xaa\x01\n" +
+	"\x11CreateReturnLabel\x121.go.escape.ship.proto.v1.CreateReturnLabelRequest\x1a2.go.escape.ship.proto.v1.CreateReturnLabelResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/order/returns/{return_id}/label\x12\x8a\x01\n" +
+	"\fImportOrders\x12,.go.escape.ship.proto.v1.ImportOrdersRequest\x1a-.go.escape.ship.proto.v1.ImportOrdersResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/order/import(\x01\x12\x91\x01\n" +
+	"\x0eGetOrdersByIDs\x12..go.escape.ship.proto.v1.GetOrdersByIDsRequest\x1a/.go.escape.ship.proto.v1.GetOrdersByIDsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/order/batch-get\x12\x8c\x01\n" +
+	"\rArchiveOrders\x12-.go.escape.ship.proto.v1.ArchiveOrdersRequest\x1a..go.escape.ship.proto.v1.ArchiveOrdersResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/order/archive\x12\x98\x01\n" +
+	"\x10GetArchivedOrder\x120.go.escape.ship.proto.v1.GetArchivedOrderRequest\x1a1.go.escape.ship.proto.v1.GetArchivedOrderResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/order/archived/{id}\x12\x7f\n" +
+	"\vCreateQuote\x12+.go.escape.ship.proto.v1.CreateQuoteRequest\x1a,.go.escape.ship.proto.v1.CreateQuoteResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
+	"/v1/quotes\x12\x91\x01\n" +
+	"\vAcceptQuote\x12+.go.escape.ship.proto.v1.AcceptQuoteRequest\x1a,.go.escape.ship.proto.v1.AcceptQuoteResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/quotes/{quote_id}/accept\x12\xaa\x01\n" +
+	"\x13ConvertQuoteToOrder\x123.go.escape.ship.proto.v1.ConvertQuoteToOrderRequest\x1a4.go.escape.ship.proto.v1.ConvertQuoteToOrderResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/quotes/{quote_id}/convert\x12\x81\x01\n" +
+	"\n" +
+	"PriceOrder\x12*.go.escape.ship.proto.v1.PriceOrderRequest\x1a+.go.escape.ship.proto.v1.PriceOrderResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/order/price\x12\xb1\x01\n" +
+	"\x18CheckPurchaseEligibility\x128.go.escape.ship.proto.v1.CheckPurchaseEligibilityRequest\x1a9.go.escape.ship.proto.v1.CheckPurchaseEligibilityResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/order/eligibilityB#Z!github.com/escape-ship/protos/genb\x06proto3"
 
 var (
 	file_order_proto_rawDescOnce sync.Once
@@ -642,29 +4595,202 @@ func file_order_proto_rawDescGZIP() []byte {
 	return file_order_proto_rawDescData
 }
 
-var file_order_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_order_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_order_proto_msgTypes = make([]protoimpl.MessageInfo, 53)
 var file_order_proto_goTypes = []any{
-	(*Order)(nil),                // 0: go.escape.ship.proto.v1.Order
-	(*OrderItem)(nil),            // 1: go.escape.ship.proto.v1.OrderItem
-	(*InsertOrderRequest)(nil),   // 2: go.escape.ship.proto.v1.InsertOrderRequest
-	(*InsertOrderItem)(nil),      // 3: go.escape.ship.proto.v1.InsertOrderItem
-	(*InsertOrderResponse)(nil),  // 4: go.escape.ship.proto.v1.InsertOrderResponse
-	(*GetAllOrdersRequest)(nil),  // 5: go.escape.ship.proto.v1.GetAllOrdersRequest
-	(*GetAllOrdersResponse)(nil), // 6: go.escape.ship.proto.v1.GetAllOrdersResponse
+	(OrderStatus)(0),                         // 0: go.escape.ship.proto.v1.OrderStatus
+	(RefundStatus)(0),                        // 1: go.escape.ship.proto.v1.RefundStatus
+	(QuoteStatus)(0),                         // 2: go.escape.ship.proto.v1.QuoteStatus
+	(PricingStage)(0),                        // 3: go.escape.ship.proto.v1.PricingStage
+	(*Order)(nil),                            // 4: go.escape.ship.proto.v1.Order
+	(*RefundItem)(nil),                       // 5: go.escape.ship.proto.v1.RefundItem
+	(*Refund)(nil),                           // 6: go.escape.ship.proto.v1.Refund
+	(*PaymentTerms)(nil),                     // 7: go.escape.ship.proto.v1.PaymentTerms
+	(*CustomsDeclaration)(nil),               // 8: go.escape.ship.proto.v1.CustomsDeclaration
+	(*CustomsItem)(nil),                      // 9: go.escape.ship.proto.v1.CustomsItem
+	(*OrderItem)(nil),                        // 10: go.escape.ship.proto.v1.OrderItem
+	(*InsertOrderRequest)(nil),               // 11: go.escape.ship.proto.v1.InsertOrderRequest
+	(*InsertOrderItem)(nil),                  // 12: go.escape.ship.proto.v1.InsertOrderItem
+	(*InsertOrderResponse)(nil),              // 13: go.escape.ship.proto.v1.InsertOrderResponse
+	(*GetAllOrdersRequest)(nil),              // 14: go.escape.ship.proto.v1.GetAllOrdersRequest
+	(*WatchOrderRequest)(nil),                // 15: go.escape.ship.proto.v1.WatchOrderRequest
+	(*OrderStatusEvent)(nil),                 // 16: go.escape.ship.proto.v1.OrderStatusEvent
+	(*CancelOrderRequest)(nil),               // 17: go.escape.ship.proto.v1.CancelOrderRequest
+	(*CancelOrderResponse)(nil),              // 18: go.escape.ship.proto.v1.CancelOrderResponse
+	(*RefundOrderRequest)(nil),               // 19: go.escape.ship.proto.v1.RefundOrderRequest
+	(*RefundOrderResponse)(nil),              // 20: go.escape.ship.proto.v1.RefundOrderResponse
+	(*GetAllOrdersResponse)(nil),             // 21: go.escape.ship.proto.v1.GetAllOrdersResponse
+	(*ReturnLabel)(nil),                      // 22: go.escape.ship.proto.v1.ReturnLabel
+	(*CreateReturnLabelRequest)(nil),         // 23: go.escape.ship.proto.v1.CreateReturnLabelRequest
+	(*CreateReturnLabelResponse)(nil),        // 24: go.escape.ship.proto.v1.CreateReturnLabelResponse
+	(*ImportOrdersRequest)(nil),              // 25: go.escape.ship.proto.v1.ImportOrdersRequest
+	(*ImportOrderRowResult)(nil),             // 26: go.escape.ship.proto.v1.ImportOrderRowResult
+	(*ImportOrdersResponse)(nil),             // 27: go.escape.ship.proto.v1.ImportOrdersResponse
+	(*GetOrderByIDRequest)(nil),              // 28: go.escape.ship.proto.v1.GetOrderByIDRequest
+	(*GetOrderByIDResponse)(nil),             // 29: go.escape.ship.proto.v1.GetOrderByIDResponse
+	(*GetOrdersByUserRequest)(nil),           // 30: go.escape.ship.proto.v1.GetOrdersByUserRequest
+	(*GetOrdersByUserResponse)(nil),          // 31: go.escape.ship.proto.v1.GetOrdersByUserResponse
+	(*GetOrdersByIDsRequest)(nil),            // 32: go.escape.ship.proto.v1.GetOrdersByIDsRequest
+	(*GetOrdersByIDsResponse)(nil),           // 33: go.escape.ship.proto.v1.GetOrdersByIDsResponse
+	(*ArchiveOrdersRequest)(nil),             // 34: go.escape.ship.proto.v1.ArchiveOrdersRequest
+	(*ArchiveOrdersResponse)(nil),            // 35: go.escape.ship.proto.v1.ArchiveOrdersResponse
+	(*GetArchivedOrderRequest)(nil),          // 36: go.escape.ship.proto.v1.GetArchivedOrderRequest
+	(*GetArchivedOrderResponse)(nil),         // 37: go.escape.ship.proto.v1.GetArchivedOrderResponse
+	(*QuoteItem)(nil),                        // 38: go.escape.ship.proto.v1.QuoteItem
+	(*Quote)(nil),                            // 39: go.escape.ship.proto.v1.Quote
+	(*CreateQuoteRequest)(nil),               // 40: go.escape.ship.proto.v1.CreateQuoteRequest
+	(*CreateQuoteResponse)(nil),              // 41: go.escape.ship.proto.v1.CreateQuoteResponse
+	(*AcceptQuoteRequest)(nil),               // 42: go.escape.ship.proto.v1.AcceptQuoteRequest
+	(*AcceptQuoteResponse)(nil),              // 43: go.escape.ship.proto.v1.AcceptQuoteResponse
+	(*ConvertQuoteToOrderRequest)(nil),       // 44: go.escape.ship.proto.v1.ConvertQuoteToOrderRequest
+	(*ConvertQuoteToOrderResponse)(nil),      // 45: go.escape.ship.proto.v1.ConvertQuoteToOrderResponse
+	(*EligibilityItem)(nil),                  // 46: go.escape.ship.proto.v1.EligibilityItem
+	(*PurchaseLimitViolation)(nil),           // 47: go.escape.ship.proto.v1.PurchaseLimitViolation
+	(*CheckPurchaseEligibilityRequest)(nil),  // 48: go.escape.ship.proto.v1.CheckPurchaseEligibilityRequest
+	(*CheckPurchaseEligibilityResponse)(nil), // 49: go.escape.ship.proto.v1.CheckPurchaseEligibilityResponse
+	(*PriceLineInput)(nil),                   // 50: go.escape.ship.proto.v1.PriceLineInput
+	(*PriceOrderRequest)(nil),                // 51: go.escape.ship.proto.v1.PriceOrderRequest
+	(*AppliedPromotion)(nil),                 // 52: go.escape.ship.proto.v1.AppliedPromotion
+	(*RejectedPromotion)(nil),                // 53: go.escape.ship.proto.v1.RejectedPromotion
+	(*DiscountAllocation)(nil),               // 54: go.escape.ship.proto.v1.DiscountAllocation
+	(*PricedLine)(nil),                       // 55: go.escape.ship.proto.v1.PricedLine
+	(*PriceOrderResponse)(nil),               // 56: go.escape.ship.proto.v1.PriceOrderResponse
+	(*FxSnapshot)(nil),                       // 57: go.escape.ship.proto.v1.FxSnapshot
+	(*Money)(nil),                            // 58: go.escape.ship.proto.v1.Money
+	(*Address)(nil),                          // 59: go.escape.ship.proto.v1.Address
+	(*timestamppb.Timestamp)(nil),            // 60: google.protobuf.Timestamp
+	(*BundleComponent)(nil),                  // 61: go.escape.ship.proto.v1.BundleComponent
+	(*DeviceFingerprint)(nil),                // 62: go.escape.ship.proto.v1.DeviceFingerprint
+	(*fieldmaskpb.FieldMask)(nil),            // 63: google.protobuf.FieldMask
+	(*TrackingEvent)(nil),                    // 64: go.escape.ship.proto.v1.TrackingEvent
 }
 var file_order_proto_depIdxs = []int32{
-	1, // 0: go.escape.ship.proto.v1.Order.items:type_name -> go.escape.ship.proto.v1.OrderItem
-	3, // 1: go.escape.ship.proto.v1.InsertOrderRequest.items:type_name -> go.escape.ship.proto.v1.InsertOrderItem
-	0, // 2: go.escape.ship.proto.v1.GetAllOrdersResponse.orders:type_name -> go.escape.ship.proto.v1.Order
-	2, // 3: go.escape.ship.proto.v1.OrderService.InsertOrder:input_type -> go.escape.ship.proto.v1.InsertOrderRequest
-	5, // 4: go.escape.ship.proto.v1.OrderService.GetAllOrders:input_type -> go.escape.ship.proto.v1.GetAllOrdersRequest
-	4, // 5: go.escape.ship.proto.v1.OrderService.InsertOrder:output_type -> go.escape.ship.proto.v1.InsertOrderResponse
-	6, // 6: go.escape.ship.proto.v1.OrderService.GetAllOrders:output_type -> go.escape.ship.proto.v1.GetAllOrdersResponse
-	5, // [5:7] is the sub-list for method output_type
-	3, // [3:5] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	10,  // 0: go.escape.ship.proto.v1.Order.items:type_name -> go.escape.ship.proto.v1.OrderItem
+	8,   // 1: go.escape.ship.proto.v1.Order.customs:type_name -> go.escape.ship.proto.v1.CustomsDeclaration
+	57,  // 2: go.escape.ship.proto.v1.Order.fx:type_name -> go.escape.ship.proto.v1.FxSnapshot
+	7,   // 3: go.escape.ship.proto.v1.Order.payment_terms:type_name -> go.escape.ship.proto.v1.PaymentTerms
+	0,   // 4: go.escape.ship.proto.v1.Order.order_status:type_name -> go.escape.ship.proto.v1.OrderStatus
+	6,   // 5: go.escape.ship.proto.v1.Order.refunds:type_name -> go.escape.ship.proto.v1.Refund
+	58,  // 6: go.escape.ship.proto.v1.Order.refunded_amount:type_name -> go.escape.ship.proto.v1.Money
+	59,  // 7: go.escape.ship.proto.v1.Order.delivery_address:type_name -> go.escape.ship.proto.v1.Address
+	60,  // 8: go.escape.ship.proto.v1.Order.ordered_time:type_name -> google.protobuf.Timestamp
+	60,  // 9: go.escape.ship.proto.v1.Order.paid_time:type_name -> google.protobuf.Timestamp
+	58,  // 10: go.escape.ship.proto.v1.RefundItem.amount:type_name -> go.escape.ship.proto.v1.Money
+	1,   // 11: go.escape.ship.proto.v1.Refund.status:type_name -> go.escape.ship.proto.v1.RefundStatus
+	58,  // 12: go.escape.ship.proto.v1.Refund.amount:type_name -> go.escape.ship.proto.v1.Money
+	58,  // 13: go.escape.ship.proto.v1.Refund.tax_free_amount:type_name -> go.escape.ship.proto.v1.Money
+	58,  // 14: go.escape.ship.proto.v1.Refund.vat_amount:type_name -> go.escape.ship.proto.v1.Money
+	5,   // 15: go.escape.ship.proto.v1.Refund.items:type_name -> go.escape.ship.proto.v1.RefundItem
+	58,  // 16: go.escape.ship.proto.v1.Refund.shipping_amount:type_name -> go.escape.ship.proto.v1.Money
+	60,  // 17: go.escape.ship.proto.v1.Refund.requested_time:type_name -> google.protobuf.Timestamp
+	60,  // 18: go.escape.ship.proto.v1.Refund.completed_time:type_name -> google.protobuf.Timestamp
+	9,   // 19: go.escape.ship.proto.v1.CustomsDeclaration.items:type_name -> go.escape.ship.proto.v1.CustomsItem
+	61,  // 20: go.escape.ship.proto.v1.OrderItem.bundle_components:type_name -> go.escape.ship.proto.v1.BundleComponent
+	58,  // 21: go.escape.ship.proto.v1.OrderItem.unit_price:type_name -> go.escape.ship.proto.v1.Money
+	12,  // 22: go.escape.ship.proto.v1.InsertOrderRequest.items:type_name -> go.escape.ship.proto.v1.InsertOrderItem
+	8,   // 23: go.escape.ship.proto.v1.InsertOrderRequest.customs:type_name -> go.escape.ship.proto.v1.CustomsDeclaration
+	57,  // 24: go.escape.ship.proto.v1.InsertOrderRequest.fx:type_name -> go.escape.ship.proto.v1.FxSnapshot
+	62,  // 25: go.escape.ship.proto.v1.InsertOrderRequest.device:type_name -> go.escape.ship.proto.v1.DeviceFingerprint
+	0,   // 26: go.escape.ship.proto.v1.InsertOrderRequest.order_status:type_name -> go.escape.ship.proto.v1.OrderStatus
+	59,  // 27: go.escape.ship.proto.v1.InsertOrderRequest.delivery_address:type_name -> go.escape.ship.proto.v1.Address
+	60,  // 28: go.escape.ship.proto.v1.InsertOrderRequest.paid_time:type_name -> google.protobuf.Timestamp
+	63,  // 29: go.escape.ship.proto.v1.GetAllOrdersRequest.read_mask:type_name -> google.protobuf.FieldMask
+	0,   // 30: go.escape.ship.proto.v1.OrderStatusEvent.status:type_name -> go.escape.ship.proto.v1.OrderStatus
+	0,   // 31: go.escape.ship.proto.v1.OrderStatusEvent.previous_status:type_name -> go.escape.ship.proto.v1.OrderStatus
+	64,  // 32: go.escape.ship.proto.v1.OrderStatusEvent.tracking:type_name -> go.escape.ship.proto.v1.TrackingEvent
+	60,  // 33: go.escape.ship.proto.v1.OrderStatusEvent.changed_time:type_name -> google.protobuf.Timestamp
+	4,   // 34: go.escape.ship.proto.v1.CancelOrderResponse.order:type_name -> go.escape.ship.proto.v1.Order
+	6,   // 35: go.escape.ship.proto.v1.CancelOrderResponse.refund:type_name -> go.escape.ship.proto.v1.Refund
+	5,   // 36: go.escape.ship.proto.v1.RefundOrderRequest.items:type_name -> go.escape.ship.proto.v1.RefundItem
+	58,  // 37: go.escape.ship.proto.v1.RefundOrderRequest.amount:type_name -> go.escape.ship.proto.v1.Money
+	58,  // 38: go.escape.ship.proto.v1.RefundOrderRequest.tax_free_amount:type_name -> go.escape.ship.proto.v1.Money
+	4,   // 39: go.escape.ship.proto.v1.RefundOrderResponse.order:type_name -> go.escape.ship.proto.v1.Order
+	6,   // 40: go.escape.ship.proto.v1.RefundOrderResponse.refund:type_name -> go.escape.ship.proto.v1.Refund
+	4,   // 41: go.escape.ship.proto.v1.GetAllOrdersResponse.orders:type_name -> go.escape.ship.proto.v1.Order
+	64,  // 42: go.escape.ship.proto.v1.ReturnLabel.events:type_name -> go.escape.ship.proto.v1.TrackingEvent
+	60,  // 43: go.escape.ship.proto.v1.ReturnLabel.created_time:type_name -> google.protobuf.Timestamp
+	59,  // 44: go.escape.ship.proto.v1.CreateReturnLabelRequest.pickup:type_name -> go.escape.ship.proto.v1.Address
+	22,  // 45: go.escape.ship.proto.v1.CreateReturnLabelResponse.label:type_name -> go.escape.ship.proto.v1.ReturnLabel
+	11,  // 46: go.escape.ship.proto.v1.ImportOrdersRequest.order:type_name -> go.escape.ship.proto.v1.InsertOrderRequest
+	26,  // 47: go.escape.ship.proto.v1.ImportOrdersResponse.results:type_name -> go.escape.ship.proto.v1.ImportOrderRowResult
+	63,  // 48: go.escape.ship.proto.v1.GetOrderByIDRequest.read_mask:type_name -> google.protobuf.FieldMask
+	4,   // 49: go.escape.ship.proto.v1.GetOrderByIDResponse.order:type_name -> go.escape.ship.proto.v1.Order
+	0,   // 50: go.escape.ship.proto.v1.GetOrdersByUserRequest.statuses:type_name -> go.escape.ship.proto.v1.OrderStatus
+	63,  // 51: go.escape.ship.proto.v1.GetOrdersByUserRequest.read_mask:type_name -> google.protobuf.FieldMask
+	4,   // 52: go.escape.ship.proto.v1.GetOrdersByUserResponse.orders:type_name -> go.escape.ship.proto.v1.Order
+	4,   // 53: go.escape.ship.proto.v1.GetOrdersByIDsResponse.orders:type_name -> go.escape.ship.proto.v1.Order
+	4,   // 54: go.escape.ship.proto.v1.GetArchivedOrderResponse.order:type_name -> go.escape.ship.proto.v1.Order
+	60,  // 55: go.escape.ship.proto.v1.GetArchivedOrderResponse.archived_time:type_name -> google.protobuf.Timestamp
+	38,  // 56: go.escape.ship.proto.v1.Quote.items:type_name -> go.escape.ship.proto.v1.QuoteItem
+	2,   // 57: go.escape.ship.proto.v1.Quote.status:type_name -> go.escape.ship.proto.v1.QuoteStatus
+	7,   // 58: go.escape.ship.proto.v1.Quote.payment_terms:type_name -> go.escape.ship.proto.v1.PaymentTerms
+	60,  // 59: go.escape.ship.proto.v1.Quote.created_time:type_name -> google.protobuf.Timestamp
+	38,  // 60: go.escape.ship.proto.v1.CreateQuoteRequest.items:type_name -> go.escape.ship.proto.v1.QuoteItem
+	7,   // 61: go.escape.ship.proto.v1.CreateQuoteRequest.payment_terms:type_name -> go.escape.ship.proto.v1.PaymentTerms
+	39,  // 62: go.escape.ship.proto.v1.CreateQuoteResponse.quote:type_name -> go.escape.ship.proto.v1.Quote
+	39,  // 63: go.escape.ship.proto.v1.AcceptQuoteResponse.quote:type_name -> go.escape.ship.proto.v1.Quote
+	46,  // 64: go.escape.ship.proto.v1.CheckPurchaseEligibilityRequest.items:type_name -> go.escape.ship.proto.v1.EligibilityItem
+	47,  // 65: go.escape.ship.proto.v1.CheckPurchaseEligibilityResponse.violations:type_name -> go.escape.ship.proto.v1.PurchaseLimitViolation
+	58,  // 66: go.escape.ship.proto.v1.PriceLineInput.unit_price:type_name -> go.escape.ship.proto.v1.Money
+	50,  // 67: go.escape.ship.proto.v1.PriceOrderRequest.items:type_name -> go.escape.ship.proto.v1.PriceLineInput
+	58,  // 68: go.escape.ship.proto.v1.PriceOrderRequest.shipping_fee:type_name -> go.escape.ship.proto.v1.Money
+	3,   // 69: go.escape.ship.proto.v1.PriceOrderRequest.stage:type_name -> go.escape.ship.proto.v1.PricingStage
+	60,  // 70: go.escape.ship.proto.v1.PriceOrderRequest.priced_time:type_name -> google.protobuf.Timestamp
+	58,  // 71: go.escape.ship.proto.v1.AppliedPromotion.discount:type_name -> go.escape.ship.proto.v1.Money
+	58,  // 72: go.escape.ship.proto.v1.DiscountAllocation.amount:type_name -> go.escape.ship.proto.v1.Money
+	58,  // 73: go.escape.ship.proto.v1.PricedLine.unit_price:type_name -> go.escape.ship.proto.v1.Money
+	58,  // 74: go.escape.ship.proto.v1.PricedLine.subtotal:type_name -> go.escape.ship.proto.v1.Money
+	54,  // 75: go.escape.ship.proto.v1.PricedLine.allocations:type_name -> go.escape.ship.proto.v1.DiscountAllocation
+	58,  // 76: go.escape.ship.proto.v1.PricedLine.discount:type_name -> go.escape.ship.proto.v1.Money
+	58,  // 77: go.escape.ship.proto.v1.PricedLine.total:type_name -> go.escape.ship.proto.v1.Money
+	55,  // 78: go.escape.ship.proto.v1.PriceOrderResponse.lines:type_name -> go.escape.ship.proto.v1.PricedLine
+	52,  // 79: go.escape.ship.proto.v1.PriceOrderResponse.applied_promotions:type_name -> go.escape.ship.proto.v1.AppliedPromotion
+	53,  // 80: go.escape.ship.proto.v1.PriceOrderResponse.rejected_promotions:type_name -> go.escape.ship.proto.v1.RejectedPromotion
+	58,  // 81: go.escape.ship.proto.v1.PriceOrderResponse.subtotal:type_name -> go.escape.ship.proto.v1.Money
+	58,  // 82: go.escape.ship.proto.v1.PriceOrderResponse.discount_total:type_name -> go.escape.ship.proto.v1.Money
+	58,  // 83: go.escape.ship.proto.v1.PriceOrderResponse.shipping_fee:type_name -> go.escape.ship.proto.v1.Money
+	58,  // 84: go.escape.ship.proto.v1.PriceOrderResponse.total:type_name -> go.escape.ship.proto.v1.Money
+	58,  // 85: go.escape.ship.proto.v1.PriceOrderResponse.rounding_remainder:type_name -> go.escape.ship.proto.v1.Money
+	60,  // 86: go.escape.ship.proto.v1.PriceOrderResponse.priced_time:type_name -> google.protobuf.Timestamp
+	11,  // 87: go.escape.ship.proto.v1.OrderService.InsertOrder:input_type -> go.escape.ship.proto.v1.InsertOrderRequest
+	14,  // 88: go.escape.ship.proto.v1.OrderService.GetAllOrders:input_type -> go.escape.ship.proto.v1.GetAllOrdersRequest
+	28,  // 89: go.escape.ship.proto.v1.OrderService.GetOrderByID:input_type -> go.escape.ship.proto.v1.GetOrderByIDRequest
+	30,  // 90: go.escape.ship.proto.v1.OrderService.GetOrdersByUser:input_type -> go.escape.ship.proto.v1.GetOrdersByUserRequest
+	15,  // 91: go.escape.ship.proto.v1.OrderService.WatchOrder:input_type -> go.escape.ship.proto.v1.WatchOrderRequest
+	17,  // 92: go.escape.ship.proto.v1.OrderService.CancelOrder:input_type -> go.escape.ship.proto.v1.CancelOrderRequest
+	19,  // 93: go.escape.ship.proto.v1.OrderService.RefundOrder:input_type -> go.escape.ship.proto.v1.RefundOrderRequest
+	23,  // 94: go.escape.ship.proto.v1.OrderService.CreateReturnLabel:input_type -> go.escape.ship.proto.v1.CreateReturnLabelRequest
+	25,  // 95: go.escape.ship.proto.v1.OrderService.ImportOrders:input_type -> go.escape.ship.proto.v1.ImportOrdersRequest
+	32,  // 96: go.escape.ship.proto.v1.OrderService.GetOrdersByIDs:input_type -> go.escape.ship.proto.v1.GetOrdersByIDsRequest
+	34,  // 97: go.escape.ship.proto.v1.OrderService.ArchiveOrders:input_type -> go.escape.ship.proto.v1.ArchiveOrdersRequest
+	36,  // 98: go.escape.ship.proto.v1.OrderService.GetArchivedOrder:input_type -> go.escape.ship.proto.v1.GetArchivedOrderRequest
+	40,  // 99: go.escape.ship.proto.v1.OrderService.CreateQuote:input_type -> go.escape.ship.proto.v1.CreateQuoteRequest
+	42,  // 100: go.escape.ship.proto.v1.OrderService.AcceptQuote:input_type -> go.escape.ship.proto.v1.AcceptQuoteRequest
+	44,  // 101: go.escape.ship.proto.v1.OrderService.ConvertQuoteToOrder:input_type -> go.escape.ship.proto.v1.ConvertQuoteToOrderRequest
+	51,  // 102: go.escape.ship.proto.v1.OrderService.PriceOrder:input_type -> go.escape.ship.proto.v1.PriceOrderRequest
+	48,  // 103: go.escape.ship.proto.v1.OrderService.CheckPurchaseEligibility:input_type -> go.escape.ship.proto.v1.CheckPurchaseEligibilityRequest
+	13,  // 104: go.escape.ship.proto.v1.OrderService.InsertOrder:output_type -> go.escape.ship.proto.v1.InsertOrderResponse
+	21,  // 105: go.escape.ship.proto.v1.OrderService.GetAllOrders:output_type -> go.escape.ship.proto.v1.GetAllOrdersResponse
+	29,  // 106: go.escape.ship.proto.v1.OrderService.GetOrderByID:output_type -> go.escape.ship.proto.v1.GetOrderByIDResponse
+	31,  // 107: go.escape.ship.proto.v1.OrderService.GetOrdersByUser:output_type -> go.escape.ship.proto.v1.GetOrdersByUserResponse
+	16,  // 108: go.escape.ship.proto.v1.OrderService.WatchOrder:output_type -> go.escape.ship.proto.v1.OrderStatusEvent
+	18,  // 109: go.escape.ship.proto.v1.OrderService.CancelOrder:output_type -> go.escape.ship.proto.v1.CancelOrderResponse
+	20,  // 110: go.escape.ship.proto.v1.OrderService.RefundOrder:output_type -> go.escape.ship.proto.v1.RefundOrderResponse
+	24,  // 111: go.escape.ship.proto.v1.OrderService.CreateReturnLabel:output_type -> go.escape.ship.proto.v1.CreateReturnLabelResponse
+	27,  // 112: go.escape.ship.proto.v1.OrderService.ImportOrders:output_type -> go.escape.ship.proto.v1.ImportOrdersResponse
+	33,  // 113: go.escape.ship.proto.v1.OrderService.GetOrdersByIDs:output_type -> go.escape.ship.proto.v1.GetOrdersByIDsResponse
+	35,  // 114: go.escape.ship.proto.v1.OrderService.ArchiveOrders:output_type -> go.escape.ship.proto.v1.ArchiveOrdersResponse
+	37,  // 115: go.escape.ship.proto.v1.OrderService.GetArchivedOrder:output_type -> go.escape.ship.proto.v1.GetArchivedOrderResponse
+	41,  // 116: go.escape.ship.proto.v1.OrderService.CreateQuote:output_type -> go.escape.ship.proto.v1.CreateQuoteResponse
+	43,  // 117: go.escape.ship.proto.v1.OrderService.AcceptQuote:output_type -> go.escape.ship.proto.v1.AcceptQuoteResponse
+	45,  // 118: go.escape.ship.proto.v1.OrderService.ConvertQuoteToOrder:output_type -> go.escape.ship.proto.v1.ConvertQuoteToOrderResponse
+	56,  // 119: go.escape.ship.proto.v1.OrderService.PriceOrder:output_type -> go.escape.ship.proto.v1.PriceOrderResponse
+	49,  // 120: go.escape.ship.proto.v1.OrderService.CheckPurchaseEligibility:output_type -> go.escape.ship.proto.v1.CheckPurchaseEligibilityResponse
+	104, // [104:121] is the sub-list for method output_type
+	87,  // [87:104] is the sub-list for method input_type
+	87,  // [87:87] is the sub-list for extension type_name
+	87,  // [87:87] is the sub-list for extension extendee
+	0,   // [0:87] is the sub-list for field type_name
 }
 
 func init() { file_order_proto_init() }
@@ -672,18 +4798,24 @@ func file_order_proto_init() {
 	if File_order_proto != nil {
 		return
 	}
+	file_common_proto_init()
+	file_product_proto_init()
+	file_shipping_proto_init()
+	file_order_proto_msgTypes[0].OneofWrappers = []any{}
+	file_order_proto_msgTypes[7].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_order_proto_rawDesc), len(file_order_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   7,
+			NumEnums:      4,
+			NumMessages:   53,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_order_proto_goTypes,
 		DependencyIndexes: file_order_proto_depIdxs,
+		EnumInfos:         file_order_proto_enumTypes,
 		MessageInfos:      file_order_proto_msgTypes,
 	}.Build()
 	File_order_proto = out.File
@@ -0,0 +1,5264 @@
+// Code generated by protoc-gen-twirp v8.1.3, DO NOT EDIT.
+// source: order.proto
+
+package gen
+
+import context "context"
+import fmt "fmt"
+import http "net/http"
+import io "io"
+import json "encoding/json"
+import strconv "strconv"
+import strings "strings"
+
+import protojson "google.golang.org/protobuf/encoding/protojson"
+import proto "google.golang.org/protobuf/proto"
+import twirp "github.com/twitchtv/twirp"
+import ctxsetters "github.com/twitchtv/twirp/ctxsetters"
+
+// Version compatibility assertion.
+// If the constant is not defined in the package, that likely means
+// the package needs to be updated to work with this generated code.
+// See https://twitchtv.github.io/twirp/docs/version_matrix.html
+const _ = twirp.TwirpPackageMinVersion_8_1_0
+
+// ======================
+// OrderService Interface
+// ======================
+
+type OrderService interface {
+	InsertOrder(context.Context, *InsertOrderRequest) (*InsertOrderResponse, error)
+
+	GetAllOrders(context.Context, *GetAllOrdersRequest) (*GetAllOrdersResponse, error)
+
+	// 주문 단건 조회, 본인 주문이 아니면 orders:admin 필요 (없으면 NOT_FOUND)
+	// 아카이브된 주문은 NOT_FOUND, GetArchivedOrder로 조회
+	GetOrderByID(context.Context, *GetOrderByIDRequest) (*GetOrderByIDResponse, error)
+
+	// 사용자별 주문 목록 (최신 주문 순), 다른 사용자의 주문은 orders:admin 필요 (없으면 PERMISSION_DENIED)
+	GetOrdersByUser(context.Context, *GetOrdersByUserRequest) (*GetOrdersByUserResponse, error)
+
+	// 주문 상태 변경을 실시간으로 전달 (GetAllOrders 폴링 대체)
+	// 구독 직후 현재 상태를 한 번 보내고, 이후 변경될 때마다 전달
+	// 게이트웨이에서는 Accept: text/event-stream 요청 시 SSE로 응답
+	WatchOrder(context.Context, *WatchOrderRequest) (*OrderStatusEvent, error)
+
+	// 배송 전(PENDING/PAID) 주문 취소, 결제 완료 주문은 전액 환불까지 처리
+	CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error)
+
+	// 전체/부분 환불 (PaymentService.KakaoCancel로 결제 취소 후 Order.refunds에 기록)
+	RefundOrder(context.Context, *RefundOrderRequest) (*RefundOrderResponse, error)
+
+	// 반품 건에 대해 택배사 수거 예약 후 출력용 라벨 URL 발급
+	CreateReturnLabel(context.Context, *CreateReturnLabelRequest) (*CreateReturnLabelResponse, error)
+
+	// 전화/오프라인 주문 및 마켓플레이스 주문 일괄 등록 (행 단위 검증 결과 반환)
+	ImportOrders(context.Context, *ImportOrdersRequest) (*ImportOrdersResponse, error)
+
+	// 여러 주문 ID를 한 번에 조회, orders:admin 필요 (일부만 존재해도 성공, 없는 ID는 not_found_ids로 반환)
+	GetOrdersByIDs(context.Context, *GetOrdersByIDsRequest) (*GetOrdersByIDsResponse, error)
+
+	// before_date 이전 주문을 콜드 스토리지로 이동
+	ArchiveOrders(context.Context, *ArchiveOrdersRequest) (*ArchiveOrdersResponse, error)
+
+	// 아카이브된 주문 조회
+	GetArchivedOrder(context.Context, *GetArchivedOrderRequest) (*GetArchivedOrderResponse, error)
+
+	// B2B 견적: 생성 → 고객 수락 → 주문 전환 (외상 결제 조건 지원)
+	CreateQuote(context.Context, *CreateQuoteRequest) (*CreateQuoteResponse, error)
+
+	AcceptQuote(context.Context, *AcceptQuoteRequest) (*AcceptQuoteResponse, error)
+
+	ConvertQuoteToOrder(context.Context, *ConvertQuoteToOrderRequest) (*ConvertQuoteToOrderResponse, error)
+
+	// 프로모션 규칙을 적용한 주문 금액 계산 (장바구니/결제/환불 시 공통 사용)
+	// 같은 입력과 priced_at이면 항상 같은 결과를 반환
+	PriceOrder(context.Context, *PriceOrderRequest) (*PriceOrderResponse, error)
+
+	// 고객당 구매 수량 제한 확인 (장바구니/결제/주문 등록 시 공통 사용)
+	CheckPurchaseEligibility(context.Context, *CheckPurchaseEligibilityRequest) (*CheckPurchaseEligibilityResponse, error)
+}
+
+// ============================
+// OrderService Protobuf Client
+// ============================
+
+type orderServiceProtobufClient struct {
+	client      HTTPClient
+	urls        [17]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewOrderServiceProtobufClient creates a Protobuf client that implements the OrderService interface.
+// It communicates using Protobuf and can be configured with a custom HTTPClient.
+func NewOrderServiceProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) OrderService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "go.escape.ship.proto.v1", "OrderService")
+	urls := [17]string{
+		serviceURL + "InsertOrder",
+		serviceURL + "GetAllOrders",
+		serviceURL + "GetOrderByID",
+		serviceURL + "GetOrdersByUser",
+		serviceURL + "WatchOrder",
+		serviceURL + "CancelOrder",
+		serviceURL + "RefundOrder",
+		serviceURL + "CreateReturnLabel",
+		serviceURL + "ImportOrders",
+		serviceURL + "GetOrdersByIDs",
+		serviceURL + "ArchiveOrders",
+		serviceURL + "GetArchivedOrder",
+		serviceURL + "CreateQuote",
+		serviceURL + "AcceptQuote",
+		serviceURL + "ConvertQuoteToOrder",
+		serviceURL + "PriceOrder",
+		serviceURL + "CheckPurchaseEligibility",
+	}
+
+	return &orderServiceProtobufClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *orderServiceProtobufClient) InsertOrder(ctx context.Context, in *InsertOrderRequest) (*InsertOrderResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderService")
+	ctx = ctxsetters.WithMethodName(ctx, "InsertOrder")
+	caller := c.callInsertOrder
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *InsertOrderRequest) (*InsertOrderResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*InsertOrderRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*InsertOrderRequest) when calling interceptor")
+					}
+					return c.callInsertOrder(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*InsertOrderResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*InsertOrderResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderServiceProtobufClient) callInsertOrder(ctx context.Context, in *InsertOrderRequest) (*InsertOrderResponse, error) {
+	out := new(InsertOrderResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderServiceProtobufClient) GetAllOrders(ctx context.Context, in *GetAllOrdersRequest) (*GetAllOrdersResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetAllOrders")
+	caller := c.callGetAllOrders
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetAllOrdersRequest) (*GetAllOrdersResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetAllOrdersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetAllOrdersRequest) when calling interceptor")
+					}
+					return c.callGetAllOrders(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetAllOrdersResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetAllOrdersResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderServiceProtobufClient) callGetAllOrders(ctx context.Context, in *GetAllOrdersRequest) (*GetAllOrdersResponse, error) {
+	out := new(GetAllOrdersResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderServiceProtobufClient) GetOrderByID(ctx context.Context, in *GetOrderByIDRequest) (*GetOrderByIDResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetOrderByID")
+	caller := c.callGetOrderByID
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetOrderByIDRequest) (*GetOrderByIDResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetOrderByIDRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetOrderByIDRequest) when calling interceptor")
+					}
+					return c.callGetOrderByID(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetOrderByIDResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetOrderByIDResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderServiceProtobufClient) callGetOrderByID(ctx context.Context, in *GetOrderByIDRequest) (*GetOrderByIDResponse, error) {
+	out := new(GetOrderByIDResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderServiceProtobufClient) GetOrdersByUser(ctx context.Context, in *GetOrdersByUserRequest) (*GetOrdersByUserResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetOrdersByUser")
+	caller := c.callGetOrdersByUser
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetOrdersByUserRequest) (*GetOrdersByUserResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetOrdersByUserRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetOrdersByUserRequest) when calling interceptor")
+					}
+					return c.callGetOrdersByUser(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetOrdersByUserResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetOrdersByUserResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderServiceProtobufClient) callGetOrdersByUser(ctx context.Context, in *GetOrdersByUserRequest) (*GetOrdersByUserResponse, error) {
+	out := new(GetOrdersByUserResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderServiceProtobufClient) WatchOrder(ctx context.Context, in *WatchOrderRequest) (*OrderStatusEvent, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderService")
+	ctx = ctxsetters.WithMethodName(ctx, "WatchOrder")
+	caller := c.callWatchOrder
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *WatchOrderRequest) (*OrderStatusEvent, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*WatchOrderRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*WatchOrderRequest) when calling interceptor")
+					}
+					return c.callWatchOrder(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*OrderStatusEvent)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*OrderStatusEvent) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderServiceProtobufClient) callWatchOrder(ctx context.Context, in *WatchOrderRequest) (*OrderStatusEvent, error) {
+	out := new(OrderStatusEvent)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderServiceProtobufClient) CancelOrder(ctx context.Context, in *CancelOrderRequest) (*CancelOrderResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderService")
+	ctx = ctxsetters.WithMethodName(ctx, "CancelOrder")
+	caller := c.callCancelOrder
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CancelOrderRequest) (*CancelOrderResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CancelOrderRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CancelOrderRequest) when calling interceptor")
+					}
+					return c.callCancelOrder(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CancelOrderResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CancelOrderResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderServiceProtobufClient) callCancelOrder(ctx context.Context, in *CancelOrderRequest) (*CancelOrderResponse, error) {
+	out := new(CancelOrderResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderServiceProtobufClient) RefundOrder(ctx context.Context, in *RefundOrderRequest) (*RefundOrderResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderService")
+	ctx = ctxsetters.WithMethodName(ctx, "RefundOrder")
+	caller := c.callRefundOrder
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RefundOrderRequest) (*RefundOrderResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RefundOrderRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RefundOrderRequest) when calling interceptor")
+					}
+					return c.callRefundOrder(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RefundOrderResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RefundOrderResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderServiceProtobufClient) callRefundOrder(ctx context.Context, in *RefundOrderRequest) (*RefundOrderResponse, error) {
+	out := new(RefundOrderResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderServiceProtobufClient) CreateReturnLabel(ctx context.Context, in *CreateReturnLabelRequest) (*CreateReturnLabelResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderService")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateReturnLabel")
+	caller := c.callCreateReturnLabel
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateReturnLabelRequest) (*CreateReturnLabelResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateReturnLabelRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateReturnLabelRequest) when calling interceptor")
+					}
+					return c.callCreateReturnLabel(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateReturnLabelResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateReturnLabelResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderServiceProtobufClient) callCreateReturnLabel(ctx context.Context, in *CreateReturnLabelRequest) (*CreateReturnLabelResponse, error) {
+	out := new(CreateReturnLabelResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderServiceProtobufClient) ImportOrders(ctx context.Context, in *ImportOrdersRequest) (*ImportOrdersResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderService")
+	ctx = ctxsetters.WithMethodName(ctx, "ImportOrders")
+	caller := c.callImportOrders
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ImportOrdersRequest) (*ImportOrdersResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ImportOrdersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ImportOrdersRequest) when calling interceptor")
+					}
+					return c.callImportOrders(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ImportOrdersResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ImportOrdersResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderServiceProtobufClient) callImportOrders(ctx context.Context, in *ImportOrdersRequest) (*ImportOrdersResponse, error) {
+	out := new(ImportOrdersResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderServiceProtobufClient) GetOrdersByIDs(ctx context.Context, in *GetOrdersByIDsRequest) (*GetOrdersByIDsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetOrdersByIDs")
+	caller := c.callGetOrdersByIDs
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetOrdersByIDsRequest) (*GetOrdersByIDsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetOrdersByIDsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetOrdersByIDsRequest) when calling interceptor")
+					}
+					return c.callGetOrdersByIDs(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetOrdersByIDsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetOrdersByIDsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderServiceProtobufClient) callGetOrdersByIDs(ctx context.Context, in *GetOrdersByIDsRequest) (*GetOrdersByIDsResponse, error) {
+	out := new(GetOrdersByIDsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderServiceProtobufClient) ArchiveOrders(ctx context.Context, in *ArchiveOrdersRequest) (*ArchiveOrdersResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderService")
+	ctx = ctxsetters.WithMethodName(ctx, "ArchiveOrders")
+	caller := c.callArchiveOrders
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ArchiveOrdersRequest) (*ArchiveOrdersResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ArchiveOrdersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ArchiveOrdersRequest) when calling interceptor")
+					}
+					return c.callArchiveOrders(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ArchiveOrdersResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ArchiveOrdersResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderServiceProtobufClient) callArchiveOrders(ctx context.Context, in *ArchiveOrdersRequest) (*ArchiveOrdersResponse, error) {
+	out := new(ArchiveOrdersResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderServiceProtobufClient) GetArchivedOrder(ctx context.Context, in *GetArchivedOrderRequest) (*GetArchivedOrderResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetArchivedOrder")
+	caller := c.callGetArchivedOrder
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetArchivedOrderRequest) (*GetArchivedOrderResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetArchivedOrderRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetArchivedOrderRequest) when calling interceptor")
+					}
+					return c.callGetArchivedOrder(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetArchivedOrderResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetArchivedOrderResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderServiceProtobufClient) callGetArchivedOrder(ctx context.Context, in *GetArchivedOrderRequest) (*GetArchivedOrderResponse, error) {
+	out := new(GetArchivedOrderResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderServiceProtobufClient) CreateQuote(ctx context.Context, in *CreateQuoteRequest) (*CreateQuoteResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderService")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateQuote")
+	caller := c.callCreateQuote
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateQuoteRequest) (*CreateQuoteResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateQuoteRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateQuoteRequest) when calling interceptor")
+					}
+					return c.callCreateQuote(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateQuoteResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateQuoteResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderServiceProtobufClient) callCreateQuote(ctx context.Context, in *CreateQuoteRequest) (*CreateQuoteResponse, error) {
+	out := new(CreateQuoteResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderServiceProtobufClient) AcceptQuote(ctx context.Context, in *AcceptQuoteRequest) (*AcceptQuoteResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderService")
+	ctx = ctxsetters.WithMethodName(ctx, "AcceptQuote")
+	caller := c.callAcceptQuote
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AcceptQuoteRequest) (*AcceptQuoteResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AcceptQuoteRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AcceptQuoteRequest) when calling interceptor")
+					}
+					return c.callAcceptQuote(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AcceptQuoteResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AcceptQuoteResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderServiceProtobufClient) callAcceptQuote(ctx context.Context, in *AcceptQuoteRequest) (*AcceptQuoteResponse, error) {
+	out := new(AcceptQuoteResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderServiceProtobufClient) ConvertQuoteToOrder(ctx context.Context, in *ConvertQuoteToOrderRequest) (*ConvertQuoteToOrderResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderService")
+	ctx = ctxsetters.WithMethodName(ctx, "ConvertQuoteToOrder")
+	caller := c.callConvertQuoteToOrder
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ConvertQuoteToOrderRequest) (*ConvertQuoteToOrderResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ConvertQuoteToOrderRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ConvertQuoteToOrderRequest) when calling interceptor")
+					}
+					return c.callConvertQuoteToOrder(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ConvertQuoteToOrderResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ConvertQuoteToOrderResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderServiceProtobufClient) callConvertQuoteToOrder(ctx context.Context, in *ConvertQuoteToOrderRequest) (*ConvertQuoteToOrderResponse, error) {
+	out := new(ConvertQuoteToOrderResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[14], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderServiceProtobufClient) PriceOrder(ctx context.Context, in *PriceOrderRequest) (*PriceOrderResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderService")
+	ctx = ctxsetters.WithMethodName(ctx, "PriceOrder")
+	caller := c.callPriceOrder
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *PriceOrderRequest) (*PriceOrderResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PriceOrderRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PriceOrderRequest) when calling interceptor")
+					}
+					return c.callPriceOrder(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PriceOrderResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PriceOrderResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderServiceProtobufClient) callPriceOrder(ctx context.Context, in *PriceOrderRequest) (*PriceOrderResponse, error) {
+	out := new(PriceOrderResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[15], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderServiceProtobufClient) CheckPurchaseEligibility(ctx context.Context, in *CheckPurchaseEligibilityRequest) (*CheckPurchaseEligibilityResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderService")
+	ctx = ctxsetters.WithMethodName(ctx, "CheckPurchaseEligibility")
+	caller := c.callCheckPurchaseEligibility
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CheckPurchaseEligibilityRequest) (*CheckPurchaseEligibilityResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CheckPurchaseEligibilityRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CheckPurchaseEligibilityRequest) when calling interceptor")
+					}
+					return c.callCheckPurchaseEligibility(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CheckPurchaseEligibilityResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CheckPurchaseEligibilityResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderServiceProtobufClient) callCheckPurchaseEligibility(ctx context.Context, in *CheckPurchaseEligibilityRequest) (*CheckPurchaseEligibilityResponse, error) {
+	out := new(CheckPurchaseEligibilityResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[16], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ========================
+// OrderService JSON Client
+// ========================
+
+type orderServiceJSONClient struct {
+	client      HTTPClient
+	urls        [17]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewOrderServiceJSONClient creates a JSON client that implements the OrderService interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewOrderServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) OrderService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "go.escape.ship.proto.v1", "OrderService")
+	urls := [17]string{
+		serviceURL + "InsertOrder",
+		serviceURL + "GetAllOrders",
+		serviceURL + "GetOrderByID",
+		serviceURL + "GetOrdersByUser",
+		serviceURL + "WatchOrder",
+		serviceURL + "CancelOrder",
+		serviceURL + "RefundOrder",
+		serviceURL + "CreateReturnLabel",
+		serviceURL + "ImportOrders",
+		serviceURL + "GetOrdersByIDs",
+		serviceURL + "ArchiveOrders",
+		serviceURL + "GetArchivedOrder",
+		serviceURL + "CreateQuote",
+		serviceURL + "AcceptQuote",
+		serviceURL + "ConvertQuoteToOrder",
+		serviceURL + "PriceOrder",
+		serviceURL + "CheckPurchaseEligibility",
+	}
+
+	return &orderServiceJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *orderServiceJSONClient) InsertOrder(ctx context.Context, in *InsertOrderRequest) (*InsertOrderResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderService")
+	ctx = ctxsetters.WithMethodName(ctx, "InsertOrder")
+	caller := c.callInsertOrder
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *InsertOrderRequest) (*InsertOrderResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*InsertOrderRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*InsertOrderRequest) when calling interceptor")
+					}
+					return c.callInsertOrder(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*InsertOrderResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*InsertOrderResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderServiceJSONClient) callInsertOrder(ctx context.Context, in *InsertOrderRequest) (*InsertOrderResponse, error) {
+	out := new(InsertOrderResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderServiceJSONClient) GetAllOrders(ctx context.Context, in *GetAllOrdersRequest) (*GetAllOrdersResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetAllOrders")
+	caller := c.callGetAllOrders
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetAllOrdersRequest) (*GetAllOrdersResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetAllOrdersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetAllOrdersRequest) when calling interceptor")
+					}
+					return c.callGetAllOrders(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetAllOrdersResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetAllOrdersResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderServiceJSONClient) callGetAllOrders(ctx context.Context, in *GetAllOrdersRequest) (*GetAllOrdersResponse, error) {
+	out := new(GetAllOrdersResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderServiceJSONClient) GetOrderByID(ctx context.Context, in *GetOrderByIDRequest) (*GetOrderByIDResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetOrderByID")
+	caller := c.callGetOrderByID
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetOrderByIDRequest) (*GetOrderByIDResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetOrderByIDRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetOrderByIDRequest) when calling interceptor")
+					}
+					return c.callGetOrderByID(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetOrderByIDResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetOrderByIDResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderServiceJSONClient) callGetOrderByID(ctx context.Context, in *GetOrderByIDRequest) (*GetOrderByIDResponse, error) {
+	out := new(GetOrderByIDResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderServiceJSONClient) GetOrdersByUser(ctx context.Context, in *GetOrdersByUserRequest) (*GetOrdersByUserResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetOrdersByUser")
+	caller := c.callGetOrdersByUser
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetOrdersByUserRequest) (*GetOrdersByUserResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetOrdersByUserRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetOrdersByUserRequest) when calling interceptor")
+					}
+					return c.callGetOrdersByUser(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetOrdersByUserResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetOrdersByUserResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderServiceJSONClient) callGetOrdersByUser(ctx context.Context, in *GetOrdersByUserRequest) (*GetOrdersByUserResponse, error) {
+	out := new(GetOrdersByUserResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderServiceJSONClient) WatchOrder(ctx context.Context, in *WatchOrderRequest) (*OrderStatusEvent, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderService")
+	ctx = ctxsetters.WithMethodName(ctx, "WatchOrder")
+	caller := c.callWatchOrder
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *WatchOrderRequest) (*OrderStatusEvent, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*WatchOrderRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*WatchOrderRequest) when calling interceptor")
+					}
+					return c.callWatchOrder(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*OrderStatusEvent)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*OrderStatusEvent) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderServiceJSONClient) callWatchOrder(ctx context.Context, in *WatchOrderRequest) (*OrderStatusEvent, error) {
+	out := new(OrderStatusEvent)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderServiceJSONClient) CancelOrder(ctx context.Context, in *CancelOrderRequest) (*CancelOrderResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderService")
+	ctx = ctxsetters.WithMethodName(ctx, "CancelOrder")
+	caller := c.callCancelOrder
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CancelOrderRequest) (*CancelOrderResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CancelOrderRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CancelOrderRequest) when calling interceptor")
+					}
+					return c.callCancelOrder(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CancelOrderResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CancelOrderResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderServiceJSONClient) callCancelOrder(ctx context.Context, in *CancelOrderRequest) (*CancelOrderResponse, error) {
+	out := new(CancelOrderResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderServiceJSONClient) RefundOrder(ctx context.Context, in *RefundOrderRequest) (*RefundOrderResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderService")
+	ctx = ctxsetters.WithMethodName(ctx, "RefundOrder")
+	caller := c.callRefundOrder
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RefundOrderRequest) (*RefundOrderResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RefundOrderRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RefundOrderRequest) when calling interceptor")
+					}
+					return c.callRefundOrder(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RefundOrderResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RefundOrderResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderServiceJSONClient) callRefundOrder(ctx context.Context, in *RefundOrderRequest) (*RefundOrderResponse, error) {
+	out := new(RefundOrderResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderServiceJSONClient) CreateReturnLabel(ctx context.Context, in *CreateReturnLabelRequest) (*CreateReturnLabelResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderService")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateReturnLabel")
+	caller := c.callCreateReturnLabel
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateReturnLabelRequest) (*CreateReturnLabelResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateReturnLabelRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateReturnLabelRequest) when calling interceptor")
+					}
+					return c.callCreateReturnLabel(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateReturnLabelResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateReturnLabelResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderServiceJSONClient) callCreateReturnLabel(ctx context.Context, in *CreateReturnLabelRequest) (*CreateReturnLabelResponse, error) {
+	out := new(CreateReturnLabelResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderServiceJSONClient) ImportOrders(ctx context.Context, in *ImportOrdersRequest) (*ImportOrdersResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderService")
+	ctx = ctxsetters.WithMethodName(ctx, "ImportOrders")
+	caller := c.callImportOrders
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ImportOrdersRequest) (*ImportOrdersResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ImportOrdersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ImportOrdersRequest) when calling interceptor")
+					}
+					return c.callImportOrders(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ImportOrdersResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ImportOrdersResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderServiceJSONClient) callImportOrders(ctx context.Context, in *ImportOrdersRequest) (*ImportOrdersResponse, error) {
+	out := new(ImportOrdersResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderServiceJSONClient) GetOrdersByIDs(ctx context.Context, in *GetOrdersByIDsRequest) (*GetOrdersByIDsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetOrdersByIDs")
+	caller := c.callGetOrdersByIDs
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetOrdersByIDsRequest) (*GetOrdersByIDsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetOrdersByIDsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetOrdersByIDsRequest) when calling interceptor")
+					}
+					return c.callGetOrdersByIDs(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetOrdersByIDsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetOrdersByIDsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderServiceJSONClient) callGetOrdersByIDs(ctx context.Context, in *GetOrdersByIDsRequest) (*GetOrdersByIDsResponse, error) {
+	out := new(GetOrdersByIDsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderServiceJSONClient) ArchiveOrders(ctx context.Context, in *ArchiveOrdersRequest) (*ArchiveOrdersResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderService")
+	ctx = ctxsetters.WithMethodName(ctx, "ArchiveOrders")
+	caller := c.callArchiveOrders
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ArchiveOrdersRequest) (*ArchiveOrdersResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ArchiveOrdersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ArchiveOrdersRequest) when calling interceptor")
+					}
+					return c.callArchiveOrders(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ArchiveOrdersResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ArchiveOrdersResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderServiceJSONClient) callArchiveOrders(ctx context.Context, in *ArchiveOrdersRequest) (*ArchiveOrdersResponse, error) {
+	out := new(ArchiveOrdersResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderServiceJSONClient) GetArchivedOrder(ctx context.Context, in *GetArchivedOrderRequest) (*GetArchivedOrderResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetArchivedOrder")
+	caller := c.callGetArchivedOrder
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetArchivedOrderRequest) (*GetArchivedOrderResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetArchivedOrderRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetArchivedOrderRequest) when calling interceptor")
+					}
+					return c.callGetArchivedOrder(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetArchivedOrderResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetArchivedOrderResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderServiceJSONClient) callGetArchivedOrder(ctx context.Context, in *GetArchivedOrderRequest) (*GetArchivedOrderResponse, error) {
+	out := new(GetArchivedOrderResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderServiceJSONClient) CreateQuote(ctx context.Context, in *CreateQuoteRequest) (*CreateQuoteResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderService")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateQuote")
+	caller := c.callCreateQuote
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateQuoteRequest) (*CreateQuoteResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateQuoteRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateQuoteRequest) when calling interceptor")
+					}
+					return c.callCreateQuote(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateQuoteResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateQuoteResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderServiceJSONClient) callCreateQuote(ctx context.Context, in *CreateQuoteRequest) (*CreateQuoteResponse, error) {
+	out := new(CreateQuoteResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderServiceJSONClient) AcceptQuote(ctx context.Context, in *AcceptQuoteRequest) (*AcceptQuoteResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderService")
+	ctx = ctxsetters.WithMethodName(ctx, "AcceptQuote")
+	caller := c.callAcceptQuote
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AcceptQuoteRequest) (*AcceptQuoteResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AcceptQuoteRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AcceptQuoteRequest) when calling interceptor")
+					}
+					return c.callAcceptQuote(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AcceptQuoteResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AcceptQuoteResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderServiceJSONClient) callAcceptQuote(ctx context.Context, in *AcceptQuoteRequest) (*AcceptQuoteResponse, error) {
+	out := new(AcceptQuoteResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderServiceJSONClient) ConvertQuoteToOrder(ctx context.Context, in *ConvertQuoteToOrderRequest) (*ConvertQuoteToOrderResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderService")
+	ctx = ctxsetters.WithMethodName(ctx, "ConvertQuoteToOrder")
+	caller := c.callConvertQuoteToOrder
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ConvertQuoteToOrderRequest) (*ConvertQuoteToOrderResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ConvertQuoteToOrderRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ConvertQuoteToOrderRequest) when calling interceptor")
+					}
+					return c.callConvertQuoteToOrder(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ConvertQuoteToOrderResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ConvertQuoteToOrderResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderServiceJSONClient) callConvertQuoteToOrder(ctx context.Context, in *ConvertQuoteToOrderRequest) (*ConvertQuoteToOrderResponse, error) {
+	out := new(ConvertQuoteToOrderResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[14], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderServiceJSONClient) PriceOrder(ctx context.Context, in *PriceOrderRequest) (*PriceOrderResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderService")
+	ctx = ctxsetters.WithMethodName(ctx, "PriceOrder")
+	caller := c.callPriceOrder
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *PriceOrderRequest) (*PriceOrderResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PriceOrderRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PriceOrderRequest) when calling interceptor")
+					}
+					return c.callPriceOrder(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PriceOrderResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PriceOrderResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderServiceJSONClient) callPriceOrder(ctx context.Context, in *PriceOrderRequest) (*PriceOrderResponse, error) {
+	out := new(PriceOrderResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[15], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *orderServiceJSONClient) CheckPurchaseEligibility(ctx context.Context, in *CheckPurchaseEligibilityRequest) (*CheckPurchaseEligibilityResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderService")
+	ctx = ctxsetters.WithMethodName(ctx, "CheckPurchaseEligibility")
+	caller := c.callCheckPurchaseEligibility
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CheckPurchaseEligibilityRequest) (*CheckPurchaseEligibilityResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CheckPurchaseEligibilityRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CheckPurchaseEligibilityRequest) when calling interceptor")
+					}
+					return c.callCheckPurchaseEligibility(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CheckPurchaseEligibilityResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CheckPurchaseEligibilityResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *orderServiceJSONClient) callCheckPurchaseEligibility(ctx context.Context, in *CheckPurchaseEligibilityRequest) (*CheckPurchaseEligibilityResponse, error) {
+	out := new(CheckPurchaseEligibilityResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[16], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ===========================
+// OrderService Server Handler
+// ===========================
+
+type orderServiceServer struct {
+	OrderService
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewOrderServiceServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewOrderServiceServer(svc OrderService, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	return &orderServiceServer{
+		OrderService:     svc,
+		hooks:            serverOpts.Hooks,
+		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:       pathPrefix,
+		jsonSkipDefaults: jsonSkipDefaults,
+		jsonCamelCase:    jsonCamelCase,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *orderServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *orderServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
+
+// OrderServicePathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const OrderServicePathPrefix = "/twirp/go.escape.ship.proto.v1.OrderService/"
+
+func (s *orderServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "OrderService")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "go.escape.ship.proto.v1.OrderService" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "InsertOrder":
+		s.serveInsertOrder(ctx, resp, req)
+		return
+	case "GetAllOrders":
+		s.serveGetAllOrders(ctx, resp, req)
+		return
+	case "GetOrderByID":
+		s.serveGetOrderByID(ctx, resp, req)
+		return
+	case "GetOrdersByUser":
+		s.serveGetOrdersByUser(ctx, resp, req)
+		return
+	case "WatchOrder":
+		s.serveWatchOrder(ctx, resp, req)
+		return
+	case "CancelOrder":
+		s.serveCancelOrder(ctx, resp, req)
+		return
+	case "RefundOrder":
+		s.serveRefundOrder(ctx, resp, req)
+		return
+	case "CreateReturnLabel":
+		s.serveCreateReturnLabel(ctx, resp, req)
+		return
+	case "ImportOrders":
+		s.serveImportOrders(ctx, resp, req)
+		return
+	case "GetOrdersByIDs":
+		s.serveGetOrdersByIDs(ctx, resp, req)
+		return
+	case "ArchiveOrders":
+		s.serveArchiveOrders(ctx, resp, req)
+		return
+	case "GetArchivedOrder":
+		s.serveGetArchivedOrder(ctx, resp, req)
+		return
+	case "CreateQuote":
+		s.serveCreateQuote(ctx, resp, req)
+		return
+	case "AcceptQuote":
+		s.serveAcceptQuote(ctx, resp, req)
+		return
+	case "ConvertQuoteToOrder":
+		s.serveConvertQuoteToOrder(ctx, resp, req)
+		return
+	case "PriceOrder":
+		s.servePriceOrder(ctx, resp, req)
+		return
+	case "CheckPurchaseEligibility":
+		s.serveCheckPurchaseEligibility(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *orderServiceServer) serveInsertOrder(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveInsertOrderJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveInsertOrderProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *orderServiceServer) serveInsertOrderJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "InsertOrder")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(InsertOrderRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.OrderService.InsertOrder
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *InsertOrderRequest) (*InsertOrderResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*InsertOrderRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*InsertOrderRequest) when calling interceptor")
+					}
+					return s.OrderService.InsertOrder(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*InsertOrderResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*InsertOrderResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *InsertOrderResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *InsertOrderResponse and nil error while calling InsertOrder. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderServiceServer) serveInsertOrderProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "InsertOrder")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(InsertOrderRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.OrderService.InsertOrder
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *InsertOrderRequest) (*InsertOrderResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*InsertOrderRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*InsertOrderRequest) when calling interceptor")
+					}
+					return s.OrderService.InsertOrder(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*InsertOrderResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*InsertOrderResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *InsertOrderResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *InsertOrderResponse and nil error while calling InsertOrder. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderServiceServer) serveGetAllOrders(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetAllOrdersJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetAllOrdersProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *orderServiceServer) serveGetAllOrdersJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetAllOrders")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetAllOrdersRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.OrderService.GetAllOrders
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetAllOrdersRequest) (*GetAllOrdersResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetAllOrdersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetAllOrdersRequest) when calling interceptor")
+					}
+					return s.OrderService.GetAllOrders(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetAllOrdersResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetAllOrdersResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetAllOrdersResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetAllOrdersResponse and nil error while calling GetAllOrders. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderServiceServer) serveGetAllOrdersProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetAllOrders")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetAllOrdersRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.OrderService.GetAllOrders
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetAllOrdersRequest) (*GetAllOrdersResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetAllOrdersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetAllOrdersRequest) when calling interceptor")
+					}
+					return s.OrderService.GetAllOrders(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetAllOrdersResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetAllOrdersResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetAllOrdersResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetAllOrdersResponse and nil error while calling GetAllOrders. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderServiceServer) serveGetOrderByID(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetOrderByIDJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetOrderByIDProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *orderServiceServer) serveGetOrderByIDJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetOrderByID")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetOrderByIDRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.OrderService.GetOrderByID
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetOrderByIDRequest) (*GetOrderByIDResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetOrderByIDRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetOrderByIDRequest) when calling interceptor")
+					}
+					return s.OrderService.GetOrderByID(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetOrderByIDResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetOrderByIDResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetOrderByIDResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetOrderByIDResponse and nil error while calling GetOrderByID. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderServiceServer) serveGetOrderByIDProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetOrderByID")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetOrderByIDRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.OrderService.GetOrderByID
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetOrderByIDRequest) (*GetOrderByIDResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetOrderByIDRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetOrderByIDRequest) when calling interceptor")
+					}
+					return s.OrderService.GetOrderByID(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetOrderByIDResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetOrderByIDResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetOrderByIDResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetOrderByIDResponse and nil error while calling GetOrderByID. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderServiceServer) serveGetOrdersByUser(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetOrdersByUserJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetOrdersByUserProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *orderServiceServer) serveGetOrdersByUserJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetOrdersByUser")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetOrdersByUserRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.OrderService.GetOrdersByUser
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetOrdersByUserRequest) (*GetOrdersByUserResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetOrdersByUserRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetOrdersByUserRequest) when calling interceptor")
+					}
+					return s.OrderService.GetOrdersByUser(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetOrdersByUserResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetOrdersByUserResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetOrdersByUserResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetOrdersByUserResponse and nil error while calling GetOrdersByUser. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderServiceServer) serveGetOrdersByUserProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetOrdersByUser")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetOrdersByUserRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.OrderService.GetOrdersByUser
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetOrdersByUserRequest) (*GetOrdersByUserResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetOrdersByUserRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetOrdersByUserRequest) when calling interceptor")
+					}
+					return s.OrderService.GetOrdersByUser(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetOrdersByUserResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetOrdersByUserResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetOrdersByUserResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetOrdersByUserResponse and nil error while calling GetOrdersByUser. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderServiceServer) serveWatchOrder(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveWatchOrderJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveWatchOrderProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *orderServiceServer) serveWatchOrderJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "WatchOrder")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(WatchOrderRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.OrderService.WatchOrder
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *WatchOrderRequest) (*OrderStatusEvent, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*WatchOrderRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*WatchOrderRequest) when calling interceptor")
+					}
+					return s.OrderService.WatchOrder(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*OrderStatusEvent)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*OrderStatusEvent) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *OrderStatusEvent
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *OrderStatusEvent and nil error while calling WatchOrder. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderServiceServer) serveWatchOrderProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "WatchOrder")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(WatchOrderRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.OrderService.WatchOrder
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *WatchOrderRequest) (*OrderStatusEvent, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*WatchOrderRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*WatchOrderRequest) when calling interceptor")
+					}
+					return s.OrderService.WatchOrder(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*OrderStatusEvent)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*OrderStatusEvent) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *OrderStatusEvent
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *OrderStatusEvent and nil error while calling WatchOrder. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderServiceServer) serveCancelOrder(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveCancelOrderJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveCancelOrderProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *orderServiceServer) serveCancelOrderJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CancelOrder")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(CancelOrderRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.OrderService.CancelOrder
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CancelOrderRequest) (*CancelOrderResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CancelOrderRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CancelOrderRequest) when calling interceptor")
+					}
+					return s.OrderService.CancelOrder(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CancelOrderResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CancelOrderResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CancelOrderResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CancelOrderResponse and nil error while calling CancelOrder. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderServiceServer) serveCancelOrderProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CancelOrder")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(CancelOrderRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.OrderService.CancelOrder
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CancelOrderRequest) (*CancelOrderResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CancelOrderRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CancelOrderRequest) when calling interceptor")
+					}
+					return s.OrderService.CancelOrder(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CancelOrderResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CancelOrderResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CancelOrderResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CancelOrderResponse and nil error while calling CancelOrder. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderServiceServer) serveRefundOrder(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveRefundOrderJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveRefundOrderProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *orderServiceServer) serveRefundOrderJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RefundOrder")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(RefundOrderRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.OrderService.RefundOrder
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RefundOrderRequest) (*RefundOrderResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RefundOrderRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RefundOrderRequest) when calling interceptor")
+					}
+					return s.OrderService.RefundOrder(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RefundOrderResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RefundOrderResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RefundOrderResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RefundOrderResponse and nil error while calling RefundOrder. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderServiceServer) serveRefundOrderProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RefundOrder")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(RefundOrderRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.OrderService.RefundOrder
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RefundOrderRequest) (*RefundOrderResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RefundOrderRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RefundOrderRequest) when calling interceptor")
+					}
+					return s.OrderService.RefundOrder(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RefundOrderResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RefundOrderResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RefundOrderResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RefundOrderResponse and nil error while calling RefundOrder. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderServiceServer) serveCreateReturnLabel(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveCreateReturnLabelJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveCreateReturnLabelProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *orderServiceServer) serveCreateReturnLabelJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateReturnLabel")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(CreateReturnLabelRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.OrderService.CreateReturnLabel
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateReturnLabelRequest) (*CreateReturnLabelResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateReturnLabelRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateReturnLabelRequest) when calling interceptor")
+					}
+					return s.OrderService.CreateReturnLabel(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateReturnLabelResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateReturnLabelResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreateReturnLabelResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateReturnLabelResponse and nil error while calling CreateReturnLabel. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderServiceServer) serveCreateReturnLabelProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateReturnLabel")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(CreateReturnLabelRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.OrderService.CreateReturnLabel
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateReturnLabelRequest) (*CreateReturnLabelResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateReturnLabelRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateReturnLabelRequest) when calling interceptor")
+					}
+					return s.OrderService.CreateReturnLabel(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateReturnLabelResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateReturnLabelResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreateReturnLabelResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateReturnLabelResponse and nil error while calling CreateReturnLabel. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderServiceServer) serveImportOrders(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveImportOrdersJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveImportOrdersProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *orderServiceServer) serveImportOrdersJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ImportOrders")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ImportOrdersRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.OrderService.ImportOrders
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ImportOrdersRequest) (*ImportOrdersResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ImportOrdersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ImportOrdersRequest) when calling interceptor")
+					}
+					return s.OrderService.ImportOrders(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ImportOrdersResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ImportOrdersResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ImportOrdersResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ImportOrdersResponse and nil error while calling ImportOrders. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderServiceServer) serveImportOrdersProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ImportOrders")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ImportOrdersRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.OrderService.ImportOrders
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ImportOrdersRequest) (*ImportOrdersResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ImportOrdersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ImportOrdersRequest) when calling interceptor")
+					}
+					return s.OrderService.ImportOrders(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ImportOrdersResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ImportOrdersResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ImportOrdersResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ImportOrdersResponse and nil error while calling ImportOrders. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderServiceServer) serveGetOrdersByIDs(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetOrdersByIDsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetOrdersByIDsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *orderServiceServer) serveGetOrdersByIDsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetOrdersByIDs")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetOrdersByIDsRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.OrderService.GetOrdersByIDs
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetOrdersByIDsRequest) (*GetOrdersByIDsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetOrdersByIDsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetOrdersByIDsRequest) when calling interceptor")
+					}
+					return s.OrderService.GetOrdersByIDs(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetOrdersByIDsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetOrdersByIDsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetOrdersByIDsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetOrdersByIDsResponse and nil error while calling GetOrdersByIDs. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderServiceServer) serveGetOrdersByIDsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetOrdersByIDs")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetOrdersByIDsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.OrderService.GetOrdersByIDs
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetOrdersByIDsRequest) (*GetOrdersByIDsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetOrdersByIDsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetOrdersByIDsRequest) when calling interceptor")
+					}
+					return s.OrderService.GetOrdersByIDs(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetOrdersByIDsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetOrdersByIDsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetOrdersByIDsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetOrdersByIDsResponse and nil error while calling GetOrdersByIDs. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderServiceServer) serveArchiveOrders(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveArchiveOrdersJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveArchiveOrdersProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *orderServiceServer) serveArchiveOrdersJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ArchiveOrders")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ArchiveOrdersRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.OrderService.ArchiveOrders
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ArchiveOrdersRequest) (*ArchiveOrdersResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ArchiveOrdersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ArchiveOrdersRequest) when calling interceptor")
+					}
+					return s.OrderService.ArchiveOrders(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ArchiveOrdersResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ArchiveOrdersResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ArchiveOrdersResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ArchiveOrdersResponse and nil error while calling ArchiveOrders. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderServiceServer) serveArchiveOrdersProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ArchiveOrders")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ArchiveOrdersRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.OrderService.ArchiveOrders
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ArchiveOrdersRequest) (*ArchiveOrdersResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ArchiveOrdersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ArchiveOrdersRequest) when calling interceptor")
+					}
+					return s.OrderService.ArchiveOrders(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ArchiveOrdersResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ArchiveOrdersResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ArchiveOrdersResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ArchiveOrdersResponse and nil error while calling ArchiveOrders. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderServiceServer) serveGetArchivedOrder(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetArchivedOrderJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetArchivedOrderProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *orderServiceServer) serveGetArchivedOrderJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetArchivedOrder")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetArchivedOrderRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.OrderService.GetArchivedOrder
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetArchivedOrderRequest) (*GetArchivedOrderResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetArchivedOrderRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetArchivedOrderRequest) when calling interceptor")
+					}
+					return s.OrderService.GetArchivedOrder(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetArchivedOrderResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetArchivedOrderResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetArchivedOrderResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetArchivedOrderResponse and nil error while calling GetArchivedOrder. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderServiceServer) serveGetArchivedOrderProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetArchivedOrder")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetArchivedOrderRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.OrderService.GetArchivedOrder
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetArchivedOrderRequest) (*GetArchivedOrderResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetArchivedOrderRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetArchivedOrderRequest) when calling interceptor")
+					}
+					return s.OrderService.GetArchivedOrder(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetArchivedOrderResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetArchivedOrderResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetArchivedOrderResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetArchivedOrderResponse and nil error while calling GetArchivedOrder. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderServiceServer) serveCreateQuote(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveCreateQuoteJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveCreateQuoteProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *orderServiceServer) serveCreateQuoteJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateQuote")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(CreateQuoteRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.OrderService.CreateQuote
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateQuoteRequest) (*CreateQuoteResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateQuoteRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateQuoteRequest) when calling interceptor")
+					}
+					return s.OrderService.CreateQuote(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateQuoteResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateQuoteResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreateQuoteResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateQuoteResponse and nil error while calling CreateQuote. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderServiceServer) serveCreateQuoteProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateQuote")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(CreateQuoteRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.OrderService.CreateQuote
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateQuoteRequest) (*CreateQuoteResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateQuoteRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateQuoteRequest) when calling interceptor")
+					}
+					return s.OrderService.CreateQuote(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateQuoteResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateQuoteResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreateQuoteResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateQuoteResponse and nil error while calling CreateQuote. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderServiceServer) serveAcceptQuote(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveAcceptQuoteJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveAcceptQuoteProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *orderServiceServer) serveAcceptQuoteJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "AcceptQuote")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(AcceptQuoteRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.OrderService.AcceptQuote
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *AcceptQuoteRequest) (*AcceptQuoteResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AcceptQuoteRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AcceptQuoteRequest) when calling interceptor")
+					}
+					return s.OrderService.AcceptQuote(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AcceptQuoteResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AcceptQuoteResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *AcceptQuoteResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AcceptQuoteResponse and nil error while calling AcceptQuote. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderServiceServer) serveAcceptQuoteProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "AcceptQuote")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(AcceptQuoteRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.OrderService.AcceptQuote
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *AcceptQuoteRequest) (*AcceptQuoteResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AcceptQuoteRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AcceptQuoteRequest) when calling interceptor")
+					}
+					return s.OrderService.AcceptQuote(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AcceptQuoteResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AcceptQuoteResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *AcceptQuoteResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AcceptQuoteResponse and nil error while calling AcceptQuote. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderServiceServer) serveConvertQuoteToOrder(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveConvertQuoteToOrderJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveConvertQuoteToOrderProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *orderServiceServer) serveConvertQuoteToOrderJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ConvertQuoteToOrder")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ConvertQuoteToOrderRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.OrderService.ConvertQuoteToOrder
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ConvertQuoteToOrderRequest) (*ConvertQuoteToOrderResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ConvertQuoteToOrderRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ConvertQuoteToOrderRequest) when calling interceptor")
+					}
+					return s.OrderService.ConvertQuoteToOrder(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ConvertQuoteToOrderResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ConvertQuoteToOrderResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ConvertQuoteToOrderResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ConvertQuoteToOrderResponse and nil error while calling ConvertQuoteToOrder. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderServiceServer) serveConvertQuoteToOrderProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ConvertQuoteToOrder")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ConvertQuoteToOrderRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.OrderService.ConvertQuoteToOrder
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ConvertQuoteToOrderRequest) (*ConvertQuoteToOrderResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ConvertQuoteToOrderRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ConvertQuoteToOrderRequest) when calling interceptor")
+					}
+					return s.OrderService.ConvertQuoteToOrder(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ConvertQuoteToOrderResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ConvertQuoteToOrderResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ConvertQuoteToOrderResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ConvertQuoteToOrderResponse and nil error while calling ConvertQuoteToOrder. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderServiceServer) servePriceOrder(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.servePriceOrderJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.servePriceOrderProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *orderServiceServer) servePriceOrderJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "PriceOrder")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(PriceOrderRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.OrderService.PriceOrder
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *PriceOrderRequest) (*PriceOrderResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PriceOrderRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PriceOrderRequest) when calling interceptor")
+					}
+					return s.OrderService.PriceOrder(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PriceOrderResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PriceOrderResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *PriceOrderResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *PriceOrderResponse and nil error while calling PriceOrder. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderServiceServer) servePriceOrderProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "PriceOrder")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(PriceOrderRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.OrderService.PriceOrder
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *PriceOrderRequest) (*PriceOrderResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PriceOrderRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PriceOrderRequest) when calling interceptor")
+					}
+					return s.OrderService.PriceOrder(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PriceOrderResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PriceOrderResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *PriceOrderResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *PriceOrderResponse and nil error while calling PriceOrder. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderServiceServer) serveCheckPurchaseEligibility(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveCheckPurchaseEligibilityJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveCheckPurchaseEligibilityProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *orderServiceServer) serveCheckPurchaseEligibilityJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CheckPurchaseEligibility")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(CheckPurchaseEligibilityRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.OrderService.CheckPurchaseEligibility
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CheckPurchaseEligibilityRequest) (*CheckPurchaseEligibilityResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CheckPurchaseEligibilityRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CheckPurchaseEligibilityRequest) when calling interceptor")
+					}
+					return s.OrderService.CheckPurchaseEligibility(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CheckPurchaseEligibilityResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CheckPurchaseEligibilityResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CheckPurchaseEligibilityResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CheckPurchaseEligibilityResponse and nil error while calling CheckPurchaseEligibility. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderServiceServer) serveCheckPurchaseEligibilityProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CheckPurchaseEligibility")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(CheckPurchaseEligibilityRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.OrderService.CheckPurchaseEligibility
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CheckPurchaseEligibilityRequest) (*CheckPurchaseEligibilityResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CheckPurchaseEligibilityRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CheckPurchaseEligibilityRequest) when calling interceptor")
+					}
+					return s.OrderService.CheckPurchaseEligibility(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CheckPurchaseEligibilityResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CheckPurchaseEligibilityResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CheckPurchaseEligibilityResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CheckPurchaseEligibilityResponse and nil error while calling CheckPurchaseEligibility. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *orderServiceServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor7, 0
+}
+
+func (s *orderServiceServer) ProtocGenTwirpVersion() string {
+	return "v8.1.3"
+}
+
+// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
+// that is everything in a Twirp route except for the <Method>. This can be used for routing,
+// for example to identify the requests that are targeted to this service in a mux.
+func (s *orderServiceServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "go.escape.ship.proto.v1", "OrderService")
+}
+
+var twirpFileDescriptor7 = []byte{
+	// 4179 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5b, 0x4d, 0x6c, 0x1b, 0x59,
+	0x72, 0xde, 0x26, 0x45, 0x8a, 0x2c, 0x52, 0x14, 0xf5, 0x64, 0xc9, 0x14, 0x6d, 0xaf, 0xe4, 0xf6,
+	0x7a, 0x46, 0xf6, 0xd8, 0xe2, 0x8e, 0x67, 0xb1, 0xe3, 0x99, 0x8d, 0x27, 0x4b, 0x91, 0x94, 0x87,
+	0x19, 0x5b, 0xd2, 0xb4, 0x24, 0xef, 0x22, 0x01, 0xd2, 0x68, 0x75, 0x3f, 0x51, 0x1d, 0x93, 0xdd,
+	0x9c, 0xfe, 0x91, 0xad, 0x31, 0x9c, 0x45, 0x16, 0x13, 0x60, 0x77, 0x11, 0x60, 0x13, 0x04, 0x48,
+	0x06, 0xb9, 0x06, 0xc8, 0x21, 0x39, 0xe5, 0x12, 0x20, 0xf7, 0x1c, 0x92, 0x63, 0x90, 0x00, 0x01,
+	0x02, 0xe4, 0xb0, 0x40, 0x0e, 0x39, 0xe4, 0x90, 0x63, 0x72, 0x0b, 0xde, 0x5f, 0xb3, 0xbb, 0xc9,
+	0x26, 0x9b, 0xf6, 0x00, 0xc9, 0x8d, 0x5d, 0xaf, 0xea, 0xf5, 0xf7, 0xea, 0x55, 0xd5, 0xab, 0x57,
+	0xd5, 0x84, 0x92, 0xed, 0x18, 0xd8, 0xd9, 0x19, 0x3a, 0xb6, 0x67, 0xa3, 0xab, 0x3d, 0x7b, 0x07,
+	0xbb, 0xba, 0x36, 0xc4, 0x3b, 0xee, 0xb9, 0x39, 0x64, 0xd4, 0x9d, 0x8b, 0xf7, 0xeb, 0x65, 0xdd,
+	0x1e, 0x0c, 0x6c, 0x8b, 0x11, 0xea, 0xd7, 0x7b, 0xb6, 0xdd, 0xeb, 0xe3, 0x86, 0x36, 0x34, 0x1b,
+	0x9a, 0x65, 0xd9, 0x9e, 0xe6, 0x99, 0xb6, 0xe5, 0xf2, 0xd1, 0xa5, 0xa1, 0x63, 0x1b, 0xbe, 0xee,
+	0xf1, 0xc7, 0x0a, 0x99, 0x69, 0x68, 0x5a, 0x3d, 0xfe, 0xbc, 0xc5, 0x85, 0xe9, 0xd3, 0xa9, 0x7f,
+	0xd6, 0x38, 0x33, 0x71, 0xdf, 0x50, 0x07, 0x9a, 0xfb, 0x9c, 0x73, 0x6c, 0xc6, 0x39, 0x3c, 0x73,
+	0x80, 0x5d, 0x4f, 0x1b, 0x70, 0x40, 0xf2, 0xdf, 0x15, 0x20, 0x77, 0x40, 0x60, 0xa3, 0x0a, 0x64,
+	0x4c, 0xa3, 0x26, 0x6d, 0x49, 0xdb, 0x45, 0x25, 0x63, 0x1a, 0xe8, 0x2a, 0x2c, 0xfa, 0x2e, 0x76,
+	0x54, 0xd3, 0xa8, 0x65, 0x28, 0x31, 0x4f, 0x1e, 0xbb, 0x06, 0xba, 0x09, 0x65, 0xba, 0x50, 0xd5,
+	0xf2, 0x07, 0xa7, 0xd8, 0xa9, 0x65, 0xe9, 0x28, 0x5b, 0xfc, 0x3e, 0x25, 0xa1, 0x3a, 0xe4, 0x5d,
+	0x4f, 0xf3, 0x7c, 0xb7, 0xb6, 0x40, 0x06, 0x77, 0x33, 0x35, 0x49, 0xe1, 0x14, 0xb4, 0x09, 0x25,
+	0xcf, 0xf6, 0xb4, 0xbe, 0x3a, 0x74, 0x4c, 0x1d, 0xd7, 0x72, 0x5b, 0xd2, 0x76, 0x56, 0x01, 0x4a,
+	0x3a, 0x24, 0x14, 0x54, 0x87, 0xc2, 0x17, 0xbe, 0x66, 0x79, 0xa6, 0x77, 0x59, 0xcb, 0x6f, 0x49,
+	0xdb, 0x39, 0x25, 0x78, 0x46, 0xb7, 0xa1, 0x32, 0xd4, 0x2e, 0x07, 0xd8, 0xf2, 0xd4, 0x01, 0xf6,
+	0xce, 0x6d, 0xa3, 0xb6, 0x48, 0xdf, 0xbe, 0xc4, 0xa9, 0x4f, 0x29, 0x11, 0xbd, 0x03, 0x65, 0xa1,
+	0x2a, 0xf5, 0x0c, 0xe3, 0x5a, 0x81, 0x4c, 0xf3, 0xe9, 0xb7, 0x94, 0x92, 0xa0, 0xee, 0x61, 0xfc,
+	0x33, 0x49, 0x42, 0xf7, 0xa1, 0x1a, 0xf0, 0x69, 0x86, 0xe1, 0x60, 0xd7, 0xad, 0x15, 0x03, 0xc4,
+	0xcb, 0x62, 0xac, 0xc9, 0x86, 0xd0, 0x4d, 0x00, 0xba, 0x4a, 0x6c, 0xa8, 0x9a, 0x57, 0x83, 0x80,
+	0xb1, 0xc8, 0xa9, 0x4d, 0x0f, 0x5d, 0x83, 0xc5, 0xa1, 0x66, 0xd2, 0xf1, 0xd2, 0x68, 0xe9, 0x84,
+	0xd4, 0xf4, 0x10, 0x82, 0x85, 0x01, 0x1e, 0xd8, 0xb5, 0x32, 0xc5, 0x4c, 0x7f, 0xa3, 0x87, 0x90,
+	0x33, 0x3d, 0x3c, 0x70, 0x6b, 0x4b, 0x5b, 0xd9, 0xed, 0xd2, 0x03, 0x79, 0x27, 0xc1, 0x6e, 0x76,
+	0xe8, 0x2e, 0x75, 0x3d, 0x3c, 0x50, 0x98, 0x00, 0xea, 0xc0, 0xa2, 0xee, 0xbb, 0x9e, 0x3d, 0x70,
+	0x6b, 0x95, 0x2d, 0x69, 0xbb, 0xf4, 0xe0, 0xbd, 0x44, 0xd9, 0x16, 0xe3, 0x6b, 0x63, 0xbd, 0xaf,
+	0x39, 0xd4, 0xc2, 0x14, 0x21, 0x8b, 0x3e, 0x80, 0xcc, 0xd9, 0xcb, 0xda, 0x32, 0x9d, 0xe1, 0x56,
+	0xe2, 0x0c, 0x7b, 0x2f, 0x8f, 0x2c, 0x6d, 0xe8, 0x9e, 0xdb, 0x9e, 0x92, 0x39, 0x7b, 0x89, 0x7e,
+	0x03, 0x84, 0xc6, 0x55, 0x0f, 0x3b, 0x03, 0xb7, 0x56, 0xa5, 0xf2, 0xb7, 0x13, 0xe5, 0x0f, 0x19,
+	0xf7, 0x31, 0x61, 0x56, 0xca, 0xc3, 0xd0, 0x13, 0x7a, 0x2c, 0xec, 0x89, 0x9b, 0xcc, 0xca, 0x96,
+	0xb4, 0x5d, 0x79, 0xf0, 0x9d, 0xe9, 0x8a, 0x38, 0xa2, 0xbc, 0xdc, 0xea, 0xd8, 0x03, 0xfa, 0x08,
+	0x16, 0x1d, 0x7c, 0xe6, 0x5b, 0x86, 0x5b, 0x43, 0x54, 0x99, 0x9b, 0x89, 0x73, 0x28, 0x94, 0x4f,
+	0x11, 0xfc, 0xe8, 0x31, 0x2c, 0xb3, 0x9f, 0x64, 0x6b, 0x07, 0xb6, 0x6f, 0x79, 0xb5, 0x55, 0xba,
+	0xa2, 0x6f, 0x27, 0x4e, 0xf1, 0xd4, 0xb6, 0xf0, 0xa5, 0x52, 0x11, 0x62, 0x4d, 0x2a, 0x85, 0x3e,
+	0x83, 0xaa, 0x81, 0xfb, 0xe6, 0x05, 0x76, 0x2e, 0x03, 0x8b, 0xba, 0x42, 0x67, 0xda, 0x4a, 0x9c,
+	0x89, 0x9b, 0x97, 0xb2, 0x2c, 0x24, 0x85, 0xbd, 0x3d, 0xe2, 0x9a, 0xc1, 0x86, 0x4a, 0xfc, 0xb6,
+	0xb6, 0x46, 0x27, 0xaa, 0xef, 0x30, 0xa7, 0xde, 0x11, 0x4e, 0xbd, 0x73, 0x2c, 0x9c, 0x9a, 0xeb,
+	0x03, 0x1b, 0x84, 0x82, 0x3e, 0x84, 0x22, 0xb5, 0x45, 0x2a, 0xbb, 0x3e, 0x53, 0xb6, 0x40, 0x98,
+	0xa9, 0xe0, 0x26, 0x94, 0xc4, 0xee, 0xea, 0xa6, 0x51, 0xbb, 0x4a, 0xcd, 0x15, 0x38, 0xa9, 0x65,
+	0x1a, 0xbb, 0xcb, 0xb0, 0xa4, 0x86, 0x1d, 0x4c, 0xfe, 0x4a, 0x02, 0x60, 0x3a, 0x25, 0x16, 0x8a,
+	0x64, 0x58, 0x62, 0x5b, 0x4a, 0x2c, 0x55, 0x0d, 0xc2, 0x0a, 0x43, 0x47, 0x38, 0xba, 0x46, 0xc4,
+	0xcd, 0x33, 0x31, 0x37, 0xff, 0x3e, 0xe4, 0xf9, 0x2e, 0x64, 0x53, 0xed, 0x02, 0xe7, 0x96, 0xbf,
+	0xca, 0x43, 0x9e, 0xc1, 0x18, 0x0b, 0x67, 0x1b, 0x50, 0xe0, 0x90, 0x44, 0x3c, 0x5b, 0x64, 0x68,
+	0x0c, 0xf4, 0x28, 0x88, 0x56, 0x59, 0x6a, 0x7a, 0xb7, 0x67, 0x98, 0x0d, 0xb7, 0x3d, 0x11, 0xd0,
+	0x46, 0x60, 0x17, 0xe6, 0x01, 0x8b, 0xf6, 0x60, 0xd9, 0xd3, 0x5e, 0xaa, 0x67, 0x0e, 0xc6, 0xc2,
+	0xe6, 0x72, 0xa9, 0x26, 0x58, 0xf2, 0xb4, 0x97, 0x7b, 0x0e, 0xc6, 0xdc, 0xe4, 0x1e, 0x01, 0x5c,
+	0x68, 0x9e, 0x98, 0x22, 0x9f, 0x6a, 0x8a, 0xe2, 0x85, 0xe6, 0x71, 0xf1, 0x8f, 0x44, 0x00, 0x5a,
+	0xdc, 0xca, 0x4e, 0x0d, 0x01, 0xa3, 0xfd, 0x15, 0x11, 0x68, 0x1d, 0xf2, 0x0e, 0xd6, 0x5c, 0xdb,
+	0xa2, 0x01, 0xb6, 0xa8, 0xf0, 0x27, 0xb4, 0x0d, 0xd5, 0xa1, 0xe6, 0x78, 0x16, 0x76, 0xd4, 0x40,
+	0xe7, 0x34, 0xac, 0x2a, 0x15, 0x4e, 0x3f, 0xe0, 0xaa, 0xbf, 0x0d, 0x95, 0x33, 0xcd, 0xec, 0xfb,
+	0x0e, 0x56, 0xf9, 0x4c, 0xc0, 0xe2, 0x39, 0xa7, 0x2a, 0x6c, 0xc2, 0xdb, 0x50, 0x76, 0xf0, 0x17,
+	0x3e, 0x76, 0x3d, 0x1c, 0x0b, 0xad, 0xa5, 0x80, 0xde, 0xf4, 0x08, 0x9b, 0x6e, 0x0f, 0x86, 0x7d,
+	0xcc, 0xd9, 0xca, 0x23, 0xb6, 0x80, 0xde, 0xf4, 0x88, 0xb3, 0x8f, 0xa2, 0x3e, 0xd3, 0xda, 0x52,
+	0x3a, 0x67, 0x0f, 0x0e, 0x04, 0xa6, 0xba, 0x26, 0x54, 0x46, 0xb0, 0xa8, 0x97, 0x55, 0x66, 0x7a,
+	0xd9, 0x52, 0x20, 0x41, 0x5d, 0xad, 0x09, 0x95, 0x11, 0x64, 0x3a, 0xc5, 0xf2, 0xec, 0x29, 0x02,
+	0x09, 0x3a, 0x45, 0x15, 0xb2, 0xc4, 0x4b, 0xab, 0x54, 0x71, 0xe4, 0xa7, 0xdc, 0x86, 0x72, 0x38,
+	0xde, 0x12, 0xdb, 0xb7, 0xb0, 0xa7, 0x1a, 0xda, 0xa5, 0x4b, 0x3d, 0x22, 0xa7, 0x2c, 0x5a, 0xd8,
+	0x6b, 0x6b, 0x97, 0x74, 0xc8, 0xf0, 0xb1, 0x6a, 0x68, 0x1e, 0x16, 0x6e, 0x61, 0xf8, 0xb8, 0xad,
+	0x79, 0x58, 0xfe, 0x79, 0x06, 0xd0, 0xf8, 0xc1, 0x81, 0x1e, 0xc0, 0xda, 0x10, 0x3b, 0xae, 0x6d,
+	0x69, 0x7d, 0x95, 0x9f, 0x21, 0xaa, 0x6e, 0x1b, 0x98, 0xfb, 0xda, 0xaa, 0x18, 0xe4, 0xa2, 0x2d,
+	0xdb, 0xc0, 0xa8, 0x01, 0xab, 0x06, 0x76, 0x3d, 0xd3, 0xa2, 0x53, 0xa8, 0x3a, 0xd1, 0x9e, 0x73,
+	0xc9, 0x5f, 0x88, 0x42, 0x43, 0x2d, 0x36, 0x82, 0xde, 0x83, 0x15, 0x83, 0xbe, 0x13, 0x1b, 0xaa,
+	0xee, 0x3b, 0x0e, 0xb6, 0xf4, 0x4b, 0x9e, 0x68, 0x54, 0xc5, 0x40, 0x8b, 0xd3, 0x89, 0x11, 0x05,
+	0xcc, 0x17, 0x5a, 0xdf, 0xc7, 0xd4, 0x11, 0xb3, 0xca, 0x92, 0xa0, 0x3e, 0x23, 0x44, 0xf4, 0xb1,
+	0x30, 0xf4, 0x1c, 0x35, 0xf4, 0xef, 0xcc, 0x3a, 0x2d, 0x43, 0x96, 0x2e, 0xff, 0xa3, 0x04, 0xa5,
+	0x10, 0x19, 0xdd, 0x00, 0xe0, 0xa9, 0xd9, 0x28, 0xba, 0x15, 0x39, 0xa5, 0x4b, 0x73, 0xa7, 0x73,
+	0xae, 0x15, 0x9e, 0x3b, 0x9d, 0x33, 0x45, 0x6c, 0x41, 0xc9, 0xc0, 0xae, 0xee, 0x98, 0x43, 0xb2,
+	0x5a, 0x91, 0x3a, 0x85, 0x48, 0x91, 0xb0, 0xb8, 0x30, 0x9e, 0xfd, 0xc4, 0x16, 0x9a, 0x9b, 0xb4,
+	0xd0, 0xdb, 0x50, 0xb1, 0x1d, 0xb3, 0x67, 0x8e, 0x14, 0x9d, 0x67, 0x4e, 0xc5, 0xa8, 0x5c, 0xc7,
+	0xf2, 0x7f, 0x65, 0xa0, 0x18, 0x24, 0x15, 0xf3, 0xc4, 0xcb, 0xe8, 0xe2, 0xb3, 0xf1, 0xc5, 0xdf,
+	0x84, 0xb2, 0x18, 0xb6, 0xb4, 0x01, 0xdb, 0x8c, 0xa2, 0x52, 0xe2, 0xb4, 0x7d, 0x6d, 0x80, 0xd1,
+	0xbb, 0x20, 0x32, 0xdb, 0x70, 0x16, 0x48, 0x3d, 0x55, 0xc8, 0xce, 0xce, 0x05, 0xaf, 0x41, 0xf1,
+	0xd4, 0xb7, 0x8c, 0x3e, 0x56, 0x4d, 0x91, 0x06, 0x16, 0x18, 0xa1, 0x6b, 0xa0, 0x13, 0x58, 0xe1,
+	0x83, 0xc4, 0x59, 0x6c, 0x0b, 0x5b, 0x9e, 0x5b, 0x2b, 0xd0, 0x8d, 0xdf, 0x4e, 0xdc, 0xf8, 0x5d,
+	0x2a, 0xd1, 0x12, 0x02, 0x4a, 0xf5, 0x34, 0x4a, 0x20, 0x27, 0x32, 0xf8, 0x96, 0x29, 0x50, 0x17,
+	0xd3, 0xc5, 0x5a, 0x22, 0x41, 0x97, 0x23, 0xff, 0x2a, 0x0f, 0xa8, 0x6b, 0xb9, 0xd8, 0xf1, 0xa8,
+	0xe2, 0x15, 0x16, 0x0a, 0xc2, 0xa9, 0xb6, 0x34, 0x35, 0xd5, 0xce, 0x4c, 0x4b, 0xb5, 0xb3, 0xb3,
+	0x52, 0xed, 0x85, 0xa9, 0xa9, 0x76, 0x6e, 0x66, 0xaa, 0x9d, 0x4f, 0x93, 0x6a, 0x2f, 0xce, 0x91,
+	0x6a, 0x17, 0x92, 0x53, 0xed, 0x50, 0x1e, 0x5d, 0x4c, 0xcc, 0xa3, 0x21, 0x94, 0x47, 0x7f, 0x22,
+	0xbc, 0xbb, 0x3c, 0x63, 0x93, 0x43, 0xfa, 0x4f, 0xc8, 0xa6, 0x97, 0xde, 0x3a, 0x9b, 0xae, 0xcc,
+	0x97, 0x4d, 0xef, 0x42, 0xde, 0xc0, 0x17, 0xa6, 0x2e, 0x82, 0xff, 0xdd, 0x44, 0xc1, 0x36, 0x65,
+	0xdb, 0x33, 0xad, 0x1e, 0x76, 0x86, 0x8e, 0x69, 0x79, 0x0a, 0x97, 0x1c, 0xcb, 0xa2, 0xab, 0x6f,
+	0x9a, 0x45, 0x4f, 0xca, 0x60, 0x57, 0xde, 0x34, 0x83, 0x7d, 0x17, 0x96, 0x4d, 0x03, 0x0f, 0x86,
+	0xb6, 0x47, 0x22, 0xb5, 0xfa, 0x1c, 0x5f, 0xd6, 0x10, 0x4b, 0x04, 0x42, 0xe4, 0xcf, 0xf0, 0x65,
+	0x34, 0x57, 0x5d, 0x4d, 0x9f, 0xab, 0x8e, 0xa7, 0xa2, 0xff, 0x2a, 0xc1, 0x72, 0x6c, 0x8f, 0x67,
+	0x85, 0xeb, 0x78, 0xc4, 0xca, 0x4c, 0x8a, 0x58, 0xcb, 0x82, 0xc5, 0xa6, 0x81, 0x9a, 0xfb, 0x9b,
+	0x52, 0xe1, 0xe4, 0x03, 0x46, 0x45, 0xb7, 0xe2, 0xa1, 0x8d, 0x79, 0x5d, 0x72, 0x58, 0xcb, 0x4d,
+	0x0b, 0x6b, 0xf9, 0x68, 0x58, 0x93, 0x6f, 0xc3, 0x6a, 0x24, 0x7e, 0xb8, 0x43, 0xdb, 0x72, 0x71,
+	0x3c, 0x78, 0xcb, 0xbf, 0x90, 0x60, 0xf5, 0x31, 0xf6, 0x9a, 0xfd, 0x3e, 0xe5, 0x73, 0x45, 0xa0,
+	0xf9, 0x10, 0x8a, 0x0e, 0xd6, 0x58, 0x85, 0xa0, 0x26, 0x25, 0x68, 0x79, 0x8f, 0x14, 0x11, 0x9e,
+	0x6a, 0xee, 0x73, 0xa5, 0x40, 0x98, 0xc9, 0x2f, 0x02, 0x6a, 0xa8, 0xf5, 0xb0, 0xea, 0x9a, 0x5f,
+	0x62, 0x91, 0xad, 0x13, 0xc2, 0x91, 0xf9, 0x25, 0xa6, 0xda, 0x25, 0x83, 0x9e, 0xfd, 0x1c, 0x5b,
+	0xc1, 0x79, 0xa0, 0xf5, 0xf0, 0x31, 0x21, 0xc8, 0x3b, 0xb0, 0xf2, 0x23, 0xcd, 0xd3, 0xcf, 0x23,
+	0x21, 0x2f, 0x7c, 0xbc, 0x48, 0x91, 0xe3, 0x45, 0xfe, 0xef, 0x0c, 0x54, 0x43, 0xd6, 0xd9, 0xb9,
+	0xc0, 0xd6, 0x34, 0x7e, 0xf4, 0x6b, 0x41, 0x04, 0xcc, 0xcc, 0x61, 0xf3, 0x22, 0x46, 0x3e, 0x25,
+	0x1b, 0x8b, 0x2f, 0x4c, 0xdb, 0x77, 0xd5, 0xc8, 0x2d, 0x20, 0xdd, 0x34, 0x15, 0x21, 0xcc, 0xbd,
+	0xe7, 0x26, 0x80, 0x7e, 0xae, 0x59, 0x3d, 0x96, 0x80, 0x8e, 0xaa, 0x1f, 0x45, 0x4e, 0x6d, 0x7a,
+	0xa1, 0xac, 0x39, 0x17, 0xc9, 0x9a, 0x77, 0xa1, 0xe0, 0x39, 0x9a, 0xfe, 0xdc, 0xb4, 0x7a, 0x3c,
+	0x8b, 0x7f, 0x27, 0x11, 0xc2, 0x31, 0x67, 0xa4, 0xca, 0x51, 0x02, 0x39, 0x72, 0x63, 0x14, 0xaf,
+	0xa7, 0x9e, 0xb4, 0x38, 0xfb, 0xc6, 0xc8, 0xf9, 0x09, 0x45, 0x1e, 0x02, 0x6a, 0x69, 0x96, 0x8e,
+	0xfb, 0x29, 0xf7, 0x2a, 0xb4, 0x96, 0x4c, 0x64, 0x2d, 0x13, 0xfc, 0x3e, 0x3b, 0xc9, 0xef, 0xc9,
+	0xc5, 0x71, 0x35, 0xf2, 0x4a, 0x6e, 0xd1, 0xdf, 0x83, 0x1c, 0x7d, 0x47, 0x4d, 0x9a, 0x71, 0xc6,
+	0x32, 0x31, 0xc6, 0x8c, 0x3e, 0x24, 0x70, 0xc8, 0x2d, 0x85, 0xc2, 0x49, 0x51, 0x00, 0xe0, 0xec,
+	0xf2, 0x5f, 0x65, 0x00, 0x31, 0x52, 0xda, 0x95, 0x07, 0xd7, 0xa6, 0xcc, 0xdc, 0xd7, 0xa6, 0x37,
+	0xbc, 0xdd, 0x4e, 0xba, 0x30, 0x2e, 0xbc, 0xc9, 0x85, 0x31, 0xc9, 0x00, 0x27, 0x6c, 0x5a, 0x3e,
+	0x71, 0xd3, 0x22, 0xda, 0xfa, 0xbf, 0xd9, 0xb4, 0x3f, 0x95, 0xe0, 0x4a, 0x34, 0xca, 0x71, 0x1c,
+	0xdf, 0x87, 0x3c, 0x9d, 0x9a, 0xdc, 0x76, 0xb2, 0x29, 0x80, 0x70, 0x6e, 0xf4, 0x0e, 0x2c, 0x5b,
+	0xf8, 0xa5, 0xa7, 0x86, 0xa2, 0x19, 0x33, 0xeb, 0x25, 0x42, 0x3e, 0x14, 0x11, 0x6d, 0x94, 0x57,
+	0xe9, 0xc1, 0x2e, 0xe6, 0x78, 0x5e, 0x45, 0x93, 0x6b, 0xf9, 0x7f, 0x32, 0x50, 0x52, 0xb0, 0xe7,
+	0x3b, 0xd6, 0x13, 0xed, 0x14, 0xf7, 0x49, 0xf8, 0x74, 0xe8, 0xe3, 0xc8, 0x90, 0x0a, 0x8c, 0xd0,
+	0x35, 0x50, 0x0d, 0x16, 0x75, 0xcd, 0x71, 0xcc, 0x20, 0xbf, 0x13, 0x8f, 0x64, 0x43, 0x84, 0x67,
+	0x47, 0x8b, 0xad, 0x15, 0x41, 0xe6, 0x49, 0xe0, 0x35, 0x28, 0xf6, 0xc9, 0x8b, 0x54, 0xdf, 0xe9,
+	0xf3, 0x7c, 0xbb, 0x40, 0x09, 0x27, 0x4e, 0x1f, 0xdd, 0x85, 0x95, 0xa1, 0xa9, 0x3f, 0xf7, 0x87,
+	0xea, 0xa9, 0x6d, 0xd3, 0xb9, 0x4c, 0x83, 0xef, 0xfc, 0x32, 0x1b, 0xd8, 0x65, 0xf4, 0xae, 0x41,
+	0x56, 0xc6, 0x79, 0xe9, 0x8d, 0x30, 0xcf, 0x2b, 0x3f, 0x94, 0x44, 0x2e, 0x85, 0x34, 0xbe, 0x39,
+	0x58, 0xe3, 0x17, 0xec, 0xc5, 0x50, 0x7c, 0x63, 0xd4, 0xa6, 0x87, 0x3e, 0x81, 0x3c, 0xbe, 0x08,
+	0xe5, 0xdb, 0x69, 0xa3, 0x18, 0x97, 0xa2, 0x31, 0x8c, 0xbf, 0x82, 0xc6, 0xb0, 0x62, 0x8a, 0x18,
+	0xc6, 0xf8, 0x69, 0x0c, 0xfb, 0x17, 0x09, 0x6a, 0x2d, 0xfa, 0x1c, 0xda, 0x01, 0xe1, 0xd0, 0x6f,
+	0xb8, 0x11, 0x77, 0xa0, 0xc2, 0xd5, 0x22, 0x32, 0xa2, 0x51, 0xb2, 0xbd, 0xc4, 0x46, 0x44, 0xc6,
+	0x13, 0xd3, 0xe0, 0xc2, 0x98, 0x06, 0x1f, 0x42, 0x9e, 0x3d, 0xd5, 0x72, 0x29, 0xb3, 0x2a, 0xce,
+	0x2f, 0xff, 0x08, 0x36, 0x26, 0x2c, 0x8c, 0xdb, 0xfc, 0xc7, 0x90, 0xa3, 0x3b, 0xce, 0x7d, 0xef,
+	0x3b, 0x53, 0x9c, 0x68, 0x24, 0xcc, 0x44, 0xe4, 0x5f, 0x4a, 0xb0, 0xda, 0x1d, 0x0c, 0x6d, 0xc7,
+	0x8b, 0xa6, 0x0b, 0x37, 0x00, 0x1c, 0xfb, 0x85, 0x30, 0x3d, 0x56, 0x39, 0x28, 0x3a, 0xf6, 0x0b,
+	0x6e, 0x75, 0xeb, 0x90, 0x77, 0x6d, 0xdf, 0xd1, 0x83, 0x4b, 0x2e, 0x7b, 0x42, 0x4d, 0x11, 0x06,
+	0xb2, 0x33, 0x12, 0xe9, 0xf1, 0xab, 0x10, 0x8f, 0x09, 0xf2, 0x4f, 0x25, 0xb8, 0x12, 0x42, 0xa4,
+	0xd8, 0x2f, 0x14, 0xec, 0xfa, 0xfd, 0x99, 0x90, 0x6a, 0xb0, 0xe8, 0xfa, 0xba, 0x4e, 0x76, 0x88,
+	0x60, 0x2a, 0x28, 0xe2, 0x31, 0x12, 0xca, 0xb3, 0x63, 0x87, 0x18, 0x76, 0x1c, 0xdb, 0x21, 0xdd,
+	0x8a, 0x2c, 0x59, 0x07, 0x7b, 0x92, 0xff, 0x3e, 0x0a, 0x62, 0x14, 0x5f, 0x6e, 0x00, 0x73, 0x76,
+	0xd5, 0xb1, 0x5f, 0x88, 0x8a, 0x4a, 0x91, 0x52, 0x14, 0xfb, 0x85, 0x4b, 0x6e, 0x4e, 0x26, 0x15,
+	0x23, 0xc5, 0x0b, 0x1a, 0x21, 0x58, 0xc6, 0xb4, 0x24, 0xa8, 0x34, 0x48, 0x90, 0xac, 0x93, 0x54,
+	0xb9, 0x02, 0x26, 0x16, 0x46, 0x4a, 0x8c, 0xc6, 0x58, 0x1e, 0x93, 0x8a, 0x36, 0x59, 0x37, 0x83,
+	0x56, 0x7a, 0x70, 0x3f, 0x59, 0x97, 0x13, 0xb4, 0xa5, 0x08, 0x69, 0xf9, 0xb7, 0x69, 0x3e, 0x48,
+	0x47, 0x77, 0x2f, 0xbb, 0x6d, 0xb1, 0xc1, 0xf1, 0x4b, 0x7f, 0x24, 0x3f, 0xcc, 0xa4, 0xcf, 0x0f,
+	0xe5, 0x27, 0x70, 0x25, 0x3a, 0xff, 0xdb, 0x9c, 0x08, 0xf2, 0x7f, 0x48, 0xb0, 0x2e, 0xa6, 0x73,
+	0x77, 0x2f, 0x4f, 0xdc, 0x14, 0x57, 0xe5, 0x1f, 0x42, 0x81, 0xa5, 0x6f, 0x98, 0x1d, 0xc9, 0x69,
+	0x13, 0xb8, 0x40, 0x2a, 0x9a, 0xe3, 0x66, 0xa7, 0xe6, 0xb8, 0x0b, 0xb1, 0x1c, 0x37, 0xaa, 0xb8,
+	0xdc, 0x1c, 0x8a, 0xfb, 0x33, 0x09, 0xae, 0x8e, 0x2d, 0xf5, 0xff, 0xcb, 0x31, 0x76, 0x07, 0xd6,
+	0x42, 0xd8, 0xba, 0xed, 0x20, 0x30, 0x54, 0x21, 0x6b, 0x1a, 0x0c, 0x56, 0x51, 0x21, 0x3f, 0x65,
+	0x0f, 0xd6, 0xe3, 0xac, 0x6f, 0xb9, 0x0a, 0x19, 0x96, 0x2c, 0xdb, 0x53, 0xcf, 0x6c, 0xdf, 0x32,
+	0x54, 0xd3, 0x60, 0xbb, 0x5a, 0x54, 0x4a, 0x96, 0xed, 0xed, 0x11, 0x5a, 0xd7, 0x70, 0xe5, 0x67,
+	0x70, 0xa5, 0xe9, 0xe8, 0xe7, 0xe6, 0x05, 0x8e, 0x06, 0xae, 0x4d, 0x28, 0x9d, 0xe2, 0x33, 0xdb,
+	0xe1, 0x85, 0x4d, 0x66, 0x29, 0xc0, 0x48, 0x34, 0x08, 0xdf, 0x00, 0x38, 0x25, 0x77, 0x92, 0xf0,
+	0x85, 0xa6, 0x48, 0x29, 0x64, 0xb7, 0xe5, 0x4f, 0x60, 0x2d, 0x36, 0x2f, 0x5f, 0xcc, 0x6d, 0xa8,
+	0x68, 0x6c, 0x40, 0x78, 0xad, 0xc4, 0x2a, 0x70, 0x82, 0x2a, 0x14, 0x47, 0x36, 0x95, 0x4f, 0x11,
+	0x4d, 0x29, 0xe3, 0x57, 0xb5, 0xbf, 0x91, 0xa0, 0x36, 0xce, 0xfb, 0x56, 0x09, 0xd5, 0x2d, 0x28,
+	0x05, 0x20, 0x35, 0x16, 0x7c, 0xd8, 0x51, 0x05, 0x82, 0xdc, 0xf4, 0xd0, 0xaf, 0x43, 0x80, 0x99,
+	0x1d, 0xb3, 0xd9, 0x99, 0xc7, 0x6c, 0x59, 0x08, 0xd0, 0x73, 0xf6, 0x67, 0x12, 0x14, 0x3f, 0xf7,
+	0x6d, 0x0f, 0x7f, 0x43, 0x37, 0xec, 0xf0, 0x9d, 0x38, 0x1b, 0xbb, 0x13, 0xdf, 0x88, 0x94, 0xdd,
+	0xd8, 0x8d, 0x3a, 0x54, 0x56, 0xfb, 0x7a, 0x01, 0x72, 0x14, 0xca, 0x5c, 0x4d, 0x6c, 0x52, 0x18,
+	0xd4, 0xac, 0x4b, 0x06, 0x88, 0x57, 0x62, 0x39, 0x8d, 0x02, 0xfa, 0x21, 0x5c, 0x3f, 0xf5, 0x5d,
+	0xd3, 0xc2, 0xae, 0xab, 0x3a, 0xb8, 0x67, 0xba, 0x1e, 0x2b, 0xf6, 0x88, 0xc3, 0x87, 0x05, 0x81,
+	0xba, 0xe0, 0x51, 0x42, 0x2c, 0xfc, 0x34, 0x7a, 0x18, 0xad, 0x38, 0x27, 0xf7, 0x76, 0x03, 0x3d,
+	0x8a, 0x2b, 0x42, 0xac, 0x72, 0x97, 0x1f, 0xab, 0xdc, 0x8d, 0x2e, 0xbd, 0x8b, 0x33, 0x6e, 0xab,
+	0x74, 0xee, 0xd8, 0xa5, 0x77, 0xac, 0x7d, 0x5b, 0x78, 0xf3, 0xf6, 0xed, 0x26, 0x94, 0x2e, 0xb4,
+	0xbe, 0x69, 0xa8, 0xbe, 0xe5, 0x99, 0x7d, 0xde, 0xe7, 0x01, 0x4a, 0x3a, 0x21, 0x94, 0xc8, 0xc9,
+	0x0b, 0xd1, 0x93, 0x37, 0x9a, 0x4d, 0x96, 0x26, 0x65, 0x93, 0xf1, 0x6c, 0xb0, 0x3c, 0x5f, 0x36,
+	0xf8, 0xb7, 0xa4, 0x89, 0x41, 0x9f, 0xa9, 0x1e, 0xd2, 0x54, 0x5c, 0x23, 0x76, 0x91, 0x99, 0xdf,
+	0x2e, 0xb2, 0xe9, 0xed, 0x62, 0x61, 0x5e, 0xbb, 0x18, 0xdb, 0xb8, 0xdc, 0x37, 0xb6, 0x71, 0xf9,
+	0xf8, 0xc6, 0xc9, 0x9f, 0xc1, 0x6a, 0x44, 0x75, 0xa3, 0xa0, 0xf4, 0x05, 0x21, 0xcc, 0x0c, 0x4a,
+	0x4c, 0x8c, 0x31, 0xcb, 0x0d, 0x40, 0x4d, 0x5d, 0xc7, 0x43, 0x2f, 0xb2, 0x0f, 0x1b, 0xc4, 0xe9,
+	0x6d, 0x0f, 0x87, 0x2e, 0xd8, 0xf4, 0xb9, 0x6b, 0x90, 0xb7, 0x47, 0x04, 0xde, 0xea, 0xed, 0x17,
+	0x50, 0x6f, 0xd9, 0xd6, 0x05, 0x76, 0xd8, 0x6c, 0xc7, 0x76, 0xfc, 0x9a, 0x9f, 0x80, 0x02, 0xdd,
+	0x99, 0x50, 0xb6, 0x66, 0x36, 0x31, 0x56, 0xb2, 0x16, 0x55, 0xe9, 0xec, 0xa8, 0x2a, 0x2d, 0x3f,
+	0x84, 0x6b, 0x13, 0xdf, 0xcb, 0x17, 0x33, 0xa5, 0x0a, 0x36, 0x84, 0xe5, 0x4e, 0xdf, 0xec, 0x99,
+	0xa7, 0x66, 0xdf, 0xf4, 0x2e, 0xd3, 0xc4, 0x58, 0x19, 0x96, 0xce, 0xfa, 0x9a, 0x7b, 0xae, 0xba,
+	0x1a, 0x2b, 0x1e, 0x72, 0xdb, 0xa5, 0xc4, 0x23, 0x8d, 0xb6, 0x45, 0xa6, 0x04, 0x59, 0xf9, 0x57,
+	0x12, 0xac, 0x1f, 0xfa, 0x8e, 0x7e, 0xae, 0xb9, 0xf8, 0x89, 0x39, 0x30, 0xbd, 0x67, 0xa6, 0xdd,
+	0x67, 0x3d, 0xbf, 0x6f, 0xe0, 0xcd, 0xf7, 0x01, 0x8d, 0x7a, 0xa5, 0x31, 0x0c, 0x2b, 0xc1, 0xc8,
+	0xe7, 0x7c, 0x80, 0x34, 0x00, 0xb5, 0x3e, 0xc9, 0x92, 0x2e, 0xd5, 0x21, 0xc7, 0x64, 0xf0, 0x7e,
+	0x58, 0x95, 0x0f, 0x08, 0xac, 0x06, 0xe9, 0x37, 0x0f, 0xb4, 0x97, 0xea, 0x10, 0x3b, 0xbc, 0x23,
+	0x89, 0x1d, 0x5e, 0x56, 0xad, 0x0c, 0xb4, 0x97, 0x87, 0xd8, 0x69, 0x71, 0xaa, 0xfc, 0x25, 0x6c,
+	0xb6, 0xce, 0xb1, 0xfe, 0x5c, 0xc8, 0x86, 0x54, 0x3c, 0x33, 0x34, 0x7c, 0x12, 0xad, 0xf8, 0x24,
+	0x77, 0x18, 0x62, 0xfb, 0x26, 0x7a, 0x88, 0xbf, 0x94, 0x60, 0x2b, 0xf9, 0xe5, 0xdc, 0x22, 0xea,
+	0x50, 0xc0, 0x94, 0xdc, 0x67, 0x16, 0x5e, 0x50, 0x82, 0x67, 0x74, 0x00, 0x70, 0x21, 0xb6, 0x44,
+	0xa0, 0x68, 0x24, 0x7b, 0xfe, 0xc4, 0xad, 0x54, 0x42, 0x53, 0xc8, 0x7f, 0x2e, 0x41, 0x85, 0x1e,
+	0x27, 0x4f, 0x4c, 0x0b, 0x77, 0xad, 0xa1, 0x4f, 0x57, 0xdf, 0x37, 0xad, 0x90, 0x27, 0xe4, 0xc9,
+	0xe3, 0x58, 0xd3, 0x2f, 0x13, 0x37, 0x81, 0x69, 0xa7, 0xf7, 0xa3, 0xb1, 0xd3, 0x7b, 0xae, 0xa6,
+	0xd9, 0x7f, 0x66, 0x60, 0x85, 0xfe, 0x4a, 0xd7, 0x33, 0x7b, 0x14, 0xdd, 0xa6, 0x77, 0x93, 0x15,
+	0x14, 0x59, 0xb9, 0x88, 0xb0, 0xf4, 0x00, 0xf0, 0x87, 0xb4, 0x4b, 0x6d, 0x60, 0x72, 0xd1, 0xcf,
+	0xb2, 0x03, 0x80, 0xd0, 0x48, 0x0f, 0xd7, 0x45, 0xcd, 0x58, 0xcb, 0x2b, 0xdd, 0x8a, 0xc2, 0x0d,
+	0x31, 0xf4, 0x03, 0xc8, 0xb9, 0x9e, 0xd6, 0x63, 0x8d, 0xcf, 0x69, 0x5f, 0x9c, 0x10, 0x90, 0xa6,
+	0xd5, 0x3b, 0x22, 0xcc, 0x0a, 0x93, 0x41, 0x9b, 0x50, 0xa4, 0xaa, 0xa4, 0x87, 0x66, 0x3e, 0x38,
+	0x34, 0x0b, 0x8c, 0xd8, 0xf4, 0xd0, 0x0f, 0xa0, 0xc4, 0x19, 0x52, 0x16, 0x81, 0x81, 0xb1, 0xd3,
+	0x13, 0xf3, 0x2f, 0x24, 0xa8, 0x36, 0x87, 0xc3, 0xbe, 0x89, 0x8d, 0x43, 0xc7, 0x1e, 0xd8, 0x34,
+	0x00, 0xb0, 0xfc, 0x8d, 0x3d, 0x84, 0xbe, 0xe7, 0x09, 0x68, 0x5d, 0x83, 0x84, 0xbf, 0xd0, 0x89,
+	0x49, 0x7f, 0x93, 0x23, 0x26, 0xa4, 0x4c, 0x1e, 0x19, 0x61, 0xa4, 0x4b, 0xf4, 0x31, 0x14, 0x0c,
+	0xd3, 0xd5, 0xe7, 0xa8, 0x65, 0x06, 0xfc, 0xb2, 0x0d, 0x2b, 0x0a, 0xfe, 0x1d, 0xac, 0x7b, 0x73,
+	0x02, 0x8d, 0x81, 0xca, 0x8c, 0x81, 0x1a, 0xd5, 0x47, 0xb3, 0xe1, 0xfa, 0xa8, 0x6c, 0x03, 0x6a,
+	0xf3, 0x97, 0x37, 0xfb, 0x7d, 0x5b, 0xd7, 0xd2, 0xbe, 0x71, 0x54, 0xf0, 0xcd, 0xcc, 0xf5, 0x39,
+	0xd3, 0xd7, 0x59, 0x00, 0x6a, 0xa5, 0x06, 0x31, 0xd3, 0x64, 0xdf, 0x8c, 0x3a, 0x58, 0x66, 0x4e,
+	0x07, 0x23, 0x9b, 0xe0, 0xfa, 0xa7, 0x34, 0xb9, 0x4c, 0x59, 0x91, 0x0e, 0xf8, 0xd1, 0x53, 0x28,
+	0x69, 0x81, 0x2e, 0x44, 0x42, 0x93, 0x5c, 0xf1, 0x19, 0xd7, 0x9f, 0x12, 0x96, 0x8f, 0xd8, 0x43,
+	0x6e, 0x3e, 0x7b, 0x20, 0x99, 0x01, 0x5b, 0x43, 0xba, 0x4f, 0xa0, 0x18, 0x73, 0x24, 0x70, 0x2d,
+	0xc6, 0x02, 0xd7, 0x06, 0x14, 0x44, 0xc1, 0x9d, 0x66, 0xc8, 0x05, 0x65, 0x91, 0x57, 0xd2, 0xe5,
+	0x7f, 0xce, 0x01, 0x0a, 0x07, 0x25, 0x1e, 0xbe, 0x3f, 0x82, 0x1c, 0xd9, 0x13, 0x71, 0xd7, 0xbd,
+	0x35, 0x3d, 0xf8, 0xd0, 0x6d, 0x55, 0x98, 0x04, 0xfa, 0x31, 0x20, 0x8d, 0xb9, 0x9d, 0x1a, 0xd8,
+	0x8e, 0x08, 0x62, 0x77, 0x92, 0x6b, 0x84, 0x31, 0x4f, 0x55, 0x56, 0xb4, 0x18, 0xc5, 0x45, 0xbf,
+	0x05, 0xab, 0x0e, 0x77, 0x94, 0xf0, 0xd4, 0xd9, 0xad, 0xec, 0xd4, 0x5e, 0xf3, 0x98, 0x73, 0x29,
+	0xc8, 0x89, 0x93, 0xdc, 0x88, 0xf1, 0x2c, 0xcc, 0x69, 0x3c, 0x1d, 0xa8, 0x88, 0xdd, 0x53, 0xd9,
+	0x0c, 0x29, 0x3f, 0x80, 0x13, 0x52, 0xc7, 0x74, 0x9a, 0x78, 0x3c, 0xce, 0xcf, 0x1f, 0x8f, 0x03,
+	0xdb, 0x59, 0x9c, 0xc7, 0x76, 0x9e, 0x02, 0x72, 0x48, 0x29, 0x82, 0xbc, 0xd8, 0xc1, 0x03, 0xcd,
+	0xb4, 0xc8, 0x5d, 0xbd, 0x90, 0x6a, 0x8a, 0x15, 0x21, 0xa9, 0x08, 0x41, 0xd2, 0x3a, 0x76, 0xfc,
+	0x3e, 0x76, 0xd5, 0x0b, 0xec, 0xb8, 0xe4, 0xf3, 0x20, 0x76, 0x97, 0x2a, 0x53, 0xe2, 0x33, 0x46,
+	0x8b, 0x06, 0x7f, 0x98, 0x1d, 0xfc, 0x4b, 0xf3, 0x04, 0xff, 0xbb, 0xff, 0x20, 0x41, 0x29, 0x54,
+	0x1d, 0x43, 0xd7, 0xa1, 0x76, 0xa0, 0xb4, 0x3b, 0x8a, 0x7a, 0x74, 0xdc, 0x3c, 0x3e, 0x39, 0x52,
+	0x4f, 0xf6, 0x8f, 0x0e, 0x3b, 0xad, 0xee, 0x5e, 0xb7, 0xd3, 0xae, 0x7e, 0x0b, 0xd5, 0xe0, 0x4a,
+	0x64, 0xf4, 0xb0, 0xb3, 0xdf, 0xee, 0xee, 0x3f, 0xae, 0x4a, 0x68, 0x0d, 0x56, 0xa2, 0x23, 0xcd,
+	0x6e, 0xbb, 0x9a, 0x19, 0x13, 0x38, 0xfa, 0xb4, 0x7b, 0x78, 0xd8, 0x69, 0x57, 0xb3, 0xa8, 0x0e,
+	0xeb, 0x91, 0x91, 0x76, 0xe7, 0x49, 0xf7, 0x59, 0x47, 0xe9, 0xb4, 0xab, 0x0b, 0x63, 0x63, 0xad,
+	0xe6, 0x7e, 0xab, 0xf3, 0xe4, 0x49, 0xa7, 0x5d, 0xcd, 0xa1, 0x0d, 0x58, 0x8b, 0x8c, 0x29, 0x9d,
+	0xbd, 0x93, 0xfd, 0x76, 0xa7, 0x5d, 0xcd, 0xdf, 0xfd, 0x09, 0x94, 0xc3, 0xdf, 0x6b, 0xa2, 0x1b,
+	0xb0, 0xc1, 0x46, 0x27, 0x2f, 0x66, 0x03, 0xd6, 0xa2, 0xc3, 0xa3, 0xd5, 0x5c, 0x83, 0xab, 0xd1,
+	0xa1, 0xd6, 0xc1, 0xd3, 0xc3, 0x27, 0x9d, 0xe3, 0x0e, 0x5f, 0x53, 0x74, 0x70, 0xaf, 0xd9, 0x25,
+	0xd8, 0xb2, 0x77, 0xff, 0x44, 0x82, 0x52, 0xe8, 0xf6, 0x4d, 0x94, 0xf9, 0xf9, 0xc9, 0xc1, 0x71,
+	0x27, 0x51, 0x99, 0x91, 0xd1, 0xd1, 0xeb, 0x37, 0x60, 0x2d, 0x32, 0xd2, 0x6c, 0xb5, 0x3a, 0x87,
+	0xec, 0xe5, 0x75, 0x58, 0x8f, 0x0c, 0xb5, 0x0e, 0xf6, 0x9f, 0x75, 0x94, 0x63, 0xaa, 0xd2, 0xf8,
+	0x84, 0x9d, 0x1f, 0x1f, 0x76, 0xa9, 0x42, 0xef, 0xbe, 0x82, 0x72, 0x38, 0xaf, 0x20, 0x9a, 0x39,
+	0x54, 0xba, 0xad, 0xee, 0xfe, 0x63, 0xc2, 0xfb, 0xb8, 0x13, 0x43, 0xb6, 0x0e, 0x28, 0x3a, 0xdc,
+	0x6a, 0x2a, 0xc7, 0x55, 0x89, 0xbc, 0x3c, 0x46, 0xff, 0xb4, 0xd3, 0xfa, 0xec, 0xe0, 0xe4, 0x98,
+	0x69, 0x25, 0x3a, 0xc6, 0x74, 0x54, 0xcd, 0x3e, 0xf8, 0xb7, 0x55, 0x28, 0x33, 0x13, 0xc3, 0x0e,
+	0xfd, 0x72, 0xe5, 0xf7, 0x25, 0x28, 0x85, 0x3a, 0x01, 0x68, 0x9e, 0x7e, 0x41, 0xfd, 0x5e, 0x3a,
+	0x66, 0x16, 0x9e, 0xe5, 0x6b, 0x3f, 0xfd, 0xa7, 0x7f, 0xff, 0xe3, 0xcc, 0xda, 0xc7, 0xd2, 0x5d,
+	0xb9, 0xda, 0xb8, 0x78, 0xbf, 0x41, 0x2f, 0x5b, 0x0d, 0x93, 0x72, 0xa2, 0xdf, 0x85, 0x72, 0xb8,
+	0x9b, 0x88, 0x92, 0xa7, 0x9e, 0xf0, 0x69, 0x45, 0xfd, 0x7e, 0x4a, 0x6e, 0x8e, 0x64, 0x85, 0x22,
+	0x29, 0xa1, 0x62, 0x00, 0x03, 0x7d, 0x25, 0x51, 0x00, 0x41, 0x11, 0x7d, 0x3a, 0x80, 0x78, 0x2d,
+	0xbf, 0x7e, 0x3f, 0x25, 0x37, 0x07, 0x70, 0x95, 0x02, 0x58, 0x41, 0xcb, 0x01, 0x00, 0xb7, 0xf1,
+	0xca, 0x34, 0x5e, 0xa3, 0xaf, 0x25, 0x58, 0x8e, 0x55, 0xa4, 0x51, 0x63, 0xe6, 0xdc, 0xd1, 0x32,
+	0x7d, 0xfd, 0xbb, 0xe9, 0x05, 0x38, 0x1e, 0x99, 0xe2, 0xb9, 0x8e, 0xea, 0x04, 0x0f, 0x49, 0xe5,
+	0xdd, 0xc6, 0x2b, 0x9e, 0xe0, 0xbf, 0xe6, 0xf8, 0xd0, 0xcf, 0x25, 0x80, 0xd1, 0xa7, 0x24, 0x28,
+	0xf9, 0xe8, 0x1a, 0xfb, 0xde, 0xa4, 0x7e, 0x27, 0x4d, 0x33, 0x80, 0xf6, 0x21, 0xa3, 0x48, 0x98,
+	0x85, 0xbc, 0x12, 0xb7, 0xf4, 0xd7, 0x8d, 0x17, 0x64, 0xea, 0xef, 0x4a, 0xe8, 0x0f, 0xc9, 0x17,
+	0xa1, 0xa3, 0x0f, 0x17, 0xa6, 0x58, 0xed, 0xf8, 0x17, 0x15, 0xf5, 0x7b, 0xe9, 0x98, 0xb9, 0x6a,
+	0xde, 0xa1, 0x80, 0xb6, 0x88, 0xd5, 0x5e, 0x9b, 0x88, 0x49, 0xa7, 0x42, 0xe8, 0x8f, 0x24, 0x28,
+	0xb1, 0x88, 0x37, 0x0b, 0xd2, 0xf8, 0xa7, 0x0e, 0xf5, 0x7b, 0xe9, 0x98, 0x39, 0xa4, 0x77, 0x29,
+	0xa4, 0x9b, 0x04, 0xd2, 0xf5, 0x89, 0x90, 0xc4, 0x1f, 0x2b, 0xfe, 0x52, 0x82, 0x95, 0xb1, 0xa6,
+	0x25, 0x7a, 0x3f, 0x79, 0xfd, 0x09, 0x9d, 0xdb, 0xfa, 0x83, 0x79, 0x44, 0x38, 0xca, 0x1d, 0x8a,
+	0x72, 0x9b, 0xa0, 0xbc, 0x35, 0x42, 0xc9, 0xfa, 0xbd, 0x6e, 0xe3, 0x55, 0xd0, 0x09, 0x7e, 0xdd,
+	0xa0, 0x7d, 0x50, 0xf4, 0x0b, 0x09, 0xca, 0xe1, 0x86, 0xdf, 0x14, 0x0f, 0x9c, 0xd0, 0x2e, 0xad,
+	0xdf, 0x4f, 0xc9, 0x3d, 0x3d, 0x18, 0x51, 0xd6, 0x6d, 0x89, 0xec, 0x66, 0x25, 0xda, 0x52, 0x41,
+	0x3b, 0x69, 0xbc, 0x6a, 0xd4, 0xa6, 0xa9, 0x37, 0x52, 0xf3, 0x73, 0x48, 0xdf, 0xa6, 0x90, 0x6a,
+	0x04, 0xd2, 0xea, 0x08, 0x12, 0xed, 0x8b, 0xdc, 0xef, 0x61, 0x0f, 0xfd, 0x81, 0x04, 0x4b, 0x91,
+	0xc6, 0x08, 0x4a, 0x5e, 0xf3, 0xa4, 0xc6, 0x4c, 0x7d, 0x27, 0x2d, 0x3b, 0x07, 0x74, 0x9d, 0x02,
+	0x5a, 0x27, 0x80, 0x56, 0x46, 0x80, 0x78, 0x1f, 0x82, 0x84, 0xaa, 0x6a, 0xbc, 0x77, 0x82, 0xa6,
+	0x86, 0x9e, 0x49, 0x2d, 0x99, 0xfa, 0xfb, 0x73, 0x48, 0x70, 0x5c, 0x9b, 0x14, 0xd7, 0x06, 0xba,
+	0x3a, 0x06, 0xca, 0x60, 0x51, 0xf4, 0x27, 0x50, 0x0a, 0xd5, 0x4e, 0xa7, 0x45, 0x87, 0xb1, 0xe2,
+	0x74, 0xfd, 0x5e, 0x3a, 0x66, 0x0e, 0x65, 0x8d, 0x42, 0x59, 0x26, 0x2a, 0x02, 0x82, 0x86, 0x56,
+	0x2e, 0x5d, 0x1a, 0x0c, 0x42, 0xf5, 0xd3, 0x29, 0x08, 0xc6, 0xcb, 0xb2, 0xf5, 0x7b, 0xe9, 0x98,
+	0x13, 0x82, 0x01, 0x43, 0xd0, 0x78, 0x25, 0x6a, 0xaa, 0xaf, 0x1b, 0x1a, 0x95, 0x22, 0xc1, 0x60,
+	0x75, 0x42, 0x39, 0x14, 0x7d, 0x90, 0xbc, 0xe0, 0xc4, 0xa2, 0x6d, 0xfd, 0x7b, 0xf3, 0x09, 0x71,
+	0xac, 0xdb, 0x14, 0xab, 0x4c, 0xb0, 0xde, 0x98, 0x8c, 0x55, 0x67, 0xd2, 0xe8, 0xf7, 0x24, 0x7e,
+	0xf9, 0x9e, 0x75, 0xd8, 0x8c, 0xd5, 0xa6, 0xea, 0xef, 0xa5, 0xe2, 0xe5, 0x88, 0xea, 0x14, 0xd1,
+	0x15, 0x82, 0x68, 0x74, 0x16, 0x37, 0x68, 0x4e, 0x8e, 0xfe, 0x9a, 0x7c, 0xcb, 0x92, 0x50, 0x32,
+	0x44, 0x0f, 0x93, 0x15, 0x30, 0xbd, 0xc4, 0x59, 0xff, 0xe8, 0x0d, 0x24, 0x39, 0xda, 0x2d, 0x8a,
+	0xb6, 0x4e, 0xd0, 0xae, 0x8d, 0xd0, 0xe2, 0x11, 0xe7, 0xee, 0xad, 0xdf, 0xbc, 0xd9, 0x33, 0xbd,
+	0x73, 0xff, 0x74, 0x47, 0xb7, 0x07, 0x0d, 0xf6, 0x96, 0xfb, 0xe4, 0x2d, 0xec, 0x4f, 0xa8, 0x6e,
+	0xa3, 0x87, 0xad, 0xd3, 0x3c, 0xfd, 0xfd, 0xc1, 0xff, 0x0e, 0x00, 0xfd, 0x6a, 0x1e, 0x8e, 0x33,
+	0x3b, 0x00, 0x00,
+}
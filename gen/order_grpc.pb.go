@@ -19,8 +19,23 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	OrderService_InsertOrder_FullMethodName  = "/go.escape.ship.proto.v1.OrderService/InsertOrder"
-	OrderService_GetAllOrders_FullMethodName = "/go.escape.ship.proto.v1.OrderService/GetAllOrders"
+	OrderService_InsertOrder_FullMethodName              = "/go.escape.ship.proto.v1.OrderService/InsertOrder"
+	OrderService_GetAllOrders_FullMethodName             = "/go.escape.ship.proto.v1.OrderService/GetAllOrders"
+	OrderService_GetOrderByID_FullMethodName             = "/go.escape.ship.proto.v1.OrderService/GetOrderByID"
+	OrderService_GetOrdersByUser_FullMethodName          = "/go.escape.ship.proto.v1.OrderService/GetOrdersByUser"
+	OrderService_WatchOrder_FullMethodName               = "/go.escape.ship.proto.v1.OrderService/WatchOrder"
+	OrderService_CancelOrder_FullMethodName              = "/go.escape.ship.proto.v1.OrderService/CancelOrder"
+	OrderService_RefundOrder_FullMethodName              = "/go.escape.ship.proto.v1.OrderService/RefundOrder"
+	OrderService_CreateReturnLabel_FullMethodName        = "/go.escape.ship.proto.v1.OrderService/CreateReturnLabel"
+	OrderService_ImportOrders_FullMethodName             = "/go.escape.ship.proto.v1.OrderService/ImportOrders"
+	OrderService_GetOrdersByIDs_FullMethodName           = "/go.escape.ship.proto.v1.OrderService/GetOrdersByIDs"
+	OrderService_ArchiveOrders_FullMethodName            = "/go.escape.ship.proto.v1.OrderService/ArchiveOrders"
+	OrderService_GetArchivedOrder_FullMethodName         = "/go.escape.ship.proto.v1.OrderService/GetArchivedOrder"
+	OrderService_CreateQuote_FullMethodName              = "/go.escape.ship.proto.v1.OrderService/CreateQuote"
+	OrderService_AcceptQuote_FullMethodName              = "/go.escape.ship.proto.v1.OrderService/AcceptQuote"
+	OrderService_ConvertQuoteToOrder_FullMethodName      = "/go.escape.ship.proto.v1.OrderService/ConvertQuoteToOrder"
+	OrderService_PriceOrder_FullMethodName               = "/go.escape.ship.proto.v1.OrderService/PriceOrder"
+	OrderService_CheckPurchaseEligibility_FullMethodName = "/go.escape.ship.proto.v1.OrderService/CheckPurchaseEligibility"
 )
 
 // OrderServiceClient is the client API for OrderService service.
@@ -29,6 +44,38 @@ const (
 type OrderServiceClient interface {
 	InsertOrder(ctx context.Context, in *InsertOrderRequest, opts ...grpc.CallOption) (*InsertOrderResponse, error)
 	GetAllOrders(ctx context.Context, in *GetAllOrdersRequest, opts ...grpc.CallOption) (*GetAllOrdersResponse, error)
+	// 주문 단건 조회, 본인 주문이 아니면 orders:admin 필요 (없으면 NOT_FOUND)
+	// 아카이브된 주문은 NOT_FOUND, GetArchivedOrder로 조회
+	GetOrderByID(ctx context.Context, in *GetOrderByIDRequest, opts ...grpc.CallOption) (*GetOrderByIDResponse, error)
+	// 사용자별 주문 목록 (최신 주문 순), 다른 사용자의 주문은 orders:admin 필요 (없으면 PERMISSION_DENIED)
+	GetOrdersByUser(ctx context.Context, in *GetOrdersByUserRequest, opts ...grpc.CallOption) (*GetOrdersByUserResponse, error)
+	// 주문 상태 변경을 실시간으로 전달 (GetAllOrders 폴링 대체)
+	// 구독 직후 현재 상태를 한 번 보내고, 이후 변경될 때마다 전달
+	// 게이트웨이에서는 Accept: text/event-stream 요청 시 SSE로 응답
+	WatchOrder(ctx context.Context, in *WatchOrderRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[OrderStatusEvent], error)
+	// 배송 전(PENDING/PAID) 주문 취소, 결제 완료 주문은 전액 환불까지 처리
+	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error)
+	// 전체/부분 환불 (PaymentService.KakaoCancel로 결제 취소 후 Order.refunds에 기록)
+	RefundOrder(ctx context.Context, in *RefundOrderRequest, opts ...grpc.CallOption) (*RefundOrderResponse, error)
+	// 반품 건에 대해 택배사 수거 예약 후 출력용 라벨 URL 발급
+	CreateReturnLabel(ctx context.Context, in *CreateReturnLabelRequest, opts ...grpc.CallOption) (*CreateReturnLabelResponse, error)
+	// 전화/오프라인 주문 및 마켓플레이스 주문 일괄 등록 (행 단위 검증 결과 반환)
+	ImportOrders(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportOrdersRequest, ImportOrdersResponse], error)
+	// 여러 주문 ID를 한 번에 조회, orders:admin 필요 (일부만 존재해도 성공, 없는 ID는 not_found_ids로 반환)
+	GetOrdersByIDs(ctx context.Context, in *GetOrdersByIDsRequest, opts ...grpc.CallOption) (*GetOrdersByIDsResponse, error)
+	// before_date 이전 주문을 콜드 스토리지로 이동
+	ArchiveOrders(ctx context.Context, in *ArchiveOrdersRequest, opts ...grpc.CallOption) (*ArchiveOrdersResponse, error)
+	// 아카이브된 주문 조회
+	GetArchivedOrder(ctx context.Context, in *GetArchivedOrderRequest, opts ...grpc.CallOption) (*GetArchivedOrderResponse, error)
+	// B2B 견적: 생성 → 고객 수락 → 주문 전환 (외상 결제 조건 지원)
+	CreateQuote(ctx context.Context, in *CreateQuoteRequest, opts ...grpc.CallOption) (*CreateQuoteResponse, error)
+	AcceptQuote(ctx context.Context, in *AcceptQuoteRequest, opts ...grpc.CallOption) (*AcceptQuoteResponse, error)
+	ConvertQuoteToOrder(ctx context.Context, in *ConvertQuoteToOrderRequest, opts ...grpc.CallOption) (*ConvertQuoteToOrderResponse, error)
+	// 프로모션 규칙을 적용한 주문 금액 계산 (장바구니/결제/환불 시 공통 사용)
+	// 같은 입력과 priced_at이면 항상 같은 결과를 반환
+	PriceOrder(ctx context.Context, in *PriceOrderRequest, opts ...grpc.CallOption) (*PriceOrderResponse, error)
+	// 고객당 구매 수량 제한 확인 (장바구니/결제/주문 등록 시 공통 사용)
+	CheckPurchaseEligibility(ctx context.Context, in *CheckPurchaseEligibilityRequest, opts ...grpc.CallOption) (*CheckPurchaseEligibilityResponse, error)
 }
 
 type orderServiceClient struct {
@@ -59,12 +106,206 @@ func (c *orderServiceClient) GetAllOrders(ctx context.Context, in *GetAllOrdersR
 	return out, nil
 }
 
+func (c *orderServiceClient) GetOrderByID(ctx context.Context, in *GetOrderByIDRequest, opts ...grpc.CallOption) (*GetOrderByIDResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrderByIDResponse)
+	err := c.cc.Invoke(ctx, OrderService_GetOrderByID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetOrdersByUser(ctx context.Context, in *GetOrdersByUserRequest, opts ...grpc.CallOption) (*GetOrdersByUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrdersByUserResponse)
+	err := c.cc.Invoke(ctx, OrderService_GetOrdersByUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) WatchOrder(ctx context.Context, in *WatchOrderRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[OrderStatusEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &OrderService_ServiceDesc.Streams[0], OrderService_WatchOrder_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchOrderRequest, OrderStatusEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrderService_WatchOrderClient = grpc.ServerStreamingClient[OrderStatusEvent]
+
+func (c *orderServiceClient) CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelOrderResponse)
+	err := c.cc.Invoke(ctx, OrderService_CancelOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) RefundOrder(ctx context.Context, in *RefundOrderRequest, opts ...grpc.CallOption) (*RefundOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefundOrderResponse)
+	err := c.cc.Invoke(ctx, OrderService_RefundOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CreateReturnLabel(ctx context.Context, in *CreateReturnLabelRequest, opts ...grpc.CallOption) (*CreateReturnLabelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateReturnLabelResponse)
+	err := c.cc.Invoke(ctx, OrderService_CreateReturnLabel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ImportOrders(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportOrdersRequest, ImportOrdersResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &OrderService_ServiceDesc.Streams[1], OrderService_ImportOrders_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportOrdersRequest, ImportOrdersResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrderService_ImportOrdersClient = grpc.ClientStreamingClient[ImportOrdersRequest, ImportOrdersResponse]
+
+func (c *orderServiceClient) GetOrdersByIDs(ctx context.Context, in *GetOrdersByIDsRequest, opts ...grpc.CallOption) (*GetOrdersByIDsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrdersByIDsResponse)
+	err := c.cc.Invoke(ctx, OrderService_GetOrdersByIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ArchiveOrders(ctx context.Context, in *ArchiveOrdersRequest, opts ...grpc.CallOption) (*ArchiveOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchiveOrdersResponse)
+	err := c.cc.Invoke(ctx, OrderService_ArchiveOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetArchivedOrder(ctx context.Context, in *GetArchivedOrderRequest, opts ...grpc.CallOption) (*GetArchivedOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetArchivedOrderResponse)
+	err := c.cc.Invoke(ctx, OrderService_GetArchivedOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CreateQuote(ctx context.Context, in *CreateQuoteRequest, opts ...grpc.CallOption) (*CreateQuoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateQuoteResponse)
+	err := c.cc.Invoke(ctx, OrderService_CreateQuote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) AcceptQuote(ctx context.Context, in *AcceptQuoteRequest, opts ...grpc.CallOption) (*AcceptQuoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptQuoteResponse)
+	err := c.cc.Invoke(ctx, OrderService_AcceptQuote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ConvertQuoteToOrder(ctx context.Context, in *ConvertQuoteToOrderRequest, opts ...grpc.CallOption) (*ConvertQuoteToOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConvertQuoteToOrderResponse)
+	err := c.cc.Invoke(ctx, OrderService_ConvertQuoteToOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) PriceOrder(ctx context.Context, in *PriceOrderRequest, opts ...grpc.CallOption) (*PriceOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PriceOrderResponse)
+	err := c.cc.Invoke(ctx, OrderService_PriceOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CheckPurchaseEligibility(ctx context.Context, in *CheckPurchaseEligibilityRequest, opts ...grpc.CallOption) (*CheckPurchaseEligibilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckPurchaseEligibilityResponse)
+	err := c.cc.Invoke(ctx, OrderService_CheckPurchaseEligibility_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrderServiceServer is the server API for OrderService service.
 // All implementations must embed UnimplementedOrderServiceServer
 // for forward compatibility.
 type OrderServiceServer interface {
 	InsertOrder(context.Context, *InsertOrderRequest) (*InsertOrderResponse, error)
 	GetAllOrders(context.Context, *GetAllOrdersRequest) (*GetAllOrdersResponse, error)
+	// 주문 단건 조회, 본인 주문이 아니면 orders:admin 필요 (없으면 NOT_FOUND)
+	// 아카이브된 주문은 NOT_FOUND, GetArchivedOrder로 조회
+	GetOrderByID(context.Context, *GetOrderByIDRequest) (*GetOrderByIDResponse, error)
+	// 사용자별 주문 목록 (최신 주문 순), 다른 사용자의 주문은 orders:admin 필요 (없으면 PERMISSION_DENIED)
+	GetOrdersByUser(context.Context, *GetOrdersByUserRequest) (*GetOrdersByUserResponse, error)
+	// 주문 상태 변경을 실시간으로 전달 (GetAllOrders 폴링 대체)
+	// 구독 직후 현재 상태를 한 번 보내고, 이후 변경될 때마다 전달
+	// 게이트웨이에서는 Accept: text/event-stream 요청 시 SSE로 응답
+	WatchOrder(*WatchOrderRequest, grpc.ServerStreamingServer[OrderStatusEvent]) error
+	// 배송 전(PENDING/PAID) 주문 취소, 결제 완료 주문은 전액 환불까지 처리
+	CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error)
+	// 전체/부분 환불 (PaymentService.KakaoCancel로 결제 취소 후 Order.refunds에 기록)
+	RefundOrder(context.Context, *RefundOrderRequest) (*RefundOrderResponse, error)
+	// 반품 건에 대해 택배사 수거 예약 후 출력용 라벨 URL 발급
+	CreateReturnLabel(context.Context, *CreateReturnLabelRequest) (*CreateReturnLabelResponse, error)
+	// 전화/오프라인 주문 및 마켓플레이스 주문 일괄 등록 (행 단위 검증 결과 반환)
+	ImportOrders(grpc.ClientStreamingServer[ImportOrdersRequest, ImportOrdersResponse]) error
+	// 여러 주문 ID를 한 번에 조회, orders:admin 필요 (일부만 존재해도 성공, 없는 ID는 not_found_ids로 반환)
+	GetOrdersByIDs(context.Context, *GetOrdersByIDsRequest) (*GetOrdersByIDsResponse, error)
+	// before_date 이전 주문을 콜드 스토리지로 이동
+	ArchiveOrders(context.Context, *ArchiveOrdersRequest) (*ArchiveOrdersResponse, error)
+	// 아카이브된 주문 조회
+	GetArchivedOrder(context.Context, *GetArchivedOrderRequest) (*GetArchivedOrderResponse, error)
+	// B2B 견적: 생성 → 고객 수락 → 주문 전환 (외상 결제 조건 지원)
+	CreateQuote(context.Context, *CreateQuoteRequest) (*CreateQuoteResponse, error)
+	AcceptQuote(context.Context, *AcceptQuoteRequest) (*AcceptQuoteResponse, error)
+	ConvertQuoteToOrder(context.Context, *ConvertQuoteToOrderRequest) (*ConvertQuoteToOrderResponse, error)
+	// 프로모션 규칙을 적용한 주문 금액 계산 (장바구니/결제/환불 시 공통 사용)
+	// 같은 입력과 priced_at이면 항상 같은 결과를 반환
+	PriceOrder(context.Context, *PriceOrderRequest) (*PriceOrderResponse, error)
+	// 고객당 구매 수량 제한 확인 (장바구니/결제/주문 등록 시 공통 사용)
+	CheckPurchaseEligibility(context.Context, *CheckPurchaseEligibilityRequest) (*CheckPurchaseEligibilityResponse, error)
 	mustEmbedUnimplementedOrderServiceServer()
 }
 
@@ -81,6 +322,51 @@ func (UnimplementedOrderServiceServer) InsertOrder(context.Context, *InsertOrder
 func (UnimplementedOrderServiceServer) GetAllOrders(context.Context, *GetAllOrdersRequest) (*GetAllOrdersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllOrders not implemented")
 }
+func (UnimplementedOrderServiceServer) GetOrderByID(context.Context, *GetOrderByIDRequest) (*GetOrderByIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderByID not implemented")
+}
+func (UnimplementedOrderServiceServer) GetOrdersByUser(context.Context, *GetOrdersByUserRequest) (*GetOrdersByUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrdersByUser not implemented")
+}
+func (UnimplementedOrderServiceServer) WatchOrder(*WatchOrderRequest, grpc.ServerStreamingServer[OrderStatusEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchOrder not implemented")
+}
+func (UnimplementedOrderServiceServer) CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+}
+func (UnimplementedOrderServiceServer) RefundOrder(context.Context, *RefundOrderRequest) (*RefundOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundOrder not implemented")
+}
+func (UnimplementedOrderServiceServer) CreateReturnLabel(context.Context, *CreateReturnLabelRequest) (*CreateReturnLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReturnLabel not implemented")
+}
+func (UnimplementedOrderServiceServer) ImportOrders(grpc.ClientStreamingServer[ImportOrdersRequest, ImportOrdersResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportOrders not implemented")
+}
+func (UnimplementedOrderServiceServer) GetOrdersByIDs(context.Context, *GetOrdersByIDsRequest) (*GetOrdersByIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrdersByIDs not implemented")
+}
+func (UnimplementedOrderServiceServer) ArchiveOrders(context.Context, *ArchiveOrdersRequest) (*ArchiveOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveOrders not implemented")
+}
+func (UnimplementedOrderServiceServer) GetArchivedOrder(context.Context, *GetArchivedOrderRequest) (*GetArchivedOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArchivedOrder not implemented")
+}
+func (UnimplementedOrderServiceServer) CreateQuote(context.Context, *CreateQuoteRequest) (*CreateQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateQuote not implemented")
+}
+func (UnimplementedOrderServiceServer) AcceptQuote(context.Context, *AcceptQuoteRequest) (*AcceptQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptQuote not implemented")
+}
+func (UnimplementedOrderServiceServer) ConvertQuoteToOrder(context.Context, *ConvertQuoteToOrderRequest) (*ConvertQuoteToOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertQuoteToOrder not implemented")
+}
+func (UnimplementedOrderServiceServer) PriceOrder(context.Context, *PriceOrderRequest) (*PriceOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceOrder not implemented")
+}
+func (UnimplementedOrderServiceServer) CheckPurchaseEligibility(context.Context, *CheckPurchaseEligibilityRequest) (*CheckPurchaseEligibilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckPurchaseEligibility not implemented")
+}
 func (UnimplementedOrderServiceServer) mustEmbedUnimplementedOrderServiceServer() {}
 func (UnimplementedOrderServiceServer) testEmbeddedByValue()                      {}
 
@@ -138,6 +424,258 @@ func _OrderService_GetAllOrders_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_GetOrderByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetOrderByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetOrderByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetOrderByID(ctx, req.(*GetOrderByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetOrdersByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrdersByUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetOrdersByUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetOrdersByUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetOrdersByUser(ctx, req.(*GetOrdersByUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_WatchOrder_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchOrderRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OrderServiceServer).WatchOrder(m, &grpc.GenericServerStream[WatchOrderRequest, OrderStatusEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrderService_WatchOrderServer = grpc.ServerStreamingServer[OrderStatusEvent]
+
+func _OrderService_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CancelOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CancelOrder(ctx, req.(*CancelOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_RefundOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).RefundOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_RefundOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).RefundOrder(ctx, req.(*RefundOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CreateReturnLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReturnLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CreateReturnLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CreateReturnLabel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CreateReturnLabel(ctx, req.(*CreateReturnLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ImportOrders_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(OrderServiceServer).ImportOrders(&grpc.GenericServerStream[ImportOrdersRequest, ImportOrdersResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrderService_ImportOrdersServer = grpc.ClientStreamingServer[ImportOrdersRequest, ImportOrdersResponse]
+
+func _OrderService_GetOrdersByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrdersByIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetOrdersByIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetOrdersByIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetOrdersByIDs(ctx, req.(*GetOrdersByIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ArchiveOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ArchiveOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ArchiveOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ArchiveOrders(ctx, req.(*ArchiveOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetArchivedOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArchivedOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetArchivedOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetArchivedOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetArchivedOrder(ctx, req.(*GetArchivedOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CreateQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CreateQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CreateQuote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CreateQuote(ctx, req.(*CreateQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_AcceptQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).AcceptQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_AcceptQuote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).AcceptQuote(ctx, req.(*AcceptQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ConvertQuoteToOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertQuoteToOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ConvertQuoteToOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ConvertQuoteToOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ConvertQuoteToOrder(ctx, req.(*ConvertQuoteToOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_PriceOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PriceOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).PriceOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_PriceOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).PriceOrder(ctx, req.(*PriceOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CheckPurchaseEligibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckPurchaseEligibilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CheckPurchaseEligibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CheckPurchaseEligibility_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CheckPurchaseEligibility(ctx, req.(*CheckPurchaseEligibilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OrderService_ServiceDesc is the grpc.ServiceDesc for OrderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,7 +691,70 @@ var OrderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAllOrders",
 			Handler:    _OrderService_GetAllOrders_Handler,
 		},
+		{
+			MethodName: "GetOrderByID",
+			Handler:    _OrderService_GetOrderByID_Handler,
+		},
+		{
+			MethodName: "GetOrdersByUser",
+			Handler:    _OrderService_GetOrdersByUser_Handler,
+		},
+		{
+			MethodName: "CancelOrder",
+			Handler:    _OrderService_CancelOrder_Handler,
+		},
+		{
+			MethodName: "RefundOrder",
+			Handler:    _OrderService_RefundOrder_Handler,
+		},
+		{
+			MethodName: "CreateReturnLabel",
+			Handler:    _OrderService_CreateReturnLabel_Handler,
+		},
+		{
+			MethodName: "GetOrdersByIDs",
+			Handler:    _OrderService_GetOrdersByIDs_Handler,
+		},
+		{
+			MethodName: "ArchiveOrders",
+			Handler:    _OrderService_ArchiveOrders_Handler,
+		},
+		{
+			MethodName: "GetArchivedOrder",
+			Handler:    _OrderService_GetArchivedOrder_Handler,
+		},
+		{
+			MethodName: "CreateQuote",
+			Handler:    _OrderService_CreateQuote_Handler,
+		},
+		{
+			MethodName: "AcceptQuote",
+			Handler:    _OrderService_AcceptQuote_Handler,
+		},
+		{
+			MethodName: "ConvertQuoteToOrder",
+			Handler:    _OrderService_ConvertQuoteToOrder_Handler,
+		},
+		{
+			MethodName: "PriceOrder",
+			Handler:    _OrderService_PriceOrder_Handler,
+		},
+		{
+			MethodName: "CheckPurchaseEligibility",
+			Handler:    _OrderService_CheckPurchaseEligibility_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchOrder",
+			Handler:       _OrderService_WatchOrder_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportOrders",
+			Handler:       _OrderService_ImportOrders_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "order.proto",
 }
@@ -0,0 +1,212 @@
+// Code generated by protoc-gen-go-shim. DO NOT EDIT.
+// source: order.proto
+
+package gen
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	iter "iter"
+)
+
+// OrderServiceAPI is OrderServiceClient without per-call options, so it can be
+// mocked with plain method signatures. Streams are exposed as iterators.
+type OrderServiceAPI interface {
+	InsertOrder(ctx context.Context, in *InsertOrderRequest) (*InsertOrderResponse, error)
+	GetAllOrders(ctx context.Context, in *GetAllOrdersRequest) (*GetAllOrdersResponse, error)
+	// 주문 단건 조회, 본인 주문이 아니면 orders:admin 필요 (없으면 NOT_FOUND)
+	// 아카이브된 주문은 NOT_FOUND, GetArchivedOrder로 조회
+	GetOrderByID(ctx context.Context, in *GetOrderByIDRequest) (*GetOrderByIDResponse, error)
+	// 사용자별 주문 목록 (최신 주문 순), 다른 사용자의 주문은 orders:admin 필요 (없으면 PERMISSION_DENIED)
+	GetOrdersByUser(ctx context.Context, in *GetOrdersByUserRequest) (*GetOrdersByUserResponse, error)
+	// 주문 상태 변경을 실시간으로 전달 (GetAllOrders 폴링 대체)
+	// 구독 직후 현재 상태를 한 번 보내고, 이후 변경될 때마다 전달
+	// 게이트웨이에서는 Accept: text/event-stream 요청 시 SSE로 응답
+	WatchOrder(ctx context.Context, in *WatchOrderRequest) iter.Seq2[*OrderStatusEvent, error]
+	// 배송 전(PENDING/PAID) 주문 취소, 결제 완료 주문은 전액 환불까지 처리
+	CancelOrder(ctx context.Context, in *CancelOrderRequest) (*CancelOrderResponse, error)
+	// 전체/부분 환불 (PaymentService.KakaoCancel로 결제 취소 후 Order.refunds에 기록)
+	RefundOrder(ctx context.Context, in *RefundOrderRequest) (*RefundOrderResponse, error)
+	// 반품 건에 대해 택배사 수거 예약 후 출력용 라벨 URL 발급
+	CreateReturnLabel(ctx context.Context, in *CreateReturnLabelRequest) (*CreateReturnLabelResponse, error)
+	// 전화/오프라인 주문 및 마켓플레이스 주문 일괄 등록 (행 단위 검증 결과 반환)
+	ImportOrders(ctx context.Context, in iter.Seq[*ImportOrdersRequest]) (*ImportOrdersResponse, error)
+	// 여러 주문 ID를 한 번에 조회, orders:admin 필요 (일부만 존재해도 성공, 없는 ID는 not_found_ids로 반환)
+	GetOrdersByIDs(ctx context.Context, in *GetOrdersByIDsRequest) (*GetOrdersByIDsResponse, error)
+	// before_date 이전 주문을 콜드 스토리지로 이동
+	ArchiveOrders(ctx context.Context, in *ArchiveOrdersRequest) (*ArchiveOrdersResponse, error)
+	// 아카이브된 주문 조회
+	GetArchivedOrder(ctx context.Context, in *GetArchivedOrderRequest) (*GetArchivedOrderResponse, error)
+	// B2B 견적: 생성 → 고객 수락 → 주문 전환 (외상 결제 조건 지원)
+	CreateQuote(ctx context.Context, in *CreateQuoteRequest) (*CreateQuoteResponse, error)
+	AcceptQuote(ctx context.Context, in *AcceptQuoteRequest) (*AcceptQuoteResponse, error)
+	ConvertQuoteToOrder(ctx context.Context, in *ConvertQuoteToOrderRequest) (*ConvertQuoteToOrderResponse, error)
+	// 프로모션 규칙을 적용한 주문 금액 계산 (장바구니/결제/환불 시 공통 사용)
+	// 같은 입력과 priced_at이면 항상 같은 결과를 반환
+	PriceOrder(ctx context.Context, in *PriceOrderRequest) (*PriceOrderResponse, error)
+	// 고객당 구매 수량 제한 확인 (장바구니/결제/주문 등록 시 공통 사용)
+	CheckPurchaseEligibility(ctx context.Context, in *CheckPurchaseEligibilityRequest) (*CheckPurchaseEligibilityResponse, error)
+}
+
+// NewOrderServiceAPI adapts c to OrderServiceAPI, passing opts to every call.
+func NewOrderServiceAPI(c OrderServiceClient, opts ...grpc.CallOption) OrderServiceAPI {
+	return &orderServiceAPI{c: c, opts: opts}
+}
+
+type orderServiceAPI struct {
+	c    OrderServiceClient
+	opts []grpc.CallOption
+}
+
+func (a *orderServiceAPI) InsertOrder(ctx context.Context, in *InsertOrderRequest) (*InsertOrderResponse, error) {
+	return a.c.InsertOrder(ctx, in, a.opts...)
+}
+
+func (a *orderServiceAPI) GetAllOrders(ctx context.Context, in *GetAllOrdersRequest) (*GetAllOrdersResponse, error) {
+	return a.c.GetAllOrders(ctx, in, a.opts...)
+}
+
+func (a *orderServiceAPI) GetOrderByID(ctx context.Context, in *GetOrderByIDRequest) (*GetOrderByIDResponse, error) {
+	return a.c.GetOrderByID(ctx, in, a.opts...)
+}
+
+func (a *orderServiceAPI) GetOrdersByUser(ctx context.Context, in *GetOrdersByUserRequest) (*GetOrdersByUserResponse, error) {
+	return a.c.GetOrdersByUser(ctx, in, a.opts...)
+}
+
+func (a *orderServiceAPI) WatchOrder(ctx context.Context, in *WatchOrderRequest) iter.Seq2[*OrderStatusEvent, error] {
+	return serverStreamSeq(ctx, func(ctx context.Context) (grpc.ServerStreamingClient[OrderStatusEvent], error) {
+		return a.c.WatchOrder(ctx, in, a.opts...)
+	})
+}
+
+func (a *orderServiceAPI) CancelOrder(ctx context.Context, in *CancelOrderRequest) (*CancelOrderResponse, error) {
+	return a.c.CancelOrder(ctx, in, a.opts...)
+}
+
+func (a *orderServiceAPI) RefundOrder(ctx context.Context, in *RefundOrderRequest) (*RefundOrderResponse, error) {
+	return a.c.RefundOrder(ctx, in, a.opts...)
+}
+
+func (a *orderServiceAPI) CreateReturnLabel(ctx context.Context, in *CreateReturnLabelRequest) (*CreateReturnLabelResponse, error) {
+	return a.c.CreateReturnLabel(ctx, in, a.opts...)
+}
+
+func (a *orderServiceAPI) ImportOrders(ctx context.Context, in iter.Seq[*ImportOrdersRequest]) (*ImportOrdersResponse, error) {
+	stream, err := a.c.ImportOrders(ctx, a.opts...)
+	if err != nil {
+		return nil, err
+	}
+	return sendAll(stream, in)
+}
+
+func (a *orderServiceAPI) GetOrdersByIDs(ctx context.Context, in *GetOrdersByIDsRequest) (*GetOrdersByIDsResponse, error) {
+	return a.c.GetOrdersByIDs(ctx, in, a.opts...)
+}
+
+func (a *orderServiceAPI) ArchiveOrders(ctx context.Context, in *ArchiveOrdersRequest) (*ArchiveOrdersResponse, error) {
+	return a.c.ArchiveOrders(ctx, in, a.opts...)
+}
+
+func (a *orderServiceAPI) GetArchivedOrder(ctx context.Context, in *GetArchivedOrderRequest) (*GetArchivedOrderResponse, error) {
+	return a.c.GetArchivedOrder(ctx, in, a.opts...)
+}
+
+func (a *orderServiceAPI) CreateQuote(ctx context.Context, in *CreateQuoteRequest) (*CreateQuoteResponse, error) {
+	return a.c.CreateQuote(ctx, in, a.opts...)
+}
+
+func (a *orderServiceAPI) AcceptQuote(ctx context.Context, in *AcceptQuoteRequest) (*AcceptQuoteResponse, error) {
+	return a.c.AcceptQuote(ctx, in, a.opts...)
+}
+
+func (a *orderServiceAPI) ConvertQuoteToOrder(ctx context.Context, in *ConvertQuoteToOrderRequest) (*ConvertQuoteToOrderResponse, error) {
+	return a.c.ConvertQuoteToOrder(ctx, in, a.opts...)
+}
+
+func (a *orderServiceAPI) PriceOrder(ctx context.Context, in *PriceOrderRequest) (*PriceOrderResponse, error) {
+	return a.c.PriceOrder(ctx, in, a.opts...)
+}
+
+func (a *orderServiceAPI) CheckPurchaseEligibility(ctx context.Context, in *CheckPurchaseEligibilityRequest) (*CheckPurchaseEligibilityResponse, error) {
+	return a.c.CheckPurchaseEligibility(ctx, in, a.opts...)
+}
+
+// OrderServiceClientFromAPI adapts a to OrderServiceClient, e.g. to hand a
+// mock OrderServiceAPI to code that takes the generated client. Call options
+// are ignored, and streams report empty headers and trailers.
+func OrderServiceClientFromAPI(a OrderServiceAPI) OrderServiceClient {
+	return orderServiceAPIClient{api: a}
+}
+
+type orderServiceAPIClient struct {
+	api OrderServiceAPI
+}
+
+func (c orderServiceAPIClient) InsertOrder(ctx context.Context, in *InsertOrderRequest, _ ...grpc.CallOption) (*InsertOrderResponse, error) {
+	return c.api.InsertOrder(ctx, in)
+}
+
+func (c orderServiceAPIClient) GetAllOrders(ctx context.Context, in *GetAllOrdersRequest, _ ...grpc.CallOption) (*GetAllOrdersResponse, error) {
+	return c.api.GetAllOrders(ctx, in)
+}
+
+func (c orderServiceAPIClient) GetOrderByID(ctx context.Context, in *GetOrderByIDRequest, _ ...grpc.CallOption) (*GetOrderByIDResponse, error) {
+	return c.api.GetOrderByID(ctx, in)
+}
+
+func (c orderServiceAPIClient) GetOrdersByUser(ctx context.Context, in *GetOrdersByUserRequest, _ ...grpc.CallOption) (*GetOrdersByUserResponse, error) {
+	return c.api.GetOrdersByUser(ctx, in)
+}
+
+func (c orderServiceAPIClient) WatchOrder(ctx context.Context, in *WatchOrderRequest, _ ...grpc.CallOption) (grpc.ServerStreamingClient[OrderStatusEvent], error) {
+	return newSeqServerStream(ctx, c.api.WatchOrder(ctx, in)), nil
+}
+
+func (c orderServiceAPIClient) CancelOrder(ctx context.Context, in *CancelOrderRequest, _ ...grpc.CallOption) (*CancelOrderResponse, error) {
+	return c.api.CancelOrder(ctx, in)
+}
+
+func (c orderServiceAPIClient) RefundOrder(ctx context.Context, in *RefundOrderRequest, _ ...grpc.CallOption) (*RefundOrderResponse, error) {
+	return c.api.RefundOrder(ctx, in)
+}
+
+func (c orderServiceAPIClient) CreateReturnLabel(ctx context.Context, in *CreateReturnLabelRequest, _ ...grpc.CallOption) (*CreateReturnLabelResponse, error) {
+	return c.api.CreateReturnLabel(ctx, in)
+}
+
+func (c orderServiceAPIClient) ImportOrders(ctx context.Context, _ ...grpc.CallOption) (grpc.ClientStreamingClient[ImportOrdersRequest, ImportOrdersResponse], error) {
+	return newSeqClientStreamingClient(ctx, c.api.ImportOrders), nil
+}
+
+func (c orderServiceAPIClient) GetOrdersByIDs(ctx context.Context, in *GetOrdersByIDsRequest, _ ...grpc.CallOption) (*GetOrdersByIDsResponse, error) {
+	return c.api.GetOrdersByIDs(ctx, in)
+}
+
+func (c orderServiceAPIClient) ArchiveOrders(ctx context.Context, in *ArchiveOrdersRequest, _ ...grpc.CallOption) (*ArchiveOrdersResponse, error) {
+	return c.api.ArchiveOrders(ctx, in)
+}
+
+func (c orderServiceAPIClient) GetArchivedOrder(ctx context.Context, in *GetArchivedOrderRequest, _ ...grpc.CallOption) (*GetArchivedOrderResponse, error) {
+	return c.api.GetArchivedOrder(ctx, in)
+}
+
+func (c orderServiceAPIClient) CreateQuote(ctx context.Context, in *CreateQuoteRequest, _ ...grpc.CallOption) (*CreateQuoteResponse, error) {
+	return c.api.CreateQuote(ctx, in)
+}
+
+func (c orderServiceAPIClient) AcceptQuote(ctx context.Context, in *AcceptQuoteRequest, _ ...grpc.CallOption) (*AcceptQuoteResponse, error) {
+	return c.api.AcceptQuote(ctx, in)
+}
+
+func (c orderServiceAPIClient) ConvertQuoteToOrder(ctx context.Context, in *ConvertQuoteToOrderRequest, _ ...grpc.CallOption) (*ConvertQuoteToOrderResponse, error) {
+	return c.api.ConvertQuoteToOrder(ctx, in)
+}
+
+func (c orderServiceAPIClient) PriceOrder(ctx context.Context, in *PriceOrderRequest, _ ...grpc.CallOption) (*PriceOrderResponse, error) {
+	return c.api.PriceOrder(ctx, in)
+}
+
+func (c orderServiceAPIClient) CheckPurchaseEligibility(ctx context.Context, in *CheckPurchaseEligibilityRequest, _ ...grpc.CallOption) (*CheckPurchaseEligibilityResponse, error) {
+	return c.api.CheckPurchaseEligibility(ctx, in)
+}
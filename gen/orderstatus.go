@@ -0,0 +1,77 @@
+package gen
+
+import "strings"
+
+// Legacy returns the status as the string older services wrote to the
+// deprecated status field, e.g. "pending". It is empty for
+// ORDER_STATUS_UNSPECIFIED.
+func (s OrderStatus) Legacy() string {
+	if s == OrderStatus_ORDER_STATUS_UNSPECIFIED {
+		return ""
+	}
+	return strings.ToLower(strings.TrimPrefix(s.String(), "ORDER_STATUS_"))
+}
+
+// ParseOrderStatus converts a legacy status string to an OrderStatus. It
+// ignores case and surrounding spaces and accepts both "PAID" and
+// "ORDER_STATUS_PAID", as well as the spelling "canceled". ok is false for
+// empty or unknown values, which map to ORDER_STATUS_UNSPECIFIED.
+func ParseOrderStatus(s string) (status OrderStatus, ok bool) {
+	name := strings.ToUpper(strings.TrimSpace(s))
+	if name == "" {
+		return OrderStatus_ORDER_STATUS_UNSPECIFIED, false
+	}
+	if name == "CANCELED" {
+		name = "CANCELLED"
+	}
+	if !strings.HasPrefix(name, "ORDER_STATUS_") {
+		name = "ORDER_STATUS_" + name
+	}
+	v, ok := OrderStatus_value[name]
+	if !ok || v == 0 {
+		return OrderStatus_ORDER_STATUS_UNSPECIFIED, false
+	}
+	return OrderStatus(v), true
+}
+
+// EffectiveStatus returns the order's order_status, falling back to the
+// deprecated string status for orders written by services that predate
+// OrderStatus.
+func (o *Order) EffectiveStatus() OrderStatus {
+	return effectiveStatus(o.GetOrderStatus(), o.GetStatus())
+}
+
+// SyncStatus fills in whichever of order_status and status is missing from
+// the other, so the order reads the same to old and new services while they
+// are rolled out.
+func (o *Order) SyncStatus() {
+	o.OrderStatus, o.Status = syncStatus(o.OrderStatus, o.Status)
+}
+
+// EffectiveStatus returns the requested order_status, falling back to the
+// deprecated string status sent by older clients.
+func (r *InsertOrderRequest) EffectiveStatus() OrderStatus {
+	return effectiveStatus(r.GetOrderStatus(), r.GetStatus())
+}
+
+// SyncStatus fills in whichever of order_status and status is missing from
+// the other.
+func (r *InsertOrderRequest) SyncStatus() {
+	r.OrderStatus, r.Status = syncStatus(r.OrderStatus, r.Status)
+}
+
+func effectiveStatus(status OrderStatus, legacy string) OrderStatus {
+	if status != OrderStatus_ORDER_STATUS_UNSPECIFIED {
+		return status
+	}
+	s, _ := ParseOrderStatus(legacy)
+	return s
+}
+
+func syncStatus(status OrderStatus, legacy string) (OrderStatus, string) {
+	status = effectiveStatus(status, legacy)
+	if legacy == "" {
+		legacy = status.Legacy()
+	}
+	return status, legacy
+}
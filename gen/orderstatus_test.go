@@ -0,0 +1,67 @@
+package gen
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func TestParseOrderStatus(t *testing.T) {
+	tests := []struct {
+		in     string
+		want   OrderStatus
+		wantOK bool
+	}{
+		{"paid", OrderStatus_ORDER_STATUS_PAID, true},
+		{" PENDING ", OrderStatus_ORDER_STATUS_PENDING, true},
+		{"ORDER_STATUS_SHIPPED", OrderStatus_ORDER_STATUS_SHIPPED, true},
+		{"canceled", OrderStatus_ORDER_STATUS_CANCELLED, true},
+		{"", OrderStatus_ORDER_STATUS_UNSPECIFIED, false},
+		{"unspecified", OrderStatus_ORDER_STATUS_UNSPECIFIED, false},
+		{"lost", OrderStatus_ORDER_STATUS_UNSPECIFIED, false},
+	}
+	for _, tt := range tests {
+		got, ok := ParseOrderStatus(tt.in)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("ParseOrderStatus(%q) = %v, %v; want %v, %v", tt.in, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestOrderSyncStatus(t *testing.T) {
+	tests := []struct {
+		name  string
+		order *Order
+		want  *Order
+	}{
+		{
+			name:  "legacy only",
+			order: &Order{Status: "paid"},
+			want:  &Order{Status: "paid", OrderStatus: OrderStatus_ORDER_STATUS_PAID},
+		},
+		{
+			name:  "enum only",
+			order: &Order{OrderStatus: OrderStatus_ORDER_STATUS_SHIPPED},
+			want:  &Order{Status: "shipped", OrderStatus: OrderStatus_ORDER_STATUS_SHIPPED},
+		},
+		{
+			name:  "both set keeps legacy spelling",
+			order: &Order{Status: "CANCELED", OrderStatus: OrderStatus_ORDER_STATUS_CANCELLED},
+			want:  &Order{Status: "CANCELED", OrderStatus: OrderStatus_ORDER_STATUS_CANCELLED},
+		},
+		{
+			name:  "unknown legacy",
+			order: &Order{Status: "lost"},
+			want:  &Order{Status: "lost"},
+		},
+		{name: "empty", order: &Order{}, want: &Order{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.order.SyncStatus()
+			if !proto.Equal(tt.order, tt.want) {
+				t.Errorf("SyncStatus() = %v, want %v", tt.order, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package gen
+
+import (
+	"context"
+	"errors"
+	"iter"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrPagerDone is returned by Pager.NextPage after the last page.
+var ErrPagerDone = errors.New("gen: no more pages")
+
+// Pager walks the pages of a list RPC by following next_page_token:
+//
+//	p := pb.NewProductsPager(client, &pb.GetProductsRequest{PageSize: 50})
+//	for product, err := range p.All(ctx) {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    // ...
+//	}
+//
+// A Pager is not safe for concurrent use.
+type Pager[T any] struct {
+	fetch func(ctx context.Context, token string) (items []T, next string, total int32, err error)
+	token string
+	total int32
+	done  bool
+}
+
+// ProductsPager pages through ProductService.GetProducts.
+type ProductsPager = Pager[*Product]
+
+// OrdersPager pages through OrderService.GetAllOrders.
+type OrdersPager = Pager[*Order]
+
+// UserOrdersPager pages through OrderService.GetOrdersByUser.
+type UserOrdersPager = Pager[*Order]
+
+// NotificationsPager pages through NotificationService.ListNotifications.
+type NotificationsPager = Pager[*Notification]
+
+// ChatMessagesPager pages through ChatService.ListChatMessages.
+type ChatMessagesPager = Pager[*ChatMessage]
+
+// ReviewsPager pages through ReviewService.ListReviewsByProduct.
+type ReviewsPager = Pager[*Review]
+
+// WishlistPager pages through WishlistService.ListWishlist.
+type WishlistPager = Pager[*WishlistItem]
+
+// NewProductsPager returns a pager over GetProducts starting at
+// req.PageToken. req is not modified.
+func NewProductsPager(c ProductServiceClient, req *GetProductsRequest, opts ...grpc.CallOption) *ProductsPager {
+	req = cloneRequest(req)
+	return newPager(req.GetPageToken(), func(ctx context.Context, token string) ([]*Product, string, int32, error) {
+		req.PageToken = token
+		res, err := c.GetProducts(ctx, req, opts...)
+		return res.GetProducts(), res.GetNextPageToken(), res.GetTotalCount(), err
+	})
+}
+
+// NewOrdersPager returns a pager over GetAllOrders starting at
+// req.PageToken. req is not modified.
+func NewOrdersPager(c OrderServiceClient, req *GetAllOrdersRequest, opts ...grpc.CallOption) *OrdersPager {
+	req = cloneRequest(req)
+	return newPager(req.GetPageToken(), func(ctx context.Context, token string) ([]*Order, string, int32, error) {
+		req.PageToken = token
+		res, err := c.GetAllOrders(ctx, req, opts...)
+		return res.GetOrders(), res.GetNextPageToken(), res.GetTotalCount(), err
+	})
+}
+
+// NewUserOrdersPager returns a pager over GetOrdersByUser starting at
+// req.PageToken. req is not modified.
+func NewUserOrdersPager(c OrderServiceClient, req *GetOrdersByUserRequest, opts ...grpc.CallOption) *UserOrdersPager {
+	req = cloneRequest(req)
+	return newPager(req.GetPageToken(), func(ctx context.Context, token string) ([]*Order, string, int32, error) {
+		req.PageToken = token
+		res, err := c.GetOrdersByUser(ctx, req, opts...)
+		return res.GetOrders(), res.GetNextPageToken(), res.GetTotalCount(), err
+	})
+}
+
+// NewNotificationsPager returns a pager over ListNotifications starting at
+// req.PageToken. req is not modified. ListNotifications has no total count,
+// so TotalCount reports the unread count of the last page instead.
+func NewNotificationsPager(c NotificationServiceClient, req *ListNotificationsRequest, opts ...grpc.CallOption) *NotificationsPager {
+	req = cloneRequest(req)
+	return newPager(req.GetPageToken(), func(ctx context.Context, token string) ([]*Notification, string, int32, error) {
+		req.PageToken = token
+		res, err := c.ListNotifications(ctx, req, opts...)
+		return res.GetNotifications(), res.GetNextPageToken(), res.GetUnreadCount(), err
+	})
+}
+
+// NewChatMessagesPager returns a pager over ListChatMessages starting at
+// req.PageToken. req is not modified. TotalCount is always zero.
+func NewChatMessagesPager(c ChatServiceClient, req *ListChatMessagesRequest, opts ...grpc.CallOption) *ChatMessagesPager {
+	req = cloneRequest(req)
+	return newPager(req.GetPageToken(), func(ctx context.Context, token string) ([]*ChatMessage, string, int32, error) {
+		req.PageToken = token
+		res, err := c.ListChatMessages(ctx, req, opts...)
+		return res.GetMessages(), res.GetNextPageToken(), 0, err
+	})
+}
+
+// NewReviewsPager returns a pager over ListReviewsByProduct starting at
+// req.PageToken. req is not modified.
+func NewReviewsPager(c ReviewServiceClient, req *ListReviewsByProductRequest, opts ...grpc.CallOption) *ReviewsPager {
+	req = cloneRequest(req)
+	return newPager(req.GetPageToken(), func(ctx context.Context, token string) ([]*Review, string, int32, error) {
+		req.PageToken = token
+		res, err := c.ListReviewsByProduct(ctx, req, opts...)
+		return res.GetReviews(), res.GetNextPageToken(), res.GetTotalCount(), err
+	})
+}
+
+// NewWishlistPager returns a pager over ListWishlist starting at
+// req.PageToken. req is not modified.
+func NewWishlistPager(c WishlistServiceClient, req *ListWishlistRequest, opts ...grpc.CallOption) *WishlistPager {
+	req = cloneRequest(req)
+	return newPager(req.GetPageToken(), func(ctx context.Context, token string) ([]*WishlistItem, string, int32, error) {
+		req.PageToken = token
+		res, err := c.ListWishlist(ctx, req, opts...)
+		return res.GetItems(), res.GetNextPageToken(), res.GetTotalCount(), err
+	})
+}
+
+func newPager[T any](token string, fetch func(context.Context, string) ([]T, string, int32, error)) *Pager[T] {
+	return &Pager[T]{fetch: fetch, token: token}
+}
+
+// cloneRequest copies req so the pager can set page tokens on it. A nil req
+// is treated as empty.
+func cloneRequest[M interface {
+	*R
+	proto.Message
+}, R any](req M) M {
+	out := M(new(R))
+	if req != nil {
+		proto.Merge(out, req)
+	}
+	return out
+}
+
+// NextPage fetches the next page. It returns ErrPagerDone once the last page
+// has been returned. A failed call can be retried; the pager does not advance.
+func (p *Pager[T]) NextPage(ctx context.Context) ([]T, error) {
+	if p.done {
+		return nil, ErrPagerDone
+	}
+	items, next, total, err := p.fetch(ctx, p.token)
+	if err != nil {
+		return nil, err
+	}
+	p.token, p.total = next, total
+	p.done = next == ""
+	return items, nil
+}
+
+// HasNext reports whether NextPage may return another page.
+func (p *Pager[T]) HasNext() bool { return !p.done }
+
+// PageToken is the token of the page NextPage fetches next, e.g. to resume a
+// walk later.
+func (p *Pager[T]) PageToken() string { return p.token }
+
+// TotalCount is the total count reported by the most recent page.
+func (p *Pager[T]) TotalCount() int32 { return p.total }
+
+// All yields the items of the remaining pages, fetching them lazily. An error
+// is yielded once as (zero, err) and ends the sequence.
+func (p *Pager[T]) All(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for !p.done {
+			items, err := p.NextPage(ctx)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package gen
+
+import (
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+// DefaultPageBytes is the serialized size budget per list page. It applies to
+// the uncompressed message, so the response on the wire is never larger.
+const DefaultPageBytes = 256 << 10
+
+// entrySize is the encoded size of m as an element of a repeated message
+// field with a one-byte tag, the case for every list field in these protos.
+func entrySize(m proto.Message) int {
+	n := proto.Size(m)
+	return 1 + protowire.SizeVarint(uint64(n)) + n
+}
+
+// PageSizeForBudget estimates how many items fit in budget bytes from the
+// average encoded size of samples, e.g. the previous page, and clamps the
+// result to [1, maxSize]. With no samples it returns maxSize. A budget of zero
+// means DefaultPageBytes.
+func PageSizeForBudget[M proto.Message](samples []M, budget, maxSize int) int {
+	if budget <= 0 {
+		budget = DefaultPageBytes
+	}
+	if len(samples) == 0 {
+		return maxSize
+	}
+	total := 0
+	for _, m := range samples {
+		total += entrySize(m)
+	}
+	n := budget * len(samples) / max(total, 1)
+	return min(max(n, 1), maxSize)
+}
+
+// TruncateToBudget returns the longest prefix of items whose encoded size stays
+// within budget (zero means DefaultPageBytes). The first item is always kept so
+// a page walk makes progress even past a single oversized item. Servers should
+// issue the next page token from the last item returned, not the last item
+// fetched.
+func TruncateToBudget[M proto.Message](items []M, budget int) []M {
+	if budget <= 0 {
+		budget = DefaultPageBytes
+	}
+	used := 0
+	for i, m := range items {
+		used += entrySize(m)
+		if used > budget && i > 0 {
+			return items[:i]
+		}
+	}
+	return items
+}
@@ -0,0 +1,80 @@
+package gen
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPageTokenCodec(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	codec := NewPageTokenCodec([]byte("key"), time.Hour)
+	codec.now = func() time.Time { return now }
+	cur := PageCursor{SortKey: "2024-01-01", LastID: "o42", Query: "status=PAID"}
+	token, err := codec.Encode(cur)
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload, sig, _ := strings.Cut(token, ".")
+	other := NewPageTokenCodec([]byte("other"), time.Hour)
+	foreign, err := other.Encode(cur)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		token  string
+		query  string
+		later  time.Duration
+		want   PageCursor
+		reason ErrorReason
+	}{
+		{name: "first page", token: "", query: "status=PAID"},
+		{name: "round trip", token: token, query: "status=PAID", want: PageCursor{SortKey: "2024-01-01", LastID: "o42", Query: "status=PAID", IssuedAt: now.Unix()}},
+		{name: "within ttl", token: token, query: "status=PAID", later: 59 * time.Minute, want: PageCursor{SortKey: "2024-01-01", LastID: "o42", Query: "status=PAID", IssuedAt: now.Unix()}},
+		{name: "expired", token: token, query: "status=PAID", later: 61 * time.Minute, reason: ErrorReason_ERROR_REASON_PAGE_TOKEN_EXPIRED},
+		{name: "other query", token: token, query: "status=SHIPPED", reason: ErrorReason_ERROR_REASON_INVALID_PAGE_TOKEN},
+		{name: "no signature", token: payload, query: "status=PAID", reason: ErrorReason_ERROR_REASON_INVALID_PAGE_TOKEN},
+		{name: "tampered payload", token: "x" + payload[1:] + "." + sig, query: "status=PAID", reason: ErrorReason_ERROR_REASON_INVALID_PAGE_TOKEN},
+		{name: "bad base64", token: "!!." + sig, query: "status=PAID", reason: ErrorReason_ERROR_REASON_INVALID_PAGE_TOKEN},
+		{name: "foreign key", token: foreign, query: "status=PAID", reason: ErrorReason_ERROR_REASON_INVALID_PAGE_TOKEN},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			codec.now = func() time.Time { return now.Add(tt.later) }
+			got, err := codec.Decode(tt.token, tt.query)
+			if tt.reason != ErrorReason_ERROR_REASON_UNSPECIFIED {
+				if status.Code(err) != codes.InvalidArgument || ErrorReasonOf(err) != tt.reason {
+					t.Fatalf("Decode() error = %v, want %v", err, tt.reason)
+				}
+				if got != (PageCursor{}) {
+					t.Errorf("Decode() = %+v on error, want zero cursor", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Decode() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Decode() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPageTokenCodecNoTTL(t *testing.T) {
+	codec := NewPageTokenCodec([]byte("key"), 0)
+	codec.now = func() time.Time { return time.Unix(0, 0) }
+	token, err := codec.Encode(PageCursor{LastID: "a"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	codec.now = func() time.Time { return time.Unix(0, 0).Add(24 * 365 * time.Hour) }
+	if _, err := codec.Decode(token, ""); err != nil {
+		t.Errorf("Decode() with zero ttl error = %v", err)
+	}
+}
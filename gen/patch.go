@@ -0,0 +1,104 @@
+package gen
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ApplyPatch copies the fields set in patch to the fields of dst with the same
+// name, leaving the others unchanged. Every field of a patch message has
+// presence (proto3 optional or a message), so a field explicitly set to zero
+// is applied while an omitted one is not. A message field whose only field is
+// a list (such as PriceTierList) replaces the same-named list of dst, so an
+// empty wrapper clears it.
+//
+// ApplyPatch panics if a set field has no counterpart of the same type in dst,
+// which means the patch message does not mirror dst.
+//
+//	var p Product
+//	ApplyPatch(&p, &ProductPatch{MaxPerCustomer: proto.Int32(0)}) // sets max_per_customer to 0
+func ApplyPatch[D, P proto.Message](dst D, patch P) {
+	d := dst.ProtoReflect()
+	patch.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		target := d.Descriptor().Fields().ByName(fd.Name())
+		switch {
+		case target == nil:
+			panic(fmt.Sprintf("gen: %s has no field %s", d.Descriptor().FullName(), fd.Name()))
+		case target.IsList() && listWrapper(fd) != nil:
+			src := v.Message().Get(listWrapper(fd)).List()
+			list := d.NewField(target).List()
+			for i := 0; i < src.Len(); i++ {
+				list.Append(cloneValue(src.Get(i)))
+			}
+			d.Set(target, protoreflect.ValueOfList(list))
+		case sameType(fd, target):
+			d.Set(target, cloneValue(v))
+		default:
+			panic(fmt.Sprintf("gen: patch field %s does not match %s", fd.FullName(), target.FullName()))
+		}
+		return true
+	})
+}
+
+// ApplyProductPatch is ApplyPatch for UpdateProduct. It does not touch id,
+// created_at or updated_at. The deprecated price and tier unit_price fields
+// follow a patched list_price and price_tiers, so older readers see the new
+// prices; they are set to 0 for amounts that are not whole won.
+func ApplyProductPatch(dst *Product, patch *ProductPatch) {
+	ApplyPatch(dst, patch)
+	if patch.GetListPrice() != nil {
+		dst.Price = legacyKRW(dst.GetListPrice())
+	}
+	if patch.GetPriceTiers() != nil {
+		for _, t := range dst.GetPriceTiers() {
+			if t.GetPrice() != nil {
+				t.UnitPrice = legacyKRW(t.GetPrice())
+			}
+		}
+	}
+}
+
+// legacyKRW returns m as whole won for a deprecated int64 field, or 0 if it
+// is not a KRW amount.
+func legacyKRW(m *Money) int64 {
+	won, err := m.KRWUnits()
+	if err != nil {
+		return 0
+	}
+	return won
+}
+
+// listWrapper returns the list field of a message field whose type wraps a
+// single repeated field, or nil.
+func listWrapper(fd protoreflect.FieldDescriptor) protoreflect.FieldDescriptor {
+	if fd.Message() == nil || fd.IsList() || fd.IsMap() || fd.Message().Fields().Len() != 1 {
+		return nil
+	}
+	if f := fd.Message().Fields().Get(0); f.IsList() {
+		return f
+	}
+	return nil
+}
+
+func sameType(a, b protoreflect.FieldDescriptor) bool {
+	if a.Kind() != b.Kind() || a.IsList() != b.IsList() || a.IsMap() != b.IsMap() {
+		return false
+	}
+	switch {
+	case a.Message() != nil:
+		return a.Message().FullName() == b.Message().FullName()
+	case a.Enum() != nil:
+		return a.Enum().FullName() == b.Enum().FullName()
+	}
+	return true
+}
+
+// cloneValue copies message values so dst does not alias the patch.
+func cloneValue(v protoreflect.Value) protoreflect.Value {
+	if m, ok := v.Interface().(protoreflect.Message); ok {
+		return protoreflect.ValueOfMessage(proto.Clone(m.Interface()).ProtoReflect())
+	}
+	return v
+}
@@ -0,0 +1,70 @@
+package gen
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func TestApplyProductPatch(t *testing.T) {
+	stored := func() *Product {
+		return &Product{
+			Id: "p-1", Name: "머그컵", Price: 12000, ListPrice: KRW(12000), MaxPerCustomer: 2,
+			PriceTiers: []*PriceTier{{MinQuantity: 10, UnitPrice: 11000, Price: KRW(11000)}},
+		}
+	}
+	tests := []struct {
+		name  string
+		patch *ProductPatch
+		want  func(p *Product)
+	}{
+		{name: "empty patch", patch: &ProductPatch{}, want: func(*Product) {}},
+		{
+			name:  "explicit zero applied",
+			patch: &ProductPatch{MaxPerCustomer: proto.Int32(0), Name: proto.String("텀블러")},
+			want:  func(p *Product) { p.MaxPerCustomer, p.Name = 0, "텀블러" },
+		},
+		{
+			name:  "list price updates price",
+			patch: &ProductPatch{ListPrice: KRW(15000)},
+			want:  func(p *Product) { p.ListPrice, p.Price = KRW(15000), 15000 },
+		},
+		{
+			name:  "non-KRW list price clears price",
+			patch: &ProductPatch{ListPrice: &Money{CurrencyCode: "USD", Units: 9}},
+			want:  func(p *Product) { p.ListPrice, p.Price = &Money{CurrencyCode: "USD", Units: 9}, 0 },
+		},
+		{
+			name:  "tiers replaced with unit prices",
+			patch: &ProductPatch{PriceTiers: &PriceTierList{Tiers: []*PriceTier{{MinQuantity: 5, Price: KRW(10500)}}}},
+			want: func(p *Product) {
+				p.PriceTiers = []*PriceTier{{MinQuantity: 5, UnitPrice: 10500, Price: KRW(10500)}}
+			},
+		},
+		{
+			name:  "empty tier list clears tiers",
+			patch: &ProductPatch{PriceTiers: &PriceTierList{}},
+			want:  func(p *Product) { p.PriceTiers = nil },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, want := stored(), stored()
+			ApplyProductPatch(got, tt.patch)
+			tt.want(want)
+			if !proto.Equal(got, want) {
+				t.Errorf("ApplyProductPatch() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestApplyProductPatchDoesNotAlias(t *testing.T) {
+	patch := &ProductPatch{ListPrice: KRW(15000)}
+	var p Product
+	ApplyProductPatch(&p, patch)
+	patch.ListPrice.Units = 1
+	if p.GetListPrice().GetUnits() != 15000 {
+		t.Errorf("list_price aliases the patch: %v", p.GetListPrice())
+	}
+}
@@ -0,0 +1,168 @@
+package gen
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// detailsProvider returns the provider of a provider_details oneof case, or
+// UNSPECIFIED if none is set.
+func detailsProvider(kakaoPay, tossPayments bool) PaymentProvider {
+	switch {
+	case kakaoPay:
+		return PaymentProvider_PAYMENT_PROVIDER_KAKAO_PAY
+	case tossPayments:
+		return PaymentProvider_PAYMENT_PROVIDER_TOSS_PAYMENTS
+	}
+	return PaymentProvider_PAYMENT_PROVIDER_UNSPECIFIED
+}
+
+// checkProvider returns an InvalidArgument error if details are set for a
+// provider other than want.
+func checkProvider(want, details PaymentProvider) error {
+	if details == PaymentProvider_PAYMENT_PROVIDER_UNSPECIFIED || details == want {
+		return nil
+	}
+	return status.Errorf(codes.InvalidArgument, "%s details given for a %s payment", details, want)
+}
+
+// EffectiveProvider returns the provider to prepare the payment with: the
+// provider field, or the one implied by provider_details when it is unset. It
+// returns an InvalidArgument error if the two disagree or neither is set.
+func (r *PreparePaymentRequest) EffectiveProvider() (PaymentProvider, error) {
+	details := detailsProvider(r.GetKakaoPay() != nil, r.GetTossPayments() != nil)
+	switch provider := r.GetProvider(); {
+	case provider == PaymentProvider_PAYMENT_PROVIDER_UNSPECIFIED && details == PaymentProvider_PAYMENT_PROVIDER_UNSPECIFIED:
+		return provider, status.Error(codes.InvalidArgument, "payment provider is required")
+	case provider == PaymentProvider_PAYMENT_PROVIDER_UNSPECIFIED:
+		return details, nil
+	default:
+		return provider, checkProvider(provider, details)
+	}
+}
+
+// CheckProvider returns an InvalidArgument error if the request carries
+// provider_details for a provider other than that of payment p.
+func (r *ApprovePaymentRequest) CheckProvider(p *Payment) error {
+	return checkProvider(p.GetProvider(), detailsProvider(r.GetKakaoPay() != nil, r.GetTossPayments() != nil))
+}
+
+// CheckProvider returns an InvalidArgument error if the request carries
+// provider_details for a provider other than that of payment p.
+func (r *CancelPaymentRequest) CheckProvider(p *Payment) error {
+	return checkProvider(p.GetProvider(), detailsProvider(false, r.GetTossPayments() != nil))
+}
+
+// CancelableAmount returns the part of the approved amount not canceled yet.
+// Payments that are not approved have nothing to cancel.
+func (p *Payment) CancelableAmount() (*Money, error) {
+	switch p.GetStatus() {
+	case PaymentStatus_PAYMENT_STATUS_APPROVED, PaymentStatus_PAYMENT_STATUS_PARTIALLY_CANCELED:
+	default:
+		return &Money{CurrencyCode: p.currency()}, nil
+	}
+	left, err := MoneyOr(p.GetAmount(), 0).Sub(MoneyOr(p.GetCanceledAmount(), 0))
+	if err != nil {
+		return nil, err
+	}
+	if left.IsNegative() {
+		return &Money{CurrencyCode: p.currency()}, nil
+	}
+	return left, nil
+}
+
+// currency is the currency of the payment's amount, KRW if unset.
+func (p *Payment) currency() string {
+	return MoneyOr(p.GetAmount(), 0).GetCurrencyCode()
+}
+
+// CheckCancel validates CancelPayment request r against payment p. An amount
+// that is not positive, any amount in another currency than the payment, a
+// negative tax_free or vat, or a tax_free and vat adding up to more than the
+// amount is rejected with InvalidArgument. An amount exceeding
+// CancelableAmount fails with FailedPrecondition and
+// ERROR_REASON_REFUND_EXCEEDS_PAYMENT. An empty amount cancels what is left
+// and only fails when nothing is.
+func (p *Payment) CheckCancel(r *CancelPaymentRequest) error {
+	currency := p.currency()
+	if a := r.GetAmount(); a != nil && (a.IsZero() || a.IsNegative()) {
+		return status.Error(codes.InvalidArgument, "amount must be positive")
+	}
+	fields := []struct {
+		name string
+		m    *Money
+	}{{"amount", r.GetAmount()}, {"tax_free", r.GetTaxFree()}, {"vat", r.GetVat()}, {"cancel_available", r.GetCancelAvailable()}}
+	for _, f := range fields {
+		switch field, m := f.name, f.m; {
+		case m == nil:
+		case m.GetCurrencyCode() != currency:
+			return status.Errorf(codes.InvalidArgument, "%s is in %s but the payment is in %s", field, m.GetCurrencyCode(), currency)
+		case m.IsNegative():
+			return status.Errorf(codes.InvalidArgument, "%s must not be negative", field)
+		}
+	}
+	cancelable, err := p.CancelableAmount()
+	if err != nil {
+		return err
+	}
+	amount := r.GetAmount()
+	if amount == nil {
+		amount = cancelable
+	}
+	left, err := cancelable.Sub(amount)
+	if err != nil {
+		return err
+	}
+	if left.IsNegative() || cancelable.IsZero() {
+		return NewError(codes.FailedPrecondition, ErrorReason_ERROR_REASON_REFUND_EXCEEDS_PAYMENT,
+			"cancel of "+amount.Format()+" exceeds cancelable "+cancelable.Format(),
+			map[string]string{"payment_id": p.GetId(), "cancelable": cancelable.Format()})
+	}
+	parts := &Money{CurrencyCode: currency}
+	for _, m := range []*Money{r.GetTaxFree(), r.GetVat()} {
+		if m == nil {
+			continue
+		}
+		if parts, err = parts.Add(m); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if rest, err := amount.Sub(parts); err != nil || rest.IsNegative() {
+		return status.Errorf(codes.InvalidArgument, "tax_free and vat add up to more than the cancel amount %s", amount.Format())
+	}
+	return nil
+}
+
+// NewKakaoPaymentCancelRequest returns the PaymentService.KakaoCancel request
+// that carries out CancelPayment request r for Kakao Pay payment p; call
+// CheckCancel first and pass p's provider_payment_id as the tid. The order,
+// the merchant code (cid) and, unless r sets it, cancel_available come from
+// p, so the cancel goes to the merchant the payment was made with. An empty
+// amount cancels what is left, and an empty vat is left to Kakao Pay.
+func NewKakaoPaymentCancelRequest(p *Payment, r *CancelPaymentRequest) (*KakaoCancelRequest, error) {
+	if p.GetProvider() != PaymentProvider_PAYMENT_PROVIDER_KAKAO_PAY {
+		return nil, status.Errorf(codes.InvalidArgument, "payment %s is a %s payment", p.GetId(), p.GetProvider())
+	}
+	available, err := p.CancelableAmount()
+	if err != nil {
+		return nil, err
+	}
+	req := &KakaoCancelRequest{
+		PartnerOrderId:  p.GetOrderId(),
+		Cid:             p.GetCid(),
+		Cancel:          r.GetAmount(),
+		CancelTaxFree:   MoneyOr(r.GetTaxFree(), 0),
+		CancelVat:       r.GetVat(),
+		CancelAvailable: r.GetCancelAvailable(),
+	}
+	if req.Cancel == nil {
+		req.Cancel = available
+	}
+	if req.CancelAvailable == nil {
+		req.CancelAvailable = available
+	}
+	if err := fillLegacyCancelAmounts(req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
@@ -11,6 +11,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -23,21 +24,1212 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// 결제 대행사(PG)
+type PaymentProvider int32
+
+const (
+	PaymentProvider_PAYMENT_PROVIDER_UNSPECIFIED   PaymentProvider = 0
+	PaymentProvider_PAYMENT_PROVIDER_KAKAO_PAY     PaymentProvider = 1
+	PaymentProvider_PAYMENT_PROVIDER_TOSS_PAYMENTS PaymentProvider = 2
+)
+
+// Enum value maps for PaymentProvider.
+var (
+	PaymentProvider_name = map[int32]string{
+		0: "PAYMENT_PROVIDER_UNSPECIFIED",
+		1: "PAYMENT_PROVIDER_KAKAO_PAY",
+		2: "PAYMENT_PROVIDER_TOSS_PAYMENTS",
+	}
+	PaymentProvider_value = map[string]int32{
+		"PAYMENT_PROVIDER_UNSPECIFIED":   0,
+		"PAYMENT_PROVIDER_KAKAO_PAY":     1,
+		"PAYMENT_PROVIDER_TOSS_PAYMENTS": 2,
+	}
+)
+
+func (x PaymentProvider) Enum() *PaymentProvider {
+	p := new(PaymentProvider)
+	*p = x
+	return p
+}
+
+func (x PaymentProvider) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PaymentProvider) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_proto_enumTypes[0].Descriptor()
+}
+
+func (PaymentProvider) Type() protoreflect.EnumType {
+	return &file_payment_proto_enumTypes[0]
+}
+
+func (x PaymentProvider) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PaymentProvider.Descriptor instead.
+func (PaymentProvider) EnumDescriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{0}
+}
+
+// 결제 상태
+type PaymentStatus int32
+
+const (
+	PaymentStatus_PAYMENT_STATUS_UNSPECIFIED        PaymentStatus = 0
+	PaymentStatus_PAYMENT_STATUS_READY              PaymentStatus = 1 // 준비 완료, 사용자 인증 대기
+	PaymentStatus_PAYMENT_STATUS_APPROVED           PaymentStatus = 2
+	PaymentStatus_PAYMENT_STATUS_PARTIALLY_CANCELED PaymentStatus = 3
+	PaymentStatus_PAYMENT_STATUS_CANCELED           PaymentStatus = 4 // 전액 취소
+	PaymentStatus_PAYMENT_STATUS_FAILED             PaymentStatus = 5 // 승인 거절 또는 인증 만료
+)
+
+// Enum value maps for PaymentStatus.
+var (
+	PaymentStatus_name = map[int32]string{
+		0: "PAYMENT_STATUS_UNSPECIFIED",
+		1: "PAYMENT_STATUS_READY",
+		2: "PAYMENT_STATUS_APPROVED",
+		3: "PAYMENT_STATUS_PARTIALLY_CANCELED",
+		4: "PAYMENT_STATUS_CANCELED",
+		5: "PAYMENT_STATUS_FAILED",
+	}
+	PaymentStatus_value = map[string]int32{
+		"PAYMENT_STATUS_UNSPECIFIED":        0,
+		"PAYMENT_STATUS_READY":              1,
+		"PAYMENT_STATUS_APPROVED":           2,
+		"PAYMENT_STATUS_PARTIALLY_CANCELED": 3,
+		"PAYMENT_STATUS_CANCELED":           4,
+		"PAYMENT_STATUS_FAILED":             5,
+	}
+)
+
+func (x PaymentStatus) Enum() *PaymentStatus {
+	p := new(PaymentStatus)
+	*p = x
+	return p
+}
+
+func (x PaymentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PaymentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_proto_enumTypes[1].Descriptor()
+}
+
+func (PaymentStatus) Type() protoreflect.EnumType {
+	return &file_payment_proto_enumTypes[1]
+}
+
+func (x PaymentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PaymentStatus.Descriptor instead.
+func (PaymentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{1}
+}
+
+type Payment struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Provider          PaymentProvider        `protobuf:"varint,2,opt,name=provider,proto3,enum=go.escape.ship.proto.v1.PaymentProvider" json:"provider,omitempty"`
+	OrderId           string                 `protobuf:"bytes,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId            string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status            PaymentStatus          `protobuf:"varint,5,opt,name=status,proto3,enum=go.escape.ship.proto.v1.PaymentStatus" json:"status,omitempty"`
+	Amount            *Money                 `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`                                                                         // 결제 금액
+	CanceledAmount    *Money                 `protobuf:"bytes,7,opt,name=canceled_amount,json=canceledAmount,proto3" json:"canceled_amount,omitempty"`                                   // 취소 누계
+	ProviderPaymentId string                 `protobuf:"bytes,8,opt,name=provider_payment_id,json=providerPaymentId,proto3" json:"provider_payment_id,omitempty"`                        // PG사 결제 ID (카카오페이 tid, 토스페이먼츠 paymentKey)
+	Method            string                 `protobuf:"bytes,9,opt,name=method,proto3" json:"method,omitempty"`                                                                         // 결제 수단 (ex: "CARD", "MONEY", "VIRTUAL_ACCOUNT")
+	CardCompany       CardCompany            `protobuf:"varint,10,opt,name=card_company,json=cardCompany,proto3,enum=go.escape.ship.proto.v1.CardCompany" json:"card_company,omitempty"` // 카드 결제만 설정
+	ApprovedAt        *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=approved_at,json=approvedAt,proto3" json:"approved_at,omitempty"`
+	CanceledAt        *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=canceled_at,json=canceledAt,proto3" json:"canceled_at,omitempty"` // 마지막 취소 시각
+	Cid               string                 `protobuf:"bytes,13,opt,name=cid,proto3" json:"cid,omitempty"`                                 // 카카오페이 결제의 가맹점 코드 (KakaoReadyRequest.cid), 취소도 같은 코드로 요청
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Payment) Reset() {
+	*x = Payment{}
+	mi := &file_payment_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Payment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payment) ProtoMessage() {}
+
+func (x *Payment) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payment.ProtoReflect.Descriptor instead.
+func (*Payment) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Payment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Payment) GetProvider() PaymentProvider {
+	if x != nil {
+		return x.Provider
+	}
+	return PaymentProvider_PAYMENT_PROVIDER_UNSPECIFIED
+}
+
+func (x *Payment) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *Payment) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Payment) GetStatus() PaymentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
+}
+
+func (x *Payment) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Payment) GetCanceledAmount() *Money {
+	if x != nil {
+		return x.CanceledAmount
+	}
+	return nil
+}
+
+func (x *Payment) GetProviderPaymentId() string {
+	if x != nil {
+		return x.ProviderPaymentId
+	}
+	return ""
+}
+
+func (x *Payment) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *Payment) GetCardCompany() CardCompany {
+	if x != nil {
+		return x.CardCompany
+	}
+	return CardCompany_CARD_COMPANY_UNSPECIFIED
+}
+
+func (x *Payment) GetApprovedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ApprovedAt
+	}
+	return nil
+}
+
+func (x *Payment) GetCanceledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CanceledAt
+	}
+	return nil
+}
+
+func (x *Payment) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+// 카카오페이 결제 준비 옵션
+type KakaoPayPrepareDetails struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cid           string                 `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"` // KakaoReadyRequest.cid 참고
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KakaoPayPrepareDetails) Reset() {
+	*x = KakaoPayPrepareDetails{}
+	mi := &file_payment_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KakaoPayPrepareDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KakaoPayPrepareDetails) ProtoMessage() {}
+
+func (x *KakaoPayPrepareDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KakaoPayPrepareDetails.ProtoReflect.Descriptor instead.
+func (*KakaoPayPrepareDetails) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *KakaoPayPrepareDetails) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+// 토스페이먼츠 결제 준비 옵션
+type TossPaymentsPrepareDetails struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerKey   string                 `protobuf:"bytes,1,opt,name=customer_key,json=customerKey,proto3" json:"customer_key,omitempty"` // 고객 식별 키 (비어 있으면 비회원 결제)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TossPaymentsPrepareDetails) Reset() {
+	*x = TossPaymentsPrepareDetails{}
+	mi := &file_payment_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TossPaymentsPrepareDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TossPaymentsPrepareDetails) ProtoMessage() {}
+
+func (x *TossPaymentsPrepareDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TossPaymentsPrepareDetails.ProtoReflect.Descriptor instead.
+func (*TossPaymentsPrepareDetails) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TossPaymentsPrepareDetails) GetCustomerKey() string {
+	if x != nil {
+		return x.CustomerKey
+	}
+	return ""
+}
+
+// 토스페이먼츠 결제창(SDK requestPayment) 호출 정보
+type TossPaymentsPrepareResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"` // 토스페이먼츠 orderId (6~64자)
+	OrderName     string                 `protobuf:"bytes,2,opt,name=order_name,json=orderName,proto3" json:"order_name,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	CustomerKey   string                 `protobuf:"bytes,4,opt,name=customer_key,json=customerKey,proto3" json:"customer_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TossPaymentsPrepareResult) Reset() {
+	*x = TossPaymentsPrepareResult{}
+	mi := &file_payment_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TossPaymentsPrepareResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TossPaymentsPrepareResult) ProtoMessage() {}
+
+func (x *TossPaymentsPrepareResult) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TossPaymentsPrepareResult.ProtoReflect.Descriptor instead.
+func (*TossPaymentsPrepareResult) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TossPaymentsPrepareResult) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *TossPaymentsPrepareResult) GetOrderName() string {
+	if x != nil {
+		return x.OrderName
+	}
+	return ""
+}
+
+func (x *TossPaymentsPrepareResult) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *TossPaymentsPrepareResult) GetCustomerKey() string {
+	if x != nil {
+		return x.CustomerKey
+	}
+	return ""
+}
+
+type PreparePaymentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// provider_details와 다르면 INVALID_ARGUMENT, 비어 있으면 provider_details로 결정
+	Provider       PaymentProvider    `protobuf:"varint,1,opt,name=provider,proto3,enum=go.escape.ship.proto.v1.PaymentProvider" json:"provider,omitempty"`
+	OrderId        string             `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId         string             `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OrderName      string             `protobuf:"bytes,4,opt,name=order_name,json=orderName,proto3" json:"order_name,omitempty"` // 결제창 표시 상품명 (ex: "티셔츠 외 2건")
+	Quantity       int32              `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Amount         *Money             `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	TaxFree        *Money             `protobuf:"bytes,7,opt,name=tax_free,json=taxFree,proto3" json:"tax_free,omitempty"` // 비과세 금액
+	Fx             *FxSnapshot        `protobuf:"bytes,8,opt,name=fx,proto3" json:"fx,omitempty"`                          // 외화 표시 결제만 설정, amount는 KRW 정산 금액
+	Device         *DeviceFingerprint `protobuf:"bytes,9,opt,name=device,proto3" json:"device,omitempty"`
+	IdempotencyKey string             `protobuf:"bytes,10,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // KakaoReadyRequest.idempotency_key 참고
+	// Types that are valid to be assigned to ProviderDetails:
+	//
+	//	*PreparePaymentRequest_KakaoPay
+	//	*PreparePaymentRequest_TossPayments
+	ProviderDetails isPreparePaymentRequest_ProviderDetails `protobuf_oneof:"provider_details"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PreparePaymentRequest) Reset() {
+	*x = PreparePaymentRequest{}
+	mi := &file_payment_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreparePaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreparePaymentRequest) ProtoMessage() {}
+
+func (x *PreparePaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreparePaymentRequest.ProtoReflect.Descriptor instead.
+func (*PreparePaymentRequest) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PreparePaymentRequest) GetProvider() PaymentProvider {
+	if x != nil {
+		return x.Provider
+	}
+	return PaymentProvider_PAYMENT_PROVIDER_UNSPECIFIED
+}
+
+func (x *PreparePaymentRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *PreparePaymentRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PreparePaymentRequest) GetOrderName() string {
+	if x != nil {
+		return x.OrderName
+	}
+	return ""
+}
+
+func (x *PreparePaymentRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PreparePaymentRequest) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *PreparePaymentRequest) GetTaxFree() *Money {
+	if x != nil {
+		return x.TaxFree
+	}
+	return nil
+}
+
+func (x *PreparePaymentRequest) GetFx() *FxSnapshot {
+	if x != nil {
+		return x.Fx
+	}
+	return nil
+}
+
+func (x *PreparePaymentRequest) GetDevice() *DeviceFingerprint {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
+func (x *PreparePaymentRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *PreparePaymentRequest) GetProviderDetails() isPreparePaymentRequest_ProviderDetails {
+	if x != nil {
+		return x.ProviderDetails
+	}
+	return nil
+}
+
+func (x *PreparePaymentRequest) GetKakaoPay() *KakaoPayPrepareDetails {
+	if x != nil {
+		if x, ok := x.ProviderDetails.(*PreparePaymentRequest_KakaoPay); ok {
+			return x.KakaoPay
+		}
+	}
+	return nil
+}
+
+func (x *PreparePaymentRequest) GetTossPayments() *TossPaymentsPrepareDetails {
+	if x != nil {
+		if x, ok := x.ProviderDetails.(*PreparePaymentRequest_TossPayments); ok {
+			return x.TossPayments
+		}
+	}
+	return nil
+}
+
+type isPreparePaymentRequest_ProviderDetails interface {
+	isPreparePaymentRequest_ProviderDetails()
+}
+
+type PreparePaymentRequest_KakaoPay struct {
+	KakaoPay *KakaoPayPrepareDetails `protobuf:"bytes,11,opt,name=kakao_pay,json=kakaoPay,proto3,oneof"`
+}
+
+type PreparePaymentRequest_TossPayments struct {
+	TossPayments *TossPaymentsPrepareDetails `protobuf:"bytes,12,opt,name=toss_payments,json=tossPayments,proto3,oneof"`
+}
+
+func (*PreparePaymentRequest_KakaoPay) isPreparePaymentRequest_ProviderDetails() {}
+
+func (*PreparePaymentRequest_TossPayments) isPreparePaymentRequest_ProviderDetails() {}
+
+type PreparePaymentResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Payment *Payment               `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"` // READY 상태
+	// Types that are valid to be assigned to ProviderDetails:
+	//
+	//	*PreparePaymentResponse_KakaoPay
+	//	*PreparePaymentResponse_TossPayments
+	ProviderDetails isPreparePaymentResponse_ProviderDetails `protobuf_oneof:"provider_details"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PreparePaymentResponse) Reset() {
+	*x = PreparePaymentResponse{}
+	mi := &file_payment_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreparePaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreparePaymentResponse) ProtoMessage() {}
+
+func (x *PreparePaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreparePaymentResponse.ProtoReflect.Descriptor instead.
+func (*PreparePaymentResponse) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PreparePaymentResponse) GetPayment() *Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+func (x *PreparePaymentResponse) GetProviderDetails() isPreparePaymentResponse_ProviderDetails {
+	if x != nil {
+		return x.ProviderDetails
+	}
+	return nil
+}
+
+func (x *PreparePaymentResponse) GetKakaoPay() *KakaoReadyResponse {
+	if x != nil {
+		if x, ok := x.ProviderDetails.(*PreparePaymentResponse_KakaoPay); ok {
+			return x.KakaoPay
+		}
+	}
+	return nil
+}
+
+func (x *PreparePaymentResponse) GetTossPayments() *TossPaymentsPrepareResult {
+	if x != nil {
+		if x, ok := x.ProviderDetails.(*PreparePaymentResponse_TossPayments); ok {
+			return x.TossPayments
+		}
+	}
+	return nil
+}
+
+type isPreparePaymentResponse_ProviderDetails interface {
+	isPreparePaymentResponse_ProviderDetails()
+}
+
+type PreparePaymentResponse_KakaoPay struct {
+	KakaoPay *KakaoReadyResponse `protobuf:"bytes,2,opt,name=kakao_pay,json=kakaoPay,proto3,oneof"` // next_redirect_*_url로 이동
+}
+
+type PreparePaymentResponse_TossPayments struct {
+	TossPayments *TossPaymentsPrepareResult `protobuf:"bytes,3,opt,name=toss_payments,json=tossPayments,proto3,oneof"`
+}
+
+func (*PreparePaymentResponse_KakaoPay) isPreparePaymentResponse_ProviderDetails() {}
+
+func (*PreparePaymentResponse_TossPayments) isPreparePaymentResponse_ProviderDetails() {}
+
+// 카카오페이 승인 정보 (approval_url로 전달된 pg_token)
+type KakaoPayApproveDetails struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PgToken       string                 `protobuf:"bytes,1,opt,name=pg_token,json=pgToken,proto3" json:"pg_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KakaoPayApproveDetails) Reset() {
+	*x = KakaoPayApproveDetails{}
+	mi := &file_payment_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KakaoPayApproveDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KakaoPayApproveDetails) ProtoMessage() {}
+
+func (x *KakaoPayApproveDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KakaoPayApproveDetails.ProtoReflect.Descriptor instead.
+func (*KakaoPayApproveDetails) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *KakaoPayApproveDetails) GetPgToken() string {
+	if x != nil {
+		return x.PgToken
+	}
+	return ""
+}
+
+// 토스페이먼츠 승인 정보 (successUrl로 전달된 값)
+type TossPaymentsApproveDetails struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaymentKey    string                 `protobuf:"bytes,1,opt,name=payment_key,json=paymentKey,proto3" json:"payment_key,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"` // 준비 때 금액과 같아야 함 (결제 금액 변조 방지)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TossPaymentsApproveDetails) Reset() {
+	*x = TossPaymentsApproveDetails{}
+	mi := &file_payment_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TossPaymentsApproveDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TossPaymentsApproveDetails) ProtoMessage() {}
+
+func (x *TossPaymentsApproveDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TossPaymentsApproveDetails.ProtoReflect.Descriptor instead.
+func (*TossPaymentsApproveDetails) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TossPaymentsApproveDetails) GetPaymentKey() string {
+	if x != nil {
+		return x.PaymentKey
+	}
+	return ""
+}
+
+func (x *TossPaymentsApproveDetails) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+type ApprovePaymentRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId      string                 `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,2,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // KakaoReadyRequest.idempotency_key 참고
+	// 준비 때와 같은 PG사여야 함, 다르면 INVALID_ARGUMENT
+	//
+	// Types that are valid to be assigned to ProviderDetails:
+	//
+	//	*ApprovePaymentRequest_KakaoPay
+	//	*ApprovePaymentRequest_TossPayments
+	ProviderDetails isApprovePaymentRequest_ProviderDetails `protobuf_oneof:"provider_details"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ApprovePaymentRequest) Reset() {
+	*x = ApprovePaymentRequest{}
+	mi := &file_payment_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovePaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovePaymentRequest) ProtoMessage() {}
+
+func (x *ApprovePaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovePaymentRequest.ProtoReflect.Descriptor instead.
+func (*ApprovePaymentRequest) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ApprovePaymentRequest) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *ApprovePaymentRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *ApprovePaymentRequest) GetProviderDetails() isApprovePaymentRequest_ProviderDetails {
+	if x != nil {
+		return x.ProviderDetails
+	}
+	return nil
+}
+
+func (x *ApprovePaymentRequest) GetKakaoPay() *KakaoPayApproveDetails {
+	if x != nil {
+		if x, ok := x.ProviderDetails.(*ApprovePaymentRequest_KakaoPay); ok {
+			return x.KakaoPay
+		}
+	}
+	return nil
+}
+
+func (x *ApprovePaymentRequest) GetTossPayments() *TossPaymentsApproveDetails {
+	if x != nil {
+		if x, ok := x.ProviderDetails.(*ApprovePaymentRequest_TossPayments); ok {
+			return x.TossPayments
+		}
+	}
+	return nil
+}
+
+type isApprovePaymentRequest_ProviderDetails interface {
+	isApprovePaymentRequest_ProviderDetails()
+}
+
+type ApprovePaymentRequest_KakaoPay struct {
+	KakaoPay *KakaoPayApproveDetails `protobuf:"bytes,3,opt,name=kakao_pay,json=kakaoPay,proto3,oneof"`
+}
+
+type ApprovePaymentRequest_TossPayments struct {
+	TossPayments *TossPaymentsApproveDetails `protobuf:"bytes,4,opt,name=toss_payments,json=tossPayments,proto3,oneof"`
+}
+
+func (*ApprovePaymentRequest_KakaoPay) isApprovePaymentRequest_ProviderDetails() {}
+
+func (*ApprovePaymentRequest_TossPayments) isApprovePaymentRequest_ProviderDetails() {}
+
+type ApprovePaymentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payment       *Payment               `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApprovePaymentResponse) Reset() {
+	*x = ApprovePaymentResponse{}
+	mi := &file_payment_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovePaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovePaymentResponse) ProtoMessage() {}
+
+func (x *ApprovePaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovePaymentResponse.ProtoReflect.Descriptor instead.
+func (*ApprovePaymentResponse) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ApprovePaymentResponse) GetPayment() *Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+// 가상계좌 결제 환불 계좌
+type RefundReceiveAccount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bank          Bank                   `protobuf:"varint,1,opt,name=bank,proto3,enum=go.escape.ship.proto.v1.Bank" json:"bank,omitempty"`
+	AccountNumber string                 `protobuf:"bytes,2,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	HolderName    string                 `protobuf:"bytes,3,opt,name=holder_name,json=holderName,proto3" json:"holder_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundReceiveAccount) Reset() {
+	*x = RefundReceiveAccount{}
+	mi := &file_payment_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundReceiveAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundReceiveAccount) ProtoMessage() {}
+
+func (x *RefundReceiveAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundReceiveAccount.ProtoReflect.Descriptor instead.
+func (*RefundReceiveAccount) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RefundReceiveAccount) GetBank() Bank {
+	if x != nil {
+		return x.Bank
+	}
+	return Bank_BANK_UNSPECIFIED
+}
+
+func (x *RefundReceiveAccount) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *RefundReceiveAccount) GetHolderName() string {
+	if x != nil {
+		return x.HolderName
+	}
+	return ""
+}
+
+// 토스페이먼츠 취소 정보
+type TossPaymentsCancelDetails struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	RefundReceiveAccount *RefundReceiveAccount  `protobuf:"bytes,1,opt,name=refund_receive_account,json=refundReceiveAccount,proto3" json:"refund_receive_account,omitempty"` // 가상계좌 결제 취소만 설정
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *TossPaymentsCancelDetails) Reset() {
+	*x = TossPaymentsCancelDetails{}
+	mi := &file_payment_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TossPaymentsCancelDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TossPaymentsCancelDetails) ProtoMessage() {}
+
+func (x *TossPaymentsCancelDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TossPaymentsCancelDetails.ProtoReflect.Descriptor instead.
+func (*TossPaymentsCancelDetails) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TossPaymentsCancelDetails) GetRefundReceiveAccount() *RefundReceiveAccount {
+	if x != nil {
+		return x.RefundReceiveAccount
+	}
+	return nil
+}
+
+type CancelPaymentRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId string                 `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	Amount    *Money                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`                  // 취소 금액 (양수, 결제와 같은 통화), 비어 있으면 남은 금액 전액
+	TaxFree   *Money                 `protobuf:"bytes,3,opt,name=tax_free,json=taxFree,proto3" json:"tax_free,omitempty"` // 취소 금액 중 비과세, tax_free + vat는 취소 금액 이하
+	Vat       *Money                 `protobuf:"bytes,4,opt,name=vat,proto3" json:"vat,omitempty"`                        // 취소 금액 중 부가세, 비어 있으면 PG사가 계산
+	// 취소 전 취소 가능 금액, 설정하면 PG사 잔액과 다를 때 FAILED_PRECONDITION (동시 취소 방지)
+	CancelAvailable *Money `protobuf:"bytes,5,opt,name=cancel_available,json=cancelAvailable,proto3" json:"cancel_available,omitempty"`
+	Reason          string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`                                       // 취소 사유 (토스페이먼츠 필수)
+	IdempotencyKey  string `protobuf:"bytes,7,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // KakaoReadyRequest.idempotency_key 참고
+	// 카카오페이는 추가 정보 없음
+	//
+	// Types that are valid to be assigned to ProviderDetails:
+	//
+	//	*CancelPaymentRequest_TossPayments
+	ProviderDetails isCancelPaymentRequest_ProviderDetails `protobuf_oneof:"provider_details"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CancelPaymentRequest) Reset() {
+	*x = CancelPaymentRequest{}
+	mi := &file_payment_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPaymentRequest) ProtoMessage() {}
+
+func (x *CancelPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPaymentRequest.ProtoReflect.Descriptor instead.
+func (*CancelPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CancelPaymentRequest) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *CancelPaymentRequest) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *CancelPaymentRequest) GetTaxFree() *Money {
+	if x != nil {
+		return x.TaxFree
+	}
+	return nil
+}
+
+func (x *CancelPaymentRequest) GetVat() *Money {
+	if x != nil {
+		return x.Vat
+	}
+	return nil
+}
+
+func (x *CancelPaymentRequest) GetCancelAvailable() *Money {
+	if x != nil {
+		return x.CancelAvailable
+	}
+	return nil
+}
+
+func (x *CancelPaymentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CancelPaymentRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *CancelPaymentRequest) GetProviderDetails() isCancelPaymentRequest_ProviderDetails {
+	if x != nil {
+		return x.ProviderDetails
+	}
+	return nil
+}
+
+func (x *CancelPaymentRequest) GetTossPayments() *TossPaymentsCancelDetails {
+	if x != nil {
+		if x, ok := x.ProviderDetails.(*CancelPaymentRequest_TossPayments); ok {
+			return x.TossPayments
+		}
+	}
+	return nil
+}
+
+type isCancelPaymentRequest_ProviderDetails interface {
+	isCancelPaymentRequest_ProviderDetails()
+}
+
+type CancelPaymentRequest_TossPayments struct {
+	TossPayments *TossPaymentsCancelDetails `protobuf:"bytes,8,opt,name=toss_payments,json=tossPayments,proto3,oneof"`
+}
+
+func (*CancelPaymentRequest_TossPayments) isCancelPaymentRequest_ProviderDetails() {}
+
+type CancelPaymentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payment       *Payment               `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelPaymentResponse) Reset() {
+	*x = CancelPaymentResponse{}
+	mi := &file_payment_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPaymentResponse) ProtoMessage() {}
+
+func (x *CancelPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPaymentResponse.ProtoReflect.Descriptor instead.
+func (*CancelPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CancelPaymentResponse) GetPayment() *Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
 type KakaoReadyRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	PartnerOrderId string                 `protobuf:"bytes,1,opt,name=partner_order_id,json=partnerOrderId,proto3" json:"partner_order_id,omitempty"`
 	PartnerUserId  string                 `protobuf:"bytes,2,opt,name=partner_user_id,json=partnerUserId,proto3" json:"partner_user_id,omitempty"`
 	ItemName       string                 `protobuf:"bytes,3,opt,name=item_name,json=itemName,proto3" json:"item_name,omitempty"`
 	Quantity       int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	TotalAmount    int64                  `protobuf:"varint,5,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
-	TaxFreeAmount  int64                  `protobuf:"varint,6,opt,name=tax_free_amount,json=taxFreeAmount,proto3" json:"tax_free_amount,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Deprecated: Marked as deprecated in payment.proto.
+	TotalAmount int64 `protobuf:"varint,5,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"` // KRW 원 단위, total로 대체됨
+	// Deprecated: Marked as deprecated in payment.proto.
+	TaxFreeAmount int64              `protobuf:"varint,6,opt,name=tax_free_amount,json=taxFreeAmount,proto3" json:"tax_free_amount,omitempty"` // KRW 원 단위, tax_free로 대체됨
+	Fx            *FxSnapshot        `protobuf:"bytes,7,opt,name=fx,proto3" json:"fx,omitempty"`                                               // 외화 표시 결제만 설정, total은 KRW 정산 금액
+	Device        *DeviceFingerprint `protobuf:"bytes,8,opt,name=device,proto3" json:"device,omitempty"`
+	Total         *Money             `protobuf:"bytes,9,opt,name=total,proto3" json:"total,omitempty"`
+	TaxFree       *Money             `protobuf:"bytes,10,opt,name=tax_free,json=taxFree,proto3" json:"tax_free,omitempty"` // 비과세 금액
+	// 재시도 시 중복 처리를 막는 키 (논리적 작업마다 클라이언트가 생성, 재시도에는 같은 값 사용)
+	// 같은 키로 다시 요청하면 서버는 처리하지 않고 처음 응답을 반환, 요청 내용이 다르면 ALREADY_EXISTS
+	// 비어 있으면 Idempotency-Key 헤더 값을 사용 (UnaryIdempotencyKeyInterceptor가 설정)
+	IdempotencyKey string `protobuf:"bytes,11,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// 가맹점 코드 (일반/정기결제/테스트 등), 비어 있으면 서버 기본값
+	// 서버에 등록되지 않은 코드는 INVALID_ARGUMENT, 승인/취소는 준비 때와 같은 코드로 요청
+	Cid           string `protobuf:"bytes,12,opt,name=cid,proto3" json:"cid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *KakaoReadyRequest) Reset() {
 	*x = KakaoReadyRequest{}
-	mi := &file_payment_proto_msgTypes[0]
+	mi := &file_payment_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -49,7 +1241,7 @@ func (x *KakaoReadyRequest) String() string {
 func (*KakaoReadyRequest) ProtoMessage() {}
 
 func (x *KakaoReadyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[0]
+	mi := &file_payment_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -62,7 +1254,7 @@ func (x *KakaoReadyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KakaoReadyRequest.ProtoReflect.Descriptor instead.
 func (*KakaoReadyRequest) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{0}
+	return file_payment_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *KakaoReadyRequest) GetPartnerOrderId() string {
@@ -93,6 +1285,7 @@ func (x *KakaoReadyRequest) GetQuantity() int32 {
 	return 0
 }
 
+// Deprecated: Marked as deprecated in payment.proto.
 func (x *KakaoReadyRequest) GetTotalAmount() int64 {
 	if x != nil {
 		return x.TotalAmount
@@ -100,6 +1293,7 @@ func (x *KakaoReadyRequest) GetTotalAmount() int64 {
 	return 0
 }
 
+// Deprecated: Marked as deprecated in payment.proto.
 func (x *KakaoReadyRequest) GetTaxFreeAmount() int64 {
 	if x != nil {
 		return x.TaxFreeAmount
@@ -107,6 +1301,48 @@ func (x *KakaoReadyRequest) GetTaxFreeAmount() int64 {
 	return 0
 }
 
+func (x *KakaoReadyRequest) GetFx() *FxSnapshot {
+	if x != nil {
+		return x.Fx
+	}
+	return nil
+}
+
+func (x *KakaoReadyRequest) GetDevice() *DeviceFingerprint {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
+func (x *KakaoReadyRequest) GetTotal() *Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *KakaoReadyRequest) GetTaxFree() *Money {
+	if x != nil {
+		return x.TaxFree
+	}
+	return nil
+}
+
+func (x *KakaoReadyRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *KakaoReadyRequest) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
 type KakaoReadyResponse struct {
 	state                 protoimpl.MessageState `protogen:"open.v1"`
 	Tid                   string                 `protobuf:"bytes,1,opt,name=tid,proto3" json:"tid,omitempty"`
@@ -121,7 +1357,7 @@ type KakaoReadyResponse struct {
 
 func (x *KakaoReadyResponse) Reset() {
 	*x = KakaoReadyResponse{}
-	mi := &file_payment_proto_msgTypes[1]
+	mi := &file_payment_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -133,7 +1369,7 @@ func (x *KakaoReadyResponse) String() string {
 func (*KakaoReadyResponse) ProtoMessage() {}
 
 func (x *KakaoReadyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[1]
+	mi := &file_payment_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -146,7 +1382,7 @@ func (x *KakaoReadyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KakaoReadyResponse.ProtoReflect.Descriptor instead.
 func (*KakaoReadyResponse) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{1}
+	return file_payment_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *KakaoReadyResponse) GetTid() string {
@@ -197,13 +1433,15 @@ type KakaoApproveRequest struct {
 	PartnerOrderId string                 `protobuf:"bytes,2,opt,name=partner_order_id,json=partnerOrderId,proto3" json:"partner_order_id,omitempty"`
 	PartnerUserId  string                 `protobuf:"bytes,3,opt,name=partner_user_id,json=partnerUserId,proto3" json:"partner_user_id,omitempty"`
 	PgToken        string                 `protobuf:"bytes,4,opt,name=pg_token,json=pgToken,proto3" json:"pg_token,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // KakaoReadyRequest.idempotency_key 참고
+	Cid            string                 `protobuf:"bytes,6,opt,name=cid,proto3" json:"cid,omitempty"`                                             // KakaoReadyRequest.cid 참고
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *KakaoApproveRequest) Reset() {
 	*x = KakaoApproveRequest{}
-	mi := &file_payment_proto_msgTypes[2]
+	mi := &file_payment_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -215,7 +1453,7 @@ func (x *KakaoApproveRequest) String() string {
 func (*KakaoApproveRequest) ProtoMessage() {}
 
 func (x *KakaoApproveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[2]
+	mi := &file_payment_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -228,7 +1466,7 @@ func (x *KakaoApproveRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KakaoApproveRequest.ProtoReflect.Descriptor instead.
 func (*KakaoApproveRequest) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{2}
+	return file_payment_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *KakaoApproveRequest) GetTid() string {
@@ -259,6 +1497,20 @@ func (x *KakaoApproveRequest) GetPgToken() string {
 	return ""
 }
 
+func (x *KakaoApproveRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *KakaoApproveRequest) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
 type KakaoApproveResponse struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	PartnerOrderId string                 `protobuf:"bytes,1,opt,name=partner_order_id,json=partnerOrderId,proto3" json:"partner_order_id,omitempty"`
@@ -268,7 +1520,7 @@ type KakaoApproveResponse struct {
 
 func (x *KakaoApproveResponse) Reset() {
 	*x = KakaoApproveResponse{}
-	mi := &file_payment_proto_msgTypes[3]
+	mi := &file_payment_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -280,7 +1532,7 @@ func (x *KakaoApproveResponse) String() string {
 func (*KakaoApproveResponse) ProtoMessage() {}
 
 func (x *KakaoApproveResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[3]
+	mi := &file_payment_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -293,7 +1545,7 @@ func (x *KakaoApproveResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KakaoApproveResponse.ProtoReflect.Descriptor instead.
 func (*KakaoApproveResponse) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{3}
+	return file_payment_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *KakaoApproveResponse) GetPartnerOrderId() string {
@@ -304,19 +1556,29 @@ func (x *KakaoApproveResponse) GetPartnerOrderId() string {
 }
 
 type KakaoCancelRequest struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	PartnerOrderId        string                 `protobuf:"bytes,1,opt,name=partner_order_id,json=partnerOrderId,proto3" json:"partner_order_id,omitempty"`
-	CancelAmount          string                 `protobuf:"bytes,2,opt,name=cancel_amount,json=cancelAmount,proto3" json:"cancel_amount,omitempty"`
-	CancelTaxFreeAmount   int64                  `protobuf:"varint,3,opt,name=cancel_tax_free_amount,json=cancelTaxFreeAmount,proto3" json:"cancel_tax_free_amount,omitempty"`
-	CancelVatAmount       int64                  `protobuf:"varint,4,opt,name=cancel_vat_amount,json=cancelVatAmount,proto3" json:"cancel_vat_amount,omitempty"`
-	CancelAvailableAmount int64                  `protobuf:"varint,5,opt,name=cancel_available_amount,json=cancelAvailableAmount,proto3" json:"cancel_available_amount,omitempty"`
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PartnerOrderId string                 `protobuf:"bytes,1,opt,name=partner_order_id,json=partnerOrderId,proto3" json:"partner_order_id,omitempty"`
+	// Deprecated: Marked as deprecated in payment.proto.
+	CancelAmount string `protobuf:"bytes,2,opt,name=cancel_amount,json=cancelAmount,proto3" json:"cancel_amount,omitempty"` // KRW 원 단위 10진수 문자열, cancel로 대체됨
+	// Deprecated: Marked as deprecated in payment.proto.
+	CancelTaxFreeAmount int64 `protobuf:"varint,3,opt,name=cancel_tax_free_amount,json=cancelTaxFreeAmount,proto3" json:"cancel_tax_free_amount,omitempty"` // cancel_tax_free로 대체됨
+	// Deprecated: Marked as deprecated in payment.proto.
+	CancelVatAmount int64 `protobuf:"varint,4,opt,name=cancel_vat_amount,json=cancelVatAmount,proto3" json:"cancel_vat_amount,omitempty"` // cancel_vat로 대체됨
+	// Deprecated: Marked as deprecated in payment.proto.
+	CancelAvailableAmount int64  `protobuf:"varint,5,opt,name=cancel_available_amount,json=cancelAvailableAmount,proto3" json:"cancel_available_amount,omitempty"` // cancel_available로 대체됨
+	Cancel                *Money `protobuf:"bytes,6,opt,name=cancel,proto3" json:"cancel,omitempty"`                                                               // 취소 금액
+	CancelTaxFree         *Money `protobuf:"bytes,7,opt,name=cancel_tax_free,json=cancelTaxFree,proto3" json:"cancel_tax_free,omitempty"`                          // 취소 비과세 금액
+	CancelVat             *Money `protobuf:"bytes,8,opt,name=cancel_vat,json=cancelVat,proto3" json:"cancel_vat,omitempty"`                                        // 취소 부가세
+	CancelAvailable       *Money `protobuf:"bytes,9,opt,name=cancel_available,json=cancelAvailable,proto3" json:"cancel_available,omitempty"`                      // 취소 가능 금액
+	IdempotencyKey        string `protobuf:"bytes,10,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`                        // KakaoReadyRequest.idempotency_key 참고
+	Cid                   string `protobuf:"bytes,11,opt,name=cid,proto3" json:"cid,omitempty"`                                                                    // KakaoReadyRequest.cid 참고
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
 
 func (x *KakaoCancelRequest) Reset() {
 	*x = KakaoCancelRequest{}
-	mi := &file_payment_proto_msgTypes[4]
+	mi := &file_payment_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -328,7 +1590,7 @@ func (x *KakaoCancelRequest) String() string {
 func (*KakaoCancelRequest) ProtoMessage() {}
 
 func (x *KakaoCancelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[4]
+	mi := &file_payment_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -341,7 +1603,7 @@ func (x *KakaoCancelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KakaoCancelRequest.ProtoReflect.Descriptor instead.
 func (*KakaoCancelRequest) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{4}
+	return file_payment_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *KakaoCancelRequest) GetPartnerOrderId() string {
@@ -351,6 +1613,7 @@ func (x *KakaoCancelRequest) GetPartnerOrderId() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in payment.proto.
 func (x *KakaoCancelRequest) GetCancelAmount() string {
 	if x != nil {
 		return x.CancelAmount
@@ -358,6 +1621,7 @@ func (x *KakaoCancelRequest) GetCancelAmount() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in payment.proto.
 func (x *KakaoCancelRequest) GetCancelTaxFreeAmount() int64 {
 	if x != nil {
 		return x.CancelTaxFreeAmount
@@ -365,6 +1629,7 @@ func (x *KakaoCancelRequest) GetCancelTaxFreeAmount() int64 {
 	return 0
 }
 
+// Deprecated: Marked as deprecated in payment.proto.
 func (x *KakaoCancelRequest) GetCancelVatAmount() int64 {
 	if x != nil {
 		return x.CancelVatAmount
@@ -372,6 +1637,7 @@ func (x *KakaoCancelRequest) GetCancelVatAmount() int64 {
 	return 0
 }
 
+// Deprecated: Marked as deprecated in payment.proto.
 func (x *KakaoCancelRequest) GetCancelAvailableAmount() int64 {
 	if x != nil {
 		return x.CancelAvailableAmount
@@ -379,6 +1645,48 @@ func (x *KakaoCancelRequest) GetCancelAvailableAmount() int64 {
 	return 0
 }
 
+func (x *KakaoCancelRequest) GetCancel() *Money {
+	if x != nil {
+		return x.Cancel
+	}
+	return nil
+}
+
+func (x *KakaoCancelRequest) GetCancelTaxFree() *Money {
+	if x != nil {
+		return x.CancelTaxFree
+	}
+	return nil
+}
+
+func (x *KakaoCancelRequest) GetCancelVat() *Money {
+	if x != nil {
+		return x.CancelVat
+	}
+	return nil
+}
+
+func (x *KakaoCancelRequest) GetCancelAvailable() *Money {
+	if x != nil {
+		return x.CancelAvailable
+	}
+	return nil
+}
+
+func (x *KakaoCancelRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *KakaoCancelRequest) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
 type KakaoCancelResponse struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	PartnerOrderId string                 `protobuf:"bytes,1,opt,name=partner_order_id,json=partnerOrderId,proto3" json:"partner_order_id,omitempty"`
@@ -388,7 +1696,7 @@ type KakaoCancelResponse struct {
 
 func (x *KakaoCancelResponse) Reset() {
 	*x = KakaoCancelResponse{}
-	mi := &file_payment_proto_msgTypes[5]
+	mi := &file_payment_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -400,7 +1708,7 @@ func (x *KakaoCancelResponse) String() string {
 func (*KakaoCancelResponse) ProtoMessage() {}
 
 func (x *KakaoCancelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[5]
+	mi := &file_payment_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -413,7 +1721,7 @@ func (x *KakaoCancelResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KakaoCancelResponse.ProtoReflect.Descriptor instead.
 func (*KakaoCancelResponse) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{5}
+	return file_payment_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *KakaoCancelResponse) GetPartnerOrderId() string {
@@ -427,36 +1735,147 @@ var File_payment_proto protoreflect.FileDescriptor
 
 const file_payment_proto_rawDesc = "" +
 	"\n" +
-	"\rpayment.proto\x12\x17go.escape.ship.proto.v1\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xe9\x01\n" +
+	"\rpayment.proto\x12\x17go.escape.ship.proto.v1\x1a\vcodes.proto\x1a\fcommon.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xf1\x04\n" +
+	"\aPayment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12D\n" +
+	"\bprovider\x18\x02 \x01(\x0e2(.go.escape.ship.proto.v1.PaymentProviderR\bprovider\x12\x19\n" +
+	"\border_id\x18\x03 \x01(\tR\aorderId\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12>\n" +
+	"\x06status\x18\x05 \x01(\x0e2&.go.escape.ship.proto.v1.PaymentStatusR\x06status\x126\n" +
+	"\x06amount\x18\x06 \x01(\v2\x1e.go.escape.ship.proto.v1.MoneyR\x06amount\x12G\n" +
+	"\x0fcanceled_amount\x18\a \x01(\v2\x1e.go.escape.ship.proto.v1.MoneyR\x0ecanceledAmount\x12.\n" +
+	"\x13provider_payment_id\x18\b \x01(\tR\x11providerPaymentId\x12\x16\n" +
+	"\x06method\x18\t \x01(\tR\x06method\x12G\n" +
+	"\fcard_company\x18\n" +
+	" \x01(\x0e2$.go.escape.ship.proto.v1.CardCompanyR\vcardCompany\x12;\n" +
+	"\vapproved_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"approvedAt\x12;\n" +
+	"\vcanceled_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"canceledAt\x12\x10\n" +
+	"\x03cid\x18\r \x01(\tR\x03cid\"*\n" +
+	"\x16KakaoPayPrepareDetails\x12\x10\n" +
+	"\x03cid\x18\x01 \x01(\tR\x03cid\"?\n" +
+	"\x1aTossPaymentsPrepareDetails\x12!\n" +
+	"\fcustomer_key\x18\x01 \x01(\tR\vcustomerKey\"\xb0\x01\n" +
+	"\x19TossPaymentsPrepareResult\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x1d\n" +
+	"\n" +
+	"order_name\x18\x02 \x01(\tR\torderName\x126\n" +
+	"\x06amount\x18\x03 \x01(\v2\x1e.go.escape.ship.proto.v1.MoneyR\x06amount\x12!\n" +
+	"\fcustomer_key\x18\x04 \x01(\tR\vcustomerKey\"\xa1\x05\n" +
+	"\x15PreparePaymentRequest\x12D\n" +
+	"\bprovider\x18\x01 \x01(\x0e2(.go.escape.ship.proto.v1.PaymentProviderR\bprovider\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"order_name\x18\x04 \x01(\tR\torderName\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x05R\bquantity\x126\n" +
+	"\x06amount\x18\x06 \x01(\v2\x1e.go.escape.ship.proto.v1.MoneyR\x06amount\x129\n" +
+	"\btax_free\x18\a \x01(\v2\x1e.go.escape.ship.proto.v1.MoneyR\ataxFree\x123\n" +
+	"\x02fx\x18\b \x01(\v2#.go.escape.ship.proto.v1.FxSnapshotR\x02fx\x12B\n" +
+	"\x06device\x18\t \x01(\v2*.go.escape.ship.proto.v1.DeviceFingerprintR\x06device\x12'\n" +
+	"\x0fidempotency_key\x18\n" +
+	" \x01(\tR\x0eidempotencyKey\x12N\n" +
+	"\tkakao_pay\x18\v \x01(\v2/.go.escape.ship.proto.v1.KakaoPayPrepareDetailsH\x00R\bkakaoPay\x12Z\n" +
+	"\rtoss_payments\x18\f \x01(\v23.go.escape.ship.proto.v1.TossPaymentsPrepareDetailsH\x00R\ftossPaymentsB\x12\n" +
+	"\x10provider_details\"\x8f\x02\n" +
+	"\x16PreparePaymentResponse\x12:\n" +
+	"\apayment\x18\x01 \x01(\v2 .go.escape.ship.proto.v1.PaymentR\apayment\x12J\n" +
+	"\tkakao_pay\x18\x02 \x01(\v2+.go.escape.ship.proto.v1.KakaoReadyResponseH\x00R\bkakaoPay\x12Y\n" +
+	"\rtoss_payments\x18\x03 \x01(\v22.go.escape.ship.proto.v1.TossPaymentsPrepareResultH\x00R\ftossPaymentsB\x12\n" +
+	"\x10provider_details\"8\n" +
+	"\x16KakaoPayApproveDetails\x12\x1e\n" +
+	"\bpg_token\x18\x01 \x01(\tB\x03\x80\x01\x01R\apgToken\"z\n" +
+	"\x1aTossPaymentsApproveDetails\x12$\n" +
+	"\vpayment_key\x18\x01 \x01(\tB\x03\x80\x01\x01R\n" +
+	"paymentKey\x126\n" +
+	"\x06amount\x18\x02 \x01(\v2\x1e.go.escape.ship.proto.v1.MoneyR\x06amount\"\x9f\x02\n" +
+	"\x15ApprovePaymentRequest\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\tR\tpaymentId\x12'\n" +
+	"\x0fidempotency_key\x18\x02 \x01(\tR\x0eidempotencyKey\x12N\n" +
+	"\tkakao_pay\x18\x03 \x01(\v2/.go.escape.ship.proto.v1.KakaoPayApproveDetailsH\x00R\bkakaoPay\x12Z\n" +
+	"\rtoss_payments\x18\x04 \x01(\v23.go.escape.ship.proto.v1.TossPaymentsApproveDetailsH\x00R\ftossPaymentsB\x12\n" +
+	"\x10provider_details\"T\n" +
+	"\x16ApprovePaymentResponse\x12:\n" +
+	"\apayment\x18\x01 \x01(\v2 .go.escape.ship.proto.v1.PaymentR\apayment\"\x9b\x01\n" +
+	"\x14RefundReceiveAccount\x121\n" +
+	"\x04bank\x18\x01 \x01(\x0e2\x1d.go.escape.ship.proto.v1.BankR\x04bank\x12*\n" +
+	"\x0eaccount_number\x18\x02 \x01(\tB\x03\x80\x01\x01R\raccountNumber\x12$\n" +
+	"\vholder_name\x18\x03 \x01(\tB\x03\x80\x01\x01R\n" +
+	"holderName\"\x80\x01\n" +
+	"\x19TossPaymentsCancelDetails\x12c\n" +
+	"\x16refund_receive_account\x18\x01 \x01(\v2-.go.escape.ship.proto.v1.RefundReceiveAccountR\x14refundReceiveAccount\"\xd5\x03\n" +
+	"\x14CancelPaymentRequest\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\tR\tpaymentId\x126\n" +
+	"\x06amount\x18\x02 \x01(\v2\x1e.go.escape.ship.proto.v1.MoneyR\x06amount\x129\n" +
+	"\btax_free\x18\x03 \x01(\v2\x1e.go.escape.ship.proto.v1.MoneyR\ataxFree\x120\n" +
+	"\x03vat\x18\x04 \x01(\v2\x1e.go.escape.ship.proto.v1.MoneyR\x03vat\x12I\n" +
+	"\x10cancel_available\x18\x05 \x01(\v2\x1e.go.escape.ship.proto.v1.MoneyR\x0fcancelAvailable\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12'\n" +
+	"\x0fidempotency_key\x18\a \x01(\tR\x0eidempotencyKey\x12Y\n" +
+	"\rtoss_payments\x18\b \x01(\v22.go.escape.ship.proto.v1.TossPaymentsCancelDetailsH\x00R\ftossPaymentsB\x12\n" +
+	"\x10provider_details\"S\n" +
+	"\x15CancelPaymentResponse\x12:\n" +
+	"\apayment\x18\x01 \x01(\v2 .go.escape.ship.proto.v1.PaymentR\apayment\"\x96\x04\n" +
 	"\x11KakaoReadyRequest\x12(\n" +
 	"\x10partner_order_id\x18\x01 \x01(\tR\x0epartnerOrderId\x12&\n" +
 	"\x0fpartner_user_id\x18\x02 \x01(\tR\rpartnerUserId\x12\x1b\n" +
 	"\titem_name\x18\x03 \x01(\tR\bitemName\x12\x1a\n" +
-	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12!\n" +
-	"\ftotal_amount\x18\x05 \x01(\x03R\vtotalAmount\x12&\n" +
-	"\x0ftax_free_amount\x18\x06 \x01(\x03R\rtaxFreeAmount\"\x97\x02\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12%\n" +
+	"\ftotal_amount\x18\x05 \x01(\x03B\x02\x18\x01R\vtotalAmount\x12*\n" +
+	"\x0ftax_free_amount\x18\x06 \x01(\x03B\x02\x18\x01R\rtaxFreeAmount\x123\n" +
+	"\x02fx\x18\a \x01(\v2#.go.escape.ship.proto.v1.FxSnapshotR\x02fx\x12B\n" +
+	"\x06device\x18\b \x01(\v2*.go.escape.ship.proto.v1.DeviceFingerprintR\x06device\x124\n" +
+	"\x05total\x18\t \x01(\v2\x1e.go.escape.ship.proto.v1.MoneyR\x05total\x129\n" +
+	"\btax_free\x18\n" +
+	" \x01(\v2\x1e.go.escape.ship.proto.v1.MoneyR\ataxFree\x12'\n" +
+	"\x0fidempotency_key\x18\v \x01(\tR\x0eidempotencyKey\x12\x10\n" +
+	"\x03cid\x18\f \x01(\tR\x03cid\"\x97\x02\n" +
 	"\x12KakaoReadyResponse\x12\x10\n" +
 	"\x03tid\x18\x01 \x01(\tR\x03tid\x121\n" +
 	"\x15next_redirect_app_url\x18\x02 \x01(\tR\x12nextRedirectAppUrl\x127\n" +
 	"\x18next_redirect_mobile_url\x18\x03 \x01(\tR\x15nextRedirectMobileUrl\x12/\n" +
 	"\x14next_redirect_pc_url\x18\x04 \x01(\tR\x11nextRedirectPcUrl\x12,\n" +
 	"\x12android_app_scheme\x18\x05 \x01(\tR\x10androidAppScheme\x12$\n" +
-	"\x0eios_app_scheme\x18\x06 \x01(\tR\fiosAppScheme\"\x94\x01\n" +
+	"\x0eios_app_scheme\x18\x06 \x01(\tR\fiosAppScheme\"\xd4\x01\n" +
 	"\x13KakaoApproveRequest\x12\x10\n" +
 	"\x03tid\x18\x01 \x01(\tR\x03tid\x12(\n" +
 	"\x10partner_order_id\x18\x02 \x01(\tR\x0epartnerOrderId\x12&\n" +
-	"\x0fpartner_user_id\x18\x03 \x01(\tR\rpartnerUserId\x12\x19\n" +
-	"\bpg_token\x18\x04 \x01(\tR\apgToken\"@\n" +
+	"\x0fpartner_user_id\x18\x03 \x01(\tR\rpartnerUserId\x12\x1e\n" +
+	"\bpg_token\x18\x04 \x01(\tB\x03\x80\x01\x01R\apgToken\x12'\n" +
+	"\x0fidempotency_key\x18\x05 \x01(\tR\x0eidempotencyKey\x12\x10\n" +
+	"\x03cid\x18\x06 \x01(\tR\x03cid\"@\n" +
 	"\x14KakaoApproveResponse\x12(\n" +
-	"\x10partner_order_id\x18\x01 \x01(\tR\x0epartnerOrderId\"\xfc\x01\n" +
+	"\x10partner_order_id\x18\x01 \x01(\tR\x0epartnerOrderId\"\xd1\x04\n" +
 	"\x12KakaoCancelRequest\x12(\n" +
-	"\x10partner_order_id\x18\x01 \x01(\tR\x0epartnerOrderId\x12#\n" +
-	"\rcancel_amount\x18\x02 \x01(\tR\fcancelAmount\x123\n" +
-	"\x16cancel_tax_free_amount\x18\x03 \x01(\x03R\x13cancelTaxFreeAmount\x12*\n" +
-	"\x11cancel_vat_amount\x18\x04 \x01(\x03R\x0fcancelVatAmount\x126\n" +
-	"\x17cancel_available_amount\x18\x05 \x01(\x03R\x15cancelAvailableAmount\"?\n" +
+	"\x10partner_order_id\x18\x01 \x01(\tR\x0epartnerOrderId\x12'\n" +
+	"\rcancel_amount\x18\x02 \x01(\tB\x02\x18\x01R\fcancelAmount\x127\n" +
+	"\x16cancel_tax_free_amount\x18\x03 \x01(\x03B\x02\x18\x01R\x13cancelTaxFreeAmount\x12.\n" +
+	"\x11cancel_vat_amount\x18\x04 \x01(\x03B\x02\x18\x01R\x0fcancelVatAmount\x12:\n" +
+	"\x17cancel_available_amount\x18\x05 \x01(\x03B\x02\x18\x01R\x15cancelAvailableAmount\x126\n" +
+	"\x06cancel\x18\x06 \x01(\v2\x1e.go.escape.ship.proto.v1.MoneyR\x06cancel\x12F\n" +
+	"\x0fcancel_tax_free\x18\a \x01(\v2\x1e.go.escape.ship.proto.v1.MoneyR\rcancelTaxFree\x12=\n" +
+	"\n" +
+	"cancel_vat\x18\b \x01(\v2\x1e.go.escape.ship.proto.v1.MoneyR\tcancelVat\x12I\n" +
+	"\x10cancel_available\x18\t \x01(\v2\x1e.go.escape.ship.proto.v1.MoneyR\x0fcancelAvailable\x12'\n" +
+	"\x0fidempotency_key\x18\n" +
+	" \x01(\tR\x0eidempotencyKey\x12\x10\n" +
+	"\x03cid\x18\v \x01(\tR\x03cid\"?\n" +
 	"\x13KakaoCancelResponse\x12(\n" +
-	"\x10partner_order_id\x18\x01 \x01(\tR\x0epartnerOrderId2\xc5\x05\n" +
+	"\x10partner_order_id\x18\x01 \x01(\tR\x0epartnerOrderId*w\n" +
+	"\x0fPaymentProvider\x12 \n" +
+	"\x1cPAYMENT_PROVIDER_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aPAYMENT_PROVIDER_KAKAO_PAY\x10\x01\x12\"\n" +
+	"\x1ePAYMENT_PROVIDER_TOSS_PAYMENTS\x10\x02*\xc5\x01\n" +
+	"\rPaymentStatus\x12\x1e\n" +
+	"\x1aPAYMENT_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14PAYMENT_STATUS_READY\x10\x01\x12\x1b\n" +
+	"\x17PAYMENT_STATUS_APPROVED\x10\x02\x12%\n" +
+	"!PAYMENT_STATUS_PARTIALLY_CANCELED\x10\x03\x12\x1b\n" +
+	"\x17PAYMENT_STATUS_CANCELED\x10\x04\x12\x19\n" +
+	"\x15PAYMENT_STATUS_FAILED\x10\x052\xd0\v\n" +
 	"\x0ePaymentService\x12\xd9\x01\n" +
 	"\n" +
 	"KakaoReady\x12*.go.escape.ship.proto.v1.KakaoReadyRequest\x1a+.go.escape.ship.proto.v1.KakaoReadyResponse\"r\x92AP\n" +
@@ -464,7 +1883,13 @@ const file_payment_proto_rawDesc = "" +
 	"\fKakaoApprove\x12,.go.escape.ship.proto.v1.KakaoApproveRequest\x1a-.go.escape.ship.proto.v1.KakaoApproveResponse\"y\x92AU\n" +
 	"\x0eKakao Payments\x12\x1aApprove payment with Kakao\x1a'Approve the payment process with Kakao.\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/payment/kakao/approve\x12\xed\x01\n" +
 	"\vKakaoCancel\x12+.go.escape.ship.proto.v1.KakaoCancelRequest\x1a,.go.escape.ship.proto.v1.KakaoCancelResponse\"\x82\x01\x92A_\n" +
-	"\x0eKakao Payments\x12\x19Cancel payment with Kakao\x1a2Cancel an ongoing or completed payment with Kakao.\x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/payment/kakao/cancelB#Z!github.com/escape-ship/protos/genb\x06proto3"
+	"\x0eKakao Payments\x12\x19Cancel payment with Kakao\x1a2Cancel an ongoing or completed payment with Kakao.\x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/payment/kakao/cancel\x12\x95\x02\n" +
+	"\x0ePreparePayment\x12..go.escape.ship.proto.v1.PreparePaymentRequest\x1a/.go.escape.ship.proto.v1.PreparePaymentResponse\"\xa1\x01\x92A\x7f\n" +
+	"\bPayments\x12\x0fPrepare payment\x1abCreate a payment with the selected provider and return what the client needs to open its checkout.\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/payments/prepare\x12\x85\x02\n" +
+	"\x0eApprovePayment\x12..go.escape.ship.proto.v1.ApprovePaymentRequest\x1a/.go.escape.ship.proto.v1.ApprovePaymentResponse\"\x91\x01\x92Ab\n" +
+	"\bPayments\x12\x0fApprove payment\x1aEConfirm a prepared payment with the provider's authentication result.\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/payments/{payment_id}/approve\x12\xe8\x01\n" +
+	"\rCancelPayment\x12-.go.escape.ship.proto.v1.CancelPaymentRequest\x1a..go.escape.ship.proto.v1.CancelPaymentResponse\"x\x92AJ\n" +
+	"\bPayments\x12\x0eCancel payment\x1a.Cancel an approved payment in full or in part.\x82\xd3\xe4\x93\x02%:\x01*\" /v1/payments/{payment_id}/cancelB#Z!github.com/escape-ship/protos/genb\x06proto3"
 
 var (
 	file_payment_proto_rawDescOnce sync.Once
@@ -478,27 +1903,94 @@ func file_payment_proto_rawDescGZIP() []byte {
 	return file_payment_proto_rawDescData
 }
 
-var file_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_payment_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_payment_proto_goTypes = []any{
-	(*KakaoReadyRequest)(nil),    // 0: go.escape.ship.proto.v1.KakaoReadyRequest
-	(*KakaoReadyResponse)(nil),   // 1: go.escape.ship.proto.v1.KakaoReadyResponse
-	(*KakaoApproveRequest)(nil),  // 2: go.escape.ship.proto.v1.KakaoApproveRequest
-	(*KakaoApproveResponse)(nil), // 3: go.escape.ship.proto.v1.KakaoApproveResponse
-	(*KakaoCancelRequest)(nil),   // 4: go.escape.ship.proto.v1.KakaoCancelRequest
-	(*KakaoCancelResponse)(nil),  // 5: go.escape.ship.proto.v1.KakaoCancelResponse
+	(PaymentProvider)(0),               // 0: go.escape.ship.proto.v1.PaymentProvider
+	(PaymentStatus)(0),                 // 1: go.escape.ship.proto.v1.PaymentStatus
+	(*Payment)(nil),                    // 2: go.escape.ship.proto.v1.Payment
+	(*KakaoPayPrepareDetails)(nil),     // 3: go.escape.ship.proto.v1.KakaoPayPrepareDetails
+	(*TossPaymentsPrepareDetails)(nil), // 4: go.escape.ship.proto.v1.TossPaymentsPrepareDetails
+	(*TossPaymentsPrepareResult)(nil),  // 5: go.escape.ship.proto.v1.TossPaymentsPrepareResult
+	(*PreparePaymentRequest)(nil),      // 6: go.escape.ship.proto.v1.PreparePaymentRequest
+	(*PreparePaymentResponse)(nil),     // 7: go.escape.ship.proto.v1.PreparePaymentResponse
+	(*KakaoPayApproveDetails)(nil),     // 8: go.escape.ship.proto.v1.KakaoPayApproveDetails
+	(*TossPaymentsApproveDetails)(nil), // 9: go.escape.ship.proto.v1.TossPaymentsApproveDetails
+	(*ApprovePaymentRequest)(nil),      // 10: go.escape.ship.proto.v1.ApprovePaymentRequest
+	(*ApprovePaymentResponse)(nil),     // 11: go.escape.ship.proto.v1.ApprovePaymentResponse
+	(*RefundReceiveAccount)(nil),       // 12: go.escape.ship.proto.v1.RefundReceiveAccount
+	(*TossPaymentsCancelDetails)(nil),  // 13: go.escape.ship.proto.v1.TossPaymentsCancelDetails
+	(*CancelPaymentRequest)(nil),       // 14: go.escape.ship.proto.v1.CancelPaymentRequest
+	(*CancelPaymentResponse)(nil),      // 15: go.escape.ship.proto.v1.CancelPaymentResponse
+	(*KakaoReadyRequest)(nil),          // 16: go.escape.ship.proto.v1.KakaoReadyRequest
+	(*KakaoReadyResponse)(nil),         // 17: go.escape.ship.proto.v1.KakaoReadyResponse
+	(*KakaoApproveRequest)(nil),        // 18: go.escape.ship.proto.v1.KakaoApproveRequest
+	(*KakaoApproveResponse)(nil),       // 19: go.escape.ship.proto.v1.KakaoApproveResponse
+	(*KakaoCancelRequest)(nil),         // 20: go.escape.ship.proto.v1.KakaoCancelRequest
+	(*KakaoCancelResponse)(nil),        // 21: go.escape.ship.proto.v1.KakaoCancelResponse
+	(*Money)(nil),                      // 22: go.escape.ship.proto.v1.Money
+	(CardCompany)(0),                   // 23: go.escape.ship.proto.v1.CardCompany
+	(*timestamppb.Timestamp)(nil),      // 24: google.protobuf.Timestamp
+	(*FxSnapshot)(nil),                 // 25: go.escape.ship.proto.v1.FxSnapshot
+	(*DeviceFingerprint)(nil),          // 26: go.escape.ship.proto.v1.DeviceFingerprint
+	(Bank)(0),                          // 27: go.escape.ship.proto.v1.Bank
 }
 var file_payment_proto_depIdxs = []int32{
-	0, // 0: go.escape.ship.proto.v1.PaymentService.KakaoReady:input_type -> go.escape.ship.proto.v1.KakaoReadyRequest
-	2, // 1: go.escape.ship.proto.v1.PaymentService.KakaoApprove:input_type -> go.escape.ship.proto.v1.KakaoApproveRequest
-	4, // 2: go.escape.ship.proto.v1.PaymentService.KakaoCancel:input_type -> go.escape.ship.proto.v1.KakaoCancelRequest
-	1, // 3: go.escape.ship.proto.v1.PaymentService.KakaoReady:output_type -> go.escape.ship.proto.v1.KakaoReadyResponse
-	3, // 4: go.escape.ship.proto.v1.PaymentService.KakaoApprove:output_type -> go.escape.ship.proto.v1.KakaoApproveResponse
-	5, // 5: go.escape.ship.proto.v1.PaymentService.KakaoCancel:output_type -> go.escape.ship.proto.v1.KakaoCancelResponse
-	3, // [3:6] is the sub-list for method output_type
-	0, // [0:3] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: go.escape.ship.proto.v1.Payment.provider:type_name -> go.escape.ship.proto.v1.PaymentProvider
+	1,  // 1: go.escape.ship.proto.v1.Payment.status:type_name -> go.escape.ship.proto.v1.PaymentStatus
+	22, // 2: go.escape.ship.proto.v1.Payment.amount:type_name -> go.escape.ship.proto.v1.Money
+	22, // 3: go.escape.ship.proto.v1.Payment.canceled_amount:type_name -> go.escape.ship.proto.v1.Money
+	23, // 4: go.escape.ship.proto.v1.Payment.card_company:type_name -> go.escape.ship.proto.v1.CardCompany
+	24, // 5: go.escape.ship.proto.v1.Payment.approved_at:type_name -> google.protobuf.Timestamp
+	24, // 6: go.escape.ship.proto.v1.Payment.canceled_at:type_name -> google.protobuf.Timestamp
+	22, // 7: go.escape.ship.proto.v1.TossPaymentsPrepareResult.amount:type_name -> go.escape.ship.proto.v1.Money
+	0,  // 8: go.escape.ship.proto.v1.PreparePaymentRequest.provider:type_name -> go.escape.ship.proto.v1.PaymentProvider
+	22, // 9: go.escape.ship.proto.v1.PreparePaymentRequest.amount:type_name -> go.escape.ship.proto.v1.Money
+	22, // 10: go.escape.ship.proto.v1.PreparePaymentRequest.tax_free:type_name -> go.escape.ship.proto.v1.Money
+	25, // 11: go.escape.ship.proto.v1.PreparePaymentRequest.fx:type_name -> go.escape.ship.proto.v1.FxSnapshot
+	26, // 12: go.escape.ship.proto.v1.PreparePaymentRequest.device:type_name -> go.escape.ship.proto.v1.DeviceFingerprint
+	3,  // 13: go.escape.ship.proto.v1.PreparePaymentRequest.kakao_pay:type_name -> go.escape.ship.proto.v1.KakaoPayPrepareDetails
+	4,  // 14: go.escape.ship.proto.v1.PreparePaymentRequest.toss_payments:type_name -> go.escape.ship.proto.v1.TossPaymentsPrepareDetails
+	2,  // 15: go.escape.ship.proto.v1.PreparePaymentResponse.payment:type_name -> go.escape.ship.proto.v1.Payment
+	17, // 16: go.escape.ship.proto.v1.PreparePaymentResponse.kakao_pay:type_name -> go.escape.ship.proto.v1.KakaoReadyResponse
+	5,  // 17: go.escape.ship.proto.v1.PreparePaymentResponse.toss_payments:type_name -> go.escape.ship.proto.v1.TossPaymentsPrepareResult
+	22, // 18: go.escape.ship.proto.v1.TossPaymentsApproveDetails.amount:type_name -> go.escape.ship.proto.v1.Money
+	8,  // 19: go.escape.ship.proto.v1.ApprovePaymentRequest.kakao_pay:type_name -> go.escape.ship.proto.v1.KakaoPayApproveDetails
+	9,  // 20: go.escape.ship.proto.v1.ApprovePaymentRequest.toss_payments:type_name -> go.escape.ship.proto.v1.TossPaymentsApproveDetails
+	2,  // 21: go.escape.ship.proto.v1.ApprovePaymentResponse.payment:type_name -> go.escape.ship.proto.v1.Payment
+	27, // 22: go.escape.ship.proto.v1.RefundReceiveAccount.bank:type_name -> go.escape.ship.proto.v1.Bank
+	12, // 23: go.escape.ship.proto.v1.TossPaymentsCancelDetails.refund_receive_account:type_name -> go.escape.ship.proto.v1.RefundReceiveAccount
+	22, // 24: go.escape.ship.proto.v1.CancelPaymentRequest.amount:type_name -> go.escape.ship.proto.v1.Money
+	22, // 25: go.escape.ship.proto.v1.CancelPaymentRequest.tax_free:type_name -> go.escape.ship.proto.v1.Money
+	22, // 26: go.escape.ship.proto.v1.CancelPaymentRequest.vat:type_name -> go.escape.ship.proto.v1.Money
+	22, // 27: go.escape.ship.proto.v1.CancelPaymentRequest.cancel_available:type_name -> go.escape.ship.proto.v1.Money
+	13, // 28: go.escape.ship.proto.v1.CancelPaymentRequest.toss_payments:type_name -> go.escape.ship.proto.v1.TossPaymentsCancelDetails
+	2,  // 29: go.escape.ship.proto.v1.CancelPaymentResponse.payment:type_name -> go.escape.ship.proto.v1.Payment
+	25, // 30: go.escape.ship.proto.v1.KakaoReadyRequest.fx:type_name -> go.escape.ship.proto.v1.FxSnapshot
+	26, // 31: go.escape.ship.proto.v1.KakaoReadyRequest.device:type_name -> go.escape.ship.proto.v1.DeviceFingerprint
+	22, // 32: go.escape.ship.proto.v1.KakaoReadyRequest.total:type_name -> go.escape.ship.proto.v1.Money
+	22, // 33: go.escape.ship.proto.v1.KakaoReadyRequest.tax_free:type_name -> go.escape.ship.proto.v1.Money
+	22, // 34: go.escape.ship.proto.v1.KakaoCancelRequest.cancel:type_name -> go.escape.ship.proto.v1.Money
+	22, // 35: go.escape.ship.proto.v1.KakaoCancelRequest.cancel_tax_free:type_name -> go.escape.ship.proto.v1.Money
+	22, // 36: go.escape.ship.proto.v1.KakaoCancelRequest.cancel_vat:type_name -> go.escape.ship.proto.v1.Money
+	22, // 37: go.escape.ship.proto.v1.KakaoCancelRequest.cancel_available:type_name -> go.escape.ship.proto.v1.Money
+	16, // 38: go.escape.ship.proto.v1.PaymentService.KakaoReady:input_type -> go.escape.ship.proto.v1.KakaoReadyRequest
+	18, // 39: go.escape.ship.proto.v1.PaymentService.KakaoApprove:input_type -> go.escape.ship.proto.v1.KakaoApproveRequest
+	20, // 40: go.escape.ship.proto.v1.PaymentService.KakaoCancel:input_type -> go.escape.ship.proto.v1.KakaoCancelRequest
+	6,  // 41: go.escape.ship.proto.v1.PaymentService.PreparePayment:input_type -> go.escape.ship.proto.v1.PreparePaymentRequest
+	10, // 42: go.escape.ship.proto.v1.PaymentService.ApprovePayment:input_type -> go.escape.ship.proto.v1.ApprovePaymentRequest
+	14, // 43: go.escape.ship.proto.v1.PaymentService.CancelPayment:input_type -> go.escape.ship.proto.v1.CancelPaymentRequest
+	17, // 44: go.escape.ship.proto.v1.PaymentService.KakaoReady:output_type -> go.escape.ship.proto.v1.KakaoReadyResponse
+	19, // 45: go.escape.ship.proto.v1.PaymentService.KakaoApprove:output_type -> go.escape.ship.proto.v1.KakaoApproveResponse
+	21, // 46: go.escape.ship.proto.v1.PaymentService.KakaoCancel:output_type -> go.escape.ship.proto.v1.KakaoCancelResponse
+	7,  // 47: go.escape.ship.proto.v1.PaymentService.PreparePayment:output_type -> go.escape.ship.proto.v1.PreparePaymentResponse
+	11, // 48: go.escape.ship.proto.v1.PaymentService.ApprovePayment:output_type -> go.escape.ship.proto.v1.ApprovePaymentResponse
+	15, // 49: go.escape.ship.proto.v1.PaymentService.CancelPayment:output_type -> go.escape.ship.proto.v1.CancelPaymentResponse
+	44, // [44:50] is the sub-list for method output_type
+	38, // [38:44] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
 }
 
 func init() { file_payment_proto_init() }
@@ -506,18 +1998,36 @@ func file_payment_proto_init() {
 	if File_payment_proto != nil {
 		return
 	}
+	file_codes_proto_init()
+	file_common_proto_init()
+	file_payment_proto_msgTypes[4].OneofWrappers = []any{
+		(*PreparePaymentRequest_KakaoPay)(nil),
+		(*PreparePaymentRequest_TossPayments)(nil),
+	}
+	file_payment_proto_msgTypes[5].OneofWrappers = []any{
+		(*PreparePaymentResponse_KakaoPay)(nil),
+		(*PreparePaymentResponse_TossPayments)(nil),
+	}
+	file_payment_proto_msgTypes[8].OneofWrappers = []any{
+		(*ApprovePaymentRequest_KakaoPay)(nil),
+		(*ApprovePaymentRequest_TossPayments)(nil),
+	}
+	file_payment_proto_msgTypes[12].OneofWrappers = []any{
+		(*CancelPaymentRequest_TossPayments)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_payment_proto_rawDesc), len(file_payment_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      2,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_payment_proto_goTypes,
 		DependencyIndexes: file_payment_proto_depIdxs,
+		EnumInfos:         file_payment_proto_enumTypes,
 		MessageInfos:      file_payment_proto_msgTypes,
 	}.Build()
 	File_payment_proto = out.File
@@ -0,0 +1,2057 @@
+// Code generated by protoc-gen-twirp v8.1.3, DO NOT EDIT.
+// source: payment.proto
+
+package gen
+
+import context "context"
+import fmt "fmt"
+import http "net/http"
+import io "io"
+import json "encoding/json"
+import strconv "strconv"
+import strings "strings"
+
+import protojson "google.golang.org/protobuf/encoding/protojson"
+import proto "google.golang.org/protobuf/proto"
+import twirp "github.com/twitchtv/twirp"
+import ctxsetters "github.com/twitchtv/twirp/ctxsetters"
+
+// Version compatibility assertion.
+// If the constant is not defined in the package, that likely means
+// the package needs to be updated to work with this generated code.
+// See https://twitchtv.github.io/twirp/docs/version_matrix.html
+const _ = twirp.TwirpPackageMinVersion_8_1_0
+
+// ========================
+// PaymentService Interface
+// ========================
+
+// Payment Service
+// PG사 공통 RPC(PreparePayment/ApprovePayment/CancelPayment)와 기존 카카오페이 전용 RPC(Kakao*)를 제공
+type PaymentService interface {
+	KakaoReady(context.Context, *KakaoReadyRequest) (*KakaoReadyResponse, error)
+
+	KakaoApprove(context.Context, *KakaoApproveRequest) (*KakaoApproveResponse, error)
+
+	KakaoCancel(context.Context, *KakaoCancelRequest) (*KakaoCancelResponse, error)
+
+	// 결제 준비: provider_details의 PG사로 결제를 생성하고 결제창 호출 정보를 반환
+	PreparePayment(context.Context, *PreparePaymentRequest) (*PreparePaymentResponse, error)
+
+	// 결제 승인: 결제창 인증 결과(카카오페이 pg_token, 토스페이먼츠 paymentKey)로 결제 확정
+	// 토스페이먼츠 금액이 준비 때와 다르면 INVALID_ARGUMENT, 거절은 PAYMENT_DECLINED 에러
+	ApprovePayment(context.Context, *ApprovePaymentRequest) (*ApprovePaymentResponse, error)
+
+	// 결제 전체/부분 취소
+	CancelPayment(context.Context, *CancelPaymentRequest) (*CancelPaymentResponse, error)
+}
+
+// ==============================
+// PaymentService Protobuf Client
+// ==============================
+
+type paymentServiceProtobufClient struct {
+	client      HTTPClient
+	urls        [6]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewPaymentServiceProtobufClient creates a Protobuf client that implements the PaymentService interface.
+// It communicates using Protobuf and can be configured with a custom HTTPClient.
+func NewPaymentServiceProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) PaymentService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "go.escape.ship.proto.v1", "PaymentService")
+	urls := [6]string{
+		serviceURL + "KakaoReady",
+		serviceURL + "KakaoApprove",
+		serviceURL + "KakaoCancel",
+		serviceURL + "PreparePayment",
+		serviceURL + "ApprovePayment",
+		serviceURL + "CancelPayment",
+	}
+
+	return &paymentServiceProtobufClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *paymentServiceProtobufClient) KakaoReady(ctx context.Context, in *KakaoReadyRequest) (*KakaoReadyResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "PaymentService")
+	ctx = ctxsetters.WithMethodName(ctx, "KakaoReady")
+	caller := c.callKakaoReady
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *KakaoReadyRequest) (*KakaoReadyResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*KakaoReadyRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*KakaoReadyRequest) when calling interceptor")
+					}
+					return c.callKakaoReady(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*KakaoReadyResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*KakaoReadyResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *paymentServiceProtobufClient) callKakaoReady(ctx context.Context, in *KakaoReadyRequest) (*KakaoReadyResponse, error) {
+	out := new(KakaoReadyResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *paymentServiceProtobufClient) KakaoApprove(ctx context.Context, in *KakaoApproveRequest) (*KakaoApproveResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "PaymentService")
+	ctx = ctxsetters.WithMethodName(ctx, "KakaoApprove")
+	caller := c.callKakaoApprove
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *KakaoApproveRequest) (*KakaoApproveResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*KakaoApproveRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*KakaoApproveRequest) when calling interceptor")
+					}
+					return c.callKakaoApprove(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*KakaoApproveResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*KakaoApproveResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *paymentServiceProtobufClient) callKakaoApprove(ctx context.Context, in *KakaoApproveRequest) (*KakaoApproveResponse, error) {
+	out := new(KakaoApproveResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *paymentServiceProtobufClient) KakaoCancel(ctx context.Context, in *KakaoCancelRequest) (*KakaoCancelResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "PaymentService")
+	ctx = ctxsetters.WithMethodName(ctx, "KakaoCancel")
+	caller := c.callKakaoCancel
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *KakaoCancelRequest) (*KakaoCancelResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*KakaoCancelRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*KakaoCancelRequest) when calling interceptor")
+					}
+					return c.callKakaoCancel(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*KakaoCancelResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*KakaoCancelResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *paymentServiceProtobufClient) callKakaoCancel(ctx context.Context, in *KakaoCancelRequest) (*KakaoCancelResponse, error) {
+	out := new(KakaoCancelResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *paymentServiceProtobufClient) PreparePayment(ctx context.Context, in *PreparePaymentRequest) (*PreparePaymentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "PaymentService")
+	ctx = ctxsetters.WithMethodName(ctx, "PreparePayment")
+	caller := c.callPreparePayment
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *PreparePaymentRequest) (*PreparePaymentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PreparePaymentRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PreparePaymentRequest) when calling interceptor")
+					}
+					return c.callPreparePayment(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PreparePaymentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PreparePaymentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *paymentServiceProtobufClient) callPreparePayment(ctx context.Context, in *PreparePaymentRequest) (*PreparePaymentResponse, error) {
+	out := new(PreparePaymentResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *paymentServiceProtobufClient) ApprovePayment(ctx context.Context, in *ApprovePaymentRequest) (*ApprovePaymentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "PaymentService")
+	ctx = ctxsetters.WithMethodName(ctx, "ApprovePayment")
+	caller := c.callApprovePayment
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ApprovePaymentRequest) (*ApprovePaymentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ApprovePaymentRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ApprovePaymentRequest) when calling interceptor")
+					}
+					return c.callApprovePayment(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ApprovePaymentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ApprovePaymentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *paymentServiceProtobufClient) callApprovePayment(ctx context.Context, in *ApprovePaymentRequest) (*ApprovePaymentResponse, error) {
+	out := new(ApprovePaymentResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *paymentServiceProtobufClient) CancelPayment(ctx context.Context, in *CancelPaymentRequest) (*CancelPaymentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "PaymentService")
+	ctx = ctxsetters.WithMethodName(ctx, "CancelPayment")
+	caller := c.callCancelPayment
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CancelPaymentRequest) (*CancelPaymentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CancelPaymentRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CancelPaymentRequest) when calling interceptor")
+					}
+					return c.callCancelPayment(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CancelPaymentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CancelPaymentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *paymentServiceProtobufClient) callCancelPayment(ctx context.Context, in *CancelPaymentRequest) (*CancelPaymentResponse, error) {
+	out := new(CancelPaymentResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ==========================
+// PaymentService JSON Client
+// ==========================
+
+type paymentServiceJSONClient struct {
+	client      HTTPClient
+	urls        [6]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewPaymentServiceJSONClient creates a JSON client that implements the PaymentService interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewPaymentServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) PaymentService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "go.escape.ship.proto.v1", "PaymentService")
+	urls := [6]string{
+		serviceURL + "KakaoReady",
+		serviceURL + "KakaoApprove",
+		serviceURL + "KakaoCancel",
+		serviceURL + "PreparePayment",
+		serviceURL + "ApprovePayment",
+		serviceURL + "CancelPayment",
+	}
+
+	return &paymentServiceJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *paymentServiceJSONClient) KakaoReady(ctx context.Context, in *KakaoReadyRequest) (*KakaoReadyResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "PaymentService")
+	ctx = ctxsetters.WithMethodName(ctx, "KakaoReady")
+	caller := c.callKakaoReady
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *KakaoReadyRequest) (*KakaoReadyResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*KakaoReadyRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*KakaoReadyRequest) when calling interceptor")
+					}
+					return c.callKakaoReady(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*KakaoReadyResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*KakaoReadyResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *paymentServiceJSONClient) callKakaoReady(ctx context.Context, in *KakaoReadyRequest) (*KakaoReadyResponse, error) {
+	out := new(KakaoReadyResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *paymentServiceJSONClient) KakaoApprove(ctx context.Context, in *KakaoApproveRequest) (*KakaoApproveResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "PaymentService")
+	ctx = ctxsetters.WithMethodName(ctx, "KakaoApprove")
+	caller := c.callKakaoApprove
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *KakaoApproveRequest) (*KakaoApproveResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*KakaoApproveRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*KakaoApproveRequest) when calling interceptor")
+					}
+					return c.callKakaoApprove(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*KakaoApproveResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*KakaoApproveResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *paymentServiceJSONClient) callKakaoApprove(ctx context.Context, in *KakaoApproveRequest) (*KakaoApproveResponse, error) {
+	out := new(KakaoApproveResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *paymentServiceJSONClient) KakaoCancel(ctx context.Context, in *KakaoCancelRequest) (*KakaoCancelResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "PaymentService")
+	ctx = ctxsetters.WithMethodName(ctx, "KakaoCancel")
+	caller := c.callKakaoCancel
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *KakaoCancelRequest) (*KakaoCancelResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*KakaoCancelRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*KakaoCancelRequest) when calling interceptor")
+					}
+					return c.callKakaoCancel(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*KakaoCancelResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*KakaoCancelResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *paymentServiceJSONClient) callKakaoCancel(ctx context.Context, in *KakaoCancelRequest) (*KakaoCancelResponse, error) {
+	out := new(KakaoCancelResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *paymentServiceJSONClient) PreparePayment(ctx context.Context, in *PreparePaymentRequest) (*PreparePaymentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "PaymentService")
+	ctx = ctxsetters.WithMethodName(ctx, "PreparePayment")
+	caller := c.callPreparePayment
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *PreparePaymentRequest) (*PreparePaymentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PreparePaymentRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PreparePaymentRequest) when calling interceptor")
+					}
+					return c.callPreparePayment(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PreparePaymentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PreparePaymentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *paymentServiceJSONClient) callPreparePayment(ctx context.Context, in *PreparePaymentRequest) (*PreparePaymentResponse, error) {
+	out := new(PreparePaymentResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *paymentServiceJSONClient) ApprovePayment(ctx context.Context, in *ApprovePaymentRequest) (*ApprovePaymentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "PaymentService")
+	ctx = ctxsetters.WithMethodName(ctx, "ApprovePayment")
+	caller := c.callApprovePayment
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ApprovePaymentRequest) (*ApprovePaymentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ApprovePaymentRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ApprovePaymentRequest) when calling interceptor")
+					}
+					return c.callApprovePayment(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ApprovePaymentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ApprovePaymentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *paymentServiceJSONClient) callApprovePayment(ctx context.Context, in *ApprovePaymentRequest) (*ApprovePaymentResponse, error) {
+	out := new(ApprovePaymentResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *paymentServiceJSONClient) CancelPayment(ctx context.Context, in *CancelPaymentRequest) (*CancelPaymentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "PaymentService")
+	ctx = ctxsetters.WithMethodName(ctx, "CancelPayment")
+	caller := c.callCancelPayment
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CancelPaymentRequest) (*CancelPaymentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CancelPaymentRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CancelPaymentRequest) when calling interceptor")
+					}
+					return c.callCancelPayment(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CancelPaymentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CancelPaymentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *paymentServiceJSONClient) callCancelPayment(ctx context.Context, in *CancelPaymentRequest) (*CancelPaymentResponse, error) {
+	out := new(CancelPaymentResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// =============================
+// PaymentService Server Handler
+// =============================
+
+type paymentServiceServer struct {
+	PaymentService
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewPaymentServiceServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewPaymentServiceServer(svc PaymentService, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	return &paymentServiceServer{
+		PaymentService:   svc,
+		hooks:            serverOpts.Hooks,
+		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:       pathPrefix,
+		jsonSkipDefaults: jsonSkipDefaults,
+		jsonCamelCase:    jsonCamelCase,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *paymentServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *paymentServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
+
+// PaymentServicePathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const PaymentServicePathPrefix = "/twirp/go.escape.ship.proto.v1.PaymentService/"
+
+func (s *paymentServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "PaymentService")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "go.escape.ship.proto.v1.PaymentService" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "KakaoReady":
+		s.serveKakaoReady(ctx, resp, req)
+		return
+	case "KakaoApprove":
+		s.serveKakaoApprove(ctx, resp, req)
+		return
+	case "KakaoCancel":
+		s.serveKakaoCancel(ctx, resp, req)
+		return
+	case "PreparePayment":
+		s.servePreparePayment(ctx, resp, req)
+		return
+	case "ApprovePayment":
+		s.serveApprovePayment(ctx, resp, req)
+		return
+	case "CancelPayment":
+		s.serveCancelPayment(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *paymentServiceServer) serveKakaoReady(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveKakaoReadyJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveKakaoReadyProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *paymentServiceServer) serveKakaoReadyJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "KakaoReady")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(KakaoReadyRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.PaymentService.KakaoReady
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *KakaoReadyRequest) (*KakaoReadyResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*KakaoReadyRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*KakaoReadyRequest) when calling interceptor")
+					}
+					return s.PaymentService.KakaoReady(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*KakaoReadyResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*KakaoReadyResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *KakaoReadyResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *KakaoReadyResponse and nil error while calling KakaoReady. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *paymentServiceServer) serveKakaoReadyProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "KakaoReady")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(KakaoReadyRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.PaymentService.KakaoReady
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *KakaoReadyRequest) (*KakaoReadyResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*KakaoReadyRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*KakaoReadyRequest) when calling interceptor")
+					}
+					return s.PaymentService.KakaoReady(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*KakaoReadyResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*KakaoReadyResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *KakaoReadyResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *KakaoReadyResponse and nil error while calling KakaoReady. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *paymentServiceServer) serveKakaoApprove(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveKakaoApproveJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveKakaoApproveProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *paymentServiceServer) serveKakaoApproveJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "KakaoApprove")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(KakaoApproveRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.PaymentService.KakaoApprove
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *KakaoApproveRequest) (*KakaoApproveResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*KakaoApproveRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*KakaoApproveRequest) when calling interceptor")
+					}
+					return s.PaymentService.KakaoApprove(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*KakaoApproveResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*KakaoApproveResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *KakaoApproveResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *KakaoApproveResponse and nil error while calling KakaoApprove. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *paymentServiceServer) serveKakaoApproveProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "KakaoApprove")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(KakaoApproveRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.PaymentService.KakaoApprove
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *KakaoApproveRequest) (*KakaoApproveResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*KakaoApproveRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*KakaoApproveRequest) when calling interceptor")
+					}
+					return s.PaymentService.KakaoApprove(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*KakaoApproveResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*KakaoApproveResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *KakaoApproveResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *KakaoApproveResponse and nil error while calling KakaoApprove. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *paymentServiceServer) serveKakaoCancel(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveKakaoCancelJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveKakaoCancelProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *paymentServiceServer) serveKakaoCancelJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "KakaoCancel")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(KakaoCancelRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.PaymentService.KakaoCancel
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *KakaoCancelRequest) (*KakaoCancelResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*KakaoCancelRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*KakaoCancelRequest) when calling interceptor")
+					}
+					return s.PaymentService.KakaoCancel(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*KakaoCancelResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*KakaoCancelResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *KakaoCancelResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *KakaoCancelResponse and nil error while calling KakaoCancel. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *paymentServiceServer) serveKakaoCancelProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "KakaoCancel")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(KakaoCancelRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.PaymentService.KakaoCancel
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *KakaoCancelRequest) (*KakaoCancelResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*KakaoCancelRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*KakaoCancelRequest) when calling interceptor")
+					}
+					return s.PaymentService.KakaoCancel(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*KakaoCancelResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*KakaoCancelResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *KakaoCancelResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *KakaoCancelResponse and nil error while calling KakaoCancel. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *paymentServiceServer) servePreparePayment(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.servePreparePaymentJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.servePreparePaymentProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *paymentServiceServer) servePreparePaymentJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "PreparePayment")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(PreparePaymentRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.PaymentService.PreparePayment
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *PreparePaymentRequest) (*PreparePaymentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PreparePaymentRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PreparePaymentRequest) when calling interceptor")
+					}
+					return s.PaymentService.PreparePayment(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PreparePaymentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PreparePaymentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *PreparePaymentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *PreparePaymentResponse and nil error while calling PreparePayment. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *paymentServiceServer) servePreparePaymentProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "PreparePayment")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(PreparePaymentRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.PaymentService.PreparePayment
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *PreparePaymentRequest) (*PreparePaymentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PreparePaymentRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PreparePaymentRequest) when calling interceptor")
+					}
+					return s.PaymentService.PreparePayment(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PreparePaymentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PreparePaymentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *PreparePaymentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *PreparePaymentResponse and nil error while calling PreparePayment. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *paymentServiceServer) serveApprovePayment(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveApprovePaymentJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveApprovePaymentProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *paymentServiceServer) serveApprovePaymentJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ApprovePayment")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ApprovePaymentRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.PaymentService.ApprovePayment
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ApprovePaymentRequest) (*ApprovePaymentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ApprovePaymentRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ApprovePaymentRequest) when calling interceptor")
+					}
+					return s.PaymentService.ApprovePayment(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ApprovePaymentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ApprovePaymentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ApprovePaymentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ApprovePaymentResponse and nil error while calling ApprovePayment. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *paymentServiceServer) serveApprovePaymentProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ApprovePayment")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ApprovePaymentRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.PaymentService.ApprovePayment
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ApprovePaymentRequest) (*ApprovePaymentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ApprovePaymentRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ApprovePaymentRequest) when calling interceptor")
+					}
+					return s.PaymentService.ApprovePayment(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ApprovePaymentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ApprovePaymentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ApprovePaymentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ApprovePaymentResponse and nil error while calling ApprovePayment. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *paymentServiceServer) serveCancelPayment(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveCancelPaymentJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveCancelPaymentProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *paymentServiceServer) serveCancelPaymentJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CancelPayment")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(CancelPaymentRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.PaymentService.CancelPayment
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CancelPaymentRequest) (*CancelPaymentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CancelPaymentRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CancelPaymentRequest) when calling interceptor")
+					}
+					return s.PaymentService.CancelPayment(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CancelPaymentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CancelPaymentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CancelPaymentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CancelPaymentResponse and nil error while calling CancelPayment. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *paymentServiceServer) serveCancelPaymentProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CancelPayment")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(CancelPaymentRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.PaymentService.CancelPayment
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CancelPaymentRequest) (*CancelPaymentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CancelPaymentRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CancelPaymentRequest) when calling interceptor")
+					}
+					return s.PaymentService.CancelPayment(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CancelPaymentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CancelPaymentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CancelPaymentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CancelPaymentResponse and nil error while calling CancelPayment. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *paymentServiceServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor8, 0
+}
+
+func (s *paymentServiceServer) ProtocGenTwirpVersion() string {
+	return "v8.1.3"
+}
+
+// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
+// that is everything in a Twirp route except for the <Method>. This can be used for routing,
+// for example to identify the requests that are targeted to this service in a mux.
+func (s *paymentServiceServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "go.escape.ship.proto.v1", "PaymentService")
+}
+
+var twirpFileDescriptor8 = []byte{
+	// 2156 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0x4f, 0x6f, 0xdb, 0xc8,
+	0x15, 0x0f, 0x25, 0xd9, 0x96, 0x9e, 0x6c, 0xd9, 0x99, 0xd8, 0x8e, 0xa2, 0x6c, 0x52, 0x46, 0x9b,
+	0x7f, 0x70, 0x63, 0xa9, 0x71, 0x8a, 0xdd, 0x36, 0x45, 0xff, 0xd0, 0xff, 0x76, 0xbd, 0x4e, 0x1c,
+	0x81, 0x76, 0x16, 0xc8, 0x5e, 0x88, 0x31, 0x39, 0x96, 0x08, 0x4b, 0x33, 0x5c, 0x72, 0xe4, 0x58,
+	0x2d, 0x8a, 0x2e, 0x16, 0xe8, 0xb9, 0xa8, 0x8b, 0xb6, 0x87, 0x1e, 0x8a, 0xfd, 0x06, 0xfd, 0x12,
+	0xbd, 0xb7, 0x68, 0x7b, 0xe8, 0xbd, 0x28, 0x7a, 0xe9, 0xa1, 0xdf, 0xa0, 0xe0, 0xcc, 0x50, 0x22,
+	0x29, 0xc9, 0x96, 0xb2, 0x7b, 0xb2, 0x67, 0xde, 0x7b, 0x33, 0xf3, 0xde, 0xfc, 0x7e, 0xef, 0xbd,
+	0xa1, 0x60, 0xc1, 0xc3, 0xbd, 0x0e, 0xa1, 0xbc, 0xe6, 0xf9, 0x8c, 0x33, 0x74, 0xb3, 0xc9, 0x6a,
+	0x24, 0xb0, 0xb1, 0x47, 0x6a, 0x41, 0xcb, 0xf5, 0xe4, 0x6c, 0xed, 0xec, 0x69, 0xa5, 0x68, 0x33,
+	0x87, 0x04, 0x72, 0x5c, 0x99, 0xb7, 0x59, 0xa7, 0xc3, 0xa8, 0x1a, 0xbd, 0xd7, 0x64, 0xac, 0xd9,
+	0x26, 0x75, 0xec, 0xb9, 0x75, 0x4c, 0x29, 0xe3, 0x98, 0xbb, 0x8c, 0x46, 0xba, 0xdf, 0x52, 0x52,
+	0x31, 0x3a, 0xee, 0x9e, 0xd4, 0xb9, 0xdb, 0x21, 0x01, 0xc7, 0x1d, 0xb5, 0x78, 0x45, 0xfe, 0xb1,
+	0xd7, 0x9b, 0x84, 0xae, 0x33, 0x8f, 0x50, 0xec, 0xb9, 0x67, 0x1b, 0x75, 0xe6, 0x89, 0x45, 0x86,
+	0x17, 0xac, 0xfe, 0x2f, 0x07, 0x73, 0x0d, 0x79, 0x68, 0x54, 0x82, 0x8c, 0xeb, 0x94, 0x35, 0x5d,
+	0x7b, 0x5c, 0x30, 0x33, 0xae, 0x83, 0xb6, 0x21, 0xef, 0xf9, 0xec, 0xcc, 0x75, 0x88, 0x5f, 0xce,
+	0xe8, 0xda, 0xe3, 0xd2, 0xc6, 0xe3, 0xda, 0x18, 0x8f, 0x6a, 0x6a, 0x8d, 0x86, 0xd2, 0x37, 0xfb,
+	0x96, 0xe8, 0x16, 0xe4, 0x99, 0xef, 0x10, 0xdf, 0x72, 0x9d, 0x72, 0x56, 0xac, 0x3d, 0x27, 0xc6,
+	0x7b, 0x0e, 0xba, 0x09, 0x73, 0xdd, 0x40, 0x4a, 0x72, 0x42, 0x32, 0x1b, 0x0e, 0xf7, 0x1c, 0xf4,
+	0x23, 0x98, 0x0d, 0x38, 0xe6, 0xdd, 0xa0, 0x3c, 0x23, 0xf6, 0x7d, 0x78, 0xd5, 0xbe, 0x87, 0x42,
+	0xdb, 0x54, 0x56, 0xe8, 0x03, 0x98, 0xc5, 0x1d, 0xd6, 0xa5, 0xbc, 0x3c, 0xab, 0x6b, 0x8f, 0x8b,
+	0x1b, 0x77, 0xc7, 0xda, 0xbf, 0x64, 0x94, 0xf4, 0x4c, 0xa5, 0x8d, 0x3e, 0x82, 0x45, 0x1b, 0x53,
+	0x9b, 0xb4, 0x89, 0x63, 0xa9, 0x05, 0xe6, 0x26, 0x5a, 0xa0, 0x14, 0x99, 0x19, 0x72, 0xa1, 0x1a,
+	0xdc, 0x88, 0x02, 0x60, 0x29, 0x4c, 0x84, 0x5e, 0xe6, 0x85, 0x97, 0xd7, 0x23, 0x91, 0x3a, 0xfc,
+	0x9e, 0x83, 0x56, 0x61, 0xb6, 0x43, 0x78, 0x8b, 0x39, 0xe5, 0x82, 0x0c, 0x84, 0x1c, 0xa1, 0x8f,
+	0x60, 0xde, 0xc6, 0xbe, 0x63, 0xd9, 0xac, 0xe3, 0x61, 0xda, 0x2b, 0x83, 0x08, 0xc7, 0xfd, 0xb1,
+	0xa7, 0xd9, 0xc2, 0xbe, 0xb3, 0x25, 0x75, 0xcd, 0xa2, 0x3d, 0x18, 0xa0, 0x1f, 0x40, 0x11, 0x7b,
+	0xe1, 0xbe, 0xa1, 0x67, 0xbc, 0x5c, 0x14, 0x5e, 0x55, 0x6a, 0x12, 0x4e, 0xb5, 0x08, 0x4e, 0xb5,
+	0xa3, 0x08, 0x4e, 0x26, 0x44, 0xea, 0x06, 0x0f, 0x8d, 0x07, 0x61, 0xe1, 0xe5, 0xf9, 0xab, 0x8d,
+	0xfb, 0xe1, 0xe0, 0x68, 0x09, 0xb2, 0xb6, 0xeb, 0x94, 0x17, 0x84, 0x5f, 0xe1, 0xbf, 0xd5, 0x35,
+	0x58, 0xdd, 0xc7, 0xa7, 0x98, 0x35, 0x70, 0xaf, 0xe1, 0x13, 0x0f, 0xfb, 0x64, 0x9b, 0x70, 0xec,
+	0xb6, 0x83, 0x48, 0x57, 0x1b, 0xe8, 0xfe, 0x18, 0x2a, 0x47, 0x2c, 0x08, 0x54, 0xa4, 0x82, 0x94,
+	0xfe, 0x3d, 0x98, 0xb7, 0xbb, 0x01, 0x67, 0x1d, 0xe2, 0x5b, 0xa7, 0xa4, 0xa7, 0x0c, 0x8b, 0xd1,
+	0xdc, 0x3e, 0xe9, 0x55, 0xff, 0xa4, 0xc1, 0xad, 0x11, 0x2b, 0x98, 0x24, 0xe8, 0xb6, 0x79, 0x02,
+	0x9c, 0x5a, 0x12, 0x9c, 0x77, 0x00, 0xa4, 0x88, 0xe2, 0x0e, 0x11, 0xf8, 0x2f, 0x98, 0x05, 0x31,
+	0x73, 0x80, 0x3b, 0x24, 0x06, 0xb1, 0xec, 0x54, 0x10, 0x4b, 0x1f, 0x39, 0x37, 0x7c, 0xe4, 0xaf,
+	0x66, 0x60, 0x45, 0x1d, 0x53, 0x9d, 0xda, 0x24, 0x9f, 0x77, 0x49, 0xc0, 0x13, 0x8c, 0xd4, 0xbe,
+	0x11, 0x46, 0x66, 0xc6, 0x32, 0x32, 0x9b, 0x60, 0x64, 0x32, 0x1a, 0xb9, 0x74, 0x34, 0x2a, 0x90,
+	0xff, 0xbc, 0x8b, 0x29, 0x77, 0x79, 0x4f, 0x50, 0x76, 0xc6, 0xec, 0x8f, 0xdf, 0x99, 0x8c, 0xdf,
+	0x87, 0x3c, 0xc7, 0xe7, 0xd6, 0x89, 0x4f, 0xc8, 0x84, 0x2c, 0x9c, 0xe3, 0xf8, 0x7c, 0xd7, 0x27,
+	0x04, 0x3d, 0x83, 0xcc, 0xc9, 0xb9, 0x60, 0x5b, 0x71, 0xe3, 0xfd, 0xb1, 0x46, 0xbb, 0xe7, 0x87,
+	0x14, 0x7b, 0x41, 0x8b, 0x71, 0x33, 0x73, 0x72, 0x8e, 0x36, 0x61, 0xd6, 0x21, 0x67, 0xae, 0x4d,
+	0x04, 0x07, 0x8b, 0x1b, 0x6b, 0x63, 0x0d, 0xb7, 0x85, 0xda, 0xae, 0x4b, 0x9b, 0xc4, 0xf7, 0x7c,
+	0x97, 0x72, 0x53, 0x59, 0xa2, 0x47, 0xb0, 0xe8, 0x3a, 0xa4, 0xe3, 0x31, 0x4e, 0xa8, 0xdd, 0x13,
+	0x17, 0x0c, 0x22, 0x56, 0xa5, 0xd8, 0xf4, 0x3e, 0xe9, 0xa1, 0x03, 0x28, 0x9c, 0x86, 0x1c, 0x08,
+	0xb3, 0x83, 0x62, 0x63, 0x7d, 0xec, 0x7e, 0xa3, 0xd9, 0xf2, 0xf1, 0x35, 0x33, 0x7f, 0xaa, 0x24,
+	0xe8, 0x33, 0x58, 0xe0, 0x2c, 0x08, 0xa2, 0x64, 0x13, 0x28, 0x92, 0x3e, 0x1b, 0xbb, 0xe6, 0x78,
+	0x56, 0x7d, 0x7c, 0xcd, 0x9c, 0xe7, 0x31, 0xe9, 0x26, 0x82, 0xa5, 0x7e, 0x32, 0x73, 0xa4, 0x4e,
+	0xf5, 0x57, 0x19, 0x58, 0x4d, 0x63, 0x34, 0xf0, 0x18, 0x0d, 0x08, 0x7a, 0x0e, 0x73, 0xea, 0x14,
+	0x02, 0xa3, 0xc5, 0x0d, 0xfd, 0x2a, 0x8c, 0x9a, 0x91, 0x01, 0xfa, 0x24, 0x1e, 0x96, 0x8c, 0xb0,
+	0xfe, 0xf6, 0xe5, 0x61, 0x31, 0x09, 0x76, 0x7a, 0xd1, 0xde, 0x89, 0x90, 0xbc, 0x49, 0x87, 0x44,
+	0x12, 0x75, 0x63, 0x9a, 0x90, 0xc8, 0x34, 0x31, 0x51, 0x44, 0xbe, 0x37, 0xc8, 0x6a, 0x86, 0x4c,
+	0x9d, 0x51, 0x96, 0xba, 0x0b, 0x79, 0xaf, 0x69, 0x71, 0x76, 0x4a, 0xa8, 0x4c, 0x32, 0x9b, 0xd9,
+	0x2f, 0x34, 0xcd, 0x9c, 0xf3, 0x9a, 0x47, 0xe1, 0x5c, 0xf5, 0xa7, 0xc9, 0x1c, 0x97, 0xb2, 0xbe,
+	0x0f, 0xc5, 0xa8, 0x82, 0xf4, 0x53, 0x9c, 0x5c, 0x00, 0xd4, 0xfc, 0x3e, 0x89, 0x93, 0x2c, 0x33,
+	0x0d, 0xc9, 0xaa, 0x7f, 0xcc, 0xc0, 0x8a, 0xda, 0x30, 0x95, 0x6b, 0xee, 0x00, 0xc4, 0x2a, 0x97,
+	0x4c, 0x8e, 0x05, 0xaf, 0x5f, 0xb1, 0x46, 0x20, 0x3d, 0x73, 0x35, 0xd2, 0xb3, 0x13, 0x22, 0x3d,
+	0x19, 0x83, 0xcb, 0x91, 0x9e, 0x9b, 0x02, 0xe9, 0x43, 0xeb, 0x5e, 0x7d, 0xaf, 0x47, 0xb0, 0x9a,
+	0x0e, 0xd0, 0xd7, 0x07, 0x7a, 0xf5, 0x0f, 0x1a, 0x2c, 0x9b, 0xe4, 0xa4, 0x4b, 0x1d, 0x93, 0xd8,
+	0xc4, 0x3d, 0x23, 0x86, 0x6d, 0x8b, 0xac, 0xf7, 0x14, 0x72, 0xc7, 0x98, 0x9e, 0xaa, 0xf4, 0x7e,
+	0x67, 0xec, 0x8a, 0x9b, 0x98, 0x9e, 0x9a, 0x42, 0x15, 0xad, 0x41, 0x09, 0x4b, 0x6b, 0x8b, 0x76,
+	0x3b, 0xc7, 0xaa, 0x5b, 0x53, 0x20, 0x59, 0x50, 0xa2, 0x03, 0x21, 0x09, 0xd1, 0xd4, 0x62, 0xed,
+	0x7e, 0x22, 0xcf, 0xc6, 0xd0, 0x24, 0xe7, 0xc3, 0x74, 0x5e, 0xfd, 0x22, 0x55, 0x34, 0xb7, 0x44,
+	0x39, 0x8f, 0x10, 0x69, 0xc3, 0xaa, 0x2f, 0x8e, 0x6e, 0xf9, 0xf2, 0xec, 0x96, 0xda, 0x43, 0x85,
+	0x61, 0x7d, 0xec, 0xa1, 0x47, 0x79, 0x6c, 0x2e, 0xfb, 0x23, 0x66, 0xab, 0xff, 0xc8, 0xc2, 0xb2,
+	0xdc, 0x76, 0x3a, 0x5c, 0xbe, 0x23, 0x11, 0x12, 0xd5, 0x26, 0x3b, 0x5d, 0xb5, 0xf9, 0x0e, 0x64,
+	0xcf, 0x30, 0x2f, 0xe7, 0x26, 0xb2, 0x0a, 0x55, 0xd1, 0x1e, 0x2c, 0xc9, 0x0e, 0xc9, 0xc2, 0x67,
+	0xd8, 0x6d, 0xe3, 0xe3, 0x36, 0x29, 0xcf, 0x4c, 0x64, 0xae, 0xfa, 0x53, 0x23, 0x32, 0x0b, 0x3b,
+	0x47, 0x9f, 0xe0, 0x80, 0x51, 0x51, 0x5d, 0x0b, 0xa6, 0x1a, 0x8d, 0xe2, 0xe7, 0xdc, 0x48, 0x7e,
+	0x0e, 0xa5, 0xc9, 0xfc, 0x14, 0x69, 0x32, 0x01, 0x8c, 0x89, 0xe8, 0x74, 0x08, 0x2b, 0xa9, 0x6b,
+	0xfd, 0x06, 0xd8, 0xf4, 0xbb, 0x1c, 0x5c, 0x8f, 0x57, 0x03, 0x89, 0x94, 0xc7, 0xb0, 0xe4, 0x61,
+	0x9f, 0x53, 0xe2, 0x5b, 0xa9, 0x26, 0xaf, 0xa4, 0xe6, 0x5f, 0xa9, 0xb6, 0xe7, 0x21, 0x2c, 0x46,
+	0x9a, 0x51, 0xfb, 0x23, 0x93, 0xd9, 0x82, 0x9a, 0x7e, 0x2d, 0xbb, 0xa0, 0xdb, 0x50, 0x70, 0x39,
+	0xe9, 0xc4, 0xb8, 0x63, 0xe6, 0xc3, 0x89, 0xa1, 0x1e, 0x28, 0x97, 0xea, 0x81, 0x1e, 0xc0, 0x3c,
+	0x67, 0x1c, 0xb7, 0xa3, 0x57, 0x45, 0x78, 0xd9, 0xd9, 0xcd, 0x4c, 0x59, 0x33, 0x8b, 0x62, 0x5e,
+	0x3d, 0x1b, 0xd6, 0x60, 0x31, 0x02, 0xa1, 0x15, 0xeb, 0x99, 0xa4, 0xe6, 0x82, 0xc2, 0x9b, 0xd2,
+	0x95, 0x3d, 0xce, 0xdc, 0xbb, 0xf6, 0x38, 0xf9, 0x77, 0xee, 0x71, 0xbe, 0x0b, 0x33, 0xe2, 0xcc,
+	0xe5, 0xc2, 0x44, 0x88, 0x95, 0xca, 0x09, 0x7e, 0xc1, 0x74, 0xfc, 0x1a, 0x01, 0xe5, 0xe2, 0x48,
+	0x28, 0xab, 0xe7, 0xc3, 0xfc, 0xe0, 0xf9, 0xf0, 0xfb, 0x0c, 0xa0, 0xe1, 0x36, 0x21, 0x54, 0xe4,
+	0x83, 0x77, 0x06, 0x77, 0x1d, 0xf4, 0x14, 0x56, 0x28, 0x39, 0xe7, 0x96, 0x4f, 0x1c, 0xd7, 0x27,
+	0x36, 0xb7, 0xb0, 0xe7, 0x59, 0x5d, 0xbf, 0xad, 0x70, 0x80, 0x42, 0xa1, 0xa9, 0x64, 0x86, 0xe7,
+	0xbd, 0xf6, 0xdb, 0xe8, 0x43, 0x28, 0x27, 0x4d, 0x3a, 0xec, 0xd8, 0x6d, 0x13, 0x61, 0x25, 0xb1,
+	0xb1, 0x12, 0xb7, 0x7a, 0x29, 0xa4, 0xa1, 0x61, 0x1d, 0x96, 0x93, 0x86, 0x9e, 0x2d, 0x8c, 0x64,
+	0x57, 0x7d, 0x3d, 0x6e, 0xd4, 0xb0, 0x43, 0x83, 0x27, 0x80, 0x30, 0x75, 0x7c, 0xe6, 0x3a, 0xe2,
+	0x58, 0x81, 0xdd, 0x22, 0x1d, 0x99, 0x30, 0x0a, 0xe6, 0x92, 0x92, 0x18, 0x9e, 0x77, 0x28, 0xe6,
+	0xd1, 0x7d, 0x28, 0xb9, 0x2c, 0x88, 0x6b, 0xca, 0xcc, 0x30, 0xef, 0xb2, 0xa0, 0xaf, 0x55, 0xfd,
+	0xbb, 0x06, 0x37, 0x44, 0x64, 0x54, 0x71, 0x8b, 0x48, 0x33, 0x1c, 0x9a, 0x51, 0x34, 0xca, 0x4c,
+	0x4a, 0xa3, 0xec, 0x28, 0x1a, 0xc5, 0x1b, 0xa2, 0xdc, 0x70, 0x43, 0x34, 0xea, 0xc2, 0x67, 0x2e,
+	0xbb, 0xf0, 0xd9, 0xc1, 0x85, 0xff, 0x04, 0x96, 0x93, 0x5e, 0xa9, 0x1b, 0x9f, 0x38, 0x17, 0x54,
+	0xff, 0x9a, 0x53, 0x90, 0x91, 0x69, 0x6a, 0xfa, 0x64, 0xf2, 0x08, 0x16, 0xa2, 0xe4, 0x3e, 0x28,
+	0x44, 0x05, 0x41, 0xe1, 0x79, 0x95, 0xbd, 0x25, 0x83, 0x3f, 0x84, 0x55, 0xa5, 0x98, 0x26, 0x7d,
+	0xb6, 0x4f, 0xfa, 0x1b, 0x52, 0xe3, 0x28, 0x41, 0xfd, 0x1a, 0x5c, 0x57, 0x86, 0x67, 0x98, 0x47,
+	0x36, 0xb9, 0xbe, 0x8d, 0xaa, 0x11, 0x9f, 0x62, 0xae, 0xf4, 0x9f, 0xc3, 0xcd, 0x74, 0xb9, 0x19,
+	0x4e, 0x44, 0x2b, 0xa9, 0xca, 0xa2, 0x6c, 0x3f, 0x80, 0x59, 0x29, 0x98, 0xf4, 0xf5, 0x26, 0xb5,
+	0xd1, 0x2e, 0x2c, 0xa6, 0x9c, 0x9b, 0xf0, 0x11, 0xb7, 0x90, 0xf0, 0x18, 0xfd, 0x10, 0x60, 0xe0,
+	0x6b, 0x39, 0x3f, 0xd1, 0x12, 0x85, 0x7e, 0x00, 0x46, 0x56, 0xda, 0xc2, 0xbb, 0x55, 0xda, 0x89,
+	0xdf, 0x76, 0x0a, 0x95, 0xc5, 0xf8, 0x57, 0x8c, 0x1b, 0x09, 0x48, 0x4d, 0x0b, 0xca, 0xb5, 0xb7,
+	0xb0, 0x98, 0x7a, 0xcf, 0x23, 0x1d, 0xde, 0x6b, 0x18, 0x6f, 0x5e, 0xee, 0x1c, 0x1c, 0x59, 0x0d,
+	0xf3, 0xd5, 0xa7, 0x7b, 0xdb, 0x3b, 0xa6, 0xf5, 0xfa, 0xe0, 0xb0, 0xb1, 0xb3, 0xb5, 0xb7, 0xbb,
+	0xb7, 0xb3, 0xbd, 0x74, 0x0d, 0xdd, 0x85, 0xca, 0x90, 0xc6, 0xbe, 0xb1, 0x6f, 0xbc, 0xb2, 0x1a,
+	0xc6, 0x9b, 0x25, 0x0d, 0x55, 0xe1, 0xee, 0x90, 0xfc, 0xe8, 0xd5, 0xe1, 0xa1, 0xa5, 0x66, 0x0f,
+	0x97, 0x32, 0x6b, 0x7f, 0xd6, 0x60, 0x21, 0xf1, 0x8d, 0x2d, 0xbe, 0xea, 0xe1, 0x91, 0x71, 0xf4,
+	0xfa, 0x30, 0xb5, 0x6b, 0x19, 0x96, 0x53, 0x72, 0x73, 0xc7, 0xd8, 0x0e, 0xf7, 0xbb, 0x0d, 0x37,
+	0x53, 0x12, 0xa3, 0x11, 0x6e, 0xbc, 0xb3, 0xbd, 0x94, 0x41, 0x0f, 0xe0, 0x5e, 0x4a, 0xd8, 0x30,
+	0xcc, 0xa3, 0x3d, 0xe3, 0xc5, 0x8b, 0x37, 0xd6, 0x96, 0x71, 0xb0, 0xb5, 0xf3, 0x62, 0x67, 0x7b,
+	0x29, 0x3b, 0x62, 0x8d, 0xbe, 0x30, 0x87, 0x6e, 0xc1, 0x4a, 0x4a, 0xb8, 0x6b, 0xec, 0x85, 0xa2,
+	0x99, 0x8d, 0xbf, 0x14, 0xa1, 0x14, 0xf9, 0x41, 0x7c, 0x51, 0xc7, 0xfe, 0xa9, 0x01, 0x0c, 0x6a,
+	0x03, 0x5a, 0x9b, 0xe8, 0x9d, 0x29, 0x92, 0x41, 0x65, 0x9a, 0x37, 0x69, 0xd5, 0xbf, 0x30, 0x1a,
+	0x50, 0x12, 0x02, 0x3d, 0x6a, 0x8d, 0x50, 0x59, 0xe8, 0xe8, 0xaa, 0x81, 0xd1, 0xdf, 0xba, 0xbc,
+	0xa5, 0x0b, 0x95, 0xca, 0xfd, 0x3d, 0xea, 0x72, 0x17, 0x73, 0xd2, 0x17, 0x7a, 0x3e, 0xb3, 0x49,
+	0x10, 0xc4, 0x94, 0x6a, 0x5f, 0xfe, 0xed, 0x5f, 0xbf, 0xc9, 0xdc, 0x7a, 0xae, 0xad, 0x55, 0x97,
+	0xeb, 0x4a, 0xad, 0x2e, 0x5e, 0x48, 0x75, 0x5f, 0x38, 0xf3, 0x6f, 0x0d, 0xe6, 0xe3, 0x79, 0x10,
+	0x3d, 0xb9, 0xfc, 0xc4, 0xc9, 0x22, 0x50, 0x59, 0x9f, 0x50, 0x5b, 0x79, 0xd8, 0xbb, 0x30, 0x5e,
+	0x0f, 0x79, 0x58, 0x51, 0x5a, 0xa3, 0x7c, 0x7c, 0x14, 0xc9, 0x78, 0xeb, 0x6a, 0x37, 0x6f, 0x87,
+	0x6e, 0xae, 0xa6, 0xdc, 0x54, 0x5f, 0x27, 0xd1, 0x7f, 0x35, 0x28, 0xc6, 0xa8, 0x85, 0xae, 0xb8,
+	0x99, 0x44, 0x4e, 0xaf, 0x3c, 0x99, 0x4c, 0x59, 0x79, 0xf9, 0xa5, 0x76, 0x61, 0x58, 0x43, 0x6e,
+	0xde, 0x92, 0x5a, 0xa3, 0xbc, 0xdc, 0x50, 0x22, 0x4c, 0x75, 0x46, 0x9b, 0xcc, 0xa5, 0x4d, 0x9d,
+	0xf9, 0x7a, 0xf8, 0x29, 0xb7, 0x4d, 0x38, 0x71, 0x46, 0x98, 0x48, 0x87, 0x2b, 0xa1, 0xc3, 0x2b,
+	0x29, 0x87, 0x55, 0x5a, 0xfd, 0x6d, 0x06, 0x4a, 0xc9, 0xef, 0x2e, 0xa8, 0x36, 0xbe, 0x4f, 0x1e,
+	0xf5, 0x11, 0xb1, 0x52, 0x9f, 0x58, 0x5f, 0x39, 0xfe, 0x95, 0x76, 0x61, 0xfc, 0x02, 0xf2, 0x7d,
+	0x97, 0x17, 0x95, 0x5e, 0xe4, 0x40, 0xe5, 0x78, 0xcb, 0x27, 0x21, 0x60, 0x71, 0xd2, 0xa5, 0xf0,
+	0x72, 0x03, 0xd2, 0x26, 0xb6, 0x70, 0x56, 0xe5, 0x2d, 0x1d, 0x53, 0x47, 0xf7, 0x09, 0xef, 0xfa,
+	0x54, 0x7f, 0xdb, 0xc2, 0x5c, 0x68, 0xd9, 0x6d, 0x37, 0xb4, 0xa2, 0x84, 0x38, 0x81, 0xce, 0x99,
+	0xce, 0x3c, 0x42, 0x75, 0x97, 0x07, 0xba, 0xdd, 0x22, 0xf6, 0x29, 0xeb, 0xf2, 0x04, 0xe0, 0xcf,
+	0x9e, 0x46, 0xb1, 0x09, 0xea, 0x9e, 0x3c, 0x0a, 0xfa, 0x65, 0x06, 0x4a, 0xc9, 0x67, 0xfa, 0x25,
+	0x71, 0x19, 0xf9, 0xc1, 0xa3, 0x52, 0x9f, 0x58, 0x5f, 0xc5, 0xe5, 0xd7, 0xda, 0x85, 0x71, 0x1c,
+	0x8f, 0x4b, 0x0a, 0xf1, 0x95, 0x9d, 0x2d, 0x46, 0x4f, 0x5c, 0xbf, 0x13, 0x06, 0x46, 0x9e, 0xd3,
+	0x19, 0x8e, 0x50, 0x14, 0x98, 0x47, 0x81, 0x8e, 0xbb, 0xbc, 0x45, 0x28, 0x77, 0x6d, 0xf1, 0x13,
+	0x8d, 0xee, 0x8b, 0x8f, 0x4f, 0xd2, 0xf5, 0x87, 0xa1, 0xeb, 0xf7, 0x12, 0xae, 0xff, 0x6c, 0xf0,
+	0x0c, 0xfe, 0x79, 0x9f, 0x0f, 0xff, 0xd1, 0x60, 0x21, 0xf1, 0xbe, 0x42, 0xeb, 0x97, 0xfc, 0x58,
+	0x30, 0xfc, 0xbc, 0xae, 0xd4, 0x26, 0x55, 0x57, 0x41, 0x38, 0xbf, 0x30, 0x3e, 0x89, 0xc5, 0xa0,
+	0x94, 0xa4, 0x43, 0xa5, 0x36, 0xe0, 0x40, 0xf4, 0x83, 0x42, 0x3f, 0x04, 0x2e, 0xd5, 0x4f, 0xba,
+	0xed, 0x76, 0x48, 0x0a, 0x97, 0xea, 0x61, 0xc5, 0x93, 0xbe, 0x3e, 0x08, 0x7d, 0xd5, 0xc7, 0xfb,
+	0x2a, 0xa9, 0xb0, 0xf9, 0xfe, 0x67, 0xf7, 0x9a, 0x2e, 0x6f, 0x75, 0x8f, 0x6b, 0x36, 0xeb, 0xd4,
+	0xe5, 0x91, 0xd7, 0xc3, 0x23, 0xcb, 0x5f, 0xc7, 0x82, 0x7a, 0x93, 0xd0, 0xe3, 0x59, 0xf1, 0xff,
+	0xb3, 0xff, 0x0f, 0x00, 0x55, 0xdd, 0x7c, 0xb7, 0x9a, 0x1b, 0x00, 0x00,
+}
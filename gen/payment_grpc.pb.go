@@ -19,20 +19,31 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PaymentService_KakaoReady_FullMethodName   = "/go.escape.ship.proto.v1.PaymentService/KakaoReady"
-	PaymentService_KakaoApprove_FullMethodName = "/go.escape.ship.proto.v1.PaymentService/KakaoApprove"
-	PaymentService_KakaoCancel_FullMethodName  = "/go.escape.ship.proto.v1.PaymentService/KakaoCancel"
+	PaymentService_KakaoReady_FullMethodName     = "/go.escape.ship.proto.v1.PaymentService/KakaoReady"
+	PaymentService_KakaoApprove_FullMethodName   = "/go.escape.ship.proto.v1.PaymentService/KakaoApprove"
+	PaymentService_KakaoCancel_FullMethodName    = "/go.escape.ship.proto.v1.PaymentService/KakaoCancel"
+	PaymentService_PreparePayment_FullMethodName = "/go.escape.ship.proto.v1.PaymentService/PreparePayment"
+	PaymentService_ApprovePayment_FullMethodName = "/go.escape.ship.proto.v1.PaymentService/ApprovePayment"
+	PaymentService_CancelPayment_FullMethodName  = "/go.escape.ship.proto.v1.PaymentService/CancelPayment"
 )
 
 // PaymentServiceClient is the client API for PaymentService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 //
-// Kakao Payment Service
+// Payment Service
+// PG사 공통 RPC(PreparePayment/ApprovePayment/CancelPayment)와 기존 카카오페이 전용 RPC(Kakao*)를 제공
 type PaymentServiceClient interface {
 	KakaoReady(ctx context.Context, in *KakaoReadyRequest, opts ...grpc.CallOption) (*KakaoReadyResponse, error)
 	KakaoApprove(ctx context.Context, in *KakaoApproveRequest, opts ...grpc.CallOption) (*KakaoApproveResponse, error)
 	KakaoCancel(ctx context.Context, in *KakaoCancelRequest, opts ...grpc.CallOption) (*KakaoCancelResponse, error)
+	// 결제 준비: provider_details의 PG사로 결제를 생성하고 결제창 호출 정보를 반환
+	PreparePayment(ctx context.Context, in *PreparePaymentRequest, opts ...grpc.CallOption) (*PreparePaymentResponse, error)
+	// 결제 승인: 결제창 인증 결과(카카오페이 pg_token, 토스페이먼츠 paymentKey)로 결제 확정
+	// 토스페이먼츠 금액이 준비 때와 다르면 INVALID_ARGUMENT, 거절은 PAYMENT_DECLINED 에러
+	ApprovePayment(ctx context.Context, in *ApprovePaymentRequest, opts ...grpc.CallOption) (*ApprovePaymentResponse, error)
+	// 결제 전체/부분 취소
+	CancelPayment(ctx context.Context, in *CancelPaymentRequest, opts ...grpc.CallOption) (*CancelPaymentResponse, error)
 }
 
 type paymentServiceClient struct {
@@ -73,15 +84,53 @@ func (c *paymentServiceClient) KakaoCancel(ctx context.Context, in *KakaoCancelR
 	return out, nil
 }
 
+func (c *paymentServiceClient) PreparePayment(ctx context.Context, in *PreparePaymentRequest, opts ...grpc.CallOption) (*PreparePaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreparePaymentResponse)
+	err := c.cc.Invoke(ctx, PaymentService_PreparePayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ApprovePayment(ctx context.Context, in *ApprovePaymentRequest, opts ...grpc.CallOption) (*ApprovePaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApprovePaymentResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ApprovePayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) CancelPayment(ctx context.Context, in *CancelPaymentRequest, opts ...grpc.CallOption) (*CancelPaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelPaymentResponse)
+	err := c.cc.Invoke(ctx, PaymentService_CancelPayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PaymentServiceServer is the server API for PaymentService service.
 // All implementations must embed UnimplementedPaymentServiceServer
 // for forward compatibility.
 //
-// Kakao Payment Service
+// Payment Service
+// PG사 공통 RPC(PreparePayment/ApprovePayment/CancelPayment)와 기존 카카오페이 전용 RPC(Kakao*)를 제공
 type PaymentServiceServer interface {
 	KakaoReady(context.Context, *KakaoReadyRequest) (*KakaoReadyResponse, error)
 	KakaoApprove(context.Context, *KakaoApproveRequest) (*KakaoApproveResponse, error)
 	KakaoCancel(context.Context, *KakaoCancelRequest) (*KakaoCancelResponse, error)
+	// 결제 준비: provider_details의 PG사로 결제를 생성하고 결제창 호출 정보를 반환
+	PreparePayment(context.Context, *PreparePaymentRequest) (*PreparePaymentResponse, error)
+	// 결제 승인: 결제창 인증 결과(카카오페이 pg_token, 토스페이먼츠 paymentKey)로 결제 확정
+	// 토스페이먼츠 금액이 준비 때와 다르면 INVALID_ARGUMENT, 거절은 PAYMENT_DECLINED 에러
+	ApprovePayment(context.Context, *ApprovePaymentRequest) (*ApprovePaymentResponse, error)
+	// 결제 전체/부분 취소
+	CancelPayment(context.Context, *CancelPaymentRequest) (*CancelPaymentResponse, error)
 	mustEmbedUnimplementedPaymentServiceServer()
 }
 
@@ -101,6 +150,15 @@ func (UnimplementedPaymentServiceServer) KakaoApprove(context.Context, *KakaoApp
 func (UnimplementedPaymentServiceServer) KakaoCancel(context.Context, *KakaoCancelRequest) (*KakaoCancelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method KakaoCancel not implemented")
 }
+func (UnimplementedPaymentServiceServer) PreparePayment(context.Context, *PreparePaymentRequest) (*PreparePaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreparePayment not implemented")
+}
+func (UnimplementedPaymentServiceServer) ApprovePayment(context.Context, *ApprovePaymentRequest) (*ApprovePaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApprovePayment not implemented")
+}
+func (UnimplementedPaymentServiceServer) CancelPayment(context.Context, *CancelPaymentRequest) (*CancelPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPayment not implemented")
+}
 func (UnimplementedPaymentServiceServer) mustEmbedUnimplementedPaymentServiceServer() {}
 func (UnimplementedPaymentServiceServer) testEmbeddedByValue()                        {}
 
@@ -176,6 +234,60 @@ func _PaymentService_KakaoCancel_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PaymentService_PreparePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreparePaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).PreparePayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_PreparePayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).PreparePayment(ctx, req.(*PreparePaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ApprovePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApprovePaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ApprovePayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ApprovePayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ApprovePayment(ctx, req.(*ApprovePaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_CancelPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).CancelPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_CancelPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).CancelPayment(ctx, req.(*CancelPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PaymentService_ServiceDesc is the grpc.ServiceDesc for PaymentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -195,6 +307,18 @@ var PaymentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "KakaoCancel",
 			Handler:    _PaymentService_KakaoCancel_Handler,
 		},
+		{
+			MethodName: "PreparePayment",
+			Handler:    _PaymentService_PreparePayment_Handler,
+		},
+		{
+			MethodName: "ApprovePayment",
+			Handler:    _PaymentService_ApprovePayment_Handler,
+		},
+		{
+			MethodName: "CancelPayment",
+			Handler:    _PaymentService_CancelPayment_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "payment.proto",
@@ -0,0 +1,93 @@
+// Code generated by protoc-gen-go-shim. DO NOT EDIT.
+// source: payment.proto
+
+package gen
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+)
+
+// PaymentServiceAPI is PaymentServiceClient without per-call options, so it can be
+// mocked with plain method signatures. Streams are exposed as iterators.
+type PaymentServiceAPI interface {
+	KakaoReady(ctx context.Context, in *KakaoReadyRequest) (*KakaoReadyResponse, error)
+	KakaoApprove(ctx context.Context, in *KakaoApproveRequest) (*KakaoApproveResponse, error)
+	KakaoCancel(ctx context.Context, in *KakaoCancelRequest) (*KakaoCancelResponse, error)
+	// 결제 준비: provider_details의 PG사로 결제를 생성하고 결제창 호출 정보를 반환
+	PreparePayment(ctx context.Context, in *PreparePaymentRequest) (*PreparePaymentResponse, error)
+	// 결제 승인: 결제창 인증 결과(카카오페이 pg_token, 토스페이먼츠 paymentKey)로 결제 확정
+	// 토스페이먼츠 금액이 준비 때와 다르면 INVALID_ARGUMENT, 거절은 PAYMENT_DECLINED 에러
+	ApprovePayment(ctx context.Context, in *ApprovePaymentRequest) (*ApprovePaymentResponse, error)
+	// 결제 전체/부분 취소
+	CancelPayment(ctx context.Context, in *CancelPaymentRequest) (*CancelPaymentResponse, error)
+}
+
+// NewPaymentServiceAPI adapts c to PaymentServiceAPI, passing opts to every call.
+func NewPaymentServiceAPI(c PaymentServiceClient, opts ...grpc.CallOption) PaymentServiceAPI {
+	return &paymentServiceAPI{c: c, opts: opts}
+}
+
+type paymentServiceAPI struct {
+	c    PaymentServiceClient
+	opts []grpc.CallOption
+}
+
+func (a *paymentServiceAPI) KakaoReady(ctx context.Context, in *KakaoReadyRequest) (*KakaoReadyResponse, error) {
+	return a.c.KakaoReady(ctx, in, a.opts...)
+}
+
+func (a *paymentServiceAPI) KakaoApprove(ctx context.Context, in *KakaoApproveRequest) (*KakaoApproveResponse, error) {
+	return a.c.KakaoApprove(ctx, in, a.opts...)
+}
+
+func (a *paymentServiceAPI) KakaoCancel(ctx context.Context, in *KakaoCancelRequest) (*KakaoCancelResponse, error) {
+	return a.c.KakaoCancel(ctx, in, a.opts...)
+}
+
+func (a *paymentServiceAPI) PreparePayment(ctx context.Context, in *PreparePaymentRequest) (*PreparePaymentResponse, error) {
+	return a.c.PreparePayment(ctx, in, a.opts...)
+}
+
+func (a *paymentServiceAPI) ApprovePayment(ctx context.Context, in *ApprovePaymentRequest) (*ApprovePaymentResponse, error) {
+	return a.c.ApprovePayment(ctx, in, a.opts...)
+}
+
+func (a *paymentServiceAPI) CancelPayment(ctx context.Context, in *CancelPaymentRequest) (*CancelPaymentResponse, error) {
+	return a.c.CancelPayment(ctx, in, a.opts...)
+}
+
+// PaymentServiceClientFromAPI adapts a to PaymentServiceClient, e.g. to hand a
+// mock PaymentServiceAPI to code that takes the generated client. Call options
+// are ignored, and streams report empty headers and trailers.
+func PaymentServiceClientFromAPI(a PaymentServiceAPI) PaymentServiceClient {
+	return paymentServiceAPIClient{api: a}
+}
+
+type paymentServiceAPIClient struct {
+	api PaymentServiceAPI
+}
+
+func (c paymentServiceAPIClient) KakaoReady(ctx context.Context, in *KakaoReadyRequest, _ ...grpc.CallOption) (*KakaoReadyResponse, error) {
+	return c.api.KakaoReady(ctx, in)
+}
+
+func (c paymentServiceAPIClient) KakaoApprove(ctx context.Context, in *KakaoApproveRequest, _ ...grpc.CallOption) (*KakaoApproveResponse, error) {
+	return c.api.KakaoApprove(ctx, in)
+}
+
+func (c paymentServiceAPIClient) KakaoCancel(ctx context.Context, in *KakaoCancelRequest, _ ...grpc.CallOption) (*KakaoCancelResponse, error) {
+	return c.api.KakaoCancel(ctx, in)
+}
+
+func (c paymentServiceAPIClient) PreparePayment(ctx context.Context, in *PreparePaymentRequest, _ ...grpc.CallOption) (*PreparePaymentResponse, error) {
+	return c.api.PreparePayment(ctx, in)
+}
+
+func (c paymentServiceAPIClient) ApprovePayment(ctx context.Context, in *ApprovePaymentRequest, _ ...grpc.CallOption) (*ApprovePaymentResponse, error) {
+	return c.api.ApprovePayment(ctx, in)
+}
+
+func (c paymentServiceAPIClient) CancelPayment(ctx context.Context, in *CancelPaymentRequest, _ ...grpc.CallOption) (*CancelPaymentResponse, error) {
+	return c.api.CancelPayment(ctx, in)
+}
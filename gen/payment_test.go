@@ -0,0 +1,155 @@
+package gen
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func approvedPayment() *Payment {
+	return &Payment{
+		Id: "pay-1", Provider: PaymentProvider_PAYMENT_PROVIDER_KAKAO_PAY, OrderId: "o-1", Cid: "TCSUBSCRIP",
+		Status: PaymentStatus_PAYMENT_STATUS_PARTIALLY_CANCELED, Amount: KRW(50000), CanceledAmount: KRW(10000),
+	}
+}
+
+func TestEffectiveProvider(t *testing.T) {
+	kakao, toss := PaymentProvider_PAYMENT_PROVIDER_KAKAO_PAY, PaymentProvider_PAYMENT_PROVIDER_TOSS_PAYMENTS
+	tests := []struct {
+		name     string
+		req      *PreparePaymentRequest
+		want     PaymentProvider
+		wantCode codes.Code
+	}{
+		{name: "provider", req: &PreparePaymentRequest{Provider: toss}, want: toss},
+		{name: "details", req: &PreparePaymentRequest{ProviderDetails: &PreparePaymentRequest_KakaoPay{KakaoPay: &KakaoPayPrepareDetails{}}}, want: kakao},
+		{name: "both agree", req: &PreparePaymentRequest{Provider: kakao, ProviderDetails: &PreparePaymentRequest_KakaoPay{KakaoPay: &KakaoPayPrepareDetails{}}}, want: kakao},
+		{name: "both disagree", req: &PreparePaymentRequest{Provider: toss, ProviderDetails: &PreparePaymentRequest_KakaoPay{KakaoPay: &KakaoPayPrepareDetails{}}}, want: toss, wantCode: codes.InvalidArgument},
+		{name: "neither", req: &PreparePaymentRequest{}, wantCode: codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.req.EffectiveProvider()
+			if got != tt.want || status.Code(err) != tt.wantCode {
+				t.Errorf("EffectiveProvider() = %v, %v, want %v, %v", got, err, tt.want, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestCancelableAmount(t *testing.T) {
+	tests := []struct {
+		name    string
+		payment *Payment
+		want    *Money
+	}{
+		{"partly canceled", approvedPayment(), KRW(40000)},
+		{"not approved", &Payment{Status: PaymentStatus_PAYMENT_STATUS_READY, Amount: KRW(50000)}, KRW(0)},
+		{"not approved USD", &Payment{Status: PaymentStatus_PAYMENT_STATUS_READY, Amount: &Money{CurrencyCode: "USD", Units: 5}}, &Money{CurrencyCode: "USD"}},
+		{"over canceled", &Payment{Status: PaymentStatus_PAYMENT_STATUS_APPROVED, Amount: KRW(100), CanceledAmount: KRW(200)}, KRW(0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.payment.CancelableAmount()
+			if err != nil || !proto.Equal(got, tt.want) {
+				t.Errorf("CancelableAmount() = %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckCancel(t *testing.T) {
+	usd := &Money{CurrencyCode: "USD", Units: 1}
+	tests := []struct {
+		name       string
+		payment    *Payment
+		req        *CancelPaymentRequest
+		wantCode   codes.Code
+		wantReason ErrorReason
+	}{
+		{name: "partial", req: &CancelPaymentRequest{Amount: KRW(11000), TaxFree: KRW(0), Vat: KRW(1000)}},
+		{name: "rest", req: &CancelPaymentRequest{}},
+		{name: "exactly the rest", req: &CancelPaymentRequest{Amount: KRW(40000)}},
+		{name: "tax free and vat fill the amount", req: &CancelPaymentRequest{Amount: KRW(1100), TaxFree: KRW(1000), Vat: KRW(100)}},
+		{name: "too much", req: &CancelPaymentRequest{Amount: KRW(40001)},
+			wantCode: codes.FailedPrecondition, wantReason: ErrorReason_ERROR_REASON_REFUND_EXCEEDS_PAYMENT},
+		{name: "nothing left", payment: &Payment{Status: PaymentStatus_PAYMENT_STATUS_CANCELED, Amount: KRW(50000)}, req: &CancelPaymentRequest{},
+			wantCode: codes.FailedPrecondition, wantReason: ErrorReason_ERROR_REASON_REFUND_EXCEEDS_PAYMENT},
+		{name: "zero", req: &CancelPaymentRequest{Amount: KRW(0)}, wantCode: codes.InvalidArgument},
+		{name: "negative", req: &CancelPaymentRequest{Amount: KRW(-1000)}, wantCode: codes.InvalidArgument},
+		{name: "other currency", req: &CancelPaymentRequest{Amount: usd}, wantCode: codes.InvalidArgument},
+		{name: "vat in other currency", req: &CancelPaymentRequest{Amount: KRW(1000), Vat: usd}, wantCode: codes.InvalidArgument},
+		{name: "negative tax free", req: &CancelPaymentRequest{Amount: KRW(1000), TaxFree: KRW(-1)}, wantCode: codes.InvalidArgument},
+		{name: "vat over amount", req: &CancelPaymentRequest{Amount: KRW(1000), Vat: KRW(1001)}, wantCode: codes.InvalidArgument},
+		{name: "tax free and vat over amount", req: &CancelPaymentRequest{Amount: KRW(1000), TaxFree: KRW(600), Vat: KRW(500)}, wantCode: codes.InvalidArgument},
+		{name: "tax free over the rest", req: &CancelPaymentRequest{TaxFree: KRW(40001)}, wantCode: codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := tt.payment
+			if p == nil {
+				p = approvedPayment()
+			}
+			err := p.CheckCancel(tt.req)
+			if status.Code(err) != tt.wantCode || ErrorReasonOf(err) != tt.wantReason {
+				t.Errorf("CheckCancel() = %v (%v), want %v (%v)", err, ErrorReasonOf(err), tt.wantCode, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestNewKakaoPaymentCancelRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		payment *Payment
+		req     *CancelPaymentRequest
+		want    *KakaoCancelRequest
+	}{
+		{
+			name: "partial",
+			req:  &CancelPaymentRequest{Amount: KRW(11000), Vat: KRW(1000)},
+			want: &KakaoCancelRequest{
+				PartnerOrderId: "o-1", Cid: "TCSUBSCRIP",
+				Cancel: KRW(11000), CancelAmount: "11000",
+				CancelTaxFree: KRW(0),
+				CancelVat:     KRW(1000), CancelVatAmount: 1000,
+				CancelAvailable: KRW(40000), CancelAvailableAmount: 40000,
+			},
+		},
+		{
+			name: "rest with vat left to Kakao Pay",
+			req:  &CancelPaymentRequest{TaxFree: KRW(5000), CancelAvailable: KRW(40000)},
+			want: &KakaoCancelRequest{
+				PartnerOrderId: "o-1", Cid: "TCSUBSCRIP",
+				Cancel: KRW(40000), CancelAmount: "40000",
+				CancelTaxFree: KRW(5000), CancelTaxFreeAmount: 5000,
+				CancelAvailable: KRW(40000), CancelAvailableAmount: 40000,
+			},
+		},
+		{
+			name:    "Toss payment",
+			payment: &Payment{Id: "pay-2", Provider: PaymentProvider_PAYMENT_PROVIDER_TOSS_PAYMENTS},
+			req:     &CancelPaymentRequest{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := tt.payment
+			if p == nil {
+				p = approvedPayment()
+			}
+			got, err := NewKakaoPaymentCancelRequest(p, tt.req)
+			if tt.want == nil {
+				if status.Code(err) != codes.InvalidArgument {
+					t.Errorf("NewKakaoPaymentCancelRequest() = %v, %v, want InvalidArgument", got, err)
+				}
+				return
+			}
+			if err != nil || !proto.Equal(got, tt.want) {
+				t.Errorf("NewKakaoPaymentCancelRequest() = %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+}
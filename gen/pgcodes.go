@@ -0,0 +1,92 @@
+package gen
+
+import (
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// codeTable maps the values of enum E to and from the external codes set by
+// one EnumValueOptions extension in codes.proto.
+type codeTable[E ~int32] struct {
+	to   map[E]string
+	from map[string]E
+}
+
+// lazyCodeTable builds the table on first use; the descriptors are not
+// initialized yet when package-level variables are.
+func lazyCodeTable[E interface {
+	~int32
+	Descriptor() protoreflect.EnumDescriptor
+}](ext protoreflect.ExtensionType) func() codeTable[E] {
+	return sync.OnceValue(func() codeTable[E] {
+		var zero E
+		return newCodeTable[E](zero.Descriptor(), ext)
+	})
+}
+
+func newCodeTable[E ~int32](ed protoreflect.EnumDescriptor, ext protoreflect.ExtensionType) codeTable[E] {
+	t := codeTable[E]{to: make(map[E]string), from: make(map[string]E)}
+	values := ed.Values()
+	for i := 0; i < values.Len(); i++ {
+		v := values.Get(i)
+		opts, _ := v.Options().(*descriptorpb.EnumValueOptions)
+		code, _ := proto.GetExtension(opts, ext).(string)
+		if code == "" {
+			continue
+		}
+		if _, dup := t.from[code]; dup {
+			panic("gen: duplicate " + string(ext.TypeDescriptor().Name()) + " " + code + " in " + string(ed.Name()))
+		}
+		t.to[E(v.Number())] = code
+		t.from[code] = E(v.Number())
+	}
+	return t
+}
+
+func (t codeTable[E]) code(v E) string { return t.to[v] }
+
+func (t codeTable[E]) value(code string) (E, bool) {
+	v, ok := t.from[code]
+	return v, ok
+}
+
+var (
+	cardKakaoCodes      = lazyCodeTable[CardCompany](E_KakaoCode)
+	cardTossCodes       = lazyCodeTable[CardCompany](E_TossCode)
+	bankTossCodes       = lazyCodeTable[Bank](E_TossCode)
+	bankKFTCCodes       = lazyCodeTable[Bank](E_KftcCode)
+	carrierTrackerCodes = lazyCodeTable[Carrier](E_TrackerCode)
+)
+
+// KakaoCode returns Kakao Pay's issuer code for c, or "" if it has none.
+func (c CardCompany) KakaoCode() string { return cardKakaoCodes().code(c) }
+
+// TossCode returns Toss Payments' issuer code for c, or "" if it has none.
+func (c CardCompany) TossCode() string { return cardTossCodes().code(c) }
+
+// CardCompanyFromKakao returns the card company of a Kakao Pay issuer code.
+func CardCompanyFromKakao(code string) (CardCompany, bool) { return cardKakaoCodes().value(code) }
+
+// CardCompanyFromToss returns the card company of a Toss Payments issuer code.
+func CardCompanyFromToss(code string) (CardCompany, bool) { return cardTossCodes().value(code) }
+
+// TossCode returns Toss Payments' two-digit bank code for b.
+func (b Bank) TossCode() string { return bankTossCodes().code(b) }
+
+// KFTCCode returns the three-digit KFTC standard bank code for b.
+func (b Bank) KFTCCode() string { return bankKFTCCodes().code(b) }
+
+// BankFromToss returns the bank of a Toss Payments bank code.
+func BankFromToss(code string) (Bank, bool) { return bankTossCodes().value(code) }
+
+// BankFromKFTC returns the bank of a KFTC standard bank code.
+func BankFromKFTC(code string) (Bank, bool) { return bankKFTCCodes().value(code) }
+
+// TrackerCode returns the Sweet Tracker carrier code for c.
+func (c Carrier) TrackerCode() string { return carrierTrackerCodes().code(c) }
+
+// CarrierFromTrackerCode returns the carrier of a Sweet Tracker carrier code.
+func CarrierFromTrackerCode(code string) (Carrier, bool) { return carrierTrackerCodes().value(code) }
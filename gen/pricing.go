@@ -0,0 +1,91 @@
+package gen
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// UnitPrice returns the per-unit price of p when quantity units are ordered.
+// The tier with the highest MinQuantity not exceeding quantity wins; without a
+// matching tier the list price applies. Prices are read through
+// EffectiveListPrice and PriceTier.EffectivePrice, so products written before
+// the Money fields still price correctly. Servers apply it through
+// PriceOrderRequest.FillUnitPrices and InsertOrderRequest.CheckItemPrices so
+// every service applies the same price breaks.
+func (p *Product) UnitPrice(quantity int32) *Money {
+	price := p.EffectiveListPrice()
+	best := int32(0)
+	for _, t := range p.GetPriceTiers() {
+		if t.GetMinQuantity() <= quantity && t.GetMinQuantity() > best {
+			best = t.GetMinQuantity()
+			price = t.EffectivePrice()
+		}
+	}
+	return price
+}
+
+// LineTotal returns the price of quantity units of p with tiered pricing applied.
+func (p *Product) LineTotal(quantity int32) (*Money, error) {
+	return p.UnitPrice(quantity).Mul(int64(quantity))
+}
+
+// FillUnitPrices sets the unit_price of every line to the tiered UnitPrice of
+// its product, looked up by ID in products. PriceOrder servers call it before
+// applying promotions. Since PriceOrder only requires orders:read, a line may
+// not choose its own price: a unit_price that differs from the product's, or
+// a quantity below one, yields an InvalidArgument error. A line for a product
+// missing from products yields a NotFound error.
+func (r *PriceOrderRequest) FillUnitPrices(products map[string]*Product) error {
+	for _, line := range r.GetItems() {
+		if err := checkQuantity(line.GetProductId(), line.GetQuantity()); err != nil {
+			return err
+		}
+		p, ok := products[line.GetProductId()]
+		if !ok {
+			return status.Errorf(codes.NotFound, "product %q not found", line.GetProductId())
+		}
+		want := p.UnitPrice(line.GetQuantity())
+		if got := line.GetUnitPrice(); got != nil && !proto.Equal(got, want) {
+			return status.Errorf(codes.InvalidArgument, "unit_price of %q is %s, want %s for quantity %d",
+				line.GetProductId(), got.Format(), want.Format(), line.GetQuantity())
+		}
+		line.UnitPrice = want
+	}
+	return nil
+}
+
+// CheckItemPrices returns an InvalidArgument error if an item's quantity is
+// below one or its product_price differs from the tiered UnitPrice of its
+// product for the ordered quantity, so InsertOrder servers never record a
+// price the client made up. Bundle items are priced by the bundle and only
+// their quantity is checked. A product missing from products yields a
+// NotFound error.
+func (r *InsertOrderRequest) CheckItemPrices(products map[string]*Product) error {
+	for _, it := range r.GetItems() {
+		if err := checkQuantity(it.GetProductId(), it.GetQuantity()); err != nil {
+			return err
+		}
+		if it.GetBundleId() != "" {
+			continue
+		}
+		p, ok := products[it.GetProductId()]
+		if !ok {
+			return status.Errorf(codes.NotFound, "product %q not found", it.GetProductId())
+		}
+		// product_price is in KRW, so a product priced in another currency
+		// never matches.
+		if got, want := KRW(it.GetProductPrice()), p.UnitPrice(it.GetQuantity()); !proto.Equal(got, want) {
+			return status.Errorf(codes.InvalidArgument, "product_price of %q is %s, want %s for quantity %d",
+				it.GetProductId(), got.Format(), want.Format(), it.GetQuantity())
+		}
+	}
+	return nil
+}
+
+func checkQuantity(productID string, quantity int32) error {
+	if quantity < 1 {
+		return status.Errorf(codes.InvalidArgument, "quantity of %q is %d, want at least 1", productID, quantity)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package gen
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// tieredProduct mixes Money prices with a tier written before them.
+func tieredProduct() *Product {
+	return &Product{
+		Id:        "p1",
+		ListPrice: KRW(10000),
+		PriceTiers: []*PriceTier{
+			{MinQuantity: 100, Price: KRW(8000)},
+			{MinQuantity: 10, UnitPrice: 9000},
+		},
+	}
+}
+
+func TestUnitPrice(t *testing.T) {
+	tests := []struct {
+		quantity  int32
+		wantUnit  int64
+		wantTotal int64
+	}{
+		{1, 10000, 10000},
+		{9, 10000, 90000},
+		{10, 9000, 90000},
+		{99, 9000, 891000},
+		{100, 8000, 800000},
+		{0, 10000, 0},
+	}
+	p := tieredProduct()
+	for _, tt := range tests {
+		if got := p.UnitPrice(tt.quantity); !proto.Equal(got, KRW(tt.wantUnit)) {
+			t.Errorf("UnitPrice(%d) = %v, want %d", tt.quantity, got, tt.wantUnit)
+		}
+		if got, err := p.LineTotal(tt.quantity); err != nil || !proto.Equal(got, KRW(tt.wantTotal)) {
+			t.Errorf("LineTotal(%d) = %v, %v, want %d", tt.quantity, got, err, tt.wantTotal)
+		}
+	}
+
+	legacy := &Product{Price: 7000}
+	if got := legacy.UnitPrice(1); !proto.Equal(got, KRW(7000)) {
+		t.Errorf("UnitPrice() of a legacy product = %v, want 7000", got)
+	}
+}
+
+func TestFillUnitPrices(t *testing.T) {
+	products := map[string]*Product{"p1": tieredProduct()}
+	tests := []struct {
+		name string
+		line *PriceLineInput
+		want *Money
+		code codes.Code
+	}{
+		{name: "filled", line: &PriceLineInput{ProductId: "p1", Quantity: 10}, want: KRW(9000)},
+		{name: "matching price", line: &PriceLineInput{ProductId: "p1", Quantity: 1, UnitPrice: KRW(10000)}, want: KRW(10000)},
+		{name: "made up price", line: &PriceLineInput{ProductId: "p1", Quantity: 1, UnitPrice: KRW(500)}, code: codes.InvalidArgument},
+		{name: "list price above tier", line: &PriceLineInput{ProductId: "p1", Quantity: 10, UnitPrice: KRW(10000)}, code: codes.InvalidArgument},
+		{name: "other currency", line: &PriceLineInput{ProductId: "p1", Quantity: 1, UnitPrice: &Money{CurrencyCode: "USD", Units: 10000}}, code: codes.InvalidArgument},
+		{name: "zero quantity", line: &PriceLineInput{ProductId: "p1"}, code: codes.InvalidArgument},
+		{name: "negative quantity", line: &PriceLineInput{ProductId: "p1", Quantity: -3}, code: codes.InvalidArgument},
+		{name: "unknown product", line: &PriceLineInput{ProductId: "nope", Quantity: 1}, code: codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &PriceOrderRequest{Items: []*PriceLineInput{tt.line}}
+			err := req.FillUnitPrices(products)
+			if status.Code(err) != tt.code {
+				t.Fatalf("FillUnitPrices() = %v, want %v", err, tt.code)
+			}
+			if got := req.GetItems()[0].GetUnitPrice(); err == nil && !proto.Equal(got, tt.want) {
+				t.Errorf("unit_price = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckItemPrices(t *testing.T) {
+	products := map[string]*Product{
+		"p1":  tieredProduct(),
+		"usd": {ListPrice: &Money{CurrencyCode: "USD", Units: 10}},
+	}
+	tests := []struct {
+		name string
+		item *InsertOrderItem
+		want codes.Code
+	}{
+		{"list price", &InsertOrderItem{ProductId: "p1", Quantity: 1, ProductPrice: 10000}, codes.OK},
+		{"tier price", &InsertOrderItem{ProductId: "p1", Quantity: 10, ProductPrice: 9000}, codes.OK},
+		{"list price above tier", &InsertOrderItem{ProductId: "p1", Quantity: 10, ProductPrice: 10000}, codes.InvalidArgument},
+		{"made up price", &InsertOrderItem{ProductId: "p1", Quantity: 1, ProductPrice: 1}, codes.InvalidArgument},
+		{"bundle item", &InsertOrderItem{ProductId: "b1", BundleId: "b1", Quantity: 1, ProductPrice: 1}, codes.OK},
+		{"unknown product", &InsertOrderItem{ProductId: "nope", Quantity: 1}, codes.NotFound},
+		{"non-KRW product", &InsertOrderItem{ProductId: "usd", Quantity: 1, ProductPrice: 10}, codes.InvalidArgument},
+		{"zero quantity", &InsertOrderItem{ProductId: "p1", ProductPrice: 10000}, codes.InvalidArgument},
+		{"negative quantity", &InsertOrderItem{ProductId: "p1", Quantity: -1, ProductPrice: 10000}, codes.InvalidArgument},
+		{"negative bundle quantity", &InsertOrderItem{ProductId: "b1", BundleId: "b1", Quantity: -1}, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &InsertOrderRequest{Items: []*InsertOrderItem{tt.item}}
+			if got := status.Code(req.CheckItemPrices(products)); got != tt.want {
+				t.Errorf("CheckItemPrices() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -24,18 +25,26 @@ const (
 
 // 상품 정보
 type Product struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
-	Price         int64                  `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
-	ImageUrl      string                 `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
-	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	OptionsJson   string                 `protobuf:"bytes,9,opt,name=options_json,json=optionsJson,proto3" json:"options_json,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name     string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Category string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	// Deprecated: Marked as deprecated in product.proto.
+	Price          int64        `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"` // KRW 원 단위, list_price로 대체됨
+	ImageUrl       string       `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Description    string       `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt      string       `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      string       `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	OptionsJson    string       `protobuf:"bytes,9,opt,name=options_json,json=optionsJson,proto3" json:"options_json,omitempty"`
+	PriceTiers     []*PriceTier `protobuf:"bytes,10,rep,name=price_tiers,json=priceTiers,proto3" json:"price_tiers,omitempty"`                // 수량별 할인 단가 (B2B/도매), 비어 있으면 list_price 고정
+	MaxPerCustomer int32        `protobuf:"varint,11,opt,name=max_per_customer,json=maxPerCustomer,proto3" json:"max_per_customer,omitempty"` // 고객당 최대 구매 수량, 0이면 제한 없음
+	ListPrice      *Money       `protobuf:"bytes,12,opt,name=list_price,json=listPrice,proto3" json:"list_price,omitempty"`                   // 정가
+	// 미설정과 0이 다른 값: 미설정은 기본값 적용, 0은 명시적 0
+	WeightGrams         *int32 `protobuf:"varint,13,opt,name=weight_grams,json=weightGrams,proto3,oneof" json:"weight_grams,omitempty"`                           // 포장 무게 (g), 미설정 시 카테고리 기본 무게로 배송비 계산
+	DiscountBasisPoints *int32 `protobuf:"varint,14,opt,name=discount_basis_points,json=discountBasisPoints,proto3,oneof" json:"discount_basis_points,omitempty"` // 상시 할인율 (1 = 0.01%, DiscountKRW), 미설정 시 할인 없음
+	ShippingFee         *Money `protobuf:"bytes,15,opt,name=shipping_fee,json=shippingFee,proto3" json:"shipping_fee,omitempty"`                                  // 상품별 배송비, 미설정 시 기본 배송비 정책 (0원은 무료배송)
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *Product) Reset() {
@@ -89,6 +98,7 @@ func (x *Product) GetCategory() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in product.proto.
 func (x *Product) GetPrice() int64 {
 	if x != nil {
 		return x.Price
@@ -131,16 +141,127 @@ func (x *Product) GetOptionsJson() string {
 	return ""
 }
 
+func (x *Product) GetPriceTiers() []*PriceTier {
+	if x != nil {
+		return x.PriceTiers
+	}
+	return nil
+}
+
+func (x *Product) GetMaxPerCustomer() int32 {
+	if x != nil {
+		return x.MaxPerCustomer
+	}
+	return 0
+}
+
+func (x *Product) GetListPrice() *Money {
+	if x != nil {
+		return x.ListPrice
+	}
+	return nil
+}
+
+func (x *Product) GetWeightGrams() int32 {
+	if x != nil && x.WeightGrams != nil {
+		return *x.WeightGrams
+	}
+	return 0
+}
+
+func (x *Product) GetDiscountBasisPoints() int32 {
+	if x != nil && x.DiscountBasisPoints != nil {
+		return *x.DiscountBasisPoints
+	}
+	return 0
+}
+
+func (x *Product) GetShippingFee() *Money {
+	if x != nil {
+		return x.ShippingFee
+	}
+	return nil
+}
+
+// 수량 구간별 단가: 주문 수량이 min_quantity 이상이면 price 적용
+type PriceTier struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	MinQuantity int32                  `protobuf:"varint,1,opt,name=min_quantity,json=minQuantity,proto3" json:"min_quantity,omitempty"`
+	// Deprecated: Marked as deprecated in product.proto.
+	UnitPrice     int64  `protobuf:"varint,2,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"` // KRW 원 단위, price로 대체됨
+	Price         *Money `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`                           // 구간 단가
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceTier) Reset() {
+	*x = PriceTier{}
+	mi := &file_product_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceTier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceTier) ProtoMessage() {}
+
+func (x *PriceTier) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceTier.ProtoReflect.Descriptor instead.
+func (*PriceTier) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PriceTier) GetMinQuantity() int32 {
+	if x != nil {
+		return x.MinQuantity
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in product.proto.
+func (x *PriceTier) GetUnitPrice() int64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *PriceTier) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
 // 전체 상품 목록 요청 (필터 없음)
 type GetProductsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 응답에 포함할 Product 필드 (ex: "id,name,price,image_url"), 비어 있으면 전체 필드
+	ReadMask *fieldmaskpb.FieldMask `protobuf:"bytes,1,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	// 페이지 크기 (0이면 서버 기본값, 최대 100)
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// 이전 응답의 next_page_token, 첫 페이지는 비워 둠
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetProductsRequest) Reset() {
 	*x = GetProductsRequest{}
-	mi := &file_product_proto_msgTypes[1]
+	mi := &file_product_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -152,7 +273,7 @@ func (x *GetProductsRequest) String() string {
 func (*GetProductsRequest) ProtoMessage() {}
 
 func (x *GetProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[1]
+	mi := &file_product_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -165,19 +286,44 @@ func (x *GetProductsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProductsRequest.ProtoReflect.Descriptor instead.
 func (*GetProductsRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{1}
+	return file_product_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetProductsRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+func (x *GetProductsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetProductsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
 }
 
 type GetProductsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Products []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	// 다음 페이지 토큰, 마지막 페이지면 빈 문자열
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// 전체 상품 수
+	TotalCount    int32 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetProductsResponse) Reset() {
 	*x = GetProductsResponse{}
-	mi := &file_product_proto_msgTypes[2]
+	mi := &file_product_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -189,7 +335,7 @@ func (x *GetProductsResponse) String() string {
 func (*GetProductsResponse) ProtoMessage() {}
 
 func (x *GetProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[2]
+	mi := &file_product_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -202,7 +348,7 @@ func (x *GetProductsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProductsResponse.ProtoReflect.Descriptor instead.
 func (*GetProductsResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{2}
+	return file_product_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetProductsResponse) GetProducts() []*Product {
@@ -212,6 +358,20 @@ func (x *GetProductsResponse) GetProducts() []*Product {
 	return nil
 }
 
+func (x *GetProductsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GetProductsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
 // ID로 상품 조회 요청
 type GetProductByIDRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -222,7 +382,7 @@ type GetProductByIDRequest struct {
 
 func (x *GetProductByIDRequest) Reset() {
 	*x = GetProductByIDRequest{}
-	mi := &file_product_proto_msgTypes[3]
+	mi := &file_product_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -234,7 +394,7 @@ func (x *GetProductByIDRequest) String() string {
 func (*GetProductByIDRequest) ProtoMessage() {}
 
 func (x *GetProductByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[3]
+	mi := &file_product_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -247,7 +407,7 @@ func (x *GetProductByIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProductByIDRequest.ProtoReflect.Descriptor instead.
 func (*GetProductByIDRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{3}
+	return file_product_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetProductByIDRequest) GetId() string {
@@ -266,7 +426,7 @@ type GetProductByIDResponse struct {
 
 func (x *GetProductByIDResponse) Reset() {
 	*x = GetProductByIDResponse{}
-	mi := &file_product_proto_msgTypes[4]
+	mi := &file_product_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -278,7 +438,7 @@ func (x *GetProductByIDResponse) String() string {
 func (*GetProductByIDResponse) ProtoMessage() {}
 
 func (x *GetProductByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[4]
+	mi := &file_product_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -291,7 +451,7 @@ func (x *GetProductByIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProductByIDResponse.ProtoReflect.Descriptor instead.
 func (*GetProductByIDResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{4}
+	return file_product_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetProductByIDResponse) GetProduct() *Product {
@@ -303,20 +463,25 @@ func (x *GetProductByIDResponse) GetProduct() *Product {
 
 // 상품 추가 요청
 type PostProductsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Category      int64                  `protobuf:"varint,2,opt,name=category,proto3" json:"category,omitempty"`
-	Price         int64                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
-	ImageUrl      string                 `protobuf:"bytes,4,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
-	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	OptionsJson   string                 `protobuf:"bytes,6,opt,name=options_json,json=optionsJson,proto3" json:"options_json,omitempty"` // JSON 문자열로 옵션 전달
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Name                string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Category            int64                  `protobuf:"varint,2,opt,name=category,proto3" json:"category,omitempty"`
+	Price               int64                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	ImageUrl            string                 `protobuf:"bytes,4,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Description         string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	OptionsJson         string                 `protobuf:"bytes,6,opt,name=options_json,json=optionsJson,proto3" json:"options_json,omitempty"` // JSON 문자열로 옵션 전달
+	PriceTiers          []*PriceTier           `protobuf:"bytes,7,rep,name=price_tiers,json=priceTiers,proto3" json:"price_tiers,omitempty"`
+	MaxPerCustomer      int32                  `protobuf:"varint,8,opt,name=max_per_customer,json=maxPerCustomer,proto3" json:"max_per_customer,omitempty"`
+	WeightGrams         *int32                 `protobuf:"varint,9,opt,name=weight_grams,json=weightGrams,proto3,oneof" json:"weight_grams,omitempty"`
+	DiscountBasisPoints *int32                 `protobuf:"varint,10,opt,name=discount_basis_points,json=discountBasisPoints,proto3,oneof" json:"discount_basis_points,omitempty"`
+	ShippingFee         *Money                 `protobuf:"bytes,11,opt,name=shipping_fee,json=shippingFee,proto3" json:"shipping_fee,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *PostProductsRequest) Reset() {
 	*x = PostProductsRequest{}
-	mi := &file_product_proto_msgTypes[5]
+	mi := &file_product_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -328,7 +493,7 @@ func (x *PostProductsRequest) String() string {
 func (*PostProductsRequest) ProtoMessage() {}
 
 func (x *PostProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[5]
+	mi := &file_product_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -341,7 +506,7 @@ func (x *PostProductsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PostProductsRequest.ProtoReflect.Descriptor instead.
 func (*PostProductsRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{5}
+	return file_product_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *PostProductsRequest) GetName() string {
@@ -386,6 +551,41 @@ func (x *PostProductsRequest) GetOptionsJson() string {
 	return ""
 }
 
+func (x *PostProductsRequest) GetPriceTiers() []*PriceTier {
+	if x != nil {
+		return x.PriceTiers
+	}
+	return nil
+}
+
+func (x *PostProductsRequest) GetMaxPerCustomer() int32 {
+	if x != nil {
+		return x.MaxPerCustomer
+	}
+	return 0
+}
+
+func (x *PostProductsRequest) GetWeightGrams() int32 {
+	if x != nil && x.WeightGrams != nil {
+		return *x.WeightGrams
+	}
+	return 0
+}
+
+func (x *PostProductsRequest) GetDiscountBasisPoints() int32 {
+	if x != nil && x.DiscountBasisPoints != nil {
+		return *x.DiscountBasisPoints
+	}
+	return 0
+}
+
+func (x *PostProductsRequest) GetShippingFee() *Money {
+	if x != nil {
+		return x.ShippingFee
+	}
+	return nil
+}
+
 type PostProductsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
@@ -395,7 +595,7 @@ type PostProductsResponse struct {
 
 func (x *PostProductsResponse) Reset() {
 	*x = PostProductsResponse{}
-	mi := &file_product_proto_msgTypes[6]
+	mi := &file_product_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -407,7 +607,7 @@ func (x *PostProductsResponse) String() string {
 func (*PostProductsResponse) ProtoMessage() {}
 
 func (x *PostProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[6]
+	mi := &file_product_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -420,7 +620,7 @@ func (x *PostProductsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PostProductsResponse.ProtoReflect.Descriptor instead.
 func (*PostProductsResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{6}
+	return file_product_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *PostProductsResponse) GetMessage() string {
@@ -430,43 +630,799 @@ func (x *PostProductsResponse) GetMessage() string {
 	return ""
 }
 
-var File_product_proto protoreflect.FileDescriptor
+// 상품 부분 수정 내용: 모든 필드가 presence를 가지며, 설정된 필드만 적용
+// (ex: {"maxPerCustomer": 0}은 구매 제한 해제, 필드 생략은 변경 없음)
+type ProductPatch struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Name                *string                `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Category            *string                `protobuf:"bytes,2,opt,name=category,proto3,oneof" json:"category,omitempty"`
+	ImageUrl            *string                `protobuf:"bytes,3,opt,name=image_url,json=imageUrl,proto3,oneof" json:"image_url,omitempty"`
+	Description         *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	OptionsJson         *string                `protobuf:"bytes,5,opt,name=options_json,json=optionsJson,proto3,oneof" json:"options_json,omitempty"`
+	PriceTiers          *PriceTierList         `protobuf:"bytes,6,opt,name=price_tiers,json=priceTiers,proto3" json:"price_tiers,omitempty"` // 설정 시 목록 전체를 교체 (빈 목록은 삭제)
+	MaxPerCustomer      *int32                 `protobuf:"varint,7,opt,name=max_per_customer,json=maxPerCustomer,proto3,oneof" json:"max_per_customer,omitempty"`
+	ListPrice           *Money                 `protobuf:"bytes,8,opt,name=list_price,json=listPrice,proto3" json:"list_price,omitempty"`
+	WeightGrams         *int32                 `protobuf:"varint,9,opt,name=weight_grams,json=weightGrams,proto3,oneof" json:"weight_grams,omitempty"`
+	DiscountBasisPoints *int32                 `protobuf:"varint,10,opt,name=discount_basis_points,json=discountBasisPoints,proto3,oneof" json:"discount_basis_points,omitempty"`
+	ShippingFee         *Money                 `protobuf:"bytes,11,opt,name=shipping_fee,json=shippingFee,proto3" json:"shipping_fee,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
 
-const file_product_proto_rawDesc = "" +
-	"\n" +
-	"\rproduct.proto\x12\x17go.escape.ship.proto.v1\x1a\x1cgoogle/api/annotations.proto\"\xff\x01\n" +
-	"\aProduct\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
-	"\bcategory\x18\x03 \x01(\tR\bcategory\x12\x14\n" +
-	"\x05price\x18\x04 \x01(\x03R\x05price\x12\x1b\n" +
-	"\timage_url\x18\x05 \x01(\tR\bimageUrl\x12 \n" +
-	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1d\n" +
-	"\n" +
-	"updated_at\x18\b \x01(\tR\tupdatedAt\x12!\n" +
-	"\foptions_json\x18\t \x01(\tR\voptionsJson\"\x14\n" +
-	"\x12GetProductsRequest\"S\n" +
-	"\x13GetProductsResponse\x12<\n" +
-	"\bproducts\x18\x01 \x03(\v2 .go.escape.ship.proto.v1.ProductR\bproducts\"'\n" +
-	"\x15GetProductByIDRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"T\n" +
-	"\x16GetProductByIDResponse\x12:\n" +
-	"\aproduct\x18\x01 \x01(\v2 .go.escape.ship.proto.v1.ProductR\aproduct\"\xbd\x01\n" +
-	"\x13PostProductsRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
-	"\bcategory\x18\x02 \x01(\x03R\bcategory\x12\x14\n" +
-	"\x05price\x18\x03 \x01(\x03R\x05price\x12\x1b\n" +
-	"\timage_url\x18\x04 \x01(\tR\bimageUrl\x12 \n" +
-	"\vdescription\x18\x05 \x01(\tR\vdescription\x12!\n" +
-	"\foptions_json\x18\x06 \x01(\tR\voptionsJson\"0\n" +
-	"\x14PostProductsResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage2\x9d\x03\n" +
+func (x *ProductPatch) Reset() {
+	*x = ProductPatch{}
+	mi := &file_product_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductPatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductPatch) ProtoMessage() {}
+
+func (x *ProductPatch) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductPatch.ProtoReflect.Descriptor instead.
+func (*ProductPatch) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ProductPatch) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *ProductPatch) GetCategory() string {
+	if x != nil && x.Category != nil {
+		return *x.Category
+	}
+	return ""
+}
+
+func (x *ProductPatch) GetImageUrl() string {
+	if x != nil && x.ImageUrl != nil {
+		return *x.ImageUrl
+	}
+	return ""
+}
+
+func (x *ProductPatch) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *ProductPatch) GetOptionsJson() string {
+	if x != nil && x.OptionsJson != nil {
+		return *x.OptionsJson
+	}
+	return ""
+}
+
+func (x *ProductPatch) GetPriceTiers() *PriceTierList {
+	if x != nil {
+		return x.PriceTiers
+	}
+	return nil
+}
+
+func (x *ProductPatch) GetMaxPerCustomer() int32 {
+	if x != nil && x.MaxPerCustomer != nil {
+		return *x.MaxPerCustomer
+	}
+	return 0
+}
+
+func (x *ProductPatch) GetListPrice() *Money {
+	if x != nil {
+		return x.ListPrice
+	}
+	return nil
+}
+
+func (x *ProductPatch) GetWeightGrams() int32 {
+	if x != nil && x.WeightGrams != nil {
+		return *x.WeightGrams
+	}
+	return 0
+}
+
+func (x *ProductPatch) GetDiscountBasisPoints() int32 {
+	if x != nil && x.DiscountBasisPoints != nil {
+		return *x.DiscountBasisPoints
+	}
+	return 0
+}
+
+func (x *ProductPatch) GetShippingFee() *Money {
+	if x != nil {
+		return x.ShippingFee
+	}
+	return nil
+}
+
+// repeated 필드를 patch에서 교체할 때 사용하는 래퍼
+type PriceTierList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tiers         []*PriceTier           `protobuf:"bytes,1,rep,name=tiers,proto3" json:"tiers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceTierList) Reset() {
+	*x = PriceTierList{}
+	mi := &file_product_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceTierList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceTierList) ProtoMessage() {}
+
+func (x *PriceTierList) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceTierList.ProtoReflect.Descriptor instead.
+func (*PriceTierList) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PriceTierList) GetTiers() []*PriceTier {
+	if x != nil {
+		return x.Tiers
+	}
+	return nil
+}
+
+type UpdateProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Patch         *ProductPatch          `protobuf:"bytes,2,opt,name=patch,proto3" json:"patch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductRequest) Reset() {
+	*x = UpdateProductRequest{}
+	mi := &file_product_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductRequest) ProtoMessage() {}
+
+func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateProductRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetPatch() *ProductPatch {
+	if x != nil {
+		return x.Patch
+	}
+	return nil
+}
+
+type UpdateProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductResponse) Reset() {
+	*x = UpdateProductResponse{}
+	mi := &file_product_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductResponse) ProtoMessage() {}
+
+func (x *UpdateProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProductResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// 번들(세트) 구성 상품
+type BundleComponent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BundleComponent) Reset() {
+	*x = BundleComponent{}
+	mi := &file_product_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BundleComponent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BundleComponent) ProtoMessage() {}
+
+func (x *BundleComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BundleComponent.ProtoReflect.Descriptor instead.
+func (*BundleComponent) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BundleComponent) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *BundleComponent) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// 번들(세트) 상품: 여러 상품을 묶어 하나의 가격으로 판매
+type Bundle struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	BundlePrice   int64                  `protobuf:"varint,3,opt,name=bundle_price,json=bundlePrice,proto3" json:"bundle_price,omitempty"`
+	Components    []*BundleComponent     `protobuf:"bytes,4,rep,name=components,proto3" json:"components,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Bundle) Reset() {
+	*x = Bundle{}
+	mi := &file_product_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Bundle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bundle) ProtoMessage() {}
+
+func (x *Bundle) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bundle.ProtoReflect.Descriptor instead.
+func (*Bundle) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Bundle) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Bundle) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Bundle) GetBundlePrice() int64 {
+	if x != nil {
+		return x.BundlePrice
+	}
+	return 0
+}
+
+func (x *Bundle) GetComponents() []*BundleComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *Bundle) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// 번들 전개 결과의 구성품 (출고/환불 시 사용)
+type ResolvedBundleComponent struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Product        *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	Quantity       int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	AllocatedPrice int64                  `protobuf:"varint,3,opt,name=allocated_price,json=allocatedPrice,proto3" json:"allocated_price,omitempty"` // bundle_price 중 이 구성품에 배분된 금액 (정상가 비율)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ResolvedBundleComponent) Reset() {
+	*x = ResolvedBundleComponent{}
+	mi := &file_product_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolvedBundleComponent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolvedBundleComponent) ProtoMessage() {}
+
+func (x *ResolvedBundleComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolvedBundleComponent.ProtoReflect.Descriptor instead.
+func (*ResolvedBundleComponent) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ResolvedBundleComponent) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *ResolvedBundleComponent) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ResolvedBundleComponent) GetAllocatedPrice() int64 {
+	if x != nil {
+		return x.AllocatedPrice
+	}
+	return 0
+}
+
+type CreateBundleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	BundlePrice   int64                  `protobuf:"varint,2,opt,name=bundle_price,json=bundlePrice,proto3" json:"bundle_price,omitempty"`
+	Components    []*BundleComponent     `protobuf:"bytes,3,rep,name=components,proto3" json:"components,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBundleRequest) Reset() {
+	*x = CreateBundleRequest{}
+	mi := &file_product_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBundleRequest) ProtoMessage() {}
+
+func (x *CreateBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBundleRequest.ProtoReflect.Descriptor instead.
+func (*CreateBundleRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateBundleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateBundleRequest) GetBundlePrice() int64 {
+	if x != nil {
+		return x.BundlePrice
+	}
+	return 0
+}
+
+func (x *CreateBundleRequest) GetComponents() []*BundleComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+type CreateBundleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bundle        *Bundle                `protobuf:"bytes,1,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBundleResponse) Reset() {
+	*x = CreateBundleResponse{}
+	mi := &file_product_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBundleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBundleResponse) ProtoMessage() {}
+
+func (x *CreateBundleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBundleResponse.ProtoReflect.Descriptor instead.
+func (*CreateBundleResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateBundleResponse) GetBundle() *Bundle {
+	if x != nil {
+		return x.Bundle
+	}
+	return nil
+}
+
+type ResolveBundleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BundleId      string                 `protobuf:"bytes,1,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveBundleRequest) Reset() {
+	*x = ResolveBundleRequest{}
+	mi := &file_product_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveBundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveBundleRequest) ProtoMessage() {}
+
+func (x *ResolveBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveBundleRequest.ProtoReflect.Descriptor instead.
+func (*ResolveBundleRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ResolveBundleRequest) GetBundleId() string {
+	if x != nil {
+		return x.BundleId
+	}
+	return ""
+}
+
+type ResolveBundleResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Bundle        *Bundle                    `protobuf:"bytes,1,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	Components    []*ResolvedBundleComponent `protobuf:"bytes,2,rep,name=components,proto3" json:"components,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveBundleResponse) Reset() {
+	*x = ResolveBundleResponse{}
+	mi := &file_product_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveBundleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveBundleResponse) ProtoMessage() {}
+
+func (x *ResolveBundleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveBundleResponse.ProtoReflect.Descriptor instead.
+func (*ResolveBundleResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ResolveBundleResponse) GetBundle() *Bundle {
+	if x != nil {
+		return x.Bundle
+	}
+	return nil
+}
+
+func (x *ResolveBundleResponse) GetComponents() []*ResolvedBundleComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+var File_product_proto protoreflect.FileDescriptor
+
+const file_product_proto_rawDesc = "" +
+	"\n" +
+	"\rproduct.proto\x12\x17go.escape.ship.proto.v1\x1a\fcommon.proto\x1a\x1cgoogle/api/annotations.proto\x1a google/protobuf/field_mask.proto\"\x80\x05\n" +
+	"\aProduct\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12\x18\n" +
+	"\x05price\x18\x04 \x01(\x03B\x02\x18\x01R\x05price\x12\x1b\n" +
+	"\timage_url\x18\x05 \x01(\tR\bimageUrl\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\tR\tupdatedAt\x12!\n" +
+	"\foptions_json\x18\t \x01(\tR\voptionsJson\x12C\n" +
+	"\vprice_tiers\x18\n" +
+	" \x03(\v2\".go.escape.ship.proto.v1.PriceTierR\n" +
+	"priceTiers\x12(\n" +
+	"\x10max_per_customer\x18\v \x01(\x05R\x0emaxPerCustomer\x12=\n" +
+	"\n" +
+	"list_price\x18\f \x01(\v2\x1e.go.escape.ship.proto.v1.MoneyR\tlistPrice\x12&\n" +
+	"\fweight_grams\x18\r \x01(\x05H\x00R\vweightGrams\x88\x01\x01\x127\n" +
+	"\x15discount_basis_points\x18\x0e \x01(\x05H\x01R\x13discountBasisPoints\x88\x01\x01\x12A\n" +
+	"\fshipping_fee\x18\x0f \x01(\v2\x1e.go.escape.ship.proto.v1.MoneyR\vshippingFeeB\x0f\n" +
+	"\r_weight_gramsB\x18\n" +
+	"\x16_discount_basis_points\"\x87\x01\n" +
+	"\tPriceTier\x12!\n" +
+	"\fmin_quantity\x18\x01 \x01(\x05R\vminQuantity\x12!\n" +
+	"\n" +
+	"unit_price\x18\x02 \x01(\x03B\x02\x18\x01R\tunitPrice\x124\n" +
+	"\x05price\x18\x03 \x01(\v2\x1e.go.escape.ship.proto.v1.MoneyR\x05price\"\x89\x01\n" +
+	"\x12GetProductsRequest\x127\n" +
+	"\tread_mask\x18\x01 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x9c\x01\n" +
+	"\x13GetProductsResponse\x12<\n" +
+	"\bproducts\x18\x01 \x03(\v2 .go.escape.ship.proto.v1.ProductR\bproducts\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"'\n" +
+	"\x15GetProductByIDRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"T\n" +
+	"\x16GetProductByIDResponse\x12:\n" +
+	"\aproduct\x18\x01 \x01(\v2 .go.escape.ship.proto.v1.ProductR\aproduct\"\xfb\x03\n" +
+	"\x13PostProductsRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\x03R\bcategory\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x03R\x05price\x12\x1b\n" +
+	"\timage_url\x18\x04 \x01(\tR\bimageUrl\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12!\n" +
+	"\foptions_json\x18\x06 \x01(\tR\voptionsJson\x12C\n" +
+	"\vprice_tiers\x18\a \x03(\v2\".go.escape.ship.proto.v1.PriceTierR\n" +
+	"priceTiers\x12(\n" +
+	"\x10max_per_customer\x18\b \x01(\x05R\x0emaxPerCustomer\x12&\n" +
+	"\fweight_grams\x18\t \x01(\x05H\x00R\vweightGrams\x88\x01\x01\x127\n" +
+	"\x15discount_basis_points\x18\n" +
+	" \x01(\x05H\x01R\x13discountBasisPoints\x88\x01\x01\x12A\n" +
+	"\fshipping_fee\x18\v \x01(\v2\x1e.go.escape.ship.proto.v1.MoneyR\vshippingFeeB\x0f\n" +
+	"\r_weight_gramsB\x18\n" +
+	"\x16_discount_basis_points\"0\n" +
+	"\x14PostProductsResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\x99\x05\n" +
+	"\fProductPatch\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x1f\n" +
+	"\bcategory\x18\x02 \x01(\tH\x01R\bcategory\x88\x01\x01\x12 \n" +
+	"\timage_url\x18\x03 \x01(\tH\x02R\bimageUrl\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x03R\vdescription\x88\x01\x01\x12&\n" +
+	"\foptions_json\x18\x05 \x01(\tH\x04R\voptionsJson\x88\x01\x01\x12G\n" +
+	"\vprice_tiers\x18\x06 \x01(\v2&.go.escape.ship.proto.v1.PriceTierListR\n" +
+	"priceTiers\x12-\n" +
+	"\x10max_per_customer\x18\a \x01(\x05H\x05R\x0emaxPerCustomer\x88\x01\x01\x12=\n" +
+	"\n" +
+	"list_price\x18\b \x01(\v2\x1e.go.escape.ship.proto.v1.MoneyR\tlistPrice\x12&\n" +
+	"\fweight_grams\x18\t \x01(\x05H\x06R\vweightGrams\x88\x01\x01\x127\n" +
+	"\x15discount_basis_points\x18\n" +
+	" \x01(\x05H\aR\x13discountBasisPoints\x88\x01\x01\x12A\n" +
+	"\fshipping_fee\x18\v \x01(\v2\x1e.go.escape.ship.proto.v1.MoneyR\vshippingFeeB\a\n" +
+	"\x05_nameB\v\n" +
+	"\t_categoryB\f\n" +
+	"\n" +
+	"_image_urlB\x0e\n" +
+	"\f_descriptionB\x0f\n" +
+	"\r_options_jsonB\x13\n" +
+	"\x11_max_per_customerB\x0f\n" +
+	"\r_weight_gramsB\x18\n" +
+	"\x16_discount_basis_points\"I\n" +
+	"\rPriceTierList\x128\n" +
+	"\x05tiers\x18\x01 \x03(\v2\".go.escape.ship.proto.v1.PriceTierR\x05tiers\"c\n" +
+	"\x14UpdateProductRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12;\n" +
+	"\x05patch\x18\x02 \x01(\v2%.go.escape.ship.proto.v1.ProductPatchR\x05patch\"S\n" +
+	"\x15UpdateProductResponse\x12:\n" +
+	"\aproduct\x18\x01 \x01(\v2 .go.escape.ship.proto.v1.ProductR\aproduct\"L\n" +
+	"\x0fBundleComponent\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\"\xb8\x01\n" +
+	"\x06Bundle\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12!\n" +
+	"\fbundle_price\x18\x03 \x01(\x03R\vbundlePrice\x12H\n" +
+	"\n" +
+	"components\x18\x04 \x03(\v2(.go.escape.ship.proto.v1.BundleComponentR\n" +
+	"components\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\"\x9a\x01\n" +
+	"\x17ResolvedBundleComponent\x12:\n" +
+	"\aproduct\x18\x01 \x01(\v2 .go.escape.ship.proto.v1.ProductR\aproduct\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12'\n" +
+	"\x0fallocated_price\x18\x03 \x01(\x03R\x0eallocatedPrice\"\x96\x01\n" +
+	"\x13CreateBundleRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\fbundle_price\x18\x02 \x01(\x03R\vbundlePrice\x12H\n" +
+	"\n" +
+	"components\x18\x03 \x03(\v2(.go.escape.ship.proto.v1.BundleComponentR\n" +
+	"components\"O\n" +
+	"\x14CreateBundleResponse\x127\n" +
+	"\x06bundle\x18\x01 \x01(\v2\x1f.go.escape.ship.proto.v1.BundleR\x06bundle\"3\n" +
+	"\x14ResolveBundleRequest\x12\x1b\n" +
+	"\tbundle_id\x18\x01 \x01(\tR\bbundleId\"\xa2\x01\n" +
+	"\x15ResolveBundleResponse\x127\n" +
+	"\x06bundle\x18\x01 \x01(\v2\x1f.go.escape.ship.proto.v1.BundleR\x06bundle\x12P\n" +
+	"\n" +
+	"components\x18\x02 \x03(\v20.go.escape.ship.proto.v1.ResolvedBundleComponentR\n" +
+	"components2\xd1\x06\n" +
 	"\x0eProductService\x12{\n" +
 	"\vGetProducts\x12+.go.escape.ship.proto.v1.GetProductsRequest\x1a,.go.escape.ship.proto.v1.GetProductsResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/products\x12\x89\x01\n" +
 	"\x0eGetProductByID\x12..go.escape.ship.proto.v1.GetProductByIDRequest\x1a/.go.escape.ship.proto.v1.GetProductByIDResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/products/{id}\x12\x81\x01\n" +
-	"\fPostProducts\x12,.go.escape.ship.proto.v1.PostProductsRequest\x1a-.go.escape.ship.proto.v1.PostProductsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/productsB#Z!github.com/escape-ship/protos/genb\x06proto3"
+	"\fPostProducts\x12,.go.escape.ship.proto.v1.PostProductsRequest\x1a-.go.escape.ship.proto.v1.PostProductsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/products\x12\x8d\x01\n" +
+	"\rUpdateProduct\x12-.go.escape.ship.proto.v1.UpdateProductRequest\x1a..go.escape.ship.proto.v1.UpdateProductResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x05patch2\x0e/products/{id}\x12\x89\x01\n" +
+	"\fCreateBundle\x12,.go.escape.ship.proto.v1.CreateBundleRequest\x1a-.go.escape.ship.proto.v1.CreateBundleResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/products/bundles\x12\x95\x01\n" +
+	"\rResolveBundle\x12-.go.escape.ship.proto.v1.ResolveBundleRequest\x1a..go.escape.ship.proto.v1.ResolveBundleResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/products/bundles/{bundle_id}B#Z!github.com/escape-ship/protos/genb\x06proto3"
 
 var (
 	file_product_proto_rawDescOnce sync.Once
@@ -480,30 +1436,69 @@ func file_product_proto_rawDescGZIP() []byte {
 	return file_product_proto_rawDescData
 }
 
-var file_product_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_product_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_product_proto_goTypes = []any{
-	(*Product)(nil),                // 0: go.escape.ship.proto.v1.Product
-	(*GetProductsRequest)(nil),     // 1: go.escape.ship.proto.v1.GetProductsRequest
-	(*GetProductsResponse)(nil),    // 2: go.escape.ship.proto.v1.GetProductsResponse
-	(*GetProductByIDRequest)(nil),  // 3: go.escape.ship.proto.v1.GetProductByIDRequest
-	(*GetProductByIDResponse)(nil), // 4: go.escape.ship.proto.v1.GetProductByIDResponse
-	(*PostProductsRequest)(nil),    // 5: go.escape.ship.proto.v1.PostProductsRequest
-	(*PostProductsResponse)(nil),   // 6: go.escape.ship.proto.v1.PostProductsResponse
+	(*Product)(nil),                 // 0: go.escape.ship.proto.v1.Product
+	(*PriceTier)(nil),               // 1: go.escape.ship.proto.v1.PriceTier
+	(*GetProductsRequest)(nil),      // 2: go.escape.ship.proto.v1.GetProductsRequest
+	(*GetProductsResponse)(nil),     // 3: go.escape.ship.proto.v1.GetProductsResponse
+	(*GetProductByIDRequest)(nil),   // 4: go.escape.ship.proto.v1.GetProductByIDRequest
+	(*GetProductByIDResponse)(nil),  // 5: go.escape.ship.proto.v1.GetProductByIDResponse
+	(*PostProductsRequest)(nil),     // 6: go.escape.ship.proto.v1.PostProductsRequest
+	(*PostProductsResponse)(nil),    // 7: go.escape.ship.proto.v1.PostProductsResponse
+	(*ProductPatch)(nil),            // 8: go.escape.ship.proto.v1.ProductPatch
+	(*PriceTierList)(nil),           // 9: go.escape.ship.proto.v1.PriceTierList
+	(*UpdateProductRequest)(nil),    // 10: go.escape.ship.proto.v1.UpdateProductRequest
+	(*UpdateProductResponse)(nil),   // 11: go.escape.ship.proto.v1.UpdateProductResponse
+	(*BundleComponent)(nil),         // 12: go.escape.ship.proto.v1.BundleComponent
+	(*Bundle)(nil),                  // 13: go.escape.ship.proto.v1.Bundle
+	(*ResolvedBundleComponent)(nil), // 14: go.escape.ship.proto.v1.ResolvedBundleComponent
+	(*CreateBundleRequest)(nil),     // 15: go.escape.ship.proto.v1.CreateBundleRequest
+	(*CreateBundleResponse)(nil),    // 16: go.escape.ship.proto.v1.CreateBundleResponse
+	(*ResolveBundleRequest)(nil),    // 17: go.escape.ship.proto.v1.ResolveBundleRequest
+	(*ResolveBundleResponse)(nil),   // 18: go.escape.ship.proto.v1.ResolveBundleResponse
+	(*Money)(nil),                   // 19: go.escape.ship.proto.v1.Money
+	(*fieldmaskpb.FieldMask)(nil),   // 20: google.protobuf.FieldMask
 }
 var file_product_proto_depIdxs = []int32{
-	0, // 0: go.escape.ship.proto.v1.GetProductsResponse.products:type_name -> go.escape.ship.proto.v1.Product
-	0, // 1: go.escape.ship.proto.v1.GetProductByIDResponse.product:type_name -> go.escape.ship.proto.v1.Product
-	1, // 2: go.escape.ship.proto.v1.ProductService.GetProducts:input_type -> go.escape.ship.proto.v1.GetProductsRequest
-	3, // 3: go.escape.ship.proto.v1.ProductService.GetProductByID:input_type -> go.escape.ship.proto.v1.GetProductByIDRequest
-	5, // 4: go.escape.ship.proto.v1.ProductService.PostProducts:input_type -> go.escape.ship.proto.v1.PostProductsRequest
-	2, // 5: go.escape.ship.proto.v1.ProductService.GetProducts:output_type -> go.escape.ship.proto.v1.GetProductsResponse
-	4, // 6: go.escape.ship.proto.v1.ProductService.GetProductByID:output_type -> go.escape.ship.proto.v1.GetProductByIDResponse
-	6, // 7: go.escape.ship.proto.v1.ProductService.PostProducts:output_type -> go.escape.ship.proto.v1.PostProductsResponse
-	5, // [5:8] is the sub-list for method output_type
-	2, // [2:5] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	1,  // 0: go.escape.ship.proto.v1.Product.price_tiers:type_name -> go.escape.ship.proto.v1.PriceTier
+	19, // 1: go.escape.ship.proto.v1.Product.list_price:type_name -> go.escape.ship.proto.v1.Money
+	19, // 2: go.escape.ship.proto.v1.Product.shipping_fee:type_name -> go.escape.ship.proto.v1.Money
+	19, // 3: go.escape.ship.proto.v1.PriceTier.price:type_name -> go.escape.ship.proto.v1.Money
+	20, // 4: go.escape.ship.proto.v1.GetProductsRequest.read_mask:type_name -> google.protobuf.FieldMask
+	0,  // 5: go.escape.ship.proto.v1.GetProductsResponse.products:type_name -> go.escape.ship.proto.v1.Product
+	0,  // 6: go.escape.ship.proto.v1.GetProductByIDResponse.product:type_name -> go.escape.ship.proto.v1.Product
+	1,  // 7: go.escape.ship.proto.v1.PostProductsRequest.price_tiers:type_name -> go.escape.ship.proto.v1.PriceTier
+	19, // 8: go.escape.ship.proto.v1.PostProductsRequest.shipping_fee:type_name -> go.escape.ship.proto.v1.Money
+	9,  // 9: go.escape.ship.proto.v1.ProductPatch.price_tiers:type_name -> go.escape.ship.proto.v1.PriceTierList
+	19, // 10: go.escape.ship.proto.v1.ProductPatch.list_price:type_name -> go.escape.ship.proto.v1.Money
+	19, // 11: go.escape.ship.proto.v1.ProductPatch.shipping_fee:type_name -> go.escape.ship.proto.v1.Money
+	1,  // 12: go.escape.ship.proto.v1.PriceTierList.tiers:type_name -> go.escape.ship.proto.v1.PriceTier
+	8,  // 13: go.escape.ship.proto.v1.UpdateProductRequest.patch:type_name -> go.escape.ship.proto.v1.ProductPatch
+	0,  // 14: go.escape.ship.proto.v1.UpdateProductResponse.product:type_name -> go.escape.ship.proto.v1.Product
+	12, // 15: go.escape.ship.proto.v1.Bundle.components:type_name -> go.escape.ship.proto.v1.BundleComponent
+	0,  // 16: go.escape.ship.proto.v1.ResolvedBundleComponent.product:type_name -> go.escape.ship.proto.v1.Product
+	12, // 17: go.escape.ship.proto.v1.CreateBundleRequest.components:type_name -> go.escape.ship.proto.v1.BundleComponent
+	13, // 18: go.escape.ship.proto.v1.CreateBundleResponse.bundle:type_name -> go.escape.ship.proto.v1.Bundle
+	13, // 19: go.escape.ship.proto.v1.ResolveBundleResponse.bundle:type_name -> go.escape.ship.proto.v1.Bundle
+	14, // 20: go.escape.ship.proto.v1.ResolveBundleResponse.components:type_name -> go.escape.ship.proto.v1.ResolvedBundleComponent
+	2,  // 21: go.escape.ship.proto.v1.ProductService.GetProducts:input_type -> go.escape.ship.proto.v1.GetProductsRequest
+	4,  // 22: go.escape.ship.proto.v1.ProductService.GetProductByID:input_type -> go.escape.ship.proto.v1.GetProductByIDRequest
+	6,  // 23: go.escape.ship.proto.v1.ProductService.PostProducts:input_type -> go.escape.ship.proto.v1.PostProductsRequest
+	10, // 24: go.escape.ship.proto.v1.ProductService.UpdateProduct:input_type -> go.escape.ship.proto.v1.UpdateProductRequest
+	15, // 25: go.escape.ship.proto.v1.ProductService.CreateBundle:input_type -> go.escape.ship.proto.v1.CreateBundleRequest
+	17, // 26: go.escape.ship.proto.v1.ProductService.ResolveBundle:input_type -> go.escape.ship.proto.v1.ResolveBundleRequest
+	3,  // 27: go.escape.ship.proto.v1.ProductService.GetProducts:output_type -> go.escape.ship.proto.v1.GetProductsResponse
+	5,  // 28: go.escape.ship.proto.v1.ProductService.GetProductByID:output_type -> go.escape.ship.proto.v1.GetProductByIDResponse
+	7,  // 29: go.escape.ship.proto.v1.ProductService.PostProducts:output_type -> go.escape.ship.proto.v1.PostProductsResponse
+	11, // 30: go.escape.ship.proto.v1.ProductService.UpdateProduct:output_type -> go.escape.ship.proto.v1.UpdateProductResponse
+	16, // 31: go.escape.ship.proto.v1.ProductService.CreateBundle:output_type -> go.escape.ship.proto.v1.CreateBundleResponse
+	18, // 32: go.escape.ship.proto.v1.ProductService.ResolveBundle:output_type -> go.escape.ship.proto.v1.ResolveBundleResponse
+	27, // [27:33] is the sub-list for method output_type
+	21, // [21:27] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_product_proto_init() }
@@ -511,13 +1506,17 @@ func file_product_proto_init() {
 	if File_product_proto != nil {
 		return
 	}
+	file_common_proto_init()
+	file_product_proto_msgTypes[0].OneofWrappers = []any{}
+	file_product_proto_msgTypes[6].OneofWrappers = []any{}
+	file_product_proto_msgTypes[8].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_product_proto_rawDesc), len(file_product_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,2006 @@
+// Code generated by protoc-gen-twirp v8.1.3, DO NOT EDIT.
+// source: product.proto
+
+package gen
+
+import context "context"
+import fmt "fmt"
+import http "net/http"
+import io "io"
+import json "encoding/json"
+import strconv "strconv"
+import strings "strings"
+
+import protojson "google.golang.org/protobuf/encoding/protojson"
+import proto "google.golang.org/protobuf/proto"
+import twirp "github.com/twitchtv/twirp"
+import ctxsetters "github.com/twitchtv/twirp/ctxsetters"
+
+// Version compatibility assertion.
+// If the constant is not defined in the package, that likely means
+// the package needs to be updated to work with this generated code.
+// See https://twitchtv.github.io/twirp/docs/version_matrix.html
+const _ = twirp.TwirpPackageMinVersion_8_1_0
+
+// ========================
+// ProductService Interface
+// ========================
+
+type ProductService interface {
+	GetProducts(context.Context, *GetProductsRequest) (*GetProductsResponse, error)
+
+	GetProductByID(context.Context, *GetProductByIDRequest) (*GetProductByIDResponse, error)
+
+	PostProducts(context.Context, *PostProductsRequest) (*PostProductsResponse, error)
+
+	// 상품 부분 수정: patch에 설정된 필드만 변경 (Go: ApplyProductPatch)
+	UpdateProduct(context.Context, *UpdateProductRequest) (*UpdateProductResponse, error)
+
+	CreateBundle(context.Context, *CreateBundleRequest) (*CreateBundleResponse, error)
+
+	// 번들을 구성 상품 단위로 전개 (주문 출고용)
+	ResolveBundle(context.Context, *ResolveBundleRequest) (*ResolveBundleResponse, error)
+}
+
+// ==============================
+// ProductService Protobuf Client
+// ==============================
+
+type productServiceProtobufClient struct {
+	client      HTTPClient
+	urls        [6]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewProductServiceProtobufClient creates a Protobuf client that implements the ProductService interface.
+// It communicates using Protobuf and can be configured with a custom HTTPClient.
+func NewProductServiceProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) ProductService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "go.escape.ship.proto.v1", "ProductService")
+	urls := [6]string{
+		serviceURL + "GetProducts",
+		serviceURL + "GetProductByID",
+		serviceURL + "PostProducts",
+		serviceURL + "UpdateProduct",
+		serviceURL + "CreateBundle",
+		serviceURL + "ResolveBundle",
+	}
+
+	return &productServiceProtobufClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *productServiceProtobufClient) GetProducts(ctx context.Context, in *GetProductsRequest) (*GetProductsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "ProductService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetProducts")
+	caller := c.callGetProducts
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetProductsRequest) (*GetProductsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetProductsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetProductsRequest) when calling interceptor")
+					}
+					return c.callGetProducts(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetProductsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetProductsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *productServiceProtobufClient) callGetProducts(ctx context.Context, in *GetProductsRequest) (*GetProductsResponse, error) {
+	out := new(GetProductsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *productServiceProtobufClient) GetProductByID(ctx context.Context, in *GetProductByIDRequest) (*GetProductByIDResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "ProductService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetProductByID")
+	caller := c.callGetProductByID
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetProductByIDRequest) (*GetProductByIDResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetProductByIDRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetProductByIDRequest) when calling interceptor")
+					}
+					return c.callGetProductByID(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetProductByIDResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetProductByIDResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *productServiceProtobufClient) callGetProductByID(ctx context.Context, in *GetProductByIDRequest) (*GetProductByIDResponse, error) {
+	out := new(GetProductByIDResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *productServiceProtobufClient) PostProducts(ctx context.Context, in *PostProductsRequest) (*PostProductsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "ProductService")
+	ctx = ctxsetters.WithMethodName(ctx, "PostProducts")
+	caller := c.callPostProducts
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *PostProductsRequest) (*PostProductsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PostProductsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PostProductsRequest) when calling interceptor")
+					}
+					return c.callPostProducts(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PostProductsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PostProductsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *productServiceProtobufClient) callPostProducts(ctx context.Context, in *PostProductsRequest) (*PostProductsResponse, error) {
+	out := new(PostProductsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *productServiceProtobufClient) UpdateProduct(ctx context.Context, in *UpdateProductRequest) (*UpdateProductResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "ProductService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateProduct")
+	caller := c.callUpdateProduct
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateProductRequest) (*UpdateProductResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateProductRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateProductRequest) when calling interceptor")
+					}
+					return c.callUpdateProduct(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateProductResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateProductResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *productServiceProtobufClient) callUpdateProduct(ctx context.Context, in *UpdateProductRequest) (*UpdateProductResponse, error) {
+	out := new(UpdateProductResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *productServiceProtobufClient) CreateBundle(ctx context.Context, in *CreateBundleRequest) (*CreateBundleResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "ProductService")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateBundle")
+	caller := c.callCreateBundle
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateBundleRequest) (*CreateBundleResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateBundleRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateBundleRequest) when calling interceptor")
+					}
+					return c.callCreateBundle(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateBundleResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateBundleResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *productServiceProtobufClient) callCreateBundle(ctx context.Context, in *CreateBundleRequest) (*CreateBundleResponse, error) {
+	out := new(CreateBundleResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *productServiceProtobufClient) ResolveBundle(ctx context.Context, in *ResolveBundleRequest) (*ResolveBundleResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "ProductService")
+	ctx = ctxsetters.WithMethodName(ctx, "ResolveBundle")
+	caller := c.callResolveBundle
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ResolveBundleRequest) (*ResolveBundleResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ResolveBundleRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ResolveBundleRequest) when calling interceptor")
+					}
+					return c.callResolveBundle(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ResolveBundleResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ResolveBundleResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *productServiceProtobufClient) callResolveBundle(ctx context.Context, in *ResolveBundleRequest) (*ResolveBundleResponse, error) {
+	out := new(ResolveBundleResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ==========================
+// ProductService JSON Client
+// ==========================
+
+type productServiceJSONClient struct {
+	client      HTTPClient
+	urls        [6]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewProductServiceJSONClient creates a JSON client that implements the ProductService interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewProductServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) ProductService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "go.escape.ship.proto.v1", "ProductService")
+	urls := [6]string{
+		serviceURL + "GetProducts",
+		serviceURL + "GetProductByID",
+		serviceURL + "PostProducts",
+		serviceURL + "UpdateProduct",
+		serviceURL + "CreateBundle",
+		serviceURL + "ResolveBundle",
+	}
+
+	return &productServiceJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *productServiceJSONClient) GetProducts(ctx context.Context, in *GetProductsRequest) (*GetProductsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "ProductService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetProducts")
+	caller := c.callGetProducts
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetProductsRequest) (*GetProductsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetProductsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetProductsRequest) when calling interceptor")
+					}
+					return c.callGetProducts(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetProductsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetProductsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *productServiceJSONClient) callGetProducts(ctx context.Context, in *GetProductsRequest) (*GetProductsResponse, error) {
+	out := new(GetProductsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *productServiceJSONClient) GetProductByID(ctx context.Context, in *GetProductByIDRequest) (*GetProductByIDResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "ProductService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetProductByID")
+	caller := c.callGetProductByID
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetProductByIDRequest) (*GetProductByIDResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetProductByIDRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetProductByIDRequest) when calling interceptor")
+					}
+					return c.callGetProductByID(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetProductByIDResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetProductByIDResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *productServiceJSONClient) callGetProductByID(ctx context.Context, in *GetProductByIDRequest) (*GetProductByIDResponse, error) {
+	out := new(GetProductByIDResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *productServiceJSONClient) PostProducts(ctx context.Context, in *PostProductsRequest) (*PostProductsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "ProductService")
+	ctx = ctxsetters.WithMethodName(ctx, "PostProducts")
+	caller := c.callPostProducts
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *PostProductsRequest) (*PostProductsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PostProductsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PostProductsRequest) when calling interceptor")
+					}
+					return c.callPostProducts(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PostProductsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PostProductsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *productServiceJSONClient) callPostProducts(ctx context.Context, in *PostProductsRequest) (*PostProductsResponse, error) {
+	out := new(PostProductsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *productServiceJSONClient) UpdateProduct(ctx context.Context, in *UpdateProductRequest) (*UpdateProductResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "ProductService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateProduct")
+	caller := c.callUpdateProduct
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateProductRequest) (*UpdateProductResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateProductRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateProductRequest) when calling interceptor")
+					}
+					return c.callUpdateProduct(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateProductResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateProductResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *productServiceJSONClient) callUpdateProduct(ctx context.Context, in *UpdateProductRequest) (*UpdateProductResponse, error) {
+	out := new(UpdateProductResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *productServiceJSONClient) CreateBundle(ctx context.Context, in *CreateBundleRequest) (*CreateBundleResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "ProductService")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateBundle")
+	caller := c.callCreateBundle
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateBundleRequest) (*CreateBundleResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateBundleRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateBundleRequest) when calling interceptor")
+					}
+					return c.callCreateBundle(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateBundleResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateBundleResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *productServiceJSONClient) callCreateBundle(ctx context.Context, in *CreateBundleRequest) (*CreateBundleResponse, error) {
+	out := new(CreateBundleResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *productServiceJSONClient) ResolveBundle(ctx context.Context, in *ResolveBundleRequest) (*ResolveBundleResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "ProductService")
+	ctx = ctxsetters.WithMethodName(ctx, "ResolveBundle")
+	caller := c.callResolveBundle
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ResolveBundleRequest) (*ResolveBundleResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ResolveBundleRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ResolveBundleRequest) when calling interceptor")
+					}
+					return c.callResolveBundle(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ResolveBundleResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ResolveBundleResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *productServiceJSONClient) callResolveBundle(ctx context.Context, in *ResolveBundleRequest) (*ResolveBundleResponse, error) {
+	out := new(ResolveBundleResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// =============================
+// ProductService Server Handler
+// =============================
+
+type productServiceServer struct {
+	ProductService
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewProductServiceServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewProductServiceServer(svc ProductService, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	return &productServiceServer{
+		ProductService:   svc,
+		hooks:            serverOpts.Hooks,
+		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:       pathPrefix,
+		jsonSkipDefaults: jsonSkipDefaults,
+		jsonCamelCase:    jsonCamelCase,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *productServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *productServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
+
+// ProductServicePathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const ProductServicePathPrefix = "/twirp/go.escape.ship.proto.v1.ProductService/"
+
+func (s *productServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "ProductService")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "go.escape.ship.proto.v1.ProductService" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "GetProducts":
+		s.serveGetProducts(ctx, resp, req)
+		return
+	case "GetProductByID":
+		s.serveGetProductByID(ctx, resp, req)
+		return
+	case "PostProducts":
+		s.servePostProducts(ctx, resp, req)
+		return
+	case "UpdateProduct":
+		s.serveUpdateProduct(ctx, resp, req)
+		return
+	case "CreateBundle":
+		s.serveCreateBundle(ctx, resp, req)
+		return
+	case "ResolveBundle":
+		s.serveResolveBundle(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *productServiceServer) serveGetProducts(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetProductsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetProductsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *productServiceServer) serveGetProductsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetProducts")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetProductsRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ProductService.GetProducts
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetProductsRequest) (*GetProductsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetProductsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetProductsRequest) when calling interceptor")
+					}
+					return s.ProductService.GetProducts(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetProductsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetProductsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetProductsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetProductsResponse and nil error while calling GetProducts. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *productServiceServer) serveGetProductsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetProducts")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetProductsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ProductService.GetProducts
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetProductsRequest) (*GetProductsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetProductsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetProductsRequest) when calling interceptor")
+					}
+					return s.ProductService.GetProducts(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetProductsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetProductsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetProductsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetProductsResponse and nil error while calling GetProducts. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *productServiceServer) serveGetProductByID(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetProductByIDJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetProductByIDProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *productServiceServer) serveGetProductByIDJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetProductByID")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetProductByIDRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ProductService.GetProductByID
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetProductByIDRequest) (*GetProductByIDResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetProductByIDRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetProductByIDRequest) when calling interceptor")
+					}
+					return s.ProductService.GetProductByID(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetProductByIDResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetProductByIDResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetProductByIDResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetProductByIDResponse and nil error while calling GetProductByID. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *productServiceServer) serveGetProductByIDProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetProductByID")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetProductByIDRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ProductService.GetProductByID
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetProductByIDRequest) (*GetProductByIDResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetProductByIDRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetProductByIDRequest) when calling interceptor")
+					}
+					return s.ProductService.GetProductByID(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetProductByIDResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetProductByIDResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetProductByIDResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetProductByIDResponse and nil error while calling GetProductByID. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *productServiceServer) servePostProducts(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.servePostProductsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.servePostProductsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *productServiceServer) servePostProductsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "PostProducts")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(PostProductsRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ProductService.PostProducts
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *PostProductsRequest) (*PostProductsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PostProductsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PostProductsRequest) when calling interceptor")
+					}
+					return s.ProductService.PostProducts(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PostProductsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PostProductsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *PostProductsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *PostProductsResponse and nil error while calling PostProducts. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *productServiceServer) servePostProductsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "PostProducts")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(PostProductsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ProductService.PostProducts
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *PostProductsRequest) (*PostProductsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PostProductsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PostProductsRequest) when calling interceptor")
+					}
+					return s.ProductService.PostProducts(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PostProductsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PostProductsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *PostProductsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *PostProductsResponse and nil error while calling PostProducts. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *productServiceServer) serveUpdateProduct(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveUpdateProductJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveUpdateProductProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *productServiceServer) serveUpdateProductJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateProduct")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(UpdateProductRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ProductService.UpdateProduct
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateProductRequest) (*UpdateProductResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateProductRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateProductRequest) when calling interceptor")
+					}
+					return s.ProductService.UpdateProduct(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateProductResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateProductResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UpdateProductResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpdateProductResponse and nil error while calling UpdateProduct. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *productServiceServer) serveUpdateProductProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateProduct")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(UpdateProductRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ProductService.UpdateProduct
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateProductRequest) (*UpdateProductResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateProductRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateProductRequest) when calling interceptor")
+					}
+					return s.ProductService.UpdateProduct(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateProductResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateProductResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UpdateProductResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpdateProductResponse and nil error while calling UpdateProduct. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *productServiceServer) serveCreateBundle(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveCreateBundleJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveCreateBundleProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *productServiceServer) serveCreateBundleJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateBundle")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(CreateBundleRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ProductService.CreateBundle
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateBundleRequest) (*CreateBundleResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateBundleRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateBundleRequest) when calling interceptor")
+					}
+					return s.ProductService.CreateBundle(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateBundleResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateBundleResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreateBundleResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateBundleResponse and nil error while calling CreateBundle. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *productServiceServer) serveCreateBundleProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateBundle")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(CreateBundleRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ProductService.CreateBundle
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateBundleRequest) (*CreateBundleResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateBundleRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateBundleRequest) when calling interceptor")
+					}
+					return s.ProductService.CreateBundle(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateBundleResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateBundleResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreateBundleResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateBundleResponse and nil error while calling CreateBundle. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *productServiceServer) serveResolveBundle(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveResolveBundleJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveResolveBundleProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *productServiceServer) serveResolveBundleJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ResolveBundle")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ResolveBundleRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ProductService.ResolveBundle
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ResolveBundleRequest) (*ResolveBundleResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ResolveBundleRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ResolveBundleRequest) when calling interceptor")
+					}
+					return s.ProductService.ResolveBundle(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ResolveBundleResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ResolveBundleResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ResolveBundleResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ResolveBundleResponse and nil error while calling ResolveBundle. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *productServiceServer) serveResolveBundleProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ResolveBundle")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ResolveBundleRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ProductService.ResolveBundle
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ResolveBundleRequest) (*ResolveBundleResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ResolveBundleRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ResolveBundleRequest) when calling interceptor")
+					}
+					return s.ProductService.ResolveBundle(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ResolveBundleResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ResolveBundleResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ResolveBundleResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ResolveBundleResponse and nil error while calling ResolveBundle. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *productServiceServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor9, 0
+}
+
+func (s *productServiceServer) ProtocGenTwirpVersion() string {
+	return "v8.1.3"
+}
+
+// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
+// that is everything in a Twirp route except for the <Method>. This can be used for routing,
+// for example to identify the requests that are targeted to this service in a mux.
+func (s *productServiceServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "go.escape.ship.proto.v1", "ProductService")
+}
+
+var twirpFileDescriptor9 = []byte{
+	// 1405 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x5d, 0x6f, 0xdb, 0x54,
+	0x18, 0x9e, 0x93, 0xe6, 0xc3, 0xaf, 0x93, 0x74, 0x3b, 0xfd, 0xb2, 0xb2, 0x8d, 0xa6, 0x46, 0xeb,
+	0x22, 0xa0, 0xc9, 0xe8, 0x90, 0x86, 0x06, 0x5c, 0x2c, 0x45, 0x5b, 0x8a, 0x36, 0x11, 0xbc, 0xed,
+	0x86, 0x1b, 0xcb, 0xb5, 0xcf, 0x52, 0xb3, 0xd8, 0xc7, 0xf3, 0x71, 0xc6, 0xba, 0x69, 0x12, 0x70,
+	0x03, 0xbb, 0xe0, 0x0a, 0x81, 0x04, 0xe2, 0x8a, 0x5f, 0xc1, 0xdf, 0x40, 0xfc, 0x05, 0xfe, 0x05,
+	0x37, 0xe8, 0x7c, 0x38, 0xb5, 0xdd, 0xa4, 0x4d, 0xe9, 0x76, 0x17, 0xbf, 0xe7, 0x3d, 0x3e, 0xcf,
+	0x79, 0xde, 0xe7, 0x7d, 0xfc, 0x2a, 0x50, 0x0f, 0x23, 0xe2, 0x8e, 0x9d, 0xb8, 0x13, 0x46, 0x24,
+	0x26, 0x68, 0x6d, 0x48, 0x3a, 0x98, 0x3a, 0x76, 0x88, 0x3b, 0x74, 0xdf, 0x0b, 0x45, 0xb4, 0xf3,
+	0xf4, 0xfd, 0x66, 0xcd, 0x21, 0xbe, 0x4f, 0x02, 0x11, 0x68, 0x5e, 0x1a, 0x12, 0x32, 0x1c, 0xe1,
+	0xae, 0x1d, 0x7a, 0x5d, 0x3b, 0x08, 0x48, 0x6c, 0xc7, 0x1e, 0x09, 0xa8, 0x5c, 0x6d, 0xc9, 0x55,
+	0xfe, 0xb4, 0x37, 0x7e, 0xd4, 0x7d, 0xe4, 0xe1, 0x91, 0x6b, 0xf9, 0x36, 0x7d, 0x2c, 0x32, 0x8c,
+	0x6f, 0x4a, 0x50, 0x19, 0x88, 0x83, 0x51, 0x03, 0x0a, 0x9e, 0xab, 0x2b, 0x2d, 0xa5, 0xad, 0x9a,
+	0x05, 0xcf, 0x45, 0x08, 0x16, 0x02, 0xdb, 0xc7, 0x7a, 0x81, 0x47, 0xf8, 0x6f, 0xd4, 0x84, 0xaa,
+	0x63, 0xc7, 0x78, 0x48, 0xa2, 0x03, 0xbd, 0xc8, 0xe3, 0x93, 0x67, 0xa4, 0x43, 0x29, 0x8c, 0x3c,
+	0x07, 0xeb, 0x0b, 0x2d, 0xa5, 0x5d, 0xec, 0x15, 0x74, 0xc5, 0x14, 0x01, 0x74, 0x11, 0x54, 0xcf,
+	0xb7, 0x87, 0xd8, 0x1a, 0x47, 0x23, 0xbd, 0x24, 0xb6, 0xf1, 0xc0, 0xc3, 0x68, 0x84, 0x5a, 0xa0,
+	0xb9, 0x98, 0x3a, 0x91, 0x17, 0x32, 0xe8, 0x7a, 0x99, 0x2f, 0xa7, 0x43, 0xe8, 0x32, 0x80, 0x13,
+	0x61, 0x3b, 0xc6, 0xae, 0x65, 0xc7, 0x7a, 0x85, 0x27, 0xa8, 0x32, 0x72, 0x2b, 0x66, 0xcb, 0xe3,
+	0xd0, 0x4d, 0x96, 0xab, 0x62, 0x59, 0x46, 0x6e, 0xc5, 0x68, 0x03, 0x6a, 0x84, 0xbf, 0x87, 0x5a,
+	0x5f, 0x51, 0x12, 0xe8, 0xaa, 0x38, 0x40, 0xc6, 0x3e, 0xa3, 0x24, 0x40, 0x3b, 0xa0, 0x71, 0xa0,
+	0x56, 0xec, 0xe1, 0x88, 0xea, 0xd0, 0x2a, 0xb6, 0xb5, 0x6d, 0xa3, 0x33, 0xa3, 0x04, 0x9d, 0x01,
+	0xcb, 0x7d, 0xe0, 0xe1, 0xc8, 0x84, 0x30, 0xf9, 0x49, 0x51, 0x1b, 0xce, 0xfb, 0xf6, 0x33, 0x2b,
+	0xc4, 0x91, 0xe5, 0x8c, 0x69, 0x4c, 0x7c, 0x1c, 0xe9, 0x5a, 0x4b, 0x69, 0x97, 0xcc, 0x86, 0x6f,
+	0x3f, 0x1b, 0xe0, 0x68, 0x47, 0x46, 0xd1, 0x27, 0x00, 0x23, 0x8f, 0xc6, 0x96, 0x60, 0xab, 0xd6,
+	0x52, 0xda, 0xda, 0xf6, 0x5b, 0x33, 0x4f, 0xbb, 0x47, 0x02, 0x7c, 0x60, 0xaa, 0x6c, 0x07, 0x3f,
+	0x18, 0x6d, 0x42, 0xed, 0x6b, 0xec, 0x0d, 0xf7, 0x63, 0x6b, 0x18, 0xd9, 0x3e, 0xd5, 0xeb, 0xec,
+	0x90, 0xfe, 0x39, 0x53, 0x13, 0xd1, 0x3b, 0x2c, 0xf8, 0x83, 0xa2, 0xa0, 0x1b, 0xb0, 0xe2, 0x7a,
+	0xd4, 0x21, 0xe3, 0x20, 0xb6, 0xf6, 0x6c, 0xea, 0x51, 0x2b, 0x24, 0x5e, 0x10, 0x53, 0xbd, 0xc1,
+	0x37, 0x28, 0xe6, 0x52, 0xb2, 0xdc, 0x63, 0xab, 0x03, 0xbe, 0xc8, 0x36, 0xde, 0x82, 0x1a, 0x83,
+	0x10, 0x7a, 0xc1, 0xd0, 0x7a, 0x84, 0xb1, 0xbe, 0x38, 0x17, 0x42, 0x2d, 0xd9, 0x73, 0x1b, 0xe3,
+	0xde, 0x22, 0xd4, 0xad, 0x34, 0xc8, 0x9e, 0x0e, 0xab, 0xd6, 0x54, 0x34, 0xc6, 0xf7, 0x0a, 0xa8,
+	0x13, 0x46, 0x59, 0xb5, 0x7c, 0x2f, 0xb0, 0x9e, 0x8c, 0xed, 0x20, 0xf6, 0xe2, 0x03, 0x2e, 0xc7,
+	0x92, 0xa9, 0xf9, 0x5e, 0xf0, 0x85, 0x0c, 0xa1, 0x0d, 0x80, 0x71, 0xe0, 0x25, 0xf4, 0x15, 0x26,
+	0x62, 0x53, 0x59, 0x54, 0x50, 0xf4, 0x41, 0x22, 0xc5, 0xe2, 0x5c, 0xd0, 0x45, 0xb2, 0xf1, 0x4a,
+	0x01, 0x74, 0x07, 0xc7, 0xb2, 0x1f, 0xa8, 0x89, 0x9f, 0x8c, 0x31, 0x8d, 0xd1, 0x0d, 0x50, 0x23,
+	0x6c, 0x8b, 0xb6, 0xe1, 0x78, 0xb4, 0xed, 0x66, 0x47, 0x74, 0x56, 0x27, 0xe9, 0xac, 0xce, 0x6d,
+	0xd6, 0x59, 0xf7, 0x6c, 0xfa, 0xd8, 0xac, 0xb2, 0x64, 0xf6, 0x8b, 0xc9, 0x3e, 0x64, 0xaa, 0xa7,
+	0xde, 0x73, 0x81, 0xb3, 0x64, 0x56, 0x59, 0xe0, 0xbe, 0xf7, 0x1c, 0x33, 0xd5, 0xf2, 0xc5, 0x98,
+	0x3c, 0xc6, 0x81, 0xec, 0x25, 0x9e, 0xfe, 0x80, 0x05, 0x8c, 0xdf, 0x15, 0x58, 0xca, 0x60, 0xa1,
+	0x21, 0x09, 0x28, 0x46, 0x1f, 0x43, 0x55, 0x1a, 0x05, 0xd5, 0x15, 0xae, 0xd3, 0xd6, 0x31, 0x3a,
+	0xe5, 0x89, 0xe6, 0x64, 0x07, 0xda, 0x84, 0xc5, 0x00, 0x3f, 0x8b, 0xad, 0xd4, 0xc9, 0xa2, 0xbb,
+	0xeb, 0x2c, 0x3c, 0x48, 0x4e, 0x47, 0xeb, 0xa0, 0xc5, 0x24, 0xb6, 0x47, 0x16, 0xaf, 0x17, 0x47,
+	0x57, 0x32, 0x81, 0x87, 0x76, 0x58, 0xc4, 0xb8, 0x0a, 0x2b, 0x87, 0xe8, 0x7a, 0x07, 0xbb, 0x9f,
+	0x26, 0x64, 0xe5, 0x4c, 0xc4, 0x78, 0x00, 0xab, 0xf9, 0x44, 0x79, 0x93, 0x9b, 0x50, 0x91, 0xb8,
+	0x24, 0xa9, 0x27, 0x5f, 0x24, 0xd9, 0x60, 0xfc, 0x5b, 0x84, 0xa5, 0x01, 0xa1, 0x47, 0x4a, 0x95,
+	0x58, 0x96, 0x32, 0xc3, 0xb2, 0xb8, 0x58, 0x52, 0x96, 0xb5, 0x9c, 0xd6, 0x49, 0x71, 0xaa, 0x5d,
+	0x2d, 0x1c, 0x6f, 0x57, 0xa5, 0xa3, 0x76, 0x95, 0x37, 0x9c, 0xf2, 0x89, 0x86, 0x53, 0x79, 0x6d,
+	0x86, 0x53, 0x9d, 0x6a, 0x38, 0x79, 0xc7, 0x50, 0x4f, 0xeb, 0x18, 0x70, 0x4a, 0xc7, 0xd0, 0xde,
+	0xa8, 0x63, 0x5c, 0x83, 0xe5, 0x6c, 0xf1, 0xa5, 0xa2, 0x74, 0xa8, 0xf8, 0x98, 0x52, 0x7b, 0x98,
+	0x08, 0x20, 0x79, 0x34, 0x7e, 0x2d, 0x41, 0x4d, 0xa6, 0x0f, 0xec, 0xd8, 0xd9, 0x47, 0x6b, 0x69,
+	0xa1, 0xf4, 0xcf, 0x09, 0xa9, 0xb0, 0x9b, 0xac, 0xe7, 0xd4, 0xa2, 0xf6, 0x95, 0x43, 0xbd, 0xb0,
+	0x84, 0x56, 0x5a, 0x1c, 0xbc, 0x6d, 0xfb, 0x85, 0x43, 0x79, 0xb0, 0x8c, 0x2b, 0x59, 0x85, 0x70,
+	0x01, 0xf5, 0x8b, 0x19, 0x8d, 0xb0, 0xb4, 0xcd, 0x9c, 0x4c, 0xb8, 0x92, 0xfa, 0x0b, 0x19, 0xa1,
+	0xb0, 0xbc, 0x3b, 0x59, 0xad, 0x94, 0x39, 0xb5, 0x9b, 0x27, 0x6b, 0xe5, 0xae, 0x47, 0xe3, 0x8c,
+	0x5e, 0xb6, 0xa6, 0xe8, 0xa5, 0xc2, 0x0b, 0x7b, 0x44, 0x31, 0xec, 0xdc, 0xec, 0x57, 0xaa, 0x7a,
+	0xd6, 0xaf, 0x94, 0xd0, 0x5c, 0xf9, 0xb4, 0x9a, 0xab, 0xbc, 0x49, 0xcd, 0x55, 0xa0, 0x64, 0xb1,
+	0xc2, 0xf7, 0x34, 0x50, 0xad, 0xa4, 0xc8, 0xbd, 0x1a, 0x80, 0x35, 0x29, 0x71, 0xaf, 0x01, 0x35,
+	0x2b, 0x55, 0x39, 0xae, 0xd3, 0x74, 0xdd, 0x7a, 0x4b, 0x70, 0xc1, 0xca, 0xf3, 0x7a, 0x1a, 0x35,
+	0xef, 0x42, 0x3d, 0x53, 0x33, 0xf4, 0x21, 0x94, 0x44, 0xa9, 0x95, 0xb9, 0x6d, 0x41, 0x6c, 0x30,
+	0x1c, 0x58, 0x7e, 0xc8, 0xe7, 0x9e, 0xc4, 0x30, 0xa7, 0x9b, 0x32, 0xfa, 0x08, 0x4a, 0x21, 0x6b,
+	0x03, 0xae, 0x70, 0x6d, 0xfb, 0xca, 0x49, 0xc6, 0xcb, 0x7b, 0xc6, 0x14, 0x7b, 0x8c, 0xfb, 0xb0,
+	0x92, 0x3b, 0xe4, 0x35, 0x18, 0xfa, 0x5d, 0x58, 0xec, 0x8d, 0x03, 0x77, 0x84, 0x77, 0x88, 0x1f,
+	0x92, 0x00, 0x07, 0x7c, 0xac, 0x93, 0xab, 0xd6, 0x04, 0xbc, 0x2a, 0x23, 0xbb, 0x2e, 0xb3, 0xf5,
+	0xc9, 0x90, 0x20, 0xbf, 0xad, 0xc9, 0xb3, 0xf1, 0xa7, 0x02, 0x65, 0xf1, 0xba, 0xb9, 0x86, 0xda,
+	0x0d, 0xa8, 0xed, 0xf1, 0x6c, 0x2b, 0xfd, 0x31, 0xd0, 0x44, 0x4c, 0xa8, 0xb9, 0x0f, 0xe0, 0x24,
+	0xc8, 0xa8, 0xbe, 0xc0, 0x0b, 0xd3, 0x9e, 0x79, 0xbd, 0xdc, 0x55, 0xcc, 0xd4, 0xde, 0xdc, 0x30,
+	0x5b, 0xca, 0x0d, 0xb3, 0xc6, 0x6f, 0x0a, 0xac, 0x99, 0x98, 0x92, 0xd1, 0x53, 0xec, 0xe6, 0x19,
+	0x39, 0x03, 0xc1, 0xc7, 0xd1, 0x85, 0xae, 0xc2, 0xa2, 0x3d, 0x1a, 0x11, 0x87, 0x83, 0x4a, 0x53,
+	0xd0, 0x98, 0x84, 0x39, 0x0b, 0xc6, 0x2f, 0x0a, 0x2c, 0xed, 0x70, 0xa8, 0x02, 0xda, 0x71, 0x9f,
+	0xdd, 0x3c, 0xa9, 0x85, 0x93, 0x48, 0x2d, 0xfe, 0x7f, 0x52, 0x8d, 0xcf, 0x61, 0x39, 0x8b, 0x4b,
+	0x4a, 0xf2, 0x06, 0x94, 0xc5, 0x81, 0x92, 0xb0, 0xf5, 0x13, 0xde, 0x6e, 0xca, 0x74, 0xe3, 0x3a,
+	0x2c, 0xcb, 0x2a, 0x64, 0x6f, 0x7a, 0x11, 0x54, 0x79, 0xab, 0x89, 0xaa, 0xaa, 0x22, 0xb0, 0xeb,
+	0x1a, 0x7f, 0x28, 0xb0, 0x92, 0xdb, 0x75, 0x46, 0x1c, 0x68, 0x90, 0xa1, 0xa8, 0xc0, 0x29, 0xba,
+	0x36, 0x73, 0xf3, 0x0c, 0xe1, 0xa4, 0xa9, 0xda, 0xfe, 0xab, 0x0c, 0x0d, 0xa9, 0x8e, 0xfb, 0x38,
+	0x7a, 0xca, 0xea, 0xf0, 0x02, 0xb4, 0xd4, 0xa8, 0x89, 0xde, 0x9d, 0xf9, 0xfe, 0xa3, 0xc3, 0x71,
+	0xf3, 0xbd, 0xf9, 0x92, 0x05, 0x0f, 0xc6, 0x85, 0xef, 0xfe, 0xfe, 0xe7, 0xa7, 0x82, 0x86, 0xd4,
+	0xee, 0x64, 0x24, 0x7d, 0xa5, 0x40, 0x23, 0x3b, 0x21, 0xa2, 0xce, 0x1c, 0xef, 0x4c, 0xcd, 0x9c,
+	0xcd, 0xee, 0xdc, 0xf9, 0x12, 0xc6, 0x2a, 0x87, 0x71, 0x1e, 0x35, 0x26, 0x30, 0xba, 0x2f, 0x3c,
+	0xf7, 0x25, 0xfa, 0x56, 0x81, 0x5a, 0x7a, 0xb2, 0x40, 0xb3, 0x6f, 0x37, 0x65, 0xfa, 0x6c, 0x6e,
+	0xcd, 0x99, 0x2d, 0x51, 0x2c, 0x73, 0x14, 0x8d, 0x9b, 0xca, 0x3b, 0x46, 0x8a, 0x8f, 0x1f, 0x15,
+	0xa8, 0x67, 0xfc, 0x15, 0xcd, 0x7e, 0xed, 0x34, 0xb3, 0x6f, 0x76, 0xe6, 0x4d, 0x97, 0x30, 0x2e,
+	0x73, 0x18, 0x6b, 0x37, 0x85, 0xbd, 0x6f, 0xe7, 0x39, 0x79, 0xa5, 0x40, 0x2d, 0xdd, 0x5b, 0xc7,
+	0x70, 0x32, 0xc5, 0x1a, 0x9a, 0x5b, 0x73, 0x66, 0x4b, 0x30, 0x97, 0x38, 0x98, 0x55, 0xc6, 0xc9,
+	0x85, 0x43, 0x20, 0xa2, 0x19, 0x28, 0xfa, 0x59, 0x81, 0x7a, 0xa6, 0xc1, 0x8e, 0xe1, 0x66, 0x5a,
+	0xfb, 0x36, 0x3b, 0xf3, 0xa6, 0x4b, 0x38, 0x57, 0x38, 0x9c, 0x75, 0x74, 0xf9, 0x08, 0x96, 0xee,
+	0x8b, 0x89, 0x0f, 0xbc, 0xec, 0xbd, 0xfd, 0xe5, 0xc6, 0xd0, 0x8b, 0xf7, 0xc7, 0x7b, 0x1d, 0x87,
+	0xf8, 0x5d, 0xf1, 0xfe, 0x2d, 0xf6, 0x7e, 0xf1, 0xcf, 0x0b, 0xed, 0x0e, 0x71, 0xb0, 0x57, 0xe6,
+	0xbf, 0xaf, 0xff, 0x37, 0x00, 0xe3, 0x0d, 0xe0, 0x9a, 0xe9, 0x11, 0x00, 0x00,
+}
@@ -22,6 +22,9 @@ const (
 	ProductService_GetProducts_FullMethodName    = "/go.escape.ship.proto.v1.ProductService/GetProducts"
 	ProductService_GetProductByID_FullMethodName = "/go.escape.ship.proto.v1.ProductService/GetProductByID"
 	ProductService_PostProducts_FullMethodName   = "/go.escape.ship.proto.v1.ProductService/PostProducts"
+	ProductService_UpdateProduct_FullMethodName  = "/go.escape.ship.proto.v1.ProductService/UpdateProduct"
+	ProductService_CreateBundle_FullMethodName   = "/go.escape.ship.proto.v1.ProductService/CreateBundle"
+	ProductService_ResolveBundle_FullMethodName  = "/go.escape.ship.proto.v1.ProductService/ResolveBundle"
 )
 
 // ProductServiceClient is the client API for ProductService service.
@@ -31,6 +34,11 @@ type ProductServiceClient interface {
 	GetProducts(ctx context.Context, in *GetProductsRequest, opts ...grpc.CallOption) (*GetProductsResponse, error)
 	GetProductByID(ctx context.Context, in *GetProductByIDRequest, opts ...grpc.CallOption) (*GetProductByIDResponse, error)
 	PostProducts(ctx context.Context, in *PostProductsRequest, opts ...grpc.CallOption) (*PostProductsResponse, error)
+	// 상품 부분 수정: patch에 설정된 필드만 변경 (Go: ApplyProductPatch)
+	UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*UpdateProductResponse, error)
+	CreateBundle(ctx context.Context, in *CreateBundleRequest, opts ...grpc.CallOption) (*CreateBundleResponse, error)
+	// 번들을 구성 상품 단위로 전개 (주문 출고용)
+	ResolveBundle(ctx context.Context, in *ResolveBundleRequest, opts ...grpc.CallOption) (*ResolveBundleResponse, error)
 }
 
 type productServiceClient struct {
@@ -71,6 +79,36 @@ func (c *productServiceClient) PostProducts(ctx context.Context, in *PostProduct
 	return out, nil
 }
 
+func (c *productServiceClient) UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*UpdateProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateProductResponse)
+	err := c.cc.Invoke(ctx, ProductService_UpdateProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CreateBundle(ctx context.Context, in *CreateBundleRequest, opts ...grpc.CallOption) (*CreateBundleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateBundleResponse)
+	err := c.cc.Invoke(ctx, ProductService_CreateBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ResolveBundle(ctx context.Context, in *ResolveBundleRequest, opts ...grpc.CallOption) (*ResolveBundleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveBundleResponse)
+	err := c.cc.Invoke(ctx, ProductService_ResolveBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProductServiceServer is the server API for ProductService service.
 // All implementations must embed UnimplementedProductServiceServer
 // for forward compatibility.
@@ -78,6 +116,11 @@ type ProductServiceServer interface {
 	GetProducts(context.Context, *GetProductsRequest) (*GetProductsResponse, error)
 	GetProductByID(context.Context, *GetProductByIDRequest) (*GetProductByIDResponse, error)
 	PostProducts(context.Context, *PostProductsRequest) (*PostProductsResponse, error)
+	// 상품 부분 수정: patch에 설정된 필드만 변경 (Go: ApplyProductPatch)
+	UpdateProduct(context.Context, *UpdateProductRequest) (*UpdateProductResponse, error)
+	CreateBundle(context.Context, *CreateBundleRequest) (*CreateBundleResponse, error)
+	// 번들을 구성 상품 단위로 전개 (주문 출고용)
+	ResolveBundle(context.Context, *ResolveBundleRequest) (*ResolveBundleResponse, error)
 	mustEmbedUnimplementedProductServiceServer()
 }
 
@@ -97,6 +140,15 @@ func (UnimplementedProductServiceServer) GetProductByID(context.Context, *GetPro
 func (UnimplementedProductServiceServer) PostProducts(context.Context, *PostProductsRequest) (*PostProductsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PostProducts not implemented")
 }
+func (UnimplementedProductServiceServer) UpdateProduct(context.Context, *UpdateProductRequest) (*UpdateProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProduct not implemented")
+}
+func (UnimplementedProductServiceServer) CreateBundle(context.Context, *CreateBundleRequest) (*CreateBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBundle not implemented")
+}
+func (UnimplementedProductServiceServer) ResolveBundle(context.Context, *ResolveBundleRequest) (*ResolveBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveBundle not implemented")
+}
 func (UnimplementedProductServiceServer) mustEmbedUnimplementedProductServiceServer() {}
 func (UnimplementedProductServiceServer) testEmbeddedByValue()                        {}
 
@@ -172,6 +224,60 @@ func _ProductService_PostProducts_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_UpdateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateProduct(ctx, req.(*UpdateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CreateBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateBundle(ctx, req.(*CreateBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ResolveBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ResolveBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ResolveBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ResolveBundle(ctx, req.(*ResolveBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProductService_ServiceDesc is the grpc.ServiceDesc for ProductService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -191,6 +297,18 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PostProducts",
 			Handler:    _ProductService_PostProducts_Handler,
 		},
+		{
+			MethodName: "UpdateProduct",
+			Handler:    _ProductService_UpdateProduct_Handler,
+		},
+		{
+			MethodName: "CreateBundle",
+			Handler:    _ProductService_CreateBundle_Handler,
+		},
+		{
+			MethodName: "ResolveBundle",
+			Handler:    _ProductService_ResolveBundle_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "product.proto",
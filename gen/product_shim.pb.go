@@ -0,0 +1,91 @@
+// Code generated by protoc-gen-go-shim. DO NOT EDIT.
+// source: product.proto
+
+package gen
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+)
+
+// ProductServiceAPI is ProductServiceClient without per-call options, so it can be
+// mocked with plain method signatures. Streams are exposed as iterators.
+type ProductServiceAPI interface {
+	GetProducts(ctx context.Context, in *GetProductsRequest) (*GetProductsResponse, error)
+	GetProductByID(ctx context.Context, in *GetProductByIDRequest) (*GetProductByIDResponse, error)
+	PostProducts(ctx context.Context, in *PostProductsRequest) (*PostProductsResponse, error)
+	// 상품 부분 수정: patch에 설정된 필드만 변경 (Go: ApplyProductPatch)
+	UpdateProduct(ctx context.Context, in *UpdateProductRequest) (*UpdateProductResponse, error)
+	CreateBundle(ctx context.Context, in *CreateBundleRequest) (*CreateBundleResponse, error)
+	// 번들을 구성 상품 단위로 전개 (주문 출고용)
+	ResolveBundle(ctx context.Context, in *ResolveBundleRequest) (*ResolveBundleResponse, error)
+}
+
+// NewProductServiceAPI adapts c to ProductServiceAPI, passing opts to every call.
+func NewProductServiceAPI(c ProductServiceClient, opts ...grpc.CallOption) ProductServiceAPI {
+	return &productServiceAPI{c: c, opts: opts}
+}
+
+type productServiceAPI struct {
+	c    ProductServiceClient
+	opts []grpc.CallOption
+}
+
+func (a *productServiceAPI) GetProducts(ctx context.Context, in *GetProductsRequest) (*GetProductsResponse, error) {
+	return a.c.GetProducts(ctx, in, a.opts...)
+}
+
+func (a *productServiceAPI) GetProductByID(ctx context.Context, in *GetProductByIDRequest) (*GetProductByIDResponse, error) {
+	return a.c.GetProductByID(ctx, in, a.opts...)
+}
+
+func (a *productServiceAPI) PostProducts(ctx context.Context, in *PostProductsRequest) (*PostProductsResponse, error) {
+	return a.c.PostProducts(ctx, in, a.opts...)
+}
+
+func (a *productServiceAPI) UpdateProduct(ctx context.Context, in *UpdateProductRequest) (*UpdateProductResponse, error) {
+	return a.c.UpdateProduct(ctx, in, a.opts...)
+}
+
+func (a *productServiceAPI) CreateBundle(ctx context.Context, in *CreateBundleRequest) (*CreateBundleResponse, error) {
+	return a.c.CreateBundle(ctx, in, a.opts...)
+}
+
+func (a *productServiceAPI) ResolveBundle(ctx context.Context, in *ResolveBundleRequest) (*ResolveBundleResponse, error) {
+	return a.c.ResolveBundle(ctx, in, a.opts...)
+}
+
+// ProductServiceClientFromAPI adapts a to ProductServiceClient, e.g. to hand a
+// mock ProductServiceAPI to code that takes the generated client. Call options
+// are ignored, and streams report empty headers and trailers.
+func ProductServiceClientFromAPI(a ProductServiceAPI) ProductServiceClient {
+	return productServiceAPIClient{api: a}
+}
+
+type productServiceAPIClient struct {
+	api ProductServiceAPI
+}
+
+func (c productServiceAPIClient) GetProducts(ctx context.Context, in *GetProductsRequest, _ ...grpc.CallOption) (*GetProductsResponse, error) {
+	return c.api.GetProducts(ctx, in)
+}
+
+func (c productServiceAPIClient) GetProductByID(ctx context.Context, in *GetProductByIDRequest, _ ...grpc.CallOption) (*GetProductByIDResponse, error) {
+	return c.api.GetProductByID(ctx, in)
+}
+
+func (c productServiceAPIClient) PostProducts(ctx context.Context, in *PostProductsRequest, _ ...grpc.CallOption) (*PostProductsResponse, error) {
+	return c.api.PostProducts(ctx, in)
+}
+
+func (c productServiceAPIClient) UpdateProduct(ctx context.Context, in *UpdateProductRequest, _ ...grpc.CallOption) (*UpdateProductResponse, error) {
+	return c.api.UpdateProduct(ctx, in)
+}
+
+func (c productServiceAPIClient) CreateBundle(ctx context.Context, in *CreateBundleRequest, _ ...grpc.CallOption) (*CreateBundleResponse, error) {
+	return c.api.CreateBundle(ctx, in)
+}
+
+func (c productServiceAPIClient) ResolveBundle(ctx context.Context, in *ResolveBundleRequest, _ ...grpc.CallOption) (*ResolveBundleResponse, error) {
+	return c.api.ResolveBundle(ctx, in)
+}
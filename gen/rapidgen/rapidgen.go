@@ -0,0 +1,384 @@
+// Package rapidgen provides pgregory.net/rapid generators for every Escape Ship
+// message, so services can property-test their validation and handlers.
+//
+// Generators are derived from the message descriptors and therefore follow the
+// protos as they grow:
+//
+//	rapid.Check(t, func(t *rapid.T) {
+//	    req := rapidgen.Valid[*pb.InsertOrderRequest]().Draw(t, "req")
+//	    if _, err := srv.InsertOrder(ctx, req); err != nil {
+//	        t.Fatalf("valid request rejected: %v", err)
+//	    }
+//	})
+//
+//	rapid.Check(t, func(t *rapid.T) {
+//	    req := rapidgen.Invalid[*pb.InsertOrderRequest]().Draw(t, "req")
+//	    if _, err := srv.InsertOrder(ctx, req); status.Code(err) != codes.InvalidArgument {
+//	        t.Fatalf("boundary request accepted: %v", req)
+//	    }
+//	})
+package rapidgen
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"pgregory.net/rapid"
+)
+
+// maxDepth bounds recursion into nested messages.
+const maxDepth = 3
+
+// Valid returns a generator of well-formed messages: strings are non-empty and
+// shaped by field name (emails, timestamps, dates, URLs, currency codes),
+// numbers are positive, enums are non-zero, repeated fields hold one to three
+// elements, every oneof has a member set, and field masks name one to three
+// fields of the message they select from.
+func Valid[M proto.Message]() *rapid.Generator[M] {
+	return rapid.Custom(func(t *rapid.T) M {
+		m := newMessage[M]()
+		fillMessage(t, m.ProtoReflect(), 0)
+		return m
+	})
+}
+
+// Invalid returns a generator of messages that are Valid except for exactly one
+// top-level field holding a boundary value: empty or oversized strings,
+// malformed emails, zero, negative or overflowing numbers, unspecified enums,
+// empty lists, unset sub-messages or oneofs, or field masks naming unknown
+// fields. Messages without a field that can be made invalid, such as empty
+// requests, are skipped.
+func Invalid[M proto.Message]() *rapid.Generator[M] {
+	return rapid.Custom(func(t *rapid.T) M {
+		m := newMessage[M]()
+		fillInvalid(t, m.ProtoReflect())
+		return m
+	})
+}
+
+func fillInvalid(t *rapid.T, m protoreflect.Message) {
+	fillMessage(t, m, 0)
+	fields := m.Descriptor().Fields()
+	var candidates []protoreflect.FieldDescriptor
+	for i := 0; i < fields.Len(); i++ {
+		if fd := fields.Get(i); breakable(m, fd) {
+			candidates = append(candidates, fd)
+		}
+	}
+	if len(candidates) == 0 {
+		t.Skipf("%s has no field that can be made invalid", m.Descriptor().FullName())
+	}
+	fd := rapid.SampledFrom(candidates).Draw(t, "invalid_field")
+	breakField(t, m, fd)
+	if fieldValid(m, fd) {
+		panic(fmt.Sprintf("rapidgen: breaking %s left it valid", fd.FullName()))
+	}
+}
+
+func newMessage[M proto.Message]() M {
+	var zero M
+	return zero.ProtoReflect().New().Interface().(M)
+}
+
+func fillMessage(t *rapid.T, m protoreflect.Message, depth int) {
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if oo := fd.ContainingOneof(); oo != nil && !oo.IsSynthetic() {
+			continue
+		}
+		fillField(t, m, fd, depth)
+	}
+	oneofs := m.Descriptor().Oneofs()
+	for i := 0; i < oneofs.Len(); i++ {
+		oo := oneofs.Get(i)
+		if oo.IsSynthetic() {
+			continue
+		}
+		fd := oo.Fields().Get(rapid.IntRange(0, oo.Fields().Len()-1).Draw(t, string(oo.Name())))
+		fillField(t, m, fd, depth)
+	}
+}
+
+func fillField(t *rapid.T, m protoreflect.Message, fd protoreflect.FieldDescriptor, depth int) {
+	if fd.Message() != nil && depth >= maxDepth {
+		return
+	}
+	switch {
+	case fd.IsMap():
+		mp := m.Mutable(fd).Map()
+		n := rapid.IntRange(0, 2).Draw(t, string(fd.Name())+"_len")
+		for i := 0; i < n; i++ {
+			k := scalarValue(t, fd.MapKey(), fd.MapKey().Name())
+			mp.Set(k.MapKey(), elemValue(t, mp.NewValue, fd.MapValue(), fd.Name(), depth))
+		}
+	case fd.IsList():
+		list := m.Mutable(fd).List()
+		n := rapid.IntRange(1, 3).Draw(t, string(fd.Name())+"_len")
+		for i := 0; i < n; i++ {
+			list.Append(elemValue(t, list.NewElement, fd, fd.Name(), depth))
+		}
+	case isFieldMask(fd):
+		paths := m.Mutable(fd).Message()
+		list := paths.Mutable(paths.Descriptor().Fields().ByName("paths")).List()
+		for _, p := range maskPaths(t, maskTarget(m.Descriptor(), fd), string(fd.Name())) {
+			list.Append(protoreflect.ValueOfString(p))
+		}
+	case fd.Message() != nil:
+		fillMessage(t, m.Mutable(fd).Message(), depth+1)
+	default:
+		m.Set(fd, scalarValue(t, fd, fd.Name()))
+	}
+}
+
+func elemValue(t *rapid.T, newElem func() protoreflect.Value, fd protoreflect.FieldDescriptor, name protoreflect.Name, depth int) protoreflect.Value {
+	if fd.Message() == nil {
+		return scalarValue(t, fd, name)
+	}
+	v := newElem()
+	fillMessage(t, v.Message(), depth+1)
+	return v
+}
+
+func scalarValue(t *rapid.T, fd protoreflect.FieldDescriptor, name protoreflect.Name) protoreflect.Value {
+	label := string(name)
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return protoreflect.ValueOfBool(rapid.Bool().Draw(t, label))
+	case protoreflect.EnumKind:
+		vals := fd.Enum().Values()
+		if vals.Len() == 1 {
+			return protoreflect.ValueOfEnum(vals.Get(0).Number())
+		}
+		return protoreflect.ValueOfEnum(vals.Get(rapid.IntRange(1, vals.Len()-1).Draw(t, label)).Number())
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return protoreflect.ValueOfInt32(int32(positive(t, name)))
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return protoreflect.ValueOfInt64(positive(t, name))
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return protoreflect.ValueOfUint32(uint32(positive(t, name)))
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return protoreflect.ValueOfUint64(uint64(positive(t, name)))
+	case protoreflect.FloatKind:
+		return protoreflect.ValueOfFloat32(float32(rapid.Float64Range(0.01, 1).Draw(t, label)))
+	case protoreflect.DoubleKind:
+		return protoreflect.ValueOfFloat64(rapid.Float64Range(0.01, 1).Draw(t, label))
+	case protoreflect.BytesKind:
+		return protoreflect.ValueOfBytes(rapid.SliceOfN(rapid.Byte(), 1, 64).Draw(t, label))
+	default:
+		return protoreflect.ValueOfString(validString(t, name))
+	}
+}
+
+// positive draws a positive number, kept small for quantities and counts so
+// that derived totals don't overflow.
+func positive(t *rapid.T, name protoreflect.Name) int64 {
+	n := string(name)
+	if strings.Contains(n, "quantity") || strings.Contains(n, "count") || strings.Contains(n, "size") ||
+		strings.Contains(n, "days") || strings.Contains(n, "attempts") || strings.Contains(n, "number") {
+		return int64(rapid.IntRange(1, 100).Draw(t, n))
+	}
+	return rapid.Int64Range(1, maxPositive).Draw(t, n)
+}
+
+// maxPositive is the largest number Valid generates.
+const maxPositive = 10_000_000
+
+var (
+	idChars   = rapid.StringMatching(`[a-z0-9]{1,12}`)
+	wordChars = rapid.StringMatching(`[A-Za-z가-힣][A-Za-z가-힣 ]{0,23}`)
+	currency  = rapid.SampledFrom([]string{"KRW", "USD", "JPY", "EUR"})
+	country   = rapid.SampledFrom([]string{"KR", "US", "JP", "DE"})
+	baseTime  = time.Date(2024, 1, 1, 0, 0, 0, 0, time.FixedZone("KST", 9*60*60))
+)
+
+func validString(t *rapid.T, name protoreflect.Name) string {
+	n := string(name)
+	switch {
+	case strings.Contains(n, "email"):
+		return idChars.Draw(t, n) + "@example.com"
+	case strings.HasSuffix(n, "_at"):
+		return baseTime.Add(time.Duration(rapid.IntRange(0, 365*24).Draw(t, n)) * time.Hour).Format(time.RFC3339)
+	case strings.HasSuffix(n, "date") || strings.HasSuffix(n, "_until"):
+		return baseTime.AddDate(0, 0, rapid.IntRange(0, 365).Draw(t, n)).Format(time.DateOnly)
+	case strings.HasSuffix(n, "url"):
+		return "https://example.com/" + idChars.Draw(t, n)
+	case strings.Contains(n, "currency"):
+		return currency.Draw(t, n)
+	case strings.Contains(n, "country"):
+		return country.Draw(t, n)
+	case n == "id" || strings.HasSuffix(n, "_id") || strings.HasSuffix(n, "_token") || n == "token" || n == "tid":
+		return n + "-" + idChars.Draw(t, n)
+	default:
+		return wordChars.Draw(t, n)
+	}
+}
+
+func isFieldMask(fd protoreflect.FieldDescriptor) bool {
+	return fd.Message() != nil && fd.Message().FullName() == "google.protobuf.FieldMask"
+}
+
+// maskTarget returns the message a field mask in md selects fields of. A
+// read_mask addresses the resource the RPC taking md returns (e.g. Order for
+// GetAllOrdersRequest); an update_mask addresses md's only resource field
+// (e.g. UserPreferences for SetPreferencesRequest), or md itself when it has
+// none, as UpdateReviewRequest does.
+func maskTarget(md protoreflect.MessageDescriptor, mask protoreflect.FieldDescriptor) protoreflect.MessageDescriptor {
+	if mask.Name() == "read_mask" {
+		services := md.ParentFile().Services()
+		for i := 0; i < services.Len(); i++ {
+			methods := services.Get(i).Methods()
+			for j := 0; j < methods.Len(); j++ {
+				if mt := methods.Get(j); mt.Input().FullName() == md.FullName() {
+					if target := resourceField(mt.Output(), nil); target != nil {
+						return target
+					}
+				}
+			}
+		}
+	}
+	if target := resourceField(md, mask); target != nil {
+		return target
+	}
+	return md
+}
+
+// resourceField returns the message type of the first message field of md
+// declared in the same package, other than skip.
+func resourceField(md protoreflect.MessageDescriptor, skip protoreflect.FieldDescriptor) protoreflect.MessageDescriptor {
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd != skip && fd.Message() != nil && !fd.IsMap() && fd.Message().ParentFile().Package() == md.ParentFile().Package() {
+			return fd.Message()
+		}
+	}
+	return nil
+}
+
+// maskPaths draws one to three distinct top-level field names of target other
+// than field masks.
+func maskPaths(t *rapid.T, target protoreflect.MessageDescriptor, label string) []string {
+	var names []string
+	fields := target.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		if fd := fields.Get(i); !isFieldMask(fd) {
+			names = append(names, string(fd.Name()))
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+	return rapid.SliceOfNDistinct(rapid.SampledFrom(names), 1, min(3, len(names)), rapid.ID[string]).Draw(t, label)
+}
+
+// breakable reports whether breakField can make fd invalid: bools and maps
+// have no invalid values, and clearing a oneof member that is not set changes
+// nothing.
+func breakable(m protoreflect.Message, fd protoreflect.FieldDescriptor) bool {
+	switch {
+	case fd.IsMap(), fd.Kind() == protoreflect.BoolKind:
+		return false
+	case fd.Kind() == protoreflect.EnumKind:
+		return fd.Enum().Values().Len() > 1
+	case fd.Message() != nil && !fd.IsList():
+		return m.Has(fd)
+	}
+	return true
+}
+
+// fieldValid reports whether fd of m satisfies what Valid generates. Only the
+// field itself is checked, not the contents of sub-messages or list elements.
+func fieldValid(m protoreflect.Message, fd protoreflect.FieldDescriptor) bool {
+	if oo := fd.ContainingOneof(); oo != nil && !oo.IsSynthetic() {
+		if set := m.WhichOneof(oo); set == nil || set != fd {
+			return set != nil
+		}
+	}
+	v := m.Get(fd)
+	switch {
+	case fd.IsMap():
+		return true
+	case fd.IsList():
+		return v.List().Len() > 0
+	case isFieldMask(fd):
+		paths := v.Message().Get(v.Message().Descriptor().Fields().ByName("paths")).List()
+		target := maskTarget(m.Descriptor(), fd)
+		for i := 0; i < paths.Len(); i++ {
+			if target.Fields().ByName(protoreflect.Name(paths.Get(i).String())) == nil {
+				return false
+			}
+		}
+		return paths.Len() > 0
+	case fd.Message() != nil:
+		return m.Has(fd)
+	}
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return true
+	case protoreflect.EnumKind:
+		return v.Enum() != 0 || fd.Enum().Values().Len() == 1
+	case protoreflect.StringKind:
+		s := v.String()
+		if strings.TrimSpace(s) == "" || len(s) > 10_000 {
+			return false
+		}
+		return !strings.Contains(string(fd.Name()), "email") || strings.Contains(s, "@")
+	case protoreflect.BytesKind:
+		return len(v.Bytes()) > 0
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		f := v.Float()
+		return f > 0 && f <= 1
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return v.Uint() > 0 && v.Uint() <= maxPositive
+	}
+	return v.Int() > 0 && v.Int() <= maxPositive
+}
+
+// breakField replaces fd in m with a boundary value.
+func breakField(t *rapid.T, m protoreflect.Message, fd protoreflect.FieldDescriptor) {
+	label := "invalid_" + string(fd.Name())
+	switch {
+	case isFieldMask(fd) && rapid.Bool().Draw(t, label):
+		mask := m.Mutable(fd).Message()
+		paths := mask.NewField(mask.Descriptor().Fields().ByName("paths")).List()
+		paths.Append(protoreflect.ValueOfString("no_such_field"))
+		mask.Set(mask.Descriptor().Fields().ByName("paths"), protoreflect.ValueOfList(paths))
+	case fd.IsList() || fd.IsMap() || fd.Message() != nil:
+		m.Clear(fd)
+	case fd.Kind() == protoreflect.StringKind:
+		choices := []string{"", " ", strings.Repeat("x", 10_001)}
+		if strings.Contains(string(fd.Name()), "email") {
+			choices = append(choices, "not-an-email")
+		}
+		m.Set(fd, protoreflect.ValueOfString(rapid.SampledFrom(choices).Draw(t, label)))
+	case fd.Kind() == protoreflect.BytesKind:
+		m.Set(fd, protoreflect.ValueOfBytes([]byte{}))
+	case fd.Kind() == protoreflect.EnumKind:
+		m.Set(fd, protoreflect.ValueOfEnum(0))
+	default:
+		m.Set(fd, boundaryNumber(t, fd, label))
+	}
+}
+
+func boundaryNumber(t *rapid.T, fd protoreflect.FieldDescriptor, label string) protoreflect.Value {
+	switch fd.Kind() {
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return protoreflect.ValueOfInt32(rapid.SampledFrom([]int32{0, -1, math.MinInt32, math.MaxInt32}).Draw(t, label))
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return protoreflect.ValueOfInt64(rapid.SampledFrom([]int64{0, -1, math.MinInt64, math.MaxInt64}).Draw(t, label))
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return protoreflect.ValueOfUint32(rapid.SampledFrom([]uint32{0, math.MaxUint32}).Draw(t, label))
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return protoreflect.ValueOfUint64(rapid.SampledFrom([]uint64{0, math.MaxUint64}).Draw(t, label))
+	case protoreflect.FloatKind:
+		return protoreflect.ValueOfFloat32(rapid.SampledFrom([]float32{0, -1, float32(math.Inf(1)), float32(math.NaN())}).Draw(t, label))
+	case protoreflect.DoubleKind:
+		return protoreflect.ValueOfFloat64(rapid.SampledFrom([]float64{0, -1, math.Inf(1), math.NaN()}).Draw(t, label))
+	}
+	panic(fmt.Sprintf("rapidgen: unexpected kind %v", fd.Kind()))
+}
@@ -0,0 +1,141 @@
+package rapidgen
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"pgregory.net/rapid"
+
+	pb "github.com/escape-ship/protos/gen"
+)
+
+// messageTypes returns every message of the Escape Ship package.
+func messageTypes() []protoreflect.MessageType {
+	var types []protoreflect.MessageType
+	protoregistry.GlobalTypes.RangeMessages(func(mt protoreflect.MessageType) bool {
+		if mt.Descriptor().ParentFile().Package() == "go.escape.ship.proto.v1" {
+			types = append(types, mt)
+		}
+		return true
+	})
+	return types
+}
+
+// canBreak reports whether md has a field with invalid values.
+func canBreak(md protoreflect.MessageDescriptor) bool {
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		switch {
+		case fd.IsMap(), fd.Kind() == protoreflect.BoolKind:
+		case fd.Kind() == protoreflect.EnumKind && fd.Enum().Values().Len() == 1:
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+func TestValidFieldsConform(t *testing.T) {
+	for _, mt := range messageTypes() {
+		t.Run(string(mt.Descriptor().Name()), func(t *testing.T) {
+			rapid.Check(t, func(t *rapid.T) {
+				m := mt.New()
+				fillMessage(t, m, 0)
+				fields := m.Descriptor().Fields()
+				for i := 0; i < fields.Len(); i++ {
+					if fd := fields.Get(i); !fieldValid(m, fd) {
+						t.Fatalf("Valid generated invalid %s: %v", fd.Name(), m.Interface())
+					}
+				}
+			})
+		})
+	}
+}
+
+func TestInvalidBreaksEveryMessage(t *testing.T) {
+	for _, mt := range messageTypes() {
+		t.Run(string(mt.Descriptor().Name()), func(t *testing.T) {
+			if !canBreak(mt.Descriptor()) {
+				t.Skip("no field can be made invalid")
+			}
+			rapid.Check(t, func(t *rapid.T) {
+				fillInvalid(t, mt.New())
+			})
+		})
+	}
+}
+
+func TestInvalidBreaksOneField(t *testing.T) {
+	tests := []struct {
+		name string
+		gen  func(*rapid.T) proto.Message
+	}{
+		{"InsertOrderRequest", func(t *rapid.T) proto.Message { return Invalid[*pb.InsertOrderRequest]().Draw(t, "m") }},
+		{"GetAllOrdersRequest", func(t *rapid.T) proto.Message { return Invalid[*pb.GetAllOrdersRequest]().Draw(t, "m") }},
+		{"KakaoReadyRequest", func(t *rapid.T) proto.Message { return Invalid[*pb.KakaoReadyRequest]().Draw(t, "m") }},
+		{"PreparePaymentRequest", func(t *rapid.T) proto.Message { return Invalid[*pb.PreparePaymentRequest]().Draw(t, "m") }},
+		{"RegisterRequest", func(t *rapid.T) proto.Message { return Invalid[*pb.RegisterRequest]().Draw(t, "m") }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rapid.Check(t, func(t *rapid.T) {
+				m := tt.gen(t).ProtoReflect()
+				fields := m.Descriptor().Fields()
+				// A oneof counts once however many members it has.
+				broken := map[protoreflect.FullName]bool{}
+				for i := 0; i < fields.Len(); i++ {
+					fd := fields.Get(i)
+					if fieldValid(m, fd) {
+						continue
+					}
+					if oo := fd.ContainingOneof(); oo != nil && !oo.IsSynthetic() {
+						broken[oo.FullName()] = true
+					} else {
+						broken[fd.FullName()] = true
+					}
+				}
+				if invalid := len(broken); invalid != 1 {
+					t.Fatalf("Invalid broke %d fields, want 1: %v", invalid, m.Interface())
+				}
+			})
+		})
+	}
+}
+
+func TestValidReadMasksApply(t *testing.T) {
+	tests := []struct {
+		name  string
+		apply func(*rapid.T) error
+	}{
+		{"GetAllOrdersRequest", func(t *rapid.T) error {
+			return pb.ApplyReadMask(&pb.Order{}, Valid[*pb.GetAllOrdersRequest]().Draw(t, "req").GetReadMask())
+		}},
+		{"GetOrderByIDRequest", func(t *rapid.T) error {
+			return pb.ApplyReadMask(&pb.Order{}, Valid[*pb.GetOrderByIDRequest]().Draw(t, "req").GetReadMask())
+		}},
+		{"GetOrdersByUserRequest", func(t *rapid.T) error {
+			return pb.ApplyReadMask(&pb.Order{}, Valid[*pb.GetOrdersByUserRequest]().Draw(t, "req").GetReadMask())
+		}},
+		{"GetProductsRequest", func(t *rapid.T) error {
+			return pb.ApplyReadMask(&pb.Product{}, Valid[*pb.GetProductsRequest]().Draw(t, "req").GetReadMask())
+		}},
+		{"SetPreferencesRequest", func(t *rapid.T) error {
+			return pb.ApplyReadMask(&pb.UserPreferences{}, Valid[*pb.SetPreferencesRequest]().Draw(t, "req").GetUpdateMask())
+		}},
+		{"UpdateReviewRequest", func(t *rapid.T) error {
+			return pb.ApplyReadMask(&pb.UpdateReviewRequest{}, Valid[*pb.UpdateReviewRequest]().Draw(t, "req").GetUpdateMask())
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rapid.Check(t, func(t *rapid.T) {
+				if err := tt.apply(t); err != nil {
+					t.Fatalf("valid mask rejected: %v", err)
+				}
+			})
+		})
+	}
+}
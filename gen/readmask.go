@@ -0,0 +1,113 @@
+package gen
+
+import (
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// ApplyReadMask clears every field of m that is not selected by mask.
+//
+// Paths are relative to m and may descend into nested messages and repeated
+// message fields (e.g. "items.product_name" on an Order). A nil or empty mask
+// leaves m untouched so clients that don't send read_mask keep receiving full
+// messages. An unknown path yields an InvalidArgument status error.
+func ApplyReadMask(m proto.Message, mask *fieldmaskpb.FieldMask) error {
+	if len(mask.GetPaths()) == 0 {
+		return nil
+	}
+	md := m.ProtoReflect().Descriptor()
+	for _, p := range mask.GetPaths() {
+		if !validMaskPath(md, p) {
+			return status.Errorf(codes.InvalidArgument, "invalid read_mask path %q for %s", p, md.FullName())
+		}
+	}
+	pruneMessage(m.ProtoReflect(), newMaskTree(mask.GetPaths()))
+	return nil
+}
+
+// ApplyReadMaskAll applies mask to every element of msgs, as used by list RPCs
+// such as GetAllOrders and GetProducts where read_mask addresses the element type.
+func ApplyReadMaskAll[M proto.Message](msgs []M, mask *fieldmaskpb.FieldMask) error {
+	for _, m := range msgs {
+		if err := ApplyReadMask(m, mask); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validMaskPath reports whether path names a field of md. Unlike
+// fieldmaskpb.IsValid it allows descending into repeated message fields.
+func validMaskPath(md protoreflect.MessageDescriptor, path string) bool {
+	for _, seg := range strings.Split(path, ".") {
+		if md == nil {
+			return false
+		}
+		fd := md.Fields().ByName(protoreflect.Name(seg))
+		if fd == nil {
+			return false
+		}
+		md = nil
+		if !fd.IsMap() {
+			md = fd.Message()
+		}
+	}
+	return true
+}
+
+// maskTree is a field mask split into path segments. A node without children
+// selects the whole field.
+type maskTree map[protoreflect.Name]maskTree
+
+// newMaskTree builds the tree of paths. A path selecting a whole field wins
+// over paths into it, so "items" and "items.product_name" keep all of items.
+func newMaskTree(paths []string) maskTree {
+	root := maskTree{}
+	for _, p := range paths {
+		node := root
+		segs := strings.Split(p, ".")
+		for i, seg := range segs {
+			name := protoreflect.Name(seg)
+			child, ok := node[name]
+			switch {
+			case ok && child == nil:
+				// Already selected as a whole.
+			case i == len(segs)-1:
+				node[name] = nil
+			case !ok:
+				child = maskTree{}
+				node[name] = child
+			}
+			if child == nil {
+				break
+			}
+			node = child
+		}
+	}
+	return root
+}
+
+func pruneMessage(m protoreflect.Message, tree maskTree) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		sub, ok := tree[fd.Name()]
+		switch {
+		case !ok:
+			m.Clear(fd)
+		case len(sub) == 0 || fd.Message() == nil || fd.IsMap():
+			// Field selected as a whole.
+		case fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				pruneMessage(list.Get(i).Message(), sub)
+			}
+		default:
+			pruneMessage(v.Message(), sub)
+		}
+		return true
+	})
+}
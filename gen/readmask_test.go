@@ -0,0 +1,77 @@
+package gen
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestApplyReadMask(t *testing.T) {
+	order := func() *Order {
+		return &Order{
+			Id:          "o1",
+			UserId:      "u1",
+			Memo:        "memo",
+			OrderStatus: OrderStatus_ORDER_STATUS_PAID,
+			Customs:     &CustomsDeclaration{},
+			Items: []*OrderItem{
+				{Id: "i1", ProductId: "p1", ProductName: "shirt", Quantity: 2},
+				{Id: "i2", ProductId: "p2", ProductName: "hat", Quantity: 1},
+			},
+		}
+	}
+	tests := []struct {
+		name  string
+		paths []string
+		want  *Order
+		code  codes.Code
+	}{
+		{name: "empty mask", paths: nil, want: order()},
+		{name: "top level", paths: []string{"id", "memo"}, want: &Order{Id: "o1", Memo: "memo"}},
+		{
+			name:  "repeated message",
+			paths: []string{"id", "items.product_name"},
+			want:  &Order{Id: "o1", Items: []*OrderItem{{ProductName: "shirt"}, {ProductName: "hat"}}},
+		},
+		{
+			name:  "whole field wins over nested path",
+			paths: []string{"items.product_name", "items"},
+			want:  &Order{Items: order().Items},
+		},
+		{
+			name:  "nested path after whole field",
+			paths: []string{"items", "items.product_name"},
+			want:  &Order{Items: order().Items},
+		},
+		{name: "unknown field", paths: []string{"nope"}, code: codes.InvalidArgument},
+		{name: "unknown nested field", paths: []string{"items.nope"}, code: codes.InvalidArgument},
+		{name: "descend into scalar", paths: []string{"memo.x"}, code: codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := order()
+			err := ApplyReadMask(got, &fieldmaskpb.FieldMask{Paths: tt.paths})
+			if status.Code(err) != tt.code {
+				t.Fatalf("ApplyReadMask() error = %v, want code %v", err, tt.code)
+			}
+			if err == nil && !proto.Equal(got, tt.want) {
+				t.Errorf("ApplyReadMask() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyReadMaskAll(t *testing.T) {
+	orders := []*Order{{Id: "a", Memo: "x"}, {Id: "b", Memo: "y"}}
+	if err := ApplyReadMaskAll(orders, &fieldmaskpb.FieldMask{Paths: []string{"id"}}); err != nil {
+		t.Fatal(err)
+	}
+	for _, o := range orders {
+		if o.GetMemo() != "" || o.GetId() == "" {
+			t.Errorf("ApplyReadMaskAll() left %v", o)
+		}
+	}
+}
@@ -0,0 +1,282 @@
+package gen
+
+import (
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Cancellable reports whether CancelOrder may cancel the order: it has not
+// shipped, been cancelled or been refunded yet.
+func (o *Order) Cancellable() bool {
+	switch o.EffectiveStatus() {
+	case OrderStatus_ORDER_STATUS_PENDING, OrderStatus_ORDER_STATUS_PAID:
+		return true
+	}
+	return false
+}
+
+// CheckCancellable returns a FailedPrecondition error with
+// ERROR_REASON_ORDER_NOT_CANCELLABLE unless the order is Cancellable.
+func (o *Order) CheckCancellable() error {
+	if o.Cancellable() {
+		return nil
+	}
+	return NewError(codes.FailedPrecondition, ErrorReason_ERROR_REASON_ORDER_NOT_CANCELLABLE,
+		"order "+o.GetId()+" is "+o.EffectiveStatus().String(), map[string]string{"order_id": o.GetId()})
+}
+
+// RefundableAmount returns the part of the paid total (total_price, in KRW)
+// not yet refunded or being refunded. Unpaid and cancelled orders have
+// nothing to refund.
+func (o *Order) RefundableAmount() (*Money, error) {
+	switch o.EffectiveStatus() {
+	case OrderStatus_ORDER_STATUS_PAID, OrderStatus_ORDER_STATUS_SHIPPED, OrderStatus_ORDER_STATUS_DELIVERED:
+	default:
+		return KRW(0), nil
+	}
+	left := KRW(o.GetTotalPrice())
+	for _, r := range o.GetRefunds() {
+		if r.GetStatus() == RefundStatus_REFUND_STATUS_FAILED {
+			continue
+		}
+		var err error
+		if left, err = left.Sub(MoneyOr(r.GetAmount(), 0)); err != nil {
+			return nil, err
+		}
+	}
+	if left.IsNegative() {
+		return KRW(0), nil
+	}
+	return left, nil
+}
+
+// CheckRefund returns an InvalidArgument error unless amount is a positive
+// KRW amount, and a FailedPrecondition error with
+// ERROR_REASON_REFUND_EXCEEDS_PAYMENT if it exceeds RefundableAmount.
+func (o *Order) CheckRefund(amount *Money) error {
+	if err := checkRefundAmount("amount", amount, true); err != nil {
+		return err
+	}
+	refundable, err := o.RefundableAmount()
+	if err != nil {
+		return err
+	}
+	left, err := refundable.Sub(amount)
+	if err != nil {
+		return err
+	}
+	if left.IsNegative() {
+		return NewError(codes.FailedPrecondition, ErrorReason_ERROR_REASON_REFUND_EXCEEDS_PAYMENT,
+			"refund of "+amount.Format()+" exceeds refundable "+refundable.Format(),
+			map[string]string{"refundable": refundable.Format()})
+	}
+	return nil
+}
+
+// NewKakaoCancelRequest returns the PaymentService.KakaoCancel request that
+// carries out refund r of order o. Call it before recording r on the order,
+// since cancel_available is the amount refundable before r. The merchant
+// code is r's cid, falling back to the order's payment_cid, so the cancel
+// goes to the merchant the order was paid with. The deprecated int64 amount
+// fields are filled too for payment services not yet reading the Money
+// fields. r's amount must be a positive KRW amount that its tax-free and VAT
+// amounts do not add up to more than; otherwise it returns InvalidArgument.
+func NewKakaoCancelRequest(o *Order, r *Refund) (*KakaoCancelRequest, error) {
+	if err := checkRefundAmounts(r); err != nil {
+		return nil, err
+	}
+	available, err := o.RefundableAmount()
+	if err != nil {
+		return nil, err
+	}
+	req := &KakaoCancelRequest{
+		PartnerOrderId:  r.GetPartnerOrderId(),
+		Cancel:          MoneyOr(r.GetAmount(), 0),
+		CancelTaxFree:   MoneyOr(r.GetTaxFreeAmount(), 0),
+		CancelVat:       MoneyOr(r.GetVatAmount(), 0),
+		CancelAvailable: available,
+		Cid:             r.GetCid(),
+	}
+	if req.PartnerOrderId == "" {
+		req.PartnerOrderId = o.GetId()
+	}
+	if req.Cid == "" {
+		req.Cid = o.GetPaymentCid()
+	}
+	if err := fillLegacyCancelAmounts(req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// checkRefundAmounts checks the amounts of refund r the way
+// Payment.CheckCancel checks a CancelPaymentRequest.
+func checkRefundAmounts(r *Refund) error {
+	if err := checkRefundAmount("amount", r.GetAmount(), true); err != nil {
+		return err
+	}
+	parts := KRW(0)
+	for _, f := range []struct {
+		name string
+		m    *Money
+	}{{"tax_free_amount", r.GetTaxFreeAmount()}, {"vat_amount", r.GetVatAmount()}} {
+		if f.m == nil {
+			continue
+		}
+		if err := checkRefundAmount(f.name, f.m, false); err != nil {
+			return err
+		}
+		var err error
+		if parts, err = parts.Add(f.m); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if rest, err := r.GetAmount().Sub(parts); err != nil || rest.IsNegative() {
+		return status.Errorf(codes.InvalidArgument, "tax_free_amount and vat_amount add up to more than the refund amount %s", r.GetAmount().Format())
+	}
+	return nil
+}
+
+// checkRefundAmount returns an InvalidArgument error unless m is a KRW
+// amount that is positive, or with positive false, not negative.
+func checkRefundAmount(field string, m *Money, positive bool) error {
+	switch {
+	case m == nil:
+		return status.Errorf(codes.InvalidArgument, "%s is required", field)
+	case m.GetCurrencyCode() != "KRW":
+		return status.Errorf(codes.InvalidArgument, "%s is in %s but the order is in KRW", field, m.GetCurrencyCode())
+	case m.IsNegative():
+		return status.Errorf(codes.InvalidArgument, "%s must not be negative", field)
+	case positive && m.IsZero():
+		return status.Errorf(codes.InvalidArgument, "%s must be positive", field)
+	}
+	return nil
+}
+
+// fillLegacyCancelAmounts sets the deprecated int64 amount fields of req from
+// its Money fields that are set. They must be whole KRW amounts.
+func fillLegacyCancelAmounts(req *KakaoCancelRequest) error {
+	amount, err := req.GetCancel().KRWUnits()
+	if err != nil {
+		return err
+	}
+	req.CancelAmount = strconv.FormatInt(amount, 10)
+	if req.CancelTaxFree != nil {
+		if req.CancelTaxFreeAmount, err = req.CancelTaxFree.KRWUnits(); err != nil {
+			return err
+		}
+	}
+	if req.CancelVat != nil {
+		if req.CancelVatAmount, err = req.CancelVat.KRWUnits(); err != nil {
+			return err
+		}
+	}
+	if req.CancelAvailable != nil {
+		if req.CancelAvailableAmount, err = req.CancelAvailable.KRWUnits(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RecordRefund adds r to the order's refunds, replacing an earlier entry with
+// the same id (e.g. when a PENDING refund completes). It then recomputes
+// refunded_amount from the completed refunds and marks the order REFUNDED
+// once the whole total has been refunded.
+func (o *Order) RecordRefund(r *Refund) error {
+	replaced := false
+	for i, prev := range o.Refunds {
+		if prev.GetId() == r.GetId() {
+			o.Refunds[i], replaced = r, true
+		}
+	}
+	if !replaced {
+		o.Refunds = append(o.Refunds, r)
+	}
+	refunded := KRW(0)
+	for _, prev := range o.Refunds {
+		if prev.GetStatus() != RefundStatus_REFUND_STATUS_COMPLETED {
+			continue
+		}
+		var err error
+		if refunded, err = refunded.Add(MoneyOr(prev.GetAmount(), 0)); err != nil {
+			return err
+		}
+	}
+	o.RefundedAmount = refunded
+	if proto.Equal(refunded, KRW(o.GetTotalPrice())) && !refunded.IsZero() {
+		o.OrderStatus = OrderStatus_ORDER_STATUS_REFUNDED
+		o.Status = o.OrderStatus.Legacy()
+	}
+	return nil
+}
+
+// AllocateRefund returns a PENDING refund of the given order items of o,
+// priced from the order's PriceOrder result. Each RefundItem needs
+// order_item_id (the line_id used when pricing) and quantity; AllocateRefund
+// fills in its amount.
+//
+// A line's refund is its share of the line total after discounts, so item
+// refunds match the discount allocation of pricing. Shares are rounded down to
+// the won and the last units returned take up the rest, so refunding a line
+// in several returns adds up to exactly its total. Quantities refunded by
+// earlier refunds of o that did not fail are taken into account. The shipping
+// fee is refunded only if refundShipping is set (e.g. the seller is at fault)
+// and no earlier refund included it. The refunds of tax_free lines make up
+// the tax-free amount, and the VAT is split from the rest.
+func AllocateRefund(o *Order, priced *PriceOrderResponse, items []*RefundItem, refundShipping bool) (*Refund, error) {
+	lines := make(map[string]*PricedLine, len(priced.GetLines()))
+	for _, l := range priced.GetLines() {
+		lines[l.GetLineId()] = l
+	}
+	returned := make(map[string]int32)
+	shippingRefunded := false
+	for _, r := range o.GetRefunds() {
+		if r.GetStatus() == RefundStatus_REFUND_STATUS_FAILED {
+			continue
+		}
+		for _, it := range r.GetItems() {
+			returned[it.GetOrderItemId()] += it.GetQuantity()
+		}
+		shippingRefunded = shippingRefunded || !r.GetShippingAmount().IsZero()
+	}
+
+	refund := &Refund{OrderId: o.GetId(), Status: RefundStatus_REFUND_STATUS_PENDING}
+	total, taxFree := int64(0), int64(0)
+	for _, it := range items {
+		l, ok := lines[it.GetOrderItemId()]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "order item %q was not priced", it.GetOrderItemId())
+		}
+		prev, qty, n := int64(returned[l.GetLineId()]), int64(l.GetQuantity()), int64(it.GetQuantity())
+		if n <= 0 || prev+n > qty {
+			return nil, status.Errorf(codes.FailedPrecondition, "order item %q: cannot refund %d of %d units, %d already refunded",
+				l.GetLineId(), n, qty, prev)
+		}
+		lineTotal, err := MoneyOr(l.GetTotal(), 0).KRWUnits()
+		if err != nil {
+			return nil, err
+		}
+		amount := mulDiv(lineTotal, prev+n, qty, RoundDown) - mulDiv(lineTotal, prev, qty, RoundDown)
+		returned[l.GetLineId()] += int32(n)
+		total += amount
+		if l.GetTaxFree() {
+			taxFree += amount
+		}
+		refund.Items = append(refund.Items, &RefundItem{OrderItemId: l.GetLineId(), Quantity: int32(n), Amount: KRW(amount)})
+	}
+	if refundShipping && !shippingRefunded {
+		shipping, err := MoneyOr(priced.GetShippingFee(), 0).KRWUnits()
+		if err != nil {
+			return nil, err
+		}
+		refund.ShippingAmount = KRW(shipping)
+		total += shipping
+	}
+	_, vat := SplitVAT(total, taxFree)
+	refund.Amount, refund.TaxFreeAmount, refund.VatAmount = KRW(total), KRW(taxFree), KRW(vat)
+	return refund, nil
+}
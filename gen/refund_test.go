@@ -0,0 +1,278 @@
+package gen
+
+import (
+	"slices"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func paidOrder(refunds ...*Refund) *Order {
+	return &Order{Id: "o-1", OrderStatus: OrderStatus_ORDER_STATUS_PAID, TotalPrice: 50000, Refunds: refunds}
+}
+
+func TestCheckCancellable(t *testing.T) {
+	tests := []struct {
+		status OrderStatus
+		want   codes.Code
+	}{
+		{OrderStatus_ORDER_STATUS_PENDING, codes.OK},
+		{OrderStatus_ORDER_STATUS_PAID, codes.OK},
+		{OrderStatus_ORDER_STATUS_SHIPPED, codes.FailedPrecondition},
+		{OrderStatus_ORDER_STATUS_CANCELLED, codes.FailedPrecondition},
+		{OrderStatus_ORDER_STATUS_REFUNDED, codes.FailedPrecondition},
+	}
+	for _, tt := range tests {
+		err := (&Order{Id: "o-1", OrderStatus: tt.status}).CheckCancellable()
+		if got := status.Code(err); got != tt.want {
+			t.Errorf("CheckCancellable(%v) = %v, want %v", tt.status, err, tt.want)
+		}
+		if err != nil && ErrorReasonOf(err) != ErrorReason_ERROR_REASON_ORDER_NOT_CANCELLABLE {
+			t.Errorf("CheckCancellable(%v) reason = %v", tt.status, ErrorReasonOf(err))
+		}
+	}
+}
+
+func TestRefundableAmount(t *testing.T) {
+	tests := []struct {
+		name  string
+		order *Order
+		want  int64
+	}{
+		{"paid", paidOrder(), 50000},
+		{"pending payment", &Order{OrderStatus: OrderStatus_ORDER_STATUS_PENDING, TotalPrice: 50000}, 0},
+		{"cancelled", &Order{OrderStatus: OrderStatus_ORDER_STATUS_CANCELLED, TotalPrice: 50000}, 0},
+		{"delivered", &Order{OrderStatus: OrderStatus_ORDER_STATUS_DELIVERED, TotalPrice: 50000}, 50000},
+		{"partly refunded", paidOrder(
+			&Refund{Status: RefundStatus_REFUND_STATUS_COMPLETED, Amount: KRW(10000)},
+			&Refund{Status: RefundStatus_REFUND_STATUS_PENDING, Amount: KRW(5000)},
+			&Refund{Status: RefundStatus_REFUND_STATUS_FAILED, Amount: KRW(20000)},
+		), 35000},
+		{"over refunded", paidOrder(&Refund{Status: RefundStatus_REFUND_STATUS_COMPLETED, Amount: KRW(60000)}), 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.order.RefundableAmount()
+			if err != nil || !proto.Equal(got, KRW(tt.want)) {
+				t.Errorf("RefundableAmount() = %v, %v, want %d", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckRefund(t *testing.T) {
+	o := paidOrder(&Refund{Status: RefundStatus_REFUND_STATUS_COMPLETED, Amount: KRW(30000)})
+	tests := []struct {
+		amount *Money
+		want   codes.Code
+	}{
+		{KRW(20000), codes.OK},
+		{KRW(20001), codes.FailedPrecondition},
+		{KRW(0), codes.InvalidArgument},
+		{KRW(-5000), codes.InvalidArgument},
+		{nil, codes.InvalidArgument},
+		{&Money{CurrencyCode: "USD", Units: 1}, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		err := o.CheckRefund(tt.amount)
+		if got := status.Code(err); got != tt.want {
+			t.Errorf("CheckRefund(%v) = %v, want %v", tt.amount, err, tt.want)
+		}
+		if tt.want == codes.FailedPrecondition && ErrorReasonOf(err) != ErrorReason_ERROR_REASON_REFUND_EXCEEDS_PAYMENT {
+			t.Errorf("CheckRefund(%v) reason = %v", tt.amount, ErrorReasonOf(err))
+		}
+	}
+}
+
+func TestNewKakaoCancelRequest(t *testing.T) {
+	o := paidOrder(&Refund{Status: RefundStatus_REFUND_STATUS_COMPLETED, Amount: KRW(10000)})
+	o.PaymentCid = "TCSUBSCRIP"
+	tests := []struct {
+		name   string
+		refund *Refund
+		want   *KakaoCancelRequest
+		code   codes.Code
+	}{
+		{
+			name:   "partial",
+			refund: &Refund{Amount: KRW(11000), TaxFreeAmount: KRW(0), VatAmount: KRW(1000)},
+			want: &KakaoCancelRequest{
+				PartnerOrderId: "o-1", Cid: "TCSUBSCRIP",
+				Cancel: KRW(11000), CancelAmount: "11000",
+				CancelTaxFree: KRW(0),
+				CancelVat:     KRW(1000), CancelVatAmount: 1000,
+				CancelAvailable: KRW(40000), CancelAvailableAmount: 40000,
+			},
+		},
+		{
+			name:   "partner order id",
+			refund: &Refund{PartnerOrderId: "po-9", Amount: KRW(500)},
+			want: &KakaoCancelRequest{
+				PartnerOrderId: "po-9", Cid: "TCSUBSCRIP",
+				Cancel: KRW(500), CancelAmount: "500",
+				CancelTaxFree: KRW(0), CancelVat: KRW(0),
+				CancelAvailable: KRW(40000), CancelAvailableAmount: 40000,
+			},
+		},
+		{
+			name:   "refund cid",
+			refund: &Refund{Cid: "TC0ONETIME", Amount: KRW(500)},
+			want: &KakaoCancelRequest{
+				PartnerOrderId: "o-1", Cid: "TC0ONETIME",
+				Cancel: KRW(500), CancelAmount: "500",
+				CancelTaxFree: KRW(0), CancelVat: KRW(0),
+				CancelAvailable: KRW(40000), CancelAvailableAmount: 40000,
+			},
+		},
+		{name: "not KRW", refund: &Refund{Amount: &Money{CurrencyCode: "USD", Units: 1}}, code: codes.InvalidArgument},
+		{name: "negative", refund: &Refund{Amount: KRW(-5000)}, code: codes.InvalidArgument},
+		{name: "zero", refund: &Refund{Amount: KRW(0)}, code: codes.InvalidArgument},
+		{name: "no amount", refund: &Refund{}, code: codes.InvalidArgument},
+		{name: "negative vat", refund: &Refund{Amount: KRW(500), VatAmount: KRW(-50)}, code: codes.InvalidArgument},
+		{name: "tax free not KRW", refund: &Refund{Amount: KRW(500), TaxFreeAmount: &Money{CurrencyCode: "USD"}}, code: codes.InvalidArgument},
+		{name: "parts exceed amount", refund: &Refund{Amount: KRW(500), TaxFreeAmount: KRW(300), VatAmount: KRW(300)}, code: codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewKakaoCancelRequest(o, tt.refund)
+			if status.Code(err) != tt.code {
+				t.Fatalf("NewKakaoCancelRequest() error = %v, want code %v", err, tt.code)
+			}
+			if err == nil && !proto.Equal(got, tt.want) {
+				t.Errorf("NewKakaoCancelRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecordRefund(t *testing.T) {
+	o := paidOrder()
+	steps := []struct {
+		refund       *Refund
+		wantRefunded int64
+		wantStatus   OrderStatus
+		wantRefunds  int
+	}{
+		{&Refund{Id: "r1", Status: RefundStatus_REFUND_STATUS_PENDING, Amount: KRW(20000)}, 0, OrderStatus_ORDER_STATUS_PAID, 1},
+		{&Refund{Id: "r1", Status: RefundStatus_REFUND_STATUS_COMPLETED, Amount: KRW(20000)}, 20000, OrderStatus_ORDER_STATUS_PAID, 1},
+		{&Refund{Id: "r2", Status: RefundStatus_REFUND_STATUS_FAILED, Amount: KRW(30000)}, 20000, OrderStatus_ORDER_STATUS_PAID, 2},
+		{&Refund{Id: "r3", Status: RefundStatus_REFUND_STATUS_COMPLETED, Amount: KRW(30000)}, 50000, OrderStatus_ORDER_STATUS_REFUNDED, 3},
+	}
+	for i, s := range steps {
+		if err := o.RecordRefund(s.refund); err != nil {
+			t.Fatal(err)
+		}
+		if !proto.Equal(o.GetRefundedAmount(), KRW(s.wantRefunded)) || o.GetOrderStatus() != s.wantStatus || len(o.GetRefunds()) != s.wantRefunds {
+			t.Errorf("step %d: refunded %v, status %v, %d refunds; want %d, %v, %d",
+				i, o.GetRefundedAmount(), o.GetOrderStatus(), len(o.GetRefunds()), s.wantRefunded, s.wantStatus, s.wantRefunds)
+		}
+	}
+	if o.GetStatus() != "refunded" {
+		t.Errorf("legacy status = %q", o.GetStatus())
+	}
+}
+
+func TestAllocateRefund(t *testing.T) {
+	priced := pricedOrder()
+	earlier := &Refund{Status: RefundStatus_REFUND_STATUS_COMPLETED, Items: []*RefundItem{{OrderItemId: "a", Quantity: 1}}, ShippingAmount: KRW(3000)}
+	failed := &Refund{Status: RefundStatus_REFUND_STATUS_FAILED, Items: []*RefundItem{{OrderItemId: "a", Quantity: 2}}, ShippingAmount: KRW(3000)}
+	tests := []struct {
+		name         string
+		refunds      []*Refund
+		items        []*RefundItem
+		shipping     bool
+		wantAmount   int64
+		wantItems    []int64
+		wantShipping int64
+		wantCode     codes.Code
+	}{
+		{name: "one unit", items: []*RefundItem{{OrderItemId: "a", Quantity: 1}}, wantAmount: 9000, wantItems: []int64{9000}},
+		{name: "whole order with shipping", items: []*RefundItem{{OrderItemId: "a", Quantity: 2}, {OrderItemId: "b", Quantity: 1}}, shipping: true,
+			wantAmount: 30000, wantItems: []int64{18000, 9000}, wantShipping: 3000},
+		{name: "after earlier return", refunds: []*Refund{earlier}, items: []*RefundItem{{OrderItemId: "a", Quantity: 1}}, shipping: true,
+			wantAmount: 9000, wantItems: []int64{9000}},
+		{name: "failed refund ignored", refunds: []*Refund{failed}, items: []*RefundItem{{OrderItemId: "a", Quantity: 2}}, shipping: true,
+			wantAmount: 21000, wantItems: []int64{18000}, wantShipping: 3000},
+		{name: "too many units", refunds: []*Refund{earlier}, items: []*RefundItem{{OrderItemId: "a", Quantity: 2}}, wantCode: codes.FailedPrecondition},
+		{name: "zero units", items: []*RefundItem{{OrderItemId: "a"}}, wantCode: codes.FailedPrecondition},
+		{name: "unpriced item", items: []*RefundItem{{OrderItemId: "z", Quantity: 1}}, wantCode: codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := paidOrder(tt.refunds...)
+			r, err := AllocateRefund(o, priced, tt.items, tt.shipping)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("AllocateRefund() error = %v, want %v", err, tt.wantCode)
+			}
+			if err != nil {
+				return
+			}
+			var items []int64
+			for _, it := range r.GetItems() {
+				units, _ := it.GetAmount().KRWUnits()
+				items = append(items, units)
+			}
+			_, vat := SplitVAT(tt.wantAmount, 0)
+			if !proto.Equal(r.GetAmount(), KRW(tt.wantAmount)) || !slices.Equal(items, tt.wantItems) ||
+				!proto.Equal(MoneyOr(r.GetShippingAmount(), 0), KRW(tt.wantShipping)) || !proto.Equal(r.GetVatAmount(), KRW(vat)) {
+				t.Errorf("AllocateRefund() = %v, want amount %d, items %v, shipping %d", r, tt.wantAmount, tt.wantItems, tt.wantShipping)
+			}
+			if r.GetStatus() != RefundStatus_REFUND_STATUS_PENDING || r.GetOrderId() != "o-1" {
+				t.Errorf("AllocateRefund() status %v, order %q", r.GetStatus(), r.GetOrderId())
+			}
+		})
+	}
+}
+
+func TestAllocateRefundTaxFree(t *testing.T) {
+	priced := pricedOrder()
+	priced.Lines[1].TaxFree = true
+	tests := []struct {
+		name                             string
+		items                            []*RefundItem
+		shipping                         bool
+		wantAmount, wantTaxFree, wantVAT int64
+	}{
+		{name: "taxable line", items: []*RefundItem{{OrderItemId: "a", Quantity: 1}}, wantAmount: 9000, wantVAT: 818},
+		{name: "tax-free line", items: []*RefundItem{{OrderItemId: "b", Quantity: 1}}, wantAmount: 9000, wantTaxFree: 9000},
+		{name: "tax-free line with shipping", items: []*RefundItem{{OrderItemId: "b", Quantity: 1}}, shipping: true,
+			wantAmount: 12000, wantTaxFree: 9000, wantVAT: 273},
+		{name: "mixed lines with shipping", items: []*RefundItem{{OrderItemId: "a", Quantity: 2}, {OrderItemId: "b", Quantity: 1}}, shipping: true,
+			wantAmount: 30000, wantTaxFree: 9000, wantVAT: 1909},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := AllocateRefund(paidOrder(), priced, tt.items, tt.shipping)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !proto.Equal(r.GetAmount(), KRW(tt.wantAmount)) || !proto.Equal(r.GetTaxFreeAmount(), KRW(tt.wantTaxFree)) ||
+				!proto.Equal(r.GetVatAmount(), KRW(tt.wantVAT)) {
+				t.Errorf("AllocateRefund() amount %v, tax free %v, vat %v; want %d, %d, %d",
+					r.GetAmount(), r.GetTaxFreeAmount(), r.GetVatAmount(), tt.wantAmount, tt.wantTaxFree, tt.wantVAT)
+			}
+		})
+	}
+}
+
+func TestAllocateRefundAddsUpInParts(t *testing.T) {
+	// 10,000 won over 3 units does not split evenly; three single-unit
+	// refunds must still add up to the line total.
+	priced := &PriceOrderResponse{Lines: []*PricedLine{{LineId: "a", Quantity: 3, Total: KRW(10000)}}}
+	o := paidOrder()
+	sum := int64(0)
+	for range 3 {
+		r, err := AllocateRefund(o, priced, []*RefundItem{{OrderItemId: "a", Quantity: 1}}, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		units, _ := r.GetAmount().KRWUnits()
+		sum += units
+		o.Refunds = append(o.Refunds, r)
+	}
+	if sum != 10000 {
+		t.Errorf("single-unit refunds add up to %d, want 10000", sum)
+	}
+}
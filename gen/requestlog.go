@@ -0,0 +1,211 @@
+package gen
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	randv2 "math/rand/v2"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// RequestIDHeader carries the request ID across the gateway (HTTP header) and
+// gRPC services (metadata key "x-request-id"), so gateway logs and service
+// logs of one request can be joined.
+const RequestIDHeader = "X-Request-Id"
+
+var requestIDKey = strings.ToLower(RequestIDHeader)
+
+// NewRequestID returns a random 128-bit request ID in hex.
+func NewRequestID() string {
+	var b [16]byte
+	rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
+
+type requestIDCtxKey struct{}
+
+// RequestIDFromContext returns the request ID set by the gateway RequestLogger
+// or the request ID interceptors, falling back to incoming metadata.
+func RequestIDFromContext(ctx context.Context) string {
+	if id, ok := ctx.Value(requestIDCtxKey{}).(string); ok {
+		return id
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get(requestIDKey); len(v) > 0 {
+			return v[0]
+		}
+	}
+	return ""
+}
+
+// withRequestID makes sure ctx carries a request ID, minting one if the caller
+// sent none.
+func withRequestID(ctx context.Context) (context.Context, string) {
+	id := RequestIDFromContext(ctx)
+	if id == "" {
+		id = NewRequestID()
+	}
+	return context.WithValue(ctx, requestIDCtxKey{}, id), id
+}
+
+// UnaryRequestIDInterceptor makes the request ID available via
+// RequestIDFromContext, minting one for callers that sent none, and echoes it
+// in the response header.
+func UnaryRequestIDInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, id := withRequestID(ctx)
+		grpc.SetHeader(ctx, metadata.Pairs(requestIDKey, id))
+		return handler(ctx, req)
+	}
+}
+
+// StreamRequestIDInterceptor is the streaming counterpart of
+// UnaryRequestIDInterceptor.
+func StreamRequestIDInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, id := withRequestID(ss.Context())
+		ss.SetHeader(metadata.Pairs(requestIDKey, id))
+		return handler(srv, &contextServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// UnaryClientRequestIDInterceptor forwards the current request ID on outgoing
+// calls, so service-to-service hops keep the gateway's ID.
+func UnaryClientRequestIDInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if id := RequestIDFromContext(ctx); id != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, requestIDKey, id)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// RequestLogger is gateway HTTP middleware that logs a sample of requests and
+// always logs slow requests and server errors (status 5xx). Each entry carries
+// the route template (e.g. "/v1/order/archived/{id}") rather than the raw
+// path, so logs aggregate per endpoint without leaking IDs.
+//
+// Install Handler around the gateway mux and Metadata on the mux itself:
+//
+//	rl := &RequestLogger{SampleRate: 0.01, SlowThreshold: time.Second}
+//	mux := runtime.NewServeMux(runtime.WithMetadata(rl.Metadata))
+//	http.ListenAndServe(":8080", rl.Handler(mux))
+type RequestLogger struct {
+	// Logger receives the entries; nil means slog.Default().
+	Logger *slog.Logger
+	// SampleRate is the fraction (0 to 1) of ordinary requests logged.
+	SampleRate float64
+	// SlowThreshold marks requests that are always logged; zero means one
+	// second.
+	SlowThreshold time.Duration
+}
+
+// requestInfo is filled in by Metadata while the mux handles the request.
+type requestInfo struct {
+	id     string
+	route  string
+	method string
+}
+
+type requestInfoKey struct{}
+
+// Metadata is a runtime.WithMetadata annotator recording the matched route for
+// the log entry and forwarding the request ID to the gRPC service.
+func (l *RequestLogger) Metadata(ctx context.Context, r *http.Request) metadata.MD {
+	info, ok := r.Context().Value(requestInfoKey{}).(*requestInfo)
+	if !ok {
+		return nil
+	}
+	info.route, _ = runtime.HTTPPathPattern(ctx)
+	info.method, _ = runtime.RPCMethod(ctx)
+	return metadata.Pairs(requestIDKey, info.id)
+}
+
+// Handler wraps next with request logging. It honours an incoming
+// X-Request-Id, minting one otherwise, and echoes it in the response.
+func (l *RequestLogger) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		info := &requestInfo{id: r.Header.Get(RequestIDHeader)}
+		if info.id == "" {
+			info.id = NewRequestID()
+		}
+		w.Header().Set(RequestIDHeader, info.id)
+		ctx := context.WithValue(r.Context(), requestInfoKey{}, info)
+		ctx = context.WithValue(ctx, requestIDCtxKey{}, info.id)
+		sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+
+		next.ServeHTTP(sw, r.WithContext(ctx))
+
+		elapsed := time.Since(start)
+		threshold := l.SlowThreshold
+		if threshold == 0 {
+			threshold = time.Second
+		}
+		level, msg := slog.LevelInfo, "gateway request"
+		switch {
+		case sw.status >= 500:
+			level, msg = slog.LevelError, "gateway request failed"
+		case elapsed >= threshold:
+			level, msg = slog.LevelWarn, "slow gateway request"
+		case randv2.Float64() >= l.SampleRate:
+			return
+		}
+		route := info.route
+		if route == "" {
+			route = "unmatched"
+		}
+		logger := l.Logger
+		if logger == nil {
+			logger = slog.Default()
+		}
+		logger.LogAttrs(r.Context(), level, msg,
+			slog.String("request_id", info.id),
+			slog.String("http_method", r.Method),
+			slog.String("route", route),
+			slog.String("rpc", info.method),
+			slog.Int("status", sw.status),
+			slog.Int64("bytes", sw.bytes),
+			slog.Duration("duration", elapsed),
+		)
+	})
+}
+
+// statusWriter records the response status and size. It forwards Flush so
+// server-streaming endpoints keep working.
+type statusWriter struct {
+	http.ResponseWriter
+	status      int
+	bytes       int64
+	wroteHeader bool
+}
+
+func (w *statusWriter) WriteHeader(code int) {
+	if !w.wroteHeader {
+		w.status, w.wroteHeader = code, true
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *statusWriter) Write(b []byte) (int, error) {
+	w.wroteHeader = true
+	n, err := w.ResponseWriter.Write(b)
+	w.bytes += int64(n)
+	return n, err
+}
+
+func (w *statusWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer.
+func (w *statusWriter) Unwrap() http.ResponseWriter { return w.ResponseWriter }
@@ -0,0 +1,23 @@
+package gen
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Author returns the user a new review is written as: the authenticated
+// caller, never the request's deprecated user_id. A user_id that names
+// someone else is rejected (PermissionDenied) rather than silently replaced,
+// so a client posting on another user's behalf notices.
+func (r *CreateReviewRequest) Author(ctx context.Context) (string, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return "", NewError(codes.Unauthenticated, ErrorReason_ERROR_REASON_UNAUTHENTICATED, "authentication required", nil)
+	}
+	if r.GetUserId() != "" && r.GetUserId() != userID {
+		return "", status.Error(codes.PermissionDenied, "user_id does not match the authenticated user")
+	}
+	return userID, nil
+}
@@ -0,0 +1,1068 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: review.proto
+
+package gen
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 리뷰 정렬 순서
+type ReviewSort int32
+
+const (
+	ReviewSort_REVIEW_SORT_UNSPECIFIED ReviewSort = 0 // 최신순
+	ReviewSort_REVIEW_SORT_NEWEST      ReviewSort = 1
+	ReviewSort_REVIEW_SORT_HELPFUL     ReviewSort = 2 // 도움돼요 많은 순
+	ReviewSort_REVIEW_SORT_RATING_HIGH ReviewSort = 3
+	ReviewSort_REVIEW_SORT_RATING_LOW  ReviewSort = 4
+)
+
+// Enum value maps for ReviewSort.
+var (
+	ReviewSort_name = map[int32]string{
+		0: "REVIEW_SORT_UNSPECIFIED",
+		1: "REVIEW_SORT_NEWEST",
+		2: "REVIEW_SORT_HELPFUL",
+		3: "REVIEW_SORT_RATING_HIGH",
+		4: "REVIEW_SORT_RATING_LOW",
+	}
+	ReviewSort_value = map[string]int32{
+		"REVIEW_SORT_UNSPECIFIED": 0,
+		"REVIEW_SORT_NEWEST":      1,
+		"REVIEW_SORT_HELPFUL":     2,
+		"REVIEW_SORT_RATING_HIGH": 3,
+		"REVIEW_SORT_RATING_LOW":  4,
+	}
+)
+
+func (x ReviewSort) Enum() *ReviewSort {
+	p := new(ReviewSort)
+	*p = x
+	return p
+}
+
+func (x ReviewSort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReviewSort) Descriptor() protoreflect.EnumDescriptor {
+	return file_review_proto_enumTypes[0].Descriptor()
+}
+
+func (ReviewSort) Type() protoreflect.EnumType {
+	return &file_review_proto_enumTypes[0]
+}
+
+func (x ReviewSort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReviewSort.Descriptor instead.
+func (ReviewSort) EnumDescriptor() ([]byte, []int) {
+	return file_review_proto_rawDescGZIP(), []int{0}
+}
+
+type Review struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OrderId       string                 `protobuf:"bytes,4,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"` // 구매 인증된 주문
+	Rating        int32                  `protobuf:"varint,5,opt,name=rating,proto3" json:"rating,omitempty"`                 // 1~5
+	Title         string                 `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
+	Content       string                 `protobuf:"bytes,7,opt,name=content,proto3" json:"content,omitempty"`
+	ImageUrls     []string               `protobuf:"bytes,8,rep,name=image_urls,json=imageUrls,proto3" json:"image_urls,omitempty"`
+	AuthorName    string                 `protobuf:"bytes,9,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"` // 표시용 (마스킹된 이름, ex: "김**")
+	HelpfulCount  int32                  `protobuf:"varint,10,opt,name=helpful_count,json=helpfulCount,proto3" json:"helpful_count,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Review) Reset() {
+	*x = Review{}
+	mi := &file_review_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Review) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Review) ProtoMessage() {}
+
+func (x *Review) ProtoReflect() protoreflect.Message {
+	mi := &file_review_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Review.ProtoReflect.Descriptor instead.
+func (*Review) Descriptor() ([]byte, []int) {
+	return file_review_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Review) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Review) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *Review) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Review) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *Review) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *Review) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Review) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Review) GetImageUrls() []string {
+	if x != nil {
+		return x.ImageUrls
+	}
+	return nil
+}
+
+func (x *Review) GetAuthorName() string {
+	if x != nil {
+		return x.AuthorName
+	}
+	return ""
+}
+
+func (x *Review) GetHelpfulCount() int32 {
+	if x != nil {
+		return x.HelpfulCount
+	}
+	return 0
+}
+
+func (x *Review) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Review) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type CreateReviewRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// 작성자는 인증 토큰의 사용자로 정해지며 이 값은 사용하지 않음 (보내면 토큰 사용자와 같아야 함)
+	//
+	// Deprecated: Marked as deprecated in review.proto.
+	UserId        string   `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OrderId       string   `protobuf:"bytes,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Rating        int32    `protobuf:"varint,4,opt,name=rating,proto3" json:"rating,omitempty"`
+	Title         string   `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Content       string   `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`
+	ImageUrls     []string `protobuf:"bytes,7,rep,name=image_urls,json=imageUrls,proto3" json:"image_urls,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReviewRequest) Reset() {
+	*x = CreateReviewRequest{}
+	mi := &file_review_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReviewRequest) ProtoMessage() {}
+
+func (x *CreateReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReviewRequest.ProtoReflect.Descriptor instead.
+func (*CreateReviewRequest) Descriptor() ([]byte, []int) {
+	return file_review_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateReviewRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in review.proto.
+func (x *CreateReviewRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateReviewRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *CreateReviewRequest) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *CreateReviewRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateReviewRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CreateReviewRequest) GetImageUrls() []string {
+	if x != nil {
+		return x.ImageUrls
+	}
+	return nil
+}
+
+type CreateReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Review        *Review                `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReviewResponse) Reset() {
+	*x = CreateReviewResponse{}
+	mi := &file_review_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReviewResponse) ProtoMessage() {}
+
+func (x *CreateReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReviewResponse.ProtoReflect.Descriptor instead.
+func (*CreateReviewResponse) Descriptor() ([]byte, []int) {
+	return file_review_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateReviewResponse) GetReview() *Review {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+type ListReviewsByProductRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// 페이지 크기 (0이면 서버 기본값, 최대 100)
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// 이전 응답의 next_page_token, 첫 페이지는 비워 둠
+	PageToken      string     `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Sort           ReviewSort `protobuf:"varint,4,opt,name=sort,proto3,enum=go.escape.ship.proto.v1.ReviewSort" json:"sort,omitempty"`
+	Rating         int32      `protobuf:"varint,5,opt,name=rating,proto3" json:"rating,omitempty"`                                         // 특정 별점만 조회 (0이면 전체)
+	WithImagesOnly bool       `protobuf:"varint,6,opt,name=with_images_only,json=withImagesOnly,proto3" json:"with_images_only,omitempty"` // 사진 리뷰만 조회
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListReviewsByProductRequest) Reset() {
+	*x = ListReviewsByProductRequest{}
+	mi := &file_review_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReviewsByProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReviewsByProductRequest) ProtoMessage() {}
+
+func (x *ListReviewsByProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReviewsByProductRequest.ProtoReflect.Descriptor instead.
+func (*ListReviewsByProductRequest) Descriptor() ([]byte, []int) {
+	return file_review_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListReviewsByProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ListReviewsByProductRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListReviewsByProductRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListReviewsByProductRequest) GetSort() ReviewSort {
+	if x != nil {
+		return x.Sort
+	}
+	return ReviewSort_REVIEW_SORT_UNSPECIFIED
+}
+
+func (x *ListReviewsByProductRequest) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *ListReviewsByProductRequest) GetWithImagesOnly() bool {
+	if x != nil {
+		return x.WithImagesOnly
+	}
+	return false
+}
+
+type ListReviewsByProductResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Reviews []*Review              `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	// 다음 페이지 토큰, 마지막 페이지면 빈 문자열
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// 필터 조건에 맞는 전체 리뷰 수
+	TotalCount    int32 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReviewsByProductResponse) Reset() {
+	*x = ListReviewsByProductResponse{}
+	mi := &file_review_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReviewsByProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReviewsByProductResponse) ProtoMessage() {}
+
+func (x *ListReviewsByProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReviewsByProductResponse.ProtoReflect.Descriptor instead.
+func (*ListReviewsByProductResponse) Descriptor() ([]byte, []int) {
+	return file_review_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListReviewsByProductResponse) GetReviews() []*Review {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+func (x *ListReviewsByProductResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListReviewsByProductResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type UpdateReviewRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ReviewId  string                 `protobuf:"bytes,2,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	Rating    int32                  `protobuf:"varint,3,opt,name=rating,proto3" json:"rating,omitempty"`
+	Title     string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Content   string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	ImageUrls []string               `protobuf:"bytes,6,rep,name=image_urls,json=imageUrls,proto3" json:"image_urls,omitempty"`
+	// 변경할 필드 (ex: "rating,content"), 비어 있으면 rating/title/content/image_urls 전체
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,7,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateReviewRequest) Reset() {
+	*x = UpdateReviewRequest{}
+	mi := &file_review_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReviewRequest) ProtoMessage() {}
+
+func (x *UpdateReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateReviewRequest.ProtoReflect.Descriptor instead.
+func (*UpdateReviewRequest) Descriptor() ([]byte, []int) {
+	return file_review_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateReviewRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *UpdateReviewRequest) GetReviewId() string {
+	if x != nil {
+		return x.ReviewId
+	}
+	return ""
+}
+
+func (x *UpdateReviewRequest) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *UpdateReviewRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateReviewRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *UpdateReviewRequest) GetImageUrls() []string {
+	if x != nil {
+		return x.ImageUrls
+	}
+	return nil
+}
+
+func (x *UpdateReviewRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type UpdateReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Review        *Review                `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateReviewResponse) Reset() {
+	*x = UpdateReviewResponse{}
+	mi := &file_review_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReviewResponse) ProtoMessage() {}
+
+func (x *UpdateReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateReviewResponse.ProtoReflect.Descriptor instead.
+func (*UpdateReviewResponse) Descriptor() ([]byte, []int) {
+	return file_review_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateReviewResponse) GetReview() *Review {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+type DeleteReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ReviewId      string                 `protobuf:"bytes,2,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReviewRequest) Reset() {
+	*x = DeleteReviewRequest{}
+	mi := &file_review_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReviewRequest) ProtoMessage() {}
+
+func (x *DeleteReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReviewRequest.ProtoReflect.Descriptor instead.
+func (*DeleteReviewRequest) Descriptor() ([]byte, []int) {
+	return file_review_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteReviewRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *DeleteReviewRequest) GetReviewId() string {
+	if x != nil {
+		return x.ReviewId
+	}
+	return ""
+}
+
+type DeleteReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReviewResponse) Reset() {
+	*x = DeleteReviewResponse{}
+	mi := &file_review_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReviewResponse) ProtoMessage() {}
+
+func (x *DeleteReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReviewResponse.ProtoReflect.Descriptor instead.
+func (*DeleteReviewResponse) Descriptor() ([]byte, []int) {
+	return file_review_proto_rawDescGZIP(), []int{8}
+}
+
+// 상품 평점 요약
+type RatingSummary struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ProductId        string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	AverageRating    float64                `protobuf:"fixed64,2,opt,name=average_rating,json=averageRating,proto3" json:"average_rating,omitempty"` // 소수점 첫째 자리까지 표시 권장, 리뷰가 없으면 0
+	ReviewCount      int32                  `protobuf:"varint,3,opt,name=review_count,json=reviewCount,proto3" json:"review_count,omitempty"`
+	RatingCounts     map[int32]int32        `protobuf:"bytes,4,rep,name=rating_counts,json=ratingCounts,proto3" json:"rating_counts,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // 별점(1~5)별 리뷰 수
+	PhotoReviewCount int32                  `protobuf:"varint,5,opt,name=photo_review_count,json=photoReviewCount,proto3" json:"photo_review_count,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RatingSummary) Reset() {
+	*x = RatingSummary{}
+	mi := &file_review_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RatingSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RatingSummary) ProtoMessage() {}
+
+func (x *RatingSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_review_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RatingSummary.ProtoReflect.Descriptor instead.
+func (*RatingSummary) Descriptor() ([]byte, []int) {
+	return file_review_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RatingSummary) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *RatingSummary) GetAverageRating() float64 {
+	if x != nil {
+		return x.AverageRating
+	}
+	return 0
+}
+
+func (x *RatingSummary) GetReviewCount() int32 {
+	if x != nil {
+		return x.ReviewCount
+	}
+	return 0
+}
+
+func (x *RatingSummary) GetRatingCounts() map[int32]int32 {
+	if x != nil {
+		return x.RatingCounts
+	}
+	return nil
+}
+
+func (x *RatingSummary) GetPhotoReviewCount() int32 {
+	if x != nil {
+		return x.PhotoReviewCount
+	}
+	return 0
+}
+
+type GetProductRatingSummaryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductRatingSummaryRequest) Reset() {
+	*x = GetProductRatingSummaryRequest{}
+	mi := &file_review_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductRatingSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductRatingSummaryRequest) ProtoMessage() {}
+
+func (x *GetProductRatingSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductRatingSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetProductRatingSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_review_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetProductRatingSummaryRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type GetProductRatingSummaryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Summary       *RatingSummary         `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductRatingSummaryResponse) Reset() {
+	*x = GetProductRatingSummaryResponse{}
+	mi := &file_review_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductRatingSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductRatingSummaryResponse) ProtoMessage() {}
+
+func (x *GetProductRatingSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductRatingSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetProductRatingSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_review_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetProductRatingSummaryResponse) GetSummary() *RatingSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+var File_review_proto protoreflect.FileDescriptor
+
+const file_review_proto_rawDesc = "" +
+	"\n" +
+	"\freview.proto\x12\x17go.escape.ship.proto.v1\x1a\x1cgoogle/api/annotations.proto\x1a google/protobuf/field_mask.proto\"\xd6\x02\n" +
+	"\x06Review\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x19\n" +
+	"\border_id\x18\x04 \x01(\tR\aorderId\x12\x16\n" +
+	"\x06rating\x18\x05 \x01(\x05R\x06rating\x12\x14\n" +
+	"\x05title\x18\x06 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\a \x01(\tR\acontent\x12\x1d\n" +
+	"\n" +
+	"image_urls\x18\b \x03(\tR\timageUrls\x12\x1f\n" +
+	"\vauthor_name\x18\t \x01(\tR\n" +
+	"authorName\x12#\n" +
+	"\rhelpful_count\x18\n" +
+	" \x01(\x05R\fhelpfulCount\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\tR\tupdatedAt\"\xd3\x01\n" +
+	"\x13CreateReviewRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1b\n" +
+	"\auser_id\x18\x02 \x01(\tB\x02\x18\x01R\x06userId\x12\x19\n" +
+	"\border_id\x18\x03 \x01(\tR\aorderId\x12\x16\n" +
+	"\x06rating\x18\x04 \x01(\x05R\x06rating\x12\x14\n" +
+	"\x05title\x18\x05 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x06 \x01(\tR\acontent\x12\x1d\n" +
+	"\n" +
+	"image_urls\x18\a \x03(\tR\timageUrls\"O\n" +
+	"\x14CreateReviewResponse\x127\n" +
+	"\x06review\x18\x01 \x01(\v2\x1f.go.escape.ship.proto.v1.ReviewR\x06review\"\xf3\x01\n" +
+	"\x1bListReviewsByProductRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x127\n" +
+	"\x04sort\x18\x04 \x01(\x0e2#.go.escape.ship.proto.v1.ReviewSortR\x04sort\x12\x16\n" +
+	"\x06rating\x18\x05 \x01(\x05R\x06rating\x12(\n" +
+	"\x10with_images_only\x18\x06 \x01(\bR\x0ewithImagesOnly\"\xa2\x01\n" +
+	"\x1cListReviewsByProductResponse\x129\n" +
+	"\areviews\x18\x01 \x03(\v2\x1f.go.escape.ship.proto.v1.ReviewR\areviews\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"\xf5\x01\n" +
+	"\x13UpdateReviewRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1b\n" +
+	"\treview_id\x18\x02 \x01(\tR\breviewId\x12\x16\n" +
+	"\x06rating\x18\x03 \x01(\x05R\x06rating\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x05 \x01(\tR\acontent\x12\x1d\n" +
+	"\n" +
+	"image_urls\x18\x06 \x03(\tR\timageUrls\x12;\n" +
+	"\vupdate_mask\x18\a \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"O\n" +
+	"\x14UpdateReviewResponse\x127\n" +
+	"\x06review\x18\x01 \x01(\v2\x1f.go.escape.ship.proto.v1.ReviewR\x06review\"Q\n" +
+	"\x13DeleteReviewRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1b\n" +
+	"\treview_id\x18\x02 \x01(\tR\breviewId\"\x16\n" +
+	"\x14DeleteReviewResponse\"\xc6\x02\n" +
+	"\rRatingSummary\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12%\n" +
+	"\x0eaverage_rating\x18\x02 \x01(\x01R\raverageRating\x12!\n" +
+	"\freview_count\x18\x03 \x01(\x05R\vreviewCount\x12]\n" +
+	"\rrating_counts\x18\x04 \x03(\v28.go.escape.ship.proto.v1.RatingSummary.RatingCountsEntryR\fratingCounts\x12,\n" +
+	"\x12photo_review_count\x18\x05 \x01(\x05R\x10photoReviewCount\x1a?\n" +
+	"\x11RatingCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"?\n" +
+	"\x1eGetProductRatingSummaryRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"c\n" +
+	"\x1fGetProductRatingSummaryResponse\x12@\n" +
+	"\asummary\x18\x01 \x01(\v2&.go.escape.ship.proto.v1.RatingSummaryR\asummary*\x93\x01\n" +
+	"\n" +
+	"ReviewSort\x12\x1b\n" +
+	"\x17REVIEW_SORT_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12REVIEW_SORT_NEWEST\x10\x01\x12\x17\n" +
+	"\x13REVIEW_SORT_HELPFUL\x10\x02\x12\x1b\n" +
+	"\x17REVIEW_SORT_RATING_HIGH\x10\x03\x12\x1a\n" +
+	"\x16REVIEW_SORT_RATING_LOW\x10\x042\xdc\x06\n" +
+	"\rReviewService\x12\x96\x01\n" +
+	"\fCreateReview\x12,.go.escape.ship.proto.v1.CreateReviewRequest\x1a-.go.escape.ship.proto.v1.CreateReviewResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/products/{product_id}/reviews\x12\xab\x01\n" +
+	"\x14ListReviewsByProduct\x124.go.escape.ship.proto.v1.ListReviewsByProductRequest\x1a5.go.escape.ship.proto.v1.ListReviewsByProductResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/products/{product_id}/reviews\x12\xa2\x01\n" +
+	"\fUpdateReview\x12,.go.escape.ship.proto.v1.UpdateReviewRequest\x1a-.go.escape.ship.proto.v1.UpdateReviewResponse\"5\x82\xd3\xe4\x93\x02/:\x01*2*/products/{product_id}/reviews/{review_id}\x12\x9f\x01\n" +
+	"\fDeleteReview\x12,.go.escape.ship.proto.v1.DeleteReviewRequest\x1a-.go.escape.ship.proto.v1.DeleteReviewResponse\"2\x82\xd3\xe4\x93\x02,**/products/{product_id}/reviews/{review_id}\x12\xbc\x01\n" +
+	"\x17GetProductRatingSummary\x127.go.escape.ship.proto.v1.GetProductRatingSummaryRequest\x1a8.go.escape.ship.proto.v1.GetProductRatingSummaryResponse\".\x82\xd3\xe4\x93\x02(\x12&/products/{product_id}/reviews/summaryB#Z!github.com/escape-ship/protos/genb\x06proto3"
+
+var (
+	file_review_proto_rawDescOnce sync.Once
+	file_review_proto_rawDescData []byte
+)
+
+func file_review_proto_rawDescGZIP() []byte {
+	file_review_proto_rawDescOnce.Do(func() {
+		file_review_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_review_proto_rawDesc), len(file_review_proto_rawDesc)))
+	})
+	return file_review_proto_rawDescData
+}
+
+var file_review_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_review_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_review_proto_goTypes = []any{
+	(ReviewSort)(0),                         // 0: go.escape.ship.proto.v1.ReviewSort
+	(*Review)(nil),                          // 1: go.escape.ship.proto.v1.Review
+	(*CreateReviewRequest)(nil),             // 2: go.escape.ship.proto.v1.CreateReviewRequest
+	(*CreateReviewResponse)(nil),            // 3: go.escape.ship.proto.v1.CreateReviewResponse
+	(*ListReviewsByProductRequest)(nil),     // 4: go.escape.ship.proto.v1.ListReviewsByProductRequest
+	(*ListReviewsByProductResponse)(nil),    // 5: go.escape.ship.proto.v1.ListReviewsByProductResponse
+	(*UpdateReviewRequest)(nil),             // 6: go.escape.ship.proto.v1.UpdateReviewRequest
+	(*UpdateReviewResponse)(nil),            // 7: go.escape.ship.proto.v1.UpdateReviewResponse
+	(*DeleteReviewRequest)(nil),             // 8: go.escape.ship.proto.v1.DeleteReviewRequest
+	(*DeleteReviewResponse)(nil),            // 9: go.escape.ship.proto.v1.DeleteReviewResponse
+	(*RatingSummary)(nil),                   // 10: go.escape.ship.proto.v1.RatingSummary
+	(*GetProductRatingSummaryRequest)(nil),  // 11: go.escape.ship.proto.v1.GetProductRatingSummaryRequest
+	(*GetProductRatingSummaryResponse)(nil), // 12: go.escape.ship.proto.v1.GetProductRatingSummaryResponse
+	nil,                                     // 13: go.escape.ship.proto.v1.RatingSummary.RatingCountsEntry
+	(*fieldmaskpb.FieldMask)(nil),           // 14: google.protobuf.FieldMask
+}
+var file_review_proto_depIdxs = []int32{
+	1,  // 0: go.escape.ship.proto.v1.CreateReviewResponse.review:type_name -> go.escape.ship.proto.v1.Review
+	0,  // 1: go.escape.ship.proto.v1.ListReviewsByProductRequest.sort:type_name -> go.escape.ship.proto.v1.ReviewSort
+	1,  // 2: go.escape.ship.proto.v1.ListReviewsByProductResponse.reviews:type_name -> go.escape.ship.proto.v1.Review
+	14, // 3: go.escape.ship.proto.v1.UpdateReviewRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,  // 4: go.escape.ship.proto.v1.UpdateReviewResponse.review:type_name -> go.escape.ship.proto.v1.Review
+	13, // 5: go.escape.ship.proto.v1.RatingSummary.rating_counts:type_name -> go.escape.ship.proto.v1.RatingSummary.RatingCountsEntry
+	10, // 6: go.escape.ship.proto.v1.GetProductRatingSummaryResponse.summary:type_name -> go.escape.ship.proto.v1.RatingSummary
+	2,  // 7: go.escape.ship.proto.v1.ReviewService.CreateReview:input_type -> go.escape.ship.proto.v1.CreateReviewRequest
+	4,  // 8: go.escape.ship.proto.v1.ReviewService.ListReviewsByProduct:input_type -> go.escape.ship.proto.v1.ListReviewsByProductRequest
+	6,  // 9: go.escape.ship.proto.v1.ReviewService.UpdateReview:input_type -> go.escape.ship.proto.v1.UpdateReviewRequest
+	8,  // 10: go.escape.ship.proto.v1.ReviewService.DeleteReview:input_type -> go.escape.ship.proto.v1.DeleteReviewRequest
+	11, // 11: go.escape.ship.proto.v1.ReviewService.GetProductRatingSummary:input_type -> go.escape.ship.proto.v1.GetProductRatingSummaryRequest
+	3,  // 12: go.escape.ship.proto.v1.ReviewService.CreateReview:output_type -> go.escape.ship.proto.v1.CreateReviewResponse
+	5,  // 13: go.escape.ship.proto.v1.ReviewService.ListReviewsByProduct:output_type -> go.escape.ship.proto.v1.ListReviewsByProductResponse
+	7,  // 14: go.escape.ship.proto.v1.ReviewService.UpdateReview:output_type -> go.escape.ship.proto.v1.UpdateReviewResponse
+	9,  // 15: go.escape.ship.proto.v1.ReviewService.DeleteReview:output_type -> go.escape.ship.proto.v1.DeleteReviewResponse
+	12, // 16: go.escape.ship.proto.v1.ReviewService.GetProductRatingSummary:output_type -> go.escape.ship.proto.v1.GetProductRatingSummaryResponse
+	12, // [12:17] is the sub-list for method output_type
+	7,  // [7:12] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_review_proto_init() }
+func file_review_proto_init() {
+	if File_review_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_review_proto_rawDesc), len(file_review_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_review_proto_goTypes,
+		DependencyIndexes: file_review_proto_depIdxs,
+		EnumInfos:         file_review_proto_enumTypes,
+		MessageInfos:      file_review_proto_msgTypes,
+	}.Build()
+	File_review_proto = out.File
+	file_review_proto_goTypes = nil
+	file_review_proto_depIdxs = nil
+}
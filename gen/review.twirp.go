@@ -0,0 +1,1710 @@
+// Code generated by protoc-gen-twirp v8.1.3, DO NOT EDIT.
+// source: review.proto
+
+package gen
+
+import context "context"
+import fmt "fmt"
+import http "net/http"
+import io "io"
+import json "encoding/json"
+import strconv "strconv"
+import strings "strings"
+
+import protojson "google.golang.org/protobuf/encoding/protojson"
+import proto "google.golang.org/protobuf/proto"
+import twirp "github.com/twitchtv/twirp"
+import ctxsetters "github.com/twitchtv/twirp/ctxsetters"
+
+// Version compatibility assertion.
+// If the constant is not defined in the package, that likely means
+// the package needs to be updated to work with this generated code.
+// See https://twitchtv.github.io/twirp/docs/version_matrix.html
+const _ = twirp.TwirpPackageMinVersion_8_1_0
+
+// =======================
+// ReviewService Interface
+// =======================
+
+// 상품 리뷰 및 평점
+type ReviewService interface {
+	// 구매 확정(배송 완료) 주문의 상품에 대해 주문 항목당 1회 작성 가능, 작성자는 인증된 사용자
+	CreateReview(context.Context, *CreateReviewRequest) (*CreateReviewResponse, error)
+
+	ListReviewsByProduct(context.Context, *ListReviewsByProductRequest) (*ListReviewsByProductResponse, error)
+
+	// 작성자 본인만 수정 가능
+	UpdateReview(context.Context, *UpdateReviewRequest) (*UpdateReviewResponse, error)
+
+	// 작성자 본인 또는 관리자만 삭제 가능
+	DeleteReview(context.Context, *DeleteReviewRequest) (*DeleteReviewResponse, error)
+
+	GetProductRatingSummary(context.Context, *GetProductRatingSummaryRequest) (*GetProductRatingSummaryResponse, error)
+}
+
+// =============================
+// ReviewService Protobuf Client
+// =============================
+
+type reviewServiceProtobufClient struct {
+	client      HTTPClient
+	urls        [5]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewReviewServiceProtobufClient creates a Protobuf client that implements the ReviewService interface.
+// It communicates using Protobuf and can be configured with a custom HTTPClient.
+func NewReviewServiceProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) ReviewService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "go.escape.ship.proto.v1", "ReviewService")
+	urls := [5]string{
+		serviceURL + "CreateReview",
+		serviceURL + "ListReviewsByProduct",
+		serviceURL + "UpdateReview",
+		serviceURL + "DeleteReview",
+		serviceURL + "GetProductRatingSummary",
+	}
+
+	return &reviewServiceProtobufClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *reviewServiceProtobufClient) CreateReview(ctx context.Context, in *CreateReviewRequest) (*CreateReviewResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "ReviewService")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateReview")
+	caller := c.callCreateReview
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateReviewRequest) (*CreateReviewResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateReviewRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateReviewRequest) when calling interceptor")
+					}
+					return c.callCreateReview(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateReviewResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateReviewResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reviewServiceProtobufClient) callCreateReview(ctx context.Context, in *CreateReviewRequest) (*CreateReviewResponse, error) {
+	out := new(CreateReviewResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reviewServiceProtobufClient) ListReviewsByProduct(ctx context.Context, in *ListReviewsByProductRequest) (*ListReviewsByProductResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "ReviewService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListReviewsByProduct")
+	caller := c.callListReviewsByProduct
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListReviewsByProductRequest) (*ListReviewsByProductResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListReviewsByProductRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListReviewsByProductRequest) when calling interceptor")
+					}
+					return c.callListReviewsByProduct(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListReviewsByProductResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListReviewsByProductResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reviewServiceProtobufClient) callListReviewsByProduct(ctx context.Context, in *ListReviewsByProductRequest) (*ListReviewsByProductResponse, error) {
+	out := new(ListReviewsByProductResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reviewServiceProtobufClient) UpdateReview(ctx context.Context, in *UpdateReviewRequest) (*UpdateReviewResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "ReviewService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateReview")
+	caller := c.callUpdateReview
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateReviewRequest) (*UpdateReviewResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateReviewRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateReviewRequest) when calling interceptor")
+					}
+					return c.callUpdateReview(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateReviewResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateReviewResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reviewServiceProtobufClient) callUpdateReview(ctx context.Context, in *UpdateReviewRequest) (*UpdateReviewResponse, error) {
+	out := new(UpdateReviewResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reviewServiceProtobufClient) DeleteReview(ctx context.Context, in *DeleteReviewRequest) (*DeleteReviewResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "ReviewService")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteReview")
+	caller := c.callDeleteReview
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeleteReviewRequest) (*DeleteReviewResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteReviewRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteReviewRequest) when calling interceptor")
+					}
+					return c.callDeleteReview(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteReviewResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteReviewResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reviewServiceProtobufClient) callDeleteReview(ctx context.Context, in *DeleteReviewRequest) (*DeleteReviewResponse, error) {
+	out := new(DeleteReviewResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reviewServiceProtobufClient) GetProductRatingSummary(ctx context.Context, in *GetProductRatingSummaryRequest) (*GetProductRatingSummaryResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "ReviewService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetProductRatingSummary")
+	caller := c.callGetProductRatingSummary
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetProductRatingSummaryRequest) (*GetProductRatingSummaryResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetProductRatingSummaryRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetProductRatingSummaryRequest) when calling interceptor")
+					}
+					return c.callGetProductRatingSummary(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetProductRatingSummaryResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetProductRatingSummaryResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reviewServiceProtobufClient) callGetProductRatingSummary(ctx context.Context, in *GetProductRatingSummaryRequest) (*GetProductRatingSummaryResponse, error) {
+	out := new(GetProductRatingSummaryResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// =========================
+// ReviewService JSON Client
+// =========================
+
+type reviewServiceJSONClient struct {
+	client      HTTPClient
+	urls        [5]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewReviewServiceJSONClient creates a JSON client that implements the ReviewService interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewReviewServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) ReviewService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "go.escape.ship.proto.v1", "ReviewService")
+	urls := [5]string{
+		serviceURL + "CreateReview",
+		serviceURL + "ListReviewsByProduct",
+		serviceURL + "UpdateReview",
+		serviceURL + "DeleteReview",
+		serviceURL + "GetProductRatingSummary",
+	}
+
+	return &reviewServiceJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *reviewServiceJSONClient) CreateReview(ctx context.Context, in *CreateReviewRequest) (*CreateReviewResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "ReviewService")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateReview")
+	caller := c.callCreateReview
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateReviewRequest) (*CreateReviewResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateReviewRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateReviewRequest) when calling interceptor")
+					}
+					return c.callCreateReview(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateReviewResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateReviewResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reviewServiceJSONClient) callCreateReview(ctx context.Context, in *CreateReviewRequest) (*CreateReviewResponse, error) {
+	out := new(CreateReviewResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reviewServiceJSONClient) ListReviewsByProduct(ctx context.Context, in *ListReviewsByProductRequest) (*ListReviewsByProductResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "ReviewService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListReviewsByProduct")
+	caller := c.callListReviewsByProduct
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListReviewsByProductRequest) (*ListReviewsByProductResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListReviewsByProductRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListReviewsByProductRequest) when calling interceptor")
+					}
+					return c.callListReviewsByProduct(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListReviewsByProductResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListReviewsByProductResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reviewServiceJSONClient) callListReviewsByProduct(ctx context.Context, in *ListReviewsByProductRequest) (*ListReviewsByProductResponse, error) {
+	out := new(ListReviewsByProductResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reviewServiceJSONClient) UpdateReview(ctx context.Context, in *UpdateReviewRequest) (*UpdateReviewResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "ReviewService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateReview")
+	caller := c.callUpdateReview
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateReviewRequest) (*UpdateReviewResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateReviewRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateReviewRequest) when calling interceptor")
+					}
+					return c.callUpdateReview(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateReviewResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateReviewResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reviewServiceJSONClient) callUpdateReview(ctx context.Context, in *UpdateReviewRequest) (*UpdateReviewResponse, error) {
+	out := new(UpdateReviewResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reviewServiceJSONClient) DeleteReview(ctx context.Context, in *DeleteReviewRequest) (*DeleteReviewResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "ReviewService")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteReview")
+	caller := c.callDeleteReview
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeleteReviewRequest) (*DeleteReviewResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteReviewRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteReviewRequest) when calling interceptor")
+					}
+					return c.callDeleteReview(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteReviewResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteReviewResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reviewServiceJSONClient) callDeleteReview(ctx context.Context, in *DeleteReviewRequest) (*DeleteReviewResponse, error) {
+	out := new(DeleteReviewResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reviewServiceJSONClient) GetProductRatingSummary(ctx context.Context, in *GetProductRatingSummaryRequest) (*GetProductRatingSummaryResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "ReviewService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetProductRatingSummary")
+	caller := c.callGetProductRatingSummary
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetProductRatingSummaryRequest) (*GetProductRatingSummaryResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetProductRatingSummaryRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetProductRatingSummaryRequest) when calling interceptor")
+					}
+					return c.callGetProductRatingSummary(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetProductRatingSummaryResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetProductRatingSummaryResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reviewServiceJSONClient) callGetProductRatingSummary(ctx context.Context, in *GetProductRatingSummaryRequest) (*GetProductRatingSummaryResponse, error) {
+	out := new(GetProductRatingSummaryResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ============================
+// ReviewService Server Handler
+// ============================
+
+type reviewServiceServer struct {
+	ReviewService
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewReviewServiceServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewReviewServiceServer(svc ReviewService, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	return &reviewServiceServer{
+		ReviewService:    svc,
+		hooks:            serverOpts.Hooks,
+		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:       pathPrefix,
+		jsonSkipDefaults: jsonSkipDefaults,
+		jsonCamelCase:    jsonCamelCase,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *reviewServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *reviewServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
+
+// ReviewServicePathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const ReviewServicePathPrefix = "/twirp/go.escape.ship.proto.v1.ReviewService/"
+
+func (s *reviewServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "ReviewService")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "go.escape.ship.proto.v1.ReviewService" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "CreateReview":
+		s.serveCreateReview(ctx, resp, req)
+		return
+	case "ListReviewsByProduct":
+		s.serveListReviewsByProduct(ctx, resp, req)
+		return
+	case "UpdateReview":
+		s.serveUpdateReview(ctx, resp, req)
+		return
+	case "DeleteReview":
+		s.serveDeleteReview(ctx, resp, req)
+		return
+	case "GetProductRatingSummary":
+		s.serveGetProductRatingSummary(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *reviewServiceServer) serveCreateReview(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveCreateReviewJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveCreateReviewProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *reviewServiceServer) serveCreateReviewJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateReview")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(CreateReviewRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ReviewService.CreateReview
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateReviewRequest) (*CreateReviewResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateReviewRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateReviewRequest) when calling interceptor")
+					}
+					return s.ReviewService.CreateReview(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateReviewResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateReviewResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreateReviewResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateReviewResponse and nil error while calling CreateReview. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reviewServiceServer) serveCreateReviewProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateReview")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(CreateReviewRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ReviewService.CreateReview
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateReviewRequest) (*CreateReviewResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateReviewRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateReviewRequest) when calling interceptor")
+					}
+					return s.ReviewService.CreateReview(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateReviewResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateReviewResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreateReviewResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateReviewResponse and nil error while calling CreateReview. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reviewServiceServer) serveListReviewsByProduct(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListReviewsByProductJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListReviewsByProductProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *reviewServiceServer) serveListReviewsByProductJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListReviewsByProduct")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ListReviewsByProductRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ReviewService.ListReviewsByProduct
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListReviewsByProductRequest) (*ListReviewsByProductResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListReviewsByProductRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListReviewsByProductRequest) when calling interceptor")
+					}
+					return s.ReviewService.ListReviewsByProduct(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListReviewsByProductResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListReviewsByProductResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListReviewsByProductResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListReviewsByProductResponse and nil error while calling ListReviewsByProduct. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reviewServiceServer) serveListReviewsByProductProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListReviewsByProduct")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ListReviewsByProductRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ReviewService.ListReviewsByProduct
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListReviewsByProductRequest) (*ListReviewsByProductResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListReviewsByProductRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListReviewsByProductRequest) when calling interceptor")
+					}
+					return s.ReviewService.ListReviewsByProduct(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListReviewsByProductResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListReviewsByProductResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListReviewsByProductResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListReviewsByProductResponse and nil error while calling ListReviewsByProduct. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reviewServiceServer) serveUpdateReview(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveUpdateReviewJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveUpdateReviewProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *reviewServiceServer) serveUpdateReviewJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateReview")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(UpdateReviewRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ReviewService.UpdateReview
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateReviewRequest) (*UpdateReviewResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateReviewRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateReviewRequest) when calling interceptor")
+					}
+					return s.ReviewService.UpdateReview(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateReviewResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateReviewResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UpdateReviewResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpdateReviewResponse and nil error while calling UpdateReview. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reviewServiceServer) serveUpdateReviewProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateReview")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(UpdateReviewRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ReviewService.UpdateReview
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateReviewRequest) (*UpdateReviewResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateReviewRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateReviewRequest) when calling interceptor")
+					}
+					return s.ReviewService.UpdateReview(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateReviewResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateReviewResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UpdateReviewResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpdateReviewResponse and nil error while calling UpdateReview. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reviewServiceServer) serveDeleteReview(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveDeleteReviewJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveDeleteReviewProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *reviewServiceServer) serveDeleteReviewJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteReview")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(DeleteReviewRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ReviewService.DeleteReview
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DeleteReviewRequest) (*DeleteReviewResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteReviewRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteReviewRequest) when calling interceptor")
+					}
+					return s.ReviewService.DeleteReview(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteReviewResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteReviewResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *DeleteReviewResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DeleteReviewResponse and nil error while calling DeleteReview. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reviewServiceServer) serveDeleteReviewProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteReview")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(DeleteReviewRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ReviewService.DeleteReview
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DeleteReviewRequest) (*DeleteReviewResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteReviewRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteReviewRequest) when calling interceptor")
+					}
+					return s.ReviewService.DeleteReview(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteReviewResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteReviewResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *DeleteReviewResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DeleteReviewResponse and nil error while calling DeleteReview. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reviewServiceServer) serveGetProductRatingSummary(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetProductRatingSummaryJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetProductRatingSummaryProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *reviewServiceServer) serveGetProductRatingSummaryJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetProductRatingSummary")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetProductRatingSummaryRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ReviewService.GetProductRatingSummary
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetProductRatingSummaryRequest) (*GetProductRatingSummaryResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetProductRatingSummaryRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetProductRatingSummaryRequest) when calling interceptor")
+					}
+					return s.ReviewService.GetProductRatingSummary(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetProductRatingSummaryResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetProductRatingSummaryResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetProductRatingSummaryResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetProductRatingSummaryResponse and nil error while calling GetProductRatingSummary. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reviewServiceServer) serveGetProductRatingSummaryProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetProductRatingSummary")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetProductRatingSummaryRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ReviewService.GetProductRatingSummary
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetProductRatingSummaryRequest) (*GetProductRatingSummaryResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetProductRatingSummaryRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetProductRatingSummaryRequest) when calling interceptor")
+					}
+					return s.ReviewService.GetProductRatingSummary(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetProductRatingSummaryResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetProductRatingSummaryResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetProductRatingSummaryResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetProductRatingSummaryResponse and nil error while calling GetProductRatingSummary. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reviewServiceServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor10, 0
+}
+
+func (s *reviewServiceServer) ProtocGenTwirpVersion() string {
+	return "v8.1.3"
+}
+
+// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
+// that is everything in a Twirp route except for the <Method>. This can be used for routing,
+// for example to identify the requests that are targeted to this service in a mux.
+func (s *reviewServiceServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "go.escape.ship.proto.v1", "ReviewService")
+}
+
+var twirpFileDescriptor10 = []byte{
+	// 1102 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x4e, 0x23, 0x47,
+	0x17, 0xfd, 0xca, 0xbf, 0x70, 0x6d, 0xf3, 0x39, 0x05, 0x82, 0x8e, 0x67, 0x32, 0x78, 0x1a, 0x05,
+	0x11, 0x04, 0x6d, 0xc5, 0xc9, 0x08, 0x42, 0x16, 0x13, 0x60, 0x0c, 0x58, 0x22, 0x40, 0xda, 0x10,
+	0xa4, 0x48, 0x51, 0xab, 0x71, 0x17, 0x76, 0x8b, 0x76, 0x97, 0xd3, 0x55, 0xcd, 0xc4, 0x33, 0x9a,
+	0x4d, 0x5e, 0x61, 0xa4, 0x6c, 0x23, 0xcd, 0x36, 0xaf, 0x90, 0x75, 0x5e, 0x60, 0xa4, 0xac, 0xb2,
+	0xcc, 0x1b, 0x44, 0xd9, 0x47, 0x5d, 0x55, 0x1e, 0x6c, 0xe2, 0x36, 0x46, 0xc9, 0xce, 0x75, 0xee,
+	0xad, 0xaa, 0x73, 0x4f, 0xdf, 0x7b, 0xca, 0x90, 0x0f, 0xc8, 0xb5, 0x4b, 0x9e, 0x1b, 0xdd, 0x80,
+	0x72, 0x8a, 0x17, 0x5a, 0xd4, 0x20, 0xac, 0x69, 0x77, 0x89, 0xc1, 0xda, 0x6e, 0x57, 0xa2, 0xc6,
+	0xf5, 0xc7, 0xa5, 0x87, 0x2d, 0x4a, 0x5b, 0x1e, 0xa9, 0xd8, 0x5d, 0xb7, 0x62, 0xfb, 0x3e, 0xe5,
+	0x36, 0x77, 0xa9, 0xcf, 0x64, 0x42, 0xa9, 0xac, 0xa2, 0x62, 0x75, 0x11, 0x5e, 0x56, 0x2e, 0x5d,
+	0xe2, 0x39, 0x56, 0xc7, 0x66, 0x57, 0x32, 0x43, 0xff, 0x2d, 0x01, 0x19, 0x53, 0xdc, 0x84, 0x67,
+	0x20, 0xe1, 0x3a, 0x1a, 0x2a, 0xa3, 0x95, 0x69, 0x33, 0xe1, 0x3a, 0xf8, 0x03, 0x80, 0x6e, 0x40,
+	0x9d, 0xb0, 0xc9, 0x2d, 0xd7, 0xd1, 0x12, 0x02, 0x9f, 0x56, 0x48, 0xdd, 0xc1, 0x0b, 0x90, 0x0d,
+	0x19, 0x09, 0xa2, 0x58, 0x52, 0xc4, 0x32, 0xd1, 0xb2, 0xee, 0xe0, 0xf7, 0x61, 0x8a, 0x06, 0x8e,
+	0x8c, 0xa4, 0x44, 0x24, 0x2b, 0xd6, 0x75, 0x07, 0xcf, 0x43, 0x26, 0xb0, 0xb9, 0xeb, 0xb7, 0xb4,
+	0x74, 0x19, 0xad, 0xa4, 0x4d, 0xb5, 0xc2, 0x73, 0x90, 0xe6, 0x2e, 0xf7, 0x88, 0x96, 0x11, 0xf9,
+	0x72, 0x81, 0x35, 0xc8, 0x36, 0xa9, 0xcf, 0x89, 0xcf, 0xb5, 0xac, 0x3c, 0x47, 0x2d, 0x23, 0x6a,
+	0x6e, 0xc7, 0x6e, 0x11, 0x2b, 0x0c, 0x3c, 0xa6, 0x4d, 0x95, 0x93, 0x11, 0x35, 0x81, 0x9c, 0x05,
+	0x1e, 0xc3, 0x8b, 0x90, 0xb3, 0x43, 0xde, 0xa6, 0x81, 0xe5, 0xdb, 0x1d, 0xa2, 0x4d, 0x8b, 0xcd,
+	0x20, 0xa1, 0x23, 0xbb, 0x43, 0xf0, 0x12, 0x14, 0xda, 0xc4, 0xeb, 0x5e, 0x86, 0x9e, 0xd5, 0xa4,
+	0xa1, 0xcf, 0x35, 0x10, 0x74, 0xf2, 0x0a, 0xdc, 0xa5, 0xa1, 0xbc, 0xa4, 0x19, 0x10, 0x9b, 0x13,
+	0xc7, 0xb2, 0xb9, 0x96, 0x93, 0xf5, 0x2b, 0x64, 0x5b, 0x84, 0xc3, 0xae, 0xd3, 0x0f, 0xe7, 0x65,
+	0x58, 0x21, 0xdb, 0x5c, 0x7f, 0x8b, 0x60, 0x76, 0x57, 0x24, 0x4b, 0x79, 0x4d, 0xf2, 0x5d, 0x48,
+	0x18, 0xbf, 0xa5, 0x2a, 0xba, 0xad, 0xea, 0x83, 0x1b, 0x55, 0x85, 0xe2, 0x3b, 0x09, 0x0d, 0x8d,
+	0x54, 0x36, 0x19, 0xa7, 0x6c, 0x6a, 0xb4, 0xb2, 0xe9, 0x18, 0x65, 0x33, 0xe3, 0x94, 0xcd, 0xde,
+	0x52, 0x56, 0x3f, 0x86, 0xb9, 0xe1, 0xa2, 0x58, 0x97, 0xfa, 0x8c, 0xe0, 0x0d, 0xc8, 0xc8, 0x7e,
+	0x15, 0x15, 0xe5, 0xaa, 0x8b, 0x46, 0x4c, 0xc3, 0x1a, 0x6a, 0xa3, 0x4a, 0xd7, 0xff, 0x44, 0xf0,
+	0xe0, 0xd0, 0x65, 0x5c, 0xc2, 0x6c, 0xa7, 0x77, 0x22, 0xa5, 0x98, 0x58, 0xae, 0xe9, 0x6e, 0xc4,
+	0x96, 0xb9, 0x2f, 0x88, 0x10, 0x2c, 0x6d, 0x4e, 0x45, 0x40, 0xc3, 0x7d, 0x41, 0xc4, 0xde, 0x28,
+	0xc8, 0xe9, 0x15, 0xf1, 0x95, 0x60, 0x22, 0xfd, 0x34, 0x02, 0xf0, 0x06, 0xa4, 0x18, 0x0d, 0xb8,
+	0x10, 0x6c, 0xa6, 0xba, 0x74, 0x07, 0xe3, 0x06, 0x0d, 0xb8, 0x29, 0x36, 0xc4, 0x76, 0xf1, 0x0a,
+	0x14, 0x9f, 0xbb, 0xbc, 0x6d, 0x09, 0xb9, 0x98, 0x45, 0x7d, 0xaf, 0x27, 0xe4, 0x9d, 0x32, 0x67,
+	0x22, 0xbc, 0x2e, 0xe0, 0x63, 0xdf, 0xeb, 0xe9, 0x6f, 0x10, 0x3c, 0x1c, 0x5d, 0xb5, 0xd2, 0xf3,
+	0x33, 0xc8, 0x4a, 0x81, 0x98, 0x86, 0xca, 0xc9, 0x49, 0x04, 0xed, 0xe7, 0xe3, 0x65, 0xf8, 0xbf,
+	0x4f, 0xbe, 0xe7, 0xd6, 0x40, 0xe9, 0x72, 0x76, 0x0b, 0x11, 0x7c, 0xf2, 0xae, 0xfc, 0x45, 0xc8,
+	0x71, 0xca, 0xed, 0xfe, 0x04, 0x24, 0x45, 0x29, 0x20, 0x20, 0xd1, 0xff, 0xfa, 0x5f, 0x08, 0x66,
+	0xcf, 0x44, 0x3f, 0xdf, 0xb3, 0x83, 0xa7, 0x25, 0x95, 0x1b, 0xd7, 0x98, 0x92, 0xc0, 0x50, 0x9b,
+	0x26, 0x47, 0xb7, 0x69, 0x2a, 0xa6, 0x4d, 0xd3, 0xe3, 0xda, 0x34, 0x73, 0xdb, 0x00, 0x3e, 0x87,
+	0x9c, 0x9c, 0x44, 0x61, 0x75, 0xc2, 0x3d, 0x72, 0xd5, 0x92, 0x21, 0xdd, 0xd0, 0xe8, 0xbb, 0xa1,
+	0xb1, 0x17, 0xb9, 0xe1, 0x97, 0x36, 0xbb, 0x32, 0xd5, 0x28, 0x47, 0xbf, 0xa3, 0x1e, 0x1f, 0x2e,
+	0xfb, 0xdf, 0xf6, 0xf8, 0x57, 0x30, 0xfb, 0x8c, 0x78, 0xe4, 0x3f, 0xd4, 0x51, 0x9f, 0x87, 0xb9,
+	0xe1, 0x23, 0x25, 0x47, 0xfd, 0xd7, 0x04, 0x14, 0x4c, 0x21, 0x69, 0x23, 0xec, 0x74, 0xec, 0xa0,
+	0x77, 0xd7, 0x2d, 0x1f, 0xc2, 0x8c, 0x7d, 0x4d, 0x82, 0x48, 0x4a, 0xf5, 0x61, 0xa2, 0xab, 0x90,
+	0x59, 0x50, 0xa8, 0x3c, 0x0c, 0x3f, 0xee, 0xbf, 0x47, 0x43, 0xdd, 0x92, 0x93, 0x98, 0xb4, 0xcb,
+	0x6f, 0xa1, 0x20, 0x4f, 0x90, 0x29, 0x4c, 0x4b, 0x89, 0xc6, 0xdd, 0x8c, 0x57, 0x69, 0x90, 0xa7,
+	0x5a, 0x89, 0xa3, 0x58, 0xcd, 0xe7, 0x41, 0xcf, 0xcc, 0x07, 0x03, 0x10, 0x5e, 0x03, 0xdc, 0x6d,
+	0x53, 0x4e, 0xad, 0x21, 0x1e, 0x72, 0x00, 0x8b, 0x22, 0x62, 0xde, 0x90, 0x29, 0x3d, 0x85, 0xf7,
+	0xfe, 0x71, 0x20, 0x2e, 0x42, 0xf2, 0x8a, 0xf4, 0x84, 0x06, 0x69, 0x33, 0xfa, 0x19, 0xb5, 0xdd,
+	0xb5, 0xed, 0x85, 0x7d, 0xeb, 0x90, 0x8b, 0xad, 0xc4, 0x26, 0xd2, 0x9f, 0xc2, 0xa3, 0x7d, 0xc2,
+	0xfb, 0x63, 0x39, 0xc8, 0x74, 0xb2, 0xcf, 0xa7, 0x37, 0x61, 0x31, 0xf6, 0x00, 0xd5, 0x50, 0x5f,
+	0x40, 0x96, 0x49, 0x48, 0x75, 0xd4, 0xf2, 0x64, 0x5a, 0x99, 0xfd, 0x6d, 0xab, 0xaf, 0x11, 0xc0,
+	0x8d, 0x3d, 0xe1, 0x07, 0xb0, 0x60, 0xd6, 0xbe, 0xae, 0xd7, 0xce, 0xad, 0xc6, 0xb1, 0x79, 0x6a,
+	0x9d, 0x1d, 0x35, 0x4e, 0x6a, 0xbb, 0xf5, 0xbd, 0x7a, 0xed, 0x59, 0xf1, 0x7f, 0x78, 0x1e, 0xf0,
+	0x60, 0xf0, 0xa8, 0x76, 0x5e, 0x6b, 0x9c, 0x16, 0x11, 0x5e, 0x80, 0xd9, 0x41, 0xfc, 0xa0, 0x76,
+	0x78, 0xb2, 0x77, 0x76, 0x58, 0x4c, 0xdc, 0x3e, 0xcd, 0xdc, 0x3e, 0xad, 0x1f, 0xed, 0x5b, 0x07,
+	0xf5, 0xfd, 0x83, 0x62, 0x12, 0x97, 0x60, 0x7e, 0x44, 0xf0, 0xf0, 0xf8, 0xbc, 0x98, 0xaa, 0xfe,
+	0x9e, 0x81, 0x82, 0x62, 0x45, 0x82, 0x6b, 0xb7, 0x49, 0xf0, 0x8f, 0x08, 0xf2, 0x83, 0xef, 0x06,
+	0x5e, 0x8b, 0xad, 0x74, 0xc4, 0x9b, 0x59, 0x5a, 0x9f, 0x30, 0x5b, 0x0d, 0xc1, 0x47, 0x3f, 0xbc,
+	0xfd, 0xe3, 0x75, 0x62, 0x69, 0x0b, 0xad, 0xea, 0x8f, 0x2a, 0xea, 0x8b, 0xb0, 0xca, 0xcb, 0x9b,
+	0xaf, 0xf5, 0xaa, 0xd2, 0x37, 0xcb, 0x9f, 0x11, 0xcc, 0x8d, 0x32, 0x62, 0xfc, 0x69, 0xec, 0x95,
+	0x63, 0x5e, 0xab, 0xd2, 0x93, 0x7b, 0xee, 0x52, 0x84, 0x97, 0x05, 0xe1, 0x32, 0xbe, 0x8b, 0xed,
+	0x1b, 0x04, 0xf9, 0x41, 0x6b, 0x1a, 0x23, 0xe3, 0x08, 0xe3, 0x2e, 0xad, 0x4f, 0x98, 0xad, 0x58,
+	0x3d, 0x11, 0xac, 0x2a, 0x5b, 0x68, 0xb5, 0xba, 0x3a, 0x9e, 0x58, 0xe5, 0xe5, 0x3b, 0xa7, 0x7a,
+	0x85, 0x7f, 0x42, 0x90, 0x1f, 0xf4, 0xa6, 0x31, 0x24, 0x47, 0xb8, 0x62, 0x69, 0x7d, 0xc2, 0x6c,
+	0x45, 0xb2, 0x2a, 0x48, 0xae, 0xad, 0xde, 0x87, 0xe1, 0x2f, 0x08, 0x16, 0x62, 0x66, 0x13, 0x6f,
+	0xc4, 0x5e, 0x3f, 0xde, 0x0e, 0x4a, 0x9b, 0xf7, 0xdf, 0xa8, 0x4a, 0x30, 0x44, 0x09, 0x2b, 0x78,
+	0xf9, 0x8e, 0x12, 0xd4, 0xd0, 0xef, 0x2c, 0x7d, 0xf3, 0xb8, 0xe5, 0xf2, 0x76, 0x78, 0x61, 0x34,
+	0x69, 0xa7, 0x22, 0xaf, 0x5c, 0x8f, 0xae, 0x94, 0x7f, 0xf3, 0x59, 0xa5, 0x45, 0xfc, 0x8b, 0x8c,
+	0xf8, 0xfd, 0xc9, 0xdf, 0x03, 0x00, 0xb2, 0x6e, 0x52, 0x05, 0x47, 0x0c, 0x00, 0x00,
+}
@@ -0,0 +1,83 @@
+// Code generated by protoc-gen-go-shim. DO NOT EDIT.
+// source: review.proto
+
+package gen
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+)
+
+// ReviewServiceAPI is ReviewServiceClient without per-call options, so it can be
+// mocked with plain method signatures. Streams are exposed as iterators.
+type ReviewServiceAPI interface {
+	// 구매 확정(배송 완료) 주문의 상품에 대해 주문 항목당 1회 작성 가능, 작성자는 인증된 사용자
+	CreateReview(ctx context.Context, in *CreateReviewRequest) (*CreateReviewResponse, error)
+	ListReviewsByProduct(ctx context.Context, in *ListReviewsByProductRequest) (*ListReviewsByProductResponse, error)
+	// 작성자 본인만 수정 가능
+	UpdateReview(ctx context.Context, in *UpdateReviewRequest) (*UpdateReviewResponse, error)
+	// 작성자 본인 또는 관리자만 삭제 가능
+	DeleteReview(ctx context.Context, in *DeleteReviewRequest) (*DeleteReviewResponse, error)
+	GetProductRatingSummary(ctx context.Context, in *GetProductRatingSummaryRequest) (*GetProductRatingSummaryResponse, error)
+}
+
+// NewReviewServiceAPI adapts c to ReviewServiceAPI, passing opts to every call.
+func NewReviewServiceAPI(c ReviewServiceClient, opts ...grpc.CallOption) ReviewServiceAPI {
+	return &reviewServiceAPI{c: c, opts: opts}
+}
+
+type reviewServiceAPI struct {
+	c    ReviewServiceClient
+	opts []grpc.CallOption
+}
+
+func (a *reviewServiceAPI) CreateReview(ctx context.Context, in *CreateReviewRequest) (*CreateReviewResponse, error) {
+	return a.c.CreateReview(ctx, in, a.opts...)
+}
+
+func (a *reviewServiceAPI) ListReviewsByProduct(ctx context.Context, in *ListReviewsByProductRequest) (*ListReviewsByProductResponse, error) {
+	return a.c.ListReviewsByProduct(ctx, in, a.opts...)
+}
+
+func (a *reviewServiceAPI) UpdateReview(ctx context.Context, in *UpdateReviewRequest) (*UpdateReviewResponse, error) {
+	return a.c.UpdateReview(ctx, in, a.opts...)
+}
+
+func (a *reviewServiceAPI) DeleteReview(ctx context.Context, in *DeleteReviewRequest) (*DeleteReviewResponse, error) {
+	return a.c.DeleteReview(ctx, in, a.opts...)
+}
+
+func (a *reviewServiceAPI) GetProductRatingSummary(ctx context.Context, in *GetProductRatingSummaryRequest) (*GetProductRatingSummaryResponse, error) {
+	return a.c.GetProductRatingSummary(ctx, in, a.opts...)
+}
+
+// ReviewServiceClientFromAPI adapts a to ReviewServiceClient, e.g. to hand a
+// mock ReviewServiceAPI to code that takes the generated client. Call options
+// are ignored, and streams report empty headers and trailers.
+func ReviewServiceClientFromAPI(a ReviewServiceAPI) ReviewServiceClient {
+	return reviewServiceAPIClient{api: a}
+}
+
+type reviewServiceAPIClient struct {
+	api ReviewServiceAPI
+}
+
+func (c reviewServiceAPIClient) CreateReview(ctx context.Context, in *CreateReviewRequest, _ ...grpc.CallOption) (*CreateReviewResponse, error) {
+	return c.api.CreateReview(ctx, in)
+}
+
+func (c reviewServiceAPIClient) ListReviewsByProduct(ctx context.Context, in *ListReviewsByProductRequest, _ ...grpc.CallOption) (*ListReviewsByProductResponse, error) {
+	return c.api.ListReviewsByProduct(ctx, in)
+}
+
+func (c reviewServiceAPIClient) UpdateReview(ctx context.Context, in *UpdateReviewRequest, _ ...grpc.CallOption) (*UpdateReviewResponse, error) {
+	return c.api.UpdateReview(ctx, in)
+}
+
+func (c reviewServiceAPIClient) DeleteReview(ctx context.Context, in *DeleteReviewRequest, _ ...grpc.CallOption) (*DeleteReviewResponse, error) {
+	return c.api.DeleteReview(ctx, in)
+}
+
+func (c reviewServiceAPIClient) GetProductRatingSummary(ctx context.Context, in *GetProductRatingSummaryRequest, _ ...grpc.CallOption) (*GetProductRatingSummaryResponse, error) {
+	return c.api.GetProductRatingSummary(ctx, in)
+}
@@ -0,0 +1,33 @@
+package gen
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCreateReviewAuthor(t *testing.T) {
+	signedIn := ContextWithUserClaims(context.Background(), &UserClaims{UserID: "u-1"})
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		userID   string
+		want     string
+		wantCode codes.Code
+	}{
+		{name: "from token", ctx: signedIn, want: "u-1"},
+		{name: "matching user_id", ctx: signedIn, userID: "u-1", want: "u-1"},
+		{name: "other user", ctx: signedIn, userID: "u-2", wantCode: codes.PermissionDenied},
+		{name: "not signed in", ctx: context.Background(), userID: "u-1", wantCode: codes.Unauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := (&CreateReviewRequest{ProductId: "p-1", UserId: tt.userID}).Author(tt.ctx)
+			if status.Code(err) != tt.wantCode || got != tt.want {
+				t.Errorf("Author() = %q, %v, want %q, %v", got, err, tt.want, tt.wantCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,597 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: risk.proto
+
+package gen
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BlocklistEntryType int32
+
+const (
+	BlocklistEntryType_BLOCKLIST_ENTRY_TYPE_UNSPECIFIED BlocklistEntryType = 0
+	BlocklistEntryType_BLOCKLIST_ENTRY_TYPE_EMAIL       BlocklistEntryType = 1
+	BlocklistEntryType_BLOCKLIST_ENTRY_TYPE_PHONE       BlocklistEntryType = 2
+	BlocklistEntryType_BLOCKLIST_ENTRY_TYPE_DEVICE_ID   BlocklistEntryType = 3
+	BlocklistEntryType_BLOCKLIST_ENTRY_TYPE_IP_RANGE    BlocklistEntryType = 4 // CIDR 표기 (ex: "203.0.113.0/24")
+)
+
+// Enum value maps for BlocklistEntryType.
+var (
+	BlocklistEntryType_name = map[int32]string{
+		0: "BLOCKLIST_ENTRY_TYPE_UNSPECIFIED",
+		1: "BLOCKLIST_ENTRY_TYPE_EMAIL",
+		2: "BLOCKLIST_ENTRY_TYPE_PHONE",
+		3: "BLOCKLIST_ENTRY_TYPE_DEVICE_ID",
+		4: "BLOCKLIST_ENTRY_TYPE_IP_RANGE",
+	}
+	BlocklistEntryType_value = map[string]int32{
+		"BLOCKLIST_ENTRY_TYPE_UNSPECIFIED": 0,
+		"BLOCKLIST_ENTRY_TYPE_EMAIL":       1,
+		"BLOCKLIST_ENTRY_TYPE_PHONE":       2,
+		"BLOCKLIST_ENTRY_TYPE_DEVICE_ID":   3,
+		"BLOCKLIST_ENTRY_TYPE_IP_RANGE":    4,
+	}
+)
+
+func (x BlocklistEntryType) Enum() *BlocklistEntryType {
+	p := new(BlocklistEntryType)
+	*p = x
+	return p
+}
+
+func (x BlocklistEntryType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BlocklistEntryType) Descriptor() protoreflect.EnumDescriptor {
+	return file_risk_proto_enumTypes[0].Descriptor()
+}
+
+func (BlocklistEntryType) Type() protoreflect.EnumType {
+	return &file_risk_proto_enumTypes[0]
+}
+
+func (x BlocklistEntryType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BlocklistEntryType.Descriptor instead.
+func (BlocklistEntryType) EnumDescriptor() ([]byte, []int) {
+	return file_risk_proto_rawDescGZIP(), []int{0}
+}
+
+type BlocklistEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          BlocklistEntryType     `protobuf:"varint,2,opt,name=type,proto3,enum=go.escape.ship.proto.v1.BlocklistEntryType" json:"type,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"` // 등록한 관리자 ID
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // 비어 있으면 영구 차단
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlocklistEntry) Reset() {
+	*x = BlocklistEntry{}
+	mi := &file_risk_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlocklistEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlocklistEntry) ProtoMessage() {}
+
+func (x *BlocklistEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_risk_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlocklistEntry.ProtoReflect.Descriptor instead.
+func (*BlocklistEntry) Descriptor() ([]byte, []int) {
+	return file_risk_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BlocklistEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BlocklistEntry) GetType() BlocklistEntryType {
+	if x != nil {
+		return x.Type
+	}
+	return BlocklistEntryType_BLOCKLIST_ENTRY_TYPE_UNSPECIFIED
+}
+
+func (x *BlocklistEntry) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *BlocklistEntry) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BlocklistEntry) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *BlocklistEntry) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *BlocklistEntry) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type AddToBlocklistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          BlocklistEntryType     `protobuf:"varint,1,opt,name=type,proto3,enum=go.escape.ship.proto.v1.BlocklistEntryType" json:"type,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddToBlocklistRequest) Reset() {
+	*x = AddToBlocklistRequest{}
+	mi := &file_risk_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddToBlocklistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddToBlocklistRequest) ProtoMessage() {}
+
+func (x *AddToBlocklistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_risk_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddToBlocklistRequest.ProtoReflect.Descriptor instead.
+func (*AddToBlocklistRequest) Descriptor() ([]byte, []int) {
+	return file_risk_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AddToBlocklistRequest) GetType() BlocklistEntryType {
+	if x != nil {
+		return x.Type
+	}
+	return BlocklistEntryType_BLOCKLIST_ENTRY_TYPE_UNSPECIFIED
+}
+
+func (x *AddToBlocklistRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *AddToBlocklistRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AddToBlocklistRequest) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type AddToBlocklistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *BlocklistEntry        `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddToBlocklistResponse) Reset() {
+	*x = AddToBlocklistResponse{}
+	mi := &file_risk_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddToBlocklistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddToBlocklistResponse) ProtoMessage() {}
+
+func (x *AddToBlocklistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_risk_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddToBlocklistResponse.ProtoReflect.Descriptor instead.
+func (*AddToBlocklistResponse) Descriptor() ([]byte, []int) {
+	return file_risk_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AddToBlocklistResponse) GetEntry() *BlocklistEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type RemoveFromBlocklistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryId       string                 `protobuf:"bytes,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFromBlocklistRequest) Reset() {
+	*x = RemoveFromBlocklistRequest{}
+	mi := &file_risk_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFromBlocklistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFromBlocklistRequest) ProtoMessage() {}
+
+func (x *RemoveFromBlocklistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_risk_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFromBlocklistRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFromBlocklistRequest) Descriptor() ([]byte, []int) {
+	return file_risk_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RemoveFromBlocklistRequest) GetEntryId() string {
+	if x != nil {
+		return x.EntryId
+	}
+	return ""
+}
+
+type RemoveFromBlocklistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFromBlocklistResponse) Reset() {
+	*x = RemoveFromBlocklistResponse{}
+	mi := &file_risk_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFromBlocklistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFromBlocklistResponse) ProtoMessage() {}
+
+func (x *RemoveFromBlocklistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_risk_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFromBlocklistResponse.ProtoReflect.Descriptor instead.
+func (*RemoveFromBlocklistResponse) Descriptor() ([]byte, []int) {
+	return file_risk_proto_rawDescGZIP(), []int{4}
+}
+
+type CheckBlocklistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Phone         string                 `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`
+	DeviceId      string                 `protobuf:"bytes,3,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	IpAddress     string                 `protobuf:"bytes,4,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckBlocklistRequest) Reset() {
+	*x = CheckBlocklistRequest{}
+	mi := &file_risk_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckBlocklistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckBlocklistRequest) ProtoMessage() {}
+
+func (x *CheckBlocklistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_risk_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckBlocklistRequest.ProtoReflect.Descriptor instead.
+func (*CheckBlocklistRequest) Descriptor() ([]byte, []int) {
+	return file_risk_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CheckBlocklistRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CheckBlocklistRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *CheckBlocklistRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *CheckBlocklistRequest) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+type CheckBlocklistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Blocked       bool                   `protobuf:"varint,1,opt,name=blocked,proto3" json:"blocked,omitempty"`
+	Matches       []*BlocklistEntry      `protobuf:"bytes,2,rep,name=matches,proto3" json:"matches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckBlocklistResponse) Reset() {
+	*x = CheckBlocklistResponse{}
+	mi := &file_risk_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckBlocklistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckBlocklistResponse) ProtoMessage() {}
+
+func (x *CheckBlocklistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_risk_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckBlocklistResponse.ProtoReflect.Descriptor instead.
+func (*CheckBlocklistResponse) Descriptor() ([]byte, []int) {
+	return file_risk_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CheckBlocklistResponse) GetBlocked() bool {
+	if x != nil {
+		return x.Blocked
+	}
+	return false
+}
+
+func (x *CheckBlocklistResponse) GetMatches() []*BlocklistEntry {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+var File_risk_proto protoreflect.FileDescriptor
+
+const file_risk_proto_rawDesc = "" +
+	"\n" +
+	"\n" +
+	"risk.proto\x12\x17go.escape.ship.proto.v1\x1a\x1cgoogle/api/annotations.proto\"\xec\x01\n" +
+	"\x0eBlocklistEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12?\n" +
+	"\x04type\x18\x02 \x01(\x0e2+.go.escape.ship.proto.v1.BlocklistEntryTypeR\x04type\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x05 \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\tR\texpiresAt\"\xa5\x01\n" +
+	"\x15AddToBlocklistRequest\x12?\n" +
+	"\x04type\x18\x01 \x01(\x0e2+.go.escape.ship.proto.v1.BlocklistEntryTypeR\x04type\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\tR\texpiresAt\"W\n" +
+	"\x16AddToBlocklistResponse\x12=\n" +
+	"\x05entry\x18\x01 \x01(\v2'.go.escape.ship.proto.v1.BlocklistEntryR\x05entry\"7\n" +
+	"\x1aRemoveFromBlocklistRequest\x12\x19\n" +
+	"\bentry_id\x18\x01 \x01(\tR\aentryId\"\x1d\n" +
+	"\x1bRemoveFromBlocklistResponse\"\x7f\n" +
+	"\x15CheckBlocklistRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\x02 \x01(\tR\x05phone\x12\x1b\n" +
+	"\tdevice_id\x18\x03 \x01(\tR\bdeviceId\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x04 \x01(\tR\tipAddress\"u\n" +
+	"\x16CheckBlocklistResponse\x12\x18\n" +
+	"\ablocked\x18\x01 \x01(\bR\ablocked\x12A\n" +
+	"\amatches\x18\x02 \x03(\v2'.go.escape.ship.proto.v1.BlocklistEntryR\amatches*\xc1\x01\n" +
+	"\x12BlocklistEntryType\x12$\n" +
+	" BLOCKLIST_ENTRY_TYPE_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aBLOCKLIST_ENTRY_TYPE_EMAIL\x10\x01\x12\x1e\n" +
+	"\x1aBLOCKLIST_ENTRY_TYPE_PHONE\x10\x02\x12\"\n" +
+	"\x1eBLOCKLIST_ENTRY_TYPE_DEVICE_ID\x10\x03\x12!\n" +
+	"\x1dBLOCKLIST_ENTRY_TYPE_IP_RANGE\x10\x042\xe3\x03\n" +
+	"\vRiskService\x12\x90\x01\n" +
+	"\x0eAddToBlocklist\x12..go.escape.ship.proto.v1.AddToBlocklistRequest\x1a/.go.escape.ship.proto.v1.AddToBlocklistResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/risk/blocklist\x12\xa7\x01\n" +
+	"\x13RemoveFromBlocklist\x123.go.escape.ship.proto.v1.RemoveFromBlocklistRequest\x1a4.go.escape.ship.proto.v1.RemoveFromBlocklistResponse\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/v1/risk/blocklist/{entry_id}\x12\x96\x01\n" +
+	"\x0eCheckBlocklist\x12..go.escape.ship.proto.v1.CheckBlocklistRequest\x1a/.go.escape.ship.proto.v1.CheckBlocklistResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/risk/blocklist/checkB#Z!github.com/escape-ship/protos/genb\x06proto3"
+
+var (
+	file_risk_proto_rawDescOnce sync.Once
+	file_risk_proto_rawDescData []byte
+)
+
+func file_risk_proto_rawDescGZIP() []byte {
+	file_risk_proto_rawDescOnce.Do(func() {
+		file_risk_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_risk_proto_rawDesc), len(file_risk_proto_rawDesc)))
+	})
+	return file_risk_proto_rawDescData
+}
+
+var file_risk_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_risk_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_risk_proto_goTypes = []any{
+	(BlocklistEntryType)(0),             // 0: go.escape.ship.proto.v1.BlocklistEntryType
+	(*BlocklistEntry)(nil),              // 1: go.escape.ship.proto.v1.BlocklistEntry
+	(*AddToBlocklistRequest)(nil),       // 2: go.escape.ship.proto.v1.AddToBlocklistRequest
+	(*AddToBlocklistResponse)(nil),      // 3: go.escape.ship.proto.v1.AddToBlocklistResponse
+	(*RemoveFromBlocklistRequest)(nil),  // 4: go.escape.ship.proto.v1.RemoveFromBlocklistRequest
+	(*RemoveFromBlocklistResponse)(nil), // 5: go.escape.ship.proto.v1.RemoveFromBlocklistResponse
+	(*CheckBlocklistRequest)(nil),       // 6: go.escape.ship.proto.v1.CheckBlocklistRequest
+	(*CheckBlocklistResponse)(nil),      // 7: go.escape.ship.proto.v1.CheckBlocklistResponse
+}
+var file_risk_proto_depIdxs = []int32{
+	0, // 0: go.escape.ship.proto.v1.BlocklistEntry.type:type_name -> go.escape.ship.proto.v1.BlocklistEntryType
+	0, // 1: go.escape.ship.proto.v1.AddToBlocklistRequest.type:type_name -> go.escape.ship.proto.v1.BlocklistEntryType
+	1, // 2: go.escape.ship.proto.v1.AddToBlocklistResponse.entry:type_name -> go.escape.ship.proto.v1.BlocklistEntry
+	1, // 3: go.escape.ship.proto.v1.CheckBlocklistResponse.matches:type_name -> go.escape.ship.proto.v1.BlocklistEntry
+	2, // 4: go.escape.ship.proto.v1.RiskService.AddToBlocklist:input_type -> go.escape.ship.proto.v1.AddToBlocklistRequest
+	4, // 5: go.escape.ship.proto.v1.RiskService.RemoveFromBlocklist:input_type -> go.escape.ship.proto.v1.RemoveFromBlocklistRequest
+	6, // 6: go.escape.ship.proto.v1.RiskService.CheckBlocklist:input_type -> go.escape.ship.proto.v1.CheckBlocklistRequest
+	3, // 7: go.escape.ship.proto.v1.RiskService.AddToBlocklist:output_type -> go.escape.ship.proto.v1.AddToBlocklistResponse
+	5, // 8: go.escape.ship.proto.v1.RiskService.RemoveFromBlocklist:output_type -> go.escape.ship.proto.v1.RemoveFromBlocklistResponse
+	7, // 9: go.escape.ship.proto.v1.RiskService.CheckBlocklist:output_type -> go.escape.ship.proto.v1.CheckBlocklistResponse
+	7, // [7:10] is the sub-list for method output_type
+	4, // [4:7] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_risk_proto_init() }
+func file_risk_proto_init() {
+	if File_risk_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_risk_proto_rawDesc), len(file_risk_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_risk_proto_goTypes,
+		DependencyIndexes: file_risk_proto_depIdxs,
+		EnumInfos:         file_risk_proto_enumTypes,
+		MessageInfos:      file_risk_proto_msgTypes,
+	}.Build()
+	File_risk_proto = out.File
+	file_risk_proto_goTypes = nil
+	file_risk_proto_depIdxs = nil
+}
@@ -0,0 +1,1123 @@
+// Code generated by protoc-gen-twirp v8.1.3, DO NOT EDIT.
+// source: risk.proto
+
+package gen
+
+import context "context"
+import fmt "fmt"
+import http "net/http"
+import io "io"
+import json "encoding/json"
+import strconv "strconv"
+import strings "strings"
+
+import protojson "google.golang.org/protobuf/encoding/protojson"
+import proto "google.golang.org/protobuf/proto"
+import twirp "github.com/twitchtv/twirp"
+import ctxsetters "github.com/twitchtv/twirp/ctxsetters"
+
+// Version compatibility assertion.
+// If the constant is not defined in the package, that likely means
+// the package needs to be updated to work with this generated code.
+// See https://twitchtv.github.io/twirp/docs/version_matrix.html
+const _ = twirp.TwirpPackageMinVersion_8_1_0
+
+// =====================
+// RiskService Interface
+// =====================
+
+// 부정 거래 방지: 차단 목록 관리 및 조회
+type RiskService interface {
+	AddToBlocklist(context.Context, *AddToBlocklistRequest) (*AddToBlocklistResponse, error)
+
+	RemoveFromBlocklist(context.Context, *RemoveFromBlocklistRequest) (*RemoveFromBlocklistResponse, error)
+
+	// 주어진 식별자 중 하나라도 차단 목록에 있으면 blocked=true
+	CheckBlocklist(context.Context, *CheckBlocklistRequest) (*CheckBlocklistResponse, error)
+}
+
+// ===========================
+// RiskService Protobuf Client
+// ===========================
+
+type riskServiceProtobufClient struct {
+	client      HTTPClient
+	urls        [3]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewRiskServiceProtobufClient creates a Protobuf client that implements the RiskService interface.
+// It communicates using Protobuf and can be configured with a custom HTTPClient.
+func NewRiskServiceProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) RiskService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "go.escape.ship.proto.v1", "RiskService")
+	urls := [3]string{
+		serviceURL + "AddToBlocklist",
+		serviceURL + "RemoveFromBlocklist",
+		serviceURL + "CheckBlocklist",
+	}
+
+	return &riskServiceProtobufClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *riskServiceProtobufClient) AddToBlocklist(ctx context.Context, in *AddToBlocklistRequest) (*AddToBlocklistResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "RiskService")
+	ctx = ctxsetters.WithMethodName(ctx, "AddToBlocklist")
+	caller := c.callAddToBlocklist
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AddToBlocklistRequest) (*AddToBlocklistResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AddToBlocklistRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AddToBlocklistRequest) when calling interceptor")
+					}
+					return c.callAddToBlocklist(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AddToBlocklistResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AddToBlocklistResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *riskServiceProtobufClient) callAddToBlocklist(ctx context.Context, in *AddToBlocklistRequest) (*AddToBlocklistResponse, error) {
+	out := new(AddToBlocklistResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *riskServiceProtobufClient) RemoveFromBlocklist(ctx context.Context, in *RemoveFromBlocklistRequest) (*RemoveFromBlocklistResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "RiskService")
+	ctx = ctxsetters.WithMethodName(ctx, "RemoveFromBlocklist")
+	caller := c.callRemoveFromBlocklist
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RemoveFromBlocklistRequest) (*RemoveFromBlocklistResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RemoveFromBlocklistRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RemoveFromBlocklistRequest) when calling interceptor")
+					}
+					return c.callRemoveFromBlocklist(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RemoveFromBlocklistResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RemoveFromBlocklistResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *riskServiceProtobufClient) callRemoveFromBlocklist(ctx context.Context, in *RemoveFromBlocklistRequest) (*RemoveFromBlocklistResponse, error) {
+	out := new(RemoveFromBlocklistResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *riskServiceProtobufClient) CheckBlocklist(ctx context.Context, in *CheckBlocklistRequest) (*CheckBlocklistResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "RiskService")
+	ctx = ctxsetters.WithMethodName(ctx, "CheckBlocklist")
+	caller := c.callCheckBlocklist
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CheckBlocklistRequest) (*CheckBlocklistResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CheckBlocklistRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CheckBlocklistRequest) when calling interceptor")
+					}
+					return c.callCheckBlocklist(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CheckBlocklistResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CheckBlocklistResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *riskServiceProtobufClient) callCheckBlocklist(ctx context.Context, in *CheckBlocklistRequest) (*CheckBlocklistResponse, error) {
+	out := new(CheckBlocklistResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// =======================
+// RiskService JSON Client
+// =======================
+
+type riskServiceJSONClient struct {
+	client      HTTPClient
+	urls        [3]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewRiskServiceJSONClient creates a JSON client that implements the RiskService interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewRiskServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) RiskService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "go.escape.ship.proto.v1", "RiskService")
+	urls := [3]string{
+		serviceURL + "AddToBlocklist",
+		serviceURL + "RemoveFromBlocklist",
+		serviceURL + "CheckBlocklist",
+	}
+
+	return &riskServiceJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *riskServiceJSONClient) AddToBlocklist(ctx context.Context, in *AddToBlocklistRequest) (*AddToBlocklistResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "RiskService")
+	ctx = ctxsetters.WithMethodName(ctx, "AddToBlocklist")
+	caller := c.callAddToBlocklist
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AddToBlocklistRequest) (*AddToBlocklistResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AddToBlocklistRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AddToBlocklistRequest) when calling interceptor")
+					}
+					return c.callAddToBlocklist(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AddToBlocklistResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AddToBlocklistResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *riskServiceJSONClient) callAddToBlocklist(ctx context.Context, in *AddToBlocklistRequest) (*AddToBlocklistResponse, error) {
+	out := new(AddToBlocklistResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *riskServiceJSONClient) RemoveFromBlocklist(ctx context.Context, in *RemoveFromBlocklistRequest) (*RemoveFromBlocklistResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "RiskService")
+	ctx = ctxsetters.WithMethodName(ctx, "RemoveFromBlocklist")
+	caller := c.callRemoveFromBlocklist
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RemoveFromBlocklistRequest) (*RemoveFromBlocklistResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RemoveFromBlocklistRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RemoveFromBlocklistRequest) when calling interceptor")
+					}
+					return c.callRemoveFromBlocklist(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RemoveFromBlocklistResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RemoveFromBlocklistResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *riskServiceJSONClient) callRemoveFromBlocklist(ctx context.Context, in *RemoveFromBlocklistRequest) (*RemoveFromBlocklistResponse, error) {
+	out := new(RemoveFromBlocklistResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *riskServiceJSONClient) CheckBlocklist(ctx context.Context, in *CheckBlocklistRequest) (*CheckBlocklistResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "RiskService")
+	ctx = ctxsetters.WithMethodName(ctx, "CheckBlocklist")
+	caller := c.callCheckBlocklist
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CheckBlocklistRequest) (*CheckBlocklistResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CheckBlocklistRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CheckBlocklistRequest) when calling interceptor")
+					}
+					return c.callCheckBlocklist(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CheckBlocklistResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CheckBlocklistResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *riskServiceJSONClient) callCheckBlocklist(ctx context.Context, in *CheckBlocklistRequest) (*CheckBlocklistResponse, error) {
+	out := new(CheckBlocklistResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ==========================
+// RiskService Server Handler
+// ==========================
+
+type riskServiceServer struct {
+	RiskService
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewRiskServiceServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewRiskServiceServer(svc RiskService, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	return &riskServiceServer{
+		RiskService:      svc,
+		hooks:            serverOpts.Hooks,
+		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:       pathPrefix,
+		jsonSkipDefaults: jsonSkipDefaults,
+		jsonCamelCase:    jsonCamelCase,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *riskServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *riskServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
+
+// RiskServicePathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const RiskServicePathPrefix = "/twirp/go.escape.ship.proto.v1.RiskService/"
+
+func (s *riskServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "RiskService")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "go.escape.ship.proto.v1.RiskService" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "AddToBlocklist":
+		s.serveAddToBlocklist(ctx, resp, req)
+		return
+	case "RemoveFromBlocklist":
+		s.serveRemoveFromBlocklist(ctx, resp, req)
+		return
+	case "CheckBlocklist":
+		s.serveCheckBlocklist(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *riskServiceServer) serveAddToBlocklist(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveAddToBlocklistJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveAddToBlocklistProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *riskServiceServer) serveAddToBlocklistJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "AddToBlocklist")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(AddToBlocklistRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.RiskService.AddToBlocklist
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *AddToBlocklistRequest) (*AddToBlocklistResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AddToBlocklistRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AddToBlocklistRequest) when calling interceptor")
+					}
+					return s.RiskService.AddToBlocklist(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AddToBlocklistResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AddToBlocklistResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *AddToBlocklistResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AddToBlocklistResponse and nil error while calling AddToBlocklist. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *riskServiceServer) serveAddToBlocklistProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "AddToBlocklist")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(AddToBlocklistRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.RiskService.AddToBlocklist
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *AddToBlocklistRequest) (*AddToBlocklistResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AddToBlocklistRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AddToBlocklistRequest) when calling interceptor")
+					}
+					return s.RiskService.AddToBlocklist(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AddToBlocklistResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AddToBlocklistResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *AddToBlocklistResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AddToBlocklistResponse and nil error while calling AddToBlocklist. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *riskServiceServer) serveRemoveFromBlocklist(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveRemoveFromBlocklistJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveRemoveFromBlocklistProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *riskServiceServer) serveRemoveFromBlocklistJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RemoveFromBlocklist")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(RemoveFromBlocklistRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.RiskService.RemoveFromBlocklist
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RemoveFromBlocklistRequest) (*RemoveFromBlocklistResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RemoveFromBlocklistRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RemoveFromBlocklistRequest) when calling interceptor")
+					}
+					return s.RiskService.RemoveFromBlocklist(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RemoveFromBlocklistResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RemoveFromBlocklistResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RemoveFromBlocklistResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RemoveFromBlocklistResponse and nil error while calling RemoveFromBlocklist. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *riskServiceServer) serveRemoveFromBlocklistProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RemoveFromBlocklist")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(RemoveFromBlocklistRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.RiskService.RemoveFromBlocklist
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RemoveFromBlocklistRequest) (*RemoveFromBlocklistResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RemoveFromBlocklistRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RemoveFromBlocklistRequest) when calling interceptor")
+					}
+					return s.RiskService.RemoveFromBlocklist(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RemoveFromBlocklistResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RemoveFromBlocklistResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RemoveFromBlocklistResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RemoveFromBlocklistResponse and nil error while calling RemoveFromBlocklist. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *riskServiceServer) serveCheckBlocklist(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveCheckBlocklistJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveCheckBlocklistProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *riskServiceServer) serveCheckBlocklistJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CheckBlocklist")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(CheckBlocklistRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.RiskService.CheckBlocklist
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CheckBlocklistRequest) (*CheckBlocklistResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CheckBlocklistRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CheckBlocklistRequest) when calling interceptor")
+					}
+					return s.RiskService.CheckBlocklist(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CheckBlocklistResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CheckBlocklistResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CheckBlocklistResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CheckBlocklistResponse and nil error while calling CheckBlocklist. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *riskServiceServer) serveCheckBlocklistProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CheckBlocklist")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(CheckBlocklistRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.RiskService.CheckBlocklist
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CheckBlocklistRequest) (*CheckBlocklistResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CheckBlocklistRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CheckBlocklistRequest) when calling interceptor")
+					}
+					return s.RiskService.CheckBlocklist(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CheckBlocklistResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CheckBlocklistResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CheckBlocklistResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CheckBlocklistResponse and nil error while calling CheckBlocklist. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *riskServiceServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor11, 0
+}
+
+func (s *riskServiceServer) ProtocGenTwirpVersion() string {
+	return "v8.1.3"
+}
+
+// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
+// that is everything in a Twirp route except for the <Method>. This can be used for routing,
+// for example to identify the requests that are targeted to this service in a mux.
+func (s *riskServiceServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "go.escape.ship.proto.v1", "RiskService")
+}
+
+var twirpFileDescriptor11 = []byte{
+	// 665 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xff, 0x6a, 0xd3, 0x50,
+	0x14, 0x36, 0x69, 0xbb, 0x6e, 0x67, 0x50, 0xca, 0x75, 0x3f, 0x62, 0x67, 0x67, 0x97, 0x29, 0x8e,
+	0x89, 0x09, 0xdb, 0x04, 0x41, 0x10, 0xc9, 0xba, 0x4c, 0x83, 0xb3, 0x2b, 0x59, 0x55, 0xe6, 0x3f,
+	0x21, 0x4d, 0x2e, 0xed, 0xa5, 0x6d, 0x6e, 0xcc, 0x4d, 0x8b, 0x45, 0x44, 0xf0, 0x09, 0x04, 0xc1,
+	0x37, 0x10, 0x9f, 0xc3, 0x67, 0xf0, 0x11, 0xf4, 0x4f, 0x1f, 0x42, 0x72, 0x93, 0x96, 0xb5, 0x4d,
+	0x65, 0xc3, 0xff, 0x72, 0xbe, 0xef, 0xfc, 0xf8, 0xce, 0xc7, 0xb9, 0x01, 0x08, 0x08, 0xeb, 0x28,
+	0x7e, 0x40, 0x43, 0x8a, 0xd6, 0x5b, 0x54, 0xc1, 0xcc, 0xb1, 0x7d, 0xac, 0xb0, 0x36, 0xf1, 0x63,
+	0x54, 0x19, 0xec, 0x95, 0x6e, 0xb6, 0x28, 0x6d, 0x75, 0xb1, 0x6a, 0xfb, 0x44, 0xb5, 0x3d, 0x8f,
+	0x86, 0x76, 0x48, 0xa8, 0xc7, 0xe2, 0x04, 0xf9, 0x8f, 0x00, 0x85, 0xc3, 0x2e, 0x75, 0x3a, 0x5d,
+	0xc2, 0x42, 0xdd, 0x0b, 0x83, 0x21, 0x2a, 0x80, 0x48, 0x5c, 0x49, 0xa8, 0x08, 0x3b, 0x4b, 0xa6,
+	0x48, 0x5c, 0xf4, 0x04, 0xb2, 0xe1, 0xd0, 0xc7, 0x92, 0x58, 0x11, 0x76, 0x0a, 0xfb, 0xf7, 0x94,
+	0x39, 0x83, 0x94, 0xc9, 0x36, 0x8d, 0xa1, 0x8f, 0x4d, 0x5e, 0x88, 0x56, 0x20, 0x37, 0xb0, 0xbb,
+	0x7d, 0x2c, 0x65, 0x78, 0xcf, 0x38, 0x40, 0x6b, 0xb0, 0x10, 0x60, 0x9b, 0x51, 0x4f, 0xca, 0x72,
+	0x38, 0x89, 0x50, 0x19, 0xc0, 0x09, 0xb0, 0x1d, 0x62, 0xd7, 0x6a, 0x0e, 0xa5, 0x1c, 0xe7, 0x96,
+	0x12, 0xe4, 0x70, 0x78, 0x91, 0xb6, 0x43, 0x69, 0x61, 0x82, 0xd6, 0xc2, 0x88, 0xc6, 0xef, 0x7c,
+	0x12, 0x60, 0x16, 0xd1, 0xf9, 0x98, 0x4e, 0x10, 0x2d, 0x94, 0xbf, 0x09, 0xb0, 0xaa, 0xb9, 0x6e,
+	0x83, 0x8e, 0xc5, 0x9a, 0xf8, 0x6d, 0x1f, 0xb3, 0x70, 0xbc, 0xa5, 0xf0, 0xdf, 0x5b, 0x8a, 0xe9,
+	0x5b, 0x66, 0xa6, 0xb7, 0xbc, 0xa0, 0x33, 0x3b, 0xad, 0xf3, 0x35, 0xac, 0x4d, 0xcb, 0x64, 0x3e,
+	0xf5, 0x18, 0x46, 0x8f, 0x21, 0x87, 0xa3, 0xc9, 0x5c, 0xe8, 0xf2, 0xfe, 0xdd, 0x4b, 0x0a, 0x35,
+	0xe3, 0x2a, 0xf9, 0x21, 0x94, 0x4c, 0xdc, 0xa3, 0x03, 0x7c, 0x1c, 0xd0, 0xde, 0x8c, 0x09, 0x37,
+	0x60, 0x91, 0xa7, 0x59, 0xe3, 0x03, 0xc8, 0xf3, 0xd8, 0x70, 0xe5, 0x32, 0x6c, 0xa4, 0x16, 0xc6,
+	0xb2, 0xe4, 0x8f, 0xb0, 0x5a, 0x6d, 0x63, 0xa7, 0x33, 0xd3, 0x72, 0x05, 0x72, 0xb8, 0x67, 0x93,
+	0x6e, 0xd2, 0x2f, 0x0e, 0x22, 0xd4, 0x6f, 0x53, 0x6f, 0x6c, 0x16, 0x0f, 0xd0, 0x06, 0x2c, 0xb9,
+	0x78, 0x40, 0x1c, 0x1c, 0xcd, 0x8f, 0xfd, 0x5a, 0x8c, 0x01, 0xc3, 0x8d, 0x1c, 0x23, 0xbe, 0x65,
+	0xbb, 0x6e, 0x80, 0x19, 0x1b, 0x39, 0x46, 0x7c, 0x2d, 0x06, 0xe4, 0x3e, 0xac, 0x4d, 0x0b, 0x48,
+	0x1c, 0x93, 0x20, 0xdf, 0x8c, 0x40, 0x1c, 0xef, 0xb4, 0x68, 0x8e, 0x42, 0xa4, 0x41, 0xbe, 0x67,
+	0x87, 0x4e, 0x1b, 0x33, 0x49, 0xac, 0x64, 0xae, 0xe2, 0xe6, 0xa8, 0x6e, 0xf7, 0x87, 0x00, 0x68,
+	0xf6, 0x24, 0xd0, 0x6d, 0xa8, 0x1c, 0x9e, 0x9c, 0x56, 0x9f, 0x9f, 0x18, 0x67, 0x0d, 0x4b, 0xaf,
+	0x35, 0xcc, 0x73, 0xab, 0x71, 0x5e, 0xd7, 0xad, 0x97, 0xb5, 0xb3, 0xba, 0x5e, 0x35, 0x8e, 0x0d,
+	0xfd, 0xa8, 0x78, 0x0d, 0x6d, 0x42, 0x29, 0x35, 0x4b, 0x7f, 0xa1, 0x19, 0x27, 0x45, 0x61, 0x2e,
+	0x5f, 0x7f, 0x76, 0x5a, 0xd3, 0x8b, 0x22, 0x92, 0x61, 0x33, 0x95, 0x3f, 0xd2, 0x5f, 0x19, 0x55,
+	0xdd, 0x32, 0x8e, 0x8a, 0x19, 0xb4, 0x05, 0xe5, 0xd4, 0x1c, 0xa3, 0x6e, 0x99, 0x5a, 0xed, 0xa9,
+	0x5e, 0xcc, 0xee, 0xff, 0xca, 0xc0, 0xb2, 0x49, 0x58, 0xe7, 0x0c, 0x07, 0x91, 0xd7, 0xe8, 0xb3,
+	0x00, 0x85, 0xc9, 0xeb, 0x43, 0xca, 0x5c, 0x63, 0x52, 0x5f, 0x53, 0x49, 0xbd, 0x74, 0x7e, 0x72,
+	0x3f, 0xe5, 0x4f, 0x3f, 0x7f, 0x7f, 0x11, 0xd7, 0x1f, 0x09, 0xbb, 0x32, 0x52, 0x07, 0x7b, 0x6a,
+	0xf4, 0x67, 0x53, 0x9b, 0xe3, 0xf9, 0xdf, 0x05, 0xb8, 0x9e, 0x72, 0x7e, 0xe8, 0x60, 0xee, 0x9c,
+	0xf9, 0x57, 0x5e, 0x7a, 0x70, 0xb5, 0xa2, 0x44, 0xe1, 0x1d, 0xae, 0xf0, 0xd6, 0x6e, 0x79, 0x56,
+	0x9e, 0xfa, 0x7e, 0xf4, 0x6a, 0x3e, 0xa0, 0xaf, 0x02, 0x14, 0x26, 0x0f, 0xf1, 0x1f, 0xe6, 0xa5,
+	0x3e, 0x99, 0x92, 0x7a, 0xe9, 0xfc, 0x44, 0xda, 0x36, 0x97, 0x56, 0x8e, 0xcc, 0x93, 0x52, 0xd4,
+	0x39, 0xbc, 0x6a, 0xfb, 0xcd, 0x56, 0x8b, 0x84, 0xed, 0x7e, 0x53, 0x71, 0x68, 0x4f, 0x8d, 0xdb,
+	0xdf, 0x8f, 0xda, 0xab, 0xbc, 0x3d, 0x53, 0x5b, 0xd8, 0x6b, 0x2e, 0xf0, 0xef, 0x83, 0xbf, 0x03,
+	0x00, 0xe5, 0x13, 0xc1, 0x48, 0x5a, 0x06, 0x00, 0x00,
+}
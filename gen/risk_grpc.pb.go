@@ -0,0 +1,203 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: risk.proto
+
+package gen
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	RiskService_AddToBlocklist_FullMethodName      = "/go.escape.ship.proto.v1.RiskService/AddToBlocklist"
+	RiskService_RemoveFromBlocklist_FullMethodName = "/go.escape.ship.proto.v1.RiskService/RemoveFromBlocklist"
+	RiskService_CheckBlocklist_FullMethodName      = "/go.escape.ship.proto.v1.RiskService/CheckBlocklist"
+)
+
+// RiskServiceClient is the client API for RiskService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 부정 거래 방지: 차단 목록 관리 및 조회
+type RiskServiceClient interface {
+	AddToBlocklist(ctx context.Context, in *AddToBlocklistRequest, opts ...grpc.CallOption) (*AddToBlocklistResponse, error)
+	RemoveFromBlocklist(ctx context.Context, in *RemoveFromBlocklistRequest, opts ...grpc.CallOption) (*RemoveFromBlocklistResponse, error)
+	// 주어진 식별자 중 하나라도 차단 목록에 있으면 blocked=true
+	CheckBlocklist(ctx context.Context, in *CheckBlocklistRequest, opts ...grpc.CallOption) (*CheckBlocklistResponse, error)
+}
+
+type riskServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRiskServiceClient(cc grpc.ClientConnInterface) RiskServiceClient {
+	return &riskServiceClient{cc}
+}
+
+func (c *riskServiceClient) AddToBlocklist(ctx context.Context, in *AddToBlocklistRequest, opts ...grpc.CallOption) (*AddToBlocklistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddToBlocklistResponse)
+	err := c.cc.Invoke(ctx, RiskService_AddToBlocklist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *riskServiceClient) RemoveFromBlocklist(ctx context.Context, in *RemoveFromBlocklistRequest, opts ...grpc.CallOption) (*RemoveFromBlocklistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveFromBlocklistResponse)
+	err := c.cc.Invoke(ctx, RiskService_RemoveFromBlocklist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *riskServiceClient) CheckBlocklist(ctx context.Context, in *CheckBlocklistRequest, opts ...grpc.CallOption) (*CheckBlocklistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckBlocklistResponse)
+	err := c.cc.Invoke(ctx, RiskService_CheckBlocklist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RiskServiceServer is the server API for RiskService service.
+// All implementations must embed UnimplementedRiskServiceServer
+// for forward compatibility.
+//
+// 부정 거래 방지: 차단 목록 관리 및 조회
+type RiskServiceServer interface {
+	AddToBlocklist(context.Context, *AddToBlocklistRequest) (*AddToBlocklistResponse, error)
+	RemoveFromBlocklist(context.Context, *RemoveFromBlocklistRequest) (*RemoveFromBlocklistResponse, error)
+	// 주어진 식별자 중 하나라도 차단 목록에 있으면 blocked=true
+	CheckBlocklist(context.Context, *CheckBlocklistRequest) (*CheckBlocklistResponse, error)
+	mustEmbedUnimplementedRiskServiceServer()
+}
+
+// UnimplementedRiskServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRiskServiceServer struct{}
+
+func (UnimplementedRiskServiceServer) AddToBlocklist(context.Context, *AddToBlocklistRequest) (*AddToBlocklistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToBlocklist not implemented")
+}
+func (UnimplementedRiskServiceServer) RemoveFromBlocklist(context.Context, *RemoveFromBlocklistRequest) (*RemoveFromBlocklistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromBlocklist not implemented")
+}
+func (UnimplementedRiskServiceServer) CheckBlocklist(context.Context, *CheckBlocklistRequest) (*CheckBlocklistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckBlocklist not implemented")
+}
+func (UnimplementedRiskServiceServer) mustEmbedUnimplementedRiskServiceServer() {}
+func (UnimplementedRiskServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeRiskServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RiskServiceServer will
+// result in compilation errors.
+type UnsafeRiskServiceServer interface {
+	mustEmbedUnimplementedRiskServiceServer()
+}
+
+func RegisterRiskServiceServer(s grpc.ServiceRegistrar, srv RiskServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRiskServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RiskService_ServiceDesc, srv)
+}
+
+func _RiskService_AddToBlocklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddToBlocklistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RiskServiceServer).AddToBlocklist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RiskService_AddToBlocklist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RiskServiceServer).AddToBlocklist(ctx, req.(*AddToBlocklistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RiskService_RemoveFromBlocklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFromBlocklistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RiskServiceServer).RemoveFromBlocklist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RiskService_RemoveFromBlocklist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RiskServiceServer).RemoveFromBlocklist(ctx, req.(*RemoveFromBlocklistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RiskService_CheckBlocklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckBlocklistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RiskServiceServer).CheckBlocklist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RiskService_CheckBlocklist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RiskServiceServer).CheckBlocklist(ctx, req.(*CheckBlocklistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RiskService_ServiceDesc is the grpc.ServiceDesc for RiskService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RiskService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "go.escape.ship.proto.v1.RiskService",
+	HandlerType: (*RiskServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddToBlocklist",
+			Handler:    _RiskService_AddToBlocklist_Handler,
+		},
+		{
+			MethodName: "RemoveFromBlocklist",
+			Handler:    _RiskService_RemoveFromBlocklist_Handler,
+		},
+		{
+			MethodName: "CheckBlocklist",
+			Handler:    _RiskService_CheckBlocklist_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "risk.proto",
+}
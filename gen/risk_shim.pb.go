@@ -0,0 +1,63 @@
+// Code generated by protoc-gen-go-shim. DO NOT EDIT.
+// source: risk.proto
+
+package gen
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+)
+
+// RiskServiceAPI is RiskServiceClient without per-call options, so it can be
+// mocked with plain method signatures. Streams are exposed as iterators.
+type RiskServiceAPI interface {
+	AddToBlocklist(ctx context.Context, in *AddToBlocklistRequest) (*AddToBlocklistResponse, error)
+	RemoveFromBlocklist(ctx context.Context, in *RemoveFromBlocklistRequest) (*RemoveFromBlocklistResponse, error)
+	// 주어진 식별자 중 하나라도 차단 목록에 있으면 blocked=true
+	CheckBlocklist(ctx context.Context, in *CheckBlocklistRequest) (*CheckBlocklistResponse, error)
+}
+
+// NewRiskServiceAPI adapts c to RiskServiceAPI, passing opts to every call.
+func NewRiskServiceAPI(c RiskServiceClient, opts ...grpc.CallOption) RiskServiceAPI {
+	return &riskServiceAPI{c: c, opts: opts}
+}
+
+type riskServiceAPI struct {
+	c    RiskServiceClient
+	opts []grpc.CallOption
+}
+
+func (a *riskServiceAPI) AddToBlocklist(ctx context.Context, in *AddToBlocklistRequest) (*AddToBlocklistResponse, error) {
+	return a.c.AddToBlocklist(ctx, in, a.opts...)
+}
+
+func (a *riskServiceAPI) RemoveFromBlocklist(ctx context.Context, in *RemoveFromBlocklistRequest) (*RemoveFromBlocklistResponse, error) {
+	return a.c.RemoveFromBlocklist(ctx, in, a.opts...)
+}
+
+func (a *riskServiceAPI) CheckBlocklist(ctx context.Context, in *CheckBlocklistRequest) (*CheckBlocklistResponse, error) {
+	return a.c.CheckBlocklist(ctx, in, a.opts...)
+}
+
+// RiskServiceClientFromAPI adapts a to RiskServiceClient, e.g. to hand a
+// mock RiskServiceAPI to code that takes the generated client. Call options
+// are ignored, and streams report empty headers and trailers.
+func RiskServiceClientFromAPI(a RiskServiceAPI) RiskServiceClient {
+	return riskServiceAPIClient{api: a}
+}
+
+type riskServiceAPIClient struct {
+	api RiskServiceAPI
+}
+
+func (c riskServiceAPIClient) AddToBlocklist(ctx context.Context, in *AddToBlocklistRequest, _ ...grpc.CallOption) (*AddToBlocklistResponse, error) {
+	return c.api.AddToBlocklist(ctx, in)
+}
+
+func (c riskServiceAPIClient) RemoveFromBlocklist(ctx context.Context, in *RemoveFromBlocklistRequest, _ ...grpc.CallOption) (*RemoveFromBlocklistResponse, error) {
+	return c.api.RemoveFromBlocklist(ctx, in)
+}
+
+func (c riskServiceAPIClient) CheckBlocklist(ctx context.Context, in *CheckBlocklistRequest, _ ...grpc.CallOption) (*CheckBlocklistResponse, error) {
+	return c.api.CheckBlocklist(ctx, in)
+}
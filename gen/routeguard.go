@@ -0,0 +1,148 @@
+package gen
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+)
+
+// RouteGroup requires Scopes (all of them) on a set of gateway routes.
+type RouteGroup struct {
+	Name string
+	// Routes are "METHOD /path" patterns. In paths "*" matches one segment
+	// and a trailing "**" the rest; METHOD "*" matches any method.
+	Routes []string
+	Scopes []string
+}
+
+// AdminRouteGroups are the back-office routes. Some are stricter than the
+// per-method scopes (e.g. listing all orders needs orders:admin here), since
+// the public gateway must never let customer tokens reach them.
+var AdminRouteGroups = []RouteGroup{
+	{
+		Name:   "catalog",
+		Routes: []string{"POST /products", "PATCH /products/*", "POST /products/bundles", "POST /v1/flash-sales"},
+		Scopes: []string{ScopeProductsWrite},
+	},
+	{
+		Name: "orders",
+		Routes: []string{"GET /v1/order", "POST /v1/order/batch-get", "POST /v1/order/import", "POST /v1/order/archive",
+			"GET /v1/order/archived/*", "POST /v1/quotes", "POST /v1/order/*/refunds"},
+		Scopes: []string{ScopeOrdersAdmin},
+	},
+	{
+		Name:   "accounts",
+		Routes: []string{"POST /users/*/unlock", "POST /users/*/anonymize", "POST /api-keys", "POST /api-keys/*/revoke"},
+		Scopes: []string{ScopeAccountAdmin},
+	},
+	{
+		Name:   "inventory",
+		Routes: []string{"POST /v1/inventory/adjustments", "POST /v1/inventory/audit"},
+		Scopes: []string{ScopeInventoryAdmin},
+	},
+	{
+		Name:   "risk",
+		Routes: []string{"* /v1/risk/**"},
+		Scopes: []string{ScopeRiskAdmin},
+	},
+}
+
+// RouteGuard is gateway middleware rejecting requests to guarded routes unless
+// the caller holds the group's scopes, before anything is proxied to gRPC.
+// Rejections go through Mux's error handler, so they look like (and are
+// localized like) any other gateway error.
+//
+//	guard := &RouteGuard{Mux: mux, Scopes: scopesFromBearerToken}
+//	http.ListenAndServe(":8080", guard.Handler(mux))
+type RouteGuard struct {
+	Mux *runtime.ServeMux
+	// Groups to enforce; nil means AdminRouteGroups.
+	Groups []RouteGroup
+	// Scopes returns the scopes granted to the request's caller, typically
+	// from its bearer token, or an error if it is not authenticated.
+	Scopes func(r *http.Request) ([]string, error)
+}
+
+// Handler wraps next with the guard.
+func (g *RouteGuard) Handler(next http.Handler) http.Handler {
+	groups := g.Groups
+	if groups == nil {
+		groups = AdminRouteGroups
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, grp := range groups {
+			if !grp.matches(r) {
+				continue
+			}
+			granted, err := g.Scopes(r)
+			if err != nil {
+				g.reject(w, r, NewError(codes.Unauthenticated, ErrorReason_ERROR_REASON_UNAUTHENTICATED,
+					fmt.Sprintf("authentication required: %v", err), nil))
+				return
+			}
+			for _, s := range grp.Scopes {
+				if !slices.Contains(granted, s) {
+					g.reject(w, r, NewError(codes.PermissionDenied, ErrorReason_ERROR_REASON_MISSING_SCOPE,
+						fmt.Sprintf("missing scope %q for %s routes", s, grp.Name), map[string]string{"scope": s}))
+					return
+				}
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (g *RouteGuard) reject(w http.ResponseWriter, r *http.Request, err error) {
+	mux := g.Mux
+	if mux == nil {
+		mux = runtime.NewServeMux()
+	}
+	// The error handler expects the metadata a proxied call would have left;
+	// without it every rejection also logs a spurious error.
+	ctx := runtime.NewServerMetadataContext(r.Context(), runtime.ServerMetadata{})
+	_, outbound := runtime.MarshalerForRequest(mux, r)
+	runtime.HTTPError(ctx, mux, outbound, w, r, err)
+}
+
+func (grp RouteGroup) matches(r *http.Request) bool {
+	methods := routedMethods(r)
+	for _, route := range grp.Routes {
+		method, pattern, _ := strings.Cut(route, " ")
+		if (method == "*" || slices.Contains(methods, method)) && pathMatches(pattern, r.URL.Path) {
+			return true
+		}
+	}
+	return false
+}
+
+// routedMethods returns the methods the gateway mux may dispatch r as. Like
+// the mux, a form-encoded POST takes its method from X-HTTP-Method-Override,
+// and a POST that matches no POST route falls back to GET, so a guard on
+// "GET /v1/order" must also catch such a POST.
+func routedMethods(r *http.Request) []string {
+	if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/x-www-form-urlencoded" {
+		return []string{r.Method}
+	}
+	if override := r.Header.Get("X-HTTP-Method-Override"); override != "" && strings.ToUpper(override) != http.MethodPost {
+		return []string{strings.ToUpper(override)}
+	}
+	return []string{http.MethodPost, http.MethodGet}
+}
+
+func pathMatches(pattern, path string) bool {
+	ps := strings.Split(strings.Trim(pattern, "/"), "/")
+	segs := strings.Split(strings.Trim(path, "/"), "/")
+	for i, p := range ps {
+		if p == "**" {
+			return true
+		}
+		if i >= len(segs) || (p != "*" && p != segs[i]) {
+			return false
+		}
+	}
+	return len(ps) == len(segs)
+}
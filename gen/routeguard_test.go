@@ -0,0 +1,96 @@
+package gen
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+)
+
+func TestPathMatches(t *testing.T) {
+	tests := []struct {
+		pattern, path string
+		want          bool
+	}{
+		{"/v1/order", "/v1/order", true},
+		{"/v1/order", "/v1/order/1", false},
+		{"/users/*/unlock", "/users/42/unlock", true},
+		{"/users/*/unlock", "/users/unlock", false},
+		{"/v1/risk/**", "/v1/risk/rules/1", true},
+		{"/v1/risk/**", "/v1/riskx", false},
+	}
+	for _, tt := range tests {
+		if got := pathMatches(tt.pattern, tt.path); got != tt.want {
+			t.Errorf("pathMatches(%q, %q) = %v, want %v", tt.pattern, tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestRouteGuard(t *testing.T) {
+	const form = "application/x-www-form-urlencoded"
+	tests := []struct {
+		name        string
+		method      string
+		path        string
+		contentType string
+		override    string
+		scopes      []string
+		wantStatus  int
+	}{
+		{name: "public route", method: "GET", path: "/products/1", wantStatus: http.StatusOK},
+		{name: "admin without scope", method: "GET", path: "/v1/order", scopes: []string{ScopeOrdersRead}, wantStatus: http.StatusForbidden},
+		{name: "admin with scope", method: "GET", path: "/v1/order", scopes: []string{ScopeOrdersAdmin}, wantStatus: http.StatusOK},
+		{name: "batch get", method: "POST", path: "/v1/order/batch-get", scopes: []string{ScopeOrdersRead}, wantStatus: http.StatusForbidden},
+		{name: "batch get as admin", method: "POST", path: "/v1/order/batch-get", scopes: []string{ScopeOrdersAdmin}, wantStatus: http.StatusOK},
+		{name: "product patch", method: "PATCH", path: "/products/1", scopes: []string{ScopeReviewsWrite}, wantStatus: http.StatusForbidden},
+		{name: "product patch as admin", method: "PATCH", path: "/products/1", scopes: []string{ScopeProductsWrite}, wantStatus: http.StatusOK},
+		{name: "review patch", method: "PATCH", path: "/products/1/reviews/r-1", scopes: []string{ScopeReviewsWrite}, wantStatus: http.StatusOK},
+		{name: "unauthenticated", method: "POST", path: "/users/1/unlock", wantStatus: http.StatusUnauthorized},
+		{name: "refund", method: "POST", path: "/v1/order/o-1/refunds", scopes: []string{ScopeOrdersWrite}, wantStatus: http.StatusForbidden},
+		{name: "refund as admin", method: "POST", path: "/v1/order/o-1/refunds", scopes: []string{ScopeOrdersAdmin}, wantStatus: http.StatusOK},
+		{name: "cancel", method: "POST", path: "/v1/order/o-1/cancel", scopes: []string{ScopeOrdersWrite}, wantStatus: http.StatusOK},
+		{name: "inventory adjustment", method: "POST", path: "/v1/inventory/adjustments", scopes: []string{ScopeInventoryWrite}, wantStatus: http.StatusForbidden},
+		{name: "inventory audit", method: "POST", path: "/v1/inventory/audit", scopes: []string{ScopeInventoryWrite}, wantStatus: http.StatusForbidden},
+		{name: "inventory audit as admin", method: "POST", path: "/v1/inventory/audit", scopes: []string{ScopeInventoryAdmin}, wantStatus: http.StatusOK},
+		{name: "inventory reservation", method: "POST", path: "/v1/inventory/reservations", scopes: []string{ScopeInventoryWrite}, wantStatus: http.StatusOK},
+		{name: "any method group", method: "DELETE", path: "/v1/risk/rules/1", scopes: []string{ScopeOrdersAdmin}, wantStatus: http.StatusForbidden},
+		{name: "form post falls back to get", method: "POST", path: "/v1/order", contentType: form, scopes: []string{ScopeOrdersRead}, wantStatus: http.StatusForbidden},
+		{name: "method override", method: "POST", path: "/v1/order", contentType: form, override: "get", scopes: []string{ScopeOrdersRead}, wantStatus: http.StatusForbidden},
+		{name: "json post does not fall back", method: "POST", path: "/v1/order", contentType: "application/json", scopes: []string{ScopeOrdersRead}, wantStatus: http.StatusOK},
+		{name: "override ignored without form", method: "POST", path: "/v1/order", override: "GET", scopes: []string{ScopeOrdersRead}, wantStatus: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hasMetadata := true
+			mux := runtime.NewServeMux(runtime.WithErrorHandler(func(ctx context.Context, mux *runtime.ServeMux, m runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+				_, hasMetadata = runtime.ServerMetadataFromContext(ctx)
+				runtime.DefaultHTTPErrorHandler(ctx, mux, m, w, r, err)
+			}))
+			guard := &RouteGuard{Mux: mux, Scopes: func(*http.Request) ([]string, error) {
+				if tt.scopes == nil {
+					return nil, errors.New("no token")
+				}
+				return tt.scopes, nil
+			}}
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.contentType != "" {
+				req.Header.Set("Content-Type", tt.contentType)
+			}
+			if tt.override != "" {
+				req.Header.Set("X-HTTP-Method-Override", tt.override)
+			}
+			rec := httptest.NewRecorder()
+			guard.Handler(next).ServeHTTP(rec, req)
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if !hasMetadata {
+				t.Error("rejection reached the error handler without ServerMetadata")
+			}
+		})
+	}
+}
@@ -0,0 +1,431 @@
+package scaffold
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	pb "github.com/escape-ship/protos/gen"
+)
+
+// DefaultPurgeDelay is how long a deleted account is kept before its personal
+// data is purged (AnonymizeUserData), unless AccountDeps.PurgeDelay is set.
+const DefaultPurgeDelay = 30 * 24 * time.Hour
+
+// DefaultReauthMaxAge is the age of access token a Kakao-only account may use
+// for sensitive methods, unless AccountDeps.ReauthMaxAge is set.
+const DefaultReauthMaxAge = 5 * time.Minute
+
+// User is an account as stored by a UserStore.
+type User struct {
+	Profile *pb.UserProfile
+	KakaoID int64    // 0 unless the account signed up with Kakao
+	Scopes  []string // granted to the tokens issued for the user
+}
+
+// UserStore persists accounts. Password hashing belongs to the store, so
+// plain passwords never leave Authenticate, CreateUser and SetPassword.
+type UserStore interface {
+	// GetUser returns the user with the given ID or ErrNotFound.
+	GetUser(ctx context.Context, userID string) (*User, error)
+	// GetUserByKakaoID returns the user linked to a Kakao account or
+	// ErrNotFound.
+	GetUserByKakaoID(ctx context.Context, kakaoID int64) (*User, error)
+	// Authenticate returns the user with email if password matches, and
+	// ErrInvalidPassword otherwise (also for unknown emails).
+	Authenticate(ctx context.Context, email, password string) (*User, error)
+	// CreateUser stores u and sets u.Profile.UserId. password is empty for
+	// Kakao accounts. A taken email yields ErrAlreadyExists.
+	CreateUser(ctx context.Context, u *User, password string) error
+	// UpdateUser saves the profile of an existing user.
+	UpdateUser(ctx context.Context, u *User) error
+	// SetPassword replaces the password of userID.
+	SetPassword(ctx context.Context, userID, password string) error
+	// DeleteUser deactivates userID. Purging personal data is scheduled
+	// separately after the purge delay.
+	DeleteUser(ctx context.Context, userID string, reason string) error
+}
+
+// Tokens is an issued access/refresh token pair.
+type Tokens struct {
+	AccessToken  string
+	RefreshToken string
+	ExpiresIn    time.Duration // lifetime of AccessToken
+	Scopes       []string
+}
+
+// TokenIssuer issues and revokes the tokens verified by pb.TokenVerifier.
+type TokenIssuer interface {
+	// IssueTokens issues a new token pair for u.
+	IssueTokens(ctx context.Context, u *User) (*Tokens, error)
+	// RefreshTokens rotates refreshToken. Unknown, expired or reused tokens
+	// should yield an Unauthenticated status error.
+	RefreshTokens(ctx context.Context, refreshToken string) (*Tokens, error)
+	// RevokeToken revokes token, or every session of its user if
+	// allSessions. Already invalid tokens are not an error.
+	RevokeToken(ctx context.Context, token string, allSessions bool) error
+	// RevokeUserTokens revokes every session of userID except the one keep
+	// (an access token, may be empty) belongs to, and returns how many were
+	// revoked.
+	RevokeUserTokens(ctx context.Context, userID, keep string) (int, error)
+}
+
+// LoginLimiter counts failed password logins per email and locks the account
+// after too many, as reported to clients through pb.AccountLockout.
+// UnlockAccount implementations clear the lock.
+type LoginLimiter interface {
+	// LockedUntil returns when the lock on email ends, or the zero time if
+	// it is not locked.
+	LockedUntil(ctx context.Context, email string) (time.Time, error)
+	// Failed records a failed login for email and returns the attempts left
+	// before it is locked, or when the lock this failure started ends.
+	Failed(ctx context.Context, email string) (remaining int32, lockedUntil time.Time, err error)
+	// Succeeded clears the failed logins of email.
+	Succeeded(ctx context.Context, email string) error
+}
+
+// KakaoUser is the Kakao account a login callback resolved to.
+type KakaoUser struct {
+	ID       int64
+	Email    string // empty unless the user agreed to share it
+	Nickname string
+}
+
+// KakaoClient talks to Kakao OAuth.
+type KakaoClient interface {
+	// AuthCodeURL returns the Kakao login page to redirect the user to.
+	AuthCodeURL() string
+	// Exchange trades the authorization code of a callback for the Kakao
+	// user it was issued to.
+	Exchange(ctx context.Context, code string) (*KakaoUser, error)
+}
+
+// AccountDeps are the dependencies of AccountServer. Users, Tokens, Kakao and
+// Logins are required.
+type AccountDeps struct {
+	Users  UserStore
+	Tokens TokenIssuer
+	Kakao  KakaoClient
+	Logins LoginLimiter
+
+	// PurgeDelay is reported as the time until a deleted account's personal
+	// data is purged. Zero means DefaultPurgeDelay.
+	PurgeDelay time.Duration
+	// ReauthMaxAge is how recently a Kakao-only account must have logged in
+	// to change its password or delete itself. Zero means
+	// DefaultReauthMaxAge.
+	ReauthMaxAge time.Duration
+	// Now returns the current time. Nil means time.Now.
+	Now func() time.Time
+}
+
+// AccountServer implements login, registration, tokens and the /users/me
+// profile methods of AccountService on top of AccountDeps. The /users/me
+// methods expect pb.UnaryAuthInterceptor to have authenticated the caller.
+type AccountServer struct {
+	pb.UnimplementedAccountServiceServer
+	deps AccountDeps
+}
+
+// NewAccountServer returns an AccountServer using deps.
+func NewAccountServer(deps AccountDeps) *AccountServer {
+	if deps.PurgeDelay == 0 {
+		deps.PurgeDelay = DefaultPurgeDelay
+	}
+	if deps.ReauthMaxAge == 0 {
+		deps.ReauthMaxAge = DefaultReauthMaxAge
+	}
+	if deps.Now == nil {
+		deps.Now = time.Now
+	}
+	return &AccountServer{deps: deps}
+}
+
+func (s *AccountServer) GetKakaoLoginURL(ctx context.Context, req *pb.GetKakaoLoginURLRequest) (*pb.GetKakaoLoginURLResponse, error) {
+	return &pb.GetKakaoLoginURLResponse{LoginUrl: s.deps.Kakao.AuthCodeURL()}, nil
+}
+
+// GetKakaoCallBack signs the Kakao user in, creating an account on first
+// login.
+func (s *AccountServer) GetKakaoCallBack(ctx context.Context, req *pb.GetKakaoCallBackRequest) (*pb.GetKakaoCallBackResponse, error) {
+	if req.GetCode() == "" {
+		return nil, status.Error(codes.InvalidArgument, "code is required")
+	}
+	ku, err := s.deps.Kakao.Exchange(ctx, req.GetCode())
+	if err != nil {
+		return nil, statusError(ctx, err, "kakao login")
+	}
+	u, err := s.deps.Users.GetUserByKakaoID(ctx, ku.ID)
+	if errors.Is(err, ErrNotFound) {
+		u = &User{
+			Profile: &pb.UserProfile{
+				Email:     ku.Email,
+				Nickname:  ku.Nickname,
+				CreatedAt: s.now(),
+			},
+			KakaoID: ku.ID,
+		}
+		err = s.deps.Users.CreateUser(ctx, u, "")
+	}
+	if err != nil {
+		return nil, statusError(ctx, err, "user")
+	}
+	t, err := s.deps.Tokens.IssueTokens(ctx, u)
+	if err != nil {
+		return nil, statusError(ctx, err, "tokens")
+	}
+	info, err := protojson.Marshal(u.Profile)
+	if err != nil {
+		return nil, statusError(ctx, err, "user")
+	}
+	return &pb.GetKakaoCallBackResponse{
+		AccessToken:  t.AccessToken,
+		RefreshToken: t.RefreshToken,
+		UserInfoJson: string(info),
+		Scopes:       t.Scopes,
+	}, nil
+}
+
+// Login signs in with email and password. Failed attempts count toward the
+// account lockout: LoginFailedError reports the attempts left, and
+// AccountLockedError is returned while the account is locked.
+func (s *AccountServer) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error) {
+	u, err := s.authenticate(ctx, req.GetEmail(), req.GetPassword())
+	if err != nil {
+		return nil, err
+	}
+	t, err := s.deps.Tokens.IssueTokens(ctx, u)
+	if err != nil {
+		return nil, statusError(ctx, err, "tokens")
+	}
+	return &pb.LoginResponse{
+		AccessToken:  t.AccessToken,
+		RefreshToken: t.RefreshToken,
+		Scopes:       t.Scopes,
+	}, nil
+}
+
+// Register creates an email account. captcha_token is not checked here;
+// override Register or verify it in an interceptor.
+func (s *AccountServer) Register(ctx context.Context, req *pb.RegisterRequest) (*pb.RegisterResponse, error) {
+	if req.GetEmail() == "" || req.GetPassword() == "" {
+		return nil, status.Error(codes.InvalidArgument, "email and password are required")
+	}
+	u := &User{Profile: &pb.UserProfile{Email: req.GetEmail(), HasPassword: true, CreatedAt: s.now()}}
+	if err := s.deps.Users.CreateUser(ctx, u, req.GetPassword()); err != nil {
+		if errors.Is(err, ErrAlreadyExists) {
+			return nil, pb.NewError(codes.AlreadyExists, pb.ErrorReason_ERROR_REASON_EMAIL_ALREADY_REGISTERED, "email already registered", nil)
+		}
+		return nil, statusError(ctx, err, "user")
+	}
+	return &pb.RegisterResponse{Message: "Registration successful"}, nil
+}
+
+func (s *AccountServer) RefreshToken(ctx context.Context, req *pb.RefreshTokenRequest) (*pb.RefreshTokenResponse, error) {
+	t, err := s.deps.Tokens.RefreshTokens(ctx, req.GetRefreshToken())
+	if err != nil {
+		return nil, statusError(ctx, err, "refresh token")
+	}
+	return &pb.RefreshTokenResponse{
+		AccessToken:  t.AccessToken,
+		RefreshToken: t.RefreshToken,
+		ExpiresIn:    int32(t.ExpiresIn / time.Second),
+		Scopes:       t.Scopes,
+	}, nil
+}
+
+func (s *AccountServer) RevokeToken(ctx context.Context, req *pb.RevokeTokenRequest) (*pb.RevokeTokenResponse, error) {
+	if err := s.deps.Tokens.RevokeToken(ctx, req.GetToken(), req.GetAllSessions()); err != nil {
+		return nil, statusError(ctx, err, "token")
+	}
+	return &pb.RevokeTokenResponse{}, nil
+}
+
+func (s *AccountServer) GetMe(ctx context.Context, req *pb.GetMeRequest) (*pb.GetMeResponse, error) {
+	u, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetMeResponse{Profile: u.Profile}, nil
+}
+
+// profileFields are the UserProfile fields UpdateProfile may change.
+var profileFields = []string{"nickname", "phone", "default_address"}
+
+func (s *AccountServer) UpdateProfile(ctx context.Context, req *pb.UpdateProfileRequest) (*pb.UpdateProfileResponse, error) {
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		paths = profileFields
+	}
+	in := req.GetProfile()
+	for _, p := range paths {
+		switch p {
+		case "nickname", "phone":
+		case "default_address":
+			if in.GetDefaultAddress() != nil {
+				if err := in.GetDefaultAddress().Validate(); err != nil {
+					return nil, err
+				}
+			}
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "update_mask: %q cannot be updated", p)
+		}
+	}
+	u, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range paths {
+		switch p {
+		case "nickname":
+			u.Profile.Nickname = in.GetNickname()
+		case "phone":
+			u.Profile.Phone = in.GetPhone()
+		case "default_address":
+			u.Profile.DefaultAddress = in.GetDefaultAddress()
+		}
+	}
+	if err := s.deps.Users.UpdateUser(ctx, u); err != nil {
+		return nil, statusError(ctx, err, "user")
+	}
+	return &pb.UpdateProfileResponse{Profile: u.Profile}, nil
+}
+
+// ChangePassword sets a new password after checking the current one. Kakao
+// accounts without a password set their first one without current_password,
+// using an access token issued within ReauthMaxAge.
+func (s *AccountServer) ChangePassword(ctx context.Context, req *pb.ChangePasswordRequest) (*pb.ChangePasswordResponse, error) {
+	if req.GetNewPassword() == "" {
+		return nil, status.Error(codes.InvalidArgument, "new_password is required")
+	}
+	u, err := s.reauthenticate(ctx, req.GetCurrentPassword())
+	if err != nil {
+		return nil, err
+	}
+	userID := u.Profile.GetUserId()
+	if err := s.deps.Users.SetPassword(ctx, userID, req.GetNewPassword()); err != nil {
+		return nil, statusError(ctx, err, "user")
+	}
+	if !u.Profile.GetHasPassword() {
+		u.Profile.HasPassword = true
+		if err := s.deps.Users.UpdateUser(ctx, u); err != nil {
+			return nil, statusError(ctx, err, "user")
+		}
+	}
+	res := &pb.ChangePasswordResponse{ChangedAt: s.now()}
+	if req.GetRevokeOtherSessions() {
+		n, err := s.deps.Tokens.RevokeUserTokens(ctx, userID, accessToken(ctx))
+		if err != nil {
+			return nil, statusError(ctx, err, "tokens")
+		}
+		res.RevokedSessions = int32(n)
+	}
+	return res, nil
+}
+
+// DeleteAccount revokes every session and deactivates the account after
+// reauthenticating the caller like ChangePassword. Personal data is purged by
+// AnonymizeUserData once PurgeDelay has passed.
+func (s *AccountServer) DeleteAccount(ctx context.Context, req *pb.DeleteAccountRequest) (*pb.DeleteAccountResponse, error) {
+	u, err := s.reauthenticate(ctx, req.GetPassword())
+	if err != nil {
+		return nil, err
+	}
+	userID := u.Profile.GetUserId()
+	if _, err := s.deps.Tokens.RevokeUserTokens(ctx, userID, ""); err != nil {
+		return nil, statusError(ctx, err, "tokens")
+	}
+	if err := s.deps.Users.DeleteUser(ctx, userID, req.GetReason()); err != nil {
+		return nil, statusError(ctx, err, "user")
+	}
+	now := s.deps.Now()
+	return &pb.DeleteAccountResponse{
+		DeletedAt:  now.UTC().Format(time.RFC3339),
+		PurgeAfter: now.Add(s.deps.PurgeDelay).UTC().Format(time.RFC3339),
+	}, nil
+}
+
+// currentUser loads the authenticated caller.
+func (s *AccountServer) currentUser(ctx context.Context) (*User, error) {
+	userID, ok := pb.UserIDFromContext(ctx)
+	if !ok {
+		return nil, pb.NewError(codes.Unauthenticated, pb.ErrorReason_ERROR_REASON_UNAUTHENTICATED, "authentication required", nil)
+	}
+	u, err := s.deps.Users.GetUser(ctx, userID)
+	if err != nil {
+		return nil, statusError(ctx, err, "user")
+	}
+	return u, nil
+}
+
+// reauthenticate loads the caller and confirms it is them: accounts with a
+// password must send it, and Kakao-only accounts must call with an access
+// token issued within ReauthMaxAge, i.e. right after logging in again.
+func (s *AccountServer) reauthenticate(ctx context.Context, password string) (*User, error) {
+	u, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !u.Profile.GetHasPassword() {
+		claims, _ := pb.UserClaimsFromContext(ctx)
+		if claims.IssuedAt.IsZero() || s.deps.Now().Sub(claims.IssuedAt) > s.deps.ReauthMaxAge {
+			return nil, pb.NewError(codes.Unauthenticated, pb.ErrorReason_ERROR_REASON_UNAUTHENTICATED, "recent login required", nil)
+		}
+		return u, nil
+	}
+	if _, err := s.authenticate(ctx, u.Profile.GetEmail(), password); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// authenticate checks email and password, counting failures with Logins so
+// that guessing passwords locks the account.
+func (s *AccountServer) authenticate(ctx context.Context, email, password string) (*User, error) {
+	until, err := s.deps.Logins.LockedUntil(ctx, email)
+	if err != nil {
+		return nil, statusError(ctx, err, "login attempts")
+	}
+	if until.After(s.deps.Now()) {
+		return nil, pb.AccountLockedError(until)
+	}
+	u, err := s.deps.Users.Authenticate(ctx, email, password)
+	if errors.Is(err, ErrInvalidPassword) {
+		remaining, lockedUntil, err := s.deps.Logins.Failed(ctx, email)
+		if err != nil {
+			return nil, statusError(ctx, err, "login attempts")
+		}
+		if !lockedUntil.IsZero() {
+			return nil, pb.AccountLockedError(lockedUntil)
+		}
+		return nil, pb.LoginFailedError(remaining)
+	}
+	if err != nil {
+		return nil, statusError(ctx, err, "user")
+	}
+	if err := s.deps.Logins.Succeeded(ctx, email); err != nil {
+		return nil, statusError(ctx, err, "login attempts")
+	}
+	return u, nil
+}
+
+func (s *AccountServer) now() string {
+	return s.deps.Now().UTC().Format(time.RFC3339)
+}
+
+// accessToken returns the caller's bearer token, or "" if there is none.
+func accessToken(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, v := range md.Get(pb.AuthorizationHeader) {
+		if scheme, token, ok := strings.Cut(v, " "); ok && strings.EqualFold(scheme, "Bearer") {
+			return strings.TrimSpace(token)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,168 @@
+package scaffold
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/escape-ship/protos/gen"
+)
+
+var now = time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+type fakeUsers struct {
+	UserStore
+	users    map[string]*User
+	password string
+	deleted  []string
+	err      error
+}
+
+func (f *fakeUsers) GetUser(_ context.Context, userID string) (*User, error) {
+	if u, ok := f.users[userID]; ok {
+		return u, nil
+	}
+	return nil, ErrNotFound
+}
+
+func (f *fakeUsers) Authenticate(_ context.Context, email, password string) (*User, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	for _, u := range f.users {
+		if u.Profile.GetEmail() == email && password == f.password {
+			return u, nil
+		}
+	}
+	return nil, ErrInvalidPassword
+}
+
+func (f *fakeUsers) DeleteUser(_ context.Context, userID, _ string) error {
+	f.deleted = append(f.deleted, userID)
+	return nil
+}
+
+type fakeTokens struct{ TokenIssuer }
+
+func (fakeTokens) IssueTokens(context.Context, *User) (*Tokens, error) {
+	return &Tokens{AccessToken: "access"}, nil
+}
+
+func (fakeTokens) RevokeUserTokens(context.Context, string, string) (int, error) { return 0, nil }
+
+// fakeLogins locks an email for an hour after three failures.
+type fakeLogins struct {
+	failures    map[string]int32
+	lockedUntil map[string]time.Time
+}
+
+func (f *fakeLogins) LockedUntil(_ context.Context, email string) (time.Time, error) {
+	return f.lockedUntil[email], nil
+}
+
+func (f *fakeLogins) Failed(_ context.Context, email string) (int32, time.Time, error) {
+	f.failures[email]++
+	if f.failures[email] >= 3 {
+		f.lockedUntil[email] = now.Add(time.Hour)
+		return 0, f.lockedUntil[email], nil
+	}
+	return 3 - f.failures[email], time.Time{}, nil
+}
+
+func (f *fakeLogins) Succeeded(_ context.Context, email string) error {
+	delete(f.failures, email)
+	return nil
+}
+
+func newTestServer(users *fakeUsers) *AccountServer {
+	return NewAccountServer(AccountDeps{
+		Users:  users,
+		Tokens: fakeTokens{},
+		Logins: &fakeLogins{failures: map[string]int32{}, lockedUntil: map[string]time.Time{}},
+		Now:    func() time.Time { return now },
+	})
+}
+
+func testUsers() *fakeUsers {
+	return &fakeUsers{
+		users: map[string]*User{
+			"u-1": {Profile: &pb.UserProfile{UserId: "u-1", Email: "a@example.com", HasPassword: true}},
+			"u-2": {Profile: &pb.UserProfile{UserId: "u-2"}, KakaoID: 42},
+		},
+		password: "secret",
+	}
+}
+
+func TestLoginLockout(t *testing.T) {
+	srv := newTestServer(testUsers())
+	ctx := context.Background()
+	steps := []struct {
+		password      string
+		wantCode      codes.Code
+		wantRemaining int32
+		wantLocked    bool
+	}{
+		{password: "wrong", wantCode: codes.Unauthenticated, wantRemaining: 2},
+		{password: "secret"},
+		{password: "wrong", wantCode: codes.Unauthenticated, wantRemaining: 2},
+		{password: "wrong", wantCode: codes.Unauthenticated, wantRemaining: 1},
+		{password: "wrong", wantCode: codes.ResourceExhausted, wantLocked: true},
+		{password: "secret", wantCode: codes.ResourceExhausted, wantLocked: true},
+	}
+	for i, st := range steps {
+		_, err := srv.Login(ctx, &pb.LoginRequest{Email: "a@example.com", Password: st.password})
+		if status.Code(err) != st.wantCode {
+			t.Fatalf("step %d: Login() = %v, want %v", i, err, st.wantCode)
+		}
+		if err == nil {
+			continue
+		}
+		l, ok := pb.AccountLockoutFromError(err)
+		if !ok || l.GetLocked() != st.wantLocked || l.GetRemainingAttempts() != st.wantRemaining {
+			t.Errorf("step %d: lockout = %v, want locked %v, %d remaining", i, l, st.wantLocked, st.wantRemaining)
+		}
+	}
+}
+
+func TestDeleteAccountReauthenticates(t *testing.T) {
+	tests := []struct {
+		name     string
+		userID   string
+		password string
+		issuedAt time.Time
+		wantCode codes.Code
+	}{
+		{name: "password", userID: "u-1", password: "secret", issuedAt: now.Add(-time.Hour)},
+		{name: "wrong password", userID: "u-1", password: "wrong", issuedAt: now, wantCode: codes.Unauthenticated},
+		{name: "kakao fresh token", userID: "u-2", issuedAt: now.Add(-time.Minute)},
+		{name: "kakao old token", userID: "u-2", issuedAt: now.Add(-time.Hour), wantCode: codes.Unauthenticated},
+		{name: "kakao token without iat", userID: "u-2", wantCode: codes.Unauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users := testUsers()
+			srv := newTestServer(users)
+			ctx := pb.ContextWithUserClaims(context.Background(), &pb.UserClaims{UserID: tt.userID, IssuedAt: tt.issuedAt})
+			_, err := srv.DeleteAccount(ctx, &pb.DeleteAccountRequest{Password: tt.password})
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("DeleteAccount() = %v, want %v", err, tt.wantCode)
+			}
+			if deleted := len(users.deleted) == 1; deleted != (tt.wantCode == codes.OK) {
+				t.Errorf("deleted = %v", users.deleted)
+			}
+		})
+	}
+}
+
+func TestInternalErrorsAreGeneric(t *testing.T) {
+	users := testUsers()
+	users.err = errors.New("pq: connection refused to 10.0.0.5:5432")
+	_, err := newTestServer(users).Login(context.Background(), &pb.LoginRequest{Email: "a@example.com", Password: "secret"})
+	if st := status.Convert(err); st.Code() != codes.Internal || st.Message() != "internal error" {
+		t.Errorf("Login() = %v, want a generic Internal error", err)
+	}
+}
@@ -0,0 +1,66 @@
+// Package scaffold provides starting-point implementations of the generated
+// service servers. Each server takes its dependencies as interfaces in a Deps
+// struct, so service teams share one structure and can unit test handlers
+// with in-memory fakes instead of databases and HTTP clients:
+//
+//	srv := scaffold.NewAccountServer(scaffold.AccountDeps{
+//	    Users:  postgresUsers{db},
+//	    Tokens: jwtIssuer{key: signingKey},
+//	    Kakao:  kakaoClient,
+//	    Logins: redisLoginLimiter{rdb},
+//	})
+//	pb.RegisterAccountServiceServer(grpcServer, srv)
+//
+// Methods a scaffold does not implement answer codes.Unimplemented through the
+// embedded Unimplemented*Server. Embed the scaffold in your own server type to
+// add or override methods, and keep a verify.Complete test on that type.
+//
+// Dependencies report well-known conditions with the sentinel errors below;
+// the scaffolds translate them to gRPC status errors. Errors that already
+// carry a status are returned unchanged. Anything else is logged with
+// slog.Default and becomes Internal with a generic message, so database or
+// upstream details never reach clients.
+package scaffold
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/escape-ship/protos/gen"
+)
+
+var (
+	// ErrNotFound is returned by stores when the requested record does not
+	// exist.
+	ErrNotFound = errors.New("scaffold: not found")
+	// ErrAlreadyExists is returned by stores when a unique key (e.g. email)
+	// is taken.
+	ErrAlreadyExists = errors.New("scaffold: already exists")
+	// ErrInvalidPassword is returned by UserStore.Authenticate when the
+	// email or password does not match.
+	ErrInvalidPassword = errors.New("scaffold: invalid password")
+)
+
+// statusError converts a dependency error to a gRPC status error. what names
+// the missing record for NotFound.
+func statusError(ctx context.Context, err error, what string) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	switch {
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return status.FromContextError(err).Err()
+	case errors.Is(err, ErrNotFound):
+		return status.Errorf(codes.NotFound, "%s not found", what)
+	case errors.Is(err, ErrAlreadyExists):
+		return status.Errorf(codes.AlreadyExists, "%s already exists", what)
+	case errors.Is(err, ErrInvalidPassword):
+		return pb.NewError(codes.Unauthenticated, pb.ErrorReason_ERROR_REASON_INVALID_CREDENTIALS, "invalid email or password", nil)
+	}
+	slog.ErrorContext(ctx, "scaffold: dependency failed", "what", what, "err", err)
+	return status.Error(codes.Internal, "internal error")
+}
@@ -0,0 +1,56 @@
+// Command schemacheck reports breaking changes between the Escape Ship API
+// version it is built from and an older one. Run it from consumer pipelines
+// before upgrading github.com/escape-ship/protos:
+//
+//	go run github.com/escape-ship/protos/gen/schemacheck/cmd/schemacheck@v1.4.0 -dump > old.binpb
+//	go run github.com/escape-ship/protos/gen/schemacheck/cmd/schemacheck@v1.5.0 -against old.binpb
+//
+// It exits non-zero if there are breaking changes. -new compares against a
+// descriptor set file instead of the embedded one.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	pb "github.com/escape-ship/protos/gen"
+	"github.com/escape-ship/protos/gen/schemacheck"
+)
+
+func main() {
+	dump := flag.Bool("dump", false, "write the embedded descriptor set to stdout")
+	against := flag.String("against", "", "descriptor set of the version in use")
+	newPath := flag.String("new", "", "descriptor set to check instead of the embedded one")
+	flag.Parse()
+	if *dump {
+		if _, err := os.Stdout.Write(pb.DescriptorSetBytes()); err != nil {
+			log.Fatal("schemacheck: ", err)
+		}
+		return
+	}
+	if *against == "" {
+		log.Fatal("schemacheck: -against or -dump is required")
+	}
+	old, err := os.ReadFile(*against)
+	if err != nil {
+		log.Fatal("schemacheck: ", err)
+	}
+	next := pb.DescriptorSetBytes()
+	if *newPath != "" {
+		if next, err = os.ReadFile(*newPath); err != nil {
+			log.Fatal("schemacheck: ", err)
+		}
+	}
+	changes, err := schemacheck.CheckBytes(old, next)
+	if err != nil {
+		log.Fatal("schemacheck: ", err)
+	}
+	for _, c := range changes {
+		fmt.Println(c)
+	}
+	if len(changes) > 0 {
+		log.Fatalf("schemacheck: %d breaking changes", len(changes))
+	}
+}
@@ -0,0 +1,235 @@
+// Package schemacheck reports breaking changes between two versions of the
+// Escape Ship API, so consumers can check an upgrade of this module before
+// taking it:
+//
+//	old, _ := os.ReadFile("old.binpb") // DescriptorSetBytes of the version in use
+//	changes, err := schemacheck.CheckBytes(old, pb.DescriptorSetBytes())
+//	for _, c := range changes {
+//	    fmt.Println(c)
+//	}
+//
+// A change is breaking if existing clients or stored messages could stop
+// working: removed or retyped fields, messages, enum values, services and
+// methods, and renamed fields (which change the JSON encoding). Additions are
+// never reported.
+package schemacheck
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Change is a breaking change to a single element.
+type Change struct {
+	// Element is the full name of the changed element, e.g.
+	// "go.escape.ship.proto.v1.Order.status".
+	Element string
+	// Description says what changed, e.g. "field removed".
+	Description string
+}
+
+func (c Change) String() string {
+	return c.Element + ": " + c.Description
+}
+
+// CheckBytes is Check for serialized FileDescriptorSets.
+func CheckBytes(old, new []byte) ([]Change, error) {
+	var o, n descriptorpb.FileDescriptorSet
+	if err := proto.Unmarshal(old, &o); err != nil {
+		return nil, fmt.Errorf("old descriptor set: %w", err)
+	}
+	if err := proto.Unmarshal(new, &n); err != nil {
+		return nil, fmt.Errorf("new descriptor set: %w", err)
+	}
+	return Check(&o, &n)
+}
+
+// Check returns the breaking changes from old to new, sorted by element.
+// Files under google/ (well-known types and API annotations) are not
+// compared.
+func Check(old, new *descriptorpb.FileDescriptorSet) ([]Change, error) {
+	oldFiles, err := protodesc.NewFiles(old)
+	if err != nil {
+		return nil, fmt.Errorf("old descriptor set: %w", err)
+	}
+	newFiles, err := protodesc.NewFiles(new)
+	if err != nil {
+		return nil, fmt.Errorf("new descriptor set: %w", err)
+	}
+	c := &checker{new: newFiles}
+	oldFiles.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		if !strings.HasPrefix(fd.Path(), "google/") {
+			c.file(fd)
+		}
+		return true
+	})
+	slices.SortFunc(c.changes, func(a, b Change) int {
+		return strings.Compare(a.Element, b.Element)
+	})
+	return c.changes, nil
+}
+
+type checker struct {
+	new     *protoregistry.Files
+	changes []Change
+}
+
+func (c *checker) report(d protoreflect.Descriptor, format string, args ...any) {
+	name := string(d.FullName())
+	if ev, ok := d.(protoreflect.EnumValueDescriptor); ok {
+		// Enum values are scoped to the enum's parent; name them by the
+		// enum instead.
+		name = string(ev.Parent().FullName()) + "." + string(ev.Name())
+	}
+	c.changes = append(c.changes, Change{Element: name, Description: fmt.Sprintf(format, args...)})
+}
+
+// lookup finds the counterpart of d in the new files, reporting it as
+// removed if there is none of the same kind.
+func lookup[D protoreflect.Descriptor](c *checker, d D, kind string) (D, bool) {
+	nd, err := c.new.FindDescriptorByName(d.FullName())
+	n, ok := nd.(D)
+	if err != nil || !ok {
+		c.report(d, "%s removed", kind)
+	}
+	return n, ok
+}
+
+func (c *checker) file(fd protoreflect.FileDescriptor) {
+	for i := 0; i < fd.Services().Len(); i++ {
+		c.service(fd.Services().Get(i))
+	}
+	c.messages(fd.Messages())
+	c.enums(fd.Enums())
+}
+
+func (c *checker) messages(ms protoreflect.MessageDescriptors) {
+	for i := 0; i < ms.Len(); i++ {
+		if !ms.Get(i).IsMapEntry() {
+			c.message(ms.Get(i))
+		}
+	}
+}
+
+func (c *checker) enums(es protoreflect.EnumDescriptors) {
+	for i := 0; i < es.Len(); i++ {
+		c.enum(es.Get(i))
+	}
+}
+
+func (c *checker) service(old protoreflect.ServiceDescriptor) {
+	n, ok := lookup(c, old, "service")
+	if !ok {
+		return
+	}
+	for i := 0; i < old.Methods().Len(); i++ {
+		om := old.Methods().Get(i)
+		nm := n.Methods().ByName(om.Name())
+		if nm == nil {
+			c.report(om, "method removed")
+			continue
+		}
+		if om.Input().FullName() != nm.Input().FullName() {
+			c.report(om, "request type changed from %s to %s", om.Input().FullName(), nm.Input().FullName())
+		}
+		if om.Output().FullName() != nm.Output().FullName() {
+			c.report(om, "response type changed from %s to %s", om.Output().FullName(), nm.Output().FullName())
+		}
+		if om.IsStreamingClient() != nm.IsStreamingClient() || om.IsStreamingServer() != nm.IsStreamingServer() {
+			c.report(om, "streaming changed from %s to %s", streaming(om), streaming(nm))
+		}
+	}
+}
+
+func streaming(md protoreflect.MethodDescriptor) string {
+	switch {
+	case md.IsStreamingClient() && md.IsStreamingServer():
+		return "bidi"
+	case md.IsStreamingClient():
+		return "client"
+	case md.IsStreamingServer():
+		return "server"
+	}
+	return "unary"
+}
+
+func (c *checker) message(old protoreflect.MessageDescriptor) {
+	n, ok := lookup(c, old, "message")
+	if !ok {
+		return
+	}
+	for i := 0; i < old.Fields().Len(); i++ {
+		of := old.Fields().Get(i)
+		nf := n.Fields().ByNumber(of.Number())
+		if nf == nil {
+			if n.ReservedNames().Has(of.Name()) && n.ReservedRanges().Has(of.Number()) {
+				continue // removed properly; the number cannot be reused
+			}
+			c.report(of, "field %d removed without reserving its name and number", of.Number())
+			continue
+		}
+		if of.Name() != nf.Name() {
+			c.report(of, "field %d renamed to %s", of.Number(), nf.Name())
+		}
+		if ot, nt := fieldType(of), fieldType(nf); ot != nt {
+			c.report(of, "type changed from %s to %s", ot, nt)
+		}
+		if of.Cardinality() != nf.Cardinality() {
+			c.report(of, "cardinality changed from %s to %s", of.Cardinality(), nf.Cardinality())
+		}
+		if oo, no := realOneof(of), realOneof(nf); (oo == nil) != (no == nil) || oo != nil && oo.Name() != no.Name() {
+			c.report(of, "moved into or out of a oneof")
+		}
+	}
+	c.messages(old.Messages())
+	c.enums(old.Enums())
+}
+
+// realOneof returns the oneof containing fd, or nil if there is none or it is
+// the synthetic oneof of a proto3 optional field: adding or dropping optional
+// keeps the wire and JSON encodings.
+func realOneof(fd protoreflect.FieldDescriptor) protoreflect.OneofDescriptor {
+	if oo := fd.ContainingOneof(); oo != nil && !oo.IsSynthetic() {
+		return oo
+	}
+	return nil
+}
+
+// fieldType describes a field's type, e.g. "string", "map<string, int64>" or
+// "message go.escape.ship.proto.v1.Order".
+func fieldType(fd protoreflect.FieldDescriptor) string {
+	switch {
+	case fd.IsMap():
+		return "map<" + fieldType(fd.MapKey()) + ", " + fieldType(fd.MapValue()) + ">"
+	case fd.Enum() != nil:
+		return "enum " + string(fd.Enum().FullName())
+	case fd.Message() != nil:
+		return "message " + string(fd.Message().FullName())
+	}
+	return fd.Kind().String()
+}
+
+func (c *checker) enum(old protoreflect.EnumDescriptor) {
+	n, ok := lookup(c, old, "enum")
+	if !ok {
+		return
+	}
+	for i := 0; i < old.Values().Len(); i++ {
+		ov := old.Values().Get(i)
+		nv := n.Values().ByName(ov.Name())
+		switch {
+		case nv == nil && n.ReservedNames().Has(ov.Name()):
+		case nv == nil:
+			c.report(ov, "enum value removed without reserving its name")
+		case nv.Number() != ov.Number():
+			c.report(ov, "number changed from %d to %d", ov.Number(), nv.Number())
+		}
+	}
+}
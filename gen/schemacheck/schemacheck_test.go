@@ -0,0 +1,138 @@
+package schemacheck
+
+import (
+	"slices"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+type testField struct {
+	name     string
+	number   int32
+	typ      descriptorpb.FieldDescriptorProto_Type
+	oneof    string // real oneof
+	optional bool   // proto3 optional
+}
+
+// testSet returns a proto3 file with a single message M holding fields.
+func testSet(fields ...testField) *descriptorpb.FileDescriptorSet {
+	m := &descriptorpb.DescriptorProto{Name: proto.String("M")}
+	oneofIndex := map[string]int32{}
+	addOneof := func(name string) int32 {
+		if i, ok := oneofIndex[name]; ok {
+			return i
+		}
+		oneofIndex[name] = int32(len(m.OneofDecl))
+		m.OneofDecl = append(m.OneofDecl, &descriptorpb.OneofDescriptorProto{Name: proto.String(name)})
+		return oneofIndex[name]
+	}
+	// Real oneofs come first; synthetic ones follow them.
+	for _, f := range fields {
+		if f.oneof != "" {
+			addOneof(f.oneof)
+		}
+	}
+	for _, f := range fields {
+		fd := &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(f.name),
+			JsonName: proto.String(f.name),
+			Number:   proto.Int32(f.number),
+			Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:     f.typ.Enum(),
+		}
+		switch {
+		case f.oneof != "":
+			fd.OneofIndex = proto.Int32(addOneof(f.oneof))
+		case f.optional:
+			fd.OneofIndex = proto.Int32(addOneof("_" + f.name))
+			fd.Proto3Optional = proto.Bool(true)
+		}
+		m.Field = append(m.Field, fd)
+	}
+	return &descriptorpb.FileDescriptorSet{File: []*descriptorpb.FileDescriptorProto{{
+		Name:        proto.String("test.proto"),
+		Package:     proto.String("test"),
+		Syntax:      proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{m},
+	}}}
+}
+
+func TestCheckFields(t *testing.T) {
+	const (
+		str = descriptorpb.FieldDescriptorProto_TYPE_STRING
+		i64 = descriptorpb.FieldDescriptorProto_TYPE_INT64
+	)
+	tests := []struct {
+		name     string
+		old, new []testField
+		want     []string
+	}{
+		{
+			name: "unchanged",
+			old:  []testField{{name: "a", number: 1, typ: str}},
+			new:  []testField{{name: "a", number: 1, typ: str}},
+		},
+		{
+			name: "field added",
+			old:  []testField{{name: "a", number: 1, typ: str}},
+			new:  []testField{{name: "a", number: 1, typ: str}, {name: "b", number: 2, typ: str}},
+		},
+		{
+			name: "made optional",
+			old:  []testField{{name: "a", number: 1, typ: str}},
+			new:  []testField{{name: "a", number: 1, typ: str, optional: true}},
+		},
+		{
+			name: "optional dropped",
+			old:  []testField{{name: "a", number: 1, typ: i64, optional: true}},
+			new:  []testField{{name: "a", number: 1, typ: i64}},
+		},
+		{
+			name: "moved into oneof",
+			old:  []testField{{name: "a", number: 1, typ: str}},
+			new:  []testField{{name: "a", number: 1, typ: str, oneof: "kind"}},
+			want: []string{"test.M.a: moved into or out of a oneof"},
+		},
+		{
+			name: "optional moved into oneof",
+			old:  []testField{{name: "a", number: 1, typ: str, optional: true}},
+			new:  []testField{{name: "a", number: 1, typ: str, oneof: "kind"}},
+			want: []string{"test.M.a: moved into or out of a oneof"},
+		},
+		{
+			name: "renamed",
+			old:  []testField{{name: "a", number: 1, typ: str}},
+			new:  []testField{{name: "b", number: 1, typ: str}},
+			want: []string{"test.M.a: field 1 renamed to b"},
+		},
+		{
+			name: "retyped",
+			old:  []testField{{name: "a", number: 1, typ: str}},
+			new:  []testField{{name: "a", number: 1, typ: i64}},
+			want: []string{"test.M.a: type changed from string to int64"},
+		},
+		{
+			name: "removed",
+			old:  []testField{{name: "a", number: 1, typ: str}, {name: "b", number: 2, typ: str}},
+			new:  []testField{{name: "a", number: 1, typ: str}},
+			want: []string{"test.M.b: field 2 removed without reserving its name and number"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes, err := Check(testSet(tt.old...), testSet(tt.new...))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, c := range changes {
+				got = append(got, c.String())
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Check() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package gen
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// Scopes granted in access tokens. A token carries the subset its holder needs,
+// so service-to-service callers can be issued least-privilege credentials.
+const (
+	ScopeAccountRead        = "account:read"
+	ScopeAccountWrite       = "account:write"
+	ScopeAccountAdmin       = "account:admin"
+	ScopeAPIKeysValidate    = "apikeys:validate"
+	ScopeCart               = "cart"
+	ScopeChat               = "chat"
+	ScopeInventoryRead      = "inventory:read"
+	ScopeInventoryWrite     = "inventory:write"
+	ScopeInventoryAdmin     = "inventory:admin"
+	ScopeNotificationsRead  = "notifications:read"
+	ScopeNotificationsWrite = "notifications:write"
+	ScopeNotificationsSend  = "notifications:send"
+	ScopeOrdersRead         = "orders:read"
+	ScopeOrdersWrite        = "orders:write"
+	ScopeOrdersAdmin        = "orders:admin"
+	ScopePaymentsWrite      = "payments:write"
+	ScopeProductsWrite      = "products:write"
+	ScopeReviewsWrite       = "reviews:write"
+	ScopeRiskRead           = "risk:read"
+	ScopeRiskAdmin          = "risk:admin"
+	ScopeShipmentsWrite     = "shipments:write"
+	ScopeWishlist           = "wishlist"
+)
+
+// methodScopes lists the scopes a caller must hold (all of them) to invoke each
+// method. Methods absent from the table, such as Login or GetProducts, are public.
+var methodScopes = map[string][]string{
+	AccountService_AnonymizeUserData_FullMethodName:   {ScopeAccountAdmin},
+	AccountService_AcceptTerms_FullMethodName:         {ScopeAccountWrite},
+	AccountService_RegisterPushToken_FullMethodName:   {ScopeAccountWrite},
+	AccountService_UnregisterPushToken_FullMethodName: {ScopeAccountWrite},
+	AccountService_UnlockAccount_FullMethodName:       {ScopeAccountAdmin},
+	AccountService_MergeAccounts_FullMethodName:       {ScopeAccountWrite},
+	AccountService_RequestEmailChange_FullMethodName:  {ScopeAccountWrite},
+	AccountService_ConfirmEmailChange_FullMethodName:  {ScopeAccountWrite},
+	AccountService_UploadAvatar_FullMethodName:        {ScopeAccountWrite},
+	AccountService_GetPreferences_FullMethodName:      {ScopeAccountRead},
+	AccountService_SetPreferences_FullMethodName:      {ScopeAccountWrite},
+	AccountService_GetMe_FullMethodName:               {ScopeAccountRead},
+	AccountService_UpdateProfile_FullMethodName:       {ScopeAccountWrite},
+	AccountService_ChangePassword_FullMethodName:      {ScopeAccountWrite},
+	AccountService_DeleteAccount_FullMethodName:       {ScopeAccountWrite},
+	AccountService_CreateAPIKey_FullMethodName:        {ScopeAccountAdmin},
+	AccountService_RevokeAPIKey_FullMethodName:        {ScopeAccountAdmin},
+	AccountService_ValidateAPIKey_FullMethodName:      {ScopeAPIKeysValidate},
+
+	CartService_GetCart_FullMethodName:        {ScopeCart},
+	CartService_AddItem_FullMethodName:        {ScopeCart},
+	CartService_RemoveItem_FullMethodName:     {ScopeCart},
+	CartService_UpdateQuantity_FullMethodName: {ScopeCart},
+	CartService_ClearCart_FullMethodName:      {ScopeCart},
+
+	WishlistService_AddToWishlist_FullMethodName:      {ScopeWishlist},
+	WishlistService_RemoveFromWishlist_FullMethodName: {ScopeWishlist},
+	WishlistService_ListWishlist_FullMethodName:       {ScopeWishlist},
+	WishlistService_MoveToCart_FullMethodName:         {ScopeWishlist, ScopeCart},
+
+	ChatService_OpenConversation_FullMethodName: {ScopeChat},
+	ChatService_ListChatMessages_FullMethodName: {ScopeChat},
+	ChatService_Chat_FullMethodName:             {ScopeChat},
+
+	InventoryService_WatchLowStock_FullMethodName:      {ScopeInventoryRead},
+	InventoryService_ReserveStock_FullMethodName:       {ScopeInventoryWrite},
+	InventoryService_ReleaseReservation_FullMethodName: {ScopeInventoryWrite},
+	InventoryService_AdjustStock_FullMethodName:        {ScopeInventoryAdmin},
+	InventoryService_AuditInventory_FullMethodName:     {ScopeInventoryAdmin},
+
+	NotificationService_GetNotificationPreferences_FullMethodName:    {ScopeNotificationsRead},
+	NotificationService_UpdateNotificationPreferences_FullMethodName: {ScopeNotificationsWrite},
+	NotificationService_ListNotifications_FullMethodName:             {ScopeNotificationsRead},
+	NotificationService_MarkNotificationRead_FullMethodName:          {ScopeNotificationsWrite},
+	NotificationService_SendEmail_FullMethodName:                     {ScopeNotificationsSend},
+	NotificationService_SendSMS_FullMethodName:                       {ScopeNotificationsSend},
+	NotificationService_SendPush_FullMethodName:                      {ScopeNotificationsSend},
+	NotificationService_SubscribeEvents_FullMethodName:               {ScopeNotificationsSend},
+
+	OrderService_InsertOrder_FullMethodName:              {ScopeOrdersWrite},
+	OrderService_GetAllOrders_FullMethodName:             {ScopeOrdersRead},
+	OrderService_GetOrderByID_FullMethodName:             {ScopeOrdersRead},
+	OrderService_GetOrdersByUser_FullMethodName:          {ScopeOrdersRead},
+	OrderService_WatchOrder_FullMethodName:               {ScopeOrdersRead},
+	OrderService_CancelOrder_FullMethodName:              {ScopeOrdersWrite},
+	OrderService_RefundOrder_FullMethodName:              {ScopeOrdersAdmin},
+	OrderService_CreateReturnLabel_FullMethodName:        {ScopeOrdersWrite},
+	OrderService_ImportOrders_FullMethodName:             {ScopeOrdersAdmin},
+	OrderService_GetOrdersByIDs_FullMethodName:           {ScopeOrdersAdmin},
+	OrderService_ArchiveOrders_FullMethodName:            {ScopeOrdersAdmin},
+	OrderService_GetArchivedOrder_FullMethodName:         {ScopeOrdersRead},
+	OrderService_CreateQuote_FullMethodName:              {ScopeOrdersAdmin},
+	OrderService_AcceptQuote_FullMethodName:              {ScopeOrdersWrite},
+	OrderService_ConvertQuoteToOrder_FullMethodName:      {ScopeOrdersWrite},
+	OrderService_PriceOrder_FullMethodName:               {ScopeOrdersRead},
+	OrderService_CheckPurchaseEligibility_FullMethodName: {ScopeOrdersRead},
+
+	PaymentService_KakaoReady_FullMethodName:     {ScopePaymentsWrite},
+	PaymentService_KakaoApprove_FullMethodName:   {ScopePaymentsWrite},
+	PaymentService_KakaoCancel_FullMethodName:    {ScopePaymentsWrite},
+	PaymentService_PreparePayment_FullMethodName: {ScopePaymentsWrite},
+	PaymentService_ApprovePayment_FullMethodName: {ScopePaymentsWrite},
+	PaymentService_CancelPayment_FullMethodName:  {ScopePaymentsWrite},
+
+	FlashSaleService_CreateFlashSale_FullMethodName: {ScopeProductsWrite},
+
+	ProductService_PostProducts_FullMethodName:  {ScopeProductsWrite},
+	ProductService_UpdateProduct_FullMethodName: {ScopeProductsWrite},
+	ProductService_CreateBundle_FullMethodName:  {ScopeProductsWrite},
+
+	ReviewService_CreateReview_FullMethodName: {ScopeReviewsWrite},
+	ReviewService_UpdateReview_FullMethodName: {ScopeReviewsWrite},
+	ReviewService_DeleteReview_FullMethodName: {ScopeReviewsWrite},
+
+	RiskService_AddToBlocklist_FullMethodName:      {ScopeRiskAdmin},
+	RiskService_RemoveFromBlocklist_FullMethodName: {ScopeRiskAdmin},
+	RiskService_CheckBlocklist_FullMethodName:      {ScopeRiskRead},
+
+	ShippingService_CreateShipment_FullMethodName:       {ScopeShipmentsWrite},
+	ShippingService_GetTrackingInfo_FullMethodName:      {ScopeOrdersRead},
+	ShippingService_UpdateShipmentStatus_FullMethodName: {ScopeShipmentsWrite},
+	ShippingService_WatchShipment_FullMethodName:        {ScopeOrdersRead},
+
+	SubscriptionService_CreateSubscription_FullMethodName: {ScopeOrdersWrite, ScopePaymentsWrite},
+	SubscriptionService_PauseSubscription_FullMethodName:  {ScopeOrdersWrite},
+	SubscriptionService_SkipNextDelivery_FullMethodName:   {ScopeOrdersWrite},
+	SubscriptionService_CancelSubscription_FullMethodName: {ScopeOrdersWrite},
+}
+
+// RequiredScopes returns the scopes required to call fullMethod
+// (e.g. OrderService_InsertOrder_FullMethodName), or nil for public methods.
+func RequiredScopes(fullMethod string) []string {
+	return slices.Clone(methodScopes[fullMethod])
+}
+
+// ScopeExtractor returns the scopes granted to the caller of ctx, typically
+// read from a verified access token. It should return an error when the caller
+// is not authenticated.
+type ScopeExtractor func(ctx context.Context) ([]string, error)
+
+// UnaryScopeInterceptor rejects unary calls whose caller lacks any scope
+// required by the invoked method.
+func UnaryScopeInterceptor(extract ScopeExtractor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := checkScopes(ctx, info.FullMethod, extract); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamScopeInterceptor is the streaming counterpart of UnaryScopeInterceptor.
+func StreamScopeInterceptor(extract ScopeExtractor) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := checkScopes(ss.Context(), info.FullMethod, extract); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+func checkScopes(ctx context.Context, fullMethod string, extract ScopeExtractor) error {
+	required := methodScopes[fullMethod]
+	if len(required) == 0 {
+		return nil
+	}
+	granted, err := extract(ctx)
+	if err != nil {
+		return NewError(codes.Unauthenticated, ErrorReason_ERROR_REASON_UNAUTHENTICATED, fmt.Sprintf("authentication required: %v", err), nil)
+	}
+	for _, s := range required {
+		if !slices.Contains(granted, s) {
+			return NewError(codes.PermissionDenied, ErrorReason_ERROR_REASON_MISSING_SCOPE, fmt.Sprintf("missing scope %q for %s", s, fullMethod),
+				map[string]string{"scope": s})
+		}
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package gen
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// publicMethods are the methods deliberately left out of methodScopes. A new
+// method must be added to methodScopes or here, so none is public by omission.
+var publicMethods = []string{
+	AccountService_GetKakaoLoginURL_FullMethodName,
+	AccountService_GetKakaoCallBack_FullMethodName,
+	AccountService_Login_FullMethodName,
+	AccountService_Register_FullMethodName,
+	AccountService_RefreshToken_FullMethodName,
+	AccountService_RevokeToken_FullMethodName,
+	AccountService_VerifyCaptcha_FullMethodName,
+	AccountService_IssueGuestToken_FullMethodName,
+	CalendarService_ListHolidays_FullMethodName,
+	CalendarService_GetSupportHours_FullMethodName,
+	FlashSaleService_GetFlashSale_FullMethodName,
+	FlashSaleService_GetQueuePosition_FullMethodName,
+	FlashSaleService_IssueQueueToken_FullMethodName,
+	FlashSaleService_ValidateQueueToken_FullMethodName,
+	InventoryService_GetStock_FullMethodName,
+	ProductService_GetProducts_FullMethodName,
+	ProductService_GetProductByID_FullMethodName,
+	ProductService_ResolveBundle_FullMethodName,
+	ReviewService_ListReviewsByProduct_FullMethodName,
+	ReviewService_GetProductRatingSummary_FullMethodName,
+}
+
+func TestEveryMethodHasScopesOrIsPublic(t *testing.T) {
+	protoregistry.GlobalFiles.RangeFiles(func(f protoreflect.FileDescriptor) bool {
+		if f.Package() != "go.escape.ship.proto.v1" {
+			return true
+		}
+		for i := 0; i < f.Services().Len(); i++ {
+			svc := f.Services().Get(i)
+			for j := 0; j < svc.Methods().Len(); j++ {
+				method := "/" + string(svc.FullName()) + "/" + string(svc.Methods().Get(j).Name())
+				_, scoped := methodScopes[method]
+				public := slices.Contains(publicMethods, method)
+				switch {
+				case !scoped && !public:
+					t.Errorf("%s has no scopes and is not listed as public", method)
+				case scoped && public:
+					t.Errorf("%s is listed as public but requires scopes", method)
+				}
+			}
+		}
+		return true
+	})
+}
+
+func TestRequiredScopes(t *testing.T) {
+	tests := []struct {
+		method string
+		want   []string
+	}{
+		{ProductService_CreateBundle_FullMethodName, []string{ScopeProductsWrite}},
+		{ProductService_PostProducts_FullMethodName, []string{ScopeProductsWrite}},
+		{OrderService_RefundOrder_FullMethodName, []string{ScopeOrdersAdmin}},
+		{OrderService_GetOrdersByIDs_FullMethodName, []string{ScopeOrdersAdmin}},
+		{WishlistService_MoveToCart_FullMethodName, []string{ScopeWishlist, ScopeCart}},
+		{ProductService_GetProducts_FullMethodName, nil},
+	}
+	for _, tt := range tests {
+		if got := RequiredScopes(tt.method); !slices.Equal(got, tt.want) {
+			t.Errorf("RequiredScopes(%s) = %v, want %v", tt.method, got, tt.want)
+		}
+	}
+}
+
+func TestCheckScopes(t *testing.T) {
+	granted := func(scopes ...string) ScopeExtractor {
+		return func(context.Context) ([]string, error) { return scopes, nil }
+	}
+	anonymous := func(context.Context) ([]string, error) { return nil, errors.New("no token") }
+	tests := []struct {
+		name    string
+		method  string
+		extract ScopeExtractor
+		want    codes.Code
+	}{
+		{"public without token", ProductService_GetProducts_FullMethodName, anonymous, codes.OK},
+		{"scoped without token", ProductService_CreateBundle_FullMethodName, anonymous, codes.Unauthenticated},
+		{"missing scope", ProductService_CreateBundle_FullMethodName, granted(ScopeOrdersRead), codes.PermissionDenied},
+		{"granted", ProductService_CreateBundle_FullMethodName, granted(ScopeOrdersRead, ScopeProductsWrite), codes.OK},
+		{"needs all scopes", WishlistService_MoveToCart_FullMethodName, granted(ScopeWishlist), codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := status.Code(checkScopes(context.Background(), tt.method, tt.extract)); got != tt.want {
+				t.Errorf("checkScopes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
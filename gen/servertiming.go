@@ -0,0 +1,218 @@
+package gen
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// ServerTimingHeader is the trailer metadata key carrying a call's latency
+// breakdown per upstream dependency, in the HTTP Server-Timing format:
+//
+//	server-timing: db;dur=12.4, InventoryService;dur=48, kakaopay;dur=812.5;desc="ready", total;dur=880.1
+//
+// Durations are in milliseconds. Servers opt in with
+// UnaryServerTimingInterceptor; clients read it with ServerTimingFromTrailer.
+const ServerTimingHeader = "server-timing"
+
+// ServerTimingTotal is the entry for the whole handler, added by the server
+// timing interceptors.
+const ServerTimingTotal = "total"
+
+// ServerTiming is the time a call spent in one dependency.
+type ServerTiming struct {
+	// Name identifies the dependency, e.g. "db" or "kakaopay". It must be an
+	// HTTP token (no spaces, commas, semicolons or quotes).
+	Name     string
+	Duration time.Duration
+	// Description is optional detail, e.g. which API was called.
+	Description string
+}
+
+// serverTimings collects the entries of one call. Repeated names are summed.
+type serverTimings struct {
+	mu      sync.Mutex
+	entries []ServerTiming
+}
+
+func (t *serverTimings) add(e ServerTiming) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for i := range t.entries {
+		if t.entries[i].Name == e.Name {
+			t.entries[i].Duration += e.Duration
+			if t.entries[i].Description == "" {
+				t.entries[i].Description = e.Description
+			}
+			return
+		}
+	}
+	t.entries = append(t.entries, e)
+}
+
+func (t *serverTimings) header() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return FormatServerTiming(t.entries)
+}
+
+type serverTimingsCtxKey struct{}
+
+// RecordServerTiming adds d to the named dependency's share of the current
+// call. It is a no-op unless a server timing interceptor is installed, so
+// stores and clients can record unconditionally.
+func RecordServerTiming(ctx context.Context, name string, d time.Duration) {
+	RecordServerTimingDesc(ctx, name, "", d)
+}
+
+// RecordServerTimingDesc is RecordServerTiming with a description.
+func RecordServerTimingDesc(ctx context.Context, name, desc string, d time.Duration) {
+	if t, ok := ctx.Value(serverTimingsCtxKey{}).(*serverTimings); ok {
+		t.add(ServerTiming{Name: name, Duration: d, Description: desc})
+	}
+}
+
+// StartServerTiming starts timing a dependency and returns the function that
+// records it:
+//
+//	defer pb.StartServerTiming(ctx, "db")()
+func StartServerTiming(ctx context.Context, name string) func() {
+	start := time.Now()
+	return func() { RecordServerTiming(ctx, name, time.Since(start)) }
+}
+
+// UnaryServerTimingInterceptor collects the timings handlers record and sends
+// them, plus the handler's total, in the ServerTimingHeader trailer. Chain it
+// first so the total covers the other interceptors.
+func UnaryServerTimingInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		t := &serverTimings{}
+		start := time.Now()
+		resp, err := handler(context.WithValue(ctx, serverTimingsCtxKey{}, t), req)
+		t.add(ServerTiming{Name: ServerTimingTotal, Duration: time.Since(start)})
+		grpc.SetTrailer(ctx, metadata.Pairs(ServerTimingHeader, t.header()))
+		return resp, err
+	}
+}
+
+// StreamServerTimingInterceptor is the streaming counterpart of
+// UnaryServerTimingInterceptor.
+func StreamServerTimingInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		t := &serverTimings{}
+		ctx := context.WithValue(ss.Context(), serverTimingsCtxKey{}, t)
+		start := time.Now()
+		err := handler(srv, &contextServerStream{ServerStream: ss, ctx: ctx})
+		t.add(ServerTiming{Name: ServerTimingTotal, Duration: time.Since(start)})
+		ss.SetTrailer(metadata.Pairs(ServerTimingHeader, t.header()))
+		return err
+	}
+}
+
+// UnaryClientServerTimingInterceptor records the duration of outgoing calls
+// made while handling a timed call, named after the called service (e.g.
+// "InventoryService"), so a checkout's breakdown shows its upstream services
+// without instrumenting each call site.
+func UnaryClientServerTimingInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		RecordServerTiming(ctx, serverTimingName(method), time.Since(start))
+		return err
+	}
+}
+
+// serverTimingName returns the unqualified service of a full method name,
+// e.g. "InventoryService" for
+// "/go.escape.ship.proto.v1.InventoryService/ReserveStock".
+func serverTimingName(method string) string {
+	svc, _, _ := strings.Cut(strings.TrimPrefix(method, "/"), "/")
+	return svc[strings.LastIndex(svc, ".")+1:]
+}
+
+// FormatServerTiming renders entries in the Server-Timing format.
+func FormatServerTiming(entries []ServerTiming) string {
+	var b strings.Builder
+	for i, e := range entries {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(e.Name)
+		b.WriteString(";dur=")
+		b.WriteString(strconv.FormatFloat(float64(e.Duration.Microseconds())/1000, 'f', -1, 64))
+		if e.Description != "" {
+			fmt.Fprintf(&b, ";desc=%+q", e.Description) // metadata must be ASCII
+		}
+	}
+	return b.String()
+}
+
+// ParseServerTiming parses Server-Timing values, skipping malformed entries.
+// Entries without a duration have a zero Duration.
+func ParseServerTiming(values ...string) []ServerTiming {
+	var out []ServerTiming
+	for _, v := range values {
+		for _, entry := range splitUnquoted(v, ',') {
+			params := splitUnquoted(entry, ';')
+			e := ServerTiming{Name: strings.TrimSpace(params[0])}
+			if e.Name == "" {
+				continue
+			}
+			for _, p := range params[1:] {
+				k, val, _ := strings.Cut(strings.TrimSpace(p), "=")
+				switch strings.ToLower(k) {
+				case "dur":
+					if ms, err := strconv.ParseFloat(val, 64); err == nil {
+						e.Duration = time.Duration(ms * float64(time.Millisecond))
+					}
+				case "desc":
+					if s, err := strconv.Unquote(val); err == nil {
+						e.Description = s
+					} else {
+						e.Description = val
+					}
+				}
+			}
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// splitUnquoted splits s at sep, except inside double-quoted descriptions.
+func splitUnquoted(s string, sep byte) []string {
+	var parts []string
+	quoted, escaped, start := false, false, 0
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case escaped:
+			escaped = false
+		case c == '\\' && quoted:
+			escaped = true
+		case c == '"':
+			quoted = !quoted
+		case c == sep && !quoted:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// ServerTimingFromTrailer returns the latency breakdown from a call's
+// trailer, or nil if the server sent none:
+//
+//	var trailer metadata.MD
+//	resp, err := client.InsertOrder(ctx, req, grpc.Trailer(&trailer))
+//	for _, t := range pb.ServerTimingFromTrailer(trailer) {
+//	    log.Printf("%s: %v", t.Name, t.Duration)
+//	}
+func ServerTimingFromTrailer(md metadata.MD) []ServerTiming {
+	return ParseServerTiming(md.Get(ServerTimingHeader)...)
+}
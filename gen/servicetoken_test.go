@@ -0,0 +1,142 @@
+package gen
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func newTestKey(t *testing.T) (ed25519.PublicKey, ed25519.PrivateKey) {
+	t.Helper()
+	pub, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pub, priv
+}
+
+// signToken signs arbitrary header and claims, for forging malformed tokens.
+func signToken(key ed25519.PrivateKey, header map[string]string, claims any) string {
+	enc := base64.RawURLEncoding
+	h, _ := json.Marshal(header)
+	p, _ := json.Marshal(claims)
+	input := enc.EncodeToString(h) + "." + enc.EncodeToString(p)
+	return input + "." + enc.EncodeToString(ed25519.Sign(key, []byte(input)))
+}
+
+func TestServiceTokenVerify(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	orderPub, orderKey := newTestKey(t)
+	cartPub, cartKey := newTestKey(t)
+	_, strangerKey := newTestKey(t)
+
+	issuer := NewServiceTokenIssuer("ordersrv", orderKey, 5*time.Minute)
+	issuer.now = func() time.Time { return now }
+	verifier := NewServiceTokenVerifier("paymentsrv", map[string]ed25519.PublicKey{"ordersrv": orderPub, "cartsrv": cartPub})
+
+	mint := func(audience string) string {
+		tok, err := issuer.Mint(audience, ScopePaymentsWrite)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tok
+	}
+	valid := mint("paymentsrv")
+	parts := strings.Split(valid, ".")
+	stranger := NewServiceTokenIssuer("ordersrv", strangerKey, 5*time.Minute)
+	stranger.now = issuer.now
+	forged, err := stranger.Mint("paymentsrv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	claims := ServiceClaims{Issuer: "ordersrv", Audience: "paymentsrv", IssuedAt: now.Unix(), ExpiresAt: now.Add(time.Minute).Unix()}
+
+	tests := []struct {
+		name    string
+		token   string
+		later   time.Duration
+		wantErr bool
+	}{
+		{name: "valid", token: valid},
+		{name: "within leeway after expiry", token: valid, later: 5*time.Minute + 20*time.Second},
+		{name: "expired", token: valid, later: 6 * time.Minute, wantErr: true},
+		{name: "not yet valid", token: valid, later: -time.Minute, wantErr: true},
+		{name: "other audience", token: mint("cartsrv"), wantErr: true},
+		{name: "signed by unknown key", token: forged, wantErr: true},
+		{name: "tampered payload", token: parts[0] + "." + base64.RawURLEncoding.EncodeToString([]byte(`{"iss":"ordersrv","aud":"paymentsrv","scp":["*"]}`)) + "." + parts[2], wantErr: true},
+		{name: "alg none", token: base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","kid":"ordersrv"}`)) + "." + parts[1] + ".", wantErr: true},
+		{name: "unknown service", token: signToken(orderKey, map[string]string{"alg": "EdDSA", "kid": "evilsrv"}, claims), wantErr: true},
+		{
+			name:    "issuer differs from signing service",
+			token:   signToken(cartKey, map[string]string{"alg": "EdDSA", "kid": "cartsrv"}, claims),
+			wantErr: true,
+		},
+		{name: "malformed", token: "abc", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verifier.now = func() time.Time { return now.Add(tt.later) }
+			got, err := verifier.Verify(tt.token)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Verify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && (got.Issuer != "ordersrv" || len(got.Scopes) != 1 || got.Scopes[0] != ScopePaymentsWrite) {
+				t.Errorf("Verify() = %+v", got)
+			}
+		})
+	}
+}
+
+func TestServiceTokenCredsRefresh(t *testing.T) {
+	_, key := newTestKey(t)
+	now := time.Unix(1_700_000_000, 0)
+	issuer := NewServiceTokenIssuer("ordersrv", key, 4*time.Minute)
+	issuer.now = func() time.Time { return now }
+	creds := issuer.PerRPCCredentials("paymentsrv")
+
+	first, err := creds.GetRequestMetadata(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	now = now.Add(2 * time.Minute)
+	second, _ := creds.GetRequestMetadata(context.Background())
+	if first[ServiceTokenHeader] != second[ServiceTokenHeader] {
+		t.Error("token re-minted before 3/4 of its ttl")
+	}
+	now = now.Add(time.Minute)
+	third, _ := creds.GetRequestMetadata(context.Background())
+	if third[ServiceTokenHeader] == first[ServiceTokenHeader] {
+		t.Error("token not re-minted after 3/4 of its ttl")
+	}
+}
+
+func TestServiceTokenAuthenticate(t *testing.T) {
+	pub, key := newTestKey(t)
+	issuer := NewServiceTokenIssuer("ordersrv", key, time.Minute)
+	verifier := NewServiceTokenVerifier("paymentsrv", map[string]ed25519.PublicKey{"ordersrv": pub})
+	tok, err := issuer.Mint("paymentsrv", ScopePaymentsWrite)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := verifier.authenticate(context.Background()); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("authenticate() without token error = %v, want Unauthenticated", err)
+	}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(ServiceTokenHeader, tok))
+	ctx, err = verifier.authenticate(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	scopes, err := ServiceScopes(ctx)
+	if err != nil || len(scopes) != 1 || scopes[0] != ScopePaymentsWrite {
+		t.Errorf("ServiceScopes() = %v, %v", scopes, err)
+	}
+}
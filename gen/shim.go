@@ -0,0 +1,250 @@
+package gen
+
+import (
+	"context"
+	"errors"
+	"io"
+	"iter"
+	"slices"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// This file holds the stream adapters used by the generated *_shim.pb.go files.
+// The *API interfaces expose streams as iterators: server streams return
+// iter.Seq2[*Res, error], client streams take iter.Seq[*Req], and bidi
+// streams do both.
+
+// serverStreamSeq lazily opens a server stream and yields its messages. A
+// failure to open or receive is yielded once as (nil, err). The stream is
+// cancelled when iteration stops, so breaking out of the loop releases it.
+func serverStreamSeq[Res any](ctx context.Context, open func(context.Context) (grpc.ServerStreamingClient[Res], error)) iter.Seq2[*Res, error] {
+	return func(yield func(*Res, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		stream, err := open(ctx)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		for {
+			res, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if !yield(res, nil) {
+				return
+			}
+		}
+	}
+}
+
+// sendAll sends every message of in on a client stream and returns the
+// server's single response.
+func sendAll[Req, Res any](stream grpc.ClientStreamingClient[Req, Res], in iter.Seq[*Req]) (*Res, error) {
+	for req := range in {
+		if err := stream.Send(req); err != nil {
+			if errors.Is(err, io.EOF) {
+				break // server closed the stream; CloseAndRecv returns its status
+			}
+			return nil, err
+		}
+	}
+	return stream.CloseAndRecv()
+}
+
+// bidiStreamSeq lazily opens a bidi stream, sends in from a separate goroutine,
+// and yields the server's messages.
+func bidiStreamSeq[Req, Res any](ctx context.Context, open func(context.Context) (grpc.BidiStreamingClient[Req, Res], error), in iter.Seq[*Req]) iter.Seq2[*Res, error] {
+	return func(yield func(*Res, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		stream, err := open(ctx)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		go func() {
+			for req := range in {
+				if stream.Send(req) != nil {
+					return
+				}
+			}
+			stream.CloseSend()
+		}()
+		for {
+			res, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if !yield(res, nil) {
+				return
+			}
+		}
+	}
+}
+
+// seqClientStream implements grpc.ClientStream for streams backed by an *API
+// implementation rather than a connection. Headers and trailers are empty.
+type seqClientStream struct {
+	ctx context.Context
+}
+
+func (s seqClientStream) Header() (metadata.MD, error) { return nil, nil }
+func (s seqClientStream) Trailer() metadata.MD         { return nil }
+func (s seqClientStream) CloseSend() error             { return nil }
+func (s seqClientStream) Context() context.Context     { return s.ctx }
+func (s seqClientStream) SendMsg(any) error {
+	return status.Error(codes.Unimplemented, "SendMsg is not supported on API-backed streams")
+}
+func (s seqClientStream) RecvMsg(any) error {
+	return status.Error(codes.Unimplemented, "RecvMsg is not supported on API-backed streams")
+}
+
+// seqServerStream adapts an iterator to grpc.ServerStreamingClient.
+type seqServerStream[Res any] struct {
+	seqClientStream
+	next func() (*Res, error, bool)
+	stop func()
+}
+
+func newSeqServerStream[Res any](ctx context.Context, seq iter.Seq2[*Res, error]) grpc.ServerStreamingClient[Res] {
+	next, stop := iter.Pull2(seq)
+	return &seqServerStream[Res]{seqClientStream: seqClientStream{ctx}, next: next, stop: stop}
+}
+
+// Recv returns the next message. The iterator is stopped once it ends, fails
+// or the stream's context is done, so its deferred cleanup runs.
+func (s *seqServerStream[Res]) Recv() (*Res, error) {
+	if err := s.ctx.Err(); err != nil {
+		s.stop()
+		return nil, status.FromContextError(err).Err()
+	}
+	res, err, ok := s.next()
+	if !ok {
+		s.stop()
+		return nil, io.EOF
+	}
+	if err != nil {
+		s.stop()
+		return nil, err
+	}
+	return res, nil
+}
+
+// seqClientStreamingClient buffers sent messages and hands them to an *API
+// client-streaming method on CloseAndRecv.
+type seqClientStreamingClient[Req, Res any] struct {
+	seqClientStream
+	call func(context.Context, iter.Seq[*Req]) (*Res, error)
+	sent []*Req
+}
+
+func newSeqClientStreamingClient[Req, Res any](ctx context.Context, call func(context.Context, iter.Seq[*Req]) (*Res, error)) grpc.ClientStreamingClient[Req, Res] {
+	return &seqClientStreamingClient[Req, Res]{seqClientStream: seqClientStream{ctx}, call: call}
+}
+
+func (s *seqClientStreamingClient[Req, Res]) Send(req *Req) error {
+	s.sent = append(s.sent, req)
+	return nil
+}
+
+func (s *seqClientStreamingClient[Req, Res]) CloseAndRecv() (*Res, error) {
+	return s.call(s.ctx, slices.Values(s.sent))
+}
+
+// seqBidiStream runs an *API bidi method in its own goroutine, feeding it
+// sent messages through one channel and receiving its output through another.
+type seqBidiStream[Req, Res any] struct {
+	seqClientStream
+	reqs   chan *Req
+	closed bool
+	out    chan seqResult[Res]
+	done   chan struct{}
+}
+
+type seqResult[Res any] struct {
+	res *Res
+	err error
+}
+
+func newSeqBidiStream[Req, Res any](ctx context.Context, call func(context.Context, iter.Seq[*Req]) iter.Seq2[*Res, error]) grpc.BidiStreamingClient[Req, Res] {
+	s := &seqBidiStream[Req, Res]{
+		seqClientStream: seqClientStream{ctx},
+		reqs:            make(chan *Req),
+		out:             make(chan seqResult[Res]),
+		done:            make(chan struct{}),
+	}
+	in := func(yield func(*Req) bool) {
+		for {
+			select {
+			case req, ok := <-s.reqs:
+				if !ok || !yield(req) {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+	go func() {
+		defer close(s.done)
+		defer close(s.out)
+		for res, err := range call(ctx, in) {
+			select {
+			case s.out <- seqResult[Res]{res, err}:
+			case <-ctx.Done():
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+	return s
+}
+
+func (s *seqBidiStream[Req, Res]) Send(req *Req) error {
+	if s.closed {
+		return status.Error(codes.FailedPrecondition, "send after CloseSend")
+	}
+	select {
+	case s.reqs <- req:
+		return nil
+	case <-s.done:
+		return io.EOF // the method returned; Recv reports its outcome
+	case <-s.ctx.Done():
+		return status.FromContextError(s.ctx.Err()).Err()
+	}
+}
+
+func (s *seqBidiStream[Req, Res]) CloseSend() error {
+	if !s.closed {
+		s.closed = true
+		close(s.reqs)
+	}
+	return nil
+}
+
+func (s *seqBidiStream[Req, Res]) Recv() (*Res, error) {
+	select {
+	case r, ok := <-s.out:
+		if !ok {
+			return nil, io.EOF
+		}
+		return r.res, r.err
+	case <-s.ctx.Done():
+		return nil, status.FromContextError(s.ctx.Err()).Err()
+	}
+}
@@ -0,0 +1,125 @@
+package gen
+
+import (
+	"context"
+	"errors"
+	"io"
+	"iter"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeServerStream yields n events, then io.EOF.
+type fakeServerStream struct {
+	grpc.ClientStream
+	n int
+}
+
+func (s *fakeServerStream) Recv() (*OrderStatusEvent, error) {
+	if s.n == 0 {
+		return nil, io.EOF
+	}
+	s.n--
+	return &OrderStatusEvent{OrderId: "o1"}, nil
+}
+
+func TestServerStreamSeqCancelsOnExit(t *testing.T) {
+	tests := []struct {
+		name  string
+		n     int
+		take  int
+		want  int
+		fails bool
+	}{
+		{name: "drained", n: 3, take: 10, want: 3},
+		{name: "break early", n: 3, take: 1, want: 1},
+		{name: "open fails", fails: true, take: 10, want: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var streamCtx context.Context
+			seq := serverStreamSeq(context.Background(), func(ctx context.Context) (grpc.ServerStreamingClient[OrderStatusEvent], error) {
+				streamCtx = ctx
+				if tt.fails {
+					return nil, status.Error(codes.Unavailable, "down")
+				}
+				return &fakeServerStream{n: tt.n}, nil
+			})
+			got := 0
+			for _, err := range seq {
+				got++
+				if (err != nil) != tt.fails {
+					t.Fatalf("yielded error %v", err)
+				}
+				if got == tt.take {
+					break
+				}
+			}
+			if got != tt.want {
+				t.Errorf("yielded %d values, want %d", got, tt.want)
+			}
+			if streamCtx.Err() == nil {
+				t.Error("stream context not cancelled after iteration stopped")
+			}
+		})
+	}
+}
+
+func TestSeqServerStreamStops(t *testing.T) {
+	events := func(stopped *bool, err error) iter.Seq2[*OrderStatusEvent, error] {
+		return func(yield func(*OrderStatusEvent, error) bool) {
+			defer func() { *stopped = true }()
+			if !yield(&OrderStatusEvent{OrderId: "o1"}, nil) {
+				return
+			}
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			// Block until stopped, as a watch stream with no more events would.
+			yield(&OrderStatusEvent{OrderId: "o1"}, nil)
+		}
+	}
+	failure := status.Error(codes.Internal, "boom")
+	tests := []struct {
+		name    string
+		err     error
+		cancel  bool
+		recvs   int
+		wantErr error
+		code    codes.Code
+	}{
+		{name: "end of stream", recvs: 3, wantErr: io.EOF},
+		{name: "error", err: failure, recvs: 2, wantErr: failure},
+		{name: "context cancelled", cancel: true, recvs: 2, code: codes.Canceled},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			stopped := false
+			stream := newSeqServerStream(ctx, events(&stopped, tt.err))
+			var err error
+			for i := 0; i < tt.recvs; i++ {
+				if i == 1 && tt.cancel {
+					cancel()
+				}
+				if _, err = stream.Recv(); err != nil {
+					break
+				}
+			}
+			switch {
+			case tt.wantErr != nil && !errors.Is(err, tt.wantErr):
+				t.Errorf("Recv() error = %v, want %v", err, tt.wantErr)
+			case tt.wantErr == nil && status.Code(err) != tt.code:
+				t.Errorf("Recv() error = %v, want code %v", err, tt.code)
+			}
+			if !stopped {
+				t.Error("iterator not stopped")
+			}
+		})
+	}
+}
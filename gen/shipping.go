@@ -0,0 +1,74 @@
+package gen
+
+import (
+	"slices"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Terminal reports whether no further tracking events are expected: the
+// shipment was delivered or returned to the sender. WatchShipment ends after a
+// terminal event.
+func (s ShipmentStatus) Terminal() bool {
+	return s == ShipmentStatus_SHIPMENT_STATUS_DELIVERED || s == ShipmentStatus_SHIPMENT_STATUS_RETURNED
+}
+
+// OrderStatus returns the status an order moves to when its shipment reaches
+// s: SHIPPED once the carrier has picked the parcel up and DELIVERED on
+// delivery. It is ORDER_STATUS_UNSPECIFIED when the order status is unchanged.
+func (s ShipmentStatus) OrderStatus() OrderStatus {
+	switch s {
+	case ShipmentStatus_SHIPMENT_STATUS_PICKED_UP, ShipmentStatus_SHIPMENT_STATUS_IN_TRANSIT,
+		ShipmentStatus_SHIPMENT_STATUS_OUT_FOR_DELIVERY, ShipmentStatus_SHIPMENT_STATUS_DELIVERY_FAILED:
+		return OrderStatus_ORDER_STATUS_SHIPPED
+	case ShipmentStatus_SHIPMENT_STATUS_DELIVERED:
+		return OrderStatus_ORDER_STATUS_DELIVERED
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+// RecordEvent adds a tracking event to the shipment, as UpdateShipmentStatus
+// does. Carrier webhooks may arrive out of order, so the event is inserted by
+// occurred_at (RFC 3339) and the shipment takes the status of its latest
+// event; delivered_at is set on delivery. Once the shipment is delivered or
+// returned, late events dated before that are still recorded in place but
+// leave the status alone. Events without a status or with an unparseable
+// occurred_at are rejected with InvalidArgument, and events dated after
+// delivery or return with FailedPrecondition.
+func (s *Shipment) RecordEvent(ev *TrackingEvent) error {
+	if ev.GetStatus() == ShipmentStatus_SHIPMENT_STATUS_UNSPECIFIED {
+		return status.Error(codes.InvalidArgument, "tracking event has no status")
+	}
+	at, err := time.Parse(time.RFC3339, ev.GetOccurredAt())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "tracking event occurred_at %q is not RFC 3339", ev.GetOccurredAt())
+	}
+	end := len(s.Events)
+	if s.GetStatus().Terminal() {
+		// The terminal event is the latest one; nothing may follow it.
+		if end == 0 || at.After(eventTime(s.Events[end-1])) {
+			return status.Errorf(codes.FailedPrecondition, "shipment %s is already %s", s.GetId(), s.GetStatus())
+		}
+		end--
+	}
+	i := slices.IndexFunc(s.Events[:end], func(e *TrackingEvent) bool { return eventTime(e).After(at) })
+	if i < 0 {
+		i = end
+	}
+	s.Events = slices.Insert(s.Events, i, ev)
+	last := s.Events[len(s.Events)-1]
+	s.Status = last.GetStatus()
+	if s.Status == ShipmentStatus_SHIPMENT_STATUS_DELIVERED {
+		s.DeliveredAt = last.GetOccurredAt()
+	}
+	return nil
+}
+
+// eventTime is the parsed occurred_at of a recorded event, or the zero time
+// if it is not RFC 3339.
+func eventTime(e *TrackingEvent) time.Time {
+	t, _ := time.Parse(time.RFC3339, e.GetOccurredAt())
+	return t
+}
@@ -0,0 +1,945 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: shipping.proto
+
+package gen
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 배송 상태
+type ShipmentStatus int32
+
+const (
+	ShipmentStatus_SHIPMENT_STATUS_UNSPECIFIED      ShipmentStatus = 0
+	ShipmentStatus_SHIPMENT_STATUS_READY            ShipmentStatus = 1 // 송장 등록, 택배사 인수 전
+	ShipmentStatus_SHIPMENT_STATUS_PICKED_UP        ShipmentStatus = 2 // 택배사 인수 (집화)
+	ShipmentStatus_SHIPMENT_STATUS_IN_TRANSIT       ShipmentStatus = 3 // 간선 이동 중
+	ShipmentStatus_SHIPMENT_STATUS_OUT_FOR_DELIVERY ShipmentStatus = 4 // 배달 출발
+	ShipmentStatus_SHIPMENT_STATUS_DELIVERED        ShipmentStatus = 5
+	ShipmentStatus_SHIPMENT_STATUS_DELIVERY_FAILED  ShipmentStatus = 6 // 부재 등으로 배달 실패, 재배달 예정
+	ShipmentStatus_SHIPMENT_STATUS_RETURNED         ShipmentStatus = 7 // 발송인에게 반송
+)
+
+// Enum value maps for ShipmentStatus.
+var (
+	ShipmentStatus_name = map[int32]string{
+		0: "SHIPMENT_STATUS_UNSPECIFIED",
+		1: "SHIPMENT_STATUS_READY",
+		2: "SHIPMENT_STATUS_PICKED_UP",
+		3: "SHIPMENT_STATUS_IN_TRANSIT",
+		4: "SHIPMENT_STATUS_OUT_FOR_DELIVERY",
+		5: "SHIPMENT_STATUS_DELIVERED",
+		6: "SHIPMENT_STATUS_DELIVERY_FAILED",
+		7: "SHIPMENT_STATUS_RETURNED",
+	}
+	ShipmentStatus_value = map[string]int32{
+		"SHIPMENT_STATUS_UNSPECIFIED":      0,
+		"SHIPMENT_STATUS_READY":            1,
+		"SHIPMENT_STATUS_PICKED_UP":        2,
+		"SHIPMENT_STATUS_IN_TRANSIT":       3,
+		"SHIPMENT_STATUS_OUT_FOR_DELIVERY": 4,
+		"SHIPMENT_STATUS_DELIVERED":        5,
+		"SHIPMENT_STATUS_DELIVERY_FAILED":  6,
+		"SHIPMENT_STATUS_RETURNED":         7,
+	}
+)
+
+func (x ShipmentStatus) Enum() *ShipmentStatus {
+	p := new(ShipmentStatus)
+	*p = x
+	return p
+}
+
+func (x ShipmentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ShipmentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_shipping_proto_enumTypes[0].Descriptor()
+}
+
+func (ShipmentStatus) Type() protoreflect.EnumType {
+	return &file_shipping_proto_enumTypes[0]
+}
+
+func (x ShipmentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ShipmentStatus.Descriptor instead.
+func (ShipmentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_shipping_proto_rawDescGZIP(), []int{0}
+}
+
+// 배송지/수거지 주소
+type Address struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Recipient     string                 `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`                     // 받는 사람
+	Phone         string                 `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`                             // 연락처 (ex: "010-1234-5678")
+	PostalCode    string                 `protobuf:"bytes,3,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"` // 우편번호 (국내는 5자리 국가기초구역번호)
+	Line1         string                 `protobuf:"bytes,4,opt,name=line1,proto3" json:"line1,omitempty"`                             // 도로명 주소
+	Line2         string                 `protobuf:"bytes,5,opt,name=line2,proto3" json:"line2,omitempty"`                             // 상세 주소 (동/호수)
+	City          string                 `protobuf:"bytes,6,opt,name=city,proto3" json:"city,omitempty"`                               // 시/도 (ex: "서울특별시")
+	Country       string                 `protobuf:"bytes,7,opt,name=country,proto3" json:"country,omitempty"`                         // ISO 3166-1 alpha-2, 비어 있으면 "KR"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Address) Reset() {
+	*x = Address{}
+	mi := &file_shipping_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Address) ProtoMessage() {}
+
+func (x *Address) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Address.ProtoReflect.Descriptor instead.
+func (*Address) Descriptor() ([]byte, []int) {
+	return file_shipping_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Address) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *Address) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Address) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *Address) GetLine1() string {
+	if x != nil {
+		return x.Line1
+	}
+	return ""
+}
+
+func (x *Address) GetLine2() string {
+	if x != nil {
+		return x.Line2
+	}
+	return ""
+}
+
+func (x *Address) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Address) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+// 택배사 추적 이벤트
+type TrackingEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        ShipmentStatus         `protobuf:"varint,1,opt,name=status,proto3,enum=go.escape.ship.proto.v1.ShipmentStatus" json:"status,omitempty"`
+	Location      string                 `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`       // 처리 지점 (ex: "옥천HUB")
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"` // 택배사 원문 설명
+	OccurredAt    string                 `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrackingEvent) Reset() {
+	*x = TrackingEvent{}
+	mi := &file_shipping_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrackingEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackingEvent) ProtoMessage() {}
+
+func (x *TrackingEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackingEvent.ProtoReflect.Descriptor instead.
+func (*TrackingEvent) Descriptor() ([]byte, []int) {
+	return file_shipping_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TrackingEvent) GetStatus() ShipmentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ShipmentStatus_SHIPMENT_STATUS_UNSPECIFIED
+}
+
+func (x *TrackingEvent) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *TrackingEvent) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TrackingEvent) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+// 출고 항목 (분할 배송)
+type ShipmentItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderItemId   string                 `protobuf:"bytes,1,opt,name=order_item_id,json=orderItemId,proto3" json:"order_item_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShipmentItem) Reset() {
+	*x = ShipmentItem{}
+	mi := &file_shipping_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShipmentItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipmentItem) ProtoMessage() {}
+
+func (x *ShipmentItem) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShipmentItem.ProtoReflect.Descriptor instead.
+func (*ShipmentItem) Descriptor() ([]byte, []int) {
+	return file_shipping_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ShipmentItem) GetOrderItemId() string {
+	if x != nil {
+		return x.OrderItemId
+	}
+	return ""
+}
+
+func (x *ShipmentItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type Shipment struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId        string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Carrier        Carrier                `protobuf:"varint,3,opt,name=carrier,proto3,enum=go.escape.ship.proto.v1.Carrier" json:"carrier,omitempty"`
+	TrackingNumber string                 `protobuf:"bytes,4,opt,name=tracking_number,json=trackingNumber,proto3" json:"tracking_number,omitempty"`
+	Status         ShipmentStatus         `protobuf:"varint,5,opt,name=status,proto3,enum=go.escape.ship.proto.v1.ShipmentStatus" json:"status,omitempty"`
+	Address        *Address               `protobuf:"bytes,6,opt,name=address,proto3" json:"address,omitempty"`
+	Items          []*ShipmentItem        `protobuf:"bytes,7,rep,name=items,proto3" json:"items,omitempty"`                                // 비어 있으면 주문 전체
+	Events         []*TrackingEvent       `protobuf:"bytes,8,rep,name=events,proto3" json:"events,omitempty"`                              // 발생 순
+	TrackingUrl    string                 `protobuf:"bytes,9,opt,name=tracking_url,json=trackingUrl,proto3" json:"tracking_url,omitempty"` // 택배사 조회 링크 (TrackingURL로 생성)
+	Estimate       *DeliveryEstimate      `protobuf:"bytes,10,opt,name=estimate,proto3" json:"estimate,omitempty"`                         // 출고 시점 예상 배송일
+	CreatedAt      string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DeliveredAt    string                 `protobuf:"bytes,12,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Shipment) Reset() {
+	*x = Shipment{}
+	mi := &file_shipping_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Shipment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Shipment) ProtoMessage() {}
+
+func (x *Shipment) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Shipment.ProtoReflect.Descriptor instead.
+func (*Shipment) Descriptor() ([]byte, []int) {
+	return file_shipping_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Shipment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Shipment) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *Shipment) GetCarrier() Carrier {
+	if x != nil {
+		return x.Carrier
+	}
+	return Carrier_CARRIER_UNSPECIFIED
+}
+
+func (x *Shipment) GetTrackingNumber() string {
+	if x != nil {
+		return x.TrackingNumber
+	}
+	return ""
+}
+
+func (x *Shipment) GetStatus() ShipmentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ShipmentStatus_SHIPMENT_STATUS_UNSPECIFIED
+}
+
+func (x *Shipment) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *Shipment) GetItems() []*ShipmentItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Shipment) GetEvents() []*TrackingEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *Shipment) GetTrackingUrl() string {
+	if x != nil {
+		return x.TrackingUrl
+	}
+	return ""
+}
+
+func (x *Shipment) GetEstimate() *DeliveryEstimate {
+	if x != nil {
+		return x.Estimate
+	}
+	return nil
+}
+
+func (x *Shipment) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Shipment) GetDeliveredAt() string {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return ""
+}
+
+type CreateShipmentRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrderId        string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Carrier        Carrier                `protobuf:"varint,2,opt,name=carrier,proto3,enum=go.escape.ship.proto.v1.Carrier" json:"carrier,omitempty"`
+	TrackingNumber string                 `protobuf:"bytes,3,opt,name=tracking_number,json=trackingNumber,proto3" json:"tracking_number,omitempty"`
+	Address        *Address               `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"` // 비어 있으면 주문 배송지
+	Items          []*ShipmentItem        `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`     // 비어 있으면 주문 전체
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateShipmentRequest) Reset() {
+	*x = CreateShipmentRequest{}
+	mi := &file_shipping_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShipmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShipmentRequest) ProtoMessage() {}
+
+func (x *CreateShipmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShipmentRequest.ProtoReflect.Descriptor instead.
+func (*CreateShipmentRequest) Descriptor() ([]byte, []int) {
+	return file_shipping_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateShipmentRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *CreateShipmentRequest) GetCarrier() Carrier {
+	if x != nil {
+		return x.Carrier
+	}
+	return Carrier_CARRIER_UNSPECIFIED
+}
+
+func (x *CreateShipmentRequest) GetTrackingNumber() string {
+	if x != nil {
+		return x.TrackingNumber
+	}
+	return ""
+}
+
+func (x *CreateShipmentRequest) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *CreateShipmentRequest) GetItems() []*ShipmentItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type CreateShipmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shipment      *Shipment              `protobuf:"bytes,1,opt,name=shipment,proto3" json:"shipment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateShipmentResponse) Reset() {
+	*x = CreateShipmentResponse{}
+	mi := &file_shipping_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShipmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShipmentResponse) ProtoMessage() {}
+
+func (x *CreateShipmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShipmentResponse.ProtoReflect.Descriptor instead.
+func (*CreateShipmentResponse) Descriptor() ([]byte, []int) {
+	return file_shipping_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateShipmentResponse) GetShipment() *Shipment {
+	if x != nil {
+		return x.Shipment
+	}
+	return nil
+}
+
+type GetTrackingInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShipmentId    string                 `protobuf:"bytes,1,opt,name=shipment_id,json=shipmentId,proto3" json:"shipment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTrackingInfoRequest) Reset() {
+	*x = GetTrackingInfoRequest{}
+	mi := &file_shipping_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrackingInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrackingInfoRequest) ProtoMessage() {}
+
+func (x *GetTrackingInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrackingInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetTrackingInfoRequest) Descriptor() ([]byte, []int) {
+	return file_shipping_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetTrackingInfoRequest) GetShipmentId() string {
+	if x != nil {
+		return x.ShipmentId
+	}
+	return ""
+}
+
+type GetTrackingInfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shipment      *Shipment              `protobuf:"bytes,1,opt,name=shipment,proto3" json:"shipment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTrackingInfoResponse) Reset() {
+	*x = GetTrackingInfoResponse{}
+	mi := &file_shipping_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrackingInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrackingInfoResponse) ProtoMessage() {}
+
+func (x *GetTrackingInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrackingInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetTrackingInfoResponse) Descriptor() ([]byte, []int) {
+	return file_shipping_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetTrackingInfoResponse) GetShipment() *Shipment {
+	if x != nil {
+		return x.Shipment
+	}
+	return nil
+}
+
+type UpdateShipmentStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShipmentId    string                 `protobuf:"bytes,1,opt,name=shipment_id,json=shipmentId,proto3" json:"shipment_id,omitempty"`
+	Event         *TrackingEvent         `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateShipmentStatusRequest) Reset() {
+	*x = UpdateShipmentStatusRequest{}
+	mi := &file_shipping_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateShipmentStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateShipmentStatusRequest) ProtoMessage() {}
+
+func (x *UpdateShipmentStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateShipmentStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateShipmentStatusRequest) Descriptor() ([]byte, []int) {
+	return file_shipping_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateShipmentStatusRequest) GetShipmentId() string {
+	if x != nil {
+		return x.ShipmentId
+	}
+	return ""
+}
+
+func (x *UpdateShipmentStatusRequest) GetEvent() *TrackingEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type UpdateShipmentStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shipment      *Shipment              `protobuf:"bytes,1,opt,name=shipment,proto3" json:"shipment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateShipmentStatusResponse) Reset() {
+	*x = UpdateShipmentStatusResponse{}
+	mi := &file_shipping_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateShipmentStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateShipmentStatusResponse) ProtoMessage() {}
+
+func (x *UpdateShipmentStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateShipmentStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateShipmentStatusResponse) Descriptor() ([]byte, []int) {
+	return file_shipping_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateShipmentStatusResponse) GetShipment() *Shipment {
+	if x != nil {
+		return x.Shipment
+	}
+	return nil
+}
+
+type WatchShipmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShipmentId    string                 `protobuf:"bytes,1,opt,name=shipment_id,json=shipmentId,proto3" json:"shipment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchShipmentRequest) Reset() {
+	*x = WatchShipmentRequest{}
+	mi := &file_shipping_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchShipmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchShipmentRequest) ProtoMessage() {}
+
+func (x *WatchShipmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchShipmentRequest.ProtoReflect.Descriptor instead.
+func (*WatchShipmentRequest) Descriptor() ([]byte, []int) {
+	return file_shipping_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *WatchShipmentRequest) GetShipmentId() string {
+	if x != nil {
+		return x.ShipmentId
+	}
+	return ""
+}
+
+var File_shipping_proto protoreflect.FileDescriptor
+
+const file_shipping_proto_rawDesc = "" +
+	"\n" +
+	"\x0eshipping.proto\x12\x17go.escape.ship.proto.v1\x1a\vcodes.proto\x1a\fcommon.proto\x1a\x1cgoogle/api/annotations.proto\"\xb8\x01\n" +
+	"\aAddress\x12\x1c\n" +
+	"\trecipient\x18\x01 \x01(\tR\trecipient\x12\x14\n" +
+	"\x05phone\x18\x02 \x01(\tR\x05phone\x12\x1f\n" +
+	"\vpostal_code\x18\x03 \x01(\tR\n" +
+	"postalCode\x12\x14\n" +
+	"\x05line1\x18\x04 \x01(\tR\x05line1\x12\x14\n" +
+	"\x05line2\x18\x05 \x01(\tR\x05line2\x12\x12\n" +
+	"\x04city\x18\x06 \x01(\tR\x04city\x12\x18\n" +
+	"\acountry\x18\a \x01(\tR\acountry\"\xaf\x01\n" +
+	"\rTrackingEvent\x12?\n" +
+	"\x06status\x18\x01 \x01(\x0e2'.go.escape.ship.proto.v1.ShipmentStatusR\x06status\x12\x1a\n" +
+	"\blocation\x18\x02 \x01(\tR\blocation\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1f\n" +
+	"\voccurred_at\x18\x04 \x01(\tR\n" +
+	"occurredAt\"N\n" +
+	"\fShipmentItem\x12\"\n" +
+	"\rorder_item_id\x18\x01 \x01(\tR\vorderItemId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\"\xc0\x04\n" +
+	"\bShipment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12:\n" +
+	"\acarrier\x18\x03 \x01(\x0e2 .go.escape.ship.proto.v1.CarrierR\acarrier\x12'\n" +
+	"\x0ftracking_number\x18\x04 \x01(\tR\x0etrackingNumber\x12?\n" +
+	"\x06status\x18\x05 \x01(\x0e2'.go.escape.ship.proto.v1.ShipmentStatusR\x06status\x12:\n" +
+	"\aaddress\x18\x06 \x01(\v2 .go.escape.ship.proto.v1.AddressR\aaddress\x12;\n" +
+	"\x05items\x18\a \x03(\v2%.go.escape.ship.proto.v1.ShipmentItemR\x05items\x12>\n" +
+	"\x06events\x18\b \x03(\v2&.go.escape.ship.proto.v1.TrackingEventR\x06events\x12!\n" +
+	"\ftracking_url\x18\t \x01(\tR\vtrackingUrl\x12E\n" +
+	"\bestimate\x18\n" +
+	" \x01(\v2).go.escape.ship.proto.v1.DeliveryEstimateR\bestimate\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\tR\tcreatedAt\x12!\n" +
+	"\fdelivered_at\x18\f \x01(\tR\vdeliveredAt\"\x90\x02\n" +
+	"\x15CreateShipmentRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12:\n" +
+	"\acarrier\x18\x02 \x01(\x0e2 .go.escape.ship.proto.v1.CarrierR\acarrier\x12'\n" +
+	"\x0ftracking_number\x18\x03 \x01(\tR\x0etrackingNumber\x12:\n" +
+	"\aaddress\x18\x04 \x01(\v2 .go.escape.ship.proto.v1.AddressR\aaddress\x12;\n" +
+	"\x05items\x18\x05 \x03(\v2%.go.escape.ship.proto.v1.ShipmentItemR\x05items\"W\n" +
+	"\x16CreateShipmentResponse\x12=\n" +
+	"\bshipment\x18\x01 \x01(\v2!.go.escape.ship.proto.v1.ShipmentR\bshipment\"9\n" +
+	"\x16GetTrackingInfoRequest\x12\x1f\n" +
+	"\vshipment_id\x18\x01 \x01(\tR\n" +
+	"shipmentId\"X\n" +
+	"\x17GetTrackingInfoResponse\x12=\n" +
+	"\bshipment\x18\x01 \x01(\v2!.go.escape.ship.proto.v1.ShipmentR\bshipment\"|\n" +
+	"\x1bUpdateShipmentStatusRequest\x12\x1f\n" +
+	"\vshipment_id\x18\x01 \x01(\tR\n" +
+	"shipmentId\x12<\n" +
+	"\x05event\x18\x02 \x01(\v2&.go.escape.ship.proto.v1.TrackingEventR\x05event\"]\n" +
+	"\x1cUpdateShipmentStatusResponse\x12=\n" +
+	"\bshipment\x18\x01 \x01(\v2!.go.escape.ship.proto.v1.ShipmentR\bshipment\"7\n" +
+	"\x14WatchShipmentRequest\x12\x1f\n" +
+	"\vshipment_id\x18\x01 \x01(\tR\n" +
+	"shipmentId*\x93\x02\n" +
+	"\x0eShipmentStatus\x12\x1f\n" +
+	"\x1bSHIPMENT_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15SHIPMENT_STATUS_READY\x10\x01\x12\x1d\n" +
+	"\x19SHIPMENT_STATUS_PICKED_UP\x10\x02\x12\x1e\n" +
+	"\x1aSHIPMENT_STATUS_IN_TRANSIT\x10\x03\x12$\n" +
+	" SHIPMENT_STATUS_OUT_FOR_DELIVERY\x10\x04\x12\x1d\n" +
+	"\x19SHIPMENT_STATUS_DELIVERED\x10\x05\x12#\n" +
+	"\x1fSHIPMENT_STATUS_DELIVERY_FAILED\x10\x06\x12\x1c\n" +
+	"\x18SHIPMENT_STATUS_RETURNED\x10\a2\x8f\x05\n" +
+	"\x0fShippingService\x12\x8b\x01\n" +
+	"\x0eCreateShipment\x12..go.escape.ship.proto.v1.CreateShipmentRequest\x1a/.go.escape.ship.proto.v1.CreateShipmentResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/shipments\x12\xa2\x01\n" +
+	"\x0fGetTrackingInfo\x12/.go.escape.ship.proto.v1.GetTrackingInfoRequest\x1a0.go.escape.ship.proto.v1.GetTrackingInfoResponse\",\x82\xd3\xe4\x93\x02&\x12$/v1/shipments/{shipment_id}/tracking\x12\xb2\x01\n" +
+	"\x14UpdateShipmentStatus\x124.go.escape.ship.proto.v1.UpdateShipmentStatusRequest\x1a5.go.escape.ship.proto.v1.UpdateShipmentStatusResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/shipments/{shipment_id}/status\x12\x93\x01\n" +
+	"\rWatchShipment\x12-.go.escape.ship.proto.v1.WatchShipmentRequest\x1a&.go.escape.ship.proto.v1.TrackingEvent\")\x82\xd3\xe4\x93\x02#\x12!/v1/shipments/{shipment_id}/watch0\x01B#Z!github.com/escape-ship/protos/genb\x06proto3"
+
+var (
+	file_shipping_proto_rawDescOnce sync.Once
+	file_shipping_proto_rawDescData []byte
+)
+
+func file_shipping_proto_rawDescGZIP() []byte {
+	file_shipping_proto_rawDescOnce.Do(func() {
+		file_shipping_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_shipping_proto_rawDesc), len(file_shipping_proto_rawDesc)))
+	})
+	return file_shipping_proto_rawDescData
+}
+
+var file_shipping_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_shipping_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_shipping_proto_goTypes = []any{
+	(ShipmentStatus)(0),                  // 0: go.escape.ship.proto.v1.ShipmentStatus
+	(*Address)(nil),                      // 1: go.escape.ship.proto.v1.Address
+	(*TrackingEvent)(nil),                // 2: go.escape.ship.proto.v1.TrackingEvent
+	(*ShipmentItem)(nil),                 // 3: go.escape.ship.proto.v1.ShipmentItem
+	(*Shipment)(nil),                     // 4: go.escape.ship.proto.v1.Shipment
+	(*CreateShipmentRequest)(nil),        // 5: go.escape.ship.proto.v1.CreateShipmentRequest
+	(*CreateShipmentResponse)(nil),       // 6: go.escape.ship.proto.v1.CreateShipmentResponse
+	(*GetTrackingInfoRequest)(nil),       // 7: go.escape.ship.proto.v1.GetTrackingInfoRequest
+	(*GetTrackingInfoResponse)(nil),      // 8: go.escape.ship.proto.v1.GetTrackingInfoResponse
+	(*UpdateShipmentStatusRequest)(nil),  // 9: go.escape.ship.proto.v1.UpdateShipmentStatusRequest
+	(*UpdateShipmentStatusResponse)(nil), // 10: go.escape.ship.proto.v1.UpdateShipmentStatusResponse
+	(*WatchShipmentRequest)(nil),         // 11: go.escape.ship.proto.v1.WatchShipmentRequest
+	(Carrier)(0),                         // 12: go.escape.ship.proto.v1.Carrier
+	(*DeliveryEstimate)(nil),             // 13: go.escape.ship.proto.v1.DeliveryEstimate
+}
+var file_shipping_proto_depIdxs = []int32{
+	0,  // 0: go.escape.ship.proto.v1.TrackingEvent.status:type_name -> go.escape.ship.proto.v1.ShipmentStatus
+	12, // 1: go.escape.ship.proto.v1.Shipment.carrier:type_name -> go.escape.ship.proto.v1.Carrier
+	0,  // 2: go.escape.ship.proto.v1.Shipment.status:type_name -> go.escape.ship.proto.v1.ShipmentStatus
+	1,  // 3: go.escape.ship.proto.v1.Shipment.address:type_name -> go.escape.ship.proto.v1.Address
+	3,  // 4: go.escape.ship.proto.v1.Shipment.items:type_name -> go.escape.ship.proto.v1.ShipmentItem
+	2,  // 5: go.escape.ship.proto.v1.Shipment.events:type_name -> go.escape.ship.proto.v1.TrackingEvent
+	13, // 6: go.escape.ship.proto.v1.Shipment.estimate:type_name -> go.escape.ship.proto.v1.DeliveryEstimate
+	12, // 7: go.escape.ship.proto.v1.CreateShipmentRequest.carrier:type_name -> go.escape.ship.proto.v1.Carrier
+	1,  // 8: go.escape.ship.proto.v1.CreateShipmentRequest.address:type_name -> go.escape.ship.proto.v1.Address
+	3,  // 9: go.escape.ship.proto.v1.CreateShipmentRequest.items:type_name -> go.escape.ship.proto.v1.ShipmentItem
+	4,  // 10: go.escape.ship.proto.v1.CreateShipmentResponse.shipment:type_name -> go.escape.ship.proto.v1.Shipment
+	4,  // 11: go.escape.ship.proto.v1.GetTrackingInfoResponse.shipment:type_name -> go.escape.ship.proto.v1.Shipment
+	2,  // 12: go.escape.ship.proto.v1.UpdateShipmentStatusRequest.event:type_name -> go.escape.ship.proto.v1.TrackingEvent
+	4,  // 13: go.escape.ship.proto.v1.UpdateShipmentStatusResponse.shipment:type_name -> go.escape.ship.proto.v1.Shipment
+	5,  // 14: go.escape.ship.proto.v1.ShippingService.CreateShipment:input_type -> go.escape.ship.proto.v1.CreateShipmentRequest
+	7,  // 15: go.escape.ship.proto.v1.ShippingService.GetTrackingInfo:input_type -> go.escape.ship.proto.v1.GetTrackingInfoRequest
+	9,  // 16: go.escape.ship.proto.v1.ShippingService.UpdateShipmentStatus:input_type -> go.escape.ship.proto.v1.UpdateShipmentStatusRequest
+	11, // 17: go.escape.ship.proto.v1.ShippingService.WatchShipment:input_type -> go.escape.ship.proto.v1.WatchShipmentRequest
+	6,  // 18: go.escape.ship.proto.v1.ShippingService.CreateShipment:output_type -> go.escape.ship.proto.v1.CreateShipmentResponse
+	8,  // 19: go.escape.ship.proto.v1.ShippingService.GetTrackingInfo:output_type -> go.escape.ship.proto.v1.GetTrackingInfoResponse
+	10, // 20: go.escape.ship.proto.v1.ShippingService.UpdateShipmentStatus:output_type -> go.escape.ship.proto.v1.UpdateShipmentStatusResponse
+	2,  // 21: go.escape.ship.proto.v1.ShippingService.WatchShipment:output_type -> go.escape.ship.proto.v1.TrackingEvent
+	18, // [18:22] is the sub-list for method output_type
+	14, // [14:18] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_shipping_proto_init() }
+func file_shipping_proto_init() {
+	if File_shipping_proto != nil {
+		return
+	}
+	file_codes_proto_init()
+	file_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_shipping_proto_rawDesc), len(file_shipping_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_shipping_proto_goTypes,
+		DependencyIndexes: file_shipping_proto_depIdxs,
+		EnumInfos:         file_shipping_proto_enumTypes,
+		MessageInfos:      file_shipping_proto_msgTypes,
+	}.Build()
+	File_shipping_proto = out.File
+	file_shipping_proto_goTypes = nil
+	file_shipping_proto_depIdxs = nil
+}
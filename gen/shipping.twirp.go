@@ -0,0 +1,1430 @@
+// Code generated by protoc-gen-twirp v8.1.3, DO NOT EDIT.
+// source: shipping.proto
+
+package gen
+
+import context "context"
+import fmt "fmt"
+import http "net/http"
+import io "io"
+import json "encoding/json"
+import strconv "strconv"
+import strings "strings"
+
+import protojson "google.golang.org/protobuf/encoding/protojson"
+import proto "google.golang.org/protobuf/proto"
+import twirp "github.com/twitchtv/twirp"
+import ctxsetters "github.com/twitchtv/twirp/ctxsetters"
+
+// Version compatibility assertion.
+// If the constant is not defined in the package, that likely means
+// the package needs to be updated to work with this generated code.
+// See https://twitchtv.github.io/twirp/docs/version_matrix.html
+const _ = twirp.TwirpPackageMinVersion_8_1_0
+
+// =========================
+// ShippingService Interface
+// =========================
+
+// 출고 및 택배 배송 추적
+type ShippingService interface {
+	// 송장 등록 (주문 항목 일부만 출고하는 분할 배송 지원)
+	CreateShipment(context.Context, *CreateShipmentRequest) (*CreateShipmentResponse, error)
+
+	// 배송 상태와 추적 이력, 택배사 조회 링크 반환
+	GetTrackingInfo(context.Context, *GetTrackingInfoRequest) (*GetTrackingInfoResponse, error)
+
+	// 택배사 웹훅/스마트택배 폴링 결과 반영 (SHIPPED/DELIVERED 시 주문 상태도 변경)
+	UpdateShipmentStatus(context.Context, *UpdateShipmentStatusRequest) (*UpdateShipmentStatusResponse, error)
+
+	// 추적 이벤트를 실시간으로 전달 (GetTrackingInfo 폴링 대체)
+	// 구독 직후 지금까지의 이벤트를 보내고, 이후 새 이벤트마다 전달하며 배송 완료/반송 시 종료
+	// 게이트웨이에서는 Accept: text/event-stream 요청 시 SSE로 응답
+	WatchShipment(context.Context, *WatchShipmentRequest) (*TrackingEvent, error)
+}
+
+// ===============================
+// ShippingService Protobuf Client
+// ===============================
+
+type shippingServiceProtobufClient struct {
+	client      HTTPClient
+	urls        [4]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewShippingServiceProtobufClient creates a Protobuf client that implements the ShippingService interface.
+// It communicates using Protobuf and can be configured with a custom HTTPClient.
+func NewShippingServiceProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) ShippingService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "go.escape.ship.proto.v1", "ShippingService")
+	urls := [4]string{
+		serviceURL + "CreateShipment",
+		serviceURL + "GetTrackingInfo",
+		serviceURL + "UpdateShipmentStatus",
+		serviceURL + "WatchShipment",
+	}
+
+	return &shippingServiceProtobufClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *shippingServiceProtobufClient) CreateShipment(ctx context.Context, in *CreateShipmentRequest) (*CreateShipmentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "ShippingService")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateShipment")
+	caller := c.callCreateShipment
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateShipmentRequest) (*CreateShipmentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateShipmentRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateShipmentRequest) when calling interceptor")
+					}
+					return c.callCreateShipment(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateShipmentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateShipmentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shippingServiceProtobufClient) callCreateShipment(ctx context.Context, in *CreateShipmentRequest) (*CreateShipmentResponse, error) {
+	out := new(CreateShipmentResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shippingServiceProtobufClient) GetTrackingInfo(ctx context.Context, in *GetTrackingInfoRequest) (*GetTrackingInfoResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "ShippingService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetTrackingInfo")
+	caller := c.callGetTrackingInfo
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetTrackingInfoRequest) (*GetTrackingInfoResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetTrackingInfoRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetTrackingInfoRequest) when calling interceptor")
+					}
+					return c.callGetTrackingInfo(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetTrackingInfoResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetTrackingInfoResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shippingServiceProtobufClient) callGetTrackingInfo(ctx context.Context, in *GetTrackingInfoRequest) (*GetTrackingInfoResponse, error) {
+	out := new(GetTrackingInfoResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shippingServiceProtobufClient) UpdateShipmentStatus(ctx context.Context, in *UpdateShipmentStatusRequest) (*UpdateShipmentStatusResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "ShippingService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateShipmentStatus")
+	caller := c.callUpdateShipmentStatus
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateShipmentStatusRequest) (*UpdateShipmentStatusResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateShipmentStatusRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateShipmentStatusRequest) when calling interceptor")
+					}
+					return c.callUpdateShipmentStatus(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateShipmentStatusResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateShipmentStatusResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shippingServiceProtobufClient) callUpdateShipmentStatus(ctx context.Context, in *UpdateShipmentStatusRequest) (*UpdateShipmentStatusResponse, error) {
+	out := new(UpdateShipmentStatusResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shippingServiceProtobufClient) WatchShipment(ctx context.Context, in *WatchShipmentRequest) (*TrackingEvent, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "ShippingService")
+	ctx = ctxsetters.WithMethodName(ctx, "WatchShipment")
+	caller := c.callWatchShipment
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *WatchShipmentRequest) (*TrackingEvent, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*WatchShipmentRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*WatchShipmentRequest) when calling interceptor")
+					}
+					return c.callWatchShipment(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TrackingEvent)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TrackingEvent) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shippingServiceProtobufClient) callWatchShipment(ctx context.Context, in *WatchShipmentRequest) (*TrackingEvent, error) {
+	out := new(TrackingEvent)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ===========================
+// ShippingService JSON Client
+// ===========================
+
+type shippingServiceJSONClient struct {
+	client      HTTPClient
+	urls        [4]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewShippingServiceJSONClient creates a JSON client that implements the ShippingService interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewShippingServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) ShippingService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "go.escape.ship.proto.v1", "ShippingService")
+	urls := [4]string{
+		serviceURL + "CreateShipment",
+		serviceURL + "GetTrackingInfo",
+		serviceURL + "UpdateShipmentStatus",
+		serviceURL + "WatchShipment",
+	}
+
+	return &shippingServiceJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *shippingServiceJSONClient) CreateShipment(ctx context.Context, in *CreateShipmentRequest) (*CreateShipmentResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "ShippingService")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateShipment")
+	caller := c.callCreateShipment
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateShipmentRequest) (*CreateShipmentResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateShipmentRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateShipmentRequest) when calling interceptor")
+					}
+					return c.callCreateShipment(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateShipmentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateShipmentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shippingServiceJSONClient) callCreateShipment(ctx context.Context, in *CreateShipmentRequest) (*CreateShipmentResponse, error) {
+	out := new(CreateShipmentResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shippingServiceJSONClient) GetTrackingInfo(ctx context.Context, in *GetTrackingInfoRequest) (*GetTrackingInfoResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "ShippingService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetTrackingInfo")
+	caller := c.callGetTrackingInfo
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetTrackingInfoRequest) (*GetTrackingInfoResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetTrackingInfoRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetTrackingInfoRequest) when calling interceptor")
+					}
+					return c.callGetTrackingInfo(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetTrackingInfoResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetTrackingInfoResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shippingServiceJSONClient) callGetTrackingInfo(ctx context.Context, in *GetTrackingInfoRequest) (*GetTrackingInfoResponse, error) {
+	out := new(GetTrackingInfoResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shippingServiceJSONClient) UpdateShipmentStatus(ctx context.Context, in *UpdateShipmentStatusRequest) (*UpdateShipmentStatusResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "ShippingService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateShipmentStatus")
+	caller := c.callUpdateShipmentStatus
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateShipmentStatusRequest) (*UpdateShipmentStatusResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateShipmentStatusRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateShipmentStatusRequest) when calling interceptor")
+					}
+					return c.callUpdateShipmentStatus(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateShipmentStatusResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateShipmentStatusResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shippingServiceJSONClient) callUpdateShipmentStatus(ctx context.Context, in *UpdateShipmentStatusRequest) (*UpdateShipmentStatusResponse, error) {
+	out := new(UpdateShipmentStatusResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shippingServiceJSONClient) WatchShipment(ctx context.Context, in *WatchShipmentRequest) (*TrackingEvent, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "ShippingService")
+	ctx = ctxsetters.WithMethodName(ctx, "WatchShipment")
+	caller := c.callWatchShipment
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *WatchShipmentRequest) (*TrackingEvent, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*WatchShipmentRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*WatchShipmentRequest) when calling interceptor")
+					}
+					return c.callWatchShipment(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TrackingEvent)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TrackingEvent) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shippingServiceJSONClient) callWatchShipment(ctx context.Context, in *WatchShipmentRequest) (*TrackingEvent, error) {
+	out := new(TrackingEvent)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ==============================
+// ShippingService Server Handler
+// ==============================
+
+type shippingServiceServer struct {
+	ShippingService
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewShippingServiceServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewShippingServiceServer(svc ShippingService, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	return &shippingServiceServer{
+		ShippingService:  svc,
+		hooks:            serverOpts.Hooks,
+		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:       pathPrefix,
+		jsonSkipDefaults: jsonSkipDefaults,
+		jsonCamelCase:    jsonCamelCase,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *shippingServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *shippingServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
+
+// ShippingServicePathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const ShippingServicePathPrefix = "/twirp/go.escape.ship.proto.v1.ShippingService/"
+
+func (s *shippingServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "ShippingService")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "go.escape.ship.proto.v1.ShippingService" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "CreateShipment":
+		s.serveCreateShipment(ctx, resp, req)
+		return
+	case "GetTrackingInfo":
+		s.serveGetTrackingInfo(ctx, resp, req)
+		return
+	case "UpdateShipmentStatus":
+		s.serveUpdateShipmentStatus(ctx, resp, req)
+		return
+	case "WatchShipment":
+		s.serveWatchShipment(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *shippingServiceServer) serveCreateShipment(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveCreateShipmentJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveCreateShipmentProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *shippingServiceServer) serveCreateShipmentJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateShipment")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(CreateShipmentRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ShippingService.CreateShipment
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateShipmentRequest) (*CreateShipmentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateShipmentRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateShipmentRequest) when calling interceptor")
+					}
+					return s.ShippingService.CreateShipment(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateShipmentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateShipmentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreateShipmentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateShipmentResponse and nil error while calling CreateShipment. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shippingServiceServer) serveCreateShipmentProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateShipment")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(CreateShipmentRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ShippingService.CreateShipment
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateShipmentRequest) (*CreateShipmentResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateShipmentRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateShipmentRequest) when calling interceptor")
+					}
+					return s.ShippingService.CreateShipment(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateShipmentResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateShipmentResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreateShipmentResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateShipmentResponse and nil error while calling CreateShipment. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shippingServiceServer) serveGetTrackingInfo(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetTrackingInfoJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetTrackingInfoProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *shippingServiceServer) serveGetTrackingInfoJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetTrackingInfo")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetTrackingInfoRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ShippingService.GetTrackingInfo
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetTrackingInfoRequest) (*GetTrackingInfoResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetTrackingInfoRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetTrackingInfoRequest) when calling interceptor")
+					}
+					return s.ShippingService.GetTrackingInfo(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetTrackingInfoResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetTrackingInfoResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetTrackingInfoResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetTrackingInfoResponse and nil error while calling GetTrackingInfo. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shippingServiceServer) serveGetTrackingInfoProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetTrackingInfo")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetTrackingInfoRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ShippingService.GetTrackingInfo
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetTrackingInfoRequest) (*GetTrackingInfoResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetTrackingInfoRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetTrackingInfoRequest) when calling interceptor")
+					}
+					return s.ShippingService.GetTrackingInfo(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetTrackingInfoResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetTrackingInfoResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetTrackingInfoResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetTrackingInfoResponse and nil error while calling GetTrackingInfo. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shippingServiceServer) serveUpdateShipmentStatus(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveUpdateShipmentStatusJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveUpdateShipmentStatusProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *shippingServiceServer) serveUpdateShipmentStatusJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateShipmentStatus")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(UpdateShipmentStatusRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ShippingService.UpdateShipmentStatus
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateShipmentStatusRequest) (*UpdateShipmentStatusResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateShipmentStatusRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateShipmentStatusRequest) when calling interceptor")
+					}
+					return s.ShippingService.UpdateShipmentStatus(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateShipmentStatusResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateShipmentStatusResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UpdateShipmentStatusResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpdateShipmentStatusResponse and nil error while calling UpdateShipmentStatus. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shippingServiceServer) serveUpdateShipmentStatusProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateShipmentStatus")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(UpdateShipmentStatusRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ShippingService.UpdateShipmentStatus
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateShipmentStatusRequest) (*UpdateShipmentStatusResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateShipmentStatusRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateShipmentStatusRequest) when calling interceptor")
+					}
+					return s.ShippingService.UpdateShipmentStatus(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateShipmentStatusResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateShipmentStatusResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UpdateShipmentStatusResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpdateShipmentStatusResponse and nil error while calling UpdateShipmentStatus. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shippingServiceServer) serveWatchShipment(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveWatchShipmentJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveWatchShipmentProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *shippingServiceServer) serveWatchShipmentJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "WatchShipment")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(WatchShipmentRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ShippingService.WatchShipment
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *WatchShipmentRequest) (*TrackingEvent, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*WatchShipmentRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*WatchShipmentRequest) when calling interceptor")
+					}
+					return s.ShippingService.WatchShipment(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TrackingEvent)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TrackingEvent) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TrackingEvent
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TrackingEvent and nil error while calling WatchShipment. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shippingServiceServer) serveWatchShipmentProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "WatchShipment")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(WatchShipmentRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ShippingService.WatchShipment
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *WatchShipmentRequest) (*TrackingEvent, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*WatchShipmentRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*WatchShipmentRequest) when calling interceptor")
+					}
+					return s.ShippingService.WatchShipment(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*TrackingEvent)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*TrackingEvent) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *TrackingEvent
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TrackingEvent and nil error while calling WatchShipment. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shippingServiceServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor12, 0
+}
+
+func (s *shippingServiceServer) ProtocGenTwirpVersion() string {
+	return "v8.1.3"
+}
+
+// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
+// that is everything in a Twirp route except for the <Method>. This can be used for routing,
+// for example to identify the requests that are targeted to this service in a mux.
+func (s *shippingServiceServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "go.escape.ship.proto.v1", "ShippingService")
+}
+
+var twirpFileDescriptor12 = []byte{
+	// 1025 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdf, 0x6e, 0xe3, 0xc4,
+	0x17, 0xfe, 0x39, 0xcd, 0xbf, 0x9e, 0xb4, 0x69, 0x34, 0xea, 0xee, 0xba, 0xd9, 0xee, 0xaf, 0xa9,
+	0xbb, 0x6c, 0x77, 0x57, 0x34, 0xde, 0x06, 0x10, 0xa2, 0xfc, 0x53, 0x48, 0x5c, 0xb0, 0x58, 0xb2,
+	0x95, 0x93, 0xb0, 0x14, 0x09, 0x59, 0xae, 0x3d, 0x24, 0x16, 0x89, 0xc7, 0x3b, 0x9e, 0x04, 0x55,
+	0xc0, 0x0d, 0xb7, 0x5c, 0x80, 0xd4, 0x37, 0xe0, 0x05, 0x90, 0xb8, 0xe2, 0x92, 0x77, 0xe0, 0x15,
+	0x78, 0x10, 0xe4, 0xf1, 0x38, 0x4d, 0xb2, 0x71, 0x9b, 0x5d, 0xf5, 0x2e, 0xf3, 0x9d, 0xf3, 0xcd,
+	0xf9, 0xce, 0x99, 0xef, 0xb8, 0x85, 0x62, 0xd0, 0x77, 0x7d, 0xdf, 0xf5, 0x7a, 0x55, 0x9f, 0x12,
+	0x46, 0xd0, 0x9d, 0x1e, 0xa9, 0xe2, 0xc0, 0xb6, 0x7c, 0x5c, 0x0d, 0x23, 0x11, 0x5a, 0x1d, 0x1f,
+	0x96, 0x0b, 0x36, 0x71, 0x70, 0x10, 0x9d, 0xcb, 0x6b, 0x36, 0x19, 0x0e, 0x89, 0x27, 0x4e, 0xdb,
+	0x3d, 0x42, 0x7a, 0x03, 0xac, 0x5a, 0xbe, 0xab, 0x5a, 0x9e, 0x47, 0x98, 0xc5, 0x5c, 0xe2, 0x89,
+	0x5c, 0xe5, 0x2f, 0x09, 0x72, 0x75, 0xc7, 0xa1, 0x38, 0x08, 0xd0, 0x36, 0xac, 0x52, 0x6c, 0xbb,
+	0xbe, 0x8b, 0x3d, 0x26, 0x4b, 0x15, 0xe9, 0xe1, 0xaa, 0x71, 0x09, 0xa0, 0x4d, 0xc8, 0xf8, 0x7d,
+	0xe2, 0x61, 0x39, 0xc5, 0x23, 0xd1, 0x01, 0xed, 0x40, 0xc1, 0x27, 0x01, 0xb3, 0x06, 0x66, 0xa8,
+	0x40, 0x5e, 0xe1, 0x31, 0x88, 0xa0, 0x06, 0x71, 0x70, 0x48, 0x1b, 0xb8, 0x1e, 0x3e, 0x94, 0xd3,
+	0x11, 0x8d, 0x1f, 0x62, 0xb4, 0x26, 0x67, 0x2e, 0xd1, 0x1a, 0x42, 0x90, 0xb6, 0x5d, 0x76, 0x2e,
+	0x67, 0x39, 0xc8, 0x7f, 0x23, 0x19, 0x72, 0x36, 0x19, 0x79, 0x8c, 0x9e, 0xcb, 0x39, 0x0e, 0xc7,
+	0x47, 0xe5, 0x0f, 0x09, 0xd6, 0x3b, 0xd4, 0xb2, 0xbf, 0x73, 0xbd, 0x9e, 0x36, 0x0e, 0x25, 0x7e,
+	0x0c, 0xd9, 0x80, 0x59, 0x6c, 0x14, 0x70, 0xf5, 0xc5, 0xda, 0x7e, 0x35, 0x61, 0x5e, 0xd5, 0x76,
+	0xdf, 0xf5, 0x87, 0xd8, 0x63, 0x6d, 0x9e, 0x6e, 0x08, 0x1a, 0x2a, 0x43, 0x7e, 0x40, 0x6c, 0x3e,
+	0x20, 0xd1, 0xe6, 0xe4, 0x8c, 0x2a, 0x50, 0x70, 0x70, 0x60, 0x53, 0xd7, 0xe7, 0xe1, 0xa8, 0xd3,
+	0x69, 0x28, 0x9c, 0x05, 0xb1, 0xed, 0x11, 0xa5, 0xd8, 0x31, 0x2d, 0x26, 0x1a, 0x86, 0x18, 0xaa,
+	0x33, 0xa5, 0x05, 0x6b, 0x71, 0x61, 0x9d, 0xe1, 0x21, 0x52, 0x60, 0x9d, 0x50, 0x07, 0x53, 0xd3,
+	0x65, 0x78, 0x68, 0xba, 0x8e, 0x18, 0x7a, 0x81, 0x83, 0x61, 0x86, 0xee, 0x84, 0x92, 0x5e, 0x8c,
+	0x2c, 0x8f, 0x85, 0x73, 0x09, 0x25, 0x65, 0x8c, 0xc9, 0x59, 0xf9, 0x3b, 0x0d, 0xf9, 0xf8, 0x42,
+	0x54, 0x84, 0xd4, 0xe4, 0x86, 0x94, 0xeb, 0xa0, 0x2d, 0xc8, 0x8b, 0xcb, 0x1d, 0xd1, 0x4b, 0x2e,
+	0xba, 0xd7, 0x41, 0x47, 0x90, 0xb3, 0x2d, 0x4a, 0x5d, 0x4c, 0x79, 0x1b, 0xc5, 0x5a, 0x25, 0x71,
+	0x50, 0x8d, 0x28, 0xcf, 0x88, 0x09, 0x68, 0x1f, 0x36, 0x98, 0x18, 0xba, 0xe9, 0x8d, 0x86, 0x67,
+	0x98, 0x8a, 0x46, 0x8b, 0x31, 0xdc, 0xe2, 0xe8, 0xd4, 0x63, 0x64, 0x5e, 0xef, 0x31, 0x8e, 0x20,
+	0x67, 0x45, 0xce, 0xe4, 0x86, 0x28, 0x5c, 0xa1, 0x52, 0x38, 0xd8, 0x88, 0x09, 0xe8, 0x7d, 0xc8,
+	0x84, 0x33, 0x0d, 0xe4, 0x5c, 0x65, 0xe5, 0x61, 0xa1, 0xf6, 0xc6, 0xb5, 0xb5, 0xc3, 0x69, 0x1b,
+	0x11, 0x07, 0x7d, 0x04, 0x59, 0x1c, 0xfa, 0x29, 0x90, 0xf3, 0x9c, 0xfd, 0x20, 0x91, 0x3d, 0x63,
+	0x3f, 0x43, 0xb0, 0xd0, 0x2e, 0xac, 0x4d, 0x46, 0x34, 0xa2, 0x03, 0x79, 0x35, 0x7a, 0xd5, 0x18,
+	0xeb, 0xd2, 0x01, 0xd2, 0x20, 0x8f, 0x03, 0xe6, 0x0e, 0x2d, 0x86, 0x65, 0xe0, 0xcd, 0x3d, 0x4a,
+	0x2c, 0xd2, 0xc4, 0x03, 0x77, 0x8c, 0xe9, 0xb9, 0x26, 0x08, 0xc6, 0x84, 0x8a, 0xee, 0x01, 0xd8,
+	0x14, 0x5b, 0x2c, 0x32, 0x5c, 0x21, 0x5a, 0x59, 0x81, 0xd4, 0x59, 0x28, 0xc4, 0x89, 0xc8, 0x51,
+	0xc2, 0x5a, 0xec, 0x59, 0x81, 0xd5, 0x99, 0xf2, 0x5b, 0x0a, 0x6e, 0x35, 0x38, 0x21, 0x9e, 0x84,
+	0x81, 0x5f, 0x8c, 0x70, 0xc0, 0x66, 0xfc, 0x23, 0x25, 0xfa, 0x27, 0x75, 0x03, 0xfe, 0x59, 0x59,
+	0xe8, 0x9f, 0xa9, 0xe7, 0x4f, 0xbf, 0xf6, 0xf3, 0x67, 0x5e, 0xfd, 0xf9, 0x95, 0xe7, 0x70, 0x7b,
+	0x7e, 0x22, 0x81, 0x4f, 0xbc, 0x00, 0xa3, 0x0f, 0x21, 0x1f, 0x08, 0x8c, 0x8f, 0xa4, 0x50, 0xdb,
+	0xbd, 0xf6, 0x66, 0x63, 0x42, 0x51, 0xde, 0x83, 0xdb, 0x9f, 0x62, 0x16, 0x7b, 0x46, 0xf7, 0xbe,
+	0x25, 0xf1, 0xac, 0x77, 0xa0, 0x10, 0x67, 0x5d, 0x8e, 0x1b, 0x62, 0x48, 0x77, 0x94, 0xaf, 0xe0,
+	0xce, 0x4b, 0xd4, 0x9b, 0x11, 0xf5, 0x23, 0xdc, 0xed, 0xfa, 0xce, 0x54, 0xb7, 0x62, 0x0b, 0x97,
+	0x54, 0x86, 0x3e, 0x80, 0x0c, 0xb7, 0x3d, 0x77, 0xc2, 0xf2, 0xbb, 0x12, 0x91, 0x94, 0x6f, 0x60,
+	0x7b, 0x71, 0xf5, 0x9b, 0x69, 0xee, 0x5d, 0xd8, 0x7c, 0x6e, 0x31, 0xbb, 0x3f, 0xef, 0xed, 0xeb,
+	0xba, 0x7a, 0x7c, 0x91, 0x82, 0xe2, 0xac, 0x24, 0xb4, 0x03, 0x77, 0xdb, 0x9f, 0xe9, 0x27, 0x5f,
+	0x68, 0xad, 0x8e, 0xd9, 0xee, 0xd4, 0x3b, 0xdd, 0xb6, 0xd9, 0x6d, 0xb5, 0x4f, 0xb4, 0x86, 0x7e,
+	0xac, 0x6b, 0xcd, 0xd2, 0xff, 0xd0, 0x16, 0xdc, 0x9a, 0x4f, 0x30, 0xb4, 0x7a, 0xf3, 0xb4, 0x24,
+	0xa1, 0x7b, 0xb0, 0x35, 0x1f, 0x3a, 0xd1, 0x1b, 0x9f, 0x6b, 0x4d, 0xb3, 0x7b, 0x52, 0x4a, 0xa1,
+	0xff, 0x43, 0x79, 0x3e, 0xac, 0xb7, 0xcc, 0x8e, 0x51, 0x6f, 0xb5, 0xf5, 0x4e, 0x69, 0x05, 0xdd,
+	0x87, 0xca, 0x7c, 0xfc, 0x59, 0xb7, 0x63, 0x1e, 0x3f, 0x33, 0xcc, 0xa6, 0xf6, 0x54, 0xff, 0x52,
+	0x33, 0x4e, 0x4b, 0xe9, 0x45, 0x45, 0x44, 0x54, 0x6b, 0x96, 0x32, 0x68, 0x0f, 0x76, 0x12, 0xc2,
+	0xa7, 0xe6, 0x71, 0x5d, 0x7f, 0xaa, 0x35, 0x4b, 0x59, 0xb4, 0x0d, 0xf2, 0xcb, 0x3d, 0x74, 0xba,
+	0x46, 0x4b, 0x6b, 0x96, 0x72, 0xb5, 0x5f, 0x33, 0xb0, 0xd1, 0x16, 0xff, 0x91, 0xb4, 0x31, 0x1d,
+	0xbb, 0x36, 0x46, 0xbf, 0x48, 0x50, 0x9c, 0x5d, 0x17, 0x54, 0x4d, 0xfe, 0x1a, 0x2c, 0xfa, 0xd2,
+	0x94, 0xd5, 0xa5, 0xf3, 0x23, 0x57, 0x28, 0xf2, 0xcf, 0xff, 0xfc, 0x7b, 0x91, 0x42, 0x47, 0xd2,
+	0x63, 0x65, 0x5d, 0x1d, 0x1f, 0xaa, 0xf1, 0xc3, 0x05, 0xe8, 0x77, 0x09, 0x36, 0xe6, 0x16, 0x05,
+	0x25, 0x5f, 0xbf, 0x78, 0x1b, 0xcb, 0x4f, 0x96, 0x27, 0x08, 0x41, 0x6f, 0x72, 0x41, 0x0f, 0xd0,
+	0xfd, 0x19, 0x35, 0xea, 0x0f, 0x53, 0x26, 0xfb, 0x49, 0x8d, 0xbf, 0x6f, 0xe8, 0x4f, 0x09, 0x36,
+	0x17, 0xb9, 0x1e, 0xbd, 0x9d, 0x58, 0xf8, 0x8a, 0x15, 0x2d, 0xbf, 0xf3, 0x8a, 0x2c, 0xa1, 0xf9,
+	0x80, 0x6b, 0xde, 0x0f, 0x87, 0xa8, 0x5c, 0x25, 0x5b, 0xfc, 0x35, 0xbe, 0x90, 0x60, 0x7d, 0x66,
+	0x97, 0xd0, 0x41, 0x62, 0xdd, 0x45, 0x3b, 0x57, 0x5e, 0xf2, 0xcb, 0xa0, 0x3c, 0xe2, 0xba, 0xf6,
+	0xd0, 0xee, 0x55, 0xa2, 0xbe, 0x0f, 0x2b, 0x3c, 0x91, 0x3e, 0xd9, 0xfb, 0x7a, 0xb7, 0xe7, 0xb2,
+	0xfe, 0xe8, 0xac, 0x6a, 0x93, 0xa1, 0x1a, 0xdd, 0x7e, 0x10, 0x66, 0xaa, 0xfc, 0xf6, 0x40, 0xed,
+	0x61, 0xef, 0x2c, 0xcb, 0x7f, 0xbf, 0xf5, 0xdf, 0x00, 0xf9, 0x52, 0x11, 0xbb, 0x4e, 0x0b, 0x00,
+	0x00,
+}
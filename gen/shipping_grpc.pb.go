@@ -0,0 +1,255 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: shipping.proto
+
+package gen
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ShippingService_CreateShipment_FullMethodName       = "/go.escape.ship.proto.v1.ShippingService/CreateShipment"
+	ShippingService_GetTrackingInfo_FullMethodName      = "/go.escape.ship.proto.v1.ShippingService/GetTrackingInfo"
+	ShippingService_UpdateShipmentStatus_FullMethodName = "/go.escape.ship.proto.v1.ShippingService/UpdateShipmentStatus"
+	ShippingService_WatchShipment_FullMethodName        = "/go.escape.ship.proto.v1.ShippingService/WatchShipment"
+)
+
+// ShippingServiceClient is the client API for ShippingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 출고 및 택배 배송 추적
+type ShippingServiceClient interface {
+	// 송장 등록 (주문 항목 일부만 출고하는 분할 배송 지원)
+	CreateShipment(ctx context.Context, in *CreateShipmentRequest, opts ...grpc.CallOption) (*CreateShipmentResponse, error)
+	// 배송 상태와 추적 이력, 택배사 조회 링크 반환
+	GetTrackingInfo(ctx context.Context, in *GetTrackingInfoRequest, opts ...grpc.CallOption) (*GetTrackingInfoResponse, error)
+	// 택배사 웹훅/스마트택배 폴링 결과 반영 (SHIPPED/DELIVERED 시 주문 상태도 변경)
+	UpdateShipmentStatus(ctx context.Context, in *UpdateShipmentStatusRequest, opts ...grpc.CallOption) (*UpdateShipmentStatusResponse, error)
+	// 추적 이벤트를 실시간으로 전달 (GetTrackingInfo 폴링 대체)
+	// 구독 직후 지금까지의 이벤트를 보내고, 이후 새 이벤트마다 전달하며 배송 완료/반송 시 종료
+	// 게이트웨이에서는 Accept: text/event-stream 요청 시 SSE로 응답
+	WatchShipment(ctx context.Context, in *WatchShipmentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TrackingEvent], error)
+}
+
+type shippingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewShippingServiceClient(cc grpc.ClientConnInterface) ShippingServiceClient {
+	return &shippingServiceClient{cc}
+}
+
+func (c *shippingServiceClient) CreateShipment(ctx context.Context, in *CreateShipmentRequest, opts ...grpc.CallOption) (*CreateShipmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateShipmentResponse)
+	err := c.cc.Invoke(ctx, ShippingService_CreateShipment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shippingServiceClient) GetTrackingInfo(ctx context.Context, in *GetTrackingInfoRequest, opts ...grpc.CallOption) (*GetTrackingInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTrackingInfoResponse)
+	err := c.cc.Invoke(ctx, ShippingService_GetTrackingInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shippingServiceClient) UpdateShipmentStatus(ctx context.Context, in *UpdateShipmentStatusRequest, opts ...grpc.CallOption) (*UpdateShipmentStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateShipmentStatusResponse)
+	err := c.cc.Invoke(ctx, ShippingService_UpdateShipmentStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shippingServiceClient) WatchShipment(ctx context.Context, in *WatchShipmentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TrackingEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ShippingService_ServiceDesc.Streams[0], ShippingService_WatchShipment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchShipmentRequest, TrackingEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ShippingService_WatchShipmentClient = grpc.ServerStreamingClient[TrackingEvent]
+
+// ShippingServiceServer is the server API for ShippingService service.
+// All implementations must embed UnimplementedShippingServiceServer
+// for forward compatibility.
+//
+// 출고 및 택배 배송 추적
+type ShippingServiceServer interface {
+	// 송장 등록 (주문 항목 일부만 출고하는 분할 배송 지원)
+	CreateShipment(context.Context, *CreateShipmentRequest) (*CreateShipmentResponse, error)
+	// 배송 상태와 추적 이력, 택배사 조회 링크 반환
+	GetTrackingInfo(context.Context, *GetTrackingInfoRequest) (*GetTrackingInfoResponse, error)
+	// 택배사 웹훅/스마트택배 폴링 결과 반영 (SHIPPED/DELIVERED 시 주문 상태도 변경)
+	UpdateShipmentStatus(context.Context, *UpdateShipmentStatusRequest) (*UpdateShipmentStatusResponse, error)
+	// 추적 이벤트를 실시간으로 전달 (GetTrackingInfo 폴링 대체)
+	// 구독 직후 지금까지의 이벤트를 보내고, 이후 새 이벤트마다 전달하며 배송 완료/반송 시 종료
+	// 게이트웨이에서는 Accept: text/event-stream 요청 시 SSE로 응답
+	WatchShipment(*WatchShipmentRequest, grpc.ServerStreamingServer[TrackingEvent]) error
+	mustEmbedUnimplementedShippingServiceServer()
+}
+
+// UnimplementedShippingServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedShippingServiceServer struct{}
+
+func (UnimplementedShippingServiceServer) CreateShipment(context.Context, *CreateShipmentRequest) (*CreateShipmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateShipment not implemented")
+}
+func (UnimplementedShippingServiceServer) GetTrackingInfo(context.Context, *GetTrackingInfoRequest) (*GetTrackingInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrackingInfo not implemented")
+}
+func (UnimplementedShippingServiceServer) UpdateShipmentStatus(context.Context, *UpdateShipmentStatusRequest) (*UpdateShipmentStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateShipmentStatus not implemented")
+}
+func (UnimplementedShippingServiceServer) WatchShipment(*WatchShipmentRequest, grpc.ServerStreamingServer[TrackingEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchShipment not implemented")
+}
+func (UnimplementedShippingServiceServer) mustEmbedUnimplementedShippingServiceServer() {}
+func (UnimplementedShippingServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeShippingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ShippingServiceServer will
+// result in compilation errors.
+type UnsafeShippingServiceServer interface {
+	mustEmbedUnimplementedShippingServiceServer()
+}
+
+func RegisterShippingServiceServer(s grpc.ServiceRegistrar, srv ShippingServiceServer) {
+	// If the following call pancis, it indicates UnimplementedShippingServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ShippingService_ServiceDesc, srv)
+}
+
+func _ShippingService_CreateShipment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateShipmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShippingServiceServer).CreateShipment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShippingService_CreateShipment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShippingServiceServer).CreateShipment(ctx, req.(*CreateShipmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShippingService_GetTrackingInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrackingInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShippingServiceServer).GetTrackingInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShippingService_GetTrackingInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShippingServiceServer).GetTrackingInfo(ctx, req.(*GetTrackingInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShippingService_UpdateShipmentStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateShipmentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShippingServiceServer).UpdateShipmentStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShippingService_UpdateShipmentStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShippingServiceServer).UpdateShipmentStatus(ctx, req.(*UpdateShipmentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShippingService_WatchShipment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchShipmentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ShippingServiceServer).WatchShipment(m, &grpc.GenericServerStream[WatchShipmentRequest, TrackingEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ShippingService_WatchShipmentServer = grpc.ServerStreamingServer[TrackingEvent]
+
+// ShippingService_ServiceDesc is the grpc.ServiceDesc for ShippingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ShippingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "go.escape.ship.proto.v1.ShippingService",
+	HandlerType: (*ShippingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateShipment",
+			Handler:    _ShippingService_CreateShipment_Handler,
+		},
+		{
+			MethodName: "GetTrackingInfo",
+			Handler:    _ShippingService_GetTrackingInfo_Handler,
+		},
+		{
+			MethodName: "UpdateShipmentStatus",
+			Handler:    _ShippingService_UpdateShipmentStatus_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchShipment",
+			Handler:       _ShippingService_WatchShipment_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "shipping.proto",
+}
@@ -0,0 +1,80 @@
+// Code generated by protoc-gen-go-shim. DO NOT EDIT.
+// source: shipping.proto
+
+package gen
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	iter "iter"
+)
+
+// ShippingServiceAPI is ShippingServiceClient without per-call options, so it can be
+// mocked with plain method signatures. Streams are exposed as iterators.
+type ShippingServiceAPI interface {
+	// 송장 등록 (주문 항목 일부만 출고하는 분할 배송 지원)
+	CreateShipment(ctx context.Context, in *CreateShipmentRequest) (*CreateShipmentResponse, error)
+	// 배송 상태와 추적 이력, 택배사 조회 링크 반환
+	GetTrackingInfo(ctx context.Context, in *GetTrackingInfoRequest) (*GetTrackingInfoResponse, error)
+	// 택배사 웹훅/스마트택배 폴링 결과 반영 (SHIPPED/DELIVERED 시 주문 상태도 변경)
+	UpdateShipmentStatus(ctx context.Context, in *UpdateShipmentStatusRequest) (*UpdateShipmentStatusResponse, error)
+	// 추적 이벤트를 실시간으로 전달 (GetTrackingInfo 폴링 대체)
+	// 구독 직후 지금까지의 이벤트를 보내고, 이후 새 이벤트마다 전달하며 배송 완료/반송 시 종료
+	// 게이트웨이에서는 Accept: text/event-stream 요청 시 SSE로 응답
+	WatchShipment(ctx context.Context, in *WatchShipmentRequest) iter.Seq2[*TrackingEvent, error]
+}
+
+// NewShippingServiceAPI adapts c to ShippingServiceAPI, passing opts to every call.
+func NewShippingServiceAPI(c ShippingServiceClient, opts ...grpc.CallOption) ShippingServiceAPI {
+	return &shippingServiceAPI{c: c, opts: opts}
+}
+
+type shippingServiceAPI struct {
+	c    ShippingServiceClient
+	opts []grpc.CallOption
+}
+
+func (a *shippingServiceAPI) CreateShipment(ctx context.Context, in *CreateShipmentRequest) (*CreateShipmentResponse, error) {
+	return a.c.CreateShipment(ctx, in, a.opts...)
+}
+
+func (a *shippingServiceAPI) GetTrackingInfo(ctx context.Context, in *GetTrackingInfoRequest) (*GetTrackingInfoResponse, error) {
+	return a.c.GetTrackingInfo(ctx, in, a.opts...)
+}
+
+func (a *shippingServiceAPI) UpdateShipmentStatus(ctx context.Context, in *UpdateShipmentStatusRequest) (*UpdateShipmentStatusResponse, error) {
+	return a.c.UpdateShipmentStatus(ctx, in, a.opts...)
+}
+
+func (a *shippingServiceAPI) WatchShipment(ctx context.Context, in *WatchShipmentRequest) iter.Seq2[*TrackingEvent, error] {
+	return serverStreamSeq(ctx, func(ctx context.Context) (grpc.ServerStreamingClient[TrackingEvent], error) {
+		return a.c.WatchShipment(ctx, in, a.opts...)
+	})
+}
+
+// ShippingServiceClientFromAPI adapts a to ShippingServiceClient, e.g. to hand a
+// mock ShippingServiceAPI to code that takes the generated client. Call options
+// are ignored, and streams report empty headers and trailers.
+func ShippingServiceClientFromAPI(a ShippingServiceAPI) ShippingServiceClient {
+	return shippingServiceAPIClient{api: a}
+}
+
+type shippingServiceAPIClient struct {
+	api ShippingServiceAPI
+}
+
+func (c shippingServiceAPIClient) CreateShipment(ctx context.Context, in *CreateShipmentRequest, _ ...grpc.CallOption) (*CreateShipmentResponse, error) {
+	return c.api.CreateShipment(ctx, in)
+}
+
+func (c shippingServiceAPIClient) GetTrackingInfo(ctx context.Context, in *GetTrackingInfoRequest, _ ...grpc.CallOption) (*GetTrackingInfoResponse, error) {
+	return c.api.GetTrackingInfo(ctx, in)
+}
+
+func (c shippingServiceAPIClient) UpdateShipmentStatus(ctx context.Context, in *UpdateShipmentStatusRequest, _ ...grpc.CallOption) (*UpdateShipmentStatusResponse, error) {
+	return c.api.UpdateShipmentStatus(ctx, in)
+}
+
+func (c shippingServiceAPIClient) WatchShipment(ctx context.Context, in *WatchShipmentRequest, _ ...grpc.CallOption) (grpc.ServerStreamingClient[TrackingEvent], error) {
+	return newSeqServerStream(ctx, c.api.WatchShipment(ctx, in)), nil
+}
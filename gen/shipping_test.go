@@ -0,0 +1,105 @@
+package gen
+
+import (
+	"slices"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestShipmentStatusOrderStatus(t *testing.T) {
+	tests := []struct {
+		status   ShipmentStatus
+		want     OrderStatus
+		terminal bool
+	}{
+		{ShipmentStatus_SHIPMENT_STATUS_PICKED_UP, OrderStatus_ORDER_STATUS_SHIPPED, false},
+		{ShipmentStatus_SHIPMENT_STATUS_DELIVERY_FAILED, OrderStatus_ORDER_STATUS_SHIPPED, false},
+		{ShipmentStatus_SHIPMENT_STATUS_DELIVERED, OrderStatus_ORDER_STATUS_DELIVERED, true},
+		{ShipmentStatus_SHIPMENT_STATUS_RETURNED, OrderStatus_ORDER_STATUS_UNSPECIFIED, true},
+	}
+	for _, tt := range tests {
+		if got := tt.status.OrderStatus(); got != tt.want {
+			t.Errorf("%v.OrderStatus() = %v, want %v", tt.status, got, tt.want)
+		}
+		if got := tt.status.Terminal(); got != tt.terminal {
+			t.Errorf("%v.Terminal() = %v, want %v", tt.status, got, tt.terminal)
+		}
+	}
+}
+
+func TestRecordEvent(t *testing.T) {
+	ev := func(st ShipmentStatus, at string) *TrackingEvent {
+		return &TrackingEvent{Status: st, OccurredAt: at}
+	}
+	s := &Shipment{Id: "s-1"}
+	steps := []struct {
+		name          string
+		event         *TrackingEvent
+		wantCode      codes.Code
+		wantStatus    ShipmentStatus
+		wantDelivered string
+		wantOrder     []string // occurred_at of the events, in order
+	}{
+		{
+			name: "picked up", event: ev(ShipmentStatus_SHIPMENT_STATUS_PICKED_UP, "2026-03-02T09:00:00+09:00"),
+			wantStatus: ShipmentStatus_SHIPMENT_STATUS_PICKED_UP,
+			wantOrder:  []string{"2026-03-02T09:00:00+09:00"},
+		},
+		{
+			name: "out for delivery", event: ev(ShipmentStatus_SHIPMENT_STATUS_OUT_FOR_DELIVERY, "2026-03-03T08:00:00+09:00"),
+			wantStatus: ShipmentStatus_SHIPMENT_STATUS_OUT_FOR_DELIVERY,
+			wantOrder:  []string{"2026-03-02T09:00:00+09:00", "2026-03-03T08:00:00+09:00"},
+		},
+		{
+			name: "earlier event arrives late", event: ev(ShipmentStatus_SHIPMENT_STATUS_IN_TRANSIT, "2026-03-02T12:00:00Z"),
+			wantStatus: ShipmentStatus_SHIPMENT_STATUS_OUT_FOR_DELIVERY,
+			wantOrder:  []string{"2026-03-02T09:00:00+09:00", "2026-03-02T12:00:00Z", "2026-03-03T08:00:00+09:00"},
+		},
+		{
+			name: "no status", event: ev(ShipmentStatus_SHIPMENT_STATUS_UNSPECIFIED, "2026-03-03T09:00:00+09:00"),
+			wantCode: codes.InvalidArgument, wantStatus: ShipmentStatus_SHIPMENT_STATUS_OUT_FOR_DELIVERY,
+			wantOrder: []string{"2026-03-02T09:00:00+09:00", "2026-03-02T12:00:00Z", "2026-03-03T08:00:00+09:00"},
+		},
+		{
+			name: "unparseable time", event: ev(ShipmentStatus_SHIPMENT_STATUS_IN_TRANSIT, "2026-03-03 09:00"),
+			wantCode: codes.InvalidArgument, wantStatus: ShipmentStatus_SHIPMENT_STATUS_OUT_FOR_DELIVERY,
+			wantOrder: []string{"2026-03-02T09:00:00+09:00", "2026-03-02T12:00:00Z", "2026-03-03T08:00:00+09:00"},
+		},
+		{
+			name: "delivered", event: ev(ShipmentStatus_SHIPMENT_STATUS_DELIVERED, "2026-03-03T14:00:00+09:00"),
+			wantStatus: ShipmentStatus_SHIPMENT_STATUS_DELIVERED, wantDelivered: "2026-03-03T14:00:00+09:00",
+			wantOrder: []string{"2026-03-02T09:00:00+09:00", "2026-03-02T12:00:00Z", "2026-03-03T08:00:00+09:00", "2026-03-03T14:00:00+09:00"},
+		},
+		{
+			name: "late webhook before delivery", event: ev(ShipmentStatus_SHIPMENT_STATUS_IN_TRANSIT, "2026-03-03T06:00:00+09:00"),
+			wantStatus: ShipmentStatus_SHIPMENT_STATUS_DELIVERED, wantDelivered: "2026-03-03T14:00:00+09:00",
+			wantOrder: []string{"2026-03-02T09:00:00+09:00", "2026-03-02T12:00:00Z", "2026-03-03T06:00:00+09:00", "2026-03-03T08:00:00+09:00", "2026-03-03T14:00:00+09:00"},
+		},
+		{
+			name: "same time as delivery", event: ev(ShipmentStatus_SHIPMENT_STATUS_OUT_FOR_DELIVERY, "2026-03-03T05:00:00Z"),
+			wantStatus: ShipmentStatus_SHIPMENT_STATUS_DELIVERED, wantDelivered: "2026-03-03T14:00:00+09:00",
+			wantOrder: []string{"2026-03-02T09:00:00+09:00", "2026-03-02T12:00:00Z", "2026-03-03T06:00:00+09:00", "2026-03-03T08:00:00+09:00", "2026-03-03T05:00:00Z", "2026-03-03T14:00:00+09:00"},
+		},
+		{
+			name: "after delivery", event: ev(ShipmentStatus_SHIPMENT_STATUS_RETURNED, "2026-03-04T10:00:00+09:00"),
+			wantCode: codes.FailedPrecondition, wantStatus: ShipmentStatus_SHIPMENT_STATUS_DELIVERED, wantDelivered: "2026-03-03T14:00:00+09:00",
+			wantOrder: []string{"2026-03-02T09:00:00+09:00", "2026-03-02T12:00:00Z", "2026-03-03T06:00:00+09:00", "2026-03-03T08:00:00+09:00", "2026-03-03T05:00:00Z", "2026-03-03T14:00:00+09:00"},
+		},
+	}
+	for _, st := range steps {
+		err := s.RecordEvent(st.event)
+		if status.Code(err) != st.wantCode {
+			t.Fatalf("%s: RecordEvent() = %v, want %v", st.name, err, st.wantCode)
+		}
+		var order []string
+		for _, e := range s.GetEvents() {
+			order = append(order, e.GetOccurredAt())
+		}
+		if s.GetStatus() != st.wantStatus || s.GetDeliveredAt() != st.wantDelivered || !slices.Equal(order, st.wantOrder) {
+			t.Errorf("%s: status %v, delivered_at %q, events %q; want %v, %q, %q",
+				st.name, s.GetStatus(), s.GetDeliveredAt(), order, st.wantStatus, st.wantDelivered, st.wantOrder)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package gen
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func TestMessageSigner(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherPub, _, _ := ed25519.GenerateKey(rand.Reader)
+	signed := time.Unix(1_700_000_000, 0)
+	msg := &KakaoReadyRequest{PartnerOrderId: "o-1", PartnerUserId: "u-1", ItemName: "상품", Quantity: 1, Total: KRW(25000)}
+
+	tests := []struct {
+		name     string
+		signer   *MessageSigner
+		verifier *MessageSigner
+		tamper   func(token string) string
+		into     proto.Message
+		after    time.Duration
+		wantErr  string
+	}{
+		{name: "hmac", signer: NewHMACMessageSigner([]byte("k"), time.Hour), verifier: NewHMACMessageSigner([]byte("k"), time.Hour)},
+		{name: "ed25519", signer: NewEd25519MessageSigner(priv, 0), verifier: NewEd25519MessageVerifier(pub, 0), after: 1000 * time.Hour},
+		{name: "wrong hmac key", signer: NewHMACMessageSigner([]byte("k"), 0), verifier: NewHMACMessageSigner([]byte("other"), 0), wantErr: "invalid signed message"},
+		{name: "wrong public key", signer: NewEd25519MessageSigner(priv, 0), verifier: NewEd25519MessageVerifier(otherPub, 0), wantErr: "invalid signed message"},
+		{name: "expired", signer: NewHMACMessageSigner([]byte("k"), time.Hour), verifier: NewHMACMessageSigner([]byte("k"), time.Hour), after: time.Hour + time.Second, wantErr: "signed message expired"},
+		{name: "other message type", signer: NewHMACMessageSigner([]byte("k"), 0), verifier: NewHMACMessageSigner([]byte("k"), 0), into: &KakaoApproveRequest{}, wantErr: "invalid signed message"},
+		{
+			name: "tampered payload", signer: NewHMACMessageSigner([]byte("k"), 0), verifier: NewHMACMessageSigner([]byte("k"), 0),
+			tamper: func(tok string) string {
+				forged, _ := NewHMACMessageSigner([]byte("attacker"), 0).Sign(&KakaoReadyRequest{Total: KRW(1)})
+				return strings.Split(forged, ".")[0] + tok[strings.Index(tok, "."):]
+			},
+			wantErr: "invalid signed message",
+		},
+		{
+			name: "backdated issue time", signer: NewHMACMessageSigner([]byte("k"), 0), verifier: NewHMACMessageSigner([]byte("k"), 0),
+			tamper: func(tok string) string {
+				parts := strings.Split(tok, ".")
+				return parts[0] + ".1." + parts[2]
+			},
+			wantErr: "invalid signed message",
+		},
+		{name: "malformed", signer: NewHMACMessageSigner([]byte("k"), 0), verifier: NewHMACMessageSigner([]byte("k"), 0), tamper: func(string) string { return "a.b" }, wantErr: "invalid signed message"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.signer.now = func() time.Time { return signed }
+			tt.verifier.now = func() time.Time { return signed.Add(tt.after) }
+			token, err := tt.signer.Sign(msg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.tamper != nil {
+				token = tt.tamper(token)
+			}
+			into := tt.into
+			if into == nil {
+				into = &KakaoReadyRequest{}
+			}
+			err = tt.verifier.Verify(token, into)
+			if tt.wantErr != "" {
+				if ErrorReasonOf(err) != ErrorReason_ERROR_REASON_INVALID_SIGNATURE || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("Verify() = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Verify() = %v", err)
+			}
+			if !proto.Equal(into, msg) {
+				t.Errorf("Verify() decoded %v, want %v", into, msg)
+			}
+		})
+	}
+}
+
+func TestMessageVerifierCannotSign(t *testing.T) {
+	pub, _, _ := ed25519.GenerateKey(rand.Reader)
+	if _, err := NewEd25519MessageVerifier(pub, 0).Sign(&Order{}); err == nil {
+		t.Error("verify-only signer signed a message")
+	}
+}
@@ -0,0 +1,863 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: subscription.proto
+
+package gen
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SubscriptionInterval int32
+
+const (
+	SubscriptionInterval_SUBSCRIPTION_INTERVAL_UNSPECIFIED SubscriptionInterval = 0
+	SubscriptionInterval_SUBSCRIPTION_INTERVAL_WEEKLY      SubscriptionInterval = 1
+	SubscriptionInterval_SUBSCRIPTION_INTERVAL_BIWEEKLY    SubscriptionInterval = 2
+	SubscriptionInterval_SUBSCRIPTION_INTERVAL_MONTHLY     SubscriptionInterval = 3
+)
+
+// Enum value maps for SubscriptionInterval.
+var (
+	SubscriptionInterval_name = map[int32]string{
+		0: "SUBSCRIPTION_INTERVAL_UNSPECIFIED",
+		1: "SUBSCRIPTION_INTERVAL_WEEKLY",
+		2: "SUBSCRIPTION_INTERVAL_BIWEEKLY",
+		3: "SUBSCRIPTION_INTERVAL_MONTHLY",
+	}
+	SubscriptionInterval_value = map[string]int32{
+		"SUBSCRIPTION_INTERVAL_UNSPECIFIED": 0,
+		"SUBSCRIPTION_INTERVAL_WEEKLY":      1,
+		"SUBSCRIPTION_INTERVAL_BIWEEKLY":    2,
+		"SUBSCRIPTION_INTERVAL_MONTHLY":     3,
+	}
+)
+
+func (x SubscriptionInterval) Enum() *SubscriptionInterval {
+	p := new(SubscriptionInterval)
+	*p = x
+	return p
+}
+
+func (x SubscriptionInterval) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SubscriptionInterval) Descriptor() protoreflect.EnumDescriptor {
+	return file_subscription_proto_enumTypes[0].Descriptor()
+}
+
+func (SubscriptionInterval) Type() protoreflect.EnumType {
+	return &file_subscription_proto_enumTypes[0]
+}
+
+func (x SubscriptionInterval) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SubscriptionInterval.Descriptor instead.
+func (SubscriptionInterval) EnumDescriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{0}
+}
+
+type SubscriptionStatus int32
+
+const (
+	SubscriptionStatus_SUBSCRIPTION_STATUS_UNSPECIFIED SubscriptionStatus = 0
+	SubscriptionStatus_SUBSCRIPTION_STATUS_ACTIVE      SubscriptionStatus = 1
+	SubscriptionStatus_SUBSCRIPTION_STATUS_PAUSED      SubscriptionStatus = 2
+	SubscriptionStatus_SUBSCRIPTION_STATUS_CANCELLED   SubscriptionStatus = 3
+)
+
+// Enum value maps for SubscriptionStatus.
+var (
+	SubscriptionStatus_name = map[int32]string{
+		0: "SUBSCRIPTION_STATUS_UNSPECIFIED",
+		1: "SUBSCRIPTION_STATUS_ACTIVE",
+		2: "SUBSCRIPTION_STATUS_PAUSED",
+		3: "SUBSCRIPTION_STATUS_CANCELLED",
+	}
+	SubscriptionStatus_value = map[string]int32{
+		"SUBSCRIPTION_STATUS_UNSPECIFIED": 0,
+		"SUBSCRIPTION_STATUS_ACTIVE":      1,
+		"SUBSCRIPTION_STATUS_PAUSED":      2,
+		"SUBSCRIPTION_STATUS_CANCELLED":   3,
+	}
+)
+
+func (x SubscriptionStatus) Enum() *SubscriptionStatus {
+	p := new(SubscriptionStatus)
+	*p = x
+	return p
+}
+
+func (x SubscriptionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SubscriptionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_subscription_proto_enumTypes[1].Descriptor()
+}
+
+func (SubscriptionStatus) Type() protoreflect.EnumType {
+	return &file_subscription_proto_enumTypes[1]
+}
+
+func (x SubscriptionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SubscriptionStatus.Descriptor instead.
+func (SubscriptionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{1}
+}
+
+type SubscriptionItem struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ProductId      string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductOptions string                 `protobuf:"bytes,2,opt,name=product_options,json=productOptions,proto3" json:"product_options,omitempty"`
+	Quantity       int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SubscriptionItem) Reset() {
+	*x = SubscriptionItem{}
+	mi := &file_subscription_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscriptionItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionItem) ProtoMessage() {}
+
+func (x *SubscriptionItem) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionItem.ProtoReflect.Descriptor instead.
+func (*SubscriptionItem) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SubscriptionItem) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SubscriptionItem) GetProductOptions() string {
+	if x != nil {
+		return x.ProductOptions
+	}
+	return ""
+}
+
+func (x *SubscriptionItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type Subscription struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId           string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Items            []*SubscriptionItem    `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	Interval         SubscriptionInterval   `protobuf:"varint,4,opt,name=interval,proto3,enum=go.escape.ship.proto.v1.SubscriptionInterval" json:"interval,omitempty"`
+	Status           SubscriptionStatus     `protobuf:"varint,5,opt,name=status,proto3,enum=go.escape.ship.proto.v1.SubscriptionStatus" json:"status,omitempty"`
+	BillingKey       string                 `protobuf:"bytes,6,opt,name=billing_key,json=billingKey,proto3" json:"billing_key,omitempty"` // PG 정기결제 키 (Kakao Pay SID 등)
+	ShippingAddress  string                 `protobuf:"bytes,7,opt,name=shipping_address,json=shippingAddress,proto3" json:"shipping_address,omitempty"`
+	NextDeliveryDate string                 `protobuf:"bytes,8,opt,name=next_delivery_date,json=nextDeliveryDate,proto3" json:"next_delivery_date,omitempty"` // YYYY-MM-DD
+	PausedUntil      string                 `protobuf:"bytes,9,opt,name=paused_until,json=pausedUntil,proto3" json:"paused_until,omitempty"`                  // PAUSED 상태일 때 자동 재개일
+	CreatedAt        string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CancelledAt      string                 `protobuf:"bytes,11,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Subscription) Reset() {
+	*x = Subscription{}
+	mi := &file_subscription_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Subscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subscription) ProtoMessage() {}
+
+func (x *Subscription) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subscription.ProtoReflect.Descriptor instead.
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Subscription) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Subscription) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Subscription) GetItems() []*SubscriptionItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Subscription) GetInterval() SubscriptionInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return SubscriptionInterval_SUBSCRIPTION_INTERVAL_UNSPECIFIED
+}
+
+func (x *Subscription) GetStatus() SubscriptionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SubscriptionStatus_SUBSCRIPTION_STATUS_UNSPECIFIED
+}
+
+func (x *Subscription) GetBillingKey() string {
+	if x != nil {
+		return x.BillingKey
+	}
+	return ""
+}
+
+func (x *Subscription) GetShippingAddress() string {
+	if x != nil {
+		return x.ShippingAddress
+	}
+	return ""
+}
+
+func (x *Subscription) GetNextDeliveryDate() string {
+	if x != nil {
+		return x.NextDeliveryDate
+	}
+	return ""
+}
+
+func (x *Subscription) GetPausedUntil() string {
+	if x != nil {
+		return x.PausedUntil
+	}
+	return ""
+}
+
+func (x *Subscription) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Subscription) GetCancelledAt() string {
+	if x != nil {
+		return x.CancelledAt
+	}
+	return ""
+}
+
+type CreateSubscriptionRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserId            string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Items             []*SubscriptionItem    `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	Interval          SubscriptionInterval   `protobuf:"varint,3,opt,name=interval,proto3,enum=go.escape.ship.proto.v1.SubscriptionInterval" json:"interval,omitempty"`
+	BillingKey        string                 `protobuf:"bytes,4,opt,name=billing_key,json=billingKey,proto3" json:"billing_key,omitempty"`
+	ShippingAddress   string                 `protobuf:"bytes,5,opt,name=shipping_address,json=shippingAddress,proto3" json:"shipping_address,omitempty"`
+	FirstDeliveryDate string                 `protobuf:"bytes,6,opt,name=first_delivery_date,json=firstDeliveryDate,proto3" json:"first_delivery_date,omitempty"` // YYYY-MM-DD
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateSubscriptionRequest) Reset() {
+	*x = CreateSubscriptionRequest{}
+	mi := &file_subscription_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSubscriptionRequest) ProtoMessage() {}
+
+func (x *CreateSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateSubscriptionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateSubscriptionRequest) GetItems() []*SubscriptionItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CreateSubscriptionRequest) GetInterval() SubscriptionInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return SubscriptionInterval_SUBSCRIPTION_INTERVAL_UNSPECIFIED
+}
+
+func (x *CreateSubscriptionRequest) GetBillingKey() string {
+	if x != nil {
+		return x.BillingKey
+	}
+	return ""
+}
+
+func (x *CreateSubscriptionRequest) GetShippingAddress() string {
+	if x != nil {
+		return x.ShippingAddress
+	}
+	return ""
+}
+
+func (x *CreateSubscriptionRequest) GetFirstDeliveryDate() string {
+	if x != nil {
+		return x.FirstDeliveryDate
+	}
+	return ""
+}
+
+type CreateSubscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscription  *Subscription          `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSubscriptionResponse) Reset() {
+	*x = CreateSubscriptionResponse{}
+	mi := &file_subscription_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSubscriptionResponse) ProtoMessage() {}
+
+func (x *CreateSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*CreateSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateSubscriptionResponse) GetSubscription() *Subscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+type PauseSubscriptionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionId string                 `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	ResumeDate     string                 `protobuf:"bytes,2,opt,name=resume_date,json=resumeDate,proto3" json:"resume_date,omitempty"` // 비어 있으면 재개할 때까지 무기한 일시정지
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PauseSubscriptionRequest) Reset() {
+	*x = PauseSubscriptionRequest{}
+	mi := &file_subscription_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseSubscriptionRequest) ProtoMessage() {}
+
+func (x *PauseSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*PauseSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PauseSubscriptionRequest) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+func (x *PauseSubscriptionRequest) GetResumeDate() string {
+	if x != nil {
+		return x.ResumeDate
+	}
+	return ""
+}
+
+type PauseSubscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscription  *Subscription          `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseSubscriptionResponse) Reset() {
+	*x = PauseSubscriptionResponse{}
+	mi := &file_subscription_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseSubscriptionResponse) ProtoMessage() {}
+
+func (x *PauseSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*PauseSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PauseSubscriptionResponse) GetSubscription() *Subscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+type SkipNextDeliveryRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionId string                 `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SkipNextDeliveryRequest) Reset() {
+	*x = SkipNextDeliveryRequest{}
+	mi := &file_subscription_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkipNextDeliveryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkipNextDeliveryRequest) ProtoMessage() {}
+
+func (x *SkipNextDeliveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkipNextDeliveryRequest.ProtoReflect.Descriptor instead.
+func (*SkipNextDeliveryRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SkipNextDeliveryRequest) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+type SkipNextDeliveryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscription  *Subscription          `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"` // next_delivery_date가 다음 주기로 변경됨
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkipNextDeliveryResponse) Reset() {
+	*x = SkipNextDeliveryResponse{}
+	mi := &file_subscription_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkipNextDeliveryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkipNextDeliveryResponse) ProtoMessage() {}
+
+func (x *SkipNextDeliveryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkipNextDeliveryResponse.ProtoReflect.Descriptor instead.
+func (*SkipNextDeliveryResponse) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SkipNextDeliveryResponse) GetSubscription() *Subscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+type CancelSubscriptionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionId string                 `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	Reason         string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CancelSubscriptionRequest) Reset() {
+	*x = CancelSubscriptionRequest{}
+	mi := &file_subscription_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelSubscriptionRequest) ProtoMessage() {}
+
+func (x *CancelSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CancelSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CancelSubscriptionRequest) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+func (x *CancelSubscriptionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CancelSubscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscription  *Subscription          `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelSubscriptionResponse) Reset() {
+	*x = CancelSubscriptionResponse{}
+	mi := &file_subscription_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelSubscriptionResponse) ProtoMessage() {}
+
+func (x *CancelSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*CancelSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CancelSubscriptionResponse) GetSubscription() *Subscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+var File_subscription_proto protoreflect.FileDescriptor
+
+const file_subscription_proto_rawDesc = "" +
+	"\n" +
+	"\x12subscription.proto\x12\x17go.escape.ship.proto.v1\x1a\x1cgoogle/api/annotations.proto\"v\n" +
+	"\x10SubscriptionItem\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12'\n" +
+	"\x0fproduct_options\x18\x02 \x01(\tR\x0eproductOptions\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\"\xec\x03\n" +
+	"\fSubscription\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12?\n" +
+	"\x05items\x18\x03 \x03(\v2).go.escape.ship.proto.v1.SubscriptionItemR\x05items\x12I\n" +
+	"\binterval\x18\x04 \x01(\x0e2-.go.escape.ship.proto.v1.SubscriptionIntervalR\binterval\x12C\n" +
+	"\x06status\x18\x05 \x01(\x0e2+.go.escape.ship.proto.v1.SubscriptionStatusR\x06status\x12$\n" +
+	"\vbilling_key\x18\x06 \x01(\tB\x03\x80\x01\x01R\n" +
+	"billingKey\x12)\n" +
+	"\x10shipping_address\x18\a \x01(\tR\x0fshippingAddress\x12,\n" +
+	"\x12next_delivery_date\x18\b \x01(\tR\x10nextDeliveryDate\x12!\n" +
+	"\fpaused_until\x18\t \x01(\tR\vpausedUntil\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\tR\tcreatedAt\x12!\n" +
+	"\fcancelled_at\x18\v \x01(\tR\vcancelledAt\"\xc1\x02\n" +
+	"\x19CreateSubscriptionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12?\n" +
+	"\x05items\x18\x02 \x03(\v2).go.escape.ship.proto.v1.SubscriptionItemR\x05items\x12I\n" +
+	"\binterval\x18\x03 \x01(\x0e2-.go.escape.ship.proto.v1.SubscriptionIntervalR\binterval\x12$\n" +
+	"\vbilling_key\x18\x04 \x01(\tB\x03\x80\x01\x01R\n" +
+	"billingKey\x12)\n" +
+	"\x10shipping_address\x18\x05 \x01(\tR\x0fshippingAddress\x12.\n" +
+	"\x13first_delivery_date\x18\x06 \x01(\tR\x11firstDeliveryDate\"g\n" +
+	"\x1aCreateSubscriptionResponse\x12I\n" +
+	"\fsubscription\x18\x01 \x01(\v2%.go.escape.ship.proto.v1.SubscriptionR\fsubscription\"d\n" +
+	"\x18PauseSubscriptionRequest\x12'\n" +
+	"\x0fsubscription_id\x18\x01 \x01(\tR\x0esubscriptionId\x12\x1f\n" +
+	"\vresume_date\x18\x02 \x01(\tR\n" +
+	"resumeDate\"f\n" +
+	"\x19PauseSubscriptionResponse\x12I\n" +
+	"\fsubscription\x18\x01 \x01(\v2%.go.escape.ship.proto.v1.SubscriptionR\fsubscription\"B\n" +
+	"\x17SkipNextDeliveryRequest\x12'\n" +
+	"\x0fsubscription_id\x18\x01 \x01(\tR\x0esubscriptionId\"e\n" +
+	"\x18SkipNextDeliveryResponse\x12I\n" +
+	"\fsubscription\x18\x01 \x01(\v2%.go.escape.ship.proto.v1.SubscriptionR\fsubscription\"\\\n" +
+	"\x19CancelSubscriptionRequest\x12'\n" +
+	"\x0fsubscription_id\x18\x01 \x01(\tR\x0esubscriptionId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"g\n" +
+	"\x1aCancelSubscriptionResponse\x12I\n" +
+	"\fsubscription\x18\x01 \x01(\v2%.go.escape.ship.proto.v1.SubscriptionR\fsubscription*\xa6\x01\n" +
+	"\x14SubscriptionInterval\x12%\n" +
+	"!SUBSCRIPTION_INTERVAL_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cSUBSCRIPTION_INTERVAL_WEEKLY\x10\x01\x12\"\n" +
+	"\x1eSUBSCRIPTION_INTERVAL_BIWEEKLY\x10\x02\x12!\n" +
+	"\x1dSUBSCRIPTION_INTERVAL_MONTHLY\x10\x03*\x9c\x01\n" +
+	"\x12SubscriptionStatus\x12#\n" +
+	"\x1fSUBSCRIPTION_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aSUBSCRIPTION_STATUS_ACTIVE\x10\x01\x12\x1e\n" +
+	"\x1aSUBSCRIPTION_STATUS_PAUSED\x10\x02\x12!\n" +
+	"\x1dSUBSCRIPTION_STATUS_CANCELLED\x10\x032\xcc\x05\n" +
+	"\x13SubscriptionService\x12\x9b\x01\n" +
+	"\x12CreateSubscription\x122.go.escape.ship.proto.v1.CreateSubscriptionRequest\x1a3.go.escape.ship.proto.v1.CreateSubscriptionResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/subscriptions\x12\xb0\x01\n" +
+	"\x11PauseSubscription\x121.go.escape.ship.proto.v1.PauseSubscriptionRequest\x1a2.go.escape.ship.proto.v1.PauseSubscriptionResponse\"4\x82\xd3\xe4\x93\x02.:\x01*\")/v1/subscriptions/{subscription_id}/pause\x12\xac\x01\n" +
+	"\x10SkipNextDelivery\x120.go.escape.ship.proto.v1.SkipNextDeliveryRequest\x1a1.go.escape.ship.proto.v1.SkipNextDeliveryResponse\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/v1/subscriptions/{subscription_id}/skip\x12\xb4\x01\n" +
+	"\x12CancelSubscription\x122.go.escape.ship.proto.v1.CancelSubscriptionRequest\x1a3.go.escape.ship.proto.v1.CancelSubscriptionResponse\"5\x82\xd3\xe4\x93\x02/:\x01*\"*/v1/subscriptions/{subscription_id}/cancelB#Z!github.com/escape-ship/protos/genb\x06proto3"
+
+var (
+	file_subscription_proto_rawDescOnce sync.Once
+	file_subscription_proto_rawDescData []byte
+)
+
+func file_subscription_proto_rawDescGZIP() []byte {
+	file_subscription_proto_rawDescOnce.Do(func() {
+		file_subscription_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_subscription_proto_rawDesc), len(file_subscription_proto_rawDesc)))
+	})
+	return file_subscription_proto_rawDescData
+}
+
+var file_subscription_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_subscription_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_subscription_proto_goTypes = []any{
+	(SubscriptionInterval)(0),          // 0: go.escape.ship.proto.v1.SubscriptionInterval
+	(SubscriptionStatus)(0),            // 1: go.escape.ship.proto.v1.SubscriptionStatus
+	(*SubscriptionItem)(nil),           // 2: go.escape.ship.proto.v1.SubscriptionItem
+	(*Subscription)(nil),               // 3: go.escape.ship.proto.v1.Subscription
+	(*CreateSubscriptionRequest)(nil),  // 4: go.escape.ship.proto.v1.CreateSubscriptionRequest
+	(*CreateSubscriptionResponse)(nil), // 5: go.escape.ship.proto.v1.CreateSubscriptionResponse
+	(*PauseSubscriptionRequest)(nil),   // 6: go.escape.ship.proto.v1.PauseSubscriptionRequest
+	(*PauseSubscriptionResponse)(nil),  // 7: go.escape.ship.proto.v1.PauseSubscriptionResponse
+	(*SkipNextDeliveryRequest)(nil),    // 8: go.escape.ship.proto.v1.SkipNextDeliveryRequest
+	(*SkipNextDeliveryResponse)(nil),   // 9: go.escape.ship.proto.v1.SkipNextDeliveryResponse
+	(*CancelSubscriptionRequest)(nil),  // 10: go.escape.ship.proto.v1.CancelSubscriptionRequest
+	(*CancelSubscriptionResponse)(nil), // 11: go.escape.ship.proto.v1.CancelSubscriptionResponse
+}
+var file_subscription_proto_depIdxs = []int32{
+	2,  // 0: go.escape.ship.proto.v1.Subscription.items:type_name -> go.escape.ship.proto.v1.SubscriptionItem
+	0,  // 1: go.escape.ship.proto.v1.Subscription.interval:type_name -> go.escape.ship.proto.v1.SubscriptionInterval
+	1,  // 2: go.escape.ship.proto.v1.Subscription.status:type_name -> go.escape.ship.proto.v1.SubscriptionStatus
+	2,  // 3: go.escape.ship.proto.v1.CreateSubscriptionRequest.items:type_name -> go.escape.ship.proto.v1.SubscriptionItem
+	0,  // 4: go.escape.ship.proto.v1.CreateSubscriptionRequest.interval:type_name -> go.escape.ship.proto.v1.SubscriptionInterval
+	3,  // 5: go.escape.ship.proto.v1.CreateSubscriptionResponse.subscription:type_name -> go.escape.ship.proto.v1.Subscription
+	3,  // 6: go.escape.ship.proto.v1.PauseSubscriptionResponse.subscription:type_name -> go.escape.ship.proto.v1.Subscription
+	3,  // 7: go.escape.ship.proto.v1.SkipNextDeliveryResponse.subscription:type_name -> go.escape.ship.proto.v1.Subscription
+	3,  // 8: go.escape.ship.proto.v1.CancelSubscriptionResponse.subscription:type_name -> go.escape.ship.proto.v1.Subscription
+	4,  // 9: go.escape.ship.proto.v1.SubscriptionService.CreateSubscription:input_type -> go.escape.ship.proto.v1.CreateSubscriptionRequest
+	6,  // 10: go.escape.ship.proto.v1.SubscriptionService.PauseSubscription:input_type -> go.escape.ship.proto.v1.PauseSubscriptionRequest
+	8,  // 11: go.escape.ship.proto.v1.SubscriptionService.SkipNextDelivery:input_type -> go.escape.ship.proto.v1.SkipNextDeliveryRequest
+	10, // 12: go.escape.ship.proto.v1.SubscriptionService.CancelSubscription:input_type -> go.escape.ship.proto.v1.CancelSubscriptionRequest
+	5,  // 13: go.escape.ship.proto.v1.SubscriptionService.CreateSubscription:output_type -> go.escape.ship.proto.v1.CreateSubscriptionResponse
+	7,  // 14: go.escape.ship.proto.v1.SubscriptionService.PauseSubscription:output_type -> go.escape.ship.proto.v1.PauseSubscriptionResponse
+	9,  // 15: go.escape.ship.proto.v1.SubscriptionService.SkipNextDelivery:output_type -> go.escape.ship.proto.v1.SkipNextDeliveryResponse
+	11, // 16: go.escape.ship.proto.v1.SubscriptionService.CancelSubscription:output_type -> go.escape.ship.proto.v1.CancelSubscriptionResponse
+	13, // [13:17] is the sub-list for method output_type
+	9,  // [9:13] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_subscription_proto_init() }
+func file_subscription_proto_init() {
+	if File_subscription_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_subscription_proto_rawDesc), len(file_subscription_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_subscription_proto_goTypes,
+		DependencyIndexes: file_subscription_proto_depIdxs,
+		EnumInfos:         file_subscription_proto_enumTypes,
+		MessageInfos:      file_subscription_proto_msgTypes,
+	}.Build()
+	File_subscription_proto = out.File
+	file_subscription_proto_goTypes = nil
+	file_subscription_proto_depIdxs = nil
+}
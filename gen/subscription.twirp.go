@@ -0,0 +1,1416 @@
+// Code generated by protoc-gen-twirp v8.1.3, DO NOT EDIT.
+// source: subscription.proto
+
+package gen
+
+import context "context"
+import fmt "fmt"
+import http "net/http"
+import io "io"
+import json "encoding/json"
+import strconv "strconv"
+import strings "strings"
+
+import protojson "google.golang.org/protobuf/encoding/protojson"
+import proto "google.golang.org/protobuf/proto"
+import twirp "github.com/twitchtv/twirp"
+import ctxsetters "github.com/twitchtv/twirp/ctxsetters"
+
+// Version compatibility assertion.
+// If the constant is not defined in the package, that likely means
+// the package needs to be updated to work with this generated code.
+// See https://twitchtv.github.io/twirp/docs/version_matrix.html
+const _ = twirp.TwirpPackageMinVersion_8_1_0
+
+// =============================
+// SubscriptionService Interface
+// =============================
+
+// 정기배송(구독) 서비스: 주기마다 주문을 생성하고 빌링키로 자동 결제
+type SubscriptionService interface {
+	CreateSubscription(context.Context, *CreateSubscriptionRequest) (*CreateSubscriptionResponse, error)
+
+	PauseSubscription(context.Context, *PauseSubscriptionRequest) (*PauseSubscriptionResponse, error)
+
+	SkipNextDelivery(context.Context, *SkipNextDeliveryRequest) (*SkipNextDeliveryResponse, error)
+
+	CancelSubscription(context.Context, *CancelSubscriptionRequest) (*CancelSubscriptionResponse, error)
+}
+
+// ===================================
+// SubscriptionService Protobuf Client
+// ===================================
+
+type subscriptionServiceProtobufClient struct {
+	client      HTTPClient
+	urls        [4]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewSubscriptionServiceProtobufClient creates a Protobuf client that implements the SubscriptionService interface.
+// It communicates using Protobuf and can be configured with a custom HTTPClient.
+func NewSubscriptionServiceProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) SubscriptionService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "go.escape.ship.proto.v1", "SubscriptionService")
+	urls := [4]string{
+		serviceURL + "CreateSubscription",
+		serviceURL + "PauseSubscription",
+		serviceURL + "SkipNextDelivery",
+		serviceURL + "CancelSubscription",
+	}
+
+	return &subscriptionServiceProtobufClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *subscriptionServiceProtobufClient) CreateSubscription(ctx context.Context, in *CreateSubscriptionRequest) (*CreateSubscriptionResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "SubscriptionService")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateSubscription")
+	caller := c.callCreateSubscription
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateSubscriptionRequest) (*CreateSubscriptionResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateSubscriptionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateSubscriptionRequest) when calling interceptor")
+					}
+					return c.callCreateSubscription(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateSubscriptionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateSubscriptionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *subscriptionServiceProtobufClient) callCreateSubscription(ctx context.Context, in *CreateSubscriptionRequest) (*CreateSubscriptionResponse, error) {
+	out := new(CreateSubscriptionResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *subscriptionServiceProtobufClient) PauseSubscription(ctx context.Context, in *PauseSubscriptionRequest) (*PauseSubscriptionResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "SubscriptionService")
+	ctx = ctxsetters.WithMethodName(ctx, "PauseSubscription")
+	caller := c.callPauseSubscription
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *PauseSubscriptionRequest) (*PauseSubscriptionResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PauseSubscriptionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PauseSubscriptionRequest) when calling interceptor")
+					}
+					return c.callPauseSubscription(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PauseSubscriptionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PauseSubscriptionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *subscriptionServiceProtobufClient) callPauseSubscription(ctx context.Context, in *PauseSubscriptionRequest) (*PauseSubscriptionResponse, error) {
+	out := new(PauseSubscriptionResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *subscriptionServiceProtobufClient) SkipNextDelivery(ctx context.Context, in *SkipNextDeliveryRequest) (*SkipNextDeliveryResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "SubscriptionService")
+	ctx = ctxsetters.WithMethodName(ctx, "SkipNextDelivery")
+	caller := c.callSkipNextDelivery
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SkipNextDeliveryRequest) (*SkipNextDeliveryResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SkipNextDeliveryRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SkipNextDeliveryRequest) when calling interceptor")
+					}
+					return c.callSkipNextDelivery(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SkipNextDeliveryResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SkipNextDeliveryResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *subscriptionServiceProtobufClient) callSkipNextDelivery(ctx context.Context, in *SkipNextDeliveryRequest) (*SkipNextDeliveryResponse, error) {
+	out := new(SkipNextDeliveryResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *subscriptionServiceProtobufClient) CancelSubscription(ctx context.Context, in *CancelSubscriptionRequest) (*CancelSubscriptionResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "SubscriptionService")
+	ctx = ctxsetters.WithMethodName(ctx, "CancelSubscription")
+	caller := c.callCancelSubscription
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CancelSubscriptionRequest) (*CancelSubscriptionResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CancelSubscriptionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CancelSubscriptionRequest) when calling interceptor")
+					}
+					return c.callCancelSubscription(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CancelSubscriptionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CancelSubscriptionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *subscriptionServiceProtobufClient) callCancelSubscription(ctx context.Context, in *CancelSubscriptionRequest) (*CancelSubscriptionResponse, error) {
+	out := new(CancelSubscriptionResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ===============================
+// SubscriptionService JSON Client
+// ===============================
+
+type subscriptionServiceJSONClient struct {
+	client      HTTPClient
+	urls        [4]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewSubscriptionServiceJSONClient creates a JSON client that implements the SubscriptionService interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewSubscriptionServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) SubscriptionService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "go.escape.ship.proto.v1", "SubscriptionService")
+	urls := [4]string{
+		serviceURL + "CreateSubscription",
+		serviceURL + "PauseSubscription",
+		serviceURL + "SkipNextDelivery",
+		serviceURL + "CancelSubscription",
+	}
+
+	return &subscriptionServiceJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *subscriptionServiceJSONClient) CreateSubscription(ctx context.Context, in *CreateSubscriptionRequest) (*CreateSubscriptionResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "SubscriptionService")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateSubscription")
+	caller := c.callCreateSubscription
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateSubscriptionRequest) (*CreateSubscriptionResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateSubscriptionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateSubscriptionRequest) when calling interceptor")
+					}
+					return c.callCreateSubscription(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateSubscriptionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateSubscriptionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *subscriptionServiceJSONClient) callCreateSubscription(ctx context.Context, in *CreateSubscriptionRequest) (*CreateSubscriptionResponse, error) {
+	out := new(CreateSubscriptionResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *subscriptionServiceJSONClient) PauseSubscription(ctx context.Context, in *PauseSubscriptionRequest) (*PauseSubscriptionResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "SubscriptionService")
+	ctx = ctxsetters.WithMethodName(ctx, "PauseSubscription")
+	caller := c.callPauseSubscription
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *PauseSubscriptionRequest) (*PauseSubscriptionResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PauseSubscriptionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PauseSubscriptionRequest) when calling interceptor")
+					}
+					return c.callPauseSubscription(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PauseSubscriptionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PauseSubscriptionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *subscriptionServiceJSONClient) callPauseSubscription(ctx context.Context, in *PauseSubscriptionRequest) (*PauseSubscriptionResponse, error) {
+	out := new(PauseSubscriptionResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *subscriptionServiceJSONClient) SkipNextDelivery(ctx context.Context, in *SkipNextDeliveryRequest) (*SkipNextDeliveryResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "SubscriptionService")
+	ctx = ctxsetters.WithMethodName(ctx, "SkipNextDelivery")
+	caller := c.callSkipNextDelivery
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SkipNextDeliveryRequest) (*SkipNextDeliveryResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SkipNextDeliveryRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SkipNextDeliveryRequest) when calling interceptor")
+					}
+					return c.callSkipNextDelivery(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SkipNextDeliveryResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SkipNextDeliveryResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *subscriptionServiceJSONClient) callSkipNextDelivery(ctx context.Context, in *SkipNextDeliveryRequest) (*SkipNextDeliveryResponse, error) {
+	out := new(SkipNextDeliveryResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *subscriptionServiceJSONClient) CancelSubscription(ctx context.Context, in *CancelSubscriptionRequest) (*CancelSubscriptionResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "SubscriptionService")
+	ctx = ctxsetters.WithMethodName(ctx, "CancelSubscription")
+	caller := c.callCancelSubscription
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CancelSubscriptionRequest) (*CancelSubscriptionResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CancelSubscriptionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CancelSubscriptionRequest) when calling interceptor")
+					}
+					return c.callCancelSubscription(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CancelSubscriptionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CancelSubscriptionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *subscriptionServiceJSONClient) callCancelSubscription(ctx context.Context, in *CancelSubscriptionRequest) (*CancelSubscriptionResponse, error) {
+	out := new(CancelSubscriptionResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ==================================
+// SubscriptionService Server Handler
+// ==================================
+
+type subscriptionServiceServer struct {
+	SubscriptionService
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewSubscriptionServiceServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewSubscriptionServiceServer(svc SubscriptionService, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	return &subscriptionServiceServer{
+		SubscriptionService: svc,
+		hooks:               serverOpts.Hooks,
+		interceptor:         twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:          pathPrefix,
+		jsonSkipDefaults:    jsonSkipDefaults,
+		jsonCamelCase:       jsonCamelCase,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *subscriptionServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *subscriptionServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
+
+// SubscriptionServicePathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const SubscriptionServicePathPrefix = "/twirp/go.escape.ship.proto.v1.SubscriptionService/"
+
+func (s *subscriptionServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "SubscriptionService")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "go.escape.ship.proto.v1.SubscriptionService" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "CreateSubscription":
+		s.serveCreateSubscription(ctx, resp, req)
+		return
+	case "PauseSubscription":
+		s.servePauseSubscription(ctx, resp, req)
+		return
+	case "SkipNextDelivery":
+		s.serveSkipNextDelivery(ctx, resp, req)
+		return
+	case "CancelSubscription":
+		s.serveCancelSubscription(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *subscriptionServiceServer) serveCreateSubscription(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveCreateSubscriptionJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveCreateSubscriptionProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *subscriptionServiceServer) serveCreateSubscriptionJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateSubscription")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(CreateSubscriptionRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.SubscriptionService.CreateSubscription
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateSubscriptionRequest) (*CreateSubscriptionResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateSubscriptionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateSubscriptionRequest) when calling interceptor")
+					}
+					return s.SubscriptionService.CreateSubscription(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateSubscriptionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateSubscriptionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreateSubscriptionResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateSubscriptionResponse and nil error while calling CreateSubscription. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *subscriptionServiceServer) serveCreateSubscriptionProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateSubscription")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(CreateSubscriptionRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.SubscriptionService.CreateSubscription
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateSubscriptionRequest) (*CreateSubscriptionResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateSubscriptionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateSubscriptionRequest) when calling interceptor")
+					}
+					return s.SubscriptionService.CreateSubscription(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateSubscriptionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateSubscriptionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreateSubscriptionResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateSubscriptionResponse and nil error while calling CreateSubscription. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *subscriptionServiceServer) servePauseSubscription(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.servePauseSubscriptionJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.servePauseSubscriptionProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *subscriptionServiceServer) servePauseSubscriptionJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "PauseSubscription")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(PauseSubscriptionRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.SubscriptionService.PauseSubscription
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *PauseSubscriptionRequest) (*PauseSubscriptionResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PauseSubscriptionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PauseSubscriptionRequest) when calling interceptor")
+					}
+					return s.SubscriptionService.PauseSubscription(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PauseSubscriptionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PauseSubscriptionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *PauseSubscriptionResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *PauseSubscriptionResponse and nil error while calling PauseSubscription. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *subscriptionServiceServer) servePauseSubscriptionProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "PauseSubscription")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(PauseSubscriptionRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.SubscriptionService.PauseSubscription
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *PauseSubscriptionRequest) (*PauseSubscriptionResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PauseSubscriptionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PauseSubscriptionRequest) when calling interceptor")
+					}
+					return s.SubscriptionService.PauseSubscription(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PauseSubscriptionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PauseSubscriptionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *PauseSubscriptionResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *PauseSubscriptionResponse and nil error while calling PauseSubscription. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *subscriptionServiceServer) serveSkipNextDelivery(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSkipNextDeliveryJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSkipNextDeliveryProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *subscriptionServiceServer) serveSkipNextDeliveryJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SkipNextDelivery")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(SkipNextDeliveryRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.SubscriptionService.SkipNextDelivery
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SkipNextDeliveryRequest) (*SkipNextDeliveryResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SkipNextDeliveryRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SkipNextDeliveryRequest) when calling interceptor")
+					}
+					return s.SubscriptionService.SkipNextDelivery(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SkipNextDeliveryResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SkipNextDeliveryResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SkipNextDeliveryResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SkipNextDeliveryResponse and nil error while calling SkipNextDelivery. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *subscriptionServiceServer) serveSkipNextDeliveryProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SkipNextDelivery")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(SkipNextDeliveryRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.SubscriptionService.SkipNextDelivery
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SkipNextDeliveryRequest) (*SkipNextDeliveryResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SkipNextDeliveryRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SkipNextDeliveryRequest) when calling interceptor")
+					}
+					return s.SubscriptionService.SkipNextDelivery(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SkipNextDeliveryResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SkipNextDeliveryResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SkipNextDeliveryResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SkipNextDeliveryResponse and nil error while calling SkipNextDelivery. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *subscriptionServiceServer) serveCancelSubscription(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveCancelSubscriptionJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveCancelSubscriptionProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *subscriptionServiceServer) serveCancelSubscriptionJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CancelSubscription")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(CancelSubscriptionRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.SubscriptionService.CancelSubscription
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CancelSubscriptionRequest) (*CancelSubscriptionResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CancelSubscriptionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CancelSubscriptionRequest) when calling interceptor")
+					}
+					return s.SubscriptionService.CancelSubscription(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CancelSubscriptionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CancelSubscriptionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CancelSubscriptionResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CancelSubscriptionResponse and nil error while calling CancelSubscription. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *subscriptionServiceServer) serveCancelSubscriptionProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CancelSubscription")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(CancelSubscriptionRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.SubscriptionService.CancelSubscription
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CancelSubscriptionRequest) (*CancelSubscriptionResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CancelSubscriptionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CancelSubscriptionRequest) when calling interceptor")
+					}
+					return s.SubscriptionService.CancelSubscription(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CancelSubscriptionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CancelSubscriptionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CancelSubscriptionResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CancelSubscriptionResponse and nil error while calling CancelSubscription. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *subscriptionServiceServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor13, 0
+}
+
+func (s *subscriptionServiceServer) ProtocGenTwirpVersion() string {
+	return "v8.1.3"
+}
+
+// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
+// that is everything in a Twirp route except for the <Method>. This can be used for routing,
+// for example to identify the requests that are targeted to this service in a mux.
+func (s *subscriptionServiceServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "go.escape.ship.proto.v1", "SubscriptionService")
+}
+
+var twirpFileDescriptor13 = []byte{
+	// 906 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0xc7, 0xc9, 0x26, 0xdb, 0xbe, 0x54, 0xad, 0x3b, 0x8b, 0xb6, 0x6e, 0xd4, 0xdd, 0x4d, 0xbd,
+	0xac, 0x36, 0x2d, 0xd4, 0xa6, 0x29, 0x5c, 0xb8, 0xa0, 0xfc, 0x31, 0xc2, 0xda, 0x90, 0x56, 0x76,
+	0xb2, 0x68, 0x11, 0x52, 0xe4, 0xc6, 0xb3, 0xd9, 0x51, 0x53, 0xdb, 0xeb, 0x19, 0x47, 0x54, 0x08,
+	0x09, 0xf1, 0x15, 0x38, 0xc2, 0x99, 0x13, 0x07, 0x0e, 0x7c, 0x01, 0xee, 0xdc, 0xf8, 0x0a, 0x1c,
+	0xf9, 0x10, 0xc8, 0x33, 0x6e, 0x71, 0x13, 0x1b, 0x25, 0x6a, 0x6e, 0xf5, 0x6f, 0xde, 0xef, 0xbd,
+	0xdf, 0x9b, 0xdf, 0xbc, 0xd7, 0x00, 0xa2, 0xd1, 0x39, 0x1d, 0x85, 0x24, 0x60, 0xc4, 0xf7, 0xb4,
+	0x20, 0xf4, 0x99, 0x8f, 0x76, 0xc6, 0xbe, 0x86, 0xe9, 0xc8, 0x09, 0xb0, 0x46, 0xdf, 0x90, 0x40,
+	0xa0, 0xda, 0xf4, 0xb8, 0xba, 0x37, 0xf6, 0xfd, 0xf1, 0x04, 0xeb, 0x4e, 0x40, 0x74, 0xc7, 0xf3,
+	0x7c, 0xe6, 0xc4, 0x2c, 0x2a, 0x02, 0xd4, 0x29, 0xc8, 0x76, 0x2a, 0x99, 0xc9, 0xf0, 0x25, 0x7a,
+	0x04, 0x10, 0x84, 0xbe, 0x1b, 0x8d, 0xd8, 0x90, 0xb8, 0x8a, 0x54, 0x93, 0xea, 0xeb, 0xd6, 0x7a,
+	0x82, 0x98, 0x2e, 0x7a, 0x0e, 0x5b, 0xd7, 0xc7, 0x3e, 0x27, 0x51, 0xa5, 0xc0, 0x63, 0x36, 0x13,
+	0xf8, 0x54, 0xa0, 0xa8, 0x0a, 0x6b, 0x6f, 0x23, 0xc7, 0x63, 0x84, 0x5d, 0x29, 0xc5, 0x9a, 0x54,
+	0x2f, 0x59, 0x37, 0xdf, 0xea, 0x3f, 0x45, 0xd8, 0x48, 0x17, 0x46, 0x9b, 0x50, 0xb8, 0x29, 0x56,
+	0x20, 0x2e, 0xda, 0x81, 0xfb, 0x11, 0xc5, 0x61, 0xac, 0x40, 0x64, 0x2f, 0xc7, 0x9f, 0xa6, 0x8b,
+	0x3e, 0x85, 0x12, 0x61, 0xf8, 0x92, 0x2a, 0xc5, 0x5a, 0xb1, 0x5e, 0x69, 0x1c, 0x68, 0x39, 0x8d,
+	0x6b, 0xb3, 0x7d, 0x59, 0x82, 0x87, 0x4c, 0x58, 0x23, 0x1e, 0xc3, 0xe1, 0xd4, 0x99, 0x28, 0xf7,
+	0x6a, 0x52, 0x7d, 0xb3, 0x71, 0xb4, 0x58, 0x8e, 0x84, 0x64, 0xdd, 0xd0, 0x51, 0x1b, 0xca, 0x94,
+	0x39, 0x2c, 0xa2, 0x4a, 0x89, 0x27, 0x7a, 0x7f, 0xa1, 0x44, 0x36, 0xa7, 0x58, 0x09, 0x15, 0xbd,
+	0x07, 0x95, 0x73, 0x32, 0x99, 0x10, 0x6f, 0x3c, 0xbc, 0xc0, 0x57, 0x4a, 0x39, 0xee, 0xb6, 0x55,
+	0xfc, 0x5e, 0x92, 0x2c, 0x48, 0xf0, 0x17, 0xf8, 0x0a, 0x1d, 0x80, 0x1c, 0x67, 0x0c, 0xe2, 0x30,
+	0xc7, 0x75, 0x43, 0x4c, 0xa9, 0x72, 0x9f, 0x5f, 0xcc, 0xd6, 0x35, 0xde, 0x14, 0x30, 0xfa, 0x00,
+	0x90, 0x87, 0xbf, 0x61, 0x43, 0x17, 0x4f, 0xc8, 0x14, 0x87, 0x57, 0x43, 0xd7, 0x61, 0x58, 0x59,
+	0xe3, 0xc1, 0x72, 0x7c, 0xd2, 0x49, 0x0e, 0x3a, 0x0e, 0xc3, 0x68, 0x1f, 0x36, 0x02, 0x27, 0xa2,
+	0xd8, 0x1d, 0x46, 0x1e, 0x23, 0x13, 0x65, 0x9d, 0xc7, 0x55, 0x04, 0x36, 0x88, 0xa1, 0xf8, 0x41,
+	0x8c, 0x42, 0xec, 0x30, 0xec, 0x0e, 0x1d, 0xa6, 0x80, 0x78, 0x10, 0x09, 0xd2, 0x64, 0x71, 0x86,
+	0x91, 0xe3, 0x8d, 0xf0, 0x64, 0x22, 0x02, 0x2a, 0x22, 0xc3, 0x0d, 0xd6, 0x64, 0xea, 0x1f, 0x05,
+	0xd8, 0x6d, 0x73, 0x42, 0xfa, 0x22, 0x2c, 0xfc, 0x36, 0xc2, 0x94, 0xa5, 0xbd, 0x96, 0xb2, 0xbd,
+	0x2e, 0xac, 0xc0, 0xeb, 0xe2, 0xdd, 0xbc, 0x9e, 0xb1, 0xe9, 0xde, 0xe2, 0x36, 0x95, 0xb2, 0x6d,
+	0xd2, 0xe0, 0xc1, 0x6b, 0x12, 0xd2, 0x59, 0x9f, 0xb8, 0xff, 0xd6, 0x36, 0x3f, 0x4a, 0x1b, 0xa5,
+	0x8e, 0xa1, 0x9a, 0x75, 0x85, 0x34, 0xf0, 0x3d, 0x8a, 0x91, 0x09, 0x1b, 0xe9, 0xad, 0xc0, 0x2f,
+	0xb2, 0xd2, 0x78, 0xb6, 0x50, 0xb7, 0xd6, 0x2d, 0xaa, 0xea, 0x82, 0x72, 0x16, 0xbb, 0x9f, 0x65,
+	0xd5, 0x73, 0xd8, 0x4a, 0xc7, 0xfe, 0x67, 0xd9, 0x66, 0x1a, 0x36, 0x5d, 0xf4, 0x04, 0x2a, 0x21,
+	0xa6, 0xd1, 0x25, 0x16, 0x5d, 0x89, 0x19, 0x06, 0x01, 0xf1, 0x76, 0x5e, 0xc3, 0x6e, 0x46, 0x95,
+	0xd5, 0x77, 0xd3, 0x82, 0x1d, 0xfb, 0x82, 0x04, 0xbd, 0xd4, 0xbb, 0x5f, 0xb6, 0x19, 0x15, 0x83,
+	0x32, 0x9f, 0x63, 0xf5, 0x52, 0xbf, 0x86, 0xdd, 0x36, 0x1f, 0x9a, 0x3b, 0xdd, 0xfc, 0x43, 0x28,
+	0x87, 0xd8, 0xa1, 0xbe, 0x77, 0xbd, 0x38, 0xc5, 0x17, 0x7f, 0x3f, 0x19, 0xd9, 0x57, 0xde, 0xc6,
+	0xe1, 0x2f, 0x12, 0xbc, 0x9b, 0x35, 0x4c, 0xe8, 0x19, 0xec, 0xdb, 0x83, 0x96, 0xdd, 0xb6, 0xcc,
+	0xb3, 0xbe, 0x79, 0xda, 0x1b, 0x9a, 0xbd, 0xbe, 0x61, 0xbd, 0x6c, 0x76, 0x87, 0x83, 0x9e, 0x7d,
+	0x66, 0xb4, 0xcd, 0xcf, 0x4c, 0xa3, 0x23, 0xbf, 0x83, 0x6a, 0xb0, 0x97, 0x1d, 0xf6, 0xa5, 0x61,
+	0xbc, 0xe8, 0xbe, 0x92, 0x25, 0xa4, 0xc2, 0xe3, 0xec, 0x88, 0x96, 0x99, 0xc4, 0x14, 0xd0, 0x3e,
+	0x3c, 0xca, 0x8e, 0xf9, 0xe2, 0xb4, 0xd7, 0xff, 0xbc, 0xfb, 0x4a, 0x2e, 0x1e, 0xfe, 0x2c, 0x01,
+	0x9a, 0x5f, 0xcc, 0xe8, 0x29, 0x3c, 0xb9, 0xc5, 0xb4, 0xfb, 0xcd, 0xfe, 0xc0, 0x9e, 0x11, 0xf9,
+	0x18, 0xaa, 0x59, 0x41, 0xcd, 0x76, 0xdf, 0x7c, 0x69, 0xc8, 0x52, 0xde, 0xf9, 0x59, 0x73, 0x60,
+	0x1b, 0x9d, 0x0c, 0x79, 0xc9, 0x79, 0xbb, 0xd9, 0x6b, 0x1b, 0xdd, 0xae, 0xd1, 0x91, 0x8b, 0x8d,
+	0x3f, 0x4b, 0xf0, 0xe0, 0x96, 0x3c, 0x1c, 0x4e, 0xc9, 0x08, 0xa3, 0x9f, 0x24, 0x40, 0xf3, 0x9b,
+	0x00, 0x35, 0x72, 0xbd, 0xca, 0xdd, 0xbc, 0xd5, 0x93, 0xa5, 0x38, 0xe2, 0xa9, 0xa8, 0x7b, 0x3f,
+	0xfc, 0xf5, 0xf7, 0x8f, 0x85, 0x87, 0x9f, 0x48, 0x87, 0xea, 0xb6, 0x3e, 0x3d, 0xd6, 0xd3, 0xe6,
+	0x53, 0xf4, 0x9b, 0x04, 0xdb, 0x73, 0x83, 0x8d, 0x8e, 0x73, 0x0b, 0xe5, 0xad, 0x9a, 0x6a, 0x63,
+	0x19, 0x4a, 0x22, 0xed, 0x23, 0x2e, 0x4d, 0x8b, 0xa5, 0x1d, 0xcc, 0x49, 0xd3, 0xbf, 0x9d, 0x19,
+	0xa0, 0xef, 0x74, 0xfe, 0x5f, 0x0e, 0xfd, 0x2a, 0x81, 0x3c, 0x3b, 0xdf, 0xe8, 0xc3, 0xfc, 0xa7,
+	0x9f, 0xbd, 0x4e, 0xaa, 0xc7, 0x4b, 0x30, 0x12, 0xbd, 0x27, 0x5c, 0xef, 0x51, 0xac, 0xb7, 0xbe,
+	0x88, 0x5e, 0x7a, 0x41, 0x02, 0xf4, 0x7b, 0xec, 0xff, 0xdc, 0x24, 0xff, 0x9f, 0xff, 0x79, 0x4b,
+	0xa5, 0x7a, 0xb2, 0x14, 0x27, 0x11, 0xfd, 0x31, 0x17, 0xad, 0xc7, 0xa2, 0x0f, 0x17, 0x11, 0x2d,
+	0x7e, 0x08, 0xb4, 0x9e, 0x7e, 0xb5, 0x3f, 0x26, 0xec, 0x4d, 0x74, 0xae, 0x8d, 0xfc, 0x4b, 0x5d,
+	0x14, 0x3d, 0x8a, 0x8b, 0xea, 0xbc, 0x28, 0xd5, 0xc7, 0xd8, 0x3b, 0x2f, 0xf3, 0xbf, 0x4f, 0xfe,
+	0x1d, 0x00, 0xcd, 0x18, 0x0b, 0xf3, 0xe3, 0x0a, 0x00, 0x00,
+}
@@ -0,0 +1,71 @@
+// Code generated by protoc-gen-go-shim. DO NOT EDIT.
+// source: subscription.proto
+
+package gen
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+)
+
+// SubscriptionServiceAPI is SubscriptionServiceClient without per-call options, so it can be
+// mocked with plain method signatures. Streams are exposed as iterators.
+type SubscriptionServiceAPI interface {
+	CreateSubscription(ctx context.Context, in *CreateSubscriptionRequest) (*CreateSubscriptionResponse, error)
+	PauseSubscription(ctx context.Context, in *PauseSubscriptionRequest) (*PauseSubscriptionResponse, error)
+	SkipNextDelivery(ctx context.Context, in *SkipNextDeliveryRequest) (*SkipNextDeliveryResponse, error)
+	CancelSubscription(ctx context.Context, in *CancelSubscriptionRequest) (*CancelSubscriptionResponse, error)
+}
+
+// NewSubscriptionServiceAPI adapts c to SubscriptionServiceAPI, passing opts to every call.
+func NewSubscriptionServiceAPI(c SubscriptionServiceClient, opts ...grpc.CallOption) SubscriptionServiceAPI {
+	return &subscriptionServiceAPI{c: c, opts: opts}
+}
+
+type subscriptionServiceAPI struct {
+	c    SubscriptionServiceClient
+	opts []grpc.CallOption
+}
+
+func (a *subscriptionServiceAPI) CreateSubscription(ctx context.Context, in *CreateSubscriptionRequest) (*CreateSubscriptionResponse, error) {
+	return a.c.CreateSubscription(ctx, in, a.opts...)
+}
+
+func (a *subscriptionServiceAPI) PauseSubscription(ctx context.Context, in *PauseSubscriptionRequest) (*PauseSubscriptionResponse, error) {
+	return a.c.PauseSubscription(ctx, in, a.opts...)
+}
+
+func (a *subscriptionServiceAPI) SkipNextDelivery(ctx context.Context, in *SkipNextDeliveryRequest) (*SkipNextDeliveryResponse, error) {
+	return a.c.SkipNextDelivery(ctx, in, a.opts...)
+}
+
+func (a *subscriptionServiceAPI) CancelSubscription(ctx context.Context, in *CancelSubscriptionRequest) (*CancelSubscriptionResponse, error) {
+	return a.c.CancelSubscription(ctx, in, a.opts...)
+}
+
+// SubscriptionServiceClientFromAPI adapts a to SubscriptionServiceClient, e.g. to hand a
+// mock SubscriptionServiceAPI to code that takes the generated client. Call options
+// are ignored, and streams report empty headers and trailers.
+func SubscriptionServiceClientFromAPI(a SubscriptionServiceAPI) SubscriptionServiceClient {
+	return subscriptionServiceAPIClient{api: a}
+}
+
+type subscriptionServiceAPIClient struct {
+	api SubscriptionServiceAPI
+}
+
+func (c subscriptionServiceAPIClient) CreateSubscription(ctx context.Context, in *CreateSubscriptionRequest, _ ...grpc.CallOption) (*CreateSubscriptionResponse, error) {
+	return c.api.CreateSubscription(ctx, in)
+}
+
+func (c subscriptionServiceAPIClient) PauseSubscription(ctx context.Context, in *PauseSubscriptionRequest, _ ...grpc.CallOption) (*PauseSubscriptionResponse, error) {
+	return c.api.PauseSubscription(ctx, in)
+}
+
+func (c subscriptionServiceAPIClient) SkipNextDelivery(ctx context.Context, in *SkipNextDeliveryRequest, _ ...grpc.CallOption) (*SkipNextDeliveryResponse, error) {
+	return c.api.SkipNextDelivery(ctx, in)
+}
+
+func (c subscriptionServiceAPIClient) CancelSubscription(ctx context.Context, in *CancelSubscriptionRequest, _ ...grpc.CallOption) (*CancelSubscriptionResponse, error) {
+	return c.api.CancelSubscription(ctx, in)
+}
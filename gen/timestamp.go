@@ -0,0 +1,133 @@
+package gen
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ParseLegacyTime parses an RFC3339 string from a deprecated *_at string
+// field, such as Order.paid_at. ok is false for empty or malformed values,
+// which yield nil.
+func ParseLegacyTime(s string) (ts *timestamppb.Timestamp, ok bool) {
+	if s == "" {
+		return nil, false
+	}
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return nil, false
+	}
+	return timestamppb.New(t), true
+}
+
+// LegacyTime formats ts as the RFC3339 string older services read from the
+// deprecated *_at string fields. It is empty for nil.
+//
+// A Timestamp has no UTC offset, so the string is always in UTC: a legacy
+// "2024-01-15T10:30:00+09:00" parsed with ParseLegacyTime comes back as
+// "2024-01-15T01:30:00Z", the same instant in different text. SyncTimestamps
+// therefore never rewrites a legacy string that is already set; compare
+// legacy strings as times, not as text.
+func LegacyTime(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+	return ts.AsTime().Format(time.RFC3339Nano)
+}
+
+// TimestampOr returns ts, or the parsed legacy RFC3339 string when ts is
+// unset. Use it to read time fields that replaced a string field while old
+// writers are still deployed:
+//
+//	paidTime := pb.TimestampOr(order.GetPaidTime(), order.GetPaidAt())
+func TimestampOr(ts *timestamppb.Timestamp, legacy string) *timestamppb.Timestamp {
+	if ts != nil {
+		return ts
+	}
+	ts, _ = ParseLegacyTime(legacy)
+	return ts
+}
+
+// syncTime fills in whichever of a time field and its legacy string is
+// missing from the other.
+func syncTime(ts **timestamppb.Timestamp, legacy *string) {
+	*ts = TimestampOr(*ts, *legacy)
+	if *legacy == "" {
+		*legacy = LegacyTime(*ts)
+	}
+}
+
+// EffectiveOrderedTime returns when the order was placed, falling back to the
+// deprecated ordered_at string for orders written by older services.
+func (o *Order) EffectiveOrderedTime() *timestamppb.Timestamp {
+	return TimestampOr(o.GetOrderedTime(), o.GetOrderedAt())
+}
+
+// EffectivePaidTime returns when the order was paid, falling back to the
+// deprecated paid_at string. It is nil for unpaid orders.
+func (o *Order) EffectivePaidTime() *timestamppb.Timestamp {
+	return TimestampOr(o.GetPaidTime(), o.GetPaidAt())
+}
+
+// SyncTimestamps fills in whichever of each *_time field and its deprecated
+// *_at string is missing from the other, including in the refunds, so
+// the order reads the same to old and new services while they are rolled out.
+func (o *Order) SyncTimestamps() {
+	syncTime(&o.OrderedTime, &o.OrderedAt)
+	syncTime(&o.PaidTime, &o.PaidAt)
+	for _, r := range o.GetRefunds() {
+		r.SyncTimestamps()
+	}
+}
+
+// SyncTimestamps fills in whichever of requested_time, completed_time and
+// their deprecated strings are missing from the other.
+func (r *Refund) SyncTimestamps() {
+	syncTime(&r.RequestedTime, &r.RequestedAt)
+	syncTime(&r.CompletedTime, &r.CompletedAt)
+}
+
+// SyncTimestamps fills in whichever of paid_time and paid_at is missing
+// from the other.
+func (r *InsertOrderRequest) SyncTimestamps() {
+	syncTime(&r.PaidTime, &r.PaidAt)
+}
+
+// SyncTimestamps fills in whichever of changed_time and changed_at is
+// missing from the other.
+func (e *OrderStatusEvent) SyncTimestamps() {
+	syncTime(&e.ChangedTime, &e.ChangedAt)
+}
+
+// SyncTimestamps fills in whichever of created_time and created_at is
+// missing from the other.
+func (l *ReturnLabel) SyncTimestamps() {
+	syncTime(&l.CreatedTime, &l.CreatedAt)
+}
+
+// SyncTimestamps fills in whichever of archived_time and archived_at is
+// missing from the other, and syncs the archived order.
+func (r *GetArchivedOrderResponse) SyncTimestamps() {
+	syncTime(&r.ArchivedTime, &r.ArchivedAt)
+	if r.GetOrder() != nil {
+		r.Order.SyncTimestamps()
+	}
+}
+
+// SyncTimestamps fills in whichever of created_time and created_at is
+// missing from the other.
+func (q *Quote) SyncTimestamps() {
+	syncTime(&q.CreatedTime, &q.CreatedAt)
+}
+
+// SyncTimestamps fills in whichever of priced_time and priced_at is
+// missing from the other.
+func (r *PriceOrderRequest) SyncTimestamps() {
+	syncTime(&r.PricedTime, &r.PricedAt)
+}
+
+// SyncTimestamps fills in whichever of priced_time and priced_at is
+// missing from the other.
+func (r *PriceOrderResponse) SyncTimestamps() {
+	syncTime(&r.PricedTime, &r.PricedAt)
+}
@@ -0,0 +1,55 @@
+package gen
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestLegacyTime(t *testing.T) {
+	tests := []struct {
+		legacy string
+		ok     bool
+		want   string
+	}{
+		{"2024-01-15T01:30:00Z", true, "2024-01-15T01:30:00Z"},
+		{"2024-01-15T10:30:00+09:00", true, "2024-01-15T01:30:00Z"}, // offset is not kept
+		{"2024-01-15T10:30:00.25+09:00", true, "2024-01-15T01:30:00.25Z"},
+		{"2024-01-15 10:30", false, ""},
+		{"", false, ""},
+	}
+	for _, tt := range tests {
+		ts, ok := ParseLegacyTime(tt.legacy)
+		if ok != tt.ok || LegacyTime(ts) != tt.want {
+			t.Errorf("LegacyTime(ParseLegacyTime(%q)) = %q, %v, want %q, %v", tt.legacy, LegacyTime(ts), ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestOrderSyncTimestamps(t *testing.T) {
+	paid := timestamppb.New(time.Date(2024, 1, 15, 1, 30, 0, 0, time.UTC))
+	o := &Order{
+		OrderedAt: "2024-01-15T10:00:00+09:00",
+		PaidTime:  paid,
+		Refunds:   []*Refund{{RequestedAt: "2024-01-16T09:00:00+09:00"}},
+	}
+	o.SyncTimestamps()
+	want := &Order{
+		OrderedAt:   "2024-01-15T10:00:00+09:00", // kept as written
+		OrderedTime: timestamppb.New(time.Date(2024, 1, 15, 1, 0, 0, 0, time.UTC)),
+		PaidAt:      "2024-01-15T01:30:00Z",
+		PaidTime:    paid,
+		Refunds: []*Refund{{
+			RequestedAt:   "2024-01-16T09:00:00+09:00",
+			RequestedTime: timestamppb.New(time.Date(2024, 1, 16, 0, 0, 0, 0, time.UTC)),
+		}},
+	}
+	if !proto.Equal(o, want) {
+		t.Errorf("SyncTimestamps() = %v, want %v", o, want)
+	}
+	if !proto.Equal(o.EffectivePaidTime(), paid) || (&Order{}).EffectivePaidTime() != nil {
+		t.Errorf("EffectivePaidTime() = %v", o.EffectivePaidTime())
+	}
+}
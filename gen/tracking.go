@@ -0,0 +1,34 @@
+package gen
+
+import (
+	"net/url"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// TrackingURL returns the carrier's tracking page for a tracking (waybill)
+// number, built from the tracking_url option in codes.proto. Hyphens and
+// spaces in number are dropped. It returns "" for an empty number or a
+// carrier without a tracking page.
+func TrackingURL(carrier Carrier, number string) string {
+	number = strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, number)
+	v := carrier.Descriptor().Values().ByNumber(protoreflect.EnumNumber(carrier))
+	if number == "" || v == nil {
+		return ""
+	}
+	tmpl := proto.GetExtension(v.Options(), E_TrackingUrl).(string)
+	if tmpl == "" {
+		return ""
+	}
+	return strings.ReplaceAll(tmpl, "{number}", url.QueryEscape(number))
+}
+
+// TrackingURL is shorthand for TrackingURL(c, number).
+func (c Carrier) TrackingURL(number string) string { return TrackingURL(c, number) }
@@ -0,0 +1,372 @@
+// Code generated by protoc-gen-tstypes. DO NOT EDIT.
+// source: account.proto
+
+import type { DeviceFingerprint } from "./common";
+import type { Address } from "./shipping";
+
+export type PushPlatform = "PUSH_PLATFORM_UNSPECIFIED" | "PUSH_PLATFORM_FCM" | "PUSH_PLATFORM_APNS";
+
+/** 병합 시 같은 항목이 양쪽에 있을 때의 처리 방식 */
+export type MergeConflictResolution = "MERGE_CONFLICT_RESOLUTION_UNSPECIFIED" | "MERGE_CONFLICT_RESOLUTION_KEPT_TARGET" | "MERGE_CONFLICT_RESOLUTION_KEPT_SOURCE" | "MERGE_CONFLICT_RESOLUTION_COMBINED";
+
+export type Theme = "THEME_UNSPECIFIED" | "THEME_LIGHT" | "THEME_DARK";
+
+export type GetKakaoLoginURLRequest = Record<string, never>;
+
+export interface GetKakaoLoginURLResponse {
+  loginUrl?: string;
+}
+
+export interface GetKakaoCallBackRequest {
+  code?: string;
+}
+
+export interface GetKakaoCallBackResponse {
+  accessToken?: string;
+  refreshToken?: string;
+  userInfoJson?: string;
+  /** access_token에 부여된 권한 (ex: "orders:read") */
+  scopes?: string[];
+}
+
+export interface LoginRequest {
+  email?: string;
+  password?: string;
+  device?: DeviceFingerprint | null;
+  /** 로그인 실패가 반복되면 필수 */
+  captchaToken?: string;
+}
+
+export interface LoginResponse {
+  accessToken?: string;
+  refreshToken?: string;
+  /**
+   * 사용자가 동의해야 하는 최신 약관 버전, terms_acceptance_required가 true면
+   * 클라이언트는 재동의 화면을 띄운 뒤 AcceptTerms를 호출해야 함
+   */
+  requiredTermsVersion?: string;
+  termsAcceptanceRequired?: boolean;
+  /** access_token에 부여된 권한 (ex: "orders:read") */
+  scopes?: string[];
+}
+
+export interface RegisterRequest {
+  email?: string;
+  password?: string;
+  /** 봇 가입 방지, 필수 */
+  captchaToken?: string;
+}
+
+export interface RegisterResponse {
+  /** ex) "Registration successful" */
+  message?: string;
+}
+
+export interface RefreshTokenRequest {
+  refreshToken?: string;
+  device?: DeviceFingerprint | null;
+}
+
+export interface RefreshTokenResponse {
+  accessToken?: string;
+  /** 새로 발급된 토큰, 다음 갱신에 사용 */
+  refreshToken?: string;
+  /** access_token 만료까지 남은 시간 (초) */
+  expiresIn?: number;
+  /** access_token에 부여된 권한 (ex: "orders:read") */
+  scopes?: string[];
+}
+
+export interface RevokeTokenRequest {
+  /** access_token 또는 refresh_token */
+  token?: string;
+  /** true면 사용자의 모든 기기 세션 폐기 */
+  allSessions?: boolean;
+}
+
+export type RevokeTokenResponse = Record<string, never>;
+
+export interface AnonymizeUserDataRequest {
+  userId?: string;
+  /** ex) "account_deleted", "privacy_request" */
+  reason?: string;
+}
+
+export interface AnonymizeUserDataResponse {
+  anonymizedOrders?: string;
+  anonymizedPayments?: string;
+  completedAt?: string;
+}
+
+export interface AcceptTermsRequest {
+  termsVersion?: string;
+}
+
+export interface AcceptTermsResponse {
+  termsVersion?: string;
+  acceptedAt?: string;
+}
+
+export interface RegisterPushTokenRequest {
+  deviceId?: string;
+  token?: string;
+  platform?: PushPlatform;
+  appVersion?: string;
+}
+
+export interface RegisterPushTokenResponse {
+  registeredAt?: string;
+}
+
+export interface UnregisterPushTokenRequest {
+  deviceId?: string;
+  token?: string;
+}
+
+export type UnregisterPushTokenResponse = Record<string, never>;
+
+export interface VerifyCaptchaRequest {
+  captchaToken?: string;
+  /** ex) "login", "register" */
+  action?: string;
+  remoteIp?: string;
+}
+
+export interface VerifyCaptchaResponse {
+  success?: boolean;
+  /** 점수 기반 CAPTCHA일 때 0.0(봇) ~ 1.0(사람) */
+  score?: number;
+  errorCodes?: string[];
+}
+
+/**
+ * Login 실패 시 gRPC status details로 전달되는 잠금 정보
+ * 잠금 전: Unauthenticated + remaining_attempts, 잠금 후: ResourceExhausted + retry_after_seconds
+ */
+export interface AccountLockout {
+  locked?: boolean;
+  remainingAttempts?: number;
+  retryAfterSeconds?: string;
+  lockedUntil?: string;
+}
+
+export interface UnlockAccountRequest {
+  userId?: string;
+  reason?: string;
+}
+
+export interface UnlockAccountResponse {
+  unlockedAt?: string;
+}
+
+export interface IssueGuestTokenRequest {
+  /** 기존 게스트 ID 갱신 시 설정, 비어 있으면 신규 발급 */
+  guestId?: string;
+  device?: DeviceFingerprint | null;
+}
+
+export interface IssueGuestTokenResponse {
+  /** 안정적인 익명 식별자 (ex: "guest_...") */
+  guestId?: string;
+  guestToken?: string;
+  expiresAt?: string;
+}
+
+export interface MergeConflict {
+  /** ex) "cart_item", "wishlist_item", "points" */
+  resourceType?: string;
+  resourceId?: string;
+  resolution?: MergeConflictResolution;
+  detail?: string;
+}
+
+export interface MergeAccountsRequest {
+  /** 병합 후 비활성화됨 */
+  sourceUserId?: string;
+  targetUserId?: string;
+  /** source 계정 소유 증명 (게스트 토큰 또는 source 액세스 토큰) */
+  sourceToken?: string;
+}
+
+export interface MergeAccountsResponse {
+  ordersMoved?: number;
+  cartItemsMoved?: number;
+  wishlistItemsMoved?: number;
+  pointsMoved?: string;
+  conflicts?: MergeConflict[];
+}
+
+export interface RequestEmailChangeRequest {
+  newEmail?: string;
+  /** 본인 확인용 현재 비밀번호 */
+  password?: string;
+}
+
+export interface RequestEmailChangeResponse {
+  changeRequestId?: string;
+  /** 인증 코드 만료 시각 */
+  expiresAt?: string;
+}
+
+export interface ConfirmEmailChangeRequest {
+  changeRequestId?: string;
+  verificationCode?: string;
+}
+
+export interface ConfirmEmailChangeResponse {
+  email?: string;
+  changedAt?: string;
+}
+
+/** 사용자 프로필 */
+export interface UserProfile {
+  userId?: string;
+  email?: string;
+  /** CDN URL */
+  avatarUrl?: string;
+  nickname?: string;
+  /** 숫자만 (ex: "01012345678") */
+  phone?: string;
+  /** 주문 시 기본 배송지 */
+  defaultAddress?: Address | null;
+  /** 카카오 전용 계정은 false */
+  hasPassword?: boolean;
+  createdAt?: string;
+}
+
+export interface AvatarMetadata {
+  /** image/jpeg, image/png, image/webp */
+  contentType?: string;
+  /** 최대 5MB */
+  sizeBytes?: string;
+}
+
+export interface UploadAvatarRequest {
+  metadata?: AvatarMetadata | null;
+  chunk?: string;
+}
+
+export interface UploadAvatarResponse {
+  profile?: UserProfile | null;
+}
+
+export interface UserPreferences {
+  /** BCP 47 (ex: "ko-KR") */
+  locale?: string;
+  /** ISO 4217 (ex: "KRW") */
+  currency?: string;
+  theme?: Theme;
+  /** 클라이언트 정의 확장 키 (ex: "web.sidebar_collapsed") */
+  extra?: { [key: string]: string };
+  updatedAt?: string;
+}
+
+export type GetPreferencesRequest = Record<string, never>;
+
+export interface GetPreferencesResponse {
+  preferences?: UserPreferences | null;
+}
+
+export interface SetPreferencesRequest {
+  preferences?: UserPreferences | null;
+  /**
+   * 변경할 필드 (ex: "locale,extra"), 비어 있으면 전체 교체
+   * extra는 맵 전체가 아닌 전달된 키만 갱신하며 값이 빈 문자열이면 키 삭제
+   */
+  updateMask?: string;
+}
+
+export interface SetPreferencesResponse {
+  preferences?: UserPreferences | null;
+}
+
+export type GetMeRequest = Record<string, never>;
+
+export interface GetMeResponse {
+  profile?: UserProfile | null;
+}
+
+export interface UpdateProfileRequest {
+  profile?: UserProfile | null;
+  /**
+   * 변경할 필드 (nickname, phone, default_address), 비어 있으면 세 필드 모두 교체
+   * default_address를 지정하고 값을 비우면 기본 배송지 삭제
+   */
+  updateMask?: string;
+}
+
+export interface UpdateProfileResponse {
+  profile?: UserProfile | null;
+}
+
+export interface ChangePasswordRequest {
+  /** has_password가 false인 계정은 비워서 최초 설정 */
+  currentPassword?: string;
+  newPassword?: string;
+  revokeOtherSessions?: boolean;
+}
+
+export interface ChangePasswordResponse {
+  changedAt?: string;
+  revokedSessions?: number;
+}
+
+export interface DeleteAccountRequest {
+  /** 카카오 전용 계정은 비움 (재인증된 access_token 필요) */
+  password?: string;
+  /** 탈퇴 사유 (선택, 통계용) */
+  reason?: string;
+}
+
+export interface DeleteAccountResponse {
+  deletedAt?: string;
+  /** 이 시각 이후 개인정보 파기 (그 전까지는 복구 문의 가능) */
+  purgeAfter?: string;
+}
+
+/** 파트너 API 키 (secret 원문은 저장하지 않고 발급 시 한 번만 반환) */
+export interface APIKey {
+  keyId?: string;
+  /** 게이트웨이가 x-partner-id 메타데이터로 전달하는 서비스 식별자 */
+  partnerId?: string;
+  partnerName?: string;
+  /** 파트너에 허용된 권한 (ex: "orders:read") */
+  scopes?: string[];
+  createdAt?: string;
+  /** 비어 있으면 만료 없음 */
+  expiresAt?: string;
+  revokedAt?: string;
+}
+
+export interface CreateAPIKeyRequest {
+  partnerId?: string;
+  partnerName?: string;
+  scopes?: string[];
+  expiresAt?: string;
+}
+
+export interface CreateAPIKeyResponse {
+  apiKey?: APIKey | null;
+  /** x-api-key 헤더 값, 재조회 불가 */
+  secret?: string;
+}
+
+export interface RevokeAPIKeyRequest {
+  keyId?: string;
+  reason?: string;
+}
+
+export interface RevokeAPIKeyResponse {
+  apiKey?: APIKey | null;
+}
+
+export interface ValidateAPIKeyRequest {
+  secret?: string;
+}
+
+export interface ValidateAPIKeyResponse {
+  /** 만료/폐기/미존재 키는 false */
+  valid?: boolean;
+  apiKey?: APIKey | null;
+}
+
@@ -0,0 +1,50 @@
+// Code generated by protoc-gen-tstypes. DO NOT EDIT.
+// source: calendar.proto
+
+export type HolidayKind = "HOLIDAY_KIND_UNSPECIFIED" | "HOLIDAY_KIND_PUBLIC" | "HOLIDAY_KIND_SUBSTITUTE" | "HOLIDAY_KIND_TEMPORARY" | "HOLIDAY_KIND_COMPANY";
+
+export interface Holiday {
+  /** YYYY-MM-DD (KST) */
+  date?: string;
+  /** ex) "추석" */
+  name?: string;
+  kind?: HolidayKind;
+}
+
+export interface ListHolidaysRequest {
+  /** YYYY-MM-DD, 비어 있으면 오늘 */
+  fromDate?: string;
+  /** YYYY-MM-DD, 비어 있으면 from_date로부터 1년 */
+  toDate?: string;
+  /** 회사 휴무일 포함 여부 */
+  includeCompany?: boolean;
+}
+
+export interface ListHolidaysResponse {
+  /** 날짜 순 */
+  holidays?: Holiday[];
+}
+
+/** 고객센터 운영 시간 (KST, 공휴일 휴무) */
+export interface SupportHours {
+  /** HH:MM (ex: "09:00") */
+  weekdayOpen?: string;
+  /** HH:MM (ex: "18:00") */
+  weekdayClose?: string;
+  /** 점심시간 시작, 없으면 빈 문자열 */
+  lunchStart?: string;
+  lunchEnd?: string;
+  openOnSaturday?: boolean;
+  /** 토요일 운영 시 종료 시각 */
+  saturdayClose?: string;
+}
+
+export type GetSupportHoursRequest = Record<string, never>;
+
+export interface GetSupportHoursResponse {
+  hours?: SupportHours | null;
+  openNow?: boolean;
+  /** 현재 운영 중이 아니면 다음 운영 시작 시각 (RFC3339) */
+  nextOpenAt?: string;
+}
+
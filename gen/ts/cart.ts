@@ -0,0 +1,69 @@
+// Code generated by protoc-gen-tstypes. DO NOT EDIT.
+// source: cart.proto
+
+export interface CartItem {
+  id?: string;
+  productId?: string;
+  productName?: string;
+  /** JSON 문자열 (InsertOrderItem.product_options와 동일 형식) */
+  productOptions?: string;
+  /** 현재 수량 기준 단가 (수량별 할인 반영) */
+  unitPrice?: string;
+  quantity?: number;
+  /** unit_price * quantity */
+  lineTotal?: string;
+  /** 번들 상품일 때 설정 */
+  bundleId?: string;
+  addedAt?: string;
+}
+
+export interface Cart {
+  userId?: string;
+  items?: CartItem[];
+  totalQuantity?: number;
+  /** 항목 line_total 합계 (배송비 제외) */
+  totalPrice?: string;
+  updatedAt?: string;
+}
+
+export type GetCartRequest = Record<string, never>;
+
+export interface GetCartResponse {
+  cart?: Cart | null;
+}
+
+export interface AddItemRequest {
+  productId?: string;
+  productOptions?: string;
+  quantity?: number;
+  /** 번들 상품을 담을 때 설정 (product_id 대신) */
+  bundleId?: string;
+}
+
+export interface AddItemResponse {
+  cart?: Cart | null;
+}
+
+export interface RemoveItemRequest {
+  itemId?: string;
+}
+
+export interface RemoveItemResponse {
+  cart?: Cart | null;
+}
+
+export interface UpdateQuantityRequest {
+  itemId?: string;
+  quantity?: number;
+}
+
+export interface UpdateQuantityResponse {
+  cart?: Cart | null;
+}
+
+export type ClearCartRequest = Record<string, never>;
+
+export interface ClearCartResponse {
+  cart?: Cart | null;
+}
+
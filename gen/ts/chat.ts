@@ -0,0 +1,80 @@
+// Code generated by protoc-gen-tstypes. DO NOT EDIT.
+// source: chat.proto
+
+export type ChatSenderRole = "CHAT_SENDER_ROLE_UNSPECIFIED" | "CHAT_SENDER_ROLE_CUSTOMER" | "CHAT_SENDER_ROLE_AGENT" | "CHAT_SENDER_ROLE_SYSTEM";
+
+export type PresenceStatus = "PRESENCE_STATUS_UNSPECIFIED" | "PRESENCE_STATUS_ONLINE" | "PRESENCE_STATUS_AWAY" | "PRESENCE_STATUS_OFFLINE";
+
+export interface Conversation {
+  id?: string;
+  /** 주문 관련 문의일 때 */
+  orderId?: string;
+  /** CS 티켓 관련 문의일 때 */
+  ticketId?: string;
+  customerId?: string;
+  agentId?: string;
+  createdAt?: string;
+  closedAt?: string;
+}
+
+export interface ChatMessage {
+  id?: string;
+  conversationId?: string;
+  senderId?: string;
+  senderRole?: ChatSenderRole;
+  text?: string;
+  /** 클라이언트 재전송 중복 제거용 */
+  clientMessageId?: string;
+  sentAt?: string;
+}
+
+export interface OpenConversationRequest {
+  orderId?: string;
+  ticketId?: string;
+}
+
+export interface OpenConversationResponse {
+  conversation?: Conversation | null;
+}
+
+export interface ListChatMessagesRequest {
+  conversationId?: string;
+  pageSize?: number;
+  pageToken?: string;
+}
+
+export interface ListChatMessagesResponse {
+  messages?: ChatMessage[];
+  nextPageToken?: string;
+}
+
+/** 참여자 접속 상태 (저장되지 않음) */
+export interface PresenceEvent {
+  userId?: string;
+  role?: ChatSenderRole;
+  status?: PresenceStatus;
+  at?: string;
+}
+
+/** 입력 중 표시 (저장되지 않음, 클라이언트는 수 초간 갱신이 없으면 typing=false로 간주) */
+export interface TypingEvent {
+  userId?: string;
+  role?: ChatSenderRole;
+  typing?: boolean;
+}
+
+export interface ChatRequest {
+  conversationId?: string;
+  /** id, sender, sent_at은 서버가 채움 */
+  message?: ChatMessage | null;
+  /** user_id, role, at은 서버가 채움 */
+  presence?: PresenceEvent | null;
+  typing?: TypingEvent | null;
+}
+
+export interface ChatResponse {
+  message?: ChatMessage | null;
+  presence?: PresenceEvent | null;
+  typing?: TypingEvent | null;
+}
+
@@ -0,0 +1,22 @@
+// Code generated by protoc-gen-tstypes. DO NOT EDIT.
+// source: codes.proto
+
+/**
+ * 카드사 (발급사 기준)
+ * kakao_code: 카카오페이 승인 응답 card_info.kakaopay_issuer_corp_code
+ * toss_code: 토스페이먼츠 card.issuerCode
+ */
+export type CardCompany = "CARD_COMPANY_UNSPECIFIED" | "CARD_COMPANY_BC" | "CARD_COMPANY_KB" | "CARD_COMPANY_SAMSUNG" | "CARD_COMPANY_SHINHAN" | "CARD_COMPANY_HYUNDAI" | "CARD_COMPANY_LOTTE" | "CARD_COMPANY_CITI" | "CARD_COMPANY_NH" | "CARD_COMPANY_SUHYUP" | "CARD_COMPANY_SHINHYUP" | "CARD_COMPANY_WOORI" | "CARD_COMPANY_HANA" | "CARD_COMPANY_KAKAOBANK" | "CARD_COMPANY_KBANK" | "CARD_COMPANY_TOSSBANK";
+
+/**
+ * 은행 (가상계좌/계좌이체/환불 계좌)
+ * toss_code: 토스페이먼츠 bankCode (2자리)
+ */
+export type Bank = "BANK_UNSPECIFIED" | "BANK_KDB" | "BANK_IBK" | "BANK_KB" | "BANK_SUHYUP" | "BANK_NH" | "BANK_WOORI" | "BANK_SC" | "BANK_CITI" | "BANK_DAEGU" | "BANK_BUSAN" | "BANK_GWANGJU" | "BANK_JEJU" | "BANK_JEONBUK" | "BANK_KYONGNAM" | "BANK_SAEMAUL" | "BANK_SHINHYUP" | "BANK_POST" | "BANK_HANA" | "BANK_SHINHAN" | "BANK_KBANK" | "BANK_KAKAOBANK" | "BANK_TOSSBANK";
+
+/**
+ * 택배사
+ * 배송 조회 링크는 UI마다 만들지 않고 tracking_url 옵션에서 생성 (Go: TrackingURL)
+ */
+export type Carrier = "CARRIER_UNSPECIFIED" | "CARRIER_EPOST" | "CARRIER_CJ_LOGISTICS" | "CARRIER_HANJIN" | "CARRIER_LOGEN" | "CARRIER_LOTTE" | "CARRIER_DAESIN" | "CARRIER_KDEXP" | "CARRIER_CU_POST";
+
@@ -0,0 +1,83 @@
+// Code generated by protoc-gen-tstypes. DO NOT EDIT.
+// source: common.proto
+
+/**
+ * 에러 사유 코드. 서버는 gRPC status details의 google.rpc.ErrorInfo로 전달
+ * (reason = 접두사 ERROR_REASON_을 뺀 이름, ex: "ACCOUNT_LOCKED", domain = "escape-ship")
+ * 게이트웨이는 이 코드로 Accept-Language에 맞는 메시지를 찾아 응답
+ */
+export type ErrorReason = "ERROR_REASON_UNSPECIFIED" | "ERROR_REASON_INVALID_CREDENTIALS" | "ERROR_REASON_ACCOUNT_LOCKED" | "ERROR_REASON_UNAUTHENTICATED" | "ERROR_REASON_MISSING_SCOPE" | "ERROR_REASON_UPGRADE_REQUIRED" | "ERROR_REASON_INVALID_PAGE_TOKEN" | "ERROR_REASON_PAGE_TOKEN_EXPIRED" | "ERROR_REASON_CAPTCHA_REQUIRED" | "ERROR_REASON_EMAIL_ALREADY_REGISTERED" | "ERROR_REASON_OUT_OF_STOCK" | "ERROR_REASON_PURCHASE_LIMIT_EXCEEDED" | "ERROR_REASON_PAYMENT_DECLINED" | "ERROR_REASON_BLOCKED" | "ERROR_REASON_INVALID_SIGNATURE" | "ERROR_REASON_ORDER_NOT_CANCELLABLE" | "ERROR_REASON_REFUND_EXCEEDS_PAYMENT" | "ERROR_REASON_INVALID_ADDRESS";
+
+/** 해외 결제 시 표시 통화 환율 스냅샷 (정산은 항상 base_currency(KRW) 기준) */
+export interface FxSnapshot {
+  /** 정산 통화, 현재 항상 "KRW" */
+  baseCurrency?: string;
+  /** 정산 금액 (base_currency 최소 단위) */
+  baseAmount?: string;
+  /** 고객에게 표시한 통화 ISO 4217 (ex: "USD") */
+  displayCurrency?: string;
+  /** 표시 금액 (display_currency 최소 단위, ex: cents) */
+  displayAmount?: string;
+  /** 1 base_currency 당 display_currency 환율, 10진수 문자열 (ex: "0.000731") */
+  fxRate?: string;
+  /** 환율 적용 시각 (RFC3339) */
+  capturedAt?: string;
+}
+
+/**
+ * 로그인/결제 요청의 디바이스 및 세션 식별 정보 (위험도 평가용)
+ * 게이트웨이 경유 요청은 X-Device-Id 등 헤더에서 서버가 자동으로 채움
+ */
+export interface DeviceFingerprint {
+  /** 앱 설치 단위 식별자 또는 웹 쿠키 ID */
+  deviceId?: string;
+  sessionId?: string;
+  /** 클라이언트 SDK가 계산한 브라우저/디바이스 지문 해시 */
+  fingerprint?: string;
+  userAgent?: string;
+  ipAddress?: string;
+}
+
+/**
+ * 예상 배송일 (배송비/배송 옵션 응답에 포함, 날짜는 KST 기준 YYYY-MM-DD)
+ * 주말·공휴일을 제외한 영업일로 계산하며, 출고 마감 시각 이후 주문은 다음 영업일 출고
+ */
+export interface DeliveryEstimate {
+  /** 출고 예정일 */
+  shipDate?: string;
+  /** 도착 예정일 (최소) */
+  earliestDate?: string;
+  /** 도착 예정일 (최대) */
+  latestDate?: string;
+  /** 출고일로부터 영업일 기준 최소 소요일 */
+  minBusinessDays?: number;
+  maxBusinessDays?: number;
+}
+
+/**
+ * 최소 지원 버전 미만 클라이언트 거부 시 gRPC status details로 전달 (FailedPrecondition)
+ * 클라이언트는 요청 메타데이터 x-client-name / x-client-version으로 빌드를 알림
+ */
+export interface UpgradeRequired {
+  clientName?: string;
+  /** 요청한 클라이언트 버전 (미전송 시 빈 문자열) */
+  clientVersion?: string;
+  /** 지원되는 최소 버전 (ex: "2.3.0") */
+  minimumVersion?: string;
+  /** 앱스토어/다운로드 링크 (선택) */
+  upgradeUrl?: string;
+}
+
+/**
+ * 통화와 금액 (google.type.Money와 같은 구조)
+ * units는 통화의 정수 단위, nanos는 10^-9 단위 소수부이며 부호는 units와 같아야 함
+ * ex: USD 1.75 = {currency_code: "USD", units: 1, nanos: 750000000}, KRW 25,000원 = {currency_code: "KRW", units: 25000}
+ */
+export interface Money {
+  /** ISO 4217 (ex: "KRW") */
+  currencyCode?: string;
+  units?: string;
+  /** -999,999,999 ~ +999,999,999 */
+  nanos?: number;
+}
+
@@ -0,0 +1,86 @@
+// Code generated by protoc-gen-tstypes. DO NOT EDIT.
+// source: flashsale.proto
+
+export type FlashSaleStatus = "FLASH_SALE_STATUS_UNSPECIFIED" | "FLASH_SALE_STATUS_SCHEDULED" | "FLASH_SALE_STATUS_ACTIVE" | "FLASH_SALE_STATUS_SOLD_OUT" | "FLASH_SALE_STATUS_ENDED";
+
+export interface FlashSale {
+  id?: string;
+  productId?: string;
+  salePrice?: string;
+  /** 세일 전체 판매 수량 상한 */
+  quantityCap?: number;
+  remainingQuantity?: number;
+  /** RFC3339 */
+  startAt?: string;
+  /** RFC3339 */
+  endAt?: string;
+  status?: FlashSaleStatus;
+  /** 고객당 최대 구매 수량, 0이면 제한 없음 */
+  maxPerCustomer?: number;
+}
+
+export interface CreateFlashSaleRequest {
+  productId?: string;
+  salePrice?: string;
+  quantityCap?: number;
+  startAt?: string;
+  endAt?: string;
+  maxPerCustomer?: number;
+}
+
+export interface CreateFlashSaleResponse {
+  flashSale?: FlashSale | null;
+}
+
+export interface GetFlashSaleRequest {
+  flashSaleId?: string;
+}
+
+export interface GetFlashSaleResponse {
+  flashSale?: FlashSale | null;
+}
+
+export interface GetQueuePositionRequest {
+  flashSaleId?: string;
+  userId?: string;
+}
+
+export interface GetQueuePositionResponse {
+  /** 1부터 시작, 0이면 대기열에 없음 */
+  position?: string;
+  queueLength?: string;
+  remainingQuantity?: number;
+  /** true면 대기 중단 안내 */
+  soldOut?: boolean;
+}
+
+export interface IssueQueueTokenRequest {
+  flashSaleId?: string;
+  userId?: string;
+}
+
+export interface IssueQueueTokenResponse {
+  /** 불투명 토큰, x-queue-token 헤더로 전달 */
+  queueToken?: string;
+  position?: string;
+  estimatedWaitSeconds?: string;
+  /** true면 즉시 결제 진입 가능 */
+  admitted?: boolean;
+  expiresAt?: string;
+}
+
+export interface ValidateQueueTokenRequest {
+  queueToken?: string;
+}
+
+export interface ValidateQueueTokenResponse {
+  valid?: boolean;
+  /** valid && admitted 일 때만 결제 진입 허용 */
+  admitted?: boolean;
+  flashSaleId?: string;
+  userId?: string;
+  position?: string;
+  estimatedWaitSeconds?: string;
+  expiresAt?: string;
+}
+
@@ -0,0 +1,19 @@
+// Code generated by protoc-gen-tstypes. DO NOT EDIT.
+
+export * from "./account";
+export * from "./calendar";
+export * from "./cart";
+export * from "./chat";
+export * from "./codes";
+export * from "./common";
+export * from "./flashsale";
+export * from "./inventory";
+export * from "./notification";
+export * from "./order";
+export * from "./payment";
+export * from "./product";
+export * from "./review";
+export * from "./risk";
+export * from "./shipping";
+export * from "./subscription";
+export * from "./wishlist";
@@ -0,0 +1,135 @@
+// Code generated by protoc-gen-tstypes. DO NOT EDIT.
+// source: inventory.proto
+
+/** 재고 불일치 유형 */
+export type InventoryDiscrepancyKind = "INVENTORY_DISCREPANCY_KIND_UNSPECIFIED" | "INVENTORY_DISCREPANCY_KIND_OVERSOLD" | "INVENTORY_DISCREPANCY_KIND_RESERVED_MISMATCH" | "INVENTORY_DISCREPANCY_KIND_EXPIRED_RESERVATION" | "INVENTORY_DISCREPANCY_KIND_ORPHANED_RESERVATION" | "INVENTORY_DISCREPANCY_KIND_NEGATIVE_ON_HAND";
+
+/** 재고 보정 방법 */
+export type InventoryCorrectionAction = "INVENTORY_CORRECTION_ACTION_UNSPECIFIED" | "INVENTORY_CORRECTION_ACTION_ADJUST_STOCK" | "INVENTORY_CORRECTION_ACTION_RELEASE_RESERVATION" | "INVENTORY_CORRECTION_ACTION_RECOUNT_RESERVED" | "INVENTORY_CORRECTION_ACTION_MANUAL_REVIEW";
+
+/** 상품 재고 수준 */
+export interface StockLevel {
+  productId?: string;
+  productName?: string;
+  /** on_hand_quantity - reserved_quantity */
+  availableQuantity?: string;
+  updatedAt?: string;
+  /** 옵션 조합, 옵션 없는 상품은 비어 있음 */
+  options?: { [key: string]: string };
+  /** 창고 보유 수량 */
+  onHandQuantity?: string;
+  /** 주문 진행 중 선점된 수량 */
+  reservedQuantity?: string;
+}
+
+/** 재고 단위 (상품 + 옵션 조합) */
+export interface StockKey {
+  productId?: string;
+  /** 옵션명 → 옵션값 (ex: {"size": "M", "color": "Blue"}) */
+  options?: { [key: string]: string };
+}
+
+export interface GetStockRequest {
+  productId?: string;
+}
+
+export interface GetStockResponse {
+  /** 옵션 조합별 재고 */
+  stocks?: StockLevel[];
+}
+
+export interface StockReservationItem {
+  key?: StockKey | null;
+  quantity?: string;
+}
+
+/** 재고 선점 */
+export interface StockReservation {
+  id?: string;
+  orderId?: string;
+  items?: StockReservationItem[];
+  createdAt?: string;
+  /** 이후 자동 해제 */
+  expiresAt?: string;
+}
+
+export interface ReserveStockRequest {
+  orderId?: string;
+  items?: StockReservationItem[];
+  /** 0이면 서버 기본값 */
+  ttlSeconds?: number;
+}
+
+export interface ReserveStockResponse {
+  reservation?: StockReservation | null;
+}
+
+export interface ReleaseReservationRequest {
+  reservationId?: string;
+  /** ex: "payment_failed", "order_cancelled" */
+  reason?: string;
+}
+
+export type ReleaseReservationResponse = Record<string, never>;
+
+export interface AdjustStockRequest {
+  key?: StockKey | null;
+  /** 증감 수량 (입고 +, 파손/분실 -) */
+  delta?: string;
+  /** ex: "restock", "stocktake", "damaged" */
+  reason?: string;
+}
+
+export interface AdjustStockResponse {
+  stock?: StockLevel | null;
+}
+
+export interface WatchLowStockRequest {
+  threshold?: string;
+  /** 비어 있으면 전체 상품 감시 */
+  productIds?: string[];
+}
+
+export interface WatchLowStockResponse {
+  stock?: StockLevel | null;
+  threshold?: string;
+}
+
+/** 제안된 보정 */
+export interface InventoryCorrection {
+  action?: InventoryCorrectionAction;
+  adjust?: AdjustStockRequest | null;
+  release?: ReleaseReservationRequest | null;
+}
+
+export interface InventoryDiscrepancy {
+  kind?: InventoryDiscrepancyKind;
+  /** 선점 관련 불일치는 비어 있을 수 있음 */
+  key?: StockKey | null;
+  /** EXPIRED/ORPHANED_RESERVATION일 때 설정 */
+  reservationId?: string;
+  orderId?: string;
+  /** 선점·주문 기준으로 계산한 수량 */
+  expectedQuantity?: string;
+  /** 저장된 수량 */
+  actualQuantity?: string;
+  correction?: InventoryCorrection | null;
+  detail?: string;
+}
+
+export interface AuditInventoryRequest {
+  /** 비어 있으면 전체 상품 점검 */
+  productIds?: string[];
+  /** 0이면 서버 기본값, 초과 시 truncated */
+  maxDiscrepancies?: number;
+}
+
+export interface AuditInventoryResponse {
+  discrepancies?: InventoryDiscrepancy[];
+  /** 점검한 재고 단위(상품 + 옵션 조합) 수 */
+  checkedStockCount?: string;
+  checkedReservationCount?: string;
+  truncated?: boolean;
+  auditedAt?: string;
+}
+
@@ -0,0 +1,146 @@
+// Code generated by protoc-gen-tstypes. DO NOT EDIT.
+// source: notification.proto
+
+export type NotificationChannel = "NOTIFICATION_CHANNEL_UNSPECIFIED" | "NOTIFICATION_CHANNEL_EMAIL" | "NOTIFICATION_CHANNEL_SMS" | "NOTIFICATION_CHANNEL_PUSH";
+
+export type NotificationCategory = "NOTIFICATION_CATEGORY_UNSPECIFIED" | "NOTIFICATION_CATEGORY_ORDER_UPDATES" | "NOTIFICATION_CATEGORY_MARKETING" | "NOTIFICATION_CATEGORY_RESTOCK_ALERTS";
+
+/** 발송 상태 */
+export type NotificationDeliveryStatus = "NOTIFICATION_DELIVERY_STATUS_UNSPECIFIED" | "NOTIFICATION_DELIVERY_STATUS_QUEUED" | "NOTIFICATION_DELIVERY_STATUS_SENT" | "NOTIFICATION_DELIVERY_STATUS_DELIVERED" | "NOTIFICATION_DELIVERY_STATUS_FAILED" | "NOTIFICATION_DELIVERY_STATUS_SUPPRESSED";
+
+export interface NotificationPreference {
+  channel?: NotificationChannel;
+  category?: NotificationCategory;
+  enabled?: boolean;
+}
+
+export type GetNotificationPreferencesRequest = Record<string, never>;
+
+export interface GetNotificationPreferencesResponse {
+  preferences?: NotificationPreference[];
+}
+
+export interface UpdateNotificationPreferencesRequest {
+  /** 포함된 (channel, category) 조합만 변경, 나머지는 유지 */
+  preferences?: NotificationPreference[];
+}
+
+export interface UpdateNotificationPreferencesResponse {
+  preferences?: NotificationPreference[];
+}
+
+/** 알림함에 표시되는 알림 */
+export interface Notification {
+  id?: string;
+  category?: NotificationCategory;
+  title?: string;
+  body?: string;
+  /** 클릭 시 이동할 앱/웹 경로 (ex: "/orders/{id}") */
+  linkUrl?: string;
+  read?: boolean;
+  createdAt?: string;
+  readAt?: string;
+}
+
+export interface ListNotificationsRequest {
+  pageSize?: number;
+  pageToken?: string;
+  unreadOnly?: boolean;
+}
+
+export interface ListNotificationsResponse {
+  notifications?: Notification[];
+  nextPageToken?: string;
+  unreadCount?: number;
+}
+
+export interface MarkNotificationReadRequest {
+  notificationId?: string;
+}
+
+export interface MarkNotificationReadResponse {
+  unreadCount?: number;
+}
+
+/**
+ * 템플릿 발송: 서버에 등록된 템플릿의 {name} 자리에 params 값을 채움
+ * template_id가 있으면 직접 입력한 제목/본문은 무시
+ */
+export interface NotificationTemplate {
+  /** ex: "order_confirmed" (Go: TemplateOrderConfirmed) */
+  templateId?: string;
+  /** ex: {"order_number": "ORD-2024-001"} */
+  params?: { [key: string]: string };
+  /** BCP 47 (ex: "ko", "en-US"), 비어 있으면 사용자 설정 언어 */
+  locale?: string;
+}
+
+export interface SendEmailRequest {
+  userId?: string;
+  /** 비어 있으면 사용자 계정 이메일 */
+  to?: string;
+  /** 수신 설정 확인용 */
+  category?: NotificationCategory;
+  template?: NotificationTemplate | null;
+  /** 템플릿 미사용 시 */
+  subject?: string;
+  /** 템플릿 미사용 시 (HTML) */
+  body?: string;
+  /** ex: "order_confirmed:{order_id}" */
+  dedupKey?: string;
+}
+
+export interface SendSMSRequest {
+  userId?: string;
+  /** 비어 있으면 사용자 계정 전화번호 */
+  phoneNumber?: string;
+  category?: NotificationCategory;
+  template?: NotificationTemplate | null;
+  /** 템플릿 미사용 시 (90바이트 초과 시 LMS) */
+  text?: string;
+  dedupKey?: string;
+}
+
+export interface SendPushRequest {
+  userId?: string;
+  category?: NotificationCategory;
+  template?: NotificationTemplate | null;
+  /** 템플릿 미사용 시 */
+  title?: string;
+  body?: string;
+  /** 클릭 시 이동할 앱 경로 (ex: "/orders/{id}") */
+  linkUrl?: string;
+  /** 앱으로 전달할 추가 데이터 */
+  data?: { [key: string]: string };
+  dedupKey?: string;
+  /** 알림함(ListNotifications)에도 저장 */
+  saveToInbox?: boolean;
+}
+
+export interface SendNotificationResponse {
+  messageId?: string;
+  status?: NotificationDeliveryStatus;
+}
+
+export interface SubscribeEventsRequest {
+  /** 비어 있으면 전체 채널 */
+  channels?: NotificationChannel[];
+  /** 비어 있으면 전체 카테고리 */
+  categories?: NotificationCategory[];
+  /** 비어 있으면 전체 사용자 */
+  userId?: string;
+}
+
+/** 발송 상태 변경 이벤트 */
+export interface NotificationEvent {
+  messageId?: string;
+  userId?: string;
+  channel?: NotificationChannel;
+  category?: NotificationCategory;
+  status?: NotificationDeliveryStatus;
+  templateId?: string;
+  dedupKey?: string;
+  failureReason?: string;
+  occurredAt?: string;
+}
+
@@ -0,0 +1,537 @@
+// Code generated by protoc-gen-tstypes. DO NOT EDIT.
+// source: order.proto
+
+import type { DeviceFingerprint, FxSnapshot, Money } from "./common";
+import type { BundleComponent } from "./product";
+import type { Address, TrackingEvent } from "./shipping";
+
+/** 주문 상태 */
+export type OrderStatus = "ORDER_STATUS_UNSPECIFIED" | "ORDER_STATUS_PENDING" | "ORDER_STATUS_PAID" | "ORDER_STATUS_SHIPPED" | "ORDER_STATUS_DELIVERED" | "ORDER_STATUS_CANCELLED" | "ORDER_STATUS_REFUNDED";
+
+/** 환불 상태 */
+export type RefundStatus = "REFUND_STATUS_UNSPECIFIED" | "REFUND_STATUS_PENDING" | "REFUND_STATUS_COMPLETED" | "REFUND_STATUS_FAILED";
+
+export type QuoteStatus = "QUOTE_STATUS_UNSPECIFIED" | "QUOTE_STATUS_PENDING" | "QUOTE_STATUS_ACCEPTED" | "QUOTE_STATUS_CONVERTED" | "QUOTE_STATUS_EXPIRED";
+
+/** 가격 계산 시점 */
+export type PricingStage = "PRICING_STAGE_UNSPECIFIED" | "PRICING_STAGE_CART" | "PRICING_STAGE_CHECKOUT" | "PRICING_STAGE_REFUND";
+
+export interface Order {
+  id?: string;
+  userId?: string;
+  orderNumber?: string;
+  /** 이전 버전의 문자열 상태 ("pending", "paid" 등), order_status로 대체됨 */
+  status?: string;
+  totalPrice?: string;
+  quantity?: number;
+  paymentMethod?: string;
+  /** 0은 무료배송, 미설정은 배송비 미산정 (이전 버전 주문) */
+  shippingFee?: number | null;
+  /** 이전 버전의 문자열 배송지, delivery_address로 대체됨 */
+  shippingAddress?: string;
+  /** 이전 버전의 RFC3339 문자열 시각, ordered_time/paid_time으로 대체됨 */
+  orderedAt?: string;
+  paidAt?: string;
+  memo?: string;
+  items?: OrderItem[];
+  /** 해외 배송 주문만 설정 */
+  customs?: CustomsDeclaration | null;
+  /** 외화 표시 주문만 설정, total_price는 KRW 정산 금액 */
+  fx?: FxSnapshot | null;
+  /** 외상(net terms) 주문만 설정, payment_method는 "net_terms" */
+  paymentTerms?: PaymentTerms | null;
+  orderStatus?: OrderStatus;
+  /** 환불 내역 (요청 순) */
+  refunds?: Refund[];
+  /** 완료된 환불 누계, 결제 금액과 같아지면 order_status는 REFUNDED */
+  refundedAmount?: Money | null;
+  /** 배송지 */
+  deliveryAddress?: Address | null;
+  orderedTime?: string;
+  /** 결제 전이면 미설정 */
+  paidTime?: string;
+  /** 결제에 사용한 카카오페이 가맹점 코드 (KakaoReadyRequest.cid), 취소도 같은 코드로 요청 */
+  paymentCid?: string;
+}
+
+/** 환불 대상 주문 항목 (부분 환불) */
+export interface RefundItem {
+  orderItemId?: string;
+  quantity?: number;
+  /** 할인 배분을 반영한 항목 환불 금액 (AllocateRefund가 계산) */
+  amount?: Money | null;
+}
+
+export interface Refund {
+  id?: string;
+  orderId?: string;
+  status?: RefundStatus;
+  /** 환불 금액 */
+  amount?: Money | null;
+  /** 환불 금액 중 비과세 */
+  taxFreeAmount?: Money | null;
+  /** 환불 금액 중 부가세 */
+  vatAmount?: Money | null;
+  /** 항목 단위 환불일 때 설정 */
+  items?: RefundItem[];
+  reason?: string;
+  /** KakaoCancel에 전달한 결제 주문 ID */
+  partnerOrderId?: string;
+  failureReason?: string;
+  /** 이전 버전의 RFC3339 문자열 시각, requested_time/completed_time으로 대체됨 */
+  requestedAt?: string;
+  completedAt?: string;
+  /** amount 중 배송비 환불분 */
+  shippingAmount?: Money | null;
+  requestedTime?: string;
+  /** COMPLETED/FAILED 처리 시각 */
+  completedTime?: string;
+  /** KakaoCancel에 전달한 가맹점 코드, 비어 있으면 Order.payment_cid */
+  cid?: string;
+}
+
+/** 외상 결제 조건 (ex: Net 30 = 주문일로부터 30일 이내 결제) */
+export interface PaymentTerms {
+  netDays?: number;
+  /** 결제 기한 (YYYY-MM-DD) */
+  dueDate?: string;
+}
+
+/** 해외 배송 통관 신고 정보 */
+export interface CustomsDeclaration {
+  /** 개인통관고유부호 (ex: "P123456789012") */
+  personalCustomsCode?: string;
+  /** ISO 3166-1 alpha-2 (ex: "US") */
+  destinationCountry?: string;
+  /** ISO 4217 (ex: "USD") */
+  declaredCurrency?: string;
+  /** 신고 총액 (declared_currency 최소 단위) */
+  declaredValue?: string;
+  items?: CustomsItem[];
+}
+
+export interface CustomsItem {
+  productId?: string;
+  /** HS 품목 분류 코드 (ex: "6109.10") */
+  hsCode?: string;
+  /** 영문 품명 */
+  description?: string;
+  quantity?: number;
+  /** 품목별 신고 금액 (declared_currency 최소 단위) */
+  declaredValue?: string;
+  /** 원산지 ISO 3166-1 alpha-2 */
+  originCountry?: string;
+}
+
+export interface OrderItem {
+  id?: string;
+  orderId?: string;
+  productId?: string;
+  productName?: string;
+  /** KRW 원 단위, unit_price로 대체됨 */
+  productPrice?: string;
+  quantity?: number;
+  /** 번들 주문 항목일 때 설정 */
+  bundleId?: string;
+  /** 출고용으로 전개된 번들 구성품 */
+  bundleComponents?: BundleComponent[];
+  /** 주문 시점 단가 */
+  unitPrice?: Money | null;
+}
+
+export interface InsertOrderRequest {
+  userId?: string;
+  orderNumber?: string;
+  /** 이전 버전의 문자열 상태, order_status로 대체됨 */
+  status?: string;
+  totalPrice?: string;
+  quantity?: number;
+  paymentMethod?: string;
+  /** 0은 무료배송, 미설정이면 서버가 배송비 정책으로 계산 */
+  shippingFee?: number | null;
+  /** 이전 버전의 문자열 배송지, delivery_address로 대체됨 */
+  shippingAddress?: string;
+  /** 이전 버전의 RFC3339 문자열 시각, paid_time으로 대체됨 */
+  paidAt?: string;
+  memo?: string;
+  items?: InsertOrderItem[];
+  /** 해외 배송 주문만 설정 */
+  customs?: CustomsDeclaration | null;
+  /** 외화 표시 주문만 설정, total_price는 KRW 정산 금액 */
+  fx?: FxSnapshot | null;
+  device?: DeviceFingerprint | null;
+  orderStatus?: OrderStatus;
+  /** 배송지 (Validate로 검증) */
+  deliveryAddress?: Address | null;
+  /**
+   * 재시도 시 중복 처리를 막는 키 (논리적 작업마다 클라이언트가 생성, 재시도에는 같은 값 사용)
+   * 같은 키로 다시 요청하면 서버는 처리하지 않고 처음 응답을 반환, 요청 내용이 다르면 ALREADY_EXISTS
+   * 비어 있으면 Idempotency-Key 헤더 값을 사용 (UnaryIdempotencyKeyInterceptor가 설정)
+   */
+  idempotencyKey?: string;
+  paidTime?: string;
+}
+
+export interface InsertOrderItem {
+  productId?: string;
+  productName?: string;
+  productOptions?: string;
+  /** 수량 구간 가격 적용 단가, 서버가 Product.UnitPrice(quantity)와 비교해 검증 */
+  productPrice?: string;
+  quantity?: number;
+  /** 번들 주문 시 설정, 서버가 구성품으로 전개 */
+  bundleId?: string;
+}
+
+export interface InsertOrderResponse {
+  id?: string;
+}
+
+export interface GetAllOrdersRequest {
+  /** 응답에 포함할 Order 필드 (ex: "id,order_status,total_price"), 비어 있으면 전체 필드 */
+  readMask?: string;
+  /** 페이지 크기 (0이면 서버 기본값, 최대 100) */
+  pageSize?: number;
+  /** 이전 응답의 next_page_token, 첫 페이지는 비워 둠 */
+  pageToken?: string;
+}
+
+export interface WatchOrderRequest {
+  orderId?: string;
+}
+
+/** 주문 상태 변경 이벤트 */
+export interface OrderStatusEvent {
+  orderId?: string;
+  status?: OrderStatus;
+  /** 구독 직후 첫 이벤트는 UNSPECIFIED */
+  previousStatus?: OrderStatus;
+  /** 이전 버전의 RFC3339 문자열 시각, changed_time으로 대체됨 */
+  changedAt?: string;
+  /** 취소/환불 사유 등 (선택) */
+  reason?: string;
+  /** SHIPPED/DELIVERED 변경일 때 원인이 된 배송 추적 이벤트 */
+  tracking?: TrackingEvent | null;
+  changedTime?: string;
+}
+
+export interface CancelOrderRequest {
+  orderId?: string;
+  reason?: string;
+  /** InsertOrderRequest.idempotency_key 참고 */
+  idempotencyKey?: string;
+}
+
+export interface CancelOrderResponse {
+  order?: Order | null;
+  /** 결제 완료 주문을 취소한 경우 전액 환불 내역 */
+  refund?: Refund | null;
+}
+
+export interface RefundOrderRequest {
+  orderId?: string;
+  /** 환불할 항목, 비어 있으면 amount 기준 (둘 다 비어 있으면 남은 금액 전액) */
+  items?: RefundItem[];
+  /** 금액 기준 부분 환불 (배송비 등), items가 있으면 무시 */
+  amount?: Money | null;
+  taxFreeAmount?: Money | null;
+  reason?: string;
+  /** InsertOrderRequest.idempotency_key 참고 */
+  idempotencyKey?: string;
+}
+
+export interface RefundOrderResponse {
+  order?: Order | null;
+  refund?: Refund | null;
+}
+
+export interface GetAllOrdersResponse {
+  orders?: Order[];
+  /** 다음 페이지 토큰, 마지막 페이지면 빈 문자열 */
+  nextPageToken?: string;
+  /** 전체 주문 수 */
+  totalCount?: number;
+}
+
+/** 반품 수거 예약 및 라벨 정보 */
+export interface ReturnLabel {
+  returnId?: string;
+  carrier?: string;
+  trackingNumber?: string;
+  /** 출력용 라벨 (PDF) URL */
+  labelUrl?: string;
+  /** 택배사 수거 예약 번호 */
+  pickupBookingId?: string;
+  pickupDate?: string;
+  /** 이전 버전의 RFC3339 문자열 시각, created_time으로 대체됨 */
+  createdAt?: string;
+  /** 반품 배송 추적 이력 (발생 순) */
+  events?: TrackingEvent[];
+  createdTime?: string;
+}
+
+export interface CreateReturnLabelRequest {
+  returnId?: string;
+  /** 비어 있으면 기본 택배사 사용 */
+  carrier?: string;
+  /** 이전 버전의 문자열 주소, pickup으로 대체됨 */
+  pickupAddress?: string;
+  /** 희망 수거일 (YYYY-MM-DD) */
+  pickupDate?: string;
+  /** 수거지 */
+  pickup?: Address | null;
+}
+
+export interface CreateReturnLabelResponse {
+  label?: ReturnLabel | null;
+}
+
+export interface ImportOrdersRequest {
+  /** 원본 CSV 행 번호 (결과 매칭용) */
+  rowNumber?: number;
+  /** ex) "call_center", "marketplace" */
+  source?: string;
+  order?: InsertOrderRequest | null;
+}
+
+export interface ImportOrderRowResult {
+  rowNumber?: number;
+  success?: boolean;
+  /** 성공 시 생성된 주문 ID */
+  orderId?: string;
+  /** 실패 시 검증 오류 목록 */
+  errors?: string[];
+}
+
+export interface ImportOrdersResponse {
+  totalRows?: number;
+  importedCount?: number;
+  failedCount?: number;
+  results?: ImportOrderRowResult[];
+}
+
+export interface GetOrderByIDRequest {
+  id?: string;
+  /** 응답에 포함할 Order 필드, 비어 있으면 전체 필드 */
+  readMask?: string;
+}
+
+export interface GetOrderByIDResponse {
+  order?: Order | null;
+}
+
+export interface GetOrdersByUserRequest {
+  userId?: string;
+  /** 조회할 주문 상태 (ex: PAID, SHIPPED), 비어 있으면 전체 */
+  statuses?: OrderStatus[];
+  /** 페이지 크기 (0이면 서버 기본값, 최대 100) */
+  pageSize?: number;
+  /** 이전 응답의 next_page_token, 첫 페이지는 비워 둠 */
+  pageToken?: string;
+  /** 응답에 포함할 Order 필드, 비어 있으면 전체 필드 */
+  readMask?: string;
+}
+
+export interface GetOrdersByUserResponse {
+  orders?: Order[];
+  /** 다음 페이지 토큰, 마지막 페이지면 빈 문자열 */
+  nextPageToken?: string;
+  /** 상태 조건에 맞는 전체 주문 수 */
+  totalCount?: number;
+}
+
+export interface GetOrdersByIDsRequest {
+  ids?: string[];
+}
+
+export interface GetOrdersByIDsResponse {
+  /** 요청 순서대로, 찾은 주문만 포함 */
+  orders?: Order[];
+  notFoundIds?: string[];
+}
+
+export interface ArchiveOrdersRequest {
+  /** 이 날짜 이전(ordered_at 기준) 주문을 아카이브 (YYYY-MM-DD) */
+  beforeDate?: string;
+  /** 0이면 서버 기본값 사용 */
+  batchSize?: number;
+}
+
+export interface ArchiveOrdersResponse {
+  archivedCount?: string;
+}
+
+export interface GetArchivedOrderRequest {
+  id?: string;
+}
+
+export interface GetArchivedOrderResponse {
+  order?: Order | null;
+  /** 이전 버전의 RFC3339 문자열 시각, archived_time으로 대체됨 */
+  archivedAt?: string;
+  archivedTime?: string;
+}
+
+export interface QuoteItem {
+  productId?: string;
+  productName?: string;
+  quantity?: number;
+  /** 협의 단가 */
+  unitPrice?: string;
+}
+
+export interface Quote {
+  id?: string;
+  userId?: string;
+  companyName?: string;
+  /** 사업자등록번호 */
+  businessRegistrationNumber?: string;
+  items?: QuoteItem[];
+  totalPrice?: string;
+  status?: QuoteStatus;
+  paymentTerms?: PaymentTerms | null;
+  validUntil?: string;
+  /** 주문 전환 후 설정 */
+  orderId?: string;
+  /** 이전 버전의 RFC3339 문자열 시각, created_time으로 대체됨 */
+  createdAt?: string;
+  createdTime?: string;
+}
+
+export interface CreateQuoteRequest {
+  userId?: string;
+  companyName?: string;
+  businessRegistrationNumber?: string;
+  items?: QuoteItem[];
+  paymentTerms?: PaymentTerms | null;
+  validUntil?: string;
+}
+
+export interface CreateQuoteResponse {
+  quote?: Quote | null;
+}
+
+export interface AcceptQuoteRequest {
+  quoteId?: string;
+}
+
+export interface AcceptQuoteResponse {
+  quote?: Quote | null;
+}
+
+export interface ConvertQuoteToOrderRequest {
+  quoteId?: string;
+  shippingAddress?: string;
+  memo?: string;
+}
+
+export interface ConvertQuoteToOrderResponse {
+  orderId?: string;
+}
+
+export interface EligibilityItem {
+  productId?: string;
+  /** 타임세일 구매일 때 설정 */
+  flashSaleId?: string;
+  quantity?: number;
+}
+
+/** 구매 제한 초과 항목 */
+export interface PurchaseLimitViolation {
+  productId?: string;
+  flashSaleId?: string;
+  requestedQuantity?: number;
+  alreadyPurchased?: number;
+  maxPerCustomer?: number;
+}
+
+export interface CheckPurchaseEligibilityRequest {
+  userId?: string;
+  items?: EligibilityItem[];
+}
+
+export interface CheckPurchaseEligibilityResponse {
+  eligible?: boolean;
+  violations?: PurchaseLimitViolation[];
+}
+
+export interface PriceLineInput {
+  /** 장바구니/주문 항목 ID (결과 매칭용) */
+  lineId?: string;
+  productId?: string;
+  quantity?: number;
+  /** 서버가 수량 구간 가격(Product.UnitPrice)으로 채움, 다른 값을 보내면 INVALID_ARGUMENT */
+  unitPrice?: Money | null;
+}
+
+export interface PriceOrderRequest {
+  userId?: string;
+  items?: PriceLineInput[];
+  couponCodes?: string[];
+  shippingFee?: Money | null;
+  stage?: PricingStage;
+  /** 이전 버전의 RFC3339 문자열 시각, priced_time으로 대체됨 */
+  pricedAt?: string;
+  /** 프로모션 유효 기간 판단 시각, 비어 있으면 현재 시각 */
+  pricedTime?: string;
+}
+
+/** 적용된 프로모션 */
+export interface AppliedPromotion {
+  promotionId?: string;
+  name?: string;
+  /** 쿠폰으로 적용된 경우 */
+  couponCode?: string;
+  /** 이 프로모션의 총 할인 금액 (항목 배분 합계) */
+  discount?: Money | null;
+}
+
+/** 적용되지 않은 쿠폰/프로모션과 사유 */
+export interface RejectedPromotion {
+  promotionId?: string;
+  couponCode?: string;
+  /** ex) "minimum_amount", "expired", "not_combinable" */
+  reason?: string;
+}
+
+/** 프로모션 할인의 항목별 배분 */
+export interface DiscountAllocation {
+  promotionId?: string;
+  amount?: Money | null;
+}
+
+export interface PricedLine {
+  lineId?: string;
+  unitPrice?: Money | null;
+  /** unit_price × quantity */
+  subtotal?: Money | null;
+  allocations?: DiscountAllocation[];
+  /** allocations 합계 */
+  discount?: Money | null;
+  /** subtotal - discount */
+  total?: Money | null;
+  quantity?: number;
+  /** 면세 품목 (도서, 농산물 등), 환불 시 부가세 계산에서 제외 */
+  taxFree?: boolean;
+}
+
+export interface PriceOrderResponse {
+  /** 요청 항목 순서대로 */
+  lines?: PricedLine[];
+  appliedPromotions?: AppliedPromotion[];
+  rejectedPromotions?: RejectedPromotion[];
+  subtotal?: Money | null;
+  /** lines의 discount 합계 */
+  discountTotal?: Money | null;
+  shippingFee?: Money | null;
+  /** subtotal - discount_total + shipping_fee */
+  total?: Money | null;
+  /** 10원 단위 배분 후 항목에 나누지 못한 할인 잔액 (discount_total에 포함되지 않음) */
+  roundingRemainder?: Money | null;
+  /** 적용된 프로모션 규칙 버전 (환불 시 재현용) */
+  rulesVersion?: string;
+  /** 이전 버전의 RFC3339 문자열 시각, priced_time으로 대체됨 */
+  pricedAt?: string;
+  pricedTime?: string;
+}
+
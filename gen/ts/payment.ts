@@ -0,0 +1,222 @@
+// Code generated by protoc-gen-tstypes. DO NOT EDIT.
+// source: payment.proto
+
+import type { Bank, CardCompany } from "./codes";
+import type { DeviceFingerprint, FxSnapshot, Money } from "./common";
+
+/** 결제 대행사(PG) */
+export type PaymentProvider = "PAYMENT_PROVIDER_UNSPECIFIED" | "PAYMENT_PROVIDER_KAKAO_PAY" | "PAYMENT_PROVIDER_TOSS_PAYMENTS";
+
+/** 결제 상태 */
+export type PaymentStatus = "PAYMENT_STATUS_UNSPECIFIED" | "PAYMENT_STATUS_READY" | "PAYMENT_STATUS_APPROVED" | "PAYMENT_STATUS_PARTIALLY_CANCELED" | "PAYMENT_STATUS_CANCELED" | "PAYMENT_STATUS_FAILED";
+
+export interface Payment {
+  id?: string;
+  provider?: PaymentProvider;
+  orderId?: string;
+  userId?: string;
+  status?: PaymentStatus;
+  /** 결제 금액 */
+  amount?: Money | null;
+  /** 취소 누계 */
+  canceledAmount?: Money | null;
+  /** PG사 결제 ID (카카오페이 tid, 토스페이먼츠 paymentKey) */
+  providerPaymentId?: string;
+  /** 결제 수단 (ex: "CARD", "MONEY", "VIRTUAL_ACCOUNT") */
+  method?: string;
+  /** 카드 결제만 설정 */
+  cardCompany?: CardCompany;
+  approvedAt?: string;
+  /** 마지막 취소 시각 */
+  canceledAt?: string;
+  /** 카카오페이 결제의 가맹점 코드 (KakaoReadyRequest.cid), 취소도 같은 코드로 요청 */
+  cid?: string;
+}
+
+/** 카카오페이 결제 준비 옵션 */
+export interface KakaoPayPrepareDetails {
+  /** KakaoReadyRequest.cid 참고 */
+  cid?: string;
+}
+
+/** 토스페이먼츠 결제 준비 옵션 */
+export interface TossPaymentsPrepareDetails {
+  /** 고객 식별 키 (비어 있으면 비회원 결제) */
+  customerKey?: string;
+}
+
+/** 토스페이먼츠 결제창(SDK requestPayment) 호출 정보 */
+export interface TossPaymentsPrepareResult {
+  /** 토스페이먼츠 orderId (6~64자) */
+  orderId?: string;
+  orderName?: string;
+  amount?: Money | null;
+  customerKey?: string;
+}
+
+export interface PreparePaymentRequest {
+  /** provider_details와 다르면 INVALID_ARGUMENT, 비어 있으면 provider_details로 결정 */
+  provider?: PaymentProvider;
+  orderId?: string;
+  userId?: string;
+  /** 결제창 표시 상품명 (ex: "티셔츠 외 2건") */
+  orderName?: string;
+  quantity?: number;
+  amount?: Money | null;
+  /** 비과세 금액 */
+  taxFree?: Money | null;
+  /** 외화 표시 결제만 설정, amount는 KRW 정산 금액 */
+  fx?: FxSnapshot | null;
+  device?: DeviceFingerprint | null;
+  /** KakaoReadyRequest.idempotency_key 참고 */
+  idempotencyKey?: string;
+  kakaoPay?: KakaoPayPrepareDetails | null;
+  tossPayments?: TossPaymentsPrepareDetails | null;
+}
+
+export interface PreparePaymentResponse {
+  /** READY 상태 */
+  payment?: Payment | null;
+  /** next_redirect_*_url로 이동 */
+  kakaoPay?: KakaoReadyResponse | null;
+  tossPayments?: TossPaymentsPrepareResult | null;
+}
+
+/** 카카오페이 승인 정보 (approval_url로 전달된 pg_token) */
+export interface KakaoPayApproveDetails {
+  pgToken?: string;
+}
+
+/** 토스페이먼츠 승인 정보 (successUrl로 전달된 값) */
+export interface TossPaymentsApproveDetails {
+  paymentKey?: string;
+  /** 준비 때 금액과 같아야 함 (결제 금액 변조 방지) */
+  amount?: Money | null;
+}
+
+export interface ApprovePaymentRequest {
+  paymentId?: string;
+  /** KakaoReadyRequest.idempotency_key 참고 */
+  idempotencyKey?: string;
+  kakaoPay?: KakaoPayApproveDetails | null;
+  tossPayments?: TossPaymentsApproveDetails | null;
+}
+
+export interface ApprovePaymentResponse {
+  payment?: Payment | null;
+}
+
+/** 가상계좌 결제 환불 계좌 */
+export interface RefundReceiveAccount {
+  bank?: Bank;
+  accountNumber?: string;
+  holderName?: string;
+}
+
+/** 토스페이먼츠 취소 정보 */
+export interface TossPaymentsCancelDetails {
+  /** 가상계좌 결제 취소만 설정 */
+  refundReceiveAccount?: RefundReceiveAccount | null;
+}
+
+export interface CancelPaymentRequest {
+  paymentId?: string;
+  /** 취소 금액 (양수, 결제와 같은 통화), 비어 있으면 남은 금액 전액 */
+  amount?: Money | null;
+  /** 취소 금액 중 비과세, tax_free + vat는 취소 금액 이하 */
+  taxFree?: Money | null;
+  /** 취소 금액 중 부가세, 비어 있으면 PG사가 계산 */
+  vat?: Money | null;
+  /** 취소 전 취소 가능 금액, 설정하면 PG사 잔액과 다를 때 FAILED_PRECONDITION (동시 취소 방지) */
+  cancelAvailable?: Money | null;
+  /** 취소 사유 (토스페이먼츠 필수) */
+  reason?: string;
+  /** KakaoReadyRequest.idempotency_key 참고 */
+  idempotencyKey?: string;
+  tossPayments?: TossPaymentsCancelDetails | null;
+}
+
+export interface CancelPaymentResponse {
+  payment?: Payment | null;
+}
+
+export interface KakaoReadyRequest {
+  partnerOrderId?: string;
+  partnerUserId?: string;
+  itemName?: string;
+  quantity?: number;
+  /** KRW 원 단위, total로 대체됨 */
+  totalAmount?: string;
+  /** KRW 원 단위, tax_free로 대체됨 */
+  taxFreeAmount?: string;
+  /** 외화 표시 결제만 설정, total은 KRW 정산 금액 */
+  fx?: FxSnapshot | null;
+  device?: DeviceFingerprint | null;
+  total?: Money | null;
+  /** 비과세 금액 */
+  taxFree?: Money | null;
+  /**
+   * 재시도 시 중복 처리를 막는 키 (논리적 작업마다 클라이언트가 생성, 재시도에는 같은 값 사용)
+   * 같은 키로 다시 요청하면 서버는 처리하지 않고 처음 응답을 반환, 요청 내용이 다르면 ALREADY_EXISTS
+   * 비어 있으면 Idempotency-Key 헤더 값을 사용 (UnaryIdempotencyKeyInterceptor가 설정)
+   */
+  idempotencyKey?: string;
+  /**
+   * 가맹점 코드 (일반/정기결제/테스트 등), 비어 있으면 서버 기본값
+   * 서버에 등록되지 않은 코드는 INVALID_ARGUMENT, 승인/취소는 준비 때와 같은 코드로 요청
+   */
+  cid?: string;
+}
+
+export interface KakaoReadyResponse {
+  tid?: string;
+  nextRedirectAppUrl?: string;
+  nextRedirectMobileUrl?: string;
+  nextRedirectPcUrl?: string;
+  androidAppScheme?: string;
+  iosAppScheme?: string;
+}
+
+export interface KakaoApproveRequest {
+  tid?: string;
+  partnerOrderId?: string;
+  partnerUserId?: string;
+  pgToken?: string;
+  /** KakaoReadyRequest.idempotency_key 참고 */
+  idempotencyKey?: string;
+  /** KakaoReadyRequest.cid 참고 */
+  cid?: string;
+}
+
+export interface KakaoApproveResponse {
+  partnerOrderId?: string;
+}
+
+export interface KakaoCancelRequest {
+  partnerOrderId?: string;
+  /** KRW 원 단위 10진수 문자열, cancel로 대체됨 */
+  cancelAmount?: string;
+  /** cancel_tax_free로 대체됨 */
+  cancelTaxFreeAmount?: string;
+  /** cancel_vat로 대체됨 */
+  cancelVatAmount?: string;
+  /** cancel_available로 대체됨 */
+  cancelAvailableAmount?: string;
+  /** 취소 금액 */
+  cancel?: Money | null;
+  /** 취소 비과세 금액 */
+  cancelTaxFree?: Money | null;
+  /** 취소 부가세 */
+  cancelVat?: Money | null;
+  /** 취소 가능 금액 */
+  cancelAvailable?: Money | null;
+  /** KakaoReadyRequest.idempotency_key 참고 */
+  idempotencyKey?: string;
+  /** KakaoReadyRequest.cid 참고 */
+  cid?: string;
+}
+
+export interface KakaoCancelResponse {
+  partnerOrderId?: string;
+}
+
@@ -0,0 +1,165 @@
+// Code generated by protoc-gen-tstypes. DO NOT EDIT.
+// source: product.proto
+
+import type { Money } from "./common";
+
+/** 상품 정보 */
+export interface Product {
+  id?: string;
+  name?: string;
+  category?: string;
+  /** KRW 원 단위, list_price로 대체됨 */
+  price?: string;
+  imageUrl?: string;
+  description?: string;
+  createdAt?: string;
+  updatedAt?: string;
+  optionsJson?: string;
+  /** 수량별 할인 단가 (B2B/도매), 비어 있으면 list_price 고정 */
+  priceTiers?: PriceTier[];
+  /** 고객당 최대 구매 수량, 0이면 제한 없음 */
+  maxPerCustomer?: number;
+  /** 정가 */
+  listPrice?: Money | null;
+  /**
+   * 미설정과 0이 다른 값: 미설정은 기본값 적용, 0은 명시적 0
+   * 포장 무게 (g), 미설정 시 카테고리 기본 무게로 배송비 계산
+   */
+  weightGrams?: number | null;
+  /** 상시 할인율 (1 = 0.01%, DiscountKRW), 미설정 시 할인 없음 */
+  discountBasisPoints?: number | null;
+  /** 상품별 배송비, 미설정 시 기본 배송비 정책 (0원은 무료배송) */
+  shippingFee?: Money | null;
+}
+
+/** 수량 구간별 단가: 주문 수량이 min_quantity 이상이면 price 적용 */
+export interface PriceTier {
+  minQuantity?: number;
+  /** KRW 원 단위, price로 대체됨 */
+  unitPrice?: string;
+  /** 구간 단가 */
+  price?: Money | null;
+}
+
+/** 전체 상품 목록 요청 (필터 없음) */
+export interface GetProductsRequest {
+  /** 응답에 포함할 Product 필드 (ex: "id,name,price,image_url"), 비어 있으면 전체 필드 */
+  readMask?: string;
+  /** 페이지 크기 (0이면 서버 기본값, 최대 100) */
+  pageSize?: number;
+  /** 이전 응답의 next_page_token, 첫 페이지는 비워 둠 */
+  pageToken?: string;
+}
+
+export interface GetProductsResponse {
+  products?: Product[];
+  /** 다음 페이지 토큰, 마지막 페이지면 빈 문자열 */
+  nextPageToken?: string;
+  /** 전체 상품 수 */
+  totalCount?: number;
+}
+
+/** ID로 상품 조회 요청 */
+export interface GetProductByIDRequest {
+  id?: string;
+}
+
+export interface GetProductByIDResponse {
+  product?: Product | null;
+}
+
+/** 상품 추가 요청 */
+export interface PostProductsRequest {
+  name?: string;
+  category?: string;
+  price?: string;
+  imageUrl?: string;
+  description?: string;
+  /** JSON 문자열로 옵션 전달 */
+  optionsJson?: string;
+  priceTiers?: PriceTier[];
+  maxPerCustomer?: number;
+  weightGrams?: number | null;
+  discountBasisPoints?: number | null;
+  shippingFee?: Money | null;
+}
+
+export interface PostProductsResponse {
+  message?: string;
+}
+
+/**
+ * 상품 부분 수정 내용: 모든 필드가 presence를 가지며, 설정된 필드만 적용
+ * (ex: {"maxPerCustomer": 0}은 구매 제한 해제, 필드 생략은 변경 없음)
+ */
+export interface ProductPatch {
+  name?: string | null;
+  category?: string | null;
+  imageUrl?: string | null;
+  description?: string | null;
+  optionsJson?: string | null;
+  /** 설정 시 목록 전체를 교체 (빈 목록은 삭제) */
+  priceTiers?: PriceTierList | null;
+  maxPerCustomer?: number | null;
+  listPrice?: Money | null;
+  weightGrams?: number | null;
+  discountBasisPoints?: number | null;
+  shippingFee?: Money | null;
+}
+
+/** repeated 필드를 patch에서 교체할 때 사용하는 래퍼 */
+export interface PriceTierList {
+  tiers?: PriceTier[];
+}
+
+export interface UpdateProductRequest {
+  id?: string;
+  patch?: ProductPatch | null;
+}
+
+export interface UpdateProductResponse {
+  product?: Product | null;
+}
+
+/** 번들(세트) 구성 상품 */
+export interface BundleComponent {
+  productId?: string;
+  quantity?: number;
+}
+
+/** 번들(세트) 상품: 여러 상품을 묶어 하나의 가격으로 판매 */
+export interface Bundle {
+  id?: string;
+  name?: string;
+  bundlePrice?: string;
+  components?: BundleComponent[];
+  createdAt?: string;
+}
+
+/** 번들 전개 결과의 구성품 (출고/환불 시 사용) */
+export interface ResolvedBundleComponent {
+  product?: Product | null;
+  quantity?: number;
+  /** bundle_price 중 이 구성품에 배분된 금액 (정상가 비율) */
+  allocatedPrice?: string;
+}
+
+export interface CreateBundleRequest {
+  name?: string;
+  bundlePrice?: string;
+  components?: BundleComponent[];
+}
+
+export interface CreateBundleResponse {
+  bundle?: Bundle | null;
+}
+
+export interface ResolveBundleRequest {
+  bundleId?: string;
+}
+
+export interface ResolveBundleResponse {
+  bundle?: Bundle | null;
+  components?: ResolvedBundleComponent[];
+}
+
@@ -0,0 +1,101 @@
+// Code generated by protoc-gen-tstypes. DO NOT EDIT.
+// source: review.proto
+
+/** 리뷰 정렬 순서 */
+export type ReviewSort = "REVIEW_SORT_UNSPECIFIED" | "REVIEW_SORT_NEWEST" | "REVIEW_SORT_HELPFUL" | "REVIEW_SORT_RATING_HIGH" | "REVIEW_SORT_RATING_LOW";
+
+export interface Review {
+  id?: string;
+  productId?: string;
+  userId?: string;
+  /** 구매 인증된 주문 */
+  orderId?: string;
+  /** 1~5 */
+  rating?: number;
+  title?: string;
+  content?: string;
+  imageUrls?: string[];
+  /** 표시용 (마스킹된 이름, ex: "김**") */
+  authorName?: string;
+  helpfulCount?: number;
+  createdAt?: string;
+  updatedAt?: string;
+}
+
+export interface CreateReviewRequest {
+  productId?: string;
+  /** 작성자는 인증 토큰의 사용자로 정해지며 이 값은 사용하지 않음 (보내면 토큰 사용자와 같아야 함) */
+  userId?: string;
+  orderId?: string;
+  rating?: number;
+  title?: string;
+  content?: string;
+  imageUrls?: string[];
+}
+
+export interface CreateReviewResponse {
+  review?: Review | null;
+}
+
+export interface ListReviewsByProductRequest {
+  productId?: string;
+  /** 페이지 크기 (0이면 서버 기본값, 최대 100) */
+  pageSize?: number;
+  /** 이전 응답의 next_page_token, 첫 페이지는 비워 둠 */
+  pageToken?: string;
+  sort?: ReviewSort;
+  /** 특정 별점만 조회 (0이면 전체) */
+  rating?: number;
+  /** 사진 리뷰만 조회 */
+  withImagesOnly?: boolean;
+}
+
+export interface ListReviewsByProductResponse {
+  reviews?: Review[];
+  /** 다음 페이지 토큰, 마지막 페이지면 빈 문자열 */
+  nextPageToken?: string;
+  /** 필터 조건에 맞는 전체 리뷰 수 */
+  totalCount?: number;
+}
+
+export interface UpdateReviewRequest {
+  productId?: string;
+  reviewId?: string;
+  rating?: number;
+  title?: string;
+  content?: string;
+  imageUrls?: string[];
+  /** 변경할 필드 (ex: "rating,content"), 비어 있으면 rating/title/content/image_urls 전체 */
+  updateMask?: string;
+}
+
+export interface UpdateReviewResponse {
+  review?: Review | null;
+}
+
+export interface DeleteReviewRequest {
+  productId?: string;
+  reviewId?: string;
+}
+
+export type DeleteReviewResponse = Record<string, never>;
+
+/** 상품 평점 요약 */
+export interface RatingSummary {
+  productId?: string;
+  /** 소수점 첫째 자리까지 표시 권장, 리뷰가 없으면 0 */
+  averageRating?: number;
+  reviewCount?: number;
+  /** 별점(1~5)별 리뷰 수 */
+  ratingCounts?: { [key: string]: number };
+  photoReviewCount?: number;
+}
+
+export interface GetProductRatingSummaryRequest {
+  productId?: string;
+}
+
+export interface GetProductRatingSummaryResponse {
+  summary?: RatingSummary | null;
+}
+
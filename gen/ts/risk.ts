@@ -0,0 +1,46 @@
+// Code generated by protoc-gen-tstypes. DO NOT EDIT.
+// source: risk.proto
+
+export type BlocklistEntryType = "BLOCKLIST_ENTRY_TYPE_UNSPECIFIED" | "BLOCKLIST_ENTRY_TYPE_EMAIL" | "BLOCKLIST_ENTRY_TYPE_PHONE" | "BLOCKLIST_ENTRY_TYPE_DEVICE_ID" | "BLOCKLIST_ENTRY_TYPE_IP_RANGE";
+
+export interface BlocklistEntry {
+  id?: string;
+  type?: BlocklistEntryType;
+  value?: string;
+  reason?: string;
+  /** 등록한 관리자 ID */
+  createdBy?: string;
+  createdAt?: string;
+  /** 비어 있으면 영구 차단 */
+  expiresAt?: string;
+}
+
+export interface AddToBlocklistRequest {
+  type?: BlocklistEntryType;
+  value?: string;
+  reason?: string;
+  expiresAt?: string;
+}
+
+export interface AddToBlocklistResponse {
+  entry?: BlocklistEntry | null;
+}
+
+export interface RemoveFromBlocklistRequest {
+  entryId?: string;
+}
+
+export type RemoveFromBlocklistResponse = Record<string, never>;
+
+export interface CheckBlocklistRequest {
+  email?: string;
+  phone?: string;
+  deviceId?: string;
+  ipAddress?: string;
+}
+
+export interface CheckBlocklistResponse {
+  blocked?: boolean;
+  matches?: BlocklistEntry[];
+}
+
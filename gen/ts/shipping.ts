@@ -0,0 +1,97 @@
+// Code generated by protoc-gen-tstypes. DO NOT EDIT.
+// source: shipping.proto
+
+import type { Carrier } from "./codes";
+import type { DeliveryEstimate } from "./common";
+
+/** 배송 상태 */
+export type ShipmentStatus = "SHIPMENT_STATUS_UNSPECIFIED" | "SHIPMENT_STATUS_READY" | "SHIPMENT_STATUS_PICKED_UP" | "SHIPMENT_STATUS_IN_TRANSIT" | "SHIPMENT_STATUS_OUT_FOR_DELIVERY" | "SHIPMENT_STATUS_DELIVERED" | "SHIPMENT_STATUS_DELIVERY_FAILED" | "SHIPMENT_STATUS_RETURNED";
+
+/** 배송지/수거지 주소 */
+export interface Address {
+  /** 받는 사람 */
+  recipient?: string;
+  /** 연락처 (ex: "010-1234-5678") */
+  phone?: string;
+  /** 우편번호 (국내는 5자리 국가기초구역번호) */
+  postalCode?: string;
+  /** 도로명 주소 */
+  line1?: string;
+  /** 상세 주소 (동/호수) */
+  line2?: string;
+  /** 시/도 (ex: "서울특별시") */
+  city?: string;
+  /** ISO 3166-1 alpha-2, 비어 있으면 "KR" */
+  country?: string;
+}
+
+/** 택배사 추적 이벤트 */
+export interface TrackingEvent {
+  status?: ShipmentStatus;
+  /** 처리 지점 (ex: "옥천HUB") */
+  location?: string;
+  /** 택배사 원문 설명 */
+  description?: string;
+  occurredAt?: string;
+}
+
+/** 출고 항목 (분할 배송) */
+export interface ShipmentItem {
+  orderItemId?: string;
+  quantity?: number;
+}
+
+export interface Shipment {
+  id?: string;
+  orderId?: string;
+  carrier?: Carrier;
+  trackingNumber?: string;
+  status?: ShipmentStatus;
+  address?: Address | null;
+  /** 비어 있으면 주문 전체 */
+  items?: ShipmentItem[];
+  /** 발생 순 */
+  events?: TrackingEvent[];
+  /** 택배사 조회 링크 (TrackingURL로 생성) */
+  trackingUrl?: string;
+  /** 출고 시점 예상 배송일 */
+  estimate?: DeliveryEstimate | null;
+  createdAt?: string;
+  deliveredAt?: string;
+}
+
+export interface CreateShipmentRequest {
+  orderId?: string;
+  carrier?: Carrier;
+  trackingNumber?: string;
+  /** 비어 있으면 주문 배송지 */
+  address?: Address | null;
+  /** 비어 있으면 주문 전체 */
+  items?: ShipmentItem[];
+}
+
+export interface CreateShipmentResponse {
+  shipment?: Shipment | null;
+}
+
+export interface GetTrackingInfoRequest {
+  shipmentId?: string;
+}
+
+export interface GetTrackingInfoResponse {
+  shipment?: Shipment | null;
+}
+
+export interface UpdateShipmentStatusRequest {
+  shipmentId?: string;
+  event?: TrackingEvent | null;
+}
+
+export interface UpdateShipmentStatusResponse {
+  shipment?: Shipment | null;
+}
+
+export interface WatchShipmentRequest {
+  shipmentId?: string;
+}
+
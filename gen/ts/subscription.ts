@@ -0,0 +1,72 @@
+// Code generated by protoc-gen-tstypes. DO NOT EDIT.
+// source: subscription.proto
+
+export type SubscriptionInterval = "SUBSCRIPTION_INTERVAL_UNSPECIFIED" | "SUBSCRIPTION_INTERVAL_WEEKLY" | "SUBSCRIPTION_INTERVAL_BIWEEKLY" | "SUBSCRIPTION_INTERVAL_MONTHLY";
+
+export type SubscriptionStatus = "SUBSCRIPTION_STATUS_UNSPECIFIED" | "SUBSCRIPTION_STATUS_ACTIVE" | "SUBSCRIPTION_STATUS_PAUSED" | "SUBSCRIPTION_STATUS_CANCELLED";
+
+export interface SubscriptionItem {
+  productId?: string;
+  productOptions?: string;
+  quantity?: number;
+}
+
+export interface Subscription {
+  id?: string;
+  userId?: string;
+  items?: SubscriptionItem[];
+  interval?: SubscriptionInterval;
+  status?: SubscriptionStatus;
+  /** PG 정기결제 키 (Kakao Pay SID 등) */
+  billingKey?: string;
+  shippingAddress?: string;
+  /** YYYY-MM-DD */
+  nextDeliveryDate?: string;
+  /** PAUSED 상태일 때 자동 재개일 */
+  pausedUntil?: string;
+  createdAt?: string;
+  cancelledAt?: string;
+}
+
+export interface CreateSubscriptionRequest {
+  userId?: string;
+  items?: SubscriptionItem[];
+  interval?: SubscriptionInterval;
+  billingKey?: string;
+  shippingAddress?: string;
+  /** YYYY-MM-DD */
+  firstDeliveryDate?: string;
+}
+
+export interface CreateSubscriptionResponse {
+  subscription?: Subscription | null;
+}
+
+export interface PauseSubscriptionRequest {
+  subscriptionId?: string;
+  /** 비어 있으면 재개할 때까지 무기한 일시정지 */
+  resumeDate?: string;
+}
+
+export interface PauseSubscriptionResponse {
+  subscription?: Subscription | null;
+}
+
+export interface SkipNextDeliveryRequest {
+  subscriptionId?: string;
+}
+
+export interface SkipNextDeliveryResponse {
+  /** next_delivery_date가 다음 주기로 변경됨 */
+  subscription?: Subscription | null;
+}
+
+export interface CancelSubscriptionRequest {
+  subscriptionId?: string;
+  reason?: string;
+}
+
+export interface CancelSubscriptionResponse {
+  subscription?: Subscription | null;
+}
+
@@ -0,0 +1,61 @@
+// Code generated by protoc-gen-tstypes. DO NOT EDIT.
+// source: wishlist.proto
+
+import type { Cart } from "./cart";
+import type { Money } from "./common";
+
+export interface WishlistItem {
+  id?: string;
+  productId?: string;
+  productName?: string;
+  /** JSON 문자열 (CartItem.product_options와 동일 형식), 옵션 미선택이면 빈 문자열 */
+  productOptions?: string;
+  /** 현재 판매가 */
+  price?: Money | null;
+  /** 담을 당시 판매가 (가격 인하 알림용) */
+  priceWhenAdded?: Money | null;
+  inStock?: boolean;
+  addedAt?: string;
+}
+
+export interface AddToWishlistRequest {
+  productId?: string;
+  productOptions?: string;
+}
+
+export interface AddToWishlistResponse {
+  item?: WishlistItem | null;
+}
+
+export interface RemoveFromWishlistRequest {
+  itemId?: string;
+}
+
+export type RemoveFromWishlistResponse = Record<string, never>;
+
+export interface ListWishlistRequest {
+  /** 페이지 크기 (0이면 서버 기본값, 최대 100) */
+  pageSize?: number;
+  /** 이전 응답의 next_page_token, 첫 페이지는 비워 둠 */
+  pageToken?: string;
+}
+
+export interface ListWishlistResponse {
+  items?: WishlistItem[];
+  /** 다음 페이지 토큰, 마지막 페이지면 빈 문자열 */
+  nextPageToken?: string;
+  totalCount?: number;
+}
+
+export interface MoveToCartRequest {
+  itemId?: string;
+  /** 0이면 1개 */
+  quantity?: number;
+  keepInWishlist?: boolean;
+}
+
+export interface MoveToCartResponse {
+  /** 담은 후 장바구니 */
+  cart?: Cart | null;
+}
+
@@ -0,0 +1,19 @@
+package gen
+
+import (
+	"embed"
+	"io/fs"
+)
+
+// typeScript holds the types generated by protoc-gen-tstypes.
+//
+//go:embed ts/*.ts
+var typeScript embed.FS
+
+// TypeScriptFS returns the TypeScript types of the gateway's JSON payloads:
+// one <file>.ts module per proto file and an index.ts re-exporting them all.
+// Frontend builds can extract them instead of maintaining mirrored types.
+func TypeScriptFS() fs.FS {
+	sub, _ := fs.Sub(typeScript, "ts")
+	return sub
+}
@@ -0,0 +1,246 @@
+package gen
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+// UserClaims are the verified claims of an end-user access token.
+type UserClaims struct {
+	UserID    string   // "sub"
+	Issuer    string   // "iss"
+	Audience  []string // "aud"
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+	Scopes    []string // "scp", or the space-separated OAuth "scope"
+}
+
+// TokenVerifier validates an end-user bearer token, checking at least its
+// signature and expiry. JWTVerifier covers tokens issued by AccountService;
+// implement it to verify tokens of another identity provider or to consult a
+// revocation list.
+type TokenVerifier interface {
+	VerifyToken(ctx context.Context, token string) (*UserClaims, error)
+}
+
+// TokenVerifierFunc adapts a function to TokenVerifier.
+type TokenVerifierFunc func(ctx context.Context, token string) (*UserClaims, error)
+
+// VerifyToken calls f(ctx, token).
+func (f TokenVerifierFunc) VerifyToken(ctx context.Context, token string) (*UserClaims, error) {
+	return f(ctx, token)
+}
+
+// JWTVerifier verifies JWT access tokens signed with HS256 or EdDSA.
+type JWTVerifier struct {
+	issuer   string
+	audience string
+	keys     map[string]any
+	leeway   time.Duration
+	now      func() time.Time
+}
+
+// NewJWTVerifier returns a verifier for tokens from issuer addressed to
+// audience; an empty issuer or audience is not checked. keys maps key IDs
+// (the "kid" header, "" for tokens without one) to a []byte HS256 secret or an
+// ed25519.PublicKey. The algorithm must match the key type, so a token cannot
+// pick a weaker algorithm than the key was issued for.
+func NewJWTVerifier(issuer, audience string, keys map[string]any) *JWTVerifier {
+	return &JWTVerifier{issuer: issuer, audience: audience, keys: keys, leeway: 30 * time.Second, now: time.Now}
+}
+
+// VerifyToken checks token's signature, issuer, audience and validity window
+// (exp is required). It implements TokenVerifier.
+func (v *JWTVerifier) VerifyToken(ctx context.Context, token string) (*UserClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed token")
+	}
+	enc := base64.RawURLEncoding
+	headerJSON, err := enc.DecodeString(parts[0])
+	if err != nil {
+		return nil, errors.New("malformed token header")
+	}
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := json.Unmarshal(headerJSON, &header); err != nil {
+		return nil, errors.New("malformed token header")
+	}
+	key, ok := v.keys[header.Kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown signing key %q", header.Kid)
+	}
+	sig, err := enc.DecodeString(parts[2])
+	if err != nil {
+		return nil, errors.New("malformed token signature")
+	}
+	signingInput := []byte(parts[0] + "." + parts[1])
+	switch key := key.(type) {
+	case []byte:
+		mac := hmac.New(sha256.New, key)
+		mac.Write(signingInput)
+		ok = header.Alg == "HS256" && hmac.Equal(sig, mac.Sum(nil))
+	case ed25519.PublicKey:
+		ok = header.Alg == "EdDSA" && ed25519.Verify(key, signingInput, sig)
+	default:
+		return nil, fmt.Errorf("unsupported key type %T for %q", key, header.Kid)
+	}
+	if !ok {
+		return nil, errors.New("invalid token signature")
+	}
+	payload, err := enc.DecodeString(parts[1])
+	if err != nil {
+		return nil, errors.New("malformed token payload")
+	}
+	var raw struct {
+		Sub   string          `json:"sub"`
+		Iss   string          `json:"iss"`
+		Aud   json.RawMessage `json:"aud"`
+		Iat   int64           `json:"iat"`
+		Nbf   int64           `json:"nbf"`
+		Exp   int64           `json:"exp"`
+		Scp   []string        `json:"scp"`
+		Scope string          `json:"scope"`
+	}
+	if err := json.Unmarshal(payload, &raw); err != nil {
+		return nil, errors.New("malformed token payload")
+	}
+	claims := &UserClaims{UserID: raw.Sub, Issuer: raw.Iss, Scopes: raw.Scp}
+	if len(raw.Aud) > 0 && json.Unmarshal(raw.Aud, &claims.Audience) != nil {
+		var aud string
+		if err := json.Unmarshal(raw.Aud, &aud); err != nil {
+			return nil, errors.New("malformed token audience")
+		}
+		claims.Audience = []string{aud}
+	}
+	if claims.Scopes == nil && raw.Scope != "" {
+		claims.Scopes = strings.Fields(raw.Scope)
+	}
+	switch now := v.now(); {
+	case claims.UserID == "":
+		return nil, errors.New("token has no subject")
+	case v.issuer != "" && claims.Issuer != v.issuer:
+		return nil, fmt.Errorf("token not issued by %q", v.issuer)
+	case v.audience != "" && !slices.Contains(claims.Audience, v.audience):
+		return nil, fmt.Errorf("token not intended for %q", v.audience)
+	case raw.Exp == 0 || now.Add(-v.leeway).Unix() > raw.Exp:
+		return nil, errors.New("token expired")
+	case now.Add(v.leeway).Unix() < max(raw.Nbf, raw.Iat):
+		return nil, errors.New("token not yet valid")
+	}
+	if raw.Iat != 0 {
+		claims.IssuedAt = time.Unix(raw.Iat, 0)
+	}
+	claims.ExpiresAt = time.Unix(raw.Exp, 0)
+	return claims, nil
+}
+
+type userClaimsKey struct{}
+
+// ContextWithUserClaims returns ctx carrying claims, as the auth interceptors
+// store them. Use it to call handlers directly in tests.
+func ContextWithUserClaims(ctx context.Context, claims *UserClaims) context.Context {
+	return context.WithValue(ctx, userClaimsKey{}, claims)
+}
+
+// UserClaimsFromContext returns the claims verified by the auth interceptors.
+// ok is false for unauthenticated calls to public methods.
+func UserClaimsFromContext(ctx context.Context) (*UserClaims, bool) {
+	c, ok := ctx.Value(userClaimsKey{}).(*UserClaims)
+	return c, ok
+}
+
+// UserIDFromContext returns the ID of the authenticated user.
+func UserIDFromContext(ctx context.Context) (string, bool) {
+	c, ok := UserClaimsFromContext(ctx)
+	if !ok {
+		return "", false
+	}
+	return c.UserID, true
+}
+
+// UserScopes is a ScopeExtractor returning the scopes of the authenticated
+// user, for use with UnaryScopeInterceptor after UnaryAuthInterceptor.
+func UserScopes(ctx context.Context) ([]string, error) {
+	c, ok := UserClaimsFromContext(ctx)
+	if !ok {
+		return nil, errors.New("not authenticated")
+	}
+	return c.Scopes, nil
+}
+
+// bearerToken returns the token of an "authorization: Bearer <token>" header.
+func bearerToken(ctx context.Context) (string, bool) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, v := range md.Get(AuthorizationHeader) {
+		scheme, token, ok := strings.Cut(v, " ")
+		if ok && strings.EqualFold(scheme, "Bearer") && strings.TrimSpace(token) != "" {
+			return strings.TrimSpace(token), true
+		}
+	}
+	return "", false
+}
+
+// authenticateUser verifies the caller's bearer token. Public methods (see
+// RequiredScopes) may be called without a token, but a token that is sent
+// must be valid.
+func authenticateUser(ctx context.Context, fullMethod string, v TokenVerifier) (context.Context, error) {
+	token, ok := bearerToken(ctx)
+	if !ok {
+		if methodScopes[fullMethod] == nil {
+			return ctx, nil
+		}
+		return nil, NewError(codes.Unauthenticated, ErrorReason_ERROR_REASON_UNAUTHENTICATED, "missing bearer token", nil)
+	}
+	claims, err := v.VerifyToken(ctx, token)
+	if err != nil {
+		return nil, NewError(codes.Unauthenticated, ErrorReason_ERROR_REASON_UNAUTHENTICATED, err.Error(), nil)
+	}
+	return ContextWithUserClaims(ctx, claims), nil
+}
+
+// UnaryAuthInterceptor verifies the "authorization: Bearer" token of each call
+// with v and makes its claims available via UserClaimsFromContext and
+// UserIDFromContext. Calls to methods requiring scopes are rejected without a
+// valid token (Unauthenticated, ERROR_REASON_UNAUTHENTICATED); chain
+// UnaryScopeInterceptor(UserScopes) after it to enforce the scopes:
+//
+//	grpc.NewServer(grpc.ChainUnaryInterceptor(
+//	    pb.UnaryAuthInterceptor(pb.NewJWTVerifier("accountsrv", "", keys)),
+//	    pb.UnaryScopeInterceptor(pb.UserScopes),
+//	))
+func UnaryAuthInterceptor(v TokenVerifier) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := authenticateUser(ctx, info.FullMethod, v)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamAuthInterceptor is the streaming counterpart of UnaryAuthInterceptor.
+func StreamAuthInterceptor(v TokenVerifier) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticateUser(ss.Context(), info.FullMethod, v)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
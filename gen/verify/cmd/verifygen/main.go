@@ -0,0 +1,69 @@
+// Command verifygen writes a test asserting that a server type implements
+// every method of a generated Escape Ship Server interface itself. Use it from
+// a go:generate directive next to the implementation:
+//
+//	//go:generate go run github.com/escape-ship/protos/gen/verify/cmd/verifygen -type accountServer -server AccountServiceServer
+//
+// The generated <type>_verify_test.go fails once the proto gains an RPC the
+// type only inherits from Unimplemented*Server.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"strings"
+	"text/template"
+)
+
+var tmpl = template.Must(template.New("verify").Parse(`// Code generated by verifygen. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"testing"
+
+	pb "github.com/escape-ship/protos/gen"
+	"github.com/escape-ship/protos/gen/verify"
+)
+
+func Test{{.Title}}Implements{{.Server}}(t *testing.T) {
+	if err := verify.Complete[pb.{{.Server}}]({{if .Pointer}}&{{end}}{{.Type}}{}); err != nil {
+		t.Fatal(err)
+	}
+}
+`))
+
+func main() {
+	typ := flag.String("type", "", "server implementation type name")
+	server := flag.String("server", "", "generated server interface, e.g. AccountServiceServer")
+	pointer := flag.Bool("pointer", true, "check *type rather than type")
+	pkg := flag.String("package", os.Getenv("GOPACKAGE"), "package name of the generated file")
+	flag.Parse()
+	if *typ == "" || *server == "" || *pkg == "" {
+		log.Fatal("verifygen: -type, -server and -package (or $GOPACKAGE) are required")
+	}
+
+	var buf bytes.Buffer
+	err := tmpl.Execute(&buf, map[string]any{
+		"Package": *pkg,
+		"Type":    *typ,
+		"Title":   strings.ToUpper((*typ)[:1]) + (*typ)[1:],
+		"Server":  *server,
+		"Pointer": *pointer,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	out := fmt.Sprintf("%s_verify_test.go", strings.ToLower(*typ))
+	if err := os.WriteFile(out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
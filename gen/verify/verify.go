@@ -0,0 +1,98 @@
+// Package verify checks that a gRPC server implementation defines every method
+// of its generated Server interface itself.
+//
+// Embedding an Unimplemented*Server struct keeps implementations compiling when
+// the protos gain RPCs, which also hides the new methods until a client gets an
+// Unimplemented error in production. Complete reports such methods so a unit
+// test (or a verifygen-generated one) fails as soon as the proto grows:
+//
+//	func TestAccountServerComplete(t *testing.T) {
+//	    if err := verify.Complete[pb.AccountServiceServer](&accountServer{}); err != nil {
+//	        t.Fatal(err)
+//	    }
+//	}
+package verify
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+)
+
+// Complete returns an error listing the methods of S that impl only inherits
+// from an embedded Unimplemented*Server.
+func Complete[S any](impl S) error {
+	if missing := Missing(impl); len(missing) > 0 {
+		return fmt.Errorf("%T does not implement %s: %s",
+			impl, reflect.TypeFor[S]().Name(), strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// Missing returns the exported methods of S that impl inherits from an embedded
+// Unimplemented*Server instead of defining itself, in interface order.
+func Missing[S any](impl S) []string {
+	iface := reflect.TypeFor[S]()
+	t := reflect.TypeOf(impl)
+	var missing []string
+	for i := 0; i < iface.NumMethod(); i++ {
+		name := iface.Method(i).Name
+		if !iface.Method(i).IsExported() {
+			continue
+		}
+		if promotedFromUnimplemented(t, name) {
+			missing = append(missing, name)
+		}
+	}
+	return missing
+}
+
+// promotedFromUnimplemented reports whether method name of t is a compiler
+// generated promotion from an embedded Unimplemented* struct.
+func promotedFromUnimplemented(t reflect.Type, name string) bool {
+	if definedDirectly(t, name) {
+		return false
+	}
+	base := t
+	if base.Kind() == reflect.Pointer {
+		base = base.Elem()
+		if definedDirectly(base, name) {
+			return false
+		}
+	}
+	if base.Kind() != reflect.Struct {
+		return false
+	}
+	for i := 0; i < base.NumField(); i++ {
+		f := base.Field(i)
+		if !f.Anonymous {
+			continue
+		}
+		ft := f.Type
+		if ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+		_, onValue := ft.MethodByName(name)
+		_, onPtr := reflect.PointerTo(ft).MethodByName(name)
+		if !onValue && !onPtr {
+			continue
+		}
+		if strings.HasPrefix(ft.Name(), "Unimplemented") {
+			return true
+		}
+		return promotedFromUnimplemented(f.Type, name)
+	}
+	return false
+}
+
+// definedDirectly reports whether t has a method name whose code is not a
+// compiler-generated wrapper, i.e. it was declared on t in source.
+func definedDirectly(t reflect.Type, name string) bool {
+	m, ok := t.MethodByName(name)
+	if !ok {
+		return false
+	}
+	file, _ := runtime.FuncForPC(m.Func.Pointer()).FileLine(m.Func.Pointer())
+	return file != "<autogenerated>"
+}
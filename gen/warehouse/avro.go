@@ -0,0 +1,367 @@
+package warehouse
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// AvroSchema returns the Avro record schema for rows encoded from md, ready
+// to be marshaled with encoding/json. Scalars default to their proto3 zero
+// values and messages and optional scalars are nullable, so adding proto
+// fields keeps the schema backward compatible.
+func AvroSchema(md protoreflect.MessageDescriptor) map[string]any {
+	return avroRecord(md, make(map[protoreflect.FullName]bool))
+}
+
+func avroRecord(md protoreflect.MessageDescriptor, defined map[protoreflect.FullName]bool) map[string]any {
+	defined[md.FullName()] = true
+	fields := md.Fields()
+	out := make([]map[string]any, 0, fields.Len())
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		typ, def := avroField(fd, defined)
+		out = append(out, map[string]any{"name": string(fd.Name()), "type": typ, "default": def})
+	}
+	return map[string]any{
+		"type":      "record",
+		"name":      string(md.Name()),
+		"namespace": avroNamespace(md),
+		"fields":    out,
+	}
+}
+
+func avroNamespace(d protoreflect.Descriptor) string {
+	return string(d.Parent().FullName())
+}
+
+// avroField returns the Avro type of fd and its default value.
+func avroField(fd protoreflect.FieldDescriptor, defined map[protoreflect.FullName]bool) (any, any) {
+	switch {
+	case fd.IsMap():
+		return map[string]any{"type": "map", "values": avroValue(fd.MapValue(), defined)}, map[string]any{}
+	case fd.IsList():
+		return map[string]any{"type": "array", "items": avroValue(fd, defined)}, []any{}
+	case fd.Message() != nil, fd.HasOptionalKeyword():
+		return []any{"null", avroValue(fd, defined)}, nil
+	}
+	return avroValue(fd, defined), avroZero(fd)
+}
+
+// avroValue is the Avro type of a single value of fd.
+func avroValue(fd protoreflect.FieldDescriptor, defined map[protoreflect.FullName]bool) any {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return "boolean"
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return "int"
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return "long"
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return map[string]any{"type": "bytes", "logicalType": "decimal", "precision": 20, "scale": 0}
+	case protoreflect.FloatKind:
+		return "float"
+	case protoreflect.DoubleKind:
+		return "double"
+	case protoreflect.StringKind:
+		return "string"
+	case protoreflect.BytesKind:
+		return "bytes"
+	case protoreflect.EnumKind:
+		ed := fd.Enum()
+		if defined[ed.FullName()] {
+			return string(ed.FullName())
+		}
+		defined[ed.FullName()] = true
+		values := ed.Values()
+		symbols := make([]string, values.Len())
+		for i := range symbols {
+			symbols[i] = string(values.Get(i).Name())
+		}
+		return map[string]any{
+			"type":      "enum",
+			"name":      string(ed.Name()),
+			"namespace": avroNamespace(ed),
+			"symbols":   symbols,
+			"default":   symbols[0], // readers map unknown symbols to UNSPECIFIED
+		}
+	}
+	md := fd.Message()
+	switch md.FullName() {
+	case "google.protobuf.Timestamp":
+		return map[string]any{"type": "long", "logicalType": "timestamp-micros"}
+	case "google.protobuf.Duration", "google.protobuf.FieldMask",
+		"google.protobuf.Struct", "google.protobuf.Value", "google.protobuf.ListValue", "google.protobuf.Any":
+		return "string" // JSON encoded
+	}
+	if w := wrapperValue(md); w != nil {
+		return avroValue(w, defined)
+	}
+	if defined[md.FullName()] {
+		return string(md.FullName())
+	}
+	return avroRecord(md, defined)
+}
+
+func avroZero(fd protoreflect.FieldDescriptor) any {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return false
+	case protoreflect.StringKind, protoreflect.BytesKind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return ""
+	case protoreflect.EnumKind:
+		return string(fd.Enum().Values().Get(0).Name())
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return 0.0
+	default:
+		return 0
+	}
+}
+
+// CheckAvroCompatible reports the changes from old to new that keep rows
+// written with old from being read with new, following Avro's schema
+// resolution rules: types may only change by Avro's promotions (e.g. int to
+// long, or a type to a union containing it), records and enums may not be
+// renamed, and new fields need a default. old and new are schemas as
+// returned by AvroSchema or decoded from an .avsc file.
+func CheckAvroCompatible(old, new any) error {
+	o, err := normalizeAvro(old)
+	if err != nil {
+		return err
+	}
+	n, err := normalizeAvro(new)
+	if err != nil {
+		return err
+	}
+	return newAvroResolver(o, n).read("", o, n)
+}
+
+// normalizeAvro round-trips schema through JSON so that generated and
+// decoded schemas have the same Go types.
+func normalizeAvro(schema any) (any, error) {
+	data, err := json.Marshal(schema)
+	if err != nil {
+		return nil, err
+	}
+	var out any
+	return out, json.Unmarshal(data, &out)
+}
+
+var avroPrimitives = []string{"null", "boolean", "int", "long", "float", "double", "bytes", "string"}
+
+// avroPromotions are the writer to reader type changes Avro resolves.
+var avroPromotions = map[[2]string]bool{
+	{"int", "long"}: true, {"int", "float"}: true, {"int", "double"}: true,
+	{"long", "float"}: true, {"long", "double"}: true,
+	{"float", "double"}: true,
+	{"string", "bytes"}: true, {"bytes", "string"}: true,
+}
+
+// avroResolver resolves a writer schema against a reader schema following
+// the Avro specification's schema resolution rules.
+type avroResolver struct {
+	writer, reader map[string]map[string]any // named types by full name
+	seen           map[[2]string]bool        // record pairs already checked
+}
+
+func newAvroResolver(writer, reader any) *avroResolver {
+	r := &avroResolver{
+		writer: make(map[string]map[string]any),
+		reader: make(map[string]map[string]any),
+		seen:   make(map[[2]string]bool),
+	}
+	collectAvroNames(writer, r.writer)
+	collectAvroNames(reader, r.reader)
+	return r
+}
+
+func collectAvroNames(schema any, names map[string]map[string]any) {
+	switch s := schema.(type) {
+	case []any:
+		for _, b := range s {
+			collectAvroNames(b, names)
+		}
+	case map[string]any:
+		switch s["type"] {
+		case "record":
+			names[avroFullName(s)] = s
+			fields, _ := s["fields"].([]any)
+			for _, f := range fields {
+				if f, ok := f.(map[string]any); ok {
+					collectAvroNames(f["type"], names)
+				}
+			}
+		case "enum":
+			names[avroFullName(s)] = s
+		case "array":
+			collectAvroNames(s["items"], names)
+		case "map":
+			collectAvroNames(s["values"], names)
+		}
+	}
+}
+
+func avroFullName(s map[string]any) string {
+	name, _ := s["name"].(string)
+	if ns, _ := s["namespace"].(string); ns != "" {
+		return ns + "." + name
+	}
+	return name
+}
+
+// resolveAvro replaces a reference to a named type with its definition.
+func resolveAvro(schema any, names map[string]map[string]any) any {
+	if name, ok := schema.(string); ok && !slices.Contains(avroPrimitives, name) {
+		if def, ok := names[name]; ok {
+			return def
+		}
+	}
+	return schema
+}
+
+func avroType(schema any) string {
+	switch s := schema.(type) {
+	case string:
+		return s
+	case []any:
+		return "union"
+	case map[string]any:
+		if t, ok := s["type"].(string); ok {
+			return t
+		}
+	}
+	return ""
+}
+
+func avroTypeName(schema any) string {
+	t := avroType(schema)
+	if s, ok := schema.(map[string]any); ok {
+		switch {
+		case t == "record" || t == "enum":
+			return t + " " + avroFullName(s)
+		case s["logicalType"] != nil:
+			return fmt.Sprintf("%s (%v)", t, s["logicalType"])
+		}
+	}
+	return t
+}
+
+// matches reports whether writer schema w selects reader union branch r.
+func (c *avroResolver) matches(w, r any) bool {
+	w, r = resolveAvro(w, c.writer), resolveAvro(r, c.reader)
+	wt, rt := avroType(w), avroType(r)
+	if wt != rt {
+		return avroPromotions[[2]string{wt, rt}]
+	}
+	if wt == "record" || wt == "enum" {
+		return w.(map[string]any)["name"] == r.(map[string]any)["name"]
+	}
+	return true
+}
+
+// read returns an error if data written with schema w at path cannot be read
+// with schema r.
+func (c *avroResolver) read(path string, w, r any) error {
+	w, r = resolveAvro(w, c.writer), resolveAvro(r, c.reader)
+	if wu, ok := w.([]any); ok {
+		var errs []error
+		for _, b := range wu {
+			errs = append(errs, c.read(path, b, r))
+		}
+		return errors.Join(errs...)
+	}
+	if ru, ok := r.([]any); ok {
+		for _, b := range ru {
+			if c.matches(w, b) {
+				return c.read(path, w, b)
+			}
+		}
+		branches := make([]string, len(ru))
+		for i, b := range ru {
+			branches[i] = avroTypeName(resolveAvro(b, c.reader))
+		}
+		return fmt.Errorf("%s: %s is not in union %v", avroPath(path), avroTypeName(w), branches)
+	}
+	wt, rt := avroType(w), avroType(r)
+	if wt != rt && !avroPromotions[[2]string{wt, rt}] {
+		return fmt.Errorf("%s: %s cannot be read as %s", avroPath(path), avroTypeName(w), avroTypeName(r))
+	}
+	wm, _ := w.(map[string]any)
+	rm, _ := r.(map[string]any)
+	if wm["logicalType"] != rm["logicalType"] {
+		return fmt.Errorf("%s: %s cannot be read as %s", avroPath(path), avroTypeName(w), avroTypeName(r))
+	}
+	switch wt {
+	case "record":
+		if wm["name"] != rm["name"] {
+			return fmt.Errorf("%s: %s renamed to %s", avroPath(path), avroTypeName(w), avroTypeName(r))
+		}
+		key := [2]string{avroFullName(wm), avroFullName(rm)}
+		if c.seen[key] {
+			return nil
+		}
+		c.seen[key] = true
+		written := make(map[string]any)
+		fields, _ := wm["fields"].([]any)
+		for _, f := range fields {
+			if f, ok := f.(map[string]any); ok {
+				written[f["name"].(string)] = f["type"]
+			}
+		}
+		var errs []error
+		fields, _ = rm["fields"].([]any)
+		for _, f := range fields {
+			f, ok := f.(map[string]any)
+			if !ok {
+				continue
+			}
+			name, _ := f["name"].(string)
+			wf, ok := written[name]
+			if !ok {
+				if _, ok := f["default"]; !ok {
+					errs = append(errs, fmt.Errorf("%s: field has no default", avroPath(joinAvroPath(path, name))))
+				}
+				continue
+			}
+			errs = append(errs, c.read(joinAvroPath(path, name), wf, f["type"]))
+		}
+		return errors.Join(errs...)
+	case "enum":
+		if wm["name"] != rm["name"] {
+			return fmt.Errorf("%s: %s renamed to %s", avroPath(path), avroTypeName(w), avroTypeName(r))
+		}
+		if _, ok := rm["default"]; ok {
+			return nil // unknown symbols read as the default
+		}
+		symbols, _ := rm["symbols"].([]any)
+		written, _ := wm["symbols"].([]any)
+		for _, s := range written {
+			if !slices.Contains(symbols, s) {
+				return fmt.Errorf("%s: symbol %v missing from %s", avroPath(path), s, avroTypeName(r))
+			}
+		}
+	case "array":
+		return c.read(path, wm["items"], rm["items"])
+	case "map":
+		return c.read(path, wm["values"], rm["values"])
+	}
+	return nil
+}
+
+func joinAvroPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func avroPath(path string) string {
+	if path == "" {
+		return "(root)"
+	}
+	return path
+}
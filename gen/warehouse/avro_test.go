@@ -0,0 +1,79 @@
+package warehouse
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestCheckAvroCompatible(t *testing.T) {
+	record := func(fields string) string {
+		return `{"type": "record", "name": "Order", "namespace": "go.escape.ship.proto.v1", "fields": [` + fields + `]}`
+	}
+	const (
+		status     = `{"name": "status", "type": "string", "default": ""}`
+		enumStatus = `{"name": "status", "type": {"type": "enum", "name": "OrderStatus", "symbols": ["ORDER_STATUS_UNSPECIFIED", "ORDER_STATUS_PAID"], "default": "ORDER_STATUS_UNSPECIFIED"}, "default": "ORDER_STATUS_UNSPECIFIED"}`
+		quantity   = `{"name": "quantity", "type": "int", "default": 0}`
+		money      = `{"name": "total", "type": ["null", {"type": "record", "name": "Money", "fields": [{"name": "units", "type": "long", "default": 0}]}], "default": null}`
+	)
+	tests := []struct {
+		name     string
+		old, new string
+		wantErr  string
+	}{
+		{name: "unchanged", old: record(status + "," + money), new: record(status + "," + money)},
+		{name: "field added", old: record(status), new: record(status + "," + quantity)},
+		{name: "field removed", old: record(status + "," + quantity), new: record(status)},
+		{name: "field added without default", old: record(status), new: record(status + `,{"name": "memo", "type": "string"}`), wantErr: "memo: field has no default"},
+		{name: "string to enum", old: record(status), new: record(enumStatus), wantErr: "status: string cannot be read as enum OrderStatus"},
+		{name: "enum to string", old: record(enumStatus), new: record(status), wantErr: "status: enum OrderStatus cannot be read as string"},
+		{name: "int promoted to long", old: record(quantity), new: record(`{"name": "quantity", "type": "long", "default": 0}`)},
+		{name: "long to int", old: record(`{"name": "quantity", "type": "long", "default": 0}`), new: record(quantity), wantErr: "quantity: long cannot be read as int"},
+		{name: "made nullable", old: record(quantity), new: record(`{"name": "quantity", "type": ["null", "int"], "default": null}`)},
+		{name: "made required", old: record(`{"name": "quantity", "type": ["null", "int"], "default": null}`), new: record(quantity), wantErr: "quantity: null cannot be read as int"},
+		{
+			name:    "nested type change",
+			old:     record(money),
+			new:     record(`{"name": "total", "type": ["null", {"type": "record", "name": "Money", "fields": [{"name": "units", "type": "string", "default": ""}]}], "default": null}`),
+			wantErr: "total.units: long cannot be read as string",
+		},
+		{
+			name:    "record renamed",
+			old:     record(money),
+			new:     record(`{"name": "total", "type": ["null", {"type": "record", "name": "Price", "fields": []}], "default": null}`),
+			wantErr: "total: record Money is not in union [null record Price]",
+		},
+		{
+			name:    "timestamp to long",
+			old:     record(`{"name": "paid_time", "type": ["null", {"type": "long", "logicalType": "timestamp-micros"}], "default": null}`),
+			new:     record(`{"name": "paid_time", "type": ["null", "long"], "default": null}`),
+			wantErr: "paid_time: long (timestamp-micros) cannot be read as long",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var old, new any
+			if err := json.Unmarshal([]byte(tt.old), &old); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal([]byte(tt.new), &new); err != nil {
+				t.Fatal(err)
+			}
+			err := CheckAvroCompatible(old, new)
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Errorf("CheckAvroCompatible() = %v, want nil", err)
+			case tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)):
+				t.Errorf("CheckAvroCompatible() = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckAvroCompatibleTables(t *testing.T) {
+	for _, tbl := range Tables {
+		if err := CheckAvroCompatible(AvroSchema(tbl.Message), AvroSchema(tbl.Message)); err != nil {
+			t.Errorf("%s: %v", tbl.Name, err)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package warehouse
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// BigQueryField is a column in BigQuery's JSON schema format, as accepted by
+// "bq mk --table" and the tables API.
+type BigQueryField struct {
+	Name   string          `json:"name"`
+	Type   string          `json:"type"`
+	Mode   string          `json:"mode"`
+	Fields []BigQueryField `json:"fields,omitempty"`
+}
+
+// BigQuerySchema returns the table schema for rows encoded from md.
+func BigQuerySchema(md protoreflect.MessageDescriptor) []BigQueryField {
+	return bigQueryFields(md, 1)
+}
+
+func bigQueryFields(md protoreflect.MessageDescriptor, depth int) []BigQueryField {
+	fields := md.Fields()
+	out := make([]BigQueryField, 0, fields.Len())
+	for i := 0; i < fields.Len(); i++ {
+		out = append(out, bigQueryField(fields.Get(i), depth))
+	}
+	return out
+}
+
+func bigQueryField(fd protoreflect.FieldDescriptor, depth int) BigQueryField {
+	f := BigQueryField{Name: string(fd.Name()), Mode: "NULLABLE"}
+	if fd.IsList() || fd.IsMap() {
+		f.Mode = "REPEATED"
+	}
+	switch {
+	case fd.IsMap():
+		f.Type = "RECORD"
+		f.Fields = []BigQueryField{
+			bigQueryField(fd.MapKey(), depth+1),
+			bigQueryField(fd.MapValue(), depth+1),
+		}
+		f.Fields[0].Name, f.Fields[1].Name = "key", "value"
+	case fd.Message() != nil:
+		switch fd.Message().FullName() {
+		case "google.protobuf.Timestamp":
+			f.Type = "TIMESTAMP"
+		case "google.protobuf.Duration", "google.protobuf.FieldMask":
+			f.Type = "STRING"
+		case "google.protobuf.Struct", "google.protobuf.Value", "google.protobuf.ListValue", "google.protobuf.Any":
+			f.Type = "JSON"
+		default:
+			if w := wrapperValue(fd.Message()); w != nil {
+				f.Type = bigQueryScalar(w)
+			} else if depth >= maxDepth {
+				f.Type = "JSON"
+			} else {
+				f.Type = "RECORD"
+				f.Fields = bigQueryFields(fd.Message(), depth+1)
+			}
+		}
+	default:
+		f.Type = bigQueryScalar(fd)
+	}
+	return f
+}
+
+func bigQueryScalar(fd protoreflect.FieldDescriptor) string {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return "BOOLEAN"
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return "NUMERIC" // exceeds INT64
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return "INTEGER"
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return "FLOAT"
+	case protoreflect.BytesKind:
+		return "BYTES"
+	default: // strings and enums (stored by value name)
+		return "STRING"
+	}
+}
+
+// wrapperValue returns the value field of a google.protobuf wrapper type, or
+// nil for other messages.
+func wrapperValue(md protoreflect.MessageDescriptor) protoreflect.FieldDescriptor {
+	if md.ParentFile().Path() != "google/protobuf/wrappers.proto" {
+		return nil
+	}
+	return md.Fields().ByName("value")
+}
+
+// CheckBigQueryCompatible reports the changes from old to new that BigQuery
+// cannot apply to an existing table in place: removed (or renamed) columns,
+// type changes, mode changes other than REQUIRED to NULLABLE, and new
+// REQUIRED columns.
+func CheckBigQueryCompatible(old, new []BigQueryField) error {
+	return checkFields("", old, new)
+}
+
+func checkFields(prefix string, old, new []BigQueryField) error {
+	byName := make(map[string]BigQueryField, len(new))
+	for _, f := range new {
+		byName[f.Name] = f
+	}
+	var errs []error
+	for _, o := range old {
+		path := prefix + o.Name
+		n, ok := byName[o.Name]
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s: column removed", path))
+			continue
+		}
+		delete(byName, o.Name)
+		if o.Type != n.Type {
+			errs = append(errs, fmt.Errorf("%s: type changed from %s to %s", path, o.Type, n.Type))
+			continue
+		}
+		if o.Mode != n.Mode && !(o.Mode == "REQUIRED" && n.Mode == "NULLABLE") {
+			errs = append(errs, fmt.Errorf("%s: mode changed from %s to %s", path, o.Mode, n.Mode))
+		}
+		if o.Type == "RECORD" {
+			errs = append(errs, checkFields(path+".", o.Fields, n.Fields))
+		}
+	}
+	for _, n := range byName {
+		if n.Mode == "REQUIRED" {
+			errs = append(errs, fmt.Errorf("%s%s: new column is REQUIRED", prefix, n.Name))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,103 @@
+// Command warehousegen writes the warehouse table schemas derived by package
+// warehouse: <dir>/bigquery/<table>.json and <dir>/avro/<table>.avsc.
+//
+//	go run github.com/escape-ship/protos/gen/warehouse/cmd/warehousegen -out gen/warehouse/schemas
+//
+// With -check it instead compares the derived schemas against those in the
+// directory and exits non-zero if any existing BigQuery table could not be
+// updated in place or any new Avro schema could not read rows written with
+// the old one. Run it in CI before regenerating.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/escape-ship/protos/gen/warehouse"
+)
+
+func main() {
+	out := flag.String("out", "", "directory to write schemas to")
+	check := flag.String("check", "", "directory with the previous schemas to check compatibility against")
+	flag.Parse()
+	switch {
+	case *check != "":
+		if err := checkAll(*check); err != nil {
+			log.Fatalf("warehousegen: incompatible schema changes:\n%v", err)
+		}
+	case *out != "":
+		if err := writeAll(*out); err != nil {
+			log.Fatal("warehousegen: ", err)
+		}
+	default:
+		log.Fatal("warehousegen: -out or -check is required")
+	}
+}
+
+func writeAll(dir string) error {
+	for _, sub := range []string{"bigquery", "avro"} {
+		if err := os.MkdirAll(filepath.Join(dir, sub), 0o755); err != nil {
+			return err
+		}
+	}
+	for _, t := range warehouse.Tables {
+		if err := writeJSON(filepath.Join(dir, "bigquery", t.Name+".json"), warehouse.BigQuerySchema(t.Message)); err != nil {
+			return err
+		}
+		if err := writeJSON(filepath.Join(dir, "avro", t.Name+".avsc"), warehouse.AvroSchema(t.Message)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeJSON(path string, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+func checkAll(dir string) error {
+	var errs []error
+	for _, t := range warehouse.Tables {
+		var oldBigQuery []warehouse.BigQueryField
+		switch ok, err := readJSON(filepath.Join(dir, "bigquery", t.Name+".json"), &oldBigQuery); {
+		case err != nil:
+			return fmt.Errorf("%s: %w", t.Name, err)
+		case ok:
+			if err := warehouse.CheckBigQueryCompatible(oldBigQuery, warehouse.BigQuerySchema(t.Message)); err != nil {
+				errs = append(errs, fmt.Errorf("table %s:\n%w", t.Name, err))
+			}
+		}
+		var oldAvro any
+		switch ok, err := readJSON(filepath.Join(dir, "avro", t.Name+".avsc"), &oldAvro); {
+		case err != nil:
+			return fmt.Errorf("%s: %w", t.Name, err)
+		case ok:
+			if err := warehouse.CheckAvroCompatible(oldAvro, warehouse.AvroSchema(t.Message)); err != nil {
+				errs = append(errs, fmt.Errorf("avro schema %s:\n%w", t.Name, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// readJSON decodes the file at path into v. It reports false without an
+// error if the file does not exist, e.g. for a new table.
+func readJSON(path string, v any) (bool, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, json.Unmarshal(data, v)
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCheckAll(t *testing.T) {
+	tests := []struct {
+		name    string
+		edit    func(t *testing.T, dir string)
+		wantErr string
+	}{
+		{name: "unchanged", edit: func(*testing.T, string) {}},
+		{name: "new table", edit: func(t *testing.T, dir string) {
+			for _, f := range []string{"bigquery/orders.json", "avro/orders.avsc"} {
+				if err := os.Remove(filepath.Join(dir, f)); err != nil {
+					t.Fatal(err)
+				}
+			}
+		}},
+		{
+			// The BigQuery column stays STRING when a string becomes an enum,
+			// so only the Avro check catches it.
+			name: "avro type change",
+			edit: func(t *testing.T, dir string) {
+				path := filepath.Join(dir, "avro", "products.avsc")
+				data, err := os.ReadFile(path)
+				if err != nil {
+					t.Fatal(err)
+				}
+				const name = `"name": "name",
+      "type": "string"`
+				if !strings.Contains(string(data), name) {
+					t.Fatalf("%s has no string name field", path)
+				}
+				data = []byte(strings.Replace(string(data), name, `"name": "name",
+      "type": {"type": "enum", "name": "Name", "symbols": ["UNKNOWN"]}`, 1))
+				if err := os.WriteFile(path, data, 0o644); err != nil {
+					t.Fatal(err)
+				}
+			},
+			wantErr: "avro schema products:\nname: enum Name cannot be read as string",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if err := writeAll(dir); err != nil {
+				t.Fatal(err)
+			}
+			tt.edit(t, dir)
+			err := checkAll(dir)
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Errorf("checkAll() = %v, want nil", err)
+			case tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)):
+				t.Errorf("checkAll() = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
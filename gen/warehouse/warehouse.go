@@ -0,0 +1,45 @@
+// Package warehouse derives BigQuery and Avro schemas from the order, payment
+// and product messages, so domain events can be landed in the data warehouse
+// without hand-written mappings:
+//
+//	for _, t := range warehouse.Tables {
+//	    schema := warehouse.BigQuerySchema(t.Message)
+//	    // create or update the table
+//	}
+//
+// Field names are the proto (snake_case) names. Enums are stored by value name
+// and google.protobuf.Timestamp as TIMESTAMP (Avro timestamp-micros).
+//
+// The schemas of the last release are committed under schemas/. Before
+// regenerating them, CheckBigQueryCompatible and CheckAvroCompatible (or
+// warehousegen -check) verify that existing tables can be updated in place
+// and that rows already written stay readable: fields may be added but not
+// removed, renamed or retyped.
+package warehouse
+
+//go:generate go run ./cmd/warehousegen -out schemas
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	pb "github.com/escape-ship/protos/gen"
+)
+
+// Table is a warehouse table whose rows are encoded from Message.
+type Table struct {
+	Name    string
+	Message protoreflect.MessageDescriptor
+}
+
+// Tables are the tables landed in the warehouse.
+var Tables = []Table{
+	{Name: "orders", Message: (*pb.Order)(nil).ProtoReflect().Descriptor()},
+	{Name: "products", Message: (*pb.Product)(nil).ProtoReflect().Descriptor()},
+	{Name: "payment_ready", Message: (*pb.KakaoReadyRequest)(nil).ProtoReflect().Descriptor()},
+	{Name: "payment_approvals", Message: (*pb.KakaoApproveRequest)(nil).ProtoReflect().Descriptor()},
+	{Name: "payment_cancellations", Message: (*pb.KakaoCancelRequest)(nil).ProtoReflect().Descriptor()},
+}
+
+// maxDepth is BigQuery's RECORD nesting limit. Deeper (e.g. recursive)
+// messages are stored as JSON.
+const maxDepth = 15
@@ -0,0 +1,638 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: wishlist.proto
+
+package gen
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type WishlistItem struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId      string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductName    string                 `protobuf:"bytes,3,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	ProductOptions string                 `protobuf:"bytes,4,opt,name=product_options,json=productOptions,proto3" json:"product_options,omitempty"`   // JSON 문자열 (CartItem.product_options와 동일 형식), 옵션 미선택이면 빈 문자열
+	Price          *Money                 `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`                                           // 현재 판매가
+	PriceWhenAdded *Money                 `protobuf:"bytes,6,opt,name=price_when_added,json=priceWhenAdded,proto3" json:"price_when_added,omitempty"` // 담을 당시 판매가 (가격 인하 알림용)
+	InStock        bool                   `protobuf:"varint,7,opt,name=in_stock,json=inStock,proto3" json:"in_stock,omitempty"`
+	AddedAt        string                 `protobuf:"bytes,8,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WishlistItem) Reset() {
+	*x = WishlistItem{}
+	mi := &file_wishlist_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WishlistItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WishlistItem) ProtoMessage() {}
+
+func (x *WishlistItem) ProtoReflect() protoreflect.Message {
+	mi := &file_wishlist_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WishlistItem.ProtoReflect.Descriptor instead.
+func (*WishlistItem) Descriptor() ([]byte, []int) {
+	return file_wishlist_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WishlistItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WishlistItem) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *WishlistItem) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *WishlistItem) GetProductOptions() string {
+	if x != nil {
+		return x.ProductOptions
+	}
+	return ""
+}
+
+func (x *WishlistItem) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *WishlistItem) GetPriceWhenAdded() *Money {
+	if x != nil {
+		return x.PriceWhenAdded
+	}
+	return nil
+}
+
+func (x *WishlistItem) GetInStock() bool {
+	if x != nil {
+		return x.InStock
+	}
+	return false
+}
+
+func (x *WishlistItem) GetAddedAt() string {
+	if x != nil {
+		return x.AddedAt
+	}
+	return ""
+}
+
+type AddToWishlistRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ProductId      string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductOptions string                 `protobuf:"bytes,2,opt,name=product_options,json=productOptions,proto3" json:"product_options,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AddToWishlistRequest) Reset() {
+	*x = AddToWishlistRequest{}
+	mi := &file_wishlist_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddToWishlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddToWishlistRequest) ProtoMessage() {}
+
+func (x *AddToWishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wishlist_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddToWishlistRequest.ProtoReflect.Descriptor instead.
+func (*AddToWishlistRequest) Descriptor() ([]byte, []int) {
+	return file_wishlist_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AddToWishlistRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *AddToWishlistRequest) GetProductOptions() string {
+	if x != nil {
+		return x.ProductOptions
+	}
+	return ""
+}
+
+type AddToWishlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *WishlistItem          `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddToWishlistResponse) Reset() {
+	*x = AddToWishlistResponse{}
+	mi := &file_wishlist_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddToWishlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddToWishlistResponse) ProtoMessage() {}
+
+func (x *AddToWishlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wishlist_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddToWishlistResponse.ProtoReflect.Descriptor instead.
+func (*AddToWishlistResponse) Descriptor() ([]byte, []int) {
+	return file_wishlist_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AddToWishlistResponse) GetItem() *WishlistItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type RemoveFromWishlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFromWishlistRequest) Reset() {
+	*x = RemoveFromWishlistRequest{}
+	mi := &file_wishlist_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFromWishlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFromWishlistRequest) ProtoMessage() {}
+
+func (x *RemoveFromWishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wishlist_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFromWishlistRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFromWishlistRequest) Descriptor() ([]byte, []int) {
+	return file_wishlist_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RemoveFromWishlistRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+type RemoveFromWishlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFromWishlistResponse) Reset() {
+	*x = RemoveFromWishlistResponse{}
+	mi := &file_wishlist_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFromWishlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFromWishlistResponse) ProtoMessage() {}
+
+func (x *RemoveFromWishlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wishlist_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFromWishlistResponse.ProtoReflect.Descriptor instead.
+func (*RemoveFromWishlistResponse) Descriptor() ([]byte, []int) {
+	return file_wishlist_proto_rawDescGZIP(), []int{4}
+}
+
+type ListWishlistRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 페이지 크기 (0이면 서버 기본값, 최대 100)
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// 이전 응답의 next_page_token, 첫 페이지는 비워 둠
+	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWishlistRequest) Reset() {
+	*x = ListWishlistRequest{}
+	mi := &file_wishlist_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWishlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWishlistRequest) ProtoMessage() {}
+
+func (x *ListWishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wishlist_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWishlistRequest.ProtoReflect.Descriptor instead.
+func (*ListWishlistRequest) Descriptor() ([]byte, []int) {
+	return file_wishlist_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListWishlistRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListWishlistRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListWishlistResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Items []*WishlistItem        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// 다음 페이지 토큰, 마지막 페이지면 빈 문자열
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWishlistResponse) Reset() {
+	*x = ListWishlistResponse{}
+	mi := &file_wishlist_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWishlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWishlistResponse) ProtoMessage() {}
+
+func (x *ListWishlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wishlist_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWishlistResponse.ProtoReflect.Descriptor instead.
+func (*ListWishlistResponse) Descriptor() ([]byte, []int) {
+	return file_wishlist_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListWishlistResponse) GetItems() []*WishlistItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListWishlistResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListWishlistResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type MoveToCartRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ItemId         string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Quantity       int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"` // 0이면 1개
+	KeepInWishlist bool                   `protobuf:"varint,3,opt,name=keep_in_wishlist,json=keepInWishlist,proto3" json:"keep_in_wishlist,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MoveToCartRequest) Reset() {
+	*x = MoveToCartRequest{}
+	mi := &file_wishlist_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveToCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveToCartRequest) ProtoMessage() {}
+
+func (x *MoveToCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wishlist_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveToCartRequest.ProtoReflect.Descriptor instead.
+func (*MoveToCartRequest) Descriptor() ([]byte, []int) {
+	return file_wishlist_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MoveToCartRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *MoveToCartRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *MoveToCartRequest) GetKeepInWishlist() bool {
+	if x != nil {
+		return x.KeepInWishlist
+	}
+	return false
+}
+
+type MoveToCartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cart          *Cart                  `protobuf:"bytes,1,opt,name=cart,proto3" json:"cart,omitempty"` // 담은 후 장바구니
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveToCartResponse) Reset() {
+	*x = MoveToCartResponse{}
+	mi := &file_wishlist_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveToCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveToCartResponse) ProtoMessage() {}
+
+func (x *MoveToCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wishlist_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveToCartResponse.ProtoReflect.Descriptor instead.
+func (*MoveToCartResponse) Descriptor() ([]byte, []int) {
+	return file_wishlist_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MoveToCartResponse) GetCart() *Cart {
+	if x != nil {
+		return x.Cart
+	}
+	return nil
+}
+
+var File_wishlist_proto protoreflect.FileDescriptor
+
+const file_wishlist_proto_rawDesc = "" +
+	"\n" +
+	"\x0ewishlist.proto\x12\x17go.escape.ship.proto.v1\x1a\x1cgoogle/api/annotations.proto\x1a\n" +
+	"cart.proto\x1a\fcommon.proto\"\xbf\x02\n" +
+	"\fWishlistItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12!\n" +
+	"\fproduct_name\x18\x03 \x01(\tR\vproductName\x12'\n" +
+	"\x0fproduct_options\x18\x04 \x01(\tR\x0eproductOptions\x124\n" +
+	"\x05price\x18\x05 \x01(\v2\x1e.go.escape.ship.proto.v1.MoneyR\x05price\x12H\n" +
+	"\x10price_when_added\x18\x06 \x01(\v2\x1e.go.escape.ship.proto.v1.MoneyR\x0epriceWhenAdded\x12\x19\n" +
+	"\bin_stock\x18\a \x01(\bR\ainStock\x12\x19\n" +
+	"\badded_at\x18\b \x01(\tR\aaddedAt\"^\n" +
+	"\x14AddToWishlistRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12'\n" +
+	"\x0fproduct_options\x18\x02 \x01(\tR\x0eproductOptions\"R\n" +
+	"\x15AddToWishlistResponse\x129\n" +
+	"\x04item\x18\x01 \x01(\v2%.go.escape.ship.proto.v1.WishlistItemR\x04item\"4\n" +
+	"\x19RemoveFromWishlistRequest\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\"\x1c\n" +
+	"\x1aRemoveFromWishlistResponse\"Q\n" +
+	"\x13ListWishlistRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"\x9c\x01\n" +
+	"\x14ListWishlistResponse\x12;\n" +
+	"\x05items\x18\x01 \x03(\v2%.go.escape.ship.proto.v1.WishlistItemR\x05items\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"r\n" +
+	"\x11MoveToCartRequest\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12(\n" +
+	"\x10keep_in_wishlist\x18\x03 \x01(\bR\x0ekeepInWishlist\"G\n" +
+	"\x12MoveToCartResponse\x121\n" +
+	"\x04cart\x18\x01 \x01(\v2\x1d.go.escape.ship.proto.v1.CartR\x04cart2\xe9\x04\n" +
+	"\x0fWishlistService\x12\x8d\x01\n" +
+	"\rAddToWishlist\x12-.go.escape.ship.proto.v1.AddToWishlistRequest\x1a..go.escape.ship.proto.v1.AddToWishlistResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/wishlist/items\x12\xa3\x01\n" +
+	"\x12RemoveFromWishlist\x122.go.escape.ship.proto.v1.RemoveFromWishlistRequest\x1a3.go.escape.ship.proto.v1.RemoveFromWishlistResponse\"$\x82\xd3\xe4\x93\x02\x1e*\x1c/v1/wishlist/items/{item_id}\x12\x81\x01\n" +
+	"\fListWishlist\x12,.go.escape.ship.proto.v1.ListWishlistRequest\x1a-.go.escape.ship.proto.v1.ListWishlistResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/wishlist\x12\x9b\x01\n" +
+	"\n" +
+	"MoveToCart\x12*.go.escape.ship.proto.v1.MoveToCartRequest\x1a+.go.escape.ship.proto.v1.MoveToCartResponse\"4\x82\xd3\xe4\x93\x02.:\x01*\")/v1/wishlist/items/{item_id}/move-to-cartB#Z!github.com/escape-ship/protos/genb\x06proto3"
+
+var (
+	file_wishlist_proto_rawDescOnce sync.Once
+	file_wishlist_proto_rawDescData []byte
+)
+
+func file_wishlist_proto_rawDescGZIP() []byte {
+	file_wishlist_proto_rawDescOnce.Do(func() {
+		file_wishlist_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_wishlist_proto_rawDesc), len(file_wishlist_proto_rawDesc)))
+	})
+	return file_wishlist_proto_rawDescData
+}
+
+var file_wishlist_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_wishlist_proto_goTypes = []any{
+	(*WishlistItem)(nil),               // 0: go.escape.ship.proto.v1.WishlistItem
+	(*AddToWishlistRequest)(nil),       // 1: go.escape.ship.proto.v1.AddToWishlistRequest
+	(*AddToWishlistResponse)(nil),      // 2: go.escape.ship.proto.v1.AddToWishlistResponse
+	(*RemoveFromWishlistRequest)(nil),  // 3: go.escape.ship.proto.v1.RemoveFromWishlistRequest
+	(*RemoveFromWishlistResponse)(nil), // 4: go.escape.ship.proto.v1.RemoveFromWishlistResponse
+	(*ListWishlistRequest)(nil),        // 5: go.escape.ship.proto.v1.ListWishlistRequest
+	(*ListWishlistResponse)(nil),       // 6: go.escape.ship.proto.v1.ListWishlistResponse
+	(*MoveToCartRequest)(nil),          // 7: go.escape.ship.proto.v1.MoveToCartRequest
+	(*MoveToCartResponse)(nil),         // 8: go.escape.ship.proto.v1.MoveToCartResponse
+	(*Money)(nil),                      // 9: go.escape.ship.proto.v1.Money
+	(*Cart)(nil),                       // 10: go.escape.ship.proto.v1.Cart
+}
+var file_wishlist_proto_depIdxs = []int32{
+	9,  // 0: go.escape.ship.proto.v1.WishlistItem.price:type_name -> go.escape.ship.proto.v1.Money
+	9,  // 1: go.escape.ship.proto.v1.WishlistItem.price_when_added:type_name -> go.escape.ship.proto.v1.Money
+	0,  // 2: go.escape.ship.proto.v1.AddToWishlistResponse.item:type_name -> go.escape.ship.proto.v1.WishlistItem
+	0,  // 3: go.escape.ship.proto.v1.ListWishlistResponse.items:type_name -> go.escape.ship.proto.v1.WishlistItem
+	10, // 4: go.escape.ship.proto.v1.MoveToCartResponse.cart:type_name -> go.escape.ship.proto.v1.Cart
+	1,  // 5: go.escape.ship.proto.v1.WishlistService.AddToWishlist:input_type -> go.escape.ship.proto.v1.AddToWishlistRequest
+	3,  // 6: go.escape.ship.proto.v1.WishlistService.RemoveFromWishlist:input_type -> go.escape.ship.proto.v1.RemoveFromWishlistRequest
+	5,  // 7: go.escape.ship.proto.v1.WishlistService.ListWishlist:input_type -> go.escape.ship.proto.v1.ListWishlistRequest
+	7,  // 8: go.escape.ship.proto.v1.WishlistService.MoveToCart:input_type -> go.escape.ship.proto.v1.MoveToCartRequest
+	2,  // 9: go.escape.ship.proto.v1.WishlistService.AddToWishlist:output_type -> go.escape.ship.proto.v1.AddToWishlistResponse
+	4,  // 10: go.escape.ship.proto.v1.WishlistService.RemoveFromWishlist:output_type -> go.escape.ship.proto.v1.RemoveFromWishlistResponse
+	6,  // 11: go.escape.ship.proto.v1.WishlistService.ListWishlist:output_type -> go.escape.ship.proto.v1.ListWishlistResponse
+	8,  // 12: go.escape.ship.proto.v1.WishlistService.MoveToCart:output_type -> go.escape.ship.proto.v1.MoveToCartResponse
+	9,  // [9:13] is the sub-list for method output_type
+	5,  // [5:9] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_wishlist_proto_init() }
+func file_wishlist_proto_init() {
+	if File_wishlist_proto != nil {
+		return
+	}
+	file_cart_proto_init()
+	file_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wishlist_proto_rawDesc), len(file_wishlist_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_wishlist_proto_goTypes,
+		DependencyIndexes: file_wishlist_proto_depIdxs,
+		MessageInfos:      file_wishlist_proto_msgTypes,
+	}.Build()
+	File_wishlist_proto = out.File
+	file_wishlist_proto_goTypes = nil
+	file_wishlist_proto_depIdxs = nil
+}
@@ -0,0 +1,1409 @@
+// Code generated by protoc-gen-twirp v8.1.3, DO NOT EDIT.
+// source: wishlist.proto
+
+package gen
+
+import context "context"
+import fmt "fmt"
+import http "net/http"
+import io "io"
+import json "encoding/json"
+import strconv "strconv"
+import strings "strings"
+
+import protojson "google.golang.org/protobuf/encoding/protojson"
+import proto "google.golang.org/protobuf/proto"
+import twirp "github.com/twitchtv/twirp"
+import ctxsetters "github.com/twitchtv/twirp/ctxsetters"
+
+// Version compatibility assertion.
+// If the constant is not defined in the package, that likely means
+// the package needs to be updated to work with this generated code.
+// See https://twitchtv.github.io/twirp/docs/version_matrix.html
+const _ = twirp.TwirpPackageMinVersion_8_1_0
+
+// =========================
+// WishlistService Interface
+// =========================
+
+// 위시리스트(찜) 서비스: Authorization 헤더의 사용자 기준으로 동작
+type WishlistService interface {
+	// 같은 상품/옵션이 이미 있으면 기존 항목을 그대로 반환
+	AddToWishlist(context.Context, *AddToWishlistRequest) (*AddToWishlistResponse, error)
+
+	RemoveFromWishlist(context.Context, *RemoveFromWishlistRequest) (*RemoveFromWishlistResponse, error)
+
+	// 최근 담은 순
+	ListWishlist(context.Context, *ListWishlistRequest) (*ListWishlistResponse, error)
+
+	// 장바구니에 담고 위시리스트에서 제거 (keep_in_wishlist면 유지)
+	// 장바구니 담기와 동일하게 재고 부족은 OUT_OF_STOCK, 구매 제한 초과는 PURCHASE_LIMIT_EXCEEDED 에러
+	MoveToCart(context.Context, *MoveToCartRequest) (*MoveToCartResponse, error)
+}
+
+// ===============================
+// WishlistService Protobuf Client
+// ===============================
+
+type wishlistServiceProtobufClient struct {
+	client      HTTPClient
+	urls        [4]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewWishlistServiceProtobufClient creates a Protobuf client that implements the WishlistService interface.
+// It communicates using Protobuf and can be configured with a custom HTTPClient.
+func NewWishlistServiceProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) WishlistService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "go.escape.ship.proto.v1", "WishlistService")
+	urls := [4]string{
+		serviceURL + "AddToWishlist",
+		serviceURL + "RemoveFromWishlist",
+		serviceURL + "ListWishlist",
+		serviceURL + "MoveToCart",
+	}
+
+	return &wishlistServiceProtobufClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *wishlistServiceProtobufClient) AddToWishlist(ctx context.Context, in *AddToWishlistRequest) (*AddToWishlistResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "WishlistService")
+	ctx = ctxsetters.WithMethodName(ctx, "AddToWishlist")
+	caller := c.callAddToWishlist
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AddToWishlistRequest) (*AddToWishlistResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AddToWishlistRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AddToWishlistRequest) when calling interceptor")
+					}
+					return c.callAddToWishlist(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AddToWishlistResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AddToWishlistResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *wishlistServiceProtobufClient) callAddToWishlist(ctx context.Context, in *AddToWishlistRequest) (*AddToWishlistResponse, error) {
+	out := new(AddToWishlistResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *wishlistServiceProtobufClient) RemoveFromWishlist(ctx context.Context, in *RemoveFromWishlistRequest) (*RemoveFromWishlistResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "WishlistService")
+	ctx = ctxsetters.WithMethodName(ctx, "RemoveFromWishlist")
+	caller := c.callRemoveFromWishlist
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RemoveFromWishlistRequest) (*RemoveFromWishlistResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RemoveFromWishlistRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RemoveFromWishlistRequest) when calling interceptor")
+					}
+					return c.callRemoveFromWishlist(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RemoveFromWishlistResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RemoveFromWishlistResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *wishlistServiceProtobufClient) callRemoveFromWishlist(ctx context.Context, in *RemoveFromWishlistRequest) (*RemoveFromWishlistResponse, error) {
+	out := new(RemoveFromWishlistResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *wishlistServiceProtobufClient) ListWishlist(ctx context.Context, in *ListWishlistRequest) (*ListWishlistResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "WishlistService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListWishlist")
+	caller := c.callListWishlist
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListWishlistRequest) (*ListWishlistResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListWishlistRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListWishlistRequest) when calling interceptor")
+					}
+					return c.callListWishlist(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListWishlistResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListWishlistResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *wishlistServiceProtobufClient) callListWishlist(ctx context.Context, in *ListWishlistRequest) (*ListWishlistResponse, error) {
+	out := new(ListWishlistResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *wishlistServiceProtobufClient) MoveToCart(ctx context.Context, in *MoveToCartRequest) (*MoveToCartResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "WishlistService")
+	ctx = ctxsetters.WithMethodName(ctx, "MoveToCart")
+	caller := c.callMoveToCart
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *MoveToCartRequest) (*MoveToCartResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MoveToCartRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MoveToCartRequest) when calling interceptor")
+					}
+					return c.callMoveToCart(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*MoveToCartResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*MoveToCartResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *wishlistServiceProtobufClient) callMoveToCart(ctx context.Context, in *MoveToCartRequest) (*MoveToCartResponse, error) {
+	out := new(MoveToCartResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ===========================
+// WishlistService JSON Client
+// ===========================
+
+type wishlistServiceJSONClient struct {
+	client      HTTPClient
+	urls        [4]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewWishlistServiceJSONClient creates a JSON client that implements the WishlistService interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewWishlistServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) WishlistService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "go.escape.ship.proto.v1", "WishlistService")
+	urls := [4]string{
+		serviceURL + "AddToWishlist",
+		serviceURL + "RemoveFromWishlist",
+		serviceURL + "ListWishlist",
+		serviceURL + "MoveToCart",
+	}
+
+	return &wishlistServiceJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *wishlistServiceJSONClient) AddToWishlist(ctx context.Context, in *AddToWishlistRequest) (*AddToWishlistResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "WishlistService")
+	ctx = ctxsetters.WithMethodName(ctx, "AddToWishlist")
+	caller := c.callAddToWishlist
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AddToWishlistRequest) (*AddToWishlistResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AddToWishlistRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AddToWishlistRequest) when calling interceptor")
+					}
+					return c.callAddToWishlist(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AddToWishlistResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AddToWishlistResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *wishlistServiceJSONClient) callAddToWishlist(ctx context.Context, in *AddToWishlistRequest) (*AddToWishlistResponse, error) {
+	out := new(AddToWishlistResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *wishlistServiceJSONClient) RemoveFromWishlist(ctx context.Context, in *RemoveFromWishlistRequest) (*RemoveFromWishlistResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "WishlistService")
+	ctx = ctxsetters.WithMethodName(ctx, "RemoveFromWishlist")
+	caller := c.callRemoveFromWishlist
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RemoveFromWishlistRequest) (*RemoveFromWishlistResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RemoveFromWishlistRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RemoveFromWishlistRequest) when calling interceptor")
+					}
+					return c.callRemoveFromWishlist(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RemoveFromWishlistResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RemoveFromWishlistResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *wishlistServiceJSONClient) callRemoveFromWishlist(ctx context.Context, in *RemoveFromWishlistRequest) (*RemoveFromWishlistResponse, error) {
+	out := new(RemoveFromWishlistResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *wishlistServiceJSONClient) ListWishlist(ctx context.Context, in *ListWishlistRequest) (*ListWishlistResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "WishlistService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListWishlist")
+	caller := c.callListWishlist
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListWishlistRequest) (*ListWishlistResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListWishlistRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListWishlistRequest) when calling interceptor")
+					}
+					return c.callListWishlist(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListWishlistResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListWishlistResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *wishlistServiceJSONClient) callListWishlist(ctx context.Context, in *ListWishlistRequest) (*ListWishlistResponse, error) {
+	out := new(ListWishlistResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *wishlistServiceJSONClient) MoveToCart(ctx context.Context, in *MoveToCartRequest) (*MoveToCartResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "WishlistService")
+	ctx = ctxsetters.WithMethodName(ctx, "MoveToCart")
+	caller := c.callMoveToCart
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *MoveToCartRequest) (*MoveToCartResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MoveToCartRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MoveToCartRequest) when calling interceptor")
+					}
+					return c.callMoveToCart(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*MoveToCartResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*MoveToCartResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *wishlistServiceJSONClient) callMoveToCart(ctx context.Context, in *MoveToCartRequest) (*MoveToCartResponse, error) {
+	out := new(MoveToCartResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ==============================
+// WishlistService Server Handler
+// ==============================
+
+type wishlistServiceServer struct {
+	WishlistService
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewWishlistServiceServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewWishlistServiceServer(svc WishlistService, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	return &wishlistServiceServer{
+		WishlistService:  svc,
+		hooks:            serverOpts.Hooks,
+		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:       pathPrefix,
+		jsonSkipDefaults: jsonSkipDefaults,
+		jsonCamelCase:    jsonCamelCase,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *wishlistServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *wishlistServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
+
+// WishlistServicePathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const WishlistServicePathPrefix = "/twirp/go.escape.ship.proto.v1.WishlistService/"
+
+func (s *wishlistServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "go.escape.ship.proto.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "WishlistService")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "go.escape.ship.proto.v1.WishlistService" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "AddToWishlist":
+		s.serveAddToWishlist(ctx, resp, req)
+		return
+	case "RemoveFromWishlist":
+		s.serveRemoveFromWishlist(ctx, resp, req)
+		return
+	case "ListWishlist":
+		s.serveListWishlist(ctx, resp, req)
+		return
+	case "MoveToCart":
+		s.serveMoveToCart(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *wishlistServiceServer) serveAddToWishlist(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveAddToWishlistJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveAddToWishlistProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *wishlistServiceServer) serveAddToWishlistJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "AddToWishlist")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(AddToWishlistRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.WishlistService.AddToWishlist
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *AddToWishlistRequest) (*AddToWishlistResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AddToWishlistRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AddToWishlistRequest) when calling interceptor")
+					}
+					return s.WishlistService.AddToWishlist(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AddToWishlistResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AddToWishlistResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *AddToWishlistResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AddToWishlistResponse and nil error while calling AddToWishlist. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *wishlistServiceServer) serveAddToWishlistProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "AddToWishlist")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(AddToWishlistRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.WishlistService.AddToWishlist
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *AddToWishlistRequest) (*AddToWishlistResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AddToWishlistRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AddToWishlistRequest) when calling interceptor")
+					}
+					return s.WishlistService.AddToWishlist(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AddToWishlistResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AddToWishlistResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *AddToWishlistResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AddToWishlistResponse and nil error while calling AddToWishlist. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *wishlistServiceServer) serveRemoveFromWishlist(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveRemoveFromWishlistJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveRemoveFromWishlistProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *wishlistServiceServer) serveRemoveFromWishlistJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RemoveFromWishlist")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(RemoveFromWishlistRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.WishlistService.RemoveFromWishlist
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RemoveFromWishlistRequest) (*RemoveFromWishlistResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RemoveFromWishlistRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RemoveFromWishlistRequest) when calling interceptor")
+					}
+					return s.WishlistService.RemoveFromWishlist(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RemoveFromWishlistResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RemoveFromWishlistResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RemoveFromWishlistResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RemoveFromWishlistResponse and nil error while calling RemoveFromWishlist. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *wishlistServiceServer) serveRemoveFromWishlistProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RemoveFromWishlist")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(RemoveFromWishlistRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.WishlistService.RemoveFromWishlist
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RemoveFromWishlistRequest) (*RemoveFromWishlistResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RemoveFromWishlistRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RemoveFromWishlistRequest) when calling interceptor")
+					}
+					return s.WishlistService.RemoveFromWishlist(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RemoveFromWishlistResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RemoveFromWishlistResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RemoveFromWishlistResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RemoveFromWishlistResponse and nil error while calling RemoveFromWishlist. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *wishlistServiceServer) serveListWishlist(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListWishlistJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListWishlistProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *wishlistServiceServer) serveListWishlistJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListWishlist")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ListWishlistRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.WishlistService.ListWishlist
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListWishlistRequest) (*ListWishlistResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListWishlistRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListWishlistRequest) when calling interceptor")
+					}
+					return s.WishlistService.ListWishlist(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListWishlistResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListWishlistResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListWishlistResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListWishlistResponse and nil error while calling ListWishlist. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *wishlistServiceServer) serveListWishlistProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListWishlist")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ListWishlistRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.WishlistService.ListWishlist
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListWishlistRequest) (*ListWishlistResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListWishlistRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListWishlistRequest) when calling interceptor")
+					}
+					return s.WishlistService.ListWishlist(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListWishlistResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListWishlistResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListWishlistResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListWishlistResponse and nil error while calling ListWishlist. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *wishlistServiceServer) serveMoveToCart(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveMoveToCartJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveMoveToCartProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *wishlistServiceServer) serveMoveToCartJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "MoveToCart")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(MoveToCartRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.WishlistService.MoveToCart
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *MoveToCartRequest) (*MoveToCartResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MoveToCartRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MoveToCartRequest) when calling interceptor")
+					}
+					return s.WishlistService.MoveToCart(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*MoveToCartResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*MoveToCartResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *MoveToCartResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *MoveToCartResponse and nil error while calling MoveToCart. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *wishlistServiceServer) serveMoveToCartProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "MoveToCart")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(MoveToCartRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.WishlistService.MoveToCart
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *MoveToCartRequest) (*MoveToCartResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MoveToCartRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MoveToCartRequest) when calling interceptor")
+					}
+					return s.WishlistService.MoveToCart(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*MoveToCartResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*MoveToCartResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *MoveToCartResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *MoveToCartResponse and nil error while calling MoveToCart. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *wishlistServiceServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor14, 0
+}
+
+func (s *wishlistServiceServer) ProtocGenTwirpVersion() string {
+	return "v8.1.3"
+}
+
+// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
+// that is everything in a Twirp route except for the <Method>. This can be used for routing,
+// for example to identify the requests that are targeted to this service in a mux.
+func (s *wishlistServiceServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "go.escape.ship.proto.v1", "WishlistService")
+}
+
+var twirpFileDescriptor14 = []byte{
+	// 731 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x4e, 0xdb, 0x4c,
+	0x14, 0x95, 0x43, 0x42, 0xc2, 0x4d, 0x08, 0x7c, 0xf3, 0xf1, 0x09, 0xe3, 0x0f, 0x68, 0x70, 0xff,
+	0x52, 0xda, 0xd8, 0x22, 0xb0, 0x69, 0xbb, 0xa2, 0x48, 0x6d, 0x23, 0x95, 0xfe, 0x18, 0x24, 0xa4,
+	0x2e, 0x6a, 0x0d, 0xf6, 0x28, 0x19, 0x81, 0x67, 0x8c, 0x3d, 0x09, 0x85, 0xaa, 0x8b, 0x76, 0xdf,
+	0x55, 0x97, 0xed, 0xae, 0x0f, 0xd2, 0x87, 0xe8, 0x2b, 0x74, 0xd3, 0xb7, 0xa8, 0x66, 0x6c, 0x43,
+	0x08, 0x09, 0x4d, 0x77, 0x99, 0xe3, 0x7b, 0xef, 0x39, 0xf7, 0xcc, 0x99, 0x40, 0xf5, 0x98, 0xc6,
+	0x9d, 0x43, 0x1a, 0x0b, 0x2b, 0x8c, 0xb8, 0xe0, 0x68, 0xbe, 0xcd, 0x2d, 0x12, 0x7b, 0x38, 0x24,
+	0x56, 0xdc, 0xa1, 0x61, 0x82, 0x5a, 0xbd, 0x35, 0x63, 0xb1, 0xcd, 0x79, 0xfb, 0x90, 0xd8, 0x38,
+	0xa4, 0x36, 0x66, 0x8c, 0x0b, 0x2c, 0x28, 0x67, 0x71, 0x52, 0x60, 0x80, 0x87, 0xa3, 0x74, 0x84,
+	0x51, 0xf1, 0x78, 0x10, 0x70, 0x96, 0x9c, 0xcc, 0xef, 0x39, 0xa8, 0xec, 0xa5, 0x1c, 0x2d, 0x41,
+	0x02, 0x54, 0x85, 0x1c, 0xf5, 0x75, 0xad, 0xa6, 0xd5, 0xa7, 0x9c, 0x1c, 0xf5, 0xd1, 0x12, 0x40,
+	0x18, 0x71, 0xbf, 0xeb, 0x09, 0x97, 0xfa, 0x7a, 0x4e, 0xe1, 0x53, 0x29, 0xd2, 0xf2, 0xd1, 0x0a,
+	0x54, 0xb2, 0xcf, 0x0c, 0x07, 0x44, 0x9f, 0x50, 0x05, 0xe5, 0x14, 0x7b, 0x8e, 0x03, 0x82, 0x6e,
+	0xc3, 0x4c, 0x56, 0xc2, 0x43, 0xa5, 0x4a, 0xcf, 0xab, 0xaa, 0x6a, 0x0a, 0xbf, 0x48, 0x50, 0xb4,
+	0x01, 0x85, 0x30, 0xa2, 0x1e, 0xd1, 0x0b, 0x35, 0xad, 0x5e, 0x6e, 0x2e, 0x5b, 0x23, 0x96, 0xb5,
+	0xb6, 0x39, 0x23, 0x27, 0x4e, 0x52, 0x8c, 0x9e, 0xc2, 0xac, 0xfa, 0xe1, 0x1e, 0x77, 0x08, 0x73,
+	0xb1, 0xef, 0x13, 0x5f, 0x9f, 0x1c, 0x6b, 0x40, 0x55, 0xf5, 0xed, 0x75, 0x08, 0xdb, 0x94, 0x5d,
+	0x68, 0x01, 0x4a, 0x94, 0xb9, 0xb1, 0xe0, 0xde, 0x81, 0x5e, 0xac, 0x69, 0xf5, 0x92, 0x53, 0xa4,
+	0x6c, 0x47, 0x1e, 0xe5, 0x27, 0x35, 0xd9, 0xc5, 0x42, 0x2f, 0x29, 0xf1, 0x45, 0x75, 0xde, 0x14,
+	0xe6, 0x1b, 0x98, 0xdb, 0xf4, 0xfd, 0x5d, 0x9e, 0xb9, 0xe8, 0x90, 0xa3, 0x2e, 0x89, 0xc5, 0x80,
+	0x71, 0xda, 0xa0, 0x71, 0x43, 0x5c, 0xc9, 0x0d, 0x73, 0xc5, 0x74, 0xe0, 0xbf, 0x81, 0xf9, 0x71,
+	0xc8, 0x59, 0x4c, 0xd0, 0x7d, 0xc8, 0x53, 0x41, 0x02, 0x35, 0xba, 0xdc, 0xbc, 0x39, 0x72, 0xd9,
+	0xfe, 0xeb, 0x75, 0x54, 0x8b, 0xb9, 0x01, 0x0b, 0x0e, 0x09, 0x78, 0x8f, 0x3c, 0x8e, 0x78, 0x30,
+	0x28, 0x7c, 0x1e, 0x8a, 0xb2, 0xe8, 0x5c, 0xf5, 0xa4, 0x3c, 0xb6, 0x7c, 0x73, 0x11, 0x8c, 0x61,
+	0x5d, 0x89, 0x1c, 0xf3, 0x15, 0xfc, 0xfb, 0x8c, 0xc6, 0x62, 0x70, 0xda, 0xff, 0x30, 0x15, 0xe2,
+	0x36, 0x71, 0x63, 0x7a, 0x4a, 0xd4, 0xbc, 0x82, 0x53, 0x92, 0xc0, 0x0e, 0x3d, 0x25, 0xca, 0x23,
+	0xf9, 0x51, 0xf0, 0x03, 0xc2, 0xce, 0xc2, 0x85, 0xdb, 0x64, 0x57, 0x02, 0xe6, 0x57, 0x0d, 0xe6,
+	0x2e, 0xce, 0x4c, 0x57, 0x7f, 0x08, 0x05, 0xa9, 0x29, 0xd6, 0xb5, 0xda, 0xc4, 0xf8, 0xbb, 0x27,
+	0x3d, 0xe8, 0x16, 0xcc, 0x30, 0xf2, 0x56, 0xb8, 0x97, 0x98, 0xa7, 0x25, 0xfc, 0x32, 0x63, 0x47,
+	0xd7, 0xa0, 0x2c, 0xb8, 0xc0, 0x87, 0xae, 0xc7, 0xbb, 0x4c, 0xa8, 0x64, 0x17, 0x1c, 0x50, 0xd0,
+	0x96, 0x44, 0xcc, 0x08, 0xfe, 0xd9, 0xe6, 0x3d, 0xb2, 0xcb, 0xb7, 0x70, 0xf4, 0x47, 0xf7, 0x90,
+	0x01, 0xa5, 0xa3, 0x2e, 0x66, 0x82, 0x8a, 0x13, 0xc5, 0x57, 0x70, 0xce, 0xce, 0xa8, 0x0e, 0xb3,
+	0x07, 0x84, 0x84, 0x2e, 0x65, 0x6e, 0xf6, 0xe0, 0x15, 0x5f, 0xc9, 0xa9, 0x4a, 0xbc, 0xc5, 0xb2,
+	0x3d, 0xcc, 0x27, 0x80, 0xfa, 0x39, 0x53, 0x3f, 0xd6, 0x20, 0x2f, 0x5f, 0x78, 0x1a, 0x85, 0xa5,
+	0x91, 0x76, 0xa8, 0x26, 0x55, 0xda, 0xfc, 0x95, 0x87, 0x99, 0x6c, 0xea, 0x0e, 0x89, 0x7a, 0xf2,
+	0x29, 0x7d, 0xd2, 0x60, 0xfa, 0x42, 0xd6, 0x50, 0x63, 0xe4, 0xa8, 0x61, 0x99, 0x37, 0xac, 0x71,
+	0xcb, 0xd3, 0xcc, 0x2c, 0x7d, 0xfc, 0xf1, 0xf3, 0x73, 0x6e, 0xfe, 0x81, 0xb6, 0x6a, 0x22, 0xbb,
+	0xb7, 0x66, 0x67, 0xcb, 0xdb, 0xc9, 0x4d, 0x7d, 0xd3, 0x00, 0x5d, 0x4e, 0x1c, 0x6a, 0x8e, 0x64,
+	0x19, 0x19, 0x6a, 0x63, 0xfd, 0xaf, 0x7a, 0x52, 0x79, 0x37, 0x94, 0xbc, 0xe5, 0xd5, 0xc5, 0xcb,
+	0xda, 0xec, 0x77, 0xe9, 0x2d, 0xbf, 0x47, 0x1f, 0x34, 0xa8, 0xf4, 0xa7, 0x14, 0xdd, 0x1b, 0xc9,
+	0x35, 0xe4, 0x81, 0x18, 0x8d, 0x31, 0xab, 0x53, 0x4d, 0x73, 0x4a, 0x53, 0x15, 0x55, 0xfa, 0x35,
+	0xa1, 0x2f, 0x1a, 0xc0, 0x79, 0x2e, 0xd0, 0xea, 0x15, 0xff, 0x7c, 0x03, 0x81, 0x35, 0xee, 0x8e,
+	0x55, 0x9b, 0xb2, 0x6f, 0x28, 0x76, 0x4b, 0x5e, 0xd8, 0x9d, 0xab, 0x4c, 0xb1, 0xa5, 0xa7, 0x0d,
+	0xc1, 0x1b, 0x32, 0x6b, 0x8f, 0xae, 0xbf, 0x5e, 0x69, 0x53, 0xd1, 0xe9, 0xee, 0x5b, 0x1e, 0x0f,
+	0xec, 0x84, 0xab, 0x21, 0xb9, 0x6c, 0xc5, 0x15, 0xdb, 0x6d, 0xc2, 0xf6, 0x27, 0xd5, 0xef, 0xf5,
+	0xdf, 0x03, 0x00, 0x43, 0x46, 0x02, 0x17, 0xf3, 0x06, 0x00, 0x00,
+}
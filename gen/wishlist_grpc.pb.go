@@ -0,0 +1,247 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: wishlist.proto
+
+package gen
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	WishlistService_AddToWishlist_FullMethodName      = "/go.escape.ship.proto.v1.WishlistService/AddToWishlist"
+	WishlistService_RemoveFromWishlist_FullMethodName = "/go.escape.ship.proto.v1.WishlistService/RemoveFromWishlist"
+	WishlistService_ListWishlist_FullMethodName       = "/go.escape.ship.proto.v1.WishlistService/ListWishlist"
+	WishlistService_MoveToCart_FullMethodName         = "/go.escape.ship.proto.v1.WishlistService/MoveToCart"
+)
+
+// WishlistServiceClient is the client API for WishlistService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 위시리스트(찜) 서비스: Authorization 헤더의 사용자 기준으로 동작
+type WishlistServiceClient interface {
+	// 같은 상품/옵션이 이미 있으면 기존 항목을 그대로 반환
+	AddToWishlist(ctx context.Context, in *AddToWishlistRequest, opts ...grpc.CallOption) (*AddToWishlistResponse, error)
+	RemoveFromWishlist(ctx context.Context, in *RemoveFromWishlistRequest, opts ...grpc.CallOption) (*RemoveFromWishlistResponse, error)
+	// 최근 담은 순
+	ListWishlist(ctx context.Context, in *ListWishlistRequest, opts ...grpc.CallOption) (*ListWishlistResponse, error)
+	// 장바구니에 담고 위시리스트에서 제거 (keep_in_wishlist면 유지)
+	// 장바구니 담기와 동일하게 재고 부족은 OUT_OF_STOCK, 구매 제한 초과는 PURCHASE_LIMIT_EXCEEDED 에러
+	MoveToCart(ctx context.Context, in *MoveToCartRequest, opts ...grpc.CallOption) (*MoveToCartResponse, error)
+}
+
+type wishlistServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWishlistServiceClient(cc grpc.ClientConnInterface) WishlistServiceClient {
+	return &wishlistServiceClient{cc}
+}
+
+func (c *wishlistServiceClient) AddToWishlist(ctx context.Context, in *AddToWishlistRequest, opts ...grpc.CallOption) (*AddToWishlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddToWishlistResponse)
+	err := c.cc.Invoke(ctx, WishlistService_AddToWishlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wishlistServiceClient) RemoveFromWishlist(ctx context.Context, in *RemoveFromWishlistRequest, opts ...grpc.CallOption) (*RemoveFromWishlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveFromWishlistResponse)
+	err := c.cc.Invoke(ctx, WishlistService_RemoveFromWishlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wishlistServiceClient) ListWishlist(ctx context.Context, in *ListWishlistRequest, opts ...grpc.CallOption) (*ListWishlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWishlistResponse)
+	err := c.cc.Invoke(ctx, WishlistService_ListWishlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wishlistServiceClient) MoveToCart(ctx context.Context, in *MoveToCartRequest, opts ...grpc.CallOption) (*MoveToCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveToCartResponse)
+	err := c.cc.Invoke(ctx, WishlistService_MoveToCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WishlistServiceServer is the server API for WishlistService service.
+// All implementations must embed UnimplementedWishlistServiceServer
+// for forward compatibility.
+//
+// 위시리스트(찜) 서비스: Authorization 헤더의 사용자 기준으로 동작
+type WishlistServiceServer interface {
+	// 같은 상품/옵션이 이미 있으면 기존 항목을 그대로 반환
+	AddToWishlist(context.Context, *AddToWishlistRequest) (*AddToWishlistResponse, error)
+	RemoveFromWishlist(context.Context, *RemoveFromWishlistRequest) (*RemoveFromWishlistResponse, error)
+	// 최근 담은 순
+	ListWishlist(context.Context, *ListWishlistRequest) (*ListWishlistResponse, error)
+	// 장바구니에 담고 위시리스트에서 제거 (keep_in_wishlist면 유지)
+	// 장바구니 담기와 동일하게 재고 부족은 OUT_OF_STOCK, 구매 제한 초과는 PURCHASE_LIMIT_EXCEEDED 에러
+	MoveToCart(context.Context, *MoveToCartRequest) (*MoveToCartResponse, error)
+	mustEmbedUnimplementedWishlistServiceServer()
+}
+
+// UnimplementedWishlistServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWishlistServiceServer struct{}
+
+func (UnimplementedWishlistServiceServer) AddToWishlist(context.Context, *AddToWishlistRequest) (*AddToWishlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToWishlist not implemented")
+}
+func (UnimplementedWishlistServiceServer) RemoveFromWishlist(context.Context, *RemoveFromWishlistRequest) (*RemoveFromWishlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromWishlist not implemented")
+}
+func (UnimplementedWishlistServiceServer) ListWishlist(context.Context, *ListWishlistRequest) (*ListWishlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWishlist not implemented")
+}
+func (UnimplementedWishlistServiceServer) MoveToCart(context.Context, *MoveToCartRequest) (*MoveToCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveToCart not implemented")
+}
+func (UnimplementedWishlistServiceServer) mustEmbedUnimplementedWishlistServiceServer() {}
+func (UnimplementedWishlistServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeWishlistServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WishlistServiceServer will
+// result in compilation errors.
+type UnsafeWishlistServiceServer interface {
+	mustEmbedUnimplementedWishlistServiceServer()
+}
+
+func RegisterWishlistServiceServer(s grpc.ServiceRegistrar, srv WishlistServiceServer) {
+	// If the following call pancis, it indicates UnimplementedWishlistServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WishlistService_ServiceDesc, srv)
+}
+
+func _WishlistService_AddToWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddToWishlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WishlistServiceServer).AddToWishlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WishlistService_AddToWishlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WishlistServiceServer).AddToWishlist(ctx, req.(*AddToWishlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WishlistService_RemoveFromWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFromWishlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WishlistServiceServer).RemoveFromWishlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WishlistService_RemoveFromWishlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WishlistServiceServer).RemoveFromWishlist(ctx, req.(*RemoveFromWishlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WishlistService_ListWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWishlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WishlistServiceServer).ListWishlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WishlistService_ListWishlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WishlistServiceServer).ListWishlist(ctx, req.(*ListWishlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WishlistService_MoveToCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveToCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WishlistServiceServer).MoveToCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WishlistService_MoveToCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WishlistServiceServer).MoveToCart(ctx, req.(*MoveToCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WishlistService_ServiceDesc is the grpc.ServiceDesc for WishlistService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WishlistService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "go.escape.ship.proto.v1.WishlistService",
+	HandlerType: (*WishlistServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddToWishlist",
+			Handler:    _WishlistService_AddToWishlist_Handler,
+		},
+		{
+			MethodName: "RemoveFromWishlist",
+			Handler:    _WishlistService_RemoveFromWishlist_Handler,
+		},
+		{
+			MethodName: "ListWishlist",
+			Handler:    _WishlistService_ListWishlist_Handler,
+		},
+		{
+			MethodName: "MoveToCart",
+			Handler:    _WishlistService_MoveToCart_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "wishlist.proto",
+}
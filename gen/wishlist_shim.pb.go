@@ -0,0 +1,75 @@
+// Code generated by protoc-gen-go-shim. DO NOT EDIT.
+// source: wishlist.proto
+
+package gen
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+)
+
+// WishlistServiceAPI is WishlistServiceClient without per-call options, so it can be
+// mocked with plain method signatures. Streams are exposed as iterators.
+type WishlistServiceAPI interface {
+	// 같은 상품/옵션이 이미 있으면 기존 항목을 그대로 반환
+	AddToWishlist(ctx context.Context, in *AddToWishlistRequest) (*AddToWishlistResponse, error)
+	RemoveFromWishlist(ctx context.Context, in *RemoveFromWishlistRequest) (*RemoveFromWishlistResponse, error)
+	// 최근 담은 순
+	ListWishlist(ctx context.Context, in *ListWishlistRequest) (*ListWishlistResponse, error)
+	// 장바구니에 담고 위시리스트에서 제거 (keep_in_wishlist면 유지)
+	// 장바구니 담기와 동일하게 재고 부족은 OUT_OF_STOCK, 구매 제한 초과는 PURCHASE_LIMIT_EXCEEDED 에러
+	MoveToCart(ctx context.Context, in *MoveToCartRequest) (*MoveToCartResponse, error)
+}
+
+// NewWishlistServiceAPI adapts c to WishlistServiceAPI, passing opts to every call.
+func NewWishlistServiceAPI(c WishlistServiceClient, opts ...grpc.CallOption) WishlistServiceAPI {
+	return &wishlistServiceAPI{c: c, opts: opts}
+}
+
+type wishlistServiceAPI struct {
+	c    WishlistServiceClient
+	opts []grpc.CallOption
+}
+
+func (a *wishlistServiceAPI) AddToWishlist(ctx context.Context, in *AddToWishlistRequest) (*AddToWishlistResponse, error) {
+	return a.c.AddToWishlist(ctx, in, a.opts...)
+}
+
+func (a *wishlistServiceAPI) RemoveFromWishlist(ctx context.Context, in *RemoveFromWishlistRequest) (*RemoveFromWishlistResponse, error) {
+	return a.c.RemoveFromWishlist(ctx, in, a.opts...)
+}
+
+func (a *wishlistServiceAPI) ListWishlist(ctx context.Context, in *ListWishlistRequest) (*ListWishlistResponse, error) {
+	return a.c.ListWishlist(ctx, in, a.opts...)
+}
+
+func (a *wishlistServiceAPI) MoveToCart(ctx context.Context, in *MoveToCartRequest) (*MoveToCartResponse, error) {
+	return a.c.MoveToCart(ctx, in, a.opts...)
+}
+
+// WishlistServiceClientFromAPI adapts a to WishlistServiceClient, e.g. to hand a
+// mock WishlistServiceAPI to code that takes the generated client. Call options
+// are ignored, and streams report empty headers and trailers.
+func WishlistServiceClientFromAPI(a WishlistServiceAPI) WishlistServiceClient {
+	return wishlistServiceAPIClient{api: a}
+}
+
+type wishlistServiceAPIClient struct {
+	api WishlistServiceAPI
+}
+
+func (c wishlistServiceAPIClient) AddToWishlist(ctx context.Context, in *AddToWishlistRequest, _ ...grpc.CallOption) (*AddToWishlistResponse, error) {
+	return c.api.AddToWishlist(ctx, in)
+}
+
+func (c wishlistServiceAPIClient) RemoveFromWishlist(ctx context.Context, in *RemoveFromWishlistRequest, _ ...grpc.CallOption) (*RemoveFromWishlistResponse, error) {
+	return c.api.RemoveFromWishlist(ctx, in)
+}
+
+func (c wishlistServiceAPIClient) ListWishlist(ctx context.Context, in *ListWishlistRequest, _ ...grpc.CallOption) (*ListWishlistResponse, error) {
+	return c.api.ListWishlist(ctx, in)
+}
+
+func (c wishlistServiceAPIClient) MoveToCart(ctx context.Context, in *MoveToCartRequest, _ ...grpc.CallOption) (*MoveToCartResponse, error) {
+	return c.api.MoveToCart(ctx, in)
+}
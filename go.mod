@@ -3,15 +3,21 @@ module github.com/escape-ship/protos
 go 1.24.5
 
 require (
+	connectrpc.com/connect v1.18.1
+	github.com/graph-gophers/graphql-go v1.5.0
 	github.com/grpc-ecosystem/grpc-gateway/v2 v2.20.0
+	github.com/twitchtv/twirp v8.1.3+incompatible
+	golang.org/x/image v0.18.0
+	golang.org/x/text v0.16.0
 	google.golang.org/genproto/googleapis/api v0.0.0-20240730163845-b1a4ccb954bf
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20240725223205-93522f1f2a9f
 	google.golang.org/grpc v1.65.0
 	google.golang.org/protobuf v1.34.2
+	pgregory.net/rapid v1.2.0
 )
 
 require (
+	github.com/pkg/errors v0.9.1 // indirect
 	golang.org/x/net v0.26.0 // indirect
 	golang.org/x/sys v0.21.0 // indirect
-	golang.org/x/text v0.16.0 // indirect
-	google.golang.org/genproto/googleapis/rpc v0.0.0-20240725223205-93522f1f2a9f // indirect
 )
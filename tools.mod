@@ -3,9 +3,11 @@ module github.com/escape-ship/tools
 go 1.24.5
 
 tool (
+	connectrpc.com/connect/cmd/protoc-gen-connect-go
 	github.com/golang/protobuf/protoc-gen-go
 	github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-grpc-gateway
 	github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2
+	github.com/twitchtv/twirp/protoc-gen-twirp
 	google.golang.org/grpc/cmd/protoc-gen-go-grpc
 	google.golang.org/protobuf/cmd/protoc-gen-go
 )